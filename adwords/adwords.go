@@ -0,0 +1,187 @@
+// Package adwords is a version-agnostic facade for the common AdWords
+// services. Accounts can be moved to a new API version one at a time by
+// opening a Client per account with the version it has been migrated to.
+//
+// The facade types carry the fields of the common entity types. They are
+// converted to and from the generated types through their JSON form, which
+// uses the element names of the API and is therefore the same across
+// versions. Ads and criteria, whose concrete types the generated types
+// can't hold, are sent and received in their XML form instead.
+package adwords
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// DefaultEndpoint is the host of the AdWords API.
+const DefaultEndpoint = "https://adwords.google.com"
+
+// Config holds the credentials and request headers of a Client.
+type Config struct {
+	// Endpoint defaults to DefaultEndpoint.
+	Endpoint string
+
+	// Login and Password are sent as HTTP basic auth if Login is set.
+	Login    string
+	Password string
+
+	ClientCustomerId string
+	DeveloperToken   string
+	UserAgent        string
+	ValidateOnly     bool
+	PartialFailure   bool
+}
+
+// url returns the URL of a service of the given group (e.g. "cm").
+func (c Config) url(group, version, service string) string {
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	return fmt.Sprintf("%s/api/adwords/%s/%s/%s", endpoint, group, version, service)
+}
+
+// ReportURL returns the URL of the ad-hoc report download endpoint of the
+// given API version.
+func (c Config) ReportURL(version string) string {
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	return fmt.Sprintf("%s/api/adwords/reportdownload/%s", endpoint, version)
+}
+
+// optional returns a pointer to s for the optional string fields of the
+// generated types, or nil if s is empty so the element is omitted.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// String returns a pointer to s, for setting the fields of the entities.
+func String(s string) *string {
+	return &s
+}
+
+// StringValue returns the value p points to, or "" if p is nil.
+func StringValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+// Int64 returns a pointer to v, for setting ids.
+func Int64(v int64) *int64 {
+	return &v
+}
+
+// Bool returns a pointer to v, for setting the flags of the entities.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// A Client gives access to the common services of one API version.
+type Client struct {
+	Version string
+
+	Budgets         Service[Budget]
+	Campaigns       Service[Campaign]
+	AdGroups        Service[AdGroup]
+	AdGroupAds      Service[AdGroupAd]
+	AdGroupCriteria Service[AdGroupCriterion]
+
+	// ReportTypes lists the report types of the version, e.g.
+	// "KEYWORDS_PERFORMANCE_REPORT".
+	ReportTypes       []string
+	ReportDefinitions ReportService
+
+	// ManagedCustomers lists the accounts managed by the ClientCustomerId
+	// of the Config.
+	ManagedCustomers AccountService
+
+	// Reports downloads ad-hoc reports. It is set if the report package,
+	// which implements it, is linked into the program; its Downloader
+	// offers the options of the download.
+	Reports ReportDownloader
+}
+
+// A Service manages entities of type T.
+type Service[T any] interface {
+	Get(selector *Selector) (*Page[T], error)
+	Mutate(operations []*Operation[T]) (*ReturnValue[T], error)
+	Query(query string) (*Page[T], error)
+}
+
+// A ReportService describes the fields of the report types.
+type ReportService interface {
+	GetReportFields(reportType string) ([]*ReportField, error)
+}
+
+// An AccountService lists the accounts of a manager account. Get returns
+// the manager account itself and all accounts below it.
+type AccountService interface {
+	Get(selector *Selector) (*Page[ManagedCustomer], error)
+}
+
+// A ReportDownloader downloads the report of an AWQL query in a download
+// format, e.g. "CSV". The report must be closed by the caller.
+type ReportDownloader interface {
+	Query(ctx context.Context, query, format string) (io.ReadCloser, error)
+}
+
+var (
+	mu       sync.RWMutex
+	versions = make(map[string]func(Config) *Client)
+	reports  func(version string, config Config) ReportDownloader
+)
+
+// register makes a generated API version available to New.
+func register(version string, open func(Config) *Client) {
+	mu.Lock()
+	defer mu.Unlock()
+	versions[version] = open
+}
+
+// RegisterReports makes the report package the ReportDownloader of the
+// Clients returned by New. The report package calls it when it is
+// initialized.
+func RegisterReports(open func(version string, config Config) ReportDownloader) {
+	mu.Lock()
+	defer mu.Unlock()
+	reports = open
+}
+
+// Versions returns the supported API versions in ascending order.
+func Versions() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	var list []string
+	for v := range versions {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// New returns a Client for the given API version, e.g. "v201802".
+func New(version string, config Config) (*Client, error) {
+	mu.RLock()
+	open, ok := versions[version]
+	openReports := reports
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("adwords: unsupported API version %q", version)
+	}
+	client := open(config)
+	if openReports != nil {
+		client.Reports = openReports(version, config)
+	}
+	return client, nil
+}
@@ -0,0 +1,36 @@
+package adwords
+
+import "github.com/godofdream/go-googleadsinofficial/batch"
+
+// MutateBatch is Service.Mutate for any number of operations. They are sent
+// in chunks limited by opts and the results are merged in the order of the
+// operations. Partial failure errors are re-indexed to the positions in
+// operations. A chunk failing as a whole or returning a number of values
+// other than its number of operations leaves nil values at its positions
+// and returns a batch.ChunkError, joined with those of the other failed
+// chunks. A chunk without values, e.g. with ValidateOnly, leaves nil
+// values too.
+func MutateBatch[T any](s Service[T], operations []*Operation[T], opts *batch.Options) (*ReturnValue[T], error) {
+	chunks, err := batch.Run(operations, opts, s.Mutate)
+	err = batch.Check(chunks, err, func(r *ReturnValue[T]) int {
+		if r == nil {
+			return 0
+		}
+		return len(r.Value)
+	})
+	rval := new(ReturnValue[T])
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil {
+			rval.Value = append(rval.Value, make([]*T, c.End-c.Start)...)
+			continue
+		}
+		rval.Value = append(rval.Value, batch.Pad(c.Result.Value, c.End-c.Start)...)
+		for _, e := range c.Result.PartialFailureErrors {
+			if e != nil {
+				e.FieldPath = batch.Reindex(e.FieldPath, c.Start)
+			}
+		}
+		rval.PartialFailureErrors = append(rval.PartialFailureErrors, c.Result.PartialFailureErrors...)
+	}
+	return rval, err
+}
@@ -0,0 +1,63 @@
+package adwords
+
+import "strconv"
+
+// keyed is implemented by the entities with an id. The pagers of GetPager
+// and QueryPager select keyFields and skip the entries of overlapping
+// pages by key.
+type keyed interface {
+	key() string
+	keyFields() []string
+}
+
+// pagerKey returns the Key of the pagers of T and the fields it needs, or
+// nil if T has no id.
+func pagerKey[T any]() (func(*T) string, []string) {
+	if k, ok := any(new(T)).(keyed); ok {
+		return func(e *T) string { return any(e).(keyed).key() }, k.keyFields()
+	}
+	return nil, nil
+}
+
+func id(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+// ids joins the ids of a child entity and its parent, or returns "" if one
+// is missing.
+func ids(parent, child *int64) string {
+	if parent == nil || child == nil {
+		return ""
+	}
+	return id(parent) + "/" + id(child)
+}
+
+func (b *Budget) key() string         { return id(b.BudgetId) }
+func (b *Budget) keyFields() []string { return []string{"BudgetId"} }
+
+func (c *Campaign) key() string         { return id(c.Id) }
+func (c *Campaign) keyFields() []string { return []string{"Id"} }
+
+func (g *AdGroup) key() string         { return id(g.Id) }
+func (g *AdGroup) keyFields() []string { return []string{"Id"} }
+
+func (a *AdGroupAd) key() string {
+	if a.Ad == nil {
+		return ""
+	}
+	return ids(a.AdGroupId, a.Ad.Id)
+}
+
+func (a *AdGroupAd) keyFields() []string { return []string{"AdGroupId", "Id"} }
+
+func (c *AdGroupCriterion) key() string {
+	if c.Criterion == nil {
+		return ""
+	}
+	return ids(c.AdGroupId, c.Criterion.Id)
+}
+
+func (c *AdGroupCriterion) keyFields() []string { return []string{"AdGroupId", "Id"} }
@@ -0,0 +1,118 @@
+package adwords
+
+import (
+	"iter"
+
+	"github.com/godofdream/go-googleadsinofficial/awql"
+	"github.com/godofdream/go-googleadsinofficial/paging"
+)
+
+// GetAll returns an iterator over the entities of s selected by sel,
+// fetching one page after the other. The Paging of sel selects the first
+// entity and the page size.
+func GetAll[T any](s Service[T], sel *Selector) iter.Seq2[*T, error] {
+	return GetPager(s, sel).All()
+}
+
+// GetEach calls fn for every entity of s selected by sel and returns the
+// first error. See GetAll.
+func GetEach[T any](s Service[T], sel *Selector, fn func(*T) error) error {
+	return GetPager(s, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests. Entities with an id have it
+// selected, so overlapping pages are deduplicated by id.
+func GetPager[T any](s Service[T], sel *Selector) *paging.Pager[*T] {
+	p := new(paging.Pager[*T])
+	var fields []string
+	p.Key, fields = pagerKey[T]()
+	if sel == nil {
+		sel = new(Selector)
+	}
+	if sel.Paging != nil {
+		p.Start, p.PageSize = sel.Paging.StartIndex, sel.Paging.NumberResults
+	}
+	p.Fetch = func(start, n int32) ([]*T, int32, error) {
+		paged := *sel
+		paged.Paging = &Paging{StartIndex: start, NumberResults: n}
+		for _, f := range fields {
+			paged.Fields = paging.WithField(paged.Fields, f)
+		}
+		page, err := s.Get(&paged)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Entries, page.TotalNumEntries, nil
+	}
+	return p
+}
+
+// GetSplitAll is GetAll for selections larger than the service returns.
+// See paging.Splitter.
+func GetSplitAll[T any](s Service[T], sel *Selector, split *paging.Splitter) iter.Seq2[*T, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel, splitPager(s))
+}
+
+// GetSplitEach is GetEach for selections larger than the service returns.
+// See paging.Splitter.
+func GetSplitEach[T any](s Service[T], sel *Selector, split *paging.Splitter, fn func(*T) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel, splitPager(s), fn)
+}
+
+func splitPager[T any](s Service[T]) func(*Selector) (*paging.Pager[*T], error) {
+	return func(sel *Selector) (*paging.Pager[*T], error) {
+		return GetPager(s, sel), nil
+	}
+}
+
+// QueryAll returns an iterator over the entities of s matching the AWQL
+// query, fetching one page after the other. The LIMIT clause of the query
+// selects the first entity and the page size.
+func QueryAll[T any](s Service[T], query string) iter.Seq2[*T, error] {
+	return QueryPager(s, query).All()
+}
+
+// QueryEach calls fn for every entity of s matching the AWQL query and
+// returns the first error. See QueryAll.
+func QueryEach[T any](s Service[T], query string, fn func(*T) error) error {
+	return QueryPager(s, query).Each(fn)
+}
+
+// QueryPager returns the paging.Pager of QueryAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func QueryPager[T any](s Service[T], query string) *paging.Pager[*T] {
+	p := new(paging.Pager[*T])
+	var fields []string
+	p.Key, fields = pagerKey[T]()
+	q, err := awql.Parse(query)
+	if err == nil && q.Paging != nil {
+		p.Start, p.PageSize = q.Paging.StartIndex, q.Paging.NumberResults
+	}
+	p.Fetch = func(start, n int32) ([]*T, int32, error) {
+		if err != nil {
+			return nil, 0, err
+		}
+		window := *q
+		window.Paging = &Paging{StartIndex: start, NumberResults: n}
+		for _, f := range fields {
+			window.Fields = paging.WithField(window.Fields, f)
+		}
+		paged, err := window.Format()
+		if err != nil {
+			return nil, 0, err
+		}
+		page, err := s.Query(paged)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Entries, page.TotalNumEntries, nil
+	}
+	return p
+}
@@ -0,0 +1,200 @@
+package adwords
+
+import (
+	"encoding/json"
+	"encoding/xml"
+)
+
+// service adapts the generated client of one version to Service. The type
+// parameters are the request and response types of the generated package.
+type service[T, Get, GetResponse, Mutate, MutateResponse, Query, QueryResponse any] struct {
+	get    func(*Get) (*GetResponse, error)
+	mutate func(*Mutate) (*MutateResponse, error)
+	query  func(*Query) (*QueryResponse, error)
+}
+
+func (s *service[T, Get, GetResponse, Mutate, MutateResponse, Query, QueryResponse]) Get(selector *Selector) (*Page[T], error) {
+	req := new(Get)
+	if err := convert(getRequest(selector), req); err != nil {
+		return nil, err
+	}
+	resp, err := s.get(req)
+	if err != nil {
+		return nil, err
+	}
+	return page[T](resp)
+}
+
+func (s *service[T, Get, GetResponse, Mutate, MutateResponse, Query, QueryResponse]) Mutate(operations []*Operation[T]) (*ReturnValue[T], error) {
+	req := new(Mutate)
+	if err := convert(struct {
+		Operations []*Operation[T] `json:"operations"`
+	}{operations}, req); err != nil {
+		return nil, err
+	}
+	resp, err := s.mutate(req)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Rval *ReturnValue[T] `json:"rval"`
+	}
+	if err = convert(resp, &out); err != nil {
+		return nil, err
+	}
+	if out.Rval == nil {
+		out.Rval = new(ReturnValue[T])
+	}
+	return out.Rval, nil
+}
+
+func (s *service[T, Get, GetResponse, Mutate, MutateResponse, Query, QueryResponse]) Query(query string) (*Page[T], error) {
+	req := new(Query)
+	if err := convert(struct {
+		Query string `json:"query"`
+	}{query}, req); err != nil {
+		return nil, err
+	}
+	resp, err := s.query(req)
+	if err != nil {
+		return nil, err
+	}
+	return page[T](resp)
+}
+
+// concreteService adapts the generated client of one version to Service
+// for the polymorphic entities, see AdGroupAd. Get and Query send the
+// generated requests, Mutate sends the operations in the namespace of the
+// version, and the responses are decoded into T.
+type concreteService[T, Get, Query any] struct {
+	namespace string
+	call      func(request, response interface{}) error
+}
+
+// rval is the response of a concreteService.
+type rval[V any] struct {
+	Rval *V `xml:"rval"`
+}
+
+func (s *concreteService[T, Get, Query]) Get(selector *Selector) (*Page[T], error) {
+	req := new(Get)
+	if err := convert(getRequest(selector), req); err != nil {
+		return nil, err
+	}
+	return s.page(req)
+}
+
+func (s *concreteService[T, Get, Query]) Mutate(operations []*Operation[T]) (*ReturnValue[T], error) {
+	req := &struct {
+		XMLName    xml.Name
+		Operations []*Operation[T] `xml:"operations"`
+	}{XMLName: xml.Name{Space: s.namespace, Local: "mutate"}, Operations: operations}
+	resp := new(rval[ReturnValue[T]])
+	if err := s.call(req, resp); err != nil {
+		return nil, err
+	}
+	if resp.Rval == nil {
+		resp.Rval = new(ReturnValue[T])
+	}
+	return resp.Rval, nil
+}
+
+func (s *concreteService[T, Get, Query]) Query(query string) (*Page[T], error) {
+	req := new(Query)
+	if err := convert(struct {
+		Query string `json:"query"`
+	}{query}, req); err != nil {
+		return nil, err
+	}
+	return s.page(req)
+}
+
+func (s *concreteService[T, Get, Query]) page(req interface{}) (*Page[T], error) {
+	resp := new(rval[Page[T]])
+	if err := s.call(req, resp); err != nil {
+		return nil, err
+	}
+	if resp.Rval == nil {
+		resp.Rval = new(Page[T])
+	}
+	return resp.Rval, nil
+}
+
+// reportDefinitionService adapts the generated ReportDefinitionService of
+// one version to ReportService.
+type reportDefinitionService[GetReportFields, GetReportFieldsResponse any] struct {
+	getReportFields func(*GetReportFields) (*GetReportFieldsResponse, error)
+}
+
+func (s *reportDefinitionService[GetReportFields, GetReportFieldsResponse]) GetReportFields(reportType string) ([]*ReportField, error) {
+	req := new(GetReportFields)
+	if err := convert(struct {
+		ReportType string `json:"reportType"`
+	}{reportType}, req); err != nil {
+		return nil, err
+	}
+	resp, err := s.getReportFields(req)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Rval []*ReportField `json:"rval"`
+	}
+	if err = convert(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Rval, nil
+}
+
+// managedCustomerService adapts the generated ManagedCustomerService of
+// one version to AccountService. Its Get takes a serviceSelector.
+type managedCustomerService[Get, GetResponse any] struct {
+	get func(*Get) (*GetResponse, error)
+}
+
+func (s *managedCustomerService[Get, GetResponse]) Get(selector *Selector) (*Page[ManagedCustomer], error) {
+	req := new(Get)
+	if err := convert(struct {
+		ServiceSelector *Selector `json:"serviceSelector"`
+	}{selector}, req); err != nil {
+		return nil, err
+	}
+	resp, err := s.get(req)
+	if err != nil {
+		return nil, err
+	}
+	return page[ManagedCustomer](resp)
+}
+
+// getRequest returns the JSON form of a Get request. Depending on the
+// service, its selector is named selector or serviceSelector.
+func getRequest(selector *Selector) interface{} {
+	return struct {
+		Selector        *Selector `json:"selector"`
+		ServiceSelector *Selector `json:"serviceSelector"`
+	}{selector, selector}
+}
+
+// page extracts the rval page of a Get or Query response.
+func page[T any](resp interface{}) (*Page[T], error) {
+	var out struct {
+		Rval *Page[T] `json:"rval"`
+	}
+	if err := convert(resp, &out); err != nil {
+		return nil, err
+	}
+	if out.Rval == nil {
+		out.Rval = new(Page[T])
+	}
+	return out.Rval, nil
+}
+
+// convert copies src into dst through their JSON form. Enum values unknown
+// to the target version become UNKNOWN, which the API rejects in requests.
+func convert(src, dst interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
@@ -0,0 +1,247 @@
+package adwords
+
+import (
+	"encoding/xml"
+
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// Selector selects the entities returned by Service.Get. Use
+// selector.Select to build one.
+type Selector = selector.Selector
+
+// Predicate filters the entities of a Selector.
+type Predicate = selector.Predicate
+
+// OrderBy sorts the entities of a Selector.
+type OrderBy = selector.OrderBy
+
+// Paging selects a window of the entities of a Selector.
+type Paging = selector.Paging
+
+// DateRange is an inclusive range of "yyyyMMdd" dates.
+type DateRange = selector.DateRange
+
+// Page is a page of entities returned by Service.Get and Service.Query.
+type Page[T any] struct {
+	TotalNumEntries int32 `xml:"totalNumEntries" json:"totalNumEntries"`
+	Entries         []*T  `xml:"entries" json:"entries"`
+}
+
+// Operation is a single change passed to Service.Mutate.
+type Operation[T any] struct {
+	// Operator is one of ADD, SET and REMOVE.
+	Operator string `xml:"operator" json:"operator"`
+	Operand  *T     `xml:"operand" json:"operand"`
+}
+
+// ReturnValue is the result of Service.Mutate.
+type ReturnValue[T any] struct {
+	Value                []*T        `xml:"value" json:"value"`
+	PartialFailureErrors []*ApiError `xml:"partialFailureErrors" json:"partialFailureErrors"`
+}
+
+// ApiError is an error of a single operation.
+type ApiError struct {
+	FieldPath    string `xml:"fieldPath" json:"fieldPath"`
+	Trigger      string `xml:"trigger" json:"trigger"`
+	ErrorString  string `xml:"errorString" json:"errorString"`
+	ApiErrorType string `xml:"ApiError.Type" json:"apiErrorType"`
+}
+
+// Money is an amount in micros of the account currency.
+type Money struct {
+	MicroAmount int64 `xml:"microAmount" json:"microAmount"`
+}
+
+// The fields of the entity types are pointers, so that unset fields are
+// omitted from a SET while empty values, e.g. an empty
+// TrackingUrlTemplate, are sent to clear a field. Use String, Int64 and
+// Bool to set them. Only the names of the concrete types are plain strings.
+
+// Budget is a shared or dedicated campaign budget.
+type Budget struct {
+	BudgetId           *int64  `json:"budgetId,omitempty"`
+	Name               *string `json:"name,omitempty"`
+	Amount             *Money  `json:"amount,omitempty"`
+	DeliveryMethod     *string `json:"deliveryMethod,omitempty"`
+	ReferenceCount     *int32  `json:"referenceCount,omitempty"`
+	IsExplicitlyShared *bool   `json:"isExplicitlyShared,omitempty"`
+	Status             *string `json:"status,omitempty"`
+}
+
+// Campaign is a campaign.
+type Campaign struct {
+	Id                        *int64  `json:"id,omitempty"`
+	Name                      *string `json:"name,omitempty"`
+	Status                    *string `json:"status,omitempty"`
+	ServingStatus             *string `json:"servingStatus,omitempty"`
+	StartDate                 *string `json:"startDate,omitempty"`
+	EndDate                   *string `json:"endDate,omitempty"`
+	Budget                    *Budget `json:"budget,omitempty"`
+	AdvertisingChannelType    *string `json:"advertisingChannelType,omitempty"`
+	AdvertisingChannelSubType *string `json:"advertisingChannelSubType,omitempty"`
+	TrackingUrlTemplate       *string `json:"trackingUrlTemplate,omitempty"`
+}
+
+// AdGroup is an ad group.
+type AdGroup struct {
+	Id                  *int64  `json:"id,omitempty"`
+	CampaignId          *int64  `json:"campaignId,omitempty"`
+	CampaignName        *string `json:"campaignName,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	Status              *string `json:"status,omitempty"`
+	AdGroupType         *string `json:"adGroupType,omitempty"`
+	TrackingUrlTemplate *string `json:"trackingUrlTemplate,omitempty"`
+}
+
+// The ads and criteria are polymorphic. The generated types only hold
+// their base types, so they are sent and received in the XML form of the
+// API, whose element names are the same across versions: the fields of
+// all concrete types are declared in the order of the schema, and the
+// concrete type is sent as xsi:type.
+
+// AdGroupAd is an ad of an ad group.
+type AdGroupAd struct {
+	AdGroupId *int64  `xml:"adGroupId,omitempty" json:"adGroupId,omitempty"`
+	Ad        *Ad     `xml:"ad,omitempty" json:"ad,omitempty"`
+	Status    *string `xml:"status,omitempty" json:"status,omitempty"`
+}
+
+// Ad holds the fields of the common ad types. AdType names the concrete
+// type, e.g. "ExpandedTextAd", whose fields are set.
+type Ad struct {
+	Id                  *int64   `xml:"id,omitempty" json:"id,omitempty"`
+	FinalUrls           []string `xml:"finalUrls,omitempty" json:"finalUrls,omitempty"`
+	TrackingUrlTemplate *string  `xml:"trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
+	Type                *string  `xml:"type,omitempty" json:"type,omitempty"`
+	AdType              string   `xml:"Ad.Type,omitempty" json:"adType,omitempty"`
+
+	// ExpandedTextAd
+	HeadlinePart1 *string `xml:"headlinePart1,omitempty" json:"headlinePart1,omitempty"`
+	HeadlinePart2 *string `xml:"headlinePart2,omitempty" json:"headlinePart2,omitempty"`
+	Description   *string `xml:"description,omitempty" json:"description,omitempty"`
+	Path1         *string `xml:"path1,omitempty" json:"path1,omitempty"`
+	Path2         *string `xml:"path2,omitempty" json:"path2,omitempty"`
+
+	// TextAd
+	Headline     *string `xml:"headline,omitempty" json:"headline,omitempty"`
+	Description1 *string `xml:"description1,omitempty" json:"description1,omitempty"`
+	Description2 *string `xml:"description2,omitempty" json:"description2,omitempty"`
+}
+
+// MarshalXML implements xml.Marshaler. It sends AdType as xsi:type.
+func (a *Ad) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain Ad
+	return encodeConcrete(e, start, a.AdType, (*plain)(a))
+}
+
+// AdGroupCriterion is a biddable or negative criterion of an ad group.
+// AdGroupCriterionType names the concrete type, "BiddableAdGroupCriterion"
+// or "NegativeAdGroupCriterion"; UserStatus and
+// BiddingStrategyConfiguration are those of biddable criteria.
+type AdGroupCriterion struct {
+	AdGroupId            *int64     `xml:"adGroupId,omitempty" json:"adGroupId,omitempty"`
+	CriterionUse         *string    `xml:"criterionUse,omitempty" json:"criterionUse,omitempty"`
+	Criterion            *Criterion `xml:"criterion,omitempty" json:"criterion,omitempty"`
+	AdGroupCriterionType string     `xml:"AdGroupCriterion.Type,omitempty" json:"adGroupCriterionType,omitempty"`
+
+	UserStatus                   *string                       `xml:"userStatus,omitempty" json:"userStatus,omitempty"`
+	BiddingStrategyConfiguration *BiddingStrategyConfiguration `xml:"biddingStrategyConfiguration,omitempty" json:"biddingStrategyConfiguration,omitempty"`
+}
+
+// MarshalXML implements xml.Marshaler. It sends AdGroupCriterionType as
+// xsi:type.
+func (c *AdGroupCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain AdGroupCriterion
+	return encodeConcrete(e, start, c.AdGroupCriterionType, (*plain)(c))
+}
+
+// Criterion holds the fields of the common criterion types. CriterionType
+// names the concrete type, e.g. "Keyword", whose fields are set.
+type Criterion struct {
+	Id            *int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Type          *string `xml:"type,omitempty" json:"type,omitempty"`
+	CriterionType string  `xml:"Criterion.Type,omitempty" json:"criterionType,omitempty"`
+
+	// Keyword
+	Text      *string `xml:"text,omitempty" json:"text,omitempty"`
+	MatchType *string `xml:"matchType,omitempty" json:"matchType,omitempty"`
+}
+
+// MarshalXML implements xml.Marshaler. It sends CriterionType as
+// xsi:type.
+func (c *Criterion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain Criterion
+	return encodeConcrete(e, start, c.CriterionType, (*plain)(c))
+}
+
+// BiddingStrategyConfiguration holds the bidding strategy and bids of a
+// criterion.
+type BiddingStrategyConfiguration struct {
+	BiddingStrategyType *string `xml:"biddingStrategyType,omitempty" json:"biddingStrategyType,omitempty"`
+	Bids                []*Bids `xml:"bids,omitempty" json:"bids,omitempty"`
+}
+
+// Bids is a bid of a BiddingStrategyConfiguration. BidsType names the
+// concrete type, e.g. "CpcBid".
+type Bids struct {
+	BidsType string `xml:"Bids.Type,omitempty" json:"bidsType,omitempty"`
+	Bid      *Money `xml:"bid,omitempty" json:"bid,omitempty"`
+}
+
+// MarshalXML implements xml.Marshaler. It sends BidsType as xsi:type.
+func (b *Bids) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain Bids
+	return encodeConcrete(e, start, b.BidsType, (*plain)(b))
+}
+
+// xsiNamespace is the namespace of the xsi:type attribute.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// encodeConcrete encodes v as start with the xsi:type attribute naming its
+// concrete type, if any. The type is resolved in the namespace of the
+// request.
+func encodeConcrete(e *xml.Encoder, start xml.StartElement, concrete string, v interface{}) error {
+	if concrete != "" {
+		start.Attr = append(start.Attr,
+			xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+			xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: concrete})
+	}
+	return e.EncodeElement(v, start)
+}
+
+// ManagedCustomer is an account managed by a manager account. CustomerId
+// is the ClientCustomerId of the account without dashes.
+type ManagedCustomer struct {
+	Name             *string `json:"name,omitempty"`
+	CustomerId       *int64  `json:"customerId,omitempty"`
+	CanManageClients *bool   `json:"canManageClients,omitempty"`
+	CurrencyCode     *string `json:"currencyCode,omitempty"`
+	DateTimeZone     *string `json:"dateTimeZone,omitempty"`
+	TestAccount      *bool   `json:"testAccount,omitempty"`
+}
+
+// ReportField describes a field of a report type.
+type ReportField struct {
+	FieldName           string           `json:"fieldName,omitempty"`
+	DisplayFieldName    string           `json:"displayFieldName,omitempty"`
+	XmlAttributeName    string           `json:"xmlAttributeName,omitempty"`
+	FieldType           string           `json:"fieldType,omitempty"`
+	FieldBehavior       string           `json:"fieldBehavior,omitempty"`
+	EnumValues          []string         `json:"enumValues,omitempty"`
+	CanSelect           bool             `json:"canSelect,omitempty"`
+	CanFilter           bool             `json:"canFilter,omitempty"`
+	IsEnumType          bool             `json:"isEnumType,omitempty"`
+	IsBeta              bool             `json:"isBeta,omitempty"`
+	IsZeroRowCompatible bool             `json:"isZeroRowCompatible,omitempty"`
+	EnumValuePairs      []*EnumValuePair `json:"enumValuePairs,omitempty"`
+	ExclusiveFields     []string         `json:"exclusiveFields,omitempty"`
+}
+
+// EnumValuePair maps an enum value of the API to the value displayed in
+// reports.
+type EnumValuePair struct {
+	EnumValue        string `json:"enumValue,omitempty"`
+	EnumDisplayValue string `json:"enumDisplayValue,omitempty"`
+}
@@ -0,0 +1,177 @@
+package adwords
+
+import (
+	"github.com/godofdream/go-googleadsinofficial/v201802/AdGroupAdService"
+	"github.com/godofdream/go-googleadsinofficial/v201802/AdGroupCriterionService"
+	"github.com/godofdream/go-googleadsinofficial/v201802/AdGroupService"
+	"github.com/godofdream/go-googleadsinofficial/v201802/BudgetService"
+	"github.com/godofdream/go-googleadsinofficial/v201802/CampaignService"
+	"github.com/godofdream/go-googleadsinofficial/v201802/ManagedCustomerService"
+	"github.com/godofdream/go-googleadsinofficial/v201802/ReportDefinitionService"
+)
+
+func init() {
+	register("v201802", openV201802)
+}
+
+func openV201802(c Config) *Client {
+	return &Client{
+		Version:         "v201802",
+		Budgets:         newV201802BudgetService(c),
+		Campaigns:       newV201802CampaignService(c),
+		AdGroups:        newV201802AdGroupService(c),
+		AdGroupAds:      newV201802AdGroupAdService(c),
+		AdGroupCriteria: newV201802AdGroupCriterionService(c),
+
+		ReportTypes:       v201802ReportTypes(),
+		ReportDefinitions: newV201802ReportDefinitionService(c),
+
+		ManagedCustomers: newV201802ManagedCustomerService(c),
+	}
+}
+
+func newV201802BudgetService(c Config) Service[Budget] {
+	var auth *BudgetService.BasicAuth
+	if c.Login != "" {
+		auth = &BudgetService.BasicAuth{Login: c.Login, Password: c.Password}
+	}
+	client := BudgetService.NewBudgetServiceInterface(c.url("cm", "v201802", "BudgetService"), false, auth)
+	client.AddHeader(&BudgetService.SoapHeader{
+		ClientCustomerId: optional(c.ClientCustomerId),
+		DeveloperToken:   optional(c.DeveloperToken),
+		UserAgent:        optional(c.UserAgent),
+		ValidateOnly:     BudgetService.Bool(c.ValidateOnly),
+		PartialFailure:   BudgetService.Bool(c.PartialFailure),
+	})
+	return &service[Budget, BudgetService.Get, BudgetService.GetResponse, BudgetService.Mutate, BudgetService.MutateResponse, BudgetService.Query, BudgetService.QueryResponse]{
+		get:    client.Get,
+		mutate: client.Mutate,
+		query:  client.Query,
+	}
+}
+
+func newV201802CampaignService(c Config) Service[Campaign] {
+	var auth *CampaignService.BasicAuth
+	if c.Login != "" {
+		auth = &CampaignService.BasicAuth{Login: c.Login, Password: c.Password}
+	}
+	client := CampaignService.NewCampaignServiceInterface(c.url("cm", "v201802", "CampaignService"), false, auth)
+	client.AddHeader(&CampaignService.SoapHeader{
+		ClientCustomerId: optional(c.ClientCustomerId),
+		DeveloperToken:   optional(c.DeveloperToken),
+		UserAgent:        optional(c.UserAgent),
+		ValidateOnly:     CampaignService.Bool(c.ValidateOnly),
+		PartialFailure:   CampaignService.Bool(c.PartialFailure),
+	})
+	return &service[Campaign, CampaignService.Get, CampaignService.GetResponse, CampaignService.Mutate, CampaignService.MutateResponse, CampaignService.Query, CampaignService.QueryResponse]{
+		get:    client.Get,
+		mutate: client.Mutate,
+		query:  client.Query,
+	}
+}
+
+func newV201802AdGroupService(c Config) Service[AdGroup] {
+	var auth *AdGroupService.BasicAuth
+	if c.Login != "" {
+		auth = &AdGroupService.BasicAuth{Login: c.Login, Password: c.Password}
+	}
+	client := AdGroupService.NewAdGroupServiceInterface(c.url("cm", "v201802", "AdGroupService"), false, auth)
+	client.AddHeader(&AdGroupService.SoapHeader{
+		ClientCustomerId: optional(c.ClientCustomerId),
+		DeveloperToken:   optional(c.DeveloperToken),
+		UserAgent:        optional(c.UserAgent),
+		ValidateOnly:     AdGroupService.Bool(c.ValidateOnly),
+		PartialFailure:   AdGroupService.Bool(c.PartialFailure),
+	})
+	return &service[AdGroup, AdGroupService.Get, AdGroupService.GetResponse, AdGroupService.Mutate, AdGroupService.MutateResponse, AdGroupService.Query, AdGroupService.QueryResponse]{
+		get:    client.Get,
+		mutate: client.Mutate,
+		query:  client.Query,
+	}
+}
+
+func newV201802AdGroupAdService(c Config) Service[AdGroupAd] {
+	var auth *AdGroupAdService.BasicAuth
+	if c.Login != "" {
+		auth = &AdGroupAdService.BasicAuth{Login: c.Login, Password: c.Password}
+	}
+	client := AdGroupAdService.NewSOAPClient(c.url("cm", "v201802", "AdGroupAdService"), false, auth)
+	client.AddHeader(&AdGroupAdService.SoapHeader{
+		ClientCustomerId: optional(c.ClientCustomerId),
+		DeveloperToken:   optional(c.DeveloperToken),
+		UserAgent:        optional(c.UserAgent),
+		ValidateOnly:     AdGroupAdService.Bool(c.ValidateOnly),
+		PartialFailure:   AdGroupAdService.Bool(c.PartialFailure),
+	})
+	return &concreteService[AdGroupAd, AdGroupAdService.Get, AdGroupAdService.Query]{
+		namespace: "https://adwords.google.com/api/adwords/cm/v201802",
+		call: func(request, response interface{}) error {
+			return client.Call("", request, response)
+		},
+	}
+}
+
+func newV201802AdGroupCriterionService(c Config) Service[AdGroupCriterion] {
+	var auth *AdGroupCriterionService.BasicAuth
+	if c.Login != "" {
+		auth = &AdGroupCriterionService.BasicAuth{Login: c.Login, Password: c.Password}
+	}
+	client := AdGroupCriterionService.NewSOAPClient(c.url("cm", "v201802", "AdGroupCriterionService"), false, auth)
+	client.AddHeader(&AdGroupCriterionService.SoapHeader{
+		ClientCustomerId: optional(c.ClientCustomerId),
+		DeveloperToken:   optional(c.DeveloperToken),
+		UserAgent:        optional(c.UserAgent),
+		ValidateOnly:     AdGroupCriterionService.Bool(c.ValidateOnly),
+		PartialFailure:   AdGroupCriterionService.Bool(c.PartialFailure),
+	})
+	return &concreteService[AdGroupCriterion, AdGroupCriterionService.Get, AdGroupCriterionService.Query]{
+		namespace: "https://adwords.google.com/api/adwords/cm/v201802",
+		call: func(request, response interface{}) error {
+			return client.Call("", request, response)
+		},
+	}
+}
+
+func v201802ReportTypes() []string {
+	var types []string
+	for _, t := range ReportDefinitionService.ReportDefinitionReportTypeValues() {
+		types = append(types, string(t))
+	}
+	return types
+}
+
+func newV201802ReportDefinitionService(c Config) ReportService {
+	var auth *ReportDefinitionService.BasicAuth
+	if c.Login != "" {
+		auth = &ReportDefinitionService.BasicAuth{Login: c.Login, Password: c.Password}
+	}
+	client := ReportDefinitionService.NewReportDefinitionServiceInterface(c.url("cm", "v201802", "ReportDefinitionService"), false, auth)
+	client.AddHeader(&ReportDefinitionService.SoapHeader{
+		ClientCustomerId: optional(c.ClientCustomerId),
+		DeveloperToken:   optional(c.DeveloperToken),
+		UserAgent:        optional(c.UserAgent),
+		ValidateOnly:     ReportDefinitionService.Bool(c.ValidateOnly),
+		PartialFailure:   ReportDefinitionService.Bool(c.PartialFailure),
+	})
+	return &reportDefinitionService[ReportDefinitionService.GetReportFields, ReportDefinitionService.GetReportFieldsResponse]{
+		getReportFields: client.GetReportFields,
+	}
+}
+
+func newV201802ManagedCustomerService(c Config) AccountService {
+	var auth *ManagedCustomerService.BasicAuth
+	if c.Login != "" {
+		auth = &ManagedCustomerService.BasicAuth{Login: c.Login, Password: c.Password}
+	}
+	client := ManagedCustomerService.NewManagedCustomerServiceInterface(c.url("mcm", "v201802", "ManagedCustomerService"), false, auth)
+	client.AddHeader(&ManagedCustomerService.SoapHeader{
+		ClientCustomerId: optional(c.ClientCustomerId),
+		DeveloperToken:   optional(c.DeveloperToken),
+		UserAgent:        optional(c.UserAgent),
+		ValidateOnly:     ManagedCustomerService.Bool(c.ValidateOnly),
+		PartialFailure:   ManagedCustomerService.Bool(c.PartialFailure),
+	})
+	return &managedCustomerService[ManagedCustomerService.Get, ManagedCustomerService.GetResponse]{
+		get: client.Get,
+	}
+}
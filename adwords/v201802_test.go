@@ -0,0 +1,131 @@
+package adwords_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+)
+
+// server answers every request with response and records the requests.
+func server(t *testing.T, response string) (*adwords.Client, *[]string) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, string(b))
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, `<?xml version="1.0"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>`+
+			response+`</soap:Body></soap:Envelope>`)
+	}))
+	t.Cleanup(srv.Close)
+	client, err := adwords.New("v201802", adwords.Config{Endpoint: srv.URL, DeveloperToken: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &requests
+}
+
+func TestGetSelector(t *testing.T) {
+	client, requests := server(t, `<getResponse xmlns="https://adwords.google.com/api/adwords/cm/v201802"><rval>`+
+		`<totalNumEntries>1</totalNumEntries><entries><id>1</id><name>Campaign</name></entries></rval></getResponse>`)
+	page, err := client.Campaigns.Get(&adwords.Selector{Fields: []string{"Id", "Name"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Entries) != 1 || *page.Entries[0].Id != 1 || adwords.StringValue(page.Entries[0].Name) != "Campaign" {
+		t.Errorf("got entries %+v", page.Entries)
+	}
+	if req := (*requests)[0]; !strings.Contains(req, "serviceSelector") || !strings.Contains(req, ">Name</fields>") {
+		t.Errorf("request has no selector: %s", req)
+	}
+}
+
+func TestClearField(t *testing.T) {
+	client, requests := server(t, `<mutateResponse xmlns="https://adwords.google.com/api/adwords/cm/v201802"><rval></rval></mutateResponse>`)
+	_, err := client.Campaigns.Mutate([]*adwords.Operation[adwords.Campaign]{{
+		Operator: "SET",
+		Operand:  &adwords.Campaign{Id: adwords.Int64(1), TrackingUrlTemplate: adwords.String("")},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	if !strings.Contains(req, `v201802"></trackingUrlTemplate>`) {
+		t.Errorf("request doesn't clear the tracking template: %s", req)
+	}
+	if strings.Contains(req, "<name ") {
+		t.Errorf("request sends the unset name: %s", req)
+	}
+}
+
+func TestAdGroupAds(t *testing.T) {
+	client, requests := server(t, `<mutateResponse xmlns="https://adwords.google.com/api/adwords/cm/v201802"><rval>`+
+		`<value><adGroupId>5</adGroupId><ad xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="ExpandedTextAd">`+
+		`<id>7</id><type>EXPANDED_TEXT_AD</type><Ad.Type>ExpandedTextAd</Ad.Type>`+
+		`<headlinePart1>Shoes</headlinePart1><headlinePart2>Cheap</headlinePart2><description>Buy now</description></ad>`+
+		`<status>ENABLED</status></value></rval></mutateResponse>`)
+	adGroupID := int64(5)
+	rval, err := client.AdGroupAds.Mutate([]*adwords.Operation[adwords.AdGroupAd]{{
+		Operator: "ADD",
+		Operand: &adwords.AdGroupAd{AdGroupId: &adGroupID, Ad: &adwords.Ad{
+			AdType:        "ExpandedTextAd",
+			FinalUrls:     []string{"https://example.com"},
+			HeadlinePart1: adwords.String("Shoes"),
+			HeadlinePart2: adwords.String("Cheap"),
+			Description:   adwords.String("Buy now"),
+		}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	for _, want := range []string{
+		`<mutate xmlns="https://adwords.google.com/api/adwords/cm/v201802"><operations><operator>ADD</operator><operand><adGroupId>5</adGroupId>`,
+		`xsi:type="ExpandedTextAd"`,
+		`<headlinePart1>Shoes</headlinePart1><headlinePart2>Cheap</headlinePart2><description>Buy now</description>`,
+	} {
+		if !strings.Contains(req, want) {
+			t.Errorf("request lacks %s: %s", want, req)
+		}
+	}
+	if len(rval.Value) != 1 {
+		t.Fatalf("got %d values, want 1", len(rval.Value))
+	}
+	ad := rval.Value[0].Ad
+	if *ad.Id != 7 || ad.AdType != "ExpandedTextAd" || *ad.HeadlinePart1 != "Shoes" || *ad.Description != "Buy now" {
+		t.Errorf("got ad %+v", ad)
+	}
+}
+
+func TestAdGroupCriteria(t *testing.T) {
+	client, requests := server(t, `<mutateResponse xmlns="https://adwords.google.com/api/adwords/cm/v201802"><rval></rval></mutateResponse>`)
+	adGroupID := int64(5)
+	_, err := client.AdGroupCriteria.Mutate([]*adwords.Operation[adwords.AdGroupCriterion]{{
+		Operator: "ADD",
+		Operand: &adwords.AdGroupCriterion{
+			AdGroupId:            &adGroupID,
+			AdGroupCriterionType: "BiddableAdGroupCriterion",
+			Criterion:            &adwords.Criterion{CriterionType: "Keyword", Text: adwords.String("shoes"), MatchType: adwords.String("EXACT")},
+			BiddingStrategyConfiguration: &adwords.BiddingStrategyConfiguration{
+				Bids: []*adwords.Bids{{BidsType: "CpcBid", Bid: &adwords.Money{MicroAmount: 1230000}}},
+			},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	for _, want := range []string{
+		`xsi:type="BiddableAdGroupCriterion"`,
+		`xsi:type="Keyword"><Criterion.Type>Keyword</Criterion.Type><text>shoes</text><matchType>EXACT</matchType></criterion>`,
+		`xsi:type="CpcBid"><Bids.Type>CpcBid</Bids.Type><bid><microAmount>1230000</microAmount></bid></bids>`,
+	} {
+		if !strings.Contains(req, want) {
+			t.Errorf("request lacks %s: %s", want, req)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Package awql formats and parses AWQL, the AdWords Query Language used by
+// the Query methods of the services and by report downloads.
+//
+// A Query is the parsed form of
+//
+//	SELECT Id, Name WHERE Status IN ['ENABLED', 'PAUSED'] AND Name CONTAINS 'sale'
+//	DURING 20180101,20180131 ORDER BY Name DESC LIMIT 0,500
+//
+// Its Selector holds everything a Get request can express, so a stored
+// query can be run through either Get or Query. Report queries additionally
+// name the report in From and may use a named range in During.
+package awql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// A Query is a parsed AWQL query.
+type Query struct {
+	selector.Selector
+
+	// From is the report type of a report query, e.g.
+	// "CAMPAIGN_PERFORMANCE_REPORT", or "" for a service query.
+	From string
+
+	// During is a named date range such as "LAST_7_DAYS". It is used
+	// instead of DateRange.
+	During string
+}
+
+// comparisons maps the AWQL comparison operators to predicate operators.
+var comparisons = map[string]string{
+	"=":  selector.Equals,
+	"!=": selector.NotEquals,
+	">":  selector.GreaterThan,
+	">=": selector.GreaterThanEquals,
+	"<":  selector.LessThan,
+	"<=": selector.LessThanEquals,
+}
+
+// symbols is the inverse of comparisons.
+var symbols = make(map[string]string)
+
+func init() {
+	for sym, op := range comparisons {
+		symbols[op] = sym
+	}
+}
+
+// keywordOperators lists the predicate operators written as keywords.
+var keywordOperators = map[string]string{
+	"IN":                           selector.In,
+	"NOT_IN":                       selector.NotIn,
+	"STARTS_WITH":                  selector.StartsWith,
+	"STARTS_WITH_IGNORE_CASE":      selector.StartsWithIgnoreCase,
+	"CONTAINS":                     selector.Contains,
+	"CONTAINS_IGNORE_CASE":         selector.ContainsIgnoreCase,
+	"DOES_NOT_CONTAIN":             selector.DoesNotContain,
+	"DOES_NOT_CONTAIN_IGNORE_CASE": selector.DoesNotContainIgnoreCase,
+	"CONTAINS_ANY":                 selector.ContainsAny,
+	"CONTAINS_ALL":                 selector.ContainsAll,
+	"CONTAINS_NONE":                selector.ContainsNone,
+}
+
+// Quote returns s as an AWQL string literal.
+func Quote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// Format returns sel as AWQL.
+func Format(sel *selector.Selector) (string, error) {
+	return (&Query{Selector: *sel}).Format()
+}
+
+// Build returns the AWQL of the selector built by b.
+func Build(b *selector.Builder) (string, error) {
+	sel, err := b.Build()
+	if err != nil {
+		return "", err
+	}
+	return Format(sel)
+}
+
+// Format returns q as AWQL. It fails for predicates with invalid operators
+// or values.
+func (q *Query) Format() (string, error) {
+	if len(q.Fields) == 0 {
+		return "", fmt.Errorf("awql: no fields selected")
+	}
+	var b strings.Builder
+	b.WriteString("SELECT " + strings.Join(q.Fields, ", "))
+	if q.From != "" {
+		b.WriteString(" FROM " + q.From)
+	}
+	for i, p := range q.Predicates {
+		if err := selector.CheckPredicate(p.Field, p.Operator, p.Values); err != nil {
+			return "", err
+		}
+		if i == 0 {
+			b.WriteString(" WHERE ")
+		} else {
+			b.WriteString(" AND ")
+		}
+		b.WriteString(p.Field + " ")
+		op := p.Operator
+		if sym, ok := symbols[op]; ok {
+			op = sym
+		}
+		b.WriteString(op + " ")
+		values := make([]string, len(p.Values))
+		for i, v := range p.Values {
+			// Values are strings as in the predicates of Get, e.g. a
+			// Name of "2018" must not become a number. The API converts
+			// quoted values of numeric fields.
+			values[i] = Quote(v)
+		}
+		if selector.IsListOperator(p.Operator) {
+			b.WriteString("[" + strings.Join(values, ", ") + "]")
+		} else {
+			b.WriteString(values[0])
+		}
+	}
+	switch {
+	case q.During != "" && q.DateRange != nil:
+		return "", fmt.Errorf("awql: both During and DateRange set")
+	case q.During != "":
+		b.WriteString(" DURING " + q.During)
+	case q.DateRange != nil:
+		if q.DateRange.Min == "" || q.DateRange.Max == "" {
+			return "", fmt.Errorf("awql: DURING needs both dates of the date range %q-%q", q.DateRange.Min, q.DateRange.Max)
+		}
+		b.WriteString(" DURING " + q.DateRange.Min + "," + q.DateRange.Max)
+	}
+	for i, o := range q.Ordering {
+		if i == 0 {
+			b.WriteString(" ORDER BY ")
+		} else {
+			b.WriteString(", ")
+		}
+		b.WriteString(o.Field)
+		switch o.SortOrder {
+		case selector.Descending:
+			b.WriteString(" DESC")
+		case selector.Ascending:
+			b.WriteString(" ASC")
+		}
+	}
+	if q.Paging != nil {
+		fmt.Fprintf(&b, " LIMIT %d,%d", q.Paging.StartIndex, q.Paging.NumberResults)
+	}
+	return b.String(), nil
+}
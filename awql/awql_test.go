@@ -0,0 +1,126 @@
+package awql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/awql"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *awql.Query
+		want  string
+	}{
+		{
+			name: "service",
+			query: &awql.Query{Selector: selector.Selector{
+				Fields: []string{"Id", "Name"},
+				Predicates: []*selector.Predicate{
+					{Field: "Status", Operator: selector.In, Values: []string{"ENABLED", "PAUSED"}},
+					{Field: "Name", Operator: selector.Contains, Values: []string{"sale"}},
+				},
+				DateRange: &selector.DateRange{Min: "20180101", Max: "20180131"},
+				Ordering:  []*selector.OrderBy{{Field: "Name", SortOrder: selector.Descending}},
+				Paging:    &selector.Paging{StartIndex: 0, NumberResults: 500},
+			}},
+			want: "SELECT Id, Name WHERE Status IN ['ENABLED', 'PAUSED'] AND Name CONTAINS 'sale' DURING 20180101,20180131 ORDER BY Name DESC LIMIT 0,500",
+		},
+		{
+			name: "numbers are quoted",
+			query: &awql.Query{Selector: selector.Selector{
+				Fields: []string{"Id"},
+				Predicates: []*selector.Predicate{
+					{Field: "Name", Operator: selector.Equals, Values: []string{"2018"}},
+					{Field: "Impressions", Operator: selector.GreaterThan, Values: []string{"-1.5"}},
+				},
+			}},
+			want: "SELECT Id WHERE Name = '2018' AND Impressions > '-1.5'",
+		},
+		{
+			name: "escaping",
+			query: &awql.Query{Selector: selector.Selector{
+				Fields:     []string{"Id"},
+				Predicates: []*selector.Predicate{{Field: "Name", Operator: selector.Equals, Values: []string{`it's a \ "test"`}}},
+			}},
+			want: `SELECT Id WHERE Name = 'it\'s a \\ "test"'`,
+		},
+		{
+			name:  "report",
+			query: &awql.Query{Selector: selector.Selector{Fields: []string{"Clicks"}}, From: "CAMPAIGN_PERFORMANCE_REPORT", During: "LAST_7_DAYS"},
+			want:  "SELECT Clicks FROM CAMPAIGN_PERFORMANCE_REPORT DURING LAST_7_DAYS",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Format()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+			q, err := awql.Parse(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(q, tt.query) {
+				t.Errorf("parsed %+v, want %+v", q, tt.query)
+			}
+		})
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	for _, q := range []*awql.Query{
+		{Selector: selector.Selector{Fields: []string{"Id"}, DateRange: &selector.DateRange{Max: "20180131"}}},
+		{Selector: selector.Selector{Fields: []string{"Id"}, DateRange: &selector.DateRange{Min: "20180101"}}},
+		{Selector: selector.Selector{Fields: []string{"Id"}, DateRange: &selector.DateRange{Min: "20180101", Max: "20180131"}}, During: "YESTERDAY"},
+	} {
+		if got, err := q.Format(); err == nil {
+			t.Errorf("formatted %+v as %s", q, got)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		query, want string
+	}{
+		// Numbers, bare enums and double quotes are formatted quoted.
+		{"select Id where CampaignId in (1, 2) and Status = ENABLED", "SELECT Id WHERE CampaignId IN ['1', '2'] AND Status = 'ENABLED'"},
+		{`SELECT Id WHERE Name = "a \"b\" 'c'"`, `SELECT Id WHERE Name = 'a "b" \'c\''`},
+		{"SELECT Id WHERE Name STARTS_WITH_IGNORE_CASE 'x' ORDER BY Id ASC, Name LIMIT 10,20", "SELECT Id WHERE Name STARTS_WITH_IGNORE_CASE 'x' ORDER BY Id ASC, Name ASC LIMIT 10,20"},
+	}
+	for _, tt := range tests {
+		q, err := awql.Parse(tt.query)
+		if err != nil {
+			t.Errorf("%s: %v", tt.query, err)
+			continue
+		}
+		got, err := q.Format()
+		if err != nil {
+			t.Errorf("%s: %v", tt.query, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, query := range []string{
+		"",
+		"SELECT",
+		"SELECT Id WHERE Name = 'unterminated",
+		"SELECT Id WHERE Name ~ 'x'",
+		"SELECT Id LIMIT x",
+	} {
+		if _, err := awql.Parse(query); err == nil {
+			t.Errorf("%q: no error", query)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package awql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// Token kinds.
+const (
+	tokEOF = iota
+	tokWord
+	tokNumber
+	tokString
+	tokSymbol
+)
+
+type token struct {
+	kind int
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of query"
+	}
+	return strconv.Quote(t.text)
+}
+
+// A SyntaxError is an error in the AWQL text.
+type SyntaxError struct {
+	// Offset is the byte offset of the error in the query.
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("awql: %s at offset %d", e.Msg, e.Offset)
+}
+
+func scan(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '\'' || c == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(s) && rune(s[j]) != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				b.WriteByte(s[j])
+			}
+			if j == len(s) {
+				return nil, &SyntaxError{i, "unterminated string"}
+			}
+			tokens = append(tokens, token{tokString, b.String(), i})
+			i = j + 1
+		case c == '-' || c >= '0' && c <= '9':
+			j := i + 1
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{tokNumber, s[i:j], i})
+			i = j
+		case c == '_' || unicode.IsLetter(c):
+			j := i
+			for j < len(s) && (s[j] == '_' || s[j] == '.' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			tokens = append(tokens, token{tokWord, s[i:j], i})
+			i = j
+		case strings.HasPrefix(s[i:], "!=") || strings.HasPrefix(s[i:], ">=") || strings.HasPrefix(s[i:], "<="):
+			tokens = append(tokens, token{tokSymbol, s[i : i+2], i})
+			i += 2
+		case strings.ContainsRune("=<>,[]()", c):
+			tokens = append(tokens, token{tokSymbol, s[i : i+1], i})
+			i++
+		default:
+			return nil, &SyntaxError{i, fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(s)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// unread pushes back t, the token last returned by next.
+func (p *parser) unread(t token) {
+	if t.kind != tokEOF {
+		p.pos--
+	}
+}
+
+// keyword consumes the next token if it is the keyword kw.
+func (p *parser) keyword(kw string) bool {
+	if t := p.peek(); t.kind == tokWord && strings.EqualFold(t.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// symbol consumes the next token if it is the symbol sym.
+func (p *parser) symbol(sym string) bool {
+	if t := p.peek(); t.kind == tokSymbol && t.text == sym {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{p.peek().pos, fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) field() (string, error) {
+	t := p.next()
+	if t.kind != tokWord || isKeyword(t.text) {
+		p.unread(t)
+		return "", p.errorf("expected field name, found %s", t)
+	}
+	return t.text, nil
+}
+
+func (p *parser) number() (int32, error) {
+	t := p.next()
+	n, err := strconv.ParseInt(t.text, 10, 32)
+	if t.kind != tokNumber || err != nil {
+		p.unread(t)
+		return 0, p.errorf("expected number, found %s", t)
+	}
+	return int32(n), nil
+}
+
+// value parses a string, number or bare enum value.
+func (p *parser) value() (string, error) {
+	switch t := p.next(); t.kind {
+	case tokString, tokNumber:
+		return t.text, nil
+	case tokWord:
+		if !isKeyword(t.text) {
+			return t.text, nil
+		}
+		fallthrough
+	default:
+		p.unread(t)
+		return "", p.errorf("expected value, found %s", t)
+	}
+}
+
+var keywords = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "AND": true, "DURING": true,
+	"ORDER": true, "BY": true, "ASC": true, "DESC": true, "LIMIT": true,
+}
+
+func isKeyword(s string) bool {
+	return keywords[strings.ToUpper(s)]
+}
+
+var dateLiteral = regexp.MustCompile(`^[0-9]{8}$`)
+
+// Parse parses an AWQL query.
+func Parse(query string) (*Query, error) {
+	tokens, err := scan(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	q := new(Query)
+
+	if !p.keyword("SELECT") {
+		return nil, p.errorf("expected SELECT")
+	}
+	for {
+		f, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+		q.Fields = append(q.Fields, f)
+		if !p.symbol(",") {
+			break
+		}
+	}
+
+	if p.keyword("FROM") {
+		if q.From, err = p.field(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.keyword("WHERE") {
+		for {
+			pred, err := p.predicate()
+			if err != nil {
+				return nil, err
+			}
+			q.Predicates = append(q.Predicates, pred)
+			if !p.keyword("AND") {
+				break
+			}
+		}
+	}
+
+	if p.keyword("DURING") {
+		t := p.next()
+		switch {
+		case t.kind == tokNumber && dateLiteral.MatchString(t.text):
+			if !p.symbol(",") {
+				return nil, p.errorf("expected , between the dates of DURING")
+			}
+			to := p.next()
+			if to.kind != tokNumber || !dateLiteral.MatchString(to.text) {
+				p.unread(to)
+				return nil, p.errorf("expected yyyyMMdd date, found %s", to)
+			}
+			q.DateRange = &selector.DateRange{Min: t.text, Max: to.text}
+		case t.kind == tokWord && !isKeyword(t.text):
+			q.During = strings.ToUpper(t.text)
+		default:
+			p.unread(t)
+			return nil, p.errorf("expected date range, found %s", t)
+		}
+	}
+
+	if p.keyword("ORDER") {
+		if !p.keyword("BY") {
+			return nil, p.errorf("expected BY")
+		}
+		for {
+			f, err := p.field()
+			if err != nil {
+				return nil, err
+			}
+			o := &selector.OrderBy{Field: f, SortOrder: selector.Ascending}
+			if p.keyword("DESC") {
+				o.SortOrder = selector.Descending
+			} else {
+				p.keyword("ASC")
+			}
+			q.Ordering = append(q.Ordering, o)
+			if !p.symbol(",") {
+				break
+			}
+		}
+	}
+
+	if p.keyword("LIMIT") {
+		start, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		if !p.symbol(",") {
+			return nil, p.errorf("expected , in LIMIT")
+		}
+		n, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		q.Paging = &selector.Paging{StartIndex: start, NumberResults: n}
+	}
+
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.errorf("unexpected %s", t)
+	}
+	return q, nil
+}
+
+func (p *parser) predicate() (*selector.Predicate, error) {
+	field, err := p.field()
+	if err != nil {
+		return nil, err
+	}
+	pred := &selector.Predicate{Field: field}
+	t := p.next()
+	switch {
+	case t.kind == tokSymbol && comparisons[t.text] != "":
+		pred.Operator = comparisons[t.text]
+	case t.kind == tokWord && keywordOperators[strings.ToUpper(t.text)] != "":
+		pred.Operator = keywordOperators[strings.ToUpper(t.text)]
+	default:
+		p.unread(t)
+		return nil, p.errorf("expected operator, found %s", t)
+	}
+
+	if selector.IsListOperator(pred.Operator) {
+		closing := "]"
+		if p.symbol("(") {
+			closing = ")"
+		} else if !p.symbol("[") {
+			return nil, p.errorf("expected [ after %s", pred.Operator)
+		}
+		for !p.symbol(closing) {
+			if len(pred.Values) > 0 && !p.symbol(",") {
+				return nil, p.errorf("expected , or %s", closing)
+			}
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			pred.Values = append(pred.Values, v)
+		}
+	} else {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		pred.Values = []string{v}
+	}
+	if err := selector.CheckPredicate(pred.Field, pred.Operator, pred.Values); err != nil {
+		return nil, err
+	}
+	return pred, nil
+}
+
+// ParseSelector parses a service query into a Selector. It fails for report
+// queries and named date ranges, which a Selector cannot express.
+func ParseSelector(query string) (*selector.Selector, error) {
+	q, err := Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	if q.From != "" {
+		return nil, fmt.Errorf("awql: report query FROM %s is not a selector", q.From)
+	}
+	if q.During != "" {
+		return nil, fmt.Errorf("awql: named date range %s is not supported by selectors", q.During)
+	}
+	return &q.Selector, nil
+}
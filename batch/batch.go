@@ -0,0 +1,200 @@
+// Package batch sends any number of mutate operations in chunks. Every
+// service package wraps it for its Mutate, MutateLabel and MutateMembers
+// methods:
+//
+//	rval, err := AdGroupCriterionService.MutateBatch(service, operations, &batch.Options{Workers: 4})
+//
+// The chunks are limited by the number of operations and the size of
+// their XML encoding. Their results are merged in the order of the
+// operations, and the partial failure errors are re-indexed to the
+// positions of the operations they refer to.
+package batch
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/godofdream/go-googleadsinofficial/retry"
+)
+
+// Defaults of the Options fields.
+const (
+	DefaultMaxOperations = 5000
+	DefaultMaxBytes      = 4 << 20
+)
+
+// Options configure the chunks of a batch. A nil *Options uses the
+// defaults.
+type Options struct {
+	// MaxOperations is the maximum number of operations of a chunk, or
+	// DefaultMaxOperations if 0.
+	MaxOperations int
+
+	// MaxBytes is the maximum size of the XML encoding of the operations
+	// of a chunk, or DefaultMaxBytes if 0. A single operation larger than
+	// MaxBytes is sent alone.
+	MaxBytes int
+
+	// Workers is the number of chunks sent concurrently. 0 or 1 sends one
+	// chunk after the other.
+	Workers int
+
+	// Retry retries failed chunks. A nil Retry makes a single attempt. A
+	// Retry without Retryable only retries errors with which nothing of the
+	// chunk was applied (retry.NotApplied), because a chunk timing out or
+	// failing with an internal error may have been applied, and sending its
+	// ADD operations again would duplicate them. Set Retryable to
+	// retry.Transient for chunks of idempotent operations only.
+	Retry *retry.Policy
+}
+
+// A Chunk is the result of the operations [Start, End).
+type Chunk[R any] struct {
+	Start, End int
+	Result     R
+	Err        error
+}
+
+// A ChunkError is the error of a chunk that failed as a whole.
+type ChunkError struct {
+	Start, End int
+	Err        error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("batch: operations %d to %d: %v", e.Start, e.End-1, e.Err)
+}
+
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// Run calls mutate for the chunks of ops and returns the chunks in the
+// order of ops. The errors of the failed chunks are returned joined as
+// ChunkErrors; the other chunks are still sent.
+func Run[Op, R any](ops []Op, opts *Options, mutate func(ops []Op) (R, error)) ([]*Chunk[R], error) {
+	if opts == nil {
+		opts = new(Options)
+	}
+	chunks, err := split[Op, R](ops, opts)
+	if err != nil {
+		return nil, err
+	}
+	policy := opts.Retry
+	if policy != nil && policy.Retryable == nil {
+		p := *policy
+		p.Retryable = retry.NotApplied
+		policy = &p
+	}
+	call := func(c *Chunk[R]) {
+		c.Err = policy.Do(func() error {
+			var err error
+			c.Result, err = mutate(ops[c.Start:c.End])
+			return err
+		})
+	}
+	if opts.Workers > 1 {
+		sem := make(chan struct{}, opts.Workers)
+		var wg sync.WaitGroup
+		for _, c := range chunks {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(c *Chunk[R]) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				call(c)
+			}(c)
+		}
+		wg.Wait()
+	} else {
+		for _, c := range chunks {
+			call(c)
+		}
+	}
+	var errs []error
+	for _, c := range chunks {
+		if c.Err != nil {
+			errs = append(errs, &ChunkError{Start: c.Start, End: c.End, Err: c.Err})
+		}
+	}
+	return chunks, errors.Join(errs...)
+}
+
+// Check fails the chunks whose result, as counted by values, hasn't one
+// value per operation, since its values can't be merged by position. It
+// sets their Err and returns err joined with their ChunkErrors. Chunks
+// that failed already are skipped. A result without any value, such as
+// that of a ValidateOnly request, succeeds: the API rejects a request with
+// an ApiError, which fails the chunk when it is sent.
+func Check[R any](chunks []*Chunk[R], err error, values func(R) int) error {
+	errs := []error{err}
+	for _, c := range chunks {
+		if c.Err != nil {
+			continue
+		}
+		if n := values(c.Result); n != 0 && n != c.End-c.Start {
+			c.Err = fmt.Errorf("batch: %d values for %d operations", n, c.End-c.Start)
+			errs = append(errs, &ChunkError{Start: c.Start, End: c.End, Err: c.Err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Pad returns values, or n zero values if there are none, so the values of
+// a result without any, which Check accepts, are merged by position.
+func Pad[V any](values []V, n int) []V {
+	if len(values) == 0 {
+		return make([]V, n)
+	}
+	return values
+}
+
+// split divides ops into chunks within the limits of opts.
+func split[Op, R any](ops []Op, opts *Options) ([]*Chunk[R], error) {
+	maxOps, maxBytes := opts.MaxOperations, opts.MaxBytes
+	if maxOps <= 0 {
+		maxOps = DefaultMaxOperations
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+	var chunks []*Chunk[R]
+	start, size := 0, 0
+	for i, op := range ops {
+		b, err := xml.Marshal(op)
+		if err != nil {
+			return nil, fmt.Errorf("batch: operation %d: %v", i, err)
+		}
+		if i > start && (i-start >= maxOps || size+len(b) > maxBytes) {
+			chunks = append(chunks, &Chunk[R]{Start: start, End: i})
+			start, size = i, 0
+		}
+		size += len(b)
+	}
+	if start < len(ops) {
+		chunks = append(chunks, &Chunk[R]{Start: start, End: len(ops)})
+	}
+	return chunks, nil
+}
+
+var operationIndex = regexp.MustCompile(`^operations\[([0-9]+)\]`)
+
+// Reindex shifts the operation index of a field path such as
+// "operations[3].operand.name" by offset.
+func Reindex(path string, offset int) string {
+	m := operationIndex.FindStringSubmatchIndex(path)
+	if m == nil {
+		return path
+	}
+	i, err := strconv.Atoi(path[m[2]:m[3]])
+	if err != nil {
+		return path
+	}
+	return path[:m[2]] + strconv.Itoa(i+offset) + path[m[3]:]
+}
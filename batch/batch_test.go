@@ -0,0 +1,109 @@
+package batch_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/batch"
+	"github.com/godofdream/go-googleadsinofficial/retry"
+)
+
+type op struct {
+	N int
+}
+
+type timeout struct{}
+
+func (timeout) Error() string   { return "i/o timeout" }
+func (timeout) Timeout() bool   { return true }
+func (timeout) Temporary() bool { return true }
+
+var _ net.Error = timeout{}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable func(error) bool
+		calls     int
+	}{
+		{name: "rate exceeded", err: errors.New("[RateExceededError <rateScope=ACCOUNT>]"), calls: 2},
+		{name: "timeout", err: timeout{}, calls: 1},
+		{name: "internal error", err: errors.New("[InternalApiError.UNEXPECTED_INTERNAL_API_ERROR @ ]"), calls: 1},
+		{name: "timeout opted in", err: timeout{}, retryable: retry.Transient, calls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &batch.Options{Retry: &retry.Policy{Attempts: 2, Initial: time.Millisecond, Retryable: tt.retryable}}
+			calls := 0
+			_, err := batch.Run([]*op{{1}, {2}}, opts, func(ops []*op) ([]int, error) {
+				calls++
+				if calls == 1 {
+					return nil, tt.err
+				}
+				return []int{1, 2}, nil
+			})
+			if calls != tt.calls {
+				t.Errorf("got %d calls, want %d", calls, tt.calls)
+			}
+			if wantErr := tt.calls == 1; (err != nil) != wantErr {
+				t.Errorf("got error %v", err)
+			}
+			if opts.Retry.Retryable != nil && tt.retryable == nil {
+				t.Error("Options.Retry changed")
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ops := []*op{{1}, {2}, {3}, {4}, {5}}
+	chunks, err := batch.Run(ops, &batch.Options{MaxOperations: 2}, func(ops []*op) ([]int, error) {
+		if ops[0].N == 3 {
+			// One value is missing.
+			return []int{3}, nil
+		}
+		var values []int
+		for _, o := range ops {
+			values = append(values, o.N)
+		}
+		return values, nil
+	})
+	err = batch.Check(chunks, err, func(values []int) int { return len(values) })
+	var ce *batch.ChunkError
+	if !errors.As(err, &ce) || ce.Start != 2 || ce.End != 4 {
+		t.Fatalf("got error %v, want a ChunkError for operations 2 to 3", err)
+	}
+	for _, c := range chunks {
+		if failed := c.Start == 2; (c.Err != nil) != failed {
+			t.Errorf("chunk %d to %d: got error %v", c.Start, c.End, c.Err)
+		}
+	}
+}
+
+func TestCheckWithoutValues(t *testing.T) {
+	ops := []*op{{1}, {2}, {3}}
+	// A ValidateOnly request returns no values.
+	chunks, err := batch.Run(ops, &batch.Options{MaxOperations: 2}, func(ops []*op) ([]int, error) {
+		return nil, nil
+	})
+	err = batch.Check(chunks, err, func(values []int) int { return len(values) })
+	if err != nil {
+		t.Fatalf("got error %v for results without values", err)
+	}
+	var merged []int
+	for _, c := range chunks {
+		if c.Err != nil {
+			t.Errorf("chunk %d to %d: got error %v", c.Start, c.End, c.Err)
+		}
+		merged = append(merged, batch.Pad(c.Result, c.End-c.Start)...)
+	}
+	if len(merged) != len(ops) {
+		t.Errorf("got %d merged values for %d operations", len(merged), len(ops))
+	}
+	if got := batch.Pad([]int{7}, 3); len(got) != 1 || got[0] != 7 {
+		t.Errorf("Pad changed values: %v", got)
+	}
+}
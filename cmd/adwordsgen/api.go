@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+)
+
+var apiTemplate = template.Must(template.New("api").Parse(`
+import (
+	"fmt"
+	"sync"
+)
+
+// {{.API}} is the interface of the {{.Package}} operations. It is
+// implemented by {{.Client}} and, for tests, by {{.Fake}}.
+type {{.API}} interface {
+{{- range .Methods}}
+	{{.Name}}(request *{{.Request}}) (*{{.Response}}, error)
+{{- end}}
+}
+
+var _ {{.API}} = (*{{.Client}})(nil)
+var _ {{.API}} = (*{{.Fake}})(nil)
+
+// FakeCall is a call recorded by {{.Fake}}.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "{{(index .Methods 0).Name}}".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// {{.Fake}} is a programmable {{.API}} for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type {{.Fake}} struct {
+{{- range .Methods}}
+	{{.Name}}Func func(request *{{.Request}}) (*{{.Response}}, error)
+{{- end}}
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *{{.Fake}}) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *{{.Fake}}) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *{{.Fake}}) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+{{range .Methods}}
+// {{.Name}} records the call and delegates to {{.Name}}Func.
+func (f *{{$.Fake}}) {{.Name}}(request *{{.Request}}) (*{{.Response}}, error) {
+	f.record("{{.Name}}", request)
+	if f.{{.Name}}Func == nil {
+		return nil, fmt.Errorf("{{$.Fake}}.{{.Name}}Func is not set")
+	}
+	return f.{{.Name}}Func(request)
+}
+
+// {{.Name}}Calls returns the requests of the recorded {{.Name}} calls.
+func (f *{{$.Fake}}) {{.Name}}Calls() []*{{.Request}} {
+	var requests []*{{.Request}}
+	for _, c := range f.Calls() {
+		if c.Method == "{{.Name}}" {
+			requests = append(requests, c.Request.(*{{.Request}}))
+		}
+	}
+	return requests
+}
+{{end}}
+`))
+
+// emitAPI generates an interface of the client operations and a fake
+// implementation recording its calls.
+func emitAPI(pkg *Package, buf *bytes.Buffer) (string, error) {
+	if len(pkg.Service.Methods) == 0 {
+		return "api", nil
+	}
+	base := strings.TrimSuffix(pkg.Name, "Service")
+	data := struct {
+		Package, API, Client, Fake string
+		Methods                    []*Method
+	}{
+		Package: pkg.Name,
+		API:     pkg.Name + "API",
+		Client:  pkg.Service.Name,
+		Fake:    "Fake" + base + "Service",
+		Methods: pkg.Service.Methods,
+	}
+	return "api", apiTemplate.Execute(buf, data)
+}
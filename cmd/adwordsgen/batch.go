@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+var batchTemplate = template.Must(template.New("batch").Parse(`
+import "github.com/godofdream/go-googleadsinofficial/batch"
+{{range .Methods}}
+// {{.Name}}Batch is {{.Name}} for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+{{- if .Errors}}
+// Partial failure errors are re-indexed to the positions in operations.
+{{- end}}
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func {{.Name}}Batch(api {{$.API}}, operations []*{{.Operation}}, opts *batch.Options) ({{.Result}}, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*{{.Operation}}) (*{{.Response}}, error) {
+		return api.{{.Name}}(&{{.Request}}{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *{{.Response}}) int {
+{{- if .Slice}}
+		if r == nil {
+			return 0
+		}
+		return len(r.Rval)
+{{- else}}
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.{{.Values}})
+{{- end}}
+	})
+{{- if .Slice}}
+	var rval {{.Result}}
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil {
+			rval = append(rval, make({{.Result}}, c.End-c.Start)...)
+			continue
+		}
+		rval = append(rval, batch.Pad(c.Result.Rval, c.End-c.Start)...)
+	}
+{{- else}}
+	rval := new({{.ReturnValue}})
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.{{.Values}} = append(rval.{{.Values}}, make([]*{{.Value}}, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+{{- with .Base}}
+		if rval.{{.}} == nil {
+			rval.{{.}} = r.{{.}}
+		}
+{{- end}}
+		rval.{{.Values}} = append(rval.{{.Values}}, batch.Pad(r.{{.Values}}, c.End-c.Start)...)
+{{- if .Errors}}
+		for _, e := range r.PartialFailureErrors {
+			reindexError(e, c.Start)
+		}
+		rval.PartialFailureErrors = append(rval.PartialFailureErrors, r.PartialFailureErrors...)
+{{- end}}
+	}
+{{- end}}
+	return rval, err
+}
+{{end}}
+{{- if .Reindex}}
+// reindexError shifts the operation index of the field path of e by offset.
+func reindexError(e *ApiError, offset int) {
+	if e == nil {
+		return
+	}
+	if e.FieldPath != nil {
+		path := batch.Reindex(*e.FieldPath, offset)
+		e.FieldPath = &path
+	}
+	if len(e.FieldPathElements) > 0 {
+		if first := e.FieldPathElements[0]; first != nil && StringValue(first.Field) == "operations" && first.Index != nil {
+			i := *first.Index + int32(offset)
+			first.Index = &i
+		}
+	}
+}
+{{end}}
+`))
+
+type batchMethod struct {
+	Name, Request, Response, Operation string
+
+	// Result is the type returned by the batch function. Slice is set for
+	// responses returning a list instead of a ReturnValue.
+	Result string
+	Slice  bool
+
+	// ReturnValue, its Base, the field holding the Values and whether it
+	// has PartialFailureErrors.
+	ReturnValue, Base, Values, Value string
+	Errors                           bool
+}
+
+// emitBatch generates functions sending any number of operations of the
+// mutate methods in chunks.
+func emitBatch(pkg *Package, buf *bytes.Buffer) (string, error) {
+	data := struct {
+		API     string
+		Methods []*batchMethod
+		Reindex bool
+	}{API: pkg.Name + "API"}
+	for _, m := range pkg.Service.Methods {
+		b := pkg.batchMethod(m)
+		if b == nil {
+			continue
+		}
+		if n := b.Name + "Batch"; pkg.Struct(n) != nil || pkg.hasEnum(n) {
+			return "", fmt.Errorf("%s: name conflicts with a type", n)
+		}
+		if b.Errors {
+			data.Reindex = true
+		}
+		data.Methods = append(data.Methods, b)
+	}
+	if len(data.Methods) == 0 {
+		return "batch", nil
+	}
+	if data.Reindex && !pkg.reindexable() {
+		return "", fmt.Errorf("ApiError: unexpected field path fields")
+	}
+	return "batch", batchTemplate.Execute(buf, data)
+}
+
+// batchMethod describes a mutate method taking a list of operations, or
+// returns nil for other methods.
+func (p *Package) batchMethod(m *Method) *batchMethod {
+	req, resp := p.Struct(m.Request), p.Struct(m.Response)
+	if !strings.HasPrefix(m.Name, "Mutate") || req == nil || resp == nil ||
+		len(req.Fields) != 1 || req.Fields[0].Name != "Operations" || !strings.HasPrefix(req.Fields[0].Type, "[]*") ||
+		len(resp.Fields) != 1 || resp.Fields[0].Name != "Rval" {
+		return nil
+	}
+	b := &batchMethod{
+		Name:      m.Name,
+		Request:   m.Request,
+		Response:  m.Response,
+		Operation: req.Fields[0].ElemType(),
+		Result:    resp.Fields[0].Type,
+	}
+	if strings.HasPrefix(b.Result, "[]*") {
+		b.Slice = true
+		return b
+	}
+	rval := p.Struct(resp.Fields[0].ElemType())
+	if rval == nil || !strings.HasPrefix(b.Result, "*") {
+		return nil
+	}
+	b.ReturnValue, b.Base = rval.Name, rval.Base()
+	for _, f := range rval.Fields {
+		switch {
+		case f.Name == "PartialFailureErrors" && f.Type == "[]*ApiError":
+			b.Errors = true
+		case strings.HasPrefix(f.Type, "[]*") && b.Values == "":
+			b.Values, b.Value = f.Name, f.ElemType()
+		default:
+			// A second list can't be merged by position.
+			return nil
+		}
+	}
+	if b.Values == "" {
+		return nil
+	}
+	return b
+}
+
+// reindexable reports whether ApiError has the field path fields
+// reindexError adjusts.
+func (p *Package) reindexable() bool {
+	e, elem := p.Struct("ApiError"), p.Struct("FieldPathElement")
+	return e != nil && elem != nil &&
+		p.fieldType(e, "FieldPath") == "*string" &&
+		p.fieldType(e, "FieldPathElements") == "[]*FieldPathElement" &&
+		p.fieldType(elem, "Field") == "*string" &&
+		p.fieldType(elem, "Index") == "*int32"
+}
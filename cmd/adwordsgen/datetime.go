@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+var datetimeTemplate = template.Must(template.New("datetime").Parse(`
+{{- if .Time}}
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+{{- else}}
+import "github.com/godofdream/go-googleadsinofficial/datetime"
+{{- end}}
+{{if .DateRange}}
+// NewDateRange returns the DateRange from one date to another, both
+// inclusive.
+func NewDateRange(from, to datetime.Date) *DateRange {
+	return &DateRange{Min: String(from.String()), Max: String(to.String())}
+}
+
+// Dates returns the bounds of r. A bound that is not set is returned as the
+// zero Date.
+func (r *DateRange) Dates() (from, to datetime.Date, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDate(*r.Min); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDate(*r.Max)
+	}
+	return
+}
+{{end}}{{if .DateTimeRange}}
+// NewDateTimeRange returns the DateTimeRange between two times formatted in
+// loc, the time zone of the account.
+func NewDateTimeRange(from, to time.Time, loc *time.Location) *DateTimeRange {
+	return &DateTimeRange{Min: String(datetime.FormatDateTime(from, loc)), Max: String(datetime.FormatDateTime(to, loc))}
+}
+
+// Times returns the bounds of r. loc is used for bounds without a time zone
+// ID. A bound that is not set is returned as the zero time.Time.
+func (r *DateTimeRange) Times(loc *time.Location) (from, to time.Time, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDateTime(*r.Min, loc); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDateTime(*r.Max, loc)
+	}
+	return
+}
+{{end}}{{range .TimeZones}}
+// Location returns the location of the DateTimeZone of the {{.}}.
+func (c *{{.}}) Location() (*time.Location, error) {
+	return datetime.Location(StringValue(c.DateTimeZone))
+}
+{{end}}{{range .Dates}}
+// Get{{.Field}} returns the {{.Field}} of t, or the zero Date if it is not
+// set.
+func (t *{{.Struct}}) Get{{.Field}}() (datetime.Date, error) {
+	if t.{{.Field}} == nil {
+		return datetime.Date{}, nil
+	}
+	return datetime.ParseDate(*t.{{.Field}})
+}
+
+// Set{{.Field}} sets the {{.Field}} of t to d.
+func (t *{{.Struct}}) Set{{.Field}}(d datetime.Date) {
+	t.{{.Field}} = String(d.String())
+}
+{{end}}{{range .DateTimes}}
+// Get{{.Field}} returns the {{.Field}} of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *{{.Struct}}) Get{{.Field}}(loc *time.Location) (time.Time, error) {
+	if t.{{.Field}} == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.{{.Field}}, loc)
+}
+
+// Set{{.Field}} sets the {{.Field}} of t to tm formatted in loc.
+func (t *{{.Struct}}) Set{{.Field}}(tm time.Time, loc *time.Location) {
+	t.{{.Field}} = String(datetime.FormatDateTime(tm, loc))
+}
+{{end}}
+`))
+
+// Formats of the string fields holding dates or timestamps.
+const (
+	dateFormat     = "date"
+	dateTimeFormat = "datetime"
+)
+
+// timeFields maps the string fields holding "yyyyMMdd" dates or
+// "yyyyMMdd HHmmss <time zone ID>" timestamps to their format. The schemas
+// declare them as plain strings and name the format in the documentation
+// at best, so they are listed here.
+var timeFields = map[string]string{
+	"BidLandscape.StartDate":                     dateFormat,
+	"BidLandscape.EndDate":                       dateFormat,
+	"Campaign.StartDate":                         dateFormat,
+	"Campaign.EndDate":                           dateFormat,
+	"ConversionTracker.MostRecentConversionDate": dateFormat,
+	"DateRange.Min":                              dateFormat,
+	"DateRange.Max":                              dateFormat,
+	"DateSpecificRuleUserList.StartDate":         dateFormat,
+	"DateSpecificRuleUserList.EndDate":           dateFormat,
+	"PerformanceTarget.StartDate":                dateFormat,
+	"PerformanceTarget.EndDate":                  dateFormat,
+	"Trial.StartDate":                            dateFormat,
+	"Trial.EndDate":                              dateFormat,
+
+	"BudgetOrder.StartDateTime":                 dateTimeFormat,
+	"BudgetOrder.EndDateTime":                   dateTimeFormat,
+	"BudgetOrderRequest.StartDateTime":          dateTimeFormat,
+	"BudgetOrderRequest.EndDateTime":            dateTimeFormat,
+	"ConversionTracker.LastReceivedRequestTime": dateTimeFormat,
+	"CustomerChangeData.LastChangeTimestamp":    dateTimeFormat,
+	"DateTimeRange.Min":                         dateTimeFormat,
+	"DateTimeRange.Max":                         dateTimeFormat,
+	"ExtensionFeedItem.StartTime":               dateTimeFormat,
+	"ExtensionFeedItem.EndTime":                 dateTimeFormat,
+	"FeedItem.StartTime":                        dateTimeFormat,
+	"FeedItem.EndTime":                          dateTimeFormat,
+	"OfflineCallConversionFeed.CallStartTime":   dateTimeFormat,
+	"OfflineCallConversionFeed.ConversionTime":  dateTimeFormat,
+	"OfflineConversionFeed.ConversionTime":      dateTimeFormat,
+	"StoreSalesTransaction.TransactionTime":     dateTimeFormat,
+	"TemporaryUrl.Expiration":                   dateTimeFormat,
+}
+
+// timeField returns the format of a field of s holding a date or timestamp,
+// or "".
+func timeField(s *Struct, f *Field) string {
+	if f.Type != "*string" {
+		return ""
+	}
+	return timeFields[s.Name+"."+f.Name]
+}
+
+// A datetimeField is a date or timestamp field with accessors.
+type datetimeField struct {
+	Struct, Field string
+}
+
+// emitDatetime generates conversions between time.Time and the date types
+// and time zones of a package.
+func emitDatetime(pkg *Package, buf *bytes.Buffer) (string, error) {
+	data := struct {
+		DateRange     bool
+		DateTimeRange bool
+		TimeZones     []string
+		Dates         []*datetimeField
+		DateTimes     []*datetimeField
+		Time          bool
+	}{
+		DateRange:     stringRange(pkg.Struct("DateRange")),
+		DateTimeRange: stringRange(pkg.Struct("DateTimeRange")),
+	}
+	for _, s := range pkg.Structs {
+		for _, f := range s.Fields {
+			if f.Name == "DateTimeZone" && f.Type == "*string" {
+				data.TimeZones = append(data.TimeZones, s.Name)
+			}
+		}
+		if s.Name == "DateRange" || s.Name == "DateTimeRange" {
+			// They have NewDateRange and Dates, or NewDateTimeRange and
+			// Times.
+			continue
+		}
+		for _, f := range s.Fields {
+			format := timeField(s, f)
+			if format == "" {
+				continue
+			}
+			for _, name := range []string{"Get" + f.Name, "Set" + f.Name} {
+				if pkg.fieldPath(s, name) != nil {
+					return "", fmt.Errorf("%s.%s: field conflicts with a generated method", s.Name, name)
+				}
+			}
+			d := &datetimeField{Struct: s.Name, Field: f.Name}
+			if format == dateFormat {
+				data.Dates = append(data.Dates, d)
+			} else {
+				data.DateTimes = append(data.DateTimes, d)
+			}
+		}
+	}
+	if !data.DateRange && !data.DateTimeRange && len(data.TimeZones) == 0 && len(data.Dates) == 0 && len(data.DateTimes) == 0 {
+		return "datetime", nil
+	}
+	data.Time = data.DateTimeRange || len(data.TimeZones) > 0 || len(data.DateTimes) > 0
+	return "datetime", datetimeTemplate.Execute(buf, data)
+}
+
+// stringRange reports whether s is a range with string bounds. A few gowsdl
+// outputs declare DateRange with time.Time bounds instead.
+func stringRange(s *Struct) bool {
+	if s == nil {
+		return false
+	}
+	for _, f := range s.Fields {
+		if (f.Name == "Min" || f.Name == "Max") && f.Type != "*string" {
+			return false
+		}
+	}
+	return true
+}
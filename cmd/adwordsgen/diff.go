@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+var diffTemplate = template.Must(template.New("diff").Parse(`
+import "github.com/godofdream/go-googleadsinofficial/deep"
+{{range .Types}}
+// Copy returns a deep copy of t.
+func (t *{{.}}) Copy() *{{.}} {
+	return deep.Copy(t).(*{{.}})
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *{{.}}) Equal(o *{{.}}) bool {
+	return deep.Equal(t, o)
+}
+{{end}}
+{{- range .Entities}}{{$e := .}}
+// Diff{{.Name}} compares from, as fetched from the API, with the desired
+// to. It returns a {{.Name}} holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func Diff{{.Name}}(from, to *{{.Name}}) (*{{.Name}}, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{ {{- range $i, $f := .Mutable}}{{if $i}}, {{end}}"{{$f}}"{{end}} },
+		{{if .Keys}}[]string{ {{- range $i, $f := .Keys}}{{if $i}}, {{end}}"{{$f}}"{{end}} }{{else}}nil{{end}},
+	).(*{{.Name}})
+	return set, changes
+}
+{{with .Operation}}
+// Set{{.}} returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See Diff{{$e.Name}}.
+func Set{{.}}(from, to *{{$e.Name}}) (*{{.}}, []deep.Change) {
+	set, changes := Diff{{$e.Name}}(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &{{.}}{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+{{end}}
+{{- end}}
+`))
+
+type diffEntity struct {
+	Name      string
+	Mutable   []string
+	Keys      []string
+	Operation string
+}
+
+// emitDiff generates Copy and Equal for every type and a Diff function for
+// every entity type, i.e. every type with selectable fields. Entities that
+// are the operand of an operation also get a Set function building the
+// SET operation.
+func emitDiff(pkg *Package, buf *bytes.Buffer) (string, error) {
+	var data struct {
+		Types    []string
+		Entities []*diffEntity
+	}
+	operations := make(map[string]string)
+	for _, s := range pkg.Structs {
+		path := pkg.operatorPath(s)
+		if len(path) != 2 || path[0] != "Operation" || !pkg.hasEnumValue("Operator", "SET") {
+			continue
+		}
+		for _, f := range s.Fields {
+			if f.Name == "Operand" && f.Type == "*"+f.ElemType() {
+				operations[f.ElemType()] = s.Name
+			}
+		}
+	}
+
+	for _, s := range pkg.Structs {
+		for _, f := range pkg.AllFields(s) {
+			if f.Name == "Copy" || f.Name == "Equal" {
+				return "", fmt.Errorf("%s.%s: field conflicts with a generated method", s.Name, f.Name)
+			}
+		}
+		data.Types = append(data.Types, s.Name)
+
+		e := &diffEntity{Name: s.Name, Operation: operations[s.Name]}
+		selectable := false
+		seen := make(map[string]bool)
+		fields := pkg.AllFields(s)
+		for i := len(fields) - 1; i >= 0; i-- {
+			f := fields[i]
+			if seen[f.Name] || f.IsDiscriminator() {
+				// Shadowed by a field of a derived type, or the concrete
+				// type that is not sent.
+				continue
+			}
+			seen[f.Name] = true
+			if len(f.Selectors()) > 0 {
+				selectable = true
+			}
+			_, readOnly := f.Constraints["ReadOnly"]
+			if requiredForSet(f) || keyID(s, f) {
+				e.Keys = append([]string{f.Name}, e.Keys...)
+				continue
+			}
+			if ops := f.Operators("ReadOnly"); readOnly && (ops == nil || contains(ops, "SET")) {
+				continue
+			}
+			e.Mutable = append([]string{f.Name}, e.Mutable...)
+		}
+		if !selectable {
+			continue
+		}
+		if name := "Diff" + s.Name; pkg.Struct(name) != nil || pkg.hasEnum(name) {
+			return "", fmt.Errorf("%s: name conflicts with a type", name)
+		}
+		if e.Operation != "" && (pkg.Struct("Set"+e.Operation) != nil || pkg.hasEnum("Set"+e.Operation)) {
+			return "", fmt.Errorf("Set%s: name conflicts with a type", e.Operation)
+		}
+		data.Entities = append(data.Entities, e)
+	}
+	if len(data.Types) == 0 {
+		return "diff", nil
+	}
+	return "diff", diffTemplate.Execute(buf, data)
+}
+
+// requiredForSet reports whether f is annotated as required in SET
+// operations.
+func requiredForSet(f *Field) bool {
+	_, required := f.Constraints["Required"]
+	ops := f.Operators("Required")
+	return required && (ops == nil || contains(ops, "SET"))
+}
+
+// keyID reports whether f is an id identifying entities of s even where it
+// is not annotated as required: the Id, the id of the entity itself, e.g.
+// Budget.BudgetId or ManagedCustomer.CustomerId, and the ids of the
+// entities it belongs to, e.g. CampaignSharedSet.CampaignId.
+func keyID(s *Struct, f *Field) bool {
+	if f.Name == "Id" {
+		return true
+	}
+	entity := strings.TrimSuffix(f.Name, "Id")
+	if entity == f.Name || entity == "" || f.Type != "*int64" {
+		return false
+	}
+	return strings.HasPrefix(s.Name, entity) || strings.HasSuffix(s.Name, entity)
+}
+
+// hasEnumValue reports whether the enum name has the value value.
+func (p *Package) hasEnumValue(name, value string) bool {
+	for _, e := range p.Enums {
+		if e.Name != name {
+			continue
+		}
+		for _, v := range e.Values {
+			if v.Value == value && v.Const == name+strings.ToUpper(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"text/template"
+)
+
+// enumsTemplate emits the helpers of every enum with values. The helpers
+// of one enum are the template "enum", which reportsTemplate uses too.
+var enumsTemplate = template.Must(template.New("enums").Parse(`
+import "fmt"
+{{range .}}{{if .Values}}{{template "enum" .}}{{end}}{{end}}
+{{- define "enum"}}
+// {{.Name}}Values returns all values of {{.Name}}.
+func {{.Name}}Values() []{{.Name}} {
+	return []{{.Name}}{ {{- range .Values}}
+		{{.Const}},{{end}}
+	}
+}
+
+// IsValid reports whether e is one of the values of {{.Name}}.
+func (e {{.Name}}) IsValid() bool {
+	switch e {
+	case {{range $i, $v := .Values}}{{if $i}},
+		{{end}}{{$v.Const}}{{end}}:
+		return true
+	}
+	return false
+}
+
+// Parse{{.Name}} returns the {{.Name}} named by s.
+func Parse{{.Name}}(s string) ({{.Name}}, error) {
+	e := {{.Name}}(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid {{.Name}} %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+{{- if .Unknown}} It fails for values that
+// are not part of {{.Name}}.
+func (e {{.Name}}) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid {{.Name}} %q", string(e))
+	}
+	return []byte(e), nil
+}
+{{- else}} Values that are not part of
+// {{.Name}} are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e {{.Name}}) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+{{- end}}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode.{{if .Unknown}} Such values become UNKNOWN.{{else}} IsValid reports such values.{{end}}
+func (e *{{.Name}}) UnmarshalText(text []byte) error {
+	v := {{.Name}}(text)
+	{{- if .Unknown}}
+	if !v.IsValid() {
+		v = {{.Unknown}}
+	}
+	{{- end}}
+	*e = v
+	return nil
+}
+{{end}}
+`))
+
+func emitEnums(pkg *Package, buf *bytes.Buffer) (string, error) {
+	return "enums", enumsTemplate.Execute(buf, pkg.Enums)
+}
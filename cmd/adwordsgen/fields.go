@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"sort"
+	"text/template"
+)
+
+var fieldsTemplate = template.Must(template.New("fields").Parse(`
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+{{range .Selectable}}
+// {{.Name}}Fields holds the selector field names of {{.Name}}, including
+// the fields of the types it holds.
+var {{.Name}}Fields = struct {
+{{- range .Names}}
+	{{.}} string
+{{- end}}
+}{
+{{- range .Names}}
+	{{.}}: "{{.}}",
+{{- end}}
+}
+{{end}}
+var typeFields = map[string][]FieldInfo{
+{{- range .Types}}
+	"{{.Name}}": {
+	{{- range .Fields}}
+		{Type: "{{.Type}}", Name: "{{.Name}}"
+			{{- with .Selectors}}, Selectors: []string{ {{- range $i, $s := .}}{{if $i}}, {{end}}"{{$s}}"{{end}} }{{end}}
+			{{- with .Filter}}, Filter: "{{.}}"{{end}}
+			{{- if .ReadOnly}}, ReadOnly: true{{end}}
+			{{- with .ReadOnlyOperators}}, ReadOnlyOperators: []string{ {{- range $i, $s := .}}{{if $i}}, {{end}}"{{$s}}"{{end}} }{{end}}
+			{{- if .Required}}, Required: true{{end}}
+			{{- with .RequiredOperators}}, RequiredOperators: []string{ {{- range $i, $s := .}}{{if $i}}, {{end}}"{{$s}}"{{end}} }{{end -}}
+		},
+	{{- end}}
+	},
+{{- end}}
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+`))
+
+// fieldInfo is the template data of a single FieldInfo.
+type fieldInfo struct {
+	Type, Name        string
+	Selectors         []string
+	Filter            string
+	ReadOnly          bool
+	ReadOnlyOperators []string
+	Required          bool
+	RequiredOperators []string
+}
+
+// emitFields generates the selector field name constants of every type with
+// selectable fields and the FieldInfo metadata of every annotated field.
+func emitFields(pkg *Package, buf *bytes.Buffer) (string, error) {
+	type typeInfo struct {
+		Name   string
+		Fields []fieldInfo
+	}
+	type selectable struct {
+		Name  string
+		Names []string
+	}
+	var data struct {
+		Types      []typeInfo
+		Selectable []selectable
+	}
+
+	declaring := make(map[*Field]string)
+	for _, s := range pkg.Structs {
+		for _, f := range s.Fields {
+			declaring[f] = s.Name
+		}
+	}
+
+	for _, s := range pkg.Structs {
+		var fields []fieldInfo
+		annotated, ownSelectors := false, false
+		for _, f := range pkg.AllFields(s) {
+			_, readOnly := f.Constraints["ReadOnly"]
+			_, required := f.Constraints["Required"]
+			info := fieldInfo{
+				Type:              declaring[f],
+				Name:              f.Name,
+				Selectors:         f.Selectors(),
+				Filter:            f.Filter(),
+				ReadOnly:          readOnly,
+				ReadOnlyOperators: f.Operators("ReadOnly"),
+				Required:          required,
+				RequiredOperators: f.Operators("Required"),
+			}
+			if len(info.Selectors) > 0 {
+				ownSelectors = true
+			}
+			if len(info.Selectors) > 0 || info.Filter != "" || readOnly || required {
+				annotated = true
+			}
+			fields = append(fields, info)
+		}
+		if annotated {
+			data.Types = append(data.Types, typeInfo{s.Name, fields})
+		}
+		if !ownSelectors {
+			continue
+		}
+
+		name := s.Name + "Fields"
+		if pkg.Struct(name) != nil || pkg.hasEnum(name) {
+			return "", fmt.Errorf("%s: name conflicts with a type", name)
+		}
+		names := pkg.reachableSelectors(s)
+		for _, n := range names {
+			if !token.IsIdentifier(n) {
+				return "", fmt.Errorf("%s: selector %q is not an identifier", name, n)
+			}
+		}
+		data.Selectable = append(data.Selectable, selectable{s.Name, names})
+	}
+	if len(data.Types) == 0 {
+		return "fields", nil
+	}
+	for _, name := range []string{"FieldInfo", "FieldKey", "TypeFields", "LookupField"} {
+		if pkg.Struct(name) != nil || pkg.hasEnum(name) {
+			return "", fmt.Errorf("%s: name conflicts with a type", name)
+		}
+	}
+	return "fields", fieldsTemplate.Execute(buf, data)
+}
+
+// reachableSelectors returns the sorted selector names of s, its subtypes
+// and the types held by their fields. Selecting a field of a nested type
+// uses the same flat names, e.g. "BudgetId" for Campaign.Budget.BudgetId.
+func (p *Package) reachableSelectors(s *Struct) []string {
+	seen := make(map[string]bool)
+	visited := make(map[*Struct]bool)
+	var walk func(s *Struct)
+	walk = func(s *Struct) {
+		if s == nil || visited[s] {
+			return
+		}
+		visited[s] = true
+		for _, f := range p.AllFields(s) {
+			for _, name := range f.Selectors() {
+				seen[name] = true
+			}
+			walk(p.Struct(f.ElemType()))
+		}
+		for _, sub := range s.EmbeddedBy {
+			walk(p.Struct(sub))
+		}
+	}
+	walk(s)
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// hasEnum reports whether the package has an enum named name.
+func (p *Package) hasEnum(name string) bool {
+	for _, e := range p.Enums {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+var iterTemplate = template.Must(template.New("iter").Parse(`
+import (
+{{- range .Std}}
+	"{{.}}"
+{{- end}}
+{{range .Local}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Methods}}
+{{- if .Query}}
+// {{.Name}}All returns an iterator over the {{.Entity}} entries of the AWQL
+// query, fetching one page after the other. The LIMIT clause of the query
+// selects the first entry and the page size. See paging.Pager for entries
+// added or removed while iterating.
+func {{.Name}}All(api {{$.API}}, query string) iter.Seq2[*{{.Entity}}, error] {
+	return {{.Pager}}(api, query).All()
+}
+
+// {{.Name}}Each calls fn for every {{.Entity}} entry of the AWQL query and
+// returns the first error. See {{.Name}}All.
+func {{.Name}}Each(api {{$.API}}, query string, fn func(*{{.Entity}}) error) error {
+	return {{.Pager}}(api, query).Each(fn)
+}
+
+// {{.Pager}} returns the paging.Pager of {{.Name}}All, e.g. to fetch pages
+// concurrently or retry failed requests.
+func {{.Pager}}(api {{$.API}}, query string) *paging.Pager[*{{.Entity}}] {
+	p := &paging.Pager[*{{.Entity}}]{ {{- if .Key}}Key: {{.Key}}{{end}}}
+	q, err := awql.Parse(query)
+	if err == nil && q.Paging != nil {
+		p.Start, p.PageSize = q.Paging.StartIndex, q.Paging.NumberResults
+	}
+	p.Fetch = func(start, n int32) ([]*{{.Entity}}, int32, error) {
+		if err != nil {
+			return nil, 0, err
+		}
+		window := *q
+		window.Paging = &selector.Paging{StartIndex: start, NumberResults: n}
+{{- if .KeyField}}
+		window.Fields = paging.WithField(window.Fields, "{{.KeyField}}")
+{{- end}}
+		paged, err := window.Format()
+		if err != nil {
+			return nil, 0, err
+		}
+		resp, err := api.{{.Name}}(&{{.Request}}{ {{- .Field}}: String(paged)})
+{{- else}}
+// {{.Name}}All returns an iterator over the {{.Entity}} entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func {{.Name}}All(api {{$.API}}, sel *{{.Selector}}) iter.Seq2[*{{.Entity}}, error] {
+	return {{.Pager}}(api, sel).All()
+}
+
+// {{.Name}}Each calls fn for every {{.Entity}} entry selected by sel and
+// returns the first error. See {{.Name}}All.
+func {{.Name}}Each(api {{$.API}}, sel *{{.Selector}}, fn func(*{{.Entity}}) error) error {
+	return {{.Pager}}(api, sel).Each(fn)
+}
+
+// {{.Pager}} returns the paging.Pager of {{.Name}}All, e.g. to fetch pages
+// concurrently or retry failed requests.
+func {{.Pager}}(api {{$.API}}, sel *{{.Selector}}) *paging.Pager[*{{.Entity}}] {
+	p := &paging.Pager[*{{.Entity}}]{ {{- if .Key}}Key: {{.Key}}{{end}}}
+	if sel == nil {
+		sel = new({{.Selector}})
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*{{.Entity}}, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+{{- if .KeyField}}
+		paged.Fields = paging.WithField(paged.Fields, "{{.KeyField}}")
+{{- end}}
+		resp, err := api.{{.Name}}(&{{.Request}}{ {{- .Field}}: paged})
+{{- end}}
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if {{.TotalGuard}} {
+			total = *resp.Rval.{{.Total}}
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+{{- if .Split}}
+
+// {{.Name}}SplitAll is {{.Name}}All for selections larger than the service
+// returns. See paging.Splitter.
+func {{.Name}}SplitAll(api {{$.API}}, sel *Selector, split *paging.Splitter) iter.Seq2[*{{.Entity}}, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel.Neutral(), {{.SplitPager}}(api))
+}
+
+// {{.Name}}SplitEach is {{.Name}}Each for selections larger than the service
+// returns. See paging.Splitter.
+func {{.Name}}SplitEach(api {{$.API}}, sel *Selector, split *paging.Splitter, fn func(*{{.Entity}}) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel.Neutral(), {{.SplitPager}}(api), fn)
+}
+
+func {{.SplitPager}}(api {{$.API}}) func(*selector.Selector) (*paging.Pager[*{{.Entity}}], error) {
+	return func(s *selector.Selector) (*paging.Pager[*{{.Entity}}], error) {
+		sel, err := ConvertSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return {{.Pager}}(api, sel), nil
+	}
+}
+{{- end}}
+{{- if .IDs}}
+
+// {{.Name}}IDs returns the ids of the {{.Entity}} entries selected by sel,
+// fetched with the Id field only, e.g. as the values of a
+// paging.Splitter.
+func {{.Name}}IDs(api {{$.API}}, sel *{{.Selector}}) ([]string, error) {
+	if sel == nil {
+		sel = new({{.Selector}})
+	}
+	ids := sel.Copy()
+	ids.Fields = []string{"Id"}
+	var list []string
+	err := {{.Name}}Each(api, ids, func(e *{{.Entity}}) error {
+		if id := {{.Key}}(e); id != "" {
+			list = append(list, id)
+		}
+		return nil
+	})
+	return list, err
+}
+{{- end}}
+{{end}}
+{{- range .Keys}}
+// {{.Name}} identifies a {{.Entity}} by its Id.
+func {{.Name}}(e *{{.Entity}}) string {
+	if {{.Guard}} {
+		return strconv.FormatInt(*e.{{.Path}}, 10)
+	}
+	return ""
+}
+{{end}}
+`))
+
+type iterMethod struct {
+	Name, Request, Field, Selector, Entity, Pager, Key string
+	Query, Split, IDs                                  bool
+	Total, TotalGuard, SplitPager                      string
+
+	// KeyField is the selector field of the Id of Key, which is selected
+	// so the entries have keys.
+	KeyField string
+}
+
+type iterKey struct {
+	Name, Entity, Path, Guard string
+}
+
+// emitIter generates iterators over all pages of the Get and Query methods
+// returning a page with Entries and TotalNumEntries.
+func emitIter(pkg *Package, buf *bytes.Buffer) (string, error) {
+	data := struct {
+		API        string
+		Std, Local []string
+		Methods    []*iterMethod
+		Keys       []*iterKey
+	}{API: pkg.Name + "API"}
+	imports := map[string]bool{
+		"iter": true,
+		"github.com/godofdream/go-googleadsinofficial/paging": true,
+	}
+	keys := make(map[string]*iterKey)
+	for _, name := range []string{"Get", "Query"} {
+		m := pkg.Service.Method(name)
+		if m == nil {
+			continue
+		}
+		it := pkg.iterMethod(m)
+		if it == nil {
+			continue
+		}
+		names := []string{it.Name + "All", it.Name + "Each", it.Pager}
+		// Splitting converts the neutral parts with the selector glue.
+		if it.Selector == "Selector" && pkg.hasEnum("PredicateOperator") && pkg.hasEnum("SortOrder") {
+			it.Split = true
+			it.SplitPager = lowerFirst(it.Name) + "SplitPager"
+			names = append(names, it.Name+"SplitAll", it.Name+"SplitEach", it.SplitPager)
+		}
+		for _, n := range names {
+			if pkg.Struct(n) != nil || pkg.hasEnum(n) {
+				return "", fmt.Errorf("%s: name conflicts with a type", n)
+			}
+		}
+		if it.Query || it.Split {
+			imports["github.com/godofdream/go-googleadsinofficial/selector"] = true
+		}
+		if it.Query {
+			imports["github.com/godofdream/go-googleadsinofficial/awql"] = true
+		}
+		if k := keys[it.Entity]; k != nil {
+			it.Key = k.Name
+		} else if path := pkg.fieldPath(pkg.Struct(it.Entity), "Id"); path != nil && pkg.fieldType(pkg.Struct(it.Entity), "Id") == "*int64" {
+			k := &iterKey{
+				Name:   lowerFirst(it.Entity) + "Key",
+				Entity: it.Entity,
+				Path:   strings.Join(path, "."),
+				Guard:  "e != nil && " + guard("e", path),
+			}
+			keys[it.Entity] = k
+			data.Keys = append(data.Keys, k)
+			it.Key = k.Name
+			imports["strconv"] = true
+		}
+		if it.Key != "" && pkg.selectableID(pkg.Struct(it.Entity)) {
+			it.KeyField = "Id"
+		}
+		if it.KeyField != "" && !it.Query {
+			if n := it.Name + "IDs"; pkg.Struct(n) != nil || pkg.hasEnum(n) {
+				return "", fmt.Errorf("%s: name conflicts with a type", n)
+			}
+			it.IDs = true
+		}
+		data.Methods = append(data.Methods, it)
+	}
+	if len(data.Methods) == 0 {
+		return "iter", nil
+	}
+	for i := range imports {
+		if strings.Contains(i, ".") {
+			data.Local = append(data.Local, i)
+		} else {
+			data.Std = append(data.Std, i)
+		}
+	}
+	sort.Strings(data.Std)
+	sort.Strings(data.Local)
+	return "iter", iterTemplate.Execute(buf, data)
+}
+
+// iterMethod describes the paging of m, or returns nil if m does not page.
+// A Get request holds a single selector with Paging, a Query request a
+// single query string. Both return an Rval page with Entries and
+// TotalNumEntries.
+func (p *Package) iterMethod(m *Method) *iterMethod {
+	req, resp := p.Struct(m.Request), p.Struct(m.Response)
+	if req == nil || resp == nil || len(req.Fields) != 1 || len(resp.Fields) != 1 || resp.Fields[0].Name != "Rval" {
+		return nil
+	}
+	page := p.Struct(strings.TrimPrefix(resp.Fields[0].Type, "*"))
+	if page == nil || !strings.HasPrefix(resp.Fields[0].Type, "*") {
+		return nil
+	}
+	entries := p.fieldType(page, "Entries")
+	total := p.fieldPath(page, "TotalNumEntries")
+	if len(p.fieldPath(page, "Entries")) != 1 || !strings.HasPrefix(entries, "[]*") ||
+		total == nil || p.fieldType(page, "TotalNumEntries") != "*int32" {
+		return nil
+	}
+	it := &iterMethod{
+		Name:       m.Name,
+		Request:    m.Request,
+		Field:      req.Fields[0].Name,
+		Entity:     strings.TrimPrefix(entries, "[]*"),
+		Pager:      m.Name + "Pager",
+		Total:      strings.Join(total, "."),
+		TotalGuard: guard("resp.Rval", total),
+	}
+	switch f := req.Fields[0]; {
+	case f.Type == "*string" && m.Name == "Query":
+		it.Query = true
+	case strings.HasPrefix(f.Type, "*"):
+		sel := p.Struct(f.ElemType())
+		if sel == nil || len(p.fieldPath(sel, "Paging")) != 1 || p.fieldType(sel, "Paging") != "*Paging" {
+			return nil
+		}
+		it.Selector = sel.Name
+	default:
+		return nil
+	}
+	return it
+}
+
+// selectableID reports whether the Id of s is selected by the field "Id".
+func (p *Package) selectableID(s *Struct) bool {
+	for _, f := range p.AllFields(s) {
+		if f.Name == "Id" && contains(f.Selectors(), "Id") {
+			return true
+		}
+	}
+	return false
+}
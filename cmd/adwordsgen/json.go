@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// rewriteJSONTags adds a json tag next to the xml tag of every schema type
+// field. XMLName is hidden from encoding/json; all other fields use their
+// lowerCamel element name, so the JSON form stays stable across gowsdl runs.
+func rewriteJSONTags(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	type edit struct {
+		offset int
+		end    int
+		text   string
+	}
+	var edits []edit
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok || parseStruct(ts.Name.Name, st) == nil {
+			return false
+		}
+
+		seen := make(map[string]string)
+		for _, f := range st.Fields.List {
+			if len(f.Names) == 0 {
+				continue
+			}
+			tag := fieldTag(f)
+			if _, ok := tag.Lookup("json"); ok {
+				continue
+			}
+			name := "-"
+			if f.Names[0].Name != "XMLName" {
+				field := &Field{Name: f.Names[0].Name, XMLName: xmlLocalName(tag)}
+				name = field.JSONName() + ",omitempty"
+				if other, ok := seen[field.JSONName()]; ok {
+					err = fmt.Errorf("%s: fields %s and %s share the JSON name %q", ts.Name.Name, other, field.Name, field.JSONName())
+				}
+				seen[field.JSONName()] = field.Name
+			}
+			if f.Tag == nil {
+				edits = append(edits, edit{
+					offset: fset.Position(f.Type.End()).Offset,
+					end:    fset.Position(f.Type.End()).Offset,
+					text:   " `json:\"" + name + "\"`",
+				})
+				continue
+			}
+			edits = append(edits, edit{
+				offset: fset.Position(f.Tag.Pos()).Offset,
+				end:    fset.Position(f.Tag.End()).Offset,
+				text:   "`" + strings.TrimSpace(string(tag)+` json:"`+name+`"`) + "`",
+			})
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].offset > edits[j].offset })
+	for _, e := range edits {
+		src = append(src[:e.offset], append([]byte(e.text), src[e.end:]...)...)
+	}
+	return src, nil
+}
+
+// A jsonType is a concrete type whose base types carry a discriminator.
+type jsonType struct {
+	Name string
+
+	// Chain lists the embedded base types from the direct base of Name down
+	// to the root of the hierarchy.
+	Chain []*jsonBase
+}
+
+type jsonBase struct {
+	Name          string
+	Discriminator string
+}
+
+var jsonTemplate = template.Must(template.New("json").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+	"prev": func(i int) string {
+		if i == 0 {
+			return "v"
+		}
+		return fmt.Sprintf("b%d", i-1)
+	},
+}).Parse(`
+import "encoding/json"
+{{range .}}{{$type := .Name}}
+// MarshalJSON implements json.Marshaler. It records {{quote $type}} as the
+// concrete type in the discriminator of its base types.
+func (t {{$type}}) MarshalJSON() ([]byte, error) {
+	type plain {{$type}}
+	v := plain(t)
+{{range $i, $b := .Chain}}
+	b{{$i}} := new({{$b.Name}})
+	if {{prev $i}}.{{$b.Name}} != nil {
+		*b{{$i}} = *{{prev $i}}.{{$b.Name}}
+	}
+	{{- if $b.Discriminator}}
+	if b{{$i}}.{{$b.Discriminator}} == nil {
+		b{{$i}}.{{$b.Discriminator}} = String({{quote $type}})
+	}
+	{{- end}}
+	{{prev $i}}.{{$b.Name}} = b{{$i}}
+{{end}}
+	return json.Marshal(v)
+}
+{{end}}
+`))
+
+// emitJSON generates MarshalJSON for every concrete type of a polymorphic
+// hierarchy, so the JSON form names the concrete type even if the
+// discriminator was never filled in by the API.
+//
+// There is no decoding counterpart: like the xml tags, a field of a base
+// type such as AdGroupAd.Ad can't hold a concrete type, so it keeps the
+// fields of the base type and the discriminator and drops the others.
+func emitJSON(pkg *Package, buf *bytes.Buffer) (string, error) {
+	var types []*jsonType
+	for _, s := range pkg.Structs {
+		if len(s.EmbeddedBy) > 0 {
+			continue
+		}
+		t := &jsonType{Name: s.Name}
+		depth := 0
+		for base := pkg.Struct(s.Base()); base != nil; base = pkg.Struct(base.Base()) {
+			b := &jsonBase{Name: base.Name}
+			for _, f := range base.Fields {
+				if f.IsDiscriminator() {
+					b.Discriminator = f.Name
+				}
+			}
+			t.Chain = append(t.Chain, b)
+			if b.Discriminator != "" {
+				depth = len(t.Chain)
+			}
+		}
+		if depth > 0 {
+			t.Chain = t.Chain[:depth]
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return "json", nil
+	}
+	return "json", jsonTemplate.Execute(buf, types)
+}
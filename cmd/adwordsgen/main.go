@@ -0,0 +1,152 @@
+// Command adwordsgen post-processes the gowsdl output of the AdWords service
+// packages. It rewrites the generated types where gowsdl cannot express the
+// XSD semantics and emits additional helper files next to each package.
+//
+// Usage:
+//
+//	adwordsgen v201802/BudgetService [v201802/CampaignService ...]
+//
+// Every argument is a package directory containing the gowsdl output named
+// after the directory (e.g. BudgetService/BudgetService.go).
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// header marks every file emitted by adwordsgen.
+const header = "// Code generated by adwordsgen. DO NOT EDIT.\n\n"
+
+// A Package is a single generated service package.
+type Package struct {
+	Name string
+	Dir  string
+
+	// Source of the gowsdl output file.
+	Source []byte
+
+	Enums   []*Enum
+	Structs []*Struct
+	Service *Service
+}
+
+// File returns the path of the gowsdl output file of the package.
+func (p *Package) File() string {
+	return filepath.Join(p.Dir, p.Name+".go")
+}
+
+// WSDL returns the path of the WSDL file the package was generated from,
+// which wsdl.sh downloads next to the package directory.
+func (p *Package) WSDL() string {
+	return filepath.Join(filepath.Dir(p.Dir), p.Name+".wsdl")
+}
+
+// rewriters modify the gowsdl output in place. They must be idempotent so
+// the generator can be re-run on already processed sources.
+var rewriters = []func(src []byte) ([]byte, error){
+	rewriteOptionalScalars,
+	rewriteJSONTags,
+	rewriteXMLNames,
+}
+
+// emitters produce additional files for a package. Each emitter writes the
+// file body to buf and returns the file name suffix (e.g. "optional" for
+// BudgetService_optional.go).
+var emitters = []func(pkg *Package, buf *bytes.Buffer) (suffix string, err error){
+	emitOptional,
+	emitEnums,
+	emitJSON,
+	emitMoney,
+	emitDatetime,
+	emitAPI,
+	emitFields,
+	emitValidate,
+	emitDiff,
+	emitSelector,
+	emitIter,
+	emitBatch,
+	emitOps,
+	emitReports,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("adwordsgen: ")
+
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: adwordsgen dir...")
+		os.Exit(2)
+	}
+	for _, dir := range os.Args[1:] {
+		if err := process(filepath.Clean(dir)); err != nil {
+			log.Fatalf("%s: %v", dir, err)
+		}
+	}
+}
+
+func process(dir string) error {
+	pkg := &Package{Name: filepath.Base(dir), Dir: dir}
+
+	src, err := ioutil.ReadFile(pkg.File())
+	if err != nil {
+		return err
+	}
+	nillable, err := readNillable(pkg.WSDL())
+	if err != nil {
+		return err
+	}
+	for _, rewrite := range append(rewriters, nillable.rewrite) {
+		if src, err = rewrite(src); err != nil {
+			return err
+		}
+	}
+	// The rewritten source is deliberately not run through go/format: it
+	// would reflow all gowsdl doc comments and bury the actual changes.
+	if _, err = parser.ParseFile(token.NewFileSet(), pkg.File(), src, parser.ParseComments); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(pkg.File(), src, 0664); err != nil {
+		return err
+	}
+	pkg.Source = src
+	if err = pkg.parse(); err != nil {
+		return err
+	}
+
+	for _, emit := range emitters {
+		buf := new(bytes.Buffer)
+		buf.WriteString(header)
+		fmt.Fprintf(buf, "package %s\n\n", pkg.Name)
+
+		empty := buf.Len()
+
+		suffix, err := emit(pkg, buf)
+		if err != nil {
+			return err
+		}
+		name := filepath.Join(pkg.Dir, pkg.Name+"_"+suffix+".go")
+		if buf.Len() == empty {
+			// Nothing to generate for this package.
+			if err = os.Remove(name); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		out, err := format.Source(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("%s: %v", suffix, err)
+		}
+		if err = ioutil.WriteFile(name, out, 0664); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/godofdream/go-googleadsinofficial/cmd/internal/constraints"
+	"github.com/godofdream/go-googleadsinofficial/cmd/internal/schema"
+)
+
+// An Enum is a string type of the gowsdl output together with its constants.
+type Enum struct {
+	Name   string
+	Values []*EnumValue
+}
+
+// Unknown returns the constant of the value UNKNOWN, which the API returns
+// for values that are not part of the version, or "" if e has none.
+func (e *Enum) Unknown() string {
+	for _, v := range e.Values {
+		if v.Value == "UNKNOWN" {
+			return v.Const
+		}
+	}
+	return ""
+}
+
+// An EnumValue is a single constant of an Enum.
+type EnumValue struct {
+	Const string
+	Value string
+}
+
+// A Struct is a complex type of the AdWords schema. The SOAP envelope types
+// of the gowsdl output are not part of the model.
+type Struct struct {
+	Name string
+
+	// Embeds lists the embedded base types. XSD extensions are single
+	// inheritance, so there is at most one.
+	Embeds []string
+	Fields []*Field
+
+	// EmbeddedBy lists the types extending this one.
+	EmbeddedBy []string
+}
+
+// Base returns the type embedded by s, or "" if s extends no other type.
+func (s *Struct) Base() string {
+	if len(s.Embeds) == 0 {
+		return ""
+	}
+	return s.Embeds[0]
+}
+
+// A Field is an element of a Struct.
+type Field struct {
+	Name string
+	Type string
+
+	// XMLName is the local element name.
+	XMLName string
+
+	// Doc is the doc comment of the field.
+	Doc string
+
+	// Constraints maps the class of each <span class="constraint ...">
+	// annotation of Doc (Selectable, Required, InRange, ...) to its text, as
+	// parsed by constraints.Parse.
+	Constraints map[string]string
+}
+
+var (
+	quotedValue  = regexp.MustCompile(`"(\w+)"`)
+	operatorList = regexp.MustCompile(`Operator}s ?: (.*)\.$`)
+)
+
+// Selectors returns the selector field names of f. Most fields have one,
+// fields of some types shared by several ad types have more.
+func (f *Field) Selectors() []string {
+	var names []string
+	for _, m := range quotedValue.FindAllStringSubmatch(f.Constraints["Selectable"], -1) {
+		names = append(names, m[1])
+	}
+	return names
+}
+
+// Filter returns the name f can be filtered on, or "" if it is not
+// filterable. Unless the annotation names it, it is the selector name.
+func (f *Field) Filter() string {
+	text, ok := f.Constraints["Filterable"]
+	if !ok {
+		return ""
+	}
+	if m := quotedValue.FindStringSubmatch(text); m != nil {
+		return m[1]
+	}
+	if names := f.Selectors(); len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// Operators returns the operators a constraint is limited to, e.g. [ADD]
+// for "required ... within {@link Operator}s : ADD.", or nil if the
+// constraint holds for every operator.
+func (f *Field) Operators(constraint string) []string {
+	m := operatorList.FindStringSubmatch(f.Constraints[constraint])
+	if m == nil {
+		return nil
+	}
+	var ops []string
+	for _, op := range strings.FieldsFunc(m[1], func(r rune) bool { return r == ',' || r == ' ' }) {
+		if op != "and" {
+			ops = append(ops, op)
+		}
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+// IsDiscriminator reports whether f is the "<Type>.Type" element that holds
+// the concrete type of a polymorphic instance.
+func (f *Field) IsDiscriminator() bool {
+	return strings.HasSuffix(f.XMLName, ".Type")
+}
+
+// JSONName returns the lowerCamel JSON name of f.
+func (f *Field) JSONName() string {
+	if strings.Contains(f.XMLName, ".") {
+		return lowerFirst(f.Name)
+	}
+	return lowerFirst(f.XMLName)
+}
+
+// ElemType returns the type of f without slice and pointer, e.g. Label for
+// a []*Label field.
+func (f *Field) ElemType() string {
+	return strings.TrimLeft(f.Type, "[]*")
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// A Service is the client type of a package.
+type Service struct {
+	// Name is the name of the client type, e.g. BudgetServiceInterface.
+	Name    string
+	Methods []*Method
+}
+
+// A Method is an operation of a Service.
+type Method struct {
+	Name     string
+	Request  string
+	Response string
+}
+
+// Method returns the method named name, or nil.
+func (s *Service) Method(name string) *Method {
+	for _, m := range s.Methods {
+		if m.Name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// AllFields returns the fields of s including the inherited ones, base
+// fields first.
+func (p *Package) AllFields(s *Struct) []*Field {
+	var fields []*Field
+	if base := p.Struct(s.Base()); base != nil {
+		fields = p.AllFields(base)
+	}
+	return append(fields, s.Fields...)
+}
+
+// Struct returns the struct named name, or nil.
+func (p *Package) Struct(name string) *Struct {
+	for _, s := range p.Structs {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// parse fills the model of the package from its gowsdl output.
+func (p *Package) parse() error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, p.File(), p.Source, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	p.Service = &Service{Name: p.Name + "Interface"}
+	enums := make(map[string]*Enum)
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			if m := parseMethod(p.Service.Name, fn); m != nil {
+				p.Service.Methods = append(p.Service.Methods, m)
+			}
+			continue
+		}
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		switch gen.Tok {
+		case token.TYPE:
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				switch t := ts.Type.(type) {
+				case *ast.Ident:
+					if t.Name == "string" {
+						enum := &Enum{Name: ts.Name.Name}
+						enums[enum.Name] = enum
+						p.Enums = append(p.Enums, enum)
+					}
+				case *ast.StructType:
+					if s := parseStruct(ts.Name.Name, t); s != nil {
+						p.Structs = append(p.Structs, s)
+					}
+				}
+			}
+		case token.CONST:
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				typ, ok := vs.Type.(*ast.Ident)
+				if !ok || enums[typ.Name] == nil || len(vs.Values) != 1 {
+					continue
+				}
+				lit, ok := vs.Values[0].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				value, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return err
+				}
+				enum := enums[typ.Name]
+				enum.Values = append(enum.Values, &EnumValue{Const: vs.Names[0].Name, Value: value})
+			}
+		}
+	}
+
+	for _, s := range p.Structs {
+		for _, name := range s.Embeds {
+			if base := p.Struct(name); base != nil {
+				base.EmbeddedBy = append(base.EmbeddedBy, s.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// parseMethod returns the operation fn of the client type, or nil if fn is
+// not one. Operations take a request and return a response and an error.
+func parseMethod(client string, fn *ast.FuncDecl) *Method {
+	if fn.Recv == nil || types.ExprString(fn.Recv.List[0].Type) != "*"+client {
+		return nil
+	}
+	params, results := fn.Type.Params.List, fn.Type.Results
+	if len(params) != 1 || len(params[0].Names) != 1 || results == nil || len(results.List) != 2 {
+		return nil
+	}
+	req, ok1 := params[0].Type.(*ast.StarExpr)
+	resp, ok2 := results.List[0].Type.(*ast.StarExpr)
+	if !ok1 || !ok2 {
+		return nil
+	}
+	return &Method{
+		Name:     fn.Name.Name,
+		Request:  types.ExprString(req.X),
+		Response: types.ExprString(resp.X),
+	}
+}
+
+// schemaPrefix is the namespace prefix of all AdWords schema types.
+const schemaPrefix = schema.Namespace
+
+// parseStruct returns the model of a schema type, or nil if t is not one.
+func parseStruct(name string, t *ast.StructType) *Struct {
+	if !schema.IsType(t) {
+		return nil
+	}
+	s := &Struct{Name: name}
+	for _, f := range t.Fields.List {
+		tag := fieldTag(f)
+		if len(f.Names) == 0 {
+			s.Embeds = append(s.Embeds, strings.TrimPrefix(types.ExprString(f.Type), "*"))
+			continue
+		}
+		if f.Names[0].Name == "XMLName" {
+			continue
+		}
+		s.Fields = append(s.Fields, &Field{
+			Name:        f.Names[0].Name,
+			Type:        types.ExprString(f.Type),
+			XMLName:     xmlLocalName(tag),
+			Doc:         f.Doc.Text(),
+			Constraints: constraints.Parse(f.Doc.Text()),
+		})
+	}
+	return s
+}
+
+// xmlLocalName returns the element name of an xml tag, without namespace and
+// options.
+func xmlLocalName(tag reflect.StructTag) string {
+	name := strings.Split(tag.Get("xml"), ",")[0]
+	if i := strings.LastIndexByte(name, ' '); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+func fieldTag(f *ast.Field) reflect.StructTag {
+	if f.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(f.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	return reflect.StructTag(tag)
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/xml"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// nillableElements lists the elements declared nillable="true" by type
+// name. gowsdl drops the attribute, so a nillable element could not be sent
+// as xsi:nil; rewrite wraps their fields in the generated Nillable type.
+type nillableElements map[string]map[string]bool
+
+// readNillable collects the nillable elements of the schemas of a WSDL
+// file. A missing file, e.g. of a package generated before its WSDL was
+// kept, has none.
+func readNillable(file string) (nillableElements, error) {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	nillable := make(nillableElements)
+	// types holds the enclosing type of every open element: the name of a
+	// complexType, or of the element declaring an anonymous one.
+	var types []string
+	current := func() string {
+		if len(types) == 0 {
+			return ""
+		}
+		return types[len(types)-1]
+	}
+	d := xml.NewDecoder(f)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nillable, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := attr(t, "name")
+			switch t.Name.Local {
+			case "complexType":
+				if name == "" {
+					name = current()
+				}
+			case "element":
+				if typ := current(); typ != "" && name != "" && attr(t, "nillable") == "true" {
+					if nillable[typ] == nil {
+						nillable[typ] = make(map[string]bool)
+					}
+					nillable[typ][name] = true
+				}
+				if name != "" {
+					// The anonymous complexType of an element is named
+					// after it.
+					name = upperFirst(name)
+				} else {
+					name = current()
+				}
+			default:
+				name = current()
+			}
+			types = append(types, name)
+		case xml.EndElement:
+			types = types[:len(types)-1]
+		}
+	}
+}
+
+func attr(e xml.StartElement, local string) string {
+	for _, a := range e.Attr {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// rewrite changes the type of the fields of nillable elements from T or
+// *T to *Nillable[T]. Lists are left alone: their items can't be nil. So
+// are the operation elements, e.g. the selector of get, and selectors in
+// general: a nil request or selector means nothing to the API, and the
+// adwords facade and the selector package fill them as plain types.
+func (n nillableElements) rewrite(src []byte) ([]byte, error) {
+	if len(n) == 0 {
+		return src, nil
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	type edit struct {
+		offset, end int
+		text        string
+	}
+	var edits []edit
+	ast.Inspect(file, func(node ast.Node) bool {
+		ts, ok := node.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok || parseStruct(ts.Name.Name, st) == nil || n[ts.Name.Name] == nil || isOperation(st) {
+			return false
+		}
+		for _, f := range st.Fields.List {
+			if len(f.Names) == 0 || !n[ts.Name.Name][xmlLocalName(fieldTag(f))] {
+				continue
+			}
+			typ := strings.TrimPrefix(types.ExprString(f.Type), "*")
+			if strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "Nillable[") || typ == "Selector" {
+				continue
+			}
+			edits = append(edits, edit{
+				offset: fset.Position(f.Type.Pos()).Offset,
+				end:    fset.Position(f.Type.End()).Offset,
+				text:   "*Nillable[" + typ + "]",
+			})
+		}
+		return false
+	})
+	sort.Slice(edits, func(i, j int) bool { return edits[i].offset > edits[j].offset })
+	for _, e := range edits {
+		src = append(src[:e.offset], append([]byte(e.text), src[e.end:]...)...)
+	}
+	return src, nil
+}
+
+// isOperation reports whether st is an operation element such as get or
+// mutateResponse, the only schema types keeping their XMLName after
+// rewriteXMLNames besides the headers.
+func isOperation(st *ast.StructType) bool {
+	if len(st.Fields.List) == 0 {
+		return false
+	}
+	f := st.Fields.List[0]
+	if len(f.Names) == 0 || f.Names[0].Name != "XMLName" {
+		return false
+	}
+	local := xmlLocalName(fieldTag(f))
+	return local != "" && unicode.IsLower(rune(local[0]))
+}
+
+// hasNillable reports whether a field of the package is Nillable.
+func (p *Package) hasNillable() bool {
+	for _, s := range p.Structs {
+		for _, f := range s.Fields {
+			if strings.HasPrefix(f.Type, "*Nillable[") {
+				return true
+			}
+		}
+	}
+	return false
+}
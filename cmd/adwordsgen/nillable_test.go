@@ -0,0 +1,136 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const nillableWSDL = `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/">
+<types>
+<schema xmlns="http://www.w3.org/2001/XMLSchema">
+<complexType name="Campaign">
+<sequence>
+<element name="id" type="xsd:long" minOccurs="0"/>
+<element name="trackingUrlTemplate" type="xsd:string" minOccurs="0" nillable="true"/>
+<element name="labels" type="Label" minOccurs="0" maxOccurs="unbounded" nillable="true"/>
+</sequence>
+</complexType>
+<element name="get">
+<complexType>
+<sequence>
+<element name="selector" type="Selector" minOccurs="0" nillable="true"/>
+</sequence>
+</complexType>
+</element>
+</schema>
+</types>
+</definitions>`
+
+const nillableSource = "package CampaignService\n\n" +
+	"type Campaign struct {\n" +
+	"\tXMLName xml.Name `xml:\"https://adwords.google.com/api/adwords/cm/v201802 Campaign\"`\n\n" +
+	"\tId *int64 `xml:\"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty\"`\n\n" +
+	"\tTrackingUrlTemplate *string `xml:\"https://adwords.google.com/api/adwords/cm/v201802 trackingUrlTemplate,omitempty\"`\n\n" +
+	"\tLabels []*Label `xml:\"https://adwords.google.com/api/adwords/cm/v201802 labels,omitempty\"`\n" +
+	"}\n\n" +
+	"type Get struct {\n" +
+	"\tXMLName xml.Name `xml:\"https://adwords.google.com/api/adwords/cm/v201802 get\"`\n\n" +
+	"\tSelector *Selector `xml:\"https://adwords.google.com/api/adwords/cm/v201802 selector,omitempty\"`\n" +
+	"}\n"
+
+func TestNillable(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "CampaignService.wsdl")
+	if err := ioutil.WriteFile(file, []byte(nillableWSDL), 0666); err != nil {
+		t.Fatal(err)
+	}
+	n, err := readNillable(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !n["Campaign"]["trackingUrlTemplate"] || n["Campaign"]["id"] || !n["Get"]["selector"] {
+		t.Fatalf("got nillable elements %v", n)
+	}
+	src, err := n.rewrite([]byte(nillableSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"\tId *int64 `",
+		"\tTrackingUrlTemplate *Nillable[string] `",
+		"\tLabels []*Label `",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("rewritten source lacks %q:\n%s", want, src)
+		}
+	}
+	// Operation elements and selectors are left alone.
+	if !strings.Contains(string(src), "\tSelector *Selector `") {
+		t.Errorf("rewritten source changed the selector of get:\n%s", src)
+	}
+	again, err := n.rewrite(src)
+	if err != nil || string(again) != string(src) {
+		t.Errorf("rewrite is not idempotent: %v\n%s", err, again)
+	}
+
+	if n, err := readNillable(filepath.Join(t.TempDir(), "missing.wsdl")); err != nil || n != nil {
+		t.Errorf("missing WSDL: got %v, %v", n, err)
+	}
+}
+
+// TestNillableGenerated generates the CampaignService of the committed tree
+// with a WSDL declaring some of its elements nillable.
+func TestNillableGenerated(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "CampaignService")
+	if err := os.Mkdir(dir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	src, err := ioutil.ReadFile("../../v201802/CampaignService/CampaignService.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "CampaignService.go"), src, 0666); err != nil {
+		t.Fatal(err)
+	}
+	wsdl := strings.Replace(nillableWSDL, `name="selector"`, `name="serviceSelector"`, 1)
+	if err = ioutil.WriteFile(filepath.Join(filepath.Dir(dir), "CampaignService.wsdl"), []byte(wsdl), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err = process(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err = ioutil.ReadFile(filepath.Join(dir, "CampaignService.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := &Package{Name: "CampaignService", Dir: dir, Source: src}
+	if err = pkg.parse(); err != nil {
+		t.Fatal(err)
+	}
+	types := map[string]string{}
+	for _, s := range []string{"Campaign", "Get"} {
+		for _, f := range pkg.Struct(s).Fields {
+			types[s+"."+f.Name] = f.Type
+		}
+	}
+	for field, want := range map[string]string{
+		"Campaign.TrackingUrlTemplate": "*Nillable[string]",
+		"Campaign.Id":                  "*int64",
+		"Campaign.FinalUrlSuffix":      "*string",
+		"Get.ServiceSelector":          "*Selector",
+	} {
+		if types[field] != want {
+			t.Errorf("%s has type %s, want %s", field, types[field], want)
+		}
+	}
+	optional, err := ioutil.ReadFile(filepath.Join(dir, "CampaignService_optional.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(optional), "func Nil[T any]() *Nillable[T] {") {
+		t.Errorf("Nillable is not generated:\n%s", optional)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+var opsTemplate = template.Must(template.New("ops").Parse(`
+{{- range .}}{{$o := .}}
+{{- range .Operators}}
+// {{.Func}} returns the {{.Value}} operation of operand.
+func {{.Func}}(operand *{{$o.Operand}}) *{{$o.Name}} {
+	op := {{.Const}}
+	return &{{$o.Name}}{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+{{end}}
+{{- end}}
+`))
+
+type opsOperation struct {
+	Name, Operand string
+	Operators     []*opsOperator
+}
+
+type opsOperator struct {
+	Func, Value, Const string
+}
+
+// emitOps generates constructors of the ADD, SET and REMOVE operations of
+// every operation type. The operation of Mutate gets AddOp, SetOp and
+// RemoveOp; the others are named after their type without the entity of
+// the package, e.g. AddLabelOp for AdGroupLabelOperation in the
+// AdGroupService. Only the operators listed as supported by the mutate
+// requests are generated.
+func emitOps(pkg *Package, buf *bytes.Buffer) (string, error) {
+	supported := make(map[string][]string)
+	var main string
+	for _, m := range pkg.Service.Methods {
+		req := pkg.Struct(m.Request)
+		if req == nil {
+			continue
+		}
+		for _, f := range req.Fields {
+			if f.Name != "Operations" || !strings.HasPrefix(f.Type, "[]*") {
+				continue
+			}
+			if m.Name == "Mutate" {
+				main = f.ElemType()
+			}
+			if m := listedOps.FindStringSubmatch(f.Constraints["SupportedOperators"]); m != nil {
+				supported[f.ElemType()] = splitOperators(m[1])
+			}
+		}
+	}
+
+	base := strings.TrimSuffix(pkg.Name, "Service")
+	var data []*opsOperation
+	names := make(map[string]string)
+	for _, s := range pkg.Structs {
+		path := pkg.operatorPath(s)
+		if len(path) != 2 || path[0] != "Operation" {
+			continue
+		}
+		o := &opsOperation{Name: s.Name}
+		for _, f := range s.Fields {
+			if f.Name == "Operand" && f.Type == "*"+f.ElemType() {
+				o.Operand = f.ElemType()
+			}
+		}
+		if o.Operand == "" {
+			continue
+		}
+		infix := ""
+		if s.Name != main {
+			infix = strings.TrimPrefix(strings.TrimSuffix(s.Name, "Operation"), base)
+		}
+		for _, value := range []string{"ADD", "SET", "REMOVE"} {
+			if !pkg.hasEnumValue("Operator", value) {
+				continue
+			}
+			if ops, ok := supported[s.Name]; ok && !contains(ops, value) {
+				continue
+			}
+			fn := strings.ToUpper(value[:1]) + strings.ToLower(value[1:]) + infix + "Op"
+			if other, ok := names[fn]; ok {
+				return "", fmt.Errorf("%s: name of %s conflicts with %s", fn, s.Name, other)
+			}
+			if pkg.Struct(fn) != nil || pkg.hasEnum(fn) {
+				return "", fmt.Errorf("%s: name conflicts with a type", fn)
+			}
+			names[fn] = s.Name
+			o.Operators = append(o.Operators, &opsOperator{Func: fn, Value: value, Const: "Operator" + value})
+		}
+		if len(o.Operators) > 0 {
+			data = append(data, o)
+		}
+	}
+	if len(data) == 0 {
+		return "ops", nil
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].Name < data[j].Name })
+	return "ops", opsTemplate.Execute(buf, data)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"text/template"
+)
+
+// optionalScalar matches struct fields that gowsdl generates as plain scalars.
+// Every element of the AdWords schemas is declared with minOccurs="0", so with
+// omitempty a zero value cannot be told apart from an absent element: false,
+// 0, 0.0 and "" were silently dropped from requests, so e.g. a tracking URL
+// template could not be cleared. Pointers keep the states apart: nil is
+// omitted, a pointer to the zero value is sent. Enum fields are pointers
+// already. Only schema elements, whose tags carry a namespace, are matched;
+// the fields of the SOAP envelope stay as they are.
+var optionalScalar = regexp.MustCompile("(?m)^(\t[A-Z][A-Za-z0-9_]*[ \t]+)(bool|int32|int64|float64|string)([ \t]+`xml:\"https?://)")
+
+func rewriteOptionalScalars(src []byte) ([]byte, error) {
+	return optionalScalar.ReplaceAll(src, []byte("$1*$2$3")), nil
+}
+
+var optionalTemplate = template.Must(template.New("optional").Parse(`
+{{- if .Nillable}}
+import (
+	"encoding/json"
+	"encoding/xml"
+)
+{{end}}
+{{range .Scalars}}
+// {{.Name}} returns a pointer to v, for setting optional {{.Type}} fields.
+func {{.Name}}(v {{.Type}}) *{{.Type}} {
+	return &v
+}
+
+// {{.Name}}Value returns the value p points to, or the zero value if p is nil.
+func {{.Name}}Value(p *{{.Type}}) {{.Type}} {
+	if p == nil {
+		return {{.Zero}}
+	}
+	return *p
+}
+{{end}}
+{{- if .Nillable}}
+// A Nillable is an element the schema declares nillable. A nil *Nillable
+// is omitted, one with Nil set is sent as xsi:nil="true", e.g. to clear a
+// value, and any other as its Value. In JSON, Nil is null.
+type Nillable[T any] struct {
+	Value T
+	Nil   bool
+}
+
+// NewNillable returns a Nillable sending v.
+func NewNillable[T any](v T) *Nillable[T] {
+	return &Nillable[T]{Value: v}
+}
+
+// Nil returns a Nillable sent as xsi:nil="true".
+func Nil[T any]() *Nillable[T] {
+	return &Nillable[T]{Nil: true}
+}
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// MarshalXML implements xml.Marshaler.
+func (n *Nillable[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.Nil {
+		return e.EncodeElement(n.Value, start)
+	}
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (n *Nillable[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, a := range start.Attr {
+		if a.Name.Space == xsiNamespace && a.Name.Local == "nil" && (a.Value == "true" || a.Value == "1") {
+			*n = Nillable[T]{Nil: true}
+			return d.Skip()
+		}
+	}
+	n.Nil = false
+	return d.DecodeElement(&n.Value, &start)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *Nillable[T]) MarshalJSON() ([]byte, error) {
+	if n.Nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Nillable[T]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*n = Nillable[T]{Nil: true}
+		return nil
+	}
+	n.Nil = false
+	return json.Unmarshal(b, &n.Value)
+}
+{{end}}
+`))
+
+// emitOptional generates the helpers for optional fields, and the Nillable
+// type if a field of the package needs it.
+func emitOptional(pkg *Package, buf *bytes.Buffer) (string, error) {
+	data := struct {
+		Scalars  []struct{ Name, Type, Zero string }
+		Nillable bool
+	}{
+		Scalars: []struct{ Name, Type, Zero string }{
+			{"Bool", "bool", "false"},
+			{"Int32", "int32", "0"},
+			{"Int64", "int64", "0"},
+			{"Float64", "float64", "0"},
+			{"String", "string", `""`},
+		},
+		Nillable: pkg.hasNillable(),
+	}
+	if data.Nillable {
+		for _, name := range []string{"Nillable", "NewNillable", "Nil"} {
+			if pkg.Struct(name) != nil || pkg.hasEnum(name) {
+				return "", fmt.Errorf("%s: name conflicts with a type", name)
+			}
+		}
+	}
+	return "optional", optionalTemplate.Execute(buf, data)
+}
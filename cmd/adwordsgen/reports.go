@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// catalogFile is the report field catalog read from the directory of the
+// package with GetReportFields. It is written by the reportfields command
+// to report.CatalogFile, which the generator can't import, as the report
+// package depends on the generated packages.
+const catalogFile = "reports.json"
+
+// reportsTemplate emits the row types and the enums of their fields.
+var reportsTemplate = template.Must(template.Must(enumsTemplate.Clone()).New("reports").Parse(`
+{{- if or .Time .Enums}}
+import (
+{{- if .Enums}}
+	"fmt"
+{{- end}}
+{{- if .Time}}
+	"time"
+{{- end}}
+)
+{{end}}
+{{- range .Rows}}
+// {{.Name}} is a row of the {{.Type}}.
+// Reports are decoded into it with report.Decode, and report.Columns lists
+// its fields.
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} ` + "`" + `report:"{{.Field}}" json:"{{.JSON}},omitempty"` + "`" + `
+{{- end}}
+}
+{{if .Const}}
+// ReportType returns {{.Type}}.
+func ({{.Name}}) ReportType() ReportDefinitionReportType {
+	return {{.Const}}
+}
+{{end}}
+{{- end}}
+{{- range .Enums}}
+// {{.Name}} holds the values of the {{.Field}} field of the
+// {{.Type}}.
+type {{.Name}} string
+
+const (
+{{- $enum := .}}
+{{- range .Values}}
+	{{.Const}} {{$enum.Name}} = "{{.Value}}"
+{{- end}}
+)
+{{template "enum" .Enum}}
+{{- end}}
+`))
+
+type reportRow struct {
+	Name, Type, Const string
+	Fields            []*reportColumn
+}
+
+type reportColumn struct {
+	Name, Type, Field, JSON string
+}
+
+// A reportEnum is the enum of a field of a report type.
+type reportEnum struct {
+	*Enum
+	Field, Type string
+}
+
+// catalogField is the part of a catalog field used by emitReports.
+type catalogField struct {
+	FieldName  string   `json:"fieldName"`
+	FieldType  string   `json:"fieldType"`
+	CanSelect  bool     `json:"canSelect"`
+	IsEnumType bool     `json:"isEnumType"`
+	EnumValues []string `json:"enumValues"`
+}
+
+// reportFieldTypes maps the report field types to the Go types the report
+// parser decodes them into. Other types are strings.
+var reportFieldTypes = map[string]string{
+	"Long":    "*int64",
+	"Integer": "*int64",
+	"Money":   "*int64",
+	"Bid":     "*int64",
+	"Double":  "*float64",
+	"Boolean": "*bool",
+	"Date":    "*time.Time",
+}
+
+// emitReports generates a row type of every report type of the catalog
+// next to the package with GetReportFields, e.g. KeywordsPerformanceRow
+// for the KEYWORDS_PERFORMANCE_REPORT. Nothing is generated without a
+// catalog.
+func emitReports(pkg *Package, buf *bytes.Buffer) (string, error) {
+	if pkg.Service.Method("GetReportFields") == nil {
+		return "reports", nil
+	}
+	b, err := ioutil.ReadFile(filepath.Join(pkg.Dir, catalogFile))
+	if os.IsNotExist(err) {
+		return "reports", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var catalog struct {
+		Reports map[string][]*catalogField `json:"reports"`
+	}
+	if err := json.Unmarshal(b, &catalog); err != nil {
+		return "", fmt.Errorf("%s: %v", catalogFile, err)
+	}
+
+	data := struct {
+		Time  bool
+		Rows  []*reportRow
+		Enums []*reportEnum
+	}{}
+	names := make(map[string]bool)
+	for reportType, fields := range catalog.Reports {
+		row := &reportRow{Name: rowName(reportType), Type: reportType}
+		if pkg.Struct(row.Name) != nil || pkg.hasEnum(row.Name) || names[row.Name] {
+			return "", fmt.Errorf("%s: name conflicts with a type", row.Name)
+		}
+		names[row.Name] = true
+		for _, e := range pkg.Enums {
+			for _, v := range e.Values {
+				if e.Name == "ReportDefinitionReportType" && v.Value == reportType {
+					row.Const = v.Const
+				}
+			}
+		}
+		for _, f := range fields {
+			if !f.CanSelect {
+				continue
+			}
+			if !token.IsExported(f.FieldName) || !token.IsIdentifier(f.FieldName) {
+				return "", fmt.Errorf("%s: field %q is not a Go identifier", reportType, f.FieldName)
+			}
+			typ, ok := reportFieldTypes[f.FieldType]
+			if !ok {
+				typ = "string"
+			}
+			if f.IsEnumType {
+				enum, err := reportEnumType(pkg, row, f)
+				if err != nil {
+					return "", err
+				}
+				typ = "string"
+				if enum != nil {
+					if names[enum.Name] {
+						return "", fmt.Errorf("%s: name conflicts with a type", enum.Name)
+					}
+					names[enum.Name] = true
+					typ = enum.Name
+					data.Enums = append(data.Enums, enum)
+				}
+			}
+			if typ == "*time.Time" {
+				data.Time = true
+			}
+			row.Fields = append(row.Fields, &reportColumn{
+				Name:  f.FieldName,
+				Type:  typ,
+				Field: f.FieldName,
+				JSON:  lowerFirst(f.FieldName),
+			})
+		}
+		data.Rows = append(data.Rows, row)
+	}
+	if len(data.Rows) == 0 {
+		return "reports", nil
+	}
+	sort.Slice(data.Rows, func(i, j int) bool { return data.Rows[i].Name < data.Rows[j].Name })
+	sort.Slice(data.Enums, func(i, j int) bool { return data.Enums[i].Name < data.Enums[j].Name })
+	return "reports", reportsTemplate.Execute(buf, data)
+}
+
+// rowName returns the row type name of a report type, e.g.
+// KeywordsPerformanceRow for KEYWORDS_PERFORMANCE_REPORT.
+func rowName(reportType string) string {
+	var name strings.Builder
+	for _, word := range strings.Split(strings.TrimSuffix(reportType, "_REPORT"), "_") {
+		if word == "" {
+			continue
+		}
+		name.WriteString(word[:1] + strings.ToLower(word[1:]))
+	}
+	return name.String() + "Row"
+}
+
+// reportEnumType returns the enum of the field f of a row, e.g.
+// KeywordsPerformanceStatus for the Status of the
+// KEYWORDS_PERFORMANCE_REPORT, or nil if the catalog has no values of f or
+// a value is no Go identifier.
+func reportEnumType(pkg *Package, row *reportRow, f *catalogField) (*reportEnum, error) {
+	if len(f.EnumValues) == 0 {
+		return nil, nil
+	}
+	enum := &reportEnum{
+		Enum:  &Enum{Name: strings.TrimSuffix(row.Name, "Row") + f.FieldName},
+		Field: f.FieldName,
+		Type:  row.Type,
+	}
+	if pkg.Struct(enum.Name) != nil || pkg.hasEnum(enum.Name) {
+		return nil, fmt.Errorf("%s: name conflicts with a type", enum.Name)
+	}
+	for _, v := range f.EnumValues {
+		c := enum.Name + v
+		if !token.IsIdentifier(c) {
+			return nil, nil
+		}
+		enum.Values = append(enum.Values, &EnumValue{Const: c, Value: v})
+	}
+	return enum, nil
+}
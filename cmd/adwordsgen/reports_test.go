@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEmitReports(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "ReportDefinitionService")
+	if err := os.Mkdir(dir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	src, err := ioutil.ReadFile("../../v201802/ReportDefinitionService/ReportDefinitionService.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	catalog, err := ioutil.ReadFile("../../report/testdata/reports.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, catalogFile), catalog, 0666); err != nil {
+		t.Fatal(err)
+	}
+	pkg := &Package{Name: "ReportDefinitionService", Dir: dir, Source: src}
+	if err = pkg.parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("package ReportDefinitionService\n\n")
+	if _, err = emitReports(pkg, buf); err != nil {
+		t.Fatal(err)
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("%v:\n%s", err, buf)
+	}
+	for _, want := range []string{
+		"type KeywordsPerformanceRow struct {",
+		"\tCost *int64 `report:\"Cost\" json:\"cost,omitempty\"`",
+		"\tStatus KeywordsPerformanceStatus `report:\"Status\" json:\"status,omitempty\"`",
+		"func (KeywordsPerformanceRow) ReportType() ReportDefinitionReportType {",
+		"\tKeywordsPerformanceStatusPAUSED KeywordsPerformanceStatus = \"PAUSED\"",
+		"type CampaignPerformanceRow struct {",
+	} {
+		if !strings.Contains(strings.Join(strings.Fields(string(out)), " "), strings.Join(strings.Fields(want), " ")) {
+			t.Errorf("generated rows lack %q:\n%s", want, out)
+		}
+	}
+
+	// Nothing is generated without a catalog.
+	if err = os.Remove(filepath.Join(dir, catalogFile)); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if _, err = emitReports(pkg, buf); err != nil || buf.Len() != 0 {
+		t.Errorf("got %q, %v without a catalog", buf, err)
+	}
+}
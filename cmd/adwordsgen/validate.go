@@ -0,0 +1,532 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var validateTemplate = template.Must(template.New("validate").Parse(`
+import "github.com/godofdream/go-googleadsinofficial/validate"
+{{range .Roots}}
+// Validate checks {{.}} against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *{{.}}) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+{{end}}
+{{- range .Structs}}
+func (t *{{.Name}}) validate(v *validate.Validator, path, op string) {
+{{- if or .Base .Checks}}
+	if t == nil {
+		t = new({{.Name}})
+	}
+{{- end}}
+{{- with .SetOp}}
+	{{.}}
+{{- end}}
+{{- with .Base}}
+	t.{{.}}.validate(v, path, op)
+{{- end}}
+{{- range .Checks}}
+	{{.}}
+{{- end}}
+}
+{{end}}
+{{- range .Operations}}
+func (o *{{.Name}}) operator() string {
+	if o == nil || !({{.Guard}}) {
+		return ""
+	}
+	return string(*o.{{.Operator}})
+}
+
+func (o *{{.Name}}) operandID() string {
+{{- if .IDs}}
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+{{- range .IDVars}}
+	{{.}}
+{{- end}}
+	return validate.ID({{.IDs}})
+{{- else}}
+	return ""
+{{- end}}
+}
+{{end}}
+`))
+
+type validateStruct struct {
+	Name   string
+	Base   string
+	SetOp  string
+	Checks []string
+}
+
+type validateOperation struct {
+	Name     string
+	Guard    string
+	Operator string
+	IDVars   []string
+	IDs      string
+}
+
+var (
+	sizeMin     = regexp.MustCompile(`minimum size of this collection is (\d+)`)
+	sizeMax     = regexp.MustCompile(`maximum size of this collection is (\d+)`)
+	rangeBounds = regexp.MustCompile(`between ([\d.E-]+) and ([\d.E-]+), inclusive`)
+	rangeMin    = regexp.MustCompile(`greater than or equal to ([\d.E-]+)`)
+	dateBounds  = regexp.MustCompile(`range \[(\d{8}), (\d{8})\]`)
+	listedOps   = regexp.MustCompile(`supported: (.*)\.$`)
+)
+
+// emitValidate generates Validate for the request and operation types. It
+// checks the constraint annotations of every field reachable from them.
+func emitValidate(pkg *Package, buf *bytes.Buffer) (string, error) {
+	for _, s := range pkg.Structs {
+		for _, f := range s.Fields {
+			switch f.Name {
+			case "Validate", "validate", "operator", "operandID":
+				return "", fmt.Errorf("%s.%s: field conflicts with a generated method", s.Name, f.Name)
+			}
+		}
+	}
+
+	var roots []string
+	for _, m := range pkg.Service.Methods {
+		if pkg.Struct(m.Request) != nil && !contains(roots, m.Request) {
+			roots = append(roots, m.Request)
+		}
+	}
+	for _, s := range pkg.Structs {
+		if strings.HasSuffix(s.Name, "Operation") && pkg.operatorPath(s) != nil && !contains(roots, s.Name) {
+			roots = append(roots, s.Name)
+		}
+	}
+	if len(roots) == 0 {
+		return "validate", nil
+	}
+
+	// A type reachable from a root needs a validate method if it has checks,
+	// is a root or holds or extends a type that needs one.
+	reachable := make(map[string]bool)
+	var reach func(name string)
+	reach = func(name string) {
+		s := pkg.Struct(name)
+		if s == nil || reachable[name] {
+			return
+		}
+		reachable[name] = true
+		reach(s.Base())
+		for _, f := range s.Fields {
+			if nestedStruct(f) {
+				reach(f.ElemType())
+			}
+		}
+	}
+	needs := make(map[string]bool)
+	for _, name := range roots {
+		reach(name)
+		needs[name] = true
+	}
+	for _, s := range pkg.Structs {
+		if !reachable[s.Name] {
+			continue
+		}
+		for _, f := range s.Fields {
+			if len(pkg.fieldChecks(s, f)) > 0 {
+				needs[s.Name] = true
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, s := range pkg.Structs {
+			if needs[s.Name] || !reachable[s.Name] {
+				continue
+			}
+			held := needs[s.Base()]
+			for _, f := range s.Fields {
+				held = held || needs[f.ElemType()] && nestedStruct(f)
+			}
+			if held {
+				needs[s.Name], changed = true, true
+			}
+		}
+	}
+
+	var data struct {
+		Roots      []string
+		Structs    []*validateStruct
+		Operations []*validateOperation
+	}
+	data.Roots = roots
+	operations := make(map[string]bool)
+	for _, s := range pkg.Structs {
+		if !needs[s.Name] {
+			continue
+		}
+		vs := &validateStruct{Name: s.Name}
+		if needs[s.Base()] {
+			vs.Base = s.Base()
+		}
+		if chain := pkg.operatorPath(s); chain != nil {
+			vs.SetOp = fmt.Sprintf("if %s {\n\t\top = string(*t.%s)\n\t}", guard("t", chain), strings.Join(chain, "."))
+			vs.Checks = append(vs.Checks, pkg.keyChecks(s)...)
+		}
+		for _, f := range s.Fields {
+			vs.Checks = append(vs.Checks, pkg.fieldChecks(s, f)...)
+			if needs[f.ElemType()] && nestedStruct(f) {
+				vs.Checks = append(vs.Checks, nestedCheck(f))
+			}
+			if op := pkg.Struct(f.ElemType()); op != nil && pkg.operatorPath(op) != nil && strings.HasPrefix(f.Type, "[]") {
+				if checks := operationListChecks(f); checks != "" {
+					vs.Checks = append(vs.Checks, checks)
+					operations[op.Name] = true
+				}
+			}
+		}
+		data.Structs = append(data.Structs, vs)
+	}
+	for _, s := range pkg.Structs {
+		if operations[s.Name] {
+			data.Operations = append(data.Operations, pkg.validateOperation(s))
+		}
+	}
+	return "validate", validateTemplate.Execute(buf, data)
+}
+
+// fieldChecks returns the statements checking the constraints and the
+// format of f, a field of s.
+func (p *Package) fieldChecks(s *Struct, f *Field) []string {
+	var checks []string
+	path := fmt.Sprintf("validate.Field(path, %q)", f.JSONName())
+	value := "t." + f.Name
+	slice := strings.HasPrefix(f.Type, "[]")
+	set := ""
+	switch {
+	case slice:
+		set = "len(" + value + ") > 0"
+	case strings.HasPrefix(f.Type, "*"):
+		set = value + " != nil"
+	}
+	withOps := func(ops []string, stmt string) string {
+		if ops == nil {
+			return stmt
+		}
+		return fmt.Sprintf("if validate.HasOperator(op, %s) {\n\t\t%s\n\t}", quoteList(ops), stmt)
+	}
+
+	for _, c := range []string{"Required", "ReadOnly", "NotEmpty", "NotEmptyForOperators", "CollectionSize",
+		"ContentsNotNull", "ContentsDistinct", "ContentsStringLength", "InRange", "StringLength", "DateRangeWithinRange"} {
+		text, ok := f.Constraints[c]
+		if !ok {
+			continue
+		}
+		switch c {
+		case "Required":
+			if set != "" {
+				checks = append(checks, withOps(f.Operators(c), fmt.Sprintf("v.Required(%s, %s)", path, set)))
+			}
+		case "ReadOnly":
+			// The API ignores read only fields, so they are only an error
+			// when an entity is added: a fetched entity must remain valid
+			// for SET.
+			if list := f.Operators(c); set != "" && (list == nil || contains(list, "ADD")) {
+				checks = append(checks, withOps([]string{"ADD"}, fmt.Sprintf("v.ReadOnly(%s, op, %s)", path, set)))
+			}
+		case "NotEmpty":
+			if slice {
+				checks = append(checks, fmt.Sprintf("v.NotEmpty(%s, %s)", path, value))
+			}
+		case "NotEmptyForOperators":
+			if slice {
+				checks = append(checks, withOps(f.Operators(c), fmt.Sprintf(
+					"if len(%s) == 0 {\n\t\t\tv.Report(%s, %q, \"collection must contain at least one element for operator %%s\", op)\n\t\t}",
+					value, path, c)))
+			}
+		case "CollectionSize":
+			if slice {
+				min, max := "0", "-1"
+				if m := sizeMin.FindStringSubmatch(text); m != nil {
+					min = m[1]
+				}
+				if m := sizeMax.FindStringSubmatch(text); m != nil {
+					max = m[1]
+				}
+				checks = append(checks, fmt.Sprintf("if %s {\n\t\tv.Size(%s, len(%s), %s, %s)\n\t}", set, path, value, min, max))
+			}
+		case "ContentsNotNull":
+			if strings.HasPrefix(f.Type, "[]*") {
+				checks = append(checks, fmt.Sprintf("v.ContentsNotNull(%s, %s)", path, value))
+			}
+		case "ContentsDistinct":
+			if slice {
+				checks = append(checks, fmt.Sprintf("v.ContentsDistinct(%s, %s)", path, value))
+			}
+		case "ContentsStringLength":
+			if f.Type == "[]string" {
+				checks = append(checks, fmt.Sprintf("v.ContentsStringLength(%s, %s)", path, value))
+			}
+		case "InRange":
+			if check := p.rangeCheck(f, path, value, text); check != "" {
+				checks = append(checks, check)
+			}
+		case "StringLength":
+			if f.Type == "*string" {
+				min, max := "1", "-1"
+				if m := rangeBounds.FindStringSubmatch(text); m != nil {
+					min, max = m[1], m[2]
+				}
+				checks = append(checks, fmt.Sprintf("if %s {\n\t\tv.StringLength(%s, *%s, %s, %s, %t, %t)\n\t}", set, path, value, min, max,
+					strings.Contains(text, "(trimmed)"), strings.Contains(text, "bytes")))
+			}
+		case "DateRangeWithinRange":
+			if m := dateBounds.FindStringSubmatch(text); m != nil && f.Type == "*DateRange" && stringRange(p.Struct("DateRange")) {
+				checks = append(checks, fmt.Sprintf("if %s != nil {\n\t\tv.DateRange(%s, StringValue(%s.Min), StringValue(%s.Max), %q, %q)\n\t}",
+					value, path, value, value, m[1], m[2]))
+			}
+		}
+	}
+	switch timeField(s, f) {
+	case dateFormat:
+		checks = append(checks, fmt.Sprintf("if %s {\n\t\tv.Date(%s, *%s)\n\t}", set, path, value))
+	case dateTimeFormat:
+		checks = append(checks, fmt.Sprintf("if %s {\n\t\tv.DateTime(%s, *%s)\n\t}", set, path, value))
+	}
+	return checks
+}
+
+// keyChecks returns the checks that the SET operation s identifies its
+// operand by the ids Diff copies into it, see keyID. Ids annotated as
+// required are checked by the operand.
+func (p *Package) keyChecks(s *Struct) []string {
+	var operand *Struct
+	for _, f := range s.Fields {
+		if f.Name == "Operand" && strings.HasPrefix(f.Type, "*") {
+			operand = p.Struct(f.ElemType())
+		}
+	}
+	if operand == nil || !p.hasEnumValue("Operator", "SET") {
+		return nil
+	}
+	var checks []string
+	for _, f := range p.AllFields(operand) {
+		if !keyID(operand, f) || requiredForSet(f) || !strings.HasPrefix(f.Type, "*") {
+			continue
+		}
+		path := append([]string{"Operand"}, p.fieldPath(operand, f.Name)...)
+		checks = append(checks, fmt.Sprintf("if validate.HasOperator(op, \"SET\") {\n\t\tv.Required(validate.Field(validate.Field(path, \"operand\"), %q), %s)\n\t}",
+			f.JSONName(), guard("t", path)))
+	}
+	return checks
+}
+
+// rangeCheck returns the InRange check of a numeric field, or of the micro
+// amount of a Money field.
+func (p *Package) rangeCheck(f *Field, path, value, text string) string {
+	switch f.Type {
+	case "*int32", "*int64", "*float64":
+	case "*Money":
+		path = fmt.Sprintf("validate.Field(%s, \"microAmount\")", path)
+		value += ".MicroAmount"
+		return wrapRange(fmt.Sprintf("t.%s != nil && %s != nil", f.Name, value), path, value, text)
+	default:
+		return ""
+	}
+	return wrapRange(value+" != nil", path, value, text)
+}
+
+func wrapRange(cond, path, value, text string) string {
+	for _, bound := range []*regexp.Regexp{rangeBounds, rangeMin} {
+		m := bound.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		for _, n := range m[1:] {
+			if _, err := strconv.ParseFloat(n, 64); err != nil {
+				return ""
+			}
+		}
+		if len(m) == 3 {
+			return fmt.Sprintf("if %s {\n\t\tv.Range(%s, float64(*%s), %s, %s)\n\t}", cond, path, value, m[1], m[2])
+		}
+		return fmt.Sprintf("if %s {\n\t\tv.AtLeast(%s, float64(*%s), %s)\n\t}", cond, path, value, m[1])
+	}
+	return ""
+}
+
+// nestedStruct reports whether f holds other structs by pointer.
+func nestedStruct(f *Field) bool {
+	return strings.HasPrefix(f.Type, "*") || strings.HasPrefix(f.Type, "[]*")
+}
+
+// nestedCheck returns the statement validating the structs held by f.
+func nestedCheck(f *Field) string {
+	path := fmt.Sprintf("validate.Field(path, %q)", f.JSONName())
+	if strings.HasPrefix(f.Type, "[]") {
+		return fmt.Sprintf("for i, e := range t.%s {\n\t\tif e != nil {\n\t\t\te.validate(v, validate.Index(%s, i), op)\n\t\t}\n\t}", f.Name, path)
+	}
+	return fmt.Sprintf("if t.%s != nil {\n\t\tt.%s.validate(v, %s, op)\n\t}", f.Name, f.Name, path)
+}
+
+// operationListChecks returns the SupportedOperators and DistinctIds checks
+// of a list of operations, or "" if it has neither.
+func operationListChecks(f *Field) string {
+	supported, distinct := f.Constraints["SupportedOperators"], f.Constraints["DistinctIds"]
+	if supported == "" && distinct == "" {
+		return ""
+	}
+	path := fmt.Sprintf("validate.Field(path, %q)", f.JSONName())
+	var b strings.Builder
+	fmt.Fprintf(&b, "if %s := t.%s; len(%s) > 0 {\n", "ops", f.Name, "ops")
+	b.WriteString("\t\toperators, ids := make([]string, len(ops)), make([]string, len(ops))\n")
+	b.WriteString("\t\tfor i, o := range ops {\n")
+	b.WriteString("\t\t\toperators[i], ids[i] = o.operator(), o.operandID()\n")
+	if m := listedOps.FindStringSubmatch(supported); m != nil {
+		fmt.Fprintf(&b, "\t\t\tv.SupportedOperator(validate.Index(%s, i), operators[i], %s)\n", path, quoteList(splitOperators(m[1])))
+	}
+	b.WriteString("\t\t}\n")
+	if distinct != "" {
+		fmt.Fprintf(&b, "\t\tv.DistinctIds(%s, operators, ids, %s)\n", path, quoteList(f.Operators("DistinctIds")))
+	}
+	b.WriteString("\t}")
+	return b.String()
+}
+
+// validateOperation returns the accessors DistinctIds and SupportedOperators
+// use on the operation type s. The operand is identified by its own ids
+// (Id, AdGroupId, ...) and the Id of the entities it holds, e.g.
+// AdGroupAd.AdGroupId and AdGroupAd.Ad.Id.
+func (p *Package) validateOperation(s *Struct) *validateOperation {
+	chain := p.operatorPath(s)
+	op := &validateOperation{Name: s.Name, Guard: guard("o", chain), Operator: strings.Join(chain, ".")}
+
+	var operand *Struct
+	for _, f := range s.Fields {
+		if f.Name == "Operand" && strings.HasPrefix(f.Type, "*") {
+			operand = p.Struct(f.ElemType())
+		}
+	}
+	if operand == nil {
+		return op
+	}
+	if path := p.fieldPath(operand, "Id"); path != nil && p.fieldType(operand, "Id") == "*int64" {
+		// Entities with an Id are identified by it alone.
+		op.IDs = p.idExpr(op, "id", path)
+		return op
+	}
+	var ids []string
+	for _, f := range p.AllFields(operand) {
+		if _, ok := f.Constraints["ReadOnly"]; ok && f.Operators("ReadOnly") == nil {
+			// Set by the API only, e.g. BaseCampaignId.
+			continue
+		}
+		path := p.fieldPath(operand, f.Name)
+		switch {
+		case f.Type == "*int64" && strings.HasSuffix(f.Name, "Id"):
+			ids = append(ids, p.idExpr(op, lowerFirst(f.Name), path))
+		case strings.HasPrefix(f.Type, "*"):
+			held := p.Struct(f.ElemType())
+			if held == nil || p.fieldType(held, "Id") != "*int64" {
+				continue
+			}
+			full := append(append([]string(nil), path...), p.fieldPath(held, "Id")...)
+			ids = append(ids, p.idExpr(op, lowerFirst(f.Name)+"ID", full))
+		}
+	}
+	op.IDs = strings.Join(ids, ", ")
+	return op
+}
+
+// idExpr returns the expression of the id at path below the operand t. Ids
+// behind embedded or held pointers are copied into a variable named name
+// first.
+func (p *Package) idExpr(op *validateOperation, name string, path []string) string {
+	if len(path) == 1 {
+		return "t." + path[0]
+	}
+	op.IDVars = append(op.IDVars, fmt.Sprintf("var %s *int64\n\tif %s {\n\t\t%s = t.%s\n\t}",
+		name, guard("t", path[:len(path)-1]), name, strings.Join(path, ".")))
+	return name
+}
+
+// operatorPath returns the selector path to the Operator field of s through
+// its embedded bases, e.g. [Operation Operator], or nil if s has none.
+func (p *Package) operatorPath(s *Struct) []string {
+	path := p.fieldPath(s, "Operator")
+	if path == nil || p.fieldType(s, "Operator") != "*Operator" {
+		return nil
+	}
+	return path
+}
+
+// fieldPath returns the selector path to the field name of s, including the
+// embedded bases it is inherited from, or nil if s has no such field.
+func (p *Package) fieldPath(s *Struct, name string) []string {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return []string{name}
+		}
+	}
+	if base := p.Struct(s.Base()); base != nil {
+		if path := p.fieldPath(base, name); path != nil {
+			return append([]string{base.Name}, path...)
+		}
+	}
+	return nil
+}
+
+// fieldType returns the type of the field name of s or its bases.
+func (p *Package) fieldType(s *Struct, name string) string {
+	for _, f := range p.AllFields(s) {
+		if f.Name == name {
+			return f.Type
+		}
+	}
+	return ""
+}
+
+// guard returns the condition under which every pointer on the selector path
+// below v, including the last one, is non-nil.
+func guard(v string, path []string) string {
+	var conds []string
+	for i := range path {
+		conds = append(conds, v+"."+strings.Join(path[:i+1], ".")+" != nil")
+	}
+	return strings.Join(conds, " && ")
+}
+
+func splitOperators(s string) []string {
+	var ops []string
+	for _, op := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if op != "and" {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+func quoteList(list []string) string {
+	quoted := make([]string, len(list))
+	for i, s := range list {
+		quoted[i] = strconv.Quote(s)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// headerElements maps the header types to the element names the API expects;
+// gowsdl names the elements after their types.
+var headerElements = map[string]string{
+	"SoapHeader":         "RequestHeader",
+	"SoapResponseHeader": "ResponseHeader",
+}
+
+// rewriteXMLNames fixes the XML names of the schema types. gowsdl tags every
+// complex type with its type name, which encoding/xml rejects as soon as the
+// type is used by a field of another name ("name "selector" in tag of
+// Get.Selector conflicts with name "Selector" in Selector.XMLName"), so no
+// request with a nested type could be encoded.
+//
+// Only the operation elements (get, mutateResponse, ...) and the SOAP
+// headers are roots and keep their XMLName. All other types take the name of
+// the field holding them and lose their XMLName: encoding/xml stores the
+// element of a decoded value in it and prefers it over the field, so a
+// fetched entity reused as an operand would be sent as <entries>.
+//
+// As the schemas are elementFormDefault="qualified", every field is
+// qualified with the namespace of the type declaring it, which also holds
+// for fields inherited from a type of another namespace.
+func rewriteXMLNames(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	type edit struct {
+		offset int
+		end    int
+		text   string
+	}
+	var edits []edit
+	replaceTag := func(f *ast.Field, tag string) {
+		if tag == "" {
+			edits = append(edits, edit{
+				offset: fset.Position(f.Type.End()).Offset,
+				end:    fset.Position(f.Tag.End()).Offset,
+			})
+			return
+		}
+		edits = append(edits, edit{
+			offset: fset.Position(f.Tag.Pos()).Offset,
+			end:    fset.Position(f.Tag.End()).Offset,
+			text:   "`" + tag + "`",
+		})
+	}
+
+	// removeField removes f up to the next field or the end of the struct.
+	removeField := func(f *ast.Field) {
+		end := fset.Position(f.End()).Offset
+		for end < len(src) && strings.IndexByte(" \t\n", src[end]) >= 0 {
+			end++
+		}
+		edits = append(edits, edit{offset: fset.Position(f.Pos()).Offset, end: end})
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok || len(st.Fields.List) == 0 {
+			return false
+		}
+		f := st.Fields.List[0]
+		if len(f.Names) == 0 || f.Names[0].Name != "XMLName" || f.Tag == nil {
+			return false
+		}
+		tag := fieldTag(f)
+		if _, ok := tag.Lookup("xml"); !ok {
+			// Left untagged by an earlier version of this rewrite.
+			removeField(f)
+			return false
+		}
+		xmlTag := strings.Fields(tag.Get("xml"))
+		if len(xmlTag) != 2 || !strings.HasPrefix(xmlTag[0], schemaPrefix) {
+			// Not a schema type, or already rewritten.
+			return false
+		}
+		ns, local := xmlTag[0], xmlTag[1]
+
+		switch {
+		case headerElements[ts.Name.Name] != "":
+			replaceTag(f, withTag(tag, "xml", ns+" "+headerElements[ts.Name.Name]))
+		case unicode.IsLower(rune(local[0])):
+		default:
+			removeField(f)
+		}
+
+		for _, f := range st.Fields.List[1:] {
+			tag := fieldTag(f)
+			if len(f.Names) == 0 || tag.Get("xml") == "" || strings.Contains(tag.Get("xml"), " ") {
+				continue
+			}
+			replaceTag(f, withTag(tag, "xml", ns+" "+tag.Get("xml")))
+		}
+		return false
+	})
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].offset > edits[j].offset })
+	for _, e := range edits {
+		src = append(src[:e.offset], append([]byte(e.text), src[e.end:]...)...)
+	}
+	return src, nil
+}
+
+// withTag returns tag with the value of key replaced, or removed if value is
+// empty. The order of the other keys is kept.
+func withTag(tag reflect.StructTag, key, value string) string {
+	var parts []string
+	for _, k := range []string{"xml", "json"} {
+		v, ok := tag.Lookup(k)
+		if k == key {
+			v, ok = value, value != ""
+		}
+		if ok {
+			parts = append(parts, k+":"+strconv.Quote(v))
+		}
+	}
+	return strings.Join(parts, " ")
+}
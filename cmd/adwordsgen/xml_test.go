@@ -0,0 +1,51 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const xmlNamesSource = "package BudgetService\n\n" +
+	"type Get struct {\n" +
+	"\tXMLName xml.Name `xml:\"https://adwords.google.com/api/adwords/cm/v201802 get\"`\n\n" +
+	"\tSelector *Selector `xml:\"serviceSelector,omitempty\"`\n" +
+	"}\n\n" +
+	"type Budget struct {\n" +
+	"\tXMLName xml.Name `xml:\"https://adwords.google.com/api/adwords/cm/v201802 Budget\"`\n\n" +
+	"\t// The ID.\n" +
+	"\tBudgetId *int64 `xml:\"budgetId,omitempty\"`\n" +
+	"}\n\n" +
+	"type Old struct {\n" +
+	"\tXMLName xml.Name `json:\"-\"`\n\n" +
+	"\tName *string `xml:\"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty\" json:\"name,omitempty\"`\n" +
+	"}\n\n" +
+	"type SoapHeader struct {\n" +
+	"\tXMLName xml.Name `xml:\"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader\"`\n" +
+	"}\n\n" +
+	"type SOAPEnvelope struct {\n" +
+	"\tXMLName xml.Name `xml:\"http://schemas.xmlsoap.org/soap/envelope/ Envelope\"`\n" +
+	"}\n"
+
+func TestRewriteXMLNames(t *testing.T) {
+	src, err := rewriteXMLNames([]byte(xmlNamesSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(src)
+	for _, want := range []string{
+		"\tXMLName xml.Name `xml:\"https://adwords.google.com/api/adwords/cm/v201802 get\"`",
+		"\tSelector *Selector `xml:\"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty\"`",
+		"type Budget struct {\n\t// The ID.\n\tBudgetId *int64 `xml:\"https://adwords.google.com/api/adwords/cm/v201802 budgetId,omitempty\"`",
+		"type Old struct {\n\tName *string",
+		"\tXMLName xml.Name `xml:\"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader\"`",
+		"\tXMLName xml.Name `xml:\"http://schemas.xmlsoap.org/soap/envelope/ Envelope\"`",
+	} {
+		if !strings.Contains(s, want) {
+			t.Errorf("rewritten source lacks %q:\n%s", want, s)
+		}
+	}
+	again, err := rewriteXMLNames(src)
+	if err != nil || string(again) != s {
+		t.Errorf("rewrite is not idempotent: %v\n%s", err, again)
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Change kinds.
+const (
+	Added   = "added"
+	Removed = "removed"
+	Changed = "changed"
+)
+
+// A Change is a single difference between two versions. Changes to types
+// that are generated into several service packages are reported once with
+// all affected services.
+type Change struct {
+	Kind string `json:"kind"`
+
+	// What names the changed element: "service", "method", "type", "field"
+	// or "enum value".
+	What string `json:"what"`
+
+	// Name is the qualified name of the element, e.g. "Campaign.Status".
+	Name   string `json:"name"`
+	Detail string `json:"detail,omitempty"`
+
+	// Breaking is set for changes that can break existing callers.
+	Breaking bool     `json:"breaking"`
+	Services []string `json:"services"`
+}
+
+// diff returns the changes from one version to another.
+func diff(from, to *Version) []*Change {
+	d := &differ{changes: make(map[string]*Change)}
+	for _, name := range keys(from.Services, to.Services) {
+		o, n := from.Services[name], to.Services[name]
+		switch {
+		case o == nil:
+			d.add(name, Added, "service", name, "", false)
+		case n == nil:
+			d.add(name, Removed, "service", name, "", true)
+		default:
+			d.service(o, n)
+		}
+	}
+
+	list := make([]*Change, 0, len(d.changes))
+	for _, c := range d.changes {
+		sort.Strings(c.Services)
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.What != b.What {
+			return whatOrder[a.What] < whatOrder[b.What]
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Kind+a.Detail < b.Kind+b.Detail
+	})
+	return list
+}
+
+var whatOrder = map[string]int{"service": 0, "method": 1, "type": 2, "field": 3, "enum value": 4}
+
+type differ struct {
+	changes map[string]*Change
+}
+
+func (d *differ) add(service, kind, what, name, detail string, breaking bool) {
+	key := strings.Join([]string{kind, what, name, detail}, "\x00")
+	c := d.changes[key]
+	if c == nil {
+		c = &Change{Kind: kind, What: what, Name: name, Detail: detail, Breaking: breaking}
+		d.changes[key] = c
+	}
+	c.Services = append(c.Services, service)
+}
+
+func (d *differ) service(o, n *Service) {
+	for _, name := range keys(o.Methods, n.Methods) {
+		om, nm := o.Methods[name], n.Methods[name]
+		qualified := o.Name + "." + name
+		switch {
+		case om == nil:
+			d.add(o.Name, Added, "method", qualified, "", false)
+		case nm == nil:
+			d.add(o.Name, Removed, "method", qualified, "", true)
+		case om.Request != nm.Request || om.Response != nm.Response:
+			d.add(o.Name, Changed, "method", qualified,
+				fmt.Sprintf("(%s) %s -> (%s) %s", om.Request, om.Response, nm.Request, nm.Response), true)
+		}
+	}
+
+	for _, name := range keys(o.Types, n.Types) {
+		ot, nt := o.Types[name], n.Types[name]
+		switch {
+		case ot == nil:
+			d.add(o.Name, Added, "type", name, "", false)
+		case nt == nil:
+			d.add(o.Name, Removed, "type", name, "", true)
+		case ot.IsEnum() != nt.IsEnum():
+			d.add(o.Name, Changed, "type", name, "changed between enum and struct", true)
+		case ot.IsEnum():
+			d.enum(o.Name, ot, nt)
+		default:
+			d.fields(o.Name, ot, nt)
+		}
+	}
+}
+
+func (d *differ) enum(service string, o, n *Type) {
+	in := func(values []string, v string) bool {
+		i := sort.SearchStrings(values, v)
+		return i < len(values) && values[i] == v
+	}
+	for _, v := range o.Values {
+		if !in(n.Values, v) {
+			d.add(service, Removed, "enum value", o.Name+"."+v, "", true)
+		}
+	}
+	for _, v := range n.Values {
+		if !in(o.Values, v) {
+			d.add(service, Added, "enum value", o.Name+"."+v, "", false)
+		}
+	}
+}
+
+func (d *differ) fields(service string, o, n *Type) {
+	if o.Base != n.Base {
+		d.add(service, Changed, "type", o.Name, fmt.Sprintf("base %q -> %q", o.Base, n.Base), true)
+	}
+	for _, name := range keys(o.Fields, n.Fields) {
+		of, nf := o.Fields[name], n.Fields[name]
+		qualified := o.Name + "." + name
+		switch {
+		case of == nil:
+			d.add(service, Added, "field", qualified, nf.Type, nf.Required != "")
+		case nf == nil:
+			d.add(service, Removed, "field", qualified, of.Type, true)
+		default:
+			if of.Type != nf.Type {
+				d.add(service, Changed, "field", qualified, fmt.Sprintf("type %s -> %s", of.Type, nf.Type), true)
+			}
+			if of.XMLName != nf.XMLName {
+				d.add(service, Changed, "field", qualified, fmt.Sprintf("element %q -> %q", of.XMLName, nf.XMLName), true)
+			}
+			if of.Required != nf.Required {
+				d.add(service, Changed, "field", qualified, constraintDetail("Required", of.Required, nf.Required), nf.Required != "")
+			}
+			if of.ReadOnly != nf.ReadOnly {
+				d.add(service, Changed, "field", qualified, constraintDetail("ReadOnly", of.ReadOnly, nf.ReadOnly), nf.ReadOnly != "")
+			}
+		}
+	}
+}
+
+func constraintDetail(name, o, n string) string {
+	switch {
+	case o == "":
+		return fmt.Sprintf("now %s: %s", name, n)
+	case n == "":
+		return fmt.Sprintf("no longer %s", name)
+	}
+	return fmt.Sprintf("%s: %s -> %s", name, o, n)
+}
+
+// keys returns the sorted union of the keys of a and b.
+func keys[V any](a, b map[string]V) []string {
+	seen := make(map[string]bool)
+	var list []string
+	for _, m := range []map[string]V{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				list = append(list, k)
+			}
+		}
+	}
+	sort.Strings(list)
+	return list
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// load returns the fixture trees of testdata, whose v201806 differs from
+// v201802 in every kind of change.
+func load(t *testing.T) (*Version, *Version) {
+	from, err := loadVersion("testdata/v201802")
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := loadVersion("testdata/v201806")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return from, to
+}
+
+func TestDiff(t *testing.T) {
+	from, to := load(t)
+	var got []string
+	for _, c := range diff(from, to) {
+		got = append(got, fmt.Sprintf("%s %s %s %q %v %v", c.Kind, c.What, c.Name, c.Detail, c.Breaking, c.Services))
+	}
+	want := []string{
+		`added service CampaignService "" false [CampaignService]`,
+		`removed service LabelService "" true [LabelService]`,
+		`removed method BudgetService.Mutate "" true [BudgetService]`,
+		`added method BudgetService.Query "" false [BudgetService]`,
+		`added type Money "" false [BudgetService]`,
+		`removed type Mutate "" true [BudgetService]`,
+		`removed type MutateResponse "" true [BudgetService]`,
+		`added type Query "" false [BudgetService]`,
+		`added type QueryResponse "" false [BudgetService]`,
+		`changed field Budget.Amount "type *int64 -> *Money" true [BudgetService]`,
+		`changed field Budget.BudgetId "now ReadOnly: This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD." true [BudgetService]`,
+		`added field Budget.DeliveryMethod "*string" true [BudgetService]`,
+		`changed field Budget.Name "no longer Required" false [BudgetService]`,
+		`removed field Budget.Period "*string" true [BudgetService]`,
+		`removed enum value BudgetStatus.DELETED "" true [BudgetService]`,
+		`added enum value BudgetStatus.PAUSED "" false [BudgetService]`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got changes\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDiffSame(t *testing.T) {
+	from, _ := load(t)
+	if changes := diff(from, from); len(changes) != 0 {
+		t.Errorf("got %d changes between the same versions", len(changes))
+	}
+}
+
+func TestLoadVersion(t *testing.T) {
+	from, _ := load(t)
+	s := from.Services["BudgetService"]
+	if s == nil {
+		t.Fatalf("got services %v", from.Services)
+	}
+	// The client and the SOAP types are no schema types, and AddHeader is
+	// no operation.
+	for _, name := range []string{"BudgetServiceInterface", "SOAPClient"} {
+		if s.Types[name] != nil {
+			t.Errorf("loaded %s as a type", name)
+		}
+	}
+	if len(s.Methods) != 2 || s.Methods["Get"].Request != "*Get" || s.Methods["Get"].Response != "*GetResponse" {
+		t.Errorf("got methods %v", s.Methods)
+	}
+	status := s.Types["BudgetStatus"]
+	if status == nil || strings.Join(status.Values, ",") != "DELETED,ENABLED,REMOVED" {
+		t.Errorf("got enum %+v", status)
+	}
+	name := s.Types["Budget"].Fields["Name"]
+	if name.XMLName != "name" || name.Required == "" || name.ReadOnly != "" {
+		t.Errorf("got field %+v", name)
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	from, to := load(t)
+	var b strings.Builder
+	if err := writeMarkdown(&b, from.Name, to.Name, diff(from, to)); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# Changes from v201802 to v201806\n",
+		"\n## Services\n\n- added `CampaignService`\n- removed `LabelService` **(breaking)**\n",
+		"\n## Fields\n\n- changed `Budget.Amount`: type *int64 -> *Money **(breaking)** (BudgetService)\n",
+		"- changed `Budget.Name`: no longer Required (BudgetService)\n",
+		"\n## Enum values\n\n- removed `BudgetStatus.DELETED` **(breaking)** (BudgetService)\n",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("changelog lacks %q:\n%s", want, b.String())
+		}
+	}
+
+	b.Reset()
+	if err := writeMarkdown(&b, from.Name, from.Name, nil); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "# Changes from v201802 to v201802\n\nNo changes.\n" {
+		t.Errorf("got changelog %q", b.String())
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/godofdream/go-googleadsinofficial/cmd/internal/constraints"
+	"github.com/godofdream/go-googleadsinofficial/cmd/internal/schema"
+)
+
+// A Version is the generated tree of one API version, e.g. v201802.
+type Version struct {
+	Name     string
+	Services map[string]*Service
+}
+
+// A Service is one generated service package.
+type Service struct {
+	Name    string
+	Methods map[string]*Method
+	Types   map[string]*Type
+}
+
+// A Method is an operation of the service client.
+type Method struct {
+	Name     string
+	Request  string
+	Response string
+}
+
+// A Type is a struct or an enum of a service package.
+type Type struct {
+	Name   string
+	Base   string
+	Fields map[string]*Field
+
+	// Values holds the values of an enum; it is nil for structs.
+	Values []string
+}
+
+// IsEnum reports whether t is an enum.
+func (t *Type) IsEnum() bool {
+	return t.Values != nil
+}
+
+// A Field is an element of a struct Type.
+type Field struct {
+	Name    string
+	Type    string
+	XMLName string
+
+	// Required and ReadOnly hold the text of the constraint, e.g. "This
+	// field is required and should not be null." or "" if the field has no
+	// such constraint.
+	Required string
+	ReadOnly string
+}
+
+// loadVersion parses every service package below dir.
+func loadVersion(dir string) (*Version, error) {
+	v := &Version{Name: filepath.Base(dir), Services: make(map[string]*Service)}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		file := filepath.Join(dir, e.Name(), e.Name()+".go")
+		s, err := loadService(e.Name(), file)
+		if err != nil {
+			return nil, err
+		}
+		v.Services[s.Name] = s
+	}
+	return v, nil
+}
+
+func loadService(name, file string) (*Service, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{Name: name, Methods: make(map[string]*Method), Types: make(map[string]*Type)}
+	client := name + "Interface"
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil || types.ExprString(decl.Recv.List[0].Type) != "*"+client || !decl.Name.IsExported() {
+				continue
+			}
+			params, results := decl.Type.Params.List, decl.Type.Results
+			if len(params) != 1 || results == nil || len(results.List) != 2 {
+				// AddHeader and SetHeader.
+				continue
+			}
+			s.Methods[decl.Name.Name] = &Method{
+				Name:     decl.Name.Name,
+				Request:  types.ExprString(params[0].Type),
+				Response: types.ExprString(results.List[0].Type),
+			}
+		case *ast.GenDecl:
+			switch decl.Tok {
+			case token.TYPE:
+				for _, spec := range decl.Specs {
+					ts := spec.(*ast.TypeSpec)
+					switch t := ts.Type.(type) {
+					case *ast.Ident:
+						if t.Name == "string" {
+							s.Types[ts.Name.Name] = &Type{Name: ts.Name.Name, Values: []string{}}
+						}
+					case *ast.StructType:
+						if typ := loadStruct(ts.Name.Name, t); typ != nil {
+							s.Types[typ.Name] = typ
+						}
+					}
+				}
+			case token.CONST:
+				for _, spec := range decl.Specs {
+					vs := spec.(*ast.ValueSpec)
+					ident, ok := vs.Type.(*ast.Ident)
+					if !ok || s.Types[ident.Name] == nil || len(vs.Values) != 1 {
+						continue
+					}
+					if lit, ok := vs.Values[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						value, _ := strconv.Unquote(lit.Value)
+						s.Types[ident.Name].Values = append(s.Types[ident.Name].Values, value)
+					}
+				}
+			}
+		}
+	}
+	for _, t := range s.Types {
+		sort.Strings(t.Values)
+	}
+	return s, nil
+}
+
+// loadStruct returns the schema type t, or nil for the SOAP envelope types.
+func loadStruct(name string, t *ast.StructType) *Type {
+	if !schema.IsType(t) {
+		return nil
+	}
+	typ := &Type{Name: name, Fields: make(map[string]*Field)}
+	for _, f := range t.Fields.List {
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			s, _ := strconv.Unquote(f.Tag.Value)
+			tag = reflect.StructTag(s)
+		}
+		if len(f.Names) == 0 {
+			typ.Base = strings.TrimPrefix(types.ExprString(f.Type), "*")
+			continue
+		}
+		if f.Names[0].Name == "XMLName" {
+			continue
+		}
+
+		field := &Field{Name: f.Names[0].Name, Type: types.ExprString(f.Type)}
+		field.XMLName = strings.Split(tag.Get("xml"), ",")[0]
+		if i := strings.LastIndexByte(field.XMLName, ' '); i >= 0 {
+			field.XMLName = field.XMLName[i+1:]
+		}
+		c := constraints.Parse(f.Doc.Text())
+		field.Required, field.ReadOnly = c["Required"], c["ReadOnly"]
+		typ.Fields[field.Name] = field
+	}
+	return typ
+}
@@ -0,0 +1,117 @@
+// Command apidiff compares the generated packages of two API versions and
+// writes a changelog of added and removed services, methods, types, fields
+// and enum values, changed field types and changed Required or ReadOnly
+// constraints.
+//
+// Usage:
+//
+//	apidiff [-format markdown|json] [-breaking] v201802 v201806
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("apidiff: ")
+
+	format := flag.String("format", "markdown", "output format: markdown or json")
+	breaking := flag.Bool("breaking", false, "only report changes that can break existing callers")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: apidiff [flags] olddir newdir")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	from, err := loadVersion(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	to, err := loadVersion(flag.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	changes := diff(from, to)
+	if *breaking {
+		var list []*Change
+		for _, c := range changes {
+			if c.Breaking {
+				list = append(list, c)
+			}
+		}
+		changes = list
+	}
+
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		err = enc.Encode(struct {
+			Old     string    `json:"old"`
+			New     string    `json:"new"`
+			Changes []*Change `json:"changes"`
+		}{from.Name, to.Name, changes})
+	case "markdown":
+		err = writeMarkdown(os.Stdout, from.Name, to.Name, changes)
+	default:
+		log.Fatalf("unknown format %q", *format)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+var headings = map[string]string{
+	"service":    "Services",
+	"method":     "Methods",
+	"type":       "Types",
+	"field":      "Fields",
+	"enum value": "Enum values",
+}
+
+// writeMarkdown writes the changes grouped by the kind of element.
+func writeMarkdown(w io.Writer, from, to string, changes []*Change) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Changes from %s to %s\n", from, to)
+	if len(changes) == 0 {
+		b.WriteString("\nNo changes.\n")
+	}
+
+	what := ""
+	for _, c := range changes {
+		if c.What != what {
+			what = c.What
+			fmt.Fprintf(&b, "\n## %s\n\n", headings[what])
+		}
+		line := fmt.Sprintf("- %s `%s`", c.Kind, c.Name)
+		if c.Detail != "" {
+			line += ": " + c.Detail
+		}
+		if c.Breaking {
+			line += " **(breaking)**"
+		}
+		if c.What != "service" && c.What != "method" {
+			if len(c.Services) > 3 {
+				line += fmt.Sprintf(" (%d services)", len(c.Services))
+			} else {
+				line += " (" + strings.Join(c.Services, ", ") + ")"
+			}
+		}
+		b.WriteString(line + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,73 @@
+package BudgetService
+
+import "encoding/xml"
+
+type BudgetStatus string
+
+const (
+	BudgetStatusENABLED BudgetStatus = "ENABLED"
+	BudgetStatusREMOVED BudgetStatus = "REMOVED"
+	BudgetStatusDELETED BudgetStatus = "DELETED"
+)
+
+type Get struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+
+	Selector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 selector,omitempty"`
+}
+
+type GetResponse struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+
+	Rval *Budget `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty"`
+}
+
+type Mutate struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate"`
+
+	Operand *Budget `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty"`
+}
+
+type MutateResponse struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse"`
+
+	Rval *Budget `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty"`
+}
+
+type Selector struct {
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty"`
+}
+
+type Budget struct {
+	BudgetId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 budgetId,omitempty"`
+
+	//
+	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
+	//
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty"`
+
+	Amount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 amount,omitempty"`
+
+	Period *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 period,omitempty"`
+
+	Status *BudgetStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty"`
+}
+
+type BudgetServiceInterface struct {
+	client *SOAPClient
+}
+
+func (service *BudgetServiceInterface) AddHeader(header interface{}) {
+}
+
+func (service *BudgetServiceInterface) Get(request *Get) (*GetResponse, error) {
+	return nil, nil
+}
+
+func (service *BudgetServiceInterface) Mutate(request *Mutate) (*MutateResponse, error) {
+	return nil, nil
+}
+
+type SOAPClient struct {
+	url string
+}
@@ -0,0 +1,18 @@
+package LabelService
+
+import "encoding/xml"
+
+type Get struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+}
+
+type GetResponse struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+}
+
+type LabelServiceInterface struct {
+}
+
+func (service *LabelServiceInterface) Get(request *Get) (*GetResponse, error) {
+	return nil, nil
+}
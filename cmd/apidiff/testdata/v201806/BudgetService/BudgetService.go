@@ -0,0 +1,80 @@
+package BudgetService
+
+import "encoding/xml"
+
+type BudgetStatus string
+
+const (
+	BudgetStatusENABLED BudgetStatus = "ENABLED"
+	BudgetStatusPAUSED  BudgetStatus = "PAUSED"
+	BudgetStatusREMOVED BudgetStatus = "REMOVED"
+)
+
+type Get struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 get"`
+
+	Selector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201806 selector,omitempty"`
+}
+
+type GetResponse struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getResponse"`
+
+	Rval *Budget `xml:"https://adwords.google.com/api/adwords/cm/v201806 rval,omitempty"`
+}
+
+type Query struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 query"`
+
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201806 query,omitempty"`
+}
+
+type QueryResponse struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 queryResponse"`
+
+	Rval *Budget `xml:"https://adwords.google.com/api/adwords/cm/v201806 rval,omitempty"`
+}
+
+type Selector struct {
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201806 fields,omitempty"`
+}
+
+type Money struct {
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201806 microAmount,omitempty"`
+}
+
+type Budget struct {
+	//
+	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
+	//
+	BudgetId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201806 budgetId,omitempty"`
+
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201806 name,omitempty"`
+
+	Amount *Money `xml:"https://adwords.google.com/api/adwords/cm/v201806 amount,omitempty"`
+
+	//
+	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
+	//
+	DeliveryMethod *string `xml:"https://adwords.google.com/api/adwords/cm/v201806 deliveryMethod,omitempty"`
+
+	Status *BudgetStatus `xml:"https://adwords.google.com/api/adwords/cm/v201806 status,omitempty"`
+}
+
+type BudgetServiceInterface struct {
+	client *SOAPClient
+}
+
+func (service *BudgetServiceInterface) AddHeader(header interface{}) {
+}
+
+func (service *BudgetServiceInterface) Get(request *Get) (*GetResponse, error) {
+	return nil, nil
+}
+
+func (service *BudgetServiceInterface) Query(request *Query) (*QueryResponse, error) {
+	return nil, nil
+}
+
+type SOAPClient struct {
+	url string
+}
@@ -0,0 +1,18 @@
+package CampaignService
+
+import "encoding/xml"
+
+type Get struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 get"`
+}
+
+type GetResponse struct {
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getResponse"`
+}
+
+type CampaignServiceInterface struct {
+}
+
+func (service *CampaignServiceInterface) Get(request *Get) (*GetResponse, error) {
+	return nil, nil
+}
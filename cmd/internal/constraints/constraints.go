@@ -0,0 +1,31 @@
+// Package constraints parses the constraint annotations of the doc comments
+// gowsdl copies from the AdWords schemas, e.g.
+//
+//	<span class="constraint Required">This field is required and should not be {@code null}.</span>
+//
+// It is shared by the commands reading the generated packages.
+package constraints
+
+import (
+	"regexp"
+	"strings"
+)
+
+// span matches an annotation. Long annotations are wrapped over several
+// comment lines.
+var span = regexp.MustCompile(`(?s)<span class="constraint (\w+)">(.*?)</span>`)
+
+// Parse maps the class of each annotation of a doc comment (Selectable,
+// Required, InRange, ...) to its text, with white space normalized. The
+// texts of repeated classes are joined.
+func Parse(doc string) map[string]string {
+	m := make(map[string]string)
+	for _, s := range span.FindAllStringSubmatch(doc, -1) {
+		text := strings.Join(strings.Fields(s[2]), " ")
+		if m[s[1]] != "" {
+			text = m[s[1]] + " " + text
+		}
+		m[s[1]] = text
+	}
+	return m
+}
@@ -0,0 +1,22 @@
+package constraints
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	doc := `Name of the campaign.
+<span class="constraint Selectable">This field can be selected using the value "Name".</span><span class="constraint Filterable">This field can be filtered on.</span>
+<span class="constraint Required">This field is required and should not be {@code null} when it is contained
+within {@link Operator}s : ADD.</span>
+`
+	want := map[string]string{
+		"Selectable": `This field can be selected using the value "Name".`,
+		"Filterable": "This field can be filtered on.",
+		"Required":   "This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.",
+	}
+	if got := Parse(doc); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+// Package schema recognizes the Go types of the AdWords schemas in the
+// gowsdl output of a service package, as opposed to the SOAP envelope and
+// client types next to them.
+//
+// It is shared by the commands reading the generated packages.
+package schema
+
+import (
+	"go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Namespace is the namespace prefix of all AdWords schemas.
+const Namespace = "https://adwords.google.com/api/adwords/"
+
+// IsType reports whether t is a schema type. gowsdl gives every schema type
+// an XMLName in an AdWords namespace, which adwordsgen only keeps for the
+// request and response elements. The other types are recognized by their
+// fields, whose elements are all qualified with an AdWords namespace, or by
+// only embedding their base type.
+func IsType(t *ast.StructType) bool {
+	if len(t.Fields.List) == 0 {
+		return false
+	}
+	for _, f := range t.Fields.List {
+		if len(f.Names) == 0 {
+			continue
+		}
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			s, _ := strconv.Unquote(f.Tag.Value)
+			tag = reflect.StructTag(s)
+		}
+		xmlTag := tag.Get("xml")
+		if f.Names[0].Name == "XMLName" {
+			// Untagged in trees generated before XMLName was dropped.
+			return xmlTag == "" || strings.HasPrefix(xmlTag, Namespace)
+		}
+		if !strings.HasPrefix(xmlTag, Namespace) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+const src = `package p
+
+type Get struct {
+	XMLName xml.Name ` + "`xml:\"https://adwords.google.com/api/adwords/cm/v201802 get\"`" + `
+
+	Selector *Selector ` + "`xml:\"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty\"`" + `
+}
+
+type Selector struct {
+	Fields []string ` + "`xml:\"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty\"`" + `
+}
+
+type NumberValue struct {
+	*ComparableValue
+}
+
+type Old struct {
+	XMLName xml.Name ` + "`json:\"-\"`" + `
+}
+
+type SOAPEnvelope struct {
+	XMLName xml.Name ` + "`xml:\"http://schemas.xmlsoap.org/soap/envelope/ Envelope\"`" + `
+}
+
+type BasicAuth struct {
+	Login    string ` + "`xml:\"Username\"`" + `
+	Password string
+}
+
+type SOAPClient struct {
+	url string
+}
+
+type Empty struct {
+}
+`
+
+func TestIsType(t *testing.T) {
+	want := map[string]bool{
+		"Get": true, "Selector": true, "NumberValue": true, "Old": true,
+		"SOAPEnvelope": false, "BasicAuth": false, "SOAPClient": false, "Empty": false,
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		if got := IsType(ts.Type.(*ast.StructType)); got != want[ts.Name.Name] {
+			t.Errorf("%s: got %v, want %v", ts.Name.Name, got, want[ts.Name.Name])
+		}
+		return false
+	})
+}
@@ -0,0 +1,77 @@
+// Command reportfields fetches the fields of all report types of an API
+// version with ReportDefinitionService.GetReportFields and writes them as
+// a report.Catalog. adwordsgen generates the report row types of the
+// version from the catalog in the ReportDefinitionService directory.
+// Report types whose fields can't be fetched are logged and listed in the
+// catalog.
+//
+// Usage:
+//
+//	reportfields [-o file] v201802
+//
+// The credentials are read from the environment variables ADWORDS_LOGIN,
+// ADWORDS_PASSWORD, ADWORDS_DEVELOPER_TOKEN and ADWORDS_CLIENT_CUSTOMER_ID.
+// ADWORDS_ENDPOINT overrides the endpoint of the API.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+	"github.com/godofdream/go-googleadsinofficial/report"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("reportfields: ")
+
+	out := flag.String("o", "", "output file (default <version>/ReportDefinitionService/reports.json)")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: reportfields [flags] version")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	version := flag.Arg(0)
+	if *out == "" {
+		*out = report.CatalogFile(version)
+	}
+
+	client, err := adwords.New(version, adwords.Config{
+		Endpoint:         os.Getenv("ADWORDS_ENDPOINT"),
+		Login:            os.Getenv("ADWORDS_LOGIN"),
+		Password:         os.Getenv("ADWORDS_PASSWORD"),
+		DeveloperToken:   os.Getenv("ADWORDS_DEVELOPER_TOKEN"),
+		ClientCustomerId: os.Getenv("ADWORDS_CLIENT_CUSTOMER_ID"),
+		UserAgent:        "reportfields",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	catalog, err := report.FetchCatalog(client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, t := range sortedKeys(catalog.Failed) {
+		log.Printf("%s: %s", t, catalog.Failed[t])
+	}
+	if err := catalog.WriteFile(*out); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
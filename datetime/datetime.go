@@ -0,0 +1,135 @@
+// Package datetime converts the date and timestamp strings of the AdWords API
+// to and from time.Time.
+//
+// AdWords uses two formats: dates such as Campaign.StartDate or DateRange.Min
+// are "yyyyMMdd" in the account time zone, timestamps such as
+// CustomerChangeData.LastChangeTimestamp or OfflineConversionFeed.ConversionTime
+// are "yyyyMMdd HHmmss <time zone ID>". The time zone of an account is
+// ManagedCustomer.DateTimeZone.
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102 150405"
+)
+
+// A Date is a calendar date without a time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// ParseDate parses a "yyyyMMdd" date. Dates that do not exist in the
+// calendar, such as 20180230, are rejected.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil || len(s) != len(dateLayout) {
+		return Date{}, fmt.Errorf("datetime: invalid date %q, want yyyyMMdd", s)
+	}
+	return DateOf(t), nil
+}
+
+// DateOf returns the date of t in the location of t.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// Today returns the current date in loc, e.g. the time zone of an account.
+func Today(loc *time.Location) Date {
+	return DateOf(time.Now().In(loc))
+}
+
+// String returns d as "yyyyMMdd".
+func (d Date) String() string {
+	return fmt.Sprintf("%04d%02d%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// In returns the start of d in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns d shifted by n days.
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// Before reports whether d is before o.
+func (d Date) Before(o Date) bool {
+	return d.String() < o.String()
+}
+
+// After reports whether d is after o.
+func (d Date) After(o Date) bool {
+	return o.Before(d)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Date) UnmarshalText(text []byte) error {
+	v, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Location returns the location of an AdWords time zone ID such as
+// ManagedCustomer.DateTimeZone.
+func Location(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return nil, fmt.Errorf("datetime: empty time zone")
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("datetime: unknown time zone %q", timeZone)
+	}
+	return loc, nil
+}
+
+// ParseDateTime parses a "yyyyMMdd HHmmss <time zone ID>" timestamp. The time
+// zone ID may be omitted for timestamps in account time, e.g.
+// TemporaryUrl.Expiration; loc is used for them and may be nil otherwise.
+func ParseDateTime(s string, loc *time.Location) (time.Time, error) {
+	fields := strings.Fields(s)
+	switch {
+	case len(fields) == 3:
+		var err error
+		if loc, err = Location(fields[2]); err != nil {
+			return time.Time{}, err
+		}
+	case len(fields) == 2 && loc != nil:
+	default:
+		return time.Time{}, fmt.Errorf("datetime: invalid timestamp %q, want yyyyMMdd HHmmss <time zone ID>", s)
+	}
+	t, err := time.ParseInLocation(dateTimeLayout, fields[0]+" "+fields[1], loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("datetime: invalid timestamp %q, want yyyyMMdd HHmmss <time zone ID>", s)
+	}
+	return t, nil
+}
+
+// FormatDateTime formats t in loc as "yyyyMMdd HHmmss <time zone ID>". loc
+// must be a named location such as one returned by Location; time.Local has
+// no AdWords time zone ID.
+func FormatDateTime(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(dateTimeLayout) + " " + loc.String()
+}
@@ -0,0 +1,136 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate("20180228")
+	if err != nil || d != (Date{2018, time.February, 28}) {
+		t.Errorf("got %v, %v", d, err)
+	}
+	for _, s := range []string{"", "2018022", "201802280", "20180230", "20181301", "2018-02-28", " 20180228"} {
+		if d, err := ParseDate(s); err == nil {
+			t.Errorf("ParseDate(%q) = %v, want an error", s, d)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	d := Date{2018, time.December, 31}
+	if s := d.String(); s != "20181231" {
+		t.Errorf("got %s", s)
+	}
+	if s := (Date{987, time.January, 2}).String(); s != "09870102" {
+		t.Errorf("got %s, want a zero-padded year", s)
+	}
+	if next := d.AddDays(1); next != (Date{2019, time.January, 1}) {
+		t.Errorf("got %v after %v", next, d)
+	}
+	if prev := (Date{2016, time.March, 1}).AddDays(-1); prev != (Date{2016, time.February, 29}) {
+		t.Errorf("got %v before a leap day", prev)
+	}
+	if !d.Before(d.AddDays(1)) || d.Before(d) || !d.AddDays(40).After(d) || d.After(d) {
+		t.Error("wrong order of dates")
+	}
+	if !(Date{}).IsZero() || d.IsZero() {
+		t.Error("wrong IsZero")
+	}
+}
+
+func TestDateInLocation(t *testing.T) {
+	tokyo, err := Location("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A date starts at midnight in the account time zone, which is still
+	// the day before in UTC.
+	start := Date{2018, time.March, 1}.In(tokyo)
+	if want := time.Date(2018, time.February, 28, 15, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("got %v, want %v", start, want)
+	}
+	if d := DateOf(start); d != (Date{2018, time.March, 1}) {
+		t.Errorf("got %v in the location of the time", d)
+	}
+	if d := DateOf(start.UTC()); d != (Date{2018, time.February, 28}) {
+		t.Errorf("got %v in UTC", d)
+	}
+}
+
+func TestDateText(t *testing.T) {
+	var d Date
+	if err := d.UnmarshalText([]byte("20180704")); err != nil || d != (Date{2018, time.July, 4}) {
+		t.Errorf("got %v, %v", d, err)
+	}
+	if b, err := d.MarshalText(); err != nil || string(b) != "20180704" {
+		t.Errorf("got %s, %v", b, err)
+	}
+	if err := d.UnmarshalText([]byte("20180732")); err == nil {
+		t.Error("unmarshaled an invalid date")
+	}
+	if d != (Date{2018, time.July, 4}) {
+		t.Errorf("a failed UnmarshalText changed the date to %v", d)
+	}
+}
+
+func TestLocation(t *testing.T) {
+	for _, tz := range []string{"", "Mars/Olympus_Mons"} {
+		if _, err := Location(tz); err == nil {
+			t.Errorf("got the location of %q", tz)
+		}
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	berlin, err := Location("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm, err := ParseDateTime("20180701 123000 America/New_York", berlin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The time zone of the timestamp wins over loc.
+	if want := time.Date(2018, time.July, 1, 16, 30, 0, 0, time.UTC); !tm.Equal(want) {
+		t.Errorf("got %v, want %v", tm, want)
+	}
+	if tm.Location().String() != "America/New_York" {
+		t.Errorf("got location %v", tm.Location())
+	}
+
+	// Timestamps without a time zone are in loc.
+	tm, err = ParseDateTime("20180101 000000", berlin)
+	if want := time.Date(2017, time.December, 31, 23, 0, 0, 0, time.UTC); err != nil || !tm.Equal(want) {
+		t.Errorf("got %v, %v, want %v", tm, err, want)
+	}
+
+	for _, s := range []string{
+		"20180101 000000",
+		"20180101",
+		"20180101 240000 Europe/Berlin",
+		"20180101 000000 Europe/Nowhere",
+		"2018-01-01 00:00:00 Europe/Berlin",
+		"20180101 000000 Europe/Berlin extra",
+	} {
+		if tm, err := ParseDateTime(s, nil); err == nil {
+			t.Errorf("ParseDateTime(%q) = %v, want an error", s, tm)
+		}
+	}
+}
+
+func TestFormatDateTime(t *testing.T) {
+	la, err := Location("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := time.Date(2018, time.March, 11, 10, 0, 0, 0, time.UTC)
+	s := FormatDateTime(tm, la)
+	if s != "20180311 030000 America/Los_Angeles" {
+		t.Errorf("got %q", s)
+	}
+	back, err := ParseDateTime(s, nil)
+	if err != nil || !back.Equal(tm) {
+		t.Errorf("got %v, %v after a round trip of %v", back, err, tm)
+	}
+}
@@ -0,0 +1,252 @@
+// Package deep copies, compares and diffs the generated API types.
+//
+// The functions work on pointers to the structs of the service packages.
+// XMLName fields are ignored, so a value decoded from a response equals a
+// value built in code. Nil and empty slices are equal, as neither is sent to
+// the API. Paths use the element names of the API, e.g.
+// "biddingStrategyConfiguration.bids[0].bid.microAmount".
+package deep
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var xmlNameType = reflect.TypeOf(xml.Name{})
+
+// Copy returns a deep copy of v.
+func Copy(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	src := reflect.ValueOf(v)
+	dst := reflect.New(src.Type()).Elem()
+	copyValue(dst, src)
+	return dst.Interface()
+}
+
+func copyValue(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.New(src.Type().Elem()))
+		copyValue(dst.Elem(), src.Elem())
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			copyValue(dst.Index(i), src.Index(i))
+		}
+	case reflect.Struct:
+		if hasUnexported(src.Type()) {
+			// time.Time and the like are values.
+			dst.Set(src)
+			return
+		}
+		for i := 0; i < src.NumField(); i++ {
+			copyValue(dst.Field(i), src.Field(i))
+		}
+	default:
+		dst.Set(src)
+	}
+}
+
+func hasUnexported(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal reports whether a and b hold the same values.
+func Equal(a, b interface{}) bool {
+	return len(Diff(a, b)) == 0
+}
+
+// A Change is a value that differs between two versions of an entity. Old
+// or New is nil if the value is unset in that version.
+type Change struct {
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, format(c.Old), format(c.New))
+}
+
+func format(v interface{}) string {
+	if v == nil {
+		return "<unset>"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// Diff returns the changed values from old to new, which must be of the
+// same type. Changed scalars are reported individually; a struct or list
+// element that is set in only one version is reported as a whole.
+func Diff(old, new interface{}) []Change {
+	var changes []Change
+	diffValue(&changes, "", reflect.ValueOf(old), reflect.ValueOf(new))
+	return changes
+}
+
+func diffValue(changes *[]Change, path string, a, b reflect.Value) {
+	switch a.Kind() {
+	case reflect.Ptr:
+		switch {
+		case a.IsNil() && b.IsNil():
+		case a.IsNil() || b.IsNil():
+			*changes = append(*changes, Change{Path: path, Old: value(a), New: value(b)})
+		default:
+			diffValue(changes, path, a.Elem(), b.Elem())
+		}
+	case reflect.Slice:
+		n := a.Len()
+		if b.Len() > n {
+			n = b.Len()
+		}
+		for i := 0; i < n; i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= a.Len():
+				*changes = append(*changes, Change{Path: p, New: value(b.Index(i))})
+			case i >= b.Len():
+				*changes = append(*changes, Change{Path: p, Old: value(a.Index(i))})
+			default:
+				diffValue(changes, p, a.Index(i), b.Index(i))
+			}
+		}
+	case reflect.Struct:
+		if hasUnexported(a.Type()) {
+			if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+				*changes = append(*changes, Change{Path: path, Old: a.Interface(), New: b.Interface()})
+			}
+			return
+		}
+		t := a.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Type == xmlNameType {
+				continue
+			}
+			if f.Anonymous {
+				// Fields of a nil base are unset.
+				diffValue(changes, path, elemOrZero(a.Field(i)), elemOrZero(b.Field(i)))
+				continue
+			}
+			diffValue(changes, join(path, jsonName(f)), a.Field(i), b.Field(i))
+		}
+	default:
+		if a.Interface() != b.Interface() {
+			*changes = append(*changes, Change{Path: path, Old: a.Interface(), New: b.Interface()})
+		}
+	}
+}
+
+// elemOrZero returns the struct the pointer v points to, or a zero struct.
+func elemOrZero(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Ptr {
+		return v
+	}
+	if v.IsNil() {
+		return reflect.Zero(v.Type().Elem())
+	}
+	return v.Elem()
+}
+
+// value returns the value v points to, or nil.
+func value(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	return v.Interface()
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
+
+// Set returns a new entity of the type of old and new holding the fields
+// of new a SET operation has to send: the fields named by mutable that
+// changed from old, and the fields named by keys that identify the entity.
+// Fields are Go field names and may be promoted from embedded bases. It
+// returns nil if no mutable field changed.
+func Set(old, new interface{}, mutable, keys []string) interface{} {
+	a, b := reflect.ValueOf(old), reflect.ValueOf(new)
+	set := reflect.New(b.Type().Elem())
+	changed := false
+	for _, name := range mutable {
+		x, y := field(a, name), field(b, name)
+		if len(Diff(x.Interface(), y.Interface())) == 0 {
+			continue
+		}
+		copyValue(settable(set, name), y)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	for _, name := range keys {
+		copyValue(settable(set, name), field(b, name))
+	}
+	return set.Interface()
+}
+
+// field returns the field name of the struct v points to, or the zero value
+// of the field if v or an embedded base holding it is nil.
+func field(v reflect.Value, name string) reflect.Value {
+	t := v.Type().Elem()
+	sf, ok := t.FieldByName(name)
+	if !ok {
+		panic("deep: " + t.Name() + " has no field " + name)
+	}
+	for _, i := range sf.Index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Zero(sf.Type)
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+// settable returns the field name of the struct v points to, allocating
+// the embedded bases on the way.
+func settable(v reflect.Value, name string) reflect.Value {
+	sf, _ := v.Type().Elem().FieldByName(name)
+	for _, i := range sf.Index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
@@ -0,0 +1,161 @@
+package deep
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The types mirror the generated ones: pointer fields, an XMLName and an
+// embedded base, which is exported as in the service packages so that Set
+// can allocate it.
+
+type money struct {
+	MicroAmount *int64 `json:"microAmount,omitempty"`
+}
+
+type bid struct {
+	Bid *money `json:"bid,omitempty"`
+}
+
+type Base struct {
+	Id   *int64  `json:"id,omitempty"`
+	Type *string `json:"baseType,omitempty"`
+}
+
+type entity struct {
+	XMLName xml.Name `json:"-"`
+	*Base
+
+	Name     *string   `json:"name,omitempty"`
+	Bids     []*bid    `json:"bids,omitempty"`
+	Labels   []string  `json:"labels,omitempty"`
+	Modified time.Time `json:"modified"`
+}
+
+func i64(v int64) *int64   { return &v }
+func str(v string) *string { return &v }
+
+func sample() *entity {
+	return &entity{
+		Base:     &Base{Id: i64(7)},
+		Name:     str("Shoes"),
+		Bids:     []*bid{{Bid: &money{MicroAmount: i64(1000000)}}},
+		Labels:   []string{"a"},
+		Modified: time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCopy(t *testing.T) {
+	a := sample()
+	b := Copy(a).(*entity)
+	if !Equal(a, b) {
+		t.Fatalf("got copy with changes %v", Diff(a, b))
+	}
+	*b.Id, *b.Name = 8, "Boots"
+	*b.Bids[0].Bid.MicroAmount = 2000000
+	b.Labels[0] = "b"
+	if *a.Id != 7 || *a.Name != "Shoes" || *a.Bids[0].Bid.MicroAmount != 1000000 || a.Labels[0] != "a" {
+		t.Errorf("the copy shares values with the original: %+v", a)
+	}
+	if Copy(nil) != nil {
+		t.Error("got a copy of nil")
+	}
+	if c := Copy(&entity{}).(*entity); c.Base != nil || c.Bids != nil {
+		t.Errorf("got %+v, want nil fields kept nil", c)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a, b := sample(), sample()
+	b.XMLName = xml.Name{Space: "https://adwords.google.com/api/adwords/cm/v201802", Local: "entity"}
+	if !Equal(a, b) {
+		t.Errorf("XMLName made values differ: %v", Diff(a, b))
+	}
+	a.Labels, b.Labels = nil, []string{}
+	if !Equal(a, b) {
+		t.Errorf("nil and empty slices differ: %v", Diff(a, b))
+	}
+	// A nil Base equals a Base without values.
+	if !Equal(&entity{}, &entity{Base: &Base{}}) {
+		t.Error("a nil Base differs from an empty one")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a, b := sample(), sample()
+	*b.Bids[0].Bid.MicroAmount = 2000000
+	b.Name = nil
+	b.Type = str("TextAd")
+	b.Labels = append(b.Labels, "b")
+	b.Modified = b.Modified.Add(time.Hour)
+
+	var got []string
+	for _, c := range Diff(a, b) {
+		got = append(got, c.String())
+	}
+	want := []string{
+		"baseType: <unset> -> TextAd",
+		"name: Shoes -> <unset>",
+		"bids[0].bid.microAmount: 1000000 -> 2000000",
+		"labels[1]: <unset> -> b",
+		"modified: 2018-02-01 00:00:00 +0000 UTC -> 2018-02-01 01:00:00 +0000 UTC",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got changes\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	// Elements set in one version only are reported as a whole.
+	b = sample()
+	b.Bids = nil
+	changes := Diff(a, b)
+	if len(changes) != 1 || changes[0].Path != "bids[0]" || changes[0].New != nil {
+		t.Errorf("got changes %v", changes)
+	}
+	if _, ok := changes[0].Old.(bid); !ok {
+		t.Errorf("got old element %#v, want the bid", changes[0].Old)
+	}
+}
+
+func TestSet(t *testing.T) {
+	old := sample()
+	new := sample()
+	*new.Name = "Boots"
+	new.Labels = []string{"b"}
+
+	set, ok := Set(old, new, []string{"Name", "Bids"}, []string{"Id"}).(*entity)
+	if !ok {
+		t.Fatal("got no entity")
+	}
+	if set.Base == nil || *set.Id != 7 || *set.Name != "Boots" {
+		t.Errorf("got %+v, want the changed name and the key", set)
+	}
+	// Labels changed, but are not mutable, and Bids didn't change.
+	if set.Labels != nil || set.Bids != nil || set.Type != nil {
+		t.Errorf("got unchanged or immutable fields in %+v", set)
+	}
+	// The fields are copied.
+	*new.Name = "Sandals"
+	if *set.Name != "Boots" {
+		t.Error("the operation shares its name with the new entity")
+	}
+
+	if v := Set(old, sample(), []string{"Name", "Id"}, []string{"Id"}); v != nil {
+		t.Errorf("got %+v without changes", v)
+	}
+
+	// A promoted field of a nil Base is unset.
+	if set, _ := Set(&entity{}, &entity{Base: &Base{Id: i64(1)}}, []string{"Id"}, nil).(*entity); set == nil || *set.Id != 1 {
+		t.Errorf("got %+v", set)
+	}
+}
+
+func TestSetUnknownField(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(r.(string), "no field Nme") {
+			t.Errorf("got panic %v", r)
+		}
+	}()
+	Set(sample(), sample(), []string{"Nme"}, nil)
+}
@@ -1,4 +1,4 @@
-//go:generate bash wsdl.sh "$GOPATH"
+//go:generate bash wsdl.sh "$GOPATH" v201802
 
 // Package googleadsinofficial is a dummypackage to hold the generator
 package googleadsinofficial
@@ -0,0 +1,239 @@
+// Package money converts between AdWords micro amounts and decimal amounts.
+//
+// AdWords transmits all monetary values as micros, one million of which are
+// one unit of the account currency. Amounts sent to the API must be a
+// multiple of the billable unit of the currency, otherwise the API rejects
+// them with NON_MULTIPLE_OF_MINIMUM_CURRENCY_UNIT. All conversions work on
+// integers and decimal strings, never on floats.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MicrosPerUnit is the number of micros in one unit of a currency.
+const MicrosPerUnit = 1000000
+
+// ErrOverflow is returned when the result of an operation does not fit into
+// an int64 micro amount.
+var ErrOverflow = errors.New("money: micro amount overflows int64")
+
+// A Currency describes how amounts of an account currency are billed and
+// displayed.
+type Currency struct {
+	// Code is the ISO 4217 currency code, as in ManagedCustomer.CurrencyCode.
+	Code string
+
+	// Decimals is the number of fractional digits used for display.
+	Decimals int
+
+	// BillableUnit is the smallest amount in micros AdWords accepts.
+	BillableUnit int64
+}
+
+// currencies lists the currencies supported by AdWords. Billable units
+// follow the ISO 4217 minor unit, except where AdWords bills in whole or
+// larger units.
+var currencies = map[string]Currency{}
+
+func init() {
+	for _, code := range strings.Fields(`AED ARS AUD BGN BND BOB BRL CAD CHF CNY
+		COP CZK DKK EGP EUR FJD GBP HKD HRK ILS INR KES LKR MAD MXN MYR NGN NOK
+		NZD PEN PHP PKR PLN RON RSD RUB SAR SEK SGD THB TRY UAH USD UYU VEF
+		ZAR`) {
+		currencies[code] = Currency{Code: code, Decimals: 2, BillableUnit: 10000}
+	}
+	// HUF, IDR and TWD have a minor unit in ISO 4217, but AdWords bills
+	// them in whole units.
+	for _, code := range []string{"CLP", "HUF", "IDR", "JPY", "TWD", "VND"} {
+		currencies[code] = Currency{Code: code, Decimals: 0, BillableUnit: MicrosPerUnit}
+	}
+	// KRW is billed in multiples of 10 won.
+	currencies["KRW"] = Currency{Code: "KRW", Decimals: 0, BillableUnit: 10 * MicrosPerUnit}
+}
+
+// Lookup returns the Currency for an ISO 4217 currency code.
+func Lookup(code string) (Currency, error) {
+	c, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("money: unknown currency %q", code)
+	}
+	return c, nil
+}
+
+// Round rounds micros to the nearest multiple of the billable unit of c.
+// Halves are rounded away from zero.
+func (c Currency) Round(micros int64) (int64, error) {
+	unit := c.BillableUnit
+	if unit <= 1 {
+		return micros, nil
+	}
+	q, r := micros/unit, micros%unit
+	if r >= (unit+1)/2 {
+		q++
+	} else if -r >= (unit+1)/2 {
+		q--
+	}
+	return Mul(q, unit)
+}
+
+// Parse converts the decimal amount s (e.g. "12.34") to micros rounded to
+// the billable unit of c.
+func (c Currency) Parse(s string) (int64, error) {
+	micros, err := ParseMicros(s)
+	if err != nil {
+		return 0, err
+	}
+	return c.Round(micros)
+}
+
+// Format formats micros as a decimal amount with the number of fractional
+// digits of c. The amount is rounded half away from zero for display.
+func (c Currency) Format(micros int64) string {
+	return FormatMicros(micros, c.Decimals)
+}
+
+// ParseMicros converts the decimal amount s to micros. Digits beyond the
+// sixth fractional digit are rounded half away from zero.
+func ParseMicros(s string) (int64, error) {
+	orig := s
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg, s = true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if whole == "" && frac == "" || !digits(whole) || !digits(frac) {
+		return 0, fmt.Errorf("money: invalid amount %q", orig)
+	}
+
+	var units int64
+	if whole != "" {
+		var err error
+		if units, err = strconv.ParseInt(whole, 10, 64); err != nil {
+			return 0, ErrOverflow
+		}
+	}
+	roundUp := len(frac) > 6 && frac[6] >= '5'
+	if len(frac) > 6 {
+		frac = frac[:6]
+	}
+	frac += strings.Repeat("0", 6-len(frac))
+	fraction, _ := strconv.ParseInt(frac, 10, 64)
+	if roundUp {
+		fraction++
+	}
+
+	micros, err := Mul(units, MicrosPerUnit)
+	if err != nil {
+		return 0, err
+	}
+	if micros, err = Add(micros, fraction); err != nil {
+		return 0, err
+	}
+	if neg {
+		micros = -micros
+	}
+	return micros, nil
+}
+
+func digits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatMicros formats micros as a decimal amount with the given number of
+// fractional digits (0 to 6), rounding half away from zero.
+func FormatMicros(micros int64, decimals int) string {
+	if decimals < 0 {
+		decimals = 0
+	} else if decimals > 6 {
+		decimals = 6
+	}
+	unit := int64(math.Pow10(6 - decimals))
+
+	// Work on the magnitude as uint64 so math.MinInt64 survives.
+	neg := micros < 0
+	mag := uint64(micros)
+	if neg {
+		mag = -mag
+	}
+	mag = (mag + uint64(unit)/2) / uint64(unit)
+
+	scale := uint64(math.Pow10(decimals))
+	s := strconv.FormatUint(mag/scale, 10)
+	if decimals > 0 {
+		f := strconv.FormatUint(mag%scale, 10)
+		s += "." + strings.Repeat("0", decimals-len(f)) + f
+	}
+	if neg && mag != 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Add returns a+b, or ErrOverflow.
+func Add(a, b int64) (int64, error) {
+	c := a + b
+	if (c > a) != (b > 0) {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// Sub returns a-b, or ErrOverflow.
+func Sub(a, b int64) (int64, error) {
+	c := a - b
+	if (c < a) != (b > 0) {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// Mul returns a*b, or ErrOverflow.
+func Mul(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// Scale returns micros*num/den rounded half away from zero, e.g. Scale(bid,
+// 110, 100) raises a bid by 10%. It fails with ErrOverflow if micros*num does
+// not fit into an int64.
+func Scale(micros, num, den int64) (int64, error) {
+	if den == 0 {
+		return 0, errors.New("money: division by zero")
+	}
+	p, err := Mul(micros, num)
+	if err != nil {
+		return 0, err
+	}
+	if den < 0 {
+		p, den = -p, -den
+	}
+	q, r := p/den, p%den
+	if r >= (den+1)/2 {
+		q++
+	} else if -r >= (den+1)/2 {
+		q--
+	}
+	return q, nil
+}
@@ -0,0 +1,149 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		code   string
+		micros int64
+		want   int64
+	}{
+		{"USD", 1234567, 1230000},
+		{"USD", 1235000, 1240000},
+		{"USD", -1235000, -1240000},
+		{"USD", 1234999, 1230000},
+		{"EUR", 5000, 10000},
+		{"EUR", 4999, 0},
+		{"JPY", 1500000, 2000000},
+		{"JPY", 1499999, 1000000},
+		{"HUF", 2500000, 3000000},
+		{"IDR", 1234567, 1000000},
+		{"TWD", 99500000, 100000000},
+		{"KRW", 1234000000, 1230000000},
+		{"KRW", 15000000, 20000000},
+		{"KRW", 14999999, 10000000},
+		{"KRW", -15000000, -20000000},
+	}
+	for _, tt := range tests {
+		c, err := Lookup(tt.code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := c.Round(tt.micros)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: Round(%d) = %d, %v, want %d", tt.code, tt.micros, got, err, tt.want)
+		}
+	}
+}
+
+func TestRoundOverflow(t *testing.T) {
+	// The nearest cent is above the maximum.
+	c, _ := Lookup("USD")
+	if _, err := c.Round(math.MaxInt64); err != ErrOverflow {
+		t.Errorf("got %v, want ErrOverflow", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		code, s string
+		want    int64
+		err     bool
+	}{
+		{"USD", "12.34", 12340000, false},
+		{"usd", "12.345", 12350000, false},
+		{"USD", "-0.005", -10000, false},
+		{"USD", ".5", 500000, false},
+		{"USD", "+1", 1000000, false},
+		{"KRW", "1234", 1230000000, false},
+		{"KRW", "1235", 1240000000, false},
+		{"JPY", "99.5", 100000000, false},
+		{"USD", "", 0, true},
+		{"USD", ".", 0, true},
+		{"USD", "1,000", 0, true},
+		{"USD", "1e3", 0, true},
+		{"USD", "99999999999999", 0, true},
+	}
+	for _, tt := range tests {
+		c, err := Lookup(tt.code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := c.Parse(tt.s)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("%s: Parse(%q) = %d, %v, want %d", tt.code, tt.s, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseMicros(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int64
+	}{
+		{"0.0000005", 1},
+		{"0.0000004", 0},
+		{"-0.0000005", -1},
+		{"1.000000", 1000000},
+	}
+	for _, tt := range tests {
+		if got, err := ParseMicros(tt.s); err != nil || got != tt.want {
+			t.Errorf("ParseMicros(%q) = %d, %v, want %d", tt.s, got, err, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		code   string
+		micros int64
+		want   string
+	}{
+		{"USD", 12340000, "12.34"},
+		{"USD", 5000, "0.01"},
+		{"USD", -4999, "0.00"},
+		{"USD", -5000, "-0.01"},
+		{"JPY", 1500000, "2"},
+		{"KRW", 1230000000, "1230"},
+		{"TWD", 99000000, "99"},
+	}
+	for _, tt := range tests {
+		c, _ := Lookup(tt.code)
+		if got := c.Format(tt.micros); got != tt.want {
+			t.Errorf("%s: Format(%d) = %q, want %q", tt.code, tt.micros, got, tt.want)
+		}
+	}
+	if got := FormatMicros(math.MinInt64, 6); got != "-9223372036854.775808" {
+		t.Errorf("FormatMicros(MinInt64) = %q", got)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	if _, err := Add(math.MaxInt64, 1); err != ErrOverflow {
+		t.Errorf("Add: got %v", err)
+	}
+	if _, err := Sub(math.MinInt64, 1); err != ErrOverflow {
+		t.Errorf("Sub: got %v", err)
+	}
+	if _, err := Mul(math.MinInt64, -1); err != ErrOverflow {
+		t.Errorf("Mul: got %v", err)
+	}
+	tests := []struct{ micros, num, den, want int64 }{
+		{1000000, 110, 100, 1100000},
+		{5, 1, 2, 3},
+		{-5, 1, 2, -3},
+		{5, 1, -2, -3},
+		{4, 1, 3, 1},
+	}
+	for _, tt := range tests {
+		if got, err := Scale(tt.micros, tt.num, tt.den); err != nil || got != tt.want {
+			t.Errorf("Scale(%d, %d, %d) = %d, %v, want %d", tt.micros, tt.num, tt.den, got, err, tt.want)
+		}
+	}
+	if _, err := Scale(1, 1, 0); err == nil {
+		t.Error("Scale by 0: no error")
+	}
+}
@@ -0,0 +1,297 @@
+// Package paging iterates over the entities of Get and Query requests page
+// by page. Every service package wraps it for its Get and Query methods:
+//
+//	for campaign, err := range CampaignService.GetAll(service, sel) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// The API pages by index, so entities added or removed while iterating
+// shift the following entities between pages. A Pager with a Key therefore
+// re-fetches the last Overlap entries of every page and skips the entries
+// of the re-fetched window it has already returned. Up to Overlap entities
+// may be added or removed between two pages without skipping or repeating
+// an entity. Entries are only skipped by their Key, never by their
+// content, so distinct entities that look the same are all returned.
+//
+// Large result sets are fetched faster with concurrent Workers, and
+// transient errors and rate limits are handled by a retry.Policy:
+//
+//	p := CampaignCriterionService.GetPager(service, sel)
+//	p.Workers = 8
+//	p.Retry = &retry.Policy{Limiter: retry.NewLimiter(10)}
+//	for criterion, err := range p.All() {
+//		...
+//	}
+package paging
+
+import (
+	"errors"
+	"iter"
+	"sync"
+
+	"github.com/godofdream/go-googleadsinofficial/retry"
+)
+
+// Defaults of the Pager fields.
+const (
+	DefaultPageSize = 500
+	DefaultOverlap  = 10
+)
+
+// Fetch returns the entries of the page of n entries starting at index
+// start and the total number of entries.
+type Fetch[T any] func(start, n int32) (entries []T, total int32, err error)
+
+// A Pager iterates over the entries returned by Fetch.
+type Pager[T any] struct {
+	Fetch Fetch[T]
+
+	// Key identifies an entry, e.g. by its Id, or returns "" if the entry
+	// has no identity, e.g. because its Id was not selected. Pages only
+	// overlap while the entries at the end of a page have a key. Without
+	// a Key, pages never overlap.
+	Key func(T) string
+
+	// Start is the index of the first entry.
+	Start int32
+
+	// PageSize is the number of entries fetched per request, or
+	// DefaultPageSize if 0.
+	PageSize int32
+
+	// Overlap is the number of entries re-fetched from the previous page,
+	// DefaultOverlap if 0 and none if negative. It is at most half the
+	// page size.
+	Overlap int32
+
+	// Workers is the number of pages fetched concurrently once the first
+	// page has revealed the total number of entries. The entries are still
+	// passed on in page order. With concurrent windows only entries added
+	// while fetching are caught, not removed ones. 0 or 1 fetches one page
+	// after the other.
+	Workers int
+
+	// Retry retries failed fetches, e.g. &retry.Policy{Limiter:
+	// retry.NewLimiter(5)}. A nil Retry makes a single attempt.
+	Retry *retry.Policy
+}
+
+// key returns the key of entry, or "" if it has none.
+func (p *Pager[T]) key(entry T) string {
+	if p.Key == nil {
+		return ""
+	}
+	return p.Key(entry)
+}
+
+// tail returns the keys of the entries at the end of a page that the
+// next page may repeat: the overlap entries it re-fetches and up to
+// overlap entries shifted into it by additions. It returns nil if the next
+// page does not overlap: overlap is 0, the page is not longer than
+// overlap, or one of these entries lacks a key.
+func (p *Pager[T]) tail(entries []T, overlap int32) map[string]bool {
+	n := int32(len(entries))
+	if overlap <= 0 || p.Key == nil || n <= overlap {
+		return nil
+	}
+	keys := make(map[string]bool, 2*overlap)
+	for _, e := range entries[max(0, n-2*overlap):] {
+		k := p.key(e)
+		if k == "" {
+			return nil
+		}
+		keys[k] = true
+	}
+	return keys
+}
+
+// Each calls fn for every entry in page order. It stops at the first
+// error of Fetch or fn and returns it.
+func (p *Pager[T]) Each(fn func(T) error) error {
+	size, overlap := p.PageSize, p.Overlap
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	switch {
+	case overlap == 0:
+		overlap = DefaultOverlap
+	case overlap < 0:
+		overlap = 0
+	}
+	if overlap > size/2 {
+		overlap = size / 2
+	}
+
+	start := p.Start
+	var seen map[string]bool
+	for first := true; ; first = false {
+		entries, total, err := p.fetch(start, size)
+		if err != nil {
+			return err
+		}
+		if err := p.emit(entries, seen, 2*overlap, fn); err != nil {
+			return err
+		}
+		n := int32(len(entries))
+		if n == 0 || start+n >= total {
+			return nil
+		}
+		if first && p.Workers > 1 {
+			more, last, lastStart, err := p.parallel(start+n, total, size, overlap, entries, fn)
+			if err != nil || !more {
+				return err
+			}
+			// Entries were added while fetching. Continue after the
+			// fetched windows, re-fetching the end of the last one.
+			entries, start, n = last, lastStart, int32(len(last))
+		}
+		if seen = p.tail(entries, overlap); seen != nil {
+			n -= overlap
+		}
+		start += n
+	}
+}
+
+// parallel fetches the windows of size entries from start to total with
+// p.Workers concurrent requests and passes their entries to fn in order.
+// At most p.Workers pages are fetched ahead of fn. The windows do not
+// overlap, but entries shifted into a window from the end of the previous
+// one, prev for the first, are skipped by their keys. It reports whether a
+// page reported more than total entries, and returns the entries and the
+// start of the last window.
+func (p *Pager[T]) parallel(start, total, size, overlap int32, prev []T, fn func(T) error) (more bool, last []T, lastStart int32, err error) {
+	type result struct {
+		entries []T
+		total   int32
+		err     error
+	}
+	results := make([]chan result, (total-start+size-1)/size)
+	for i := range results {
+		results[i] = make(chan result, 1)
+	}
+	sem := make(chan struct{}, p.Workers)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range results {
+			select {
+			case sem <- struct{}{}:
+			case <-done:
+				return
+			}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				var r result
+				r.entries, r.total, r.err = p.fetch(start+int32(i)*size, size)
+				results[i] <- r
+			}(i)
+		}
+	}()
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	last = prev
+	for i := range results {
+		r := <-results[i]
+		<-sem
+		if r.err != nil {
+			return false, nil, 0, r.err
+		}
+		if err := p.emit(r.entries, p.shifted(last, overlap), overlap, fn); err != nil {
+			return false, nil, 0, err
+		}
+		more = more || r.total > total
+		last, lastStart = r.entries, start+int32(i)*size
+	}
+	return more, last, lastStart, nil
+}
+
+// shifted returns the keys of the last overlap entries of a window, which
+// additions may shift into the next window.
+func (p *Pager[T]) shifted(entries []T, overlap int32) map[string]bool {
+	if overlap <= 0 || p.Key == nil {
+		return nil
+	}
+	if n := int32(len(entries)); n > overlap {
+		entries = entries[n-overlap:]
+	}
+	keys := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		if k := p.key(e); k != "" {
+			keys[k] = true
+		}
+	}
+	return keys
+}
+
+// fetch calls Fetch with the retries of p.Retry.
+func (p *Pager[T]) fetch(start, n int32) (entries []T, total int32, err error) {
+	err = p.Retry.Do(func() error {
+		var err error
+		entries, total, err = p.Fetch(start, n)
+		return err
+	})
+	return entries, total, err
+}
+
+// emit passes the entries to fn, except those among the first n whose key
+// is in seen.
+func (p *Pager[T]) emit(entries []T, seen map[string]bool, n int32, fn func(T) error) error {
+	for i, e := range entries {
+		if int32(i) < n && len(seen) > 0 {
+			if k := p.key(e); k != "" && seen[k] {
+				continue
+			}
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithField returns fields with field appended unless it is already
+// selected, e.g. to select the Id a Key needs.
+func WithField(fields []string, field string) []string {
+	for _, f := range fields {
+		if f == field {
+			return fields
+		}
+	}
+	return append(append([]string(nil), fields...), field)
+}
+
+// All returns an iterator over the entries in page order. An error ends
+// the iteration after being yielded with the zero entry.
+func (p *Pager[T]) All() iter.Seq2[T, error] {
+	return seq(p.Each)
+}
+
+// seq turns an Each function into an iterator.
+func seq[T any](each func(fn func(T) error) error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stopped := false
+		err := each(func(e T) error {
+			if !yield(e, nil) {
+				stopped = true
+				return errStop
+			}
+			return nil
+		})
+		if err != nil && !stopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// errStop ends Each when the consumer of All stops.
+var errStop = errors.New("paging: iteration stopped")
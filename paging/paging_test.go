@@ -0,0 +1,220 @@
+package paging_test
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/v201802/CampaignService"
+)
+
+// entity is an entry of a fake service.
+type entity struct {
+	ID     int64
+	Status string
+}
+
+// list is a fake service whose entries can change between fetches.
+type list struct {
+	mu      sync.Mutex
+	entries []*entity
+
+	// before is called before every fetch with its start and the number
+	// of fetches so far.
+	before  func(l *list, start int32, fetches int)
+	fetches int
+}
+
+func (l *list) fetch(start, n int32) ([]*entity, int32, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.before != nil {
+		l.before(l, start, l.fetches)
+	}
+	l.fetches++
+	total := int32(len(l.entries))
+	if start >= total {
+		return nil, total, nil
+	}
+	end := min(start+n, total)
+	return append([]*entity(nil), l.entries[start:end]...), total, nil
+}
+
+func entities(n int) []*entity {
+	list := make([]*entity, n)
+	for i := range list {
+		list[i] = &entity{ID: int64(i + 1), Status: "ENABLED"}
+	}
+	return list
+}
+
+func key(e *entity) string {
+	if e.ID == 0 {
+		return ""
+	}
+	return strconv.FormatInt(e.ID, 10)
+}
+
+func ids(entries []*entity) []int64 {
+	var ids []int64
+	for _, e := range entries {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func collect(t *testing.T, p *paging.Pager[*entity]) []*entity {
+	t.Helper()
+	var got []*entity
+	if err := p.Each(func(e *entity) error {
+		got = append(got, e)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestPager(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		key     bool
+		workers int
+		before  func(l *list, start int32, fetches int)
+		want    []int64
+	}{
+		{name: "sequential", n: 25, key: true, want: ids(entities(25))},
+		{name: "parallel", n: 25, key: true, workers: 4, want: ids(entities(25))},
+		{name: "empty", n: 0, key: true},
+		{
+			name: "added twice before second page",
+			n:    25,
+			key:  true,
+			before: func(l *list, start int32, fetches int) {
+				if fetches == 1 {
+					l.entries = append([]*entity{{ID: 100}, {ID: 101}}, l.entries...)
+				}
+			},
+			want: ids(entities(25)),
+		},
+		{
+			name: "added before second page",
+			n:    25,
+			key:  true,
+			before: func(l *list, start int32, fetches int) {
+				if fetches == 1 {
+					l.entries = append([]*entity{{ID: 100}}, l.entries...)
+				}
+			},
+			want: ids(entities(25)),
+		},
+		{
+			name: "removed before second page",
+			n:    25,
+			key:  true,
+			before: func(l *list, start int32, fetches int) {
+				if fetches == 1 {
+					l.entries = l.entries[1:]
+				}
+			},
+			want: ids(entities(25)),
+		},
+		{
+			name:    "added while fetching windows",
+			n:       25,
+			key:     true,
+			workers: 4,
+			before: func(l *list, start int32, fetches int) {
+				// The windows are fetched in any order.
+				if start == 20 && fetches < 3 {
+					l.entries = append([]*entity{{ID: 100}}, l.entries...)
+				}
+			},
+			want: ids(entities(25)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &list{entries: entities(tt.n), before: tt.before}
+			p := &paging.Pager[*entity]{Fetch: l.fetch, PageSize: 10, Overlap: 2, Workers: tt.workers}
+			if tt.key {
+				p.Key = key
+			}
+			if got := ids(collect(t, p)); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPagerWithoutKey checks that entries without a key are never
+// deduplicated, even if they look the same.
+func TestPagerWithoutKey(t *testing.T) {
+	for _, workers := range []int{0, 4} {
+		for _, withKey := range []bool{false, true} {
+			l := &list{entries: make([]*entity, 25)}
+			for i := range l.entries {
+				l.entries[i] = &entity{Status: "ENABLED"}
+			}
+			p := &paging.Pager[*entity]{Fetch: l.fetch, PageSize: 10, Workers: workers}
+			if withKey {
+				p.Key = key
+			}
+			if got := len(collect(t, p)); got != 25 {
+				t.Errorf("workers %d, key %v: got %d entries, want 25", workers, withKey, got)
+			}
+		}
+	}
+}
+
+func TestCampaignPager(t *testing.T) {
+	const total = 25
+	enabled := CampaignService.CampaignStatusENABLED
+	fake := &CampaignService.FakeCampaignService{
+		GetFunc: func(req *CampaignService.Get) (*CampaignService.GetResponse, error) {
+			sel := req.ServiceSelector
+			start, n := *sel.Paging.StartIndex, *sel.Paging.NumberResults
+			var entries []*CampaignService.Campaign
+			for i := start; i < min(start+n, total); i++ {
+				c := &CampaignService.Campaign{Status: &enabled}
+				for _, f := range sel.Fields {
+					if f == "Id" {
+						c.Id = CampaignService.Int64(int64(i + 1))
+					}
+				}
+				entries = append(entries, c)
+			}
+			return &CampaignService.GetResponse{Rval: &CampaignService.CampaignPage{
+				Page:    &CampaignService.Page{TotalNumEntries: CampaignService.Int32(total)},
+				Entries: entries,
+			}}, nil
+		},
+	}
+	for _, workers := range []int{0, 4} {
+		sel := &CampaignService.Selector{
+			Fields: []string{"Status"},
+			Paging: &CampaignService.Paging{NumberResults: CampaignService.Int32(10)},
+		}
+		p := CampaignService.GetPager(fake, sel)
+		p.Workers = workers
+		seen := make(map[int64]bool)
+		for c, err := range p.All() {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.Id == nil || seen[*c.Id] {
+				t.Fatalf("workers %d: campaign without Id or repeated: %v", workers, c.Id)
+			}
+			seen[*c.Id] = true
+		}
+		if len(seen) != total {
+			t.Errorf("workers %d: got %d campaigns, want %d", workers, len(seen), total)
+		}
+		if fields := sel.Fields; len(fields) != 1 {
+			t.Errorf("workers %d: selector of the caller changed to %v", workers, fields)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package paging
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/godofdream/go-googleadsinofficial/retry"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// DefaultChunkSize is the default number of values of a Splitter part.
+const DefaultChunkSize = 1000
+
+// tooLargeErrors are the ApiError reasons of selections exceeding the
+// result size a service returns.
+var tooLargeErrors = []string{
+	"SizeLimitError.RESPONSE_SIZE_LIMIT_EXCEEDED",
+	"SelectorError.START_INDEX_IS_TOO_HIGH",
+}
+
+// TooLarge reports whether err rejects a selection for the size of its
+// result, e.g. because it has more than the 100,000 entries a service
+// returns.
+func TooLarge(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, t := range tooLargeErrors {
+		if strings.Contains(msg, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// A Splitter fetches a selection too large for a service in parts. Each
+// part restricts the selector to a chunk of the values of Field with an IN
+// predicate, e.g. to a chunk of the campaigns with "CampaignId". Parts
+// that are still too large are halved until they hold a single value.
+type Splitter struct {
+	// Field is the field the parts are restricted on.
+	Field string
+
+	// Values returns the values of Field, e.g. the ids of all campaigns
+	// from CampaignService.GetIDs. It is only called if the selector does
+	// not already restrict Field with EQUALS or IN, whose values are split
+	// instead.
+	Values func() ([]string, error)
+
+	// ChunkSize is the number of values of a part, or DefaultChunkSize if
+	// 0.
+	ChunkSize int
+
+	// Workers and Retry are set on the Pager of every part if not zero.
+	Workers int
+	Retry   *retry.Policy
+}
+
+// SplitEach calls fn for every entry of the pager of sel. If sel is too
+// large, see TooLarge, it continues with the pagers of the parts of sel.
+// Entries returned before a selection turned out too large are skipped in
+// its parts by their key, so the pagers need a Key. A selection that turns
+// out too large after returning entries without a key fails.
+func SplitEach[T any](s *Splitter, sel *selector.Selector, pager func(*selector.Selector) (*Pager[T], error), fn func(T) error) error {
+	skip := make(map[string]bool)
+	// run fetches sel and reports whether it was too large.
+	run := func(sel *selector.Selector) (bool, error) {
+		p, err := pager(sel)
+		if err != nil {
+			return false, err
+		}
+		if p.Key == nil {
+			return false, fmt.Errorf("paging: splitting requires a pager with a Key")
+		}
+		if s.Workers != 0 {
+			p.Workers = s.Workers
+		}
+		if s.Retry != nil {
+			p.Retry = s.Retry
+		}
+		returned := make(map[string]bool)
+		unkeyed := false
+		err = p.Each(func(e T) error {
+			k := p.key(e)
+			switch {
+			case k == "":
+				unkeyed = true
+			case skip[k]:
+				return nil
+			default:
+				returned[k] = true
+			}
+			return fn(e)
+		})
+		if !TooLarge(err) {
+			return false, err
+		}
+		if unkeyed {
+			return false, fmt.Errorf("paging: entries without a key were returned before the selection turned out too large: %w", err)
+		}
+		for k := range returned {
+			skip[k] = true
+		}
+		return true, err
+	}
+
+	tooLarge, err := run(sel)
+	if !tooLarge {
+		return err
+	}
+	base, values := restrict(sel, s.Field)
+	if values == nil {
+		if s.Values == nil {
+			return err
+		}
+		if values, err = s.Values(); err != nil {
+			return err
+		}
+	}
+	size := s.ChunkSize
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+	var chunks [][]string
+	for len(values) > 0 {
+		n := min(size, len(values))
+		chunks = append(chunks, values[:n])
+		values = values[n:]
+	}
+	for len(chunks) > 0 {
+		chunk := chunks[0]
+		chunks = chunks[1:]
+		part := *base
+		part.Predicates = append(append([]*selector.Predicate(nil), base.Predicates...),
+			&selector.Predicate{Field: s.Field, Operator: selector.In, Values: chunk})
+		if base.Paging != nil {
+			// Keep the page size, but start every part at its beginning.
+			part.Paging = &selector.Paging{NumberResults: base.Paging.NumberResults}
+		}
+		tooLarge, err := run(&part)
+		switch {
+		case tooLarge && len(chunk) > 1:
+			half := len(chunk) / 2
+			chunks = append([][]string{chunk[:half], chunk[half:]}, chunks...)
+		case tooLarge:
+			return fmt.Errorf("paging: %s %s alone is too large: %w", s.Field, chunk[0], err)
+		case err != nil:
+			return err
+		}
+	}
+	return nil
+}
+
+// SplitAll returns an iterator over the entries of SplitEach.
+func SplitAll[T any](s *Splitter, sel *selector.Selector, pager func(*selector.Selector) (*Pager[T], error)) iter.Seq2[T, error] {
+	return seq(func(fn func(T) error) error {
+		return SplitEach(s, sel, pager, fn)
+	})
+}
+
+// restrict returns sel without its EQUALS or IN predicates on field and
+// their values, or sel and nil if it has none.
+func restrict(sel *selector.Selector, field string) (*selector.Selector, []string) {
+	base := *sel
+	base.Predicates = nil
+	var values []string
+	found := false
+	for _, p := range sel.Predicates {
+		if p.Field == field && (p.Operator == selector.Equals || p.Operator == selector.In) {
+			if found {
+				values = intersect(values, p.Values)
+			} else {
+				values = append([]string(nil), p.Values...)
+			}
+			found = true
+			continue
+		}
+		base.Predicates = append(base.Predicates, p)
+	}
+	if !found {
+		return sel, nil
+	}
+	if values == nil {
+		values = []string{}
+	}
+	return &base, values
+}
+
+func intersect(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, v := range b {
+		in[v] = true
+	}
+	var both []string
+	for _, v := range a {
+		if in[v] {
+			both = append(both, v)
+		}
+	}
+	return both
+}
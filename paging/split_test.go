@@ -0,0 +1,83 @@
+package paging_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// splitPager returns pagers over 25 entities, which look the same except
+// for their ids, in parent 1 to 5. Selections of more than 10 entities
+// fail with a SizeLimitError after the first page.
+func splitPager(key func(*entity) string) func(*selector.Selector) (*paging.Pager[*entity], error) {
+	return func(sel *selector.Selector) (*paging.Pager[*entity], error) {
+		var entries []*entity
+		for _, e := range entities(25) {
+			parent := strconv.FormatInt((e.ID-1)/5+1, 10)
+			in := true
+			for _, p := range sel.Predicates {
+				in = in && p.Field == "ParentId" && contains(p.Values, parent)
+			}
+			if in {
+				entries = append(entries, &entity{ID: e.ID, Status: e.Status})
+			}
+		}
+		l := &list{entries: entries}
+		return &paging.Pager[*entity]{
+			Key:      key,
+			PageSize: 5,
+			Fetch: func(start, n int32) ([]*entity, int32, error) {
+				if start > 0 && len(entries) > 10 {
+					return nil, 0, errors.New("[SizeLimitError.RESPONSE_SIZE_LIMIT_EXCEEDED @ ; trigger:'']")
+				}
+				return l.fetch(start, n)
+			},
+		}, nil
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSplitEach(t *testing.T) {
+	s := &paging.Splitter{
+		Field:     "ParentId",
+		Values:    func() ([]string, error) { return []string{"1", "2", "3", "4", "5"}, nil },
+		ChunkSize: 3,
+	}
+	var got []*entity
+	err := paging.SplitEach(s, new(selector.Selector), splitPager(key), func(e *entity) error {
+		got = append(got, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[int64]bool)
+	for _, e := range got {
+		if seen[e.ID] {
+			t.Errorf("entity %d returned twice", e.ID)
+		}
+		seen[e.ID] = true
+	}
+	if len(seen) != 25 {
+		t.Errorf("got %d entities, want 25", len(seen))
+	}
+
+	if err := paging.SplitEach(s, new(selector.Selector), splitPager(nil), func(*entity) error { return nil }); err == nil {
+		t.Error("splitting without a Key succeeded")
+	}
+	unkeyed := func(*entity) string { return "" }
+	if err := paging.SplitEach(s, new(selector.Selector), splitPager(unkeyed), func(*entity) error { return nil }); err == nil {
+		t.Error("splitting entries without keys succeeded")
+	}
+}
@@ -0,0 +1,516 @@
+// Package parquet writes flat tables as Apache Parquet files. It covers
+// what reports need and nothing more: optional columns of booleans,
+// integers, doubles, strings and dates, dictionary encoded strings and
+// uncompressed pages.
+//
+//	w, err := parquet.NewWriter(f, []parquet.Column{
+//		{Name: "CampaignId", Type: parquet.Int64},
+//		{Name: "CampaignStatus", Type: parquet.String, Dictionary: true},
+//	})
+//	err = w.Write([]interface{}{int64(1), "ENABLED"})
+//	err = w.Close()
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"time"
+)
+
+// DefaultRowGroupSize is the default number of rows of a row group.
+const DefaultRowGroupSize = 100000
+
+// A Type is the type of the values of a Column.
+type Type int
+
+// Column types.
+const (
+	// Boolean values are bool.
+	Boolean Type = iota
+	// Int32 values are int32 or int64 within its range.
+	Int32
+	// Int64 values are int64.
+	Int64
+	// Double values are float64.
+	Double
+	// String values are string.
+	String
+	// Date values are time.Time; their date in their location is stored.
+	Date
+)
+
+// Physical types, encodings, repetition and converted types of the
+// Parquet format.
+const (
+	typeBoolean   = 0
+	typeInt32     = 1
+	typeInt64     = 2
+	typeDouble    = 5
+	typeByteArray = 6
+
+	encodingPlain         = 0
+	encodingRLE           = 3
+	encodingRLEDictionary = 8
+
+	repetitionOptional = 1
+
+	convertedUTF8 = 0
+	convertedDate = 6
+
+	pageData       = 0
+	pageDictionary = 2
+)
+
+var magic = []byte("PAR1")
+
+// A Column is an optional column of a table.
+type Column struct {
+	Name string
+	Type Type
+
+	// Dictionary stores the distinct values of a String column once and
+	// the values as indexes into them, e.g. for enums.
+	Dictionary bool
+}
+
+func (c Column) physical() int32 {
+	switch c.Type {
+	case Boolean:
+		return typeBoolean
+	case Int32, Date:
+		return typeInt32
+	case Int64:
+		return typeInt64
+	case Double:
+		return typeDouble
+	}
+	return typeByteArray
+}
+
+// A Writer writes the rows of a table to a Parquet file. Rows are buffered
+// in memory until a row group is complete.
+type Writer struct {
+	// RowGroupSize is the number of rows of a row group, or
+	// DefaultRowGroupSize if 0.
+	RowGroupSize int
+
+	w       io.Writer
+	offset  int64
+	columns []*column
+	rows    int
+	groups  []*rowGroup
+	err     error
+}
+
+// column buffers the values of a column of the current row group.
+type column struct {
+	Column
+
+	// present holds whether every row has a value. The values are in
+	// ints, floats, bools or strs, or indexes in dict if Dictionary is set.
+	present []bool
+	ints    []int64
+	floats  []float64
+	bools   []bool
+	strs    []string
+
+	dict    map[string]int
+	indexes []int64
+}
+
+// rowGroup holds the metadata of a written row group.
+type rowGroup struct {
+	rows   int
+	size   int64
+	chunks []*chunk
+}
+
+// chunk holds the metadata of a written column chunk.
+type chunk struct {
+	values             int
+	offset, dictOffset int64
+	size               int64
+	dictionary         bool
+	physical           int32
+	name               string
+}
+
+// NewWriter returns a Writer of a table with the given columns to w.
+func NewWriter(w io.Writer, columns []Column) (*Writer, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("parquet: no columns")
+	}
+	pw := &Writer{w: w}
+	names := make(map[string]bool)
+	for _, c := range columns {
+		if c.Name == "" || names[c.Name] {
+			return nil, fmt.Errorf("parquet: empty or duplicate column name %q", c.Name)
+		}
+		if c.Type < Boolean || c.Type > Date {
+			return nil, fmt.Errorf("parquet: column %s has unknown type %d", c.Name, c.Type)
+		}
+		if c.Dictionary && c.Type != String {
+			return nil, fmt.Errorf("parquet: column %s: only strings are dictionary encoded", c.Name)
+		}
+		names[c.Name] = true
+		pw.columns = append(pw.columns, &column{Column: c})
+	}
+	if err := pw.write(magic); err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+// Write adds a row with a value for every column. A nil value is null.
+func (w *Writer) Write(row []interface{}) error {
+	if w.err != nil {
+		return w.err
+	}
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("parquet: row has %d values, want %d", len(row), len(w.columns))
+	}
+	for i, c := range w.columns {
+		if err := c.check(row[i]); err != nil {
+			return err
+		}
+	}
+	for i, c := range w.columns {
+		c.add(row[i])
+	}
+	w.rows++
+	size := w.RowGroupSize
+	if size <= 0 {
+		size = DefaultRowGroupSize
+	}
+	if w.rows >= size {
+		return w.Flush()
+	}
+	return nil
+}
+
+// check reports whether v is a value of c.
+func (c *column) check(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	ok := false
+	switch v := v.(type) {
+	case bool:
+		ok = c.Type == Boolean
+	case int32:
+		ok = c.Type == Int32
+	case int64:
+		ok = c.Type == Int64 || c.Type == Int32 && v >= math.MinInt32 && v <= math.MaxInt32
+	case float64:
+		ok = c.Type == Double
+	case string:
+		ok = c.Type == String
+	case time.Time:
+		ok = c.Type == Date
+	}
+	if !ok {
+		return fmt.Errorf("parquet: invalid value %v of type %T for column %s", v, v, c.Name)
+	}
+	return nil
+}
+
+func (c *column) add(v interface{}) {
+	c.present = append(c.present, v != nil)
+	switch v := v.(type) {
+	case bool:
+		c.bools = append(c.bools, v)
+	case int32:
+		c.ints = append(c.ints, int64(v))
+	case int64:
+		c.ints = append(c.ints, v)
+	case float64:
+		c.floats = append(c.floats, v)
+	case time.Time:
+		y, m, d := v.Date()
+		days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+		c.ints = append(c.ints, days)
+	case string:
+		if !c.Dictionary {
+			c.strs = append(c.strs, v)
+			break
+		}
+		if c.dict == nil {
+			c.dict = make(map[string]int)
+		}
+		i, ok := c.dict[v]
+		if !ok {
+			i = len(c.strs)
+			c.dict[v] = i
+			c.strs = append(c.strs, v)
+		}
+		c.indexes = append(c.indexes, int64(i))
+	}
+}
+
+// Flush writes the buffered rows as a row group.
+func (w *Writer) Flush() error {
+	if w.err != nil || w.rows == 0 {
+		return w.err
+	}
+	g := &rowGroup{rows: w.rows}
+	for _, c := range w.columns {
+		ch, err := w.writeChunk(c)
+		if err != nil {
+			return err
+		}
+		g.size += ch.size
+		g.chunks = append(g.chunks, ch)
+		*c = column{Column: c.Column}
+	}
+	w.groups = append(w.groups, g)
+	w.rows = 0
+	return nil
+}
+
+// Close flushes the buffered rows and writes the metadata of the file. It
+// does not close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	meta := w.metadata()
+	if err := w.write(meta); err != nil {
+		return err
+	}
+	if err := w.write(binary.LittleEndian.AppendUint32(nil, uint32(len(meta)))); err != nil {
+		return err
+	}
+	if err := w.write(magic); err != nil {
+		return err
+	}
+	w.err = fmt.Errorf("parquet: writer is closed")
+	return nil
+}
+
+func (w *Writer) write(b []byte) error {
+	if w.err != nil {
+		return w.err
+	}
+	n, err := w.w.Write(b)
+	w.offset += int64(n)
+	if err != nil {
+		w.err = err
+	}
+	return err
+}
+
+// writeChunk writes the pages of the buffered values of c.
+func (w *Writer) writeChunk(c *column) (*chunk, error) {
+	ch := &chunk{
+		values:     len(c.present),
+		offset:     w.offset,
+		dictionary: c.Dictionary && len(c.strs) > 0,
+		physical:   c.physical(),
+		name:       c.Name,
+	}
+	if ch.dictionary {
+		ch.dictOffset = w.offset
+		dict := c.plain()
+		header := pageHeader(pageDictionary, len(dict), len(c.strs), encodingPlain)
+		if err := w.write(append(header, dict...)); err != nil {
+			return nil, err
+		}
+		ch.offset = w.offset
+	}
+
+	levels := hybrid(nil, boolsToInts(c.present), 1)
+	page := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+	page = append(page, levels...)
+	encoding := int32(encodingPlain)
+	if ch.dictionary {
+		width := bits.Len(uint(len(c.strs) - 1))
+		if width == 0 {
+			width = 1
+		}
+		page = append(page, byte(width))
+		page = hybrid(page, c.indexes, width)
+		encoding = encodingRLEDictionary
+	} else {
+		page = append(page, c.plain()...)
+	}
+	header := pageHeader(pageData, len(page), len(c.present), encoding)
+	if err := w.write(append(header, page...)); err != nil {
+		return nil, err
+	}
+	start := ch.offset
+	if ch.dictionary {
+		start = ch.dictOffset
+	}
+	ch.size = w.offset - start
+	return ch, nil
+}
+
+// plain returns the PLAIN encoding of the values of c, or of the
+// dictionary of a dictionary encoded column.
+func (c *column) plain() []byte {
+	var b []byte
+	switch c.Type {
+	case Boolean:
+		for i := 0; i < len(c.bools); i += 8 {
+			var packed byte
+			for j := 0; j < 8 && i+j < len(c.bools); j++ {
+				if c.bools[i+j] {
+					packed |= 1 << j
+				}
+			}
+			b = append(b, packed)
+		}
+	case Int32, Date:
+		for _, v := range c.ints {
+			b = binary.LittleEndian.AppendUint32(b, uint32(int32(v)))
+		}
+	case Int64:
+		for _, v := range c.ints {
+			b = binary.LittleEndian.AppendUint64(b, uint64(v))
+		}
+	case Double:
+		for _, v := range c.floats {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(v))
+		}
+	case String:
+		for _, v := range c.strs {
+			b = binary.LittleEndian.AppendUint32(b, uint32(len(v)))
+			b = append(b, v...)
+		}
+	}
+	return b
+}
+
+func boolsToInts(bs []bool) []int64 {
+	ints := make([]int64, len(bs))
+	for i, b := range bs {
+		if b {
+			ints[i] = 1
+		}
+	}
+	return ints
+}
+
+// hybrid appends values of the given bit width in the RLE/bit-packing
+// hybrid encoding to b. All values are written as a single bit-packed run.
+func hybrid(b []byte, values []int64, width int) []byte {
+	if len(values) == 0 {
+		return b
+	}
+	groups := (len(values) + 7) / 8
+	b = binary.AppendUvarint(b, uint64(groups)<<1|1)
+	var acc uint64
+	n := 0
+	for i := 0; i < groups*8; i++ {
+		var v uint64
+		if i < len(values) {
+			v = uint64(values[i])
+		}
+		acc |= v << n
+		n += width
+		for n >= 8 {
+			b = append(b, byte(acc))
+			acc >>= 8
+			n -= 8
+		}
+	}
+	return b
+}
+
+// pageHeader returns the PageHeader of a data or dictionary page.
+func pageHeader(typ int32, size, values int, encoding int32) []byte {
+	e := newEncoder()
+	e.i32(1, typ)
+	e.i32(2, int32(size))
+	e.i32(3, int32(size))
+	if typ == pageDictionary {
+		e.field(7)
+		e.i32(1, int32(values))
+		e.i32(2, encoding)
+		e.end()
+	} else {
+		e.field(5)
+		e.i32(1, int32(values))
+		e.i32(2, encoding)
+		e.i32(3, encodingRLE)
+		e.i32(4, encodingRLE)
+		e.end()
+	}
+	e.end()
+	return e.b
+}
+
+// metadata returns the FileMetaData of the written row groups.
+func (w *Writer) metadata() []byte {
+	e := newEncoder()
+	e.i32(1, 1)
+	e.list(2, thriftStruct, len(w.columns)+1)
+	e.begin()
+	e.str(4, "schema")
+	e.i32(5, int32(len(w.columns)))
+	e.end()
+	for _, c := range w.columns {
+		e.begin()
+		e.i32(1, c.physical())
+		e.i32(3, repetitionOptional)
+		e.str(4, c.Name)
+		switch c.Type {
+		case String:
+			e.i32(6, convertedUTF8)
+		case Date:
+			e.i32(6, convertedDate)
+		}
+		e.end()
+	}
+	var rows int64
+	for _, g := range w.groups {
+		rows += int64(g.rows)
+	}
+	e.i64(3, rows)
+	e.list(4, thriftStruct, len(w.groups))
+	for _, g := range w.groups {
+		e.begin()
+		e.list(1, thriftStruct, len(g.chunks))
+		for _, ch := range g.chunks {
+			start := ch.offset
+			if ch.dictionary {
+				start = ch.dictOffset
+			}
+			e.begin()
+			e.i64(2, start)
+			e.field(3)
+			e.i32(1, ch.physical)
+			if ch.dictionary {
+				e.list(2, thriftI32, 3)
+				e.listI32(encodingPlain)
+				e.listI32(encodingRLE)
+				e.listI32(encodingRLEDictionary)
+			} else {
+				e.list(2, thriftI32, 2)
+				e.listI32(encodingPlain)
+				e.listI32(encodingRLE)
+			}
+			e.list(3, thriftBinary, 1)
+			e.listStr(ch.name)
+			e.i32(4, 0)
+			e.i64(5, int64(ch.values))
+			e.i64(6, ch.size)
+			e.i64(7, ch.size)
+			e.i64(9, ch.offset)
+			if ch.dictionary {
+				e.i64(11, ch.dictOffset)
+			}
+			e.end()
+			e.end()
+		}
+		e.i64(2, g.size)
+		e.i64(3, int64(g.rows))
+		e.end()
+	}
+	e.str(6, "github.com/godofdream/go-googleadsinofficial/parquet")
+	e.end()
+	return e.b
+}
@@ -0,0 +1,90 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestHybrid(t *testing.T) {
+	tests := []struct {
+		values []int64
+		width  int
+		want   []byte
+	}{
+		{nil, 1, nil},
+		// One group of 8 values, LSB first.
+		{[]int64{1, 0, 1, 1, 0, 0, 0, 1}, 1, []byte{0x03, 0x8d}},
+		// The group is padded with zeros.
+		{[]int64{0, 1, 2, 3}, 2, []byte{0x03, 0xe4, 0x00}},
+		// Two groups.
+		{[]int64{1, 1, 1, 1, 1, 1, 1, 1, 1}, 1, []byte{0x05, 0xff, 0x01}},
+	}
+	for _, tt := range tests {
+		if got := hybrid(nil, tt.values, tt.width); !bytes.Equal(got, tt.want) {
+			t.Errorf("hybrid(%v, %d) = % x, want % x", tt.values, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestPageHeader(t *testing.T) {
+	tests := []struct {
+		name         string
+		typ          int32
+		size, values int
+		encoding     int32
+		want         []byte
+	}{
+		{
+			name: "data", typ: pageData, size: 9, values: 4, encoding: encodingRLEDictionary,
+			want: []byte{0x15, 0x00, 0x15, 0x12, 0x15, 0x12, 0x2c, 0x15, 0x08, 0x15, 0x10, 0x15, 0x06, 0x15, 0x06, 0x00, 0x00},
+		},
+		{
+			name: "dictionary", typ: pageDictionary, size: 10, values: 2, encoding: encodingPlain,
+			want: []byte{0x15, 0x04, 0x15, 0x14, 0x15, 0x14, 0x4c, 0x15, 0x04, 0x15, 0x00, 0x00, 0x00},
+		},
+	}
+	for _, tt := range tests {
+		if got := pageHeader(tt.typ, tt.size, tt.values, tt.encoding); !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: got % x, want % x", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []Column{{Name: "Status", Type: String, Dictionary: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []interface{}{"A", "B", "A", nil} {
+		if err := w.Write([]interface{}{v}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+
+	var want []byte
+	want = append(want, "PAR1"...)
+	// The dictionary page: "A" and "B" in the plain encoding.
+	want = append(want, 0x15, 0x04, 0x15, 0x14, 0x15, 0x14, 0x4c, 0x15, 0x04, 0x15, 0x00, 0x00, 0x00)
+	want = append(want, 0x01, 0x00, 0x00, 0x00, 'A', 0x01, 0x00, 0x00, 0x00, 'B')
+	// The data page: the definition levels 1, 1, 1, 0 and the indexes 0, 1,
+	// 0 of the three values of bit width 1.
+	want = append(want, 0x15, 0x00, 0x15, 0x12, 0x15, 0x12, 0x2c, 0x15, 0x08, 0x15, 0x10, 0x15, 0x06, 0x15, 0x06, 0x00, 0x00)
+	want = append(want, 0x02, 0x00, 0x00, 0x00, 0x03, 0x07, 0x01, 0x03, 0x02)
+	if !bytes.HasPrefix(b, want) {
+		t.Errorf("got\n% x\nwant prefix\n% x", b, want)
+	}
+
+	if !bytes.HasSuffix(b, magic) {
+		t.Fatal("missing magic at the end")
+	}
+	n := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	if footer := len(b) - 8 - n; footer != len(want) {
+		t.Errorf("footer at %d, want %d", footer, len(want))
+	}
+}
@@ -0,0 +1,504 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// The reader below follows the Parquet format specification
+// (parquet.thrift and Encodings.md) independently of the writer: it uses
+// the numbers of the specification rather than the constants of the
+// writer, decodes any Thrift compact struct, both run types of the
+// RLE/bit-packing hybrid and checks the offsets and sizes of the metadata
+// against the pages it reads.
+
+// tstruct is a decoded Thrift struct by field id.
+type tstruct map[int16]interface{}
+
+// tdecoder decodes the Thrift compact protocol.
+type tdecoder struct {
+	b   []byte
+	pos int
+	err error
+}
+
+func (d *tdecoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf("offset %d: "+format, append([]interface{}{d.pos}, args...)...)
+	}
+}
+
+func (d *tdecoder) byte() byte {
+	if d.pos >= len(d.b) {
+		d.fail("unexpected end")
+		return 0
+	}
+	d.pos++
+	return d.b[d.pos-1]
+}
+
+func (d *tdecoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.b[d.pos:])
+	if n <= 0 {
+		d.fail("invalid varint")
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *tdecoder) varint() int64 {
+	v, n := binary.Varint(d.b[d.pos:])
+	if n <= 0 {
+		d.fail("invalid varint")
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *tdecoder) value(typ byte) interface{} {
+	switch typ {
+	case 1:
+		return true
+	case 2:
+		return false
+	case 3:
+		return int64(int8(d.byte()))
+	case 4, 5, 6:
+		return d.varint()
+	case 7:
+		if d.pos+8 > len(d.b) {
+			d.fail("unexpected end")
+			return 0.0
+		}
+		d.pos += 8
+		return math.Float64frombits(binary.LittleEndian.Uint64(d.b[d.pos-8:]))
+	case 8:
+		n := int(d.uvarint())
+		if d.err != nil || d.pos+n > len(d.b) {
+			d.fail("unexpected end")
+			return []byte(nil)
+		}
+		d.pos += n
+		return d.b[d.pos-n : d.pos]
+	case 9, 10:
+		h := d.byte()
+		n, elem := int(h>>4), h&0x0f
+		if n == 15 {
+			n = int(d.uvarint())
+		}
+		var list []interface{}
+		for i := 0; i < n && d.err == nil; i++ {
+			if elem == 1 || elem == 2 {
+				list = append(list, d.byte() == 1)
+				continue
+			}
+			list = append(list, d.value(elem))
+		}
+		return list
+	case 12:
+		return d.structure()
+	}
+	d.fail("unknown type %d", typ)
+	return nil
+}
+
+func (d *tdecoder) structure() tstruct {
+	s := make(tstruct)
+	var id int16
+	for d.err == nil {
+		h := d.byte()
+		if h == 0 {
+			break
+		}
+		if delta := h >> 4; delta != 0 {
+			id += int16(delta)
+		} else {
+			id = int16(d.varint())
+		}
+		s[id] = d.value(h & 0x0f)
+	}
+	return s
+}
+
+func (s tstruct) int(id int16) int64 {
+	v, _ := s[id].(int64)
+	return v
+}
+
+func (s tstruct) has(id int16) bool {
+	_, ok := s[id]
+	return ok
+}
+
+// hybridValues decodes n values of the RLE/bit-packing hybrid encoding of
+// the given bit width from b.
+func hybridValues(b []byte, width, n int) ([]int64, error) {
+	var values []int64
+	pos := 0
+	for len(values) < n {
+		h, k := binary.Uvarint(b[pos:])
+		if k <= 0 {
+			return nil, fmt.Errorf("invalid run header at %d", pos)
+		}
+		pos += k
+		if h&1 == 0 {
+			// An RLE run: the count and the value in (width+7)/8 bytes.
+			bytes := (width + 7) / 8
+			if pos+bytes > len(b) {
+				return nil, fmt.Errorf("short RLE run")
+			}
+			var v int64
+			for i := 0; i < bytes; i++ {
+				v |= int64(b[pos+i]) << (8 * i)
+			}
+			pos += bytes
+			for i := uint64(0); i < h>>1; i++ {
+				values = append(values, v)
+			}
+			continue
+		}
+		// A bit-packed run of groups of 8 values, LSB first.
+		count := int(h>>1) * 8
+		if pos+count*width/8 > len(b) {
+			return nil, fmt.Errorf("short bit-packed run")
+		}
+		for i := 0; i < count; i++ {
+			var v int64
+			for j := 0; j < width; j++ {
+				bit := i*width + j
+				if b[pos+bit/8]>>(bit%8)&1 == 1 {
+					v |= 1 << j
+				}
+			}
+			values = append(values, v)
+		}
+		pos += count * width / 8
+	}
+	return values[:n], nil
+}
+
+// readColumn is a column of a file read by readFile.
+type readColumn struct {
+	name      string
+	physical  int64
+	converted int64
+	values    []interface{}
+}
+
+// readFile reads all columns of a Parquet file.
+func readFile(b []byte) ([]*readColumn, error) {
+	if !bytes.HasPrefix(b, []byte("PAR1")) || !bytes.HasSuffix(b, []byte("PAR1")) || len(b) < 12 {
+		return nil, fmt.Errorf("no magic")
+	}
+	n := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	footer := len(b) - 8 - n
+	if footer < 4 {
+		return nil, fmt.Errorf("invalid footer length %d", n)
+	}
+	d := &tdecoder{b: b[footer : len(b)-8]}
+	meta := d.structure()
+	if d.err != nil {
+		return nil, fmt.Errorf("metadata: %v", d.err)
+	}
+	if d.pos != n {
+		return nil, fmt.Errorf("metadata has %d bytes, footer length %d", d.pos, n)
+	}
+
+	schema, _ := meta[2].([]interface{})
+	if len(schema) < 2 || schema[0].(tstruct).int(5) != int64(len(schema)-1) {
+		return nil, fmt.Errorf("invalid schema %v", schema)
+	}
+	var columns []*readColumn
+	for _, e := range schema[1:] {
+		e := e.(tstruct)
+		if e.int(3) != 1 {
+			return nil, fmt.Errorf("column %s is not OPTIONAL", e[4])
+		}
+		c := &readColumn{name: string(e[4].([]byte)), physical: e.int(1), converted: -1}
+		if e.has(6) {
+			c.converted = e.int(6)
+		}
+		columns = append(columns, c)
+	}
+
+	groups, _ := meta[4].([]interface{})
+	var rows int64
+	end := int64(4)
+	for gi, g := range groups {
+		g := g.(tstruct)
+		chunks := g[1].([]interface{})
+		if len(chunks) != len(columns) {
+			return nil, fmt.Errorf("row group %d has %d chunks", gi, len(chunks))
+		}
+		var size int64
+		for ci, ch := range chunks {
+			cm := ch.(tstruct)[3].(tstruct)
+			c := columns[ci]
+			if cm.int(1) != c.physical || string(cm[3].([]interface{})[0].([]byte)) != c.name || cm.int(4) != 0 {
+				return nil, fmt.Errorf("row group %d: chunk %d doesn't match column %s", gi, ci, c.name)
+			}
+			start := cm.int(9)
+			if cm.has(11) {
+				start = cm.int(11)
+			}
+			if start != end {
+				return nil, fmt.Errorf("chunk %s of row group %d starts at %d, want %d", c.name, gi, start, end)
+			}
+			values, read, err := readChunk(b, start, cm, c)
+			if err != nil {
+				return nil, fmt.Errorf("chunk %s of row group %d: %v", c.name, gi, err)
+			}
+			if read != cm.int(6) || read != cm.int(7) {
+				return nil, fmt.Errorf("chunk %s of row group %d: read %d bytes, metadata says %d/%d", c.name, gi, read, cm.int(6), cm.int(7))
+			}
+			if int64(len(values)) != g.int(3) || cm.int(5) != g.int(3) {
+				return nil, fmt.Errorf("chunk %s of row group %d has %d values, want %d", c.name, gi, len(values), g.int(3))
+			}
+			c.values = append(c.values, values...)
+			size += read
+			end += read
+		}
+		if size != g.int(2) {
+			return nil, fmt.Errorf("row group %d has %d bytes, metadata says %d", gi, size, g.int(2))
+		}
+		rows += g.int(3)
+	}
+	if rows != meta.int(3) {
+		return nil, fmt.Errorf("read %d rows, metadata says %d", rows, meta.int(3))
+	}
+	if end != int64(footer) {
+		return nil, fmt.Errorf("pages end at %d, the metadata starts at %d", end, footer)
+	}
+	return columns, nil
+}
+
+// readChunk reads the pages of a column chunk starting at offset, and
+// returns its values and its size.
+func readChunk(b []byte, offset int64, cm tstruct, c *readColumn) ([]interface{}, int64, error) {
+	pos := int(offset)
+	var dict []interface{}
+	for {
+		start := int64(pos)
+		d := &tdecoder{b: b, pos: pos}
+		header := d.structure()
+		if d.err != nil {
+			return nil, 0, d.err
+		}
+		size := int(header.int(3))
+		if header.int(2) != int64(size) || d.pos+size > len(b) {
+			return nil, 0, fmt.Errorf("invalid page sizes %d/%d", header.int(2), size)
+		}
+		page := b[d.pos : d.pos+size]
+		pos = d.pos + size
+
+		switch header.int(1) {
+		case 2: // DICTIONARY_PAGE
+			dh := header[7].(tstruct)
+			if dict != nil || dh.int(2) != 0 || !cm.has(11) || start != cm.int(11) {
+				return nil, 0, fmt.Errorf("unexpected dictionary page")
+			}
+			var err error
+			if dict, err = plainValues(page, c.physical, int(dh.int(1))); err != nil {
+				return nil, 0, err
+			}
+		case 0: // DATA_PAGE
+			if start != cm.int(9) {
+				return nil, 0, fmt.Errorf("data page at %d, metadata says %d", start, cm.int(9))
+			}
+			dh := header[5].(tstruct)
+			n := int(dh.int(1))
+			if dh.int(3) != 3 || dh.int(4) != 3 {
+				return nil, 0, fmt.Errorf("levels are not RLE encoded")
+			}
+			levelsSize := int(binary.LittleEndian.Uint32(page))
+			levels, err := hybridValues(page[4:4+levelsSize], 1, n)
+			if err != nil {
+				return nil, 0, fmt.Errorf("definition levels: %v", err)
+			}
+			present := 0
+			for _, l := range levels {
+				present += int(l)
+			}
+			data := page[4+levelsSize:]
+			var values []interface{}
+			switch dh.int(2) {
+			case 0: // PLAIN
+				values, err = plainValues(data, c.physical, present)
+			case 8: // RLE_DICTIONARY
+				if dict == nil {
+					return nil, 0, fmt.Errorf("dictionary encoded page without dictionary")
+				}
+				var indexes []int64
+				if present > 0 {
+					indexes, err = hybridValues(data[1:], int(data[0]), present)
+				}
+				for _, i := range indexes {
+					if i < 0 || int(i) >= len(dict) {
+						return nil, 0, fmt.Errorf("index %d beyond the dictionary of %d values", i, len(dict))
+					}
+					values = append(values, dict[i])
+				}
+			default:
+				return nil, 0, fmt.Errorf("unknown encoding %d", dh.int(2))
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			var out []interface{}
+			for _, l := range levels {
+				if l == 0 {
+					out = append(out, nil)
+					continue
+				}
+				out = append(out, values[0])
+				values = values[1:]
+			}
+			return out, int64(pos) - offset, nil
+		default:
+			return nil, 0, fmt.Errorf("unknown page type %d", header.int(1))
+		}
+	}
+}
+
+// plainValues decodes n values of the PLAIN encoding of a physical type.
+func plainValues(b []byte, physical int64, n int) ([]interface{}, error) {
+	var values []interface{}
+	pos := 0
+	for i := 0; i < n; i++ {
+		switch physical {
+		case 0: // BOOLEAN
+			if i/8 >= len(b) {
+				return nil, fmt.Errorf("short booleans")
+			}
+			values = append(values, b[i/8]>>(i%8)&1 == 1)
+		case 1: // INT32
+			if pos+4 > len(b) {
+				return nil, fmt.Errorf("short INT32")
+			}
+			values = append(values, int32(binary.LittleEndian.Uint32(b[pos:])))
+			pos += 4
+		case 2: // INT64
+			if pos+8 > len(b) {
+				return nil, fmt.Errorf("short INT64")
+			}
+			values = append(values, int64(binary.LittleEndian.Uint64(b[pos:])))
+			pos += 8
+		case 5: // DOUBLE
+			if pos+8 > len(b) {
+				return nil, fmt.Errorf("short DOUBLE")
+			}
+			values = append(values, math.Float64frombits(binary.LittleEndian.Uint64(b[pos:])))
+			pos += 8
+		case 6: // BYTE_ARRAY
+			if pos+4 > len(b) {
+				return nil, fmt.Errorf("short BYTE_ARRAY")
+			}
+			l := int(binary.LittleEndian.Uint32(b[pos:]))
+			if pos+4+l > len(b) {
+				return nil, fmt.Errorf("short BYTE_ARRAY")
+			}
+			values = append(values, string(b[pos+4:pos+4+l]))
+			pos += 4 + l
+		default:
+			return nil, fmt.Errorf("unknown physical type %d", physical)
+		}
+	}
+	return values, nil
+}
+
+func TestReadBack(t *testing.T) {
+	columns := []Column{
+		{Name: "Enabled", Type: Boolean},
+		{Name: "QualityScore", Type: Int32},
+		{Name: "Cost", Type: Int64},
+		{Name: "Ctr", Type: Double},
+		{Name: "Criteria", Type: String},
+		{Name: "Status", Type: String, Dictionary: true},
+		{Name: "Date", Type: Date},
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		berlin = time.FixedZone("CET", 3600)
+	}
+	rows := [][]interface{}{
+		{true, int32(7), int64(1230000), 0.25, "shoes", "ENABLED", time.Date(2018, 1, 31, 23, 30, 0, 0, berlin)},
+		{false, nil, int64(0), nil, "", "PAUSED", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{nil, int64(-3), nil, math.Inf(1), nil, nil, nil},
+		{true, int32(math.MaxInt32), int64(math.MinInt64), -0.5, "größe", "ENABLED", time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{nil, nil, nil, nil, nil, nil, nil},
+	}
+	for _, size := range []int{0, 1, 2} {
+		t.Run(fmt.Sprint("row group size ", size), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, columns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w.RowGroupSize = size
+			for _, row := range rows {
+				if err := w.Write(row); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			read, err := readFile(buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(read) != len(columns) {
+				t.Fatalf("read %d columns", len(read))
+			}
+			// UTF8 is converted type 0 and DATE 6.
+			converted := []int64{-1, -1, -1, -1, 0, 0, 6}
+			for i, c := range read {
+				if c.name != columns[i].Name || c.converted != converted[i] {
+					t.Errorf("column %d: got %s with converted type %d", i, c.name, c.converted)
+				}
+			}
+			for r, row := range rows {
+				for i, v := range row {
+					want := v
+					switch v := v.(type) {
+					case int64:
+						if columns[i].Type == Int32 {
+							want = int32(v)
+						}
+					case time.Time:
+						y, m, d := v.Date()
+						want = int32(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+					}
+					if got := read[i].values[r]; !reflect.DeepEqual(got, want) {
+						t.Errorf("row %d, column %s: got %#v, want %#v", r, columns[i].Name, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestHybridValues(t *testing.T) {
+	// An RLE run of five 3s of width 2, and a bit-packed run of 1, 1, 0
+	// padded to eight values.
+	values, err := hybridValues([]byte{0x0a, 0x03, 0x03, 0x05, 0x00}, 2, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{3, 3, 3, 3, 3, 1, 1, 0}; !reflect.DeepEqual(values, want) {
+		t.Errorf("got %v, want %v", values, want)
+	}
+	// The reader decodes what the writer encodes.
+	in := []int64{0, 5, 7, 1, 2, 3, 4, 6, 7, 0}
+	if out, err := hybridValues(hybrid(nil, in, 3), 3, len(in)); err != nil || !reflect.DeepEqual(out, in) {
+		t.Errorf("got %v, %v, want %v", out, err, in)
+	}
+}
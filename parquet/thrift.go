@@ -0,0 +1,92 @@
+package parquet
+
+import "encoding/binary"
+
+// Thrift compact protocol types.
+const (
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// An encoder writes structs in the Thrift compact protocol, the encoding
+// of the page headers and the file metadata.
+type encoder struct {
+	b []byte
+
+	// last holds the id of the last field of every open struct.
+	last []int16
+}
+
+func newEncoder() *encoder {
+	return &encoder{last: []int16{0}}
+}
+
+func (e *encoder) header(id int16, typ byte) {
+	last := &e.last[len(e.last)-1]
+	if d := id - *last; d > 0 && d <= 15 {
+		e.b = append(e.b, byte(d)<<4|typ)
+	} else {
+		e.b = append(e.b, typ)
+		e.b = binary.AppendVarint(e.b, int64(id))
+	}
+	*last = id
+}
+
+func (e *encoder) i32(id int16, v int32) {
+	e.header(id, thriftI32)
+	e.b = binary.AppendVarint(e.b, int64(v))
+}
+
+func (e *encoder) i64(id int16, v int64) {
+	e.header(id, thriftI64)
+	e.b = binary.AppendVarint(e.b, v)
+}
+
+func (e *encoder) str(id int16, s string) {
+	e.header(id, thriftBinary)
+	e.b = binary.AppendUvarint(e.b, uint64(len(s)))
+	e.b = append(e.b, s...)
+}
+
+// field starts a struct field, which is ended by end.
+func (e *encoder) field(id int16) {
+	e.header(id, thriftStruct)
+	e.begin()
+}
+
+// list starts a list field of n elements of type elem. Elements are
+// written with listI32, listStr or begin and end.
+func (e *encoder) list(id int16, elem byte, n int) {
+	e.header(id, thriftList)
+	if n < 15 {
+		e.b = append(e.b, byte(n)<<4|elem)
+	} else {
+		e.b = append(e.b, 0xf0|elem)
+		e.b = binary.AppendUvarint(e.b, uint64(n))
+	}
+}
+
+func (e *encoder) listI32(v int32) {
+	e.b = binary.AppendVarint(e.b, int64(v))
+}
+
+func (e *encoder) listStr(s string) {
+	e.b = binary.AppendUvarint(e.b, uint64(len(s)))
+	e.b = append(e.b, s...)
+}
+
+// begin starts a struct.
+func (e *encoder) begin() {
+	e.last = append(e.last, 0)
+}
+
+// end ends the struct started last.
+func (e *encoder) end() {
+	e.b = append(e.b, 0)
+	e.last = e.last[:len(e.last)-1]
+}
@@ -0,0 +1,309 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/retry"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// DefaultWorkers is the number of accounts downloaded concurrently by an
+// AccountDownloader with no Workers.
+const DefaultWorkers = 4
+
+// ClientCustomers returns the IDs of the accounts below the manager account
+// of s that can run reports, i.e. that are no manager accounts themselves.
+// s is typically the ManagedCustomers service of an adwords.Client of the
+// manager account.
+func ClientCustomers(s adwords.AccountService) ([]string, error) {
+	p := &paging.Pager[*adwords.ManagedCustomer]{
+		Key: func(c *adwords.ManagedCustomer) string {
+			if c.CustomerId == nil {
+				return ""
+			}
+			return strconv.FormatInt(*c.CustomerId, 10)
+		},
+		Fetch: func(start, n int32) ([]*adwords.ManagedCustomer, int32, error) {
+			page, err := s.Get(&selector.Selector{
+				Fields: []string{"CustomerId", "Name", "CanManageClients"},
+				Paging: &selector.Paging{StartIndex: start, NumberResults: n},
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			return page.Entries, page.TotalNumEntries, nil
+		},
+	}
+	var ids []string
+	err := p.Each(func(c *adwords.ManagedCustomer) error {
+		if c.CustomerId != nil && (c.CanManageClients == nil || !*c.CanManageClients) {
+			ids = append(ids, strconv.FormatInt(*c.CustomerId, 10))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("report: client customers: %w", err)
+	}
+	return ids, nil
+}
+
+// An AccountFunc processes the report of an account. It is called
+// concurrently for different accounts and again for an account whose
+// download is retried, so it must discard what it did in a failed call,
+// as Split and Merge do.
+type AccountFunc func(customerID string, r io.Reader) error
+
+// An AccountDownloader downloads a report for many accounts at once, e.g.
+// for the ClientCustomers of a manager account:
+//
+//	ids, err := report.ClientCustomers(client.ManagedCustomers)
+//	m := &report.AccountDownloader{Downloader: *d, Workers: 8}
+//	summary := m.Query(ctx, ids, query, report.GzippedCSV, report.Split(func(customerID string) report.Sink {
+//		return &report.ParquetSink{Dir: "reports", CustomerID: customerID}
+//	}, p))
+//
+// Failed accounts don't stop the others. They are listed in the Summary.
+type AccountDownloader struct {
+	// Downloader is copied for every account with the ClientCustomerId of
+	// its Config set to the account.
+	Downloader Downloader
+
+	// Workers is the number of accounts downloaded concurrently, or
+	// DefaultWorkers if 0.
+	Workers int
+
+	// Retry retries the download and AccountFunc of an account failing
+	// with transient errors, including errors while reading the report. A
+	// nil Retry makes a single attempt per account. Requests are also
+	// retried by the Retry of the Downloader.
+	Retry *retry.Policy
+}
+
+// A Summary lists the accounts of a run by outcome.
+type Summary struct {
+	// Succeeded holds the IDs of the accounts processed without error in
+	// ascending order.
+	Succeeded []string
+
+	// Failed holds the errors of the other accounts, ordered by account.
+	Failed []*AccountError
+}
+
+// Err returns the errors of the failed accounts joined, or nil.
+func (s *Summary) Err() error {
+	errs := make([]error, len(s.Failed))
+	for i, err := range s.Failed {
+		errs[i] = err
+	}
+	return errors.Join(errs...)
+}
+
+// An AccountError is the error of an account, e.g. a *Error of the type
+// "AuthorizationError.CUSTOMER_NOT_ACTIVE".
+type AccountError struct {
+	CustomerID string
+	Err        error
+}
+
+func (e *AccountError) Error() string {
+	return fmt.Sprintf("report: account %s: %v", e.CustomerID, e.Err)
+}
+
+func (e *AccountError) Unwrap() error {
+	return e.Err
+}
+
+// Query downloads the report of an AWQL query for every account and
+// passes it to fn. When ctx is done, the running downloads fail and the
+// remaining accounts fail with the error of ctx.
+func (m *AccountDownloader) Query(ctx context.Context, customerIDs []string, query string, format DownloadFormat, fn AccountFunc) *Summary {
+	return m.run(ctx, customerIDs, func(ctx context.Context, d *Downloader) (io.ReadCloser, error) {
+		return d.QueryContext(ctx, query, format)
+	}, fn)
+}
+
+// Download downloads the report of a definition for every account and
+// passes it to fn. ctx is used as by Query.
+func (m *AccountDownloader) Download(ctx context.Context, customerIDs []string, def *Definition, fn AccountFunc) *Summary {
+	return m.run(ctx, customerIDs, func(ctx context.Context, d *Downloader) (io.ReadCloser, error) {
+		return d.DownloadContext(ctx, def)
+	}, fn)
+}
+
+// downloadFunc downloads the report of the account of a Downloader.
+type downloadFunc func(ctx context.Context, d *Downloader) (io.ReadCloser, error)
+
+func (m *AccountDownloader) run(ctx context.Context, customerIDs []string, download downloadFunc, fn AccountFunc) *Summary {
+	workers := m.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	ids := make(chan string)
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		summary = new(Summary)
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				err := m.account(ctx, id, download, fn)
+				mu.Lock()
+				if err != nil {
+					summary.Failed = append(summary.Failed, &AccountError{CustomerID: id, Err: err})
+				} else {
+					summary.Succeeded = append(summary.Succeeded, id)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	sent := 0
+send:
+	for _, id := range customerIDs {
+		select {
+		case ids <- id:
+			sent++
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(ids)
+	wg.Wait()
+	for _, id := range customerIDs[sent:] {
+		summary.Failed = append(summary.Failed, &AccountError{CustomerID: id, Err: ctx.Err()})
+	}
+
+	sort.Strings(summary.Succeeded)
+	sort.Slice(summary.Failed, func(i, j int) bool {
+		return summary.Failed[i].CustomerID < summary.Failed[j].CustomerID
+	})
+	return summary
+}
+
+// account downloads and processes the report of one account.
+func (m *AccountDownloader) account(ctx context.Context, id string, download downloadFunc, fn AccountFunc) error {
+	d := m.Downloader
+	d.Config.ClientCustomerId = id
+	return m.Retry.DoContext(ctx, func() error {
+		r, err := download(ctx, &d)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		return fn(id, r)
+	})
+}
+
+// Split returns an AccountFunc writing the rows of every account to a sink
+// of its own returned by newSink, which is closed afterwards. If the report
+// can't be read or written, the sink is rolled back if it has a Rollback
+// method, as ParquetSink and SQLSink have, and closed otherwise, so a retry
+// starts over with a new sink.
+func Split(newSink func(customerID string) Sink, p *Parser) AccountFunc {
+	return func(customerID string, r io.Reader) error {
+		sink := newSink(customerID)
+		if err := Copy(sink, p, r); err != nil {
+			if rb, ok := sink.(interface{ Rollback() error }); ok {
+				rb.Rollback()
+			} else {
+				sink.Close()
+			}
+			return err
+		}
+		return sink.Close()
+	}
+}
+
+// Merge returns an AccountFunc writing the rows of all accounts to one
+// sink. Rows get an ExternalCustomerId column with the account unless the
+// report has one. The report of an account is parsed while it is spooled
+// to a temporary file, and its rows are written from there once it has
+// been read completely, so the sink receives the rows of an account at
+// once and only once, even if the download is retried, without holding
+// them in memory. If the sink fails after some rows of an account were
+// written, the account fails, and so does every retry of it, as its rows
+// would be written twice. Merge serializes the writes, so sink needn't be
+// safe for concurrent use.
+func Merge(sink Sink, p *Parser) AccountFunc {
+	var (
+		mu      sync.Mutex
+		partial = make(map[string]bool) // accounts written partially
+	)
+	customerField := &Field{FieldName: "ExternalCustomerId", DisplayFieldName: "Customer ID", FieldType: "Long"}
+	return func(customerID string, r io.Reader) error {
+		customer, err := strconv.ParseInt(strings.ReplaceAll(customerID, "-", ""), 10, 64)
+		if err != nil {
+			return fmt.Errorf("report: invalid customer ID %q", customerID)
+		}
+		mu.Lock()
+		written := partial[customerID]
+		mu.Unlock()
+		if written {
+			return fmt.Errorf("report: rows of account %s were written partially", customerID)
+		}
+
+		spool, err := os.CreateTemp("", "report-"+customerID+"-*")
+		if err != nil {
+			return fmt.Errorf("report: %v", err)
+		}
+		defer os.Remove(spool.Name())
+		defer spool.Close()
+		// The report is parsed once while it is read, so errors of the
+		// download and of the report show up before any row is written.
+		tee := io.TeeReader(r, spool)
+		if err = p.Each(tee, func(*Row) error { return nil }); err != nil {
+			return err
+		}
+		if _, err = io.Copy(io.Discard, tee); err != nil {
+			return err
+		}
+		if _, err = spool.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("report: %v", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		rows := 0
+		err = p.Each(spool, func(row *Row) error {
+			if !hasField(row.Fields, "ExternalCustomerId") {
+				row = &Row{
+					Fields: append([]*Field{customerField}, row.Fields...),
+					Values: append([]interface{}{customer}, row.Values...),
+				}
+			}
+			if err := sink.Write(row); err != nil {
+				return err
+			}
+			rows++
+			return nil
+		})
+		if err != nil && rows > 0 {
+			// A retry of the account fails at once by partial.
+			partial[customerID] = true
+			return fmt.Errorf("report: rows of account %s were written partially: %v", customerID, err)
+		}
+		return err
+	}
+}
+
+func hasField(fields []*Field, fieldName string) bool {
+	for _, f := range fields {
+		if f.FieldName == fieldName {
+			return true
+		}
+	}
+	return false
+}
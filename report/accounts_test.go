@@ -0,0 +1,149 @@
+package report_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+	"github.com/godofdream/go-googleadsinofficial/report"
+	"github.com/godofdream/go-googleadsinofficial/retry"
+)
+
+// accounts returns an AccountDownloader of a server responding with the
+// same report for every account.
+func accounts(t *testing.T, csv string) *report.AccountDownloader {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(csv))
+	}))
+	t.Cleanup(srv.Close)
+	return &report.AccountDownloader{
+		Downloader: report.Downloader{Version: "v201802", Config: adwords.Config{Endpoint: srv.URL}},
+		Retry:      &retry.Policy{Attempts: 2, Initial: time.Millisecond, Retryable: func(error) bool { return true }},
+	}
+}
+
+var clicks = &report.Parser{
+	Fields:            []*report.Field{{FieldName: "Clicks", FieldType: "Long"}},
+	Format:            report.CSV,
+	SkipReportHeader:  true,
+	SkipReportSummary: true,
+}
+
+// failingSink fails after writing n rows.
+type failingSink struct {
+	mu   sync.Mutex
+	n    int
+	rows int
+}
+
+func (s *failingSink) Write(*report.Row) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rows == s.n {
+		return errors.New("disk full")
+	}
+	s.rows++
+	return nil
+}
+
+func (s *failingSink) Close() error { return nil }
+
+func TestMergePartial(t *testing.T) {
+	m := accounts(t, "Clicks\n1\n2\n")
+	sink := &failingSink{n: 1}
+	summary := m.Query(context.Background(), []string{"1234567890"}, "SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT", report.CSV, report.Merge(sink, clicks))
+	if len(summary.Failed) != 1 || !strings.Contains(summary.Failed[0].Error(), "written partially") {
+		t.Fatalf("got summary %+v", summary)
+	}
+	if sink.rows != 1 {
+		t.Errorf("got %d rows written, want 1", sink.rows)
+	}
+}
+
+// rowsSink keeps the rows written to it.
+type rowsSink struct {
+	rows []*report.Row
+}
+
+func (s *rowsSink) Write(row *report.Row) error {
+	s.rows = append(s.rows, row)
+	return nil
+}
+
+func (s *rowsSink) Close() error { return nil }
+
+func TestMergeFailedRead(t *testing.T) {
+	sink := new(rowsSink)
+	merge := report.Merge(sink, clicks)
+	// The download breaks after the first row.
+	broken := io.MultiReader(strings.NewReader("Clicks\n1\n"), iotest.ErrReader(errors.New("connection reset")))
+	if err := merge("123-456-7890", broken); err == nil || !strings.Contains(err.Error(), "connection reset") {
+		t.Fatalf("got error %v", err)
+	}
+	if len(sink.rows) != 0 {
+		t.Fatalf("wrote %d rows of a broken download", len(sink.rows))
+	}
+	// The retry writes every row once.
+	if err := merge("123-456-7890", strings.NewReader("Clicks\n1\n2\n")); err != nil {
+		t.Fatal(err)
+	}
+	if len(sink.rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(sink.rows))
+	}
+	for i, row := range sink.rows {
+		if row.Get("ExternalCustomerId") != int64(1234567890) || row.Get("Clicks") != int64(i+1) {
+			t.Errorf("got row %v", row.Values)
+		}
+	}
+}
+
+func TestSplitRollback(t *testing.T) {
+	dir := t.TempDir()
+	// The second row is no number.
+	m := accounts(t, "Clicks\n1\nx\n")
+	summary := m.Query(context.Background(), []string{"1234567890"}, "SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT", report.CSV, report.Split(func(customerID string) report.Sink {
+		return &report.ParquetSink{Dir: dir, CustomerID: customerID}
+	}, clicks))
+	if len(summary.Failed) != 1 {
+		t.Fatalf("got summary %+v", summary)
+	}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			t.Errorf("file %s left", path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccountsCanceled(t *testing.T) {
+	m := accounts(t, "Clicks\n1\n")
+	m.Workers = 1
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int
+	summary := m.Query(ctx, []string{"1", "2", "3"}, "SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT", report.CSV, func(string, io.Reader) error {
+		calls++
+		cancel()
+		return nil
+	})
+	if calls != 1 || len(summary.Succeeded) != 1 || len(summary.Failed) != 2 {
+		t.Fatalf("got %d calls and summary %+v", calls, summary)
+	}
+	for _, f := range summary.Failed {
+		if !errors.Is(f, context.Canceled) {
+			t.Errorf("account %s: got error %v", f.CustomerID, f.Err)
+		}
+	}
+}
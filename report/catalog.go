@@ -0,0 +1,291 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+	"github.com/godofdream/go-googleadsinofficial/awql"
+)
+
+// A Catalog holds the fields of all report types of an API version. The
+// fields rarely change within a version, so a catalog is fetched once and
+// answers offline which fields a report has, which can be selected or
+// filtered, the values of enums and which fields can be combined. See
+// CachedCatalog.
+type Catalog struct {
+	Version string `json:"version"`
+
+	// Fetched is the time the catalog was fetched.
+	Fetched time.Time `json:"fetched"`
+
+	// Reports maps the report types to their fields.
+	Reports map[string][]*Field `json:"reports"`
+
+	// Failed maps the report types whose fields couldn't be fetched to
+	// the error, e.g. of a report type not available to the account.
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
+// FetchCatalog fetches the fields of all report types of a client with
+// ReportDefinitionService.GetReportFields. Report types failing are
+// recorded in Failed; an error is only returned if all fail.
+func FetchCatalog(c *adwords.Client) (*Catalog, error) {
+	cat := &Catalog{Version: c.Version, Reports: make(map[string][]*Field)}
+	if err := cat.fetch(c, c.ReportTypes); err != nil {
+		return nil, err
+	}
+	return cat, nil
+}
+
+// fetch fetches the fields of the given report types into c, replacing
+// their earlier failures.
+func (c *Catalog) fetch(client *adwords.Client, reportTypes []string) error {
+	c.Fetched = time.Now()
+	var first error
+	fetched := 0
+	for _, t := range reportTypes {
+		fields, err := client.ReportDefinitions.GetReportFields(t)
+		if err != nil {
+			if first == nil {
+				first = fmt.Errorf("report: fields of %s: %w", t, err)
+			}
+			if c.Failed == nil {
+				c.Failed = make(map[string]string)
+			}
+			c.Failed[t] = err.Error()
+			continue
+		}
+		c.Reports[t] = fields
+		delete(c.Failed, t)
+		fetched++
+	}
+	if len(c.Failed) == 0 {
+		c.Failed = nil
+	}
+	if fetched == 0 && len(reportTypes) > 0 {
+		return first
+	}
+	return nil
+}
+
+// ReadCatalog reads a catalog from a JSON file written by WriteFile.
+func ReadCatalog(path string) (*Catalog, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cat := new(Catalog)
+	if err := json.Unmarshal(b, cat); err != nil {
+		return nil, fmt.Errorf("report: %s: %v", path, err)
+	}
+	if cat.Reports == nil {
+		cat.Reports = make(map[string][]*Field)
+	}
+	return cat, nil
+}
+
+// CatalogFile returns the path of the catalog of an API version relative
+// to the root of the repository, e.g.
+// "v201802/ReportDefinitionService/reports.json". The reportfields command
+// writes it there and adwordsgen generates the row types from it.
+func CatalogFile(version string) string {
+	return filepath.Join(version, "ReportDefinitionService", "reports.json")
+}
+
+// CachedCatalog returns the catalog of the version of c cached in dir at
+// CatalogFile. If dir has none, it is fetched and saved there. The report
+// types that failed before are fetched again. Delete the file to fetch the
+// catalog again.
+func CachedCatalog(dir string, c *adwords.Client) (*Catalog, error) {
+	path := filepath.Join(dir, CatalogFile(c.Version))
+	cat, err := ReadCatalog(path)
+	switch {
+	case err == nil && cat.Version != c.Version:
+		return nil, fmt.Errorf("report: %s holds the catalog of %s", path, cat.Version)
+	case err == nil && len(cat.Failed) == 0:
+		return cat, nil
+	case err == nil:
+		failed := make([]string, 0, len(cat.Failed))
+		for t := range cat.Failed {
+			failed = append(failed, t)
+		}
+		sort.Strings(failed)
+		n := len(cat.Failed)
+		if err = cat.fetch(c, failed); err != nil || len(cat.Failed) == n {
+			// Nothing new: keep the file.
+			return cat, nil
+		}
+	case !os.IsNotExist(err):
+		return nil, err
+	default:
+		if cat, err = FetchCatalog(c); err != nil {
+			return nil, err
+		}
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0775); err != nil {
+		return nil, err
+	}
+	if err = cat.WriteFile(path); err != nil {
+		return nil, err
+	}
+	return cat, nil
+}
+
+// WriteFile writes c as JSON to path. The file is replaced at once, so
+// concurrent readers never see a partial catalog.
+func (c *Catalog) WriteFile(path string) error {
+	b, err := json.MarshalIndent(c, "", "\t")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(append(b, '\n')); err == nil {
+		err = tmp.Chmod(0664)
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ReportTypes returns the report types of c in ascending order.
+func (c *Catalog) ReportTypes() []string {
+	var types []string
+	for t := range c.Reports {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Fields returns the fields of a report type, or nil if c has none.
+func (c *Catalog) Fields(reportType string) []*Field {
+	return c.Reports[reportType]
+}
+
+// Field returns a field of a report type by its name, or nil.
+func (c *Catalog) Field(reportType, fieldName string) *Field {
+	for _, f := range c.Reports[reportType] {
+		if f.FieldName == fieldName {
+			return f
+		}
+	}
+	return nil
+}
+
+// Selectable returns the names of the fields of a report type that can be
+// selected.
+func (c *Catalog) Selectable(reportType string) []string {
+	var names []string
+	for _, f := range c.Reports[reportType] {
+		if f.CanSelect {
+			names = append(names, f.FieldName)
+		}
+	}
+	return names
+}
+
+// Filterable returns the names of the fields of a report type that can be
+// filtered on.
+func (c *Catalog) Filterable(reportType string) []string {
+	var names []string
+	for _, f := range c.Reports[reportType] {
+		if f.CanFilter {
+			names = append(names, f.FieldName)
+		}
+	}
+	return names
+}
+
+// EnumValues returns the values of an enum field of a report type, or nil
+// if it is no enum.
+func (c *Catalog) EnumValues(reportType, fieldName string) []string {
+	f := c.Field(reportType, fieldName)
+	if f == nil || !f.IsEnumType {
+		return nil
+	}
+	return f.EnumValues
+}
+
+// Compatible checks that the fields exist in a report type and that none
+// of them excludes another, so they can be used in one query.
+func (c *Catalog) Compatible(reportType string, fieldNames ...string) error {
+	if _, ok := c.Reports[reportType]; !ok {
+		return fmt.Errorf("report: unknown report type %s", reportType)
+	}
+	used := make(map[string]bool)
+	for _, name := range fieldNames {
+		if c.Field(reportType, name) == nil {
+			return fmt.Errorf("report: %s has no field %s", reportType, name)
+		}
+		used[name] = true
+	}
+	for _, name := range fieldNames {
+		for _, excluded := range c.Field(reportType, name).ExclusiveFields {
+			if used[excluded] {
+				return fmt.Errorf("report: %s and %s can't be used together in %s", name, excluded, reportType)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks a report query against c: the report type exists, the
+// selected fields can be selected, the filtered fields can be filtered on
+// with enum values of their enum, and no field excludes another.
+func (c *Catalog) Validate(q *awql.Query) error {
+	if q.From == "" {
+		return fmt.Errorf("report: query has no FROM clause")
+	}
+	if _, ok := c.Reports[q.From]; !ok {
+		return fmt.Errorf("report: unknown report type %s", q.From)
+	}
+	if len(q.Ordering) > 0 || q.Paging != nil {
+		return fmt.Errorf("report: reports can't be ordered or limited")
+	}
+	used := append([]string(nil), q.Fields...)
+	for _, name := range q.Fields {
+		if f := c.Field(q.From, name); f != nil && !f.CanSelect {
+			return fmt.Errorf("report: %s of %s can't be selected", name, q.From)
+		}
+	}
+	for _, p := range q.Predicates {
+		f := c.Field(q.From, p.Field)
+		if f == nil {
+			return fmt.Errorf("report: %s has no field %s", q.From, p.Field)
+		}
+		if !f.CanFilter {
+			return fmt.Errorf("report: %s of %s can't be filtered on", p.Field, q.From)
+		}
+		if f.IsEnumType && len(f.EnumValues) > 0 {
+			for _, v := range p.Values {
+				if !contains(f.EnumValues, v) {
+					return fmt.Errorf("report: %s is no value of %s", v, p.Field)
+				}
+			}
+		}
+		used = append(used, p.Field)
+	}
+	return c.Compatible(q.From, used...)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
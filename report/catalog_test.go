@@ -0,0 +1,62 @@
+package report_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+	"github.com/godofdream/go-googleadsinofficial/report"
+)
+
+// reportFields fails for the report types in failing.
+type reportFields struct {
+	failing map[string]bool
+	calls   int
+}
+
+func (s *reportFields) GetReportFields(reportType string) ([]*adwords.ReportField, error) {
+	s.calls++
+	if s.failing[reportType] {
+		return nil, errors.New("[ReportDefinitionError.CUSTOMER_SERVING_TYPE_REPORT_MISMATCH @ ]")
+	}
+	return []*adwords.ReportField{{FieldName: "Clicks"}}, nil
+}
+
+func TestCachedCatalog(t *testing.T) {
+	dir := t.TempDir()
+	s := &reportFields{failing: map[string]bool{"B": true}}
+	c := &adwords.Client{Version: "v201802", ReportTypes: []string{"A", "B"}, ReportDefinitions: s}
+
+	cat, err := report.CachedCatalog(dir, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cat.Fields("A") == nil || cat.Failed["B"] == "" {
+		t.Fatalf("got reports %v and failures %v", cat.Reports, cat.Failed)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "v201802", "ReportDefinitionService", "reports.json")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the failed report type is fetched again.
+	s.failing, s.calls = nil, 0
+	if cat, err = report.CachedCatalog(dir, c); err != nil {
+		t.Fatal(err)
+	}
+	if s.calls != 1 || cat.Fields("B") == nil || cat.Failed != nil {
+		t.Fatalf("got %d calls, reports %v and failures %v", s.calls, cat.Reports, cat.Failed)
+	}
+	if cat, err = report.CachedCatalog(dir, c); err != nil || s.calls != 1 {
+		t.Fatalf("got %d calls and error %v", s.calls, err)
+	}
+}
+
+func TestFetchCatalogFailed(t *testing.T) {
+	s := &reportFields{failing: map[string]bool{"A": true}}
+	c := &adwords.Client{Version: "v201802", ReportTypes: []string{"A"}, ReportDefinitions: s}
+	if _, err := report.FetchCatalog(c); err == nil {
+		t.Error("got no error with all report types failing")
+	}
+}
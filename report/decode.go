@@ -0,0 +1,166 @@
+package report
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"strings"
+)
+
+// Decode returns an iterator over the rows of the report read from r
+// decoded into values of the struct type T. See DecodeEach.
+func Decode[T any](p *Parser, r io.Reader) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		stopped := false
+		err := DecodeEach(p, r, func(v *T) error {
+			if !yield(v, nil) {
+				stopped = true
+				return errStop
+			}
+			return nil
+		})
+		if err != nil && !stopped {
+			yield(nil, err)
+		}
+	}
+}
+
+// DecodeEach calls fn for every row of the report read from r decoded into
+// a value of the struct type T, such as the row types generated for the
+// report types, and returns the first error.
+//
+// The struct fields are matched to the columns by the field name in their
+// report tag, e.g. `report:"Cost"`. Fields without a tag are ignored, and
+// tagged fields without a column are left unset, so a struct such as a
+// generated row type can decode reports of any subset of its fields. A
+// report without any of the columns is an error. A field must hold the Go
+// type of the values of its column, see Parser, or a type convertible from
+// it, such as int32 or a named string type of an enum. Fields implementing
+// encoding.TextUnmarshaler, such as the generated enums, decode strings
+// with it. A pointer is left nil, and any other field zero, if the value
+// is nil.
+//
+// Columns are taken from T if p requires them but has none, so the query
+// of a report without a column header must select Columns[T]() then.
+func DecodeEach[T any](p *Parser, r io.Reader, fn func(*T) error) error {
+	t := reflect.TypeFor[T]()
+	tags, err := tagged(t)
+	if err != nil {
+		return err
+	}
+	if p.SkipColumnHeader && len(p.Columns) == 0 {
+		withColumns := *p
+		for _, tag := range tags {
+			withColumns.Columns = append(withColumns.Columns, tag.name)
+		}
+		p = &withColumns
+	}
+	// index holds the column of every tagged field, determined by the
+	// first row.
+	var index []int
+	return p.Each(r, func(row *Row) error {
+		if index == nil {
+			index = make([]int, len(tags))
+			found := false
+			for i, tag := range tags {
+				index[i] = -1
+				for j, f := range row.Fields {
+					if f.FieldName == tag.name {
+						index[i], found = j, true
+					}
+				}
+			}
+			if !found {
+				return fmt.Errorf("report: the report has none of the columns of %s", t)
+			}
+		}
+		v := new(T)
+		rv := reflect.ValueOf(v).Elem()
+		for i, tag := range tags {
+			if index[i] < 0 {
+				continue
+			}
+			if err := set(rv.Field(tag.field), row.Values[index[i]]); err != nil {
+				return fmt.Errorf("report: %s.%s: %v", t, t.Field(tag.field).Name, err)
+			}
+		}
+		return fn(v)
+	})
+}
+
+// Columns returns the field names of the report tags of the struct type
+// T in order, e.g. to select them in a query.
+func Columns[T any]() []string {
+	tags, _ := tagged(reflect.TypeFor[T]())
+	var names []string
+	for _, tag := range tags {
+		names = append(names, tag.name)
+	}
+	return names
+}
+
+// A tag is a struct field with a report tag.
+type tag struct {
+	field int
+	name  string
+}
+
+func tagged(t reflect.Type) ([]tag, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("report: %s is not a struct", t)
+	}
+	var tags []tag
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("report"), ",")
+		if name == "" || name == "-" || !t.Field(i).IsExported() {
+			continue
+		}
+		tags = append(tags, tag{field: i, name: name})
+	}
+	return tags, nil
+}
+
+// set stores the value v of a column in f.
+func set(f reflect.Value, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	src := reflect.ValueOf(v)
+	dst := f
+	if f.Kind() == reflect.Ptr {
+		dst = reflect.New(f.Type().Elem()).Elem()
+	}
+	u, ok := dst.Addr().Interface().(encoding.TextUnmarshaler)
+	switch {
+	case ok && src.Kind() == reflect.String:
+		if err := u.UnmarshalText([]byte(src.String())); err != nil {
+			return err
+		}
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+	case convertible(src.Kind(), dst.Kind()):
+		dst.Set(src.Convert(dst.Type()))
+	default:
+		return fmt.Errorf("cannot decode %s into %s", src.Type(), f.Type())
+	}
+	if f.Kind() == reflect.Ptr {
+		f.Set(dst.Addr())
+	}
+	return nil
+}
+
+// convertible reports whether values of kind from are decoded into fields
+// of kind to.
+func convertible(from, to reflect.Kind) bool {
+	switch from {
+	case reflect.Int64:
+		return to >= reflect.Int && to <= reflect.Int64 || to == reflect.Float64
+	case reflect.Float64:
+		return to == reflect.Float32
+	case reflect.String:
+		return to == reflect.String
+	}
+	return false
+}
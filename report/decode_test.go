@@ -0,0 +1,84 @@
+package report_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/report"
+)
+
+// keywordsRow is a row of the KEYWORDS_PERFORMANCE_REPORT like the row
+// types generated from a catalog.
+type keywordsRow struct {
+	Id               *int64     `report:"Id"`
+	Criteria         string     `report:"Criteria"`
+	KeywordMatchType string     `report:"KeywordMatchType"`
+	Status           string     `report:"Status"`
+	Date             *time.Time `report:"Date"`
+	Device           device     `report:"Device"`
+	Cost             *int64     `report:"Cost"`
+	Clicks           *int64     `report:"Clicks"`
+	CampaignName     string     `report:"CampaignName"`
+}
+
+// device is an enum like the generated ones, which decode values they
+// don't know as UNKNOWN.
+type device string
+
+func (d *device) UnmarshalText(text []byte) error {
+	switch v := device(text); v {
+	case "DESKTOP", "HIGH_END_MOBILE", "TABLET":
+		*d = v
+	default:
+		*d = "UNKNOWN"
+	}
+	return nil
+}
+
+func TestDecodeRow(t *testing.T) {
+	catalog, err := report.ReadCatalog("testdata/reports.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	csv := "\"KEYWORDS_PERFORMANCE_REPORT (Jan 1, 2018-Jan 31, 2018)\"\n" +
+		"Keyword ID,Keyword,Match type,Keyword state,Day,Device,Cost\n" +
+		"123,shoes,Exact,enabled,2018-01-31,Tablets with full browsers,1230000\n" +
+		"124,boots,Broad,paused,2018-01-31,Smart watches,--\n" +
+		"Total,--,--,--,--,--,1230000\n"
+	p := &report.Parser{Fields: catalog.Fields("KEYWORDS_PERFORMANCE_REPORT"), Format: report.CSV}
+	var rows []*keywordsRow
+	for row, err := range report.Decode[keywordsRow](p, strings.NewReader(csv)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	first, second := rows[0], rows[1]
+	if *first.Id != 123 || first.Criteria != "shoes" || *first.Cost != 1230000 || first.Date.Format("2006-01-02") != "2018-01-31" {
+		t.Errorf("got first row %+v", first)
+	}
+	if first.KeywordMatchType != "EXACT" || first.Status != "ENABLED" || first.Device != "TABLET" {
+		t.Errorf("got enums %q, %q, %q", first.KeywordMatchType, first.Status, first.Device)
+	}
+	// Fields not selected are left unset.
+	if first.Clicks != nil || first.CampaignName != "" {
+		t.Errorf("got unselected fields %v, %q", first.Clicks, first.CampaignName)
+	}
+	// Values added after the catalog was fetched become UNKNOWN.
+	if second.Device != "UNKNOWN" || second.Cost != nil {
+		t.Errorf("got second row device %q, cost %v", second.Device, second.Cost)
+	}
+}
+
+func TestDecodeWithoutColumns(t *testing.T) {
+	p := &report.Parser{Format: report.CSV, SkipReportHeader: true, SkipReportSummary: true}
+	for _, err := range report.Decode[keywordsRow](p, strings.NewReader("Foo\n1\n")) {
+		if err == nil {
+			t.Fatal("decoded a report without any column of the row")
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package report
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+	"github.com/godofdream/go-googleadsinofficial/retry"
+)
+
+// maxErrorSize limits the part of an error response that is read.
+const maxErrorSize = 1 << 20
+
+// A Downloader downloads reports with the credentials and headers of a
+// Config:
+//
+//	d := report.NewDownloader("v201802", config)
+//	d.SkipReportHeader, d.SkipReportSummary = true, true
+//	r, err := d.Query("SELECT CampaignId, Clicks FROM CAMPAIGN_PERFORMANCE_REPORT DURING YESTERDAY", report.CSV)
+//	if err != nil {
+//		return err
+//	}
+//	defer r.Close()
+type Downloader struct {
+	// Version is the API version, e.g. "v201802".
+	Version string
+
+	// Config holds the endpoint, the credentials and the customer.
+	Config adwords.Config
+
+	// Client sends the requests, or http.DefaultClient if nil.
+	Client *http.Client
+
+	// SkipReportHeader, SkipColumnHeader and SkipReportSummary omit the
+	// line with the report name and date range, the line with the column
+	// names and the line with the totals.
+	SkipReportHeader  bool
+	SkipColumnHeader  bool
+	SkipReportSummary bool
+
+	// UseRawEnumValues returns enum values as in the API, e.g. "ENABLED",
+	// instead of their display values, e.g. "enabled".
+	UseRawEnumValues bool
+
+	// IncludeZeroImpressions, if set, includes or excludes the rows
+	// without impressions. The API decides if nil.
+	IncludeZeroImpressions *bool
+
+	// Retry retries requests failing with transient errors. A nil Retry
+	// makes a single attempt. Errors while reading the report are not
+	// retried.
+	Retry *retry.Policy
+}
+
+// NewDownloader returns a Downloader for the given API version.
+func NewDownloader(version string, config adwords.Config) *Downloader {
+	return &Downloader{Version: version, Config: config}
+}
+
+func init() {
+	adwords.RegisterReports(func(version string, config adwords.Config) adwords.ReportDownloader {
+		return facade{NewDownloader(version, config)}
+	})
+}
+
+// facade is the adwords.ReportDownloader of the Clients of the adwords
+// package.
+type facade struct {
+	d *Downloader
+}
+
+func (f facade) Query(ctx context.Context, query, format string) (io.ReadCloser, error) {
+	return f.d.QueryContext(ctx, query, DownloadFormat(format))
+}
+
+// Query downloads the report of an AWQL query in the given format. The
+// report must be closed by the caller.
+func (d *Downloader) Query(query string, format DownloadFormat) (io.ReadCloser, error) {
+	return d.QueryContext(context.Background(), query, format)
+}
+
+// QueryContext is like Query with a context for the request and the
+// reading of the report.
+func (d *Downloader) QueryContext(ctx context.Context, query string, format DownloadFormat) (io.ReadCloser, error) {
+	if !format.Valid() {
+		return nil, fmt.Errorf("report: unknown download format %q", format)
+	}
+	return d.post(ctx, url.Values{"__rdquery": {query}, "__fmt": {string(format)}})
+}
+
+// Download downloads the report of a definition. The report must be closed
+// by the caller.
+func (d *Downloader) Download(def *Definition) (io.ReadCloser, error) {
+	return d.DownloadContext(context.Background(), def)
+}
+
+// DownloadContext is like Download with a context for the request and the
+// reading of the report.
+func (d *Downloader) DownloadContext(ctx context.Context, def *Definition) (io.ReadCloser, error) {
+	b, err := def.Marshal(d.Version)
+	if err != nil {
+		return nil, err
+	}
+	return d.post(ctx, url.Values{"__rdxml": {string(b)}})
+}
+
+func (d *Downloader) post(ctx context.Context, form url.Values) (io.ReadCloser, error) {
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	body := form.Encode()
+	var resp *http.Response
+	err := d.Retry.DoContext(ctx, func() error {
+		req, err := http.NewRequestWithContext(ctx, "POST", d.Config.ReportURL(d.Version), strings.NewReader(body))
+		if err != nil {
+			return err
+		}
+		d.header(req)
+		resp, err = client.Do(req)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			return readError(resp)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// header sets the authentication and report headers of req.
+func (d *Downloader) header(req *http.Request) {
+	c := d.Config
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if c.Login != "" {
+		req.SetBasicAuth(c.Login, c.Password)
+	}
+	if c.DeveloperToken != "" {
+		req.Header.Set("developerToken", c.DeveloperToken)
+	}
+	if c.ClientCustomerId != "" {
+		req.Header.Set("clientCustomerId", c.ClientCustomerId)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	flags := []struct {
+		name string
+		set  bool
+	}{
+		{"skipReportHeader", d.SkipReportHeader},
+		{"skipColumnHeader", d.SkipColumnHeader},
+		{"skipReportSummary", d.SkipReportSummary},
+		{"useRawEnumValues", d.UseRawEnumValues},
+	}
+	for _, f := range flags {
+		if f.set {
+			req.Header.Set(f.name, "true")
+		}
+	}
+	if d.IncludeZeroImpressions != nil {
+		req.Header.Set("includeZeroImpressions", strconv.FormatBool(*d.IncludeZeroImpressions))
+	}
+}
+
+// An Error is a failed report download. The API describes it as an
+// ApiError, e.g. of type "ReportDefinitionError.INVALID_FIELD_NAME_FOR_REPORT",
+// whose type is part of the error text, so retry.Transient recognizes rate
+// limits and internal errors.
+type Error struct {
+	StatusCode int
+
+	// Type, Trigger and FieldPath are those of the ApiError. Type is empty
+	// if the response is not a report download error.
+	Type      string
+	Trigger   string
+	FieldPath string
+
+	// Body is the response if it is not a report download error.
+	Body string
+}
+
+func (e *Error) Error() string {
+	if e.Type == "" {
+		return fmt.Sprintf("report: HTTP %d: %s", e.StatusCode, e.Body)
+	}
+	msg := fmt.Sprintf("report: HTTP %d: %s", e.StatusCode, e.Type)
+	if e.Trigger != "" {
+		msg += fmt.Sprintf(" (trigger %q)", e.Trigger)
+	}
+	if e.FieldPath != "" {
+		msg += " at " + e.FieldPath
+	}
+	return msg
+}
+
+// downloadError is the XML of an Error.
+type downloadError struct {
+	XMLName  xml.Name `xml:"reportDownloadError"`
+	ApiError struct {
+		Type      string `xml:"type"`
+		Trigger   string `xml:"trigger"`
+		FieldPath string `xml:"fieldPath"`
+	} `xml:"ApiError"`
+}
+
+// readError returns the Error of a failed response.
+func readError(resp *http.Response) error {
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorSize))
+	if err != nil {
+		return fmt.Errorf("report: HTTP %d: %v", resp.StatusCode, err)
+	}
+	e := &Error{StatusCode: resp.StatusCode}
+	var de downloadError
+	if xml.Unmarshal(b, &de) == nil && de.ApiError.Type != "" {
+		e.Type, e.Trigger, e.FieldPath = de.ApiError.Type, de.ApiError.Trigger, de.ApiError.FieldPath
+	} else {
+		e.Body = strings.TrimSpace(string(b))
+	}
+	return e
+}
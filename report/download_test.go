@@ -0,0 +1,273 @@
+package report_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+	"github.com/godofdream/go-googleadsinofficial/report"
+	"github.com/godofdream/go-googleadsinofficial/retry"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// downloader returns a Downloader of a server answering with the given
+// handler, and the requests it received.
+func downloader(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*report.Downloader, *[]*http.Request) {
+	var requests []*http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		requests = append(requests, r)
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return report.NewDownloader("v201802", adwords.Config{Endpoint: srv.URL}), &requests
+}
+
+func respond(body string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, body)
+	}
+}
+
+func download(t *testing.T, d *report.Downloader, format report.DownloadFormat) string {
+	r, err := d.Query("SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT DURING YESTERDAY", format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestDownloadHeaders(t *testing.T) {
+	d, requests := downloader(t, respond("Clicks\n1\n"))
+	d.Config.Login, d.Config.Password = "login", "secret"
+	d.Config.DeveloperToken, d.Config.ClientCustomerId, d.Config.UserAgent = "token", "123-456-7890", "agent"
+	d.SkipReportHeader, d.SkipColumnHeader, d.SkipReportSummary, d.UseRawEnumValues = true, true, true, true
+	includeZeroImpressions := false
+	d.IncludeZeroImpressions = &includeZeroImpressions
+	if got := download(t, d, report.CSV); got != "Clicks\n1\n" {
+		t.Errorf("got report %q", got)
+	}
+
+	r := (*requests)[0]
+	if r.Method != "POST" || r.URL.Path != "/api/adwords/reportdownload/v201802" {
+		t.Errorf("got %s %s", r.Method, r.URL.Path)
+	}
+	if login, password, ok := r.BasicAuth(); !ok || login != "login" || password != "secret" {
+		t.Errorf("got basic auth %q, %q, %v", login, password, ok)
+	}
+	for header, want := range map[string]string{
+		"Content-Type":           "application/x-www-form-urlencoded",
+		"developerToken":         "token",
+		"clientCustomerId":       "123-456-7890",
+		"User-Agent":             "agent",
+		"skipReportHeader":       "true",
+		"skipColumnHeader":       "true",
+		"skipReportSummary":      "true",
+		"useRawEnumValues":       "true",
+		"includeZeroImpressions": "false",
+	} {
+		if got := r.Header.Get(header); got != want {
+			t.Errorf("got header %s %q, want %q", header, got, want)
+		}
+	}
+	if q, f := r.PostForm.Get("__rdquery"), r.PostForm.Get("__fmt"); q != "SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT DURING YESTERDAY" || f != "CSV" {
+		t.Errorf("got query %q in format %q", q, f)
+	}
+}
+
+func TestDownloadDefaultHeaders(t *testing.T) {
+	d, requests := downloader(t, respond(""))
+	download(t, d, report.TSV)
+	r := (*requests)[0]
+	if _, _, ok := r.BasicAuth(); ok {
+		t.Error("sent basic auth without a login")
+	}
+	for _, header := range []string{"developerToken", "clientCustomerId", "skipReportHeader", "skipColumnHeader",
+		"skipReportSummary", "useRawEnumValues", "includeZeroImpressions"} {
+		if got, ok := r.Header[header]; ok {
+			t.Errorf("sent unset header %s %q", header, got)
+		}
+	}
+}
+
+func TestDownloadDefinition(t *testing.T) {
+	d, requests := downloader(t, respond(""))
+	r, err := d.Download(&report.Definition{
+		Name:     "clicks",
+		Type:     "ACCOUNT_PERFORMANCE_REPORT",
+		Selector: &selector.Selector{Fields: []string{"Clicks"}},
+		Format:   report.XML,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+	form := (*requests)[0].PostForm
+	def := form.Get("__rdxml")
+	for _, want := range []string{"<reportDefinition", "<fields>Clicks</fields>", "<reportType>ACCOUNT_PERFORMANCE_REPORT</reportType>", "<downloadFormat>XML</downloadFormat>"} {
+		if !strings.Contains(def, want) {
+			t.Errorf("definition lacks %s: %s", want, def)
+		}
+	}
+	if form.Has("__rdquery") || form.Has("__fmt") {
+		t.Errorf("definition sent with a query: %v", form)
+	}
+}
+
+func TestDownloadUnknownFormat(t *testing.T) {
+	d, requests := downloader(t, respond(""))
+	if _, err := d.Query("SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT", "PDF"); err == nil {
+		t.Error("downloaded a report in the unknown format PDF")
+	}
+	if len(*requests) != 0 {
+		t.Errorf("sent %d requests", len(*requests))
+	}
+}
+
+func TestDownloadGzip(t *testing.T) {
+	var buf bytes.Buffer
+	z := gzip.NewWriter(&buf)
+	io.WriteString(z, "Clicks\n1\n2\n")
+	z.Close()
+	d, requests := downloader(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-gzip")
+		w.Write(buf.Bytes())
+	})
+	r, err := d.Query("SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT", report.GzippedCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if f := (*requests)[0].PostForm.Get("__fmt"); f != "GZIPPED_CSV" {
+		t.Errorf("got format %q", f)
+	}
+	// The report is returned compressed as sent, and the Parser of the
+	// format decompresses it.
+	d.SkipReportHeader, d.SkipReportSummary = true, true
+	var clicks []int64
+	for row, err := range d.Parser([]*report.Field{{FieldName: "Clicks", FieldType: "Long"}}, report.GzippedCSV).All(r) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		clicks = append(clicks, row.Get("Clicks").(int64))
+	}
+	if len(clicks) != 2 || clicks[0] != 1 || clicks[1] != 2 {
+		t.Errorf("got clicks %v", clicks)
+	}
+}
+
+func TestDownloadError(t *testing.T) {
+	d, _ := downloader(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`+
+			`<reportDownloadError><ApiError><type>ReportDefinitionError.INVALID_FIELD_NAME_FOR_REPORT</type>`+
+			`<trigger>Clickz</trigger><fieldPath>selector.fields[0]</fieldPath></ApiError></reportDownloadError>`)
+	})
+	_, err := d.Query("SELECT Clickz FROM ACCOUNT_PERFORMANCE_REPORT", report.CSV)
+	var e *report.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("got error %v", err)
+	}
+	if e.StatusCode != http.StatusBadRequest || e.Type != "ReportDefinitionError.INVALID_FIELD_NAME_FOR_REPORT" ||
+		e.Trigger != "Clickz" || e.FieldPath != "selector.fields[0]" || e.Body != "" {
+		t.Errorf("got error %+v", e)
+	}
+	want := `report: HTTP 400: ReportDefinitionError.INVALID_FIELD_NAME_FOR_REPORT (trigger "Clickz") at selector.fields[0]`
+	if err.Error() != want {
+		t.Errorf("got error text %q, want %q", err, want)
+	}
+}
+
+func TestDownloadErrorNotXML(t *testing.T) {
+	d, _ := downloader(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusBadGateway)
+	})
+	_, err := d.Query("SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT", report.CSV)
+	var e *report.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("got error %v", err)
+	}
+	if e.StatusCode != http.StatusBadGateway || e.Type != "" || e.Body != "upstream unavailable" {
+		t.Errorf("got error %+v", e)
+	}
+	if err.Error() != "report: HTTP 502: upstream unavailable" {
+		t.Errorf("got error text %q", err)
+	}
+}
+
+func TestDownloadRetry(t *testing.T) {
+	attempts := 0
+	d, requests := downloader(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts++; attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			io.WriteString(w, `<reportDownloadError><ApiError><type>InternalApiError.TRANSIENT_ERROR</type></ApiError></reportDownloadError>`)
+			return
+		}
+		io.WriteString(w, "Clicks\n1\n")
+	})
+	d.Retry = &retry.Policy{Attempts: 2, Initial: time.Millisecond}
+	if got := download(t, d, report.CSV); got != "Clicks\n1\n" {
+		t.Errorf("got report %q", got)
+	}
+	if len(*requests) != 2 || (*requests)[1].PostForm.Get("__rdquery") == "" {
+		t.Errorf("got %d requests, the last without a query", len(*requests))
+	}
+
+	// Errors that aren't transient are not retried.
+	d, requests = downloader(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+	})
+	d.Retry = &retry.Policy{Attempts: 2, Initial: time.Millisecond}
+	if _, err := d.QueryContext(context.Background(), "SELECT Clicks FROM ACCOUNT_PERFORMANCE_REPORT", report.CSV); err == nil {
+		t.Error("downloaded a failing report")
+	}
+	if len(*requests) != 1 {
+		t.Errorf("got %d requests for an error that isn't transient", len(*requests))
+	}
+}
+
+func TestFacadeReports(t *testing.T) {
+	var path, query, format string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query, format = r.URL.Path, r.FormValue("__rdquery"), r.FormValue("__fmt")
+		w.Write([]byte("Campaign ID,Clicks\n1,2\n"))
+	}))
+	t.Cleanup(srv.Close)
+	client, err := adwords.New("v201802", adwords.Config{Endpoint: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Reports == nil {
+		t.Fatal("the client has no report downloader")
+	}
+	r, err := client.Reports.Query(context.Background(), "SELECT CampaignId, Clicks FROM CAMPAIGN_PERFORMANCE_REPORT", "CSV")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "Campaign ID,Clicks\n1,2\n" {
+		t.Errorf("got report %q", b)
+	}
+	if path != "/api/adwords/reportdownload/v201802" || query != "SELECT CampaignId, Clicks FROM CAMPAIGN_PERFORMANCE_REPORT" || format != "CSV" {
+		t.Errorf("got request to %s with query %q and format %q", path, query, format)
+	}
+}
@@ -0,0 +1,31 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/godofdream/go-googleadsinofficial/adwords"
+)
+
+// A Field describes a report field like the ReportDefinitionField returned
+// by ReportDefinitionService.GetReportFields. It has the same JSON form, so
+// the fields of every API version convert with ConvertFields.
+type Field = adwords.ReportField
+
+// An EnumValuePair maps an enum value of the API to the value displayed in
+// reports.
+type EnumValuePair = adwords.EnumValuePair
+
+// ConvertFields converts the ReportDefinitionFields of a generated version,
+// e.g. the Rval of a GetReportFieldsResponse, through their JSON form.
+func ConvertFields(fields interface{}) ([]*Field, error) {
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("report: %v", err)
+	}
+	var converted []*Field
+	if err := json.Unmarshal(b, &converted); err != nil {
+		return nil, fmt.Errorf("report: %v", err)
+	}
+	return converted, nil
+}
@@ -0,0 +1,461 @@
+package report
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/godofdream/go-googleadsinofficial/money"
+)
+
+// A Parser decodes downloaded reports into rows of typed values. The
+// columns are described by the Fields of the report type from
+// ReportDefinitionService.GetReportFields, whose FieldType selects the Go
+// type of their values:
+//
+//	Long, Integer    int64
+//	Money, Bid       int64 micros
+//	Double           float64, percentages such as "12.5%" as 12.5
+//	Boolean          bool
+//	Date             time.Time at the start of the day in Location
+//	enums            string, the value of the API even for display values
+//	others           string
+//
+// Values reported as "--" are nil, as are empty numbers, booleans and
+// dates. The bounds of impression shares such as "< 10%" are returned as
+// the bound, 10. Columns without a Field are strings.
+type Parser struct {
+	// Fields describe the columns. They are matched by FieldName,
+	// DisplayFieldName or XmlAttributeName, whichever the report uses.
+	Fields []*Field
+
+	// Format is the format the report was downloaded in.
+	Format DownloadFormat
+
+	// SkipReportHeader, SkipColumnHeader and SkipReportSummary must match
+	// those of the Downloader of a CSV or TSV report.
+	SkipReportHeader  bool
+	SkipColumnHeader  bool
+	SkipReportSummary bool
+
+	// Columns are the field names of the selected fields in order. They are
+	// required for reports without a column header.
+	Columns []string
+
+	// Location is the time zone of Date values, or UTC if nil.
+	Location *time.Location
+}
+
+// Parser returns a Parser of the reports of d in the given format.
+func (d *Downloader) Parser(fields []*Field, format DownloadFormat) *Parser {
+	return &Parser{
+		Fields:            fields,
+		Format:            format,
+		SkipReportHeader:  d.SkipReportHeader,
+		SkipColumnHeader:  d.SkipColumnHeader,
+		SkipReportSummary: d.SkipReportSummary,
+	}
+}
+
+// A Row is a decoded report row.
+type Row struct {
+	// Fields describe the columns, Values hold their values.
+	Fields []*Field
+	Values []interface{}
+}
+
+// Get returns the value of the column of a field name, or nil if the
+// report has no such column.
+func (r *Row) Get(fieldName string) interface{} {
+	for i, f := range r.Fields {
+		if f.FieldName == fieldName {
+			return r.Values[i]
+		}
+	}
+	return nil
+}
+
+// All returns an iterator over the rows of the report read from r. See
+// Each.
+func (p *Parser) All(r io.Reader) iter.Seq2[*Row, error] {
+	return func(yield func(*Row, error) bool) {
+		stopped := false
+		err := p.Each(r, func(row *Row) error {
+			if !yield(row, nil) {
+				stopped = true
+				return errStop
+			}
+			return nil
+		})
+		if err != nil && !stopped {
+			yield(nil, err)
+		}
+	}
+}
+
+// errStop ends Each when the consumer of All stops.
+var errStop = errors.New("report: iteration stopped")
+
+// Each calls fn for every row of the report read from r and returns the
+// first error. The report is read as the rows are decoded, so reports of
+// any size can be parsed.
+func (p *Parser) Each(r io.Reader, fn func(*Row) error) error {
+	if !p.Format.Valid() {
+		return fmt.Errorf("report: unknown download format %q", p.Format)
+	}
+	if p.Format.Gzipped() {
+		z, err := gzip.NewReader(r)
+		if err != nil {
+			return fmt.Errorf("report: %v", err)
+		}
+		defer z.Close()
+		r = z
+	}
+	br := bufio.NewReader(r)
+	utf16LE := false
+	if bom, _ := br.Peek(3); len(bom) >= 2 && bom[0] == 0xff && bom[1] == 0xfe {
+		br.Discard(2)
+		utf16LE = true
+		r = &utf16Reader{r: br}
+	} else {
+		if len(bom) == 3 && bom[0] == 0xef && bom[1] == 0xbb && bom[2] == 0xbf {
+			br.Discard(3)
+		}
+		r = br
+	}
+	switch p.Format {
+	case XML, GzippedXML:
+		return p.eachXML(r, fn)
+	case TSV:
+		return p.eachCSV(r, '\t', fn)
+	case CSVForExcel:
+		if utf16LE {
+			return p.eachCSV(r, '\t', fn)
+		}
+	}
+	return p.eachCSV(r, ',', fn)
+}
+
+func (p *Parser) eachCSV(r io.Reader, comma rune, fn func(*Row) error) error {
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	cr.FieldsPerRecord = -1
+	line := 0
+	read := func() ([]string, error) {
+		rec, err := cr.Read()
+		line++
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("report: %v", err)
+		}
+		return rec, err
+	}
+	if !p.SkipReportHeader {
+		if _, err := read(); err != nil {
+			return unexpectedEOF(err)
+		}
+	}
+	var cols []*column
+	if p.SkipColumnHeader {
+		if len(p.Columns) == 0 {
+			return fmt.Errorf("report: Columns are required without a column header")
+		}
+		cols = p.columns(p.Columns)
+	} else {
+		header, err := read()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		cols = p.columns(header)
+	}
+	fields := make([]*Field, len(cols))
+	for i, c := range cols {
+		fields[i] = c.field
+	}
+
+	// The last line is held back until the next one is read, so the
+	// summary can be dropped.
+	var pending []string
+	pendingLine := 0
+	emit := func(rec []string, line int) error {
+		if len(rec) != len(cols) {
+			return fmt.Errorf("report: line %d has %d columns, want %d", line, len(rec), len(cols))
+		}
+		row := &Row{Fields: fields, Values: make([]interface{}, len(cols))}
+		for i, c := range cols {
+			v, err := c.decode(rec[i], p.Location)
+			if err != nil {
+				return fmt.Errorf("report: line %d, %s: %v", line, c.field.FieldName, err)
+			}
+			row.Values[i] = v
+		}
+		return fn(row)
+	}
+	for {
+		rec, err := read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if pending != nil {
+			if err := emit(pending, pendingLine); err != nil {
+				return err
+			}
+		}
+		pending, pendingLine = rec, line
+	}
+	if pending != nil && p.SkipReportSummary {
+		return emit(pending, pendingLine)
+	}
+	return nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return fmt.Errorf("report: unexpected end of report")
+	}
+	return err
+}
+
+func (p *Parser) eachXML(r io.Reader, fn func(*Row) error) error {
+	d := xml.NewDecoder(r)
+	var names []string
+	var cols []*column
+	var fields []*Field
+	index := make(map[string]int)
+	rows := 0
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("report: %v", err)
+		}
+		e, ok := t.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch e.Name.Local {
+		case "column":
+			for _, a := range e.Attr {
+				if a.Name.Local == "name" {
+					names = append(names, a.Value)
+				}
+			}
+		case "row":
+			rows++
+			if cols == nil {
+				if len(names) == 0 {
+					if len(p.Columns) == 0 {
+						return fmt.Errorf("report: Columns are required without a column header")
+					}
+					for _, c := range p.Columns {
+						name := c
+						if f := p.field(c); f != nil && f.XmlAttributeName != "" {
+							name = f.XmlAttributeName
+						}
+						names = append(names, name)
+					}
+				}
+				cols = p.columns(names)
+				fields = make([]*Field, len(cols))
+				for i, c := range cols {
+					fields[i] = c.field
+					index[names[i]] = i
+				}
+			}
+			row := &Row{Fields: fields, Values: make([]interface{}, len(cols))}
+			for _, a := range e.Attr {
+				i, ok := index[a.Name.Local]
+				if !ok {
+					return fmt.Errorf("report: row %d has unknown attribute %s", rows, a.Name.Local)
+				}
+				v, err := cols[i].decode(a.Value, p.Location)
+				if err != nil {
+					return fmt.Errorf("report: row %d, %s: %v", rows, cols[i].field.FieldName, err)
+				}
+				row.Values[i] = v
+			}
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// field returns the Field named name by its FieldName, DisplayFieldName or
+// XmlAttributeName, or nil.
+func (p *Parser) field(name string) *Field {
+	for _, f := range p.Fields {
+		if f.FieldName == name {
+			return f
+		}
+	}
+	for _, f := range p.Fields {
+		if f.DisplayFieldName == name || f.XmlAttributeName == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// columns returns the columns of the given names.
+func (p *Parser) columns(names []string) []*column {
+	cols := make([]*column, len(names))
+	for i, name := range names {
+		f := p.field(name)
+		if f == nil {
+			f = &Field{FieldName: name, FieldType: "String"}
+		}
+		c := &column{field: f}
+		if f.IsEnumType && len(f.EnumValuePairs) > 0 {
+			c.enum = make(map[string]string)
+			for _, pair := range f.EnumValuePairs {
+				c.enum[pair.EnumDisplayValue] = pair.EnumValue
+			}
+		}
+		cols[i] = c
+	}
+	return cols
+}
+
+// A column decodes the values of a field.
+type column struct {
+	field *Field
+
+	// enum maps the display values of an enum to its values.
+	enum map[string]string
+}
+
+func (c *column) decode(s string, loc *time.Location) (interface{}, error) {
+	t := strings.TrimSpace(s)
+	if t == "--" {
+		return nil, nil
+	}
+	if c.field.IsEnumType {
+		if v, ok := c.enum[t]; ok {
+			return v, nil
+		}
+		return t, nil
+	}
+	switch c.field.FieldType {
+	case "Long", "Integer", "Money", "Bid":
+		// Bids set automatically are reported as "auto: 1230000".
+		t = strings.ReplaceAll(strings.TrimPrefix(t, "auto: "), ",", "")
+		if t == "" {
+			return nil, nil
+		}
+		if v, err := strconv.ParseInt(t, 10, 64); err == nil {
+			return v, nil
+		}
+		if c.field.FieldType == "Money" || c.field.FieldType == "Bid" {
+			return money.ParseMicros(t)
+		}
+		return nil, fmt.Errorf("invalid %s %q", c.field.FieldType, s)
+	case "Double":
+		t = strings.TrimSpace(strings.TrimLeft(strings.TrimSuffix(t, "%"), "<>"))
+		t = strings.ReplaceAll(t, ",", "")
+		if t == "" {
+			return nil, nil
+		}
+		v, err := strconv.ParseFloat(t, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Double %q", s)
+		}
+		return v, nil
+	case "Boolean":
+		switch strings.ToLower(t) {
+		case "":
+			return nil, nil
+		case "true", "yes":
+			return true, nil
+		case "false", "no":
+			return false, nil
+		}
+		return nil, fmt.Errorf("invalid Boolean %q", s)
+	case "Date":
+		if t == "" {
+			return nil, nil
+		}
+		if loc == nil {
+			loc = time.UTC
+		}
+		for _, layout := range []string{"2006-01-02", "20060102"} {
+			if v, err := time.ParseInLocation(layout, t, loc); err == nil {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid Date %q", s)
+	}
+	return s, nil
+}
+
+// A utf16Reader converts little-endian UTF-16, the encoding of
+// CSVFOREXCEL reports, to UTF-8.
+type utf16Reader struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+func (u *utf16Reader) Read(p []byte) (int, error) {
+	for len(u.buf) < len(p) {
+		r, err := u.unit()
+		if err != nil {
+			return u.flush(p, err)
+		}
+		if utf16.IsSurrogate(r) {
+			r2, err := u.unit()
+			if err == io.EOF {
+				err = errTruncated
+			}
+			if err != nil {
+				return u.flush(p, err)
+			}
+			r = utf16.DecodeRune(r, r2)
+		}
+		u.buf = utf8.AppendRune(u.buf, r)
+		if u.r.Buffered() < 2 {
+			// Don't wait for more input.
+			break
+		}
+	}
+	return u.flush(p, nil)
+}
+
+// flush copies the converted bytes to p. err is returned once they are
+// all read.
+func (u *utf16Reader) flush(p []byte, err error) (int, error) {
+	if len(u.buf) == 0 {
+		return 0, err
+	}
+	n := copy(p, u.buf)
+	u.buf = u.buf[:copy(u.buf, u.buf[n:])]
+	return n, nil
+}
+
+var errTruncated = errors.New("report: truncated UTF-16")
+
+// unit reads a UTF-16 code unit.
+func (u *utf16Reader) unit() (rune, error) {
+	var b [2]byte
+	if _, err := io.ReadFull(u.r, b[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = errTruncated
+		}
+		return 0, err
+	}
+	return rune(binary.LittleEndian.Uint16(b[:])), nil
+}
@@ -0,0 +1,148 @@
+package report_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/godofdream/go-googleadsinofficial/report"
+)
+
+var parseFields = []*report.Field{
+	{FieldName: "Date", DisplayFieldName: "Day", XmlAttributeName: "day", FieldType: "Date"},
+	{FieldName: "Status", DisplayFieldName: "Keyword state", XmlAttributeName: "keywordState", FieldType: "KeywordStatus", IsEnumType: true, EnumValuePairs: []*report.EnumValuePair{
+		{EnumValue: "ENABLED", EnumDisplayValue: "enabled"},
+		{EnumValue: "PAUSED", EnumDisplayValue: "paused"},
+	}},
+	{FieldName: "CpcBid", DisplayFieldName: "Max. CPC", XmlAttributeName: "maxCPC", FieldType: "Bid"},
+	{FieldName: "SearchImpressionShare", DisplayFieldName: "Search Impr. share", XmlAttributeName: "searchImprShare", FieldType: "Double"},
+}
+
+// parseWant are the rows of the reports of TestParser.
+var parseWant = [][]interface{}{
+	{time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC), "ENABLED", int64(1230000), 10.0},
+	{time.Date(2018, 1, 3, 0, 0, 0, 0, time.UTC), "PAUSED", nil, 12.5},
+}
+
+const parseCSV = `"KEYWORDS_PERFORMANCE_REPORT (Jan 2, 2018-Jan 3, 2018)"
+Day,Keyword state,Max. CPC,Search Impr. share
+2018-01-02, enabled ,"auto: 1,230,000",< 10%
+2018-01-03,paused,--,12.5%
+Total,--,--,--
+`
+
+const parseXML = `<?xml version='1.0' encoding='UTF-8' standalone='yes'?>
+<report>
+<report-name name='KEYWORDS_PERFORMANCE_REPORT'/>
+<date-range date='Jan 2, 2018-Jan 3, 2018'/>
+<table>
+<columns>
+<column name='day' display='Day'/>
+<column name='keywordState' display='Keyword state'/>
+<column name='maxCPC' display='Max. CPC'/>
+<column name='searchImprShare' display='Search Impr. share'/>
+</columns>
+<row day='2018-01-02' keywordState='enabled' maxCPC='auto: 1230000' searchImprShare='&lt; 10%'/>
+<row day='2018-01-03' keywordState='paused' maxCPC=' --' searchImprShare='12.5%'/>
+</table>
+</report>
+`
+
+func gzipped(s string) string {
+	var buf bytes.Buffer
+	z := gzip.NewWriter(&buf)
+	z.Write([]byte(s))
+	z.Close()
+	return buf.String()
+}
+
+// utf16LE encodes s as CSVFOREXCEL reports are.
+func utf16LE(s string) string {
+	b := []byte{0xff, 0xfe}
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = append(b, byte(u), byte(u>>8))
+	}
+	return string(b)
+}
+
+func TestParser(t *testing.T) {
+	tsv := strings.NewReplacer(`"auto: 1,230,000"`, "auto: 1230000", ",", "\t").Replace(parseCSV)
+	tests := []struct {
+		name   string
+		format report.DownloadFormat
+		report string
+	}{
+		{"csv", report.CSV, parseCSV},
+		{"csv with bom", report.CSV, "\xef\xbb\xbf" + parseCSV},
+		{"tsv", report.TSV, tsv},
+		{"csv for excel", report.CSVForExcel, utf16LE(tsv)},
+		{"gzipped csv", report.GzippedCSV, gzipped(parseCSV)},
+		{"xml", report.XML, parseXML},
+		{"gzipped xml", report.GzippedXML, gzipped(parseXML)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &report.Parser{Fields: parseFields, Format: tt.format}
+			var got [][]interface{}
+			for row, err := range p.All(strings.NewReader(tt.report)) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(row.Fields) != len(parseFields) || row.Fields[1] != parseFields[1] {
+					t.Fatalf("got fields %v", row.Fields)
+				}
+				got = append(got, row.Values)
+			}
+			if !reflect.DeepEqual(got, parseWant) {
+				t.Errorf("got %v, want %v", got, parseWant)
+			}
+		})
+	}
+}
+
+func TestParserErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		p      report.Parser
+		report string
+		err    string
+	}{
+		{"invalid bid", report.Parser{Fields: parseFields, Format: report.CSV, SkipReportHeader: true, SkipReportSummary: true},
+			"Max. CPC\nabc\n", "line 2, CpcBid"},
+		{"columns", report.Parser{Fields: parseFields, Format: report.CSV, SkipReportHeader: true, SkipReportSummary: true},
+			"Day,Max. CPC\n2018-01-02\n", "line 2 has 1 columns, want 2"},
+		{"no header", report.Parser{Format: report.CSV, SkipReportHeader: true, SkipColumnHeader: true},
+			"1\n", "Columns are required"},
+		{"empty", report.Parser{Format: report.CSV}, "", "unexpected end of report"},
+		{"unknown attribute", report.Parser{Fields: parseFields, Format: report.XML},
+			"<report><table><columns><column name='day'/></columns><row cost='1'/></table></report>", "unknown attribute cost"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.p.Each(strings.NewReader(tt.report), func(*report.Row) error { return nil })
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got error %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParserColumns(t *testing.T) {
+	// Without a column header, Columns name the fields.
+	p := &report.Parser{Fields: parseFields, Format: report.CSV, SkipReportHeader: true, SkipColumnHeader: true, SkipReportSummary: true, Columns: []string{"Status", "CpcBid"}}
+	var got [][]interface{}
+	err := p.Each(strings.NewReader("enabled,100\n"), func(row *report.Row) error {
+		got = append(got, row.Values)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]interface{}{{"ENABLED", int64(100)}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
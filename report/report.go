@@ -0,0 +1,138 @@
+// Package report downloads ad-hoc reports from the report download
+// endpoint of the AdWords API. Reports are defined either by an AWQL query
+//
+//	SELECT CampaignId, Impressions, Cost FROM CAMPAIGN_PERFORMANCE_REPORT DURING LAST_7_DAYS
+//
+// or by a Definition, which is sent as the XML of a ReportDefinition. They
+// are returned as a stream, so large reports never have to be held in
+// memory.
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// A DownloadFormat is the format of a downloaded report.
+type DownloadFormat string
+
+// Download formats.
+const (
+	CSVForExcel DownloadFormat = "CSVFOREXCEL"
+	CSV         DownloadFormat = "CSV"
+	TSV         DownloadFormat = "TSV"
+	XML         DownloadFormat = "XML"
+	GzippedCSV  DownloadFormat = "GZIPPED_CSV"
+	GzippedXML  DownloadFormat = "GZIPPED_XML"
+)
+
+// Formats lists all download formats.
+var Formats = []DownloadFormat{CSVForExcel, CSV, TSV, XML, GzippedCSV, GzippedXML}
+
+// Valid reports whether f is a known download format.
+func (f DownloadFormat) Valid() bool {
+	for _, v := range Formats {
+		if f == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Gzipped reports whether reports of format f are compressed.
+func (f DownloadFormat) Gzipped() bool {
+	return f == GzippedCSV || f == GzippedXML
+}
+
+// A Definition defines a report like the ReportDefinition of the API.
+type Definition struct {
+	// Name is the name of the report.
+	Name string
+
+	// Type is the ReportDefinitionReportType, e.g.
+	// "KEYWORDS_PERFORMANCE_REPORT".
+	Type string
+
+	// DateRangeType is a named date range such as "LAST_7_DAYS". It
+	// defaults to "CUSTOM_DATE" if the Selector has a DateRange and to
+	// "ALL_TIME" otherwise.
+	DateRangeType string
+
+	// Selector selects the fields, filters and date range of the report.
+	// Its Ordering and Paging are not supported by reports.
+	Selector *selector.Selector
+
+	Format DownloadFormat
+}
+
+// definitionXML is the XML form of a Definition.
+type definitionXML struct {
+	XMLName       xml.Name     `xml:"reportDefinition"`
+	Namespace     string       `xml:"xmlns,attr"`
+	Selector      *selectorXML `xml:"selector"`
+	Name          string       `xml:"reportName"`
+	Type          string       `xml:"reportType"`
+	DateRangeType string       `xml:"dateRangeType"`
+	Format        string       `xml:"downloadFormat"`
+}
+
+type selectorXML struct {
+	Fields     []string        `xml:"fields"`
+	Predicates []*predicateXML `xml:"predicates"`
+	DateRange  *dateRangeXML   `xml:"dateRange"`
+}
+
+type predicateXML struct {
+	Field    string   `xml:"field"`
+	Operator string   `xml:"operator"`
+	Values   []string `xml:"values"`
+}
+
+type dateRangeXML struct {
+	Min string `xml:"min"`
+	Max string `xml:"max"`
+}
+
+// Marshal returns the XML of d for the given API version, e.g. "v201802".
+func (d *Definition) Marshal(version string) ([]byte, error) {
+	if d.Selector == nil || len(d.Selector.Fields) == 0 {
+		return nil, fmt.Errorf("report: definition %q selects no fields", d.Name)
+	}
+	if d.Type == "" {
+		return nil, fmt.Errorf("report: definition %q has no report type", d.Name)
+	}
+	if !d.Format.Valid() {
+		return nil, fmt.Errorf("report: definition %q has unknown download format %q", d.Name, d.Format)
+	}
+	if len(d.Selector.Ordering) > 0 || d.Selector.Paging != nil {
+		return nil, fmt.Errorf("report: definition %q: reports can't be ordered or paged", d.Name)
+	}
+	dateRangeType := d.DateRangeType
+	switch {
+	case dateRangeType != "":
+	case d.Selector.DateRange != nil:
+		dateRangeType = "CUSTOM_DATE"
+	default:
+		dateRangeType = "ALL_TIME"
+	}
+	if (dateRangeType == "CUSTOM_DATE") != (d.Selector.DateRange != nil) {
+		return nil, fmt.Errorf("report: definition %q: a date range requires the CUSTOM_DATE range type", d.Name)
+	}
+	sel := &selectorXML{Fields: d.Selector.Fields}
+	for _, p := range d.Selector.Predicates {
+		sel.Predicates = append(sel.Predicates, &predicateXML{Field: p.Field, Operator: p.Operator, Values: p.Values})
+	}
+	if r := d.Selector.DateRange; r != nil {
+		sel.DateRange = &dateRangeXML{Min: r.Min, Max: r.Max}
+	}
+	return xml.Marshal(&definitionXML{
+		Namespace:     "https://adwords.google.com/api/adwords/cm/" + version,
+		Selector:      sel,
+		Name:          d.Name,
+		Type:          d.Type,
+		DateRangeType: dateRangeType,
+		Format:        string(d.Format),
+	})
+}
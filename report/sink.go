@@ -0,0 +1,309 @@
+package report
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/parquet"
+)
+
+// A Sink receives the decoded rows of reports, e.g. to store them.
+type Sink interface {
+	Write(row *Row) error
+	Close() error
+}
+
+// Copy writes the rows of the report read from r with p to sink. It does
+// not close sink, so the rows of several reports can be copied to it.
+func Copy(sink Sink, p *Parser, r io.Reader) error {
+	return p.Each(r, sink.Write)
+}
+
+// nullPartition names the partition of rows without a value, as Hive does.
+const nullPartition = "__HIVE_DEFAULT_PARTITION__"
+
+// DefaultMaxOpenFiles is the number of files a ParquetSink with no
+// MaxOpenFiles keeps open.
+const DefaultMaxOpenFiles = 32
+
+// A ParquetSink writes report rows to Parquet files partitioned by
+// customer and date in the directory layout of Hive,
+//
+//	Dir/customer_id=1234567890/date=2018-01-31/Name.parquet
+//
+// The column types follow the field types: Long, Integer, Money and Bid
+// are INT64, so money stays in micros, Double is DOUBLE, Boolean BOOLEAN,
+// Date DATE, enums are dictionary encoded strings and everything else
+// strings. The partition columns are not repeated in the files. All rows
+// must have the fields of the first row.
+//
+// A file is written under a temporary name and renamed when it is
+// complete, so readers of the directory never see incomplete files. At
+// most MaxOpenFiles files are open, each buffering a row group. Writing to
+// another partition completes the file written least recently; if its
+// partition gets more rows, they go to a further file Name-2.parquet,
+// Name-3.parquet and so on. Files of earlier runs beyond the ones written
+// are removed on Close.
+type ParquetSink struct {
+	// Dir is the root directory of the partitions.
+	Dir string
+
+	// Name is the file name in the partitions without extension, or
+	// "report" if empty. Sinks writing to the same directory need
+	// different names.
+	Name string
+
+	// CustomerID partitions the rows, e.g. the ClientCustomerId of the
+	// Downloader. If empty, rows are partitioned by their
+	// ExternalCustomerId column, if any.
+	CustomerID string
+
+	// DateField partitions the rows by date, or "Date" if empty. Rows of
+	// reports without the field are not partitioned by date.
+	DateField string
+
+	// RowGroupSize is passed to the parquet.Writer of every file.
+	RowGroupSize int
+
+	// MaxOpenFiles is the number of files kept open, or
+	// DefaultMaxOpenFiles if 0.
+	MaxOpenFiles int
+
+	fields   []*Field // of the first row
+	columns  []parquet.Column
+	index    []int // the row values of the columns
+	customer int   // the row value of the customer, or -1
+	date     int   // the row value of the date, or -1
+
+	files map[string]*parquetFile // the open files by partition
+	parts map[string]int          // the number of files by partition
+	clock int
+}
+
+type parquetFile struct {
+	f    *os.File
+	w    *parquet.Writer
+	path string
+	used int // the clock of the last write
+}
+
+// Write adds a row to the file of its partition.
+func (s *ParquetSink) Write(row *Row) error {
+	if s.fields == nil {
+		s.files = make(map[string]*parquetFile)
+		s.parts = make(map[string]int)
+		s.schema(row.Fields)
+	} else if err := s.check(row.Fields); err != nil {
+		return err
+	}
+	dir := s.Dir
+	if s.customer >= 0 || s.CustomerID != "" {
+		id := s.CustomerID
+		if s.customer >= 0 {
+			id = partitionValue(row.Values[s.customer])
+		}
+		dir = filepath.Join(dir, "customer_id="+strings.ReplaceAll(id, "-", ""))
+	}
+	if s.date >= 0 {
+		dir = filepath.Join(dir, "date="+partitionValue(row.Values[s.date]))
+	}
+	pf, ok := s.files[dir]
+	if !ok {
+		var err error
+		if pf, err = s.open(dir); err != nil {
+			return err
+		}
+	}
+	s.clock++
+	pf.used = s.clock
+	values := make([]interface{}, len(s.index))
+	for i, j := range s.index {
+		values[i] = row.Values[j]
+	}
+	return pf.w.Write(values)
+}
+
+// check reports fields that differ from those of the first row.
+func (s *ParquetSink) check(fields []*Field) error {
+	if len(fields) != len(s.fields) {
+		return fmt.Errorf("report: row has %d fields, the first row %d", len(fields), len(s.fields))
+	}
+	for i, f := range fields {
+		if first := s.fields[i]; f.FieldName != first.FieldName || f.FieldType != first.FieldType || f.IsEnumType != first.IsEnumType {
+			return fmt.Errorf("report: field %d of row is %s (%s), of the first row %s (%s)", i, f.FieldName, f.FieldType, first.FieldName, first.FieldType)
+		}
+	}
+	return nil
+}
+
+// schema determines the columns of the files from the fields of the first
+// row.
+func (s *ParquetSink) schema(fields []*Field) {
+	dateField := s.DateField
+	if dateField == "" {
+		dateField = "Date"
+	}
+	s.fields = fields
+	s.columns, s.index = nil, nil
+	s.customer, s.date = -1, -1
+	for i, f := range fields {
+		switch {
+		case f.FieldName == dateField:
+			s.date = i
+			continue
+		case f.FieldName == "ExternalCustomerId" && s.CustomerID == "":
+			s.customer = i
+			continue
+		}
+		s.index = append(s.index, i)
+		s.columns = append(s.columns, parquetColumn(f))
+	}
+}
+
+// parquetColumn returns the column of the values of f.
+func parquetColumn(f *Field) parquet.Column {
+	c := parquet.Column{Name: f.FieldName, Type: parquet.String}
+	switch {
+	case f.IsEnumType:
+		c.Dictionary = true
+	case f.FieldType == "Long" || f.FieldType == "Integer" || f.FieldType == "Money" || f.FieldType == "Bid":
+		c.Type = parquet.Int64
+	case f.FieldType == "Double":
+		c.Type = parquet.Double
+	case f.FieldType == "Boolean":
+		c.Type = parquet.Boolean
+	case f.FieldType == "Date":
+		c.Type = parquet.Date
+	}
+	return c
+}
+
+func partitionValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return nullPartition
+	case time.Time:
+		return v.Format("2006-01-02")
+	case int64:
+		return strconv.FormatInt(v, 10)
+	}
+	return fmt.Sprint(v)
+}
+
+// open creates the next file of the partition dir, completing the file
+// written least recently if MaxOpenFiles are open.
+func (s *ParquetSink) open(dir string) (*parquetFile, error) {
+	max := s.MaxOpenFiles
+	if max <= 0 {
+		max = DefaultMaxOpenFiles
+	}
+	if len(s.files) >= max {
+		oldest := ""
+		for d, pf := range s.files {
+			if oldest == "" || pf.used < s.files[oldest].used {
+				oldest = d
+			}
+		}
+		pf := s.files[oldest]
+		delete(s.files, oldest)
+		if err := pf.complete(); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(dir, 0775); err != nil {
+		return nil, err
+	}
+	s.parts[dir]++
+	path := s.part(dir, s.parts[dir])
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, err
+	}
+	w, err := parquet.NewWriter(f, s.columns)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	w.RowGroupSize = s.RowGroupSize
+	pf := &parquetFile{f: f, w: w, path: path}
+	s.files[dir] = pf
+	return pf, nil
+}
+
+// part returns the path of the nth file of the partition dir.
+func (s *ParquetSink) part(dir string, n int) string {
+	name := s.Name
+	if name == "" {
+		name = "report"
+	}
+	if n > 1 {
+		name += "-" + strconv.Itoa(n)
+	}
+	return filepath.Join(dir, name+".parquet")
+}
+
+// complete finishes the file and renames it to its final name, or removes
+// it on failure.
+func (pf *parquetFile) complete() error {
+	err := pf.w.Close()
+	if cerr := pf.f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(pf.f.Name(), pf.path)
+	}
+	if err != nil {
+		os.Remove(pf.f.Name())
+	}
+	return err
+}
+
+// Close completes all files and removes the further files of earlier runs
+// from the partitions written. A sink can be written again after Close.
+func (s *ParquetSink) Close() error {
+	var errs []error
+	for _, pf := range s.files {
+		if err := pf.complete(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for dir, n := range s.parts {
+		for i := n + 1; ; i++ {
+			err := os.Remove(s.part(dir, i))
+			if os.IsNotExist(err) {
+				break
+			}
+			if err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+	}
+	s.fields, s.files, s.parts = nil, nil, nil
+	return errors.Join(errs...)
+}
+
+// Rollback closes and removes the open files, discarding their rows since
+// the last Close. Files completed before because MaxOpenFiles files were
+// open are kept. A sink can be written again after Rollback.
+func (s *ParquetSink) Rollback() error {
+	var errs []error
+	for _, pf := range s.files {
+		pf.w.Close()
+		if err := pf.f.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		if err := os.Remove(pf.f.Name()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	s.fields, s.files, s.parts = nil, nil, nil
+	return errors.Join(errs...)
+}
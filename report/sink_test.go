@@ -0,0 +1,103 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sinkRow(date string, clicks int64) *Row {
+	d, _ := time.Parse("2006-01-02", date)
+	return &Row{
+		Fields: []*Field{
+			{FieldName: "Date", FieldType: "Date"},
+			{FieldName: "Clicks", FieldType: "Long"},
+		},
+		Values: []interface{}{d, clicks},
+	}
+}
+
+// files returns the files below dir relative to it.
+func files(t *testing.T, dir string) []string {
+	t.Helper()
+	var names []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		names = append(names, filepath.ToSlash(rel))
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(string(b), "PAR1") || !strings.HasSuffix(string(b), "PAR1") {
+			t.Errorf("%s is no complete Parquet file", rel)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestParquetSinkMaxOpenFiles(t *testing.T) {
+	dir := t.TempDir()
+	// A file of an earlier run with more parts.
+	stale := filepath.Join(dir, "customer_id=1234567890", "date=2018-01-01", "report-3.parquet")
+	if err := os.MkdirAll(filepath.Dir(stale), 0775); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(stale, []byte("PAR1PAR1"), 0664); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &ParquetSink{Dir: dir, CustomerID: "123-456-7890", MaxOpenFiles: 1}
+	for _, row := range []*Row{
+		sinkRow("2018-01-01", 1),
+		sinkRow("2018-01-02", 2),
+		sinkRow("2018-01-01", 3),
+	} {
+		if err := s.Write(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := len(s.files); got != 1 {
+		t.Errorf("got %d open files, want 1", got)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"customer_id=1234567890/date=2018-01-01/report-2.parquet",
+		"customer_id=1234567890/date=2018-01-01/report.parquet",
+		"customer_id=1234567890/date=2018-01-02/report.parquet",
+	}
+	if got := files(t, dir); strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("got files %v, want %v", got, want)
+	}
+}
+
+func TestParquetSinkSchema(t *testing.T) {
+	s := &ParquetSink{Dir: t.TempDir(), CustomerID: "1234567890"}
+	if err := s.Write(sinkRow("2018-01-01", 1)); err != nil {
+		t.Fatal(err)
+	}
+	other := sinkRow("2018-01-01", 2)
+	other.Fields[1] = &Field{FieldName: "Cost", FieldType: "Money"}
+	if err := s.Write(other); err == nil {
+		t.Error("row with other fields was written")
+	}
+	short := &Row{Fields: other.Fields[:1], Values: other.Values[:1]}
+	if err := s.Write(short); err == nil {
+		t.Error("row with fewer fields was written")
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
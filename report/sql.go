@@ -0,0 +1,390 @@
+package report
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// A Dialect is the SQL dialect of a database.
+type Dialect int
+
+// The supported dialects.
+const (
+	SQLite Dialect = iota
+	Postgres
+)
+
+// maxParams bounds the parameters of a statement. SQLite before 3.32
+// allows 999.
+const maxParams = 999
+
+func (d Dialect) placeholder(n int) string {
+	if d == Postgres {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// columnType returns the column type of the values of f.
+func (d Dialect) columnType(f *Field) string {
+	switch {
+	case f == nil || f.IsEnumType:
+		return "TEXT"
+	case f.FieldType == "Long" || f.FieldType == "Integer" || f.FieldType == "Money" || f.FieldType == "Bid":
+		if d == Postgres {
+			return "BIGINT"
+		}
+		return "INTEGER"
+	case f.FieldType == "Double":
+		if d == Postgres {
+			return "DOUBLE PRECISION"
+		}
+		return "REAL"
+	case f.FieldType == "Boolean":
+		if d == Postgres {
+			return "BOOLEAN"
+		}
+		return "INTEGER"
+	case f.FieldType == "Date":
+		if d == Postgres {
+			return "DATE"
+		}
+		return "TEXT"
+	}
+	return "TEXT"
+}
+
+// value converts a row value for the database. SQLite has no date type, so
+// dates are stored as YYYY-MM-DD, which sorts and compares as dates.
+func (d Dialect) value(v interface{}) interface{} {
+	if t, ok := v.(time.Time); ok && d == SQLite {
+		return t.Format("2006-01-02")
+	}
+	return v
+}
+
+// quote quotes an identifier.
+func quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// ColumnName returns the SQL column name of a field name, e.g.
+// external_customer_id for ExternalCustomerId.
+func ColumnName(fieldName string) string {
+	var b strings.Builder
+	runes := []rune(fieldName)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) ||
+			i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// TableName returns the table name of a report type, e.g.
+// keywords_performance_report for KEYWORDS_PERFORMANCE_REPORT.
+func TableName(reportType string) string {
+	return strings.ToLower(reportType)
+}
+
+// A SQLSink loads report rows into a table of a database/sql database, one
+// table per report type. The table is created from the fields of the
+// rows, and columns of fields it lacks are added, so it follows the
+// selected fields of the reports loaded into it. Column names are the
+// snake case field names, see ColumnName, and the types follow the field
+// types as the values of a Parser: micros stay integers, enums are text.
+//
+// Rows are keyed by customer and date. Replace deletes the rows of a
+// customer and date range loaded before, so the range can be loaded again
+// after the API updated its statistics; LoadSQL does both. All deletes and
+// rows are loaded in one transaction, which is committed by Close.
+type SQLSink struct {
+	DB      *sql.DB
+	Dialect Dialect
+
+	// Table is the name of the table, e.g. TableName of the report type.
+	Table string
+
+	// CustomerID is stored in the customer_id column of the rows, e.g.
+	// the ClientCustomerId of the Downloader. If empty, rows are keyed by
+	// their ExternalCustomerId column, which they must have then, as the
+	// rows of Merge do.
+	CustomerID string
+
+	// DateField is the field of the dates of Replace, or "Date" if empty.
+	DateField string
+
+	// BatchSize is the number of rows inserted by one statement, or as
+	// many as the parameters of a statement allow if zero.
+	BatchSize int
+
+	tx      *sql.Tx
+	columns []string // the quoted column names of the insert
+	index   []int    // the row values of the columns, -1 for CustomerID
+	batch   []interface{}
+	rows    int
+}
+
+// LoadSQL loads the report of a customer read from r with p into s and
+// commits it. It replaces the rows of the customer between the dates from
+// and to, inclusive, i.e. the date range of the report, see Replace. On
+// errors nothing is loaded.
+func LoadSQL(s *SQLSink, p *Parser, r io.Reader, customerID string, from, to time.Time) error {
+	if err := s.Replace(customerID, from, to); err != nil {
+		return err
+	}
+	if err := Copy(s, p, r); err != nil {
+		s.Rollback()
+		return err
+	}
+	return s.Close()
+}
+
+// Replace deletes the rows of a customer between the dates from and to,
+// inclusive, so the rows written next replace them. They are deleted even
+// if no rows follow, e.g. because the range has no statistics anymore.
+// Tables without the date column lose all rows of the customer. The
+// customer is the CustomerID of s if set, and the ExternalCustomerId of
+// the rows otherwise.
+func (s *SQLSink) Replace(customerID string, from, to time.Time) error {
+	column, customer := "customer_id", interface{}(customerID)
+	switch {
+	case customerID == "":
+		return fmt.Errorf("report: %s: no customer to replace", s.Table)
+	case s.CustomerID != "" && customerID != s.CustomerID:
+		return fmt.Errorf("report: %s: rows of %s can't replace those of %s", s.Table, s.CustomerID, customerID)
+	case s.CustomerID == "":
+		id, err := strconv.ParseInt(strings.ReplaceAll(customerID, "-", ""), 10, 64)
+		if err != nil {
+			return fmt.Errorf("report: invalid customer ID %q", customerID)
+		}
+		column, customer = "external_customer_id", id
+	}
+	if err := s.begin(); err != nil {
+		return err
+	}
+	existing, err := s.existing()
+	if err != nil || existing == nil {
+		return err
+	}
+	if !contains(existing, column) {
+		s.Rollback()
+		return fmt.Errorf("report: %s has no column %s", s.Table, column)
+	}
+	query := "DELETE FROM " + quote(s.Table) + " WHERE " + quote(column) + " = " + s.Dialect.placeholder(1)
+	args := []interface{}{customer}
+	if date := ColumnName(s.dateField()); contains(existing, date) {
+		query += " AND " + quote(date) + " BETWEEN " + s.Dialect.placeholder(2) + " AND " + s.Dialect.placeholder(3)
+		args = append(args, s.Dialect.value(from), s.Dialect.value(to))
+	}
+	if _, err := s.tx.Exec(query, args...); err != nil {
+		s.Rollback()
+		return fmt.Errorf("report: %s: %v", s.Table, err)
+	}
+	return nil
+}
+
+func (s *SQLSink) dateField() string {
+	if s.DateField == "" {
+		return "Date"
+	}
+	return s.DateField
+}
+
+// Write adds a row to the table. The table is created or migrated with
+// the first row.
+func (s *SQLSink) Write(row *Row) error {
+	if s.columns == nil {
+		if err := s.prepare(row.Fields); err != nil {
+			s.Rollback()
+			return err
+		}
+	}
+	for _, i := range s.index {
+		if i < 0 {
+			s.batch = append(s.batch, s.CustomerID)
+		} else {
+			s.batch = append(s.batch, s.Dialect.value(row.Values[i]))
+		}
+	}
+	if s.rows++; s.rows >= s.batchSize() {
+		return s.flush()
+	}
+	return nil
+}
+
+func (s *SQLSink) batchSize() int {
+	if s.BatchSize > 0 && s.BatchSize*len(s.columns) <= maxParams {
+		return s.BatchSize
+	}
+	if n := maxParams / len(s.columns); n > 1 {
+		return n
+	}
+	return 1
+}
+
+// begin starts the transaction unless it is running.
+func (s *SQLSink) begin() error {
+	if s.tx != nil {
+		return nil
+	}
+	var err error
+	s.tx, err = s.DB.Begin()
+	return err
+}
+
+// prepare prepares the table for rows of fields.
+func (s *SQLSink) prepare(fields []*Field) error {
+	s.columns, s.index = nil, nil
+	types := make(map[string]string)
+	var key []string
+	if s.CustomerID != "" {
+		s.add("customer_id", -1, types, "TEXT")
+		key = append(key, quote("customer_id"))
+	} else if !hasField(fields, "ExternalCustomerId") {
+		return fmt.Errorf("report: rows of %s have no ExternalCustomerId and the sink no CustomerID", s.Table)
+	}
+	for i, f := range fields {
+		name := ColumnName(f.FieldName)
+		if _, ok := types[name]; ok {
+			return fmt.Errorf("report: column %s of %s is not unique", name, f.FieldName)
+		}
+		s.add(name, i, types, s.Dialect.columnType(f))
+		if f.FieldName == "ExternalCustomerId" && s.CustomerID == "" || f.FieldName == s.dateField() {
+			key = append(key, quote(name))
+		}
+	}
+	if err := s.begin(); err != nil {
+		return err
+	}
+	return s.migrate(types, key)
+}
+
+func (s *SQLSink) add(name string, i int, types map[string]string, typ string) {
+	s.columns = append(s.columns, quote(name))
+	s.index = append(s.index, i)
+	types[name] = typ
+}
+
+// existing returns the columns of the table, or nil if it doesn't exist.
+func (s *SQLSink) existing() ([]string, error) {
+	query := "SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?"
+	if s.Dialect == Postgres {
+		query = "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1"
+	}
+	var name string
+	switch err := s.tx.QueryRow(query, s.Table).Scan(&name); {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("report: %s: %v", s.Table, err)
+	}
+	rows, err := s.tx.Query("SELECT * FROM " + quote(s.Table) + " WHERE 1 = 0")
+	if err != nil {
+		return nil, fmt.Errorf("report: %s: %v", s.Table, err)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("report: %s: %v", s.Table, err)
+	}
+	return columns, nil
+}
+
+// migrate creates the table or adds the columns it lacks, and indexes
+// the key.
+func (s *SQLSink) migrate(types map[string]string, key []string) error {
+	table := quote(s.Table)
+	defs := make([]string, len(s.columns))
+	for i, c := range s.columns {
+		defs[i] = c + " " + types[unquote(c)]
+	}
+	existing, err := s.existing()
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		if _, err := s.tx.Exec("CREATE TABLE " + table + " (" + strings.Join(defs, ", ") + ")"); err != nil {
+			return fmt.Errorf("report: %s: %v", s.Table, err)
+		}
+	}
+	for i, c := range s.columns {
+		if existing != nil && !contains(existing, unquote(c)) {
+			if _, err := s.tx.Exec("ALTER TABLE " + table + " ADD COLUMN " + defs[i]); err != nil {
+				return fmt.Errorf("report: %s: %v", s.Table, err)
+			}
+		}
+	}
+	// The index speeds up the deletes of Replace.
+	index := "CREATE INDEX IF NOT EXISTS " + quote(s.Table+"_key") + " ON " + table + " (" + strings.Join(key, ", ") + ")"
+	if _, err := s.tx.Exec(index); err != nil {
+		return fmt.Errorf("report: %s: %v", s.Table, err)
+	}
+	return nil
+}
+
+func unquote(name string) string {
+	return strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+}
+
+// flush inserts the rows of the batch.
+func (s *SQLSink) flush() error {
+	if s.rows == 0 {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString("INSERT INTO " + quote(s.Table) + " (" + strings.Join(s.columns, ", ") + ") VALUES ")
+	n := 0
+	for r := 0; r < s.rows; r++ {
+		if r > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteByte('(')
+		for c := range s.columns {
+			if c > 0 {
+				b.WriteString(", ")
+			}
+			n++
+			b.WriteString(s.Dialect.placeholder(n))
+		}
+		b.WriteByte(')')
+	}
+	_, err := s.tx.Exec(b.String(), s.batch...)
+	s.batch, s.rows = s.batch[:0], 0
+	if err != nil {
+		return fmt.Errorf("report: %s: %v", s.Table, err)
+	}
+	return nil
+}
+
+// Close inserts the remaining rows and commits them with the deletes of
+// Replace. A sink can be written again after Close.
+func (s *SQLSink) Close() error {
+	if s.tx == nil {
+		return nil
+	}
+	if err := s.flush(); err != nil {
+		s.Rollback()
+		return err
+	}
+	err := s.tx.Commit()
+	s.tx, s.columns = nil, nil
+	return err
+}
+
+// Rollback discards the deletes and rows since the last Close.
+func (s *SQLSink) Rollback() error {
+	if s.tx == nil {
+		return nil
+	}
+	err := s.tx.Rollback()
+	s.tx, s.columns, s.batch, s.rows = nil, nil, nil, 0
+	return err
+}
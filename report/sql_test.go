@@ -0,0 +1,155 @@
+package report_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/report"
+)
+
+// recorder is a database/sql driver recording the statements executed. It
+// knows a table if its columns are set.
+type recorder struct {
+	mu         sync.Mutex
+	columns    []string
+	statements []string
+}
+
+func (r *recorder) Open(string) (driver.Conn, error) { return &conn{r}, nil }
+
+type conn struct{ r *recorder }
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) { return &stmt{c.r, query}, nil }
+func (c *conn) Close() error                              { return nil }
+func (c *conn) Begin() (driver.Tx, error)                 { return c, nil }
+func (c *conn) Commit() error                             { return c.r.record("COMMIT") }
+func (c *conn) Rollback() error                           { return c.r.record("ROLLBACK") }
+
+func (r *recorder) record(s string, args ...driver.Value) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(args) > 0 {
+		s += fmt.Sprint(" ", args)
+	}
+	r.statements = append(r.statements, s)
+	return nil
+}
+
+type stmt struct {
+	r     *recorder
+	query string
+}
+
+func (s *stmt) Close() error  { return nil }
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), s.r.record(s.query, args...)
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	switch {
+	case s.r.columns == nil:
+		return &rows{columns: []string{"name"}}, nil
+	case strings.Contains(s.query, "sqlite_master"), strings.Contains(s.query, "information_schema"):
+		return &rows{columns: []string{"name"}, values: [][]driver.Value{{args[0]}}}, nil
+	}
+	return &rows{columns: s.r.columns}, nil
+}
+
+type rows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *rows) Columns() []string { return r.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func open(t *testing.T, columns ...string) (*sql.DB, *recorder) {
+	r := &recorder{columns: columns}
+	name := t.Name()
+	sql.Register(name, r)
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, r
+}
+
+func TestLoadSQL(t *testing.T) {
+	from := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2018, 1, 31, 0, 0, 0, 0, time.UTC)
+	fields := []*report.Field{
+		{FieldName: "Date", DisplayFieldName: "Day", FieldType: "Date"},
+		{FieldName: "Cost", FieldType: "Money"},
+	}
+	p := &report.Parser{Fields: fields, Format: report.CSV, SkipReportHeader: true, SkipReportSummary: true}
+
+	t.Run("new table", func(t *testing.T) {
+		db, r := open(t)
+		s := &report.SQLSink{DB: db, Table: "campaign", CustomerID: "123-456-7890"}
+		if err := report.LoadSQL(s, p, strings.NewReader("Day,Cost\n2018-01-02,10\n"), "123-456-7890", from, to); err != nil {
+			t.Fatal(err)
+		}
+		want := []string{
+			`CREATE TABLE "campaign" ("customer_id" TEXT, "date" TEXT, "cost" INTEGER)`,
+			`CREATE INDEX IF NOT EXISTS "campaign_key" ON "campaign" ("customer_id", "date")`,
+			`INSERT INTO "campaign" ("customer_id", "date", "cost") VALUES (?, ?, ?) [123-456-7890 2018-01-02 10]`,
+			"COMMIT",
+		}
+		if got := strings.Join(r.statements, "\n"); got != strings.Join(want, "\n") {
+			t.Errorf("got\n%s\nwant\n%s", got, strings.Join(want, "\n"))
+		}
+	})
+
+	t.Run("reload without rows", func(t *testing.T) {
+		db, r := open(t, "customer_id", "date", "cost")
+		s := &report.SQLSink{DB: db, Dialect: report.Postgres, Table: "campaign", CustomerID: "123-456-7890"}
+		if err := report.LoadSQL(s, p, strings.NewReader("Day,Cost\n"), "123-456-7890", from, to); err != nil {
+			t.Fatal(err)
+		}
+		want := []string{
+			`DELETE FROM "campaign" WHERE "customer_id" = $1 AND "date" BETWEEN $2 AND $3 [123-456-7890 ` + fmt.Sprint(from) + " " + fmt.Sprint(to) + "]",
+			"COMMIT",
+		}
+		if got := strings.Join(r.statements, "\n"); got != strings.Join(want, "\n") {
+			t.Errorf("got\n%s\nwant\n%s", got, strings.Join(want, "\n"))
+		}
+	})
+
+	t.Run("no customer", func(t *testing.T) {
+		db, r := open(t)
+		s := &report.SQLSink{DB: db, Table: "campaign"}
+		if err := report.LoadSQL(s, p, strings.NewReader("Day,Cost\n2018-01-02,10\n"), "123-456-7890", from, to); err == nil {
+			t.Error("loaded rows without a customer")
+		}
+		if got := r.statements; len(got) != 1 || got[0] != "ROLLBACK" {
+			t.Errorf("got statements %q, want a rollback", got)
+		}
+	})
+
+	t.Run("other customer", func(t *testing.T) {
+		db, _ := open(t)
+		s := &report.SQLSink{DB: db, Table: "campaign", CustomerID: "123-456-7890"}
+		if err := s.Replace("111-111-1111", from, to); err == nil {
+			t.Error("replaced the rows of another customer")
+		}
+	})
+}
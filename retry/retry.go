@@ -0,0 +1,201 @@
+// Package retry retries transient AdWords errors with exponential backoff
+// and spaces requests to stay below a rate limit.
+//
+// The generated clients return SOAP faults whose text names the ApiError
+// types, e.g.
+//
+//	[RateExceededError <rateScope=ACCOUNT, rateName=RATE_LIMIT, rateKey=null, retryAfterSeconds=30>]
+//
+// Transient classifies errors by these names, so it works for the faults
+// of every version and package.
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Defaults of the Policy fields.
+const (
+	DefaultAttempts = 5
+	DefaultInitial  = time.Second
+	DefaultMax      = time.Minute
+)
+
+// transientErrors are the ApiError reasons worth retrying.
+var transientErrors = []string{
+	"RateExceededError",
+	"InternalApiError.UNEXPECTED_INTERNAL_API_ERROR",
+	"InternalApiError.TRANSIENT_ERROR",
+	"DatabaseError.CONCURRENT_MODIFICATION",
+	"AuthenticationError.GOOGLE_ACCOUNT_COOKIE_INVALID",
+}
+
+// Transient reports whether err is worth retrying: a rate limit, an
+// internal error of the API, a concurrent modification or a network
+// timeout.
+func Transient(err error) bool {
+	if err == nil {
+		return false
+	}
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		return true
+	}
+	msg := err.Error()
+	for _, t := range transientErrors {
+		if strings.Contains(msg, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// notAppliedErrors are the ApiError reasons with which the API rejects a
+// request before applying any of it.
+var notAppliedErrors = []string{
+	"RateExceededError",
+	"DatabaseError.CONCURRENT_MODIFICATION",
+	"AuthenticationError.GOOGLE_ACCOUNT_COOKIE_INVALID",
+}
+
+// NotApplied reports whether err is a Transient error with which the API
+// is known to have applied nothing: a rate limit, a concurrent modification
+// or an invalid session. Timeouts and internal errors are excluded, since
+// the request may have been applied before they occurred, so retrying on
+// NotApplied is safe for operations that aren't idempotent, such as ADD.
+func NotApplied(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, t := range notAppliedErrors {
+		if strings.Contains(msg, t) {
+			return true
+		}
+	}
+	return false
+}
+
+var retryAfter = regexp.MustCompile(`retryAfterSeconds=([0-9]+)`)
+
+// RetryAfter returns the delay requested by a RateExceededError, or 0.
+func RetryAfter(err error) time.Duration {
+	if err == nil {
+		return 0
+	}
+	m := retryAfter.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0
+	}
+	s, _ := strconv.Atoi(m[1])
+	return time.Duration(s) * time.Second
+}
+
+// A Policy retries a function with exponential backoff. The zero Policy
+// makes DefaultAttempts attempts. A nil *Policy makes a single attempt.
+type Policy struct {
+	// Attempts is the maximum number of attempts including the first, or
+	// DefaultAttempts if 0.
+	Attempts int
+
+	// Initial is the delay before the first retry, or DefaultInitial if 0.
+	// It doubles with every retry up to Max, or DefaultMax if 0. A random
+	// jitter of up to half the delay is added.
+	Initial, Max time.Duration
+
+	// Retryable reports whether an error is worth retrying, or Transient if
+	// nil.
+	Retryable func(error) bool
+
+	// Limiter, if set, is waited for before every attempt.
+	Limiter *Limiter
+}
+
+// Do calls fn until it succeeds, fails with an error that is not
+// retryable or the attempts are exhausted, and returns the last error. A
+// RateExceededError delays the next attempt by at least its
+// retryAfterSeconds.
+func (p *Policy) Do(fn func() error) error {
+	return p.DoContext(context.Background(), fn)
+}
+
+// DoContext is like Do but stops waiting for the next attempt when ctx is
+// done and returns the last error of fn then.
+func (p *Policy) DoContext(ctx context.Context, fn func() error) error {
+	if p == nil {
+		return fn()
+	}
+	attempts, delay, maxDelay := p.Attempts, p.Initial, p.Max
+	if attempts <= 0 {
+		attempts = DefaultAttempts
+	}
+	if delay <= 0 {
+		delay = DefaultInitial
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultMax
+	}
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = Transient
+	}
+	for i := 1; ; i++ {
+		if p.Limiter != nil {
+			p.Limiter.Wait()
+		}
+		err := fn()
+		if err == nil || i >= attempts || !retryable(err) || ctx.Err() != nil {
+			return err
+		}
+		wait := delay + rand.N(delay/2+1)
+		if after := RetryAfter(err); after > wait {
+			wait = after
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+		if delay *= 2; delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+// A Limiter spaces calls of Wait to a maximum rate. It is safe for
+// concurrent use, so one Limiter can be shared by all requests to an
+// account.
+type Limiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewLimiter returns a Limiter allowing perSecond calls per second.
+func NewLimiter(perSecond float64) *Limiter {
+	return &Limiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// Wait blocks until the next call is allowed.
+func (l *Limiter) Wait() {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+	time.Sleep(wait)
+}
@@ -0,0 +1,137 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+const rateExceeded = "[RateExceededError <rateScope=ACCOUNT, rateName=RATE_LIMIT, rateKey=null, retryAfterSeconds=30>]"
+
+func TestTransient(t *testing.T) {
+	tests := []struct {
+		err                   error
+		transient, notApplied bool
+	}{
+		{nil, false, false},
+		{errors.New(rateExceeded), true, true},
+		{errors.New("[InternalApiError.UNEXPECTED_INTERNAL_API_ERROR @ ]"), true, false},
+		{errors.New("[InternalApiError.TRANSIENT_ERROR @ ]"), true, false},
+		{fmt.Errorf("mutate: %w", errors.New("[DatabaseError.CONCURRENT_MODIFICATION @ operations[0]]")), true, true},
+		{errors.New("[AuthenticationError.GOOGLE_ACCOUNT_COOKIE_INVALID @ ]"), true, true},
+		{&net.OpError{Op: "read", Err: timeout{}}, true, false},
+		{errors.New("[RequiredError.REQUIRED @ operations[0].operand.name]"), false, false},
+		{errors.New("[AuthenticationError.NOT_ADS_USER @ ]"), false, false},
+	}
+	for _, tt := range tests {
+		if got := Transient(tt.err); got != tt.transient {
+			t.Errorf("Transient(%v) = %v", tt.err, got)
+		}
+		if got := NotApplied(tt.err); got != tt.notApplied {
+			t.Errorf("NotApplied(%v) = %v", tt.err, got)
+		}
+	}
+}
+
+// timeout is a net.Error that timed out.
+type timeout struct{}
+
+func (timeout) Error() string   { return "i/o timeout" }
+func (timeout) Timeout() bool   { return true }
+func (timeout) Temporary() bool { return true }
+
+func TestRetryAfter(t *testing.T) {
+	if d := RetryAfter(errors.New(rateExceeded)); d != 30*time.Second {
+		t.Errorf("got %v", d)
+	}
+	if d := RetryAfter(errors.New("[InternalApiError.TRANSIENT_ERROR @ ]")); d != 0 {
+		t.Errorf("got %v without retryAfterSeconds", d)
+	}
+	if d := RetryAfter(nil); d != 0 {
+		t.Errorf("got %v for nil", d)
+	}
+}
+
+func TestDo(t *testing.T) {
+	transient := errors.New("[InternalApiError.TRANSIENT_ERROR @ ]")
+	p := &Policy{Attempts: 3, Initial: time.Millisecond}
+
+	calls := 0
+	err := p.Do(func() error {
+		if calls++; calls < 3 {
+			return transient
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("got %v after %d calls, want success after 3", err, calls)
+	}
+
+	// The last error is returned when the attempts are exhausted.
+	calls = 0
+	if err = p.Do(func() error { calls++; return transient }); err != transient || calls != 3 {
+		t.Errorf("got %v after %d calls", err, calls)
+	}
+
+	// Errors that aren't retryable end at once.
+	calls = 0
+	permanent := errors.New("[RequiredError.REQUIRED @ ]")
+	if err = p.Do(func() error { calls++; return permanent }); err != permanent || calls != 1 {
+		t.Errorf("got %v after %d calls", err, calls)
+	}
+
+	// Retryable replaces Transient.
+	calls = 0
+	p.Retryable = func(err error) bool { return err == permanent }
+	if err = p.Do(func() error { calls++; return permanent }); calls != 3 {
+		t.Errorf("got %v after %d calls", err, calls)
+	}
+}
+
+func TestDoNil(t *testing.T) {
+	var p *Policy
+	calls := 0
+	transient := errors.New("[InternalApiError.TRANSIENT_ERROR @ ]")
+	if err := p.Do(func() error { calls++; return transient }); err != transient || calls != 1 {
+		t.Errorf("got %v after %d calls, want a single attempt", err, calls)
+	}
+}
+
+func TestDoContext(t *testing.T) {
+	// A RateExceededError delays the next attempt by 30s, which the context
+	// cancels.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	rate := errors.New(rateExceeded)
+	calls := 0
+	start := time.Now()
+	err := (&Policy{Initial: time.Millisecond}).DoContext(ctx, func() error { calls++; return rate })
+	if err != rate || calls != 1 {
+		t.Errorf("got %v after %d calls", err, calls)
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Errorf("waited %v after the context was done", d)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(100)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		l.Wait()
+	}
+	// The first call passes at once, the others are spaced by 10ms.
+	if d := time.Since(start); d < 40*time.Millisecond {
+		t.Errorf("5 calls at 100 per second took %v", d)
+	}
+
+	p := &Policy{Attempts: 2, Initial: time.Millisecond, Limiter: NewLimiter(50)}
+	start = time.Now()
+	p.Do(func() error { return errors.New("[InternalApiError.TRANSIENT_ERROR @ ]") })
+	if d := time.Since(start); d < 20*time.Millisecond {
+		t.Errorf("2 attempts at 50 per second took %v", d)
+	}
+}
@@ -0,0 +1,246 @@
+// Package selector builds the Selector of a Get request fluently:
+//
+//	sel, err := CampaignService.NewSelector(selector.Select("Id", "Name", "Status").
+//		Where("Status").In("ENABLED", "PAUSED").
+//		OrderAsc("Name").
+//		Page(0, 500))
+//
+// The Builder checks the number of values of every predicate operator, the
+// paging and the date range. The first error is returned by Build, so a
+// chain needs a single error check. Every service package converts the
+// built Selector with its NewSelector function.
+package selector
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// Selector is the version independent form of the Selector of the service
+// packages.
+type Selector struct {
+	Fields     []string     `json:"fields,omitempty"`
+	Predicates []*Predicate `json:"predicates,omitempty"`
+	DateRange  *DateRange   `json:"dateRange,omitempty"`
+	Ordering   []*OrderBy   `json:"ordering,omitempty"`
+	Paging     *Paging      `json:"paging,omitempty"`
+}
+
+// Predicate filters the entities of a Selector.
+type Predicate struct {
+	Field    string   `json:"field"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+// OrderBy sorts the entities of a Selector.
+type OrderBy struct {
+	Field     string `json:"field"`
+	SortOrder string `json:"sortOrder,omitempty"`
+}
+
+// Paging selects a window of the entities of a Selector.
+type Paging struct {
+	StartIndex    int32 `json:"startIndex"`
+	NumberResults int32 `json:"numberResults"`
+}
+
+// DateRange is an inclusive range of "yyyyMMdd" dates.
+type DateRange struct {
+	Min string `json:"min,omitempty"`
+	Max string `json:"max,omitempty"`
+}
+
+// Predicate operators.
+const (
+	Equals                   = "EQUALS"
+	NotEquals                = "NOT_EQUALS"
+	In                       = "IN"
+	NotIn                    = "NOT_IN"
+	GreaterThan              = "GREATER_THAN"
+	GreaterThanEquals        = "GREATER_THAN_EQUALS"
+	LessThan                 = "LESS_THAN"
+	LessThanEquals           = "LESS_THAN_EQUALS"
+	StartsWith               = "STARTS_WITH"
+	StartsWithIgnoreCase     = "STARTS_WITH_IGNORE_CASE"
+	Contains                 = "CONTAINS"
+	ContainsIgnoreCase       = "CONTAINS_IGNORE_CASE"
+	DoesNotContain           = "DOES_NOT_CONTAIN"
+	DoesNotContainIgnoreCase = "DOES_NOT_CONTAIN_IGNORE_CASE"
+	ContainsAny              = "CONTAINS_ANY"
+	ContainsAll              = "CONTAINS_ALL"
+	ContainsNone             = "CONTAINS_NONE"
+)
+
+// listOperators take one or more values, all other operators exactly one.
+var listOperators = map[string]bool{
+	In:           true,
+	NotIn:        true,
+	ContainsAny:  true,
+	ContainsAll:  true,
+	ContainsNone: true,
+}
+
+// IsListOperator reports whether operator takes a list of values.
+func IsListOperator(operator string) bool {
+	return listOperators[operator]
+}
+
+// Sort orders.
+const (
+	Ascending  = "ASCENDING"
+	Descending = "DESCENDING"
+)
+
+// CheckPredicate checks the number of values of a predicate operator.
+func CheckPredicate(field, operator string, values []string) error {
+	switch {
+	case field == "":
+		return fmt.Errorf("selector: %s predicate without field", operator)
+	case listOperators[operator]:
+		if len(values) == 0 {
+			return fmt.Errorf("selector: %s %s needs at least one value", field, operator)
+		}
+	case operator == Equals || operator == NotEquals || operator == GreaterThan ||
+		operator == GreaterThanEquals || operator == LessThan || operator == LessThanEquals ||
+		operator == StartsWith || operator == StartsWithIgnoreCase || operator == Contains ||
+		operator == ContainsIgnoreCase || operator == DoesNotContain || operator == DoesNotContainIgnoreCase:
+		if len(values) != 1 {
+			return fmt.Errorf("selector: %s %s needs exactly one value, got %d", field, operator, len(values))
+		}
+	default:
+		return fmt.Errorf("selector: unknown predicate operator %q", operator)
+	}
+	return nil
+}
+
+// A Builder builds a Selector.
+type Builder struct {
+	sel Selector
+	err error
+}
+
+// Select returns a Builder selecting fields.
+func Select(fields ...string) *Builder {
+	b := new(Builder)
+	if len(fields) == 0 {
+		b.err = fmt.Errorf("selector: no fields selected")
+	}
+	b.sel.Fields = append([]string(nil), fields...)
+	return b
+}
+
+// A Condition is the field of a predicate waiting for its operator.
+type Condition struct {
+	b     *Builder
+	field string
+}
+
+// Where starts a predicate on field. Predicates are combined with AND.
+func (b *Builder) Where(field string) *Condition {
+	return &Condition{b: b, field: field}
+}
+
+// Op adds the predicate field operator values. Values are formatted with
+// fmt.Sprint, so numbers and enums can be passed as they are.
+func (c *Condition) Op(operator string, values ...interface{}) *Builder {
+	list := make([]string, len(values))
+	for i, v := range values {
+		list[i] = fmt.Sprint(v)
+	}
+	if err := CheckPredicate(c.field, operator, list); err != nil && c.b.err == nil {
+		c.b.err = err
+	}
+	c.b.sel.Predicates = append(c.b.sel.Predicates, &Predicate{Field: c.field, Operator: operator, Values: list})
+	return c.b
+}
+
+// Equals adds an EQUALS predicate.
+func (c *Condition) Equals(value interface{}) *Builder { return c.Op(Equals, value) }
+
+// NotEquals adds a NOT_EQUALS predicate.
+func (c *Condition) NotEquals(value interface{}) *Builder { return c.Op(NotEquals, value) }
+
+// In adds an IN predicate.
+func (c *Condition) In(values ...interface{}) *Builder { return c.Op(In, values...) }
+
+// NotIn adds a NOT_IN predicate.
+func (c *Condition) NotIn(values ...interface{}) *Builder { return c.Op(NotIn, values...) }
+
+// GreaterThan adds a GREATER_THAN predicate.
+func (c *Condition) GreaterThan(value interface{}) *Builder { return c.Op(GreaterThan, value) }
+
+// GreaterThanEquals adds a GREATER_THAN_EQUALS predicate.
+func (c *Condition) GreaterThanEquals(value interface{}) *Builder {
+	return c.Op(GreaterThanEquals, value)
+}
+
+// LessThan adds a LESS_THAN predicate.
+func (c *Condition) LessThan(value interface{}) *Builder { return c.Op(LessThan, value) }
+
+// LessThanEquals adds a LESS_THAN_EQUALS predicate.
+func (c *Condition) LessThanEquals(value interface{}) *Builder { return c.Op(LessThanEquals, value) }
+
+// StartsWith adds a STARTS_WITH predicate.
+func (c *Condition) StartsWith(value string) *Builder { return c.Op(StartsWith, value) }
+
+// Contains adds a CONTAINS predicate.
+func (c *Condition) Contains(value string) *Builder { return c.Op(Contains, value) }
+
+// DoesNotContain adds a DOES_NOT_CONTAIN predicate.
+func (c *Condition) DoesNotContain(value string) *Builder { return c.Op(DoesNotContain, value) }
+
+// ContainsAny adds a CONTAINS_ANY predicate.
+func (c *Condition) ContainsAny(values ...interface{}) *Builder { return c.Op(ContainsAny, values...) }
+
+// ContainsAll adds a CONTAINS_ALL predicate.
+func (c *Condition) ContainsAll(values ...interface{}) *Builder { return c.Op(ContainsAll, values...) }
+
+// ContainsNone adds a CONTAINS_NONE predicate.
+func (c *Condition) ContainsNone(values ...interface{}) *Builder {
+	return c.Op(ContainsNone, values...)
+}
+
+// OrderAsc sorts by field in ascending order after the previous orderings.
+func (b *Builder) OrderAsc(field string) *Builder {
+	b.sel.Ordering = append(b.sel.Ordering, &OrderBy{Field: field, SortOrder: Ascending})
+	return b
+}
+
+// OrderDesc sorts by field in descending order after the previous
+// orderings.
+func (b *Builder) OrderDesc(field string) *Builder {
+	b.sel.Ordering = append(b.sel.Ordering, &OrderBy{Field: field, SortOrder: Descending})
+	return b
+}
+
+// Page selects n entities starting at index start. Both must fit the
+// int32 of the API.
+func (b *Builder) Page(start, n int) *Builder {
+	if (start < 0 || n <= 0 || start > math.MaxInt32 || n > math.MaxInt32) && b.err == nil {
+		b.err = fmt.Errorf("selector: invalid page %d+%d", start, n)
+	}
+	b.sel.Paging = &Paging{StartIndex: int32(start), NumberResults: int32(n)}
+	return b
+}
+
+// During restricts the statistics of the selected entities to the dates
+// from to to, inclusive.
+func (b *Builder) During(from, to datetime.Date) *Builder {
+	if to.Before(from) && b.err == nil {
+		b.err = fmt.Errorf("selector: date range %s-%s ends before it starts", from, to)
+	}
+	b.sel.DateRange = &DateRange{Min: from.String(), Max: to.String()}
+	return b
+}
+
+// Build returns the Selector, or the first error of the chain.
+func (b *Builder) Build() (*Selector, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	sel := b.sel
+	return &sel, nil
+}
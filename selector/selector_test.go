@@ -0,0 +1,85 @@
+package selector_test
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+func TestBuild(t *testing.T) {
+	from, _ := datetime.ParseDate("20180101")
+	to, _ := datetime.ParseDate("20180131")
+	sel, err := selector.Select("Id", "Name").
+		Where("Status").In("ENABLED", "PAUSED").
+		Where("Impressions").GreaterThan(100).
+		OrderDesc("Impressions").
+		OrderAsc("Name").
+		Page(500, 100).
+		During(from, to).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &selector.Selector{
+		Fields: []string{"Id", "Name"},
+		Predicates: []*selector.Predicate{
+			{Field: "Status", Operator: selector.In, Values: []string{"ENABLED", "PAUSED"}},
+			{Field: "Impressions", Operator: selector.GreaterThan, Values: []string{"100"}},
+		},
+		DateRange: &selector.DateRange{Min: "20180101", Max: "20180131"},
+		Ordering: []*selector.OrderBy{
+			{Field: "Impressions", SortOrder: selector.Descending},
+			{Field: "Name", SortOrder: selector.Ascending},
+		},
+		Paging: &selector.Paging{StartIndex: 500, NumberResults: 100},
+	}
+	if !reflect.DeepEqual(sel, want) {
+		t.Errorf("got %+v, want %+v", sel, want)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	from, _ := datetime.ParseDate("20180131")
+	to, _ := datetime.ParseDate("20180101")
+	tests := []struct {
+		name string
+		b    *selector.Builder
+		want string
+	}{
+		{"no fields", selector.Select(), "no fields"},
+		{"no values", selector.Select("Id").Where("Status").In(), "at least one value"},
+		{"two values", selector.Select("Id").Where("Name").Op(selector.Equals, "a", "b"), "exactly one value"},
+		{"no field", selector.Select("Id").Where("").Equals(1), "without field"},
+		{"unknown operator", selector.Select("Id").Where("Name").Op("LIKE", "a"), "unknown predicate operator"},
+		{"negative start", selector.Select("Id").Page(-1, 10), "invalid page"},
+		{"empty page", selector.Select("Id").Page(0, 0), "invalid page"},
+		{"start beyond int32", selector.Select("Id").Page(math.MaxInt32+1, 10), "invalid page"},
+		{"size beyond int32", selector.Select("Id").Page(0, math.MaxInt32+1), "invalid page"},
+		{"reversed dates", selector.Select("Id").During(from, to), "ends before it starts"},
+		{"first error", selector.Select("Id").Page(0, 0).Where("Name").Op("LIKE", "a"), "invalid page"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := tt.b.Build()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %+v, %v, want an error containing %q", sel, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildCopies(t *testing.T) {
+	b := selector.Select("Id")
+	first, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := b.OrderAsc("Id").Build()
+	if len(first.Ordering) != 0 || len(second.Ordering) != 1 {
+		t.Errorf("got orderings %v and %v", first.Ordering, second.Ordering)
+	}
+}
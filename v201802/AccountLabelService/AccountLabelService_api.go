@@ -0,0 +1,98 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AccountLabelService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AccountLabelServiceAPI is the interface of the AccountLabelService operations. It is
+// implemented by AccountLabelServiceInterface and, for tests, by FakeAccountLabelService.
+type AccountLabelServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+}
+
+var _ AccountLabelServiceAPI = (*AccountLabelServiceInterface)(nil)
+var _ AccountLabelServiceAPI = (*FakeAccountLabelService)(nil)
+
+// FakeCall is a call recorded by FakeAccountLabelService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeAccountLabelService is a programmable AccountLabelServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeAccountLabelService struct {
+	GetFunc    func(request *Get) (*GetResponse, error)
+	MutateFunc func(request *Mutate) (*MutateResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeAccountLabelService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeAccountLabelService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAccountLabelService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeAccountLabelService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeAccountLabelService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeAccountLabelService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeAccountLabelService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeAccountLabelService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeAccountLabelService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,34 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AccountLabelService
+
+import "github.com/godofdream/go-googleadsinofficial/batch"
+
+// MutateBatch is Mutate for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateBatch(api AccountLabelServiceAPI, operations []*AccountLabelOperation, opts *batch.Options) (*AccountLabelReturnValue, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*AccountLabelOperation) (*MutateResponse, error) {
+		return api.Mutate(&Mutate{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Labels)
+	})
+	rval := new(AccountLabelReturnValue)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Labels = append(rval.Labels, make([]*AccountLabel, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		rval.Labels = append(rval.Labels, batch.Pad(r.Labels, c.End-c.Start)...)
+	}
+	return rval, err
+}
@@ -0,0 +1,527 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AccountLabelService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CollectionSizeError" as the
+// concrete type in the discriminator of its base types.
+func (t CollectionSizeError) MarshalJSON() ([]byte, error) {
+	type plain CollectionSizeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CollectionSizeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DateError" as the
+// concrete type in the discriminator of its base types.
+func (t DateError) MarshalJSON() ([]byte, error) {
+	type plain DateError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DateError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NullError" as the
+// concrete type in the discriminator of its base types.
+func (t NullError) MarshalJSON() ([]byte, error) {
+	type plain NullError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NullError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RegionCodeError" as the
+// concrete type in the discriminator of its base types.
+func (t RegionCodeError) MarshalJSON() ([]byte, error) {
+	type plain RegionCodeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RegionCodeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SelectorError" as the
+// concrete type in the discriminator of its base types.
+func (t SelectorError) MarshalJSON() ([]byte, error) {
+	type plain SelectorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SelectorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CurrencyCodeError" as the
+// concrete type in the discriminator of its base types.
+func (t CurrencyCodeError) MarshalJSON() ([]byte, error) {
+	type plain CurrencyCodeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CurrencyCodeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LabelServiceError" as the
+// concrete type in the discriminator of its base types.
+func (t LabelServiceError) MarshalJSON() ([]byte, error) {
+	type plain LabelServiceError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("LabelServiceError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AccountLabelOperation" as the
+// concrete type in the discriminator of its base types.
+func (t AccountLabelOperation) MarshalJSON() ([]byte, error) {
+	type plain AccountLabelOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("AccountLabelOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AccountLabelService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *AccountLabel) *AccountLabelOperation {
+	op := OperatorADD
+	return &AccountLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AccountLabel) *AccountLabelOperation {
+	op := OperatorSET
+	return &AccountLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *AccountLabel) *AccountLabelOperation {
+	op := OperatorREMOVE
+	return &AccountLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
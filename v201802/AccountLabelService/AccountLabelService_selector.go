@@ -0,0 +1,122 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AccountLabelService
+
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/awql"
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// NewSelector returns the Selector built by b, e.g.
+//
+//	NewSelector(selector.Select("Id", "Name").Where("Status").In("ENABLED", "PAUSED"))
+func NewSelector(b *selector.Builder) (*Selector, error) {
+	s, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return ConvertSelector(s)
+}
+
+// ConvertSelector converts a version independent selector.Selector. It
+// fails for operators and sort orders unknown to this version.
+func ConvertSelector(s *selector.Selector) (*Selector, error) {
+	sel := &Selector{Fields: append([]string(nil), s.Fields...)}
+	for _, p := range s.Predicates {
+		if err := selector.CheckPredicate(p.Field, p.Operator, p.Values); err != nil {
+			return nil, err
+		}
+		op, err := ParsePredicateOperator(p.Operator)
+		if err != nil {
+			return nil, err
+		}
+		sel.Predicates = append(sel.Predicates, &Predicate{
+			Field:    String(p.Field),
+			Operator: &op,
+			Values:   append([]string(nil), p.Values...),
+		})
+	}
+	for _, o := range s.Ordering {
+		order := &OrderBy{Field: String(o.Field)}
+		if o.SortOrder != "" {
+			so, err := ParseSortOrder(o.SortOrder)
+			if err != nil {
+				return nil, err
+			}
+			order.SortOrder = &so
+		}
+		sel.Ordering = append(sel.Ordering, order)
+	}
+	if s.Paging != nil {
+		sel.Paging = &Paging{StartIndex: Int32(s.Paging.StartIndex), NumberResults: Int32(s.Paging.NumberResults)}
+	}
+	if s.DateRange != nil {
+		from, err := datetime.ParseDate(s.DateRange.Min)
+		if err != nil {
+			return nil, err
+		}
+		to, err := datetime.ParseDate(s.DateRange.Max)
+		if err != nil {
+			return nil, err
+		}
+		sel.DateRange = &DateRange{Min: from.In(time.UTC), Max: to.In(time.UTC)}
+	}
+	return sel, nil
+}
+
+// ParseSelector parses the AWQL of a Query request into a Selector, e.g.
+//
+//	ParseSelector("SELECT Id, Name WHERE Status IN ['ENABLED', 'PAUSED'] LIMIT 0,100")
+func ParseSelector(query string) (*Selector, error) {
+	s, err := awql.ParseSelector(query)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertSelector(s)
+}
+
+// Neutral returns s as a version independent selector.Selector.
+func (s *Selector) Neutral() *selector.Selector {
+	sel := &selector.Selector{Fields: append([]string(nil), s.Fields...)}
+	for _, p := range s.Predicates {
+		pred := &selector.Predicate{Field: StringValue(p.Field), Values: append([]string(nil), p.Values...)}
+		if p.Operator != nil {
+			pred.Operator = string(*p.Operator)
+		}
+		sel.Predicates = append(sel.Predicates, pred)
+	}
+	for _, o := range s.Ordering {
+		order := &selector.OrderBy{Field: StringValue(o.Field)}
+		if o.SortOrder != nil {
+			order.SortOrder = string(*o.SortOrder)
+		}
+		sel.Ordering = append(sel.Ordering, order)
+	}
+	if s.Paging != nil {
+		sel.Paging = new(selector.Paging)
+		if s.Paging.StartIndex != nil {
+			sel.Paging.StartIndex = *s.Paging.StartIndex
+		}
+		if s.Paging.NumberResults != nil {
+			sel.Paging.NumberResults = *s.Paging.NumberResults
+		}
+	}
+	if s.DateRange != nil {
+		sel.DateRange = new(selector.DateRange)
+		if !s.DateRange.Min.IsZero() {
+			sel.DateRange.Min = datetime.DateOf(s.DateRange.Min).String()
+		}
+		if !s.DateRange.Max.IsZero() {
+			sel.DateRange.Max = datetime.DateOf(s.DateRange.Max).String()
+		}
+	}
+	return sel
+}
+
+// AWQL returns s as the query of a Query request.
+func (s *Selector) AWQL() (string, error) {
+	return awql.Format(s.Neutral())
+}
@@ -0,0 +1,98 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdCustomizerFeedService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AdCustomizerFeedServiceAPI is the interface of the AdCustomizerFeedService operations. It is
+// implemented by AdCustomizerFeedServiceInterface and, for tests, by FakeAdCustomizerFeedService.
+type AdCustomizerFeedServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+}
+
+var _ AdCustomizerFeedServiceAPI = (*AdCustomizerFeedServiceInterface)(nil)
+var _ AdCustomizerFeedServiceAPI = (*FakeAdCustomizerFeedService)(nil)
+
+// FakeCall is a call recorded by FakeAdCustomizerFeedService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeAdCustomizerFeedService is a programmable AdCustomizerFeedServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeAdCustomizerFeedService struct {
+	GetFunc    func(request *Get) (*GetResponse, error)
+	MutateFunc func(request *Mutate) (*MutateResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeAdCustomizerFeedService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeAdCustomizerFeedService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAdCustomizerFeedService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeAdCustomizerFeedService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeAdCustomizerFeedService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeAdCustomizerFeedService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeAdCustomizerFeedService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeAdCustomizerFeedService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeAdCustomizerFeedService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,37 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdCustomizerFeedService
+
+import "github.com/godofdream/go-googleadsinofficial/batch"
+
+// MutateBatch is Mutate for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateBatch(api AdCustomizerFeedServiceAPI, operations []*AdCustomizerFeedOperation, opts *batch.Options) (*AdCustomizerFeedReturnValue, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*AdCustomizerFeedOperation) (*MutateResponse, error) {
+		return api.Mutate(&Mutate{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Value)
+	})
+	rval := new(AdCustomizerFeedReturnValue)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Value = append(rval.Value, make([]*AdCustomizerFeed, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		if rval.ListReturnValue == nil {
+			rval.ListReturnValue = r.ListReturnValue
+		}
+		rval.Value = append(rval.Value, batch.Pad(r.Value, c.End-c.Start)...)
+	}
+	return rval, err
+}
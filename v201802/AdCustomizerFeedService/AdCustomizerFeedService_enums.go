@@ -0,0 +1,1665 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdCustomizerFeedService
+
+import "fmt"
+
+// AdCustomizerFeedAttributeTypeValues returns all values of AdCustomizerFeedAttributeType.
+func AdCustomizerFeedAttributeTypeValues() []AdCustomizerFeedAttributeType {
+	return []AdCustomizerFeedAttributeType{
+		AdCustomizerFeedAttributeTypeINTEGER,
+		AdCustomizerFeedAttributeTypePRICE,
+		AdCustomizerFeedAttributeTypeDATE_TIME,
+		AdCustomizerFeedAttributeTypeSTRING,
+		AdCustomizerFeedAttributeTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdCustomizerFeedAttributeType.
+func (e AdCustomizerFeedAttributeType) IsValid() bool {
+	switch e {
+	case AdCustomizerFeedAttributeTypeINTEGER,
+		AdCustomizerFeedAttributeTypePRICE,
+		AdCustomizerFeedAttributeTypeDATE_TIME,
+		AdCustomizerFeedAttributeTypeSTRING,
+		AdCustomizerFeedAttributeTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAdCustomizerFeedAttributeType returns the AdCustomizerFeedAttributeType named by s.
+func ParseAdCustomizerFeedAttributeType(s string) (AdCustomizerFeedAttributeType, error) {
+	e := AdCustomizerFeedAttributeType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdCustomizerFeedAttributeType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdCustomizerFeedAttributeType.
+func (e AdCustomizerFeedAttributeType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdCustomizerFeedAttributeType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdCustomizerFeedAttributeType) UnmarshalText(text []byte) error {
+	v := AdCustomizerFeedAttributeType(text)
+	if !v.IsValid() {
+		v = AdCustomizerFeedAttributeTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdCustomizerFeedErrorReasonValues returns all values of AdCustomizerFeedErrorReason.
+func AdCustomizerFeedErrorReasonValues() []AdCustomizerFeedErrorReason {
+	return []AdCustomizerFeedErrorReason{
+		AdCustomizerFeedErrorReasonCANNOT_ADD_KEY_ATTRIBUTE,
+		AdCustomizerFeedErrorReasonNOT_AD_CUSTOMIZER_FEED,
+		AdCustomizerFeedErrorReasonINVALID_FEED_NAME,
+		AdCustomizerFeedErrorReasonTOO_MANY_FEED_ATTRIBUTES_FOR_FEED,
+		AdCustomizerFeedErrorReasonATTRIBUTE_NAMES_NOT_UNIQUE,
+		AdCustomizerFeedErrorReasonFEED_DELETED,
+		AdCustomizerFeedErrorReasonDUPLICATE_FEED_NAME,
+		AdCustomizerFeedErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdCustomizerFeedErrorReason.
+func (e AdCustomizerFeedErrorReason) IsValid() bool {
+	switch e {
+	case AdCustomizerFeedErrorReasonCANNOT_ADD_KEY_ATTRIBUTE,
+		AdCustomizerFeedErrorReasonNOT_AD_CUSTOMIZER_FEED,
+		AdCustomizerFeedErrorReasonINVALID_FEED_NAME,
+		AdCustomizerFeedErrorReasonTOO_MANY_FEED_ATTRIBUTES_FOR_FEED,
+		AdCustomizerFeedErrorReasonATTRIBUTE_NAMES_NOT_UNIQUE,
+		AdCustomizerFeedErrorReasonFEED_DELETED,
+		AdCustomizerFeedErrorReasonDUPLICATE_FEED_NAME,
+		AdCustomizerFeedErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAdCustomizerFeedErrorReason returns the AdCustomizerFeedErrorReason named by s.
+func ParseAdCustomizerFeedErrorReason(s string) (AdCustomizerFeedErrorReason, error) {
+	e := AdCustomizerFeedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdCustomizerFeedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdCustomizerFeedErrorReason.
+func (e AdCustomizerFeedErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdCustomizerFeedErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdCustomizerFeedErrorReason) UnmarshalText(text []byte) error {
+	v := AdCustomizerFeedErrorReason(text)
+	if !v.IsValid() {
+		v = AdCustomizerFeedErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AuthenticationErrorReasonValues returns all values of AuthenticationErrorReason.
+func AuthenticationErrorReasonValues() []AuthenticationErrorReason {
+	return []AuthenticationErrorReason{
+		AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthenticationErrorReason.
+func (e AuthenticationErrorReason) IsValid() bool {
+	switch e {
+	case AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID:
+		return true
+	}
+	return false
+}
+
+// ParseAuthenticationErrorReason returns the AuthenticationErrorReason named by s.
+func ParseAuthenticationErrorReason(s string) (AuthenticationErrorReason, error) {
+	e := AuthenticationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthenticationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthenticationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthenticationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthenticationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthenticationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthorizationErrorReasonValues returns all values of AuthorizationErrorReason.
+func AuthorizationErrorReasonValues() []AuthorizationErrorReason {
+	return []AuthorizationErrorReason{
+		AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthorizationErrorReason.
+func (e AuthorizationErrorReason) IsValid() bool {
+	switch e {
+	case AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseAuthorizationErrorReason returns the AuthorizationErrorReason named by s.
+func ParseAuthorizationErrorReason(s string) (AuthorizationErrorReason, error) {
+	e := AuthorizationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthorizationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthorizationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthorizationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthorizationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthorizationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ClientTermsErrorReasonValues returns all values of ClientTermsErrorReason.
+func ClientTermsErrorReasonValues() []ClientTermsErrorReason {
+	return []ClientTermsErrorReason{
+		ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ClientTermsErrorReason.
+func (e ClientTermsErrorReason) IsValid() bool {
+	switch e {
+	case ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED:
+		return true
+	}
+	return false
+}
+
+// ParseClientTermsErrorReason returns the ClientTermsErrorReason named by s.
+func ParseClientTermsErrorReason(s string) (ClientTermsErrorReason, error) {
+	e := ClientTermsErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ClientTermsErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ClientTermsErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ClientTermsErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ClientTermsErrorReason) UnmarshalText(text []byte) error {
+	v := ClientTermsErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DatabaseErrorReasonValues returns all values of DatabaseErrorReason.
+func DatabaseErrorReasonValues() []DatabaseErrorReason {
+	return []DatabaseErrorReason{
+		DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DatabaseErrorReason.
+func (e DatabaseErrorReason) IsValid() bool {
+	switch e {
+	case DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDatabaseErrorReason returns the DatabaseErrorReason named by s.
+func ParseDatabaseErrorReason(s string) (DatabaseErrorReason, error) {
+	e := DatabaseErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DatabaseErrorReason.
+func (e DatabaseErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DatabaseErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DatabaseErrorReason) UnmarshalText(text []byte) error {
+	v := DatabaseErrorReason(text)
+	if !v.IsValid() {
+		v = DatabaseErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DistinctErrorReasonValues returns all values of DistinctErrorReason.
+func DistinctErrorReasonValues() []DistinctErrorReason {
+	return []DistinctErrorReason{
+		DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DistinctErrorReason.
+func (e DistinctErrorReason) IsValid() bool {
+	switch e {
+	case DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDistinctErrorReason returns the DistinctErrorReason named by s.
+func ParseDistinctErrorReason(s string) (DistinctErrorReason, error) {
+	e := DistinctErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DistinctErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DistinctErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DistinctErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DistinctErrorReason) UnmarshalText(text []byte) error {
+	v := DistinctErrorReason(text)
+	*e = v
+	return nil
+}
+
+// EntityCountLimitExceededReasonValues returns all values of EntityCountLimitExceededReason.
+func EntityCountLimitExceededReasonValues() []EntityCountLimitExceededReason {
+	return []EntityCountLimitExceededReason{
+		EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) IsValid() bool {
+	switch e {
+	case EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseEntityCountLimitExceededReason returns the EntityCountLimitExceededReason named by s.
+func ParseEntityCountLimitExceededReason(s string) (EntityCountLimitExceededReason, error) {
+	e := EntityCountLimitExceededReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityCountLimitExceededReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid EntityCountLimitExceededReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *EntityCountLimitExceededReason) UnmarshalText(text []byte) error {
+	v := EntityCountLimitExceededReason(text)
+	if !v.IsValid() {
+		v = EntityCountLimitExceededReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// EntityNotFoundReasonValues returns all values of EntityNotFoundReason.
+func EntityNotFoundReasonValues() []EntityNotFoundReason {
+	return []EntityNotFoundReason{
+		EntityNotFoundReasonINVALID_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityNotFoundReason.
+func (e EntityNotFoundReason) IsValid() bool {
+	switch e {
+	case EntityNotFoundReasonINVALID_ID:
+		return true
+	}
+	return false
+}
+
+// ParseEntityNotFoundReason returns the EntityNotFoundReason named by s.
+func ParseEntityNotFoundReason(s string) (EntityNotFoundReason, error) {
+	e := EntityNotFoundReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityNotFoundReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityNotFoundReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityNotFoundReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityNotFoundReason) UnmarshalText(text []byte) error {
+	v := EntityNotFoundReason(text)
+	*e = v
+	return nil
+}
+
+// FeedStatusValues returns all values of FeedStatus.
+func FeedStatusValues() []FeedStatus {
+	return []FeedStatus{
+		FeedStatusENABLED,
+		FeedStatusREMOVED,
+		FeedStatusUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedStatus.
+func (e FeedStatus) IsValid() bool {
+	switch e {
+	case FeedStatusENABLED,
+		FeedStatusREMOVED,
+		FeedStatusUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFeedStatus returns the FeedStatus named by s.
+func ParseFeedStatus(s string) (FeedStatus, error) {
+	e := FeedStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FeedStatus.
+func (e FeedStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FeedStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FeedStatus) UnmarshalText(text []byte) error {
+	v := FeedStatus(text)
+	if !v.IsValid() {
+		v = FeedStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FeedErrorReasonValues returns all values of FeedErrorReason.
+func FeedErrorReasonValues() []FeedErrorReason {
+	return []FeedErrorReason{
+		FeedErrorReasonATTRIBUTE_NAMES_NOT_UNIQUE,
+		FeedErrorReasonATTRIBUTES_DO_NOT_MATCH_EXISTING_ATTRIBUTES,
+		FeedErrorReasonCANNOT_CHANGE_ORIGIN,
+		FeedErrorReasonCANNOT_SPECIFY_USER_ORIGIN_FOR_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_SPECIFY_ADWORDS_ORIGIN_FOR_NON_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_SPECIFY_FEED_ATTRIBUTES_FOR_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_UPDATE_FEED_ATTRIBUTES_WITH_ORIGIN_ADWORDS,
+		FeedErrorReasonFEED_REMOVED,
+		FeedErrorReasonINVALID_ORIGIN_VALUE,
+		FeedErrorReasonFEED_ORIGIN_IS_NOT_USER,
+		FeedErrorReasonINVALID_AUTH_TOKEN_FOR_EMAIL,
+		FeedErrorReasonINVALID_EMAIL,
+		FeedErrorReasonDUPLICATE_FEED_NAME,
+		FeedErrorReasonINVALID_FEED_NAME,
+		FeedErrorReasonMISSING_OAUTH_INFO,
+		FeedErrorReasonNEW_ATTRIBUTE_CANNOT_BE_PART_OF_UNIQUE_KEY,
+		FeedErrorReasonTOO_MANY_FEED_ATTRIBUTES_FOR_FEED,
+		FeedErrorReasonINVALID_BUSINESS_ACCOUNT,
+		FeedErrorReasonBUSINESS_ACCOUNT_CANNOT_ACCESS_LOCATION_ACCOUNT,
+		FeedErrorReasonINVALID_AFFILIATE_CHAIN_ID,
+		FeedErrorReasonCANNOT_CHANGE_SYSTEM_FEED_GENERATION_DATA_TYPE,
+		FeedErrorReasonUNSUPPORTED_AFFILIATE_LOCATION_RELATIONSHIP_TYPE,
+		FeedErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedErrorReason.
+func (e FeedErrorReason) IsValid() bool {
+	switch e {
+	case FeedErrorReasonATTRIBUTE_NAMES_NOT_UNIQUE,
+		FeedErrorReasonATTRIBUTES_DO_NOT_MATCH_EXISTING_ATTRIBUTES,
+		FeedErrorReasonCANNOT_CHANGE_ORIGIN,
+		FeedErrorReasonCANNOT_SPECIFY_USER_ORIGIN_FOR_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_SPECIFY_ADWORDS_ORIGIN_FOR_NON_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_SPECIFY_FEED_ATTRIBUTES_FOR_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_UPDATE_FEED_ATTRIBUTES_WITH_ORIGIN_ADWORDS,
+		FeedErrorReasonFEED_REMOVED,
+		FeedErrorReasonINVALID_ORIGIN_VALUE,
+		FeedErrorReasonFEED_ORIGIN_IS_NOT_USER,
+		FeedErrorReasonINVALID_AUTH_TOKEN_FOR_EMAIL,
+		FeedErrorReasonINVALID_EMAIL,
+		FeedErrorReasonDUPLICATE_FEED_NAME,
+		FeedErrorReasonINVALID_FEED_NAME,
+		FeedErrorReasonMISSING_OAUTH_INFO,
+		FeedErrorReasonNEW_ATTRIBUTE_CANNOT_BE_PART_OF_UNIQUE_KEY,
+		FeedErrorReasonTOO_MANY_FEED_ATTRIBUTES_FOR_FEED,
+		FeedErrorReasonINVALID_BUSINESS_ACCOUNT,
+		FeedErrorReasonBUSINESS_ACCOUNT_CANNOT_ACCESS_LOCATION_ACCOUNT,
+		FeedErrorReasonINVALID_AFFILIATE_CHAIN_ID,
+		FeedErrorReasonCANNOT_CHANGE_SYSTEM_FEED_GENERATION_DATA_TYPE,
+		FeedErrorReasonUNSUPPORTED_AFFILIATE_LOCATION_RELATIONSHIP_TYPE,
+		FeedErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFeedErrorReason returns the FeedErrorReason named by s.
+func ParseFeedErrorReason(s string) (FeedErrorReason, error) {
+	e := FeedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FeedErrorReason.
+func (e FeedErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FeedErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FeedErrorReason) UnmarshalText(text []byte) error {
+	v := FeedErrorReason(text)
+	if !v.IsValid() {
+		v = FeedErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// IdErrorReasonValues returns all values of IdErrorReason.
+func IdErrorReasonValues() []IdErrorReason {
+	return []IdErrorReason{
+		IdErrorReasonNOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of IdErrorReason.
+func (e IdErrorReason) IsValid() bool {
+	switch e {
+	case IdErrorReasonNOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseIdErrorReason returns the IdErrorReason named by s.
+func ParseIdErrorReason(s string) (IdErrorReason, error) {
+	e := IdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// IdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e IdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *IdErrorReason) UnmarshalText(text []byte) error {
+	v := IdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// InternalApiErrorReasonValues returns all values of InternalApiErrorReason.
+func InternalApiErrorReasonValues() []InternalApiErrorReason {
+	return []InternalApiErrorReason{
+		InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE,
+	}
+}
+
+// IsValid reports whether e is one of the values of InternalApiErrorReason.
+func (e InternalApiErrorReason) IsValid() bool {
+	switch e {
+	case InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE:
+		return true
+	}
+	return false
+}
+
+// ParseInternalApiErrorReason returns the InternalApiErrorReason named by s.
+func ParseInternalApiErrorReason(s string) (InternalApiErrorReason, error) {
+	e := InternalApiErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid InternalApiErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of InternalApiErrorReason.
+func (e InternalApiErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid InternalApiErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *InternalApiErrorReason) UnmarshalText(text []byte) error {
+	v := InternalApiErrorReason(text)
+	if !v.IsValid() {
+		v = InternalApiErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// NewEntityCreationErrorReasonValues returns all values of NewEntityCreationErrorReason.
+func NewEntityCreationErrorReasonValues() []NewEntityCreationErrorReason {
+	return []NewEntityCreationErrorReason{
+		NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS,
+	}
+}
+
+// IsValid reports whether e is one of the values of NewEntityCreationErrorReason.
+func (e NewEntityCreationErrorReason) IsValid() bool {
+	switch e {
+	case NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS:
+		return true
+	}
+	return false
+}
+
+// ParseNewEntityCreationErrorReason returns the NewEntityCreationErrorReason named by s.
+func ParseNewEntityCreationErrorReason(s string) (NewEntityCreationErrorReason, error) {
+	e := NewEntityCreationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NewEntityCreationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NewEntityCreationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NewEntityCreationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NewEntityCreationErrorReason) UnmarshalText(text []byte) error {
+	v := NewEntityCreationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NotEmptyErrorReasonValues returns all values of NotEmptyErrorReason.
+func NotEmptyErrorReasonValues() []NotEmptyErrorReason {
+	return []NotEmptyErrorReason{
+		NotEmptyErrorReasonEMPTY_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotEmptyErrorReason.
+func (e NotEmptyErrorReason) IsValid() bool {
+	switch e {
+	case NotEmptyErrorReasonEMPTY_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseNotEmptyErrorReason returns the NotEmptyErrorReason named by s.
+func ParseNotEmptyErrorReason(s string) (NotEmptyErrorReason, error) {
+	e := NotEmptyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotEmptyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotEmptyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotEmptyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotEmptyErrorReason) UnmarshalText(text []byte) error {
+	v := NotEmptyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NullErrorReasonValues returns all values of NullErrorReason.
+func NullErrorReasonValues() []NullErrorReason {
+	return []NullErrorReason{
+		NullErrorReasonNULL_CONTENT,
+	}
+}
+
+// IsValid reports whether e is one of the values of NullErrorReason.
+func (e NullErrorReason) IsValid() bool {
+	switch e {
+	case NullErrorReasonNULL_CONTENT:
+		return true
+	}
+	return false
+}
+
+// ParseNullErrorReason returns the NullErrorReason named by s.
+func ParseNullErrorReason(s string) (NullErrorReason, error) {
+	e := NullErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NullErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NullErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NullErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NullErrorReason) UnmarshalText(text []byte) error {
+	v := NullErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OperationAccessDeniedReasonValues returns all values of OperationAccessDeniedReason.
+func OperationAccessDeniedReasonValues() []OperationAccessDeniedReason {
+	return []OperationAccessDeniedReason{
+		OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) IsValid() bool {
+	switch e {
+	case OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperationAccessDeniedReason returns the OperationAccessDeniedReason named by s.
+func ParseOperationAccessDeniedReason(s string) (OperationAccessDeniedReason, error) {
+	e := OperationAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperationAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperationAccessDeniedReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperationAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := OperationAccessDeniedReason(text)
+	if !v.IsValid() {
+		v = OperationAccessDeniedReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperatorValues returns all values of Operator.
+func OperatorValues() []Operator {
+	return []Operator{
+		OperatorADD,
+		OperatorREMOVE,
+		OperatorSET,
+	}
+}
+
+// IsValid reports whether e is one of the values of Operator.
+func (e Operator) IsValid() bool {
+	switch e {
+	case OperatorADD,
+		OperatorREMOVE,
+		OperatorSET:
+		return true
+	}
+	return false
+}
+
+// ParseOperator returns the Operator named by s.
+func ParseOperator(s string) (Operator, error) {
+	e := Operator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid Operator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// Operator are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e Operator) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *Operator) UnmarshalText(text []byte) error {
+	v := Operator(text)
+	*e = v
+	return nil
+}
+
+// OperatorErrorReasonValues returns all values of OperatorErrorReason.
+func OperatorErrorReasonValues() []OperatorErrorReason {
+	return []OperatorErrorReason{
+		OperatorErrorReasonOPERATOR_NOT_SUPPORTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatorErrorReason.
+func (e OperatorErrorReason) IsValid() bool {
+	switch e {
+	case OperatorErrorReasonOPERATOR_NOT_SUPPORTED:
+		return true
+	}
+	return false
+}
+
+// ParseOperatorErrorReason returns the OperatorErrorReason named by s.
+func ParseOperatorErrorReason(s string) (OperatorErrorReason, error) {
+	e := OperatorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// OperatorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e OperatorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *OperatorErrorReason) UnmarshalText(text []byte) error {
+	v := OperatorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// PredicateOperatorValues returns all values of PredicateOperator.
+func PredicateOperatorValues() []PredicateOperator {
+	return []PredicateOperator{
+		PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PredicateOperator.
+func (e PredicateOperator) IsValid() bool {
+	switch e {
+	case PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePredicateOperator returns the PredicateOperator named by s.
+func ParsePredicateOperator(s string) (PredicateOperator, error) {
+	e := PredicateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PredicateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PredicateOperator.
+func (e PredicateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PredicateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PredicateOperator) UnmarshalText(text []byte) error {
+	v := PredicateOperator(text)
+	if !v.IsValid() {
+		v = PredicateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// QuotaCheckErrorReasonValues returns all values of QuotaCheckErrorReason.
+func QuotaCheckErrorReasonValues() []QuotaCheckErrorReason {
+	return []QuotaCheckErrorReason{
+		QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QuotaCheckErrorReason.
+func (e QuotaCheckErrorReason) IsValid() bool {
+	switch e {
+	case QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseQuotaCheckErrorReason returns the QuotaCheckErrorReason named by s.
+func ParseQuotaCheckErrorReason(s string) (QuotaCheckErrorReason, error) {
+	e := QuotaCheckErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QuotaCheckErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QuotaCheckErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QuotaCheckErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QuotaCheckErrorReason) UnmarshalText(text []byte) error {
+	v := QuotaCheckErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RangeErrorReasonValues returns all values of RangeErrorReason.
+func RangeErrorReasonValues() []RangeErrorReason {
+	return []RangeErrorReason{
+		RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH,
+	}
+}
+
+// IsValid reports whether e is one of the values of RangeErrorReason.
+func (e RangeErrorReason) IsValid() bool {
+	switch e {
+	case RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH:
+		return true
+	}
+	return false
+}
+
+// ParseRangeErrorReason returns the RangeErrorReason named by s.
+func ParseRangeErrorReason(s string) (RangeErrorReason, error) {
+	e := RangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RangeErrorReason) UnmarshalText(text []byte) error {
+	v := RangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RateExceededErrorReasonValues returns all values of RateExceededErrorReason.
+func RateExceededErrorReasonValues() []RateExceededErrorReason {
+	return []RateExceededErrorReason{
+		RateExceededErrorReasonRATE_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RateExceededErrorReason.
+func (e RateExceededErrorReason) IsValid() bool {
+	switch e {
+	case RateExceededErrorReasonRATE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseRateExceededErrorReason returns the RateExceededErrorReason named by s.
+func ParseRateExceededErrorReason(s string) (RateExceededErrorReason, error) {
+	e := RateExceededErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RateExceededErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RateExceededErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RateExceededErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RateExceededErrorReason) UnmarshalText(text []byte) error {
+	v := RateExceededErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ReadOnlyErrorReasonValues returns all values of ReadOnlyErrorReason.
+func ReadOnlyErrorReasonValues() []ReadOnlyErrorReason {
+	return []ReadOnlyErrorReason{
+		ReadOnlyErrorReasonREAD_ONLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ReadOnlyErrorReason.
+func (e ReadOnlyErrorReason) IsValid() bool {
+	switch e {
+	case ReadOnlyErrorReasonREAD_ONLY:
+		return true
+	}
+	return false
+}
+
+// ParseReadOnlyErrorReason returns the ReadOnlyErrorReason named by s.
+func ParseReadOnlyErrorReason(s string) (ReadOnlyErrorReason, error) {
+	e := ReadOnlyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ReadOnlyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ReadOnlyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ReadOnlyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ReadOnlyErrorReason) UnmarshalText(text []byte) error {
+	v := ReadOnlyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RejectedErrorReasonValues returns all values of RejectedErrorReason.
+func RejectedErrorReasonValues() []RejectedErrorReason {
+	return []RejectedErrorReason{
+		RejectedErrorReasonUNKNOWN_VALUE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RejectedErrorReason.
+func (e RejectedErrorReason) IsValid() bool {
+	switch e {
+	case RejectedErrorReasonUNKNOWN_VALUE:
+		return true
+	}
+	return false
+}
+
+// ParseRejectedErrorReason returns the RejectedErrorReason named by s.
+func ParseRejectedErrorReason(s string) (RejectedErrorReason, error) {
+	e := RejectedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RejectedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RejectedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RejectedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RejectedErrorReason) UnmarshalText(text []byte) error {
+	v := RejectedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RequestErrorReasonValues returns all values of RequestErrorReason.
+func RequestErrorReasonValues() []RequestErrorReason {
+	return []RequestErrorReason{
+		RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequestErrorReason.
+func (e RequestErrorReason) IsValid() bool {
+	switch e {
+	case RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION:
+		return true
+	}
+	return false
+}
+
+// ParseRequestErrorReason returns the RequestErrorReason named by s.
+func ParseRequestErrorReason(s string) (RequestErrorReason, error) {
+	e := RequestErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequestErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of RequestErrorReason.
+func (e RequestErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid RequestErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *RequestErrorReason) UnmarshalText(text []byte) error {
+	v := RequestErrorReason(text)
+	if !v.IsValid() {
+		v = RequestErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RequiredErrorReasonValues returns all values of RequiredErrorReason.
+func RequiredErrorReasonValues() []RequiredErrorReason {
+	return []RequiredErrorReason{
+		RequiredErrorReasonREQUIRED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequiredErrorReason.
+func (e RequiredErrorReason) IsValid() bool {
+	switch e {
+	case RequiredErrorReasonREQUIRED:
+		return true
+	}
+	return false
+}
+
+// ParseRequiredErrorReason returns the RequiredErrorReason named by s.
+func ParseRequiredErrorReason(s string) (RequiredErrorReason, error) {
+	e := RequiredErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequiredErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RequiredErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RequiredErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RequiredErrorReason) UnmarshalText(text []byte) error {
+	v := RequiredErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SelectorErrorReasonValues returns all values of SelectorErrorReason.
+func SelectorErrorReasonValues() []SelectorErrorReason {
+	return []SelectorErrorReason{
+		SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of SelectorErrorReason.
+func (e SelectorErrorReason) IsValid() bool {
+	switch e {
+	case SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseSelectorErrorReason returns the SelectorErrorReason named by s.
+func ParseSelectorErrorReason(s string) (SelectorErrorReason, error) {
+	e := SelectorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SelectorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SelectorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SelectorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SelectorErrorReason) UnmarshalText(text []byte) error {
+	v := SelectorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SizeLimitErrorReasonValues returns all values of SizeLimitErrorReason.
+func SizeLimitErrorReasonValues() []SizeLimitErrorReason {
+	return []SizeLimitErrorReason{
+		SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) IsValid() bool {
+	switch e {
+	case SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSizeLimitErrorReason returns the SizeLimitErrorReason named by s.
+func ParseSizeLimitErrorReason(s string) (SizeLimitErrorReason, error) {
+	e := SizeLimitErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeLimitErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SizeLimitErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SizeLimitErrorReason) UnmarshalText(text []byte) error {
+	v := SizeLimitErrorReason(text)
+	if !v.IsValid() {
+		v = SizeLimitErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SortOrderValues returns all values of SortOrder.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASCENDING,
+		SortOrderDESCENDING,
+	}
+}
+
+// IsValid reports whether e is one of the values of SortOrder.
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderASCENDING,
+		SortOrderDESCENDING:
+		return true
+	}
+	return false
+}
+
+// ParseSortOrder returns the SortOrder named by s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	e := SortOrder(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SortOrder %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SortOrder are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	*e = v
+	return nil
+}
+
+// StringFormatErrorReasonValues returns all values of StringFormatErrorReason.
+func StringFormatErrorReasonValues() []StringFormatErrorReason {
+	return []StringFormatErrorReason{
+		StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringFormatErrorReason.
+func (e StringFormatErrorReason) IsValid() bool {
+	switch e {
+	case StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT:
+		return true
+	}
+	return false
+}
+
+// ParseStringFormatErrorReason returns the StringFormatErrorReason named by s.
+func ParseStringFormatErrorReason(s string) (StringFormatErrorReason, error) {
+	e := StringFormatErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringFormatErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringFormatErrorReason.
+func (e StringFormatErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringFormatErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringFormatErrorReason) UnmarshalText(text []byte) error {
+	v := StringFormatErrorReason(text)
+	if !v.IsValid() {
+		v = StringFormatErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// StringLengthErrorReasonValues returns all values of StringLengthErrorReason.
+func StringLengthErrorReasonValues() []StringLengthErrorReason {
+	return []StringLengthErrorReason{
+		StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringLengthErrorReason.
+func (e StringLengthErrorReason) IsValid() bool {
+	switch e {
+	case StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG:
+		return true
+	}
+	return false
+}
+
+// ParseStringLengthErrorReason returns the StringLengthErrorReason named by s.
+func ParseStringLengthErrorReason(s string) (StringLengthErrorReason, error) {
+	e := StringLengthErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringLengthErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StringLengthErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StringLengthErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StringLengthErrorReason) UnmarshalText(text []byte) error {
+	v := StringLengthErrorReason(text)
+	*e = v
+	return nil
+}
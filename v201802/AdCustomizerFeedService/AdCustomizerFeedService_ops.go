@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdCustomizerFeedService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *AdCustomizerFeed) *AdCustomizerFeedOperation {
+	op := OperatorADD
+	return &AdCustomizerFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AdCustomizerFeed) *AdCustomizerFeedOperation {
+	op := OperatorSET
+	return &AdCustomizerFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *AdCustomizerFeed) *AdCustomizerFeedOperation {
+	op := OperatorREMOVE
+	return &AdCustomizerFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
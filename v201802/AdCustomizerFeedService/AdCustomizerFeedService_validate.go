@@ -0,0 +1,177 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdCustomizerFeedService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks AdCustomizerFeedOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *AdCustomizerFeedOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "selector"), t.Selector != nil)
+	if t.Selector != nil {
+		t.Selector.validate(v, validate.Field(path, "selector"), op)
+	}
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+}
+
+func (t *AdCustomizerFeed) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdCustomizerFeed)
+	}
+	if validate.HasOperator(op, "REMOVE", "SET") {
+		v.Required(validate.Field(path, "feedId"), t.FeedId != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "feedName"), t.FeedName != nil)
+	}
+	if t.FeedName != nil {
+		v.StringLength(validate.Field(path, "feedName"), *t.FeedName, 1, 128, true, false)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "feedStatus"), op, t.FeedStatus != nil)
+	}
+	if validate.HasOperator(op, "ADD", "SET") {
+		v.Required(validate.Field(path, "feedAttributes"), len(t.FeedAttributes) > 0)
+	}
+	v.ContentsNotNull(validate.Field(path, "feedAttributes"), t.FeedAttributes)
+	for i, e := range t.FeedAttributes {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "feedAttributes"), i), op)
+		}
+	}
+}
+
+func (t *AdCustomizerFeedAttribute) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdCustomizerFeedAttribute)
+	}
+	if validate.HasOperator(op, "ADD", "SET") {
+		v.Required(validate.Field(path, "name"), t.Name != nil)
+	}
+	if t.Name != nil {
+		v.StringLength(validate.Field(path, "name"), *t.Name, 1, 30, true, false)
+	}
+	if validate.HasOperator(op, "ADD", "SET") {
+		v.Required(validate.Field(path, "type"), t.Type_ != nil)
+	}
+}
+
+func (t *AdCustomizerFeedOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdCustomizerFeedOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
@@ -3495,22 +3495,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse" json:"-"`
 
-	Rval *AdGroupAdPage `xml:"rval,omitempty"`
+	Rval *AdGroupAdPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -3518,17 +3518,17 @@ type Mutate struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operations []*AdGroupAdOperation `xml:"operations,omitempty"`
+	Operations []*AdGroupAdOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse" json:"-"`
 
-	Rval *AdGroupAdReturnValue `xml:"rval,omitempty"`
+	Rval *AdGroupAdReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabel"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabel" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -3537,39 +3537,37 @@ type MutateLabel struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, REMOVE.</span>
 	//
-	Operations []*AdGroupAdLabelOperation `xml:"operations,omitempty"`
+	Operations []*AdGroupAdLabelOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateLabelResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabelResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabelResponse" json:"-"`
 
-	Rval *AdGroupAdLabelReturnValue `xml:"rval,omitempty"`
+	Rval *AdGroupAdLabelReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Query struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse" json:"-"`
 
-	Rval *AdGroupAdPage `xml:"rval,omitempty"`
+	Rval *AdGroupAdPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Ad struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Ad"`
-
 	//
 	// ID of this ad. This field is ignored when creating
 	// ads using {@code AdGroupAdService}.
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Destination URL.
@@ -3577,13 +3575,13 @@ type Ad struct {
 	// https://developers.google.com/adwords/api/docs/guides/upgraded-urls
 	// <span class="constraint Selectable">This field can be selected using the value "Url".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 
 	//
 	// Visible URL.
 	// <span class="constraint Selectable">This field can be selected using the value "DisplayUrl".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	DisplayUrl string `xml:"displayUrl,omitempty"`
+	DisplayUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayUrl,omitempty" json:"displayUrl,omitempty"`
 
 	//
 	// A list of possible final URLs after all cross domain redirects.
@@ -3592,7 +3590,7 @@ type Ad struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CreativeFinalUrls".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint CollectionSize">The maximum size of this collection is 10.</span>
 	//
-	FinalUrls []string `xml:"finalUrls,omitempty"`
+	FinalUrls []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrls,omitempty" json:"finalUrls,omitempty"`
 
 	//
 	// A list of possible final mobile URLs after all cross domain redirects.
@@ -3601,7 +3599,7 @@ type Ad struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CreativeFinalMobileUrls".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint CollectionSize">The maximum size of this collection is 10.</span>
 	//
-	FinalMobileUrls []string `xml:"finalMobileUrls,omitempty"`
+	FinalMobileUrls []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalMobileUrls,omitempty" json:"finalMobileUrls,omitempty"`
 
 	//
 	// A list of final app URLs that will be used on mobile if the user has the specific app
@@ -3610,7 +3608,7 @@ type Ad struct {
 	// https://developers.google.com/adwords/api/docs/guides/upgraded-urls
 	// <span class="constraint Selectable">This field can be selected using the value "CreativeFinalAppUrls".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	FinalAppUrls []*AppUrl `xml:"finalAppUrls,omitempty"`
+	FinalAppUrls []*AppUrl `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalAppUrls,omitempty" json:"finalAppUrls,omitempty"`
 
 	//
 	// URL template for constructing a tracking URL.
@@ -3618,7 +3616,7 @@ type Ad struct {
 	// https://developers.google.com/adwords/api/docs/guides/upgraded-urls
 	// <span class="constraint Selectable">This field can be selected using the value "CreativeTrackingUrlTemplate".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TrackingUrlTemplate string `xml:"trackingUrlTemplate,omitempty"`
+	TrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
 
 	//
 	// URL template for appending params to Final URL.
@@ -3626,7 +3624,7 @@ type Ad struct {
 	// <p>On update, empty string ("") indicates to clear the field.
 	// <p>This field is supported only in test accounts.
 	//
-	FinalUrlSuffix string `xml:"finalUrlSuffix,omitempty"`
+	FinalUrlSuffix *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrlSuffix,omitempty" json:"finalUrlSuffix,omitempty"`
 
 	//
 	// A list of mappings to be used for substituting URL custom parameter tags in the
@@ -3635,7 +3633,7 @@ type Ad struct {
 	// https://developers.google.com/adwords/api/docs/guides/upgraded-urls
 	// <span class="constraint Selectable">This field can be selected using the value "CreativeUrlCustomParameters".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	UrlCustomParameters *CustomParameters `xml:"urlCustomParameters,omitempty"`
+	UrlCustomParameters *CustomParameters `xml:"https://adwords.google.com/api/adwords/cm/v201802 urlCustomParameters,omitempty" json:"urlCustomParameters,omitempty"`
 
 	//
 	// Additional urls for the ad that are tagged with a unique identifier. Currently only used for
@@ -3643,19 +3641,19 @@ type Ad struct {
 	// finalMobileUrls and finalAppUrls instead.
 	// <span class="constraint Selectable">This field can be selected using the value "UrlData".</span>
 	//
-	UrlData []*UrlData `xml:"urlData,omitempty"`
+	UrlData []*UrlData `xml:"https://adwords.google.com/api/adwords/cm/v201802 urlData,omitempty" json:"urlData,omitempty"`
 
 	//
 	// Indicates if this ad was added by AdWords.
 	// <span class="constraint Selectable">This field can be selected using the value "Automated".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Automated bool `xml:"automated,omitempty"`
+	Automated *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 automated,omitempty" json:"automated,omitempty"`
 
 	//
 	// Type of ad.
 	// <span class="constraint Selectable">This field can be selected using the value "AdType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Type_ *AdType `xml:"type,omitempty"`
+	Type_ *AdType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// The device preference for the ad. You can only specify a preference for
@@ -3663,77 +3661,71 @@ type Ad struct {
 	// all devices are targeted.
 	// <span class="constraint Selectable">This field can be selected using the value "DevicePreference".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	DevicePreference int64 `xml:"devicePreference,omitempty"`
+	DevicePreference *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 devicePreference,omitempty" json:"devicePreference,omitempty"`
 
 	//
 	// The source of this system-managed ad.
 	// <span class="constraint Selectable">This field can be selected using the value "SystemManagedEntitySource".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SystemManagedEntitySource *SystemManagedEntitySource `xml:"systemManagedEntitySource,omitempty"`
+	SystemManagedEntitySource *SystemManagedEntitySource `xml:"https://adwords.google.com/api/adwords/cm/v201802 systemManagedEntitySource,omitempty" json:"systemManagedEntitySource,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Ad.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	AdType string `xml:"Ad.Type,omitempty"`
+	AdType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Ad.Type,omitempty" json:"adType,omitempty"`
 }
 
 type AdCustomizerError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdCustomizerError"`
-
 	*ApiError
 
-	Reason *AdCustomizerErrorReason `xml:"reason,omitempty"`
+	Reason *AdCustomizerErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// String form of the function that contained the error.
 	//
-	FunctionString string `xml:"functionString,omitempty"`
+	FunctionString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 functionString,omitempty" json:"functionString,omitempty"`
 
 	//
 	// Lowercased string representation of the ad customizer function's operator.
 	//
-	OperatorName string `xml:"operatorName,omitempty"`
+	OperatorName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 operatorName,omitempty" json:"operatorName,omitempty"`
 
 	//
 	// Index of the operand that caused the error.
 	//
-	OperandIndex int32 `xml:"operandIndex,omitempty"`
+	OperandIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operandIndex,omitempty" json:"operandIndex,omitempty"`
 
 	//
 	// Value of the operand that caused the error.
 	//
-	OperandValue string `xml:"operandValue,omitempty"`
+	OperandValue *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 operandValue,omitempty" json:"operandValue,omitempty"`
 }
 
 type AdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdErrorReason `xml:"reason,omitempty"`
+	Reason *AdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AdGroupAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAd"`
-
 	//
 	// The id of the adgroup containing this ad.
 	// <span class="constraint Selectable">This field can be selected using the value "AdGroupId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// The contents of the ad itself.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Ad *Ad `xml:"ad,omitempty"`
+	Ad *Ad `xml:"https://adwords.google.com/api/adwords/cm/v201802 ad,omitempty" json:"ad,omitempty"`
 
 	//
 	// The status of the ad.
@@ -3741,14 +3733,14 @@ type AdGroupAd struct {
 	// {@link Operator}s : SET.
 	// <span class="constraint Selectable">This field can be selected using the value "Status".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Status *AdGroupAdStatus `xml:"status,omitempty"`
+	Status *AdGroupAdStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// Summary of policy findings for this ad.
 	// <span class="constraint Selectable">This field can be selected using the value "PolicySummary".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PolicySummary *AdGroupAdPolicySummary `xml:"policySummary,omitempty"`
+	PolicySummary *AdGroupAdPolicySummary `xml:"https://adwords.google.com/api/adwords/cm/v201802 policySummary,omitempty" json:"policySummary,omitempty"`
 
 	//
 	// Labels that are attached to the AdGroupAd. To associate an existing {@link Label} to an
@@ -3761,7 +3753,7 @@ type AdGroupAd struct {
 	// <span class="constraint CampaignType">This field may not be set for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	Labels []*Label `xml:"labels,omitempty"`
+	Labels []*Label `xml:"https://adwords.google.com/api/adwords/cm/v201802 labels,omitempty" json:"labels,omitempty"`
 
 	//
 	// ID of the base campaign from which this draft/trial ad was created.
@@ -3769,7 +3761,7 @@ type AdGroupAd struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BaseCampaignId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BaseCampaignId int64 `xml:"baseCampaignId,omitempty"`
+	BaseCampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 baseCampaignId,omitempty" json:"baseCampaignId,omitempty"`
 
 	//
 	// ID of the base ad group from which this draft/trial ad was created. For
@@ -3779,7 +3771,7 @@ type AdGroupAd struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BaseAdGroupId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BaseAdGroupId int64 `xml:"baseAdGroupId,omitempty"`
+	BaseAdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 baseAdGroupId,omitempty" json:"baseAdGroupId,omitempty"`
 
 	//
 	// This Map provides a place to put new features and settings in older versions
@@ -3788,352 +3780,310 @@ type AdGroupAd struct {
 	//
 	// It is presently unused.  Do not set a value.
 	//
-	ForwardCompatibilityMap []*String_StringMapEntry `xml:"forwardCompatibilityMap,omitempty"`
+	ForwardCompatibilityMap []*String_StringMapEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 forwardCompatibilityMap,omitempty" json:"forwardCompatibilityMap,omitempty"`
 }
 
 type AdGroupAdCountLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdCountLimitExceeded"`
-
 	*EntityCountLimitExceeded
 }
 
 type AdGroupAdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdGroupAdErrorReason `xml:"reason,omitempty"`
+	Reason *AdGroupAdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AdGroupAdLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdLabel"`
-
 	//
 	// The id of the adgroup containing the ad that the label to be applied to.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// The id of the ad that the label to be applied to.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	AdId int64 `xml:"adId,omitempty"`
+	AdId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adId,omitempty" json:"adId,omitempty"`
 
 	//
 	// The id of an existing label to be applied to the adgroup ad.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	LabelId int64 `xml:"labelId,omitempty"`
+	LabelId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 labelId,omitempty" json:"labelId,omitempty"`
 }
 
 type AdGroupAdLabelOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdLabelOperation"`
-
 	*Operation
 
 	//
 	// AdGroupAdLabel to operate on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *AdGroupAdLabel `xml:"operand,omitempty"`
+	Operand *AdGroupAdLabel `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type AdGroupAdLabelReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdLabelReturnValue"`
-
 	*ListReturnValue
 
-	Value []*AdGroupAdLabel `xml:"value,omitempty"`
+	Value []*AdGroupAdLabel `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type AdGroupAdOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdOperation"`
-
 	*Operation
 
 	//
 	// AdGroupAd to operate on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *AdGroupAd `xml:"operand,omitempty"`
+	Operand *AdGroupAd `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 
 	//
 	// Exemption requests for any policy violations in this Ad.  This field is
 	// only used for ADD operations
 	//
-	ExemptionRequests []*ExemptionRequest `xml:"exemptionRequests,omitempty"`
+	ExemptionRequests []*ExemptionRequest `xml:"https://adwords.google.com/api/adwords/cm/v201802 exemptionRequests,omitempty" json:"exemptionRequests,omitempty"`
 }
 
 type AdGroupAdPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*AdGroupAd `xml:"entries,omitempty"`
+	Entries []*AdGroupAd `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type AdGroupAdPolicySummary struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdPolicySummary"`
-
 	//
 	// List of policy findings.
 	//
-	PolicyTopicEntries []*PolicyTopicEntry `xml:"policyTopicEntries,omitempty"`
+	PolicyTopicEntries []*PolicyTopicEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicEntries,omitempty" json:"policyTopicEntries,omitempty"`
 
 	//
 	// Progress through the review process.
 	//
-	ReviewState *PolicySummaryReviewState `xml:"reviewState,omitempty"`
+	ReviewState *PolicySummaryReviewState `xml:"https://adwords.google.com/api/adwords/cm/v201802 reviewState,omitempty" json:"reviewState,omitempty"`
 
 	//
 	// Overall review status based on the policy topic entries.
 	//
-	DenormalizedStatus *PolicySummaryDenormalizedStatus `xml:"denormalizedStatus,omitempty"`
+	DenormalizedStatus *PolicySummaryDenormalizedStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 denormalizedStatus,omitempty" json:"denormalizedStatus,omitempty"`
 
 	//
 	// Approval status that combines review state and status.
 	// <span class="constraint Selectable">This field can be selected using the value "CombinedApprovalStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CombinedApprovalStatus *PolicyApprovalStatus `xml:"combinedApprovalStatus,omitempty"`
+	CombinedApprovalStatus *PolicyApprovalStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 combinedApprovalStatus,omitempty" json:"combinedApprovalStatus,omitempty"`
 }
 
 type AdGroupAdReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdReturnValue"`
-
 	*ListReturnValue
 
 	//
 	// List of ads in an ad group.
 	//
-	Value []*AdGroupAd `xml:"value,omitempty"`
+	Value []*AdGroupAd `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type AdSharingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdSharingError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdSharingErrorReason `xml:"reason,omitempty"`
+	Reason *AdSharingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	SharedAdError *ApiError `xml:"sharedAdError,omitempty"`
+	SharedAdError *ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 sharedAdError,omitempty" json:"sharedAdError,omitempty"`
 }
 
 type AdUnionId struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdUnionId"`
-
 	//
 	// The ID of the ad union
 	// <span class="constraint InRange">This field must be greater than or equal to 1.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of AdUnionId.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	AdUnionIdType string `xml:"AdUnionId.Type,omitempty"`
+	AdUnionIdType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdUnionId.Type,omitempty" json:"adUnionIdType,omitempty"`
 }
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type AppUrl struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AppUrl"`
-
 	//
 	// The app deep link url. E.g. "android-app://com.my.App"
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 
 	//
 	// The operating system targeted by this url.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	OsType *AppUrlOsType `xml:"osType,omitempty"`
+	OsType *AppUrlOsType `xml:"https://adwords.google.com/api/adwords/cm/v201802 osType,omitempty" json:"osType,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type LabelAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LabelAttribute"`
-
 	//
 	// Indicates that this instance is a subtype of LabelAttribute.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	LabelAttributeType string `xml:"LabelAttribute.Type,omitempty"`
+	LabelAttributeType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 LabelAttribute.Type,omitempty" json:"labelAttributeType,omitempty"`
 }
 
 type Audio struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Audio"`
-
 	*Media
 
 	//
 	// The duration of the associated audio, in milliseconds.
 	// <span class="constraint Selectable">This field can be selected using the value "DurationMillis".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	DurationMillis int64 `xml:"durationMillis,omitempty"`
+	DurationMillis *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 durationMillis,omitempty" json:"durationMillis,omitempty"`
 
 	//
 	// The streaming URL of the audio.
 	// <span class="constraint Selectable">This field can be selected using the value "StreamingUrl".</span>
 	//
-	StreamingUrl string `xml:"streamingUrl,omitempty"`
+	StreamingUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 streamingUrl,omitempty" json:"streamingUrl,omitempty"`
 
 	//
 	// Indicates whether the audio is ready to play on the web.
 	// <span class="constraint Selectable">This field can be selected using the value "ReadyToPlayOnTheWeb".</span>
 	//
-	ReadyToPlayOnTheWeb bool `xml:"readyToPlayOnTheWeb,omitempty"`
+	ReadyToPlayOnTheWeb *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 readyToPlayOnTheWeb,omitempty" json:"readyToPlayOnTheWeb,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CallOnlyAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CallOnlyAd"`
-
 	*Ad
 
 	//
 	// Two letter country code for the ad. Examples: 'US', 'GB'.
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdCountryCode".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CountryCode string `xml:"countryCode,omitempty"`
+	CountryCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 countryCode,omitempty" json:"countryCode,omitempty"`
 
 	//
 	// Phone number string for the ad.
 	// Examples: '(800) 356-9377', "16502531234", "+442001234567"
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdPhoneNumber".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	PhoneNumber string `xml:"phoneNumber,omitempty"`
+	PhoneNumber *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 phoneNumber,omitempty" json:"phoneNumber,omitempty"`
 
 	//
 	// Business name of the ad.
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdBusinessName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	BusinessName string `xml:"businessName,omitempty"`
+	BusinessName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 businessName,omitempty" json:"businessName,omitempty"`
 
 	//
 	// First line of ad text.
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdDescription1".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Description1 string `xml:"description1,omitempty"`
+	Description1 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description1,omitempty" json:"description1,omitempty"`
 
 	//
 	// Second line of ad text.
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdDescription2".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Description2 string `xml:"description2,omitempty"`
+	Description2 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description2,omitempty" json:"description2,omitempty"`
 
 	//
 	// If set to true, enable call tracking for the creative. Enabling call
 	// tracking also enables call conversions.
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdCallTracked".</span>
 	//
-	CallTracked bool `xml:"callTracked,omitempty"`
+	CallTracked *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 callTracked,omitempty" json:"callTracked,omitempty"`
 
 	//
 	// By default, call conversions are enabled when callTracked is on.
@@ -4142,7 +4092,7 @@ type CallOnlyAd struct {
 	// to false, this field is ignored.
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdDisableCallConversion".</span>
 	//
-	DisableCallConversion bool `xml:"disableCallConversion,omitempty"`
+	DisableCallConversion *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 disableCallConversion,omitempty" json:"disableCallConversion,omitempty"`
 
 	//
 	// Conversion type to attribute a call conversion to. If not set, then a
@@ -4150,161 +4100,135 @@ type CallOnlyAd struct {
 	// set to true otherwise this field is ignored.
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdConversionTypeId".</span>
 	//
-	ConversionTypeId int64 `xml:"conversionTypeId,omitempty"`
+	ConversionTypeId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 conversionTypeId,omitempty" json:"conversionTypeId,omitempty"`
 
 	//
 	// Url to be used for phone number verification.
 	// <span class="constraint Selectable">This field can be selected using the value "CallOnlyAdPhoneNumberVerificationUrl".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	PhoneNumberVerificationUrl string `xml:"phoneNumberVerificationUrl,omitempty"`
+	PhoneNumberVerificationUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 phoneNumberVerificationUrl,omitempty" json:"phoneNumberVerificationUrl,omitempty"`
 }
 
 type TextLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TextLabel"`
-
 	*Label
 }
 
 type DisplayAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DisplayAttribute"`
-
 	*LabelAttribute
 
 	//
 	// Background color of the label in RGB format.
 	// <span class="constraint MatchesRegex">A background color string must begin with a '#' character followed by either 6 or 3 hexadecimal characters (24 vs. 12 bits). This is checked by the regular expression '^\#([a-fA-F0-9]{6}|[a-fA-F0-9]{3})$'.</span>
 	//
-	BackgroundColor string `xml:"backgroundColor,omitempty"`
+	BackgroundColor *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 backgroundColor,omitempty" json:"backgroundColor,omitempty"`
 
 	//
 	// A short description of the label.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive.</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 }
 
 type CertificateDomainMismatchInCountryConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CertificateDomainMismatchInCountryConstraint"`
-
 	*CountryConstraint
 }
 
 type CertificateMissingConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CertificateMissingConstraint"`
-
 	*PolicyTopicConstraint
 }
 
 type CertificateMissingInCountryConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CertificateMissingInCountryConstraint"`
-
 	*CountryConstraint
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CountryConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CountryConstraint"`
-
 	*PolicyTopicConstraint
 
 	//
 	// The set of targeted country criterion IDs to which a policy topic entry applies.
 	//
-	ConstrainedCountries []int64 `xml:"constrainedCountries,omitempty"`
+	ConstrainedCountries []int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 constrainedCountries,omitempty" json:"constrainedCountries,omitempty"`
 
 	//
 	// The total number of targeted countries.
 	//
-	TotalTargetedCountries int32 `xml:"totalTargetedCountries,omitempty"`
+	TotalTargetedCountries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalTargetedCountries,omitempty" json:"totalTargetedCountries,omitempty"`
 }
 
 type CustomParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameter"`
-
 	//
 	// The parameter key to be mapped.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 16, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// The value this parameter should be mapped to. It should be null if isRemove is true.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// On SET operation, indicates that the parameter should be removed from the existing parameters.
 	// If set to true, the value field must be null.
 	//
-	IsRemove bool `xml:"isRemove,omitempty"`
+	IsRemove *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isRemove,omitempty" json:"isRemove,omitempty"`
 }
 
 type CustomParameters struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameters"`
-
 	//
 	// The list of custom parameters.
 	//
 	// <p>On update, all parameters can be cleared by providing an empty or null list and setting
 	// doReplace to true.
 	//
-	Parameters []*CustomParameter `xml:"parameters,omitempty"`
+	Parameters []*CustomParameter `xml:"https://adwords.google.com/api/adwords/cm/v201802 parameters,omitempty" json:"parameters,omitempty"`
 
 	//
 	// On SET operation, indicates that the current parameters should be cleared and replaced
 	// with these parameters.
 	//
-	DoReplace bool `xml:"doReplace,omitempty"`
+	DoReplace *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 doReplace,omitempty" json:"doReplace,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DeprecatedAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DeprecatedAd"`
-
 	*Ad
 
 	//
@@ -4312,71 +4236,63 @@ type DeprecatedAd struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Name".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Type of the creative.
 	// <span class="constraint Selectable">This field can be selected using the value "Type".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DeprecatedAdType *DeprecatedAdType `xml:"deprecatedAdType,omitempty"`
+	DeprecatedAdType *DeprecatedAdType `xml:"https://adwords.google.com/api/adwords/cm/v201802 deprecatedAdType,omitempty" json:"deprecatedAdType,omitempty"`
 }
 
 type Dimensions struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Dimensions"`
-
 	//
 	// Width of the dimension
 	// <span class="constraint Selectable">This field can be selected using the value "Width".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Width int32 `xml:"width,omitempty"`
+	Width *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 width,omitempty" json:"width,omitempty"`
 
 	//
 	// Height of the dimension
 	// <span class="constraint Selectable">This field can be selected using the value "Height".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Height int32 `xml:"height,omitempty"`
+	Height *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 height,omitempty" json:"height,omitempty"`
 }
 
 type DisplayCallToAction struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DisplayCallToAction"`
-
 	//
 	// Text of the display-call-to-action. Maximum display width is 15 characters.
 	// <span class="constraint Selectable">This field can be selected using the value "MarketingImageCallToActionText".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Text string `xml:"text,omitempty"`
+	Text *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 text,omitempty" json:"text,omitempty"`
 
 	//
 	// Text color of the display-call-to-action. In hexadecimal, e.g. #ffffff for white.
 	// <span class="constraint Selectable">This field can be selected using the value "MarketingImageCallToActionTextColor".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TextColor string `xml:"textColor,omitempty"`
+	TextColor *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 textColor,omitempty" json:"textColor,omitempty"`
 
 	//
 	// Identifies the url data in Ad.urlData used for this DisplayCallToAction. If not set, the url
 	// defaults to {@link Ad#finalUrls}.
 	//
-	UrlId string `xml:"urlId,omitempty"`
+	UrlId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 urlId,omitempty" json:"urlId,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DynamicSettings struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DynamicSettings"`
-
 	//
 	// Landscape logo image. This ad format does not allow the creation of an image using the
 	// Image.data field. An image must first be created using the MediaService, and Image.mediaId must
@@ -4384,88 +4300,78 @@ type DynamicSettings struct {
 	// PNG. The minimum size is 512x128 the aspect ratio must be 512:128 (+-1%).
 	// <span class="constraint Selectable">This field can be selected using the value "LandscapeLogoImage".</span>
 	//
-	LandscapeLogoImage *Image `xml:"landscapeLogoImage,omitempty"`
+	LandscapeLogoImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 landscapeLogoImage,omitempty" json:"landscapeLogoImage,omitempty"`
 
 	//
 	// Prefix before price. Maximum display width is 10. example, "as low as".
 	// <span class="constraint Selectable">This field can be selected using the value "PricePrefix".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	PricePrefix string `xml:"pricePrefix,omitempty"`
+	PricePrefix *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 pricePrefix,omitempty" json:"pricePrefix,omitempty"`
 
 	//
 	// Promotion text used for dynamic formats of responsive ads. Maximum display width is 25. For
 	// example, "Free two-day shipping".
 	// <span class="constraint Selectable">This field can be selected using the value "PromoText".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	PromoText string `xml:"promoText,omitempty"`
+	PromoText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 promoText,omitempty" json:"promoText,omitempty"`
 }
 
 type EntityAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityAccessDenied"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *EntityAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type EntityCountLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityCountLimitExceeded"`
-
 	*ApiError
 
 	//
 	// Specifies which level's limit was exceeded.
 	//
-	Reason *EntityCountLimitExceededReason `xml:"reason,omitempty"`
+	Reason *EntityCountLimitExceededReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Id of the entity whose limit was exceeded.
 	//
-	EnclosingId string `xml:"enclosingId,omitempty"`
+	EnclosingId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 enclosingId,omitempty" json:"enclosingId,omitempty"`
 
 	//
 	// The limit which was exceeded.
 	//
-	Limit int32 `xml:"limit,omitempty"`
+	Limit *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 limit,omitempty" json:"limit,omitempty"`
 
 	//
 	// The account limit type which was exceeded.
 	//
-	AccountLimitType string `xml:"accountLimitType,omitempty"`
+	AccountLimitType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 accountLimitType,omitempty" json:"accountLimitType,omitempty"`
 
 	//
 	// The count of existing entities.
 	//
-	ExistingCount int32 `xml:"existingCount,omitempty"`
+	ExistingCount *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 existingCount,omitempty" json:"existingCount,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ExemptionRequest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExemptionRequest"`
-
 	//
 	// Identifies the violation to request an exemption for.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Key *PolicyViolationKey `xml:"key,omitempty"`
+	Key *PolicyViolationKey `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 }
 
 type ExpandedDynamicSearchAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExpandedDynamicSearchAd"`
-
 	*Ad
 
 	//
@@ -4473,12 +4379,10 @@ type ExpandedDynamicSearchAd struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Description".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 }
 
 type ExpandedTextAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExpandedTextAd"`
-
 	*Ad
 
 	//
@@ -4486,111 +4390,99 @@ type ExpandedTextAd struct {
 	// <span class="constraint Selectable">This field can be selected using the value "HeadlinePart1".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	HeadlinePart1 string `xml:"headlinePart1,omitempty"`
+	HeadlinePart1 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 headlinePart1,omitempty" json:"headlinePart1,omitempty"`
 
 	//
 	// Second part of the headline.
 	// <span class="constraint Selectable">This field can be selected using the value "HeadlinePart2".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	HeadlinePart2 string `xml:"headlinePart2,omitempty"`
+	HeadlinePart2 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 headlinePart2,omitempty" json:"headlinePart2,omitempty"`
 
 	//
 	// The descriptive text of the ad.
 	// <span class="constraint Selectable">This field can be selected using the value "Description".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 
 	//
 	// Text that appears in the ad with the displayed URL.
 	// <span class="constraint Selectable">This field can be selected using the value "Path1".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Path1 string `xml:"path1,omitempty"`
+	Path1 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 path1,omitempty" json:"path1,omitempty"`
 
 	//
 	// In addition to {@link #path1}, more text that appears with the displayed URL.
 	// <span class="constraint Selectable">This field can be selected using the value "Path2".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Path2 string `xml:"path2,omitempty"`
+	Path2 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 path2,omitempty" json:"path2,omitempty"`
 }
 
 type FeedAttributeReferenceError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedAttributeReferenceError"`
-
 	*ApiError
 
-	Reason *FeedAttributeReferenceErrorReason `xml:"reason,omitempty"`
+	Reason *FeedAttributeReferenceErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// The referenced feed name.
 	//
-	FeedName string `xml:"feedName,omitempty"`
+	FeedName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedName,omitempty" json:"feedName,omitempty"`
 
 	//
 	// The referenced feed attribute name.
 	//
-	FeedAttributeName string `xml:"feedAttributeName,omitempty"`
+	FeedAttributeName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedAttributeName,omitempty" json:"feedAttributeName,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type ForwardCompatibilityError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ForwardCompatibilityError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ForwardCompatibilityErrorReason `xml:"reason,omitempty"`
+	Reason *ForwardCompatibilityErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FunctionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FunctionError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum
 	//
-	Reason *FunctionErrorReason `xml:"reason,omitempty"`
+	Reason *FunctionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FunctionParsingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FunctionParsingError"`
-
 	*ApiError
 
-	Reason *FunctionParsingErrorReason `xml:"reason,omitempty"`
+	Reason *FunctionParsingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	OffendingText string `xml:"offendingText,omitempty"`
+	OffendingText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 offendingText,omitempty" json:"offendingText,omitempty"`
 
-	OffendingTextIndex int32 `xml:"offendingTextIndex,omitempty"`
+	OffendingTextIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 offendingTextIndex,omitempty" json:"offendingTextIndex,omitempty"`
 }
 
 type GmailAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 GmailAd"`
-
 	*Ad
 
 	//
 	// Gmail teaser info.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Teaser *GmailTeaser `xml:"teaser,omitempty"`
+	Teaser *GmailTeaser `xml:"https://adwords.google.com/api/adwords/cm/v201802 teaser,omitempty" json:"teaser,omitempty"`
 
 	//
 	// Header image. An image must first be created using the MediaService, and Image.mediaId must be
@@ -4598,7 +4490,7 @@ type GmailAd struct {
 	// minimum size is 300x100 and the aspect ratio must be in 3:1 to 5:1 (+-1%).
 	// <span class="constraint Selectable">This field can be selected using the value "GmailHeaderImage".</span>
 	//
-	HeaderImage *Image `xml:"headerImage,omitempty"`
+	HeaderImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 headerImage,omitempty" json:"headerImage,omitempty"`
 
 	//
 	// Marketing image. An image must first be created using the MediaService, and Image.mediaId must
@@ -4608,33 +4500,33 @@ type GmailAd struct {
 	// productVideos or marketingImage must be specified.
 	// <span class="constraint Selectable">This field can be selected using the value "GmailMarketingImage".</span>
 	//
-	MarketingImage *Image `xml:"marketingImage,omitempty"`
+	MarketingImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 marketingImage,omitempty" json:"marketingImage,omitempty"`
 
 	//
 	// Headline of the marketing image. Maximum display width is 25 characters.
 	// <span class="constraint Selectable">This field can be selected using the value "MarketingImageHeadline".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MarketingImageHeadline string `xml:"marketingImageHeadline,omitempty"`
+	MarketingImageHeadline *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 marketingImageHeadline,omitempty" json:"marketingImageHeadline,omitempty"`
 
 	//
 	// Description of the marketing image. Maximum display width is 90 characters.
 	// <span class="constraint Selectable">This field can be selected using the value "MarketingImageDescription".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MarketingImageDescription string `xml:"marketingImageDescription,omitempty"`
+	MarketingImageDescription *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 marketingImageDescription,omitempty" json:"marketingImageDescription,omitempty"`
 
 	//
 	// Display-call-to-action of the marketing image. The DisplayCallToAction.urlId field cannot be
 	// set when setting this field.
 	//
-	MarketingImageDisplayCallToAction *DisplayCallToAction `xml:"marketingImageDisplayCallToAction,omitempty"`
+	MarketingImageDisplayCallToAction *DisplayCallToAction `xml:"https://adwords.google.com/api/adwords/cm/v201802 marketingImageDisplayCallToAction,omitempty" json:"marketingImageDisplayCallToAction,omitempty"`
 
 	//
 	// Product images. Support up to 15 product images.
 	// <span class="constraint Selectable">This field can be selected using the value "ProductImages".</span>
 	//
-	ProductImages []*ProductImage `xml:"productImages,omitempty"`
+	ProductImages []*ProductImage `xml:"https://adwords.google.com/api/adwords/cm/v201802 productImages,omitempty" json:"productImages,omitempty"`
 
 	//
 	// Product Videos. Either productVideoList or marketingImage must be specified. Supports up to 7
@@ -4643,32 +4535,30 @@ type GmailAd struct {
 	// (https://developers.google.com/adwords/scripts/docs/reference/adwordsapp/adwordsapp_videobuilder).
 	// <span class="constraint Selectable">This field can be selected using the value "ProductVideoList".</span>
 	//
-	ProductVideoList []*Video `xml:"productVideoList,omitempty"`
+	ProductVideoList []*Video `xml:"https://adwords.google.com/api/adwords/cm/v201802 productVideoList,omitempty" json:"productVideoList,omitempty"`
 }
 
 type GmailTeaser struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 GmailTeaser"`
-
 	//
 	// Headline of the teaser. Maximum display width is 25 characters.
 	// <span class="constraint Selectable">This field can be selected using the value "GmailTeaserHeadline". This field can be selected using the value "DisplayUploadAdGmailTeaserHeadline".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Headline string `xml:"headline,omitempty"`
+	Headline *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 headline,omitempty" json:"headline,omitempty"`
 
 	//
 	// Description of the teaser. Maximum display width is 90 characters.
 	// <span class="constraint Selectable">This field can be selected using the value "GmailTeaserDescription". This field can be selected using the value "DisplayUploadAdGmailTeaserDescription".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 
 	//
 	// Business name of the advertiser. Maximum display width is 20 characters.
 	// <span class="constraint Selectable">This field can be selected using the value "GmailTeaserBusinessName". This field can be selected using the value "DisplayUploadAdGmailTeaserBusinessName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	BusinessName string `xml:"businessName,omitempty"`
+	BusinessName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 businessName,omitempty" json:"businessName,omitempty"`
 
 	//
 	// Required. Logo image. An image must first be created using the MediaService, and Image.mediaId
@@ -4676,40 +4566,34 @@ type GmailTeaser struct {
 	// PNG. The minimum size is 144x144 and the aspect ratio must be 1:1 (+-1%). Required.
 	// <span class="constraint Selectable">This field can be selected using the value "GmailTeaserLogoImage". This field can be selected using the value "DisplayUploadAdGmailTeaserLogoImage".</span>
 	//
-	LogoImage *Image `xml:"logoImage,omitempty"`
+	LogoImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 logoImage,omitempty" json:"logoImage,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Image struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Image"`
-
 	*Media
 
 	//
 	// Raw image data.
 	//
-	Data []byte `xml:"data,omitempty"`
+	Data []byte `xml:"https://adwords.google.com/api/adwords/cm/v201802 data,omitempty" json:"data,omitempty"`
 }
 
 type ImageAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ImageAd"`
-
 	*Ad
 
 	//
 	// The image data for the ad.
 	//
-	Image *Image `xml:"image,omitempty"`
+	Image *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 image,omitempty" json:"image,omitempty"`
 
 	//
 	// The name label for this ad.
@@ -4717,7 +4601,7 @@ type ImageAd struct {
 	// This field is required and should not be {@code null}.</span>
 	// <span class="constraint Selectable">This field can be selected using the value "ImageCreativeName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// For ADD operations only: use this field to specify an existing
@@ -4727,86 +4611,76 @@ type ImageAd struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored
 	// when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	AdToCopyImageFrom int64 `xml:"adToCopyImageFrom,omitempty"`
+	AdToCopyImageFrom *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adToCopyImageFrom,omitempty" json:"adToCopyImageFrom,omitempty"`
 }
 
 type ImageError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ImageError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ImageErrorReason `xml:"reason,omitempty"`
+	Reason *ImageErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Label struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Label"`
-
 	//
 	// Id of label.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Name of label.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 80, inclusive.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Status of the label.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Status *LabelStatus `xml:"status,omitempty"`
+	Status *LabelStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// Attributes of the label.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	Attribute *LabelAttribute `xml:"attribute,omitempty"`
+	Attribute *LabelAttribute `xml:"https://adwords.google.com/api/adwords/cm/v201802 attribute,omitempty" json:"attribute,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Label.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	LabelType string `xml:"Label.Type,omitempty"`
+	LabelType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Label.Type,omitempty" json:"labelType,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type Media struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Media"`
-
 	//
 	// ID of this media object.
 	// <span class="constraint Selectable">This field can be selected using the value "MediaId".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	MediaId int64 `xml:"mediaId,omitempty"`
+	MediaId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 mediaId,omitempty" json:"mediaId,omitempty"`
 
 	//
 	// Type of media object. Required when using {@link MediaService#upload} to upload a new media
@@ -4814,56 +4688,56 @@ type Media struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Type".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	Type_ *MediaMediaType `xml:"type,omitempty"`
+	Type_ *MediaMediaType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Media reference ID key.
 	// <span class="constraint Selectable">This field can be selected using the value "ReferenceId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	ReferenceId int64 `xml:"referenceId,omitempty"`
+	ReferenceId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 referenceId,omitempty" json:"referenceId,omitempty"`
 
 	//
 	// Various dimension sizes for the media. Only applies to image media (and video media for
 	// video thumbnails).
 	// <span class="constraint Selectable">This field can be selected using the value "Dimensions".</span>
 	//
-	Dimensions []*Media_Size_DimensionsMapEntry `xml:"dimensions,omitempty"`
+	Dimensions []*Media_Size_DimensionsMapEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 dimensions,omitempty" json:"dimensions,omitempty"`
 
 	//
 	// URLs pointing to the resized media for the given sizes. Only applies to image media.
 	// <span class="constraint Selectable">This field can be selected using the value "Urls".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Urls []*Media_Size_StringMapEntry `xml:"urls,omitempty"`
+	Urls []*Media_Size_StringMapEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 urls,omitempty" json:"urls,omitempty"`
 
 	//
 	// The mime type of the media.
 	// <span class="constraint Selectable">This field can be selected using the value "MimeType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	MimeType *MediaMimeType `xml:"mimeType,omitempty"`
+	MimeType *MediaMimeType `xml:"https://adwords.google.com/api/adwords/cm/v201802 mimeType,omitempty" json:"mimeType,omitempty"`
 
 	//
 	// The URL of where the original media was downloaded from (or a file name).
 	// <span class="constraint Selectable">This field can be selected using the value "SourceUrl".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	SourceUrl string `xml:"sourceUrl,omitempty"`
+	SourceUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 sourceUrl,omitempty" json:"sourceUrl,omitempty"`
 
 	//
 	// The name of the media. The name can be used by clients to
 	// help identify previously uploaded media.
 	// <span class="constraint Selectable">This field can be selected using the value "Name".</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// The size of the media file in bytes.
 	// <span class="constraint Selectable">This field can be selected using the value "FileSize".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	FileSize int64 `xml:"fileSize,omitempty"`
+	FileSize *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 fileSize,omitempty" json:"fileSize,omitempty"`
 
 	//
 	// Media creation date in the format YYYY-MM-DD HH:MM:SS+TZ.
@@ -4871,31 +4745,29 @@ type Media struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CreationTime".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	CreationTime string `xml:"creationTime,omitempty"`
+	CreationTime *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 creationTime,omitempty" json:"creationTime,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Media.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	MediaType string `xml:"Media.Type,omitempty"`
+	MediaType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Media.Type,omitempty" json:"mediaType,omitempty"`
 }
 
 type MediaBundle struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MediaBundle"`
-
 	*Media
 
 	//
 	// Raw zipped data.
 	//
-	Data []byte `xml:"data,omitempty"`
+	Data []byte `xml:"https://adwords.google.com/api/adwords/cm/v201802 data,omitempty" json:"data,omitempty"`
 
 	//
 	// URL pointing to the data for the MediaBundle data.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	MediaBundleUrl string `xml:"mediaBundleUrl,omitempty"`
+	MediaBundleUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 mediaBundleUrl,omitempty" json:"mediaBundleUrl,omitempty"`
 
 	//
 	// Entry in the ZIP archive used to display the <code>MediaBundle</code> in an
@@ -4907,300 +4779,260 @@ type MediaBundle struct {
 	// an <code>Ad</code>, create a bundle and set the <code>mediaId</code> and
 	// <code>entryPoint</code> fields.
 	//
-	EntryPoint string `xml:"entryPoint,omitempty"`
+	EntryPoint *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 entryPoint,omitempty" json:"entryPoint,omitempty"`
 }
 
 type MediaBundleError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MediaBundleError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *MediaBundleErrorReason `xml:"reason,omitempty"`
+	Reason *MediaBundleErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type MediaError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MediaError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *MediaErrorReason `xml:"reason,omitempty"`
+	Reason *MediaErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Media_Size_DimensionsMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Media_Size_DimensionsMapEntry"`
-
-	Key *MediaSize `xml:"key,omitempty"`
+	Key *MediaSize `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
-	Value *Dimensions `xml:"value,omitempty"`
+	Value *Dimensions `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type Media_Size_StringMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Media_Size_StringMapEntry"`
+	Key *MediaSize `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
-	Key *MediaSize `xml:"key,omitempty"`
-
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type NewEntityCreationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NewEntityCreationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NewEntityCreationErrorReason `xml:"reason,omitempty"`
+	Reason *NewEntityCreationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type PagingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PagingError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *PagingErrorReason `xml:"reason,omitempty"`
+	Reason *PagingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type PolicyTopicConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyTopicConstraint"`
-
-	ConstraintType *PolicyTopicConstraintPolicyTopicConstraintType `xml:"constraintType,omitempty"`
+	ConstraintType *PolicyTopicConstraintPolicyTopicConstraintType `xml:"https://adwords.google.com/api/adwords/cm/v201802 constraintType,omitempty" json:"constraintType,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of PolicyTopicConstraint.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PolicyTopicConstraintType string `xml:"PolicyTopicConstraint.Type,omitempty"`
+	PolicyTopicConstraintType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyTopicConstraint.Type,omitempty" json:"policyTopicConstraintType,omitempty"`
 }
 
 type PolicyTopicEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyTopicEntry"`
-
 	//
 	// The type of the policy topic entry.
 	//
-	PolicyTopicEntryType *PolicyTopicEntryType `xml:"policyTopicEntryType,omitempty"`
+	PolicyTopicEntryType *PolicyTopicEntryType `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicEntryType,omitempty" json:"policyTopicEntryType,omitempty"`
 
 	//
 	// The policy topic evidences associated with this policy topic entry.
 	//
-	PolicyTopicEvidences []*PolicyTopicEvidence `xml:"policyTopicEvidences,omitempty"`
+	PolicyTopicEvidences []*PolicyTopicEvidence `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicEvidences,omitempty" json:"policyTopicEvidences,omitempty"`
 
 	//
 	// The targeting constraints to which this PolicyTopicEntry is related.
 	//
-	PolicyTopicConstraints []*PolicyTopicConstraint `xml:"policyTopicConstraints,omitempty"`
+	PolicyTopicConstraints []*PolicyTopicConstraint `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicConstraints,omitempty" json:"policyTopicConstraints,omitempty"`
 
 	//
 	// The policy topic id.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PolicyTopicId string `xml:"policyTopicId,omitempty"`
+	PolicyTopicId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicId,omitempty" json:"policyTopicId,omitempty"`
 
 	//
 	// The policy topic name (in English).
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PolicyTopicName string `xml:"policyTopicName,omitempty"`
+	PolicyTopicName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicName,omitempty" json:"policyTopicName,omitempty"`
 
 	//
 	// URL of the help center article describing this policy topic entry.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PolicyTopicHelpCenterUrl string `xml:"policyTopicHelpCenterUrl,omitempty"`
+	PolicyTopicHelpCenterUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicHelpCenterUrl,omitempty" json:"policyTopicHelpCenterUrl,omitempty"`
 }
 
 type PolicyTopicEvidence struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyTopicEvidence"`
-
 	//
 	// The type of evidence for the policy topic.
 	//
-	PolicyTopicEvidenceType *PolicyTopicEvidenceType `xml:"policyTopicEvidenceType,omitempty"`
+	PolicyTopicEvidenceType *PolicyTopicEvidenceType `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicEvidenceType,omitempty" json:"policyTopicEvidenceType,omitempty"`
 
 	//
 	// The actual evidence that triggered this policy topic to be reported. This field is associated
 	// with the policyTopicEvidenceType. So for example, when policyTopicEvidenceType is AD_TEXT,
 	// the evidence is the texts associated with the Ad.
 	//
-	EvidenceTextList []string `xml:"evidenceTextList,omitempty"`
+	EvidenceTextList []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 evidenceTextList,omitempty" json:"evidenceTextList,omitempty"`
 }
 
 type PolicyViolationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationError"`
-
 	*ApiError
 
 	//
 	// Unique identifier for the violation.
 	//
-	Key *PolicyViolationKey `xml:"key,omitempty"`
+	Key *PolicyViolationKey `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// Name of policy suitable for display to users. In the user's preferred
 	// language.
 	//
-	ExternalPolicyName string `xml:"externalPolicyName,omitempty"`
+	ExternalPolicyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyName,omitempty" json:"externalPolicyName,omitempty"`
 
 	//
 	// Url with writeup about the policy.
 	//
-	ExternalPolicyUrl string `xml:"externalPolicyUrl,omitempty"`
+	ExternalPolicyUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyUrl,omitempty" json:"externalPolicyUrl,omitempty"`
 
 	//
 	// Localized description of the violation.
 	//
-	ExternalPolicyDescription string `xml:"externalPolicyDescription,omitempty"`
+	ExternalPolicyDescription *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyDescription,omitempty" json:"externalPolicyDescription,omitempty"`
 
 	//
 	// Whether user can file an exemption request for this violation.
 	//
-	IsExemptable bool `xml:"isExemptable,omitempty"`
+	IsExemptable *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isExemptable,omitempty" json:"isExemptable,omitempty"`
 
 	//
 	// Lists the parts that violate the policy.
 	//
-	ViolatingParts []*PolicyViolationErrorPart `xml:"violatingParts,omitempty"`
+	ViolatingParts []*PolicyViolationErrorPart `xml:"https://adwords.google.com/api/adwords/cm/v201802 violatingParts,omitempty" json:"violatingParts,omitempty"`
 }
 
 type PolicyViolationErrorPart struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationError.Part"`
-
 	//
 	// Index of the starting position of the violating text within the line.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 
 	//
 	// The length of the violating text.
 	//
-	Length int32 `xml:"length,omitempty"`
+	Length *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 length,omitempty" json:"length,omitempty"`
 }
 
 type PolicyViolationKey struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationKey"`
-
 	//
 	// Unique id of the violated policy.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	PolicyName string `xml:"policyName,omitempty"`
+	PolicyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyName,omitempty" json:"policyName,omitempty"`
 
 	//
 	// The text that violates the policy if specified. Otherwise, refers to the
@@ -5210,12 +5042,10 @@ type PolicyViolationKey struct {
 	// May be null for criterion exemptions, in which case this refers to the
 	// whole policy. Must be specified for ad exemptions.
 	//
-	ViolatingText string `xml:"violatingText,omitempty"`
+	ViolatingText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 violatingText,omitempty" json:"violatingText,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -5223,13 +5053,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -5238,143 +5068,119 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type ProductAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductAd"`
-
 	*Ad
 }
 
 type ProductImage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductImage"`
-
 	//
 	// Product image. An image must first be created using the MediaService, and Image.mediaId must be
 	// populated when creating a {@link "ProductImage"}. Valid image types are GIF, JPEG, and PNG. The
 	// minimum size is 300x300 and the aspect ratio must be 1:1 (+-1%).
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	ProductImage *Image `xml:"productImage,omitempty"`
+	ProductImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 productImage,omitempty" json:"productImage,omitempty"`
 
 	//
 	// Description of the product. Maximum display width is 15 characters.
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 
 	//
 	// Display-call-to-action of the product image. The DisplayCallToAction.textColor field cannot be
 	// set when setting this field.
 	//
-	DisplayCallToAction *DisplayCallToAction `xml:"displayCallToAction,omitempty"`
+	DisplayCallToAction *DisplayCallToAction `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayCallToAction,omitempty" json:"displayCallToAction,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ResellerConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResellerConstraint"`
-
 	*PolicyTopicConstraint
 }
 
 type ResponsiveDisplayAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponsiveDisplayAd"`
-
 	*Ad
 
 	//
@@ -5386,7 +5192,7 @@ type ResponsiveDisplayAd struct {
 	// <span class="constraint Selectable">This field can be selected using the value "MarketingImage".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MarketingImage *Image `xml:"marketingImage,omitempty"`
+	MarketingImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 marketingImage,omitempty" json:"marketingImage,omitempty"`
 
 	//
 	// Logo image to be used in the ad. This ad format does not allow the creation of an image using
@@ -5395,7 +5201,7 @@ type ResponsiveDisplayAd struct {
 	// JPEG, and PNG. The minimum size is 128x128 and the aspect ratio must be 1:1 (+-1%).
 	// <span class="constraint Selectable">This field can be selected using the value "LogoImage".</span>
 	//
-	LogoImage *Image `xml:"logoImage,omitempty"`
+	LogoImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 logoImage,omitempty" json:"logoImage,omitempty"`
 
 	//
 	// Square marketing image to be used in the ad. This image may be used when a square aspect ratio
@@ -5406,28 +5212,28 @@ type ResponsiveDisplayAd struct {
 	// the aspect ratio must be 1:1 (+-1%).
 	// <span class="constraint Selectable">This field can be selected using the value "SquareMarketingImage".</span>
 	//
-	SquareMarketingImage *Image `xml:"squareMarketingImage,omitempty"`
+	SquareMarketingImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 squareMarketingImage,omitempty" json:"squareMarketingImage,omitempty"`
 
 	//
 	// Short format of the headline of the ad. Maximum display width is 25.
 	// <span class="constraint Selectable">This field can be selected using the value "ShortHeadline".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	ShortHeadline string `xml:"shortHeadline,omitempty"`
+	ShortHeadline *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 shortHeadline,omitempty" json:"shortHeadline,omitempty"`
 
 	//
 	// Long format of the headline of the ad. Maximum display width is 90.
 	// <span class="constraint Selectable">This field can be selected using the value "LongHeadline".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	LongHeadline string `xml:"longHeadline,omitempty"`
+	LongHeadline *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 longHeadline,omitempty" json:"longHeadline,omitempty"`
 
 	//
 	// The descriptive text of the ad. Maximum display width is 90.
 	// <span class="constraint Selectable">This field can be selected using the value "Description".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 
 	//
 	// The business name. Maximum display width is 25. <span class="constraint Required">This field is
@@ -5435,21 +5241,21 @@ type ResponsiveDisplayAd struct {
 	// ADD.</span>
 	// <span class="constraint Selectable">This field can be selected using the value "BusinessName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	BusinessName string `xml:"businessName,omitempty"`
+	BusinessName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 businessName,omitempty" json:"businessName,omitempty"`
 
 	//
 	// Main color. In hexadecimal, e.g. #ffffff for white. If one of mainColor and accentColor is set,
 	// the other is required as well.
 	// <span class="constraint Selectable">This field can be selected using the value "MainColor".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	MainColor string `xml:"mainColor,omitempty"`
+	MainColor *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 mainColor,omitempty" json:"mainColor,omitempty"`
 
 	//
 	// Accent color. In hexadecimal, e.g. #ffffff for white. If one of mainColor and accentColor is
 	// set, the other is required as well.
 	// <span class="constraint Selectable">This field can be selected using the value "AccentColor".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AccentColor string `xml:"accentColor,omitempty"`
+	AccentColor *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 accentColor,omitempty" json:"accentColor,omitempty"`
 
 	//
 	// Advertiser?s consent to allow flexible color. When true, we may serve the ad with different
@@ -5457,29 +5263,27 @@ type ResponsiveDisplayAd struct {
 	// Must be true if mainColor and accentColor are not set. The default value is true.
 	// <span class="constraint Selectable">This field can be selected using the value "AllowFlexibleColor".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AllowFlexibleColor bool `xml:"allowFlexibleColor,omitempty"`
+	AllowFlexibleColor *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 allowFlexibleColor,omitempty" json:"allowFlexibleColor,omitempty"`
 
 	//
 	// Call to action text. Valid texts: https://support.google.com/adwords/answer/7005917
 	// <span class="constraint Selectable">This field can be selected using the value "CallToActionText".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CallToActionText string `xml:"callToActionText,omitempty"`
+	CallToActionText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 callToActionText,omitempty" json:"callToActionText,omitempty"`
 
 	//
 	// Settings for serving dynamic ResponsiveDisplayAd.
 	//
-	DynamicDisplayAdSettings *DynamicSettings `xml:"dynamicDisplayAdSettings,omitempty"`
+	DynamicDisplayAdSettings *DynamicSettings `xml:"https://adwords.google.com/api/adwords/cm/v201802 dynamicDisplayAdSettings,omitempty" json:"dynamicDisplayAdSettings,omitempty"`
 
 	//
 	// Specifies which format the ad will be served in. The default value is ALL_FORMATS.
 	// <span class="constraint Selectable">This field can be selected using the value "FormatSetting".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	FormatSetting *DisplayAdFormatSetting `xml:"formatSetting,omitempty"`
+	FormatSetting *DisplayAdFormatSetting `xml:"https://adwords.google.com/api/adwords/cm/v201802 formatSetting,omitempty" json:"formatSetting,omitempty"`
 }
 
 type RichMediaAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RichMediaAd"`
-
 	*Ad
 
 	//
@@ -5487,7 +5291,7 @@ type RichMediaAd struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint Selectable">This field can be selected using the value "RichMediaAdName".</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Dimensions (height and width) of the ad.
@@ -5497,27 +5301,27 @@ type RichMediaAd struct {
 	// ThirdPartyRedirectAd; instead, the size is specified in the publisher
 	// front end when creating a mediation chain.
 	//
-	Dimensions *Dimensions `xml:"dimensions,omitempty"`
+	Dimensions *Dimensions `xml:"https://adwords.google.com/api/adwords/cm/v201802 dimensions,omitempty" json:"dimensions,omitempty"`
 
 	//
 	// Snippet for this ad. Required for standard third-party ads.
 	// <p>The length of the string should be between 1 and 3072, inclusive.
 	// <span class="constraint Selectable">This field can be selected using the value "RichMediaAdSnippet".</span>
 	//
-	Snippet string `xml:"snippet,omitempty"`
+	Snippet *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 snippet,omitempty" json:"snippet,omitempty"`
 
 	//
 	// Impression beacon URL for the ad.
 	// <span class="constraint Selectable">This field can be selected using the value "RichMediaAdImpressionBeaconUrl".</span>
 	//
-	ImpressionBeaconUrl string `xml:"impressionBeaconUrl,omitempty"`
+	ImpressionBeaconUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 impressionBeaconUrl,omitempty" json:"impressionBeaconUrl,omitempty"`
 
 	//
 	// Duration for the ad (in milliseconds). Default is 0.
 	// <span class="constraint Selectable">This field can be selected using the value "RichMediaAdDuration".</span>
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	AdDuration int32 `xml:"adDuration,omitempty"`
+	AdDuration *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adDuration,omitempty" json:"adDuration,omitempty"`
 
 	//
 	// <a href="/adwords/api/docs/appendix/richmediacodes">
@@ -5525,7 +5329,7 @@ type RichMediaAd struct {
 	// <span class="constraint Selectable">This field can be selected using the value "RichMediaAdCertifiedVendorFormatId".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	CertifiedVendorFormatId int64 `xml:"certifiedVendorFormatId,omitempty"`
+	CertifiedVendorFormatId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 certifiedVendorFormatId,omitempty" json:"certifiedVendorFormatId,omitempty"`
 
 	//
 	// SourceUrl pointing to the third party snippet.
@@ -5533,23 +5337,21 @@ type RichMediaAd struct {
 	// it stores the InRed URL.
 	// <span class="constraint Selectable">This field can be selected using the value "RichMediaAdSourceUrl".</span>
 	//
-	SourceUrl string `xml:"sourceUrl,omitempty"`
+	SourceUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 sourceUrl,omitempty" json:"sourceUrl,omitempty"`
 
 	//
 	// Type of this rich media ad, the default is Standard.
 	// <span class="constraint Selectable">This field can be selected using the value "RichMediaAdType".</span>
 	//
-	RichMediaAdType *RichMediaAdRichMediaAdType `xml:"richMediaAdType,omitempty"`
+	RichMediaAdType *RichMediaAdRichMediaAdType `xml:"https://adwords.google.com/api/adwords/cm/v201802 richMediaAdType,omitempty" json:"richMediaAdType,omitempty"`
 
 	//
 	// A list of attributes that describe the rich media ad capabilities.
 	//
-	AdAttributes []*RichMediaAdAdAttribute `xml:"adAttributes,omitempty"`
+	AdAttributes []*RichMediaAdAdAttribute `xml:"https://adwords.google.com/api/adwords/cm/v201802 adAttributes,omitempty" json:"adAttributes,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -5560,13 +5362,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -5575,59 +5377,55 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ShowcaseAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ShowcaseAd"`
-
 	*Ad
 
 	//
 	// The name label for this ad.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Headline displayed in the Showcase shopping ad.
 	//
-	Headline string `xml:"headline,omitempty"`
+	Headline *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 headline,omitempty" json:"headline,omitempty"`
 
 	//
 	// Description displayed in the expanded view of the Showcase shopping ad.
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 
 	//
 	// Image displayed in the collapsed view of the Showcase shopping ad.
 	// <p>The format of the image must be either JPEG or PNG and the size of the image must be
 	// 270x270 px.
 	//
-	CollapsedImage *Image `xml:"collapsedImage,omitempty"`
+	CollapsedImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 collapsedImage,omitempty" json:"collapsedImage,omitempty"`
 
 	//
 	// Image displayed in the expanded view of the Showcase shopping ad.
@@ -5635,35 +5433,33 @@ type ShowcaseAd struct {
 	// 1080x566 px.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	ExpandedImage *Image `xml:"expandedImage,omitempty"`
+	ExpandedImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 expandedImage,omitempty" json:"expandedImage,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -5671,12 +5467,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -5684,94 +5480,82 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StatsQueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StatsQueryError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StatsQueryErrorReason `xml:"reason,omitempty"`
+	Reason *StatsQueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type String_StringMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 String_StringMapEntry"`
-
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type TempAdUnionId struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TempAdUnionId"`
-
 	*AdUnionId
 }
 
 type TemplateAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TemplateAd"`
-
 	*Ad
 
 	//
 	// ID of the template to use.
 	// <span class="constraint Selectable">This field can be selected using the value "TemplateId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TemplateId int64 `xml:"templateId,omitempty"`
+	TemplateId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 templateId,omitempty" json:"templateId,omitempty"`
 
 	//
 	// Group ID of all template ads, which should be created together.
@@ -5780,7 +5564,7 @@ type TemplateAd struct {
 	// an ad union with only one ad, no union will be created.
 	// <span class="constraint Selectable">This field can be selected using the value "TemplateAdUnionId".</span>
 	//
-	AdUnionId *AdUnionId `xml:"adUnionId,omitempty"`
+	AdUnionId *AdUnionId `xml:"https://adwords.google.com/api/adwords/cm/v201802 adUnionId,omitempty" json:"adUnionId,omitempty"`
 
 	//
 	// List of elements (each containing a set of fields) for the template
@@ -5788,17 +5572,17 @@ type TemplateAd struct {
 	// <a href="/adwords/api/docs/appendix/templateads">Template
 	// Ads</a> for the elements and fields required for each template.
 	//
-	TemplateElements []*TemplateElement `xml:"templateElements,omitempty"`
+	TemplateElements []*TemplateElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 templateElements,omitempty" json:"templateElements,omitempty"`
 
 	//
 	// The template ad rendered as an image.
 	//
-	AdAsImage *Image `xml:"adAsImage,omitempty"`
+	AdAsImage *Image `xml:"https://adwords.google.com/api/adwords/cm/v201802 adAsImage,omitempty" json:"adAsImage,omitempty"`
 
 	//
 	// The dimensions for this ad.
 	//
-	Dimensions *Dimensions `xml:"dimensions,omitempty"`
+	Dimensions *Dimensions `xml:"https://adwords.google.com/api/adwords/cm/v201802 dimensions,omitempty" json:"dimensions,omitempty"`
 
 	//
 	// Name of this ad.
@@ -5806,56 +5590,52 @@ type TemplateAd struct {
 	// This field is required and should not be {@code null}.</span>
 	// <span class="constraint Selectable">This field can be selected using the value "TemplateAdName".</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Duration of this ad (if it contains playable media).
 	// <span class="constraint Selectable">This field can be selected using the value "TemplateAdDuration".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Duration int32 `xml:"duration,omitempty"`
+	Duration *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 duration,omitempty" json:"duration,omitempty"`
 
 	//
 	// For copies, the ad id of the ad this was or should be copied from.
 	// <span class="constraint Selectable">This field can be selected using the value "TemplateOriginAdId".</span>
 	//
-	OriginAdId int64 `xml:"originAdId,omitempty"`
+	OriginAdId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 originAdId,omitempty" json:"originAdId,omitempty"`
 }
 
 type TemplateElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TemplateElement"`
-
 	//
 	// Unique name for this element.
 	// <span class="constraint Selectable">This field can be selected using the value "UniqueName".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	UniqueName string `xml:"uniqueName,omitempty"`
+	UniqueName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 uniqueName,omitempty" json:"uniqueName,omitempty"`
 
 	//
 	// List of fields to use for this template element.
 	// These must be the same for all template ads in the same template ad union.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []*TemplateElementField `xml:"fields,omitempty"`
+	Fields []*TemplateElementField `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 }
 
 type TemplateElementField struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TemplateElementField"`
-
 	//
 	// The name of this field.
 	// <span class="constraint Selectable">This field can be selected using the value "TemplateElementFieldName".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// The type of this field.
 	// <span class="constraint Selectable">This field can be selected using the value "TemplateElementFieldType".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Type_ *TemplateElementFieldType `xml:"type,omitempty"`
+	Type_ *TemplateElementFieldType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Text value for text field types. Null if not text field.
@@ -5863,42 +5643,38 @@ type TemplateElementField struct {
 	// or VISIBLE_URL.
 	// <span class="constraint Selectable">This field can be selected using the value "TemplateElementFieldText".</span>
 	//
-	FieldText string `xml:"fieldText,omitempty"`
+	FieldText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldText,omitempty" json:"fieldText,omitempty"`
 
 	//
 	// Media value for non-text field types. Null if a text field. This
 	// fields must be specified if fieldText is null.
 	//
-	FieldMedia *Media `xml:"fieldMedia,omitempty"`
+	FieldMedia *Media `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldMedia,omitempty" json:"fieldMedia,omitempty"`
 }
 
 type TextAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TextAd"`
-
 	*Ad
 
 	//
 	// The headline of the ad.
 	// <span class="constraint Selectable">This field can be selected using the value "Headline".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Headline string `xml:"headline,omitempty"`
+	Headline *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 headline,omitempty" json:"headline,omitempty"`
 
 	//
 	// The first description line.
 	// <span class="constraint Selectable">This field can be selected using the value "Description1".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Description1 string `xml:"description1,omitempty"`
+	Description1 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description1,omitempty" json:"description1,omitempty"`
 
 	//
 	// The second description line.
 	// <span class="constraint Selectable">This field can be selected using the value "Description2".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Description2 string `xml:"description2,omitempty"`
+	Description2 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description2,omitempty" json:"description2,omitempty"`
 }
 
 type ThirdPartyRedirectAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ThirdPartyRedirectAd"`
-
 	*RichMediaAd
 
 	//
@@ -5907,27 +5683,27 @@ type ThirdPartyRedirectAd struct {
 	// <span class="constraint Selectable">This field can be selected using the value "IsCookieTargeted".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	IsCookieTargeted bool `xml:"isCookieTargeted,omitempty"`
+	IsCookieTargeted *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isCookieTargeted,omitempty" json:"isCookieTargeted,omitempty"`
 
 	//
 	// Defines whether or not the ad is targeting user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "IsUserInterestTargeted".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	IsUserInterestTargeted bool `xml:"isUserInterestTargeted,omitempty"`
+	IsUserInterestTargeted *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isUserInterestTargeted,omitempty" json:"isUserInterestTargeted,omitempty"`
 
 	//
 	// Defines whether or not the ad contains a tracking pixel of any kind.
 	// <span class="constraint Selectable">This field can be selected using the value "IsTagged".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	IsTagged bool `xml:"isTagged,omitempty"`
+	IsTagged *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isTagged,omitempty" json:"isTagged,omitempty"`
 
 	//
 	// Video Types of the ad. (RealMedia, Quick Time etc.)
 	// <span class="constraint Selectable">This field can be selected using the value "VideoTypes".</span>
 	//
-	VideoTypes []*VideoType `xml:"videoTypes,omitempty"`
+	VideoTypes []*VideoType `xml:"https://adwords.google.com/api/adwords/cm/v201802 videoTypes,omitempty" json:"videoTypes,omitempty"`
 
 	//
 	// Allowed expanding directions. These directions are used to match
@@ -5935,18 +5711,14 @@ type ThirdPartyRedirectAd struct {
 	// right, only ads with EXPANDING_RIGHT specified will show up there.
 	// <span class="constraint Selectable">This field can be selected using the value "ExpandingDirections".</span>
 	//
-	ExpandingDirections []*ThirdPartyRedirectAdExpandingDirection `xml:"expandingDirections,omitempty"`
+	ExpandingDirections []*ThirdPartyRedirectAdExpandingDirection `xml:"https://adwords.google.com/api/adwords/cm/v201802 expandingDirections,omitempty" json:"expandingDirections,omitempty"`
 }
 
 type UniversalShoppingAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UniversalShoppingAd"`
-
 	*Ad
 }
 
 type UrlData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlData"`
-
 	//
 	// Unique identifier for this instance of UrlData. Refer to the
 	// <a href="https://developers.google.com/adwords/api/docs/appendix/templateads">Template
@@ -5954,75 +5726,69 @@ type UrlData struct {
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	UrlId string `xml:"urlId,omitempty"`
+	UrlId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 urlId,omitempty" json:"urlId,omitempty"`
 
 	//
 	// A list of final landing page urls.
 	//
-	FinalUrls *UrlList `xml:"finalUrls,omitempty"`
+	FinalUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrls,omitempty" json:"finalUrls,omitempty"`
 
 	//
 	// A list of final mobile landing page urls.
 	//
-	FinalMobileUrls *UrlList `xml:"finalMobileUrls,omitempty"`
+	FinalMobileUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalMobileUrls,omitempty" json:"finalMobileUrls,omitempty"`
 
 	//
 	// URL template for constructing a tracking URL.
 	//
-	TrackingUrlTemplate string `xml:"trackingUrlTemplate,omitempty"`
+	TrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
 }
 
 type UrlError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *UrlErrorReason `xml:"reason,omitempty"`
+	Reason *UrlErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type UrlList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlList"`
-
 	//
 	// List of URLs.  On SET operation, empty list indicates to clear the list.
 	// <span class="constraint CollectionSize">The maximum size of this collection is 10.</span>
 	// <span class="constraint ContentsStringLength">Strings in this field must be non-empty (trimmed).</span>
 	//
-	Urls []string `xml:"urls,omitempty"`
+	Urls []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 urls,omitempty" json:"urls,omitempty"`
 }
 
 type Video struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Video"`
-
 	*Media
 
 	//
 	// The duration of the associated video, in milliseconds.
 	// <span class="constraint Selectable">This field can be selected using the value "DurationMillis".</span>
 	//
-	DurationMillis int64 `xml:"durationMillis,omitempty"`
+	DurationMillis *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 durationMillis,omitempty" json:"durationMillis,omitempty"`
 
 	//
 	// Streaming URL for the video.
 	// <span class="constraint Selectable">This field can be selected using the value "StreamingUrl".</span>
 	//
-	StreamingUrl string `xml:"streamingUrl,omitempty"`
+	StreamingUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 streamingUrl,omitempty" json:"streamingUrl,omitempty"`
 
 	//
 	// Indicates whether the video is ready to play on the web.
 	// <span class="constraint Selectable">This field can be selected using the value "ReadyToPlayOnTheWeb".</span>
 	//
-	ReadyToPlayOnTheWeb bool `xml:"readyToPlayOnTheWeb,omitempty"`
+	ReadyToPlayOnTheWeb *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 readyToPlayOnTheWeb,omitempty" json:"readyToPlayOnTheWeb,omitempty"`
 
 	//
 	// The Industry Standard Commercial Identifier code for this media, used
 	// mainly for television commercials.
 	// <span class="constraint Selectable">This field can be selected using the value "IndustryStandardCommercialIdentifier".</span>
 	//
-	IndustryStandardCommercialIdentifier string `xml:"industryStandardCommercialIdentifier,omitempty"`
+	IndustryStandardCommercialIdentifier *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 industryStandardCommercialIdentifier,omitempty" json:"industryStandardCommercialIdentifier,omitempty"`
 
 	//
 	// The Advertising Digital Identification code for this media, as defined by
@@ -6030,32 +5796,30 @@ type Video struct {
 	// television commercials.
 	// <span class="constraint Selectable">This field can be selected using the value "AdvertisingId".</span>
 	//
-	AdvertisingId string `xml:"advertisingId,omitempty"`
+	AdvertisingId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 advertisingId,omitempty" json:"advertisingId,omitempty"`
 
 	//
 	// For YouTube-hosted videos, the YouTube video ID (as seen in YouTube URLs)
 	// may also be filled in.
 	// <span class="constraint Selectable">This field can be selected using the value "YouTubeVideoIdString".</span>
 	//
-	YouTubeVideoIdString string `xml:"youTubeVideoIdString,omitempty"`
+	YouTubeVideoIdString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 youTubeVideoIdString,omitempty" json:"youTubeVideoIdString,omitempty"`
 }
 
 type DynamicSearchAd struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DynamicSearchAd"`
-
 	*Ad
 
 	//
 	// The first description line.
 	// <span class="constraint Selectable">This field can be selected using the value "Description1".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Description1 string `xml:"description1,omitempty"`
+	Description1 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description1,omitempty" json:"description1,omitempty"`
 
 	//
 	// The second description line.
 	// <span class="constraint Selectable">This field can be selected using the value "Description2".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Description2 string `xml:"description2,omitempty"`
+	Description2 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description2,omitempty" json:"description2,omitempty"`
 }
 
 type AdGroupAdServiceInterface struct {
@@ -0,0 +1,1760 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupAdService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateLabel) Copy() *MutateLabel {
+	return deep.Copy(t).(*MutateLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateLabel) Equal(o *MutateLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateLabelResponse) Copy() *MutateLabelResponse {
+	return deep.Copy(t).(*MutateLabelResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateLabelResponse) Equal(o *MutateLabelResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Ad) Copy() *Ad {
+	return deep.Copy(t).(*Ad)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Ad) Equal(o *Ad) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdCustomizerError) Copy() *AdCustomizerError {
+	return deep.Copy(t).(*AdCustomizerError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdCustomizerError) Equal(o *AdCustomizerError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdError) Copy() *AdError {
+	return deep.Copy(t).(*AdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdError) Equal(o *AdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAd) Copy() *AdGroupAd {
+	return deep.Copy(t).(*AdGroupAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAd) Equal(o *AdGroupAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdCountLimitExceeded) Copy() *AdGroupAdCountLimitExceeded {
+	return deep.Copy(t).(*AdGroupAdCountLimitExceeded)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdCountLimitExceeded) Equal(o *AdGroupAdCountLimitExceeded) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdError) Copy() *AdGroupAdError {
+	return deep.Copy(t).(*AdGroupAdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdError) Equal(o *AdGroupAdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdLabel) Copy() *AdGroupAdLabel {
+	return deep.Copy(t).(*AdGroupAdLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdLabel) Equal(o *AdGroupAdLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdLabelOperation) Copy() *AdGroupAdLabelOperation {
+	return deep.Copy(t).(*AdGroupAdLabelOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdLabelOperation) Equal(o *AdGroupAdLabelOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdLabelReturnValue) Copy() *AdGroupAdLabelReturnValue {
+	return deep.Copy(t).(*AdGroupAdLabelReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdLabelReturnValue) Equal(o *AdGroupAdLabelReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdOperation) Copy() *AdGroupAdOperation {
+	return deep.Copy(t).(*AdGroupAdOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdOperation) Equal(o *AdGroupAdOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdPage) Copy() *AdGroupAdPage {
+	return deep.Copy(t).(*AdGroupAdPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdPage) Equal(o *AdGroupAdPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdPolicySummary) Copy() *AdGroupAdPolicySummary {
+	return deep.Copy(t).(*AdGroupAdPolicySummary)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdPolicySummary) Equal(o *AdGroupAdPolicySummary) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdReturnValue) Copy() *AdGroupAdReturnValue {
+	return deep.Copy(t).(*AdGroupAdReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdReturnValue) Equal(o *AdGroupAdReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdSharingError) Copy() *AdSharingError {
+	return deep.Copy(t).(*AdSharingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdSharingError) Equal(o *AdSharingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdUnionId) Copy() *AdUnionId {
+	return deep.Copy(t).(*AdUnionId)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdUnionId) Equal(o *AdUnionId) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdxError) Copy() *AdxError {
+	return deep.Copy(t).(*AdxError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdxError) Equal(o *AdxError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AppUrl) Copy() *AppUrl {
+	return deep.Copy(t).(*AppUrl)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AppUrl) Equal(o *AppUrl) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LabelAttribute) Copy() *LabelAttribute {
+	return deep.Copy(t).(*LabelAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LabelAttribute) Equal(o *LabelAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Audio) Copy() *Audio {
+	return deep.Copy(t).(*Audio)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Audio) Equal(o *Audio) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CallOnlyAd) Copy() *CallOnlyAd {
+	return deep.Copy(t).(*CallOnlyAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CallOnlyAd) Equal(o *CallOnlyAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TextLabel) Copy() *TextLabel {
+	return deep.Copy(t).(*TextLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TextLabel) Equal(o *TextLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DisplayAttribute) Copy() *DisplayAttribute {
+	return deep.Copy(t).(*DisplayAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DisplayAttribute) Equal(o *DisplayAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CertificateDomainMismatchInCountryConstraint) Copy() *CertificateDomainMismatchInCountryConstraint {
+	return deep.Copy(t).(*CertificateDomainMismatchInCountryConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CertificateDomainMismatchInCountryConstraint) Equal(o *CertificateDomainMismatchInCountryConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CertificateMissingConstraint) Copy() *CertificateMissingConstraint {
+	return deep.Copy(t).(*CertificateMissingConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CertificateMissingConstraint) Equal(o *CertificateMissingConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CertificateMissingInCountryConstraint) Copy() *CertificateMissingInCountryConstraint {
+	return deep.Copy(t).(*CertificateMissingInCountryConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CertificateMissingInCountryConstraint) Equal(o *CertificateMissingInCountryConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CountryConstraint) Copy() *CountryConstraint {
+	return deep.Copy(t).(*CountryConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CountryConstraint) Equal(o *CountryConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameter) Copy() *CustomParameter {
+	return deep.Copy(t).(*CustomParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameter) Equal(o *CustomParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameters) Copy() *CustomParameters {
+	return deep.Copy(t).(*CustomParameters)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameters) Equal(o *CustomParameters) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DeprecatedAd) Copy() *DeprecatedAd {
+	return deep.Copy(t).(*DeprecatedAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DeprecatedAd) Equal(o *DeprecatedAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Dimensions) Copy() *Dimensions {
+	return deep.Copy(t).(*Dimensions)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Dimensions) Equal(o *Dimensions) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DisplayCallToAction) Copy() *DisplayCallToAction {
+	return deep.Copy(t).(*DisplayCallToAction)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DisplayCallToAction) Equal(o *DisplayCallToAction) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DynamicSettings) Copy() *DynamicSettings {
+	return deep.Copy(t).(*DynamicSettings)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DynamicSettings) Equal(o *DynamicSettings) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityAccessDenied) Copy() *EntityAccessDenied {
+	return deep.Copy(t).(*EntityAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityAccessDenied) Equal(o *EntityAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityCountLimitExceeded) Copy() *EntityCountLimitExceeded {
+	return deep.Copy(t).(*EntityCountLimitExceeded)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityCountLimitExceeded) Equal(o *EntityCountLimitExceeded) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityNotFound) Copy() *EntityNotFound {
+	return deep.Copy(t).(*EntityNotFound)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityNotFound) Equal(o *EntityNotFound) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExemptionRequest) Copy() *ExemptionRequest {
+	return deep.Copy(t).(*ExemptionRequest)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExemptionRequest) Equal(o *ExemptionRequest) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExpandedDynamicSearchAd) Copy() *ExpandedDynamicSearchAd {
+	return deep.Copy(t).(*ExpandedDynamicSearchAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExpandedDynamicSearchAd) Equal(o *ExpandedDynamicSearchAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExpandedTextAd) Copy() *ExpandedTextAd {
+	return deep.Copy(t).(*ExpandedTextAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExpandedTextAd) Equal(o *ExpandedTextAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedAttributeReferenceError) Copy() *FeedAttributeReferenceError {
+	return deep.Copy(t).(*FeedAttributeReferenceError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedAttributeReferenceError) Equal(o *FeedAttributeReferenceError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ForwardCompatibilityError) Copy() *ForwardCompatibilityError {
+	return deep.Copy(t).(*ForwardCompatibilityError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ForwardCompatibilityError) Equal(o *ForwardCompatibilityError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FunctionError) Copy() *FunctionError {
+	return deep.Copy(t).(*FunctionError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FunctionError) Equal(o *FunctionError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FunctionParsingError) Copy() *FunctionParsingError {
+	return deep.Copy(t).(*FunctionParsingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FunctionParsingError) Equal(o *FunctionParsingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GmailAd) Copy() *GmailAd {
+	return deep.Copy(t).(*GmailAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GmailAd) Equal(o *GmailAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GmailTeaser) Copy() *GmailTeaser {
+	return deep.Copy(t).(*GmailTeaser)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GmailTeaser) Equal(o *GmailTeaser) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Image) Copy() *Image {
+	return deep.Copy(t).(*Image)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Image) Equal(o *Image) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ImageAd) Copy() *ImageAd {
+	return deep.Copy(t).(*ImageAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ImageAd) Equal(o *ImageAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ImageError) Copy() *ImageError {
+	return deep.Copy(t).(*ImageError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ImageError) Equal(o *ImageError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Label) Copy() *Label {
+	return deep.Copy(t).(*Label)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Label) Equal(o *Label) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Media) Copy() *Media {
+	return deep.Copy(t).(*Media)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Media) Equal(o *Media) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MediaBundle) Copy() *MediaBundle {
+	return deep.Copy(t).(*MediaBundle)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MediaBundle) Equal(o *MediaBundle) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MediaBundleError) Copy() *MediaBundleError {
+	return deep.Copy(t).(*MediaBundleError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MediaBundleError) Equal(o *MediaBundleError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MediaError) Copy() *MediaError {
+	return deep.Copy(t).(*MediaError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MediaError) Equal(o *MediaError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Media_Size_DimensionsMapEntry) Copy() *Media_Size_DimensionsMapEntry {
+	return deep.Copy(t).(*Media_Size_DimensionsMapEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Media_Size_DimensionsMapEntry) Equal(o *Media_Size_DimensionsMapEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Media_Size_StringMapEntry) Copy() *Media_Size_StringMapEntry {
+	return deep.Copy(t).(*Media_Size_StringMapEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Media_Size_StringMapEntry) Equal(o *Media_Size_StringMapEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NewEntityCreationError) Copy() *NewEntityCreationError {
+	return deep.Copy(t).(*NewEntityCreationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NewEntityCreationError) Equal(o *NewEntityCreationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PagingError) Copy() *PagingError {
+	return deep.Copy(t).(*PagingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PagingError) Equal(o *PagingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyTopicConstraint) Copy() *PolicyTopicConstraint {
+	return deep.Copy(t).(*PolicyTopicConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyTopicConstraint) Equal(o *PolicyTopicConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyTopicEntry) Copy() *PolicyTopicEntry {
+	return deep.Copy(t).(*PolicyTopicEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyTopicEntry) Equal(o *PolicyTopicEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyTopicEvidence) Copy() *PolicyTopicEvidence {
+	return deep.Copy(t).(*PolicyTopicEvidence)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyTopicEvidence) Equal(o *PolicyTopicEvidence) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyViolationError) Copy() *PolicyViolationError {
+	return deep.Copy(t).(*PolicyViolationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyViolationError) Equal(o *PolicyViolationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyViolationErrorPart) Copy() *PolicyViolationErrorPart {
+	return deep.Copy(t).(*PolicyViolationErrorPart)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyViolationErrorPart) Equal(o *PolicyViolationErrorPart) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyViolationKey) Copy() *PolicyViolationKey {
+	return deep.Copy(t).(*PolicyViolationKey)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyViolationKey) Equal(o *PolicyViolationKey) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductAd) Copy() *ProductAd {
+	return deep.Copy(t).(*ProductAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductAd) Equal(o *ProductAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductImage) Copy() *ProductImage {
+	return deep.Copy(t).(*ProductImage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductImage) Equal(o *ProductImage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ResellerConstraint) Copy() *ResellerConstraint {
+	return deep.Copy(t).(*ResellerConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ResellerConstraint) Equal(o *ResellerConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ResponsiveDisplayAd) Copy() *ResponsiveDisplayAd {
+	return deep.Copy(t).(*ResponsiveDisplayAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ResponsiveDisplayAd) Equal(o *ResponsiveDisplayAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RichMediaAd) Copy() *RichMediaAd {
+	return deep.Copy(t).(*RichMediaAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RichMediaAd) Equal(o *RichMediaAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ShowcaseAd) Copy() *ShowcaseAd {
+	return deep.Copy(t).(*ShowcaseAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ShowcaseAd) Equal(o *ShowcaseAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StatsQueryError) Copy() *StatsQueryError {
+	return deep.Copy(t).(*StatsQueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StatsQueryError) Equal(o *StatsQueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *String_StringMapEntry) Copy() *String_StringMapEntry {
+	return deep.Copy(t).(*String_StringMapEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *String_StringMapEntry) Equal(o *String_StringMapEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TempAdUnionId) Copy() *TempAdUnionId {
+	return deep.Copy(t).(*TempAdUnionId)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TempAdUnionId) Equal(o *TempAdUnionId) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TemplateAd) Copy() *TemplateAd {
+	return deep.Copy(t).(*TemplateAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TemplateAd) Equal(o *TemplateAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TemplateElement) Copy() *TemplateElement {
+	return deep.Copy(t).(*TemplateElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TemplateElement) Equal(o *TemplateElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TemplateElementField) Copy() *TemplateElementField {
+	return deep.Copy(t).(*TemplateElementField)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TemplateElementField) Equal(o *TemplateElementField) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TextAd) Copy() *TextAd {
+	return deep.Copy(t).(*TextAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TextAd) Equal(o *TextAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ThirdPartyRedirectAd) Copy() *ThirdPartyRedirectAd {
+	return deep.Copy(t).(*ThirdPartyRedirectAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ThirdPartyRedirectAd) Equal(o *ThirdPartyRedirectAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UniversalShoppingAd) Copy() *UniversalShoppingAd {
+	return deep.Copy(t).(*UniversalShoppingAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UniversalShoppingAd) Equal(o *UniversalShoppingAd) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlData) Copy() *UrlData {
+	return deep.Copy(t).(*UrlData)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlData) Equal(o *UrlData) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlError) Copy() *UrlError {
+	return deep.Copy(t).(*UrlError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlError) Equal(o *UrlError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlList) Copy() *UrlList {
+	return deep.Copy(t).(*UrlList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlList) Equal(o *UrlList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Video) Copy() *Video {
+	return deep.Copy(t).(*Video)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Video) Equal(o *Video) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DynamicSearchAd) Copy() *DynamicSearchAd {
+	return deep.Copy(t).(*DynamicSearchAd)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DynamicSearchAd) Equal(o *DynamicSearchAd) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffAd compares from, as fetched from the API, with the desired
+// to. It returns a Ad holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAd(from, to *Ad) (*Ad, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference"},
+		[]string{"Id"},
+	).(*Ad)
+	return set, changes
+}
+
+// DiffAdGroupAd compares from, as fetched from the API, with the desired
+// to. It returns a AdGroupAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdGroupAd(from, to *AdGroupAd) (*AdGroupAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Status", "ForwardCompatibilityMap"},
+		[]string{"AdGroupId", "Ad"},
+	).(*AdGroupAd)
+	return set, changes
+}
+
+// SetAdGroupAdOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffAdGroupAd.
+func SetAdGroupAdOperation(from, to *AdGroupAd) (*AdGroupAdOperation, []deep.Change) {
+	set, changes := DiffAdGroupAd(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &AdGroupAdOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+
+// DiffAdGroupAdPolicySummary compares from, as fetched from the API, with the desired
+// to. It returns a AdGroupAdPolicySummary holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdGroupAdPolicySummary(from, to *AdGroupAdPolicySummary) (*AdGroupAdPolicySummary, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"PolicyTopicEntries", "ReviewState", "DenormalizedStatus", "CombinedApprovalStatus"},
+		nil,
+	).(*AdGroupAdPolicySummary)
+	return set, changes
+}
+
+// DiffAudio compares from, as fetched from the API, with the desired
+// to. It returns a Audio holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAudio(from, to *Audio) (*Audio, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Dimensions", "Name", "DurationMillis", "StreamingUrl", "ReadyToPlayOnTheWeb"},
+		[]string{"MediaId"},
+	).(*Audio)
+	return set, changes
+}
+
+// DiffCallOnlyAd compares from, as fetched from the API, with the desired
+// to. It returns a CallOnlyAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCallOnlyAd(from, to *CallOnlyAd) (*CallOnlyAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "CountryCode", "PhoneNumber", "BusinessName", "Description1", "Description2", "CallTracked", "DisableCallConversion", "ConversionTypeId", "PhoneNumberVerificationUrl"},
+		[]string{"Id"},
+	).(*CallOnlyAd)
+	return set, changes
+}
+
+// DiffDeprecatedAd compares from, as fetched from the API, with the desired
+// to. It returns a DeprecatedAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffDeprecatedAd(from, to *DeprecatedAd) (*DeprecatedAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference"},
+		[]string{"Id"},
+	).(*DeprecatedAd)
+	return set, changes
+}
+
+// DiffDimensions compares from, as fetched from the API, with the desired
+// to. It returns a Dimensions holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffDimensions(from, to *Dimensions) (*Dimensions, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Width", "Height"},
+	).(*Dimensions)
+	return set, changes
+}
+
+// DiffDisplayCallToAction compares from, as fetched from the API, with the desired
+// to. It returns a DisplayCallToAction holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffDisplayCallToAction(from, to *DisplayCallToAction) (*DisplayCallToAction, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Text", "TextColor", "UrlId"},
+		nil,
+	).(*DisplayCallToAction)
+	return set, changes
+}
+
+// DiffDynamicSettings compares from, as fetched from the API, with the desired
+// to. It returns a DynamicSettings holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffDynamicSettings(from, to *DynamicSettings) (*DynamicSettings, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"LandscapeLogoImage", "PricePrefix", "PromoText"},
+		nil,
+	).(*DynamicSettings)
+	return set, changes
+}
+
+// DiffExpandedDynamicSearchAd compares from, as fetched from the API, with the desired
+// to. It returns a ExpandedDynamicSearchAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffExpandedDynamicSearchAd(from, to *ExpandedDynamicSearchAd) (*ExpandedDynamicSearchAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "Description"},
+		[]string{"Id"},
+	).(*ExpandedDynamicSearchAd)
+	return set, changes
+}
+
+// DiffExpandedTextAd compares from, as fetched from the API, with the desired
+// to. It returns a ExpandedTextAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffExpandedTextAd(from, to *ExpandedTextAd) (*ExpandedTextAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "HeadlinePart1", "HeadlinePart2", "Description", "Path1", "Path2"},
+		[]string{"Id"},
+	).(*ExpandedTextAd)
+	return set, changes
+}
+
+// DiffGmailAd compares from, as fetched from the API, with the desired
+// to. It returns a GmailAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffGmailAd(from, to *GmailAd) (*GmailAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "Teaser", "HeaderImage", "MarketingImage", "MarketingImageHeadline", "MarketingImageDescription", "MarketingImageDisplayCallToAction", "ProductImages", "ProductVideoList"},
+		[]string{"Id"},
+	).(*GmailAd)
+	return set, changes
+}
+
+// DiffGmailTeaser compares from, as fetched from the API, with the desired
+// to. It returns a GmailTeaser holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffGmailTeaser(from, to *GmailTeaser) (*GmailTeaser, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Headline", "Description", "BusinessName", "LogoImage"},
+		nil,
+	).(*GmailTeaser)
+	return set, changes
+}
+
+// DiffImage compares from, as fetched from the API, with the desired
+// to. It returns a Image holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffImage(from, to *Image) (*Image, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Dimensions", "Name", "Data"},
+		[]string{"MediaId"},
+	).(*Image)
+	return set, changes
+}
+
+// DiffImageAd compares from, as fetched from the API, with the desired
+// to. It returns a ImageAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffImageAd(from, to *ImageAd) (*ImageAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "Image"},
+		[]string{"Id", "Name"},
+	).(*ImageAd)
+	return set, changes
+}
+
+// DiffMedia compares from, as fetched from the API, with the desired
+// to. It returns a Media holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMedia(from, to *Media) (*Media, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Dimensions", "Name"},
+		[]string{"MediaId"},
+	).(*Media)
+	return set, changes
+}
+
+// DiffMediaBundle compares from, as fetched from the API, with the desired
+// to. It returns a MediaBundle holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMediaBundle(from, to *MediaBundle) (*MediaBundle, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Dimensions", "Name", "Data", "EntryPoint"},
+		[]string{"MediaId"},
+	).(*MediaBundle)
+	return set, changes
+}
+
+// DiffProductAd compares from, as fetched from the API, with the desired
+// to. It returns a ProductAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffProductAd(from, to *ProductAd) (*ProductAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference"},
+		[]string{"Id"},
+	).(*ProductAd)
+	return set, changes
+}
+
+// DiffResponsiveDisplayAd compares from, as fetched from the API, with the desired
+// to. It returns a ResponsiveDisplayAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffResponsiveDisplayAd(from, to *ResponsiveDisplayAd) (*ResponsiveDisplayAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "MarketingImage", "LogoImage", "SquareMarketingImage", "ShortHeadline", "LongHeadline", "Description", "BusinessName", "MainColor", "AccentColor", "AllowFlexibleColor", "CallToActionText", "DynamicDisplayAdSettings", "FormatSetting"},
+		[]string{"Id"},
+	).(*ResponsiveDisplayAd)
+	return set, changes
+}
+
+// DiffRichMediaAd compares from, as fetched from the API, with the desired
+// to. It returns a RichMediaAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffRichMediaAd(from, to *RichMediaAd) (*RichMediaAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "Dimensions", "Snippet", "ImpressionBeaconUrl", "AdDuration", "SourceUrl", "RichMediaAdType", "AdAttributes"},
+		[]string{"Id", "Name", "CertifiedVendorFormatId"},
+	).(*RichMediaAd)
+	return set, changes
+}
+
+// DiffShowcaseAd compares from, as fetched from the API, with the desired
+// to. It returns a ShowcaseAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffShowcaseAd(from, to *ShowcaseAd) (*ShowcaseAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "Name", "Headline", "Description", "CollapsedImage", "ExpandedImage"},
+		[]string{"Id"},
+	).(*ShowcaseAd)
+	return set, changes
+}
+
+// DiffTemplateAd compares from, as fetched from the API, with the desired
+// to. It returns a TemplateAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffTemplateAd(from, to *TemplateAd) (*TemplateAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "AdUnionId", "TemplateElements", "AdAsImage", "Dimensions", "OriginAdId"},
+		[]string{"Id", "TemplateId", "Name"},
+	).(*TemplateAd)
+	return set, changes
+}
+
+// DiffTemplateElement compares from, as fetched from the API, with the desired
+// to. It returns a TemplateElement holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffTemplateElement(from, to *TemplateElement) (*TemplateElement, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"UniqueName", "Fields"},
+	).(*TemplateElement)
+	return set, changes
+}
+
+// DiffTemplateElementField compares from, as fetched from the API, with the desired
+// to. It returns a TemplateElementField holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffTemplateElementField(from, to *TemplateElementField) (*TemplateElementField, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"FieldText", "FieldMedia"},
+		[]string{"Name", "Type_"},
+	).(*TemplateElementField)
+	return set, changes
+}
+
+// DiffTextAd compares from, as fetched from the API, with the desired
+// to. It returns a TextAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffTextAd(from, to *TextAd) (*TextAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "Headline", "Description1", "Description2"},
+		[]string{"Id"},
+	).(*TextAd)
+	return set, changes
+}
+
+// DiffThirdPartyRedirectAd compares from, as fetched from the API, with the desired
+// to. It returns a ThirdPartyRedirectAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffThirdPartyRedirectAd(from, to *ThirdPartyRedirectAd) (*ThirdPartyRedirectAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "Dimensions", "Snippet", "ImpressionBeaconUrl", "AdDuration", "SourceUrl", "RichMediaAdType", "AdAttributes", "VideoTypes", "ExpandingDirections"},
+		[]string{"Id", "Name", "CertifiedVendorFormatId", "IsCookieTargeted", "IsUserInterestTargeted", "IsTagged"},
+	).(*ThirdPartyRedirectAd)
+	return set, changes
+}
+
+// DiffUniversalShoppingAd compares from, as fetched from the API, with the desired
+// to. It returns a UniversalShoppingAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffUniversalShoppingAd(from, to *UniversalShoppingAd) (*UniversalShoppingAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference"},
+		[]string{"Id"},
+	).(*UniversalShoppingAd)
+	return set, changes
+}
+
+// DiffVideo compares from, as fetched from the API, with the desired
+// to. It returns a Video holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffVideo(from, to *Video) (*Video, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Dimensions", "Name", "DurationMillis", "StreamingUrl", "ReadyToPlayOnTheWeb", "IndustryStandardCommercialIdentifier", "AdvertisingId", "YouTubeVideoIdString"},
+		[]string{"MediaId"},
+	).(*Video)
+	return set, changes
+}
+
+// DiffDynamicSearchAd compares from, as fetched from the API, with the desired
+// to. It returns a DynamicSearchAd holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffDynamicSearchAd(from, to *DynamicSearchAd) (*DynamicSearchAd, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url", "DisplayUrl", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "UrlData", "Automated", "Type_", "DevicePreference", "Description1", "Description2"},
+		[]string{"Id"},
+	).(*DynamicSearchAd)
+	return set, changes
+}
@@ -0,0 +1,3978 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupAdService
+
+import "fmt"
+
+// AdTypeValues returns all values of AdType.
+func AdTypeValues() []AdType {
+	return []AdType{
+		AdTypeDEPRECATED_AD,
+		AdTypeIMAGE_AD,
+		AdTypePRODUCT_AD,
+		AdTypeTEMPLATE_AD,
+		AdTypeTEXT_AD,
+		AdTypeTHIRD_PARTY_REDIRECT_AD,
+		AdTypeDYNAMIC_SEARCH_AD,
+		AdTypeCALL_ONLY_AD,
+		AdTypeEXPANDED_TEXT_AD,
+		AdTypeRESPONSIVE_DISPLAY_AD,
+		AdTypeSHOWCASE_AD,
+		AdTypeUNIVERSAL_SHOPPING_AD,
+		AdTypeEXPANDED_DYNAMIC_SEARCH_AD,
+		AdTypeGMAIL_AD,
+		AdTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdType.
+func (e AdType) IsValid() bool {
+	switch e {
+	case AdTypeDEPRECATED_AD,
+		AdTypeIMAGE_AD,
+		AdTypePRODUCT_AD,
+		AdTypeTEMPLATE_AD,
+		AdTypeTEXT_AD,
+		AdTypeTHIRD_PARTY_REDIRECT_AD,
+		AdTypeDYNAMIC_SEARCH_AD,
+		AdTypeCALL_ONLY_AD,
+		AdTypeEXPANDED_TEXT_AD,
+		AdTypeRESPONSIVE_DISPLAY_AD,
+		AdTypeSHOWCASE_AD,
+		AdTypeUNIVERSAL_SHOPPING_AD,
+		AdTypeEXPANDED_DYNAMIC_SEARCH_AD,
+		AdTypeGMAIL_AD,
+		AdTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAdType returns the AdType named by s.
+func ParseAdType(s string) (AdType, error) {
+	e := AdType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdType.
+func (e AdType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdType) UnmarshalText(text []byte) error {
+	v := AdType(text)
+	if !v.IsValid() {
+		v = AdTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdCustomizerErrorReasonValues returns all values of AdCustomizerErrorReason.
+func AdCustomizerErrorReasonValues() []AdCustomizerErrorReason {
+	return []AdCustomizerErrorReason{
+		AdCustomizerErrorReasonCOUNTDOWN_INVALID_DATE_FORMAT,
+		AdCustomizerErrorReasonCOUNTDOWN_DATE_IN_PAST,
+		AdCustomizerErrorReasonCOUNTDOWN_INVALID_LOCALE,
+		AdCustomizerErrorReasonCOUNTDOWN_INVALID_START_DAYS_BEFORE,
+		AdCustomizerErrorReasonUNKNOWN_USER_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdCustomizerErrorReason.
+func (e AdCustomizerErrorReason) IsValid() bool {
+	switch e {
+	case AdCustomizerErrorReasonCOUNTDOWN_INVALID_DATE_FORMAT,
+		AdCustomizerErrorReasonCOUNTDOWN_DATE_IN_PAST,
+		AdCustomizerErrorReasonCOUNTDOWN_INVALID_LOCALE,
+		AdCustomizerErrorReasonCOUNTDOWN_INVALID_START_DAYS_BEFORE,
+		AdCustomizerErrorReasonUNKNOWN_USER_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseAdCustomizerErrorReason returns the AdCustomizerErrorReason named by s.
+func ParseAdCustomizerErrorReason(s string) (AdCustomizerErrorReason, error) {
+	e := AdCustomizerErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdCustomizerErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdCustomizerErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdCustomizerErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdCustomizerErrorReason) UnmarshalText(text []byte) error {
+	v := AdCustomizerErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AdErrorReasonValues returns all values of AdErrorReason.
+func AdErrorReasonValues() []AdErrorReason {
+	return []AdErrorReason{
+		AdErrorReasonAD_CUSTOMIZERS_NOT_SUPPORTED_FOR_AD_TYPE,
+		AdErrorReasonAPPROXIMATELY_TOO_LONG,
+		AdErrorReasonAPPROXIMATELY_TOO_SHORT,
+		AdErrorReasonBAD_SNIPPET,
+		AdErrorReasonCANNOT_MODIFY_AD,
+		AdErrorReasonCANNOT_SET_BUSINESS_NAME_IF_URL_SET,
+		AdErrorReasonCANNOT_SET_FIELD,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_ORIGIN_AD_ID_SET,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_AD_ID_SET_FOR_SHARING,
+		AdErrorReasonCANNOT_SET_ALLOW_FLEXIBLE_COLOR_FALSE,
+		AdErrorReasonCANNOT_SET_COLOR_CONTROL_WHEN_NATIVE_FORMAT_SETTING,
+		AdErrorReasonCANNOT_SET_URL,
+		AdErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_TRACKING_URL_TEMPLATE,
+		AdErrorReasonCANNOT_SET_WITH_URL_DATA,
+		AdErrorReasonCANNOT_USE_AD_SUBCLASS_FOR_OPERATOR,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_MOBILEADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_ADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_REDIRECT_ADS,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE_FOR_UPDATING_BEACON_URL,
+		AdErrorReasonDIMENSION_ALREADY_IN_UNION,
+		AdErrorReasonDIMENSION_MUST_BE_SET,
+		AdErrorReasonDIMENSION_NOT_IN_UNION,
+		AdErrorReasonDISPLAY_URL_CANNOT_BE_SPECIFIED,
+		AdErrorReasonDOMESTIC_PHONE_NUMBER_FORMAT,
+		AdErrorReasonEMERGENCY_PHONE_NUMBER,
+		AdErrorReasonEMPTY_FIELD,
+		AdErrorReasonFEED_ATTRIBUTE_MUST_HAVE_MAPPING_FOR_TYPE_ID,
+		AdErrorReasonFEED_ATTRIBUTE_MAPPING_TYPE_MISMATCH,
+		AdErrorReasonILLEGAL_AD_CUSTOMIZER_TAG_USE,
+		AdErrorReasonILLEGAL_TAG_USE,
+		AdErrorReasonINCONSISTENT_DIMENSIONS,
+		AdErrorReasonINCONSISTENT_STATUS_IN_TEMPLATE_UNION,
+		AdErrorReasonINCORRECT_LENGTH,
+		AdErrorReasonINELIGIBLE_FOR_UPGRADE,
+		AdErrorReasonINVALID_AD_ADDRESS_CAMPAIGN_TARGET,
+		AdErrorReasonINVALID_AD_TYPE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_IMAGE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_TEXT,
+		AdErrorReasonINVALID_CALL_TO_ACTION_TEXT,
+		AdErrorReasonINVALID_CHARACTER_FOR_URL,
+		AdErrorReasonINVALID_COUNTRY_CODE,
+		AdErrorReasonINVALID_DSA_URL_TAG,
+		AdErrorReasonINVALID_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonINVALID_INPUT,
+		AdErrorReasonINVALID_MARKUP_LANGUAGE,
+		AdErrorReasonINVALID_MOBILE_CARRIER,
+		AdErrorReasonINVALID_MOBILE_CARRIER_TARGET,
+		AdErrorReasonINVALID_NUMBER_OF_ELEMENTS,
+		AdErrorReasonINVALID_PHONE_NUMBER_FORMAT,
+		AdErrorReasonINVALID_RICH_MEDIA_CERTIFIED_VENDOR_FORMAT_ID,
+		AdErrorReasonINVALID_TEMPLATE_DATA,
+		AdErrorReasonINVALID_TEMPLATE_ELEMENT_FIELD_TYPE,
+		AdErrorReasonINVALID_TEMPLATE_ID,
+		AdErrorReasonLINE_TOO_WIDE,
+		AdErrorReasonMISSING_AD_CUSTOMIZER_MAPPING,
+		AdErrorReasonMISSING_ADDRESS_COMPONENT,
+		AdErrorReasonMISSING_ADVERTISEMENT_NAME,
+		AdErrorReasonMISSING_BUSINESS_NAME,
+		AdErrorReasonMISSING_DESCRIPTION1,
+		AdErrorReasonMISSING_DESCRIPTION2,
+		AdErrorReasonMISSING_DESTINATION_URL_TAG,
+		AdErrorReasonMISSING_LANDING_PAGE_URL_TAG,
+		AdErrorReasonMISSING_DIMENSION,
+		AdErrorReasonMISSING_DISPLAY_URL,
+		AdErrorReasonMISSING_HEADLINE,
+		AdErrorReasonMISSING_HEIGHT,
+		AdErrorReasonMISSING_IMAGE,
+		AdErrorReasonMISSING_MARKETING_IMAGE_OR_PRODUCT_VIDEOS,
+		AdErrorReasonMISSING_MARKUP_LANGUAGES,
+		AdErrorReasonMISSING_MOBILE_CARRIER,
+		AdErrorReasonMISSING_PHONE,
+		AdErrorReasonMISSING_REQUIRED_TEMPLATE_FIELDS,
+		AdErrorReasonMISSING_TEMPLATE_FIELD_VALUE,
+		AdErrorReasonMISSING_TEXT,
+		AdErrorReasonMISSING_VISIBLE_URL,
+		AdErrorReasonMISSING_WIDTH,
+		AdErrorReasonMULTIPLE_DISTINCT_FEEDS_UNSUPPORTED,
+		AdErrorReasonMUST_USE_TEMP_AD_UNION_ID_ON_ADD,
+		AdErrorReasonTOO_LONG,
+		AdErrorReasonTOO_SHORT,
+		AdErrorReasonUNION_DIMENSIONS_CANNOT_CHANGE,
+		AdErrorReasonUNKNOWN_ADDRESS_COMPONENT,
+		AdErrorReasonUNKNOWN_FIELD_NAME,
+		AdErrorReasonUNKNOWN_UNIQUE_NAME,
+		AdErrorReasonUNSUPPORTED_DIMENSIONS,
+		AdErrorReasonURL_INVALID_SCHEME,
+		AdErrorReasonURL_INVALID_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_MALFORMED,
+		AdErrorReasonURL_NO_HOST,
+		AdErrorReasonURL_NOT_EQUIVALENT,
+		AdErrorReasonURL_HOST_NAME_TOO_LONG,
+		AdErrorReasonURL_NO_SCHEME,
+		AdErrorReasonURL_NO_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_PATH_NOT_ALLOWED,
+		AdErrorReasonURL_PORT_NOT_ALLOWED,
+		AdErrorReasonURL_QUERY_NOT_ALLOWED,
+		AdErrorReasonURL_SCHEME_BEFORE_DSA_TAG,
+		AdErrorReasonURL_SCHEME_BEFORE_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonUSER_DOES_NOT_HAVE_ACCESS_TO_TEMPLATE,
+		AdErrorReasonINCONSISTENT_EXPANDABLE_SETTINGS,
+		AdErrorReasonINVALID_FORMAT,
+		AdErrorReasonINVALID_FIELD_TEXT,
+		AdErrorReasonELEMENT_NOT_PRESENT,
+		AdErrorReasonIMAGE_ERROR,
+		AdErrorReasonVALUE_NOT_IN_RANGE,
+		AdErrorReasonFIELD_NOT_PRESENT,
+		AdErrorReasonADDRESS_NOT_COMPLETE,
+		AdErrorReasonADDRESS_INVALID,
+		AdErrorReasonVIDEO_RETRIEVAL_ERROR,
+		AdErrorReasonAUDIO_ERROR,
+		AdErrorReasonINVALID_YOUTUBE_DISPLAY_URL,
+		AdErrorReasonTOO_MANY_PRODUCT_IMAGES,
+		AdErrorReasonTOO_MANY_PRODUCT_VIDEOS,
+		AdErrorReasonINCOMPATIBLE_AD_TYPE_AND_DEVICE_PREFERENCE,
+		AdErrorReasonCALLTRACKING_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonCARRIER_SPECIFIC_SHORT_NUMBER_NOT_ALLOWED,
+		AdErrorReasonDISALLOWED_NUMBER_TYPE,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_WITH_CALLTRACKING_FOR_COUNTRY,
+		AdErrorReasonPREMIUM_RATE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonVANITY_PHONE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonINVALID_CALL_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_DISABLE_CALL_CONVERSION_AND_SET_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_SET_PATH2_WITHOUT_PATH1,
+		AdErrorReasonMISSING_DYNAMIC_SEARCH_ADS_SETTING_DOMAIN_NAME,
+		AdErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdErrorReason.
+func (e AdErrorReason) IsValid() bool {
+	switch e {
+	case AdErrorReasonAD_CUSTOMIZERS_NOT_SUPPORTED_FOR_AD_TYPE,
+		AdErrorReasonAPPROXIMATELY_TOO_LONG,
+		AdErrorReasonAPPROXIMATELY_TOO_SHORT,
+		AdErrorReasonBAD_SNIPPET,
+		AdErrorReasonCANNOT_MODIFY_AD,
+		AdErrorReasonCANNOT_SET_BUSINESS_NAME_IF_URL_SET,
+		AdErrorReasonCANNOT_SET_FIELD,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_ORIGIN_AD_ID_SET,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_AD_ID_SET_FOR_SHARING,
+		AdErrorReasonCANNOT_SET_ALLOW_FLEXIBLE_COLOR_FALSE,
+		AdErrorReasonCANNOT_SET_COLOR_CONTROL_WHEN_NATIVE_FORMAT_SETTING,
+		AdErrorReasonCANNOT_SET_URL,
+		AdErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_TRACKING_URL_TEMPLATE,
+		AdErrorReasonCANNOT_SET_WITH_URL_DATA,
+		AdErrorReasonCANNOT_USE_AD_SUBCLASS_FOR_OPERATOR,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_MOBILEADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_ADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_REDIRECT_ADS,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE_FOR_UPDATING_BEACON_URL,
+		AdErrorReasonDIMENSION_ALREADY_IN_UNION,
+		AdErrorReasonDIMENSION_MUST_BE_SET,
+		AdErrorReasonDIMENSION_NOT_IN_UNION,
+		AdErrorReasonDISPLAY_URL_CANNOT_BE_SPECIFIED,
+		AdErrorReasonDOMESTIC_PHONE_NUMBER_FORMAT,
+		AdErrorReasonEMERGENCY_PHONE_NUMBER,
+		AdErrorReasonEMPTY_FIELD,
+		AdErrorReasonFEED_ATTRIBUTE_MUST_HAVE_MAPPING_FOR_TYPE_ID,
+		AdErrorReasonFEED_ATTRIBUTE_MAPPING_TYPE_MISMATCH,
+		AdErrorReasonILLEGAL_AD_CUSTOMIZER_TAG_USE,
+		AdErrorReasonILLEGAL_TAG_USE,
+		AdErrorReasonINCONSISTENT_DIMENSIONS,
+		AdErrorReasonINCONSISTENT_STATUS_IN_TEMPLATE_UNION,
+		AdErrorReasonINCORRECT_LENGTH,
+		AdErrorReasonINELIGIBLE_FOR_UPGRADE,
+		AdErrorReasonINVALID_AD_ADDRESS_CAMPAIGN_TARGET,
+		AdErrorReasonINVALID_AD_TYPE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_IMAGE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_TEXT,
+		AdErrorReasonINVALID_CALL_TO_ACTION_TEXT,
+		AdErrorReasonINVALID_CHARACTER_FOR_URL,
+		AdErrorReasonINVALID_COUNTRY_CODE,
+		AdErrorReasonINVALID_DSA_URL_TAG,
+		AdErrorReasonINVALID_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonINVALID_INPUT,
+		AdErrorReasonINVALID_MARKUP_LANGUAGE,
+		AdErrorReasonINVALID_MOBILE_CARRIER,
+		AdErrorReasonINVALID_MOBILE_CARRIER_TARGET,
+		AdErrorReasonINVALID_NUMBER_OF_ELEMENTS,
+		AdErrorReasonINVALID_PHONE_NUMBER_FORMAT,
+		AdErrorReasonINVALID_RICH_MEDIA_CERTIFIED_VENDOR_FORMAT_ID,
+		AdErrorReasonINVALID_TEMPLATE_DATA,
+		AdErrorReasonINVALID_TEMPLATE_ELEMENT_FIELD_TYPE,
+		AdErrorReasonINVALID_TEMPLATE_ID,
+		AdErrorReasonLINE_TOO_WIDE,
+		AdErrorReasonMISSING_AD_CUSTOMIZER_MAPPING,
+		AdErrorReasonMISSING_ADDRESS_COMPONENT,
+		AdErrorReasonMISSING_ADVERTISEMENT_NAME,
+		AdErrorReasonMISSING_BUSINESS_NAME,
+		AdErrorReasonMISSING_DESCRIPTION1,
+		AdErrorReasonMISSING_DESCRIPTION2,
+		AdErrorReasonMISSING_DESTINATION_URL_TAG,
+		AdErrorReasonMISSING_LANDING_PAGE_URL_TAG,
+		AdErrorReasonMISSING_DIMENSION,
+		AdErrorReasonMISSING_DISPLAY_URL,
+		AdErrorReasonMISSING_HEADLINE,
+		AdErrorReasonMISSING_HEIGHT,
+		AdErrorReasonMISSING_IMAGE,
+		AdErrorReasonMISSING_MARKETING_IMAGE_OR_PRODUCT_VIDEOS,
+		AdErrorReasonMISSING_MARKUP_LANGUAGES,
+		AdErrorReasonMISSING_MOBILE_CARRIER,
+		AdErrorReasonMISSING_PHONE,
+		AdErrorReasonMISSING_REQUIRED_TEMPLATE_FIELDS,
+		AdErrorReasonMISSING_TEMPLATE_FIELD_VALUE,
+		AdErrorReasonMISSING_TEXT,
+		AdErrorReasonMISSING_VISIBLE_URL,
+		AdErrorReasonMISSING_WIDTH,
+		AdErrorReasonMULTIPLE_DISTINCT_FEEDS_UNSUPPORTED,
+		AdErrorReasonMUST_USE_TEMP_AD_UNION_ID_ON_ADD,
+		AdErrorReasonTOO_LONG,
+		AdErrorReasonTOO_SHORT,
+		AdErrorReasonUNION_DIMENSIONS_CANNOT_CHANGE,
+		AdErrorReasonUNKNOWN_ADDRESS_COMPONENT,
+		AdErrorReasonUNKNOWN_FIELD_NAME,
+		AdErrorReasonUNKNOWN_UNIQUE_NAME,
+		AdErrorReasonUNSUPPORTED_DIMENSIONS,
+		AdErrorReasonURL_INVALID_SCHEME,
+		AdErrorReasonURL_INVALID_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_MALFORMED,
+		AdErrorReasonURL_NO_HOST,
+		AdErrorReasonURL_NOT_EQUIVALENT,
+		AdErrorReasonURL_HOST_NAME_TOO_LONG,
+		AdErrorReasonURL_NO_SCHEME,
+		AdErrorReasonURL_NO_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_PATH_NOT_ALLOWED,
+		AdErrorReasonURL_PORT_NOT_ALLOWED,
+		AdErrorReasonURL_QUERY_NOT_ALLOWED,
+		AdErrorReasonURL_SCHEME_BEFORE_DSA_TAG,
+		AdErrorReasonURL_SCHEME_BEFORE_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonUSER_DOES_NOT_HAVE_ACCESS_TO_TEMPLATE,
+		AdErrorReasonINCONSISTENT_EXPANDABLE_SETTINGS,
+		AdErrorReasonINVALID_FORMAT,
+		AdErrorReasonINVALID_FIELD_TEXT,
+		AdErrorReasonELEMENT_NOT_PRESENT,
+		AdErrorReasonIMAGE_ERROR,
+		AdErrorReasonVALUE_NOT_IN_RANGE,
+		AdErrorReasonFIELD_NOT_PRESENT,
+		AdErrorReasonADDRESS_NOT_COMPLETE,
+		AdErrorReasonADDRESS_INVALID,
+		AdErrorReasonVIDEO_RETRIEVAL_ERROR,
+		AdErrorReasonAUDIO_ERROR,
+		AdErrorReasonINVALID_YOUTUBE_DISPLAY_URL,
+		AdErrorReasonTOO_MANY_PRODUCT_IMAGES,
+		AdErrorReasonTOO_MANY_PRODUCT_VIDEOS,
+		AdErrorReasonINCOMPATIBLE_AD_TYPE_AND_DEVICE_PREFERENCE,
+		AdErrorReasonCALLTRACKING_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonCARRIER_SPECIFIC_SHORT_NUMBER_NOT_ALLOWED,
+		AdErrorReasonDISALLOWED_NUMBER_TYPE,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_WITH_CALLTRACKING_FOR_COUNTRY,
+		AdErrorReasonPREMIUM_RATE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonVANITY_PHONE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonINVALID_CALL_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_DISABLE_CALL_CONVERSION_AND_SET_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_SET_PATH2_WITHOUT_PATH1,
+		AdErrorReasonMISSING_DYNAMIC_SEARCH_ADS_SETTING_DOMAIN_NAME,
+		AdErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAdErrorReason returns the AdErrorReason named by s.
+func ParseAdErrorReason(s string) (AdErrorReason, error) {
+	e := AdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdErrorReason.
+func (e AdErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdErrorReason) UnmarshalText(text []byte) error {
+	v := AdErrorReason(text)
+	if !v.IsValid() {
+		v = AdErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdGroupAdStatusValues returns all values of AdGroupAdStatus.
+func AdGroupAdStatusValues() []AdGroupAdStatus {
+	return []AdGroupAdStatus{
+		AdGroupAdStatusENABLED,
+		AdGroupAdStatusPAUSED,
+		AdGroupAdStatusDISABLED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupAdStatus.
+func (e AdGroupAdStatus) IsValid() bool {
+	switch e {
+	case AdGroupAdStatusENABLED,
+		AdGroupAdStatusPAUSED,
+		AdGroupAdStatusDISABLED:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupAdStatus returns the AdGroupAdStatus named by s.
+func ParseAdGroupAdStatus(s string) (AdGroupAdStatus, error) {
+	e := AdGroupAdStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupAdStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdGroupAdStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdGroupAdStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdGroupAdStatus) UnmarshalText(text []byte) error {
+	v := AdGroupAdStatus(text)
+	*e = v
+	return nil
+}
+
+// DeprecatedAdTypeValues returns all values of DeprecatedAdType.
+func DeprecatedAdTypeValues() []DeprecatedAdType {
+	return []DeprecatedAdType{
+		DeprecatedAdTypeVIDEO,
+		DeprecatedAdTypeCLICK_TO_CALL,
+		DeprecatedAdTypeIN_STREAM_VIDEO,
+		DeprecatedAdTypeFROOGLE,
+		DeprecatedAdTypeTEXT_LINK,
+		DeprecatedAdTypeGADGET,
+		DeprecatedAdTypePRINT,
+		DeprecatedAdTypeTEXT_WIDE,
+		DeprecatedAdTypeGADGET_TEMPLATE,
+		DeprecatedAdTypeTEXT_WITH_VIDEO,
+		DeprecatedAdTypeAUDIO,
+		DeprecatedAdTypeLOCAL_BUSINESS_AD,
+		DeprecatedAdTypeAUDIO_TEMPLATE,
+		DeprecatedAdTypeMOBILE_AD,
+		DeprecatedAdTypeMOBILE_IMAGE_AD,
+		DeprecatedAdTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DeprecatedAdType.
+func (e DeprecatedAdType) IsValid() bool {
+	switch e {
+	case DeprecatedAdTypeVIDEO,
+		DeprecatedAdTypeCLICK_TO_CALL,
+		DeprecatedAdTypeIN_STREAM_VIDEO,
+		DeprecatedAdTypeFROOGLE,
+		DeprecatedAdTypeTEXT_LINK,
+		DeprecatedAdTypeGADGET,
+		DeprecatedAdTypePRINT,
+		DeprecatedAdTypeTEXT_WIDE,
+		DeprecatedAdTypeGADGET_TEMPLATE,
+		DeprecatedAdTypeTEXT_WITH_VIDEO,
+		DeprecatedAdTypeAUDIO,
+		DeprecatedAdTypeLOCAL_BUSINESS_AD,
+		DeprecatedAdTypeAUDIO_TEMPLATE,
+		DeprecatedAdTypeMOBILE_AD,
+		DeprecatedAdTypeMOBILE_IMAGE_AD,
+		DeprecatedAdTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDeprecatedAdType returns the DeprecatedAdType named by s.
+func ParseDeprecatedAdType(s string) (DeprecatedAdType, error) {
+	e := DeprecatedAdType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DeprecatedAdType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DeprecatedAdType.
+func (e DeprecatedAdType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DeprecatedAdType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DeprecatedAdType) UnmarshalText(text []byte) error {
+	v := DeprecatedAdType(text)
+	if !v.IsValid() {
+		v = DeprecatedAdTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdGroupAdErrorReasonValues returns all values of AdGroupAdErrorReason.
+func AdGroupAdErrorReasonValues() []AdGroupAdErrorReason {
+	return []AdGroupAdErrorReason{
+		AdGroupAdErrorReasonAD_GROUP_AD_LABEL_DOES_NOT_EXIST,
+		AdGroupAdErrorReasonAD_GROUP_AD_LABEL_ALREADY_EXISTS,
+		AdGroupAdErrorReasonAD_NOT_UNDER_ADGROUP,
+		AdGroupAdErrorReasonCANNOT_OPERATE_ON_REMOVED_ADGROUPAD,
+		AdGroupAdErrorReasonCANNOT_CREATE_DEPRECATED_ADS,
+		AdGroupAdErrorReasonCANNOT_CREATE_TEXT_ADS,
+		AdGroupAdErrorReasonEMPTY_FIELD,
+		AdGroupAdErrorReasonENTITY_REFERENCED_IN_MULTIPLE_OPS,
+		AdGroupAdErrorReasonUNSUPPORTED_OPERATION,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupAdErrorReason.
+func (e AdGroupAdErrorReason) IsValid() bool {
+	switch e {
+	case AdGroupAdErrorReasonAD_GROUP_AD_LABEL_DOES_NOT_EXIST,
+		AdGroupAdErrorReasonAD_GROUP_AD_LABEL_ALREADY_EXISTS,
+		AdGroupAdErrorReasonAD_NOT_UNDER_ADGROUP,
+		AdGroupAdErrorReasonCANNOT_OPERATE_ON_REMOVED_ADGROUPAD,
+		AdGroupAdErrorReasonCANNOT_CREATE_DEPRECATED_ADS,
+		AdGroupAdErrorReasonCANNOT_CREATE_TEXT_ADS,
+		AdGroupAdErrorReasonEMPTY_FIELD,
+		AdGroupAdErrorReasonENTITY_REFERENCED_IN_MULTIPLE_OPS,
+		AdGroupAdErrorReasonUNSUPPORTED_OPERATION:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupAdErrorReason returns the AdGroupAdErrorReason named by s.
+func ParseAdGroupAdErrorReason(s string) (AdGroupAdErrorReason, error) {
+	e := AdGroupAdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupAdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdGroupAdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdGroupAdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdGroupAdErrorReason) UnmarshalText(text []byte) error {
+	v := AdGroupAdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AdSharingErrorReasonValues returns all values of AdSharingErrorReason.
+func AdSharingErrorReasonValues() []AdSharingErrorReason {
+	return []AdSharingErrorReason{
+		AdSharingErrorReasonAD_GROUP_ALREADY_CONTAINS_AD,
+		AdSharingErrorReasonINCOMPATIBLE_AD_UNDER_AD_GROUP,
+		AdSharingErrorReasonCANNOT_SHARE_INACTIVE_AD,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdSharingErrorReason.
+func (e AdSharingErrorReason) IsValid() bool {
+	switch e {
+	case AdSharingErrorReasonAD_GROUP_ALREADY_CONTAINS_AD,
+		AdSharingErrorReasonINCOMPATIBLE_AD_UNDER_AD_GROUP,
+		AdSharingErrorReasonCANNOT_SHARE_INACTIVE_AD:
+		return true
+	}
+	return false
+}
+
+// ParseAdSharingErrorReason returns the AdSharingErrorReason named by s.
+func ParseAdSharingErrorReason(s string) (AdSharingErrorReason, error) {
+	e := AdSharingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdSharingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdSharingErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdSharingErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdSharingErrorReason) UnmarshalText(text []byte) error {
+	v := AdSharingErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AdxErrorReasonValues returns all values of AdxErrorReason.
+func AdxErrorReasonValues() []AdxErrorReason {
+	return []AdxErrorReason{
+		AdxErrorReasonUNSUPPORTED_FEATURE,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdxErrorReason.
+func (e AdxErrorReason) IsValid() bool {
+	switch e {
+	case AdxErrorReasonUNSUPPORTED_FEATURE:
+		return true
+	}
+	return false
+}
+
+// ParseAdxErrorReason returns the AdxErrorReason named by s.
+func ParseAdxErrorReason(s string) (AdxErrorReason, error) {
+	e := AdxErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdxErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdxErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdxErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdxErrorReason) UnmarshalText(text []byte) error {
+	v := AdxErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AppUrlOsTypeValues returns all values of AppUrlOsType.
+func AppUrlOsTypeValues() []AppUrlOsType {
+	return []AppUrlOsType{
+		AppUrlOsTypeOS_TYPE_IOS,
+		AppUrlOsTypeOS_TYPE_ANDROID,
+		AppUrlOsTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AppUrlOsType.
+func (e AppUrlOsType) IsValid() bool {
+	switch e {
+	case AppUrlOsTypeOS_TYPE_IOS,
+		AppUrlOsTypeOS_TYPE_ANDROID,
+		AppUrlOsTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAppUrlOsType returns the AppUrlOsType named by s.
+func ParseAppUrlOsType(s string) (AppUrlOsType, error) {
+	e := AppUrlOsType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AppUrlOsType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AppUrlOsType.
+func (e AppUrlOsType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AppUrlOsType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AppUrlOsType) UnmarshalText(text []byte) error {
+	v := AppUrlOsType(text)
+	if !v.IsValid() {
+		v = AppUrlOsTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AuthenticationErrorReasonValues returns all values of AuthenticationErrorReason.
+func AuthenticationErrorReasonValues() []AuthenticationErrorReason {
+	return []AuthenticationErrorReason{
+		AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthenticationErrorReason.
+func (e AuthenticationErrorReason) IsValid() bool {
+	switch e {
+	case AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID:
+		return true
+	}
+	return false
+}
+
+// ParseAuthenticationErrorReason returns the AuthenticationErrorReason named by s.
+func ParseAuthenticationErrorReason(s string) (AuthenticationErrorReason, error) {
+	e := AuthenticationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthenticationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthenticationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthenticationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthenticationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthenticationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthorizationErrorReasonValues returns all values of AuthorizationErrorReason.
+func AuthorizationErrorReasonValues() []AuthorizationErrorReason {
+	return []AuthorizationErrorReason{
+		AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthorizationErrorReason.
+func (e AuthorizationErrorReason) IsValid() bool {
+	switch e {
+	case AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseAuthorizationErrorReason returns the AuthorizationErrorReason named by s.
+func ParseAuthorizationErrorReason(s string) (AuthorizationErrorReason, error) {
+	e := AuthorizationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthorizationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthorizationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthorizationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthorizationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthorizationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ClientTermsErrorReasonValues returns all values of ClientTermsErrorReason.
+func ClientTermsErrorReasonValues() []ClientTermsErrorReason {
+	return []ClientTermsErrorReason{
+		ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ClientTermsErrorReason.
+func (e ClientTermsErrorReason) IsValid() bool {
+	switch e {
+	case ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED:
+		return true
+	}
+	return false
+}
+
+// ParseClientTermsErrorReason returns the ClientTermsErrorReason named by s.
+func ParseClientTermsErrorReason(s string) (ClientTermsErrorReason, error) {
+	e := ClientTermsErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ClientTermsErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ClientTermsErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ClientTermsErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ClientTermsErrorReason) UnmarshalText(text []byte) error {
+	v := ClientTermsErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DatabaseErrorReasonValues returns all values of DatabaseErrorReason.
+func DatabaseErrorReasonValues() []DatabaseErrorReason {
+	return []DatabaseErrorReason{
+		DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DatabaseErrorReason.
+func (e DatabaseErrorReason) IsValid() bool {
+	switch e {
+	case DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDatabaseErrorReason returns the DatabaseErrorReason named by s.
+func ParseDatabaseErrorReason(s string) (DatabaseErrorReason, error) {
+	e := DatabaseErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DatabaseErrorReason.
+func (e DatabaseErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DatabaseErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DatabaseErrorReason) UnmarshalText(text []byte) error {
+	v := DatabaseErrorReason(text)
+	if !v.IsValid() {
+		v = DatabaseErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DateErrorReasonValues returns all values of DateErrorReason.
+func DateErrorReasonValues() []DateErrorReason {
+	return []DateErrorReason{
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL,
+	}
+}
+
+// IsValid reports whether e is one of the values of DateErrorReason.
+func (e DateErrorReason) IsValid() bool {
+	switch e {
+	case DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL:
+		return true
+	}
+	return false
+}
+
+// ParseDateErrorReason returns the DateErrorReason named by s.
+func ParseDateErrorReason(s string) (DateErrorReason, error) {
+	e := DateErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DateErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DateErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DateErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DateErrorReason) UnmarshalText(text []byte) error {
+	v := DateErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DisplayAdFormatSettingValues returns all values of DisplayAdFormatSetting.
+func DisplayAdFormatSettingValues() []DisplayAdFormatSetting {
+	return []DisplayAdFormatSetting{
+		DisplayAdFormatSettingUNKNOWN,
+		DisplayAdFormatSettingALL_FORMATS,
+		DisplayAdFormatSettingNON_NATIVE,
+		DisplayAdFormatSettingNATIVE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DisplayAdFormatSetting.
+func (e DisplayAdFormatSetting) IsValid() bool {
+	switch e {
+	case DisplayAdFormatSettingUNKNOWN,
+		DisplayAdFormatSettingALL_FORMATS,
+		DisplayAdFormatSettingNON_NATIVE,
+		DisplayAdFormatSettingNATIVE:
+		return true
+	}
+	return false
+}
+
+// ParseDisplayAdFormatSetting returns the DisplayAdFormatSetting named by s.
+func ParseDisplayAdFormatSetting(s string) (DisplayAdFormatSetting, error) {
+	e := DisplayAdFormatSetting(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DisplayAdFormatSetting %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DisplayAdFormatSetting.
+func (e DisplayAdFormatSetting) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DisplayAdFormatSetting %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DisplayAdFormatSetting) UnmarshalText(text []byte) error {
+	v := DisplayAdFormatSetting(text)
+	if !v.IsValid() {
+		v = DisplayAdFormatSettingUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DistinctErrorReasonValues returns all values of DistinctErrorReason.
+func DistinctErrorReasonValues() []DistinctErrorReason {
+	return []DistinctErrorReason{
+		DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DistinctErrorReason.
+func (e DistinctErrorReason) IsValid() bool {
+	switch e {
+	case DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDistinctErrorReason returns the DistinctErrorReason named by s.
+func ParseDistinctErrorReason(s string) (DistinctErrorReason, error) {
+	e := DistinctErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DistinctErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DistinctErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DistinctErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DistinctErrorReason) UnmarshalText(text []byte) error {
+	v := DistinctErrorReason(text)
+	*e = v
+	return nil
+}
+
+// EntityAccessDeniedReasonValues returns all values of EntityAccessDeniedReason.
+func EntityAccessDeniedReasonValues() []EntityAccessDeniedReason {
+	return []EntityAccessDeniedReason{
+		EntityAccessDeniedReasonREAD_ACCESS_DENIED,
+		EntityAccessDeniedReasonWRITE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityAccessDeniedReason.
+func (e EntityAccessDeniedReason) IsValid() bool {
+	switch e {
+	case EntityAccessDeniedReasonREAD_ACCESS_DENIED,
+		EntityAccessDeniedReasonWRITE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseEntityAccessDeniedReason returns the EntityAccessDeniedReason named by s.
+func ParseEntityAccessDeniedReason(s string) (EntityAccessDeniedReason, error) {
+	e := EntityAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityAccessDeniedReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityAccessDeniedReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := EntityAccessDeniedReason(text)
+	*e = v
+	return nil
+}
+
+// EntityCountLimitExceededReasonValues returns all values of EntityCountLimitExceededReason.
+func EntityCountLimitExceededReasonValues() []EntityCountLimitExceededReason {
+	return []EntityCountLimitExceededReason{
+		EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) IsValid() bool {
+	switch e {
+	case EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseEntityCountLimitExceededReason returns the EntityCountLimitExceededReason named by s.
+func ParseEntityCountLimitExceededReason(s string) (EntityCountLimitExceededReason, error) {
+	e := EntityCountLimitExceededReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityCountLimitExceededReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid EntityCountLimitExceededReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *EntityCountLimitExceededReason) UnmarshalText(text []byte) error {
+	v := EntityCountLimitExceededReason(text)
+	if !v.IsValid() {
+		v = EntityCountLimitExceededReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// EntityNotFoundReasonValues returns all values of EntityNotFoundReason.
+func EntityNotFoundReasonValues() []EntityNotFoundReason {
+	return []EntityNotFoundReason{
+		EntityNotFoundReasonINVALID_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityNotFoundReason.
+func (e EntityNotFoundReason) IsValid() bool {
+	switch e {
+	case EntityNotFoundReasonINVALID_ID:
+		return true
+	}
+	return false
+}
+
+// ParseEntityNotFoundReason returns the EntityNotFoundReason named by s.
+func ParseEntityNotFoundReason(s string) (EntityNotFoundReason, error) {
+	e := EntityNotFoundReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityNotFoundReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityNotFoundReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityNotFoundReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityNotFoundReason) UnmarshalText(text []byte) error {
+	v := EntityNotFoundReason(text)
+	*e = v
+	return nil
+}
+
+// FeedAttributeReferenceErrorReasonValues returns all values of FeedAttributeReferenceErrorReason.
+func FeedAttributeReferenceErrorReasonValues() []FeedAttributeReferenceErrorReason {
+	return []FeedAttributeReferenceErrorReason{
+		FeedAttributeReferenceErrorReasonCANNOT_REFERENCE_DELETED_FEED,
+		FeedAttributeReferenceErrorReasonINVALID_FEED_NAME,
+		FeedAttributeReferenceErrorReasonINVALID_FEED_ATTRIBUTE_NAME,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedAttributeReferenceErrorReason.
+func (e FeedAttributeReferenceErrorReason) IsValid() bool {
+	switch e {
+	case FeedAttributeReferenceErrorReasonCANNOT_REFERENCE_DELETED_FEED,
+		FeedAttributeReferenceErrorReasonINVALID_FEED_NAME,
+		FeedAttributeReferenceErrorReasonINVALID_FEED_ATTRIBUTE_NAME:
+		return true
+	}
+	return false
+}
+
+// ParseFeedAttributeReferenceErrorReason returns the FeedAttributeReferenceErrorReason named by s.
+func ParseFeedAttributeReferenceErrorReason(s string) (FeedAttributeReferenceErrorReason, error) {
+	e := FeedAttributeReferenceErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedAttributeReferenceErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// FeedAttributeReferenceErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e FeedAttributeReferenceErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *FeedAttributeReferenceErrorReason) UnmarshalText(text []byte) error {
+	v := FeedAttributeReferenceErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ForwardCompatibilityErrorReasonValues returns all values of ForwardCompatibilityErrorReason.
+func ForwardCompatibilityErrorReasonValues() []ForwardCompatibilityErrorReason {
+	return []ForwardCompatibilityErrorReason{
+		ForwardCompatibilityErrorReasonINVALID_FORWARD_COMPATIBILITY_MAP_VALUE,
+		ForwardCompatibilityErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of ForwardCompatibilityErrorReason.
+func (e ForwardCompatibilityErrorReason) IsValid() bool {
+	switch e {
+	case ForwardCompatibilityErrorReasonINVALID_FORWARD_COMPATIBILITY_MAP_VALUE,
+		ForwardCompatibilityErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseForwardCompatibilityErrorReason returns the ForwardCompatibilityErrorReason named by s.
+func ParseForwardCompatibilityErrorReason(s string) (ForwardCompatibilityErrorReason, error) {
+	e := ForwardCompatibilityErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ForwardCompatibilityErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ForwardCompatibilityErrorReason.
+func (e ForwardCompatibilityErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ForwardCompatibilityErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ForwardCompatibilityErrorReason) UnmarshalText(text []byte) error {
+	v := ForwardCompatibilityErrorReason(text)
+	if !v.IsValid() {
+		v = ForwardCompatibilityErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FunctionErrorReasonValues returns all values of FunctionErrorReason.
+func FunctionErrorReasonValues() []FunctionErrorReason {
+	return []FunctionErrorReason{
+		FunctionErrorReasonINVALID_FUNCTION_FORMAT,
+		FunctionErrorReasonDATA_TYPE_MISMATCH,
+		FunctionErrorReasonINVALID_CONJUNCTION_OPERANDS,
+		FunctionErrorReasonINVALID_NUMBER_OF_OPERANDS,
+		FunctionErrorReasonINVALID_OPERAND_TYPE,
+		FunctionErrorReasonINVALID_OPERATOR,
+		FunctionErrorReasonINVALID_REQUEST_CONTEXT_TYPE,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_CALL_PLACEHOLDER,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_PLACEHOLDER,
+		FunctionErrorReasonINVALID_OPERAND,
+		FunctionErrorReasonMISSING_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_NESTING,
+		FunctionErrorReasonMULTIPLE_FEED_IDS_NOT_SUPPORTED,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_FEED_WITH_FIXED_SCHEMA,
+		FunctionErrorReasonINVALID_ATTRIBUTE_NAME,
+		FunctionErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FunctionErrorReason.
+func (e FunctionErrorReason) IsValid() bool {
+	switch e {
+	case FunctionErrorReasonINVALID_FUNCTION_FORMAT,
+		FunctionErrorReasonDATA_TYPE_MISMATCH,
+		FunctionErrorReasonINVALID_CONJUNCTION_OPERANDS,
+		FunctionErrorReasonINVALID_NUMBER_OF_OPERANDS,
+		FunctionErrorReasonINVALID_OPERAND_TYPE,
+		FunctionErrorReasonINVALID_OPERATOR,
+		FunctionErrorReasonINVALID_REQUEST_CONTEXT_TYPE,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_CALL_PLACEHOLDER,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_PLACEHOLDER,
+		FunctionErrorReasonINVALID_OPERAND,
+		FunctionErrorReasonMISSING_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_NESTING,
+		FunctionErrorReasonMULTIPLE_FEED_IDS_NOT_SUPPORTED,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_FEED_WITH_FIXED_SCHEMA,
+		FunctionErrorReasonINVALID_ATTRIBUTE_NAME,
+		FunctionErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFunctionErrorReason returns the FunctionErrorReason named by s.
+func ParseFunctionErrorReason(s string) (FunctionErrorReason, error) {
+	e := FunctionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FunctionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FunctionErrorReason.
+func (e FunctionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FunctionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FunctionErrorReason) UnmarshalText(text []byte) error {
+	v := FunctionErrorReason(text)
+	if !v.IsValid() {
+		v = FunctionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FunctionParsingErrorReasonValues returns all values of FunctionParsingErrorReason.
+func FunctionParsingErrorReasonValues() []FunctionParsingErrorReason {
+	return []FunctionParsingErrorReason{
+		FunctionParsingErrorReasonNO_MORE_INPUT,
+		FunctionParsingErrorReasonEXPECTED_CHARACTER,
+		FunctionParsingErrorReasonUNEXPECTED_SEPARATOR,
+		FunctionParsingErrorReasonUNMATCHED_LEFT_BRACKET,
+		FunctionParsingErrorReasonUNMATCHED_RIGHT_BRACKET,
+		FunctionParsingErrorReasonTOO_MANY_NESTED_FUNCTIONS,
+		FunctionParsingErrorReasonMISSING_RIGHT_HAND_OPERAND,
+		FunctionParsingErrorReasonINVALID_OPERATOR_NAME,
+		FunctionParsingErrorReasonFEED_ATTRIBUTE_OPERAND_ARGUMENT_NOT_INTEGER,
+		FunctionParsingErrorReasonNO_OPERANDS,
+		FunctionParsingErrorReasonTOO_MANY_OPERANDS,
+		FunctionParsingErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FunctionParsingErrorReason.
+func (e FunctionParsingErrorReason) IsValid() bool {
+	switch e {
+	case FunctionParsingErrorReasonNO_MORE_INPUT,
+		FunctionParsingErrorReasonEXPECTED_CHARACTER,
+		FunctionParsingErrorReasonUNEXPECTED_SEPARATOR,
+		FunctionParsingErrorReasonUNMATCHED_LEFT_BRACKET,
+		FunctionParsingErrorReasonUNMATCHED_RIGHT_BRACKET,
+		FunctionParsingErrorReasonTOO_MANY_NESTED_FUNCTIONS,
+		FunctionParsingErrorReasonMISSING_RIGHT_HAND_OPERAND,
+		FunctionParsingErrorReasonINVALID_OPERATOR_NAME,
+		FunctionParsingErrorReasonFEED_ATTRIBUTE_OPERAND_ARGUMENT_NOT_INTEGER,
+		FunctionParsingErrorReasonNO_OPERANDS,
+		FunctionParsingErrorReasonTOO_MANY_OPERANDS,
+		FunctionParsingErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFunctionParsingErrorReason returns the FunctionParsingErrorReason named by s.
+func ParseFunctionParsingErrorReason(s string) (FunctionParsingErrorReason, error) {
+	e := FunctionParsingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FunctionParsingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FunctionParsingErrorReason.
+func (e FunctionParsingErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FunctionParsingErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FunctionParsingErrorReason) UnmarshalText(text []byte) error {
+	v := FunctionParsingErrorReason(text)
+	if !v.IsValid() {
+		v = FunctionParsingErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// IdErrorReasonValues returns all values of IdErrorReason.
+func IdErrorReasonValues() []IdErrorReason {
+	return []IdErrorReason{
+		IdErrorReasonNOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of IdErrorReason.
+func (e IdErrorReason) IsValid() bool {
+	switch e {
+	case IdErrorReasonNOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseIdErrorReason returns the IdErrorReason named by s.
+func ParseIdErrorReason(s string) (IdErrorReason, error) {
+	e := IdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// IdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e IdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *IdErrorReason) UnmarshalText(text []byte) error {
+	v := IdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ImageErrorReasonValues returns all values of ImageErrorReason.
+func ImageErrorReasonValues() []ImageErrorReason {
+	return []ImageErrorReason{
+		ImageErrorReasonINVALID_IMAGE,
+		ImageErrorReasonSTORAGE_ERROR,
+		ImageErrorReasonBAD_REQUEST,
+		ImageErrorReasonUNEXPECTED_SIZE,
+		ImageErrorReasonANIMATED_NOT_ALLOWED,
+		ImageErrorReasonANIMATION_TOO_LONG,
+		ImageErrorReasonSERVER_ERROR,
+		ImageErrorReasonCMYK_JPEG_NOT_ALLOWED,
+		ImageErrorReasonFLASH_NOT_ALLOWED,
+		ImageErrorReasonFLASH_WITHOUT_CLICKTAG,
+		ImageErrorReasonFLASH_ERROR_AFTER_FIXING_CLICK_TAG,
+		ImageErrorReasonANIMATED_VISUAL_EFFECT,
+		ImageErrorReasonFLASH_ERROR,
+		ImageErrorReasonLAYOUT_PROBLEM,
+		ImageErrorReasonPROBLEM_READING_IMAGE_FILE,
+		ImageErrorReasonERROR_STORING_IMAGE,
+		ImageErrorReasonASPECT_RATIO_NOT_ALLOWED,
+		ImageErrorReasonFLASH_HAS_NETWORK_OBJECTS,
+		ImageErrorReasonFLASH_HAS_NETWORK_METHODS,
+		ImageErrorReasonFLASH_HAS_URL,
+		ImageErrorReasonFLASH_HAS_MOUSE_TRACKING,
+		ImageErrorReasonFLASH_HAS_RANDOM_NUM,
+		ImageErrorReasonFLASH_SELF_TARGETS,
+		ImageErrorReasonFLASH_BAD_GETURL_TARGET,
+		ImageErrorReasonFLASH_VERSION_NOT_SUPPORTED,
+		ImageErrorReasonFLASH_WITHOUT_HARD_CODED_CLICK_URL,
+		ImageErrorReasonINVALID_FLASH_FILE,
+		ImageErrorReasonFAILED_TO_FIX_CLICK_TAG_IN_FLASH,
+		ImageErrorReasonFLASH_ACCESSES_NETWORK_RESOURCES,
+		ImageErrorReasonFLASH_EXTERNAL_JS_CALL,
+		ImageErrorReasonFLASH_EXTERNAL_FS_CALL,
+		ImageErrorReasonFILE_TOO_LARGE,
+		ImageErrorReasonIMAGE_DATA_TOO_LARGE,
+		ImageErrorReasonIMAGE_PROCESSING_ERROR,
+		ImageErrorReasonIMAGE_TOO_SMALL,
+		ImageErrorReasonINVALID_INPUT,
+		ImageErrorReasonPROBLEM_READING_FILE,
+	}
+}
+
+// IsValid reports whether e is one of the values of ImageErrorReason.
+func (e ImageErrorReason) IsValid() bool {
+	switch e {
+	case ImageErrorReasonINVALID_IMAGE,
+		ImageErrorReasonSTORAGE_ERROR,
+		ImageErrorReasonBAD_REQUEST,
+		ImageErrorReasonUNEXPECTED_SIZE,
+		ImageErrorReasonANIMATED_NOT_ALLOWED,
+		ImageErrorReasonANIMATION_TOO_LONG,
+		ImageErrorReasonSERVER_ERROR,
+		ImageErrorReasonCMYK_JPEG_NOT_ALLOWED,
+		ImageErrorReasonFLASH_NOT_ALLOWED,
+		ImageErrorReasonFLASH_WITHOUT_CLICKTAG,
+		ImageErrorReasonFLASH_ERROR_AFTER_FIXING_CLICK_TAG,
+		ImageErrorReasonANIMATED_VISUAL_EFFECT,
+		ImageErrorReasonFLASH_ERROR,
+		ImageErrorReasonLAYOUT_PROBLEM,
+		ImageErrorReasonPROBLEM_READING_IMAGE_FILE,
+		ImageErrorReasonERROR_STORING_IMAGE,
+		ImageErrorReasonASPECT_RATIO_NOT_ALLOWED,
+		ImageErrorReasonFLASH_HAS_NETWORK_OBJECTS,
+		ImageErrorReasonFLASH_HAS_NETWORK_METHODS,
+		ImageErrorReasonFLASH_HAS_URL,
+		ImageErrorReasonFLASH_HAS_MOUSE_TRACKING,
+		ImageErrorReasonFLASH_HAS_RANDOM_NUM,
+		ImageErrorReasonFLASH_SELF_TARGETS,
+		ImageErrorReasonFLASH_BAD_GETURL_TARGET,
+		ImageErrorReasonFLASH_VERSION_NOT_SUPPORTED,
+		ImageErrorReasonFLASH_WITHOUT_HARD_CODED_CLICK_URL,
+		ImageErrorReasonINVALID_FLASH_FILE,
+		ImageErrorReasonFAILED_TO_FIX_CLICK_TAG_IN_FLASH,
+		ImageErrorReasonFLASH_ACCESSES_NETWORK_RESOURCES,
+		ImageErrorReasonFLASH_EXTERNAL_JS_CALL,
+		ImageErrorReasonFLASH_EXTERNAL_FS_CALL,
+		ImageErrorReasonFILE_TOO_LARGE,
+		ImageErrorReasonIMAGE_DATA_TOO_LARGE,
+		ImageErrorReasonIMAGE_PROCESSING_ERROR,
+		ImageErrorReasonIMAGE_TOO_SMALL,
+		ImageErrorReasonINVALID_INPUT,
+		ImageErrorReasonPROBLEM_READING_FILE:
+		return true
+	}
+	return false
+}
+
+// ParseImageErrorReason returns the ImageErrorReason named by s.
+func ParseImageErrorReason(s string) (ImageErrorReason, error) {
+	e := ImageErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ImageErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ImageErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ImageErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ImageErrorReason) UnmarshalText(text []byte) error {
+	v := ImageErrorReason(text)
+	*e = v
+	return nil
+}
+
+// InternalApiErrorReasonValues returns all values of InternalApiErrorReason.
+func InternalApiErrorReasonValues() []InternalApiErrorReason {
+	return []InternalApiErrorReason{
+		InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE,
+	}
+}
+
+// IsValid reports whether e is one of the values of InternalApiErrorReason.
+func (e InternalApiErrorReason) IsValid() bool {
+	switch e {
+	case InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE:
+		return true
+	}
+	return false
+}
+
+// ParseInternalApiErrorReason returns the InternalApiErrorReason named by s.
+func ParseInternalApiErrorReason(s string) (InternalApiErrorReason, error) {
+	e := InternalApiErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid InternalApiErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of InternalApiErrorReason.
+func (e InternalApiErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid InternalApiErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *InternalApiErrorReason) UnmarshalText(text []byte) error {
+	v := InternalApiErrorReason(text)
+	if !v.IsValid() {
+		v = InternalApiErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// LabelStatusValues returns all values of LabelStatus.
+func LabelStatusValues() []LabelStatus {
+	return []LabelStatus{
+		LabelStatusENABLED,
+		LabelStatusREMOVED,
+		LabelStatusUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of LabelStatus.
+func (e LabelStatus) IsValid() bool {
+	switch e {
+	case LabelStatusENABLED,
+		LabelStatusREMOVED,
+		LabelStatusUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseLabelStatus returns the LabelStatus named by s.
+func ParseLabelStatus(s string) (LabelStatus, error) {
+	e := LabelStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid LabelStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of LabelStatus.
+func (e LabelStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid LabelStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *LabelStatus) UnmarshalText(text []byte) error {
+	v := LabelStatus(text)
+	if !v.IsValid() {
+		v = LabelStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// MediaMediaTypeValues returns all values of MediaMediaType.
+func MediaMediaTypeValues() []MediaMediaType {
+	return []MediaMediaType{
+		MediaMediaTypeAUDIO,
+		MediaMediaTypeDYNAMIC_IMAGE,
+		MediaMediaTypeICON,
+		MediaMediaTypeIMAGE,
+		MediaMediaTypeSTANDARD_ICON,
+		MediaMediaTypeVIDEO,
+		MediaMediaTypeMEDIA_BUNDLE,
+	}
+}
+
+// IsValid reports whether e is one of the values of MediaMediaType.
+func (e MediaMediaType) IsValid() bool {
+	switch e {
+	case MediaMediaTypeAUDIO,
+		MediaMediaTypeDYNAMIC_IMAGE,
+		MediaMediaTypeICON,
+		MediaMediaTypeIMAGE,
+		MediaMediaTypeSTANDARD_ICON,
+		MediaMediaTypeVIDEO,
+		MediaMediaTypeMEDIA_BUNDLE:
+		return true
+	}
+	return false
+}
+
+// ParseMediaMediaType returns the MediaMediaType named by s.
+func ParseMediaMediaType(s string) (MediaMediaType, error) {
+	e := MediaMediaType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MediaMediaType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MediaMediaType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MediaMediaType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MediaMediaType) UnmarshalText(text []byte) error {
+	v := MediaMediaType(text)
+	*e = v
+	return nil
+}
+
+// MediaMimeTypeValues returns all values of MediaMimeType.
+func MediaMimeTypeValues() []MediaMimeType {
+	return []MediaMimeType{
+		MediaMimeTypeIMAGE_JPEG,
+		MediaMimeTypeIMAGE_GIF,
+		MediaMimeTypeIMAGE_PNG,
+		MediaMimeTypeFLASH,
+		MediaMimeTypeTEXT_HTML,
+		MediaMimeTypePDF,
+		MediaMimeTypeMSWORD,
+		MediaMimeTypeMSEXCEL,
+		MediaMimeTypeRTF,
+		MediaMimeTypeAUDIO_WAV,
+		MediaMimeTypeAUDIO_MP3,
+		MediaMimeTypeHTML5_AD_ZIP,
+	}
+}
+
+// IsValid reports whether e is one of the values of MediaMimeType.
+func (e MediaMimeType) IsValid() bool {
+	switch e {
+	case MediaMimeTypeIMAGE_JPEG,
+		MediaMimeTypeIMAGE_GIF,
+		MediaMimeTypeIMAGE_PNG,
+		MediaMimeTypeFLASH,
+		MediaMimeTypeTEXT_HTML,
+		MediaMimeTypePDF,
+		MediaMimeTypeMSWORD,
+		MediaMimeTypeMSEXCEL,
+		MediaMimeTypeRTF,
+		MediaMimeTypeAUDIO_WAV,
+		MediaMimeTypeAUDIO_MP3,
+		MediaMimeTypeHTML5_AD_ZIP:
+		return true
+	}
+	return false
+}
+
+// ParseMediaMimeType returns the MediaMimeType named by s.
+func ParseMediaMimeType(s string) (MediaMimeType, error) {
+	e := MediaMimeType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MediaMimeType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MediaMimeType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MediaMimeType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MediaMimeType) UnmarshalText(text []byte) error {
+	v := MediaMimeType(text)
+	*e = v
+	return nil
+}
+
+// MediaSizeValues returns all values of MediaSize.
+func MediaSizeValues() []MediaSize {
+	return []MediaSize{
+		MediaSizeFULL,
+		MediaSizeSHRUNKEN,
+		MediaSizePREVIEW,
+		MediaSizeVIDEO_THUMBNAIL,
+	}
+}
+
+// IsValid reports whether e is one of the values of MediaSize.
+func (e MediaSize) IsValid() bool {
+	switch e {
+	case MediaSizeFULL,
+		MediaSizeSHRUNKEN,
+		MediaSizePREVIEW,
+		MediaSizeVIDEO_THUMBNAIL:
+		return true
+	}
+	return false
+}
+
+// ParseMediaSize returns the MediaSize named by s.
+func ParseMediaSize(s string) (MediaSize, error) {
+	e := MediaSize(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MediaSize %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MediaSize are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MediaSize) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MediaSize) UnmarshalText(text []byte) error {
+	v := MediaSize(text)
+	*e = v
+	return nil
+}
+
+// MediaBundleErrorReasonValues returns all values of MediaBundleErrorReason.
+func MediaBundleErrorReasonValues() []MediaBundleErrorReason {
+	return []MediaBundleErrorReason{
+		MediaBundleErrorReasonENTRY_POINT_CANNOT_BE_SET_USING_MEDIA_SERVICE,
+		MediaBundleErrorReasonBAD_REQUEST,
+		MediaBundleErrorReasonDOUBLECLICK_BUNDLE_NOT_ALLOWED,
+		MediaBundleErrorReasonEXTERNAL_URL_NOT_ALLOWED,
+		MediaBundleErrorReasonFILE_TOO_LARGE,
+		MediaBundleErrorReasonGOOGLE_WEB_DESIGNER_ZIP_FILE_NOT_PUBLISHED,
+		MediaBundleErrorReasonINVALID_INPUT,
+		MediaBundleErrorReasonINVALID_MEDIA_BUNDLE,
+		MediaBundleErrorReasonINVALID_MEDIA_BUNDLE_ENTRY,
+		MediaBundleErrorReasonINVALID_MIME_TYPE,
+		MediaBundleErrorReasonINVALID_PATH,
+		MediaBundleErrorReasonINVALID_URL_REFERENCE,
+		MediaBundleErrorReasonMEDIA_DATA_TOO_LARGE,
+		MediaBundleErrorReasonMISSING_PRIMARY_MEDIA_BUNDLE_ENTRY,
+		MediaBundleErrorReasonSERVER_ERROR,
+		MediaBundleErrorReasonSTORAGE_ERROR,
+		MediaBundleErrorReasonSWIFFY_BUNDLE_NOT_ALLOWED,
+		MediaBundleErrorReasonTOO_MANY_FILES,
+		MediaBundleErrorReasonUNEXPECTED_SIZE,
+		MediaBundleErrorReasonUNSUPPORTED_GOOGLE_WEB_DESIGNER_ENVIRONMENT,
+		MediaBundleErrorReasonUNSUPPORTED_HTML5_FEATURE,
+		MediaBundleErrorReasonURL_IN_MEDIA_BUNDLE_NOT_SSL_COMPLIANT,
+	}
+}
+
+// IsValid reports whether e is one of the values of MediaBundleErrorReason.
+func (e MediaBundleErrorReason) IsValid() bool {
+	switch e {
+	case MediaBundleErrorReasonENTRY_POINT_CANNOT_BE_SET_USING_MEDIA_SERVICE,
+		MediaBundleErrorReasonBAD_REQUEST,
+		MediaBundleErrorReasonDOUBLECLICK_BUNDLE_NOT_ALLOWED,
+		MediaBundleErrorReasonEXTERNAL_URL_NOT_ALLOWED,
+		MediaBundleErrorReasonFILE_TOO_LARGE,
+		MediaBundleErrorReasonGOOGLE_WEB_DESIGNER_ZIP_FILE_NOT_PUBLISHED,
+		MediaBundleErrorReasonINVALID_INPUT,
+		MediaBundleErrorReasonINVALID_MEDIA_BUNDLE,
+		MediaBundleErrorReasonINVALID_MEDIA_BUNDLE_ENTRY,
+		MediaBundleErrorReasonINVALID_MIME_TYPE,
+		MediaBundleErrorReasonINVALID_PATH,
+		MediaBundleErrorReasonINVALID_URL_REFERENCE,
+		MediaBundleErrorReasonMEDIA_DATA_TOO_LARGE,
+		MediaBundleErrorReasonMISSING_PRIMARY_MEDIA_BUNDLE_ENTRY,
+		MediaBundleErrorReasonSERVER_ERROR,
+		MediaBundleErrorReasonSTORAGE_ERROR,
+		MediaBundleErrorReasonSWIFFY_BUNDLE_NOT_ALLOWED,
+		MediaBundleErrorReasonTOO_MANY_FILES,
+		MediaBundleErrorReasonUNEXPECTED_SIZE,
+		MediaBundleErrorReasonUNSUPPORTED_GOOGLE_WEB_DESIGNER_ENVIRONMENT,
+		MediaBundleErrorReasonUNSUPPORTED_HTML5_FEATURE,
+		MediaBundleErrorReasonURL_IN_MEDIA_BUNDLE_NOT_SSL_COMPLIANT:
+		return true
+	}
+	return false
+}
+
+// ParseMediaBundleErrorReason returns the MediaBundleErrorReason named by s.
+func ParseMediaBundleErrorReason(s string) (MediaBundleErrorReason, error) {
+	e := MediaBundleErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MediaBundleErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MediaBundleErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MediaBundleErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MediaBundleErrorReason) UnmarshalText(text []byte) error {
+	v := MediaBundleErrorReason(text)
+	*e = v
+	return nil
+}
+
+// MediaErrorReasonValues returns all values of MediaErrorReason.
+func MediaErrorReasonValues() []MediaErrorReason {
+	return []MediaErrorReason{
+		MediaErrorReasonCANNOT_ADD_STANDARD_ICON,
+		MediaErrorReasonCANNOT_SELECT_STANDARD_ICON_WITH_OTHER_TYPES,
+		MediaErrorReasonCANNOT_SPECIFY_MEDIA_ID_AND_DATA,
+		MediaErrorReasonDUPLICATE_MEDIA,
+		MediaErrorReasonEMPTY_FIELD,
+		MediaErrorReasonENTITY_REFERENCED_IN_MULTIPLE_OPS,
+		MediaErrorReasonFIELD_NOT_SUPPORTED_FOR_MEDIA_SUB_TYPE,
+		MediaErrorReasonINVALID_MEDIA_ID,
+		MediaErrorReasonINVALID_MEDIA_SUB_TYPE,
+		MediaErrorReasonINVALID_MEDIA_TYPE,
+		MediaErrorReasonINVALID_MIME_TYPE,
+		MediaErrorReasonINVALID_REFERENCE_ID,
+		MediaErrorReasonINVALID_YOU_TUBE_ID,
+		MediaErrorReasonMEDIA_FAILED_TRANSCODING,
+		MediaErrorReasonMEDIA_NOT_TRANSCODED,
+		MediaErrorReasonMEDIA_TYPE_DOES_NOT_MATCH_OBJECT_TYPE,
+		MediaErrorReasonNO_FIELDS_SPECIFIED,
+		MediaErrorReasonNULL_REFERENCE_ID_AND_MEDIA_ID,
+		MediaErrorReasonTOO_LONG,
+		MediaErrorReasonUNSUPPORTED_OPERATION,
+		MediaErrorReasonUNSUPPORTED_TYPE,
+		MediaErrorReasonYOU_TUBE_SERVICE_UNAVAILABLE,
+		MediaErrorReasonYOU_TUBE_VIDEO_HAS_NON_POSITIVE_DURATION,
+		MediaErrorReasonYOU_TUBE_VIDEO_NOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of MediaErrorReason.
+func (e MediaErrorReason) IsValid() bool {
+	switch e {
+	case MediaErrorReasonCANNOT_ADD_STANDARD_ICON,
+		MediaErrorReasonCANNOT_SELECT_STANDARD_ICON_WITH_OTHER_TYPES,
+		MediaErrorReasonCANNOT_SPECIFY_MEDIA_ID_AND_DATA,
+		MediaErrorReasonDUPLICATE_MEDIA,
+		MediaErrorReasonEMPTY_FIELD,
+		MediaErrorReasonENTITY_REFERENCED_IN_MULTIPLE_OPS,
+		MediaErrorReasonFIELD_NOT_SUPPORTED_FOR_MEDIA_SUB_TYPE,
+		MediaErrorReasonINVALID_MEDIA_ID,
+		MediaErrorReasonINVALID_MEDIA_SUB_TYPE,
+		MediaErrorReasonINVALID_MEDIA_TYPE,
+		MediaErrorReasonINVALID_MIME_TYPE,
+		MediaErrorReasonINVALID_REFERENCE_ID,
+		MediaErrorReasonINVALID_YOU_TUBE_ID,
+		MediaErrorReasonMEDIA_FAILED_TRANSCODING,
+		MediaErrorReasonMEDIA_NOT_TRANSCODED,
+		MediaErrorReasonMEDIA_TYPE_DOES_NOT_MATCH_OBJECT_TYPE,
+		MediaErrorReasonNO_FIELDS_SPECIFIED,
+		MediaErrorReasonNULL_REFERENCE_ID_AND_MEDIA_ID,
+		MediaErrorReasonTOO_LONG,
+		MediaErrorReasonUNSUPPORTED_OPERATION,
+		MediaErrorReasonUNSUPPORTED_TYPE,
+		MediaErrorReasonYOU_TUBE_SERVICE_UNAVAILABLE,
+		MediaErrorReasonYOU_TUBE_VIDEO_HAS_NON_POSITIVE_DURATION,
+		MediaErrorReasonYOU_TUBE_VIDEO_NOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseMediaErrorReason returns the MediaErrorReason named by s.
+func ParseMediaErrorReason(s string) (MediaErrorReason, error) {
+	e := MediaErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MediaErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MediaErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MediaErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MediaErrorReason) UnmarshalText(text []byte) error {
+	v := MediaErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NewEntityCreationErrorReasonValues returns all values of NewEntityCreationErrorReason.
+func NewEntityCreationErrorReasonValues() []NewEntityCreationErrorReason {
+	return []NewEntityCreationErrorReason{
+		NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS,
+	}
+}
+
+// IsValid reports whether e is one of the values of NewEntityCreationErrorReason.
+func (e NewEntityCreationErrorReason) IsValid() bool {
+	switch e {
+	case NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS:
+		return true
+	}
+	return false
+}
+
+// ParseNewEntityCreationErrorReason returns the NewEntityCreationErrorReason named by s.
+func ParseNewEntityCreationErrorReason(s string) (NewEntityCreationErrorReason, error) {
+	e := NewEntityCreationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NewEntityCreationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NewEntityCreationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NewEntityCreationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NewEntityCreationErrorReason) UnmarshalText(text []byte) error {
+	v := NewEntityCreationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NotEmptyErrorReasonValues returns all values of NotEmptyErrorReason.
+func NotEmptyErrorReasonValues() []NotEmptyErrorReason {
+	return []NotEmptyErrorReason{
+		NotEmptyErrorReasonEMPTY_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotEmptyErrorReason.
+func (e NotEmptyErrorReason) IsValid() bool {
+	switch e {
+	case NotEmptyErrorReasonEMPTY_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseNotEmptyErrorReason returns the NotEmptyErrorReason named by s.
+func ParseNotEmptyErrorReason(s string) (NotEmptyErrorReason, error) {
+	e := NotEmptyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotEmptyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotEmptyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotEmptyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotEmptyErrorReason) UnmarshalText(text []byte) error {
+	v := NotEmptyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NullErrorReasonValues returns all values of NullErrorReason.
+func NullErrorReasonValues() []NullErrorReason {
+	return []NullErrorReason{
+		NullErrorReasonNULL_CONTENT,
+	}
+}
+
+// IsValid reports whether e is one of the values of NullErrorReason.
+func (e NullErrorReason) IsValid() bool {
+	switch e {
+	case NullErrorReasonNULL_CONTENT:
+		return true
+	}
+	return false
+}
+
+// ParseNullErrorReason returns the NullErrorReason named by s.
+func ParseNullErrorReason(s string) (NullErrorReason, error) {
+	e := NullErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NullErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NullErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NullErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NullErrorReason) UnmarshalText(text []byte) error {
+	v := NullErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OperationAccessDeniedReasonValues returns all values of OperationAccessDeniedReason.
+func OperationAccessDeniedReasonValues() []OperationAccessDeniedReason {
+	return []OperationAccessDeniedReason{
+		OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) IsValid() bool {
+	switch e {
+	case OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperationAccessDeniedReason returns the OperationAccessDeniedReason named by s.
+func ParseOperationAccessDeniedReason(s string) (OperationAccessDeniedReason, error) {
+	e := OperationAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperationAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperationAccessDeniedReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperationAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := OperationAccessDeniedReason(text)
+	if !v.IsValid() {
+		v = OperationAccessDeniedReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperatorValues returns all values of Operator.
+func OperatorValues() []Operator {
+	return []Operator{
+		OperatorADD,
+		OperatorREMOVE,
+		OperatorSET,
+	}
+}
+
+// IsValid reports whether e is one of the values of Operator.
+func (e Operator) IsValid() bool {
+	switch e {
+	case OperatorADD,
+		OperatorREMOVE,
+		OperatorSET:
+		return true
+	}
+	return false
+}
+
+// ParseOperator returns the Operator named by s.
+func ParseOperator(s string) (Operator, error) {
+	e := Operator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid Operator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// Operator are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e Operator) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *Operator) UnmarshalText(text []byte) error {
+	v := Operator(text)
+	*e = v
+	return nil
+}
+
+// OperatorErrorReasonValues returns all values of OperatorErrorReason.
+func OperatorErrorReasonValues() []OperatorErrorReason {
+	return []OperatorErrorReason{
+		OperatorErrorReasonOPERATOR_NOT_SUPPORTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatorErrorReason.
+func (e OperatorErrorReason) IsValid() bool {
+	switch e {
+	case OperatorErrorReasonOPERATOR_NOT_SUPPORTED:
+		return true
+	}
+	return false
+}
+
+// ParseOperatorErrorReason returns the OperatorErrorReason named by s.
+func ParseOperatorErrorReason(s string) (OperatorErrorReason, error) {
+	e := OperatorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// OperatorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e OperatorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *OperatorErrorReason) UnmarshalText(text []byte) error {
+	v := OperatorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// PagingErrorReasonValues returns all values of PagingErrorReason.
+func PagingErrorReasonValues() []PagingErrorReason {
+	return []PagingErrorReason{
+		PagingErrorReasonSTART_INDEX_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonNUMBER_OF_RESULTS_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PagingErrorReason.
+func (e PagingErrorReason) IsValid() bool {
+	switch e {
+	case PagingErrorReasonSTART_INDEX_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonNUMBER_OF_RESULTS_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePagingErrorReason returns the PagingErrorReason named by s.
+func ParsePagingErrorReason(s string) (PagingErrorReason, error) {
+	e := PagingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PagingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PagingErrorReason.
+func (e PagingErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PagingErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PagingErrorReason) UnmarshalText(text []byte) error {
+	v := PagingErrorReason(text)
+	if !v.IsValid() {
+		v = PagingErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PolicyApprovalStatusValues returns all values of PolicyApprovalStatus.
+func PolicyApprovalStatusValues() []PolicyApprovalStatus {
+	return []PolicyApprovalStatus{
+		PolicyApprovalStatusUNKNOWN,
+		PolicyApprovalStatusAPPROVED,
+		PolicyApprovalStatusAPPROVED_LIMITED,
+		PolicyApprovalStatusELIGIBLE,
+		PolicyApprovalStatusUNDER_REVIEW,
+		PolicyApprovalStatusDISAPPROVED,
+		PolicyApprovalStatusSITE_SUSPENDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of PolicyApprovalStatus.
+func (e PolicyApprovalStatus) IsValid() bool {
+	switch e {
+	case PolicyApprovalStatusUNKNOWN,
+		PolicyApprovalStatusAPPROVED,
+		PolicyApprovalStatusAPPROVED_LIMITED,
+		PolicyApprovalStatusELIGIBLE,
+		PolicyApprovalStatusUNDER_REVIEW,
+		PolicyApprovalStatusDISAPPROVED,
+		PolicyApprovalStatusSITE_SUSPENDED:
+		return true
+	}
+	return false
+}
+
+// ParsePolicyApprovalStatus returns the PolicyApprovalStatus named by s.
+func ParsePolicyApprovalStatus(s string) (PolicyApprovalStatus, error) {
+	e := PolicyApprovalStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PolicyApprovalStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PolicyApprovalStatus.
+func (e PolicyApprovalStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PolicyApprovalStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PolicyApprovalStatus) UnmarshalText(text []byte) error {
+	v := PolicyApprovalStatus(text)
+	if !v.IsValid() {
+		v = PolicyApprovalStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PolicySummaryDenormalizedStatusValues returns all values of PolicySummaryDenormalizedStatus.
+func PolicySummaryDenormalizedStatusValues() []PolicySummaryDenormalizedStatus {
+	return []PolicySummaryDenormalizedStatus{
+		PolicySummaryDenormalizedStatusUNKNOWN,
+		PolicySummaryDenormalizedStatusDISAPPROVED,
+		PolicySummaryDenormalizedStatusAPPROVED_LIMITED,
+		PolicySummaryDenormalizedStatusAPPROVED,
+	}
+}
+
+// IsValid reports whether e is one of the values of PolicySummaryDenormalizedStatus.
+func (e PolicySummaryDenormalizedStatus) IsValid() bool {
+	switch e {
+	case PolicySummaryDenormalizedStatusUNKNOWN,
+		PolicySummaryDenormalizedStatusDISAPPROVED,
+		PolicySummaryDenormalizedStatusAPPROVED_LIMITED,
+		PolicySummaryDenormalizedStatusAPPROVED:
+		return true
+	}
+	return false
+}
+
+// ParsePolicySummaryDenormalizedStatus returns the PolicySummaryDenormalizedStatus named by s.
+func ParsePolicySummaryDenormalizedStatus(s string) (PolicySummaryDenormalizedStatus, error) {
+	e := PolicySummaryDenormalizedStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PolicySummaryDenormalizedStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PolicySummaryDenormalizedStatus.
+func (e PolicySummaryDenormalizedStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PolicySummaryDenormalizedStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PolicySummaryDenormalizedStatus) UnmarshalText(text []byte) error {
+	v := PolicySummaryDenormalizedStatus(text)
+	if !v.IsValid() {
+		v = PolicySummaryDenormalizedStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PolicySummaryReviewStateValues returns all values of PolicySummaryReviewState.
+func PolicySummaryReviewStateValues() []PolicySummaryReviewState {
+	return []PolicySummaryReviewState{
+		PolicySummaryReviewStateUNKNOWN,
+		PolicySummaryReviewStateREVIEW_IN_PROGRESS,
+		PolicySummaryReviewStateREVIEWED,
+		PolicySummaryReviewStateUNDER_APPEAL,
+	}
+}
+
+// IsValid reports whether e is one of the values of PolicySummaryReviewState.
+func (e PolicySummaryReviewState) IsValid() bool {
+	switch e {
+	case PolicySummaryReviewStateUNKNOWN,
+		PolicySummaryReviewStateREVIEW_IN_PROGRESS,
+		PolicySummaryReviewStateREVIEWED,
+		PolicySummaryReviewStateUNDER_APPEAL:
+		return true
+	}
+	return false
+}
+
+// ParsePolicySummaryReviewState returns the PolicySummaryReviewState named by s.
+func ParsePolicySummaryReviewState(s string) (PolicySummaryReviewState, error) {
+	e := PolicySummaryReviewState(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PolicySummaryReviewState %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PolicySummaryReviewState.
+func (e PolicySummaryReviewState) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PolicySummaryReviewState %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PolicySummaryReviewState) UnmarshalText(text []byte) error {
+	v := PolicySummaryReviewState(text)
+	if !v.IsValid() {
+		v = PolicySummaryReviewStateUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PolicyTopicConstraintPolicyTopicConstraintTypeValues returns all values of PolicyTopicConstraintPolicyTopicConstraintType.
+func PolicyTopicConstraintPolicyTopicConstraintTypeValues() []PolicyTopicConstraintPolicyTopicConstraintType {
+	return []PolicyTopicConstraintPolicyTopicConstraintType{
+		PolicyTopicConstraintPolicyTopicConstraintTypeUNKNOWN,
+		PolicyTopicConstraintPolicyTopicConstraintTypeCOUNTRY,
+		PolicyTopicConstraintPolicyTopicConstraintTypeRESELLER,
+		PolicyTopicConstraintPolicyTopicConstraintTypeCERTIFICATE_MISSING_IN_COUNTRY,
+		PolicyTopicConstraintPolicyTopicConstraintTypeCERTIFICATE_DOMAIN_MISMATCH_IN_COUNTRY,
+		PolicyTopicConstraintPolicyTopicConstraintTypeCERTIFICATE_MISSING,
+	}
+}
+
+// IsValid reports whether e is one of the values of PolicyTopicConstraintPolicyTopicConstraintType.
+func (e PolicyTopicConstraintPolicyTopicConstraintType) IsValid() bool {
+	switch e {
+	case PolicyTopicConstraintPolicyTopicConstraintTypeUNKNOWN,
+		PolicyTopicConstraintPolicyTopicConstraintTypeCOUNTRY,
+		PolicyTopicConstraintPolicyTopicConstraintTypeRESELLER,
+		PolicyTopicConstraintPolicyTopicConstraintTypeCERTIFICATE_MISSING_IN_COUNTRY,
+		PolicyTopicConstraintPolicyTopicConstraintTypeCERTIFICATE_DOMAIN_MISMATCH_IN_COUNTRY,
+		PolicyTopicConstraintPolicyTopicConstraintTypeCERTIFICATE_MISSING:
+		return true
+	}
+	return false
+}
+
+// ParsePolicyTopicConstraintPolicyTopicConstraintType returns the PolicyTopicConstraintPolicyTopicConstraintType named by s.
+func ParsePolicyTopicConstraintPolicyTopicConstraintType(s string) (PolicyTopicConstraintPolicyTopicConstraintType, error) {
+	e := PolicyTopicConstraintPolicyTopicConstraintType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PolicyTopicConstraintPolicyTopicConstraintType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PolicyTopicConstraintPolicyTopicConstraintType.
+func (e PolicyTopicConstraintPolicyTopicConstraintType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PolicyTopicConstraintPolicyTopicConstraintType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PolicyTopicConstraintPolicyTopicConstraintType) UnmarshalText(text []byte) error {
+	v := PolicyTopicConstraintPolicyTopicConstraintType(text)
+	if !v.IsValid() {
+		v = PolicyTopicConstraintPolicyTopicConstraintTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PolicyTopicEntryTypeValues returns all values of PolicyTopicEntryType.
+func PolicyTopicEntryTypeValues() []PolicyTopicEntryType {
+	return []PolicyTopicEntryType{
+		PolicyTopicEntryTypeUNKNOWN,
+		PolicyTopicEntryTypePROHIBITED,
+		PolicyTopicEntryTypeLIMITED,
+	}
+}
+
+// IsValid reports whether e is one of the values of PolicyTopicEntryType.
+func (e PolicyTopicEntryType) IsValid() bool {
+	switch e {
+	case PolicyTopicEntryTypeUNKNOWN,
+		PolicyTopicEntryTypePROHIBITED,
+		PolicyTopicEntryTypeLIMITED:
+		return true
+	}
+	return false
+}
+
+// ParsePolicyTopicEntryType returns the PolicyTopicEntryType named by s.
+func ParsePolicyTopicEntryType(s string) (PolicyTopicEntryType, error) {
+	e := PolicyTopicEntryType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PolicyTopicEntryType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PolicyTopicEntryType.
+func (e PolicyTopicEntryType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PolicyTopicEntryType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PolicyTopicEntryType) UnmarshalText(text []byte) error {
+	v := PolicyTopicEntryType(text)
+	if !v.IsValid() {
+		v = PolicyTopicEntryTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PolicyTopicEvidenceTypeValues returns all values of PolicyTopicEvidenceType.
+func PolicyTopicEvidenceTypeValues() []PolicyTopicEvidenceType {
+	return []PolicyTopicEvidenceType{
+		PolicyTopicEvidenceTypeUNKNOWN,
+		PolicyTopicEvidenceTypeAD_TEXT,
+		PolicyTopicEvidenceTypeHTTP_CODE,
+		PolicyTopicEvidenceTypeWEBSITES,
+		PolicyTopicEvidenceTypeLANGUAGE,
+		PolicyTopicEvidenceTypeDESTINATION_TEXT_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of PolicyTopicEvidenceType.
+func (e PolicyTopicEvidenceType) IsValid() bool {
+	switch e {
+	case PolicyTopicEvidenceTypeUNKNOWN,
+		PolicyTopicEvidenceTypeAD_TEXT,
+		PolicyTopicEvidenceTypeHTTP_CODE,
+		PolicyTopicEvidenceTypeWEBSITES,
+		PolicyTopicEvidenceTypeLANGUAGE,
+		PolicyTopicEvidenceTypeDESTINATION_TEXT_LIST:
+		return true
+	}
+	return false
+}
+
+// ParsePolicyTopicEvidenceType returns the PolicyTopicEvidenceType named by s.
+func ParsePolicyTopicEvidenceType(s string) (PolicyTopicEvidenceType, error) {
+	e := PolicyTopicEvidenceType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PolicyTopicEvidenceType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PolicyTopicEvidenceType.
+func (e PolicyTopicEvidenceType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PolicyTopicEvidenceType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PolicyTopicEvidenceType) UnmarshalText(text []byte) error {
+	v := PolicyTopicEvidenceType(text)
+	if !v.IsValid() {
+		v = PolicyTopicEvidenceTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PredicateOperatorValues returns all values of PredicateOperator.
+func PredicateOperatorValues() []PredicateOperator {
+	return []PredicateOperator{
+		PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PredicateOperator.
+func (e PredicateOperator) IsValid() bool {
+	switch e {
+	case PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePredicateOperator returns the PredicateOperator named by s.
+func ParsePredicateOperator(s string) (PredicateOperator, error) {
+	e := PredicateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PredicateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PredicateOperator.
+func (e PredicateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PredicateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PredicateOperator) UnmarshalText(text []byte) error {
+	v := PredicateOperator(text)
+	if !v.IsValid() {
+		v = PredicateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// QueryErrorReasonValues returns all values of QueryErrorReason.
+func QueryErrorReasonValues() []QueryErrorReason {
+	return []QueryErrorReason{
+		QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QueryErrorReason.
+func (e QueryErrorReason) IsValid() bool {
+	switch e {
+	case QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED:
+		return true
+	}
+	return false
+}
+
+// ParseQueryErrorReason returns the QueryErrorReason named by s.
+func ParseQueryErrorReason(s string) (QueryErrorReason, error) {
+	e := QueryErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QueryErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QueryErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QueryErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QueryErrorReason) UnmarshalText(text []byte) error {
+	v := QueryErrorReason(text)
+	*e = v
+	return nil
+}
+
+// QuotaCheckErrorReasonValues returns all values of QuotaCheckErrorReason.
+func QuotaCheckErrorReasonValues() []QuotaCheckErrorReason {
+	return []QuotaCheckErrorReason{
+		QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QuotaCheckErrorReason.
+func (e QuotaCheckErrorReason) IsValid() bool {
+	switch e {
+	case QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseQuotaCheckErrorReason returns the QuotaCheckErrorReason named by s.
+func ParseQuotaCheckErrorReason(s string) (QuotaCheckErrorReason, error) {
+	e := QuotaCheckErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QuotaCheckErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QuotaCheckErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QuotaCheckErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QuotaCheckErrorReason) UnmarshalText(text []byte) error {
+	v := QuotaCheckErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RangeErrorReasonValues returns all values of RangeErrorReason.
+func RangeErrorReasonValues() []RangeErrorReason {
+	return []RangeErrorReason{
+		RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH,
+	}
+}
+
+// IsValid reports whether e is one of the values of RangeErrorReason.
+func (e RangeErrorReason) IsValid() bool {
+	switch e {
+	case RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH:
+		return true
+	}
+	return false
+}
+
+// ParseRangeErrorReason returns the RangeErrorReason named by s.
+func ParseRangeErrorReason(s string) (RangeErrorReason, error) {
+	e := RangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RangeErrorReason) UnmarshalText(text []byte) error {
+	v := RangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RateExceededErrorReasonValues returns all values of RateExceededErrorReason.
+func RateExceededErrorReasonValues() []RateExceededErrorReason {
+	return []RateExceededErrorReason{
+		RateExceededErrorReasonRATE_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RateExceededErrorReason.
+func (e RateExceededErrorReason) IsValid() bool {
+	switch e {
+	case RateExceededErrorReasonRATE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseRateExceededErrorReason returns the RateExceededErrorReason named by s.
+func ParseRateExceededErrorReason(s string) (RateExceededErrorReason, error) {
+	e := RateExceededErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RateExceededErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RateExceededErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RateExceededErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RateExceededErrorReason) UnmarshalText(text []byte) error {
+	v := RateExceededErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ReadOnlyErrorReasonValues returns all values of ReadOnlyErrorReason.
+func ReadOnlyErrorReasonValues() []ReadOnlyErrorReason {
+	return []ReadOnlyErrorReason{
+		ReadOnlyErrorReasonREAD_ONLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ReadOnlyErrorReason.
+func (e ReadOnlyErrorReason) IsValid() bool {
+	switch e {
+	case ReadOnlyErrorReasonREAD_ONLY:
+		return true
+	}
+	return false
+}
+
+// ParseReadOnlyErrorReason returns the ReadOnlyErrorReason named by s.
+func ParseReadOnlyErrorReason(s string) (ReadOnlyErrorReason, error) {
+	e := ReadOnlyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ReadOnlyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ReadOnlyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ReadOnlyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ReadOnlyErrorReason) UnmarshalText(text []byte) error {
+	v := ReadOnlyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RejectedErrorReasonValues returns all values of RejectedErrorReason.
+func RejectedErrorReasonValues() []RejectedErrorReason {
+	return []RejectedErrorReason{
+		RejectedErrorReasonUNKNOWN_VALUE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RejectedErrorReason.
+func (e RejectedErrorReason) IsValid() bool {
+	switch e {
+	case RejectedErrorReasonUNKNOWN_VALUE:
+		return true
+	}
+	return false
+}
+
+// ParseRejectedErrorReason returns the RejectedErrorReason named by s.
+func ParseRejectedErrorReason(s string) (RejectedErrorReason, error) {
+	e := RejectedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RejectedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RejectedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RejectedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RejectedErrorReason) UnmarshalText(text []byte) error {
+	v := RejectedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RequestErrorReasonValues returns all values of RequestErrorReason.
+func RequestErrorReasonValues() []RequestErrorReason {
+	return []RequestErrorReason{
+		RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequestErrorReason.
+func (e RequestErrorReason) IsValid() bool {
+	switch e {
+	case RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION:
+		return true
+	}
+	return false
+}
+
+// ParseRequestErrorReason returns the RequestErrorReason named by s.
+func ParseRequestErrorReason(s string) (RequestErrorReason, error) {
+	e := RequestErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequestErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of RequestErrorReason.
+func (e RequestErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid RequestErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *RequestErrorReason) UnmarshalText(text []byte) error {
+	v := RequestErrorReason(text)
+	if !v.IsValid() {
+		v = RequestErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RequiredErrorReasonValues returns all values of RequiredErrorReason.
+func RequiredErrorReasonValues() []RequiredErrorReason {
+	return []RequiredErrorReason{
+		RequiredErrorReasonREQUIRED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequiredErrorReason.
+func (e RequiredErrorReason) IsValid() bool {
+	switch e {
+	case RequiredErrorReasonREQUIRED:
+		return true
+	}
+	return false
+}
+
+// ParseRequiredErrorReason returns the RequiredErrorReason named by s.
+func ParseRequiredErrorReason(s string) (RequiredErrorReason, error) {
+	e := RequiredErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequiredErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RequiredErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RequiredErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RequiredErrorReason) UnmarshalText(text []byte) error {
+	v := RequiredErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RichMediaAdAdAttributeValues returns all values of RichMediaAdAdAttribute.
+func RichMediaAdAdAttributeValues() []RichMediaAdAdAttribute {
+	return []RichMediaAdAdAttribute{
+		RichMediaAdAdAttributeUNKNOWN,
+		RichMediaAdAdAttributeROLL_OVER_TO_EXPAND,
+		RichMediaAdAdAttributeSSL,
+	}
+}
+
+// IsValid reports whether e is one of the values of RichMediaAdAdAttribute.
+func (e RichMediaAdAdAttribute) IsValid() bool {
+	switch e {
+	case RichMediaAdAdAttributeUNKNOWN,
+		RichMediaAdAdAttributeROLL_OVER_TO_EXPAND,
+		RichMediaAdAdAttributeSSL:
+		return true
+	}
+	return false
+}
+
+// ParseRichMediaAdAdAttribute returns the RichMediaAdAdAttribute named by s.
+func ParseRichMediaAdAdAttribute(s string) (RichMediaAdAdAttribute, error) {
+	e := RichMediaAdAdAttribute(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RichMediaAdAdAttribute %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of RichMediaAdAdAttribute.
+func (e RichMediaAdAdAttribute) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid RichMediaAdAdAttribute %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *RichMediaAdAdAttribute) UnmarshalText(text []byte) error {
+	v := RichMediaAdAdAttribute(text)
+	if !v.IsValid() {
+		v = RichMediaAdAdAttributeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RichMediaAdRichMediaAdTypeValues returns all values of RichMediaAdRichMediaAdType.
+func RichMediaAdRichMediaAdTypeValues() []RichMediaAdRichMediaAdType {
+	return []RichMediaAdRichMediaAdType{
+		RichMediaAdRichMediaAdTypeSTANDARD,
+		RichMediaAdRichMediaAdTypeIN_STREAM_VIDEO,
+	}
+}
+
+// IsValid reports whether e is one of the values of RichMediaAdRichMediaAdType.
+func (e RichMediaAdRichMediaAdType) IsValid() bool {
+	switch e {
+	case RichMediaAdRichMediaAdTypeSTANDARD,
+		RichMediaAdRichMediaAdTypeIN_STREAM_VIDEO:
+		return true
+	}
+	return false
+}
+
+// ParseRichMediaAdRichMediaAdType returns the RichMediaAdRichMediaAdType named by s.
+func ParseRichMediaAdRichMediaAdType(s string) (RichMediaAdRichMediaAdType, error) {
+	e := RichMediaAdRichMediaAdType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RichMediaAdRichMediaAdType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RichMediaAdRichMediaAdType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RichMediaAdRichMediaAdType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RichMediaAdRichMediaAdType) UnmarshalText(text []byte) error {
+	v := RichMediaAdRichMediaAdType(text)
+	*e = v
+	return nil
+}
+
+// SelectorErrorReasonValues returns all values of SelectorErrorReason.
+func SelectorErrorReasonValues() []SelectorErrorReason {
+	return []SelectorErrorReason{
+		SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of SelectorErrorReason.
+func (e SelectorErrorReason) IsValid() bool {
+	switch e {
+	case SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseSelectorErrorReason returns the SelectorErrorReason named by s.
+func ParseSelectorErrorReason(s string) (SelectorErrorReason, error) {
+	e := SelectorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SelectorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SelectorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SelectorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SelectorErrorReason) UnmarshalText(text []byte) error {
+	v := SelectorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SizeLimitErrorReasonValues returns all values of SizeLimitErrorReason.
+func SizeLimitErrorReasonValues() []SizeLimitErrorReason {
+	return []SizeLimitErrorReason{
+		SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) IsValid() bool {
+	switch e {
+	case SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSizeLimitErrorReason returns the SizeLimitErrorReason named by s.
+func ParseSizeLimitErrorReason(s string) (SizeLimitErrorReason, error) {
+	e := SizeLimitErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeLimitErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SizeLimitErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SizeLimitErrorReason) UnmarshalText(text []byte) error {
+	v := SizeLimitErrorReason(text)
+	if !v.IsValid() {
+		v = SizeLimitErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SortOrderValues returns all values of SortOrder.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASCENDING,
+		SortOrderDESCENDING,
+	}
+}
+
+// IsValid reports whether e is one of the values of SortOrder.
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderASCENDING,
+		SortOrderDESCENDING:
+		return true
+	}
+	return false
+}
+
+// ParseSortOrder returns the SortOrder named by s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	e := SortOrder(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SortOrder %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SortOrder are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	*e = v
+	return nil
+}
+
+// StatsQueryErrorReasonValues returns all values of StatsQueryErrorReason.
+func StatsQueryErrorReasonValues() []StatsQueryErrorReason {
+	return []StatsQueryErrorReason{
+		StatsQueryErrorReasonDATE_NOT_IN_VALID_RANGE,
+	}
+}
+
+// IsValid reports whether e is one of the values of StatsQueryErrorReason.
+func (e StatsQueryErrorReason) IsValid() bool {
+	switch e {
+	case StatsQueryErrorReasonDATE_NOT_IN_VALID_RANGE:
+		return true
+	}
+	return false
+}
+
+// ParseStatsQueryErrorReason returns the StatsQueryErrorReason named by s.
+func ParseStatsQueryErrorReason(s string) (StatsQueryErrorReason, error) {
+	e := StatsQueryErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StatsQueryErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StatsQueryErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StatsQueryErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StatsQueryErrorReason) UnmarshalText(text []byte) error {
+	v := StatsQueryErrorReason(text)
+	*e = v
+	return nil
+}
+
+// StringFormatErrorReasonValues returns all values of StringFormatErrorReason.
+func StringFormatErrorReasonValues() []StringFormatErrorReason {
+	return []StringFormatErrorReason{
+		StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringFormatErrorReason.
+func (e StringFormatErrorReason) IsValid() bool {
+	switch e {
+	case StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT:
+		return true
+	}
+	return false
+}
+
+// ParseStringFormatErrorReason returns the StringFormatErrorReason named by s.
+func ParseStringFormatErrorReason(s string) (StringFormatErrorReason, error) {
+	e := StringFormatErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringFormatErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringFormatErrorReason.
+func (e StringFormatErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringFormatErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringFormatErrorReason) UnmarshalText(text []byte) error {
+	v := StringFormatErrorReason(text)
+	if !v.IsValid() {
+		v = StringFormatErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// StringLengthErrorReasonValues returns all values of StringLengthErrorReason.
+func StringLengthErrorReasonValues() []StringLengthErrorReason {
+	return []StringLengthErrorReason{
+		StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringLengthErrorReason.
+func (e StringLengthErrorReason) IsValid() bool {
+	switch e {
+	case StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG:
+		return true
+	}
+	return false
+}
+
+// ParseStringLengthErrorReason returns the StringLengthErrorReason named by s.
+func ParseStringLengthErrorReason(s string) (StringLengthErrorReason, error) {
+	e := StringLengthErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringLengthErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StringLengthErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StringLengthErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StringLengthErrorReason) UnmarshalText(text []byte) error {
+	v := StringLengthErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SystemManagedEntitySourceValues returns all values of SystemManagedEntitySource.
+func SystemManagedEntitySourceValues() []SystemManagedEntitySource {
+	return []SystemManagedEntitySource{
+		SystemManagedEntitySourceUNKNOWN,
+		SystemManagedEntitySourceAD_VARIATIONS,
+	}
+}
+
+// IsValid reports whether e is one of the values of SystemManagedEntitySource.
+func (e SystemManagedEntitySource) IsValid() bool {
+	switch e {
+	case SystemManagedEntitySourceUNKNOWN,
+		SystemManagedEntitySourceAD_VARIATIONS:
+		return true
+	}
+	return false
+}
+
+// ParseSystemManagedEntitySource returns the SystemManagedEntitySource named by s.
+func ParseSystemManagedEntitySource(s string) (SystemManagedEntitySource, error) {
+	e := SystemManagedEntitySource(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SystemManagedEntitySource %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SystemManagedEntitySource.
+func (e SystemManagedEntitySource) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SystemManagedEntitySource %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SystemManagedEntitySource) UnmarshalText(text []byte) error {
+	v := SystemManagedEntitySource(text)
+	if !v.IsValid() {
+		v = SystemManagedEntitySourceUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// TemplateElementFieldTypeValues returns all values of TemplateElementFieldType.
+func TemplateElementFieldTypeValues() []TemplateElementFieldType {
+	return []TemplateElementFieldType{
+		TemplateElementFieldTypeADDRESS,
+		TemplateElementFieldTypeAUDIO,
+		TemplateElementFieldTypeENUM,
+		TemplateElementFieldTypeIMAGE,
+		TemplateElementFieldTypeBACKGROUND_IMAGE,
+		TemplateElementFieldTypeNUMBER,
+		TemplateElementFieldTypeTEXT,
+		TemplateElementFieldTypeURL,
+		TemplateElementFieldTypeVIDEO,
+		TemplateElementFieldTypeVISIBLE_URL,
+		TemplateElementFieldTypeMEDIA_BUNDLE,
+		TemplateElementFieldTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of TemplateElementFieldType.
+func (e TemplateElementFieldType) IsValid() bool {
+	switch e {
+	case TemplateElementFieldTypeADDRESS,
+		TemplateElementFieldTypeAUDIO,
+		TemplateElementFieldTypeENUM,
+		TemplateElementFieldTypeIMAGE,
+		TemplateElementFieldTypeBACKGROUND_IMAGE,
+		TemplateElementFieldTypeNUMBER,
+		TemplateElementFieldTypeTEXT,
+		TemplateElementFieldTypeURL,
+		TemplateElementFieldTypeVIDEO,
+		TemplateElementFieldTypeVISIBLE_URL,
+		TemplateElementFieldTypeMEDIA_BUNDLE,
+		TemplateElementFieldTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseTemplateElementFieldType returns the TemplateElementFieldType named by s.
+func ParseTemplateElementFieldType(s string) (TemplateElementFieldType, error) {
+	e := TemplateElementFieldType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid TemplateElementFieldType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of TemplateElementFieldType.
+func (e TemplateElementFieldType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid TemplateElementFieldType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *TemplateElementFieldType) UnmarshalText(text []byte) error {
+	v := TemplateElementFieldType(text)
+	if !v.IsValid() {
+		v = TemplateElementFieldTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ThirdPartyRedirectAdExpandingDirectionValues returns all values of ThirdPartyRedirectAdExpandingDirection.
+func ThirdPartyRedirectAdExpandingDirectionValues() []ThirdPartyRedirectAdExpandingDirection {
+	return []ThirdPartyRedirectAdExpandingDirection{
+		ThirdPartyRedirectAdExpandingDirectionUNKNOWN,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_UP,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_DOWN,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_LEFT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_RIGHT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_UPLEFT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_UPRIGHT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_DOWNLEFT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_DOWNRIGHT,
+	}
+}
+
+// IsValid reports whether e is one of the values of ThirdPartyRedirectAdExpandingDirection.
+func (e ThirdPartyRedirectAdExpandingDirection) IsValid() bool {
+	switch e {
+	case ThirdPartyRedirectAdExpandingDirectionUNKNOWN,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_UP,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_DOWN,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_LEFT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_RIGHT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_UPLEFT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_UPRIGHT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_DOWNLEFT,
+		ThirdPartyRedirectAdExpandingDirectionEXPANDING_DOWNRIGHT:
+		return true
+	}
+	return false
+}
+
+// ParseThirdPartyRedirectAdExpandingDirection returns the ThirdPartyRedirectAdExpandingDirection named by s.
+func ParseThirdPartyRedirectAdExpandingDirection(s string) (ThirdPartyRedirectAdExpandingDirection, error) {
+	e := ThirdPartyRedirectAdExpandingDirection(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ThirdPartyRedirectAdExpandingDirection %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ThirdPartyRedirectAdExpandingDirection.
+func (e ThirdPartyRedirectAdExpandingDirection) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ThirdPartyRedirectAdExpandingDirection %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ThirdPartyRedirectAdExpandingDirection) UnmarshalText(text []byte) error {
+	v := ThirdPartyRedirectAdExpandingDirection(text)
+	if !v.IsValid() {
+		v = ThirdPartyRedirectAdExpandingDirectionUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// UrlErrorReasonValues returns all values of UrlErrorReason.
+func UrlErrorReasonValues() []UrlErrorReason {
+	return []UrlErrorReason{
+		UrlErrorReasonINVALID_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TAG_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMALFORMED_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_HOST_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TLD_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonREDUNDANT_NESTED_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonINVALID_FINAL_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonMALFORMED_FINAL_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_URL,
+		UrlErrorReasonINVALID_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_MOBILE_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonMALFORMED_FINAL_MOBILE_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_FINAL_APP_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_APP_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_APP_URL_TAG,
+		UrlErrorReasonMULTIPLE_APP_URLS_FOR_OSTYPE,
+		UrlErrorReasonINVALID_OSTYPE,
+		UrlErrorReasonINVALID_PROTOCOL_FOR_APP_URL,
+		UrlErrorReasonINVALID_PACKAGE_ID_FOR_APP_URL,
+		UrlErrorReasonURL_CUSTOM_PARAMETERS_COUNT_EXCEEDS_LIMIT,
+		UrlErrorReasonURL_CUSTOM_PARAMETER_REMOVAL_WITH_NON_NULL_VALUE,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_IN_ADD_OPERATION,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_DURING_FULL_REPLACEMENT,
+		UrlErrorReasonNULL_CUSTOM_PARAMETER_VALUE_DURING_ADD_OR_FULL_REPLACEMENT,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_KEY,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonINVALID_TAG_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonREDUNDANT_NESTED_URL_CUSTOM_PARAMETER_TAG,
+		UrlErrorReasonMISSING_PROTOCOL,
+		UrlErrorReasonINVALID_URL,
+		UrlErrorReasonDESTINATION_URL_DEPRECATED,
+		UrlErrorReasonINVALID_TAG_IN_URL,
+		UrlErrorReasonMISSING_URL_TAG,
+		UrlErrorReasonDUPLICATE_URL_ID,
+		UrlErrorReasonINVALID_URL_ID,
+		UrlErrorReasonURL_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of UrlErrorReason.
+func (e UrlErrorReason) IsValid() bool {
+	switch e {
+	case UrlErrorReasonINVALID_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TAG_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMALFORMED_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_HOST_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TLD_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonREDUNDANT_NESTED_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonINVALID_FINAL_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonMALFORMED_FINAL_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_URL,
+		UrlErrorReasonINVALID_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_MOBILE_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonMALFORMED_FINAL_MOBILE_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_FINAL_APP_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_APP_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_APP_URL_TAG,
+		UrlErrorReasonMULTIPLE_APP_URLS_FOR_OSTYPE,
+		UrlErrorReasonINVALID_OSTYPE,
+		UrlErrorReasonINVALID_PROTOCOL_FOR_APP_URL,
+		UrlErrorReasonINVALID_PACKAGE_ID_FOR_APP_URL,
+		UrlErrorReasonURL_CUSTOM_PARAMETERS_COUNT_EXCEEDS_LIMIT,
+		UrlErrorReasonURL_CUSTOM_PARAMETER_REMOVAL_WITH_NON_NULL_VALUE,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_IN_ADD_OPERATION,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_DURING_FULL_REPLACEMENT,
+		UrlErrorReasonNULL_CUSTOM_PARAMETER_VALUE_DURING_ADD_OR_FULL_REPLACEMENT,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_KEY,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonINVALID_TAG_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonREDUNDANT_NESTED_URL_CUSTOM_PARAMETER_TAG,
+		UrlErrorReasonMISSING_PROTOCOL,
+		UrlErrorReasonINVALID_URL,
+		UrlErrorReasonDESTINATION_URL_DEPRECATED,
+		UrlErrorReasonINVALID_TAG_IN_URL,
+		UrlErrorReasonMISSING_URL_TAG,
+		UrlErrorReasonDUPLICATE_URL_ID,
+		UrlErrorReasonINVALID_URL_ID,
+		UrlErrorReasonURL_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseUrlErrorReason returns the UrlErrorReason named by s.
+func ParseUrlErrorReason(s string) (UrlErrorReason, error) {
+	e := UrlErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UrlErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// UrlErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e UrlErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *UrlErrorReason) UnmarshalText(text []byte) error {
+	v := UrlErrorReason(text)
+	*e = v
+	return nil
+}
+
+// VideoTypeValues returns all values of VideoType.
+func VideoTypeValues() []VideoType {
+	return []VideoType{
+		VideoTypeADOBE,
+		VideoTypeREALPLAYER,
+		VideoTypeQUICKTIME,
+		VideoTypeWINDOWSMEDIA,
+	}
+}
+
+// IsValid reports whether e is one of the values of VideoType.
+func (e VideoType) IsValid() bool {
+	switch e {
+	case VideoTypeADOBE,
+		VideoTypeREALPLAYER,
+		VideoTypeQUICKTIME,
+		VideoTypeWINDOWSMEDIA:
+		return true
+	}
+	return false
+}
+
+// ParseVideoType returns the VideoType named by s.
+func ParseVideoType(s string) (VideoType, error) {
+	e := VideoType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid VideoType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// VideoType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e VideoType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *VideoType) UnmarshalText(text []byte) error {
+	v := VideoType(text)
+	*e = v
+	return nil
+}
@@ -0,0 +1,2278 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupAdService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AdFields holds the selector field names of Ad, including
+// the fields of the types it holds.
+var AdFields = struct {
+	AccentColor                            string
+	AdType                                 string
+	AdvertisingId                          string
+	AllowFlexibleColor                     string
+	Automated                              string
+	BusinessName                           string
+	CallOnlyAdBusinessName                 string
+	CallOnlyAdCallTracked                  string
+	CallOnlyAdConversionTypeId             string
+	CallOnlyAdCountryCode                  string
+	CallOnlyAdDescription1                 string
+	CallOnlyAdDescription2                 string
+	CallOnlyAdDisableCallConversion        string
+	CallOnlyAdPhoneNumber                  string
+	CallOnlyAdPhoneNumberVerificationUrl   string
+	CallToActionText                       string
+	CreationTime                           string
+	CreativeFinalAppUrls                   string
+	CreativeFinalMobileUrls                string
+	CreativeFinalUrls                      string
+	CreativeTrackingUrlTemplate            string
+	CreativeUrlCustomParameters            string
+	Description                            string
+	Description1                           string
+	Description2                           string
+	DevicePreference                       string
+	Dimensions                             string
+	DisplayUploadAdGmailTeaserBusinessName string
+	DisplayUploadAdGmailTeaserDescription  string
+	DisplayUploadAdGmailTeaserHeadline     string
+	DisplayUploadAdGmailTeaserLogoImage    string
+	DisplayUrl                             string
+	DurationMillis                         string
+	ExpandingDirections                    string
+	FileSize                               string
+	FormatSetting                          string
+	GmailHeaderImage                       string
+	GmailMarketingImage                    string
+	GmailTeaserBusinessName                string
+	GmailTeaserDescription                 string
+	GmailTeaserHeadline                    string
+	GmailTeaserLogoImage                   string
+	Headline                               string
+	HeadlinePart1                          string
+	HeadlinePart2                          string
+	Height                                 string
+	Id                                     string
+	ImageCreativeName                      string
+	IndustryStandardCommercialIdentifier   string
+	IsCookieTargeted                       string
+	IsTagged                               string
+	IsUserInterestTargeted                 string
+	LandscapeLogoImage                     string
+	LogoImage                              string
+	LongHeadline                           string
+	MainColor                              string
+	MarketingImage                         string
+	MarketingImageCallToActionText         string
+	MarketingImageCallToActionTextColor    string
+	MarketingImageDescription              string
+	MarketingImageHeadline                 string
+	MediaId                                string
+	MimeType                               string
+	Name                                   string
+	Path1                                  string
+	Path2                                  string
+	PricePrefix                            string
+	ProductImages                          string
+	ProductVideoList                       string
+	PromoText                              string
+	ReadyToPlayOnTheWeb                    string
+	ReferenceId                            string
+	RichMediaAdCertifiedVendorFormatId     string
+	RichMediaAdDuration                    string
+	RichMediaAdImpressionBeaconUrl         string
+	RichMediaAdName                        string
+	RichMediaAdSnippet                     string
+	RichMediaAdSourceUrl                   string
+	RichMediaAdType                        string
+	ShortHeadline                          string
+	SourceUrl                              string
+	SquareMarketingImage                   string
+	StreamingUrl                           string
+	SystemManagedEntitySource              string
+	TemplateAdDuration                     string
+	TemplateAdName                         string
+	TemplateAdUnionId                      string
+	TemplateElementFieldName               string
+	TemplateElementFieldText               string
+	TemplateElementFieldType               string
+	TemplateId                             string
+	TemplateOriginAdId                     string
+	Type                                   string
+	UniqueName                             string
+	Url                                    string
+	UrlData                                string
+	Urls                                   string
+	VideoTypes                             string
+	Width                                  string
+	YouTubeVideoIdString                   string
+}{
+	AccentColor:                            "AccentColor",
+	AdType:                                 "AdType",
+	AdvertisingId:                          "AdvertisingId",
+	AllowFlexibleColor:                     "AllowFlexibleColor",
+	Automated:                              "Automated",
+	BusinessName:                           "BusinessName",
+	CallOnlyAdBusinessName:                 "CallOnlyAdBusinessName",
+	CallOnlyAdCallTracked:                  "CallOnlyAdCallTracked",
+	CallOnlyAdConversionTypeId:             "CallOnlyAdConversionTypeId",
+	CallOnlyAdCountryCode:                  "CallOnlyAdCountryCode",
+	CallOnlyAdDescription1:                 "CallOnlyAdDescription1",
+	CallOnlyAdDescription2:                 "CallOnlyAdDescription2",
+	CallOnlyAdDisableCallConversion:        "CallOnlyAdDisableCallConversion",
+	CallOnlyAdPhoneNumber:                  "CallOnlyAdPhoneNumber",
+	CallOnlyAdPhoneNumberVerificationUrl:   "CallOnlyAdPhoneNumberVerificationUrl",
+	CallToActionText:                       "CallToActionText",
+	CreationTime:                           "CreationTime",
+	CreativeFinalAppUrls:                   "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:                "CreativeFinalMobileUrls",
+	CreativeFinalUrls:                      "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate:            "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters:            "CreativeUrlCustomParameters",
+	Description:                            "Description",
+	Description1:                           "Description1",
+	Description2:                           "Description2",
+	DevicePreference:                       "DevicePreference",
+	Dimensions:                             "Dimensions",
+	DisplayUploadAdGmailTeaserBusinessName: "DisplayUploadAdGmailTeaserBusinessName",
+	DisplayUploadAdGmailTeaserDescription:  "DisplayUploadAdGmailTeaserDescription",
+	DisplayUploadAdGmailTeaserHeadline:     "DisplayUploadAdGmailTeaserHeadline",
+	DisplayUploadAdGmailTeaserLogoImage:    "DisplayUploadAdGmailTeaserLogoImage",
+	DisplayUrl:                             "DisplayUrl",
+	DurationMillis:                         "DurationMillis",
+	ExpandingDirections:                    "ExpandingDirections",
+	FileSize:                               "FileSize",
+	FormatSetting:                          "FormatSetting",
+	GmailHeaderImage:                       "GmailHeaderImage",
+	GmailMarketingImage:                    "GmailMarketingImage",
+	GmailTeaserBusinessName:                "GmailTeaserBusinessName",
+	GmailTeaserDescription:                 "GmailTeaserDescription",
+	GmailTeaserHeadline:                    "GmailTeaserHeadline",
+	GmailTeaserLogoImage:                   "GmailTeaserLogoImage",
+	Headline:                               "Headline",
+	HeadlinePart1:                          "HeadlinePart1",
+	HeadlinePart2:                          "HeadlinePart2",
+	Height:                                 "Height",
+	Id:                                     "Id",
+	ImageCreativeName:                      "ImageCreativeName",
+	IndustryStandardCommercialIdentifier:   "IndustryStandardCommercialIdentifier",
+	IsCookieTargeted:                       "IsCookieTargeted",
+	IsTagged:                               "IsTagged",
+	IsUserInterestTargeted:                 "IsUserInterestTargeted",
+	LandscapeLogoImage:                     "LandscapeLogoImage",
+	LogoImage:                              "LogoImage",
+	LongHeadline:                           "LongHeadline",
+	MainColor:                              "MainColor",
+	MarketingImage:                         "MarketingImage",
+	MarketingImageCallToActionText:         "MarketingImageCallToActionText",
+	MarketingImageCallToActionTextColor:    "MarketingImageCallToActionTextColor",
+	MarketingImageDescription:              "MarketingImageDescription",
+	MarketingImageHeadline:                 "MarketingImageHeadline",
+	MediaId:                                "MediaId",
+	MimeType:                               "MimeType",
+	Name:                                   "Name",
+	Path1:                                  "Path1",
+	Path2:                                  "Path2",
+	PricePrefix:                            "PricePrefix",
+	ProductImages:                          "ProductImages",
+	ProductVideoList:                       "ProductVideoList",
+	PromoText:                              "PromoText",
+	ReadyToPlayOnTheWeb:                    "ReadyToPlayOnTheWeb",
+	ReferenceId:                            "ReferenceId",
+	RichMediaAdCertifiedVendorFormatId:     "RichMediaAdCertifiedVendorFormatId",
+	RichMediaAdDuration:                    "RichMediaAdDuration",
+	RichMediaAdImpressionBeaconUrl:         "RichMediaAdImpressionBeaconUrl",
+	RichMediaAdName:                        "RichMediaAdName",
+	RichMediaAdSnippet:                     "RichMediaAdSnippet",
+	RichMediaAdSourceUrl:                   "RichMediaAdSourceUrl",
+	RichMediaAdType:                        "RichMediaAdType",
+	ShortHeadline:                          "ShortHeadline",
+	SourceUrl:                              "SourceUrl",
+	SquareMarketingImage:                   "SquareMarketingImage",
+	StreamingUrl:                           "StreamingUrl",
+	SystemManagedEntitySource:              "SystemManagedEntitySource",
+	TemplateAdDuration:                     "TemplateAdDuration",
+	TemplateAdName:                         "TemplateAdName",
+	TemplateAdUnionId:                      "TemplateAdUnionId",
+	TemplateElementFieldName:               "TemplateElementFieldName",
+	TemplateElementFieldText:               "TemplateElementFieldText",
+	TemplateElementFieldType:               "TemplateElementFieldType",
+	TemplateId:                             "TemplateId",
+	TemplateOriginAdId:                     "TemplateOriginAdId",
+	Type:                                   "Type",
+	UniqueName:                             "UniqueName",
+	Url:                                    "Url",
+	UrlData:                                "UrlData",
+	Urls:                                   "Urls",
+	VideoTypes:                             "VideoTypes",
+	Width:                                  "Width",
+	YouTubeVideoIdString:                   "YouTubeVideoIdString",
+}
+
+// AdGroupAdFields holds the selector field names of AdGroupAd, including
+// the fields of the types it holds.
+var AdGroupAdFields = struct {
+	AccentColor                            string
+	AdGroupId                              string
+	AdType                                 string
+	AdvertisingId                          string
+	AllowFlexibleColor                     string
+	Automated                              string
+	BaseAdGroupId                          string
+	BaseCampaignId                         string
+	BusinessName                           string
+	CallOnlyAdBusinessName                 string
+	CallOnlyAdCallTracked                  string
+	CallOnlyAdConversionTypeId             string
+	CallOnlyAdCountryCode                  string
+	CallOnlyAdDescription1                 string
+	CallOnlyAdDescription2                 string
+	CallOnlyAdDisableCallConversion        string
+	CallOnlyAdPhoneNumber                  string
+	CallOnlyAdPhoneNumberVerificationUrl   string
+	CallToActionText                       string
+	CombinedApprovalStatus                 string
+	CreationTime                           string
+	CreativeFinalAppUrls                   string
+	CreativeFinalMobileUrls                string
+	CreativeFinalUrls                      string
+	CreativeTrackingUrlTemplate            string
+	CreativeUrlCustomParameters            string
+	Description                            string
+	Description1                           string
+	Description2                           string
+	DevicePreference                       string
+	Dimensions                             string
+	DisplayUploadAdGmailTeaserBusinessName string
+	DisplayUploadAdGmailTeaserDescription  string
+	DisplayUploadAdGmailTeaserHeadline     string
+	DisplayUploadAdGmailTeaserLogoImage    string
+	DisplayUrl                             string
+	DurationMillis                         string
+	ExpandingDirections                    string
+	FileSize                               string
+	FormatSetting                          string
+	GmailHeaderImage                       string
+	GmailMarketingImage                    string
+	GmailTeaserBusinessName                string
+	GmailTeaserDescription                 string
+	GmailTeaserHeadline                    string
+	GmailTeaserLogoImage                   string
+	Headline                               string
+	HeadlinePart1                          string
+	HeadlinePart2                          string
+	Height                                 string
+	Id                                     string
+	ImageCreativeName                      string
+	IndustryStandardCommercialIdentifier   string
+	IsCookieTargeted                       string
+	IsTagged                               string
+	IsUserInterestTargeted                 string
+	Labels                                 string
+	LandscapeLogoImage                     string
+	LogoImage                              string
+	LongHeadline                           string
+	MainColor                              string
+	MarketingImage                         string
+	MarketingImageCallToActionText         string
+	MarketingImageCallToActionTextColor    string
+	MarketingImageDescription              string
+	MarketingImageHeadline                 string
+	MediaId                                string
+	MimeType                               string
+	Name                                   string
+	Path1                                  string
+	Path2                                  string
+	PolicySummary                          string
+	PricePrefix                            string
+	ProductImages                          string
+	ProductVideoList                       string
+	PromoText                              string
+	ReadyToPlayOnTheWeb                    string
+	ReferenceId                            string
+	RichMediaAdCertifiedVendorFormatId     string
+	RichMediaAdDuration                    string
+	RichMediaAdImpressionBeaconUrl         string
+	RichMediaAdName                        string
+	RichMediaAdSnippet                     string
+	RichMediaAdSourceUrl                   string
+	RichMediaAdType                        string
+	ShortHeadline                          string
+	SourceUrl                              string
+	SquareMarketingImage                   string
+	Status                                 string
+	StreamingUrl                           string
+	SystemManagedEntitySource              string
+	TemplateAdDuration                     string
+	TemplateAdName                         string
+	TemplateAdUnionId                      string
+	TemplateElementFieldName               string
+	TemplateElementFieldText               string
+	TemplateElementFieldType               string
+	TemplateId                             string
+	TemplateOriginAdId                     string
+	Type                                   string
+	UniqueName                             string
+	Url                                    string
+	UrlData                                string
+	Urls                                   string
+	VideoTypes                             string
+	Width                                  string
+	YouTubeVideoIdString                   string
+}{
+	AccentColor:                            "AccentColor",
+	AdGroupId:                              "AdGroupId",
+	AdType:                                 "AdType",
+	AdvertisingId:                          "AdvertisingId",
+	AllowFlexibleColor:                     "AllowFlexibleColor",
+	Automated:                              "Automated",
+	BaseAdGroupId:                          "BaseAdGroupId",
+	BaseCampaignId:                         "BaseCampaignId",
+	BusinessName:                           "BusinessName",
+	CallOnlyAdBusinessName:                 "CallOnlyAdBusinessName",
+	CallOnlyAdCallTracked:                  "CallOnlyAdCallTracked",
+	CallOnlyAdConversionTypeId:             "CallOnlyAdConversionTypeId",
+	CallOnlyAdCountryCode:                  "CallOnlyAdCountryCode",
+	CallOnlyAdDescription1:                 "CallOnlyAdDescription1",
+	CallOnlyAdDescription2:                 "CallOnlyAdDescription2",
+	CallOnlyAdDisableCallConversion:        "CallOnlyAdDisableCallConversion",
+	CallOnlyAdPhoneNumber:                  "CallOnlyAdPhoneNumber",
+	CallOnlyAdPhoneNumberVerificationUrl:   "CallOnlyAdPhoneNumberVerificationUrl",
+	CallToActionText:                       "CallToActionText",
+	CombinedApprovalStatus:                 "CombinedApprovalStatus",
+	CreationTime:                           "CreationTime",
+	CreativeFinalAppUrls:                   "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:                "CreativeFinalMobileUrls",
+	CreativeFinalUrls:                      "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate:            "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters:            "CreativeUrlCustomParameters",
+	Description:                            "Description",
+	Description1:                           "Description1",
+	Description2:                           "Description2",
+	DevicePreference:                       "DevicePreference",
+	Dimensions:                             "Dimensions",
+	DisplayUploadAdGmailTeaserBusinessName: "DisplayUploadAdGmailTeaserBusinessName",
+	DisplayUploadAdGmailTeaserDescription:  "DisplayUploadAdGmailTeaserDescription",
+	DisplayUploadAdGmailTeaserHeadline:     "DisplayUploadAdGmailTeaserHeadline",
+	DisplayUploadAdGmailTeaserLogoImage:    "DisplayUploadAdGmailTeaserLogoImage",
+	DisplayUrl:                             "DisplayUrl",
+	DurationMillis:                         "DurationMillis",
+	ExpandingDirections:                    "ExpandingDirections",
+	FileSize:                               "FileSize",
+	FormatSetting:                          "FormatSetting",
+	GmailHeaderImage:                       "GmailHeaderImage",
+	GmailMarketingImage:                    "GmailMarketingImage",
+	GmailTeaserBusinessName:                "GmailTeaserBusinessName",
+	GmailTeaserDescription:                 "GmailTeaserDescription",
+	GmailTeaserHeadline:                    "GmailTeaserHeadline",
+	GmailTeaserLogoImage:                   "GmailTeaserLogoImage",
+	Headline:                               "Headline",
+	HeadlinePart1:                          "HeadlinePart1",
+	HeadlinePart2:                          "HeadlinePart2",
+	Height:                                 "Height",
+	Id:                                     "Id",
+	ImageCreativeName:                      "ImageCreativeName",
+	IndustryStandardCommercialIdentifier:   "IndustryStandardCommercialIdentifier",
+	IsCookieTargeted:                       "IsCookieTargeted",
+	IsTagged:                               "IsTagged",
+	IsUserInterestTargeted:                 "IsUserInterestTargeted",
+	Labels:                                 "Labels",
+	LandscapeLogoImage:                     "LandscapeLogoImage",
+	LogoImage:                              "LogoImage",
+	LongHeadline:                           "LongHeadline",
+	MainColor:                              "MainColor",
+	MarketingImage:                         "MarketingImage",
+	MarketingImageCallToActionText:         "MarketingImageCallToActionText",
+	MarketingImageCallToActionTextColor:    "MarketingImageCallToActionTextColor",
+	MarketingImageDescription:              "MarketingImageDescription",
+	MarketingImageHeadline:                 "MarketingImageHeadline",
+	MediaId:                                "MediaId",
+	MimeType:                               "MimeType",
+	Name:                                   "Name",
+	Path1:                                  "Path1",
+	Path2:                                  "Path2",
+	PolicySummary:                          "PolicySummary",
+	PricePrefix:                            "PricePrefix",
+	ProductImages:                          "ProductImages",
+	ProductVideoList:                       "ProductVideoList",
+	PromoText:                              "PromoText",
+	ReadyToPlayOnTheWeb:                    "ReadyToPlayOnTheWeb",
+	ReferenceId:                            "ReferenceId",
+	RichMediaAdCertifiedVendorFormatId:     "RichMediaAdCertifiedVendorFormatId",
+	RichMediaAdDuration:                    "RichMediaAdDuration",
+	RichMediaAdImpressionBeaconUrl:         "RichMediaAdImpressionBeaconUrl",
+	RichMediaAdName:                        "RichMediaAdName",
+	RichMediaAdSnippet:                     "RichMediaAdSnippet",
+	RichMediaAdSourceUrl:                   "RichMediaAdSourceUrl",
+	RichMediaAdType:                        "RichMediaAdType",
+	ShortHeadline:                          "ShortHeadline",
+	SourceUrl:                              "SourceUrl",
+	SquareMarketingImage:                   "SquareMarketingImage",
+	Status:                                 "Status",
+	StreamingUrl:                           "StreamingUrl",
+	SystemManagedEntitySource:              "SystemManagedEntitySource",
+	TemplateAdDuration:                     "TemplateAdDuration",
+	TemplateAdName:                         "TemplateAdName",
+	TemplateAdUnionId:                      "TemplateAdUnionId",
+	TemplateElementFieldName:               "TemplateElementFieldName",
+	TemplateElementFieldText:               "TemplateElementFieldText",
+	TemplateElementFieldType:               "TemplateElementFieldType",
+	TemplateId:                             "TemplateId",
+	TemplateOriginAdId:                     "TemplateOriginAdId",
+	Type:                                   "Type",
+	UniqueName:                             "UniqueName",
+	Url:                                    "Url",
+	UrlData:                                "UrlData",
+	Urls:                                   "Urls",
+	VideoTypes:                             "VideoTypes",
+	Width:                                  "Width",
+	YouTubeVideoIdString:                   "YouTubeVideoIdString",
+}
+
+// AdGroupAdPolicySummaryFields holds the selector field names of AdGroupAdPolicySummary, including
+// the fields of the types it holds.
+var AdGroupAdPolicySummaryFields = struct {
+	CombinedApprovalStatus string
+}{
+	CombinedApprovalStatus: "CombinedApprovalStatus",
+}
+
+// AudioFields holds the selector field names of Audio, including
+// the fields of the types it holds.
+var AudioFields = struct {
+	CreationTime        string
+	Dimensions          string
+	DurationMillis      string
+	FileSize            string
+	Height              string
+	MediaId             string
+	MimeType            string
+	Name                string
+	ReadyToPlayOnTheWeb string
+	ReferenceId         string
+	SourceUrl           string
+	StreamingUrl        string
+	Type                string
+	Urls                string
+	Width               string
+}{
+	CreationTime:        "CreationTime",
+	Dimensions:          "Dimensions",
+	DurationMillis:      "DurationMillis",
+	FileSize:            "FileSize",
+	Height:              "Height",
+	MediaId:             "MediaId",
+	MimeType:            "MimeType",
+	Name:                "Name",
+	ReadyToPlayOnTheWeb: "ReadyToPlayOnTheWeb",
+	ReferenceId:         "ReferenceId",
+	SourceUrl:           "SourceUrl",
+	StreamingUrl:        "StreamingUrl",
+	Type:                "Type",
+	Urls:                "Urls",
+	Width:               "Width",
+}
+
+// CallOnlyAdFields holds the selector field names of CallOnlyAd, including
+// the fields of the types it holds.
+var CallOnlyAdFields = struct {
+	AdType                               string
+	Automated                            string
+	CallOnlyAdBusinessName               string
+	CallOnlyAdCallTracked                string
+	CallOnlyAdConversionTypeId           string
+	CallOnlyAdCountryCode                string
+	CallOnlyAdDescription1               string
+	CallOnlyAdDescription2               string
+	CallOnlyAdDisableCallConversion      string
+	CallOnlyAdPhoneNumber                string
+	CallOnlyAdPhoneNumberVerificationUrl string
+	CreativeFinalAppUrls                 string
+	CreativeFinalMobileUrls              string
+	CreativeFinalUrls                    string
+	CreativeTrackingUrlTemplate          string
+	CreativeUrlCustomParameters          string
+	DevicePreference                     string
+	DisplayUrl                           string
+	Id                                   string
+	SystemManagedEntitySource            string
+	Url                                  string
+	UrlData                              string
+}{
+	AdType:                               "AdType",
+	Automated:                            "Automated",
+	CallOnlyAdBusinessName:               "CallOnlyAdBusinessName",
+	CallOnlyAdCallTracked:                "CallOnlyAdCallTracked",
+	CallOnlyAdConversionTypeId:           "CallOnlyAdConversionTypeId",
+	CallOnlyAdCountryCode:                "CallOnlyAdCountryCode",
+	CallOnlyAdDescription1:               "CallOnlyAdDescription1",
+	CallOnlyAdDescription2:               "CallOnlyAdDescription2",
+	CallOnlyAdDisableCallConversion:      "CallOnlyAdDisableCallConversion",
+	CallOnlyAdPhoneNumber:                "CallOnlyAdPhoneNumber",
+	CallOnlyAdPhoneNumberVerificationUrl: "CallOnlyAdPhoneNumberVerificationUrl",
+	CreativeFinalAppUrls:                 "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:              "CreativeFinalMobileUrls",
+	CreativeFinalUrls:                    "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate:          "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters:          "CreativeUrlCustomParameters",
+	DevicePreference:                     "DevicePreference",
+	DisplayUrl:                           "DisplayUrl",
+	Id:                                   "Id",
+	SystemManagedEntitySource:            "SystemManagedEntitySource",
+	Url:                                  "Url",
+	UrlData:                              "UrlData",
+}
+
+// DeprecatedAdFields holds the selector field names of DeprecatedAd, including
+// the fields of the types it holds.
+var DeprecatedAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	DevicePreference            string
+	DisplayUrl                  string
+	Id                          string
+	Name                        string
+	SystemManagedEntitySource   string
+	Type                        string
+	Url                         string
+	UrlData                     string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	DevicePreference:            "DevicePreference",
+	DisplayUrl:                  "DisplayUrl",
+	Id:                          "Id",
+	Name:                        "Name",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Type:                        "Type",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+}
+
+// DimensionsFields holds the selector field names of Dimensions, including
+// the fields of the types it holds.
+var DimensionsFields = struct {
+	Height string
+	Width  string
+}{
+	Height: "Height",
+	Width:  "Width",
+}
+
+// DisplayCallToActionFields holds the selector field names of DisplayCallToAction, including
+// the fields of the types it holds.
+var DisplayCallToActionFields = struct {
+	MarketingImageCallToActionText      string
+	MarketingImageCallToActionTextColor string
+}{
+	MarketingImageCallToActionText:      "MarketingImageCallToActionText",
+	MarketingImageCallToActionTextColor: "MarketingImageCallToActionTextColor",
+}
+
+// DynamicSettingsFields holds the selector field names of DynamicSettings, including
+// the fields of the types it holds.
+var DynamicSettingsFields = struct {
+	CreationTime       string
+	Dimensions         string
+	FileSize           string
+	Height             string
+	LandscapeLogoImage string
+	MediaId            string
+	MimeType           string
+	Name               string
+	PricePrefix        string
+	PromoText          string
+	ReferenceId        string
+	SourceUrl          string
+	Type               string
+	Urls               string
+	Width              string
+}{
+	CreationTime:       "CreationTime",
+	Dimensions:         "Dimensions",
+	FileSize:           "FileSize",
+	Height:             "Height",
+	LandscapeLogoImage: "LandscapeLogoImage",
+	MediaId:            "MediaId",
+	MimeType:           "MimeType",
+	Name:               "Name",
+	PricePrefix:        "PricePrefix",
+	PromoText:          "PromoText",
+	ReferenceId:        "ReferenceId",
+	SourceUrl:          "SourceUrl",
+	Type:               "Type",
+	Urls:               "Urls",
+	Width:              "Width",
+}
+
+// ExpandedDynamicSearchAdFields holds the selector field names of ExpandedDynamicSearchAd, including
+// the fields of the types it holds.
+var ExpandedDynamicSearchAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	Description                 string
+	DevicePreference            string
+	DisplayUrl                  string
+	Id                          string
+	SystemManagedEntitySource   string
+	Url                         string
+	UrlData                     string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	Description:                 "Description",
+	DevicePreference:            "DevicePreference",
+	DisplayUrl:                  "DisplayUrl",
+	Id:                          "Id",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+}
+
+// ExpandedTextAdFields holds the selector field names of ExpandedTextAd, including
+// the fields of the types it holds.
+var ExpandedTextAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	Description                 string
+	DevicePreference            string
+	DisplayUrl                  string
+	HeadlinePart1               string
+	HeadlinePart2               string
+	Id                          string
+	Path1                       string
+	Path2                       string
+	SystemManagedEntitySource   string
+	Url                         string
+	UrlData                     string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	Description:                 "Description",
+	DevicePreference:            "DevicePreference",
+	DisplayUrl:                  "DisplayUrl",
+	HeadlinePart1:               "HeadlinePart1",
+	HeadlinePart2:               "HeadlinePart2",
+	Id:                          "Id",
+	Path1:                       "Path1",
+	Path2:                       "Path2",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+}
+
+// GmailAdFields holds the selector field names of GmailAd, including
+// the fields of the types it holds.
+var GmailAdFields = struct {
+	AdType                                 string
+	AdvertisingId                          string
+	Automated                              string
+	CreationTime                           string
+	CreativeFinalAppUrls                   string
+	CreativeFinalMobileUrls                string
+	CreativeFinalUrls                      string
+	CreativeTrackingUrlTemplate            string
+	CreativeUrlCustomParameters            string
+	DevicePreference                       string
+	Dimensions                             string
+	DisplayUploadAdGmailTeaserBusinessName string
+	DisplayUploadAdGmailTeaserDescription  string
+	DisplayUploadAdGmailTeaserHeadline     string
+	DisplayUploadAdGmailTeaserLogoImage    string
+	DisplayUrl                             string
+	DurationMillis                         string
+	FileSize                               string
+	GmailHeaderImage                       string
+	GmailMarketingImage                    string
+	GmailTeaserBusinessName                string
+	GmailTeaserDescription                 string
+	GmailTeaserHeadline                    string
+	GmailTeaserLogoImage                   string
+	Height                                 string
+	Id                                     string
+	IndustryStandardCommercialIdentifier   string
+	MarketingImageCallToActionText         string
+	MarketingImageCallToActionTextColor    string
+	MarketingImageDescription              string
+	MarketingImageHeadline                 string
+	MediaId                                string
+	MimeType                               string
+	Name                                   string
+	ProductImages                          string
+	ProductVideoList                       string
+	ReadyToPlayOnTheWeb                    string
+	ReferenceId                            string
+	SourceUrl                              string
+	StreamingUrl                           string
+	SystemManagedEntitySource              string
+	Type                                   string
+	Url                                    string
+	UrlData                                string
+	Urls                                   string
+	Width                                  string
+	YouTubeVideoIdString                   string
+}{
+	AdType:                                 "AdType",
+	AdvertisingId:                          "AdvertisingId",
+	Automated:                              "Automated",
+	CreationTime:                           "CreationTime",
+	CreativeFinalAppUrls:                   "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:                "CreativeFinalMobileUrls",
+	CreativeFinalUrls:                      "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate:            "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters:            "CreativeUrlCustomParameters",
+	DevicePreference:                       "DevicePreference",
+	Dimensions:                             "Dimensions",
+	DisplayUploadAdGmailTeaserBusinessName: "DisplayUploadAdGmailTeaserBusinessName",
+	DisplayUploadAdGmailTeaserDescription:  "DisplayUploadAdGmailTeaserDescription",
+	DisplayUploadAdGmailTeaserHeadline:     "DisplayUploadAdGmailTeaserHeadline",
+	DisplayUploadAdGmailTeaserLogoImage:    "DisplayUploadAdGmailTeaserLogoImage",
+	DisplayUrl:                             "DisplayUrl",
+	DurationMillis:                         "DurationMillis",
+	FileSize:                               "FileSize",
+	GmailHeaderImage:                       "GmailHeaderImage",
+	GmailMarketingImage:                    "GmailMarketingImage",
+	GmailTeaserBusinessName:                "GmailTeaserBusinessName",
+	GmailTeaserDescription:                 "GmailTeaserDescription",
+	GmailTeaserHeadline:                    "GmailTeaserHeadline",
+	GmailTeaserLogoImage:                   "GmailTeaserLogoImage",
+	Height:                                 "Height",
+	Id:                                     "Id",
+	IndustryStandardCommercialIdentifier:   "IndustryStandardCommercialIdentifier",
+	MarketingImageCallToActionText:         "MarketingImageCallToActionText",
+	MarketingImageCallToActionTextColor:    "MarketingImageCallToActionTextColor",
+	MarketingImageDescription:              "MarketingImageDescription",
+	MarketingImageHeadline:                 "MarketingImageHeadline",
+	MediaId:                                "MediaId",
+	MimeType:                               "MimeType",
+	Name:                                   "Name",
+	ProductImages:                          "ProductImages",
+	ProductVideoList:                       "ProductVideoList",
+	ReadyToPlayOnTheWeb:                    "ReadyToPlayOnTheWeb",
+	ReferenceId:                            "ReferenceId",
+	SourceUrl:                              "SourceUrl",
+	StreamingUrl:                           "StreamingUrl",
+	SystemManagedEntitySource:              "SystemManagedEntitySource",
+	Type:                                   "Type",
+	Url:                                    "Url",
+	UrlData:                                "UrlData",
+	Urls:                                   "Urls",
+	Width:                                  "Width",
+	YouTubeVideoIdString:                   "YouTubeVideoIdString",
+}
+
+// GmailTeaserFields holds the selector field names of GmailTeaser, including
+// the fields of the types it holds.
+var GmailTeaserFields = struct {
+	CreationTime                           string
+	Dimensions                             string
+	DisplayUploadAdGmailTeaserBusinessName string
+	DisplayUploadAdGmailTeaserDescription  string
+	DisplayUploadAdGmailTeaserHeadline     string
+	DisplayUploadAdGmailTeaserLogoImage    string
+	FileSize                               string
+	GmailTeaserBusinessName                string
+	GmailTeaserDescription                 string
+	GmailTeaserHeadline                    string
+	GmailTeaserLogoImage                   string
+	Height                                 string
+	MediaId                                string
+	MimeType                               string
+	Name                                   string
+	ReferenceId                            string
+	SourceUrl                              string
+	Type                                   string
+	Urls                                   string
+	Width                                  string
+}{
+	CreationTime:                           "CreationTime",
+	Dimensions:                             "Dimensions",
+	DisplayUploadAdGmailTeaserBusinessName: "DisplayUploadAdGmailTeaserBusinessName",
+	DisplayUploadAdGmailTeaserDescription:  "DisplayUploadAdGmailTeaserDescription",
+	DisplayUploadAdGmailTeaserHeadline:     "DisplayUploadAdGmailTeaserHeadline",
+	DisplayUploadAdGmailTeaserLogoImage:    "DisplayUploadAdGmailTeaserLogoImage",
+	FileSize:                               "FileSize",
+	GmailTeaserBusinessName:                "GmailTeaserBusinessName",
+	GmailTeaserDescription:                 "GmailTeaserDescription",
+	GmailTeaserHeadline:                    "GmailTeaserHeadline",
+	GmailTeaserLogoImage:                   "GmailTeaserLogoImage",
+	Height:                                 "Height",
+	MediaId:                                "MediaId",
+	MimeType:                               "MimeType",
+	Name:                                   "Name",
+	ReferenceId:                            "ReferenceId",
+	SourceUrl:                              "SourceUrl",
+	Type:                                   "Type",
+	Urls:                                   "Urls",
+	Width:                                  "Width",
+}
+
+// ImageFields holds the selector field names of Image, including
+// the fields of the types it holds.
+var ImageFields = struct {
+	CreationTime string
+	Dimensions   string
+	FileSize     string
+	Height       string
+	MediaId      string
+	MimeType     string
+	Name         string
+	ReferenceId  string
+	SourceUrl    string
+	Type         string
+	Urls         string
+	Width        string
+}{
+	CreationTime: "CreationTime",
+	Dimensions:   "Dimensions",
+	FileSize:     "FileSize",
+	Height:       "Height",
+	MediaId:      "MediaId",
+	MimeType:     "MimeType",
+	Name:         "Name",
+	ReferenceId:  "ReferenceId",
+	SourceUrl:    "SourceUrl",
+	Type:         "Type",
+	Urls:         "Urls",
+	Width:        "Width",
+}
+
+// ImageAdFields holds the selector field names of ImageAd, including
+// the fields of the types it holds.
+var ImageAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreationTime                string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	DevicePreference            string
+	Dimensions                  string
+	DisplayUrl                  string
+	FileSize                    string
+	Height                      string
+	Id                          string
+	ImageCreativeName           string
+	MediaId                     string
+	MimeType                    string
+	Name                        string
+	ReferenceId                 string
+	SourceUrl                   string
+	SystemManagedEntitySource   string
+	Type                        string
+	Url                         string
+	UrlData                     string
+	Urls                        string
+	Width                       string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreationTime:                "CreationTime",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	DevicePreference:            "DevicePreference",
+	Dimensions:                  "Dimensions",
+	DisplayUrl:                  "DisplayUrl",
+	FileSize:                    "FileSize",
+	Height:                      "Height",
+	Id:                          "Id",
+	ImageCreativeName:           "ImageCreativeName",
+	MediaId:                     "MediaId",
+	MimeType:                    "MimeType",
+	Name:                        "Name",
+	ReferenceId:                 "ReferenceId",
+	SourceUrl:                   "SourceUrl",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Type:                        "Type",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+	Urls:                        "Urls",
+	Width:                       "Width",
+}
+
+// MediaFields holds the selector field names of Media, including
+// the fields of the types it holds.
+var MediaFields = struct {
+	AdvertisingId                        string
+	CreationTime                         string
+	Dimensions                           string
+	DurationMillis                       string
+	FileSize                             string
+	Height                               string
+	IndustryStandardCommercialIdentifier string
+	MediaId                              string
+	MimeType                             string
+	Name                                 string
+	ReadyToPlayOnTheWeb                  string
+	ReferenceId                          string
+	SourceUrl                            string
+	StreamingUrl                         string
+	Type                                 string
+	Urls                                 string
+	Width                                string
+	YouTubeVideoIdString                 string
+}{
+	AdvertisingId:                        "AdvertisingId",
+	CreationTime:                         "CreationTime",
+	Dimensions:                           "Dimensions",
+	DurationMillis:                       "DurationMillis",
+	FileSize:                             "FileSize",
+	Height:                               "Height",
+	IndustryStandardCommercialIdentifier: "IndustryStandardCommercialIdentifier",
+	MediaId:                              "MediaId",
+	MimeType:                             "MimeType",
+	Name:                                 "Name",
+	ReadyToPlayOnTheWeb:                  "ReadyToPlayOnTheWeb",
+	ReferenceId:                          "ReferenceId",
+	SourceUrl:                            "SourceUrl",
+	StreamingUrl:                         "StreamingUrl",
+	Type:                                 "Type",
+	Urls:                                 "Urls",
+	Width:                                "Width",
+	YouTubeVideoIdString:                 "YouTubeVideoIdString",
+}
+
+// MediaBundleFields holds the selector field names of MediaBundle, including
+// the fields of the types it holds.
+var MediaBundleFields = struct {
+	CreationTime string
+	Dimensions   string
+	FileSize     string
+	Height       string
+	MediaId      string
+	MimeType     string
+	Name         string
+	ReferenceId  string
+	SourceUrl    string
+	Type         string
+	Urls         string
+	Width        string
+}{
+	CreationTime: "CreationTime",
+	Dimensions:   "Dimensions",
+	FileSize:     "FileSize",
+	Height:       "Height",
+	MediaId:      "MediaId",
+	MimeType:     "MimeType",
+	Name:         "Name",
+	ReferenceId:  "ReferenceId",
+	SourceUrl:    "SourceUrl",
+	Type:         "Type",
+	Urls:         "Urls",
+	Width:        "Width",
+}
+
+// ProductAdFields holds the selector field names of ProductAd, including
+// the fields of the types it holds.
+var ProductAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	DevicePreference            string
+	DisplayUrl                  string
+	Id                          string
+	SystemManagedEntitySource   string
+	Url                         string
+	UrlData                     string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	DevicePreference:            "DevicePreference",
+	DisplayUrl:                  "DisplayUrl",
+	Id:                          "Id",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+}
+
+// ResponsiveDisplayAdFields holds the selector field names of ResponsiveDisplayAd, including
+// the fields of the types it holds.
+var ResponsiveDisplayAdFields = struct {
+	AccentColor                 string
+	AdType                      string
+	AllowFlexibleColor          string
+	Automated                   string
+	BusinessName                string
+	CallToActionText            string
+	CreationTime                string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	Description                 string
+	DevicePreference            string
+	Dimensions                  string
+	DisplayUrl                  string
+	FileSize                    string
+	FormatSetting               string
+	Height                      string
+	Id                          string
+	LandscapeLogoImage          string
+	LogoImage                   string
+	LongHeadline                string
+	MainColor                   string
+	MarketingImage              string
+	MediaId                     string
+	MimeType                    string
+	Name                        string
+	PricePrefix                 string
+	PromoText                   string
+	ReferenceId                 string
+	ShortHeadline               string
+	SourceUrl                   string
+	SquareMarketingImage        string
+	SystemManagedEntitySource   string
+	Type                        string
+	Url                         string
+	UrlData                     string
+	Urls                        string
+	Width                       string
+}{
+	AccentColor:                 "AccentColor",
+	AdType:                      "AdType",
+	AllowFlexibleColor:          "AllowFlexibleColor",
+	Automated:                   "Automated",
+	BusinessName:                "BusinessName",
+	CallToActionText:            "CallToActionText",
+	CreationTime:                "CreationTime",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	Description:                 "Description",
+	DevicePreference:            "DevicePreference",
+	Dimensions:                  "Dimensions",
+	DisplayUrl:                  "DisplayUrl",
+	FileSize:                    "FileSize",
+	FormatSetting:               "FormatSetting",
+	Height:                      "Height",
+	Id:                          "Id",
+	LandscapeLogoImage:          "LandscapeLogoImage",
+	LogoImage:                   "LogoImage",
+	LongHeadline:                "LongHeadline",
+	MainColor:                   "MainColor",
+	MarketingImage:              "MarketingImage",
+	MediaId:                     "MediaId",
+	MimeType:                    "MimeType",
+	Name:                        "Name",
+	PricePrefix:                 "PricePrefix",
+	PromoText:                   "PromoText",
+	ReferenceId:                 "ReferenceId",
+	ShortHeadline:               "ShortHeadline",
+	SourceUrl:                   "SourceUrl",
+	SquareMarketingImage:        "SquareMarketingImage",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Type:                        "Type",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+	Urls:                        "Urls",
+	Width:                       "Width",
+}
+
+// RichMediaAdFields holds the selector field names of RichMediaAd, including
+// the fields of the types it holds.
+var RichMediaAdFields = struct {
+	AdType                             string
+	Automated                          string
+	CreativeFinalAppUrls               string
+	CreativeFinalMobileUrls            string
+	CreativeFinalUrls                  string
+	CreativeTrackingUrlTemplate        string
+	CreativeUrlCustomParameters        string
+	DevicePreference                   string
+	DisplayUrl                         string
+	ExpandingDirections                string
+	Height                             string
+	Id                                 string
+	IsCookieTargeted                   string
+	IsTagged                           string
+	IsUserInterestTargeted             string
+	RichMediaAdCertifiedVendorFormatId string
+	RichMediaAdDuration                string
+	RichMediaAdImpressionBeaconUrl     string
+	RichMediaAdName                    string
+	RichMediaAdSnippet                 string
+	RichMediaAdSourceUrl               string
+	RichMediaAdType                    string
+	SystemManagedEntitySource          string
+	Url                                string
+	UrlData                            string
+	VideoTypes                         string
+	Width                              string
+}{
+	AdType:                             "AdType",
+	Automated:                          "Automated",
+	CreativeFinalAppUrls:               "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:            "CreativeFinalMobileUrls",
+	CreativeFinalUrls:                  "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate:        "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters:        "CreativeUrlCustomParameters",
+	DevicePreference:                   "DevicePreference",
+	DisplayUrl:                         "DisplayUrl",
+	ExpandingDirections:                "ExpandingDirections",
+	Height:                             "Height",
+	Id:                                 "Id",
+	IsCookieTargeted:                   "IsCookieTargeted",
+	IsTagged:                           "IsTagged",
+	IsUserInterestTargeted:             "IsUserInterestTargeted",
+	RichMediaAdCertifiedVendorFormatId: "RichMediaAdCertifiedVendorFormatId",
+	RichMediaAdDuration:                "RichMediaAdDuration",
+	RichMediaAdImpressionBeaconUrl:     "RichMediaAdImpressionBeaconUrl",
+	RichMediaAdName:                    "RichMediaAdName",
+	RichMediaAdSnippet:                 "RichMediaAdSnippet",
+	RichMediaAdSourceUrl:               "RichMediaAdSourceUrl",
+	RichMediaAdType:                    "RichMediaAdType",
+	SystemManagedEntitySource:          "SystemManagedEntitySource",
+	Url:                                "Url",
+	UrlData:                            "UrlData",
+	VideoTypes:                         "VideoTypes",
+	Width:                              "Width",
+}
+
+// ShowcaseAdFields holds the selector field names of ShowcaseAd, including
+// the fields of the types it holds.
+var ShowcaseAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreationTime                string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	DevicePreference            string
+	Dimensions                  string
+	DisplayUrl                  string
+	FileSize                    string
+	Height                      string
+	Id                          string
+	MediaId                     string
+	MimeType                    string
+	Name                        string
+	ReferenceId                 string
+	SourceUrl                   string
+	SystemManagedEntitySource   string
+	Type                        string
+	Url                         string
+	UrlData                     string
+	Urls                        string
+	Width                       string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreationTime:                "CreationTime",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	DevicePreference:            "DevicePreference",
+	Dimensions:                  "Dimensions",
+	DisplayUrl:                  "DisplayUrl",
+	FileSize:                    "FileSize",
+	Height:                      "Height",
+	Id:                          "Id",
+	MediaId:                     "MediaId",
+	MimeType:                    "MimeType",
+	Name:                        "Name",
+	ReferenceId:                 "ReferenceId",
+	SourceUrl:                   "SourceUrl",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Type:                        "Type",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+	Urls:                        "Urls",
+	Width:                       "Width",
+}
+
+// TemplateAdFields holds the selector field names of TemplateAd, including
+// the fields of the types it holds.
+var TemplateAdFields = struct {
+	AdType                               string
+	AdvertisingId                        string
+	Automated                            string
+	CreationTime                         string
+	CreativeFinalAppUrls                 string
+	CreativeFinalMobileUrls              string
+	CreativeFinalUrls                    string
+	CreativeTrackingUrlTemplate          string
+	CreativeUrlCustomParameters          string
+	DevicePreference                     string
+	Dimensions                           string
+	DisplayUrl                           string
+	DurationMillis                       string
+	FileSize                             string
+	Height                               string
+	Id                                   string
+	IndustryStandardCommercialIdentifier string
+	MediaId                              string
+	MimeType                             string
+	Name                                 string
+	ReadyToPlayOnTheWeb                  string
+	ReferenceId                          string
+	SourceUrl                            string
+	StreamingUrl                         string
+	SystemManagedEntitySource            string
+	TemplateAdDuration                   string
+	TemplateAdName                       string
+	TemplateAdUnionId                    string
+	TemplateElementFieldName             string
+	TemplateElementFieldText             string
+	TemplateElementFieldType             string
+	TemplateId                           string
+	TemplateOriginAdId                   string
+	Type                                 string
+	UniqueName                           string
+	Url                                  string
+	UrlData                              string
+	Urls                                 string
+	Width                                string
+	YouTubeVideoIdString                 string
+}{
+	AdType:                               "AdType",
+	AdvertisingId:                        "AdvertisingId",
+	Automated:                            "Automated",
+	CreationTime:                         "CreationTime",
+	CreativeFinalAppUrls:                 "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:              "CreativeFinalMobileUrls",
+	CreativeFinalUrls:                    "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate:          "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters:          "CreativeUrlCustomParameters",
+	DevicePreference:                     "DevicePreference",
+	Dimensions:                           "Dimensions",
+	DisplayUrl:                           "DisplayUrl",
+	DurationMillis:                       "DurationMillis",
+	FileSize:                             "FileSize",
+	Height:                               "Height",
+	Id:                                   "Id",
+	IndustryStandardCommercialIdentifier: "IndustryStandardCommercialIdentifier",
+	MediaId:                              "MediaId",
+	MimeType:                             "MimeType",
+	Name:                                 "Name",
+	ReadyToPlayOnTheWeb:                  "ReadyToPlayOnTheWeb",
+	ReferenceId:                          "ReferenceId",
+	SourceUrl:                            "SourceUrl",
+	StreamingUrl:                         "StreamingUrl",
+	SystemManagedEntitySource:            "SystemManagedEntitySource",
+	TemplateAdDuration:                   "TemplateAdDuration",
+	TemplateAdName:                       "TemplateAdName",
+	TemplateAdUnionId:                    "TemplateAdUnionId",
+	TemplateElementFieldName:             "TemplateElementFieldName",
+	TemplateElementFieldText:             "TemplateElementFieldText",
+	TemplateElementFieldType:             "TemplateElementFieldType",
+	TemplateId:                           "TemplateId",
+	TemplateOriginAdId:                   "TemplateOriginAdId",
+	Type:                                 "Type",
+	UniqueName:                           "UniqueName",
+	Url:                                  "Url",
+	UrlData:                              "UrlData",
+	Urls:                                 "Urls",
+	Width:                                "Width",
+	YouTubeVideoIdString:                 "YouTubeVideoIdString",
+}
+
+// TemplateElementFields holds the selector field names of TemplateElement, including
+// the fields of the types it holds.
+var TemplateElementFields = struct {
+	AdvertisingId                        string
+	CreationTime                         string
+	Dimensions                           string
+	DurationMillis                       string
+	FileSize                             string
+	Height                               string
+	IndustryStandardCommercialIdentifier string
+	MediaId                              string
+	MimeType                             string
+	Name                                 string
+	ReadyToPlayOnTheWeb                  string
+	ReferenceId                          string
+	SourceUrl                            string
+	StreamingUrl                         string
+	TemplateElementFieldName             string
+	TemplateElementFieldText             string
+	TemplateElementFieldType             string
+	Type                                 string
+	UniqueName                           string
+	Urls                                 string
+	Width                                string
+	YouTubeVideoIdString                 string
+}{
+	AdvertisingId:                        "AdvertisingId",
+	CreationTime:                         "CreationTime",
+	Dimensions:                           "Dimensions",
+	DurationMillis:                       "DurationMillis",
+	FileSize:                             "FileSize",
+	Height:                               "Height",
+	IndustryStandardCommercialIdentifier: "IndustryStandardCommercialIdentifier",
+	MediaId:                              "MediaId",
+	MimeType:                             "MimeType",
+	Name:                                 "Name",
+	ReadyToPlayOnTheWeb:                  "ReadyToPlayOnTheWeb",
+	ReferenceId:                          "ReferenceId",
+	SourceUrl:                            "SourceUrl",
+	StreamingUrl:                         "StreamingUrl",
+	TemplateElementFieldName:             "TemplateElementFieldName",
+	TemplateElementFieldText:             "TemplateElementFieldText",
+	TemplateElementFieldType:             "TemplateElementFieldType",
+	Type:                                 "Type",
+	UniqueName:                           "UniqueName",
+	Urls:                                 "Urls",
+	Width:                                "Width",
+	YouTubeVideoIdString:                 "YouTubeVideoIdString",
+}
+
+// TemplateElementFieldFields holds the selector field names of TemplateElementField, including
+// the fields of the types it holds.
+var TemplateElementFieldFields = struct {
+	AdvertisingId                        string
+	CreationTime                         string
+	Dimensions                           string
+	DurationMillis                       string
+	FileSize                             string
+	Height                               string
+	IndustryStandardCommercialIdentifier string
+	MediaId                              string
+	MimeType                             string
+	Name                                 string
+	ReadyToPlayOnTheWeb                  string
+	ReferenceId                          string
+	SourceUrl                            string
+	StreamingUrl                         string
+	TemplateElementFieldName             string
+	TemplateElementFieldText             string
+	TemplateElementFieldType             string
+	Type                                 string
+	Urls                                 string
+	Width                                string
+	YouTubeVideoIdString                 string
+}{
+	AdvertisingId:                        "AdvertisingId",
+	CreationTime:                         "CreationTime",
+	Dimensions:                           "Dimensions",
+	DurationMillis:                       "DurationMillis",
+	FileSize:                             "FileSize",
+	Height:                               "Height",
+	IndustryStandardCommercialIdentifier: "IndustryStandardCommercialIdentifier",
+	MediaId:                              "MediaId",
+	MimeType:                             "MimeType",
+	Name:                                 "Name",
+	ReadyToPlayOnTheWeb:                  "ReadyToPlayOnTheWeb",
+	ReferenceId:                          "ReferenceId",
+	SourceUrl:                            "SourceUrl",
+	StreamingUrl:                         "StreamingUrl",
+	TemplateElementFieldName:             "TemplateElementFieldName",
+	TemplateElementFieldText:             "TemplateElementFieldText",
+	TemplateElementFieldType:             "TemplateElementFieldType",
+	Type:                                 "Type",
+	Urls:                                 "Urls",
+	Width:                                "Width",
+	YouTubeVideoIdString:                 "YouTubeVideoIdString",
+}
+
+// TextAdFields holds the selector field names of TextAd, including
+// the fields of the types it holds.
+var TextAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	Description1                string
+	Description2                string
+	DevicePreference            string
+	DisplayUrl                  string
+	Headline                    string
+	Id                          string
+	SystemManagedEntitySource   string
+	Url                         string
+	UrlData                     string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	Description1:                "Description1",
+	Description2:                "Description2",
+	DevicePreference:            "DevicePreference",
+	DisplayUrl:                  "DisplayUrl",
+	Headline:                    "Headline",
+	Id:                          "Id",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+}
+
+// ThirdPartyRedirectAdFields holds the selector field names of ThirdPartyRedirectAd, including
+// the fields of the types it holds.
+var ThirdPartyRedirectAdFields = struct {
+	AdType                             string
+	Automated                          string
+	CreativeFinalAppUrls               string
+	CreativeFinalMobileUrls            string
+	CreativeFinalUrls                  string
+	CreativeTrackingUrlTemplate        string
+	CreativeUrlCustomParameters        string
+	DevicePreference                   string
+	DisplayUrl                         string
+	ExpandingDirections                string
+	Height                             string
+	Id                                 string
+	IsCookieTargeted                   string
+	IsTagged                           string
+	IsUserInterestTargeted             string
+	RichMediaAdCertifiedVendorFormatId string
+	RichMediaAdDuration                string
+	RichMediaAdImpressionBeaconUrl     string
+	RichMediaAdName                    string
+	RichMediaAdSnippet                 string
+	RichMediaAdSourceUrl               string
+	RichMediaAdType                    string
+	SystemManagedEntitySource          string
+	Url                                string
+	UrlData                            string
+	VideoTypes                         string
+	Width                              string
+}{
+	AdType:                             "AdType",
+	Automated:                          "Automated",
+	CreativeFinalAppUrls:               "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:            "CreativeFinalMobileUrls",
+	CreativeFinalUrls:                  "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate:        "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters:        "CreativeUrlCustomParameters",
+	DevicePreference:                   "DevicePreference",
+	DisplayUrl:                         "DisplayUrl",
+	ExpandingDirections:                "ExpandingDirections",
+	Height:                             "Height",
+	Id:                                 "Id",
+	IsCookieTargeted:                   "IsCookieTargeted",
+	IsTagged:                           "IsTagged",
+	IsUserInterestTargeted:             "IsUserInterestTargeted",
+	RichMediaAdCertifiedVendorFormatId: "RichMediaAdCertifiedVendorFormatId",
+	RichMediaAdDuration:                "RichMediaAdDuration",
+	RichMediaAdImpressionBeaconUrl:     "RichMediaAdImpressionBeaconUrl",
+	RichMediaAdName:                    "RichMediaAdName",
+	RichMediaAdSnippet:                 "RichMediaAdSnippet",
+	RichMediaAdSourceUrl:               "RichMediaAdSourceUrl",
+	RichMediaAdType:                    "RichMediaAdType",
+	SystemManagedEntitySource:          "SystemManagedEntitySource",
+	Url:                                "Url",
+	UrlData:                            "UrlData",
+	VideoTypes:                         "VideoTypes",
+	Width:                              "Width",
+}
+
+// UniversalShoppingAdFields holds the selector field names of UniversalShoppingAd, including
+// the fields of the types it holds.
+var UniversalShoppingAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	DevicePreference            string
+	DisplayUrl                  string
+	Id                          string
+	SystemManagedEntitySource   string
+	Url                         string
+	UrlData                     string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	DevicePreference:            "DevicePreference",
+	DisplayUrl:                  "DisplayUrl",
+	Id:                          "Id",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+}
+
+// VideoFields holds the selector field names of Video, including
+// the fields of the types it holds.
+var VideoFields = struct {
+	AdvertisingId                        string
+	CreationTime                         string
+	Dimensions                           string
+	DurationMillis                       string
+	FileSize                             string
+	Height                               string
+	IndustryStandardCommercialIdentifier string
+	MediaId                              string
+	MimeType                             string
+	Name                                 string
+	ReadyToPlayOnTheWeb                  string
+	ReferenceId                          string
+	SourceUrl                            string
+	StreamingUrl                         string
+	Type                                 string
+	Urls                                 string
+	Width                                string
+	YouTubeVideoIdString                 string
+}{
+	AdvertisingId:                        "AdvertisingId",
+	CreationTime:                         "CreationTime",
+	Dimensions:                           "Dimensions",
+	DurationMillis:                       "DurationMillis",
+	FileSize:                             "FileSize",
+	Height:                               "Height",
+	IndustryStandardCommercialIdentifier: "IndustryStandardCommercialIdentifier",
+	MediaId:                              "MediaId",
+	MimeType:                             "MimeType",
+	Name:                                 "Name",
+	ReadyToPlayOnTheWeb:                  "ReadyToPlayOnTheWeb",
+	ReferenceId:                          "ReferenceId",
+	SourceUrl:                            "SourceUrl",
+	StreamingUrl:                         "StreamingUrl",
+	Type:                                 "Type",
+	Urls:                                 "Urls",
+	Width:                                "Width",
+	YouTubeVideoIdString:                 "YouTubeVideoIdString",
+}
+
+// DynamicSearchAdFields holds the selector field names of DynamicSearchAd, including
+// the fields of the types it holds.
+var DynamicSearchAdFields = struct {
+	AdType                      string
+	Automated                   string
+	CreativeFinalAppUrls        string
+	CreativeFinalMobileUrls     string
+	CreativeFinalUrls           string
+	CreativeTrackingUrlTemplate string
+	CreativeUrlCustomParameters string
+	Description1                string
+	Description2                string
+	DevicePreference            string
+	DisplayUrl                  string
+	Id                          string
+	SystemManagedEntitySource   string
+	Url                         string
+	UrlData                     string
+}{
+	AdType:                      "AdType",
+	Automated:                   "Automated",
+	CreativeFinalAppUrls:        "CreativeFinalAppUrls",
+	CreativeFinalMobileUrls:     "CreativeFinalMobileUrls",
+	CreativeFinalUrls:           "CreativeFinalUrls",
+	CreativeTrackingUrlTemplate: "CreativeTrackingUrlTemplate",
+	CreativeUrlCustomParameters: "CreativeUrlCustomParameters",
+	Description1:                "Description1",
+	Description2:                "Description2",
+	DevicePreference:            "DevicePreference",
+	DisplayUrl:                  "DisplayUrl",
+	Id:                          "Id",
+	SystemManagedEntitySource:   "SystemManagedEntitySource",
+	Url:                         "Url",
+	UrlData:                     "UrlData",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"MutateLabel": {
+		{Type: "MutateLabel", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"Ad": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+	},
+	"AdGroupAd": {
+		{Type: "AdGroupAd", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId", Required: true},
+		{Type: "AdGroupAd", Name: "Ad", Required: true},
+		{Type: "AdGroupAd", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "AdGroupAd", Name: "PolicySummary", Selectors: []string{"PolicySummary"}, ReadOnly: true},
+		{Type: "AdGroupAd", Name: "Labels", Selectors: []string{"Labels"}, Filter: "Labels", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "AdGroupAd", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, Filter: "BaseCampaignId", ReadOnly: true},
+		{Type: "AdGroupAd", Name: "BaseAdGroupId", Selectors: []string{"BaseAdGroupId"}, Filter: "BaseAdGroupId", ReadOnly: true},
+		{Type: "AdGroupAd", Name: "ForwardCompatibilityMap"},
+	},
+	"AdGroupAdLabel": {
+		{Type: "AdGroupAdLabel", Name: "AdGroupId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+		{Type: "AdGroupAdLabel", Name: "AdId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+		{Type: "AdGroupAdLabel", Name: "LabelId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+	},
+	"AdGroupAdLabelOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "AdGroupAdLabelOperation", Name: "Operand", Required: true},
+	},
+	"AdGroupAdOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "AdGroupAdOperation", Name: "Operand", Required: true},
+		{Type: "AdGroupAdOperation", Name: "ExemptionRequests"},
+	},
+	"AdGroupAdPolicySummary": {
+		{Type: "AdGroupAdPolicySummary", Name: "PolicyTopicEntries"},
+		{Type: "AdGroupAdPolicySummary", Name: "ReviewState"},
+		{Type: "AdGroupAdPolicySummary", Name: "DenormalizedStatus"},
+		{Type: "AdGroupAdPolicySummary", Name: "CombinedApprovalStatus", Selectors: []string{"CombinedApprovalStatus"}, Filter: "CombinedApprovalStatus"},
+	},
+	"AppUrl": {
+		{Type: "AppUrl", Name: "Url"},
+		{Type: "AppUrl", Name: "OsType", Required: true},
+	},
+	"Audio": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+		{Type: "Audio", Name: "DurationMillis", Selectors: []string{"DurationMillis"}, Filter: "DurationMillis"},
+		{Type: "Audio", Name: "StreamingUrl", Selectors: []string{"StreamingUrl"}},
+		{Type: "Audio", Name: "ReadyToPlayOnTheWeb", Selectors: []string{"ReadyToPlayOnTheWeb"}},
+	},
+	"CallOnlyAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "CallOnlyAd", Name: "CountryCode", Selectors: []string{"CallOnlyAdCountryCode"}, Filter: "CallOnlyAdCountryCode"},
+		{Type: "CallOnlyAd", Name: "PhoneNumber", Selectors: []string{"CallOnlyAdPhoneNumber"}, Filter: "CallOnlyAdPhoneNumber"},
+		{Type: "CallOnlyAd", Name: "BusinessName", Selectors: []string{"CallOnlyAdBusinessName"}, Filter: "CallOnlyAdBusinessName"},
+		{Type: "CallOnlyAd", Name: "Description1", Selectors: []string{"CallOnlyAdDescription1"}, Filter: "CallOnlyAdDescription1"},
+		{Type: "CallOnlyAd", Name: "Description2", Selectors: []string{"CallOnlyAdDescription2"}, Filter: "CallOnlyAdDescription2"},
+		{Type: "CallOnlyAd", Name: "CallTracked", Selectors: []string{"CallOnlyAdCallTracked"}},
+		{Type: "CallOnlyAd", Name: "DisableCallConversion", Selectors: []string{"CallOnlyAdDisableCallConversion"}},
+		{Type: "CallOnlyAd", Name: "ConversionTypeId", Selectors: []string{"CallOnlyAdConversionTypeId"}},
+		{Type: "CallOnlyAd", Name: "PhoneNumberVerificationUrl", Selectors: []string{"CallOnlyAdPhoneNumberVerificationUrl"}, Filter: "CallOnlyAdPhoneNumberVerificationUrl"},
+	},
+	"TextLabel": {
+		{Type: "Label", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Label", Name: "Name"},
+		{Type: "Label", Name: "Status", ReadOnly: true},
+		{Type: "Label", Name: "Attribute", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Label", Name: "LabelType"},
+	},
+	"CustomParameter": {
+		{Type: "CustomParameter", Name: "Key", Required: true},
+		{Type: "CustomParameter", Name: "Value"},
+		{Type: "CustomParameter", Name: "IsRemove"},
+	},
+	"DeprecatedAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "DeprecatedAd", Name: "Name", Selectors: []string{"Name"}, ReadOnly: true},
+		{Type: "DeprecatedAd", Name: "DeprecatedAdType", Selectors: []string{"Type"}, ReadOnly: true},
+	},
+	"Dimensions": {
+		{Type: "Dimensions", Name: "Width", Selectors: []string{"Width"}, Required: true},
+		{Type: "Dimensions", Name: "Height", Selectors: []string{"Height"}, Required: true},
+	},
+	"DisplayCallToAction": {
+		{Type: "DisplayCallToAction", Name: "Text", Selectors: []string{"MarketingImageCallToActionText"}, Filter: "MarketingImageCallToActionText", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "DisplayCallToAction", Name: "TextColor", Selectors: []string{"MarketingImageCallToActionTextColor"}, Filter: "MarketingImageCallToActionTextColor"},
+		{Type: "DisplayCallToAction", Name: "UrlId"},
+	},
+	"DynamicSettings": {
+		{Type: "DynamicSettings", Name: "LandscapeLogoImage", Selectors: []string{"LandscapeLogoImage"}},
+		{Type: "DynamicSettings", Name: "PricePrefix", Selectors: []string{"PricePrefix"}, Filter: "PricePrefix"},
+		{Type: "DynamicSettings", Name: "PromoText", Selectors: []string{"PromoText"}, Filter: "PromoText"},
+	},
+	"ExemptionRequest": {
+		{Type: "ExemptionRequest", Name: "Key", Required: true},
+	},
+	"ExpandedDynamicSearchAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "ExpandedDynamicSearchAd", Name: "Description", Selectors: []string{"Description"}, Filter: "Description", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"ExpandedTextAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "ExpandedTextAd", Name: "HeadlinePart1", Selectors: []string{"HeadlinePart1"}, Filter: "HeadlinePart1", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ExpandedTextAd", Name: "HeadlinePart2", Selectors: []string{"HeadlinePart2"}, Filter: "HeadlinePart2", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ExpandedTextAd", Name: "Description", Selectors: []string{"Description"}, Filter: "Description", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ExpandedTextAd", Name: "Path1", Selectors: []string{"Path1"}, Filter: "Path1"},
+		{Type: "ExpandedTextAd", Name: "Path2", Selectors: []string{"Path2"}, Filter: "Path2"},
+	},
+	"GmailAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "GmailAd", Name: "Teaser", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "GmailAd", Name: "HeaderImage", Selectors: []string{"GmailHeaderImage"}},
+		{Type: "GmailAd", Name: "MarketingImage", Selectors: []string{"GmailMarketingImage"}},
+		{Type: "GmailAd", Name: "MarketingImageHeadline", Selectors: []string{"MarketingImageHeadline"}, Filter: "MarketingImageHeadline", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "GmailAd", Name: "MarketingImageDescription", Selectors: []string{"MarketingImageDescription"}, Filter: "MarketingImageDescription", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "GmailAd", Name: "MarketingImageDisplayCallToAction"},
+		{Type: "GmailAd", Name: "ProductImages", Selectors: []string{"ProductImages"}},
+		{Type: "GmailAd", Name: "ProductVideoList", Selectors: []string{"ProductVideoList"}},
+	},
+	"GmailTeaser": {
+		{Type: "GmailTeaser", Name: "Headline", Selectors: []string{"GmailTeaserHeadline", "DisplayUploadAdGmailTeaserHeadline"}, Filter: "GmailTeaserHeadline", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "GmailTeaser", Name: "Description", Selectors: []string{"GmailTeaserDescription", "DisplayUploadAdGmailTeaserDescription"}, Filter: "GmailTeaserDescription", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "GmailTeaser", Name: "BusinessName", Selectors: []string{"GmailTeaserBusinessName", "DisplayUploadAdGmailTeaserBusinessName"}, Filter: "GmailTeaserBusinessName", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "GmailTeaser", Name: "LogoImage", Selectors: []string{"GmailTeaserLogoImage", "DisplayUploadAdGmailTeaserLogoImage"}},
+	},
+	"Image": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+		{Type: "Image", Name: "Data"},
+	},
+	"ImageAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "ImageAd", Name: "Image"},
+		{Type: "ImageAd", Name: "Name", Selectors: []string{"ImageCreativeName"}, Filter: "ImageCreativeName", Required: true},
+		{Type: "ImageAd", Name: "AdToCopyImageFrom", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+	},
+	"Label": {
+		{Type: "Label", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Label", Name: "Name"},
+		{Type: "Label", Name: "Status", ReadOnly: true},
+		{Type: "Label", Name: "Attribute", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Label", Name: "LabelType"},
+	},
+	"Media": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+	},
+	"MediaBundle": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+		{Type: "MediaBundle", Name: "Data"},
+		{Type: "MediaBundle", Name: "MediaBundleUrl", ReadOnly: true},
+		{Type: "MediaBundle", Name: "EntryPoint"},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"PolicyTopicEntry": {
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicEntryType"},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicEvidences"},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicConstraints"},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicId", ReadOnly: true},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicName", ReadOnly: true},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicHelpCenterUrl", ReadOnly: true},
+	},
+	"PolicyViolationKey": {
+		{Type: "PolicyViolationKey", Name: "PolicyName", Required: true},
+		{Type: "PolicyViolationKey", Name: "ViolatingText"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"ProductAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+	},
+	"ProductImage": {
+		{Type: "ProductImage", Name: "ProductImage", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductImage", Name: "Description"},
+		{Type: "ProductImage", Name: "DisplayCallToAction"},
+	},
+	"ResponsiveDisplayAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "ResponsiveDisplayAd", Name: "MarketingImage", Selectors: []string{"MarketingImage"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ResponsiveDisplayAd", Name: "LogoImage", Selectors: []string{"LogoImage"}},
+		{Type: "ResponsiveDisplayAd", Name: "SquareMarketingImage", Selectors: []string{"SquareMarketingImage"}},
+		{Type: "ResponsiveDisplayAd", Name: "ShortHeadline", Selectors: []string{"ShortHeadline"}, Filter: "ShortHeadline", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ResponsiveDisplayAd", Name: "LongHeadline", Selectors: []string{"LongHeadline"}, Filter: "LongHeadline", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ResponsiveDisplayAd", Name: "Description", Selectors: []string{"Description"}, Filter: "Description", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ResponsiveDisplayAd", Name: "BusinessName", Selectors: []string{"BusinessName"}, Filter: "BusinessName", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ResponsiveDisplayAd", Name: "MainColor", Selectors: []string{"MainColor"}, Filter: "MainColor"},
+		{Type: "ResponsiveDisplayAd", Name: "AccentColor", Selectors: []string{"AccentColor"}, Filter: "AccentColor"},
+		{Type: "ResponsiveDisplayAd", Name: "AllowFlexibleColor", Selectors: []string{"AllowFlexibleColor"}, Filter: "AllowFlexibleColor"},
+		{Type: "ResponsiveDisplayAd", Name: "CallToActionText", Selectors: []string{"CallToActionText"}, Filter: "CallToActionText"},
+		{Type: "ResponsiveDisplayAd", Name: "DynamicDisplayAdSettings"},
+		{Type: "ResponsiveDisplayAd", Name: "FormatSetting", Selectors: []string{"FormatSetting"}, Filter: "FormatSetting"},
+	},
+	"RichMediaAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "RichMediaAd", Name: "Name", Selectors: []string{"RichMediaAdName"}, Required: true},
+		{Type: "RichMediaAd", Name: "Dimensions"},
+		{Type: "RichMediaAd", Name: "Snippet", Selectors: []string{"RichMediaAdSnippet"}},
+		{Type: "RichMediaAd", Name: "ImpressionBeaconUrl", Selectors: []string{"RichMediaAdImpressionBeaconUrl"}},
+		{Type: "RichMediaAd", Name: "AdDuration", Selectors: []string{"RichMediaAdDuration"}},
+		{Type: "RichMediaAd", Name: "CertifiedVendorFormatId", Selectors: []string{"RichMediaAdCertifiedVendorFormatId"}, Required: true},
+		{Type: "RichMediaAd", Name: "SourceUrl", Selectors: []string{"RichMediaAdSourceUrl"}},
+		{Type: "RichMediaAd", Name: "RichMediaAdType", Selectors: []string{"RichMediaAdType"}},
+		{Type: "RichMediaAd", Name: "AdAttributes"},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"ShowcaseAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "ShowcaseAd", Name: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ShowcaseAd", Name: "Headline"},
+		{Type: "ShowcaseAd", Name: "Description"},
+		{Type: "ShowcaseAd", Name: "CollapsedImage"},
+		{Type: "ShowcaseAd", Name: "ExpandedImage", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"TemplateAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "TemplateAd", Name: "TemplateId", Selectors: []string{"TemplateId"}, Filter: "TemplateId"},
+		{Type: "TemplateAd", Name: "AdUnionId", Selectors: []string{"TemplateAdUnionId"}},
+		{Type: "TemplateAd", Name: "TemplateElements"},
+		{Type: "TemplateAd", Name: "AdAsImage"},
+		{Type: "TemplateAd", Name: "Dimensions"},
+		{Type: "TemplateAd", Name: "Name", Selectors: []string{"TemplateAdName"}, Required: true},
+		{Type: "TemplateAd", Name: "Duration", Selectors: []string{"TemplateAdDuration"}, ReadOnly: true},
+		{Type: "TemplateAd", Name: "OriginAdId", Selectors: []string{"TemplateOriginAdId"}},
+	},
+	"TemplateElement": {
+		{Type: "TemplateElement", Name: "UniqueName", Selectors: []string{"UniqueName"}, Required: true},
+		{Type: "TemplateElement", Name: "Fields", Required: true},
+	},
+	"TemplateElementField": {
+		{Type: "TemplateElementField", Name: "Name", Selectors: []string{"TemplateElementFieldName"}, Required: true},
+		{Type: "TemplateElementField", Name: "Type_", Selectors: []string{"TemplateElementFieldType"}, Required: true},
+		{Type: "TemplateElementField", Name: "FieldText", Selectors: []string{"TemplateElementFieldText"}},
+		{Type: "TemplateElementField", Name: "FieldMedia"},
+	},
+	"TextAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "TextAd", Name: "Headline", Selectors: []string{"Headline"}, Filter: "Headline"},
+		{Type: "TextAd", Name: "Description1", Selectors: []string{"Description1"}, Filter: "Description1"},
+		{Type: "TextAd", Name: "Description2", Selectors: []string{"Description2"}, Filter: "Description2"},
+	},
+	"ThirdPartyRedirectAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "RichMediaAd", Name: "Name", Selectors: []string{"RichMediaAdName"}, Required: true},
+		{Type: "RichMediaAd", Name: "Dimensions"},
+		{Type: "RichMediaAd", Name: "Snippet", Selectors: []string{"RichMediaAdSnippet"}},
+		{Type: "RichMediaAd", Name: "ImpressionBeaconUrl", Selectors: []string{"RichMediaAdImpressionBeaconUrl"}},
+		{Type: "RichMediaAd", Name: "AdDuration", Selectors: []string{"RichMediaAdDuration"}},
+		{Type: "RichMediaAd", Name: "CertifiedVendorFormatId", Selectors: []string{"RichMediaAdCertifiedVendorFormatId"}, Required: true},
+		{Type: "RichMediaAd", Name: "SourceUrl", Selectors: []string{"RichMediaAdSourceUrl"}},
+		{Type: "RichMediaAd", Name: "RichMediaAdType", Selectors: []string{"RichMediaAdType"}},
+		{Type: "RichMediaAd", Name: "AdAttributes"},
+		{Type: "ThirdPartyRedirectAd", Name: "IsCookieTargeted", Selectors: []string{"IsCookieTargeted"}, Required: true},
+		{Type: "ThirdPartyRedirectAd", Name: "IsUserInterestTargeted", Selectors: []string{"IsUserInterestTargeted"}, Required: true},
+		{Type: "ThirdPartyRedirectAd", Name: "IsTagged", Selectors: []string{"IsTagged"}, Required: true},
+		{Type: "ThirdPartyRedirectAd", Name: "VideoTypes", Selectors: []string{"VideoTypes"}},
+		{Type: "ThirdPartyRedirectAd", Name: "ExpandingDirections", Selectors: []string{"ExpandingDirections"}},
+	},
+	"UniversalShoppingAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+	},
+	"UrlData": {
+		{Type: "UrlData", Name: "UrlId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "UrlData", Name: "FinalUrls"},
+		{Type: "UrlData", Name: "FinalMobileUrls"},
+		{Type: "UrlData", Name: "TrackingUrlTemplate"},
+	},
+	"Video": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+		{Type: "Video", Name: "DurationMillis", Selectors: []string{"DurationMillis"}},
+		{Type: "Video", Name: "StreamingUrl", Selectors: []string{"StreamingUrl"}},
+		{Type: "Video", Name: "ReadyToPlayOnTheWeb", Selectors: []string{"ReadyToPlayOnTheWeb"}},
+		{Type: "Video", Name: "IndustryStandardCommercialIdentifier", Selectors: []string{"IndustryStandardCommercialIdentifier"}},
+		{Type: "Video", Name: "AdvertisingId", Selectors: []string{"AdvertisingId"}},
+		{Type: "Video", Name: "YouTubeVideoIdString", Selectors: []string{"YouTubeVideoIdString"}},
+	},
+	"DynamicSearchAd": {
+		{Type: "Ad", Name: "Id", Selectors: []string{"Id"}, Filter: "Id"},
+		{Type: "Ad", Name: "Url", Selectors: []string{"Url"}, Filter: "Url"},
+		{Type: "Ad", Name: "DisplayUrl", Selectors: []string{"DisplayUrl"}, Filter: "DisplayUrl"},
+		{Type: "Ad", Name: "FinalUrls", Selectors: []string{"CreativeFinalUrls"}, Filter: "CreativeFinalUrls"},
+		{Type: "Ad", Name: "FinalMobileUrls", Selectors: []string{"CreativeFinalMobileUrls"}, Filter: "CreativeFinalMobileUrls"},
+		{Type: "Ad", Name: "FinalAppUrls", Selectors: []string{"CreativeFinalAppUrls"}, Filter: "CreativeFinalAppUrls"},
+		{Type: "Ad", Name: "TrackingUrlTemplate", Selectors: []string{"CreativeTrackingUrlTemplate"}, Filter: "CreativeTrackingUrlTemplate"},
+		{Type: "Ad", Name: "FinalUrlSuffix"},
+		{Type: "Ad", Name: "UrlCustomParameters", Selectors: []string{"CreativeUrlCustomParameters"}, Filter: "CreativeUrlCustomParameters"},
+		{Type: "Ad", Name: "UrlData", Selectors: []string{"UrlData"}},
+		{Type: "Ad", Name: "Automated", Selectors: []string{"Automated"}, Filter: "Automated"},
+		{Type: "Ad", Name: "Type_", Selectors: []string{"AdType"}, Filter: "AdType"},
+		{Type: "Ad", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "Ad", Name: "SystemManagedEntitySource", Selectors: []string{"SystemManagedEntitySource"}, Filter: "SystemManagedEntitySource", ReadOnly: true},
+		{Type: "Ad", Name: "AdType"},
+		{Type: "DynamicSearchAd", Name: "Description1", Selectors: []string{"Description1"}, Filter: "Description1"},
+		{Type: "DynamicSearchAd", Name: "Description2", Selectors: []string{"Description2"}, Filter: "Description2"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
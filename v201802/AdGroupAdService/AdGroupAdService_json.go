@@ -0,0 +1,1379 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupAdService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "AdCustomizerError" as the
+// concrete type in the discriminator of its base types.
+func (t AdCustomizerError) MarshalJSON() ([]byte, error) {
+	type plain AdCustomizerError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdCustomizerError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdError" as the
+// concrete type in the discriminator of its base types.
+func (t AdError) MarshalJSON() ([]byte, error) {
+	type plain AdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupAdCountLimitExceeded" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupAdCountLimitExceeded) MarshalJSON() ([]byte, error) {
+	type plain AdGroupAdCountLimitExceeded
+	v := plain(t)
+
+	b0 := new(EntityCountLimitExceeded)
+	if v.EntityCountLimitExceeded != nil {
+		*b0 = *v.EntityCountLimitExceeded
+	}
+	v.EntityCountLimitExceeded = b0
+
+	b1 := new(ApiError)
+	if b0.ApiError != nil {
+		*b1 = *b0.ApiError
+	}
+	if b1.ApiErrorType == nil {
+		b1.ApiErrorType = String("AdGroupAdCountLimitExceeded")
+	}
+	b0.ApiError = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupAdError" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupAdError) MarshalJSON() ([]byte, error) {
+	type plain AdGroupAdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdGroupAdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupAdLabelOperation" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupAdLabelOperation) MarshalJSON() ([]byte, error) {
+	type plain AdGroupAdLabelOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("AdGroupAdLabelOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupAdLabelReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupAdLabelReturnValue) MarshalJSON() ([]byte, error) {
+	type plain AdGroupAdLabelReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("AdGroupAdLabelReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupAdOperation" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupAdOperation) MarshalJSON() ([]byte, error) {
+	type plain AdGroupAdOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("AdGroupAdOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupAdPage" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupAdPage) MarshalJSON() ([]byte, error) {
+	type plain AdGroupAdPage
+	v := plain(t)
+
+	b0 := new(Page)
+	if v.Page != nil {
+		*b0 = *v.Page
+	}
+	if b0.PageType == nil {
+		b0.PageType = String("AdGroupAdPage")
+	}
+	v.Page = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupAdReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupAdReturnValue) MarshalJSON() ([]byte, error) {
+	type plain AdGroupAdReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("AdGroupAdReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdSharingError" as the
+// concrete type in the discriminator of its base types.
+func (t AdSharingError) MarshalJSON() ([]byte, error) {
+	type plain AdSharingError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdSharingError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdxError" as the
+// concrete type in the discriminator of its base types.
+func (t AdxError) MarshalJSON() ([]byte, error) {
+	type plain AdxError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdxError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Audio" as the
+// concrete type in the discriminator of its base types.
+func (t Audio) MarshalJSON() ([]byte, error) {
+	type plain Audio
+	v := plain(t)
+
+	b0 := new(Media)
+	if v.Media != nil {
+		*b0 = *v.Media
+	}
+	if b0.MediaType == nil {
+		b0.MediaType = String("Audio")
+	}
+	v.Media = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CallOnlyAd" as the
+// concrete type in the discriminator of its base types.
+func (t CallOnlyAd) MarshalJSON() ([]byte, error) {
+	type plain CallOnlyAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("CallOnlyAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TextLabel" as the
+// concrete type in the discriminator of its base types.
+func (t TextLabel) MarshalJSON() ([]byte, error) {
+	type plain TextLabel
+	v := plain(t)
+
+	b0 := new(Label)
+	if v.Label != nil {
+		*b0 = *v.Label
+	}
+	if b0.LabelType == nil {
+		b0.LabelType = String("TextLabel")
+	}
+	v.Label = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DisplayAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t DisplayAttribute) MarshalJSON() ([]byte, error) {
+	type plain DisplayAttribute
+	v := plain(t)
+
+	b0 := new(LabelAttribute)
+	if v.LabelAttribute != nil {
+		*b0 = *v.LabelAttribute
+	}
+	if b0.LabelAttributeType == nil {
+		b0.LabelAttributeType = String("DisplayAttribute")
+	}
+	v.LabelAttribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CertificateDomainMismatchInCountryConstraint" as the
+// concrete type in the discriminator of its base types.
+func (t CertificateDomainMismatchInCountryConstraint) MarshalJSON() ([]byte, error) {
+	type plain CertificateDomainMismatchInCountryConstraint
+	v := plain(t)
+
+	b0 := new(CountryConstraint)
+	if v.CountryConstraint != nil {
+		*b0 = *v.CountryConstraint
+	}
+	v.CountryConstraint = b0
+
+	b1 := new(PolicyTopicConstraint)
+	if b0.PolicyTopicConstraint != nil {
+		*b1 = *b0.PolicyTopicConstraint
+	}
+	if b1.PolicyTopicConstraintType == nil {
+		b1.PolicyTopicConstraintType = String("CertificateDomainMismatchInCountryConstraint")
+	}
+	b0.PolicyTopicConstraint = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CertificateMissingConstraint" as the
+// concrete type in the discriminator of its base types.
+func (t CertificateMissingConstraint) MarshalJSON() ([]byte, error) {
+	type plain CertificateMissingConstraint
+	v := plain(t)
+
+	b0 := new(PolicyTopicConstraint)
+	if v.PolicyTopicConstraint != nil {
+		*b0 = *v.PolicyTopicConstraint
+	}
+	if b0.PolicyTopicConstraintType == nil {
+		b0.PolicyTopicConstraintType = String("CertificateMissingConstraint")
+	}
+	v.PolicyTopicConstraint = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CertificateMissingInCountryConstraint" as the
+// concrete type in the discriminator of its base types.
+func (t CertificateMissingInCountryConstraint) MarshalJSON() ([]byte, error) {
+	type plain CertificateMissingInCountryConstraint
+	v := plain(t)
+
+	b0 := new(CountryConstraint)
+	if v.CountryConstraint != nil {
+		*b0 = *v.CountryConstraint
+	}
+	v.CountryConstraint = b0
+
+	b1 := new(PolicyTopicConstraint)
+	if b0.PolicyTopicConstraint != nil {
+		*b1 = *b0.PolicyTopicConstraint
+	}
+	if b1.PolicyTopicConstraintType == nil {
+		b1.PolicyTopicConstraintType = String("CertificateMissingInCountryConstraint")
+	}
+	b0.PolicyTopicConstraint = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DateError" as the
+// concrete type in the discriminator of its base types.
+func (t DateError) MarshalJSON() ([]byte, error) {
+	type plain DateError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DateError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DeprecatedAd" as the
+// concrete type in the discriminator of its base types.
+func (t DeprecatedAd) MarshalJSON() ([]byte, error) {
+	type plain DeprecatedAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("DeprecatedAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t EntityAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain EntityAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityNotFound" as the
+// concrete type in the discriminator of its base types.
+func (t EntityNotFound) MarshalJSON() ([]byte, error) {
+	type plain EntityNotFound
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityNotFound")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ExpandedDynamicSearchAd" as the
+// concrete type in the discriminator of its base types.
+func (t ExpandedDynamicSearchAd) MarshalJSON() ([]byte, error) {
+	type plain ExpandedDynamicSearchAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("ExpandedDynamicSearchAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ExpandedTextAd" as the
+// concrete type in the discriminator of its base types.
+func (t ExpandedTextAd) MarshalJSON() ([]byte, error) {
+	type plain ExpandedTextAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("ExpandedTextAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "FeedAttributeReferenceError" as the
+// concrete type in the discriminator of its base types.
+func (t FeedAttributeReferenceError) MarshalJSON() ([]byte, error) {
+	type plain FeedAttributeReferenceError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("FeedAttributeReferenceError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ForwardCompatibilityError" as the
+// concrete type in the discriminator of its base types.
+func (t ForwardCompatibilityError) MarshalJSON() ([]byte, error) {
+	type plain ForwardCompatibilityError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ForwardCompatibilityError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "FunctionError" as the
+// concrete type in the discriminator of its base types.
+func (t FunctionError) MarshalJSON() ([]byte, error) {
+	type plain FunctionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("FunctionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "FunctionParsingError" as the
+// concrete type in the discriminator of its base types.
+func (t FunctionParsingError) MarshalJSON() ([]byte, error) {
+	type plain FunctionParsingError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("FunctionParsingError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "GmailAd" as the
+// concrete type in the discriminator of its base types.
+func (t GmailAd) MarshalJSON() ([]byte, error) {
+	type plain GmailAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("GmailAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Image" as the
+// concrete type in the discriminator of its base types.
+func (t Image) MarshalJSON() ([]byte, error) {
+	type plain Image
+	v := plain(t)
+
+	b0 := new(Media)
+	if v.Media != nil {
+		*b0 = *v.Media
+	}
+	if b0.MediaType == nil {
+		b0.MediaType = String("Image")
+	}
+	v.Media = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ImageAd" as the
+// concrete type in the discriminator of its base types.
+func (t ImageAd) MarshalJSON() ([]byte, error) {
+	type plain ImageAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("ImageAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ImageError" as the
+// concrete type in the discriminator of its base types.
+func (t ImageError) MarshalJSON() ([]byte, error) {
+	type plain ImageError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ImageError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MediaBundle" as the
+// concrete type in the discriminator of its base types.
+func (t MediaBundle) MarshalJSON() ([]byte, error) {
+	type plain MediaBundle
+	v := plain(t)
+
+	b0 := new(Media)
+	if v.Media != nil {
+		*b0 = *v.Media
+	}
+	if b0.MediaType == nil {
+		b0.MediaType = String("MediaBundle")
+	}
+	v.Media = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MediaBundleError" as the
+// concrete type in the discriminator of its base types.
+func (t MediaBundleError) MarshalJSON() ([]byte, error) {
+	type plain MediaBundleError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("MediaBundleError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MediaError" as the
+// concrete type in the discriminator of its base types.
+func (t MediaError) MarshalJSON() ([]byte, error) {
+	type plain MediaError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("MediaError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NewEntityCreationError" as the
+// concrete type in the discriminator of its base types.
+func (t NewEntityCreationError) MarshalJSON() ([]byte, error) {
+	type plain NewEntityCreationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NewEntityCreationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NullError" as the
+// concrete type in the discriminator of its base types.
+func (t NullError) MarshalJSON() ([]byte, error) {
+	type plain NullError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NullError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PagingError" as the
+// concrete type in the discriminator of its base types.
+func (t PagingError) MarshalJSON() ([]byte, error) {
+	type plain PagingError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("PagingError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PolicyViolationError" as the
+// concrete type in the discriminator of its base types.
+func (t PolicyViolationError) MarshalJSON() ([]byte, error) {
+	type plain PolicyViolationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("PolicyViolationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductAd" as the
+// concrete type in the discriminator of its base types.
+func (t ProductAd) MarshalJSON() ([]byte, error) {
+	type plain ProductAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("ProductAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QueryError" as the
+// concrete type in the discriminator of its base types.
+func (t QueryError) MarshalJSON() ([]byte, error) {
+	type plain QueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ResellerConstraint" as the
+// concrete type in the discriminator of its base types.
+func (t ResellerConstraint) MarshalJSON() ([]byte, error) {
+	type plain ResellerConstraint
+	v := plain(t)
+
+	b0 := new(PolicyTopicConstraint)
+	if v.PolicyTopicConstraint != nil {
+		*b0 = *v.PolicyTopicConstraint
+	}
+	if b0.PolicyTopicConstraintType == nil {
+		b0.PolicyTopicConstraintType = String("ResellerConstraint")
+	}
+	v.PolicyTopicConstraint = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ResponsiveDisplayAd" as the
+// concrete type in the discriminator of its base types.
+func (t ResponsiveDisplayAd) MarshalJSON() ([]byte, error) {
+	type plain ResponsiveDisplayAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("ResponsiveDisplayAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SelectorError" as the
+// concrete type in the discriminator of its base types.
+func (t SelectorError) MarshalJSON() ([]byte, error) {
+	type plain SelectorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SelectorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ShowcaseAd" as the
+// concrete type in the discriminator of its base types.
+func (t ShowcaseAd) MarshalJSON() ([]byte, error) {
+	type plain ShowcaseAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("ShowcaseAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StatsQueryError" as the
+// concrete type in the discriminator of its base types.
+func (t StatsQueryError) MarshalJSON() ([]byte, error) {
+	type plain StatsQueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StatsQueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TempAdUnionId" as the
+// concrete type in the discriminator of its base types.
+func (t TempAdUnionId) MarshalJSON() ([]byte, error) {
+	type plain TempAdUnionId
+	v := plain(t)
+
+	b0 := new(AdUnionId)
+	if v.AdUnionId != nil {
+		*b0 = *v.AdUnionId
+	}
+	if b0.AdUnionIdType == nil {
+		b0.AdUnionIdType = String("TempAdUnionId")
+	}
+	v.AdUnionId = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TemplateAd" as the
+// concrete type in the discriminator of its base types.
+func (t TemplateAd) MarshalJSON() ([]byte, error) {
+	type plain TemplateAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("TemplateAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TextAd" as the
+// concrete type in the discriminator of its base types.
+func (t TextAd) MarshalJSON() ([]byte, error) {
+	type plain TextAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("TextAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ThirdPartyRedirectAd" as the
+// concrete type in the discriminator of its base types.
+func (t ThirdPartyRedirectAd) MarshalJSON() ([]byte, error) {
+	type plain ThirdPartyRedirectAd
+	v := plain(t)
+
+	b0 := new(RichMediaAd)
+	if v.RichMediaAd != nil {
+		*b0 = *v.RichMediaAd
+	}
+	v.RichMediaAd = b0
+
+	b1 := new(Ad)
+	if b0.Ad != nil {
+		*b1 = *b0.Ad
+	}
+	if b1.AdType == nil {
+		b1.AdType = String("ThirdPartyRedirectAd")
+	}
+	b0.Ad = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UniversalShoppingAd" as the
+// concrete type in the discriminator of its base types.
+func (t UniversalShoppingAd) MarshalJSON() ([]byte, error) {
+	type plain UniversalShoppingAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("UniversalShoppingAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UrlError" as the
+// concrete type in the discriminator of its base types.
+func (t UrlError) MarshalJSON() ([]byte, error) {
+	type plain UrlError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("UrlError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Video" as the
+// concrete type in the discriminator of its base types.
+func (t Video) MarshalJSON() ([]byte, error) {
+	type plain Video
+	v := plain(t)
+
+	b0 := new(Media)
+	if v.Media != nil {
+		*b0 = *v.Media
+	}
+	if b0.MediaType == nil {
+		b0.MediaType = String("Video")
+	}
+	v.Media = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DynamicSearchAd" as the
+// concrete type in the discriminator of its base types.
+func (t DynamicSearchAd) MarshalJSON() ([]byte, error) {
+	type plain DynamicSearchAd
+	v := plain(t)
+
+	b0 := new(Ad)
+	if v.Ad != nil {
+		*b0 = *v.Ad
+	}
+	if b0.AdType == nil {
+		b0.AdType = String("DynamicSearchAd")
+	}
+	v.Ad = b0
+
+	return json.Marshal(v)
+}
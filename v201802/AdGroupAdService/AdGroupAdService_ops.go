@@ -0,0 +1,33 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupAdService
+
+// AddLabelOp returns the ADD operation of operand.
+func AddLabelOp(operand *AdGroupAdLabel) *AdGroupAdLabelOperation {
+	op := OperatorADD
+	return &AdGroupAdLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveLabelOp returns the REMOVE operation of operand.
+func RemoveLabelOp(operand *AdGroupAdLabel) *AdGroupAdLabelOperation {
+	op := OperatorREMOVE
+	return &AdGroupAdLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *AdGroupAd) *AdGroupAdOperation {
+	op := OperatorADD
+	return &AdGroupAdOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AdGroupAd) *AdGroupAdOperation {
+	op := OperatorSET
+	return &AdGroupAdOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *AdGroupAd) *AdGroupAdOperation {
+	op := OperatorREMOVE
+	return &AdGroupAdOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,68 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupAdService
+
+// Bool returns a pointer to v, for setting optional bool fields.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// BoolValue returns the value p points to, or the zero value if p is nil.
+func BoolValue(p *bool) bool {
+	if p == nil {
+		return false
+	}
+	return *p
+}
+
+// Int32 returns a pointer to v, for setting optional int32 fields.
+func Int32(v int32) *int32 {
+	return &v
+}
+
+// Int32Value returns the value p points to, or the zero value if p is nil.
+func Int32Value(p *int32) int32 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// Int64 returns a pointer to v, for setting optional int64 fields.
+func Int64(v int64) *int64 {
+	return &v
+}
+
+// Int64Value returns the value p points to, or the zero value if p is nil.
+func Int64Value(p *int64) int64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// Float64 returns a pointer to v, for setting optional float64 fields.
+func Float64(v float64) *float64 {
+	return &v
+}
+
+// Float64Value returns the value p points to, or the zero value if p is nil.
+func Float64Value(p *float64) float64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// String returns a pointer to v, for setting optional string fields.
+func String(v string) *string {
+	return &v
+}
+
+// StringValue returns the value p points to, or the zero value if p is nil.
+func StringValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
@@ -0,0 +1,62 @@
+package AdGroupAdService
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	ad := &ExpandedTextAd{
+		Ad:            &Ad{Id: Int64(7), FinalUrls: []string{"https://example.com"}},
+		HeadlinePart1: String("Shoes"),
+		Description:   String("Buy now"),
+	}
+	b, err := json.Marshal(ad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"adType":"ExpandedTextAd"`) {
+		t.Errorf("JSON lacks the concrete type: %s", b)
+	}
+	var again ExpandedTextAd
+	if err = json.Unmarshal(b, &again); err != nil {
+		t.Fatal(err)
+	}
+	if *again.Id != 7 || again.FinalUrls[0] != "https://example.com" || *again.HeadlinePart1 != "Shoes" ||
+		*again.Description != "Buy now" || *again.AdType != "ExpandedTextAd" {
+		t.Errorf("got %+v, %+v from %s", again, again.Ad, b)
+	}
+	// The discriminator set by the API is kept.
+	b2, err := json.Marshal(&again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b2) != string(b) {
+		t.Errorf("got %s after a round trip, want %s", b2, b)
+	}
+}
+
+// TestJSONBaseField shows the limits of a field of a base type: it holds
+// the fields of the base type and the discriminator naming the concrete
+// type, while the fields of the concrete type are dropped.
+func TestJSONBaseField(t *testing.T) {
+	ad, err := json.Marshal(&ExpandedTextAd{Ad: &Ad{Id: Int64(7)}, HeadlinePart1: String("Shoes")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var a AdGroupAd
+	if err = json.Unmarshal([]byte(`{"adGroupId":5,"ad":`+string(ad)+`}`), &a); err != nil {
+		t.Fatal(err)
+	}
+	if *a.AdGroupId != 5 || *a.Ad.Id != 7 || *a.Ad.AdType != "ExpandedTextAd" {
+		t.Errorf("got %+v, %+v", a, a.Ad)
+	}
+	b, err := json.Marshal(&a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"adGroupId":5,"ad":{"id":7,"adType":"ExpandedTextAd"}}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+}
@@ -0,0 +1,120 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupBidModifierService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AdGroupBidModifierServiceAPI is the interface of the AdGroupBidModifierService operations. It is
+// implemented by AdGroupBidModifierServiceInterface and, for tests, by FakeAdGroupBidModifierService.
+type AdGroupBidModifierServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+	Query(request *Query) (*QueryResponse, error)
+}
+
+var _ AdGroupBidModifierServiceAPI = (*AdGroupBidModifierServiceInterface)(nil)
+var _ AdGroupBidModifierServiceAPI = (*FakeAdGroupBidModifierService)(nil)
+
+// FakeCall is a call recorded by FakeAdGroupBidModifierService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeAdGroupBidModifierService is a programmable AdGroupBidModifierServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeAdGroupBidModifierService struct {
+	GetFunc    func(request *Get) (*GetResponse, error)
+	MutateFunc func(request *Mutate) (*MutateResponse, error)
+	QueryFunc  func(request *Query) (*QueryResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeAdGroupBidModifierService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeAdGroupBidModifierService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAdGroupBidModifierService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeAdGroupBidModifierService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupBidModifierService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeAdGroupBidModifierService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeAdGroupBidModifierService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupBidModifierService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeAdGroupBidModifierService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
+
+// Query records the call and delegates to QueryFunc.
+func (f *FakeAdGroupBidModifierService) Query(request *Query) (*QueryResponse, error) {
+	f.record("Query", request)
+	if f.QueryFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupBidModifierService.QueryFunc is not set")
+	}
+	return f.QueryFunc(request)
+}
+
+// QueryCalls returns the requests of the recorded Query calls.
+func (f *FakeAdGroupBidModifierService) QueryCalls() []*Query {
+	var requests []*Query
+	for _, c := range f.Calls() {
+		if c.Method == "Query" {
+			requests = append(requests, c.Request.(*Query))
+		}
+	}
+	return requests
+}
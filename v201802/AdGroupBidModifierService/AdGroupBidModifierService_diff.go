@@ -0,0 +1,600 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupBidModifierService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupBidModifier) Copy() *AdGroupBidModifier {
+	return deep.Copy(t).(*AdGroupBidModifier)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupBidModifier) Equal(o *AdGroupBidModifier) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupBidModifierOperation) Copy() *AdGroupBidModifierOperation {
+	return deep.Copy(t).(*AdGroupBidModifierOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupBidModifierOperation) Equal(o *AdGroupBidModifierOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupBidModifierPage) Copy() *AdGroupBidModifierPage {
+	return deep.Copy(t).(*AdGroupBidModifierPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupBidModifierPage) Equal(o *AdGroupBidModifierPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupBidModifierReturnValue) Copy() *AdGroupBidModifierReturnValue {
+	return deep.Copy(t).(*AdGroupBidModifierReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupBidModifierReturnValue) Equal(o *AdGroupBidModifierReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Criterion) Copy() *Criterion {
+	return deep.Copy(t).(*Criterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Criterion) Equal(o *Criterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionError) Copy() *CriterionError {
+	return deep.Copy(t).(*CriterionError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionError) Equal(o *CriterionError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityNotFound) Copy() *EntityNotFound {
+	return deep.Copy(t).(*EntityNotFound)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityNotFound) Equal(o *EntityNotFound) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NewEntityCreationError) Copy() *NewEntityCreationError {
+	return deep.Copy(t).(*NewEntityCreationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NewEntityCreationError) Equal(o *NewEntityCreationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Platform) Copy() *Platform {
+	return deep.Copy(t).(*Platform)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Platform) Equal(o *Platform) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PreferredContent) Copy() *PreferredContent {
+	return deep.Copy(t).(*PreferredContent)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PreferredContent) Equal(o *PreferredContent) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffAdGroupBidModifier compares from, as fetched from the API, with the desired
+// to. It returns a AdGroupBidModifier holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdGroupBidModifier(from, to *AdGroupBidModifier) (*AdGroupBidModifier, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"CampaignId"},
+		[]string{"AdGroupId", "Criterion", "BidModifier"},
+	).(*AdGroupBidModifier)
+	return set, changes
+}
+
+// SetAdGroupBidModifierOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffAdGroupBidModifier.
+func SetAdGroupBidModifierOperation(from, to *AdGroupBidModifier) (*AdGroupBidModifierOperation, []deep.Change) {
+	set, changes := DiffAdGroupBidModifier(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &AdGroupBidModifierOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+
+// DiffCriterion compares from, as fetched from the API, with the desired
+// to. It returns a Criterion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCriterion(from, to *Criterion) (*Criterion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Criterion)
+	return set, changes
+}
+
+// DiffPlatform compares from, as fetched from the API, with the desired
+// to. It returns a Platform holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffPlatform(from, to *Platform) (*Platform, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Platform)
+	return set, changes
+}
+
+// DiffPreferredContent compares from, as fetched from the API, with the desired
+// to. It returns a PreferredContent holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffPreferredContent(from, to *PreferredContent) (*PreferredContent, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*PreferredContent)
+	return set, changes
+}
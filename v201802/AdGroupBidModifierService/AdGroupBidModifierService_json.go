@@ -0,0 +1,563 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupBidModifierService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupBidModifierOperation" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupBidModifierOperation) MarshalJSON() ([]byte, error) {
+	type plain AdGroupBidModifierOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("AdGroupBidModifierOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupBidModifierPage" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupBidModifierPage) MarshalJSON() ([]byte, error) {
+	type plain AdGroupBidModifierPage
+	v := plain(t)
+
+	b0 := new(Page)
+	if v.Page != nil {
+		*b0 = *v.Page
+	}
+	if b0.PageType == nil {
+		b0.PageType = String("AdGroupBidModifierPage")
+	}
+	v.Page = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupBidModifierReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupBidModifierReturnValue) MarshalJSON() ([]byte, error) {
+	type plain AdGroupBidModifierReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("AdGroupBidModifierReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionError" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionError) MarshalJSON() ([]byte, error) {
+	type plain CriterionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CriterionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityNotFound" as the
+// concrete type in the discriminator of its base types.
+func (t EntityNotFound) MarshalJSON() ([]byte, error) {
+	type plain EntityNotFound
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityNotFound")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NewEntityCreationError" as the
+// concrete type in the discriminator of its base types.
+func (t NewEntityCreationError) MarshalJSON() ([]byte, error) {
+	type plain NewEntityCreationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NewEntityCreationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Platform" as the
+// concrete type in the discriminator of its base types.
+func (t Platform) MarshalJSON() ([]byte, error) {
+	type plain Platform
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Platform")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PreferredContent" as the
+// concrete type in the discriminator of its base types.
+func (t PreferredContent) MarshalJSON() ([]byte, error) {
+	type plain PreferredContent
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("PreferredContent")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QueryError" as the
+// concrete type in the discriminator of its base types.
+func (t QueryError) MarshalJSON() ([]byte, error) {
+	type plain QueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SelectorError" as the
+// concrete type in the discriminator of its base types.
+func (t SelectorError) MarshalJSON() ([]byte, error) {
+	type plain SelectorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SelectorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
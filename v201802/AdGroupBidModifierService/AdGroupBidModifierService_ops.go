@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupBidModifierService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *AdGroupBidModifier) *AdGroupBidModifierOperation {
+	op := OperatorADD
+	return &AdGroupBidModifierOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AdGroupBidModifier) *AdGroupBidModifierOperation {
+	op := OperatorSET
+	return &AdGroupBidModifierOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *AdGroupBidModifier) *AdGroupBidModifierOperation {
+	op := OperatorREMOVE
+	return &AdGroupBidModifierOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -3106,22 +3106,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse" json:"-"`
 
-	Rval *AdGroupCriterionPage `xml:"rval,omitempty"`
+	Rval *AdGroupCriterionPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -3130,17 +3130,17 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, SET, REMOVE.</span>
 	//
-	Operations []*AdGroupCriterionOperation `xml:"operations,omitempty"`
+	Operations []*AdGroupCriterionOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse" json:"-"`
 
-	Rval *AdGroupCriterionReturnValue `xml:"rval,omitempty"`
+	Rval *AdGroupCriterionReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabel"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabel" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -3149,51 +3149,49 @@ type MutateLabel struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, REMOVE.</span>
 	//
-	Operations []*AdGroupCriterionLabelOperation `xml:"operations,omitempty"`
+	Operations []*AdGroupCriterionLabelOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateLabelResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabelResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabelResponse" json:"-"`
 
-	Rval *AdGroupCriterionLabelReturnValue `xml:"rval,omitempty"`
+	Rval *AdGroupCriterionLabelReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Query struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse" json:"-"`
 
-	Rval *AdGroupCriterionPage `xml:"rval,omitempty"`
+	Rval *AdGroupCriterionPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdGroupCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterion"`
-
 	//
 	// The ad group this criterion is in.
 	// <span class="constraint Selectable">This field can be selected using the value "AdGroupId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "CriterionUse".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CriterionUse *CriterionUse `xml:"criterionUse,omitempty"`
+	CriterionUse *CriterionUse `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterionUse,omitempty" json:"criterionUse,omitempty"`
 
 	//
 	// The criterion part of the ad group criterion.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Criterion *Criterion `xml:"criterion,omitempty"`
+	Criterion *Criterion `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterion,omitempty" json:"criterion,omitempty"`
 
 	//
 	// Labels that are attached to the AdGroupCriterion. To associate an existing {@link Label} to an
@@ -3206,7 +3204,7 @@ type AdGroupCriterion struct {
 	// <span class="constraint CampaignType">This field may not be set for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	Labels []*Label `xml:"labels,omitempty"`
+	Labels []*Label `xml:"https://adwords.google.com/api/adwords/cm/v201802 labels,omitempty" json:"labels,omitempty"`
 
 	//
 	// This Map provides a place to put new features and settings in older versions
@@ -3215,7 +3213,7 @@ type AdGroupCriterion struct {
 	//
 	// It is presently unused.  Do not set a value.
 	//
-	ForwardCompatibilityMap []*String_StringMapEntry `xml:"forwardCompatibilityMap,omitempty"`
+	ForwardCompatibilityMap []*String_StringMapEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 forwardCompatibilityMap,omitempty" json:"forwardCompatibilityMap,omitempty"`
 
 	//
 	// ID of the base campaign from which this draft/trial ad group criterion was created.
@@ -3223,7 +3221,7 @@ type AdGroupCriterion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BaseCampaignId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BaseCampaignId int64 `xml:"baseCampaignId,omitempty"`
+	BaseCampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 baseCampaignId,omitempty" json:"baseCampaignId,omitempty"`
 
 	//
 	// ID of the base ad group from which this draft/trial ad group criterion was created. For
@@ -3233,89 +3231,77 @@ type AdGroupCriterion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BaseAdGroupId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BaseAdGroupId int64 `xml:"baseAdGroupId,omitempty"`
+	BaseAdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 baseAdGroupId,omitempty" json:"baseAdGroupId,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of AdGroupCriterion.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	AdGroupCriterionType string `xml:"AdGroupCriterion.Type,omitempty"`
+	AdGroupCriterionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterion.Type,omitempty" json:"adGroupCriterionType,omitempty"`
 }
 
 type AdGroupCriterionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdGroupCriterionErrorReason `xml:"reason,omitempty"`
+	Reason *AdGroupCriterionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AdGroupCriterionLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionLabel"`
-
 	//
 	// The id of the adgroup containing the criterion that the label is applied to.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// The id of the criterion that the label is applied to.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	CriterionId int64 `xml:"criterionId,omitempty"`
+	CriterionId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterionId,omitempty" json:"criterionId,omitempty"`
 
 	//
 	// The id of an existing label to be applied to the adgroup criterion.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	LabelId int64 `xml:"labelId,omitempty"`
+	LabelId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 labelId,omitempty" json:"labelId,omitempty"`
 }
 
 type AdGroupCriterionLabelOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionLabelOperation"`
-
 	*Operation
 
 	//
 	// AdGroupCriterionLabel to operate on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *AdGroupCriterionLabel `xml:"operand,omitempty"`
+	Operand *AdGroupCriterionLabel `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type AdGroupCriterionLabelReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionLabelReturnValue"`
-
 	*ListReturnValue
 
-	Value []*AdGroupCriterionLabel `xml:"value,omitempty"`
+	Value []*AdGroupCriterionLabel `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type AdGroupCriterionLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionLimitExceeded"`
-
 	*EntityCountLimitExceeded
 
-	LimitType *AdGroupCriterionLimitExceededCriteriaLimitType `xml:"limitType,omitempty"`
+	LimitType *AdGroupCriterionLimitExceededCriteriaLimitType `xml:"https://adwords.google.com/api/adwords/cm/v201802 limitType,omitempty" json:"limitType,omitempty"`
 }
 
 type AdGroupCriterionOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionOperation"`
-
 	*Operation
 
 	//
 	// The adgroup criterion being operated on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *AdGroupCriterion `xml:"operand,omitempty"`
+	Operand *AdGroupCriterion `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 
 	//
 	// List of exemption requests for policy violations flagged by this criterion.
@@ -3323,201 +3309,171 @@ type AdGroupCriterionOperation struct {
 	// <p>Only set this field when adding criteria that trigger policy violations
 	// for which you wish to get exemptions for.
 	//
-	ExemptionRequests []*ExemptionRequest `xml:"exemptionRequests,omitempty"`
+	ExemptionRequests []*ExemptionRequest `xml:"https://adwords.google.com/api/adwords/cm/v201802 exemptionRequests,omitempty" json:"exemptionRequests,omitempty"`
 }
 
 type AdGroupCriterionPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*AdGroupCriterion `xml:"entries,omitempty"`
+	Entries []*AdGroupCriterion `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type AdGroupCriterionReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionReturnValue"`
-
 	*ListReturnValue
 
 	//
 	// List of adgroup criteria.
 	//
-	Value []*AdGroupCriterion `xml:"value,omitempty"`
+	Value []*AdGroupCriterion `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// List of partial failure errors.
 	//
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AgeRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AgeRange"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "AgeRangeType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	AgeRangeType *AgeRangeAgeRangeType `xml:"ageRangeType,omitempty"`
+	AgeRangeType *AgeRangeAgeRangeType `xml:"https://adwords.google.com/api/adwords/cm/v201802 ageRangeType,omitempty" json:"ageRangeType,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type AppPaymentModel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AppPaymentModel"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "AppPaymentModelType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	AppPaymentModelType *AppPaymentModelAppPaymentModelType `xml:"appPaymentModelType,omitempty"`
+	AppPaymentModelType *AppPaymentModelAppPaymentModelType `xml:"https://adwords.google.com/api/adwords/cm/v201802 appPaymentModelType,omitempty" json:"appPaymentModelType,omitempty"`
 }
 
 type AppUrl struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AppUrl"`
-
 	//
 	// The app deep link url. E.g. "android-app://com.my.App"
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 
 	//
 	// The operating system targeted by this url.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	OsType *AppUrlOsType `xml:"osType,omitempty"`
+	OsType *AppUrlOsType `xml:"https://adwords.google.com/api/adwords/cm/v201802 osType,omitempty" json:"osType,omitempty"`
 }
 
 type AppUrlList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AppUrlList"`
-
 	//
 	// List of URLs. On SET operation, empty list indicates to clear the list.
 	// <span class="constraint CollectionSize">The maximum size of this collection is 10.</span>
 	//
-	AppUrls []*AppUrl `xml:"appUrls,omitempty"`
+	AppUrls []*AppUrl `xml:"https://adwords.google.com/api/adwords/cm/v201802 appUrls,omitempty" json:"appUrls,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type LabelAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LabelAttribute"`
-
 	//
 	// Indicates that this instance is a subtype of LabelAttribute.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	LabelAttributeType string `xml:"LabelAttribute.Type,omitempty"`
+	LabelAttributeType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 LabelAttribute.Type,omitempty" json:"labelAttributeType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Bid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Bid"`
-
 	//
 	// Bid amount.
 	//
-	Amount *Money `xml:"amount,omitempty"`
+	Amount *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 amount,omitempty" json:"amount,omitempty"`
 }
 
 type BiddableAdGroupCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddableAdGroupCriterion"`
-
 	*AdGroupCriterion
 
 	//
@@ -3526,28 +3482,28 @@ type BiddableAdGroupCriterion struct {
 	// criterion. On add, defaults to {@code ENABLED} if unspecified.
 	// <span class="constraint Selectable">This field can be selected using the value "Status".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	UserStatus *UserStatus `xml:"userStatus,omitempty"`
+	UserStatus *UserStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 userStatus,omitempty" json:"userStatus,omitempty"`
 
 	//
 	// Serving status.
 	// <span class="constraint Selectable">This field can be selected using the value "SystemServingStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SystemServingStatus *SystemServingStatus `xml:"systemServingStatus,omitempty"`
+	SystemServingStatus *SystemServingStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 systemServingStatus,omitempty" json:"systemServingStatus,omitempty"`
 
 	//
 	// Approval status.
 	// <span class="constraint Selectable">This field can be selected using the value "ApprovalStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ApprovalStatus *ApprovalStatus `xml:"approvalStatus,omitempty"`
+	ApprovalStatus *ApprovalStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 approvalStatus,omitempty" json:"approvalStatus,omitempty"`
 
 	//
 	// List of disapproval reasons.
 	// <span class="constraint Selectable">This field can be selected using the value "DisapprovalReasons".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisapprovalReasons []string `xml:"disapprovalReasons,omitempty"`
+	DisapprovalReasons []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 disapprovalReasons,omitempty" json:"disapprovalReasons,omitempty"`
 
 	//
 	// Destination URL override when Ad is triggered by this criterion.
@@ -3557,14 +3513,14 @@ type BiddableAdGroupCriterion struct {
 	// <p>Set to the empty string ("") to clear the destination URL.
 	// <span class="constraint Selectable">This field can be selected using the value "DestinationUrl".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	DestinationUrl string `xml:"destinationUrl,omitempty"`
+	DestinationUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 destinationUrl,omitempty" json:"destinationUrl,omitempty"`
 
 	//
 	// First page Cpc for this criterion.
 	// <span class="constraint Selectable">This field can be selected using the value "FirstPageCpc".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	FirstPageCpc *Bid `xml:"firstPageCpc,omitempty"`
+	FirstPageCpc *Bid `xml:"https://adwords.google.com/api/adwords/cm/v201802 firstPageCpc,omitempty" json:"firstPageCpc,omitempty"`
 
 	//
 	// An estimate of the cpc bid needed for your ad to appear above the
@@ -3574,7 +3530,7 @@ type BiddableAdGroupCriterion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "TopOfPageCpc".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	TopOfPageCpc *Bid `xml:"topOfPageCpc,omitempty"`
+	TopOfPageCpc *Bid `xml:"https://adwords.google.com/api/adwords/cm/v201802 topOfPageCpc,omitempty" json:"topOfPageCpc,omitempty"`
 
 	//
 	// An estimate of the cpc bid needed for your ad to regularly appear in the top position above
@@ -3583,13 +3539,13 @@ type BiddableAdGroupCriterion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "FirstPositionCpc".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	FirstPositionCpc *Bid `xml:"firstPositionCpc,omitempty"`
+	FirstPositionCpc *Bid `xml:"https://adwords.google.com/api/adwords/cm/v201802 firstPositionCpc,omitempty" json:"firstPositionCpc,omitempty"`
 
 	//
 	// Contains quality information about the criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	QualityInfo *QualityInfo `xml:"qualityInfo,omitempty"`
+	QualityInfo *QualityInfo `xml:"https://adwords.google.com/api/adwords/cm/v201802 qualityInfo,omitempty" json:"qualityInfo,omitempty"`
 
 	//
 	// Bidding configuration for this ad group criterion. To set the bids on the ad groups
@@ -3598,28 +3554,28 @@ type BiddableAdGroupCriterion struct {
 	// strategy {@linkplain Campaign#biddingStrategyConfiguration bidding strategy}
 	// will be used.
 	//
-	BiddingStrategyConfiguration *BiddingStrategyConfiguration `xml:"biddingStrategyConfiguration,omitempty"`
+	BiddingStrategyConfiguration *BiddingStrategyConfiguration `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyConfiguration,omitempty" json:"biddingStrategyConfiguration,omitempty"`
 
 	//
 	// Bid modifier of the criterion which is used when the criterion is not in an absolute bidding
 	// dimension.
 	// <span class="constraint Selectable">This field can be selected using the value "BidModifier".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	BidModifier float64 `xml:"bidModifier,omitempty"`
+	BidModifier *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidModifier,omitempty" json:"bidModifier,omitempty"`
 
 	//
 	// A list of possible final URLs after all cross domain redirects.
 	// <span class="constraint Selectable">This field can be selected using the value "FinalUrls".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint CampaignType">This field may not be set for campaign channel type SHOPPING with campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	FinalUrls *UrlList `xml:"finalUrls,omitempty"`
+	FinalUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrls,omitempty" json:"finalUrls,omitempty"`
 
 	//
 	// A list of possible final mobile URLs after all cross domain redirects.
 	// <span class="constraint Selectable">This field can be selected using the value "FinalMobileUrls".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint CampaignType">This field may not be set for campaign channel type SHOPPING with campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	FinalMobileUrls *UrlList `xml:"finalMobileUrls,omitempty"`
+	FinalMobileUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalMobileUrls,omitempty" json:"finalMobileUrls,omitempty"`
 
 	//
 	// A list of final app URLs that will be used on mobile if the user has the specific app
@@ -3627,7 +3583,7 @@ type BiddableAdGroupCriterion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "FinalAppUrls".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint CampaignType">This field may not be set for campaign channel type SHOPPING with campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	FinalAppUrls *AppUrlList `xml:"finalAppUrls,omitempty"`
+	FinalAppUrls *AppUrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalAppUrls,omitempty" json:"finalAppUrls,omitempty"`
 
 	//
 	// URL template for constructing a tracking URL.
@@ -3635,7 +3591,7 @@ type BiddableAdGroupCriterion struct {
 	// <p>On update, empty string ("") indicates to clear the field.
 	// <span class="constraint Selectable">This field can be selected using the value "TrackingUrlTemplate".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TrackingUrlTemplate string `xml:"trackingUrlTemplate,omitempty"`
+	TrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
 
 	//
 	// URL template for appending params to Final URL.
@@ -3643,41 +3599,35 @@ type BiddableAdGroupCriterion struct {
 	// <p>On update, empty string ("") indicates to clear the field.
 	// <p>This field is supported only in test accounts.
 	//
-	FinalUrlSuffix string `xml:"finalUrlSuffix,omitempty"`
+	FinalUrlSuffix *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrlSuffix,omitempty" json:"finalUrlSuffix,omitempty"`
 
 	//
 	// A list of mappings to be used for substituting URL custom parameter tags in the
 	// trackingUrlTemplate, finalUrls, and/or finalMobileUrls.
 	// <span class="constraint Selectable">This field can be selected using the value "UrlCustomParameters".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	UrlCustomParameters *CustomParameters `xml:"urlCustomParameters,omitempty"`
+	UrlCustomParameters *CustomParameters `xml:"https://adwords.google.com/api/adwords/cm/v201802 urlCustomParameters,omitempty" json:"urlCustomParameters,omitempty"`
 }
 
 type BiddingErrors struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingErrors"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *BiddingErrorsReason `xml:"reason,omitempty"`
+	Reason *BiddingErrorsReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingScheme"`
-
 	//
 	// Indicates that this instance is a subtype of BiddingScheme.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	BiddingSchemeType string `xml:"BiddingScheme.Type,omitempty"`
+	BiddingSchemeType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingScheme.Type,omitempty" json:"biddingSchemeType,omitempty"`
 }
 
 type BiddingStrategyConfiguration struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingStrategyConfiguration"`
-
 	//
 	// Id of the bidding strategy to be associated with the campaign, ad group or ad group criteria. A
 	// bidding strategy is created using the BiddingStrategyService ADD operation and is assigned a
@@ -3689,14 +3639,14 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint AdxEnabled">This is disabled for AdX.</span>
 	// <span class="constraint CampaignType">This field may not be set for campaign channel type SHOPPING.</span>
 	//
-	BiddingStrategyId int64 `xml:"biddingStrategyId,omitempty"`
+	BiddingStrategyId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyId,omitempty" json:"biddingStrategyId,omitempty"`
 
 	//
 	// Name of the bidding strategy. This is applicable only for flexible bidding strategies.
 	// <span class="constraint Selectable">This field can be selected using the value "BiddingStrategyName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BiddingStrategyName string `xml:"biddingStrategyName,omitempty"`
+	BiddingStrategyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyName,omitempty" json:"biddingStrategyName,omitempty"`
 
 	//
 	// The type of the bidding strategy to be attached.
@@ -3717,7 +3667,7 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint CampaignType">This field may only be set to NONE for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	// <span class="constraint CampaignType">This field may only be set to the values: MANUAL_CPC, ENHANCED_CPC, TARGET_CPA, TARGET_ROAS, NONE for campaign channel type DISPLAY with campaign channel subtype DISPLAY_GMAIL_AD.</span>
 	//
-	BiddingStrategyType *BiddingStrategyType `xml:"biddingStrategyType,omitempty"`
+	BiddingStrategyType *BiddingStrategyType `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyType,omitempty" json:"biddingStrategyType,omitempty"`
 
 	//
 	// Indicates where the bidding strategy is associated i.e. campaign, ad group or
@@ -3725,7 +3675,7 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BiddingStrategySource".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BiddingStrategySource *BiddingStrategySource `xml:"biddingStrategySource,omitempty"`
+	BiddingStrategySource *BiddingStrategySource `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategySource,omitempty" json:"biddingStrategySource,omitempty"`
 
 	//
 	// The bidding strategy metadata. Bidding strategy can be associated using the {@linkplain
@@ -3736,7 +3686,7 @@ type BiddingStrategyConfiguration struct {
 	//
 	// <p>Starting with v201705, this field cannot be set at the ad group or ad group criterion level.
 	//
-	BiddingScheme *BiddingScheme `xml:"biddingScheme,omitempty"`
+	BiddingScheme *BiddingScheme `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingScheme,omitempty" json:"biddingScheme,omitempty"`
 
 	//
 	// Specifies the bids. Bids can be set only on ad groups and ad group criteria.
@@ -3746,7 +3696,7 @@ type BiddingStrategyConfiguration struct {
 	// creation. Default CPC and CPM values are minimal billable amounts in local currencies.
 	// For example, for US Dollars CPC and CPM default values are $0.01 and $0.01, respectively.
 	//
-	Bids []*Bids `xml:"bids,omitempty"`
+	Bids []*Bids `xml:"https://adwords.google.com/api/adwords/cm/v201802 bids,omitempty" json:"bids,omitempty"`
 
 	//
 	// The target return on average spend (ROAS). This target can only be set on ad groups. If this
@@ -3756,77 +3706,63 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint CampaignType">This field may not be set.</span>
 	// <span class="constraint InRange">This field must be between 0.01 and 1000.0, inclusive.</span>
 	//
-	TargetRoasOverride float64 `xml:"targetRoasOverride,omitempty"`
+	TargetRoasOverride *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoasOverride,omitempty" json:"targetRoasOverride,omitempty"`
 }
 
 type Bids struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Bids"`
-
 	//
 	// Indicates that this instance is a subtype of Bids.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	BidsType string `xml:"Bids.Type,omitempty"`
+	BidsType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Bids.Type,omitempty" json:"bidsType,omitempty"`
 }
 
 type TextLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TextLabel"`
-
 	*Label
 }
 
 type DisplayAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DisplayAttribute"`
-
 	*LabelAttribute
 
 	//
 	// Background color of the label in RGB format.
 	// <span class="constraint MatchesRegex">A background color string must begin with a '#' character followed by either 6 or 3 hexadecimal characters (24 vs. 12 bits). This is checked by the regular expression '^\#([a-fA-F0-9]{6}|[a-fA-F0-9]{3})$'.</span>
 	//
-	BackgroundColor string `xml:"backgroundColor,omitempty"`
+	BackgroundColor *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 backgroundColor,omitempty" json:"backgroundColor,omitempty"`
 
 	//
 	// A short description of the label.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive.</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CollectionSizeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CollectionSizeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CollectionSizeErrorReason `xml:"reason,omitempty"`
+	Reason *CollectionSizeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type CpaBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpaBid"`
-
 	*Bids
 
 	//
@@ -3837,19 +3773,17 @@ type CpaBid struct {
 	// set the {@linkplain TargetCpaBiddingScheme#targetCpa} on the strategy's
 	// {@linkplain BiddingStrategyConfiguration#biddingScheme}.
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group, ad group strategy, or campaign strategy) at which the bid was set.
 	// This is applicable only at the ad group level.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BidSource *BidSource `xml:"bidSource,omitempty"`
+	BidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidSource,omitempty" json:"bidSource,omitempty"`
 }
 
 type CpcBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpcBid"`
-
 	*Bids
 
 	//
@@ -3860,7 +3794,7 @@ type CpcBid struct {
 	// At the ad group criteria level, this is the max cpc bid.
 	// <span class="constraint Selectable">This field can be selected using the value "CpcBid".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group or criterion) at which the bid was set. This is applicable
@@ -3868,19 +3802,17 @@ type CpcBid struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CpcBidSource".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CpcBidSource *BidSource `xml:"cpcBidSource,omitempty"`
+	CpcBidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 cpcBidSource,omitempty" json:"cpcBidSource,omitempty"`
 }
 
 type CpmBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpmBid"`
-
 	*Bids
 
 	//
 	// Max CPM (cost per thousand impressions) bid.
 	// <span class="constraint Selectable">This field can be selected using the value "CpmBid".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group or criterion) at which the bid was set. This is applicable
@@ -3888,300 +3820,254 @@ type CpmBid struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CpmBidSource".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CpmBidSource *BidSource `xml:"cpmBidSource,omitempty"`
+	CpmBidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 cpmBidSource,omitempty" json:"cpmBidSource,omitempty"`
 }
 
 type Criterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion"`
-
 	//
 	// ID of this criterion.
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "CriteriaType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Type_ *CriterionType `xml:"type,omitempty"`
+	Type_ *CriterionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Criterion.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CriterionType string `xml:"Criterion.Type,omitempty"`
+	CriterionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion.Type,omitempty" json:"criterionType,omitempty"`
 }
 
 type CriterionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionError"`
-
 	*ApiError
 
-	Reason *CriterionErrorReason `xml:"reason,omitempty"`
+	Reason *CriterionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CriterionParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionParameter"`
-
 	//
 	// Indicates that this instance is a subtype of CriterionParameter.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CriterionParameterType string `xml:"CriterionParameter.Type,omitempty"`
+	CriterionParameterType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionParameter.Type,omitempty" json:"criterionParameterType,omitempty"`
 }
 
 type CriterionPolicyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionPolicyError"`
-
 	*PolicyViolationError
 }
 
 type CustomParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameter"`
-
 	//
 	// The parameter key to be mapped.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 16, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// The value this parameter should be mapped to. It should be null if isRemove is true.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// On SET operation, indicates that the parameter should be removed from the existing parameters.
 	// If set to true, the value field must be null.
 	//
-	IsRemove bool `xml:"isRemove,omitempty"`
+	IsRemove *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isRemove,omitempty" json:"isRemove,omitempty"`
 }
 
 type CustomParameters struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameters"`
-
 	//
 	// The list of custom parameters.
 	//
 	// <p>On update, all parameters can be cleared by providing an empty or null list and setting
 	// doReplace to true.
 	//
-	Parameters []*CustomParameter `xml:"parameters,omitempty"`
+	Parameters []*CustomParameter `xml:"https://adwords.google.com/api/adwords/cm/v201802 parameters,omitempty" json:"parameters,omitempty"`
 
 	//
 	// On SET operation, indicates that the current parameters should be cleared and replaced
 	// with these parameters.
 	//
-	DoReplace bool `xml:"doReplace,omitempty"`
+	DoReplace *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 doReplace,omitempty" json:"doReplace,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type EnhancedCpcBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EnhancedCpcBiddingScheme"`
-
 	*BiddingScheme
 }
 
 type EntityAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityAccessDenied"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *EntityAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type EntityCountLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityCountLimitExceeded"`
-
 	*ApiError
 
 	//
 	// Specifies which level's limit was exceeded.
 	//
-	Reason *EntityCountLimitExceededReason `xml:"reason,omitempty"`
+	Reason *EntityCountLimitExceededReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Id of the entity whose limit was exceeded.
 	//
-	EnclosingId string `xml:"enclosingId,omitempty"`
+	EnclosingId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 enclosingId,omitempty" json:"enclosingId,omitempty"`
 
 	//
 	// The limit which was exceeded.
 	//
-	Limit int32 `xml:"limit,omitempty"`
+	Limit *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 limit,omitempty" json:"limit,omitempty"`
 
 	//
 	// The account limit type which was exceeded.
 	//
-	AccountLimitType string `xml:"accountLimitType,omitempty"`
+	AccountLimitType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 accountLimitType,omitempty" json:"accountLimitType,omitempty"`
 
 	//
 	// The count of existing entities.
 	//
-	ExistingCount int32 `xml:"existingCount,omitempty"`
+	ExistingCount *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 existingCount,omitempty" json:"existingCount,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ExemptionRequest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExemptionRequest"`
-
 	//
 	// Identifies the violation to request an exemption for.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Key *PolicyViolationKey `xml:"key,omitempty"`
+	Key *PolicyViolationKey `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type ForwardCompatibilityError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ForwardCompatibilityError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ForwardCompatibilityErrorReason `xml:"reason,omitempty"`
+	Reason *ForwardCompatibilityErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Gender struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Gender"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "GenderType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	GenderType *GenderGenderType `xml:"genderType,omitempty"`
+	GenderType *GenderGenderType `xml:"https://adwords.google.com/api/adwords/cm/v201802 genderType,omitempty" json:"genderType,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type IncomeRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IncomeRange"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "IncomeRangeType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	IncomeRangeType *IncomeRangeIncomeRangeType `xml:"incomeRangeType,omitempty"`
+	IncomeRangeType *IncomeRangeIncomeRangeType `xml:"https://adwords.google.com/api/adwords/cm/v201802 incomeRangeType,omitempty" json:"incomeRangeType,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Keyword struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Keyword"`
-
 	*Criterion
 
 	//
@@ -4190,76 +4076,68 @@ type Keyword struct {
 	// <span class="constraint MatchesRegex">Keyword text must not contain NUL (code point 0x0) characters. This is checked by the regular expression '[^\x00]*'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Text string `xml:"text,omitempty"`
+	Text *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 text,omitempty" json:"text,omitempty"`
 
 	//
 	// Match type of this keyword.
 	// <span class="constraint Selectable">This field can be selected using the value "KeywordMatchType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MatchType *KeywordMatchType `xml:"matchType,omitempty"`
+	MatchType *KeywordMatchType `xml:"https://adwords.google.com/api/adwords/cm/v201802 matchType,omitempty" json:"matchType,omitempty"`
 }
 
 type Label struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Label"`
-
 	//
 	// Id of label.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Name of label.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 80, inclusive.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Status of the label.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Status *LabelStatus `xml:"status,omitempty"`
+	Status *LabelStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// Attributes of the label.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	Attribute *LabelAttribute `xml:"attribute,omitempty"`
+	Attribute *LabelAttribute `xml:"https://adwords.google.com/api/adwords/cm/v201802 attribute,omitempty" json:"attribute,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Label.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	LabelType string `xml:"Label.Type,omitempty"`
+	LabelType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Label.Type,omitempty" json:"labelType,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type ManualCpcBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ManualCpcBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -4270,12 +4148,10 @@ type ManualCpcBiddingScheme struct {
 	// >AdWords Help Center</a>.
 	// <span class="constraint Selectable">This field can be selected using the value "EnhancedCpcEnabled".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	EnhancedCpcEnabled bool `xml:"enhancedCpcEnabled,omitempty"`
+	EnhancedCpcEnabled *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 enhancedCpcEnabled,omitempty" json:"enhancedCpcEnabled,omitempty"`
 }
 
 type ManualCpmBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ManualCpmBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -4284,12 +4160,10 @@ type ManualCpmBiddingScheme struct {
 	// selectable in CampaignService, using the value ViewableCpmEnabled.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ViewableCpmEnabled bool `xml:"viewableCpmEnabled,omitempty"`
+	ViewableCpmEnabled *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 viewableCpmEnabled,omitempty" json:"viewableCpmEnabled,omitempty"`
 }
 
 type MaximizeConversionValueBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MaximizeConversionValueBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -4299,18 +4173,14 @@ type MaximizeConversionValueBiddingScheme struct {
 	// bid strategy will aim to achieve the highest possible ROAS for the budget.
 	// <span class="constraint InRange">This field must be between 0.0 and 1.7976931348623157E308, inclusive.</span>
 	//
-	TargetRoas float64 `xml:"targetRoas,omitempty"`
+	TargetRoas *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoas,omitempty" json:"targetRoas,omitempty"`
 }
 
 type MaximizeConversionsBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MaximizeConversionsBiddingScheme"`
-
 	*BiddingScheme
 }
 
 type MobileAppCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileAppCategory"`
-
 	*Criterion
 
 	//
@@ -4319,18 +4189,16 @@ type MobileAppCategory struct {
 	// <span class="constraint Selectable">This field can be selected using the value "MobileAppCategoryId".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MobileAppCategoryId int32 `xml:"mobileAppCategoryId,omitempty"`
+	MobileAppCategoryId *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 mobileAppCategoryId,omitempty" json:"mobileAppCategoryId,omitempty"`
 
 	//
 	// Name of this mobile app category.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type MobileApplication struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileApplication"`
-
 	*Criterion
 
 	//
@@ -4349,171 +4217,145 @@ type MobileApplication struct {
 	// <span class="constraint Selectable">This field can be selected using the value "AppId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// Title of this mobile application.
 	// <span class="constraint Selectable">This field can be selected using the value "DisplayName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type MultiplierError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MultiplierError"`
-
 	*ApiError
 
-	Reason *MultiplierErrorReason `xml:"reason,omitempty"`
+	Reason *MultiplierErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NegativeAdGroupCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NegativeAdGroupCriterion"`
-
 	*AdGroupCriterion
 }
 
 type NewEntityCreationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NewEntityCreationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NewEntityCreationErrorReason `xml:"reason,omitempty"`
+	Reason *NewEntityCreationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type PageOnePromotedBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PageOnePromotedBiddingScheme"`
-
 	*BiddingScheme
 
 	//
 	// Specifies the strategy goal: where impressions are desired to be shown on
 	// search result pages.
 	//
-	StrategyGoal *PageOnePromotedBiddingSchemeStrategyGoal `xml:"strategyGoal,omitempty"`
+	StrategyGoal *PageOnePromotedBiddingSchemeStrategyGoal `xml:"https://adwords.google.com/api/adwords/cm/v201802 strategyGoal,omitempty" json:"strategyGoal,omitempty"`
 
 	//
 	// Strategy maximum bid limit in advertiser local currency micro units.
 	// This upper limit applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// Bid Multiplier to be applied to the relevant bid estimate (depending on the strategyGoal)
 	// in determining a keyword's new max cpc bid.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidModifier float64 `xml:"bidModifier,omitempty"`
+	BidModifier *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidModifier,omitempty" json:"bidModifier,omitempty"`
 
 	//
 	// Controls whether the strategy always follows bid estimate changes, or only
@@ -4521,64 +4363,56 @@ type PageOnePromotedBiddingScheme struct {
 	// If true, only updates a keyword's bid if the current bid estimate is
 	// greater than the current bid.
 	//
-	BidChangesForRaisesOnly bool `xml:"bidChangesForRaisesOnly,omitempty"`
+	BidChangesForRaisesOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidChangesForRaisesOnly,omitempty" json:"bidChangesForRaisesOnly,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids when the throttling rate
 	// of the budget it is serving out of rises above a threshold.
 	//
-	RaiseBidWhenBudgetConstrained bool `xml:"raiseBidWhenBudgetConstrained,omitempty"`
+	RaiseBidWhenBudgetConstrained *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenBudgetConstrained,omitempty" json:"raiseBidWhenBudgetConstrained,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids on keywords with lower-range
 	// quality scores.
 	//
-	RaiseBidWhenLowQualityScore bool `xml:"raiseBidWhenLowQualityScore,omitempty"`
+	RaiseBidWhenLowQualityScore *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenLowQualityScore,omitempty" json:"raiseBidWhenLowQualityScore,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type PagingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PagingError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *PagingErrorReason `xml:"reason,omitempty"`
+	Reason *PagingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Parent struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Parent"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "ParentType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ParentType *ParentParentType `xml:"parentType,omitempty"`
+	ParentType *ParentParentType `xml:"https://adwords.google.com/api/adwords/cm/v201802 parentType,omitempty" json:"parentType,omitempty"`
 }
 
 type Placement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Placement"`
-
 	*Criterion
 
 	//
@@ -4588,68 +4422,62 @@ type Placement struct {
 	// <span class="constraint Selectable">This field can be selected using the value "PlacementUrl".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 }
 
 type PolicyViolationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationError"`
-
 	*ApiError
 
 	//
 	// Unique identifier for the violation.
 	//
-	Key *PolicyViolationKey `xml:"key,omitempty"`
+	Key *PolicyViolationKey `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// Name of policy suitable for display to users. In the user's preferred
 	// language.
 	//
-	ExternalPolicyName string `xml:"externalPolicyName,omitempty"`
+	ExternalPolicyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyName,omitempty" json:"externalPolicyName,omitempty"`
 
 	//
 	// Url with writeup about the policy.
 	//
-	ExternalPolicyUrl string `xml:"externalPolicyUrl,omitempty"`
+	ExternalPolicyUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyUrl,omitempty" json:"externalPolicyUrl,omitempty"`
 
 	//
 	// Localized description of the violation.
 	//
-	ExternalPolicyDescription string `xml:"externalPolicyDescription,omitempty"`
+	ExternalPolicyDescription *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyDescription,omitempty" json:"externalPolicyDescription,omitempty"`
 
 	//
 	// Whether user can file an exemption request for this violation.
 	//
-	IsExemptable bool `xml:"isExemptable,omitempty"`
+	IsExemptable *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isExemptable,omitempty" json:"isExemptable,omitempty"`
 
 	//
 	// Lists the parts that violate the policy.
 	//
-	ViolatingParts []*PolicyViolationErrorPart `xml:"violatingParts,omitempty"`
+	ViolatingParts []*PolicyViolationErrorPart `xml:"https://adwords.google.com/api/adwords/cm/v201802 violatingParts,omitempty" json:"violatingParts,omitempty"`
 }
 
 type PolicyViolationErrorPart struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationError.Part"`
-
 	//
 	// Index of the starting position of the violating text within the line.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 
 	//
 	// The length of the violating text.
 	//
-	Length int32 `xml:"length,omitempty"`
+	Length *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 length,omitempty" json:"length,omitempty"`
 }
 
 type PolicyViolationKey struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationKey"`
-
 	//
 	// Unique id of the violated policy.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	PolicyName string `xml:"policyName,omitempty"`
+	PolicyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyName,omitempty" json:"policyName,omitempty"`
 
 	//
 	// The text that violates the policy if specified. Otherwise, refers to the
@@ -4659,12 +4487,10 @@ type PolicyViolationKey struct {
 	// May be null for criterion exemptions, in which case this refers to the
 	// whole policy. Must be specified for ad exemptions.
 	//
-	ViolatingText string `xml:"violatingText,omitempty"`
+	ViolatingText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 violatingText,omitempty" json:"violatingText,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -4672,13 +4498,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -4687,34 +4513,28 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type ProductAdwordsGrouping struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductAdwordsGrouping"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductAdwordsLabels struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductAdwordsLabels"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductBiddingCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductBiddingCategory"`
-
 	*ProductDimension
 
 	//
@@ -4723,61 +4543,49 @@ type ProductBiddingCategory struct {
 	// <span class="constraint OneOf">The value must be one of {BIDDING_CATEGORY_L1, BIDDING_CATEGORY_L2, BIDDING_CATEGORY_L3, BIDDING_CATEGORY_L4, BIDDING_CATEGORY_L5}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// ID of the product category.
 	// <span class="constraint Filterable">This field can be filtered on using the value "ParentDimensionId".</span>
 	//
-	Value int64 `xml:"value,omitempty"`
+	Value *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductBrand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductBrand"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductCanonicalCondition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductCanonicalCondition"`
-
 	*ProductDimension
 
-	Condition *ProductCanonicalConditionCondition `xml:"condition,omitempty"`
+	Condition *ProductCanonicalConditionCondition `xml:"https://adwords.google.com/api/adwords/cm/v201802 condition,omitempty" json:"condition,omitempty"`
 }
 
 type ProductChannel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductChannel"`
-
 	*ProductDimension
 
-	Channel *ShoppingProductChannel `xml:"channel,omitempty"`
+	Channel *ShoppingProductChannel `xml:"https://adwords.google.com/api/adwords/cm/v201802 channel,omitempty" json:"channel,omitempty"`
 }
 
 type ProductChannelExclusivity struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductChannelExclusivity"`
-
 	*ProductDimension
 
-	ChannelExclusivity *ShoppingProductChannelExclusivity `xml:"channelExclusivity,omitempty"`
+	ChannelExclusivity *ShoppingProductChannelExclusivity `xml:"https://adwords.google.com/api/adwords/cm/v201802 channelExclusivity,omitempty" json:"channelExclusivity,omitempty"`
 }
 
 type ProductLegacyCondition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductLegacyCondition"`
-
 	*ProductDimension
 
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductCustomAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductCustomAttribute"`
-
 	*ProductDimension
 
 	//
@@ -4785,39 +4593,33 @@ type ProductCustomAttribute struct {
 	// <span class="constraint OneOf">The value must be one of {CUSTOM_ATTRIBUTE_0, CUSTOM_ATTRIBUTE_1, CUSTOM_ATTRIBUTE_2, CUSTOM_ATTRIBUTE_3, CUSTOM_ATTRIBUTE_4}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductDimension struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductDimension"`
-
 	//
 	// Indicates that this instance is a subtype of ProductDimension.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ProductDimensionType string `xml:"ProductDimension.Type,omitempty"`
+	ProductDimensionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductDimension.Type,omitempty" json:"productDimensionType,omitempty"`
 }
 
 type ProductOfferId struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductOfferId"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductPartition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductPartition"`
-
 	*Criterion
 
 	//
@@ -4825,25 +4627,23 @@ type ProductPartition struct {
 	// <span class="constraint Selectable">This field can be selected using the value "PartitionType".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	PartitionType *ProductPartitionType `xml:"partitionType,omitempty"`
+	PartitionType *ProductPartitionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 partitionType,omitempty" json:"partitionType,omitempty"`
 
 	//
 	// ID of the parent product partition subdivision. Undefined for the root partition.
 	// <span class="constraint Selectable">This field can be selected using the value "ParentCriterionId".</span>
 	//
-	ParentCriterionId int64 `xml:"parentCriterionId,omitempty"`
+	ParentCriterionId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 parentCriterionId,omitempty" json:"parentCriterionId,omitempty"`
 
 	//
 	// Dimension value with which this product partition is refining its parent. Undefined for the
 	// root partition.
 	// <span class="constraint Selectable">This field can be selected using the value "CaseValue".</span>
 	//
-	CaseValue *ProductDimension `xml:"caseValue,omitempty"`
+	CaseValue *ProductDimension `xml:"https://adwords.google.com/api/adwords/cm/v201802 caseValue,omitempty" json:"caseValue,omitempty"`
 }
 
 type ProductType struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductType"`
-
 	*ProductDimension
 
 	//
@@ -4851,28 +4651,24 @@ type ProductType struct {
 	// <span class="constraint OneOf">The value must be one of {PRODUCT_TYPE_L1, PRODUCT_TYPE_L2, PRODUCT_TYPE_L3, PRODUCT_TYPE_L4, PRODUCT_TYPE_L5}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductTypeFull struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductTypeFull"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type QualityInfo struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QualityInfo"`
-
 	//
 	// The keyword quality score ranges from 1 (lowest) to 10 (highest).
 	// <p>If there aren't enough impressions or clicks to determine an appropriate
@@ -4880,108 +4676,90 @@ type QualityInfo struct {
 	// this field will return null (designated by "--").
 	// <span class="constraint Selectable">This field can be selected using the value "QualityScore".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	QualityScore int32 `xml:"qualityScore,omitempty"`
+	QualityScore *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 qualityScore,omitempty" json:"qualityScore,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -4992,13 +4770,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -5007,58 +4785,54 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -5066,12 +4840,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -5079,105 +4853,93 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StatsQueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StatsQueryError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StatsQueryErrorReason `xml:"reason,omitempty"`
+	Reason *StatsQueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type String_StringMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 String_StringMapEntry"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
-	Key string `xml:"key,omitempty"`
-
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type TargetCpaBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetCpaBiddingScheme"`
-
 	*BiddingScheme
 
 	//
 	// Average cost per acquisition (CPA) target. This target should be greater than or equal to
 	// minimum billable unit based on the currency for the account.
 	//
-	TargetCpa *Money `xml:"targetCpa,omitempty"`
+	TargetCpa *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetCpa,omitempty" json:"targetCpa,omitempty"`
 
 	//
 	// Maximum cpc bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	MaxCpcBidCeiling *Money `xml:"maxCpcBidCeiling,omitempty"`
+	MaxCpcBidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidCeiling,omitempty" json:"maxCpcBidCeiling,omitempty"`
 
 	//
 	// Minimum cpc bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	MaxCpcBidFloor *Money `xml:"maxCpcBidFloor,omitempty"`
+	MaxCpcBidFloor *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidFloor,omitempty" json:"maxCpcBidFloor,omitempty"`
 }
 
 type TargetOutrankShareBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetOutrankShareBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -5187,66 +4949,62 @@ type TargetOutrankShareBiddingScheme struct {
 	// competitor does not.
 	// <span class="constraint InRange">This field must be between 1 and 1000000, inclusive.</span>
 	//
-	TargetOutrankShare int32 `xml:"targetOutrankShare,omitempty"`
+	TargetOutrankShare *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetOutrankShare,omitempty" json:"targetOutrankShare,omitempty"`
 
 	//
 	// Competitor's visible domain URL.
 	//
-	CompetitorDomain string `xml:"competitorDomain,omitempty"`
+	CompetitorDomain *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 competitorDomain,omitempty" json:"competitorDomain,omitempty"`
 
 	//
 	// Ceiling on max CPC bids.
 	//
-	MaxCpcBidCeiling *Money `xml:"maxCpcBidCeiling,omitempty"`
+	MaxCpcBidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidCeiling,omitempty" json:"maxCpcBidCeiling,omitempty"`
 
 	//
 	// Controls whether the strategy always follows bid estimate changes, or only increases. If false,
 	// always sets a keyword's new bid to the estimate that will meet the target. If true, only
 	// updates a keyword's bid if the current bid estimate is greater than the current bid.
 	//
-	BidChangesForRaisesOnly bool `xml:"bidChangesForRaisesOnly,omitempty"`
+	BidChangesForRaisesOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidChangesForRaisesOnly,omitempty" json:"bidChangesForRaisesOnly,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids on keywords with lower-range quality
 	// scores.
 	//
-	RaiseBidWhenLowQualityScore bool `xml:"raiseBidWhenLowQualityScore,omitempty"`
+	RaiseBidWhenLowQualityScore *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenLowQualityScore,omitempty" json:"raiseBidWhenLowQualityScore,omitempty"`
 }
 
 type TargetRoasBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetRoasBiddingScheme"`
-
 	*BiddingScheme
 
 	//
 	// The target return on average spend (ROAS).
 	// <span class="constraint InRange">This field must be between 0.01 and 1000.0, inclusive.</span>
 	//
-	TargetRoas float64 `xml:"targetRoas,omitempty"`
+	TargetRoas *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoas,omitempty" json:"targetRoas,omitempty"`
 
 	//
 	// Maximum bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// Minimum bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidFloor *Money `xml:"bidFloor,omitempty"`
+	BidFloor *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidFloor,omitempty" json:"bidFloor,omitempty"`
 }
 
 type TargetSpendBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetSpendBiddingScheme"`
-
 	*BiddingScheme
 
 	//
 	// The largest max CPC bid that can be set by the TargetSpend bidder.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// A spend target under which to maximize clicks. The TargetSpend bidder will
@@ -5254,82 +5012,72 @@ type TargetSpendBiddingScheme struct {
 	// amount. If not specified, the budget is used as the spend target.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	SpendTarget *Money `xml:"spendTarget,omitempty"`
+	SpendTarget *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 spendTarget,omitempty" json:"spendTarget,omitempty"`
 }
 
 type UnknownProductDimension struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UnknownProductDimension"`
-
 	*ProductDimension
 }
 
 type UrlError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *UrlErrorReason `xml:"reason,omitempty"`
+	Reason *UrlErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type UrlList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlList"`
-
 	//
 	// List of URLs.  On SET operation, empty list indicates to clear the list.
 	// <span class="constraint CollectionSize">The maximum size of this collection is 10.</span>
 	// <span class="constraint ContentsStringLength">Strings in this field must be non-empty (trimmed).</span>
 	//
-	Urls []string `xml:"urls,omitempty"`
+	Urls []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 urls,omitempty" json:"urls,omitempty"`
 }
 
 type CriterionUserInterest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserInterest"`
-
 	*Criterion
 
 	//
 	// Id of this user interest. This is a required field.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestId".</span>
 	//
-	UserInterestId int64 `xml:"userInterestId,omitempty"`
+	UserInterestId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestId,omitempty" json:"userInterestId,omitempty"`
 
 	//
 	// Parent Id of this user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestParentId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserInterestParentId int64 `xml:"userInterestParentId,omitempty"`
+	UserInterestParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestParentId,omitempty" json:"userInterestParentId,omitempty"`
 
 	//
 	// Name of this user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestName".</span>
 	//
-	UserInterestName string `xml:"userInterestName,omitempty"`
+	UserInterestName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestName,omitempty" json:"userInterestName,omitempty"`
 }
 
 type CriterionUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserList"`
-
 	*Criterion
 
 	//
 	// Id of this user list. This is a required field.
 	// <span class="constraint Selectable">This field can be selected using the value "UserListId".</span>
 	//
-	UserListId int64 `xml:"userListId,omitempty"`
+	UserListId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListId,omitempty" json:"userListId,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "UserListName".</span>
 	//
-	UserListName string `xml:"userListName,omitempty"`
+	UserListName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListName,omitempty" json:"userListName,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "UserListMembershipStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"userListMembershipStatus,omitempty"`
+	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListMembershipStatus,omitempty" json:"userListMembershipStatus,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the google.com
@@ -5337,33 +5085,31 @@ type CriterionUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "UserListEligibleForSearch".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForSearch bool `xml:"userListEligibleForSearch,omitempty"`
+	UserListEligibleForSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForSearch,omitempty" json:"userListEligibleForSearch,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the display network.
 	// <span class="constraint Selectable">This field can be selected using the value "UserListEligibleForDisplay".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForDisplay bool `xml:"userListEligibleForDisplay,omitempty"`
+	UserListEligibleForDisplay *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForDisplay,omitempty" json:"userListEligibleForDisplay,omitempty"`
 }
 
 type Vertical struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Vertical"`
-
 	*Criterion
 
 	//
 	// Id of this vertical.
 	// <span class="constraint Selectable">This field can be selected using the value "VerticalId".</span>
 	//
-	VerticalId int64 `xml:"verticalId,omitempty"`
+	VerticalId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalId,omitempty" json:"verticalId,omitempty"`
 
 	//
 	// Id of the parent of this vertical.
 	// <span class="constraint Selectable">This field can be selected using the value "VerticalParentId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	VerticalParentId int64 `xml:"verticalParentId,omitempty"`
+	VerticalParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalParentId,omitempty" json:"verticalParentId,omitempty"`
 
 	//
 	// The category to target or exclude. Each subsequent element in the array
@@ -5374,12 +5120,10 @@ type Vertical struct {
 	// This field is required and must not be empty.
 	// <span class="constraint Selectable">This field can be selected using the value "Path".</span>
 	//
-	Path []string `xml:"path,omitempty"`
+	Path []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 path,omitempty" json:"path,omitempty"`
 }
 
 type Webpage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Webpage"`
-
 	*Criterion
 
 	//
@@ -5387,7 +5131,7 @@ type Webpage struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Parameter".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Parameter *WebpageParameter `xml:"parameter,omitempty"`
+	Parameter *WebpageParameter `xml:"https://adwords.google.com/api/adwords/cm/v201802 parameter,omitempty" json:"parameter,omitempty"`
 
 	//
 	// Keywordless criteria coverage - Computed percentage of website coverage based on the
@@ -5395,24 +5139,22 @@ type Webpage struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CriteriaCoverage".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CriteriaCoverage float64 `xml:"criteriaCoverage,omitempty"`
+	CriteriaCoverage *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 criteriaCoverage,omitempty" json:"criteriaCoverage,omitempty"`
 
 	//
 	// Keywordless criteria samples - List of sample urls that matches with the website target.
 	// <span class="constraint Selectable">This field can be selected using the value "CriteriaSamples".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CriteriaSamples []string `xml:"criteriaSamples,omitempty"`
+	CriteriaSamples []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 criteriaSamples,omitempty" json:"criteriaSamples,omitempty"`
 }
 
 type WebpageCondition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 WebpageCondition"`
-
 	//
 	// Operand of webpage targeting condition.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *WebpageConditionOperand `xml:"operand,omitempty"`
+	Operand *WebpageConditionOperand `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 
 	//
 	// Argument of the webpage targeting condition.
@@ -5420,12 +5162,10 @@ type WebpageCondition struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 2048, inclusive.</span>
 	//
-	Argument string `xml:"argument,omitempty"`
+	Argument *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 argument,omitempty" json:"argument,omitempty"`
 }
 
 type WebpageParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 WebpageParameter"`
-
 	*CriterionParameter
 
 	//
@@ -5438,7 +5178,7 @@ type WebpageParameter struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 2048, inclusive.</span>
 	//
-	CriterionName string `xml:"criterionName,omitempty"`
+	CriterionName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterionName,omitempty" json:"criterionName,omitempty"`
 
 	//
 	// Conditions, or logical expressions, for webpage targeting.
@@ -5449,12 +5189,10 @@ type WebpageParameter struct {
 	// <span class="constraint CollectionSize">The maximum size of this collection is 3.</span>
 	// <span class="constraint ContentsDistinct">This field must contain distinct elements.</span>
 	//
-	Conditions []*WebpageCondition `xml:"conditions,omitempty"`
+	Conditions []*WebpageCondition `xml:"https://adwords.google.com/api/adwords/cm/v201802 conditions,omitempty" json:"conditions,omitempty"`
 }
 
 type YouTubeChannel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 YouTubeChannel"`
-
 	*Criterion
 
 	//
@@ -5465,33 +5203,31 @@ type YouTubeChannel struct {
 	// <p>For more information see: https://support.google.com/youtube/answer/6180214
 	// <span class="constraint Selectable">This field can be selected using the value "ChannelId".</span>
 	//
-	ChannelId string `xml:"channelId,omitempty"`
+	ChannelId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 channelId,omitempty" json:"channelId,omitempty"`
 
 	//
 	// The public name for a YouTube user channel.
 	// <span class="constraint Selectable">This field can be selected using the value "ChannelName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ChannelName string `xml:"channelName,omitempty"`
+	ChannelName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 channelName,omitempty" json:"channelName,omitempty"`
 }
 
 type YouTubeVideo struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 YouTubeVideo"`
-
 	*Criterion
 
 	//
 	// YouTube video id as it appears on the YouTube watch page.
 	// <span class="constraint Selectable">This field can be selected using the value "VideoId".</span>
 	//
-	VideoId string `xml:"videoId,omitempty"`
+	VideoId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 videoId,omitempty" json:"videoId,omitempty"`
 
 	//
 	// Name of the video.
 	// <span class="constraint Selectable">This field can be selected using the value "VideoName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	VideoName string `xml:"videoName,omitempty"`
+	VideoName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 videoName,omitempty" json:"videoName,omitempty"`
 }
 
 type AdGroupCriterionServiceInterface struct {
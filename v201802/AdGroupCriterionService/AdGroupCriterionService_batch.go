@@ -0,0 +1,96 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupCriterionService
+
+import "github.com/godofdream/go-googleadsinofficial/batch"
+
+// MutateBatch is Mutate for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// Partial failure errors are re-indexed to the positions in operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateBatch(api AdGroupCriterionServiceAPI, operations []*AdGroupCriterionOperation, opts *batch.Options) (*AdGroupCriterionReturnValue, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*AdGroupCriterionOperation) (*MutateResponse, error) {
+		return api.Mutate(&Mutate{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Value)
+	})
+	rval := new(AdGroupCriterionReturnValue)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Value = append(rval.Value, make([]*AdGroupCriterion, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		if rval.ListReturnValue == nil {
+			rval.ListReturnValue = r.ListReturnValue
+		}
+		rval.Value = append(rval.Value, batch.Pad(r.Value, c.End-c.Start)...)
+		for _, e := range r.PartialFailureErrors {
+			reindexError(e, c.Start)
+		}
+		rval.PartialFailureErrors = append(rval.PartialFailureErrors, r.PartialFailureErrors...)
+	}
+	return rval, err
+}
+
+// MutateLabelBatch is MutateLabel for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// Partial failure errors are re-indexed to the positions in operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateLabelBatch(api AdGroupCriterionServiceAPI, operations []*AdGroupCriterionLabelOperation, opts *batch.Options) (*AdGroupCriterionLabelReturnValue, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*AdGroupCriterionLabelOperation) (*MutateLabelResponse, error) {
+		return api.MutateLabel(&MutateLabel{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateLabelResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Value)
+	})
+	rval := new(AdGroupCriterionLabelReturnValue)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Value = append(rval.Value, make([]*AdGroupCriterionLabel, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		if rval.ListReturnValue == nil {
+			rval.ListReturnValue = r.ListReturnValue
+		}
+		rval.Value = append(rval.Value, batch.Pad(r.Value, c.End-c.Start)...)
+		for _, e := range r.PartialFailureErrors {
+			reindexError(e, c.Start)
+		}
+		rval.PartialFailureErrors = append(rval.PartialFailureErrors, r.PartialFailureErrors...)
+	}
+	return rval, err
+}
+
+// reindexError shifts the operation index of the field path of e by offset.
+func reindexError(e *ApiError, offset int) {
+	if e == nil {
+		return
+	}
+	if e.FieldPath != nil {
+		path := batch.Reindex(*e.FieldPath, offset)
+		e.FieldPath = &path
+	}
+	if len(e.FieldPathElements) > 0 {
+		if first := e.FieldPathElements[0]; first != nil && StringValue(first.Field) == "operations" && first.Index != nil {
+			i := *first.Index + int32(offset)
+			first.Index = &i
+		}
+	}
+}
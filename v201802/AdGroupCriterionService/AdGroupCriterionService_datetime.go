@@ -0,0 +1,25 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupCriterionService
+
+import "github.com/godofdream/go-googleadsinofficial/datetime"
+
+// NewDateRange returns the DateRange from one date to another, both
+// inclusive.
+func NewDateRange(from, to datetime.Date) *DateRange {
+	return &DateRange{Min: String(from.String()), Max: String(to.String())}
+}
+
+// Dates returns the bounds of r. A bound that is not set is returned as the
+// zero Date.
+func (r *DateRange) Dates() (from, to datetime.Date, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDate(*r.Min); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDate(*r.Max)
+	}
+	return
+}
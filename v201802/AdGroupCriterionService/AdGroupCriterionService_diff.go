@@ -0,0 +1,1836 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupCriterionService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateLabel) Copy() *MutateLabel {
+	return deep.Copy(t).(*MutateLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateLabel) Equal(o *MutateLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateLabelResponse) Copy() *MutateLabelResponse {
+	return deep.Copy(t).(*MutateLabelResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateLabelResponse) Equal(o *MutateLabelResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterion) Copy() *AdGroupCriterion {
+	return deep.Copy(t).(*AdGroupCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterion) Equal(o *AdGroupCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterionError) Copy() *AdGroupCriterionError {
+	return deep.Copy(t).(*AdGroupCriterionError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterionError) Equal(o *AdGroupCriterionError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterionLabel) Copy() *AdGroupCriterionLabel {
+	return deep.Copy(t).(*AdGroupCriterionLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterionLabel) Equal(o *AdGroupCriterionLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterionLabelOperation) Copy() *AdGroupCriterionLabelOperation {
+	return deep.Copy(t).(*AdGroupCriterionLabelOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterionLabelOperation) Equal(o *AdGroupCriterionLabelOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterionLabelReturnValue) Copy() *AdGroupCriterionLabelReturnValue {
+	return deep.Copy(t).(*AdGroupCriterionLabelReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterionLabelReturnValue) Equal(o *AdGroupCriterionLabelReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterionLimitExceeded) Copy() *AdGroupCriterionLimitExceeded {
+	return deep.Copy(t).(*AdGroupCriterionLimitExceeded)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterionLimitExceeded) Equal(o *AdGroupCriterionLimitExceeded) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterionOperation) Copy() *AdGroupCriterionOperation {
+	return deep.Copy(t).(*AdGroupCriterionOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterionOperation) Equal(o *AdGroupCriterionOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterionPage) Copy() *AdGroupCriterionPage {
+	return deep.Copy(t).(*AdGroupCriterionPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterionPage) Equal(o *AdGroupCriterionPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupCriterionReturnValue) Copy() *AdGroupCriterionReturnValue {
+	return deep.Copy(t).(*AdGroupCriterionReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupCriterionReturnValue) Equal(o *AdGroupCriterionReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdxError) Copy() *AdxError {
+	return deep.Copy(t).(*AdxError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdxError) Equal(o *AdxError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AgeRange) Copy() *AgeRange {
+	return deep.Copy(t).(*AgeRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AgeRange) Equal(o *AgeRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AppPaymentModel) Copy() *AppPaymentModel {
+	return deep.Copy(t).(*AppPaymentModel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AppPaymentModel) Equal(o *AppPaymentModel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AppUrl) Copy() *AppUrl {
+	return deep.Copy(t).(*AppUrl)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AppUrl) Equal(o *AppUrl) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AppUrlList) Copy() *AppUrlList {
+	return deep.Copy(t).(*AppUrlList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AppUrlList) Equal(o *AppUrlList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LabelAttribute) Copy() *LabelAttribute {
+	return deep.Copy(t).(*LabelAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LabelAttribute) Equal(o *LabelAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Bid) Copy() *Bid {
+	return deep.Copy(t).(*Bid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Bid) Equal(o *Bid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddableAdGroupCriterion) Copy() *BiddableAdGroupCriterion {
+	return deep.Copy(t).(*BiddableAdGroupCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddableAdGroupCriterion) Equal(o *BiddableAdGroupCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingErrors) Copy() *BiddingErrors {
+	return deep.Copy(t).(*BiddingErrors)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingErrors) Equal(o *BiddingErrors) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingScheme) Copy() *BiddingScheme {
+	return deep.Copy(t).(*BiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingScheme) Equal(o *BiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingStrategyConfiguration) Copy() *BiddingStrategyConfiguration {
+	return deep.Copy(t).(*BiddingStrategyConfiguration)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingStrategyConfiguration) Equal(o *BiddingStrategyConfiguration) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Bids) Copy() *Bids {
+	return deep.Copy(t).(*Bids)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Bids) Equal(o *Bids) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TextLabel) Copy() *TextLabel {
+	return deep.Copy(t).(*TextLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TextLabel) Equal(o *TextLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DisplayAttribute) Copy() *DisplayAttribute {
+	return deep.Copy(t).(*DisplayAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DisplayAttribute) Equal(o *DisplayAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CollectionSizeError) Copy() *CollectionSizeError {
+	return deep.Copy(t).(*CollectionSizeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CollectionSizeError) Equal(o *CollectionSizeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ComparableValue) Copy() *ComparableValue {
+	return deep.Copy(t).(*ComparableValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ComparableValue) Equal(o *ComparableValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpaBid) Copy() *CpaBid {
+	return deep.Copy(t).(*CpaBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpaBid) Equal(o *CpaBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpcBid) Copy() *CpcBid {
+	return deep.Copy(t).(*CpcBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpcBid) Equal(o *CpcBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpmBid) Copy() *CpmBid {
+	return deep.Copy(t).(*CpmBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpmBid) Equal(o *CpmBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Criterion) Copy() *Criterion {
+	return deep.Copy(t).(*Criterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Criterion) Equal(o *Criterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionError) Copy() *CriterionError {
+	return deep.Copy(t).(*CriterionError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionError) Equal(o *CriterionError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionParameter) Copy() *CriterionParameter {
+	return deep.Copy(t).(*CriterionParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionParameter) Equal(o *CriterionParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionPolicyError) Copy() *CriterionPolicyError {
+	return deep.Copy(t).(*CriterionPolicyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionPolicyError) Equal(o *CriterionPolicyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameter) Copy() *CustomParameter {
+	return deep.Copy(t).(*CustomParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameter) Equal(o *CustomParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameters) Copy() *CustomParameters {
+	return deep.Copy(t).(*CustomParameters)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameters) Equal(o *CustomParameters) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DoubleValue) Copy() *DoubleValue {
+	return deep.Copy(t).(*DoubleValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DoubleValue) Equal(o *DoubleValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EnhancedCpcBiddingScheme) Copy() *EnhancedCpcBiddingScheme {
+	return deep.Copy(t).(*EnhancedCpcBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EnhancedCpcBiddingScheme) Equal(o *EnhancedCpcBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityAccessDenied) Copy() *EntityAccessDenied {
+	return deep.Copy(t).(*EntityAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityAccessDenied) Equal(o *EntityAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityCountLimitExceeded) Copy() *EntityCountLimitExceeded {
+	return deep.Copy(t).(*EntityCountLimitExceeded)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityCountLimitExceeded) Equal(o *EntityCountLimitExceeded) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityNotFound) Copy() *EntityNotFound {
+	return deep.Copy(t).(*EntityNotFound)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityNotFound) Equal(o *EntityNotFound) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExemptionRequest) Copy() *ExemptionRequest {
+	return deep.Copy(t).(*ExemptionRequest)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExemptionRequest) Equal(o *ExemptionRequest) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ForwardCompatibilityError) Copy() *ForwardCompatibilityError {
+	return deep.Copy(t).(*ForwardCompatibilityError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ForwardCompatibilityError) Equal(o *ForwardCompatibilityError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Gender) Copy() *Gender {
+	return deep.Copy(t).(*Gender)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Gender) Equal(o *Gender) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IncomeRange) Copy() *IncomeRange {
+	return deep.Copy(t).(*IncomeRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IncomeRange) Equal(o *IncomeRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Keyword) Copy() *Keyword {
+	return deep.Copy(t).(*Keyword)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Keyword) Equal(o *Keyword) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Label) Copy() *Label {
+	return deep.Copy(t).(*Label)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Label) Equal(o *Label) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LongValue) Copy() *LongValue {
+	return deep.Copy(t).(*LongValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LongValue) Equal(o *LongValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ManualCpcBiddingScheme) Copy() *ManualCpcBiddingScheme {
+	return deep.Copy(t).(*ManualCpcBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ManualCpcBiddingScheme) Equal(o *ManualCpcBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ManualCpmBiddingScheme) Copy() *ManualCpmBiddingScheme {
+	return deep.Copy(t).(*ManualCpmBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ManualCpmBiddingScheme) Equal(o *ManualCpmBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MaximizeConversionValueBiddingScheme) Copy() *MaximizeConversionValueBiddingScheme {
+	return deep.Copy(t).(*MaximizeConversionValueBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MaximizeConversionValueBiddingScheme) Equal(o *MaximizeConversionValueBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MaximizeConversionsBiddingScheme) Copy() *MaximizeConversionsBiddingScheme {
+	return deep.Copy(t).(*MaximizeConversionsBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MaximizeConversionsBiddingScheme) Equal(o *MaximizeConversionsBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileAppCategory) Copy() *MobileAppCategory {
+	return deep.Copy(t).(*MobileAppCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileAppCategory) Equal(o *MobileAppCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileApplication) Copy() *MobileApplication {
+	return deep.Copy(t).(*MobileApplication)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileApplication) Equal(o *MobileApplication) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Money) Copy() *Money {
+	return deep.Copy(t).(*Money)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Money) Equal(o *Money) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MultiplierError) Copy() *MultiplierError {
+	return deep.Copy(t).(*MultiplierError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MultiplierError) Equal(o *MultiplierError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NegativeAdGroupCriterion) Copy() *NegativeAdGroupCriterion {
+	return deep.Copy(t).(*NegativeAdGroupCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NegativeAdGroupCriterion) Equal(o *NegativeAdGroupCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NewEntityCreationError) Copy() *NewEntityCreationError {
+	return deep.Copy(t).(*NewEntityCreationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NewEntityCreationError) Equal(o *NewEntityCreationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NumberValue) Copy() *NumberValue {
+	return deep.Copy(t).(*NumberValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NumberValue) Equal(o *NumberValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PageOnePromotedBiddingScheme) Copy() *PageOnePromotedBiddingScheme {
+	return deep.Copy(t).(*PageOnePromotedBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PageOnePromotedBiddingScheme) Equal(o *PageOnePromotedBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PagingError) Copy() *PagingError {
+	return deep.Copy(t).(*PagingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PagingError) Equal(o *PagingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Parent) Copy() *Parent {
+	return deep.Copy(t).(*Parent)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Parent) Equal(o *Parent) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Placement) Copy() *Placement {
+	return deep.Copy(t).(*Placement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Placement) Equal(o *Placement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyViolationError) Copy() *PolicyViolationError {
+	return deep.Copy(t).(*PolicyViolationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyViolationError) Equal(o *PolicyViolationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyViolationErrorPart) Copy() *PolicyViolationErrorPart {
+	return deep.Copy(t).(*PolicyViolationErrorPart)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyViolationErrorPart) Equal(o *PolicyViolationErrorPart) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyViolationKey) Copy() *PolicyViolationKey {
+	return deep.Copy(t).(*PolicyViolationKey)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyViolationKey) Equal(o *PolicyViolationKey) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductAdwordsGrouping) Copy() *ProductAdwordsGrouping {
+	return deep.Copy(t).(*ProductAdwordsGrouping)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductAdwordsGrouping) Equal(o *ProductAdwordsGrouping) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductAdwordsLabels) Copy() *ProductAdwordsLabels {
+	return deep.Copy(t).(*ProductAdwordsLabels)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductAdwordsLabels) Equal(o *ProductAdwordsLabels) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductBiddingCategory) Copy() *ProductBiddingCategory {
+	return deep.Copy(t).(*ProductBiddingCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductBiddingCategory) Equal(o *ProductBiddingCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductBrand) Copy() *ProductBrand {
+	return deep.Copy(t).(*ProductBrand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductBrand) Equal(o *ProductBrand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductCanonicalCondition) Copy() *ProductCanonicalCondition {
+	return deep.Copy(t).(*ProductCanonicalCondition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductCanonicalCondition) Equal(o *ProductCanonicalCondition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductChannel) Copy() *ProductChannel {
+	return deep.Copy(t).(*ProductChannel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductChannel) Equal(o *ProductChannel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductChannelExclusivity) Copy() *ProductChannelExclusivity {
+	return deep.Copy(t).(*ProductChannelExclusivity)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductChannelExclusivity) Equal(o *ProductChannelExclusivity) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductLegacyCondition) Copy() *ProductLegacyCondition {
+	return deep.Copy(t).(*ProductLegacyCondition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductLegacyCondition) Equal(o *ProductLegacyCondition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductCustomAttribute) Copy() *ProductCustomAttribute {
+	return deep.Copy(t).(*ProductCustomAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductCustomAttribute) Equal(o *ProductCustomAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductDimension) Copy() *ProductDimension {
+	return deep.Copy(t).(*ProductDimension)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductDimension) Equal(o *ProductDimension) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductOfferId) Copy() *ProductOfferId {
+	return deep.Copy(t).(*ProductOfferId)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductOfferId) Equal(o *ProductOfferId) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductPartition) Copy() *ProductPartition {
+	return deep.Copy(t).(*ProductPartition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductPartition) Equal(o *ProductPartition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductType) Copy() *ProductType {
+	return deep.Copy(t).(*ProductType)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductType) Equal(o *ProductType) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductTypeFull) Copy() *ProductTypeFull {
+	return deep.Copy(t).(*ProductTypeFull)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductTypeFull) Equal(o *ProductTypeFull) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QualityInfo) Copy() *QualityInfo {
+	return deep.Copy(t).(*QualityInfo)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QualityInfo) Equal(o *QualityInfo) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StatsQueryError) Copy() *StatsQueryError {
+	return deep.Copy(t).(*StatsQueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StatsQueryError) Equal(o *StatsQueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *String_StringMapEntry) Copy() *String_StringMapEntry {
+	return deep.Copy(t).(*String_StringMapEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *String_StringMapEntry) Equal(o *String_StringMapEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetCpaBiddingScheme) Copy() *TargetCpaBiddingScheme {
+	return deep.Copy(t).(*TargetCpaBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetCpaBiddingScheme) Equal(o *TargetCpaBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetOutrankShareBiddingScheme) Copy() *TargetOutrankShareBiddingScheme {
+	return deep.Copy(t).(*TargetOutrankShareBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetOutrankShareBiddingScheme) Equal(o *TargetOutrankShareBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetRoasBiddingScheme) Copy() *TargetRoasBiddingScheme {
+	return deep.Copy(t).(*TargetRoasBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetRoasBiddingScheme) Equal(o *TargetRoasBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetSpendBiddingScheme) Copy() *TargetSpendBiddingScheme {
+	return deep.Copy(t).(*TargetSpendBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetSpendBiddingScheme) Equal(o *TargetSpendBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UnknownProductDimension) Copy() *UnknownProductDimension {
+	return deep.Copy(t).(*UnknownProductDimension)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UnknownProductDimension) Equal(o *UnknownProductDimension) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlError) Copy() *UrlError {
+	return deep.Copy(t).(*UrlError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlError) Equal(o *UrlError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlList) Copy() *UrlList {
+	return deep.Copy(t).(*UrlList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlList) Equal(o *UrlList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionUserInterest) Copy() *CriterionUserInterest {
+	return deep.Copy(t).(*CriterionUserInterest)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionUserInterest) Equal(o *CriterionUserInterest) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionUserList) Copy() *CriterionUserList {
+	return deep.Copy(t).(*CriterionUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionUserList) Equal(o *CriterionUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Vertical) Copy() *Vertical {
+	return deep.Copy(t).(*Vertical)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Vertical) Equal(o *Vertical) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Webpage) Copy() *Webpage {
+	return deep.Copy(t).(*Webpage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Webpage) Equal(o *Webpage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *WebpageCondition) Copy() *WebpageCondition {
+	return deep.Copy(t).(*WebpageCondition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *WebpageCondition) Equal(o *WebpageCondition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *WebpageParameter) Copy() *WebpageParameter {
+	return deep.Copy(t).(*WebpageParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *WebpageParameter) Equal(o *WebpageParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *YouTubeChannel) Copy() *YouTubeChannel {
+	return deep.Copy(t).(*YouTubeChannel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *YouTubeChannel) Equal(o *YouTubeChannel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *YouTubeVideo) Copy() *YouTubeVideo {
+	return deep.Copy(t).(*YouTubeVideo)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *YouTubeVideo) Equal(o *YouTubeVideo) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffAdGroupCriterion compares from, as fetched from the API, with the desired
+// to. It returns a AdGroupCriterion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdGroupCriterion(from, to *AdGroupCriterion) (*AdGroupCriterion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"ForwardCompatibilityMap"},
+		[]string{"AdGroupId", "Criterion"},
+	).(*AdGroupCriterion)
+	return set, changes
+}
+
+// SetAdGroupCriterionOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffAdGroupCriterion.
+func SetAdGroupCriterionOperation(from, to *AdGroupCriterion) (*AdGroupCriterionOperation, []deep.Change) {
+	set, changes := DiffAdGroupCriterion(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &AdGroupCriterionOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+
+// DiffAgeRange compares from, as fetched from the API, with the desired
+// to. It returns a AgeRange holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAgeRange(from, to *AgeRange) (*AgeRange, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*AgeRange)
+	return set, changes
+}
+
+// DiffAppPaymentModel compares from, as fetched from the API, with the desired
+// to. It returns a AppPaymentModel holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAppPaymentModel(from, to *AppPaymentModel) (*AppPaymentModel, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*AppPaymentModel)
+	return set, changes
+}
+
+// DiffBiddableAdGroupCriterion compares from, as fetched from the API, with the desired
+// to. It returns a BiddableAdGroupCriterion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffBiddableAdGroupCriterion(from, to *BiddableAdGroupCriterion) (*BiddableAdGroupCriterion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"ForwardCompatibilityMap", "UserStatus", "DestinationUrl", "BiddingStrategyConfiguration", "BidModifier", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters"},
+		[]string{"AdGroupId", "Criterion"},
+	).(*BiddableAdGroupCriterion)
+	return set, changes
+}
+
+// DiffBiddingStrategyConfiguration compares from, as fetched from the API, with the desired
+// to. It returns a BiddingStrategyConfiguration holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffBiddingStrategyConfiguration(from, to *BiddingStrategyConfiguration) (*BiddingStrategyConfiguration, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"BiddingStrategyType", "BiddingScheme", "Bids", "TargetRoasOverride"},
+		[]string{"BiddingStrategyId"},
+	).(*BiddingStrategyConfiguration)
+	return set, changes
+}
+
+// DiffCpcBid compares from, as fetched from the API, with the desired
+// to. It returns a CpcBid holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCpcBid(from, to *CpcBid) (*CpcBid, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Bid"},
+		nil,
+	).(*CpcBid)
+	return set, changes
+}
+
+// DiffCpmBid compares from, as fetched from the API, with the desired
+// to. It returns a CpmBid holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCpmBid(from, to *CpmBid) (*CpmBid, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Bid"},
+		nil,
+	).(*CpmBid)
+	return set, changes
+}
+
+// DiffCriterion compares from, as fetched from the API, with the desired
+// to. It returns a Criterion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCriterion(from, to *Criterion) (*Criterion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Criterion)
+	return set, changes
+}
+
+// DiffGender compares from, as fetched from the API, with the desired
+// to. It returns a Gender holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffGender(from, to *Gender) (*Gender, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Gender)
+	return set, changes
+}
+
+// DiffIncomeRange compares from, as fetched from the API, with the desired
+// to. It returns a IncomeRange holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffIncomeRange(from, to *IncomeRange) (*IncomeRange, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*IncomeRange)
+	return set, changes
+}
+
+// DiffKeyword compares from, as fetched from the API, with the desired
+// to. It returns a Keyword holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffKeyword(from, to *Keyword) (*Keyword, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Text", "MatchType"},
+		[]string{"Id"},
+	).(*Keyword)
+	return set, changes
+}
+
+// DiffManualCpcBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a ManualCpcBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffManualCpcBiddingScheme(from, to *ManualCpcBiddingScheme) (*ManualCpcBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"EnhancedCpcEnabled"},
+		nil,
+	).(*ManualCpcBiddingScheme)
+	return set, changes
+}
+
+// DiffMobileAppCategory compares from, as fetched from the API, with the desired
+// to. It returns a MobileAppCategory holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMobileAppCategory(from, to *MobileAppCategory) (*MobileAppCategory, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"MobileAppCategoryId"},
+		[]string{"Id"},
+	).(*MobileAppCategory)
+	return set, changes
+}
+
+// DiffMobileApplication compares from, as fetched from the API, with the desired
+// to. It returns a MobileApplication holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMobileApplication(from, to *MobileApplication) (*MobileApplication, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"AppId"},
+		[]string{"Id"},
+	).(*MobileApplication)
+	return set, changes
+}
+
+// DiffNegativeAdGroupCriterion compares from, as fetched from the API, with the desired
+// to. It returns a NegativeAdGroupCriterion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffNegativeAdGroupCriterion(from, to *NegativeAdGroupCriterion) (*NegativeAdGroupCriterion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"ForwardCompatibilityMap"},
+		[]string{"AdGroupId", "Criterion"},
+	).(*NegativeAdGroupCriterion)
+	return set, changes
+}
+
+// DiffParent compares from, as fetched from the API, with the desired
+// to. It returns a Parent holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffParent(from, to *Parent) (*Parent, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Parent)
+	return set, changes
+}
+
+// DiffPlacement compares from, as fetched from the API, with the desired
+// to. It returns a Placement holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffPlacement(from, to *Placement) (*Placement, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url"},
+		[]string{"Id"},
+	).(*Placement)
+	return set, changes
+}
+
+// DiffProductPartition compares from, as fetched from the API, with the desired
+// to. It returns a ProductPartition holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffProductPartition(from, to *ProductPartition) (*ProductPartition, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"PartitionType", "ParentCriterionId", "CaseValue"},
+		[]string{"Id"},
+	).(*ProductPartition)
+	return set, changes
+}
+
+// DiffQualityInfo compares from, as fetched from the API, with the desired
+// to. It returns a QualityInfo holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffQualityInfo(from, to *QualityInfo) (*QualityInfo, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"QualityScore"},
+		nil,
+	).(*QualityInfo)
+	return set, changes
+}
+
+// DiffCriterionUserInterest compares from, as fetched from the API, with the desired
+// to. It returns a CriterionUserInterest holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCriterionUserInterest(from, to *CriterionUserInterest) (*CriterionUserInterest, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"UserInterestName"},
+		[]string{"Id", "UserInterestId"},
+	).(*CriterionUserInterest)
+	return set, changes
+}
+
+// DiffCriterionUserList compares from, as fetched from the API, with the desired
+// to. It returns a CriterionUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCriterionUserList(from, to *CriterionUserList) (*CriterionUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"UserListName", "UserListMembershipStatus"},
+		[]string{"Id", "UserListId"},
+	).(*CriterionUserList)
+	return set, changes
+}
+
+// DiffVertical compares from, as fetched from the API, with the desired
+// to. It returns a Vertical holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffVertical(from, to *Vertical) (*Vertical, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Path"},
+		[]string{"Id", "VerticalId"},
+	).(*Vertical)
+	return set, changes
+}
+
+// DiffWebpage compares from, as fetched from the API, with the desired
+// to. It returns a Webpage holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffWebpage(from, to *Webpage) (*Webpage, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Parameter"},
+		[]string{"Id"},
+	).(*Webpage)
+	return set, changes
+}
+
+// DiffYouTubeChannel compares from, as fetched from the API, with the desired
+// to. It returns a YouTubeChannel holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffYouTubeChannel(from, to *YouTubeChannel) (*YouTubeChannel, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"ChannelId"},
+		[]string{"Id"},
+	).(*YouTubeChannel)
+	return set, changes
+}
+
+// DiffYouTubeVideo compares from, as fetched from the API, with the desired
+// to. It returns a YouTubeVideo holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffYouTubeVideo(from, to *YouTubeVideo) (*YouTubeVideo, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"VideoId"},
+		[]string{"Id"},
+	).(*YouTubeVideo)
+	return set, changes
+}
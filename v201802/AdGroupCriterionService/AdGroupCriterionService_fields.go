@@ -0,0 +1,1091 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupCriterionService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AdGroupCriterionFields holds the selector field names of AdGroupCriterion, including
+// the fields of the types it holds.
+var AdGroupCriterionFields = struct {
+	AdGroupId                  string
+	AgeRangeType               string
+	AppId                      string
+	AppPaymentModelType        string
+	ApprovalStatus             string
+	BaseAdGroupId              string
+	BaseCampaignId             string
+	BidModifier                string
+	BiddingStrategyId          string
+	BiddingStrategyName        string
+	BiddingStrategySource      string
+	BiddingStrategyType        string
+	CaseValue                  string
+	ChannelId                  string
+	ChannelName                string
+	CpcBid                     string
+	CpcBidSource               string
+	CpmBid                     string
+	CpmBidSource               string
+	CriteriaCoverage           string
+	CriteriaSamples            string
+	CriteriaType               string
+	CriterionUse               string
+	DestinationUrl             string
+	DisapprovalReasons         string
+	DisplayName                string
+	EnhancedCpcEnabled         string
+	FinalAppUrls               string
+	FinalMobileUrls            string
+	FinalUrls                  string
+	FirstPageCpc               string
+	FirstPositionCpc           string
+	GenderType                 string
+	Id                         string
+	IncomeRangeType            string
+	KeywordMatchType           string
+	KeywordText                string
+	Labels                     string
+	MobileAppCategoryId        string
+	Parameter                  string
+	ParentCriterionId          string
+	ParentType                 string
+	PartitionType              string
+	Path                       string
+	PlacementUrl               string
+	QualityScore               string
+	Status                     string
+	SystemServingStatus        string
+	TopOfPageCpc               string
+	TrackingUrlTemplate        string
+	UrlCustomParameters        string
+	UserInterestId             string
+	UserInterestName           string
+	UserInterestParentId       string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+	VerticalId                 string
+	VerticalParentId           string
+	VideoId                    string
+	VideoName                  string
+}{
+	AdGroupId:                  "AdGroupId",
+	AgeRangeType:               "AgeRangeType",
+	AppId:                      "AppId",
+	AppPaymentModelType:        "AppPaymentModelType",
+	ApprovalStatus:             "ApprovalStatus",
+	BaseAdGroupId:              "BaseAdGroupId",
+	BaseCampaignId:             "BaseCampaignId",
+	BidModifier:                "BidModifier",
+	BiddingStrategyId:          "BiddingStrategyId",
+	BiddingStrategyName:        "BiddingStrategyName",
+	BiddingStrategySource:      "BiddingStrategySource",
+	BiddingStrategyType:        "BiddingStrategyType",
+	CaseValue:                  "CaseValue",
+	ChannelId:                  "ChannelId",
+	ChannelName:                "ChannelName",
+	CpcBid:                     "CpcBid",
+	CpcBidSource:               "CpcBidSource",
+	CpmBid:                     "CpmBid",
+	CpmBidSource:               "CpmBidSource",
+	CriteriaCoverage:           "CriteriaCoverage",
+	CriteriaSamples:            "CriteriaSamples",
+	CriteriaType:               "CriteriaType",
+	CriterionUse:               "CriterionUse",
+	DestinationUrl:             "DestinationUrl",
+	DisapprovalReasons:         "DisapprovalReasons",
+	DisplayName:                "DisplayName",
+	EnhancedCpcEnabled:         "EnhancedCpcEnabled",
+	FinalAppUrls:               "FinalAppUrls",
+	FinalMobileUrls:            "FinalMobileUrls",
+	FinalUrls:                  "FinalUrls",
+	FirstPageCpc:               "FirstPageCpc",
+	FirstPositionCpc:           "FirstPositionCpc",
+	GenderType:                 "GenderType",
+	Id:                         "Id",
+	IncomeRangeType:            "IncomeRangeType",
+	KeywordMatchType:           "KeywordMatchType",
+	KeywordText:                "KeywordText",
+	Labels:                     "Labels",
+	MobileAppCategoryId:        "MobileAppCategoryId",
+	Parameter:                  "Parameter",
+	ParentCriterionId:          "ParentCriterionId",
+	ParentType:                 "ParentType",
+	PartitionType:              "PartitionType",
+	Path:                       "Path",
+	PlacementUrl:               "PlacementUrl",
+	QualityScore:               "QualityScore",
+	Status:                     "Status",
+	SystemServingStatus:        "SystemServingStatus",
+	TopOfPageCpc:               "TopOfPageCpc",
+	TrackingUrlTemplate:        "TrackingUrlTemplate",
+	UrlCustomParameters:        "UrlCustomParameters",
+	UserInterestId:             "UserInterestId",
+	UserInterestName:           "UserInterestName",
+	UserInterestParentId:       "UserInterestParentId",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+	VerticalId:                 "VerticalId",
+	VerticalParentId:           "VerticalParentId",
+	VideoId:                    "VideoId",
+	VideoName:                  "VideoName",
+}
+
+// AgeRangeFields holds the selector field names of AgeRange, including
+// the fields of the types it holds.
+var AgeRangeFields = struct {
+	AgeRangeType string
+	CriteriaType string
+	Id           string
+}{
+	AgeRangeType: "AgeRangeType",
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// AppPaymentModelFields holds the selector field names of AppPaymentModel, including
+// the fields of the types it holds.
+var AppPaymentModelFields = struct {
+	AppPaymentModelType string
+	CriteriaType        string
+	Id                  string
+}{
+	AppPaymentModelType: "AppPaymentModelType",
+	CriteriaType:        "CriteriaType",
+	Id:                  "Id",
+}
+
+// BiddableAdGroupCriterionFields holds the selector field names of BiddableAdGroupCriterion, including
+// the fields of the types it holds.
+var BiddableAdGroupCriterionFields = struct {
+	AdGroupId                  string
+	AgeRangeType               string
+	AppId                      string
+	AppPaymentModelType        string
+	ApprovalStatus             string
+	BaseAdGroupId              string
+	BaseCampaignId             string
+	BidModifier                string
+	BiddingStrategyId          string
+	BiddingStrategyName        string
+	BiddingStrategySource      string
+	BiddingStrategyType        string
+	CaseValue                  string
+	ChannelId                  string
+	ChannelName                string
+	CpcBid                     string
+	CpcBidSource               string
+	CpmBid                     string
+	CpmBidSource               string
+	CriteriaCoverage           string
+	CriteriaSamples            string
+	CriteriaType               string
+	CriterionUse               string
+	DestinationUrl             string
+	DisapprovalReasons         string
+	DisplayName                string
+	EnhancedCpcEnabled         string
+	FinalAppUrls               string
+	FinalMobileUrls            string
+	FinalUrls                  string
+	FirstPageCpc               string
+	FirstPositionCpc           string
+	GenderType                 string
+	Id                         string
+	IncomeRangeType            string
+	KeywordMatchType           string
+	KeywordText                string
+	Labels                     string
+	MobileAppCategoryId        string
+	Parameter                  string
+	ParentCriterionId          string
+	ParentType                 string
+	PartitionType              string
+	Path                       string
+	PlacementUrl               string
+	QualityScore               string
+	Status                     string
+	SystemServingStatus        string
+	TopOfPageCpc               string
+	TrackingUrlTemplate        string
+	UrlCustomParameters        string
+	UserInterestId             string
+	UserInterestName           string
+	UserInterestParentId       string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+	VerticalId                 string
+	VerticalParentId           string
+	VideoId                    string
+	VideoName                  string
+}{
+	AdGroupId:                  "AdGroupId",
+	AgeRangeType:               "AgeRangeType",
+	AppId:                      "AppId",
+	AppPaymentModelType:        "AppPaymentModelType",
+	ApprovalStatus:             "ApprovalStatus",
+	BaseAdGroupId:              "BaseAdGroupId",
+	BaseCampaignId:             "BaseCampaignId",
+	BidModifier:                "BidModifier",
+	BiddingStrategyId:          "BiddingStrategyId",
+	BiddingStrategyName:        "BiddingStrategyName",
+	BiddingStrategySource:      "BiddingStrategySource",
+	BiddingStrategyType:        "BiddingStrategyType",
+	CaseValue:                  "CaseValue",
+	ChannelId:                  "ChannelId",
+	ChannelName:                "ChannelName",
+	CpcBid:                     "CpcBid",
+	CpcBidSource:               "CpcBidSource",
+	CpmBid:                     "CpmBid",
+	CpmBidSource:               "CpmBidSource",
+	CriteriaCoverage:           "CriteriaCoverage",
+	CriteriaSamples:            "CriteriaSamples",
+	CriteriaType:               "CriteriaType",
+	CriterionUse:               "CriterionUse",
+	DestinationUrl:             "DestinationUrl",
+	DisapprovalReasons:         "DisapprovalReasons",
+	DisplayName:                "DisplayName",
+	EnhancedCpcEnabled:         "EnhancedCpcEnabled",
+	FinalAppUrls:               "FinalAppUrls",
+	FinalMobileUrls:            "FinalMobileUrls",
+	FinalUrls:                  "FinalUrls",
+	FirstPageCpc:               "FirstPageCpc",
+	FirstPositionCpc:           "FirstPositionCpc",
+	GenderType:                 "GenderType",
+	Id:                         "Id",
+	IncomeRangeType:            "IncomeRangeType",
+	KeywordMatchType:           "KeywordMatchType",
+	KeywordText:                "KeywordText",
+	Labels:                     "Labels",
+	MobileAppCategoryId:        "MobileAppCategoryId",
+	Parameter:                  "Parameter",
+	ParentCriterionId:          "ParentCriterionId",
+	ParentType:                 "ParentType",
+	PartitionType:              "PartitionType",
+	Path:                       "Path",
+	PlacementUrl:               "PlacementUrl",
+	QualityScore:               "QualityScore",
+	Status:                     "Status",
+	SystemServingStatus:        "SystemServingStatus",
+	TopOfPageCpc:               "TopOfPageCpc",
+	TrackingUrlTemplate:        "TrackingUrlTemplate",
+	UrlCustomParameters:        "UrlCustomParameters",
+	UserInterestId:             "UserInterestId",
+	UserInterestName:           "UserInterestName",
+	UserInterestParentId:       "UserInterestParentId",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+	VerticalId:                 "VerticalId",
+	VerticalParentId:           "VerticalParentId",
+	VideoId:                    "VideoId",
+	VideoName:                  "VideoName",
+}
+
+// BiddingStrategyConfigurationFields holds the selector field names of BiddingStrategyConfiguration, including
+// the fields of the types it holds.
+var BiddingStrategyConfigurationFields = struct {
+	BiddingStrategyId     string
+	BiddingStrategyName   string
+	BiddingStrategySource string
+	BiddingStrategyType   string
+	CpcBid                string
+	CpcBidSource          string
+	CpmBid                string
+	CpmBidSource          string
+	EnhancedCpcEnabled    string
+}{
+	BiddingStrategyId:     "BiddingStrategyId",
+	BiddingStrategyName:   "BiddingStrategyName",
+	BiddingStrategySource: "BiddingStrategySource",
+	BiddingStrategyType:   "BiddingStrategyType",
+	CpcBid:                "CpcBid",
+	CpcBidSource:          "CpcBidSource",
+	CpmBid:                "CpmBid",
+	CpmBidSource:          "CpmBidSource",
+	EnhancedCpcEnabled:    "EnhancedCpcEnabled",
+}
+
+// CpcBidFields holds the selector field names of CpcBid, including
+// the fields of the types it holds.
+var CpcBidFields = struct {
+	CpcBid       string
+	CpcBidSource string
+}{
+	CpcBid:       "CpcBid",
+	CpcBidSource: "CpcBidSource",
+}
+
+// CpmBidFields holds the selector field names of CpmBid, including
+// the fields of the types it holds.
+var CpmBidFields = struct {
+	CpmBid       string
+	CpmBidSource string
+}{
+	CpmBid:       "CpmBid",
+	CpmBidSource: "CpmBidSource",
+}
+
+// CriterionFields holds the selector field names of Criterion, including
+// the fields of the types it holds.
+var CriterionFields = struct {
+	AgeRangeType               string
+	AppId                      string
+	AppPaymentModelType        string
+	CaseValue                  string
+	ChannelId                  string
+	ChannelName                string
+	CriteriaCoverage           string
+	CriteriaSamples            string
+	CriteriaType               string
+	DisplayName                string
+	GenderType                 string
+	Id                         string
+	IncomeRangeType            string
+	KeywordMatchType           string
+	KeywordText                string
+	MobileAppCategoryId        string
+	Parameter                  string
+	ParentCriterionId          string
+	ParentType                 string
+	PartitionType              string
+	Path                       string
+	PlacementUrl               string
+	UserInterestId             string
+	UserInterestName           string
+	UserInterestParentId       string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+	VerticalId                 string
+	VerticalParentId           string
+	VideoId                    string
+	VideoName                  string
+}{
+	AgeRangeType:               "AgeRangeType",
+	AppId:                      "AppId",
+	AppPaymentModelType:        "AppPaymentModelType",
+	CaseValue:                  "CaseValue",
+	ChannelId:                  "ChannelId",
+	ChannelName:                "ChannelName",
+	CriteriaCoverage:           "CriteriaCoverage",
+	CriteriaSamples:            "CriteriaSamples",
+	CriteriaType:               "CriteriaType",
+	DisplayName:                "DisplayName",
+	GenderType:                 "GenderType",
+	Id:                         "Id",
+	IncomeRangeType:            "IncomeRangeType",
+	KeywordMatchType:           "KeywordMatchType",
+	KeywordText:                "KeywordText",
+	MobileAppCategoryId:        "MobileAppCategoryId",
+	Parameter:                  "Parameter",
+	ParentCriterionId:          "ParentCriterionId",
+	ParentType:                 "ParentType",
+	PartitionType:              "PartitionType",
+	Path:                       "Path",
+	PlacementUrl:               "PlacementUrl",
+	UserInterestId:             "UserInterestId",
+	UserInterestName:           "UserInterestName",
+	UserInterestParentId:       "UserInterestParentId",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+	VerticalId:                 "VerticalId",
+	VerticalParentId:           "VerticalParentId",
+	VideoId:                    "VideoId",
+	VideoName:                  "VideoName",
+}
+
+// GenderFields holds the selector field names of Gender, including
+// the fields of the types it holds.
+var GenderFields = struct {
+	CriteriaType string
+	GenderType   string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	GenderType:   "GenderType",
+	Id:           "Id",
+}
+
+// IncomeRangeFields holds the selector field names of IncomeRange, including
+// the fields of the types it holds.
+var IncomeRangeFields = struct {
+	CriteriaType    string
+	Id              string
+	IncomeRangeType string
+}{
+	CriteriaType:    "CriteriaType",
+	Id:              "Id",
+	IncomeRangeType: "IncomeRangeType",
+}
+
+// KeywordFields holds the selector field names of Keyword, including
+// the fields of the types it holds.
+var KeywordFields = struct {
+	CriteriaType     string
+	Id               string
+	KeywordMatchType string
+	KeywordText      string
+}{
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+}
+
+// ManualCpcBiddingSchemeFields holds the selector field names of ManualCpcBiddingScheme, including
+// the fields of the types it holds.
+var ManualCpcBiddingSchemeFields = struct {
+	EnhancedCpcEnabled string
+}{
+	EnhancedCpcEnabled: "EnhancedCpcEnabled",
+}
+
+// MobileAppCategoryFields holds the selector field names of MobileAppCategory, including
+// the fields of the types it holds.
+var MobileAppCategoryFields = struct {
+	CriteriaType        string
+	Id                  string
+	MobileAppCategoryId string
+}{
+	CriteriaType:        "CriteriaType",
+	Id:                  "Id",
+	MobileAppCategoryId: "MobileAppCategoryId",
+}
+
+// MobileApplicationFields holds the selector field names of MobileApplication, including
+// the fields of the types it holds.
+var MobileApplicationFields = struct {
+	AppId        string
+	CriteriaType string
+	DisplayName  string
+	Id           string
+}{
+	AppId:        "AppId",
+	CriteriaType: "CriteriaType",
+	DisplayName:  "DisplayName",
+	Id:           "Id",
+}
+
+// NegativeAdGroupCriterionFields holds the selector field names of NegativeAdGroupCriterion, including
+// the fields of the types it holds.
+var NegativeAdGroupCriterionFields = struct {
+	AdGroupId                  string
+	AgeRangeType               string
+	AppId                      string
+	AppPaymentModelType        string
+	BaseAdGroupId              string
+	BaseCampaignId             string
+	CaseValue                  string
+	ChannelId                  string
+	ChannelName                string
+	CriteriaCoverage           string
+	CriteriaSamples            string
+	CriteriaType               string
+	CriterionUse               string
+	DisplayName                string
+	GenderType                 string
+	Id                         string
+	IncomeRangeType            string
+	KeywordMatchType           string
+	KeywordText                string
+	Labels                     string
+	MobileAppCategoryId        string
+	Parameter                  string
+	ParentCriterionId          string
+	ParentType                 string
+	PartitionType              string
+	Path                       string
+	PlacementUrl               string
+	UserInterestId             string
+	UserInterestName           string
+	UserInterestParentId       string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+	VerticalId                 string
+	VerticalParentId           string
+	VideoId                    string
+	VideoName                  string
+}{
+	AdGroupId:                  "AdGroupId",
+	AgeRangeType:               "AgeRangeType",
+	AppId:                      "AppId",
+	AppPaymentModelType:        "AppPaymentModelType",
+	BaseAdGroupId:              "BaseAdGroupId",
+	BaseCampaignId:             "BaseCampaignId",
+	CaseValue:                  "CaseValue",
+	ChannelId:                  "ChannelId",
+	ChannelName:                "ChannelName",
+	CriteriaCoverage:           "CriteriaCoverage",
+	CriteriaSamples:            "CriteriaSamples",
+	CriteriaType:               "CriteriaType",
+	CriterionUse:               "CriterionUse",
+	DisplayName:                "DisplayName",
+	GenderType:                 "GenderType",
+	Id:                         "Id",
+	IncomeRangeType:            "IncomeRangeType",
+	KeywordMatchType:           "KeywordMatchType",
+	KeywordText:                "KeywordText",
+	Labels:                     "Labels",
+	MobileAppCategoryId:        "MobileAppCategoryId",
+	Parameter:                  "Parameter",
+	ParentCriterionId:          "ParentCriterionId",
+	ParentType:                 "ParentType",
+	PartitionType:              "PartitionType",
+	Path:                       "Path",
+	PlacementUrl:               "PlacementUrl",
+	UserInterestId:             "UserInterestId",
+	UserInterestName:           "UserInterestName",
+	UserInterestParentId:       "UserInterestParentId",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+	VerticalId:                 "VerticalId",
+	VerticalParentId:           "VerticalParentId",
+	VideoId:                    "VideoId",
+	VideoName:                  "VideoName",
+}
+
+// ParentFields holds the selector field names of Parent, including
+// the fields of the types it holds.
+var ParentFields = struct {
+	CriteriaType string
+	Id           string
+	ParentType   string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	ParentType:   "ParentType",
+}
+
+// PlacementFields holds the selector field names of Placement, including
+// the fields of the types it holds.
+var PlacementFields = struct {
+	CriteriaType string
+	Id           string
+	PlacementUrl string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	PlacementUrl: "PlacementUrl",
+}
+
+// ProductPartitionFields holds the selector field names of ProductPartition, including
+// the fields of the types it holds.
+var ProductPartitionFields = struct {
+	CaseValue         string
+	CriteriaType      string
+	Id                string
+	ParentCriterionId string
+	PartitionType     string
+}{
+	CaseValue:         "CaseValue",
+	CriteriaType:      "CriteriaType",
+	Id:                "Id",
+	ParentCriterionId: "ParentCriterionId",
+	PartitionType:     "PartitionType",
+}
+
+// QualityInfoFields holds the selector field names of QualityInfo, including
+// the fields of the types it holds.
+var QualityInfoFields = struct {
+	QualityScore string
+}{
+	QualityScore: "QualityScore",
+}
+
+// CriterionUserInterestFields holds the selector field names of CriterionUserInterest, including
+// the fields of the types it holds.
+var CriterionUserInterestFields = struct {
+	CriteriaType         string
+	Id                   string
+	UserInterestId       string
+	UserInterestName     string
+	UserInterestParentId string
+}{
+	CriteriaType:         "CriteriaType",
+	Id:                   "Id",
+	UserInterestId:       "UserInterestId",
+	UserInterestName:     "UserInterestName",
+	UserInterestParentId: "UserInterestParentId",
+}
+
+// CriterionUserListFields holds the selector field names of CriterionUserList, including
+// the fields of the types it holds.
+var CriterionUserListFields = struct {
+	CriteriaType               string
+	Id                         string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+}{
+	CriteriaType:               "CriteriaType",
+	Id:                         "Id",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+}
+
+// VerticalFields holds the selector field names of Vertical, including
+// the fields of the types it holds.
+var VerticalFields = struct {
+	CriteriaType     string
+	Id               string
+	Path             string
+	VerticalId       string
+	VerticalParentId string
+}{
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	Path:             "Path",
+	VerticalId:       "VerticalId",
+	VerticalParentId: "VerticalParentId",
+}
+
+// WebpageFields holds the selector field names of Webpage, including
+// the fields of the types it holds.
+var WebpageFields = struct {
+	CriteriaCoverage string
+	CriteriaSamples  string
+	CriteriaType     string
+	Id               string
+	Parameter        string
+}{
+	CriteriaCoverage: "CriteriaCoverage",
+	CriteriaSamples:  "CriteriaSamples",
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	Parameter:        "Parameter",
+}
+
+// YouTubeChannelFields holds the selector field names of YouTubeChannel, including
+// the fields of the types it holds.
+var YouTubeChannelFields = struct {
+	ChannelId    string
+	ChannelName  string
+	CriteriaType string
+	Id           string
+}{
+	ChannelId:    "ChannelId",
+	ChannelName:  "ChannelName",
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// YouTubeVideoFields holds the selector field names of YouTubeVideo, including
+// the fields of the types it holds.
+var YouTubeVideoFields = struct {
+	CriteriaType string
+	Id           string
+	VideoId      string
+	VideoName    string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	VideoId:      "VideoId",
+	VideoName:    "VideoName",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"MutateLabel": {
+		{Type: "MutateLabel", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"AdGroupCriterion": {
+		{Type: "AdGroupCriterion", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId", Required: true},
+		{Type: "AdGroupCriterion", Name: "CriterionUse", Selectors: []string{"CriterionUse"}, Filter: "CriterionUse", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "Criterion", Required: true},
+		{Type: "AdGroupCriterion", Name: "Labels", Selectors: []string{"Labels"}, Filter: "Labels", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "AdGroupCriterion", Name: "ForwardCompatibilityMap"},
+		{Type: "AdGroupCriterion", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, Filter: "BaseCampaignId", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "BaseAdGroupId", Selectors: []string{"BaseAdGroupId"}, Filter: "BaseAdGroupId", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "AdGroupCriterionType"},
+	},
+	"AdGroupCriterionLabel": {
+		{Type: "AdGroupCriterionLabel", Name: "AdGroupId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+		{Type: "AdGroupCriterionLabel", Name: "CriterionId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+		{Type: "AdGroupCriterionLabel", Name: "LabelId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+	},
+	"AdGroupCriterionLabelOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "AdGroupCriterionLabelOperation", Name: "Operand", Required: true},
+	},
+	"AdGroupCriterionOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "AdGroupCriterionOperation", Name: "Operand", Required: true},
+		{Type: "AdGroupCriterionOperation", Name: "ExemptionRequests"},
+	},
+	"AgeRange": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "AgeRange", Name: "AgeRangeType", Selectors: []string{"AgeRangeType"}, ReadOnly: true},
+	},
+	"AppPaymentModel": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "AppPaymentModel", Name: "AppPaymentModelType", Selectors: []string{"AppPaymentModelType"}, ReadOnly: true},
+	},
+	"AppUrl": {
+		{Type: "AppUrl", Name: "Url"},
+		{Type: "AppUrl", Name: "OsType", Required: true},
+	},
+	"BiddableAdGroupCriterion": {
+		{Type: "AdGroupCriterion", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId", Required: true},
+		{Type: "AdGroupCriterion", Name: "CriterionUse", Selectors: []string{"CriterionUse"}, Filter: "CriterionUse", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "Criterion", Required: true},
+		{Type: "AdGroupCriterion", Name: "Labels", Selectors: []string{"Labels"}, Filter: "Labels", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "AdGroupCriterion", Name: "ForwardCompatibilityMap"},
+		{Type: "AdGroupCriterion", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, Filter: "BaseCampaignId", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "BaseAdGroupId", Selectors: []string{"BaseAdGroupId"}, Filter: "BaseAdGroupId", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "AdGroupCriterionType"},
+		{Type: "BiddableAdGroupCriterion", Name: "UserStatus", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "BiddableAdGroupCriterion", Name: "SystemServingStatus", Selectors: []string{"SystemServingStatus"}, Filter: "SystemServingStatus", ReadOnly: true},
+		{Type: "BiddableAdGroupCriterion", Name: "ApprovalStatus", Selectors: []string{"ApprovalStatus"}, Filter: "ApprovalStatus", ReadOnly: true},
+		{Type: "BiddableAdGroupCriterion", Name: "DisapprovalReasons", Selectors: []string{"DisapprovalReasons"}, ReadOnly: true},
+		{Type: "BiddableAdGroupCriterion", Name: "DestinationUrl", Selectors: []string{"DestinationUrl"}, Filter: "DestinationUrl"},
+		{Type: "BiddableAdGroupCriterion", Name: "FirstPageCpc", Selectors: []string{"FirstPageCpc"}, Filter: "FirstPageCpc", ReadOnly: true},
+		{Type: "BiddableAdGroupCriterion", Name: "TopOfPageCpc", Selectors: []string{"TopOfPageCpc"}, Filter: "TopOfPageCpc", ReadOnly: true},
+		{Type: "BiddableAdGroupCriterion", Name: "FirstPositionCpc", Selectors: []string{"FirstPositionCpc"}, Filter: "FirstPositionCpc", ReadOnly: true},
+		{Type: "BiddableAdGroupCriterion", Name: "QualityInfo", ReadOnly: true},
+		{Type: "BiddableAdGroupCriterion", Name: "BiddingStrategyConfiguration"},
+		{Type: "BiddableAdGroupCriterion", Name: "BidModifier", Selectors: []string{"BidModifier"}, Filter: "BidModifier"},
+		{Type: "BiddableAdGroupCriterion", Name: "FinalUrls", Selectors: []string{"FinalUrls"}, Filter: "FinalUrls"},
+		{Type: "BiddableAdGroupCriterion", Name: "FinalMobileUrls", Selectors: []string{"FinalMobileUrls"}, Filter: "FinalMobileUrls"},
+		{Type: "BiddableAdGroupCriterion", Name: "FinalAppUrls", Selectors: []string{"FinalAppUrls"}, Filter: "FinalAppUrls"},
+		{Type: "BiddableAdGroupCriterion", Name: "TrackingUrlTemplate", Selectors: []string{"TrackingUrlTemplate"}, Filter: "TrackingUrlTemplate"},
+		{Type: "BiddableAdGroupCriterion", Name: "FinalUrlSuffix"},
+		{Type: "BiddableAdGroupCriterion", Name: "UrlCustomParameters", Selectors: []string{"UrlCustomParameters"}, Filter: "UrlCustomParameters"},
+	},
+	"BiddingStrategyConfiguration": {
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyId", Selectors: []string{"BiddingStrategyId"}, Filter: "BiddingStrategyId"},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyName", Selectors: []string{"BiddingStrategyName"}, Filter: "BiddingStrategyName", ReadOnly: true},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyType", Selectors: []string{"BiddingStrategyType"}, Filter: "BiddingStrategyType"},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategySource", Selectors: []string{"BiddingStrategySource"}, Filter: "BiddingStrategySource", ReadOnly: true},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingScheme"},
+		{Type: "BiddingStrategyConfiguration", Name: "Bids"},
+		{Type: "BiddingStrategyConfiguration", Name: "TargetRoasOverride"},
+	},
+	"TextLabel": {
+		{Type: "Label", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Label", Name: "Name"},
+		{Type: "Label", Name: "Status", ReadOnly: true},
+		{Type: "Label", Name: "Attribute", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Label", Name: "LabelType"},
+	},
+	"CpaBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpaBid", Name: "Bid"},
+		{Type: "CpaBid", Name: "BidSource", ReadOnly: true},
+	},
+	"CpcBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpcBid", Name: "Bid", Selectors: []string{"CpcBid"}, Filter: "CpcBid"},
+		{Type: "CpcBid", Name: "CpcBidSource", Selectors: []string{"CpcBidSource"}, Filter: "CpcBidSource", ReadOnly: true},
+	},
+	"CpmBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpmBid", Name: "Bid", Selectors: []string{"CpmBid"}, Filter: "CpmBid"},
+		{Type: "CpmBid", Name: "CpmBidSource", Selectors: []string{"CpmBidSource"}, Filter: "CpmBidSource", ReadOnly: true},
+	},
+	"Criterion": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+	},
+	"CustomParameter": {
+		{Type: "CustomParameter", Name: "Key", Required: true},
+		{Type: "CustomParameter", Name: "Value"},
+		{Type: "CustomParameter", Name: "IsRemove"},
+	},
+	"ExemptionRequest": {
+		{Type: "ExemptionRequest", Name: "Key", Required: true},
+	},
+	"Gender": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Gender", Name: "GenderType", Selectors: []string{"GenderType"}, ReadOnly: true},
+	},
+	"IncomeRange": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "IncomeRange", Name: "IncomeRangeType", Selectors: []string{"IncomeRangeType"}, ReadOnly: true},
+	},
+	"Keyword": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Keyword", Name: "Text", Selectors: []string{"KeywordText"}, Filter: "KeywordText", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Keyword", Name: "MatchType", Selectors: []string{"KeywordMatchType"}, Filter: "KeywordMatchType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Label": {
+		{Type: "Label", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Label", Name: "Name"},
+		{Type: "Label", Name: "Status", ReadOnly: true},
+		{Type: "Label", Name: "Attribute", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Label", Name: "LabelType"},
+	},
+	"ManualCpcBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "ManualCpcBiddingScheme", Name: "EnhancedCpcEnabled", Selectors: []string{"EnhancedCpcEnabled"}, Filter: "EnhancedCpcEnabled"},
+	},
+	"ManualCpmBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "ManualCpmBiddingScheme", Name: "ViewableCpmEnabled", ReadOnly: true},
+	},
+	"MobileAppCategory": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileAppCategory", Name: "MobileAppCategoryId", Selectors: []string{"MobileAppCategoryId"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileAppCategory", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileApplication": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileApplication", Name: "AppId", Selectors: []string{"AppId"}, Filter: "AppId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileApplication", Name: "DisplayName", Selectors: []string{"DisplayName"}, Filter: "DisplayName", ReadOnly: true},
+	},
+	"NegativeAdGroupCriterion": {
+		{Type: "AdGroupCriterion", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId", Required: true},
+		{Type: "AdGroupCriterion", Name: "CriterionUse", Selectors: []string{"CriterionUse"}, Filter: "CriterionUse", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "Criterion", Required: true},
+		{Type: "AdGroupCriterion", Name: "Labels", Selectors: []string{"Labels"}, Filter: "Labels", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "AdGroupCriterion", Name: "ForwardCompatibilityMap"},
+		{Type: "AdGroupCriterion", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, Filter: "BaseCampaignId", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "BaseAdGroupId", Selectors: []string{"BaseAdGroupId"}, Filter: "BaseAdGroupId", ReadOnly: true},
+		{Type: "AdGroupCriterion", Name: "AdGroupCriterionType"},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Parent": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Parent", Name: "ParentType", Selectors: []string{"ParentType"}, ReadOnly: true},
+	},
+	"Placement": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Placement", Name: "Url", Selectors: []string{"PlacementUrl"}, Filter: "PlacementUrl", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"PolicyViolationKey": {
+		{Type: "PolicyViolationKey", Name: "PolicyName", Required: true},
+		{Type: "PolicyViolationKey", Name: "ViolatingText"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"ProductBiddingCategory": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductBiddingCategory", Name: "Type_", Filter: "ParentDimensionType", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductBiddingCategory", Name: "Value", Filter: "ParentDimensionId"},
+	},
+	"ProductCustomAttribute": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductCustomAttribute", Name: "Type_", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductCustomAttribute", Name: "Value"},
+	},
+	"ProductPartition": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "ProductPartition", Name: "PartitionType", Selectors: []string{"PartitionType"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductPartition", Name: "ParentCriterionId", Selectors: []string{"ParentCriterionId"}},
+		{Type: "ProductPartition", Name: "CaseValue", Selectors: []string{"CaseValue"}},
+	},
+	"ProductType": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductType", Name: "Type_", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductType", Name: "Value"},
+	},
+	"QualityInfo": {
+		{Type: "QualityInfo", Name: "QualityScore", Selectors: []string{"QualityScore"}, Filter: "QualityScore"},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"CriterionUserInterest": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserInterest", Name: "UserInterestId", Selectors: []string{"UserInterestId"}},
+		{Type: "CriterionUserInterest", Name: "UserInterestParentId", Selectors: []string{"UserInterestParentId"}, ReadOnly: true},
+		{Type: "CriterionUserInterest", Name: "UserInterestName", Selectors: []string{"UserInterestName"}},
+	},
+	"CriterionUserList": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserList", Name: "UserListId", Selectors: []string{"UserListId"}},
+		{Type: "CriterionUserList", Name: "UserListName", Selectors: []string{"UserListName"}},
+		{Type: "CriterionUserList", Name: "UserListMembershipStatus", Selectors: []string{"UserListMembershipStatus"}, Filter: "UserListMembershipStatus"},
+		{Type: "CriterionUserList", Name: "UserListEligibleForSearch", Selectors: []string{"UserListEligibleForSearch"}, Filter: "UserListEligibleForSearch", ReadOnly: true},
+		{Type: "CriterionUserList", Name: "UserListEligibleForDisplay", Selectors: []string{"UserListEligibleForDisplay"}, Filter: "UserListEligibleForDisplay", ReadOnly: true},
+	},
+	"Vertical": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Vertical", Name: "VerticalId", Selectors: []string{"VerticalId"}},
+		{Type: "Vertical", Name: "VerticalParentId", Selectors: []string{"VerticalParentId"}, ReadOnly: true},
+		{Type: "Vertical", Name: "Path", Selectors: []string{"Path"}},
+	},
+	"Webpage": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Webpage", Name: "Parameter", Selectors: []string{"Parameter"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Webpage", Name: "CriteriaCoverage", Selectors: []string{"CriteriaCoverage"}, ReadOnly: true},
+		{Type: "Webpage", Name: "CriteriaSamples", Selectors: []string{"CriteriaSamples"}, ReadOnly: true},
+	},
+	"WebpageCondition": {
+		{Type: "WebpageCondition", Name: "Operand", Required: true},
+		{Type: "WebpageCondition", Name: "Argument", Required: true},
+	},
+	"WebpageParameter": {
+		{Type: "CriterionParameter", Name: "CriterionParameterType"},
+		{Type: "WebpageParameter", Name: "CriterionName", Required: true},
+		{Type: "WebpageParameter", Name: "Conditions"},
+	},
+	"YouTubeChannel": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "YouTubeChannel", Name: "ChannelId", Selectors: []string{"ChannelId"}},
+		{Type: "YouTubeChannel", Name: "ChannelName", Selectors: []string{"ChannelName"}, ReadOnly: true},
+	},
+	"YouTubeVideo": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "YouTubeVideo", Name: "VideoId", Selectors: []string{"VideoId"}},
+		{Type: "YouTubeVideo", Name: "VideoName", Selectors: []string{"VideoName"}, ReadOnly: true},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
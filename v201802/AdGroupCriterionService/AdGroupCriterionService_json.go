@@ -0,0 +1,1739 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupCriterionService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionError" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionError) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdGroupCriterionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionLabelOperation" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionLabelOperation) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionLabelOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("AdGroupCriterionLabelOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionLabelReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionLabelReturnValue) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionLabelReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("AdGroupCriterionLabelReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionLimitExceeded" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionLimitExceeded) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionLimitExceeded
+	v := plain(t)
+
+	b0 := new(EntityCountLimitExceeded)
+	if v.EntityCountLimitExceeded != nil {
+		*b0 = *v.EntityCountLimitExceeded
+	}
+	v.EntityCountLimitExceeded = b0
+
+	b1 := new(ApiError)
+	if b0.ApiError != nil {
+		*b1 = *b0.ApiError
+	}
+	if b1.ApiErrorType == nil {
+		b1.ApiErrorType = String("AdGroupCriterionLimitExceeded")
+	}
+	b0.ApiError = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionOperation" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionOperation) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("AdGroupCriterionOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionPage" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionPage) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionPage
+	v := plain(t)
+
+	b0 := new(Page)
+	if v.Page != nil {
+		*b0 = *v.Page
+	}
+	if b0.PageType == nil {
+		b0.PageType = String("AdGroupCriterionPage")
+	}
+	v.Page = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionReturnValue) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("AdGroupCriterionReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdxError" as the
+// concrete type in the discriminator of its base types.
+func (t AdxError) MarshalJSON() ([]byte, error) {
+	type plain AdxError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdxError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AgeRange" as the
+// concrete type in the discriminator of its base types.
+func (t AgeRange) MarshalJSON() ([]byte, error) {
+	type plain AgeRange
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("AgeRange")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AppPaymentModel" as the
+// concrete type in the discriminator of its base types.
+func (t AppPaymentModel) MarshalJSON() ([]byte, error) {
+	type plain AppPaymentModel
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("AppPaymentModel")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "BiddableAdGroupCriterion" as the
+// concrete type in the discriminator of its base types.
+func (t BiddableAdGroupCriterion) MarshalJSON() ([]byte, error) {
+	type plain BiddableAdGroupCriterion
+	v := plain(t)
+
+	b0 := new(AdGroupCriterion)
+	if v.AdGroupCriterion != nil {
+		*b0 = *v.AdGroupCriterion
+	}
+	if b0.AdGroupCriterionType == nil {
+		b0.AdGroupCriterionType = String("BiddableAdGroupCriterion")
+	}
+	v.AdGroupCriterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "BiddingErrors" as the
+// concrete type in the discriminator of its base types.
+func (t BiddingErrors) MarshalJSON() ([]byte, error) {
+	type plain BiddingErrors
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("BiddingErrors")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TextLabel" as the
+// concrete type in the discriminator of its base types.
+func (t TextLabel) MarshalJSON() ([]byte, error) {
+	type plain TextLabel
+	v := plain(t)
+
+	b0 := new(Label)
+	if v.Label != nil {
+		*b0 = *v.Label
+	}
+	if b0.LabelType == nil {
+		b0.LabelType = String("TextLabel")
+	}
+	v.Label = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DisplayAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t DisplayAttribute) MarshalJSON() ([]byte, error) {
+	type plain DisplayAttribute
+	v := plain(t)
+
+	b0 := new(LabelAttribute)
+	if v.LabelAttribute != nil {
+		*b0 = *v.LabelAttribute
+	}
+	if b0.LabelAttributeType == nil {
+		b0.LabelAttributeType = String("DisplayAttribute")
+	}
+	v.LabelAttribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CollectionSizeError" as the
+// concrete type in the discriminator of its base types.
+func (t CollectionSizeError) MarshalJSON() ([]byte, error) {
+	type plain CollectionSizeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CollectionSizeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CpaBid" as the
+// concrete type in the discriminator of its base types.
+func (t CpaBid) MarshalJSON() ([]byte, error) {
+	type plain CpaBid
+	v := plain(t)
+
+	b0 := new(Bids)
+	if v.Bids != nil {
+		*b0 = *v.Bids
+	}
+	if b0.BidsType == nil {
+		b0.BidsType = String("CpaBid")
+	}
+	v.Bids = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CpcBid" as the
+// concrete type in the discriminator of its base types.
+func (t CpcBid) MarshalJSON() ([]byte, error) {
+	type plain CpcBid
+	v := plain(t)
+
+	b0 := new(Bids)
+	if v.Bids != nil {
+		*b0 = *v.Bids
+	}
+	if b0.BidsType == nil {
+		b0.BidsType = String("CpcBid")
+	}
+	v.Bids = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CpmBid" as the
+// concrete type in the discriminator of its base types.
+func (t CpmBid) MarshalJSON() ([]byte, error) {
+	type plain CpmBid
+	v := plain(t)
+
+	b0 := new(Bids)
+	if v.Bids != nil {
+		*b0 = *v.Bids
+	}
+	if b0.BidsType == nil {
+		b0.BidsType = String("CpmBid")
+	}
+	v.Bids = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionError" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionError) MarshalJSON() ([]byte, error) {
+	type plain CriterionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CriterionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionPolicyError" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionPolicyError) MarshalJSON() ([]byte, error) {
+	type plain CriterionPolicyError
+	v := plain(t)
+
+	b0 := new(PolicyViolationError)
+	if v.PolicyViolationError != nil {
+		*b0 = *v.PolicyViolationError
+	}
+	v.PolicyViolationError = b0
+
+	b1 := new(ApiError)
+	if b0.ApiError != nil {
+		*b1 = *b0.ApiError
+	}
+	if b1.ApiErrorType == nil {
+		b1.ApiErrorType = String("CriterionPolicyError")
+	}
+	b0.ApiError = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DateError" as the
+// concrete type in the discriminator of its base types.
+func (t DateError) MarshalJSON() ([]byte, error) {
+	type plain DateError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DateError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DoubleValue" as the
+// concrete type in the discriminator of its base types.
+func (t DoubleValue) MarshalJSON() ([]byte, error) {
+	type plain DoubleValue
+	v := plain(t)
+
+	b0 := new(NumberValue)
+	if v.NumberValue != nil {
+		*b0 = *v.NumberValue
+	}
+	v.NumberValue = b0
+
+	b1 := new(ComparableValue)
+	if b0.ComparableValue != nil {
+		*b1 = *b0.ComparableValue
+	}
+	if b1.ComparableValueType == nil {
+		b1.ComparableValueType = String("DoubleValue")
+	}
+	b0.ComparableValue = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EnhancedCpcBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t EnhancedCpcBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain EnhancedCpcBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("EnhancedCpcBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t EntityAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain EntityAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityNotFound" as the
+// concrete type in the discriminator of its base types.
+func (t EntityNotFound) MarshalJSON() ([]byte, error) {
+	type plain EntityNotFound
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityNotFound")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ForwardCompatibilityError" as the
+// concrete type in the discriminator of its base types.
+func (t ForwardCompatibilityError) MarshalJSON() ([]byte, error) {
+	type plain ForwardCompatibilityError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ForwardCompatibilityError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Gender" as the
+// concrete type in the discriminator of its base types.
+func (t Gender) MarshalJSON() ([]byte, error) {
+	type plain Gender
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Gender")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IncomeRange" as the
+// concrete type in the discriminator of its base types.
+func (t IncomeRange) MarshalJSON() ([]byte, error) {
+	type plain IncomeRange
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("IncomeRange")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Keyword" as the
+// concrete type in the discriminator of its base types.
+func (t Keyword) MarshalJSON() ([]byte, error) {
+	type plain Keyword
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Keyword")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LongValue" as the
+// concrete type in the discriminator of its base types.
+func (t LongValue) MarshalJSON() ([]byte, error) {
+	type plain LongValue
+	v := plain(t)
+
+	b0 := new(NumberValue)
+	if v.NumberValue != nil {
+		*b0 = *v.NumberValue
+	}
+	v.NumberValue = b0
+
+	b1 := new(ComparableValue)
+	if b0.ComparableValue != nil {
+		*b1 = *b0.ComparableValue
+	}
+	if b1.ComparableValueType == nil {
+		b1.ComparableValueType = String("LongValue")
+	}
+	b0.ComparableValue = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ManualCpcBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t ManualCpcBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain ManualCpcBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("ManualCpcBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ManualCpmBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t ManualCpmBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain ManualCpmBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("ManualCpmBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MaximizeConversionValueBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t MaximizeConversionValueBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain MaximizeConversionValueBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("MaximizeConversionValueBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MaximizeConversionsBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t MaximizeConversionsBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain MaximizeConversionsBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("MaximizeConversionsBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileAppCategory" as the
+// concrete type in the discriminator of its base types.
+func (t MobileAppCategory) MarshalJSON() ([]byte, error) {
+	type plain MobileAppCategory
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileAppCategory")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileApplication" as the
+// concrete type in the discriminator of its base types.
+func (t MobileApplication) MarshalJSON() ([]byte, error) {
+	type plain MobileApplication
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileApplication")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Money" as the
+// concrete type in the discriminator of its base types.
+func (t Money) MarshalJSON() ([]byte, error) {
+	type plain Money
+	v := plain(t)
+
+	b0 := new(ComparableValue)
+	if v.ComparableValue != nil {
+		*b0 = *v.ComparableValue
+	}
+	if b0.ComparableValueType == nil {
+		b0.ComparableValueType = String("Money")
+	}
+	v.ComparableValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MultiplierError" as the
+// concrete type in the discriminator of its base types.
+func (t MultiplierError) MarshalJSON() ([]byte, error) {
+	type plain MultiplierError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("MultiplierError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NegativeAdGroupCriterion" as the
+// concrete type in the discriminator of its base types.
+func (t NegativeAdGroupCriterion) MarshalJSON() ([]byte, error) {
+	type plain NegativeAdGroupCriterion
+	v := plain(t)
+
+	b0 := new(AdGroupCriterion)
+	if v.AdGroupCriterion != nil {
+		*b0 = *v.AdGroupCriterion
+	}
+	if b0.AdGroupCriterionType == nil {
+		b0.AdGroupCriterionType = String("NegativeAdGroupCriterion")
+	}
+	v.AdGroupCriterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NewEntityCreationError" as the
+// concrete type in the discriminator of its base types.
+func (t NewEntityCreationError) MarshalJSON() ([]byte, error) {
+	type plain NewEntityCreationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NewEntityCreationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NullError" as the
+// concrete type in the discriminator of its base types.
+func (t NullError) MarshalJSON() ([]byte, error) {
+	type plain NullError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NullError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PageOnePromotedBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t PageOnePromotedBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain PageOnePromotedBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("PageOnePromotedBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PagingError" as the
+// concrete type in the discriminator of its base types.
+func (t PagingError) MarshalJSON() ([]byte, error) {
+	type plain PagingError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("PagingError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Parent" as the
+// concrete type in the discriminator of its base types.
+func (t Parent) MarshalJSON() ([]byte, error) {
+	type plain Parent
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Parent")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Placement" as the
+// concrete type in the discriminator of its base types.
+func (t Placement) MarshalJSON() ([]byte, error) {
+	type plain Placement
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Placement")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductAdwordsGrouping" as the
+// concrete type in the discriminator of its base types.
+func (t ProductAdwordsGrouping) MarshalJSON() ([]byte, error) {
+	type plain ProductAdwordsGrouping
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductAdwordsGrouping")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductAdwordsLabels" as the
+// concrete type in the discriminator of its base types.
+func (t ProductAdwordsLabels) MarshalJSON() ([]byte, error) {
+	type plain ProductAdwordsLabels
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductAdwordsLabels")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductBiddingCategory" as the
+// concrete type in the discriminator of its base types.
+func (t ProductBiddingCategory) MarshalJSON() ([]byte, error) {
+	type plain ProductBiddingCategory
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductBiddingCategory")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductBrand" as the
+// concrete type in the discriminator of its base types.
+func (t ProductBrand) MarshalJSON() ([]byte, error) {
+	type plain ProductBrand
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductBrand")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductCanonicalCondition" as the
+// concrete type in the discriminator of its base types.
+func (t ProductCanonicalCondition) MarshalJSON() ([]byte, error) {
+	type plain ProductCanonicalCondition
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductCanonicalCondition")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductChannel" as the
+// concrete type in the discriminator of its base types.
+func (t ProductChannel) MarshalJSON() ([]byte, error) {
+	type plain ProductChannel
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductChannel")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductChannelExclusivity" as the
+// concrete type in the discriminator of its base types.
+func (t ProductChannelExclusivity) MarshalJSON() ([]byte, error) {
+	type plain ProductChannelExclusivity
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductChannelExclusivity")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductLegacyCondition" as the
+// concrete type in the discriminator of its base types.
+func (t ProductLegacyCondition) MarshalJSON() ([]byte, error) {
+	type plain ProductLegacyCondition
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductLegacyCondition")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductCustomAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t ProductCustomAttribute) MarshalJSON() ([]byte, error) {
+	type plain ProductCustomAttribute
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductCustomAttribute")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductOfferId" as the
+// concrete type in the discriminator of its base types.
+func (t ProductOfferId) MarshalJSON() ([]byte, error) {
+	type plain ProductOfferId
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductOfferId")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductPartition" as the
+// concrete type in the discriminator of its base types.
+func (t ProductPartition) MarshalJSON() ([]byte, error) {
+	type plain ProductPartition
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("ProductPartition")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductType" as the
+// concrete type in the discriminator of its base types.
+func (t ProductType) MarshalJSON() ([]byte, error) {
+	type plain ProductType
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductType")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductTypeFull" as the
+// concrete type in the discriminator of its base types.
+func (t ProductTypeFull) MarshalJSON() ([]byte, error) {
+	type plain ProductTypeFull
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductTypeFull")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QueryError" as the
+// concrete type in the discriminator of its base types.
+func (t QueryError) MarshalJSON() ([]byte, error) {
+	type plain QueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SelectorError" as the
+// concrete type in the discriminator of its base types.
+func (t SelectorError) MarshalJSON() ([]byte, error) {
+	type plain SelectorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SelectorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StatsQueryError" as the
+// concrete type in the discriminator of its base types.
+func (t StatsQueryError) MarshalJSON() ([]byte, error) {
+	type plain StatsQueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StatsQueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetCpaBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t TargetCpaBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain TargetCpaBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("TargetCpaBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetOutrankShareBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t TargetOutrankShareBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain TargetOutrankShareBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("TargetOutrankShareBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetRoasBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t TargetRoasBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain TargetRoasBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("TargetRoasBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetSpendBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t TargetSpendBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain TargetSpendBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("TargetSpendBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UnknownProductDimension" as the
+// concrete type in the discriminator of its base types.
+func (t UnknownProductDimension) MarshalJSON() ([]byte, error) {
+	type plain UnknownProductDimension
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("UnknownProductDimension")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UrlError" as the
+// concrete type in the discriminator of its base types.
+func (t UrlError) MarshalJSON() ([]byte, error) {
+	type plain UrlError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("UrlError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserInterest" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserInterest) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserInterest
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserInterest")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserList" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserList) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserList
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserList")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Vertical" as the
+// concrete type in the discriminator of its base types.
+func (t Vertical) MarshalJSON() ([]byte, error) {
+	type plain Vertical
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Vertical")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Webpage" as the
+// concrete type in the discriminator of its base types.
+func (t Webpage) MarshalJSON() ([]byte, error) {
+	type plain Webpage
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Webpage")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "WebpageParameter" as the
+// concrete type in the discriminator of its base types.
+func (t WebpageParameter) MarshalJSON() ([]byte, error) {
+	type plain WebpageParameter
+	v := plain(t)
+
+	b0 := new(CriterionParameter)
+	if v.CriterionParameter != nil {
+		*b0 = *v.CriterionParameter
+	}
+	if b0.CriterionParameterType == nil {
+		b0.CriterionParameterType = String("WebpageParameter")
+	}
+	v.CriterionParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "YouTubeChannel" as the
+// concrete type in the discriminator of its base types.
+func (t YouTubeChannel) MarshalJSON() ([]byte, error) {
+	type plain YouTubeChannel
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("YouTubeChannel")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "YouTubeVideo" as the
+// concrete type in the discriminator of its base types.
+func (t YouTubeVideo) MarshalJSON() ([]byte, error) {
+	type plain YouTubeVideo
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("YouTubeVideo")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
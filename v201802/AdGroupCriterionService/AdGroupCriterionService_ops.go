@@ -0,0 +1,33 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupCriterionService
+
+// AddLabelOp returns the ADD operation of operand.
+func AddLabelOp(operand *AdGroupCriterionLabel) *AdGroupCriterionLabelOperation {
+	op := OperatorADD
+	return &AdGroupCriterionLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveLabelOp returns the REMOVE operation of operand.
+func RemoveLabelOp(operand *AdGroupCriterionLabel) *AdGroupCriterionLabelOperation {
+	op := OperatorREMOVE
+	return &AdGroupCriterionLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *AdGroupCriterion) *AdGroupCriterionOperation {
+	op := OperatorADD
+	return &AdGroupCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AdGroupCriterion) *AdGroupCriterionOperation {
+	op := OperatorSET
+	return &AdGroupCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *AdGroupCriterion) *AdGroupCriterionOperation {
+	op := OperatorREMOVE
+	return &AdGroupCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,357 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupCriterionService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks MutateLabel against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *MutateLabel) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Query against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Query) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks AdGroupCriterionLabelOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *AdGroupCriterionLabelOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks AdGroupCriterionOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *AdGroupCriterionOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "serviceSelector"), t.ServiceSelector != nil)
+	if t.ServiceSelector != nil {
+		t.ServiceSelector.validate(v, validate.Field(path, "serviceSelector"), op)
+	}
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "ADD", "SET", "REMOVE")
+		}
+		v.DistinctIds(validate.Field(path, "operations"), operators, ids, "REMOVE", "SET")
+	}
+}
+
+func (t *MutateLabel) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(MutateLabel)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "ADD", "REMOVE")
+		}
+		v.DistinctIds(validate.Field(path, "operations"), operators, ids, "ADD", "REMOVE")
+	}
+}
+
+func (t *Query) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Query)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *AdGroupCriterion) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdGroupCriterion)
+	}
+	v.Required(validate.Field(path, "adGroupId"), t.AdGroupId != nil)
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "criterionUse"), op, t.CriterionUse != nil)
+	}
+	v.Required(validate.Field(path, "criterion"), t.Criterion != nil)
+	if t.Criterion != nil {
+		t.Criterion.validate(v, validate.Field(path, "criterion"), op)
+	}
+	for i, e := range t.Labels {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "labels"), i), op)
+		}
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "baseCampaignId"), op, t.BaseCampaignId != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "baseAdGroupId"), op, t.BaseAdGroupId != nil)
+	}
+}
+
+func (t *AdGroupCriterionLabel) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdGroupCriterionLabel)
+	}
+	if validate.HasOperator(op, "ADD", "REMOVE") {
+		v.Required(validate.Field(path, "adGroupId"), t.AdGroupId != nil)
+	}
+	if validate.HasOperator(op, "ADD", "REMOVE") {
+		v.Required(validate.Field(path, "criterionId"), t.CriterionId != nil)
+	}
+	if validate.HasOperator(op, "ADD", "REMOVE") {
+		v.Required(validate.Field(path, "labelId"), t.LabelId != nil)
+	}
+}
+
+func (t *AdGroupCriterionLabelOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdGroupCriterionLabelOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	if validate.HasOperator(op, "SET") {
+		v.Required(validate.Field(validate.Field(path, "operand"), "adGroupId"), t.Operand != nil && t.Operand.AdGroupId != nil)
+	}
+	if validate.HasOperator(op, "SET") {
+		v.Required(validate.Field(validate.Field(path, "operand"), "labelId"), t.Operand != nil && t.Operand.LabelId != nil)
+	}
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *AdGroupCriterionOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdGroupCriterionOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+	for i, e := range t.ExemptionRequests {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "exemptionRequests"), i), op)
+		}
+	}
+}
+
+func (t *Criterion) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Criterion)
+	}
+	if validate.HasOperator(op, "REMOVE", "SET") {
+		v.Required(validate.Field(path, "id"), t.Id != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "type"), op, t.Type_ != nil)
+	}
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *ExemptionRequest) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(ExemptionRequest)
+	}
+	v.Required(validate.Field(path, "key"), t.Key != nil)
+	if t.Key != nil {
+		t.Key.validate(v, validate.Field(path, "key"), op)
+	}
+}
+
+func (t *Label) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Label)
+	}
+	if validate.HasOperator(op, "REMOVE", "SET") {
+		v.Required(validate.Field(path, "id"), t.Id != nil)
+	}
+	if t.Name != nil {
+		v.StringLength(validate.Field(path, "name"), *t.Name, 1, 80, false, false)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "status"), op, t.Status != nil)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *PolicyViolationKey) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(PolicyViolationKey)
+	}
+	v.Required(validate.Field(path, "policyName"), t.PolicyName != nil)
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
+
+func (o *AdGroupCriterionLabelOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *AdGroupCriterionLabelOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	return validate.ID(t.AdGroupId, t.CriterionId, t.LabelId)
+}
+
+func (o *AdGroupCriterionOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *AdGroupCriterionOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	var criterionID *int64
+	if t.Criterion != nil {
+		criterionID = t.Criterion.Id
+	}
+	return validate.ID(t.AdGroupId, criterionID)
+}
@@ -2943,22 +2943,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Selector *Selector `xml:"selector,omitempty"`
+	Selector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 selector,omitempty" json:"selector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse" json:"-"`
 
-	Rval *AdGroupExtensionSettingPage `xml:"rval,omitempty"`
+	Rval *AdGroupExtensionSettingPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -2966,528 +2966,468 @@ type Mutate struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operations []*AdGroupExtensionSettingOperation `xml:"operations,omitempty"`
+	Operations []*AdGroupExtensionSettingOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse" json:"-"`
 
-	Rval *AdGroupExtensionSettingReturnValue `xml:"rval,omitempty"`
+	Rval *AdGroupExtensionSettingReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Query struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse" json:"-"`
 
-	Rval *AdGroupExtensionSettingPage `xml:"rval,omitempty"`
+	Rval *AdGroupExtensionSettingPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdGroupExtensionSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupExtensionSetting"`
-
 	//
 	// The id of the ad group for the feed items being added or modified.
 	// <span class="constraint Selectable">This field can be selected using the value "AdGroupId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// The extension type the extension setting applies to.
 	// <span class="constraint Selectable">This field can be selected using the value "ExtensionType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ExtensionType *FeedType `xml:"extensionType,omitempty"`
+	ExtensionType *FeedType `xml:"https://adwords.google.com/api/adwords/cm/v201802 extensionType,omitempty" json:"extensionType,omitempty"`
 
 	//
 	// The extension setting specifying which extensions to serve for the specified ad group. If
 	// extensionSetting is empty (i.e. has an empty list of feed items and null platformRestrictions),
 	// extensions are disabled for the specified extensionType.
 	//
-	ExtensionSetting *ExtensionSetting `xml:"extensionSetting,omitempty"`
+	ExtensionSetting *ExtensionSetting `xml:"https://adwords.google.com/api/adwords/cm/v201802 extensionSetting,omitempty" json:"extensionSetting,omitempty"`
 }
 
 type AdGroupExtensionSettingOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupExtensionSettingOperation"`
-
 	*Operation
 
 	//
 	// AdGroupExtensionSetting to operate on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *AdGroupExtensionSetting `xml:"operand,omitempty"`
+	Operand *AdGroupExtensionSetting `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type AdGroupExtensionSettingPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupExtensionSettingPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*AdGroupExtensionSetting `xml:"entries,omitempty"`
+	Entries []*AdGroupExtensionSetting `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type AdGroupExtensionSettingReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupExtensionSettingReturnValue"`
-
 	*ListReturnValue
 
 	//
 	// The resulting AdGroupExtensionSettings.
 	//
-	Value []*AdGroupExtensionSetting `xml:"value,omitempty"`
+	Value []*AdGroupExtensionSetting `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type AppFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AppFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
 	// The application store that the target application belongs to.
 	//
-	AppStore *AppFeedItemAppStore `xml:"appStore,omitempty"`
+	AppStore *AppFeedItemAppStore `xml:"https://adwords.google.com/api/adwords/cm/v201802 appStore,omitempty" json:"appStore,omitempty"`
 
 	//
 	// The store-specific ID for the target application.
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// The visible text displayed when the link is rendered in an ad.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	AppLinkText string `xml:"appLinkText,omitempty"`
+	AppLinkText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appLinkText,omitempty" json:"appLinkText,omitempty"`
 
 	//
 	// The destination URL of the in-app link.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 2076, inclusive, (trimmed).</span>
 	//
-	AppUrl string `xml:"appUrl,omitempty"`
+	AppUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appUrl,omitempty" json:"appUrl,omitempty"`
 
 	//
 	// A list of possible final URLs after all cross domain redirects.
 	//
-	AppFinalUrls *UrlList `xml:"appFinalUrls,omitempty"`
+	AppFinalUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 appFinalUrls,omitempty" json:"appFinalUrls,omitempty"`
 
 	//
 	// A list of possible final mobile URLs after all cross domain redirects.
 	//
-	AppFinalMobileUrls *UrlList `xml:"appFinalMobileUrls,omitempty"`
+	AppFinalMobileUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 appFinalMobileUrls,omitempty" json:"appFinalMobileUrls,omitempty"`
 
 	//
 	// URL template for constructing a tracking URL. To clear this field, set its value to the empty
 	// string.
 	//
-	AppTrackingUrlTemplate string `xml:"appTrackingUrlTemplate,omitempty"`
+	AppTrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appTrackingUrlTemplate,omitempty" json:"appTrackingUrlTemplate,omitempty"`
 
 	//
 	// A list of mappings to be used for substituting URL custom parameter tags in the
 	// trackingUrlTemplate, finalUrls, and/or finalMobileUrls.
 	//
-	AppUrlCustomParameters *CustomParameters `xml:"appUrlCustomParameters,omitempty"`
+	AppUrlCustomParameters *CustomParameters `xml:"https://adwords.google.com/api/adwords/cm/v201802 appUrlCustomParameters,omitempty" json:"appUrlCustomParameters,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CallConversionType struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CallConversionType"`
-
 	//
 	// The ID of an AdCallMetricsConversion object. This object contains the phoneCallDuration field
 	// which is the minimum duration (in seconds) of a call to be considered a conversion.
 	//
-	ConversionTypeId int64 `xml:"conversionTypeId,omitempty"`
+	ConversionTypeId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 conversionTypeId,omitempty" json:"conversionTypeId,omitempty"`
 }
 
 type CallFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CallFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
 	// The advertiser's phone number to append to the ad.
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	CallPhoneNumber string `xml:"callPhoneNumber,omitempty"`
+	CallPhoneNumber *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 callPhoneNumber,omitempty" json:"callPhoneNumber,omitempty"`
 
 	//
 	// Uppercase two-letter country code of the advertiser's phone number.
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	CallCountryCode string `xml:"callCountryCode,omitempty"`
+	CallCountryCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 callCountryCode,omitempty" json:"callCountryCode,omitempty"`
 
 	//
 	// Indicates whether call tracking is enabled. By default, call tracking is not enabled.
 	//
-	CallTracking bool `xml:"callTracking,omitempty"`
+	CallTracking *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 callTracking,omitempty" json:"callTracking,omitempty"`
 
 	//
 	// Call conversion type. To clear this field, set a CallConversionType with a value of null in its
 	// conversionTypeId field. This value should not be set if
 	// {@linkPlain CallFeedItem#disableCallConversionTracking} is true.
 	//
-	CallConversionType *CallConversionType `xml:"callConversionType,omitempty"`
+	CallConversionType *CallConversionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 callConversionType,omitempty" json:"callConversionType,omitempty"`
 
 	//
 	// If set, disable call conversion tracking. {@linkPlain CallFeedItem#callConversionType} should
 	// not be set if this value is true.
 	//
-	DisableCallConversionTracking bool `xml:"disableCallConversionTracking,omitempty"`
+	DisableCallConversionTracking *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 disableCallConversionTracking,omitempty" json:"disableCallConversionTracking,omitempty"`
 }
 
 type CalloutFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CalloutFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
 	// The callout text.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	CalloutText string `xml:"calloutText,omitempty"`
+	CalloutText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 calloutText,omitempty" json:"calloutText,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CollectionSizeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CollectionSizeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CollectionSizeErrorReason `xml:"reason,omitempty"`
+	Reason *CollectionSizeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type Criterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion"`
-
 	//
 	// ID of this criterion.
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Type_ *CriterionType `xml:"type,omitempty"`
+	Type_ *CriterionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Criterion.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CriterionType string `xml:"Criterion.Type,omitempty"`
+	CriterionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion.Type,omitempty" json:"criterionType,omitempty"`
 }
 
 type CriterionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionError"`
-
 	*ApiError
 
-	Reason *CriterionErrorReason `xml:"reason,omitempty"`
+	Reason *CriterionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CustomParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameter"`
-
 	//
 	// The parameter key to be mapped.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 16, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// The value this parameter should be mapped to. It should be null if isRemove is true.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// On SET operation, indicates that the parameter should be removed from the existing parameters.
 	// If set to true, the value field must be null.
 	//
-	IsRemove bool `xml:"isRemove,omitempty"`
+	IsRemove *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isRemove,omitempty" json:"isRemove,omitempty"`
 }
 
 type CustomParameters struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameters"`
-
 	//
 	// The list of custom parameters.
 	//
 	// <p>On update, all parameters can be cleared by providing an empty or null list and setting
 	// doReplace to true.
 	//
-	Parameters []*CustomParameter `xml:"parameters,omitempty"`
+	Parameters []*CustomParameter `xml:"https://adwords.google.com/api/adwords/cm/v201802 parameters,omitempty" json:"parameters,omitempty"`
 
 	//
 	// On SET operation, indicates that the current parameters should be cleared and replaced
 	// with these parameters.
 	//
-	DoReplace bool `xml:"doReplace,omitempty"`
+	DoReplace *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 doReplace,omitempty" json:"doReplace,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DisapprovalReason struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DisapprovalReason"`
-
 	//
 	// Short description of the disapproval reason, localized for the specific advertiser.
 	//
-	ShortName string `xml:"shortName,omitempty"`
+	ShortName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 shortName,omitempty" json:"shortName,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type EntityAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityAccessDenied"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *EntityAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type EntityCountLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityCountLimitExceeded"`
-
 	*ApiError
 
 	//
 	// Specifies which level's limit was exceeded.
 	//
-	Reason *EntityCountLimitExceededReason `xml:"reason,omitempty"`
+	Reason *EntityCountLimitExceededReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Id of the entity whose limit was exceeded.
 	//
-	EnclosingId string `xml:"enclosingId,omitempty"`
+	EnclosingId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 enclosingId,omitempty" json:"enclosingId,omitempty"`
 
 	//
 	// The limit which was exceeded.
 	//
-	Limit int32 `xml:"limit,omitempty"`
+	Limit *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 limit,omitempty" json:"limit,omitempty"`
 
 	//
 	// The account limit type which was exceeded.
 	//
-	AccountLimitType string `xml:"accountLimitType,omitempty"`
+	AccountLimitType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 accountLimitType,omitempty" json:"accountLimitType,omitempty"`
 
 	//
 	// The count of existing entities.
 	//
-	ExistingCount int32 `xml:"existingCount,omitempty"`
+	ExistingCount *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 existingCount,omitempty" json:"existingCount,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ExtensionFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExtensionFeedItem"`
-
 	//
 	// Id of this feed item's feed.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	FeedId int64 `xml:"feedId,omitempty"`
+	FeedId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedId,omitempty" json:"feedId,omitempty"`
 
 	//
 	// Id of the feed item.
 	//
-	FeedItemId int64 `xml:"feedItemId,omitempty"`
+	FeedItemId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedItemId,omitempty" json:"feedItemId,omitempty"`
 
 	//
 	// Status of the feed item.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Status *FeedItemStatus `xml:"status,omitempty"`
+	Status *FeedItemStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// The type of the feed containing this extension feed item data. The field will be set by a
 	// subclass with a defined feed type.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	FeedType *FeedType `xml:"feedType,omitempty"`
+	FeedType *FeedType `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedType,omitempty" json:"feedType,omitempty"`
 
 	//
 	// Start time in which this feed item is effective and can begin serving. The time zone
@@ -3497,7 +3437,7 @@ type ExtensionFeedItem struct {
 	// The special value "00000101 000000" may be used to clear an existing start time.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	StartTime string `xml:"startTime,omitempty"`
+	StartTime *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 startTime,omitempty" json:"startTime,omitempty"`
 
 	//
 	// End time in which this feed item is no longer effective and will stop serving. The time zone
@@ -3507,12 +3447,12 @@ type ExtensionFeedItem struct {
 	// The special value "00000101 000000" may be used to clear an existing end time.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	EndTime string `xml:"endTime,omitempty"`
+	EndTime *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 endTime,omitempty" json:"endTime,omitempty"`
 
 	//
 	// Device preference for the feed item.
 	//
-	DevicePreference *FeedItemDevicePreference `xml:"devicePreference,omitempty"`
+	DevicePreference *FeedItemDevicePreference `xml:"https://adwords.google.com/api/adwords/cm/v201802 devicePreference,omitempty" json:"devicePreference,omitempty"`
 
 	//
 	// FeedItemScheduling specifying times for when the feed item may serve.
@@ -3524,7 +3464,7 @@ type ExtensionFeedItem struct {
 	// list, the scheduling will be cleared of all FeedItemSchedules indicating the feed item
 	// has no scheduling restrictions.
 	//
-	Scheduling *FeedItemScheduling `xml:"scheduling,omitempty"`
+	Scheduling *FeedItemScheduling `xml:"https://adwords.google.com/api/adwords/cm/v201802 scheduling,omitempty" json:"scheduling,omitempty"`
 
 	//
 	// Campaign targeting specifying what campaign this extension can serve with.
@@ -3537,7 +3477,7 @@ type ExtensionFeedItem struct {
 	// If only adGroupTargeting is specified and there is no campaignTargeting, then a
 	// campaignTargeting will be set to the targeted adgroup's campaign.
 	//
-	CampaignTargeting *FeedItemCampaignTargeting `xml:"campaignTargeting,omitempty"`
+	CampaignTargeting *FeedItemCampaignTargeting `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignTargeting,omitempty" json:"campaignTargeting,omitempty"`
 
 	//
 	// Adgroup targeting specifying what adgroup this extension can serve with.
@@ -3550,7 +3490,7 @@ type ExtensionFeedItem struct {
 	// If only adGroupTargeting is specified and there is no campaignTargeting, then a
 	// campaignTargeting will be set to the targeted adgroup's campaign.
 	//
-	AdGroupTargeting *FeedItemAdGroupTargeting `xml:"adGroupTargeting,omitempty"`
+	AdGroupTargeting *FeedItemAdGroupTargeting `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupTargeting,omitempty" json:"adGroupTargeting,omitempty"`
 
 	//
 	// Keyword targeting specifies what keyword this extension can serve with.
@@ -3559,7 +3499,7 @@ type ExtensionFeedItem struct {
 	// On update, if the field is set with a Keyword and without Keyword.text set keyword targeting
 	// will be cleared.
 	//
-	KeywordTargeting *Keyword `xml:"keywordTargeting,omitempty"`
+	KeywordTargeting *Keyword `xml:"https://adwords.google.com/api/adwords/cm/v201802 keywordTargeting,omitempty" json:"keywordTargeting,omitempty"`
 
 	//
 	// Geo targeting specifies what locations this extension can serve with.
@@ -3568,7 +3508,7 @@ type ExtensionFeedItem struct {
 	// On update, if the field is set with a null value for criterionId, the geo targeting will be
 	// cleared.
 	//
-	GeoTargeting *Location `xml:"geoTargeting,omitempty"`
+	GeoTargeting *Location `xml:"https://adwords.google.com/api/adwords/cm/v201802 geoTargeting,omitempty" json:"geoTargeting,omitempty"`
 
 	//
 	// Geo targeting restriction specifies the type of location that can be used for targeting.
@@ -3577,30 +3517,28 @@ type ExtensionFeedItem struct {
 	// On update, if the field is set with a null GeoRestriction enum, the geo targeting restriction
 	// will be cleared.
 	//
-	GeoTargetingRestriction *FeedItemGeoRestriction `xml:"geoTargetingRestriction,omitempty"`
+	GeoTargetingRestriction *FeedItemGeoRestriction `xml:"https://adwords.google.com/api/adwords/cm/v201802 geoTargetingRestriction,omitempty" json:"geoTargetingRestriction,omitempty"`
 
 	//
 	// List of details about a feed item's validation and approval state.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PolicyData []*FeedItemPolicyData `xml:"policyData,omitempty"`
+	PolicyData []*FeedItemPolicyData `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyData,omitempty" json:"policyData,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ExtensionFeedItem.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ExtensionFeedItemType string `xml:"ExtensionFeedItem.Type,omitempty"`
+	ExtensionFeedItemType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExtensionFeedItem.Type,omitempty" json:"extensionFeedItemType,omitempty"`
 }
 
 type ExtensionSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExtensionSetting"`
-
 	//
 	// The list of feed items to add or modify.
 	// <span class="constraint Selectable">This field can be selected using the value "Extensions".</span>
 	//
-	Extensions []*ExtensionFeedItem `xml:"extensions,omitempty"`
+	Extensions []*ExtensionFeedItem `xml:"https://adwords.google.com/api/adwords/cm/v201802 extensions,omitempty" json:"extensions,omitempty"`
 
 	//
 	// Any platform (desktop, mobile) restrictions for feed items being served. If set to DESKTOP or
@@ -3608,145 +3546,129 @@ type ExtensionSetting struct {
 	// will serve.
 	// <span class="constraint Selectable">This field can be selected using the value "PlatformRestrictions".</span>
 	//
-	PlatformRestrictions *ExtensionSettingPlatform `xml:"platformRestrictions,omitempty"`
+	PlatformRestrictions *ExtensionSettingPlatform `xml:"https://adwords.google.com/api/adwords/cm/v201802 platformRestrictions,omitempty" json:"platformRestrictions,omitempty"`
 }
 
 type ExtensionSettingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExtensionSettingError"`
-
 	*ApiError
 
 	//
 	// Error reason.
 	//
-	Reason *ExtensionSettingErrorReason `xml:"reason,omitempty"`
+	Reason *ExtensionSettingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FeedItemAdGroupTargeting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedItemAdGroupTargeting"`
-
 	//
 	// The ID of the adgroup to target.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	TargetingAdGroupId int64 `xml:"TargetingAdGroupId,omitempty"`
+	TargetingAdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetingAdGroupId,omitempty" json:"targetingAdGroupId,omitempty"`
 }
 
 type FeedItemAttributeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedItemAttributeError"`
-
 	//
 	// Contains the set of feed attribute ids whose attributes together triggered the error.
 	// Null or empty field means error code does not apply to a specific set of attributes.
 	//
-	FeedAttributeIds []int64 `xml:"feedAttributeIds,omitempty"`
+	FeedAttributeIds []int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedAttributeIds,omitempty" json:"feedAttributeIds,omitempty"`
 
 	//
 	// Validation error code. See the
 	// <a href="/adwords/api/docs/appendix/feed-errors">list of error codes</a>.
 	//
-	ValidationErrorCode int32 `xml:"validationErrorCode,omitempty"`
+	ValidationErrorCode *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 validationErrorCode,omitempty" json:"validationErrorCode,omitempty"`
 
 	//
 	// Extra information about the error, including related field IDs.
 	//
-	ErrorInformation string `xml:"errorInformation,omitempty"`
+	ErrorInformation *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorInformation,omitempty" json:"errorInformation,omitempty"`
 }
 
 type FeedItemCampaignTargeting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedItemCampaignTargeting"`
-
 	//
 	// The ID of the campaign to target.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	TargetingCampaignId int64 `xml:"TargetingCampaignId,omitempty"`
+	TargetingCampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetingCampaignId,omitempty" json:"targetingCampaignId,omitempty"`
 }
 
 type FeedItemDevicePreference struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedItemDevicePreference"`
-
 	//
 	// CriterionId of the type of device the feed item is preferred to serve on.
 	// Only CriterionId 30001 (mobile devices) is currently supported.
 	// If unspecified, the device preference will be cleared indicating that the feed item
 	// is not preferred for any device type.
 	//
-	DevicePreference int64 `xml:"devicePreference,omitempty"`
+	DevicePreference *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 devicePreference,omitempty" json:"devicePreference,omitempty"`
 }
 
 type FeedItemGeoRestriction struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedItemGeoRestriction"`
-
 	//
 	// The geo targeting restriction of a feed item.  If null then the geo restriction is cleared.
 	//
-	GeoRestriction *GeoRestriction `xml:"geoRestriction,omitempty"`
+	GeoRestriction *GeoRestriction `xml:"https://adwords.google.com/api/adwords/cm/v201802 geoRestriction,omitempty" json:"geoRestriction,omitempty"`
 }
 
 type FeedItemPolicyData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedItemPolicyData"`
-
 	*PolicyData
 
 	//
 	// Mapped placeholder type used in validation/approvals checks.
 	//
-	PlaceholderType int32 `xml:"placeholderType,omitempty"`
+	PlaceholderType *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 placeholderType,omitempty" json:"placeholderType,omitempty"`
 
 	//
 	// Id of FeedMapping used in validation/approvals checks.
 	//
-	FeedMappingId int64 `xml:"feedMappingId,omitempty"`
+	FeedMappingId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedMappingId,omitempty" json:"feedMappingId,omitempty"`
 
 	//
 	// Validation status of feed item for a particular feed mapping.
 	//
-	ValidationStatus *FeedItemValidationStatus `xml:"validationStatus,omitempty"`
+	ValidationStatus *FeedItemValidationStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 validationStatus,omitempty" json:"validationStatus,omitempty"`
 
 	//
 	// Feed item approval status.
 	//
-	ApprovalStatus *FeedItemApprovalStatus `xml:"approvalStatus,omitempty"`
+	ApprovalStatus *FeedItemApprovalStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 approvalStatus,omitempty" json:"approvalStatus,omitempty"`
 
 	//
 	// List of error codes specifying what errors were found during validation.
 	//
-	ValidationErrors []*FeedItemAttributeError `xml:"validationErrors,omitempty"`
+	ValidationErrors []*FeedItemAttributeError `xml:"https://adwords.google.com/api/adwords/cm/v201802 validationErrors,omitempty" json:"validationErrors,omitempty"`
 
 	//
 	// Feed item quality evaluation approval status for a particular feed mapping.
 	//
-	QualityApprovalStatus *FeedItemQualityApprovalStatus `xml:"qualityApprovalStatus,omitempty"`
+	QualityApprovalStatus *FeedItemQualityApprovalStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 qualityApprovalStatus,omitempty" json:"qualityApprovalStatus,omitempty"`
 
 	//
 	// Feed item quality evaluation disapproval reasons.
 	//
-	QualityDisapprovalReasons []*FeedItemQualityDisapprovalReasons `xml:"qualityDisapprovalReasons,omitempty"`
+	QualityDisapprovalReasons []*FeedItemQualityDisapprovalReasons `xml:"https://adwords.google.com/api/adwords/cm/v201802 qualityDisapprovalReasons,omitempty" json:"qualityDisapprovalReasons,omitempty"`
 }
 
 type FeedItemSchedule struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedItemSchedule"`
-
 	//
 	// Day of the week the schedule applies to.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	DayOfWeek *DayOfWeek `xml:"dayOfWeek,omitempty"`
+	DayOfWeek *DayOfWeek `xml:"https://adwords.google.com/api/adwords/cm/v201802 dayOfWeek,omitempty" json:"dayOfWeek,omitempty"`
 
 	//
 	// Starting hour in 24 hour time.
 	// <span class="constraint InRange">This field must be between 0 and 23, inclusive.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	StartHour int32 `xml:"startHour,omitempty"`
+	StartHour *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startHour,omitempty" json:"startHour,omitempty"`
 
 	//
 	// Interval starts these minutes after the starting hour.
 	// The value can be 0, 15, 30, and 45.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	StartMinute *MinuteOfHour `xml:"startMinute,omitempty"`
+	StartMinute *MinuteOfHour `xml:"https://adwords.google.com/api/adwords/cm/v201802 startMinute,omitempty" json:"startMinute,omitempty"`
 
 	//
 	// Ending hour in 24 hour time; <code>24</code> signifies
@@ -3754,67 +3676,57 @@ type FeedItemSchedule struct {
 	// <span class="constraint InRange">This field must be between 0 and 24, inclusive.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	EndHour int32 `xml:"endHour,omitempty"`
+	EndHour *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 endHour,omitempty" json:"endHour,omitempty"`
 
 	//
 	// Interval ends these minutes after the ending hour.
 	// The value can be 0, 15, 30, and 45.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	EndMinute *MinuteOfHour `xml:"endMinute,omitempty"`
+	EndMinute *MinuteOfHour `xml:"https://adwords.google.com/api/adwords/cm/v201802 endMinute,omitempty" json:"endMinute,omitempty"`
 }
 
 type FeedItemScheduling struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FeedItemScheduling"`
-
 	//
 	// List of non-overlapping feed item schedules indicating when the feed item may serve.
 	// There can be a maximum of 6 FeedItemSchedules per day.
 	// If empty, the scheduling will be cleared of all FeedItemSchedules indicating the feed item
 	// has no scheduling restrictions.
 	//
-	FeedItemSchedules []*FeedItemSchedule `xml:"feedItemSchedules,omitempty"`
+	FeedItemSchedules []*FeedItemSchedule `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedItemSchedules,omitempty" json:"feedItemSchedules,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Keyword struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Keyword"`
-
 	*Criterion
 
 	//
@@ -3822,29 +3734,25 @@ type Keyword struct {
 	// <span class="constraint MatchesRegex">Keyword text must not contain NUL (code point 0x0) characters. This is checked by the regular expression '[^\x00]*'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Text string `xml:"text,omitempty"`
+	Text *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 text,omitempty" json:"text,omitempty"`
 
 	//
 	// Match type of this keyword.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MatchType *KeywordMatchType `xml:"matchType,omitempty"`
+	MatchType *KeywordMatchType `xml:"https://adwords.google.com/api/adwords/cm/v201802 matchType,omitempty" json:"matchType,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type Location struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Location"`
-
 	*Criterion
 
 	//
@@ -3853,77 +3761,71 @@ type Location struct {
 	// characters.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	LocationName string `xml:"locationName,omitempty"`
+	LocationName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 locationName,omitempty" json:"locationName,omitempty"`
 
 	//
 	// Display type of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayType string `xml:"displayType,omitempty"`
+	DisplayType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayType,omitempty" json:"displayType,omitempty"`
 
 	//
 	// The targeting status of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	TargetingStatus *LocationTargetingStatus `xml:"targetingStatus,omitempty"`
+	TargetingStatus *LocationTargetingStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetingStatus,omitempty" json:"targetingStatus,omitempty"`
 
 	//
 	// Ordered list of parents of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ParentLocations []*Location `xml:"parentLocations,omitempty"`
+	ParentLocations []*Location `xml:"https://adwords.google.com/api/adwords/cm/v201802 parentLocations,omitempty" json:"parentLocations,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type MessageFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MessageFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
 	// The business name of the message. This will be prepended to the message text.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	MessageBusinessName string `xml:"messageBusinessName,omitempty"`
+	MessageBusinessName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 messageBusinessName,omitempty" json:"messageBusinessName,omitempty"`
 
 	//
 	// Uppercase two-letter country code of the advertiser's phone number to message.
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	MessageCountryCode string `xml:"messageCountryCode,omitempty"`
+	MessageCountryCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 messageCountryCode,omitempty" json:"messageCountryCode,omitempty"`
 
 	//
 	// The advertiser's phone number the message will be sent to.
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	MessagePhoneNumber string `xml:"messagePhoneNumber,omitempty"`
+	MessagePhoneNumber *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 messagePhoneNumber,omitempty" json:"messagePhoneNumber,omitempty"`
 
 	//
 	// The text to show in the ad.
 	// <span class="constraint StringLength">The length of this string should be between 5 and 35, inclusive, (trimmed).</span>
 	//
-	MessageExtensionText string `xml:"messageExtensionText,omitempty"`
+	MessageExtensionText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 messageExtensionText,omitempty" json:"messageExtensionText,omitempty"`
 
 	//
 	// The message text populated in the messaging app.
 	// <span class="constraint StringLength">The length of this string should be between 10 and 100, inclusive, (trimmed).</span>
 	//
-	MessageText string `xml:"messageText,omitempty"`
+	MessageText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 messageText,omitempty" json:"messageText,omitempty"`
 }
 
 type MobileAppCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileAppCategory"`
-
 	*Criterion
 
 	//
@@ -3931,18 +3833,16 @@ type MobileAppCategory struct {
 	// available <a href="/adwords/api/docs/appendix/mobileappcategories">here</a>.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MobileAppCategoryId int32 `xml:"mobileAppCategoryId,omitempty"`
+	MobileAppCategoryId *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 mobileAppCategoryId,omitempty" json:"mobileAppCategoryId,omitempty"`
 
 	//
 	// Name of this mobile app category.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type MobileApplication struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileApplication"`
-
 	*Criterion
 
 	//
@@ -3960,29 +3860,25 @@ type MobileApplication struct {
 	// "2-com.labpixies.colordrips" for Android.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// Title of this mobile application.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type MoneyWithCurrency struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MoneyWithCurrency"`
-
 	*ComparableValue
 
 	//
@@ -3990,141 +3886,119 @@ type MoneyWithCurrency struct {
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, ADD.</span>
 	//
-	Money *Money `xml:"money,omitempty"`
+	Money *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 money,omitempty" json:"money,omitempty"`
 
 	//
 	// Currency code.
 	// <span class="constraint StringLength">The length of this string should be between 3 and 3, inclusive, (trimmed).</span>
 	//
-	CurrencyCode string `xml:"currencyCode,omitempty"`
+	CurrencyCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 currencyCode,omitempty" json:"currencyCode,omitempty"`
 }
 
 type NewEntityCreationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NewEntityCreationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NewEntityCreationErrorReason `xml:"reason,omitempty"`
+	Reason *NewEntityCreationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Placement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Placement"`
-
 	*Criterion
 
 	//
@@ -4133,28 +4007,24 @@ type Placement struct {
 	// <p>For example, "http://www.domain.com".
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 }
 
 type PolicyData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyData"`
-
 	//
 	// List of disapproval reasons attached to the entity.
 	//
-	DisapprovalReasons []*DisapprovalReason `xml:"disapprovalReasons,omitempty"`
+	DisapprovalReasons []*DisapprovalReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 disapprovalReasons,omitempty" json:"disapprovalReasons,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of PolicyData.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PolicyDataType string `xml:"PolicyData.Type,omitempty"`
+	PolicyDataType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyData.Type,omitempty" json:"policyDataType,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -4162,13 +4032,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -4177,29 +4047,27 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type PriceFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PriceFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
 	// Price extension type of this extension. Required.
 	//
-	PriceExtensionType *PriceExtensionType `xml:"priceExtensionType,omitempty"`
+	PriceExtensionType *PriceExtensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 priceExtensionType,omitempty" json:"priceExtensionType,omitempty"`
 
 	//
 	// Price qualifier for all rows of this price extension.
 	//
-	PriceQualifier *PriceExtensionPriceQualifier `xml:"priceQualifier,omitempty"`
+	PriceQualifier *PriceExtensionPriceQualifier `xml:"https://adwords.google.com/api/adwords/cm/v201802 priceQualifier,omitempty" json:"priceQualifier,omitempty"`
 
 	//
 	// Tracking URL template for all rows of this price extension. To clear this field, set its value
 	// to an empty string.
 	//
-	TrackingUrlTemplate string `xml:"trackingUrlTemplate,omitempty"`
+	TrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
 
 	//
 	// The language the advertiser is using for this price extension. Required.
@@ -4220,130 +4088,126 @@ type PriceFeedItem struct {
 	// <li>sv</li>
 	// </ul>
 	//
-	Language string `xml:"language,omitempty"`
+	Language *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 language,omitempty" json:"language,omitempty"`
 
 	//
 	// The rows in this price extension. Minimum number of items allowed is 3 and the maximum number
 	// is 8.
 	//
-	TableRows []*PriceTableRow `xml:"tableRows,omitempty"`
+	TableRows []*PriceTableRow `xml:"https://adwords.google.com/api/adwords/cm/v201802 tableRows,omitempty" json:"tableRows,omitempty"`
 }
 
 type PriceTableRow struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PriceTableRow"`
-
 	//
 	// Header text of this row. Required.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	Header string `xml:"header,omitempty"`
+	Header *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 header,omitempty" json:"header,omitempty"`
 
 	//
 	// Description text of this row. Required.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 
 	//
 	// A list of possible final URLs after all cross domain redirects. Required.
 	//
-	FinalUrls *UrlList `xml:"finalUrls,omitempty"`
+	FinalUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrls,omitempty" json:"finalUrls,omitempty"`
 
 	//
 	// A list of possible final mobile URLs after all cross domain redirects.
 	//
-	FinalMobileUrls *UrlList `xml:"finalMobileUrls,omitempty"`
+	FinalMobileUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalMobileUrls,omitempty" json:"finalMobileUrls,omitempty"`
 
 	//
 	// Price value of this row. Required.
 	//
-	Price *MoneyWithCurrency `xml:"price,omitempty"`
+	Price *MoneyWithCurrency `xml:"https://adwords.google.com/api/adwords/cm/v201802 price,omitempty" json:"price,omitempty"`
 
 	//
 	// Price unit for this row.
 	//
-	PriceUnit *PriceExtensionPriceUnit `xml:"priceUnit,omitempty"`
+	PriceUnit *PriceExtensionPriceUnit `xml:"https://adwords.google.com/api/adwords/cm/v201802 priceUnit,omitempty" json:"priceUnit,omitempty"`
 }
 
 type PromotionFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PromotionFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
 	// Promotion target. Required.
 	//
-	PromotionTarget string `xml:"promotionTarget,omitempty"`
+	PromotionTarget *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 promotionTarget,omitempty" json:"promotionTarget,omitempty"`
 
 	//
 	// Discount modifier. Optional.
 	//
-	DiscountModifier *PromotionExtensionDiscountModifier `xml:"discountModifier,omitempty"`
+	DiscountModifier *PromotionExtensionDiscountModifier `xml:"https://adwords.google.com/api/adwords/cm/v201802 discountModifier,omitempty" json:"discountModifier,omitempty"`
 
 	//
 	// Percent off in micros. One million is equivalent to one percent.
 	// Either percentOff or moneyAmountOff is required.
 	// Cannot set both percentOff and moneyAmountOff.
 	//
-	PercentOff int64 `xml:"percentOff,omitempty"`
+	PercentOff *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 percentOff,omitempty" json:"percentOff,omitempty"`
 
 	//
 	// Money amount off. Either percentOff or moneyAmountOff is required.
 	// Cannot set both moneyAmountOff and percentOff.
 	//
-	MoneyAmountOff *MoneyWithCurrency `xml:"moneyAmountOff,omitempty"`
+	MoneyAmountOff *MoneyWithCurrency `xml:"https://adwords.google.com/api/adwords/cm/v201802 moneyAmountOff,omitempty" json:"moneyAmountOff,omitempty"`
 
 	//
 	// Promotion code. Optional.
 	// Cannot set both promotionCode and ordersOverAmount.
 	//
-	PromotionCode string `xml:"promotionCode,omitempty"`
+	PromotionCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 promotionCode,omitempty" json:"promotionCode,omitempty"`
 
 	//
 	// Orders over amount. Optional.
 	// Cannot set both ordersOverAmount and promotionCode.
 	//
-	OrdersOverAmount *MoneyWithCurrency `xml:"ordersOverAmount,omitempty"`
+	OrdersOverAmount *MoneyWithCurrency `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordersOverAmount,omitempty" json:"ordersOverAmount,omitempty"`
 
 	//
 	// Promotion start. Optional.
 	// The time part must be set to midnight.
 	// The special value "00000101 000000" may be used to clear an existing value.
 	//
-	PromotionStart string `xml:"promotionStart,omitempty"`
+	PromotionStart *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 promotionStart,omitempty" json:"promotionStart,omitempty"`
 
 	//
 	// Promotion end. Optional
 	// The time part must be set to midnight.
 	// The special value "00000101 000000" may be used to clear an existing value.
 	//
-	PromotionEnd string `xml:"promotionEnd,omitempty"`
+	PromotionEnd *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 promotionEnd,omitempty" json:"promotionEnd,omitempty"`
 
 	//
 	// Occasion of the promotion. Optional.
 	//
-	Occasion *PromotionExtensionOccasion `xml:"occasion,omitempty"`
+	Occasion *PromotionExtensionOccasion `xml:"https://adwords.google.com/api/adwords/cm/v201802 occasion,omitempty" json:"occasion,omitempty"`
 
 	//
 	// Final URLs. Required.
 	//
-	FinalUrls *UrlList `xml:"finalUrls,omitempty"`
+	FinalUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrls,omitempty" json:"finalUrls,omitempty"`
 
 	//
 	// Final mobile URLs. Optional.
 	//
-	FinalMobileUrls *UrlList `xml:"finalMobileUrls,omitempty"`
+	FinalMobileUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalMobileUrls,omitempty" json:"finalMobileUrls,omitempty"`
 
 	//
 	// Tracking URL template. Optional.
 	//
-	TrackingUrlTemplate string `xml:"trackingUrlTemplate,omitempty"`
+	TrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
 
 	//
 	// A list of mappings to be used for substituting URL custom parameter tags in the
 	// trackingUrlTemplate, finalUrls, and/or finalMobileUrls.
 	//
-	PromotionUrlCustomParameters *CustomParameters `xml:"promotionUrlCustomParameters,omitempty"`
+	PromotionUrlCustomParameters *CustomParameters `xml:"https://adwords.google.com/api/adwords/cm/v201802 promotionUrlCustomParameters,omitempty" json:"promotionUrlCustomParameters,omitempty"`
 
 	//
 	// The language of the promotion. Optional.
@@ -4399,138 +4263,118 @@ type PromotionFeedItem struct {
 	// <li>vi</li>
 	// </ul>
 	//
-	Language string `xml:"language,omitempty"`
+	Language *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 language,omitempty" json:"language,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ReviewFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReviewFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
 	// An exact quote or paraphrase from a third-party source.
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	ReviewText string `xml:"reviewText,omitempty"`
+	ReviewText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 reviewText,omitempty" json:"reviewText,omitempty"`
 
 	//
 	// Name of the third-party publisher of the review.
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	ReviewSourceName string `xml:"reviewSourceName,omitempty"`
+	ReviewSourceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 reviewSourceName,omitempty" json:"reviewSourceName,omitempty"`
 
 	//
 	// Landing page of the third-party website of the review.
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	ReviewSourceUrl string `xml:"reviewSourceUrl,omitempty"`
+	ReviewSourceUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 reviewSourceUrl,omitempty" json:"reviewSourceUrl,omitempty"`
 
 	//
 	// Indicates if your review is formatted as an exact quote. Use a value of false to indicate that
 	// the review is paraphrased. If not set, the value is treated as false.
 	//
-	ReviewTextExactlyQuoted bool `xml:"reviewTextExactlyQuoted,omitempty"`
+	ReviewTextExactlyQuoted *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 reviewTextExactlyQuoted,omitempty" json:"reviewTextExactlyQuoted,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -4541,13 +4385,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -4556,112 +4400,106 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SitelinkFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SitelinkFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
 	// URL display text for the sitelink.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	SitelinkText string `xml:"sitelinkText,omitempty"`
+	SitelinkText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 sitelinkText,omitempty" json:"sitelinkText,omitempty"`
 
 	//
 	// Destination URL for the sitelink.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 2076, inclusive, (trimmed).</span>
 	//
-	SitelinkUrl string `xml:"sitelinkUrl,omitempty"`
+	SitelinkUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 sitelinkUrl,omitempty" json:"sitelinkUrl,omitempty"`
 
 	//
 	// First line of the description for the sitelink. To clear this field, set its value to the empty
 	// string. If this value is set, sitelinkLine3 must also be set.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 35, inclusive, (trimmed).</span>
 	//
-	SitelinkLine2 string `xml:"sitelinkLine2,omitempty"`
+	SitelinkLine2 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 sitelinkLine2,omitempty" json:"sitelinkLine2,omitempty"`
 
 	//
 	// Second line of the description for the sitelink. To clear this field, set its value to the
 	// empty string. If this value is set, sitelinkLine2 must also be set.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 35, inclusive, (trimmed).</span>
 	//
-	SitelinkLine3 string `xml:"sitelinkLine3,omitempty"`
+	SitelinkLine3 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 sitelinkLine3,omitempty" json:"sitelinkLine3,omitempty"`
 
 	//
 	// A list of possible final URLs after all cross domain redirects.
 	//
-	SitelinkFinalUrls *UrlList `xml:"sitelinkFinalUrls,omitempty"`
+	SitelinkFinalUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 sitelinkFinalUrls,omitempty" json:"sitelinkFinalUrls,omitempty"`
 
 	//
 	// A list of possible final mobile URLs after all cross domain redirects.
 	//
-	SitelinkFinalMobileUrls *UrlList `xml:"sitelinkFinalMobileUrls,omitempty"`
+	SitelinkFinalMobileUrls *UrlList `xml:"https://adwords.google.com/api/adwords/cm/v201802 sitelinkFinalMobileUrls,omitempty" json:"sitelinkFinalMobileUrls,omitempty"`
 
 	//
 	// URL template for constructing a tracking URL. To clear this field, set its value to the empty
 	// string.
 	//
-	SitelinkTrackingUrlTemplate string `xml:"sitelinkTrackingUrlTemplate,omitempty"`
+	SitelinkTrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 sitelinkTrackingUrlTemplate,omitempty" json:"sitelinkTrackingUrlTemplate,omitempty"`
 
 	//
 	// A list of mappings to be used for substituting URL custom parameter tags in the
 	// trackingUrlTemplate, finalUrls, and/or finalMobileUrls.
 	//
-	SitelinkUrlCustomParameters *CustomParameters `xml:"sitelinkUrlCustomParameters,omitempty"`
+	SitelinkUrlCustomParameters *CustomParameters `xml:"https://adwords.google.com/api/adwords/cm/v201802 sitelinkUrlCustomParameters,omitempty" json:"sitelinkUrlCustomParameters,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -4669,12 +4507,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -4682,62 +4520,56 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StructuredSnippetFeedItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StructuredSnippetFeedItem"`
-
 	*ExtensionFeedItem
 
 	//
@@ -4746,7 +4578,7 @@ type StructuredSnippetFeedItem struct {
 	// structured snippets header translations</a> page for supported localized headers.
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Header string `xml:"header,omitempty"`
+	Header *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 header,omitempty" json:"header,omitempty"`
 
 	//
 	// The values in the snippet. A SET operation replaces the values in the list.
@@ -4754,96 +4586,86 @@ type StructuredSnippetFeedItem struct {
 	// <span class="constraint ContentsDistinct">This field must contain distinct elements.</span>
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type UrlError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *UrlErrorReason `xml:"reason,omitempty"`
+	Reason *UrlErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type UrlList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlList"`
-
 	//
 	// List of URLs.  On SET operation, empty list indicates to clear the list.
 	// <span class="constraint CollectionSize">The maximum size of this collection is 10.</span>
 	// <span class="constraint ContentsStringLength">Strings in this field must be non-empty (trimmed).</span>
 	//
-	Urls []string `xml:"urls,omitempty"`
+	Urls []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 urls,omitempty" json:"urls,omitempty"`
 }
 
 type CriterionUserInterest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserInterest"`
-
 	*Criterion
 
 	//
 	// Id of this user interest. This is a required field.
 	//
-	UserInterestId int64 `xml:"userInterestId,omitempty"`
+	UserInterestId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestId,omitempty" json:"userInterestId,omitempty"`
 
 	//
 	// Parent Id of this user interest.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserInterestParentId int64 `xml:"userInterestParentId,omitempty"`
+	UserInterestParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestParentId,omitempty" json:"userInterestParentId,omitempty"`
 
 	//
 	// Name of this user interest.
 	//
-	UserInterestName string `xml:"userInterestName,omitempty"`
+	UserInterestName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestName,omitempty" json:"userInterestName,omitempty"`
 }
 
 type CriterionUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserList"`
-
 	*Criterion
 
 	//
 	// Id of this user list. This is a required field.
 	//
-	UserListId int64 `xml:"userListId,omitempty"`
+	UserListId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListId,omitempty" json:"userListId,omitempty"`
 
-	UserListName string `xml:"userListName,omitempty"`
+	UserListName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListName,omitempty" json:"userListName,omitempty"`
 
-	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"userListMembershipStatus,omitempty"`
+	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListMembershipStatus,omitempty" json:"userListMembershipStatus,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the google.com
 	// (search) network.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForSearch bool `xml:"userListEligibleForSearch,omitempty"`
+	UserListEligibleForSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForSearch,omitempty" json:"userListEligibleForSearch,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the display network.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForDisplay bool `xml:"userListEligibleForDisplay,omitempty"`
+	UserListEligibleForDisplay *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForDisplay,omitempty" json:"userListEligibleForDisplay,omitempty"`
 }
 
 type Vertical struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Vertical"`
-
 	*Criterion
 
 	//
 	// Id of this vertical.
 	//
-	VerticalId int64 `xml:"verticalId,omitempty"`
+	VerticalId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalId,omitempty" json:"verticalId,omitempty"`
 
 	//
 	// Id of the parent of this vertical.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	VerticalParentId int64 `xml:"verticalParentId,omitempty"`
+	VerticalParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalParentId,omitempty" json:"verticalParentId,omitempty"`
 
 	//
 	// The category to target or exclude. Each subsequent element in the array
@@ -4853,7 +4675,7 @@ type Vertical struct {
 	// is available <a href="/adwords/api/docs/appendix/verticals">here</a>
 	// This field is required and must not be empty.
 	//
-	Path []string `xml:"path,omitempty"`
+	Path []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 path,omitempty" json:"path,omitempty"`
 }
 
 type AdGroupExtensionSettingServiceInterface struct {
@@ -0,0 +1,120 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupExtensionSettingService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AdGroupExtensionSettingServiceAPI is the interface of the AdGroupExtensionSettingService operations. It is
+// implemented by AdGroupExtensionSettingServiceInterface and, for tests, by FakeAdGroupExtensionSettingService.
+type AdGroupExtensionSettingServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+	Query(request *Query) (*QueryResponse, error)
+}
+
+var _ AdGroupExtensionSettingServiceAPI = (*AdGroupExtensionSettingServiceInterface)(nil)
+var _ AdGroupExtensionSettingServiceAPI = (*FakeAdGroupExtensionSettingService)(nil)
+
+// FakeCall is a call recorded by FakeAdGroupExtensionSettingService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeAdGroupExtensionSettingService is a programmable AdGroupExtensionSettingServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeAdGroupExtensionSettingService struct {
+	GetFunc    func(request *Get) (*GetResponse, error)
+	MutateFunc func(request *Mutate) (*MutateResponse, error)
+	QueryFunc  func(request *Query) (*QueryResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeAdGroupExtensionSettingService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeAdGroupExtensionSettingService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAdGroupExtensionSettingService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeAdGroupExtensionSettingService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupExtensionSettingService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeAdGroupExtensionSettingService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeAdGroupExtensionSettingService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupExtensionSettingService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeAdGroupExtensionSettingService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
+
+// Query records the call and delegates to QueryFunc.
+func (f *FakeAdGroupExtensionSettingService) Query(request *Query) (*QueryResponse, error) {
+	f.record("Query", request)
+	if f.QueryFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupExtensionSettingService.QueryFunc is not set")
+	}
+	return f.QueryFunc(request)
+}
+
+// QueryCalls returns the requests of the recorded Query calls.
+func (f *FakeAdGroupExtensionSettingService) QueryCalls() []*Query {
+	var requests []*Query
+	for _, c := range f.Calls() {
+		if c.Method == "Query" {
+			requests = append(requests, c.Request.(*Query))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,1061 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupExtensionSettingService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupExtensionSettingOperation" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupExtensionSettingOperation) MarshalJSON() ([]byte, error) {
+	type plain AdGroupExtensionSettingOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("AdGroupExtensionSettingOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupExtensionSettingPage" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupExtensionSettingPage) MarshalJSON() ([]byte, error) {
+	type plain AdGroupExtensionSettingPage
+	v := plain(t)
+
+	b0 := new(Page)
+	if v.Page != nil {
+		*b0 = *v.Page
+	}
+	if b0.PageType == nil {
+		b0.PageType = String("AdGroupExtensionSettingPage")
+	}
+	v.Page = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupExtensionSettingReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupExtensionSettingReturnValue) MarshalJSON() ([]byte, error) {
+	type plain AdGroupExtensionSettingReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("AdGroupExtensionSettingReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AppFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t AppFeedItem) MarshalJSON() ([]byte, error) {
+	type plain AppFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("AppFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CallFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t CallFeedItem) MarshalJSON() ([]byte, error) {
+	type plain CallFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("CallFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CalloutFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t CalloutFeedItem) MarshalJSON() ([]byte, error) {
+	type plain CalloutFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("CalloutFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CollectionSizeError" as the
+// concrete type in the discriminator of its base types.
+func (t CollectionSizeError) MarshalJSON() ([]byte, error) {
+	type plain CollectionSizeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CollectionSizeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionError" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionError) MarshalJSON() ([]byte, error) {
+	type plain CriterionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CriterionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DateError" as the
+// concrete type in the discriminator of its base types.
+func (t DateError) MarshalJSON() ([]byte, error) {
+	type plain DateError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DateError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DoubleValue" as the
+// concrete type in the discriminator of its base types.
+func (t DoubleValue) MarshalJSON() ([]byte, error) {
+	type plain DoubleValue
+	v := plain(t)
+
+	b0 := new(NumberValue)
+	if v.NumberValue != nil {
+		*b0 = *v.NumberValue
+	}
+	v.NumberValue = b0
+
+	b1 := new(ComparableValue)
+	if b0.ComparableValue != nil {
+		*b1 = *b0.ComparableValue
+	}
+	if b1.ComparableValueType == nil {
+		b1.ComparableValueType = String("DoubleValue")
+	}
+	b0.ComparableValue = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t EntityAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain EntityAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityCountLimitExceeded" as the
+// concrete type in the discriminator of its base types.
+func (t EntityCountLimitExceeded) MarshalJSON() ([]byte, error) {
+	type plain EntityCountLimitExceeded
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityCountLimitExceeded")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityNotFound" as the
+// concrete type in the discriminator of its base types.
+func (t EntityNotFound) MarshalJSON() ([]byte, error) {
+	type plain EntityNotFound
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityNotFound")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ExtensionSettingError" as the
+// concrete type in the discriminator of its base types.
+func (t ExtensionSettingError) MarshalJSON() ([]byte, error) {
+	type plain ExtensionSettingError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ExtensionSettingError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "FeedItemPolicyData" as the
+// concrete type in the discriminator of its base types.
+func (t FeedItemPolicyData) MarshalJSON() ([]byte, error) {
+	type plain FeedItemPolicyData
+	v := plain(t)
+
+	b0 := new(PolicyData)
+	if v.PolicyData != nil {
+		*b0 = *v.PolicyData
+	}
+	if b0.PolicyDataType == nil {
+		b0.PolicyDataType = String("FeedItemPolicyData")
+	}
+	v.PolicyData = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Keyword" as the
+// concrete type in the discriminator of its base types.
+func (t Keyword) MarshalJSON() ([]byte, error) {
+	type plain Keyword
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Keyword")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Location" as the
+// concrete type in the discriminator of its base types.
+func (t Location) MarshalJSON() ([]byte, error) {
+	type plain Location
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Location")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LongValue" as the
+// concrete type in the discriminator of its base types.
+func (t LongValue) MarshalJSON() ([]byte, error) {
+	type plain LongValue
+	v := plain(t)
+
+	b0 := new(NumberValue)
+	if v.NumberValue != nil {
+		*b0 = *v.NumberValue
+	}
+	v.NumberValue = b0
+
+	b1 := new(ComparableValue)
+	if b0.ComparableValue != nil {
+		*b1 = *b0.ComparableValue
+	}
+	if b1.ComparableValueType == nil {
+		b1.ComparableValueType = String("LongValue")
+	}
+	b0.ComparableValue = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MessageFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t MessageFeedItem) MarshalJSON() ([]byte, error) {
+	type plain MessageFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("MessageFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileAppCategory" as the
+// concrete type in the discriminator of its base types.
+func (t MobileAppCategory) MarshalJSON() ([]byte, error) {
+	type plain MobileAppCategory
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileAppCategory")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileApplication" as the
+// concrete type in the discriminator of its base types.
+func (t MobileApplication) MarshalJSON() ([]byte, error) {
+	type plain MobileApplication
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileApplication")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Money" as the
+// concrete type in the discriminator of its base types.
+func (t Money) MarshalJSON() ([]byte, error) {
+	type plain Money
+	v := plain(t)
+
+	b0 := new(ComparableValue)
+	if v.ComparableValue != nil {
+		*b0 = *v.ComparableValue
+	}
+	if b0.ComparableValueType == nil {
+		b0.ComparableValueType = String("Money")
+	}
+	v.ComparableValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MoneyWithCurrency" as the
+// concrete type in the discriminator of its base types.
+func (t MoneyWithCurrency) MarshalJSON() ([]byte, error) {
+	type plain MoneyWithCurrency
+	v := plain(t)
+
+	b0 := new(ComparableValue)
+	if v.ComparableValue != nil {
+		*b0 = *v.ComparableValue
+	}
+	if b0.ComparableValueType == nil {
+		b0.ComparableValueType = String("MoneyWithCurrency")
+	}
+	v.ComparableValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NewEntityCreationError" as the
+// concrete type in the discriminator of its base types.
+func (t NewEntityCreationError) MarshalJSON() ([]byte, error) {
+	type plain NewEntityCreationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NewEntityCreationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NullError" as the
+// concrete type in the discriminator of its base types.
+func (t NullError) MarshalJSON() ([]byte, error) {
+	type plain NullError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NullError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Placement" as the
+// concrete type in the discriminator of its base types.
+func (t Placement) MarshalJSON() ([]byte, error) {
+	type plain Placement
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Placement")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PriceFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t PriceFeedItem) MarshalJSON() ([]byte, error) {
+	type plain PriceFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("PriceFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PromotionFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t PromotionFeedItem) MarshalJSON() ([]byte, error) {
+	type plain PromotionFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("PromotionFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QueryError" as the
+// concrete type in the discriminator of its base types.
+func (t QueryError) MarshalJSON() ([]byte, error) {
+	type plain QueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReviewFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t ReviewFeedItem) MarshalJSON() ([]byte, error) {
+	type plain ReviewFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("ReviewFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SelectorError" as the
+// concrete type in the discriminator of its base types.
+func (t SelectorError) MarshalJSON() ([]byte, error) {
+	type plain SelectorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SelectorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SitelinkFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t SitelinkFeedItem) MarshalJSON() ([]byte, error) {
+	type plain SitelinkFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("SitelinkFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StructuredSnippetFeedItem" as the
+// concrete type in the discriminator of its base types.
+func (t StructuredSnippetFeedItem) MarshalJSON() ([]byte, error) {
+	type plain StructuredSnippetFeedItem
+	v := plain(t)
+
+	b0 := new(ExtensionFeedItem)
+	if v.ExtensionFeedItem != nil {
+		*b0 = *v.ExtensionFeedItem
+	}
+	if b0.ExtensionFeedItemType == nil {
+		b0.ExtensionFeedItemType = String("StructuredSnippetFeedItem")
+	}
+	v.ExtensionFeedItem = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UrlError" as the
+// concrete type in the discriminator of its base types.
+func (t UrlError) MarshalJSON() ([]byte, error) {
+	type plain UrlError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("UrlError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserInterest" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserInterest) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserInterest
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserInterest")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserList" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserList) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserList
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserList")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Vertical" as the
+// concrete type in the discriminator of its base types.
+func (t Vertical) MarshalJSON() ([]byte, error) {
+	type plain Vertical
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Vertical")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupExtensionSettingService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *AdGroupExtensionSetting) *AdGroupExtensionSettingOperation {
+	op := OperatorADD
+	return &AdGroupExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AdGroupExtensionSetting) *AdGroupExtensionSettingOperation {
+	op := OperatorSET
+	return &AdGroupExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *AdGroupExtensionSetting) *AdGroupExtensionSettingOperation {
+	op := OperatorREMOVE
+	return &AdGroupExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
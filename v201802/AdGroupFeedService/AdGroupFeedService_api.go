@@ -0,0 +1,120 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupFeedService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AdGroupFeedServiceAPI is the interface of the AdGroupFeedService operations. It is
+// implemented by AdGroupFeedServiceInterface and, for tests, by FakeAdGroupFeedService.
+type AdGroupFeedServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+	Query(request *Query) (*QueryResponse, error)
+}
+
+var _ AdGroupFeedServiceAPI = (*AdGroupFeedServiceInterface)(nil)
+var _ AdGroupFeedServiceAPI = (*FakeAdGroupFeedService)(nil)
+
+// FakeCall is a call recorded by FakeAdGroupFeedService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeAdGroupFeedService is a programmable AdGroupFeedServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeAdGroupFeedService struct {
+	GetFunc    func(request *Get) (*GetResponse, error)
+	MutateFunc func(request *Mutate) (*MutateResponse, error)
+	QueryFunc  func(request *Query) (*QueryResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeAdGroupFeedService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeAdGroupFeedService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAdGroupFeedService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeAdGroupFeedService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupFeedService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeAdGroupFeedService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeAdGroupFeedService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupFeedService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeAdGroupFeedService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
+
+// Query records the call and delegates to QueryFunc.
+func (f *FakeAdGroupFeedService) Query(request *Query) (*QueryResponse, error) {
+	f.record("Query", request)
+	if f.QueryFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupFeedService.QueryFunc is not set")
+	}
+	return f.QueryFunc(request)
+}
+
+// QueryCalls returns the requests of the recorded Query calls.
+func (f *FakeAdGroupFeedService) QueryCalls() []*Query {
+	var requests []*Query
+	for _, c := range f.Calls() {
+		if c.Method == "Query" {
+			requests = append(requests, c.Request.(*Query))
+		}
+	}
+	return requests
+}
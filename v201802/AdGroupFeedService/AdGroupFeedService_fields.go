@@ -0,0 +1,174 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupFeedService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AdGroupFeedFields holds the selector field names of AdGroupFeed, including
+// the fields of the types it holds.
+var AdGroupFeedFields = struct {
+	AdGroupId        string
+	BaseAdGroupId    string
+	BaseCampaignId   string
+	FeedId           string
+	MatchingFunction string
+	PlaceholderTypes string
+	Status           string
+}{
+	AdGroupId:        "AdGroupId",
+	BaseAdGroupId:    "BaseAdGroupId",
+	BaseCampaignId:   "BaseCampaignId",
+	FeedId:           "FeedId",
+	MatchingFunction: "MatchingFunction",
+	PlaceholderTypes: "PlaceholderTypes",
+	Status:           "Status",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"AdGroupFeed": {
+		{Type: "AdGroupFeed", Name: "FeedId", Selectors: []string{"FeedId"}, Filter: "FeedId", Required: true},
+		{Type: "AdGroupFeed", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId", Required: true},
+		{Type: "AdGroupFeed", Name: "MatchingFunction", Selectors: []string{"MatchingFunction"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdGroupFeed", Name: "PlaceholderTypes", Selectors: []string{"PlaceholderTypes"}, Filter: "PlaceholderTypes", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdGroupFeed", Name: "Status", Selectors: []string{"Status"}, Filter: "Status", ReadOnly: true},
+		{Type: "AdGroupFeed", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, Filter: "BaseCampaignId", ReadOnly: true},
+		{Type: "AdGroupFeed", Name: "BaseAdGroupId", Selectors: []string{"BaseAdGroupId"}, Filter: "BaseAdGroupId", ReadOnly: true},
+	},
+	"AdGroupFeedOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "AdGroupFeedOperation", Name: "Operand", Required: true},
+	},
+	"ConstantOperand": {
+		{Type: "FunctionArgumentOperand", Name: "FunctionArgumentOperandType"},
+		{Type: "ConstantOperand", Name: "Type_", Required: true},
+		{Type: "ConstantOperand", Name: "Unit"},
+		{Type: "ConstantOperand", Name: "LongValue"},
+		{Type: "ConstantOperand", Name: "BooleanValue"},
+		{Type: "ConstantOperand", Name: "DoubleValue"},
+		{Type: "ConstantOperand", Name: "StringValue"},
+	},
+	"FeedAttributeOperand": {
+		{Type: "FunctionArgumentOperand", Name: "FunctionArgumentOperandType"},
+		{Type: "FeedAttributeOperand", Name: "FeedId", Required: true},
+		{Type: "FeedAttributeOperand", Name: "FeedAttributeId", Required: true},
+	},
+	"Function": {
+		{Type: "Function", Name: "Operator", Required: true},
+		{Type: "Function", Name: "LhsOperand"},
+		{Type: "Function", Name: "RhsOperand"},
+		{Type: "Function", Name: "FunctionString"},
+	},
+	"FunctionOperand": {
+		{Type: "FunctionArgumentOperand", Name: "FunctionArgumentOperandType"},
+		{Type: "FunctionOperand", Name: "Value", Required: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"RequestContextOperand": {
+		{Type: "FunctionArgumentOperand", Name: "FunctionArgumentOperandType"},
+		{Type: "RequestContextOperand", Name: "ContextType", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupFeedService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *AdGroupFeed) *AdGroupFeedOperation {
+	op := OperatorADD
+	return &AdGroupFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AdGroupFeed) *AdGroupFeedOperation {
+	op := OperatorSET
+	return &AdGroupFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *AdGroupFeed) *AdGroupFeedOperation {
+	op := OperatorREMOVE
+	return &AdGroupFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
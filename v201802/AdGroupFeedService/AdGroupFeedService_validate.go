@@ -0,0 +1,204 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupFeedService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Query against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Query) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks AdGroupFeedOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *AdGroupFeedOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "selector"), t.Selector != nil)
+	if t.Selector != nil {
+		t.Selector.validate(v, validate.Field(path, "selector"), op)
+	}
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+}
+
+func (t *Query) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Query)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *AdGroupFeed) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdGroupFeed)
+	}
+	v.Required(validate.Field(path, "feedId"), t.FeedId != nil)
+	v.Required(validate.Field(path, "adGroupId"), t.AdGroupId != nil)
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "matchingFunction"), t.MatchingFunction != nil)
+	}
+	if t.MatchingFunction != nil {
+		t.MatchingFunction.validate(v, validate.Field(path, "matchingFunction"), op)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "placeholderTypes"), len(t.PlaceholderTypes) > 0)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "status"), op, t.Status != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "baseCampaignId"), op, t.BaseCampaignId != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "baseAdGroupId"), op, t.BaseAdGroupId != nil)
+	}
+}
+
+func (t *AdGroupFeedOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdGroupFeedOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *Function) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Function)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	if len(t.LhsOperand) > 0 {
+		v.Size(validate.Field(path, "lhsOperand"), len(t.LhsOperand), 1, -1)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
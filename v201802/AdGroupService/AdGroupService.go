@@ -2165,22 +2165,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse" json:"-"`
 
-	Rval *AdGroupPage `xml:"rval,omitempty"`
+	Rval *AdGroupPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -2189,17 +2189,17 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, SET.</span>
 	//
-	Operations []*AdGroupOperation `xml:"operations,omitempty"`
+	Operations []*AdGroupOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse" json:"-"`
 
-	Rval *AdGroupReturnValue `xml:"rval,omitempty"`
+	Rval *AdGroupReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabel"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabel" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -2208,54 +2208,52 @@ type MutateLabel struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, REMOVE.</span>
 	//
-	Operations []*AdGroupLabelOperation `xml:"operations,omitempty"`
+	Operations []*AdGroupLabelOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateLabelResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabelResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabelResponse" json:"-"`
 
-	Rval *AdGroupLabelReturnValue `xml:"rval,omitempty"`
+	Rval *AdGroupLabelReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Query struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse" json:"-"`
 
-	Rval *AdGroupPage `xml:"rval,omitempty"`
+	Rval *AdGroupPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdGroup struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroup"`
-
 	//
 	// ID of this ad group.
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// ID of the campaign with which this ad group is associated.
 	// <span class="constraint Selectable">This field can be selected using the value "CampaignId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 
 	//
 	// Name of the campaign with which this ad group is associated.
 	// <span class="constraint Selectable">This field can be selected using the value "CampaignName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CampaignName string `xml:"campaignName,omitempty"`
+	CampaignName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignName,omitempty" json:"campaignName,omitempty"`
 
 	//
 	// Name of this ad group (at most 255 UTF-8 full-width characters).
@@ -2263,19 +2261,19 @@ type AdGroup struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Name".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint MatchesRegex">AdGroup names must not contain any null (code point 0x0), NL line feed (code point 0xA) or carriage return (code point 0xD) characters. This is checked by the regular expression '[^\x00\x0A\x0D]*'.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Status of this ad group.
 	// <span class="constraint Selectable">This field can be selected using the value "Status".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Status *AdGroupStatus `xml:"status,omitempty"`
+	Status *AdGroupStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// List of settings for the AdGroup.
 	// <span class="constraint Selectable">This field can be selected using the value "Settings".</span>
 	//
-	Settings []*Setting `xml:"settings,omitempty"`
+	Settings []*Setting `xml:"https://adwords.google.com/api/adwords/cm/v201802 settings,omitempty" json:"settings,omitempty"`
 
 	//
 	// Labels that are attached to the {@link AdGroup}. To associate an existing {@link Label} to an
@@ -2288,7 +2286,7 @@ type AdGroup struct {
 	// <span class="constraint CampaignType">This field may not be set for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	Labels []*Label `xml:"labels,omitempty"`
+	Labels []*Label `xml:"https://adwords.google.com/api/adwords/cm/v201802 labels,omitempty" json:"labels,omitempty"`
 
 	//
 	// This Map provides a place to put new features and settings in older versions
@@ -2297,7 +2295,7 @@ type AdGroup struct {
 	//
 	// It is presently unused.  Do not set a value.
 	//
-	ForwardCompatibilityMap []*String_StringMapEntry `xml:"forwardCompatibilityMap,omitempty"`
+	ForwardCompatibilityMap []*String_StringMapEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 forwardCompatibilityMap,omitempty" json:"forwardCompatibilityMap,omitempty"`
 
 	//
 	// Bidding configuration for this ad group. To set the bids on the ad groups use
@@ -2306,14 +2304,14 @@ type AdGroup struct {
 	// bidding strategy is considered to be the directly attached strategy or inherited campaign level
 	// strategy when there?s no directly attached strategy.
 	//
-	BiddingStrategyConfiguration *BiddingStrategyConfiguration `xml:"biddingStrategyConfiguration,omitempty"`
+	BiddingStrategyConfiguration *BiddingStrategyConfiguration `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyConfiguration,omitempty" json:"biddingStrategyConfiguration,omitempty"`
 
 	//
 	// Allows advertisers to specify a criteria dimension on which to place absolute bids.
 	// This is only applicable for campaigns that target only the content network and not search.
 	// <span class="constraint Selectable">This field can be selected using the value "ContentBidCriterionTypeGroup".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	ContentBidCriterionTypeGroup *CriterionTypeGroup `xml:"contentBidCriterionTypeGroup,omitempty"`
+	ContentBidCriterionTypeGroup *CriterionTypeGroup `xml:"https://adwords.google.com/api/adwords/cm/v201802 contentBidCriterionTypeGroup,omitempty" json:"contentBidCriterionTypeGroup,omitempty"`
 
 	//
 	// ID of the base campaign from which this draft/trial adgroup was created. This
@@ -2321,7 +2319,7 @@ type AdGroup struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BaseCampaignId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BaseCampaignId int64 `xml:"baseCampaignId,omitempty"`
+	BaseCampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 baseCampaignId,omitempty" json:"baseCampaignId,omitempty"`
 
 	//
 	// ID of the base adgroup from which this draft/trial adgroup was created. For
@@ -2330,7 +2328,7 @@ type AdGroup struct {
 	// This field is readonly and will be ignored when sent to the API.
 	// <span class="constraint Selectable">This field can be selected using the value "BaseAdGroupId".</span>
 	//
-	BaseAdGroupId int64 `xml:"baseAdGroupId,omitempty"`
+	BaseAdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 baseAdGroupId,omitempty" json:"baseAdGroupId,omitempty"`
 
 	//
 	// URL template for constructing a tracking URL.
@@ -2338,7 +2336,7 @@ type AdGroup struct {
 	// <p>On update, empty string ("") indicates to clear the field.
 	// <span class="constraint Selectable">This field can be selected using the value "TrackingUrlTemplate".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TrackingUrlTemplate string `xml:"trackingUrlTemplate,omitempty"`
+	TrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
 
 	//
 	// URL template for appending params to Final URL.
@@ -2347,255 +2345,219 @@ type AdGroup struct {
 	// <p>This field is supported only in test accounts.
 	// <span class="constraint Selectable">This field can be selected using the value "FinalUrlSuffix".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	FinalUrlSuffix string `xml:"finalUrlSuffix,omitempty"`
+	FinalUrlSuffix *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrlSuffix,omitempty" json:"finalUrlSuffix,omitempty"`
 
 	//
 	// A list of mappings to be used for substituting URL custom parameter tags in the
 	// trackingUrlTemplate, finalUrls, and/or finalMobileUrls.
 	// <span class="constraint Selectable">This field can be selected using the value "UrlCustomParameters".</span>
 	//
-	UrlCustomParameters *CustomParameters `xml:"urlCustomParameters,omitempty"`
+	UrlCustomParameters *CustomParameters `xml:"https://adwords.google.com/api/adwords/cm/v201802 urlCustomParameters,omitempty" json:"urlCustomParameters,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "AdGroupType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint CampaignType">This field may only be set to SHOPPING_UNIVERSAL_ADS for campaign channel type SHOPPING with campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: SET.</span>
 	//
-	AdGroupType *AdGroupType `xml:"adGroupType,omitempty"`
+	AdGroupType *AdGroupType `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupType,omitempty" json:"adGroupType,omitempty"`
 
 	//
 	// The ad rotation mode to specify how often the ads in the ad group will be served relative to
 	// one another.
 	//
-	AdGroupAdRotationMode *AdGroupAdRotationMode `xml:"adGroupAdRotationMode,omitempty"`
+	AdGroupAdRotationMode *AdGroupAdRotationMode `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupAdRotationMode,omitempty" json:"adGroupAdRotationMode,omitempty"`
 }
 
 type AdGroupAdRotationMode struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupAdRotationMode"`
-
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "AdRotationMode".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AdRotationMode *AdRotationMode `xml:"adRotationMode,omitempty"`
+	AdRotationMode *AdRotationMode `xml:"https://adwords.google.com/api/adwords/cm/v201802 adRotationMode,omitempty" json:"adRotationMode,omitempty"`
 }
 
 type AdGroupLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupLabel"`
-
 	//
 	// The id of the adGroup that the label is applied to.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// The id of an existing label to be applied to the ad group.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	LabelId int64 `xml:"labelId,omitempty"`
+	LabelId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 labelId,omitempty" json:"labelId,omitempty"`
 }
 
 type AdGroupLabelOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupLabelOperation"`
-
 	*Operation
 
 	//
 	// AdGroupLabel to operate on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *AdGroupLabel `xml:"operand,omitempty"`
+	Operand *AdGroupLabel `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type AdGroupLabelReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupLabelReturnValue"`
-
 	*ListReturnValue
 
-	Value []*AdGroupLabel `xml:"value,omitempty"`
+	Value []*AdGroupLabel `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type AdGroupOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupOperation"`
-
 	*Operation
 
 	//
 	// AdGroup to operate on
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *AdGroup `xml:"operand,omitempty"`
+	Operand *AdGroup `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type AdGroupPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*AdGroup `xml:"entries,omitempty"`
+	Entries []*AdGroup `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type AdGroupReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupReturnValue"`
-
 	*ListReturnValue
 
 	//
 	// List of AdGroups
 	//
-	Value []*AdGroup `xml:"value,omitempty"`
+	Value []*AdGroup `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// List of partial failure errors.
 	//
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type AdGroupServiceError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupServiceError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdGroupServiceErrorReason `xml:"reason,omitempty"`
+	Reason *AdGroupServiceErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type LabelAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LabelAttribute"`
-
 	//
 	// Indicates that this instance is a subtype of LabelAttribute.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	LabelAttributeType string `xml:"LabelAttribute.Type,omitempty"`
+	LabelAttributeType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 LabelAttribute.Type,omitempty" json:"labelAttributeType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BiddingErrors struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingErrors"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *BiddingErrorsReason `xml:"reason,omitempty"`
+	Reason *BiddingErrorsReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingScheme"`
-
 	//
 	// Indicates that this instance is a subtype of BiddingScheme.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	BiddingSchemeType string `xml:"BiddingScheme.Type,omitempty"`
+	BiddingSchemeType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingScheme.Type,omitempty" json:"biddingSchemeType,omitempty"`
 }
 
 type BiddingStrategyConfiguration struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingStrategyConfiguration"`
-
 	//
 	// Id of the bidding strategy to be associated with the campaign, ad group or ad group criteria. A
 	// bidding strategy is created using the BiddingStrategyService ADD operation and is assigned a
@@ -2606,14 +2568,14 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BiddingStrategyId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint AdxEnabled">This is disabled for AdX.</span>
 	//
-	BiddingStrategyId int64 `xml:"biddingStrategyId,omitempty"`
+	BiddingStrategyId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyId,omitempty" json:"biddingStrategyId,omitempty"`
 
 	//
 	// Name of the bidding strategy. This is applicable only for flexible bidding strategies.
 	// <span class="constraint Selectable">This field can be selected using the value "BiddingStrategyName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BiddingStrategyName string `xml:"biddingStrategyName,omitempty"`
+	BiddingStrategyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyName,omitempty" json:"biddingStrategyName,omitempty"`
 
 	//
 	// The type of the bidding strategy to be attached.
@@ -2634,7 +2596,7 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint CampaignType">This field may only be set to NONE for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	// <span class="constraint CampaignType">This field may only be set to the values: MANUAL_CPC, ENHANCED_CPC, TARGET_CPA, TARGET_ROAS, NONE for campaign channel type DISPLAY with campaign channel subtype DISPLAY_GMAIL_AD.</span>
 	//
-	BiddingStrategyType *BiddingStrategyType `xml:"biddingStrategyType,omitempty"`
+	BiddingStrategyType *BiddingStrategyType `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyType,omitempty" json:"biddingStrategyType,omitempty"`
 
 	//
 	// Indicates where the bidding strategy is associated i.e. campaign, ad group or
@@ -2642,7 +2604,7 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BiddingStrategySource".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BiddingStrategySource *BiddingStrategySource `xml:"biddingStrategySource,omitempty"`
+	BiddingStrategySource *BiddingStrategySource `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategySource,omitempty" json:"biddingStrategySource,omitempty"`
 
 	//
 	// The bidding strategy metadata. Bidding strategy can be associated using the {@linkplain
@@ -2653,7 +2615,7 @@ type BiddingStrategyConfiguration struct {
 	//
 	// <p>Starting with v201705, this field cannot be set at the ad group or ad group criterion level.
 	//
-	BiddingScheme *BiddingScheme `xml:"biddingScheme,omitempty"`
+	BiddingScheme *BiddingScheme `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingScheme,omitempty" json:"biddingScheme,omitempty"`
 
 	//
 	// Specifies the bids. Bids can be set only on ad groups and ad group criteria.
@@ -2663,7 +2625,7 @@ type BiddingStrategyConfiguration struct {
 	// creation. Default CPC and CPM values are minimal billable amounts in local currencies.
 	// For example, for US Dollars CPC and CPM default values are $0.01 and $0.01, respectively.
 	//
-	Bids []*Bids `xml:"bids,omitempty"`
+	Bids []*Bids `xml:"https://adwords.google.com/api/adwords/cm/v201802 bids,omitempty" json:"bids,omitempty"`
 
 	//
 	// The target return on average spend (ROAS). This target can only be set on ad groups. If this
@@ -2673,66 +2635,54 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint Selectable">This field can be selected using the value "TargetRoasOverride".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint InRange">This field must be between 0.01 and 1000.0, inclusive.</span>
 	//
-	TargetRoasOverride float64 `xml:"targetRoasOverride,omitempty"`
+	TargetRoasOverride *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoasOverride,omitempty" json:"targetRoasOverride,omitempty"`
 }
 
 type Bids struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Bids"`
-
 	//
 	// Indicates that this instance is a subtype of Bids.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	BidsType string `xml:"Bids.Type,omitempty"`
+	BidsType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Bids.Type,omitempty" json:"bidsType,omitempty"`
 }
 
 type TextLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TextLabel"`
-
 	*Label
 }
 
 type DisplayAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DisplayAttribute"`
-
 	*LabelAttribute
 
 	//
 	// Background color of the label in RGB format.
 	// <span class="constraint MatchesRegex">A background color string must begin with a '#' character followed by either 6 or 3 hexadecimal characters (24 vs. 12 bits). This is checked by the regular expression '^\#([a-fA-F0-9]{6}|[a-fA-F0-9]{3})$'.</span>
 	//
-	BackgroundColor string `xml:"backgroundColor,omitempty"`
+	BackgroundColor *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 backgroundColor,omitempty" json:"backgroundColor,omitempty"`
 
 	//
 	// A short description of the label.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive.</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type CpaBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpaBid"`
-
 	*Bids
 
 	//
@@ -2744,7 +2694,7 @@ type CpaBid struct {
 	// {@linkplain BiddingStrategyConfiguration#biddingScheme}.
 	// <span class="constraint Selectable">This field can be selected using the value "TargetCpaBid".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group, ad group strategy, or campaign strategy) at which the bid was set.
@@ -2752,12 +2702,10 @@ type CpaBid struct {
 	// <span class="constraint Selectable">This field can be selected using the value "TargetCpaBidSource".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BidSource *BidSource `xml:"bidSource,omitempty"`
+	BidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidSource,omitempty" json:"bidSource,omitempty"`
 }
 
 type CpcBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpcBid"`
-
 	*Bids
 
 	//
@@ -2768,311 +2716,269 @@ type CpcBid struct {
 	// At the ad group criteria level, this is the max cpc bid.
 	// <span class="constraint Selectable">This field can be selected using the value "CpcBid".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group or criterion) at which the bid was set. This is applicable
 	// only at the criteria level.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CpcBidSource *BidSource `xml:"cpcBidSource,omitempty"`
+	CpcBidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 cpcBidSource,omitempty" json:"cpcBidSource,omitempty"`
 }
 
 type CpmBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpmBid"`
-
 	*Bids
 
 	//
 	// Max CPM (cost per thousand impressions) bid.
 	// <span class="constraint Selectable">This field can be selected using the value "CpmBid".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group or criterion) at which the bid was set. This is applicable
 	// only at the criteria level.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CpmBidSource *BidSource `xml:"cpmBidSource,omitempty"`
+	CpmBidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 cpmBidSource,omitempty" json:"cpmBidSource,omitempty"`
 }
 
 type CustomParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameter"`
-
 	//
 	// The parameter key to be mapped.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 16, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// The value this parameter should be mapped to. It should be null if isRemove is true.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// On SET operation, indicates that the parameter should be removed from the existing parameters.
 	// If set to true, the value field must be null.
 	//
-	IsRemove bool `xml:"isRemove,omitempty"`
+	IsRemove *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isRemove,omitempty" json:"isRemove,omitempty"`
 }
 
 type CustomParameters struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameters"`
-
 	//
 	// The list of custom parameters.
 	//
 	// <p>On update, all parameters can be cleared by providing an empty or null list and setting
 	// doReplace to true.
 	//
-	Parameters []*CustomParameter `xml:"parameters,omitempty"`
+	Parameters []*CustomParameter `xml:"https://adwords.google.com/api/adwords/cm/v201802 parameters,omitempty" json:"parameters,omitempty"`
 
 	//
 	// On SET operation, indicates that the current parameters should be cleared and replaced
 	// with these parameters.
 	//
-	DoReplace bool `xml:"doReplace,omitempty"`
+	DoReplace *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 doReplace,omitempty" json:"doReplace,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type EnhancedCpcBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EnhancedCpcBiddingScheme"`
-
 	*BiddingScheme
 }
 
 type EntityAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityAccessDenied"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *EntityAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type EntityCountLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityCountLimitExceeded"`
-
 	*ApiError
 
 	//
 	// Specifies which level's limit was exceeded.
 	//
-	Reason *EntityCountLimitExceededReason `xml:"reason,omitempty"`
+	Reason *EntityCountLimitExceededReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Id of the entity whose limit was exceeded.
 	//
-	EnclosingId string `xml:"enclosingId,omitempty"`
+	EnclosingId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 enclosingId,omitempty" json:"enclosingId,omitempty"`
 
 	//
 	// The limit which was exceeded.
 	//
-	Limit int32 `xml:"limit,omitempty"`
+	Limit *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 limit,omitempty" json:"limit,omitempty"`
 
 	//
 	// The account limit type which was exceeded.
 	//
-	AccountLimitType string `xml:"accountLimitType,omitempty"`
+	AccountLimitType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 accountLimitType,omitempty" json:"accountLimitType,omitempty"`
 
 	//
 	// The count of existing entities.
 	//
-	ExistingCount int32 `xml:"existingCount,omitempty"`
+	ExistingCount *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 existingCount,omitempty" json:"existingCount,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ExplorerAutoOptimizerSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ExplorerAutoOptimizerSetting"`
-
 	*Setting
 
 	//
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel type SHOPPING with campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	OptIn bool `xml:"optIn,omitempty"`
+	OptIn *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 optIn,omitempty" json:"optIn,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type ForwardCompatibilityError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ForwardCompatibilityError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ForwardCompatibilityErrorReason `xml:"reason,omitempty"`
+	Reason *ForwardCompatibilityErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Label struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Label"`
-
 	//
 	// Id of label.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Name of label.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 80, inclusive.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Status of the label.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Status *LabelStatus `xml:"status,omitempty"`
+	Status *LabelStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// Attributes of the label.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	Attribute *LabelAttribute `xml:"attribute,omitempty"`
+	Attribute *LabelAttribute `xml:"https://adwords.google.com/api/adwords/cm/v201802 attribute,omitempty" json:"attribute,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Label.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	LabelType string `xml:"Label.Type,omitempty"`
+	LabelType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Label.Type,omitempty" json:"labelType,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type ManualCpcBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ManualCpcBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -3083,12 +2989,10 @@ type ManualCpcBiddingScheme struct {
 	// >AdWords Help Center</a>.
 	// <span class="constraint Selectable">This field can be selected using the value "EnhancedCpcEnabled".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	EnhancedCpcEnabled bool `xml:"enhancedCpcEnabled,omitempty"`
+	EnhancedCpcEnabled *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 enhancedCpcEnabled,omitempty" json:"enhancedCpcEnabled,omitempty"`
 }
 
 type ManualCpmBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ManualCpmBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -3097,12 +3001,10 @@ type ManualCpmBiddingScheme struct {
 	// selectable in CampaignService, using the value ViewableCpmEnabled.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ViewableCpmEnabled bool `xml:"viewableCpmEnabled,omitempty"`
+	ViewableCpmEnabled *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 viewableCpmEnabled,omitempty" json:"viewableCpmEnabled,omitempty"`
 }
 
 type MaximizeConversionValueBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MaximizeConversionValueBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -3112,164 +3014,138 @@ type MaximizeConversionValueBiddingScheme struct {
 	// bid strategy will aim to achieve the highest possible ROAS for the budget.
 	// <span class="constraint InRange">This field must be between 0.0 and 1.7976931348623157E308, inclusive.</span>
 	//
-	TargetRoas float64 `xml:"targetRoas,omitempty"`
+	TargetRoas *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoas,omitempty" json:"targetRoas,omitempty"`
 }
 
 type MaximizeConversionsBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MaximizeConversionsBiddingScheme"`
-
 	*BiddingScheme
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type MultiplierError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MultiplierError"`
-
 	*ApiError
 
-	Reason *MultiplierErrorReason `xml:"reason,omitempty"`
+	Reason *MultiplierErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NewEntityCreationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NewEntityCreationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NewEntityCreationErrorReason `xml:"reason,omitempty"`
+	Reason *NewEntityCreationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type PageOnePromotedBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PageOnePromotedBiddingScheme"`
-
 	*BiddingScheme
 
 	//
 	// Specifies the strategy goal: where impressions are desired to be shown on
 	// search result pages.
 	//
-	StrategyGoal *PageOnePromotedBiddingSchemeStrategyGoal `xml:"strategyGoal,omitempty"`
+	StrategyGoal *PageOnePromotedBiddingSchemeStrategyGoal `xml:"https://adwords.google.com/api/adwords/cm/v201802 strategyGoal,omitempty" json:"strategyGoal,omitempty"`
 
 	//
 	// Strategy maximum bid limit in advertiser local currency micro units.
 	// This upper limit applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// Bid Multiplier to be applied to the relevant bid estimate (depending on the strategyGoal)
 	// in determining a keyword's new max cpc bid.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidModifier float64 `xml:"bidModifier,omitempty"`
+	BidModifier *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidModifier,omitempty" json:"bidModifier,omitempty"`
 
 	//
 	// Controls whether the strategy always follows bid estimate changes, or only
@@ -3277,41 +3153,37 @@ type PageOnePromotedBiddingScheme struct {
 	// If true, only updates a keyword's bid if the current bid estimate is
 	// greater than the current bid.
 	//
-	BidChangesForRaisesOnly bool `xml:"bidChangesForRaisesOnly,omitempty"`
+	BidChangesForRaisesOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidChangesForRaisesOnly,omitempty" json:"bidChangesForRaisesOnly,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids when the throttling rate
 	// of the budget it is serving out of rises above a threshold.
 	//
-	RaiseBidWhenBudgetConstrained bool `xml:"raiseBidWhenBudgetConstrained,omitempty"`
+	RaiseBidWhenBudgetConstrained *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenBudgetConstrained,omitempty" json:"raiseBidWhenBudgetConstrained,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids on keywords with lower-range
 	// quality scores.
 	//
-	RaiseBidWhenLowQualityScore bool `xml:"raiseBidWhenLowQualityScore,omitempty"`
+	RaiseBidWhenLowQualityScore *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenLowQualityScore,omitempty" json:"raiseBidWhenLowQualityScore,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -3319,13 +3191,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -3334,108 +3206,90 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -3446,13 +3300,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -3461,80 +3315,72 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Setting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Setting"`
-
 	//
 	// Indicates that this instance is a subtype of Setting.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	SettingType string `xml:"Setting.Type,omitempty"`
+	SettingType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Setting.Type,omitempty" json:"settingType,omitempty"`
 }
 
 type SettingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SettingError"`
-
 	*ApiError
 
 	//
 	// The setting error reason.
 	//
-	Reason *SettingErrorReason `xml:"reason,omitempty"`
+	Reason *SettingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -3542,12 +3388,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -3555,81 +3401,71 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StatsQueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StatsQueryError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StatsQueryErrorReason `xml:"reason,omitempty"`
+	Reason *StatsQueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type String_StringMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 String_StringMapEntry"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
-	Key string `xml:"key,omitempty"`
-
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type TargetCpaBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetCpaBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -3637,24 +3473,22 @@ type TargetCpaBiddingScheme struct {
 	// minimum billable unit based on the currency for the account.
 	// <span class="constraint Selectable">This field can be selected using the value "TargetCpa".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TargetCpa *Money `xml:"targetCpa,omitempty"`
+	TargetCpa *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetCpa,omitempty" json:"targetCpa,omitempty"`
 
 	//
 	// Maximum cpc bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	MaxCpcBidCeiling *Money `xml:"maxCpcBidCeiling,omitempty"`
+	MaxCpcBidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidCeiling,omitempty" json:"maxCpcBidCeiling,omitempty"`
 
 	//
 	// Minimum cpc bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	MaxCpcBidFloor *Money `xml:"maxCpcBidFloor,omitempty"`
+	MaxCpcBidFloor *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidFloor,omitempty" json:"maxCpcBidFloor,omitempty"`
 }
 
 type TargetOutrankShareBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetOutrankShareBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -3664,40 +3498,38 @@ type TargetOutrankShareBiddingScheme struct {
 	// competitor does not.
 	// <span class="constraint InRange">This field must be between 1 and 1000000, inclusive.</span>
 	//
-	TargetOutrankShare int32 `xml:"targetOutrankShare,omitempty"`
+	TargetOutrankShare *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetOutrankShare,omitempty" json:"targetOutrankShare,omitempty"`
 
 	//
 	// Competitor's visible domain URL.
 	//
-	CompetitorDomain string `xml:"competitorDomain,omitempty"`
+	CompetitorDomain *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 competitorDomain,omitempty" json:"competitorDomain,omitempty"`
 
 	//
 	// Ceiling on max CPC bids.
 	//
-	MaxCpcBidCeiling *Money `xml:"maxCpcBidCeiling,omitempty"`
+	MaxCpcBidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidCeiling,omitempty" json:"maxCpcBidCeiling,omitempty"`
 
 	//
 	// Controls whether the strategy always follows bid estimate changes, or only increases. If false,
 	// always sets a keyword's new bid to the estimate that will meet the target. If true, only
 	// updates a keyword's bid if the current bid estimate is greater than the current bid.
 	//
-	BidChangesForRaisesOnly bool `xml:"bidChangesForRaisesOnly,omitempty"`
+	BidChangesForRaisesOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidChangesForRaisesOnly,omitempty" json:"bidChangesForRaisesOnly,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids on keywords with lower-range quality
 	// scores.
 	//
-	RaiseBidWhenLowQualityScore bool `xml:"raiseBidWhenLowQualityScore,omitempty"`
+	RaiseBidWhenLowQualityScore *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenLowQualityScore,omitempty" json:"raiseBidWhenLowQualityScore,omitempty"`
 }
 
 type TargetingSettingDetail struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetingSettingDetail"`
-
 	//
 	// The criterion type group that these settings apply to.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	CriterionTypeGroup *CriterionTypeGroup `xml:"criterionTypeGroup,omitempty"`
+	CriterionTypeGroup *CriterionTypeGroup `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterionTypeGroup,omitempty" json:"criterionTypeGroup,omitempty"`
 
 	//
 	// If true, criteria of this type can be used to modify bidding but will not restrict targeting
@@ -3707,43 +3539,39 @@ type TargetingSettingDetail struct {
 	// The default setting for a CriterionTypeGroup is false ("Target and Bid").
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	TargetAll bool `xml:"targetAll,omitempty"`
+	TargetAll *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetAll,omitempty" json:"targetAll,omitempty"`
 }
 
 type TargetRoasBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetRoasBiddingScheme"`
-
 	*BiddingScheme
 
 	//
 	// The target return on average spend (ROAS).
 	// <span class="constraint InRange">This field must be between 0.01 and 1000.0, inclusive.</span>
 	//
-	TargetRoas float64 `xml:"targetRoas,omitempty"`
+	TargetRoas *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoas,omitempty" json:"targetRoas,omitempty"`
 
 	//
 	// Maximum bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// Minimum bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidFloor *Money `xml:"bidFloor,omitempty"`
+	BidFloor *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidFloor,omitempty" json:"bidFloor,omitempty"`
 }
 
 type TargetSpendBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetSpendBiddingScheme"`
-
 	*BiddingScheme
 
 	//
 	// The largest max CPC bid that can be set by the TargetSpend bidder.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// A spend target under which to maximize clicks. The TargetSpend bidder will
@@ -3751,29 +3579,25 @@ type TargetSpendBiddingScheme struct {
 	// amount. If not specified, the budget is used as the spend target.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	SpendTarget *Money `xml:"spendTarget,omitempty"`
+	SpendTarget *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 spendTarget,omitempty" json:"spendTarget,omitempty"`
 }
 
 type TargetingSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetingSetting"`
-
 	*Setting
 
 	//
 	// The list of per-criterion-type-group targeting settings.
 	//
-	Details []*TargetingSettingDetail `xml:"details,omitempty"`
+	Details []*TargetingSettingDetail `xml:"https://adwords.google.com/api/adwords/cm/v201802 details,omitempty" json:"details,omitempty"`
 }
 
 type UrlError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *UrlErrorReason `xml:"reason,omitempty"`
+	Reason *UrlErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AdGroupServiceInterface struct {
@@ -0,0 +1,142 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AdGroupServiceAPI is the interface of the AdGroupService operations. It is
+// implemented by AdGroupServiceInterface and, for tests, by FakeAdGroupService.
+type AdGroupServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+	MutateLabel(request *MutateLabel) (*MutateLabelResponse, error)
+	Query(request *Query) (*QueryResponse, error)
+}
+
+var _ AdGroupServiceAPI = (*AdGroupServiceInterface)(nil)
+var _ AdGroupServiceAPI = (*FakeAdGroupService)(nil)
+
+// FakeCall is a call recorded by FakeAdGroupService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeAdGroupService is a programmable AdGroupServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeAdGroupService struct {
+	GetFunc         func(request *Get) (*GetResponse, error)
+	MutateFunc      func(request *Mutate) (*MutateResponse, error)
+	MutateLabelFunc func(request *MutateLabel) (*MutateLabelResponse, error)
+	QueryFunc       func(request *Query) (*QueryResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeAdGroupService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeAdGroupService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAdGroupService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeAdGroupService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeAdGroupService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeAdGroupService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeAdGroupService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
+
+// MutateLabel records the call and delegates to MutateLabelFunc.
+func (f *FakeAdGroupService) MutateLabel(request *MutateLabel) (*MutateLabelResponse, error) {
+	f.record("MutateLabel", request)
+	if f.MutateLabelFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupService.MutateLabelFunc is not set")
+	}
+	return f.MutateLabelFunc(request)
+}
+
+// MutateLabelCalls returns the requests of the recorded MutateLabel calls.
+func (f *FakeAdGroupService) MutateLabelCalls() []*MutateLabel {
+	var requests []*MutateLabel
+	for _, c := range f.Calls() {
+		if c.Method == "MutateLabel" {
+			requests = append(requests, c.Request.(*MutateLabel))
+		}
+	}
+	return requests
+}
+
+// Query records the call and delegates to QueryFunc.
+func (f *FakeAdGroupService) Query(request *Query) (*QueryResponse, error) {
+	f.record("Query", request)
+	if f.QueryFunc == nil {
+		return nil, fmt.Errorf("FakeAdGroupService.QueryFunc is not set")
+	}
+	return f.QueryFunc(request)
+}
+
+// QueryCalls returns the requests of the recorded Query calls.
+func (f *FakeAdGroupService) QueryCalls() []*Query {
+	var requests []*Query
+	for _, c := range f.Calls() {
+		if c.Method == "Query" {
+			requests = append(requests, c.Request.(*Query))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,1124 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateLabel) Copy() *MutateLabel {
+	return deep.Copy(t).(*MutateLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateLabel) Equal(o *MutateLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateLabelResponse) Copy() *MutateLabelResponse {
+	return deep.Copy(t).(*MutateLabelResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateLabelResponse) Equal(o *MutateLabelResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroup) Copy() *AdGroup {
+	return deep.Copy(t).(*AdGroup)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroup) Equal(o *AdGroup) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupAdRotationMode) Copy() *AdGroupAdRotationMode {
+	return deep.Copy(t).(*AdGroupAdRotationMode)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupAdRotationMode) Equal(o *AdGroupAdRotationMode) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupLabel) Copy() *AdGroupLabel {
+	return deep.Copy(t).(*AdGroupLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupLabel) Equal(o *AdGroupLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupLabelOperation) Copy() *AdGroupLabelOperation {
+	return deep.Copy(t).(*AdGroupLabelOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupLabelOperation) Equal(o *AdGroupLabelOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupLabelReturnValue) Copy() *AdGroupLabelReturnValue {
+	return deep.Copy(t).(*AdGroupLabelReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupLabelReturnValue) Equal(o *AdGroupLabelReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupOperation) Copy() *AdGroupOperation {
+	return deep.Copy(t).(*AdGroupOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupOperation) Equal(o *AdGroupOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupPage) Copy() *AdGroupPage {
+	return deep.Copy(t).(*AdGroupPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupPage) Equal(o *AdGroupPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupReturnValue) Copy() *AdGroupReturnValue {
+	return deep.Copy(t).(*AdGroupReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupReturnValue) Equal(o *AdGroupReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupServiceError) Copy() *AdGroupServiceError {
+	return deep.Copy(t).(*AdGroupServiceError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupServiceError) Equal(o *AdGroupServiceError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdxError) Copy() *AdxError {
+	return deep.Copy(t).(*AdxError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdxError) Equal(o *AdxError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LabelAttribute) Copy() *LabelAttribute {
+	return deep.Copy(t).(*LabelAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LabelAttribute) Equal(o *LabelAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingErrors) Copy() *BiddingErrors {
+	return deep.Copy(t).(*BiddingErrors)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingErrors) Equal(o *BiddingErrors) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingScheme) Copy() *BiddingScheme {
+	return deep.Copy(t).(*BiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingScheme) Equal(o *BiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingStrategyConfiguration) Copy() *BiddingStrategyConfiguration {
+	return deep.Copy(t).(*BiddingStrategyConfiguration)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingStrategyConfiguration) Equal(o *BiddingStrategyConfiguration) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Bids) Copy() *Bids {
+	return deep.Copy(t).(*Bids)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Bids) Equal(o *Bids) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TextLabel) Copy() *TextLabel {
+	return deep.Copy(t).(*TextLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TextLabel) Equal(o *TextLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DisplayAttribute) Copy() *DisplayAttribute {
+	return deep.Copy(t).(*DisplayAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DisplayAttribute) Equal(o *DisplayAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ComparableValue) Copy() *ComparableValue {
+	return deep.Copy(t).(*ComparableValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ComparableValue) Equal(o *ComparableValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpaBid) Copy() *CpaBid {
+	return deep.Copy(t).(*CpaBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpaBid) Equal(o *CpaBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpcBid) Copy() *CpcBid {
+	return deep.Copy(t).(*CpcBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpcBid) Equal(o *CpcBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpmBid) Copy() *CpmBid {
+	return deep.Copy(t).(*CpmBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpmBid) Equal(o *CpmBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameter) Copy() *CustomParameter {
+	return deep.Copy(t).(*CustomParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameter) Equal(o *CustomParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameters) Copy() *CustomParameters {
+	return deep.Copy(t).(*CustomParameters)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameters) Equal(o *CustomParameters) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DoubleValue) Copy() *DoubleValue {
+	return deep.Copy(t).(*DoubleValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DoubleValue) Equal(o *DoubleValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EnhancedCpcBiddingScheme) Copy() *EnhancedCpcBiddingScheme {
+	return deep.Copy(t).(*EnhancedCpcBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EnhancedCpcBiddingScheme) Equal(o *EnhancedCpcBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityAccessDenied) Copy() *EntityAccessDenied {
+	return deep.Copy(t).(*EntityAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityAccessDenied) Equal(o *EntityAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityCountLimitExceeded) Copy() *EntityCountLimitExceeded {
+	return deep.Copy(t).(*EntityCountLimitExceeded)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityCountLimitExceeded) Equal(o *EntityCountLimitExceeded) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityNotFound) Copy() *EntityNotFound {
+	return deep.Copy(t).(*EntityNotFound)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityNotFound) Equal(o *EntityNotFound) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExplorerAutoOptimizerSetting) Copy() *ExplorerAutoOptimizerSetting {
+	return deep.Copy(t).(*ExplorerAutoOptimizerSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExplorerAutoOptimizerSetting) Equal(o *ExplorerAutoOptimizerSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ForwardCompatibilityError) Copy() *ForwardCompatibilityError {
+	return deep.Copy(t).(*ForwardCompatibilityError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ForwardCompatibilityError) Equal(o *ForwardCompatibilityError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Label) Copy() *Label {
+	return deep.Copy(t).(*Label)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Label) Equal(o *Label) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LongValue) Copy() *LongValue {
+	return deep.Copy(t).(*LongValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LongValue) Equal(o *LongValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ManualCpcBiddingScheme) Copy() *ManualCpcBiddingScheme {
+	return deep.Copy(t).(*ManualCpcBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ManualCpcBiddingScheme) Equal(o *ManualCpcBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ManualCpmBiddingScheme) Copy() *ManualCpmBiddingScheme {
+	return deep.Copy(t).(*ManualCpmBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ManualCpmBiddingScheme) Equal(o *ManualCpmBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MaximizeConversionValueBiddingScheme) Copy() *MaximizeConversionValueBiddingScheme {
+	return deep.Copy(t).(*MaximizeConversionValueBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MaximizeConversionValueBiddingScheme) Equal(o *MaximizeConversionValueBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MaximizeConversionsBiddingScheme) Copy() *MaximizeConversionsBiddingScheme {
+	return deep.Copy(t).(*MaximizeConversionsBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MaximizeConversionsBiddingScheme) Equal(o *MaximizeConversionsBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Money) Copy() *Money {
+	return deep.Copy(t).(*Money)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Money) Equal(o *Money) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MultiplierError) Copy() *MultiplierError {
+	return deep.Copy(t).(*MultiplierError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MultiplierError) Equal(o *MultiplierError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NewEntityCreationError) Copy() *NewEntityCreationError {
+	return deep.Copy(t).(*NewEntityCreationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NewEntityCreationError) Equal(o *NewEntityCreationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NumberValue) Copy() *NumberValue {
+	return deep.Copy(t).(*NumberValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NumberValue) Equal(o *NumberValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PageOnePromotedBiddingScheme) Copy() *PageOnePromotedBiddingScheme {
+	return deep.Copy(t).(*PageOnePromotedBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PageOnePromotedBiddingScheme) Equal(o *PageOnePromotedBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Setting) Copy() *Setting {
+	return deep.Copy(t).(*Setting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Setting) Equal(o *Setting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SettingError) Copy() *SettingError {
+	return deep.Copy(t).(*SettingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SettingError) Equal(o *SettingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StatsQueryError) Copy() *StatsQueryError {
+	return deep.Copy(t).(*StatsQueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StatsQueryError) Equal(o *StatsQueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *String_StringMapEntry) Copy() *String_StringMapEntry {
+	return deep.Copy(t).(*String_StringMapEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *String_StringMapEntry) Equal(o *String_StringMapEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetCpaBiddingScheme) Copy() *TargetCpaBiddingScheme {
+	return deep.Copy(t).(*TargetCpaBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetCpaBiddingScheme) Equal(o *TargetCpaBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetOutrankShareBiddingScheme) Copy() *TargetOutrankShareBiddingScheme {
+	return deep.Copy(t).(*TargetOutrankShareBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetOutrankShareBiddingScheme) Equal(o *TargetOutrankShareBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetingSettingDetail) Copy() *TargetingSettingDetail {
+	return deep.Copy(t).(*TargetingSettingDetail)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetingSettingDetail) Equal(o *TargetingSettingDetail) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetRoasBiddingScheme) Copy() *TargetRoasBiddingScheme {
+	return deep.Copy(t).(*TargetRoasBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetRoasBiddingScheme) Equal(o *TargetRoasBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetSpendBiddingScheme) Copy() *TargetSpendBiddingScheme {
+	return deep.Copy(t).(*TargetSpendBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetSpendBiddingScheme) Equal(o *TargetSpendBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetingSetting) Copy() *TargetingSetting {
+	return deep.Copy(t).(*TargetingSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetingSetting) Equal(o *TargetingSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlError) Copy() *UrlError {
+	return deep.Copy(t).(*UrlError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlError) Equal(o *UrlError) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffAdGroup compares from, as fetched from the API, with the desired
+// to. It returns a AdGroup holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdGroup(from, to *AdGroup) (*AdGroup, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"CampaignId", "Name", "Status", "Settings", "ForwardCompatibilityMap", "BiddingStrategyConfiguration", "ContentBidCriterionTypeGroup", "BaseAdGroupId", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "AdGroupAdRotationMode"},
+		[]string{"Id"},
+	).(*AdGroup)
+	return set, changes
+}
+
+// SetAdGroupOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffAdGroup.
+func SetAdGroupOperation(from, to *AdGroup) (*AdGroupOperation, []deep.Change) {
+	set, changes := DiffAdGroup(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &AdGroupOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+
+// DiffAdGroupAdRotationMode compares from, as fetched from the API, with the desired
+// to. It returns a AdGroupAdRotationMode holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdGroupAdRotationMode(from, to *AdGroupAdRotationMode) (*AdGroupAdRotationMode, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"AdRotationMode"},
+		nil,
+	).(*AdGroupAdRotationMode)
+	return set, changes
+}
+
+// DiffBiddingStrategyConfiguration compares from, as fetched from the API, with the desired
+// to. It returns a BiddingStrategyConfiguration holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffBiddingStrategyConfiguration(from, to *BiddingStrategyConfiguration) (*BiddingStrategyConfiguration, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"BiddingStrategyType", "BiddingScheme", "Bids", "TargetRoasOverride"},
+		[]string{"BiddingStrategyId"},
+	).(*BiddingStrategyConfiguration)
+	return set, changes
+}
+
+// DiffCpaBid compares from, as fetched from the API, with the desired
+// to. It returns a CpaBid holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCpaBid(from, to *CpaBid) (*CpaBid, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Bid"},
+		nil,
+	).(*CpaBid)
+	return set, changes
+}
+
+// DiffCpcBid compares from, as fetched from the API, with the desired
+// to. It returns a CpcBid holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCpcBid(from, to *CpcBid) (*CpcBid, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Bid"},
+		nil,
+	).(*CpcBid)
+	return set, changes
+}
+
+// DiffCpmBid compares from, as fetched from the API, with the desired
+// to. It returns a CpmBid holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCpmBid(from, to *CpmBid) (*CpmBid, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Bid"},
+		nil,
+	).(*CpmBid)
+	return set, changes
+}
+
+// DiffManualCpcBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a ManualCpcBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffManualCpcBiddingScheme(from, to *ManualCpcBiddingScheme) (*ManualCpcBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"EnhancedCpcEnabled"},
+		nil,
+	).(*ManualCpcBiddingScheme)
+	return set, changes
+}
+
+// DiffTargetCpaBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a TargetCpaBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffTargetCpaBiddingScheme(from, to *TargetCpaBiddingScheme) (*TargetCpaBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"TargetCpa", "MaxCpcBidCeiling", "MaxCpcBidFloor"},
+		nil,
+	).(*TargetCpaBiddingScheme)
+	return set, changes
+}
@@ -0,0 +1,2648 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupService
+
+import "fmt"
+
+// AdGroupServiceErrorReasonValues returns all values of AdGroupServiceErrorReason.
+func AdGroupServiceErrorReasonValues() []AdGroupServiceErrorReason {
+	return []AdGroupServiceErrorReason{
+		AdGroupServiceErrorReasonDUPLICATE_ADGROUP_NAME,
+		AdGroupServiceErrorReasonINVALID_ADGROUP_NAME,
+		AdGroupServiceErrorReasonUSE_SET_OPERATOR_AND_MARK_STATUS_TO_REMOVED,
+		AdGroupServiceErrorReasonADVERTISER_NOT_ON_CONTENT_NETWORK,
+		AdGroupServiceErrorReasonBID_TOO_BIG,
+		AdGroupServiceErrorReasonBID_TYPE_AND_BIDDING_STRATEGY_MISMATCH,
+		AdGroupServiceErrorReasonMISSING_ADGROUP_NAME,
+		AdGroupServiceErrorReasonADGROUP_LABEL_DOES_NOT_EXIST,
+		AdGroupServiceErrorReasonADGROUP_LABEL_ALREADY_EXISTS,
+		AdGroupServiceErrorReasonINVALID_CONTENT_BID_CRITERION_TYPE_GROUP,
+		AdGroupServiceErrorReasonAD_GROUP_TYPE_NOT_VALID_FOR_ADVERTISING_CHANNEL_TYPE,
+		AdGroupServiceErrorReasonADGROUP_TYPE_NOT_SUPPORTED_FOR_CAMPAIGN_SALES_COUNTRY,
+		AdGroupServiceErrorReasonCANNOT_ADD_ADGROUP_OF_TYPE_DSA_TO_CAMPAIGN_WITHOUT_DSA_SETTING,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupServiceErrorReason.
+func (e AdGroupServiceErrorReason) IsValid() bool {
+	switch e {
+	case AdGroupServiceErrorReasonDUPLICATE_ADGROUP_NAME,
+		AdGroupServiceErrorReasonINVALID_ADGROUP_NAME,
+		AdGroupServiceErrorReasonUSE_SET_OPERATOR_AND_MARK_STATUS_TO_REMOVED,
+		AdGroupServiceErrorReasonADVERTISER_NOT_ON_CONTENT_NETWORK,
+		AdGroupServiceErrorReasonBID_TOO_BIG,
+		AdGroupServiceErrorReasonBID_TYPE_AND_BIDDING_STRATEGY_MISMATCH,
+		AdGroupServiceErrorReasonMISSING_ADGROUP_NAME,
+		AdGroupServiceErrorReasonADGROUP_LABEL_DOES_NOT_EXIST,
+		AdGroupServiceErrorReasonADGROUP_LABEL_ALREADY_EXISTS,
+		AdGroupServiceErrorReasonINVALID_CONTENT_BID_CRITERION_TYPE_GROUP,
+		AdGroupServiceErrorReasonAD_GROUP_TYPE_NOT_VALID_FOR_ADVERTISING_CHANNEL_TYPE,
+		AdGroupServiceErrorReasonADGROUP_TYPE_NOT_SUPPORTED_FOR_CAMPAIGN_SALES_COUNTRY,
+		AdGroupServiceErrorReasonCANNOT_ADD_ADGROUP_OF_TYPE_DSA_TO_CAMPAIGN_WITHOUT_DSA_SETTING:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupServiceErrorReason returns the AdGroupServiceErrorReason named by s.
+func ParseAdGroupServiceErrorReason(s string) (AdGroupServiceErrorReason, error) {
+	e := AdGroupServiceErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupServiceErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdGroupServiceErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdGroupServiceErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdGroupServiceErrorReason) UnmarshalText(text []byte) error {
+	v := AdGroupServiceErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AdGroupStatusValues returns all values of AdGroupStatus.
+func AdGroupStatusValues() []AdGroupStatus {
+	return []AdGroupStatus{
+		AdGroupStatusUNKNOWN,
+		AdGroupStatusENABLED,
+		AdGroupStatusPAUSED,
+		AdGroupStatusREMOVED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupStatus.
+func (e AdGroupStatus) IsValid() bool {
+	switch e {
+	case AdGroupStatusUNKNOWN,
+		AdGroupStatusENABLED,
+		AdGroupStatusPAUSED,
+		AdGroupStatusREMOVED:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupStatus returns the AdGroupStatus named by s.
+func ParseAdGroupStatus(s string) (AdGroupStatus, error) {
+	e := AdGroupStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdGroupStatus.
+func (e AdGroupStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdGroupStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdGroupStatus) UnmarshalText(text []byte) error {
+	v := AdGroupStatus(text)
+	if !v.IsValid() {
+		v = AdGroupStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdGroupTypeValues returns all values of AdGroupType.
+func AdGroupTypeValues() []AdGroupType {
+	return []AdGroupType{
+		AdGroupTypeUNKNOWN,
+		AdGroupTypeSEARCH_STANDARD,
+		AdGroupTypeSEARCH_DYNAMIC_ADS,
+		AdGroupTypeDISPLAY_STANDARD,
+		AdGroupTypeSHOPPING_PRODUCT_ADS,
+		AdGroupTypeSHOPPING_SHOWCASE_ADS,
+		AdGroupTypeSHOPPING_UNIVERSAL_ADS,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupType.
+func (e AdGroupType) IsValid() bool {
+	switch e {
+	case AdGroupTypeUNKNOWN,
+		AdGroupTypeSEARCH_STANDARD,
+		AdGroupTypeSEARCH_DYNAMIC_ADS,
+		AdGroupTypeDISPLAY_STANDARD,
+		AdGroupTypeSHOPPING_PRODUCT_ADS,
+		AdGroupTypeSHOPPING_SHOWCASE_ADS,
+		AdGroupTypeSHOPPING_UNIVERSAL_ADS:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupType returns the AdGroupType named by s.
+func ParseAdGroupType(s string) (AdGroupType, error) {
+	e := AdGroupType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdGroupType.
+func (e AdGroupType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdGroupType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdGroupType) UnmarshalText(text []byte) error {
+	v := AdGroupType(text)
+	if !v.IsValid() {
+		v = AdGroupTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdRotationModeValues returns all values of AdRotationMode.
+func AdRotationModeValues() []AdRotationMode {
+	return []AdRotationMode{
+		AdRotationModeUNKNOWN,
+		AdRotationModeOPTIMIZE,
+		AdRotationModeROTATE_FOREVER,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdRotationMode.
+func (e AdRotationMode) IsValid() bool {
+	switch e {
+	case AdRotationModeUNKNOWN,
+		AdRotationModeOPTIMIZE,
+		AdRotationModeROTATE_FOREVER:
+		return true
+	}
+	return false
+}
+
+// ParseAdRotationMode returns the AdRotationMode named by s.
+func ParseAdRotationMode(s string) (AdRotationMode, error) {
+	e := AdRotationMode(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdRotationMode %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdRotationMode.
+func (e AdRotationMode) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdRotationMode %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdRotationMode) UnmarshalText(text []byte) error {
+	v := AdRotationMode(text)
+	if !v.IsValid() {
+		v = AdRotationModeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdxErrorReasonValues returns all values of AdxErrorReason.
+func AdxErrorReasonValues() []AdxErrorReason {
+	return []AdxErrorReason{
+		AdxErrorReasonUNSUPPORTED_FEATURE,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdxErrorReason.
+func (e AdxErrorReason) IsValid() bool {
+	switch e {
+	case AdxErrorReasonUNSUPPORTED_FEATURE:
+		return true
+	}
+	return false
+}
+
+// ParseAdxErrorReason returns the AdxErrorReason named by s.
+func ParseAdxErrorReason(s string) (AdxErrorReason, error) {
+	e := AdxErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdxErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdxErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdxErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdxErrorReason) UnmarshalText(text []byte) error {
+	v := AdxErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthenticationErrorReasonValues returns all values of AuthenticationErrorReason.
+func AuthenticationErrorReasonValues() []AuthenticationErrorReason {
+	return []AuthenticationErrorReason{
+		AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthenticationErrorReason.
+func (e AuthenticationErrorReason) IsValid() bool {
+	switch e {
+	case AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID:
+		return true
+	}
+	return false
+}
+
+// ParseAuthenticationErrorReason returns the AuthenticationErrorReason named by s.
+func ParseAuthenticationErrorReason(s string) (AuthenticationErrorReason, error) {
+	e := AuthenticationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthenticationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthenticationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthenticationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthenticationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthenticationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthorizationErrorReasonValues returns all values of AuthorizationErrorReason.
+func AuthorizationErrorReasonValues() []AuthorizationErrorReason {
+	return []AuthorizationErrorReason{
+		AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthorizationErrorReason.
+func (e AuthorizationErrorReason) IsValid() bool {
+	switch e {
+	case AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseAuthorizationErrorReason returns the AuthorizationErrorReason named by s.
+func ParseAuthorizationErrorReason(s string) (AuthorizationErrorReason, error) {
+	e := AuthorizationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthorizationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthorizationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthorizationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthorizationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthorizationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// BidSourceValues returns all values of BidSource.
+func BidSourceValues() []BidSource {
+	return []BidSource{
+		BidSourceADGROUP,
+		BidSourceCRITERION,
+		BidSourceADGROUP_BIDDING_STRATEGY,
+		BidSourceCAMPAIGN_BIDDING_STRATEGY,
+	}
+}
+
+// IsValid reports whether e is one of the values of BidSource.
+func (e BidSource) IsValid() bool {
+	switch e {
+	case BidSourceADGROUP,
+		BidSourceCRITERION,
+		BidSourceADGROUP_BIDDING_STRATEGY,
+		BidSourceCAMPAIGN_BIDDING_STRATEGY:
+		return true
+	}
+	return false
+}
+
+// ParseBidSource returns the BidSource named by s.
+func ParseBidSource(s string) (BidSource, error) {
+	e := BidSource(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid BidSource %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// BidSource are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e BidSource) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *BidSource) UnmarshalText(text []byte) error {
+	v := BidSource(text)
+	*e = v
+	return nil
+}
+
+// BiddingErrorsReasonValues returns all values of BiddingErrorsReason.
+func BiddingErrorsReasonValues() []BiddingErrorsReason {
+	return []BiddingErrorsReason{
+		BiddingErrorsReasonBIDDING_STRATEGY_TRANSITION_NOT_ALLOWED,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_COMPATIBLE_WITH_ADGROUP_OVERRIDES,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_COMPATIBLE_WITH_ADGROUP_CRITERIA_OVERRIDES,
+		BiddingErrorsReasonCAMPAIGN_BIDDING_STRATEGY_CANNOT_BE_OVERRIDDEN,
+		BiddingErrorsReasonADGROUP_BIDDING_STRATEGY_CANNOT_BE_OVERRIDDEN,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_CAMPAIGN,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_ADGROUP,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_ADGROUP_CRITERIA,
+		BiddingErrorsReasonINVALID_ANONYMOUS_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonBIDS_NOT_ALLLOWED,
+		BiddingErrorsReasonDUPLICATE_BIDS,
+		BiddingErrorsReasonINVALID_BIDDING_SCHEME,
+		BiddingErrorsReasonINVALID_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonMISSING_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonNULL_BID,
+		BiddingErrorsReasonINVALID_BID,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_AVAILABLE_FOR_ACCOUNT_TYPE,
+		BiddingErrorsReasonCONVERSION_TRACKING_NOT_ENABLED,
+		BiddingErrorsReasonNOT_ENOUGH_CONVERSIONS,
+		BiddingErrorsReasonCANNOT_CREATE_CAMPAIGN_WITH_BIDDING_STRATEGY,
+		BiddingErrorsReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_AD_GROUP_LEVEL_POP_BIDDING_STRATEGY,
+		BiddingErrorsReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_CAMPAIGN_LEVEL_POP_BIDDING_STRATEGY,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_SUPPORTED_WITH_AD_SCHEDULE,
+		BiddingErrorsReasonPAY_PER_CONVERSION_NOT_AVAILABLE_FOR_CUSTOMER,
+		BiddingErrorsReasonPAY_PER_CONVERSION_NOT_ALLOWED_WITH_TARGET_CPA,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_ALLOWED_FOR_SEARCH_ONLY_CAMPAIGNS,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_SUPPORTED_IN_DRAFTS_OR_EXPERIMENTS,
+		BiddingErrorsReasonBIDDING_STRATEGY_TYPE_DOES_NOT_SUPPORT_PRODUCT_TYPE_ADGROUP_CRITERION,
+		BiddingErrorsReasonBID_TOO_SMALL,
+		BiddingErrorsReasonBID_TOO_BIG,
+		BiddingErrorsReasonBID_TOO_MANY_FRACTIONAL_DIGITS,
+		BiddingErrorsReasonENHANCED_CPC_ENABLED_NOT_SUPPORTED_ON_PORTFOLIO_TARGET_SPEND_STRATEGY,
+		BiddingErrorsReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of BiddingErrorsReason.
+func (e BiddingErrorsReason) IsValid() bool {
+	switch e {
+	case BiddingErrorsReasonBIDDING_STRATEGY_TRANSITION_NOT_ALLOWED,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_COMPATIBLE_WITH_ADGROUP_OVERRIDES,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_COMPATIBLE_WITH_ADGROUP_CRITERIA_OVERRIDES,
+		BiddingErrorsReasonCAMPAIGN_BIDDING_STRATEGY_CANNOT_BE_OVERRIDDEN,
+		BiddingErrorsReasonADGROUP_BIDDING_STRATEGY_CANNOT_BE_OVERRIDDEN,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_CAMPAIGN,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_ADGROUP,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_ADGROUP_CRITERIA,
+		BiddingErrorsReasonINVALID_ANONYMOUS_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonBIDS_NOT_ALLLOWED,
+		BiddingErrorsReasonDUPLICATE_BIDS,
+		BiddingErrorsReasonINVALID_BIDDING_SCHEME,
+		BiddingErrorsReasonINVALID_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonMISSING_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonNULL_BID,
+		BiddingErrorsReasonINVALID_BID,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_AVAILABLE_FOR_ACCOUNT_TYPE,
+		BiddingErrorsReasonCONVERSION_TRACKING_NOT_ENABLED,
+		BiddingErrorsReasonNOT_ENOUGH_CONVERSIONS,
+		BiddingErrorsReasonCANNOT_CREATE_CAMPAIGN_WITH_BIDDING_STRATEGY,
+		BiddingErrorsReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_AD_GROUP_LEVEL_POP_BIDDING_STRATEGY,
+		BiddingErrorsReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_CAMPAIGN_LEVEL_POP_BIDDING_STRATEGY,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_SUPPORTED_WITH_AD_SCHEDULE,
+		BiddingErrorsReasonPAY_PER_CONVERSION_NOT_AVAILABLE_FOR_CUSTOMER,
+		BiddingErrorsReasonPAY_PER_CONVERSION_NOT_ALLOWED_WITH_TARGET_CPA,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_ALLOWED_FOR_SEARCH_ONLY_CAMPAIGNS,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_SUPPORTED_IN_DRAFTS_OR_EXPERIMENTS,
+		BiddingErrorsReasonBIDDING_STRATEGY_TYPE_DOES_NOT_SUPPORT_PRODUCT_TYPE_ADGROUP_CRITERION,
+		BiddingErrorsReasonBID_TOO_SMALL,
+		BiddingErrorsReasonBID_TOO_BIG,
+		BiddingErrorsReasonBID_TOO_MANY_FRACTIONAL_DIGITS,
+		BiddingErrorsReasonENHANCED_CPC_ENABLED_NOT_SUPPORTED_ON_PORTFOLIO_TARGET_SPEND_STRATEGY,
+		BiddingErrorsReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseBiddingErrorsReason returns the BiddingErrorsReason named by s.
+func ParseBiddingErrorsReason(s string) (BiddingErrorsReason, error) {
+	e := BiddingErrorsReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid BiddingErrorsReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of BiddingErrorsReason.
+func (e BiddingErrorsReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid BiddingErrorsReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *BiddingErrorsReason) UnmarshalText(text []byte) error {
+	v := BiddingErrorsReason(text)
+	if !v.IsValid() {
+		v = BiddingErrorsReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// BiddingStrategySourceValues returns all values of BiddingStrategySource.
+func BiddingStrategySourceValues() []BiddingStrategySource {
+	return []BiddingStrategySource{
+		BiddingStrategySourceCAMPAIGN,
+		BiddingStrategySourceADGROUP,
+		BiddingStrategySourceCRITERION,
+	}
+}
+
+// IsValid reports whether e is one of the values of BiddingStrategySource.
+func (e BiddingStrategySource) IsValid() bool {
+	switch e {
+	case BiddingStrategySourceCAMPAIGN,
+		BiddingStrategySourceADGROUP,
+		BiddingStrategySourceCRITERION:
+		return true
+	}
+	return false
+}
+
+// ParseBiddingStrategySource returns the BiddingStrategySource named by s.
+func ParseBiddingStrategySource(s string) (BiddingStrategySource, error) {
+	e := BiddingStrategySource(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid BiddingStrategySource %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// BiddingStrategySource are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e BiddingStrategySource) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *BiddingStrategySource) UnmarshalText(text []byte) error {
+	v := BiddingStrategySource(text)
+	*e = v
+	return nil
+}
+
+// BiddingStrategyTypeValues returns all values of BiddingStrategyType.
+func BiddingStrategyTypeValues() []BiddingStrategyType {
+	return []BiddingStrategyType{
+		BiddingStrategyTypeMANUAL_CPC,
+		BiddingStrategyTypeMANUAL_CPM,
+		BiddingStrategyTypePAGE_ONE_PROMOTED,
+		BiddingStrategyTypeTARGET_SPEND,
+		BiddingStrategyTypeENHANCED_CPC,
+		BiddingStrategyTypeTARGET_CPA,
+		BiddingStrategyTypeTARGET_ROAS,
+		BiddingStrategyTypeMAXIMIZE_CONVERSIONS,
+		BiddingStrategyTypeMAXIMIZE_CONVERSION_VALUE,
+		BiddingStrategyTypeTARGET_OUTRANK_SHARE,
+		BiddingStrategyTypeNONE,
+		BiddingStrategyTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of BiddingStrategyType.
+func (e BiddingStrategyType) IsValid() bool {
+	switch e {
+	case BiddingStrategyTypeMANUAL_CPC,
+		BiddingStrategyTypeMANUAL_CPM,
+		BiddingStrategyTypePAGE_ONE_PROMOTED,
+		BiddingStrategyTypeTARGET_SPEND,
+		BiddingStrategyTypeENHANCED_CPC,
+		BiddingStrategyTypeTARGET_CPA,
+		BiddingStrategyTypeTARGET_ROAS,
+		BiddingStrategyTypeMAXIMIZE_CONVERSIONS,
+		BiddingStrategyTypeMAXIMIZE_CONVERSION_VALUE,
+		BiddingStrategyTypeTARGET_OUTRANK_SHARE,
+		BiddingStrategyTypeNONE,
+		BiddingStrategyTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseBiddingStrategyType returns the BiddingStrategyType named by s.
+func ParseBiddingStrategyType(s string) (BiddingStrategyType, error) {
+	e := BiddingStrategyType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid BiddingStrategyType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of BiddingStrategyType.
+func (e BiddingStrategyType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid BiddingStrategyType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *BiddingStrategyType) UnmarshalText(text []byte) error {
+	v := BiddingStrategyType(text)
+	if !v.IsValid() {
+		v = BiddingStrategyTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ClientTermsErrorReasonValues returns all values of ClientTermsErrorReason.
+func ClientTermsErrorReasonValues() []ClientTermsErrorReason {
+	return []ClientTermsErrorReason{
+		ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ClientTermsErrorReason.
+func (e ClientTermsErrorReason) IsValid() bool {
+	switch e {
+	case ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED:
+		return true
+	}
+	return false
+}
+
+// ParseClientTermsErrorReason returns the ClientTermsErrorReason named by s.
+func ParseClientTermsErrorReason(s string) (ClientTermsErrorReason, error) {
+	e := ClientTermsErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ClientTermsErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ClientTermsErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ClientTermsErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ClientTermsErrorReason) UnmarshalText(text []byte) error {
+	v := ClientTermsErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CriterionTypeGroupValues returns all values of CriterionTypeGroup.
+func CriterionTypeGroupValues() []CriterionTypeGroup {
+	return []CriterionTypeGroup{
+		CriterionTypeGroupKEYWORD,
+		CriterionTypeGroupUSER_INTEREST_AND_LIST,
+		CriterionTypeGroupVERTICAL,
+		CriterionTypeGroupGENDER,
+		CriterionTypeGroupAGE_RANGE,
+		CriterionTypeGroupPLACEMENT,
+		CriterionTypeGroupPARENT,
+		CriterionTypeGroupINCOME_RANGE,
+		CriterionTypeGroupNONE,
+		CriterionTypeGroupUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CriterionTypeGroup.
+func (e CriterionTypeGroup) IsValid() bool {
+	switch e {
+	case CriterionTypeGroupKEYWORD,
+		CriterionTypeGroupUSER_INTEREST_AND_LIST,
+		CriterionTypeGroupVERTICAL,
+		CriterionTypeGroupGENDER,
+		CriterionTypeGroupAGE_RANGE,
+		CriterionTypeGroupPLACEMENT,
+		CriterionTypeGroupPARENT,
+		CriterionTypeGroupINCOME_RANGE,
+		CriterionTypeGroupNONE,
+		CriterionTypeGroupUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCriterionTypeGroup returns the CriterionTypeGroup named by s.
+func ParseCriterionTypeGroup(s string) (CriterionTypeGroup, error) {
+	e := CriterionTypeGroup(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CriterionTypeGroup %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CriterionTypeGroup.
+func (e CriterionTypeGroup) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CriterionTypeGroup %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CriterionTypeGroup) UnmarshalText(text []byte) error {
+	v := CriterionTypeGroup(text)
+	if !v.IsValid() {
+		v = CriterionTypeGroupUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DatabaseErrorReasonValues returns all values of DatabaseErrorReason.
+func DatabaseErrorReasonValues() []DatabaseErrorReason {
+	return []DatabaseErrorReason{
+		DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DatabaseErrorReason.
+func (e DatabaseErrorReason) IsValid() bool {
+	switch e {
+	case DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDatabaseErrorReason returns the DatabaseErrorReason named by s.
+func ParseDatabaseErrorReason(s string) (DatabaseErrorReason, error) {
+	e := DatabaseErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DatabaseErrorReason.
+func (e DatabaseErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DatabaseErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DatabaseErrorReason) UnmarshalText(text []byte) error {
+	v := DatabaseErrorReason(text)
+	if !v.IsValid() {
+		v = DatabaseErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DateErrorReasonValues returns all values of DateErrorReason.
+func DateErrorReasonValues() []DateErrorReason {
+	return []DateErrorReason{
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL,
+	}
+}
+
+// IsValid reports whether e is one of the values of DateErrorReason.
+func (e DateErrorReason) IsValid() bool {
+	switch e {
+	case DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL:
+		return true
+	}
+	return false
+}
+
+// ParseDateErrorReason returns the DateErrorReason named by s.
+func ParseDateErrorReason(s string) (DateErrorReason, error) {
+	e := DateErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DateErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DateErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DateErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DateErrorReason) UnmarshalText(text []byte) error {
+	v := DateErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DistinctErrorReasonValues returns all values of DistinctErrorReason.
+func DistinctErrorReasonValues() []DistinctErrorReason {
+	return []DistinctErrorReason{
+		DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DistinctErrorReason.
+func (e DistinctErrorReason) IsValid() bool {
+	switch e {
+	case DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDistinctErrorReason returns the DistinctErrorReason named by s.
+func ParseDistinctErrorReason(s string) (DistinctErrorReason, error) {
+	e := DistinctErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DistinctErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DistinctErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DistinctErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DistinctErrorReason) UnmarshalText(text []byte) error {
+	v := DistinctErrorReason(text)
+	*e = v
+	return nil
+}
+
+// EntityAccessDeniedReasonValues returns all values of EntityAccessDeniedReason.
+func EntityAccessDeniedReasonValues() []EntityAccessDeniedReason {
+	return []EntityAccessDeniedReason{
+		EntityAccessDeniedReasonREAD_ACCESS_DENIED,
+		EntityAccessDeniedReasonWRITE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityAccessDeniedReason.
+func (e EntityAccessDeniedReason) IsValid() bool {
+	switch e {
+	case EntityAccessDeniedReasonREAD_ACCESS_DENIED,
+		EntityAccessDeniedReasonWRITE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseEntityAccessDeniedReason returns the EntityAccessDeniedReason named by s.
+func ParseEntityAccessDeniedReason(s string) (EntityAccessDeniedReason, error) {
+	e := EntityAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityAccessDeniedReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityAccessDeniedReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := EntityAccessDeniedReason(text)
+	*e = v
+	return nil
+}
+
+// EntityCountLimitExceededReasonValues returns all values of EntityCountLimitExceededReason.
+func EntityCountLimitExceededReasonValues() []EntityCountLimitExceededReason {
+	return []EntityCountLimitExceededReason{
+		EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) IsValid() bool {
+	switch e {
+	case EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseEntityCountLimitExceededReason returns the EntityCountLimitExceededReason named by s.
+func ParseEntityCountLimitExceededReason(s string) (EntityCountLimitExceededReason, error) {
+	e := EntityCountLimitExceededReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityCountLimitExceededReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid EntityCountLimitExceededReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *EntityCountLimitExceededReason) UnmarshalText(text []byte) error {
+	v := EntityCountLimitExceededReason(text)
+	if !v.IsValid() {
+		v = EntityCountLimitExceededReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// EntityNotFoundReasonValues returns all values of EntityNotFoundReason.
+func EntityNotFoundReasonValues() []EntityNotFoundReason {
+	return []EntityNotFoundReason{
+		EntityNotFoundReasonINVALID_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityNotFoundReason.
+func (e EntityNotFoundReason) IsValid() bool {
+	switch e {
+	case EntityNotFoundReasonINVALID_ID:
+		return true
+	}
+	return false
+}
+
+// ParseEntityNotFoundReason returns the EntityNotFoundReason named by s.
+func ParseEntityNotFoundReason(s string) (EntityNotFoundReason, error) {
+	e := EntityNotFoundReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityNotFoundReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityNotFoundReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityNotFoundReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityNotFoundReason) UnmarshalText(text []byte) error {
+	v := EntityNotFoundReason(text)
+	*e = v
+	return nil
+}
+
+// ForwardCompatibilityErrorReasonValues returns all values of ForwardCompatibilityErrorReason.
+func ForwardCompatibilityErrorReasonValues() []ForwardCompatibilityErrorReason {
+	return []ForwardCompatibilityErrorReason{
+		ForwardCompatibilityErrorReasonINVALID_FORWARD_COMPATIBILITY_MAP_VALUE,
+		ForwardCompatibilityErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of ForwardCompatibilityErrorReason.
+func (e ForwardCompatibilityErrorReason) IsValid() bool {
+	switch e {
+	case ForwardCompatibilityErrorReasonINVALID_FORWARD_COMPATIBILITY_MAP_VALUE,
+		ForwardCompatibilityErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseForwardCompatibilityErrorReason returns the ForwardCompatibilityErrorReason named by s.
+func ParseForwardCompatibilityErrorReason(s string) (ForwardCompatibilityErrorReason, error) {
+	e := ForwardCompatibilityErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ForwardCompatibilityErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ForwardCompatibilityErrorReason.
+func (e ForwardCompatibilityErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ForwardCompatibilityErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ForwardCompatibilityErrorReason) UnmarshalText(text []byte) error {
+	v := ForwardCompatibilityErrorReason(text)
+	if !v.IsValid() {
+		v = ForwardCompatibilityErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// IdErrorReasonValues returns all values of IdErrorReason.
+func IdErrorReasonValues() []IdErrorReason {
+	return []IdErrorReason{
+		IdErrorReasonNOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of IdErrorReason.
+func (e IdErrorReason) IsValid() bool {
+	switch e {
+	case IdErrorReasonNOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseIdErrorReason returns the IdErrorReason named by s.
+func ParseIdErrorReason(s string) (IdErrorReason, error) {
+	e := IdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// IdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e IdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *IdErrorReason) UnmarshalText(text []byte) error {
+	v := IdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// InternalApiErrorReasonValues returns all values of InternalApiErrorReason.
+func InternalApiErrorReasonValues() []InternalApiErrorReason {
+	return []InternalApiErrorReason{
+		InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE,
+	}
+}
+
+// IsValid reports whether e is one of the values of InternalApiErrorReason.
+func (e InternalApiErrorReason) IsValid() bool {
+	switch e {
+	case InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE:
+		return true
+	}
+	return false
+}
+
+// ParseInternalApiErrorReason returns the InternalApiErrorReason named by s.
+func ParseInternalApiErrorReason(s string) (InternalApiErrorReason, error) {
+	e := InternalApiErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid InternalApiErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of InternalApiErrorReason.
+func (e InternalApiErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid InternalApiErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *InternalApiErrorReason) UnmarshalText(text []byte) error {
+	v := InternalApiErrorReason(text)
+	if !v.IsValid() {
+		v = InternalApiErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// LabelStatusValues returns all values of LabelStatus.
+func LabelStatusValues() []LabelStatus {
+	return []LabelStatus{
+		LabelStatusENABLED,
+		LabelStatusREMOVED,
+		LabelStatusUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of LabelStatus.
+func (e LabelStatus) IsValid() bool {
+	switch e {
+	case LabelStatusENABLED,
+		LabelStatusREMOVED,
+		LabelStatusUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseLabelStatus returns the LabelStatus named by s.
+func ParseLabelStatus(s string) (LabelStatus, error) {
+	e := LabelStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid LabelStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of LabelStatus.
+func (e LabelStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid LabelStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *LabelStatus) UnmarshalText(text []byte) error {
+	v := LabelStatus(text)
+	if !v.IsValid() {
+		v = LabelStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// MultiplierErrorReasonValues returns all values of MultiplierErrorReason.
+func MultiplierErrorReasonValues() []MultiplierErrorReason {
+	return []MultiplierErrorReason{
+		MultiplierErrorReasonMULTIPLIER_TOO_HIGH,
+		MultiplierErrorReasonMULTIPLIER_TOO_LOW,
+		MultiplierErrorReasonTOO_MANY_FRACTIONAL_DIGITS,
+		MultiplierErrorReasonMULTIPLIER_NOT_ALLOWED_FOR_BIDDING_STRATEGY,
+		MultiplierErrorReasonMULTIPLIER_NOT_ALLOWED_WHEN_BASE_BID_IS_MISSING,
+		MultiplierErrorReasonNO_MULTIPLIER_SPECIFIED,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_DAILY_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_MONTHLY_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_CUSTOM_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_MAX_ALLOWED_BID,
+		MultiplierErrorReasonBID_LESS_THAN_MIN_ALLOWED_BID_WITH_MULTIPLIER,
+		MultiplierErrorReasonMULTIPLIER_AND_BIDDING_STRATEGY_TYPE_MISMATCH,
+		MultiplierErrorReasonMULTIPLIER_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of MultiplierErrorReason.
+func (e MultiplierErrorReason) IsValid() bool {
+	switch e {
+	case MultiplierErrorReasonMULTIPLIER_TOO_HIGH,
+		MultiplierErrorReasonMULTIPLIER_TOO_LOW,
+		MultiplierErrorReasonTOO_MANY_FRACTIONAL_DIGITS,
+		MultiplierErrorReasonMULTIPLIER_NOT_ALLOWED_FOR_BIDDING_STRATEGY,
+		MultiplierErrorReasonMULTIPLIER_NOT_ALLOWED_WHEN_BASE_BID_IS_MISSING,
+		MultiplierErrorReasonNO_MULTIPLIER_SPECIFIED,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_DAILY_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_MONTHLY_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_CUSTOM_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_MAX_ALLOWED_BID,
+		MultiplierErrorReasonBID_LESS_THAN_MIN_ALLOWED_BID_WITH_MULTIPLIER,
+		MultiplierErrorReasonMULTIPLIER_AND_BIDDING_STRATEGY_TYPE_MISMATCH,
+		MultiplierErrorReasonMULTIPLIER_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseMultiplierErrorReason returns the MultiplierErrorReason named by s.
+func ParseMultiplierErrorReason(s string) (MultiplierErrorReason, error) {
+	e := MultiplierErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MultiplierErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MultiplierErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MultiplierErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MultiplierErrorReason) UnmarshalText(text []byte) error {
+	v := MultiplierErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NewEntityCreationErrorReasonValues returns all values of NewEntityCreationErrorReason.
+func NewEntityCreationErrorReasonValues() []NewEntityCreationErrorReason {
+	return []NewEntityCreationErrorReason{
+		NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS,
+	}
+}
+
+// IsValid reports whether e is one of the values of NewEntityCreationErrorReason.
+func (e NewEntityCreationErrorReason) IsValid() bool {
+	switch e {
+	case NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS:
+		return true
+	}
+	return false
+}
+
+// ParseNewEntityCreationErrorReason returns the NewEntityCreationErrorReason named by s.
+func ParseNewEntityCreationErrorReason(s string) (NewEntityCreationErrorReason, error) {
+	e := NewEntityCreationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NewEntityCreationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NewEntityCreationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NewEntityCreationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NewEntityCreationErrorReason) UnmarshalText(text []byte) error {
+	v := NewEntityCreationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NotEmptyErrorReasonValues returns all values of NotEmptyErrorReason.
+func NotEmptyErrorReasonValues() []NotEmptyErrorReason {
+	return []NotEmptyErrorReason{
+		NotEmptyErrorReasonEMPTY_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotEmptyErrorReason.
+func (e NotEmptyErrorReason) IsValid() bool {
+	switch e {
+	case NotEmptyErrorReasonEMPTY_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseNotEmptyErrorReason returns the NotEmptyErrorReason named by s.
+func ParseNotEmptyErrorReason(s string) (NotEmptyErrorReason, error) {
+	e := NotEmptyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotEmptyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotEmptyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotEmptyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotEmptyErrorReason) UnmarshalText(text []byte) error {
+	v := NotEmptyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NullErrorReasonValues returns all values of NullErrorReason.
+func NullErrorReasonValues() []NullErrorReason {
+	return []NullErrorReason{
+		NullErrorReasonNULL_CONTENT,
+	}
+}
+
+// IsValid reports whether e is one of the values of NullErrorReason.
+func (e NullErrorReason) IsValid() bool {
+	switch e {
+	case NullErrorReasonNULL_CONTENT:
+		return true
+	}
+	return false
+}
+
+// ParseNullErrorReason returns the NullErrorReason named by s.
+func ParseNullErrorReason(s string) (NullErrorReason, error) {
+	e := NullErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NullErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NullErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NullErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NullErrorReason) UnmarshalText(text []byte) error {
+	v := NullErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OperationAccessDeniedReasonValues returns all values of OperationAccessDeniedReason.
+func OperationAccessDeniedReasonValues() []OperationAccessDeniedReason {
+	return []OperationAccessDeniedReason{
+		OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) IsValid() bool {
+	switch e {
+	case OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperationAccessDeniedReason returns the OperationAccessDeniedReason named by s.
+func ParseOperationAccessDeniedReason(s string) (OperationAccessDeniedReason, error) {
+	e := OperationAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperationAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperationAccessDeniedReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperationAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := OperationAccessDeniedReason(text)
+	if !v.IsValid() {
+		v = OperationAccessDeniedReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperatorValues returns all values of Operator.
+func OperatorValues() []Operator {
+	return []Operator{
+		OperatorADD,
+		OperatorREMOVE,
+		OperatorSET,
+	}
+}
+
+// IsValid reports whether e is one of the values of Operator.
+func (e Operator) IsValid() bool {
+	switch e {
+	case OperatorADD,
+		OperatorREMOVE,
+		OperatorSET:
+		return true
+	}
+	return false
+}
+
+// ParseOperator returns the Operator named by s.
+func ParseOperator(s string) (Operator, error) {
+	e := Operator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid Operator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// Operator are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e Operator) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *Operator) UnmarshalText(text []byte) error {
+	v := Operator(text)
+	*e = v
+	return nil
+}
+
+// OperatorErrorReasonValues returns all values of OperatorErrorReason.
+func OperatorErrorReasonValues() []OperatorErrorReason {
+	return []OperatorErrorReason{
+		OperatorErrorReasonOPERATOR_NOT_SUPPORTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatorErrorReason.
+func (e OperatorErrorReason) IsValid() bool {
+	switch e {
+	case OperatorErrorReasonOPERATOR_NOT_SUPPORTED:
+		return true
+	}
+	return false
+}
+
+// ParseOperatorErrorReason returns the OperatorErrorReason named by s.
+func ParseOperatorErrorReason(s string) (OperatorErrorReason, error) {
+	e := OperatorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// OperatorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e OperatorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *OperatorErrorReason) UnmarshalText(text []byte) error {
+	v := OperatorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// PageOnePromotedBiddingSchemeStrategyGoalValues returns all values of PageOnePromotedBiddingSchemeStrategyGoal.
+func PageOnePromotedBiddingSchemeStrategyGoalValues() []PageOnePromotedBiddingSchemeStrategyGoal {
+	return []PageOnePromotedBiddingSchemeStrategyGoal{
+		PageOnePromotedBiddingSchemeStrategyGoalPAGE_ONE,
+		PageOnePromotedBiddingSchemeStrategyGoalPAGE_ONE_PROMOTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of PageOnePromotedBiddingSchemeStrategyGoal.
+func (e PageOnePromotedBiddingSchemeStrategyGoal) IsValid() bool {
+	switch e {
+	case PageOnePromotedBiddingSchemeStrategyGoalPAGE_ONE,
+		PageOnePromotedBiddingSchemeStrategyGoalPAGE_ONE_PROMOTED:
+		return true
+	}
+	return false
+}
+
+// ParsePageOnePromotedBiddingSchemeStrategyGoal returns the PageOnePromotedBiddingSchemeStrategyGoal named by s.
+func ParsePageOnePromotedBiddingSchemeStrategyGoal(s string) (PageOnePromotedBiddingSchemeStrategyGoal, error) {
+	e := PageOnePromotedBiddingSchemeStrategyGoal(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PageOnePromotedBiddingSchemeStrategyGoal %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// PageOnePromotedBiddingSchemeStrategyGoal are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e PageOnePromotedBiddingSchemeStrategyGoal) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *PageOnePromotedBiddingSchemeStrategyGoal) UnmarshalText(text []byte) error {
+	v := PageOnePromotedBiddingSchemeStrategyGoal(text)
+	*e = v
+	return nil
+}
+
+// PredicateOperatorValues returns all values of PredicateOperator.
+func PredicateOperatorValues() []PredicateOperator {
+	return []PredicateOperator{
+		PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PredicateOperator.
+func (e PredicateOperator) IsValid() bool {
+	switch e {
+	case PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePredicateOperator returns the PredicateOperator named by s.
+func ParsePredicateOperator(s string) (PredicateOperator, error) {
+	e := PredicateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PredicateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PredicateOperator.
+func (e PredicateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PredicateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PredicateOperator) UnmarshalText(text []byte) error {
+	v := PredicateOperator(text)
+	if !v.IsValid() {
+		v = PredicateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// QueryErrorReasonValues returns all values of QueryErrorReason.
+func QueryErrorReasonValues() []QueryErrorReason {
+	return []QueryErrorReason{
+		QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QueryErrorReason.
+func (e QueryErrorReason) IsValid() bool {
+	switch e {
+	case QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED:
+		return true
+	}
+	return false
+}
+
+// ParseQueryErrorReason returns the QueryErrorReason named by s.
+func ParseQueryErrorReason(s string) (QueryErrorReason, error) {
+	e := QueryErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QueryErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QueryErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QueryErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QueryErrorReason) UnmarshalText(text []byte) error {
+	v := QueryErrorReason(text)
+	*e = v
+	return nil
+}
+
+// QuotaCheckErrorReasonValues returns all values of QuotaCheckErrorReason.
+func QuotaCheckErrorReasonValues() []QuotaCheckErrorReason {
+	return []QuotaCheckErrorReason{
+		QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QuotaCheckErrorReason.
+func (e QuotaCheckErrorReason) IsValid() bool {
+	switch e {
+	case QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseQuotaCheckErrorReason returns the QuotaCheckErrorReason named by s.
+func ParseQuotaCheckErrorReason(s string) (QuotaCheckErrorReason, error) {
+	e := QuotaCheckErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QuotaCheckErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QuotaCheckErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QuotaCheckErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QuotaCheckErrorReason) UnmarshalText(text []byte) error {
+	v := QuotaCheckErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RangeErrorReasonValues returns all values of RangeErrorReason.
+func RangeErrorReasonValues() []RangeErrorReason {
+	return []RangeErrorReason{
+		RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH,
+	}
+}
+
+// IsValid reports whether e is one of the values of RangeErrorReason.
+func (e RangeErrorReason) IsValid() bool {
+	switch e {
+	case RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH:
+		return true
+	}
+	return false
+}
+
+// ParseRangeErrorReason returns the RangeErrorReason named by s.
+func ParseRangeErrorReason(s string) (RangeErrorReason, error) {
+	e := RangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RangeErrorReason) UnmarshalText(text []byte) error {
+	v := RangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RateExceededErrorReasonValues returns all values of RateExceededErrorReason.
+func RateExceededErrorReasonValues() []RateExceededErrorReason {
+	return []RateExceededErrorReason{
+		RateExceededErrorReasonRATE_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RateExceededErrorReason.
+func (e RateExceededErrorReason) IsValid() bool {
+	switch e {
+	case RateExceededErrorReasonRATE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseRateExceededErrorReason returns the RateExceededErrorReason named by s.
+func ParseRateExceededErrorReason(s string) (RateExceededErrorReason, error) {
+	e := RateExceededErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RateExceededErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RateExceededErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RateExceededErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RateExceededErrorReason) UnmarshalText(text []byte) error {
+	v := RateExceededErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ReadOnlyErrorReasonValues returns all values of ReadOnlyErrorReason.
+func ReadOnlyErrorReasonValues() []ReadOnlyErrorReason {
+	return []ReadOnlyErrorReason{
+		ReadOnlyErrorReasonREAD_ONLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ReadOnlyErrorReason.
+func (e ReadOnlyErrorReason) IsValid() bool {
+	switch e {
+	case ReadOnlyErrorReasonREAD_ONLY:
+		return true
+	}
+	return false
+}
+
+// ParseReadOnlyErrorReason returns the ReadOnlyErrorReason named by s.
+func ParseReadOnlyErrorReason(s string) (ReadOnlyErrorReason, error) {
+	e := ReadOnlyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ReadOnlyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ReadOnlyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ReadOnlyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ReadOnlyErrorReason) UnmarshalText(text []byte) error {
+	v := ReadOnlyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RejectedErrorReasonValues returns all values of RejectedErrorReason.
+func RejectedErrorReasonValues() []RejectedErrorReason {
+	return []RejectedErrorReason{
+		RejectedErrorReasonUNKNOWN_VALUE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RejectedErrorReason.
+func (e RejectedErrorReason) IsValid() bool {
+	switch e {
+	case RejectedErrorReasonUNKNOWN_VALUE:
+		return true
+	}
+	return false
+}
+
+// ParseRejectedErrorReason returns the RejectedErrorReason named by s.
+func ParseRejectedErrorReason(s string) (RejectedErrorReason, error) {
+	e := RejectedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RejectedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RejectedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RejectedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RejectedErrorReason) UnmarshalText(text []byte) error {
+	v := RejectedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RequestErrorReasonValues returns all values of RequestErrorReason.
+func RequestErrorReasonValues() []RequestErrorReason {
+	return []RequestErrorReason{
+		RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequestErrorReason.
+func (e RequestErrorReason) IsValid() bool {
+	switch e {
+	case RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION:
+		return true
+	}
+	return false
+}
+
+// ParseRequestErrorReason returns the RequestErrorReason named by s.
+func ParseRequestErrorReason(s string) (RequestErrorReason, error) {
+	e := RequestErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequestErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of RequestErrorReason.
+func (e RequestErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid RequestErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *RequestErrorReason) UnmarshalText(text []byte) error {
+	v := RequestErrorReason(text)
+	if !v.IsValid() {
+		v = RequestErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RequiredErrorReasonValues returns all values of RequiredErrorReason.
+func RequiredErrorReasonValues() []RequiredErrorReason {
+	return []RequiredErrorReason{
+		RequiredErrorReasonREQUIRED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequiredErrorReason.
+func (e RequiredErrorReason) IsValid() bool {
+	switch e {
+	case RequiredErrorReasonREQUIRED:
+		return true
+	}
+	return false
+}
+
+// ParseRequiredErrorReason returns the RequiredErrorReason named by s.
+func ParseRequiredErrorReason(s string) (RequiredErrorReason, error) {
+	e := RequiredErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequiredErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RequiredErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RequiredErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RequiredErrorReason) UnmarshalText(text []byte) error {
+	v := RequiredErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SelectorErrorReasonValues returns all values of SelectorErrorReason.
+func SelectorErrorReasonValues() []SelectorErrorReason {
+	return []SelectorErrorReason{
+		SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of SelectorErrorReason.
+func (e SelectorErrorReason) IsValid() bool {
+	switch e {
+	case SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseSelectorErrorReason returns the SelectorErrorReason named by s.
+func ParseSelectorErrorReason(s string) (SelectorErrorReason, error) {
+	e := SelectorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SelectorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SelectorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SelectorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SelectorErrorReason) UnmarshalText(text []byte) error {
+	v := SelectorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SettingErrorReasonValues returns all values of SettingErrorReason.
+func SettingErrorReasonValues() []SettingErrorReason {
+	return []SettingErrorReason{
+		SettingErrorReasonDUPLICATE_SETTING_TYPE,
+		SettingErrorReasonSETTING_TYPE_IS_NOT_AVAILABLE,
+		SettingErrorReasonSETTING_TYPE_IS_NOT_COMPATIBLE_WITH_CAMPAIGN,
+		SettingErrorReasonTARGETING_SETTING_CONTAINS_INVALID_CRITERION_TYPE_GROUP,
+		SettingErrorReasonTARGETING_SETTING_DEMOGRAPHIC_CRITERION_TYPE_GROUPS_MUST_BE_SET_TO_TARGET_ALL,
+		SettingErrorReasonTARGETING_SETTING_CANNOT_CHANGE_TARGET_ALL_TO_FALSE_FOR_DEMOGRAPHIC_CRITERION_TYPE_GROUP,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_AT_LEAST_ONE_FEED_ID_MUST_BE_PRESENT,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_INVALID_DOMAIN_NAME,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_SUBDOMAIN_NAME,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_INVALID_LANGUAGE_CODE,
+		SettingErrorReasonTARGET_ALL_IS_NOT_ALLOWED_FOR_PLACEMENT_IN_SEARCH_CAMPAIGN,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_DUPLICATE_DESCRIPTION,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_DESCRIPTION_LINE_WIDTH_TOO_LONG,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_APP_ID_CANNOT_BE_MODIFIED,
+		SettingErrorReasonTOO_MANY_YOUTUBE_MEDIA_IDS_IN_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonTOO_MANY_IMAGE_MEDIA_IDS_IN_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonMEDIA_INCOMPATIBLE_FOR_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonTOO_MANY_EXCLAMATION_MARKS,
+		SettingErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SettingErrorReason.
+func (e SettingErrorReason) IsValid() bool {
+	switch e {
+	case SettingErrorReasonDUPLICATE_SETTING_TYPE,
+		SettingErrorReasonSETTING_TYPE_IS_NOT_AVAILABLE,
+		SettingErrorReasonSETTING_TYPE_IS_NOT_COMPATIBLE_WITH_CAMPAIGN,
+		SettingErrorReasonTARGETING_SETTING_CONTAINS_INVALID_CRITERION_TYPE_GROUP,
+		SettingErrorReasonTARGETING_SETTING_DEMOGRAPHIC_CRITERION_TYPE_GROUPS_MUST_BE_SET_TO_TARGET_ALL,
+		SettingErrorReasonTARGETING_SETTING_CANNOT_CHANGE_TARGET_ALL_TO_FALSE_FOR_DEMOGRAPHIC_CRITERION_TYPE_GROUP,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_AT_LEAST_ONE_FEED_ID_MUST_BE_PRESENT,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_INVALID_DOMAIN_NAME,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_SUBDOMAIN_NAME,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_INVALID_LANGUAGE_CODE,
+		SettingErrorReasonTARGET_ALL_IS_NOT_ALLOWED_FOR_PLACEMENT_IN_SEARCH_CAMPAIGN,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_DUPLICATE_DESCRIPTION,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_DESCRIPTION_LINE_WIDTH_TOO_LONG,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_APP_ID_CANNOT_BE_MODIFIED,
+		SettingErrorReasonTOO_MANY_YOUTUBE_MEDIA_IDS_IN_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonTOO_MANY_IMAGE_MEDIA_IDS_IN_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonMEDIA_INCOMPATIBLE_FOR_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonTOO_MANY_EXCLAMATION_MARKS,
+		SettingErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSettingErrorReason returns the SettingErrorReason named by s.
+func ParseSettingErrorReason(s string) (SettingErrorReason, error) {
+	e := SettingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SettingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SettingErrorReason.
+func (e SettingErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SettingErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SettingErrorReason) UnmarshalText(text []byte) error {
+	v := SettingErrorReason(text)
+	if !v.IsValid() {
+		v = SettingErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SizeLimitErrorReasonValues returns all values of SizeLimitErrorReason.
+func SizeLimitErrorReasonValues() []SizeLimitErrorReason {
+	return []SizeLimitErrorReason{
+		SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) IsValid() bool {
+	switch e {
+	case SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSizeLimitErrorReason returns the SizeLimitErrorReason named by s.
+func ParseSizeLimitErrorReason(s string) (SizeLimitErrorReason, error) {
+	e := SizeLimitErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeLimitErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SizeLimitErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SizeLimitErrorReason) UnmarshalText(text []byte) error {
+	v := SizeLimitErrorReason(text)
+	if !v.IsValid() {
+		v = SizeLimitErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SortOrderValues returns all values of SortOrder.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASCENDING,
+		SortOrderDESCENDING,
+	}
+}
+
+// IsValid reports whether e is one of the values of SortOrder.
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderASCENDING,
+		SortOrderDESCENDING:
+		return true
+	}
+	return false
+}
+
+// ParseSortOrder returns the SortOrder named by s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	e := SortOrder(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SortOrder %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SortOrder are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	*e = v
+	return nil
+}
+
+// StatsQueryErrorReasonValues returns all values of StatsQueryErrorReason.
+func StatsQueryErrorReasonValues() []StatsQueryErrorReason {
+	return []StatsQueryErrorReason{
+		StatsQueryErrorReasonDATE_NOT_IN_VALID_RANGE,
+	}
+}
+
+// IsValid reports whether e is one of the values of StatsQueryErrorReason.
+func (e StatsQueryErrorReason) IsValid() bool {
+	switch e {
+	case StatsQueryErrorReasonDATE_NOT_IN_VALID_RANGE:
+		return true
+	}
+	return false
+}
+
+// ParseStatsQueryErrorReason returns the StatsQueryErrorReason named by s.
+func ParseStatsQueryErrorReason(s string) (StatsQueryErrorReason, error) {
+	e := StatsQueryErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StatsQueryErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StatsQueryErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StatsQueryErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StatsQueryErrorReason) UnmarshalText(text []byte) error {
+	v := StatsQueryErrorReason(text)
+	*e = v
+	return nil
+}
+
+// StringFormatErrorReasonValues returns all values of StringFormatErrorReason.
+func StringFormatErrorReasonValues() []StringFormatErrorReason {
+	return []StringFormatErrorReason{
+		StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringFormatErrorReason.
+func (e StringFormatErrorReason) IsValid() bool {
+	switch e {
+	case StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT:
+		return true
+	}
+	return false
+}
+
+// ParseStringFormatErrorReason returns the StringFormatErrorReason named by s.
+func ParseStringFormatErrorReason(s string) (StringFormatErrorReason, error) {
+	e := StringFormatErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringFormatErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringFormatErrorReason.
+func (e StringFormatErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringFormatErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringFormatErrorReason) UnmarshalText(text []byte) error {
+	v := StringFormatErrorReason(text)
+	if !v.IsValid() {
+		v = StringFormatErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// StringLengthErrorReasonValues returns all values of StringLengthErrorReason.
+func StringLengthErrorReasonValues() []StringLengthErrorReason {
+	return []StringLengthErrorReason{
+		StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringLengthErrorReason.
+func (e StringLengthErrorReason) IsValid() bool {
+	switch e {
+	case StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG:
+		return true
+	}
+	return false
+}
+
+// ParseStringLengthErrorReason returns the StringLengthErrorReason named by s.
+func ParseStringLengthErrorReason(s string) (StringLengthErrorReason, error) {
+	e := StringLengthErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringLengthErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StringLengthErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StringLengthErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StringLengthErrorReason) UnmarshalText(text []byte) error {
+	v := StringLengthErrorReason(text)
+	*e = v
+	return nil
+}
+
+// UrlErrorReasonValues returns all values of UrlErrorReason.
+func UrlErrorReasonValues() []UrlErrorReason {
+	return []UrlErrorReason{
+		UrlErrorReasonINVALID_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TAG_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMALFORMED_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_HOST_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TLD_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonREDUNDANT_NESTED_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonINVALID_FINAL_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonMALFORMED_FINAL_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_URL,
+		UrlErrorReasonINVALID_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_MOBILE_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonMALFORMED_FINAL_MOBILE_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_FINAL_APP_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_APP_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_APP_URL_TAG,
+		UrlErrorReasonMULTIPLE_APP_URLS_FOR_OSTYPE,
+		UrlErrorReasonINVALID_OSTYPE,
+		UrlErrorReasonINVALID_PROTOCOL_FOR_APP_URL,
+		UrlErrorReasonINVALID_PACKAGE_ID_FOR_APP_URL,
+		UrlErrorReasonURL_CUSTOM_PARAMETERS_COUNT_EXCEEDS_LIMIT,
+		UrlErrorReasonURL_CUSTOM_PARAMETER_REMOVAL_WITH_NON_NULL_VALUE,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_IN_ADD_OPERATION,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_DURING_FULL_REPLACEMENT,
+		UrlErrorReasonNULL_CUSTOM_PARAMETER_VALUE_DURING_ADD_OR_FULL_REPLACEMENT,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_KEY,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonINVALID_TAG_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonREDUNDANT_NESTED_URL_CUSTOM_PARAMETER_TAG,
+		UrlErrorReasonMISSING_PROTOCOL,
+		UrlErrorReasonINVALID_URL,
+		UrlErrorReasonDESTINATION_URL_DEPRECATED,
+		UrlErrorReasonINVALID_TAG_IN_URL,
+		UrlErrorReasonMISSING_URL_TAG,
+		UrlErrorReasonDUPLICATE_URL_ID,
+		UrlErrorReasonINVALID_URL_ID,
+		UrlErrorReasonURL_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of UrlErrorReason.
+func (e UrlErrorReason) IsValid() bool {
+	switch e {
+	case UrlErrorReasonINVALID_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TAG_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMALFORMED_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_HOST_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TLD_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonREDUNDANT_NESTED_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonINVALID_FINAL_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonMALFORMED_FINAL_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_URL,
+		UrlErrorReasonINVALID_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_MOBILE_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonMALFORMED_FINAL_MOBILE_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_FINAL_APP_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_APP_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_APP_URL_TAG,
+		UrlErrorReasonMULTIPLE_APP_URLS_FOR_OSTYPE,
+		UrlErrorReasonINVALID_OSTYPE,
+		UrlErrorReasonINVALID_PROTOCOL_FOR_APP_URL,
+		UrlErrorReasonINVALID_PACKAGE_ID_FOR_APP_URL,
+		UrlErrorReasonURL_CUSTOM_PARAMETERS_COUNT_EXCEEDS_LIMIT,
+		UrlErrorReasonURL_CUSTOM_PARAMETER_REMOVAL_WITH_NON_NULL_VALUE,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_IN_ADD_OPERATION,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_DURING_FULL_REPLACEMENT,
+		UrlErrorReasonNULL_CUSTOM_PARAMETER_VALUE_DURING_ADD_OR_FULL_REPLACEMENT,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_KEY,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonINVALID_TAG_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonREDUNDANT_NESTED_URL_CUSTOM_PARAMETER_TAG,
+		UrlErrorReasonMISSING_PROTOCOL,
+		UrlErrorReasonINVALID_URL,
+		UrlErrorReasonDESTINATION_URL_DEPRECATED,
+		UrlErrorReasonINVALID_TAG_IN_URL,
+		UrlErrorReasonMISSING_URL_TAG,
+		UrlErrorReasonDUPLICATE_URL_ID,
+		UrlErrorReasonINVALID_URL_ID,
+		UrlErrorReasonURL_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseUrlErrorReason returns the UrlErrorReason named by s.
+func ParseUrlErrorReason(s string) (UrlErrorReason, error) {
+	e := UrlErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UrlErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// UrlErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e UrlErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *UrlErrorReason) UnmarshalText(text []byte) error {
+	v := UrlErrorReason(text)
+	*e = v
+	return nil
+}
@@ -0,0 +1,348 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AdGroupFields holds the selector field names of AdGroup, including
+// the fields of the types it holds.
+var AdGroupFields = struct {
+	AdGroupType                  string
+	AdRotationMode               string
+	BaseAdGroupId                string
+	BaseCampaignId               string
+	BiddingStrategyId            string
+	BiddingStrategyName          string
+	BiddingStrategySource        string
+	BiddingStrategyType          string
+	CampaignId                   string
+	CampaignName                 string
+	ContentBidCriterionTypeGroup string
+	CpcBid                       string
+	CpmBid                       string
+	EnhancedCpcEnabled           string
+	FinalUrlSuffix               string
+	Id                           string
+	Labels                       string
+	Name                         string
+	Settings                     string
+	Status                       string
+	TargetCpa                    string
+	TargetCpaBid                 string
+	TargetCpaBidSource           string
+	TargetRoasOverride           string
+	TrackingUrlTemplate          string
+	UrlCustomParameters          string
+}{
+	AdGroupType:                  "AdGroupType",
+	AdRotationMode:               "AdRotationMode",
+	BaseAdGroupId:                "BaseAdGroupId",
+	BaseCampaignId:               "BaseCampaignId",
+	BiddingStrategyId:            "BiddingStrategyId",
+	BiddingStrategyName:          "BiddingStrategyName",
+	BiddingStrategySource:        "BiddingStrategySource",
+	BiddingStrategyType:          "BiddingStrategyType",
+	CampaignId:                   "CampaignId",
+	CampaignName:                 "CampaignName",
+	ContentBidCriterionTypeGroup: "ContentBidCriterionTypeGroup",
+	CpcBid:                       "CpcBid",
+	CpmBid:                       "CpmBid",
+	EnhancedCpcEnabled:           "EnhancedCpcEnabled",
+	FinalUrlSuffix:               "FinalUrlSuffix",
+	Id:                           "Id",
+	Labels:                       "Labels",
+	Name:                         "Name",
+	Settings:                     "Settings",
+	Status:                       "Status",
+	TargetCpa:                    "TargetCpa",
+	TargetCpaBid:                 "TargetCpaBid",
+	TargetCpaBidSource:           "TargetCpaBidSource",
+	TargetRoasOverride:           "TargetRoasOverride",
+	TrackingUrlTemplate:          "TrackingUrlTemplate",
+	UrlCustomParameters:          "UrlCustomParameters",
+}
+
+// AdGroupAdRotationModeFields holds the selector field names of AdGroupAdRotationMode, including
+// the fields of the types it holds.
+var AdGroupAdRotationModeFields = struct {
+	AdRotationMode string
+}{
+	AdRotationMode: "AdRotationMode",
+}
+
+// BiddingStrategyConfigurationFields holds the selector field names of BiddingStrategyConfiguration, including
+// the fields of the types it holds.
+var BiddingStrategyConfigurationFields = struct {
+	BiddingStrategyId     string
+	BiddingStrategyName   string
+	BiddingStrategySource string
+	BiddingStrategyType   string
+	CpcBid                string
+	CpmBid                string
+	EnhancedCpcEnabled    string
+	TargetCpa             string
+	TargetCpaBid          string
+	TargetCpaBidSource    string
+	TargetRoasOverride    string
+}{
+	BiddingStrategyId:     "BiddingStrategyId",
+	BiddingStrategyName:   "BiddingStrategyName",
+	BiddingStrategySource: "BiddingStrategySource",
+	BiddingStrategyType:   "BiddingStrategyType",
+	CpcBid:                "CpcBid",
+	CpmBid:                "CpmBid",
+	EnhancedCpcEnabled:    "EnhancedCpcEnabled",
+	TargetCpa:             "TargetCpa",
+	TargetCpaBid:          "TargetCpaBid",
+	TargetCpaBidSource:    "TargetCpaBidSource",
+	TargetRoasOverride:    "TargetRoasOverride",
+}
+
+// CpaBidFields holds the selector field names of CpaBid, including
+// the fields of the types it holds.
+var CpaBidFields = struct {
+	TargetCpaBid       string
+	TargetCpaBidSource string
+}{
+	TargetCpaBid:       "TargetCpaBid",
+	TargetCpaBidSource: "TargetCpaBidSource",
+}
+
+// CpcBidFields holds the selector field names of CpcBid, including
+// the fields of the types it holds.
+var CpcBidFields = struct {
+	CpcBid string
+}{
+	CpcBid: "CpcBid",
+}
+
+// CpmBidFields holds the selector field names of CpmBid, including
+// the fields of the types it holds.
+var CpmBidFields = struct {
+	CpmBid string
+}{
+	CpmBid: "CpmBid",
+}
+
+// ManualCpcBiddingSchemeFields holds the selector field names of ManualCpcBiddingScheme, including
+// the fields of the types it holds.
+var ManualCpcBiddingSchemeFields = struct {
+	EnhancedCpcEnabled string
+}{
+	EnhancedCpcEnabled: "EnhancedCpcEnabled",
+}
+
+// TargetCpaBiddingSchemeFields holds the selector field names of TargetCpaBiddingScheme, including
+// the fields of the types it holds.
+var TargetCpaBiddingSchemeFields = struct {
+	TargetCpa string
+}{
+	TargetCpa: "TargetCpa",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"MutateLabel": {
+		{Type: "MutateLabel", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"AdGroup": {
+		{Type: "AdGroup", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}, Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "AdGroup", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdGroup", Name: "CampaignName", Selectors: []string{"CampaignName"}, Filter: "CampaignName", ReadOnly: true},
+		{Type: "AdGroup", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "AdGroup", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "AdGroup", Name: "Settings", Selectors: []string{"Settings"}},
+		{Type: "AdGroup", Name: "Labels", Selectors: []string{"Labels"}, Filter: "Labels", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "AdGroup", Name: "ForwardCompatibilityMap"},
+		{Type: "AdGroup", Name: "BiddingStrategyConfiguration"},
+		{Type: "AdGroup", Name: "ContentBidCriterionTypeGroup", Selectors: []string{"ContentBidCriterionTypeGroup"}, Filter: "ContentBidCriterionTypeGroup"},
+		{Type: "AdGroup", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, ReadOnly: true},
+		{Type: "AdGroup", Name: "BaseAdGroupId", Selectors: []string{"BaseAdGroupId"}},
+		{Type: "AdGroup", Name: "TrackingUrlTemplate", Selectors: []string{"TrackingUrlTemplate"}, Filter: "TrackingUrlTemplate"},
+		{Type: "AdGroup", Name: "FinalUrlSuffix", Selectors: []string{"FinalUrlSuffix"}, Filter: "FinalUrlSuffix"},
+		{Type: "AdGroup", Name: "UrlCustomParameters", Selectors: []string{"UrlCustomParameters"}},
+		{Type: "AdGroup", Name: "AdGroupType", Selectors: []string{"AdGroupType"}, Filter: "AdGroupType", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "AdGroup", Name: "AdGroupAdRotationMode"},
+	},
+	"AdGroupAdRotationMode": {
+		{Type: "AdGroupAdRotationMode", Name: "AdRotationMode", Selectors: []string{"AdRotationMode"}, Filter: "AdRotationMode"},
+	},
+	"AdGroupLabel": {
+		{Type: "AdGroupLabel", Name: "AdGroupId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+		{Type: "AdGroupLabel", Name: "LabelId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+	},
+	"AdGroupLabelOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "AdGroupLabelOperation", Name: "Operand", Required: true},
+	},
+	"AdGroupOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "AdGroupOperation", Name: "Operand", Required: true},
+	},
+	"BiddingStrategyConfiguration": {
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyId", Selectors: []string{"BiddingStrategyId"}, Filter: "BiddingStrategyId"},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyName", Selectors: []string{"BiddingStrategyName"}, Filter: "BiddingStrategyName", ReadOnly: true},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyType", Selectors: []string{"BiddingStrategyType"}, Filter: "BiddingStrategyType"},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategySource", Selectors: []string{"BiddingStrategySource"}, Filter: "BiddingStrategySource", ReadOnly: true},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingScheme"},
+		{Type: "BiddingStrategyConfiguration", Name: "Bids"},
+		{Type: "BiddingStrategyConfiguration", Name: "TargetRoasOverride", Selectors: []string{"TargetRoasOverride"}, Filter: "TargetRoasOverride"},
+	},
+	"TextLabel": {
+		{Type: "Label", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Label", Name: "Name"},
+		{Type: "Label", Name: "Status", ReadOnly: true},
+		{Type: "Label", Name: "Attribute", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Label", Name: "LabelType"},
+	},
+	"CpaBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpaBid", Name: "Bid", Selectors: []string{"TargetCpaBid"}, Filter: "TargetCpaBid"},
+		{Type: "CpaBid", Name: "BidSource", Selectors: []string{"TargetCpaBidSource"}, Filter: "TargetCpaBidSource", ReadOnly: true},
+	},
+	"CpcBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpcBid", Name: "Bid", Selectors: []string{"CpcBid"}, Filter: "CpcBid"},
+		{Type: "CpcBid", Name: "CpcBidSource", ReadOnly: true},
+	},
+	"CpmBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpmBid", Name: "Bid", Selectors: []string{"CpmBid"}, Filter: "CpmBid"},
+		{Type: "CpmBid", Name: "CpmBidSource", ReadOnly: true},
+	},
+	"CustomParameter": {
+		{Type: "CustomParameter", Name: "Key", Required: true},
+		{Type: "CustomParameter", Name: "Value"},
+		{Type: "CustomParameter", Name: "IsRemove"},
+	},
+	"Label": {
+		{Type: "Label", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Label", Name: "Name"},
+		{Type: "Label", Name: "Status", ReadOnly: true},
+		{Type: "Label", Name: "Attribute", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Label", Name: "LabelType"},
+	},
+	"ManualCpcBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "ManualCpcBiddingScheme", Name: "EnhancedCpcEnabled", Selectors: []string{"EnhancedCpcEnabled"}, Filter: "EnhancedCpcEnabled"},
+	},
+	"ManualCpmBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "ManualCpmBiddingScheme", Name: "ViewableCpmEnabled", ReadOnly: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"TargetCpaBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "TargetCpaBiddingScheme", Name: "TargetCpa", Selectors: []string{"TargetCpa"}, Filter: "TargetCpa"},
+		{Type: "TargetCpaBiddingScheme", Name: "MaxCpcBidCeiling"},
+		{Type: "TargetCpaBiddingScheme", Name: "MaxCpcBidFloor"},
+	},
+	"TargetingSettingDetail": {
+		{Type: "TargetingSettingDetail", Name: "CriterionTypeGroup", Required: true},
+		{Type: "TargetingSettingDetail", Name: "TargetAll", Required: true},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdGroupService
+
+// AddLabelOp returns the ADD operation of operand.
+func AddLabelOp(operand *AdGroupLabel) *AdGroupLabelOperation {
+	op := OperatorADD
+	return &AdGroupLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveLabelOp returns the REMOVE operation of operand.
+func RemoveLabelOp(operand *AdGroupLabel) *AdGroupLabelOperation {
+	op := OperatorREMOVE
+	return &AdGroupLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *AdGroup) *AdGroupOperation {
+	op := OperatorADD
+	return &AdGroupOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AdGroup) *AdGroupOperation {
+	op := OperatorSET
+	return &AdGroupOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,33 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdParamService
+
+import "github.com/godofdream/go-googleadsinofficial/batch"
+
+// MutateBatch is Mutate for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateBatch(api AdParamServiceAPI, operations []*AdParamOperation, opts *batch.Options) ([]*AdParam, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*AdParamOperation) (*MutateResponse, error) {
+		return api.Mutate(&Mutate{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateResponse) int {
+		if r == nil {
+			return 0
+		}
+		return len(r.Rval)
+	})
+	var rval []*AdParam
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil {
+			rval = append(rval, make([]*AdParam, c.End-c.Start)...)
+			continue
+		}
+		rval = append(rval, batch.Pad(c.Result.Rval, c.End-c.Start)...)
+	}
+	return rval, err
+}
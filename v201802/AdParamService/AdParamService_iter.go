@@ -0,0 +1,86 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdParamService
+
+import (
+	"iter"
+
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// GetAll returns an iterator over the AdParam entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func GetAll(api AdParamServiceAPI, sel *Selector) iter.Seq2[*AdParam, error] {
+	return GetPager(api, sel).All()
+}
+
+// GetEach calls fn for every AdParam entry selected by sel and
+// returns the first error. See GetAll.
+func GetEach(api AdParamServiceAPI, sel *Selector, fn func(*AdParam) error) error {
+	return GetPager(api, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func GetPager(api AdParamServiceAPI, sel *Selector) *paging.Pager[*AdParam] {
+	p := &paging.Pager[*AdParam]{}
+	if sel == nil {
+		sel = new(Selector)
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*AdParam, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+		resp, err := api.Get(&Get{ServiceSelector: paged})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.TotalNumEntries != nil {
+			total = *resp.Rval.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// GetSplitAll is GetAll for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitAll(api AdParamServiceAPI, sel *Selector, split *paging.Splitter) iter.Seq2[*AdParam, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel.Neutral(), getSplitPager(api))
+}
+
+// GetSplitEach is GetEach for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitEach(api AdParamServiceAPI, sel *Selector, split *paging.Splitter, fn func(*AdParam) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel.Neutral(), getSplitPager(api), fn)
+}
+
+func getSplitPager(api AdParamServiceAPI) func(*selector.Selector) (*paging.Pager[*AdParam], error) {
+	return func(s *selector.Selector) (*paging.Pager[*AdParam], error) {
+		sel, err := ConvertSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return GetPager(api, sel), nil
+	}
+}
@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdParamService
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *AdParam) *AdParamOperation {
+	op := OperatorSET
+	return &AdParamOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *AdParam) *AdParamOperation {
+	op := OperatorREMOVE
+	return &AdParamOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
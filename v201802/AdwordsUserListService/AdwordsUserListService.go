@@ -997,312 +997,264 @@ const (
 )
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CollectionSizeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CollectionSizeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CollectionSizeErrorReason `xml:"reason,omitempty"`
+	Reason *CollectionSizeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotWhitelistedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotWhitelistedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotWhitelistedErrorReason `xml:"reason,omitempty"`
+	Reason *NotWhitelistedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -1310,13 +1262,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -1325,108 +1277,90 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -1437,13 +1371,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -1452,58 +1386,54 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -1511,12 +1441,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -1524,57 +1454,53 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 //
@@ -2152,22 +2078,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/rm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 getResponse" json:"-"`
 
-	Rval *UserListPage `xml:"rval,omitempty"`
+	Rval *UserListPage `xml:"https://adwords.google.com/api/adwords/rm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint CollectionSize">The minimum size of this collection is 1. The maximum size of this collection is 10000.</span>
@@ -2175,17 +2101,17 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, SET.</span>
 	//
-	Operations []*UserListOperation `xml:"operations,omitempty"`
+	Operations []*UserListOperation `xml:"https://adwords.google.com/api/adwords/rm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutateResponse" json:"-"`
 
-	Rval *UserListReturnValue `xml:"rval,omitempty"`
+	Rval *UserListReturnValue `xml:"https://adwords.google.com/api/adwords/rm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateMembers struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutateMembers"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutateMembers" json:"-"`
 
 	//
 	// <span class="constraint CollectionSize">The minimum size of this collection is 1. The maximum size of this collection is 10000.</span>
@@ -2193,107 +2119,99 @@ type MutateMembers struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, REMOVE.</span>
 	//
-	Operations []*MutateMembersOperation `xml:"operations,omitempty"`
+	Operations []*MutateMembersOperation `xml:"https://adwords.google.com/api/adwords/rm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateMembersResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutateMembersResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutateMembersResponse" json:"-"`
 
-	Rval *MutateMembersReturnValue `xml:"rval,omitempty"`
+	Rval *MutateMembersReturnValue `xml:"https://adwords.google.com/api/adwords/rm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Query struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 query"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 query" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 queryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 queryResponse" json:"-"`
 
-	Rval *UserListPage `xml:"rval,omitempty"`
+	Rval *UserListPage `xml:"https://adwords.google.com/api/adwords/rm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AddressInfo struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 AddressInfo"`
-
 	//
 	// First name of the member, which is hashed as SHA-256 after normalized (Lowercase all
 	// characters; Remove any extra spaces before, after, and in between).
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	HashedFirstName string `xml:"hashedFirstName,omitempty"`
+	HashedFirstName *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 hashedFirstName,omitempty" json:"hashedFirstName,omitempty"`
 
 	//
 	// Last name of the member, which is hashed as SHA-256 after normalized (lower case only and no
 	// punctuation).
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	HashedLastName string `xml:"hashedLastName,omitempty"`
+	HashedLastName *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 hashedLastName,omitempty" json:"hashedLastName,omitempty"`
 
 	//
 	// 2-letter country code in ISO-3166-1 alpha-2 of the member's address.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 2 and 2, inclusive.</span>
 	//
-	CountryCode string `xml:"countryCode,omitempty"`
+	CountryCode *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 countryCode,omitempty" json:"countryCode,omitempty"`
 
 	//
 	// Zip code of the member's address.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	ZipCode string `xml:"zipCode,omitempty"`
+	ZipCode *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 zipCode,omitempty" json:"zipCode,omitempty"`
 }
 
 type CombinedRuleUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 CombinedRuleUserList"`
-
 	*RuleBasedUserList
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	LeftOperand *Rule `xml:"leftOperand,omitempty"`
+	LeftOperand *Rule `xml:"https://adwords.google.com/api/adwords/rm/v201802 leftOperand,omitempty" json:"leftOperand,omitempty"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	RightOperand *Rule `xml:"rightOperand,omitempty"`
+	RightOperand *Rule `xml:"https://adwords.google.com/api/adwords/rm/v201802 rightOperand,omitempty" json:"rightOperand,omitempty"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	RuleOperator *CombinedRuleUserListRuleOperator `xml:"ruleOperator,omitempty"`
+	RuleOperator *CombinedRuleUserListRuleOperator `xml:"https://adwords.google.com/api/adwords/rm/v201802 ruleOperator,omitempty" json:"ruleOperator,omitempty"`
 }
 
 type UserListConversionType struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserListConversionType"`
-
 	//
 	// Conversion type id
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Name of this conversion type
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// The category of the ConversionType based on the location where the
 	// conversion event was generated (from a user's perspective).
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Category *UserListConversionTypeCategory `xml:"category,omitempty"`
+	Category *UserListConversionTypeCategory `xml:"https://adwords.google.com/api/adwords/rm/v201802 category,omitempty" json:"category,omitempty"`
 }
 
 type CrmBasedUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 CrmBasedUserList"`
-
 	*UserList
 
 	//
@@ -2309,7 +2227,7 @@ type CrmBasedUserList struct {
 	// Required when creating CrmBasedUserList for uploading mobile advertising IDs.
 	// <span class="constraint Selectable">This field can be selected using the value "AppId".</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// Matching key type of the list.
@@ -2319,7 +2237,7 @@ type CrmBasedUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "UploadKeyType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: SET.</span>
 	//
-	UploadKeyType *CustomerMatchUploadKeyType `xml:"uploadKeyType,omitempty"`
+	UploadKeyType *CustomerMatchUploadKeyType `xml:"https://adwords.google.com/api/adwords/rm/v201802 uploadKeyType,omitempty" json:"uploadKeyType,omitempty"`
 
 	//
 	// Data source of the list.
@@ -2328,72 +2246,64 @@ type CrmBasedUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "DataSourceType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: SET.</span>
 	//
-	DataSourceType *CrmDataSourceType `xml:"dataSourceType,omitempty"`
+	DataSourceType *CrmDataSourceType `xml:"https://adwords.google.com/api/adwords/rm/v201802 dataSourceType,omitempty" json:"dataSourceType,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "DataUploadResult".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
 	//
-	DataUploadResult *DataUploadResult `xml:"dataUploadResult,omitempty"`
+	DataUploadResult *DataUploadResult `xml:"https://adwords.google.com/api/adwords/rm/v201802 dataUploadResult,omitempty" json:"dataUploadResult,omitempty"`
 }
 
 type DataUploadResult struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 DataUploadResult"`
-
 	//
 	// Indicates status of the upload operation.
 	// Upload operation is triggered when {@link MutateMembersOperand#removeAll removeAll} is not set
 	// to true and {@link Operator operator} is "ADD" or "REMOVE".
 	//
-	UploadStatus *UserListUploadStatus `xml:"uploadStatus,omitempty"`
+	UploadStatus *UserListUploadStatus `xml:"https://adwords.google.com/api/adwords/rm/v201802 uploadStatus,omitempty" json:"uploadStatus,omitempty"`
 
 	//
 	// Indicates status of the remove-all operation.
 	// Remove-all operation is triggered when {@link MutateMembersOperand#removeAll removeAll} is set
 	// to true and {@link Operator operator} is "REMOVE".
 	//
-	RemoveAllStatus *UserListUploadStatus `xml:"removeAllStatus,omitempty"`
+	RemoveAllStatus *UserListUploadStatus `xml:"https://adwords.google.com/api/adwords/rm/v201802 removeAllStatus,omitempty" json:"removeAllStatus,omitempty"`
 }
 
 type DateKey struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 DateKey"`
-
 	//
 	// <span class="constraint MatchesRegex">A name must begin with US-ascii letters or underscore or UTF8 code that is greater than 127 and consist of US-ascii letters or digits or underscore or UTF8 code that is greater than 127. This is checked by the regular expression '^[a-zA-Z_?-?][a-zA-Z0-9_?-?]*$'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type DateRuleItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 DateRuleItem"`
-
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Key *DateKey `xml:"key,omitempty"`
+	Key *DateKey `xml:"https://adwords.google.com/api/adwords/rm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Op *DateRuleItemDateOperator `xml:"op,omitempty"`
+	Op *DateRuleItemDateOperator `xml:"https://adwords.google.com/api/adwords/rm/v201802 op,omitempty" json:"op,omitempty"`
 
 	//
 	// The right hand side of date rule item. The date's format should be YYYYMMDD.
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// The relative date value of the right hand side. The {@code value} field will
 	// override this field when both are present.
 	//
-	RelativeValue *RelativeDate `xml:"relativeValue,omitempty"`
+	RelativeValue *RelativeDate `xml:"https://adwords.google.com/api/adwords/rm/v201802 relativeValue,omitempty" json:"relativeValue,omitempty"`
 }
 
 type DateSpecificRuleUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 DateSpecificRuleUserList"`
-
 	*RuleBasedUserList
 
 	//
@@ -2401,7 +2311,7 @@ type DateSpecificRuleUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "DateSpecificListRule".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Rule *Rule `xml:"rule,omitempty"`
+	Rule *Rule `xml:"https://adwords.google.com/api/adwords/rm/v201802 rule,omitempty" json:"rule,omitempty"`
 
 	//
 	// Start date of users visit. If set to <code>20000101</code>, then includes
@@ -2410,7 +2320,7 @@ type DateSpecificRuleUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "DateSpecificListStartDate".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	StartDate string `xml:"startDate,omitempty"`
+	StartDate *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 startDate,omitempty" json:"startDate,omitempty"`
 
 	//
 	// End date of users visit. If set to <code>20371230</code>, then includes
@@ -2419,12 +2329,10 @@ type DateSpecificRuleUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "DateSpecificListEndDate".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	EndDate string `xml:"endDate,omitempty"`
+	EndDate *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 endDate,omitempty" json:"endDate,omitempty"`
 }
 
 type ExpressionRuleUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 ExpressionRuleUserList"`
-
 	*RuleBasedUserList
 
 	//
@@ -2436,12 +2344,10 @@ type ExpressionRuleUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "ExpressionListRule".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Rule *Rule `xml:"rule,omitempty"`
+	Rule *Rule `xml:"https://adwords.google.com/api/adwords/rm/v201802 rule,omitempty" json:"rule,omitempty"`
 }
 
 type LogicalUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 LogicalUserList"`
-
 	*UserList
 
 	//
@@ -2450,68 +2356,60 @@ type LogicalUserList struct {
 	// anded for the evaluation. Required for ADD operation.
 	// <span class="constraint Selectable">This field can be selected using the value "Rules".</span>
 	//
-	Rules []*UserListLogicalRule `xml:"rules,omitempty"`
+	Rules []*UserListLogicalRule `xml:"https://adwords.google.com/api/adwords/rm/v201802 rules,omitempty" json:"rules,omitempty"`
 }
 
 type LogicalUserListOperand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 LogicalUserListOperand"`
-
-	UserList *UserList `xml:"UserList,omitempty"`
+	UserList *UserList `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserList,omitempty" json:"userList,omitempty"`
 }
 
 type Member struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 Member"`
-
 	//
 	// Hashed email address using SHA-256 hash function after normalization.
 	//
-	HashedEmail string `xml:"hashedEmail,omitempty"`
+	HashedEmail *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 hashedEmail,omitempty" json:"hashedEmail,omitempty"`
 
 	//
 	// Mobile device ID (advertising ID/IDFA).
 	//
-	MobileId string `xml:"mobileId,omitempty"`
+	MobileId *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 mobileId,omitempty" json:"mobileId,omitempty"`
 
 	//
 	// Hashed phone number using SHA-256 hash function after normalization.
 	//
-	HashedPhoneNumber string `xml:"hashedPhoneNumber,omitempty"`
+	HashedPhoneNumber *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 hashedPhoneNumber,omitempty" json:"hashedPhoneNumber,omitempty"`
 
 	//
 	// Address info.
 	//
-	AddressInfo *AddressInfo `xml:"addressInfo,omitempty"`
+	AddressInfo *AddressInfo `xml:"https://adwords.google.com/api/adwords/rm/v201802 addressInfo,omitempty" json:"addressInfo,omitempty"`
 
 	//
 	// Advertiser generated and assigned user ID. Accessible to whitelisted US customers only.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 512, inclusive.</span>
 	//
-	UserId string `xml:"userId,omitempty"`
+	UserId *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 userId,omitempty" json:"userId,omitempty"`
 }
 
 type MutateMembersError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 MutateMembersError"`
-
 	*ApiError
 
-	Reason *MutateMembersErrorReason `xml:"reason,omitempty"`
+	Reason *MutateMembersErrorReason `xml:"https://adwords.google.com/api/adwords/rm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type MutateMembersOperand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 MutateMembersOperand"`
-
 	//
 	// The id of the user list.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	UserListId int64 `xml:"userListId,omitempty"`
+	UserListId *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 userListId,omitempty" json:"userListId,omitempty"`
 
 	//
 	// Set to indicate a remove-all operation which will remove all members from the user list.
 	// Can only be set with {@code Operator#REMOVE} and
 	// when set to true {@link #members} must be null or empty.
 	//
-	RemoveAll bool `xml:"removeAll,omitempty"`
+	RemoveAll *bool `xml:"https://adwords.google.com/api/adwords/rm/v201802 removeAll,omitempty" json:"removeAll,omitempty"`
 
 	//
 	// A list of members to be added or removed.
@@ -2521,86 +2419,72 @@ type MutateMembersOperand struct {
 	// <span class="constraint CollectionSize">The maximum size of this collection is 1000000.</span>
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	MembersList []*Member `xml:"membersList,omitempty"`
+	MembersList []*Member `xml:"https://adwords.google.com/api/adwords/rm/v201802 membersList,omitempty" json:"membersList,omitempty"`
 }
 
 type MutateMembersOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 MutateMembersOperation"`
-
 	*Operation
 
 	//
 	// The mutate members operand to operate on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *MutateMembersOperand `xml:"operand,omitempty"`
+	Operand *MutateMembersOperand `xml:"https://adwords.google.com/api/adwords/rm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type MutateMembersReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 MutateMembersReturnValue"`
-
 	//
 	// The user lists associated in mutate members operations.
 	//
-	UserLists []*UserList `xml:"userLists,omitempty"`
+	UserLists []*UserList `xml:"https://adwords.google.com/api/adwords/rm/v201802 userLists,omitempty" json:"userLists,omitempty"`
 }
 
 type NumberKey struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 NumberKey"`
-
 	//
 	// <span class="constraint MatchesRegex">A name must begin with US-ascii letters or underscore or UTF8 code that is greater than 127 and consist of US-ascii letters or digits or underscore or UTF8 code that is greater than 127. This is checked by the regular expression '^[a-zA-Z_?-?][a-zA-Z0-9_?-?]*$'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type NumberRuleItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 NumberRuleItem"`
-
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Key *NumberKey `xml:"key,omitempty"`
+	Key *NumberKey `xml:"https://adwords.google.com/api/adwords/rm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Op *NumberRuleItemNumberOperator `xml:"op,omitempty"`
+	Op *NumberRuleItemNumberOperator `xml:"https://adwords.google.com/api/adwords/rm/v201802 op,omitempty" json:"op,omitempty"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Value float64 `xml:"value,omitempty"`
+	Value *float64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type RelativeDate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 RelativeDate"`
-
 	//
 	// Number of days offset from current date.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	OffsetInDays int32 `xml:"offsetInDays,omitempty"`
+	OffsetInDays *int32 `xml:"https://adwords.google.com/api/adwords/rm/v201802 offsetInDays,omitempty" json:"offsetInDays,omitempty"`
 }
 
 type BasicUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 BasicUserList"`
-
 	*UserList
 
 	//
 	// Conversion types associated with this user list.
 	// <span class="constraint Selectable">This field can be selected using the value "ConversionTypes".</span>
 	//
-	ConversionTypes []*UserListConversionType `xml:"conversionTypes,omitempty"`
+	ConversionTypes []*UserListConversionType `xml:"https://adwords.google.com/api/adwords/rm/v201802 conversionTypes,omitempty" json:"conversionTypes,omitempty"`
 }
 
 type Rule struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 Rule"`
-
 	//
 	// List of rule item groups that defines this rule.
 	// Rule item groups are ORed together for evaluation before version V201705.
@@ -2611,7 +2495,7 @@ type Rule struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Groups []*RuleItemGroup `xml:"groups,omitempty"`
+	Groups []*RuleItemGroup `xml:"https://adwords.google.com/api/adwords/rm/v201802 groups,omitempty" json:"groups,omitempty"`
 
 	//
 	// Rule type is used to determine how to group rule item groups and rule items inside rule item
@@ -2620,12 +2504,10 @@ type Rule struct {
 	// form (OR of ANDs), namely rule item groups are ORed together and inside each rule item group,
 	// rule items are ANDed together.
 	//
-	RuleType *UserListRuleTypeEnumsEnum `xml:"ruleType,omitempty"`
+	RuleType *UserListRuleTypeEnumsEnum `xml:"https://adwords.google.com/api/adwords/rm/v201802 ruleType,omitempty" json:"ruleType,omitempty"`
 }
 
 type RuleBasedUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 RuleBasedUserList"`
-
 	*UserList
 
 	//
@@ -2637,22 +2519,18 @@ type RuleBasedUserList struct {
 	// once request is processed, or FAILED if the request fails.
 	// <span class="constraint Selectable">This field can be selected using the value "PrepopulationStatus".</span>
 	//
-	PrepopulationStatus *RuleBasedUserListPrepopulationStatus `xml:"prepopulationStatus,omitempty"`
+	PrepopulationStatus *RuleBasedUserListPrepopulationStatus `xml:"https://adwords.google.com/api/adwords/rm/v201802 prepopulationStatus,omitempty" json:"prepopulationStatus,omitempty"`
 }
 
 type RuleItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 RuleItem"`
-
-	DateRuleItem *DateRuleItem `xml:"DateRuleItem,omitempty"`
+	DateRuleItem *DateRuleItem `xml:"https://adwords.google.com/api/adwords/rm/v201802 DateRuleItem,omitempty" json:"dateRuleItem,omitempty"`
 
-	NumberRuleItem *NumberRuleItem `xml:"NumberRuleItem,omitempty"`
+	NumberRuleItem *NumberRuleItem `xml:"https://adwords.google.com/api/adwords/rm/v201802 NumberRuleItem,omitempty" json:"numberRuleItem,omitempty"`
 
-	StringRuleItem *StringRuleItem `xml:"StringRuleItem,omitempty"`
+	StringRuleItem *StringRuleItem `xml:"https://adwords.google.com/api/adwords/rm/v201802 StringRuleItem,omitempty" json:"stringRuleItem,omitempty"`
 }
 
 type RuleItemGroup struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 RuleItemGroup"`
-
 	//
 	// Before version V201705, rule items are ANDed together.
 	// Starting from version V201705, rule items will be grouped together based on
@@ -2662,12 +2540,10 @@ type RuleItemGroup struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Items []*RuleItem `xml:"items,omitempty"`
+	Items []*RuleItem `xml:"https://adwords.google.com/api/adwords/rm/v201802 items,omitempty" json:"items,omitempty"`
 }
 
 type SimilarUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 SimilarUserList"`
-
 	*UserList
 
 	//
@@ -2676,28 +2552,28 @@ type SimilarUserList struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: SET.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	SeedUserListId int64 `xml:"seedUserListId,omitempty"`
+	SeedUserListId *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 seedUserListId,omitempty" json:"seedUserListId,omitempty"`
 
 	//
 	// Name of the seed user list.
 	// <span class="constraint Selectable">This field can be selected using the value "SeedUserListName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SeedUserListName string `xml:"seedUserListName,omitempty"`
+	SeedUserListName *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 seedUserListName,omitempty" json:"seedUserListName,omitempty"`
 
 	//
 	// Description of this seed user list.
 	// <span class="constraint Selectable">This field can be selected using the value "SeedUserListDescription".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SeedUserListDescription string `xml:"seedUserListDescription,omitempty"`
+	SeedUserListDescription *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 seedUserListDescription,omitempty" json:"seedUserListDescription,omitempty"`
 
 	//
 	// Membership status of this seed user list.
 	// <span class="constraint Selectable">This field can be selected using the value "SeedUserListStatus".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SeedUserListStatus *UserListMembershipStatus `xml:"seedUserListStatus,omitempty"`
+	SeedUserListStatus *UserListMembershipStatus `xml:"https://adwords.google.com/api/adwords/rm/v201802 seedUserListStatus,omitempty" json:"seedUserListStatus,omitempty"`
 
 	//
 	// Estimated number of users in this seed user list.
@@ -2705,32 +2581,28 @@ type SimilarUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "SeedListSize".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SeedListSize int64 `xml:"seedListSize,omitempty"`
+	SeedListSize *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 seedListSize,omitempty" json:"seedListSize,omitempty"`
 }
 
 type StringKey struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 StringKey"`
-
 	//
 	// <span class="constraint MatchesRegex">A name must begin with US-ascii letters or underscore or UTF8 code that is greater than 127 and consist of US-ascii letters or digits or underscore or UTF8 code that is greater than 127. This is checked by the regular expression '^[a-zA-Z_?-?][a-zA-Z0-9_?-?]*$'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type StringRuleItem struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 StringRuleItem"`
-
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Key *StringKey `xml:"key,omitempty"`
+	Key *StringKey `xml:"https://adwords.google.com/api/adwords/rm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Op *StringRuleItemStringOperator `xml:"op,omitempty"`
+	Op *StringRuleItemStringOperator `xml:"https://adwords.google.com/api/adwords/rm/v201802 op,omitempty" json:"op,omitempty"`
 
 	//
 	// The right hand side of the string rule item. For URL/Referrer URL,
@@ -2741,18 +2613,16 @@ type StringRuleItem struct {
 	// "]*'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type UserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserList"`
-
 	//
 	// Id of this user list.
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// A flag that indicates if a user may edit a list. Depends on the list ownership
@@ -2760,34 +2630,34 @@ type UserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "IsReadOnly".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	IsReadOnly bool `xml:"isReadOnly,omitempty"`
+	IsReadOnly *bool `xml:"https://adwords.google.com/api/adwords/rm/v201802 isReadOnly,omitempty" json:"isReadOnly,omitempty"`
 
 	//
 	// Name of this user list. Depending on its AccessReason, the user list name
 	// may not be unique (e.g. if {@code AccessReason=SHARED}).
 	// <span class="constraint Selectable">This field can be selected using the value "Name".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Description of this user list.
 	// <span class="constraint Selectable">This field can be selected using the value "Description".</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 description,omitempty" json:"description,omitempty"`
 
 	//
 	// Membership status of this user list. Indicates whether a user list is open
 	// or active. Only open user lists can accumulate more users and can be targeted to.
 	// <span class="constraint Selectable">This field can be selected using the value "Status".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Status *UserListMembershipStatus `xml:"status,omitempty"`
+	Status *UserListMembershipStatus `xml:"https://adwords.google.com/api/adwords/rm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// An Id from external system. It is used by user list sellers to correlate ids on their
 	// systems.
 	// <span class="constraint Selectable">This field can be selected using the value "IntegrationCode".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	IntegrationCode string `xml:"integrationCode,omitempty"`
+	IntegrationCode *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 integrationCode,omitempty" json:"integrationCode,omitempty"`
 
 	//
 	// Indicates the reason this account has been granted access to the list. The reason can be
@@ -2795,7 +2665,7 @@ type UserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "AccessReason".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	AccessReason *AccessReason `xml:"accessReason,omitempty"`
+	AccessReason *AccessReason `xml:"https://adwords.google.com/api/adwords/rm/v201802 accessReason,omitempty" json:"accessReason,omitempty"`
 
 	//
 	// Indicates if this share is still active. When a UserList is shared with the user
@@ -2803,7 +2673,7 @@ type UserList struct {
 	// share and make it Inactive. The default value of this field is set to Active.
 	// <span class="constraint Selectable">This field can be selected using the value "AccountUserListStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AccountUserListStatus *AccountUserListStatus `xml:"accountUserListStatus,omitempty"`
+	AccountUserListStatus *AccountUserListStatus `xml:"https://adwords.google.com/api/adwords/rm/v201802 accountUserListStatus,omitempty" json:"accountUserListStatus,omitempty"`
 
 	//
 	// Number of days a user's cookie stays on your list since its most recent addition to the list.
@@ -2813,7 +2683,7 @@ type UserList struct {
 	// <p>It'll be ignored for {@link LogicalUserList}.
 	// <span class="constraint Selectable">This field can be selected using the value "MembershipLifeSpan".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	MembershipLifeSpan int64 `xml:"membershipLifeSpan,omitempty"`
+	MembershipLifeSpan *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 membershipLifeSpan,omitempty" json:"membershipLifeSpan,omitempty"`
 
 	//
 	// Estimated number of users in this user list, on the Google Display Network.
@@ -2821,14 +2691,14 @@ type UserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Size".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Size int64 `xml:"size,omitempty"`
+	Size *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 size,omitempty" json:"size,omitempty"`
 
 	//
 	// Size range in terms of number of users of the UserList.
 	// <span class="constraint Selectable">This field can be selected using the value "SizeRange".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SizeRange *SizeRange `xml:"sizeRange,omitempty"`
+	SizeRange *SizeRange `xml:"https://adwords.google.com/api/adwords/rm/v201802 sizeRange,omitempty" json:"sizeRange,omitempty"`
 
 	//
 	// Estimated number of users in this user list in the google.com domain.
@@ -2837,34 +2707,34 @@ type UserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "SizeForSearch".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SizeForSearch int64 `xml:"sizeForSearch,omitempty"`
+	SizeForSearch *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 sizeForSearch,omitempty" json:"sizeForSearch,omitempty"`
 
 	//
 	// Size range in terms of number of users of the UserList, for Search ads.
 	// <span class="constraint Selectable">This field can be selected using the value "SizeRangeForSearch".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SizeRangeForSearch *SizeRange `xml:"sizeRangeForSearch,omitempty"`
+	SizeRangeForSearch *SizeRange `xml:"https://adwords.google.com/api/adwords/rm/v201802 sizeRangeForSearch,omitempty" json:"sizeRangeForSearch,omitempty"`
 
 	//
 	// Type of this list: remarketing/logical/external remarketing.
 	// <span class="constraint Selectable">This field can be selected using the value "ListType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ListType *UserListType `xml:"listType,omitempty"`
+	ListType *UserListType `xml:"https://adwords.google.com/api/adwords/rm/v201802 listType,omitempty" json:"listType,omitempty"`
 
 	//
 	// A flag that indicates this user list is eligible for Google Search Network.
 	// <span class="constraint Selectable">This field can be selected using the value "IsEligibleForSearch".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	IsEligibleForSearch bool `xml:"isEligibleForSearch,omitempty"`
+	IsEligibleForSearch *bool `xml:"https://adwords.google.com/api/adwords/rm/v201802 isEligibleForSearch,omitempty" json:"isEligibleForSearch,omitempty"`
 
 	//
 	// A flag that indicates this user list is eligible for Display Network.
 	// <span class="constraint Selectable">This field can be selected using the value "IsEligibleForDisplay".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	IsEligibleForDisplay bool `xml:"isEligibleForDisplay,omitempty"`
+	IsEligibleForDisplay *bool `xml:"https://adwords.google.com/api/adwords/rm/v201802 isEligibleForDisplay,omitempty" json:"isEligibleForDisplay,omitempty"`
 
 	//
 	// Indicating the reason why this user list membership status is closed. It is only populated on
@@ -2872,35 +2742,31 @@ type UserList struct {
 	// membership status becomes open.
 	// <span class="constraint Selectable">This field can be selected using the value "ClosingReason".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	ClosingReason *UserListClosingReason `xml:"closingReason,omitempty"`
+	ClosingReason *UserListClosingReason `xml:"https://adwords.google.com/api/adwords/rm/v201802 closingReason,omitempty" json:"closingReason,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of UserList.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	UserListType string `xml:"UserList.Type,omitempty"`
+	UserListType *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserList.Type,omitempty" json:"userListType,omitempty"`
 }
 
 type UserListError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserListError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *UserListErrorReason `xml:"reason,omitempty"`
+	Reason *UserListErrorReason `xml:"https://adwords.google.com/api/adwords/rm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type UserListLogicalRule struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserListLogicalRule"`
-
 	//
 	// The logical operator of the rule.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *UserListLogicalRuleOperator `xml:"operator,omitempty"`
+	Operator *UserListLogicalRuleOperator `xml:"https://adwords.google.com/api/adwords/rm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The list of operands of the rule.
@@ -2908,38 +2774,32 @@ type UserListLogicalRule struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	RuleOperands []*LogicalUserListOperand `xml:"ruleOperands,omitempty"`
+	RuleOperands []*LogicalUserListOperand `xml:"https://adwords.google.com/api/adwords/rm/v201802 ruleOperands,omitempty" json:"ruleOperands,omitempty"`
 }
 
 type UserListOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserListOperation"`
-
 	*Operation
 
 	//
 	// UserList to operate on
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *UserList `xml:"operand,omitempty"`
+	Operand *UserList `xml:"https://adwords.google.com/api/adwords/rm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type UserListPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserListPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*UserList `xml:"entries,omitempty"`
+	Entries []*UserList `xml:"https://adwords.google.com/api/adwords/rm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type UserListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserListReturnValue"`
-
 	*ListReturnValue
 
-	Value []*UserList `xml:"value,omitempty"`
+	Value []*UserList `xml:"https://adwords.google.com/api/adwords/rm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type AdwordsUserListServiceInterface struct {
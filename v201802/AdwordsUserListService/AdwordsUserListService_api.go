@@ -0,0 +1,142 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdwordsUserListService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AdwordsUserListServiceAPI is the interface of the AdwordsUserListService operations. It is
+// implemented by AdwordsUserListServiceInterface and, for tests, by FakeAdwordsUserListService.
+type AdwordsUserListServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+	MutateMembers(request *MutateMembers) (*MutateMembersResponse, error)
+	Query(request *Query) (*QueryResponse, error)
+}
+
+var _ AdwordsUserListServiceAPI = (*AdwordsUserListServiceInterface)(nil)
+var _ AdwordsUserListServiceAPI = (*FakeAdwordsUserListService)(nil)
+
+// FakeCall is a call recorded by FakeAdwordsUserListService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeAdwordsUserListService is a programmable AdwordsUserListServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeAdwordsUserListService struct {
+	GetFunc           func(request *Get) (*GetResponse, error)
+	MutateFunc        func(request *Mutate) (*MutateResponse, error)
+	MutateMembersFunc func(request *MutateMembers) (*MutateMembersResponse, error)
+	QueryFunc         func(request *Query) (*QueryResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeAdwordsUserListService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeAdwordsUserListService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAdwordsUserListService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeAdwordsUserListService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeAdwordsUserListService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeAdwordsUserListService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeAdwordsUserListService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeAdwordsUserListService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeAdwordsUserListService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
+
+// MutateMembers records the call and delegates to MutateMembersFunc.
+func (f *FakeAdwordsUserListService) MutateMembers(request *MutateMembers) (*MutateMembersResponse, error) {
+	f.record("MutateMembers", request)
+	if f.MutateMembersFunc == nil {
+		return nil, fmt.Errorf("FakeAdwordsUserListService.MutateMembersFunc is not set")
+	}
+	return f.MutateMembersFunc(request)
+}
+
+// MutateMembersCalls returns the requests of the recorded MutateMembers calls.
+func (f *FakeAdwordsUserListService) MutateMembersCalls() []*MutateMembers {
+	var requests []*MutateMembers
+	for _, c := range f.Calls() {
+		if c.Method == "MutateMembers" {
+			requests = append(requests, c.Request.(*MutateMembers))
+		}
+	}
+	return requests
+}
+
+// Query records the call and delegates to QueryFunc.
+func (f *FakeAdwordsUserListService) Query(request *Query) (*QueryResponse, error) {
+	f.record("Query", request)
+	if f.QueryFunc == nil {
+		return nil, fmt.Errorf("FakeAdwordsUserListService.QueryFunc is not set")
+	}
+	return f.QueryFunc(request)
+}
+
+// QueryCalls returns the requests of the recorded Query calls.
+func (f *FakeAdwordsUserListService) QueryCalls() []*Query {
+	var requests []*Query
+	for _, c := range f.Calls() {
+		if c.Method == "Query" {
+			requests = append(requests, c.Request.(*Query))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,66 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdwordsUserListService
+
+import "github.com/godofdream/go-googleadsinofficial/batch"
+
+// MutateBatch is Mutate for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateBatch(api AdwordsUserListServiceAPI, operations []*UserListOperation, opts *batch.Options) (*UserListReturnValue, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*UserListOperation) (*MutateResponse, error) {
+		return api.Mutate(&Mutate{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Value)
+	})
+	rval := new(UserListReturnValue)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Value = append(rval.Value, make([]*UserList, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		if rval.ListReturnValue == nil {
+			rval.ListReturnValue = r.ListReturnValue
+		}
+		rval.Value = append(rval.Value, batch.Pad(r.Value, c.End-c.Start)...)
+	}
+	return rval, err
+}
+
+// MutateMembersBatch is MutateMembers for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateMembersBatch(api AdwordsUserListServiceAPI, operations []*MutateMembersOperation, opts *batch.Options) (*MutateMembersReturnValue, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*MutateMembersOperation) (*MutateMembersResponse, error) {
+		return api.MutateMembers(&MutateMembers{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateMembersResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.UserLists)
+	})
+	rval := new(MutateMembersReturnValue)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.UserLists = append(rval.UserLists, make([]*UserList, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		rval.UserLists = append(rval.UserLists, batch.Pad(r.UserLists, c.End-c.Start)...)
+	}
+	return rval, err
+}
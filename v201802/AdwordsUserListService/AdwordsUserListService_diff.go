@@ -0,0 +1,960 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdwordsUserListService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CollectionSizeError) Copy() *CollectionSizeError {
+	return deep.Copy(t).(*CollectionSizeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CollectionSizeError) Equal(o *CollectionSizeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityNotFound) Copy() *EntityNotFound {
+	return deep.Copy(t).(*EntityNotFound)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityNotFound) Equal(o *EntityNotFound) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotWhitelistedError) Copy() *NotWhitelistedError {
+	return deep.Copy(t).(*NotWhitelistedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotWhitelistedError) Equal(o *NotWhitelistedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateMembers) Copy() *MutateMembers {
+	return deep.Copy(t).(*MutateMembers)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateMembers) Equal(o *MutateMembers) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateMembersResponse) Copy() *MutateMembersResponse {
+	return deep.Copy(t).(*MutateMembersResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateMembersResponse) Equal(o *MutateMembersResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AddressInfo) Copy() *AddressInfo {
+	return deep.Copy(t).(*AddressInfo)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AddressInfo) Equal(o *AddressInfo) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CombinedRuleUserList) Copy() *CombinedRuleUserList {
+	return deep.Copy(t).(*CombinedRuleUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CombinedRuleUserList) Equal(o *CombinedRuleUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UserListConversionType) Copy() *UserListConversionType {
+	return deep.Copy(t).(*UserListConversionType)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UserListConversionType) Equal(o *UserListConversionType) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CrmBasedUserList) Copy() *CrmBasedUserList {
+	return deep.Copy(t).(*CrmBasedUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CrmBasedUserList) Equal(o *CrmBasedUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DataUploadResult) Copy() *DataUploadResult {
+	return deep.Copy(t).(*DataUploadResult)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DataUploadResult) Equal(o *DataUploadResult) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateKey) Copy() *DateKey {
+	return deep.Copy(t).(*DateKey)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateKey) Equal(o *DateKey) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRuleItem) Copy() *DateRuleItem {
+	return deep.Copy(t).(*DateRuleItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRuleItem) Equal(o *DateRuleItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateSpecificRuleUserList) Copy() *DateSpecificRuleUserList {
+	return deep.Copy(t).(*DateSpecificRuleUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateSpecificRuleUserList) Equal(o *DateSpecificRuleUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExpressionRuleUserList) Copy() *ExpressionRuleUserList {
+	return deep.Copy(t).(*ExpressionRuleUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExpressionRuleUserList) Equal(o *ExpressionRuleUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LogicalUserList) Copy() *LogicalUserList {
+	return deep.Copy(t).(*LogicalUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LogicalUserList) Equal(o *LogicalUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LogicalUserListOperand) Copy() *LogicalUserListOperand {
+	return deep.Copy(t).(*LogicalUserListOperand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LogicalUserListOperand) Equal(o *LogicalUserListOperand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Member) Copy() *Member {
+	return deep.Copy(t).(*Member)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Member) Equal(o *Member) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateMembersError) Copy() *MutateMembersError {
+	return deep.Copy(t).(*MutateMembersError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateMembersError) Equal(o *MutateMembersError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateMembersOperand) Copy() *MutateMembersOperand {
+	return deep.Copy(t).(*MutateMembersOperand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateMembersOperand) Equal(o *MutateMembersOperand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateMembersOperation) Copy() *MutateMembersOperation {
+	return deep.Copy(t).(*MutateMembersOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateMembersOperation) Equal(o *MutateMembersOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateMembersReturnValue) Copy() *MutateMembersReturnValue {
+	return deep.Copy(t).(*MutateMembersReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateMembersReturnValue) Equal(o *MutateMembersReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NumberKey) Copy() *NumberKey {
+	return deep.Copy(t).(*NumberKey)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NumberKey) Equal(o *NumberKey) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NumberRuleItem) Copy() *NumberRuleItem {
+	return deep.Copy(t).(*NumberRuleItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NumberRuleItem) Equal(o *NumberRuleItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RelativeDate) Copy() *RelativeDate {
+	return deep.Copy(t).(*RelativeDate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RelativeDate) Equal(o *RelativeDate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BasicUserList) Copy() *BasicUserList {
+	return deep.Copy(t).(*BasicUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BasicUserList) Equal(o *BasicUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Rule) Copy() *Rule {
+	return deep.Copy(t).(*Rule)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Rule) Equal(o *Rule) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RuleBasedUserList) Copy() *RuleBasedUserList {
+	return deep.Copy(t).(*RuleBasedUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RuleBasedUserList) Equal(o *RuleBasedUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RuleItem) Copy() *RuleItem {
+	return deep.Copy(t).(*RuleItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RuleItem) Equal(o *RuleItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RuleItemGroup) Copy() *RuleItemGroup {
+	return deep.Copy(t).(*RuleItemGroup)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RuleItemGroup) Equal(o *RuleItemGroup) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SimilarUserList) Copy() *SimilarUserList {
+	return deep.Copy(t).(*SimilarUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SimilarUserList) Equal(o *SimilarUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringKey) Copy() *StringKey {
+	return deep.Copy(t).(*StringKey)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringKey) Equal(o *StringKey) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringRuleItem) Copy() *StringRuleItem {
+	return deep.Copy(t).(*StringRuleItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringRuleItem) Equal(o *StringRuleItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UserList) Copy() *UserList {
+	return deep.Copy(t).(*UserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UserList) Equal(o *UserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UserListError) Copy() *UserListError {
+	return deep.Copy(t).(*UserListError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UserListError) Equal(o *UserListError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UserListLogicalRule) Copy() *UserListLogicalRule {
+	return deep.Copy(t).(*UserListLogicalRule)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UserListLogicalRule) Equal(o *UserListLogicalRule) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UserListOperation) Copy() *UserListOperation {
+	return deep.Copy(t).(*UserListOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UserListOperation) Equal(o *UserListOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UserListPage) Copy() *UserListPage {
+	return deep.Copy(t).(*UserListPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UserListPage) Equal(o *UserListPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UserListReturnValue) Copy() *UserListReturnValue {
+	return deep.Copy(t).(*UserListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UserListReturnValue) Equal(o *UserListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffCombinedRuleUserList compares from, as fetched from the API, with the desired
+// to. It returns a CombinedRuleUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCombinedRuleUserList(from, to *CombinedRuleUserList) (*CombinedRuleUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason", "PrepopulationStatus", "LeftOperand", "RightOperand", "RuleOperator"},
+		[]string{"Id"},
+	).(*CombinedRuleUserList)
+	return set, changes
+}
+
+// DiffCrmBasedUserList compares from, as fetched from the API, with the desired
+// to. It returns a CrmBasedUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCrmBasedUserList(from, to *CrmBasedUserList) (*CrmBasedUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason", "AppId", "DataUploadResult"},
+		[]string{"Id"},
+	).(*CrmBasedUserList)
+	return set, changes
+}
+
+// DiffDateSpecificRuleUserList compares from, as fetched from the API, with the desired
+// to. It returns a DateSpecificRuleUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffDateSpecificRuleUserList(from, to *DateSpecificRuleUserList) (*DateSpecificRuleUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason", "PrepopulationStatus", "Rule", "StartDate", "EndDate"},
+		[]string{"Id"},
+	).(*DateSpecificRuleUserList)
+	return set, changes
+}
+
+// DiffExpressionRuleUserList compares from, as fetched from the API, with the desired
+// to. It returns a ExpressionRuleUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffExpressionRuleUserList(from, to *ExpressionRuleUserList) (*ExpressionRuleUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason", "PrepopulationStatus", "Rule"},
+		[]string{"Id"},
+	).(*ExpressionRuleUserList)
+	return set, changes
+}
+
+// DiffLogicalUserList compares from, as fetched from the API, with the desired
+// to. It returns a LogicalUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffLogicalUserList(from, to *LogicalUserList) (*LogicalUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason", "Rules"},
+		[]string{"Id"},
+	).(*LogicalUserList)
+	return set, changes
+}
+
+// DiffBasicUserList compares from, as fetched from the API, with the desired
+// to. It returns a BasicUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffBasicUserList(from, to *BasicUserList) (*BasicUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason", "ConversionTypes"},
+		[]string{"Id"},
+	).(*BasicUserList)
+	return set, changes
+}
+
+// DiffRuleBasedUserList compares from, as fetched from the API, with the desired
+// to. It returns a RuleBasedUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffRuleBasedUserList(from, to *RuleBasedUserList) (*RuleBasedUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason", "PrepopulationStatus"},
+		[]string{"Id"},
+	).(*RuleBasedUserList)
+	return set, changes
+}
+
+// DiffSimilarUserList compares from, as fetched from the API, with the desired
+// to. It returns a SimilarUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffSimilarUserList(from, to *SimilarUserList) (*SimilarUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason"},
+		[]string{"Id"},
+	).(*SimilarUserList)
+	return set, changes
+}
+
+// DiffUserList compares from, as fetched from the API, with the desired
+// to. It returns a UserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffUserList(from, to *UserList) (*UserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Description", "Status", "IntegrationCode", "AccountUserListStatus", "MembershipLifeSpan", "IsEligibleForSearch", "ClosingReason"},
+		[]string{"Id"},
+	).(*UserList)
+	return set, changes
+}
+
+// SetUserListOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffUserList.
+func SetUserListOperation(from, to *UserList) (*UserListOperation, []deep.Change) {
+	set, changes := DiffUserList(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &UserListOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
@@ -0,0 +1,2473 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdwordsUserListService
+
+import "fmt"
+
+// AuthenticationErrorReasonValues returns all values of AuthenticationErrorReason.
+func AuthenticationErrorReasonValues() []AuthenticationErrorReason {
+	return []AuthenticationErrorReason{
+		AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthenticationErrorReason.
+func (e AuthenticationErrorReason) IsValid() bool {
+	switch e {
+	case AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID:
+		return true
+	}
+	return false
+}
+
+// ParseAuthenticationErrorReason returns the AuthenticationErrorReason named by s.
+func ParseAuthenticationErrorReason(s string) (AuthenticationErrorReason, error) {
+	e := AuthenticationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthenticationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthenticationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthenticationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthenticationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthenticationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthorizationErrorReasonValues returns all values of AuthorizationErrorReason.
+func AuthorizationErrorReasonValues() []AuthorizationErrorReason {
+	return []AuthorizationErrorReason{
+		AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthorizationErrorReason.
+func (e AuthorizationErrorReason) IsValid() bool {
+	switch e {
+	case AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseAuthorizationErrorReason returns the AuthorizationErrorReason named by s.
+func ParseAuthorizationErrorReason(s string) (AuthorizationErrorReason, error) {
+	e := AuthorizationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthorizationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthorizationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthorizationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthorizationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthorizationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CollectionSizeErrorReasonValues returns all values of CollectionSizeErrorReason.
+func CollectionSizeErrorReasonValues() []CollectionSizeErrorReason {
+	return []CollectionSizeErrorReason{
+		CollectionSizeErrorReasonTOO_FEW,
+		CollectionSizeErrorReasonTOO_MANY,
+	}
+}
+
+// IsValid reports whether e is one of the values of CollectionSizeErrorReason.
+func (e CollectionSizeErrorReason) IsValid() bool {
+	switch e {
+	case CollectionSizeErrorReasonTOO_FEW,
+		CollectionSizeErrorReasonTOO_MANY:
+		return true
+	}
+	return false
+}
+
+// ParseCollectionSizeErrorReason returns the CollectionSizeErrorReason named by s.
+func ParseCollectionSizeErrorReason(s string) (CollectionSizeErrorReason, error) {
+	e := CollectionSizeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CollectionSizeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// CollectionSizeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e CollectionSizeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *CollectionSizeErrorReason) UnmarshalText(text []byte) error {
+	v := CollectionSizeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DatabaseErrorReasonValues returns all values of DatabaseErrorReason.
+func DatabaseErrorReasonValues() []DatabaseErrorReason {
+	return []DatabaseErrorReason{
+		DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DatabaseErrorReason.
+func (e DatabaseErrorReason) IsValid() bool {
+	switch e {
+	case DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDatabaseErrorReason returns the DatabaseErrorReason named by s.
+func ParseDatabaseErrorReason(s string) (DatabaseErrorReason, error) {
+	e := DatabaseErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DatabaseErrorReason.
+func (e DatabaseErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DatabaseErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DatabaseErrorReason) UnmarshalText(text []byte) error {
+	v := DatabaseErrorReason(text)
+	if !v.IsValid() {
+		v = DatabaseErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DateErrorReasonValues returns all values of DateErrorReason.
+func DateErrorReasonValues() []DateErrorReason {
+	return []DateErrorReason{
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL,
+	}
+}
+
+// IsValid reports whether e is one of the values of DateErrorReason.
+func (e DateErrorReason) IsValid() bool {
+	switch e {
+	case DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL:
+		return true
+	}
+	return false
+}
+
+// ParseDateErrorReason returns the DateErrorReason named by s.
+func ParseDateErrorReason(s string) (DateErrorReason, error) {
+	e := DateErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DateErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DateErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DateErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DateErrorReason) UnmarshalText(text []byte) error {
+	v := DateErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DistinctErrorReasonValues returns all values of DistinctErrorReason.
+func DistinctErrorReasonValues() []DistinctErrorReason {
+	return []DistinctErrorReason{
+		DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DistinctErrorReason.
+func (e DistinctErrorReason) IsValid() bool {
+	switch e {
+	case DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDistinctErrorReason returns the DistinctErrorReason named by s.
+func ParseDistinctErrorReason(s string) (DistinctErrorReason, error) {
+	e := DistinctErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DistinctErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DistinctErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DistinctErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DistinctErrorReason) UnmarshalText(text []byte) error {
+	v := DistinctErrorReason(text)
+	*e = v
+	return nil
+}
+
+// EntityNotFoundReasonValues returns all values of EntityNotFoundReason.
+func EntityNotFoundReasonValues() []EntityNotFoundReason {
+	return []EntityNotFoundReason{
+		EntityNotFoundReasonINVALID_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityNotFoundReason.
+func (e EntityNotFoundReason) IsValid() bool {
+	switch e {
+	case EntityNotFoundReasonINVALID_ID:
+		return true
+	}
+	return false
+}
+
+// ParseEntityNotFoundReason returns the EntityNotFoundReason named by s.
+func ParseEntityNotFoundReason(s string) (EntityNotFoundReason, error) {
+	e := EntityNotFoundReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityNotFoundReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityNotFoundReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityNotFoundReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityNotFoundReason) UnmarshalText(text []byte) error {
+	v := EntityNotFoundReason(text)
+	*e = v
+	return nil
+}
+
+// InternalApiErrorReasonValues returns all values of InternalApiErrorReason.
+func InternalApiErrorReasonValues() []InternalApiErrorReason {
+	return []InternalApiErrorReason{
+		InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE,
+	}
+}
+
+// IsValid reports whether e is one of the values of InternalApiErrorReason.
+func (e InternalApiErrorReason) IsValid() bool {
+	switch e {
+	case InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE:
+		return true
+	}
+	return false
+}
+
+// ParseInternalApiErrorReason returns the InternalApiErrorReason named by s.
+func ParseInternalApiErrorReason(s string) (InternalApiErrorReason, error) {
+	e := InternalApiErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid InternalApiErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of InternalApiErrorReason.
+func (e InternalApiErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid InternalApiErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *InternalApiErrorReason) UnmarshalText(text []byte) error {
+	v := InternalApiErrorReason(text)
+	if !v.IsValid() {
+		v = InternalApiErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// NotEmptyErrorReasonValues returns all values of NotEmptyErrorReason.
+func NotEmptyErrorReasonValues() []NotEmptyErrorReason {
+	return []NotEmptyErrorReason{
+		NotEmptyErrorReasonEMPTY_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotEmptyErrorReason.
+func (e NotEmptyErrorReason) IsValid() bool {
+	switch e {
+	case NotEmptyErrorReasonEMPTY_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseNotEmptyErrorReason returns the NotEmptyErrorReason named by s.
+func ParseNotEmptyErrorReason(s string) (NotEmptyErrorReason, error) {
+	e := NotEmptyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotEmptyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotEmptyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotEmptyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotEmptyErrorReason) UnmarshalText(text []byte) error {
+	v := NotEmptyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NotWhitelistedErrorReasonValues returns all values of NotWhitelistedErrorReason.
+func NotWhitelistedErrorReasonValues() []NotWhitelistedErrorReason {
+	return []NotWhitelistedErrorReason{
+		NotWhitelistedErrorReasonCUSTOMER_NOT_WHITELISTED_FOR_API,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotWhitelistedErrorReason.
+func (e NotWhitelistedErrorReason) IsValid() bool {
+	switch e {
+	case NotWhitelistedErrorReasonCUSTOMER_NOT_WHITELISTED_FOR_API:
+		return true
+	}
+	return false
+}
+
+// ParseNotWhitelistedErrorReason returns the NotWhitelistedErrorReason named by s.
+func ParseNotWhitelistedErrorReason(s string) (NotWhitelistedErrorReason, error) {
+	e := NotWhitelistedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotWhitelistedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotWhitelistedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotWhitelistedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotWhitelistedErrorReason) UnmarshalText(text []byte) error {
+	v := NotWhitelistedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NullErrorReasonValues returns all values of NullErrorReason.
+func NullErrorReasonValues() []NullErrorReason {
+	return []NullErrorReason{
+		NullErrorReasonNULL_CONTENT,
+	}
+}
+
+// IsValid reports whether e is one of the values of NullErrorReason.
+func (e NullErrorReason) IsValid() bool {
+	switch e {
+	case NullErrorReasonNULL_CONTENT:
+		return true
+	}
+	return false
+}
+
+// ParseNullErrorReason returns the NullErrorReason named by s.
+func ParseNullErrorReason(s string) (NullErrorReason, error) {
+	e := NullErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NullErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NullErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NullErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NullErrorReason) UnmarshalText(text []byte) error {
+	v := NullErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OperationAccessDeniedReasonValues returns all values of OperationAccessDeniedReason.
+func OperationAccessDeniedReasonValues() []OperationAccessDeniedReason {
+	return []OperationAccessDeniedReason{
+		OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) IsValid() bool {
+	switch e {
+	case OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperationAccessDeniedReason returns the OperationAccessDeniedReason named by s.
+func ParseOperationAccessDeniedReason(s string) (OperationAccessDeniedReason, error) {
+	e := OperationAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperationAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperationAccessDeniedReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperationAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := OperationAccessDeniedReason(text)
+	if !v.IsValid() {
+		v = OperationAccessDeniedReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperatorValues returns all values of Operator.
+func OperatorValues() []Operator {
+	return []Operator{
+		OperatorADD,
+		OperatorREMOVE,
+		OperatorSET,
+	}
+}
+
+// IsValid reports whether e is one of the values of Operator.
+func (e Operator) IsValid() bool {
+	switch e {
+	case OperatorADD,
+		OperatorREMOVE,
+		OperatorSET:
+		return true
+	}
+	return false
+}
+
+// ParseOperator returns the Operator named by s.
+func ParseOperator(s string) (Operator, error) {
+	e := Operator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid Operator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// Operator are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e Operator) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *Operator) UnmarshalText(text []byte) error {
+	v := Operator(text)
+	*e = v
+	return nil
+}
+
+// OperatorErrorReasonValues returns all values of OperatorErrorReason.
+func OperatorErrorReasonValues() []OperatorErrorReason {
+	return []OperatorErrorReason{
+		OperatorErrorReasonOPERATOR_NOT_SUPPORTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatorErrorReason.
+func (e OperatorErrorReason) IsValid() bool {
+	switch e {
+	case OperatorErrorReasonOPERATOR_NOT_SUPPORTED:
+		return true
+	}
+	return false
+}
+
+// ParseOperatorErrorReason returns the OperatorErrorReason named by s.
+func ParseOperatorErrorReason(s string) (OperatorErrorReason, error) {
+	e := OperatorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// OperatorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e OperatorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *OperatorErrorReason) UnmarshalText(text []byte) error {
+	v := OperatorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// PredicateOperatorValues returns all values of PredicateOperator.
+func PredicateOperatorValues() []PredicateOperator {
+	return []PredicateOperator{
+		PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PredicateOperator.
+func (e PredicateOperator) IsValid() bool {
+	switch e {
+	case PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePredicateOperator returns the PredicateOperator named by s.
+func ParsePredicateOperator(s string) (PredicateOperator, error) {
+	e := PredicateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PredicateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PredicateOperator.
+func (e PredicateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PredicateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PredicateOperator) UnmarshalText(text []byte) error {
+	v := PredicateOperator(text)
+	if !v.IsValid() {
+		v = PredicateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// QueryErrorReasonValues returns all values of QueryErrorReason.
+func QueryErrorReasonValues() []QueryErrorReason {
+	return []QueryErrorReason{
+		QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QueryErrorReason.
+func (e QueryErrorReason) IsValid() bool {
+	switch e {
+	case QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED:
+		return true
+	}
+	return false
+}
+
+// ParseQueryErrorReason returns the QueryErrorReason named by s.
+func ParseQueryErrorReason(s string) (QueryErrorReason, error) {
+	e := QueryErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QueryErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QueryErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QueryErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QueryErrorReason) UnmarshalText(text []byte) error {
+	v := QueryErrorReason(text)
+	*e = v
+	return nil
+}
+
+// QuotaCheckErrorReasonValues returns all values of QuotaCheckErrorReason.
+func QuotaCheckErrorReasonValues() []QuotaCheckErrorReason {
+	return []QuotaCheckErrorReason{
+		QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QuotaCheckErrorReason.
+func (e QuotaCheckErrorReason) IsValid() bool {
+	switch e {
+	case QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseQuotaCheckErrorReason returns the QuotaCheckErrorReason named by s.
+func ParseQuotaCheckErrorReason(s string) (QuotaCheckErrorReason, error) {
+	e := QuotaCheckErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QuotaCheckErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QuotaCheckErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QuotaCheckErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QuotaCheckErrorReason) UnmarshalText(text []byte) error {
+	v := QuotaCheckErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RangeErrorReasonValues returns all values of RangeErrorReason.
+func RangeErrorReasonValues() []RangeErrorReason {
+	return []RangeErrorReason{
+		RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH,
+	}
+}
+
+// IsValid reports whether e is one of the values of RangeErrorReason.
+func (e RangeErrorReason) IsValid() bool {
+	switch e {
+	case RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH:
+		return true
+	}
+	return false
+}
+
+// ParseRangeErrorReason returns the RangeErrorReason named by s.
+func ParseRangeErrorReason(s string) (RangeErrorReason, error) {
+	e := RangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RangeErrorReason) UnmarshalText(text []byte) error {
+	v := RangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RateExceededErrorReasonValues returns all values of RateExceededErrorReason.
+func RateExceededErrorReasonValues() []RateExceededErrorReason {
+	return []RateExceededErrorReason{
+		RateExceededErrorReasonRATE_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RateExceededErrorReason.
+func (e RateExceededErrorReason) IsValid() bool {
+	switch e {
+	case RateExceededErrorReasonRATE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseRateExceededErrorReason returns the RateExceededErrorReason named by s.
+func ParseRateExceededErrorReason(s string) (RateExceededErrorReason, error) {
+	e := RateExceededErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RateExceededErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RateExceededErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RateExceededErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RateExceededErrorReason) UnmarshalText(text []byte) error {
+	v := RateExceededErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ReadOnlyErrorReasonValues returns all values of ReadOnlyErrorReason.
+func ReadOnlyErrorReasonValues() []ReadOnlyErrorReason {
+	return []ReadOnlyErrorReason{
+		ReadOnlyErrorReasonREAD_ONLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ReadOnlyErrorReason.
+func (e ReadOnlyErrorReason) IsValid() bool {
+	switch e {
+	case ReadOnlyErrorReasonREAD_ONLY:
+		return true
+	}
+	return false
+}
+
+// ParseReadOnlyErrorReason returns the ReadOnlyErrorReason named by s.
+func ParseReadOnlyErrorReason(s string) (ReadOnlyErrorReason, error) {
+	e := ReadOnlyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ReadOnlyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ReadOnlyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ReadOnlyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ReadOnlyErrorReason) UnmarshalText(text []byte) error {
+	v := ReadOnlyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RejectedErrorReasonValues returns all values of RejectedErrorReason.
+func RejectedErrorReasonValues() []RejectedErrorReason {
+	return []RejectedErrorReason{
+		RejectedErrorReasonUNKNOWN_VALUE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RejectedErrorReason.
+func (e RejectedErrorReason) IsValid() bool {
+	switch e {
+	case RejectedErrorReasonUNKNOWN_VALUE:
+		return true
+	}
+	return false
+}
+
+// ParseRejectedErrorReason returns the RejectedErrorReason named by s.
+func ParseRejectedErrorReason(s string) (RejectedErrorReason, error) {
+	e := RejectedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RejectedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RejectedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RejectedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RejectedErrorReason) UnmarshalText(text []byte) error {
+	v := RejectedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RequestErrorReasonValues returns all values of RequestErrorReason.
+func RequestErrorReasonValues() []RequestErrorReason {
+	return []RequestErrorReason{
+		RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequestErrorReason.
+func (e RequestErrorReason) IsValid() bool {
+	switch e {
+	case RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION:
+		return true
+	}
+	return false
+}
+
+// ParseRequestErrorReason returns the RequestErrorReason named by s.
+func ParseRequestErrorReason(s string) (RequestErrorReason, error) {
+	e := RequestErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequestErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of RequestErrorReason.
+func (e RequestErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid RequestErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *RequestErrorReason) UnmarshalText(text []byte) error {
+	v := RequestErrorReason(text)
+	if !v.IsValid() {
+		v = RequestErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RequiredErrorReasonValues returns all values of RequiredErrorReason.
+func RequiredErrorReasonValues() []RequiredErrorReason {
+	return []RequiredErrorReason{
+		RequiredErrorReasonREQUIRED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequiredErrorReason.
+func (e RequiredErrorReason) IsValid() bool {
+	switch e {
+	case RequiredErrorReasonREQUIRED:
+		return true
+	}
+	return false
+}
+
+// ParseRequiredErrorReason returns the RequiredErrorReason named by s.
+func ParseRequiredErrorReason(s string) (RequiredErrorReason, error) {
+	e := RequiredErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequiredErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RequiredErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RequiredErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RequiredErrorReason) UnmarshalText(text []byte) error {
+	v := RequiredErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SelectorErrorReasonValues returns all values of SelectorErrorReason.
+func SelectorErrorReasonValues() []SelectorErrorReason {
+	return []SelectorErrorReason{
+		SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of SelectorErrorReason.
+func (e SelectorErrorReason) IsValid() bool {
+	switch e {
+	case SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseSelectorErrorReason returns the SelectorErrorReason named by s.
+func ParseSelectorErrorReason(s string) (SelectorErrorReason, error) {
+	e := SelectorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SelectorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SelectorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SelectorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SelectorErrorReason) UnmarshalText(text []byte) error {
+	v := SelectorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SizeLimitErrorReasonValues returns all values of SizeLimitErrorReason.
+func SizeLimitErrorReasonValues() []SizeLimitErrorReason {
+	return []SizeLimitErrorReason{
+		SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) IsValid() bool {
+	switch e {
+	case SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSizeLimitErrorReason returns the SizeLimitErrorReason named by s.
+func ParseSizeLimitErrorReason(s string) (SizeLimitErrorReason, error) {
+	e := SizeLimitErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeLimitErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SizeLimitErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SizeLimitErrorReason) UnmarshalText(text []byte) error {
+	v := SizeLimitErrorReason(text)
+	if !v.IsValid() {
+		v = SizeLimitErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SortOrderValues returns all values of SortOrder.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASCENDING,
+		SortOrderDESCENDING,
+	}
+}
+
+// IsValid reports whether e is one of the values of SortOrder.
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderASCENDING,
+		SortOrderDESCENDING:
+		return true
+	}
+	return false
+}
+
+// ParseSortOrder returns the SortOrder named by s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	e := SortOrder(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SortOrder %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SortOrder are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	*e = v
+	return nil
+}
+
+// StringFormatErrorReasonValues returns all values of StringFormatErrorReason.
+func StringFormatErrorReasonValues() []StringFormatErrorReason {
+	return []StringFormatErrorReason{
+		StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringFormatErrorReason.
+func (e StringFormatErrorReason) IsValid() bool {
+	switch e {
+	case StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT:
+		return true
+	}
+	return false
+}
+
+// ParseStringFormatErrorReason returns the StringFormatErrorReason named by s.
+func ParseStringFormatErrorReason(s string) (StringFormatErrorReason, error) {
+	e := StringFormatErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringFormatErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringFormatErrorReason.
+func (e StringFormatErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringFormatErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringFormatErrorReason) UnmarshalText(text []byte) error {
+	v := StringFormatErrorReason(text)
+	if !v.IsValid() {
+		v = StringFormatErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// StringLengthErrorReasonValues returns all values of StringLengthErrorReason.
+func StringLengthErrorReasonValues() []StringLengthErrorReason {
+	return []StringLengthErrorReason{
+		StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringLengthErrorReason.
+func (e StringLengthErrorReason) IsValid() bool {
+	switch e {
+	case StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG:
+		return true
+	}
+	return false
+}
+
+// ParseStringLengthErrorReason returns the StringLengthErrorReason named by s.
+func ParseStringLengthErrorReason(s string) (StringLengthErrorReason, error) {
+	e := StringLengthErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringLengthErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StringLengthErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StringLengthErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StringLengthErrorReason) UnmarshalText(text []byte) error {
+	v := StringLengthErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AccessReasonValues returns all values of AccessReason.
+func AccessReasonValues() []AccessReason {
+	return []AccessReason{
+		AccessReasonOWNED,
+		AccessReasonSHARED,
+		AccessReasonLICENSED,
+		AccessReasonSUBSCRIBED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AccessReason.
+func (e AccessReason) IsValid() bool {
+	switch e {
+	case AccessReasonOWNED,
+		AccessReasonSHARED,
+		AccessReasonLICENSED,
+		AccessReasonSUBSCRIBED:
+		return true
+	}
+	return false
+}
+
+// ParseAccessReason returns the AccessReason named by s.
+func ParseAccessReason(s string) (AccessReason, error) {
+	e := AccessReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AccessReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AccessReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AccessReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AccessReason) UnmarshalText(text []byte) error {
+	v := AccessReason(text)
+	*e = v
+	return nil
+}
+
+// AccountUserListStatusValues returns all values of AccountUserListStatus.
+func AccountUserListStatusValues() []AccountUserListStatus {
+	return []AccountUserListStatus{
+		AccountUserListStatusACTIVE,
+		AccountUserListStatusINACTIVE,
+	}
+}
+
+// IsValid reports whether e is one of the values of AccountUserListStatus.
+func (e AccountUserListStatus) IsValid() bool {
+	switch e {
+	case AccountUserListStatusACTIVE,
+		AccountUserListStatusINACTIVE:
+		return true
+	}
+	return false
+}
+
+// ParseAccountUserListStatus returns the AccountUserListStatus named by s.
+func ParseAccountUserListStatus(s string) (AccountUserListStatus, error) {
+	e := AccountUserListStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AccountUserListStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AccountUserListStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AccountUserListStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AccountUserListStatus) UnmarshalText(text []byte) error {
+	v := AccountUserListStatus(text)
+	*e = v
+	return nil
+}
+
+// CombinedRuleUserListRuleOperatorValues returns all values of CombinedRuleUserListRuleOperator.
+func CombinedRuleUserListRuleOperatorValues() []CombinedRuleUserListRuleOperator {
+	return []CombinedRuleUserListRuleOperator{
+		CombinedRuleUserListRuleOperatorUNKNOWN,
+		CombinedRuleUserListRuleOperatorAND,
+		CombinedRuleUserListRuleOperatorAND_NOT,
+	}
+}
+
+// IsValid reports whether e is one of the values of CombinedRuleUserListRuleOperator.
+func (e CombinedRuleUserListRuleOperator) IsValid() bool {
+	switch e {
+	case CombinedRuleUserListRuleOperatorUNKNOWN,
+		CombinedRuleUserListRuleOperatorAND,
+		CombinedRuleUserListRuleOperatorAND_NOT:
+		return true
+	}
+	return false
+}
+
+// ParseCombinedRuleUserListRuleOperator returns the CombinedRuleUserListRuleOperator named by s.
+func ParseCombinedRuleUserListRuleOperator(s string) (CombinedRuleUserListRuleOperator, error) {
+	e := CombinedRuleUserListRuleOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CombinedRuleUserListRuleOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CombinedRuleUserListRuleOperator.
+func (e CombinedRuleUserListRuleOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CombinedRuleUserListRuleOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CombinedRuleUserListRuleOperator) UnmarshalText(text []byte) error {
+	v := CombinedRuleUserListRuleOperator(text)
+	if !v.IsValid() {
+		v = CombinedRuleUserListRuleOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// UserListConversionTypeCategoryValues returns all values of UserListConversionTypeCategory.
+func UserListConversionTypeCategoryValues() []UserListConversionTypeCategory {
+	return []UserListConversionTypeCategory{
+		UserListConversionTypeCategoryBOOMERANG_EVENT,
+		UserListConversionTypeCategoryOTHER,
+	}
+}
+
+// IsValid reports whether e is one of the values of UserListConversionTypeCategory.
+func (e UserListConversionTypeCategory) IsValid() bool {
+	switch e {
+	case UserListConversionTypeCategoryBOOMERANG_EVENT,
+		UserListConversionTypeCategoryOTHER:
+		return true
+	}
+	return false
+}
+
+// ParseUserListConversionTypeCategory returns the UserListConversionTypeCategory named by s.
+func ParseUserListConversionTypeCategory(s string) (UserListConversionTypeCategory, error) {
+	e := UserListConversionTypeCategory(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UserListConversionTypeCategory %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// UserListConversionTypeCategory are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e UserListConversionTypeCategory) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *UserListConversionTypeCategory) UnmarshalText(text []byte) error {
+	v := UserListConversionTypeCategory(text)
+	*e = v
+	return nil
+}
+
+// CrmDataSourceTypeValues returns all values of CrmDataSourceType.
+func CrmDataSourceTypeValues() []CrmDataSourceType {
+	return []CrmDataSourceType{
+		CrmDataSourceTypeUNKNOWN,
+		CrmDataSourceTypeFIRST_PARTY,
+		CrmDataSourceTypeTHIRD_PARTY_CREDIT_BUREAU,
+		CrmDataSourceTypeTHIRD_PARTY_VOTER_FILE,
+	}
+}
+
+// IsValid reports whether e is one of the values of CrmDataSourceType.
+func (e CrmDataSourceType) IsValid() bool {
+	switch e {
+	case CrmDataSourceTypeUNKNOWN,
+		CrmDataSourceTypeFIRST_PARTY,
+		CrmDataSourceTypeTHIRD_PARTY_CREDIT_BUREAU,
+		CrmDataSourceTypeTHIRD_PARTY_VOTER_FILE:
+		return true
+	}
+	return false
+}
+
+// ParseCrmDataSourceType returns the CrmDataSourceType named by s.
+func ParseCrmDataSourceType(s string) (CrmDataSourceType, error) {
+	e := CrmDataSourceType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CrmDataSourceType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CrmDataSourceType.
+func (e CrmDataSourceType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CrmDataSourceType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CrmDataSourceType) UnmarshalText(text []byte) error {
+	v := CrmDataSourceType(text)
+	if !v.IsValid() {
+		v = CrmDataSourceTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CustomerMatchUploadKeyTypeValues returns all values of CustomerMatchUploadKeyType.
+func CustomerMatchUploadKeyTypeValues() []CustomerMatchUploadKeyType {
+	return []CustomerMatchUploadKeyType{
+		CustomerMatchUploadKeyTypeUNKNOWN,
+		CustomerMatchUploadKeyTypeCONTACT_INFO,
+		CustomerMatchUploadKeyTypeCRM_ID,
+		CustomerMatchUploadKeyTypeMOBILE_ADVERTISING_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of CustomerMatchUploadKeyType.
+func (e CustomerMatchUploadKeyType) IsValid() bool {
+	switch e {
+	case CustomerMatchUploadKeyTypeUNKNOWN,
+		CustomerMatchUploadKeyTypeCONTACT_INFO,
+		CustomerMatchUploadKeyTypeCRM_ID,
+		CustomerMatchUploadKeyTypeMOBILE_ADVERTISING_ID:
+		return true
+	}
+	return false
+}
+
+// ParseCustomerMatchUploadKeyType returns the CustomerMatchUploadKeyType named by s.
+func ParseCustomerMatchUploadKeyType(s string) (CustomerMatchUploadKeyType, error) {
+	e := CustomerMatchUploadKeyType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CustomerMatchUploadKeyType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CustomerMatchUploadKeyType.
+func (e CustomerMatchUploadKeyType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CustomerMatchUploadKeyType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CustomerMatchUploadKeyType) UnmarshalText(text []byte) error {
+	v := CustomerMatchUploadKeyType(text)
+	if !v.IsValid() {
+		v = CustomerMatchUploadKeyTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DateRuleItemDateOperatorValues returns all values of DateRuleItemDateOperator.
+func DateRuleItemDateOperatorValues() []DateRuleItemDateOperator {
+	return []DateRuleItemDateOperator{
+		DateRuleItemDateOperatorUNKNOWN,
+		DateRuleItemDateOperatorEQUALS,
+		DateRuleItemDateOperatorNOT_EQUAL,
+		DateRuleItemDateOperatorBEFORE,
+		DateRuleItemDateOperatorAFTER,
+	}
+}
+
+// IsValid reports whether e is one of the values of DateRuleItemDateOperator.
+func (e DateRuleItemDateOperator) IsValid() bool {
+	switch e {
+	case DateRuleItemDateOperatorUNKNOWN,
+		DateRuleItemDateOperatorEQUALS,
+		DateRuleItemDateOperatorNOT_EQUAL,
+		DateRuleItemDateOperatorBEFORE,
+		DateRuleItemDateOperatorAFTER:
+		return true
+	}
+	return false
+}
+
+// ParseDateRuleItemDateOperator returns the DateRuleItemDateOperator named by s.
+func ParseDateRuleItemDateOperator(s string) (DateRuleItemDateOperator, error) {
+	e := DateRuleItemDateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DateRuleItemDateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DateRuleItemDateOperator.
+func (e DateRuleItemDateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DateRuleItemDateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DateRuleItemDateOperator) UnmarshalText(text []byte) error {
+	v := DateRuleItemDateOperator(text)
+	if !v.IsValid() {
+		v = DateRuleItemDateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// MutateMembersErrorReasonValues returns all values of MutateMembersErrorReason.
+func MutateMembersErrorReasonValues() []MutateMembersErrorReason {
+	return []MutateMembersErrorReason{
+		MutateMembersErrorReasonUNKNOWN,
+		MutateMembersErrorReasonUNSUPPORTED_METHOD,
+		MutateMembersErrorReasonINVALID_USER_LIST_ID,
+		MutateMembersErrorReasonINVALID_USER_LIST_TYPE,
+		MutateMembersErrorReasonINVALID_DATA_TYPE,
+		MutateMembersErrorReasonINVALID_SHA256_FORMAT,
+		MutateMembersErrorReasonOPERATOR_CONFLICT_FOR_SAME_USER_LIST_ID,
+		MutateMembersErrorReasonINVALID_REMOVEALL_OPERATION,
+		MutateMembersErrorReasonINVALID_OPERATION_ORDER,
+		MutateMembersErrorReasonMISSING_MEMBER_IDENTIFIER,
+		MutateMembersErrorReasonINCOMPATIBLE_UPLOAD_KEY_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of MutateMembersErrorReason.
+func (e MutateMembersErrorReason) IsValid() bool {
+	switch e {
+	case MutateMembersErrorReasonUNKNOWN,
+		MutateMembersErrorReasonUNSUPPORTED_METHOD,
+		MutateMembersErrorReasonINVALID_USER_LIST_ID,
+		MutateMembersErrorReasonINVALID_USER_LIST_TYPE,
+		MutateMembersErrorReasonINVALID_DATA_TYPE,
+		MutateMembersErrorReasonINVALID_SHA256_FORMAT,
+		MutateMembersErrorReasonOPERATOR_CONFLICT_FOR_SAME_USER_LIST_ID,
+		MutateMembersErrorReasonINVALID_REMOVEALL_OPERATION,
+		MutateMembersErrorReasonINVALID_OPERATION_ORDER,
+		MutateMembersErrorReasonMISSING_MEMBER_IDENTIFIER,
+		MutateMembersErrorReasonINCOMPATIBLE_UPLOAD_KEY_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseMutateMembersErrorReason returns the MutateMembersErrorReason named by s.
+func ParseMutateMembersErrorReason(s string) (MutateMembersErrorReason, error) {
+	e := MutateMembersErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MutateMembersErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of MutateMembersErrorReason.
+func (e MutateMembersErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid MutateMembersErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *MutateMembersErrorReason) UnmarshalText(text []byte) error {
+	v := MutateMembersErrorReason(text)
+	if !v.IsValid() {
+		v = MutateMembersErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// NumberRuleItemNumberOperatorValues returns all values of NumberRuleItemNumberOperator.
+func NumberRuleItemNumberOperatorValues() []NumberRuleItemNumberOperator {
+	return []NumberRuleItemNumberOperator{
+		NumberRuleItemNumberOperatorUNKNOWN,
+		NumberRuleItemNumberOperatorGREATER_THAN,
+		NumberRuleItemNumberOperatorGREATER_THAN_OR_EQUAL,
+		NumberRuleItemNumberOperatorEQUALS,
+		NumberRuleItemNumberOperatorNOT_EQUAL,
+		NumberRuleItemNumberOperatorLESS_THAN,
+		NumberRuleItemNumberOperatorLESS_THAN_OR_EQUAL,
+	}
+}
+
+// IsValid reports whether e is one of the values of NumberRuleItemNumberOperator.
+func (e NumberRuleItemNumberOperator) IsValid() bool {
+	switch e {
+	case NumberRuleItemNumberOperatorUNKNOWN,
+		NumberRuleItemNumberOperatorGREATER_THAN,
+		NumberRuleItemNumberOperatorGREATER_THAN_OR_EQUAL,
+		NumberRuleItemNumberOperatorEQUALS,
+		NumberRuleItemNumberOperatorNOT_EQUAL,
+		NumberRuleItemNumberOperatorLESS_THAN,
+		NumberRuleItemNumberOperatorLESS_THAN_OR_EQUAL:
+		return true
+	}
+	return false
+}
+
+// ParseNumberRuleItemNumberOperator returns the NumberRuleItemNumberOperator named by s.
+func ParseNumberRuleItemNumberOperator(s string) (NumberRuleItemNumberOperator, error) {
+	e := NumberRuleItemNumberOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NumberRuleItemNumberOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of NumberRuleItemNumberOperator.
+func (e NumberRuleItemNumberOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid NumberRuleItemNumberOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *NumberRuleItemNumberOperator) UnmarshalText(text []byte) error {
+	v := NumberRuleItemNumberOperator(text)
+	if !v.IsValid() {
+		v = NumberRuleItemNumberOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RuleBasedUserListPrepopulationStatusValues returns all values of RuleBasedUserListPrepopulationStatus.
+func RuleBasedUserListPrepopulationStatusValues() []RuleBasedUserListPrepopulationStatus {
+	return []RuleBasedUserListPrepopulationStatus{
+		RuleBasedUserListPrepopulationStatusNONE,
+		RuleBasedUserListPrepopulationStatusREQUESTED,
+		RuleBasedUserListPrepopulationStatusFINISHED,
+		RuleBasedUserListPrepopulationStatusFAILED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RuleBasedUserListPrepopulationStatus.
+func (e RuleBasedUserListPrepopulationStatus) IsValid() bool {
+	switch e {
+	case RuleBasedUserListPrepopulationStatusNONE,
+		RuleBasedUserListPrepopulationStatusREQUESTED,
+		RuleBasedUserListPrepopulationStatusFINISHED,
+		RuleBasedUserListPrepopulationStatusFAILED:
+		return true
+	}
+	return false
+}
+
+// ParseRuleBasedUserListPrepopulationStatus returns the RuleBasedUserListPrepopulationStatus named by s.
+func ParseRuleBasedUserListPrepopulationStatus(s string) (RuleBasedUserListPrepopulationStatus, error) {
+	e := RuleBasedUserListPrepopulationStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RuleBasedUserListPrepopulationStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RuleBasedUserListPrepopulationStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RuleBasedUserListPrepopulationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RuleBasedUserListPrepopulationStatus) UnmarshalText(text []byte) error {
+	v := RuleBasedUserListPrepopulationStatus(text)
+	*e = v
+	return nil
+}
+
+// SizeRangeValues returns all values of SizeRange.
+func SizeRangeValues() []SizeRange {
+	return []SizeRange{
+		SizeRangeLESS_THAN_FIVE_HUNDRED,
+		SizeRangeLESS_THAN_ONE_THOUSAND,
+		SizeRangeONE_THOUSAND_TO_TEN_THOUSAND,
+		SizeRangeTEN_THOUSAND_TO_FIFTY_THOUSAND,
+		SizeRangeFIFTY_THOUSAND_TO_ONE_HUNDRED_THOUSAND,
+		SizeRangeONE_HUNDRED_THOUSAND_TO_THREE_HUNDRED_THOUSAND,
+		SizeRangeTHREE_HUNDRED_THOUSAND_TO_FIVE_HUNDRED_THOUSAND,
+		SizeRangeFIVE_HUNDRED_THOUSAND_TO_ONE_MILLION,
+		SizeRangeONE_MILLION_TO_TWO_MILLION,
+		SizeRangeTWO_MILLION_TO_THREE_MILLION,
+		SizeRangeTHREE_MILLION_TO_FIVE_MILLION,
+		SizeRangeFIVE_MILLION_TO_TEN_MILLION,
+		SizeRangeTEN_MILLION_TO_TWENTY_MILLION,
+		SizeRangeTWENTY_MILLION_TO_THIRTY_MILLION,
+		SizeRangeTHIRTY_MILLION_TO_FIFTY_MILLION,
+		SizeRangeOVER_FIFTY_MILLION,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeRange.
+func (e SizeRange) IsValid() bool {
+	switch e {
+	case SizeRangeLESS_THAN_FIVE_HUNDRED,
+		SizeRangeLESS_THAN_ONE_THOUSAND,
+		SizeRangeONE_THOUSAND_TO_TEN_THOUSAND,
+		SizeRangeTEN_THOUSAND_TO_FIFTY_THOUSAND,
+		SizeRangeFIFTY_THOUSAND_TO_ONE_HUNDRED_THOUSAND,
+		SizeRangeONE_HUNDRED_THOUSAND_TO_THREE_HUNDRED_THOUSAND,
+		SizeRangeTHREE_HUNDRED_THOUSAND_TO_FIVE_HUNDRED_THOUSAND,
+		SizeRangeFIVE_HUNDRED_THOUSAND_TO_ONE_MILLION,
+		SizeRangeONE_MILLION_TO_TWO_MILLION,
+		SizeRangeTWO_MILLION_TO_THREE_MILLION,
+		SizeRangeTHREE_MILLION_TO_FIVE_MILLION,
+		SizeRangeFIVE_MILLION_TO_TEN_MILLION,
+		SizeRangeTEN_MILLION_TO_TWENTY_MILLION,
+		SizeRangeTWENTY_MILLION_TO_THIRTY_MILLION,
+		SizeRangeTHIRTY_MILLION_TO_FIFTY_MILLION,
+		SizeRangeOVER_FIFTY_MILLION:
+		return true
+	}
+	return false
+}
+
+// ParseSizeRange returns the SizeRange named by s.
+func ParseSizeRange(s string) (SizeRange, error) {
+	e := SizeRange(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeRange %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SizeRange are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SizeRange) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SizeRange) UnmarshalText(text []byte) error {
+	v := SizeRange(text)
+	*e = v
+	return nil
+}
+
+// StringRuleItemStringOperatorValues returns all values of StringRuleItemStringOperator.
+func StringRuleItemStringOperatorValues() []StringRuleItemStringOperator {
+	return []StringRuleItemStringOperator{
+		StringRuleItemStringOperatorUNKNOWN,
+		StringRuleItemStringOperatorCONTAINS,
+		StringRuleItemStringOperatorEQUALS,
+		StringRuleItemStringOperatorSTARTS_WITH,
+		StringRuleItemStringOperatorENDS_WITH,
+		StringRuleItemStringOperatorNOT_EQUAL,
+		StringRuleItemStringOperatorNOT_CONTAIN,
+		StringRuleItemStringOperatorNOT_START_WITH,
+		StringRuleItemStringOperatorNOT_END_WITH,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringRuleItemStringOperator.
+func (e StringRuleItemStringOperator) IsValid() bool {
+	switch e {
+	case StringRuleItemStringOperatorUNKNOWN,
+		StringRuleItemStringOperatorCONTAINS,
+		StringRuleItemStringOperatorEQUALS,
+		StringRuleItemStringOperatorSTARTS_WITH,
+		StringRuleItemStringOperatorENDS_WITH,
+		StringRuleItemStringOperatorNOT_EQUAL,
+		StringRuleItemStringOperatorNOT_CONTAIN,
+		StringRuleItemStringOperatorNOT_START_WITH,
+		StringRuleItemStringOperatorNOT_END_WITH:
+		return true
+	}
+	return false
+}
+
+// ParseStringRuleItemStringOperator returns the StringRuleItemStringOperator named by s.
+func ParseStringRuleItemStringOperator(s string) (StringRuleItemStringOperator, error) {
+	e := StringRuleItemStringOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringRuleItemStringOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringRuleItemStringOperator.
+func (e StringRuleItemStringOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringRuleItemStringOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringRuleItemStringOperator) UnmarshalText(text []byte) error {
+	v := StringRuleItemStringOperator(text)
+	if !v.IsValid() {
+		v = StringRuleItemStringOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// UserListClosingReasonValues returns all values of UserListClosingReason.
+func UserListClosingReasonValues() []UserListClosingReason {
+	return []UserListClosingReason{
+		UserListClosingReasonUNKNOWN,
+		UserListClosingReasonUNUSED_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of UserListClosingReason.
+func (e UserListClosingReason) IsValid() bool {
+	switch e {
+	case UserListClosingReasonUNKNOWN,
+		UserListClosingReasonUNUSED_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseUserListClosingReason returns the UserListClosingReason named by s.
+func ParseUserListClosingReason(s string) (UserListClosingReason, error) {
+	e := UserListClosingReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UserListClosingReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of UserListClosingReason.
+func (e UserListClosingReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid UserListClosingReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *UserListClosingReason) UnmarshalText(text []byte) error {
+	v := UserListClosingReason(text)
+	if !v.IsValid() {
+		v = UserListClosingReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// UserListErrorReasonValues returns all values of UserListErrorReason.
+func UserListErrorReasonValues() []UserListErrorReason {
+	return []UserListErrorReason{
+		UserListErrorReasonEXTERNAL_REMARKETING_USER_LIST_MUTATE_NOT_SUPPORTED,
+		UserListErrorReasonCONCRETE_TYPE_REQUIRED,
+		UserListErrorReasonCONVERSION_TYPE_ID_REQUIRED,
+		UserListErrorReasonDUPLICATE_CONVERSION_TYPES,
+		UserListErrorReasonINVALID_CONVERSION_TYPE,
+		UserListErrorReasonINVALID_DESCRIPTION,
+		UserListErrorReasonINVALID_NAME,
+		UserListErrorReasonINVALID_TYPE,
+		UserListErrorReasonCAN_NOT_ADD_SIMILAR_LIST_AS_LOGICAL_LIST_NONE_OPERAND,
+		UserListErrorReasonCAN_NOT_ADD_LOGICAL_LIST_AS_LOGICAL_LIST_OPERAND,
+		UserListErrorReasonINVALID_USER_LIST_LOGICAL_RULE_OPERAND,
+		UserListErrorReasonNAME_ALREADY_USED,
+		UserListErrorReasonNEW_CONVERSION_TYPE_NAME_REQUIRED,
+		UserListErrorReasonCONVERSION_TYPE_NAME_ALREADY_USED,
+		UserListErrorReasonOWNERSHIP_REQUIRED_FOR_SET,
+		UserListErrorReasonREMOVE_NOT_SUPPORTED,
+		UserListErrorReasonUSER_LIST_MUTATE_NOT_SUPPORTED,
+		UserListErrorReasonINVALID_RULE,
+		UserListErrorReasonINVALID_DATE_RANGE,
+		UserListErrorReasonCAN_NOT_MUTATE_SENSITIVE_USERLIST,
+		UserListErrorReasonMAX_NUM_RULEBASED_USERLISTS,
+		UserListErrorReasonCANNOT_MODIFY_BILLABLE_RECORD_COUNT,
+		UserListErrorReasonAPP_ID_NOT_ALLOWED,
+		UserListErrorReasonAPP_ID_NOT_SET,
+		UserListErrorReasonUSER_LIST_SERVICE_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of UserListErrorReason.
+func (e UserListErrorReason) IsValid() bool {
+	switch e {
+	case UserListErrorReasonEXTERNAL_REMARKETING_USER_LIST_MUTATE_NOT_SUPPORTED,
+		UserListErrorReasonCONCRETE_TYPE_REQUIRED,
+		UserListErrorReasonCONVERSION_TYPE_ID_REQUIRED,
+		UserListErrorReasonDUPLICATE_CONVERSION_TYPES,
+		UserListErrorReasonINVALID_CONVERSION_TYPE,
+		UserListErrorReasonINVALID_DESCRIPTION,
+		UserListErrorReasonINVALID_NAME,
+		UserListErrorReasonINVALID_TYPE,
+		UserListErrorReasonCAN_NOT_ADD_SIMILAR_LIST_AS_LOGICAL_LIST_NONE_OPERAND,
+		UserListErrorReasonCAN_NOT_ADD_LOGICAL_LIST_AS_LOGICAL_LIST_OPERAND,
+		UserListErrorReasonINVALID_USER_LIST_LOGICAL_RULE_OPERAND,
+		UserListErrorReasonNAME_ALREADY_USED,
+		UserListErrorReasonNEW_CONVERSION_TYPE_NAME_REQUIRED,
+		UserListErrorReasonCONVERSION_TYPE_NAME_ALREADY_USED,
+		UserListErrorReasonOWNERSHIP_REQUIRED_FOR_SET,
+		UserListErrorReasonREMOVE_NOT_SUPPORTED,
+		UserListErrorReasonUSER_LIST_MUTATE_NOT_SUPPORTED,
+		UserListErrorReasonINVALID_RULE,
+		UserListErrorReasonINVALID_DATE_RANGE,
+		UserListErrorReasonCAN_NOT_MUTATE_SENSITIVE_USERLIST,
+		UserListErrorReasonMAX_NUM_RULEBASED_USERLISTS,
+		UserListErrorReasonCANNOT_MODIFY_BILLABLE_RECORD_COUNT,
+		UserListErrorReasonAPP_ID_NOT_ALLOWED,
+		UserListErrorReasonAPP_ID_NOT_SET,
+		UserListErrorReasonUSER_LIST_SERVICE_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseUserListErrorReason returns the UserListErrorReason named by s.
+func ParseUserListErrorReason(s string) (UserListErrorReason, error) {
+	e := UserListErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UserListErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// UserListErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e UserListErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *UserListErrorReason) UnmarshalText(text []byte) error {
+	v := UserListErrorReason(text)
+	*e = v
+	return nil
+}
+
+// UserListLogicalRuleOperatorValues returns all values of UserListLogicalRuleOperator.
+func UserListLogicalRuleOperatorValues() []UserListLogicalRuleOperator {
+	return []UserListLogicalRuleOperator{
+		UserListLogicalRuleOperatorALL,
+		UserListLogicalRuleOperatorANY,
+		UserListLogicalRuleOperatorNONE,
+		UserListLogicalRuleOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of UserListLogicalRuleOperator.
+func (e UserListLogicalRuleOperator) IsValid() bool {
+	switch e {
+	case UserListLogicalRuleOperatorALL,
+		UserListLogicalRuleOperatorANY,
+		UserListLogicalRuleOperatorNONE,
+		UserListLogicalRuleOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseUserListLogicalRuleOperator returns the UserListLogicalRuleOperator named by s.
+func ParseUserListLogicalRuleOperator(s string) (UserListLogicalRuleOperator, error) {
+	e := UserListLogicalRuleOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UserListLogicalRuleOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of UserListLogicalRuleOperator.
+func (e UserListLogicalRuleOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid UserListLogicalRuleOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *UserListLogicalRuleOperator) UnmarshalText(text []byte) error {
+	v := UserListLogicalRuleOperator(text)
+	if !v.IsValid() {
+		v = UserListLogicalRuleOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// UserListMembershipStatusValues returns all values of UserListMembershipStatus.
+func UserListMembershipStatusValues() []UserListMembershipStatus {
+	return []UserListMembershipStatus{
+		UserListMembershipStatusOPEN,
+		UserListMembershipStatusCLOSED,
+	}
+}
+
+// IsValid reports whether e is one of the values of UserListMembershipStatus.
+func (e UserListMembershipStatus) IsValid() bool {
+	switch e {
+	case UserListMembershipStatusOPEN,
+		UserListMembershipStatusCLOSED:
+		return true
+	}
+	return false
+}
+
+// ParseUserListMembershipStatus returns the UserListMembershipStatus named by s.
+func ParseUserListMembershipStatus(s string) (UserListMembershipStatus, error) {
+	e := UserListMembershipStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UserListMembershipStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// UserListMembershipStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e UserListMembershipStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *UserListMembershipStatus) UnmarshalText(text []byte) error {
+	v := UserListMembershipStatus(text)
+	*e = v
+	return nil
+}
+
+// UserListRuleTypeEnumsEnumValues returns all values of UserListRuleTypeEnumsEnum.
+func UserListRuleTypeEnumsEnumValues() []UserListRuleTypeEnumsEnum {
+	return []UserListRuleTypeEnumsEnum{
+		UserListRuleTypeEnumsEnumUNKNOWN,
+		UserListRuleTypeEnumsEnumCNF,
+		UserListRuleTypeEnumsEnumDNF,
+	}
+}
+
+// IsValid reports whether e is one of the values of UserListRuleTypeEnumsEnum.
+func (e UserListRuleTypeEnumsEnum) IsValid() bool {
+	switch e {
+	case UserListRuleTypeEnumsEnumUNKNOWN,
+		UserListRuleTypeEnumsEnumCNF,
+		UserListRuleTypeEnumsEnumDNF:
+		return true
+	}
+	return false
+}
+
+// ParseUserListRuleTypeEnumsEnum returns the UserListRuleTypeEnumsEnum named by s.
+func ParseUserListRuleTypeEnumsEnum(s string) (UserListRuleTypeEnumsEnum, error) {
+	e := UserListRuleTypeEnumsEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UserListRuleTypeEnumsEnum %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of UserListRuleTypeEnumsEnum.
+func (e UserListRuleTypeEnumsEnum) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid UserListRuleTypeEnumsEnum %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *UserListRuleTypeEnumsEnum) UnmarshalText(text []byte) error {
+	v := UserListRuleTypeEnumsEnum(text)
+	if !v.IsValid() {
+		v = UserListRuleTypeEnumsEnumUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// UserListTypeValues returns all values of UserListType.
+func UserListTypeValues() []UserListType {
+	return []UserListType{
+		UserListTypeUNKNOWN,
+		UserListTypeREMARKETING,
+		UserListTypeLOGICAL,
+		UserListTypeEXTERNAL_REMARKETING,
+		UserListTypeRULE_BASED,
+		UserListTypeSIMILAR,
+		UserListTypeCRM_BASED,
+	}
+}
+
+// IsValid reports whether e is one of the values of UserListType.
+func (e UserListType) IsValid() bool {
+	switch e {
+	case UserListTypeUNKNOWN,
+		UserListTypeREMARKETING,
+		UserListTypeLOGICAL,
+		UserListTypeEXTERNAL_REMARKETING,
+		UserListTypeRULE_BASED,
+		UserListTypeSIMILAR,
+		UserListTypeCRM_BASED:
+		return true
+	}
+	return false
+}
+
+// ParseUserListType returns the UserListType named by s.
+func ParseUserListType(s string) (UserListType, error) {
+	e := UserListType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UserListType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of UserListType.
+func (e UserListType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid UserListType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *UserListType) UnmarshalText(text []byte) error {
+	v := UserListType(text)
+	if !v.IsValid() {
+		v = UserListTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// UserListUploadStatusValues returns all values of UserListUploadStatus.
+func UserListUploadStatusValues() []UserListUploadStatus {
+	return []UserListUploadStatus{
+		UserListUploadStatusUNKNOWN,
+		UserListUploadStatusIN_PROCESS,
+		UserListUploadStatusSUCCESS,
+		UserListUploadStatusFAILURE,
+	}
+}
+
+// IsValid reports whether e is one of the values of UserListUploadStatus.
+func (e UserListUploadStatus) IsValid() bool {
+	switch e {
+	case UserListUploadStatusUNKNOWN,
+		UserListUploadStatusIN_PROCESS,
+		UserListUploadStatusSUCCESS,
+		UserListUploadStatusFAILURE:
+		return true
+	}
+	return false
+}
+
+// ParseUserListUploadStatus returns the UserListUploadStatus named by s.
+func ParseUserListUploadStatus(s string) (UserListUploadStatus, error) {
+	e := UserListUploadStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UserListUploadStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of UserListUploadStatus.
+func (e UserListUploadStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid UserListUploadStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *UserListUploadStatus) UnmarshalText(text []byte) error {
+	v := UserListUploadStatus(text)
+	if !v.IsValid() {
+		v = UserListUploadStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
@@ -0,0 +1,850 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdwordsUserListService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// CombinedRuleUserListFields holds the selector field names of CombinedRuleUserList, including
+// the fields of the types it holds.
+var CombinedRuleUserListFields = struct {
+	AccessReason          string
+	AccountUserListStatus string
+	ClosingReason         string
+	Description           string
+	Id                    string
+	IntegrationCode       string
+	IsEligibleForDisplay  string
+	IsEligibleForSearch   string
+	IsReadOnly            string
+	ListType              string
+	MembershipLifeSpan    string
+	Name                  string
+	PrepopulationStatus   string
+	Size                  string
+	SizeForSearch         string
+	SizeRange             string
+	SizeRangeForSearch    string
+	Status                string
+}{
+	AccessReason:          "AccessReason",
+	AccountUserListStatus: "AccountUserListStatus",
+	ClosingReason:         "ClosingReason",
+	Description:           "Description",
+	Id:                    "Id",
+	IntegrationCode:       "IntegrationCode",
+	IsEligibleForDisplay:  "IsEligibleForDisplay",
+	IsEligibleForSearch:   "IsEligibleForSearch",
+	IsReadOnly:            "IsReadOnly",
+	ListType:              "ListType",
+	MembershipLifeSpan:    "MembershipLifeSpan",
+	Name:                  "Name",
+	PrepopulationStatus:   "PrepopulationStatus",
+	Size:                  "Size",
+	SizeForSearch:         "SizeForSearch",
+	SizeRange:             "SizeRange",
+	SizeRangeForSearch:    "SizeRangeForSearch",
+	Status:                "Status",
+}
+
+// CrmBasedUserListFields holds the selector field names of CrmBasedUserList, including
+// the fields of the types it holds.
+var CrmBasedUserListFields = struct {
+	AccessReason          string
+	AccountUserListStatus string
+	AppId                 string
+	ClosingReason         string
+	DataSourceType        string
+	DataUploadResult      string
+	Description           string
+	Id                    string
+	IntegrationCode       string
+	IsEligibleForDisplay  string
+	IsEligibleForSearch   string
+	IsReadOnly            string
+	ListType              string
+	MembershipLifeSpan    string
+	Name                  string
+	Size                  string
+	SizeForSearch         string
+	SizeRange             string
+	SizeRangeForSearch    string
+	Status                string
+	UploadKeyType         string
+}{
+	AccessReason:          "AccessReason",
+	AccountUserListStatus: "AccountUserListStatus",
+	AppId:                 "AppId",
+	ClosingReason:         "ClosingReason",
+	DataSourceType:        "DataSourceType",
+	DataUploadResult:      "DataUploadResult",
+	Description:           "Description",
+	Id:                    "Id",
+	IntegrationCode:       "IntegrationCode",
+	IsEligibleForDisplay:  "IsEligibleForDisplay",
+	IsEligibleForSearch:   "IsEligibleForSearch",
+	IsReadOnly:            "IsReadOnly",
+	ListType:              "ListType",
+	MembershipLifeSpan:    "MembershipLifeSpan",
+	Name:                  "Name",
+	Size:                  "Size",
+	SizeForSearch:         "SizeForSearch",
+	SizeRange:             "SizeRange",
+	SizeRangeForSearch:    "SizeRangeForSearch",
+	Status:                "Status",
+	UploadKeyType:         "UploadKeyType",
+}
+
+// DateSpecificRuleUserListFields holds the selector field names of DateSpecificRuleUserList, including
+// the fields of the types it holds.
+var DateSpecificRuleUserListFields = struct {
+	AccessReason              string
+	AccountUserListStatus     string
+	ClosingReason             string
+	DateSpecificListEndDate   string
+	DateSpecificListRule      string
+	DateSpecificListStartDate string
+	Description               string
+	Id                        string
+	IntegrationCode           string
+	IsEligibleForDisplay      string
+	IsEligibleForSearch       string
+	IsReadOnly                string
+	ListType                  string
+	MembershipLifeSpan        string
+	Name                      string
+	PrepopulationStatus       string
+	Size                      string
+	SizeForSearch             string
+	SizeRange                 string
+	SizeRangeForSearch        string
+	Status                    string
+}{
+	AccessReason:              "AccessReason",
+	AccountUserListStatus:     "AccountUserListStatus",
+	ClosingReason:             "ClosingReason",
+	DateSpecificListEndDate:   "DateSpecificListEndDate",
+	DateSpecificListRule:      "DateSpecificListRule",
+	DateSpecificListStartDate: "DateSpecificListStartDate",
+	Description:               "Description",
+	Id:                        "Id",
+	IntegrationCode:           "IntegrationCode",
+	IsEligibleForDisplay:      "IsEligibleForDisplay",
+	IsEligibleForSearch:       "IsEligibleForSearch",
+	IsReadOnly:                "IsReadOnly",
+	ListType:                  "ListType",
+	MembershipLifeSpan:        "MembershipLifeSpan",
+	Name:                      "Name",
+	PrepopulationStatus:       "PrepopulationStatus",
+	Size:                      "Size",
+	SizeForSearch:             "SizeForSearch",
+	SizeRange:                 "SizeRange",
+	SizeRangeForSearch:        "SizeRangeForSearch",
+	Status:                    "Status",
+}
+
+// ExpressionRuleUserListFields holds the selector field names of ExpressionRuleUserList, including
+// the fields of the types it holds.
+var ExpressionRuleUserListFields = struct {
+	AccessReason          string
+	AccountUserListStatus string
+	ClosingReason         string
+	Description           string
+	ExpressionListRule    string
+	Id                    string
+	IntegrationCode       string
+	IsEligibleForDisplay  string
+	IsEligibleForSearch   string
+	IsReadOnly            string
+	ListType              string
+	MembershipLifeSpan    string
+	Name                  string
+	PrepopulationStatus   string
+	Size                  string
+	SizeForSearch         string
+	SizeRange             string
+	SizeRangeForSearch    string
+	Status                string
+}{
+	AccessReason:          "AccessReason",
+	AccountUserListStatus: "AccountUserListStatus",
+	ClosingReason:         "ClosingReason",
+	Description:           "Description",
+	ExpressionListRule:    "ExpressionListRule",
+	Id:                    "Id",
+	IntegrationCode:       "IntegrationCode",
+	IsEligibleForDisplay:  "IsEligibleForDisplay",
+	IsEligibleForSearch:   "IsEligibleForSearch",
+	IsReadOnly:            "IsReadOnly",
+	ListType:              "ListType",
+	MembershipLifeSpan:    "MembershipLifeSpan",
+	Name:                  "Name",
+	PrepopulationStatus:   "PrepopulationStatus",
+	Size:                  "Size",
+	SizeForSearch:         "SizeForSearch",
+	SizeRange:             "SizeRange",
+	SizeRangeForSearch:    "SizeRangeForSearch",
+	Status:                "Status",
+}
+
+// LogicalUserListFields holds the selector field names of LogicalUserList, including
+// the fields of the types it holds.
+var LogicalUserListFields = struct {
+	AccessReason              string
+	AccountUserListStatus     string
+	AppId                     string
+	ClosingReason             string
+	ConversionTypes           string
+	DataSourceType            string
+	DataUploadResult          string
+	DateSpecificListEndDate   string
+	DateSpecificListRule      string
+	DateSpecificListStartDate string
+	Description               string
+	ExpressionListRule        string
+	Id                        string
+	IntegrationCode           string
+	IsEligibleForDisplay      string
+	IsEligibleForSearch       string
+	IsReadOnly                string
+	ListType                  string
+	MembershipLifeSpan        string
+	Name                      string
+	PrepopulationStatus       string
+	Rules                     string
+	SeedListSize              string
+	SeedUserListDescription   string
+	SeedUserListId            string
+	SeedUserListName          string
+	SeedUserListStatus        string
+	Size                      string
+	SizeForSearch             string
+	SizeRange                 string
+	SizeRangeForSearch        string
+	Status                    string
+	UploadKeyType             string
+}{
+	AccessReason:              "AccessReason",
+	AccountUserListStatus:     "AccountUserListStatus",
+	AppId:                     "AppId",
+	ClosingReason:             "ClosingReason",
+	ConversionTypes:           "ConversionTypes",
+	DataSourceType:            "DataSourceType",
+	DataUploadResult:          "DataUploadResult",
+	DateSpecificListEndDate:   "DateSpecificListEndDate",
+	DateSpecificListRule:      "DateSpecificListRule",
+	DateSpecificListStartDate: "DateSpecificListStartDate",
+	Description:               "Description",
+	ExpressionListRule:        "ExpressionListRule",
+	Id:                        "Id",
+	IntegrationCode:           "IntegrationCode",
+	IsEligibleForDisplay:      "IsEligibleForDisplay",
+	IsEligibleForSearch:       "IsEligibleForSearch",
+	IsReadOnly:                "IsReadOnly",
+	ListType:                  "ListType",
+	MembershipLifeSpan:        "MembershipLifeSpan",
+	Name:                      "Name",
+	PrepopulationStatus:       "PrepopulationStatus",
+	Rules:                     "Rules",
+	SeedListSize:              "SeedListSize",
+	SeedUserListDescription:   "SeedUserListDescription",
+	SeedUserListId:            "SeedUserListId",
+	SeedUserListName:          "SeedUserListName",
+	SeedUserListStatus:        "SeedUserListStatus",
+	Size:                      "Size",
+	SizeForSearch:             "SizeForSearch",
+	SizeRange:                 "SizeRange",
+	SizeRangeForSearch:        "SizeRangeForSearch",
+	Status:                    "Status",
+	UploadKeyType:             "UploadKeyType",
+}
+
+// BasicUserListFields holds the selector field names of BasicUserList, including
+// the fields of the types it holds.
+var BasicUserListFields = struct {
+	AccessReason          string
+	AccountUserListStatus string
+	ClosingReason         string
+	ConversionTypes       string
+	Description           string
+	Id                    string
+	IntegrationCode       string
+	IsEligibleForDisplay  string
+	IsEligibleForSearch   string
+	IsReadOnly            string
+	ListType              string
+	MembershipLifeSpan    string
+	Name                  string
+	Size                  string
+	SizeForSearch         string
+	SizeRange             string
+	SizeRangeForSearch    string
+	Status                string
+}{
+	AccessReason:          "AccessReason",
+	AccountUserListStatus: "AccountUserListStatus",
+	ClosingReason:         "ClosingReason",
+	ConversionTypes:       "ConversionTypes",
+	Description:           "Description",
+	Id:                    "Id",
+	IntegrationCode:       "IntegrationCode",
+	IsEligibleForDisplay:  "IsEligibleForDisplay",
+	IsEligibleForSearch:   "IsEligibleForSearch",
+	IsReadOnly:            "IsReadOnly",
+	ListType:              "ListType",
+	MembershipLifeSpan:    "MembershipLifeSpan",
+	Name:                  "Name",
+	Size:                  "Size",
+	SizeForSearch:         "SizeForSearch",
+	SizeRange:             "SizeRange",
+	SizeRangeForSearch:    "SizeRangeForSearch",
+	Status:                "Status",
+}
+
+// RuleBasedUserListFields holds the selector field names of RuleBasedUserList, including
+// the fields of the types it holds.
+var RuleBasedUserListFields = struct {
+	AccessReason              string
+	AccountUserListStatus     string
+	ClosingReason             string
+	DateSpecificListEndDate   string
+	DateSpecificListRule      string
+	DateSpecificListStartDate string
+	Description               string
+	ExpressionListRule        string
+	Id                        string
+	IntegrationCode           string
+	IsEligibleForDisplay      string
+	IsEligibleForSearch       string
+	IsReadOnly                string
+	ListType                  string
+	MembershipLifeSpan        string
+	Name                      string
+	PrepopulationStatus       string
+	Size                      string
+	SizeForSearch             string
+	SizeRange                 string
+	SizeRangeForSearch        string
+	Status                    string
+}{
+	AccessReason:              "AccessReason",
+	AccountUserListStatus:     "AccountUserListStatus",
+	ClosingReason:             "ClosingReason",
+	DateSpecificListEndDate:   "DateSpecificListEndDate",
+	DateSpecificListRule:      "DateSpecificListRule",
+	DateSpecificListStartDate: "DateSpecificListStartDate",
+	Description:               "Description",
+	ExpressionListRule:        "ExpressionListRule",
+	Id:                        "Id",
+	IntegrationCode:           "IntegrationCode",
+	IsEligibleForDisplay:      "IsEligibleForDisplay",
+	IsEligibleForSearch:       "IsEligibleForSearch",
+	IsReadOnly:                "IsReadOnly",
+	ListType:                  "ListType",
+	MembershipLifeSpan:        "MembershipLifeSpan",
+	Name:                      "Name",
+	PrepopulationStatus:       "PrepopulationStatus",
+	Size:                      "Size",
+	SizeForSearch:             "SizeForSearch",
+	SizeRange:                 "SizeRange",
+	SizeRangeForSearch:        "SizeRangeForSearch",
+	Status:                    "Status",
+}
+
+// SimilarUserListFields holds the selector field names of SimilarUserList, including
+// the fields of the types it holds.
+var SimilarUserListFields = struct {
+	AccessReason            string
+	AccountUserListStatus   string
+	ClosingReason           string
+	Description             string
+	Id                      string
+	IntegrationCode         string
+	IsEligibleForDisplay    string
+	IsEligibleForSearch     string
+	IsReadOnly              string
+	ListType                string
+	MembershipLifeSpan      string
+	Name                    string
+	SeedListSize            string
+	SeedUserListDescription string
+	SeedUserListId          string
+	SeedUserListName        string
+	SeedUserListStatus      string
+	Size                    string
+	SizeForSearch           string
+	SizeRange               string
+	SizeRangeForSearch      string
+	Status                  string
+}{
+	AccessReason:            "AccessReason",
+	AccountUserListStatus:   "AccountUserListStatus",
+	ClosingReason:           "ClosingReason",
+	Description:             "Description",
+	Id:                      "Id",
+	IntegrationCode:         "IntegrationCode",
+	IsEligibleForDisplay:    "IsEligibleForDisplay",
+	IsEligibleForSearch:     "IsEligibleForSearch",
+	IsReadOnly:              "IsReadOnly",
+	ListType:                "ListType",
+	MembershipLifeSpan:      "MembershipLifeSpan",
+	Name:                    "Name",
+	SeedListSize:            "SeedListSize",
+	SeedUserListDescription: "SeedUserListDescription",
+	SeedUserListId:          "SeedUserListId",
+	SeedUserListName:        "SeedUserListName",
+	SeedUserListStatus:      "SeedUserListStatus",
+	Size:                    "Size",
+	SizeForSearch:           "SizeForSearch",
+	SizeRange:               "SizeRange",
+	SizeRangeForSearch:      "SizeRangeForSearch",
+	Status:                  "Status",
+}
+
+// UserListFields holds the selector field names of UserList, including
+// the fields of the types it holds.
+var UserListFields = struct {
+	AccessReason              string
+	AccountUserListStatus     string
+	AppId                     string
+	ClosingReason             string
+	ConversionTypes           string
+	DataSourceType            string
+	DataUploadResult          string
+	DateSpecificListEndDate   string
+	DateSpecificListRule      string
+	DateSpecificListStartDate string
+	Description               string
+	ExpressionListRule        string
+	Id                        string
+	IntegrationCode           string
+	IsEligibleForDisplay      string
+	IsEligibleForSearch       string
+	IsReadOnly                string
+	ListType                  string
+	MembershipLifeSpan        string
+	Name                      string
+	PrepopulationStatus       string
+	Rules                     string
+	SeedListSize              string
+	SeedUserListDescription   string
+	SeedUserListId            string
+	SeedUserListName          string
+	SeedUserListStatus        string
+	Size                      string
+	SizeForSearch             string
+	SizeRange                 string
+	SizeRangeForSearch        string
+	Status                    string
+	UploadKeyType             string
+}{
+	AccessReason:              "AccessReason",
+	AccountUserListStatus:     "AccountUserListStatus",
+	AppId:                     "AppId",
+	ClosingReason:             "ClosingReason",
+	ConversionTypes:           "ConversionTypes",
+	DataSourceType:            "DataSourceType",
+	DataUploadResult:          "DataUploadResult",
+	DateSpecificListEndDate:   "DateSpecificListEndDate",
+	DateSpecificListRule:      "DateSpecificListRule",
+	DateSpecificListStartDate: "DateSpecificListStartDate",
+	Description:               "Description",
+	ExpressionListRule:        "ExpressionListRule",
+	Id:                        "Id",
+	IntegrationCode:           "IntegrationCode",
+	IsEligibleForDisplay:      "IsEligibleForDisplay",
+	IsEligibleForSearch:       "IsEligibleForSearch",
+	IsReadOnly:                "IsReadOnly",
+	ListType:                  "ListType",
+	MembershipLifeSpan:        "MembershipLifeSpan",
+	Name:                      "Name",
+	PrepopulationStatus:       "PrepopulationStatus",
+	Rules:                     "Rules",
+	SeedListSize:              "SeedListSize",
+	SeedUserListDescription:   "SeedUserListDescription",
+	SeedUserListId:            "SeedUserListId",
+	SeedUserListName:          "SeedUserListName",
+	SeedUserListStatus:        "SeedUserListStatus",
+	Size:                      "Size",
+	SizeForSearch:             "SizeForSearch",
+	SizeRange:                 "SizeRange",
+	SizeRangeForSearch:        "SizeRangeForSearch",
+	Status:                    "Status",
+	UploadKeyType:             "UploadKeyType",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"MutateMembers": {
+		{Type: "MutateMembers", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"AddressInfo": {
+		{Type: "AddressInfo", Name: "HashedFirstName", Required: true},
+		{Type: "AddressInfo", Name: "HashedLastName", Required: true},
+		{Type: "AddressInfo", Name: "CountryCode", Required: true},
+		{Type: "AddressInfo", Name: "ZipCode", Required: true},
+	},
+	"CombinedRuleUserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+		{Type: "RuleBasedUserList", Name: "PrepopulationStatus", Selectors: []string{"PrepopulationStatus"}},
+		{Type: "CombinedRuleUserList", Name: "LeftOperand", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "CombinedRuleUserList", Name: "RightOperand", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "CombinedRuleUserList", Name: "RuleOperator", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"UserListConversionType": {
+		{Type: "UserListConversionType", Name: "Id"},
+		{Type: "UserListConversionType", Name: "Name"},
+		{Type: "UserListConversionType", Name: "Category", ReadOnly: true},
+	},
+	"CrmBasedUserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+		{Type: "CrmBasedUserList", Name: "AppId", Selectors: []string{"AppId"}},
+		{Type: "CrmBasedUserList", Name: "UploadKeyType", Selectors: []string{"UploadKeyType"}, ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "CrmBasedUserList", Name: "DataSourceType", Selectors: []string{"DataSourceType"}, Filter: "DataSourceType", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "CrmBasedUserList", Name: "DataUploadResult", Selectors: []string{"DataUploadResult"}, ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+	},
+	"DateKey": {
+		{Type: "DateKey", Name: "Name", Required: true},
+	},
+	"DateRuleItem": {
+		{Type: "DateRuleItem", Name: "Key", Required: true},
+		{Type: "DateRuleItem", Name: "Op", Required: true},
+		{Type: "DateRuleItem", Name: "Value"},
+		{Type: "DateRuleItem", Name: "RelativeValue"},
+	},
+	"DateSpecificRuleUserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+		{Type: "RuleBasedUserList", Name: "PrepopulationStatus", Selectors: []string{"PrepopulationStatus"}},
+		{Type: "DateSpecificRuleUserList", Name: "Rule", Selectors: []string{"DateSpecificListRule"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "DateSpecificRuleUserList", Name: "StartDate", Selectors: []string{"DateSpecificListStartDate"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "DateSpecificRuleUserList", Name: "EndDate", Selectors: []string{"DateSpecificListEndDate"}, Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"ExpressionRuleUserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+		{Type: "RuleBasedUserList", Name: "PrepopulationStatus", Selectors: []string{"PrepopulationStatus"}},
+		{Type: "ExpressionRuleUserList", Name: "Rule", Selectors: []string{"ExpressionListRule"}, Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"LogicalUserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+		{Type: "LogicalUserList", Name: "Rules", Selectors: []string{"Rules"}},
+	},
+	"MutateMembersOperand": {
+		{Type: "MutateMembersOperand", Name: "UserListId", Required: true},
+		{Type: "MutateMembersOperand", Name: "RemoveAll"},
+		{Type: "MutateMembersOperand", Name: "MembersList"},
+	},
+	"MutateMembersOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "MutateMembersOperation", Name: "Operand", Required: true},
+	},
+	"NumberKey": {
+		{Type: "NumberKey", Name: "Name", Required: true},
+	},
+	"NumberRuleItem": {
+		{Type: "NumberRuleItem", Name: "Key", Required: true},
+		{Type: "NumberRuleItem", Name: "Op", Required: true},
+		{Type: "NumberRuleItem", Name: "Value", Required: true},
+	},
+	"RelativeDate": {
+		{Type: "RelativeDate", Name: "OffsetInDays", Required: true},
+	},
+	"BasicUserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+		{Type: "BasicUserList", Name: "ConversionTypes", Selectors: []string{"ConversionTypes"}},
+	},
+	"Rule": {
+		{Type: "Rule", Name: "Groups", Required: true},
+		{Type: "Rule", Name: "RuleType"},
+	},
+	"RuleBasedUserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+		{Type: "RuleBasedUserList", Name: "PrepopulationStatus", Selectors: []string{"PrepopulationStatus"}},
+	},
+	"RuleItemGroup": {
+		{Type: "RuleItemGroup", Name: "Items", Required: true},
+	},
+	"SimilarUserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+		{Type: "SimilarUserList", Name: "SeedUserListId", Selectors: []string{"SeedUserListId"}, Filter: "SeedUserListId", ReadOnly: true, ReadOnlyOperators: []string{"SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "SimilarUserList", Name: "SeedUserListName", Selectors: []string{"SeedUserListName"}, ReadOnly: true},
+		{Type: "SimilarUserList", Name: "SeedUserListDescription", Selectors: []string{"SeedUserListDescription"}, ReadOnly: true},
+		{Type: "SimilarUserList", Name: "SeedUserListStatus", Selectors: []string{"SeedUserListStatus"}, ReadOnly: true},
+		{Type: "SimilarUserList", Name: "SeedListSize", Selectors: []string{"SeedListSize"}, Filter: "SeedListSize", ReadOnly: true},
+	},
+	"StringKey": {
+		{Type: "StringKey", Name: "Name", Required: true},
+	},
+	"StringRuleItem": {
+		{Type: "StringRuleItem", Name: "Key", Required: true},
+		{Type: "StringRuleItem", Name: "Op", Required: true},
+		{Type: "StringRuleItem", Name: "Value", Required: true},
+	},
+	"UserList": {
+		{Type: "UserList", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"SET"}},
+		{Type: "UserList", Name: "IsReadOnly", Selectors: []string{"IsReadOnly"}, ReadOnly: true},
+		{Type: "UserList", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "UserList", Name: "Description", Selectors: []string{"Description"}},
+		{Type: "UserList", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "UserList", Name: "IntegrationCode", Selectors: []string{"IntegrationCode"}, Filter: "IntegrationCode"},
+		{Type: "UserList", Name: "AccessReason", Selectors: []string{"AccessReason"}, Filter: "AccessReason", ReadOnly: true},
+		{Type: "UserList", Name: "AccountUserListStatus", Selectors: []string{"AccountUserListStatus"}, Filter: "AccountUserListStatus"},
+		{Type: "UserList", Name: "MembershipLifeSpan", Selectors: []string{"MembershipLifeSpan"}, Filter: "MembershipLifeSpan"},
+		{Type: "UserList", Name: "Size", Selectors: []string{"Size"}, Filter: "Size", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRange", Selectors: []string{"SizeRange"}, ReadOnly: true},
+		{Type: "UserList", Name: "SizeForSearch", Selectors: []string{"SizeForSearch"}, Filter: "SizeForSearch", ReadOnly: true},
+		{Type: "UserList", Name: "SizeRangeForSearch", Selectors: []string{"SizeRangeForSearch"}, ReadOnly: true},
+		{Type: "UserList", Name: "ListType", Selectors: []string{"ListType"}, Filter: "ListType", ReadOnly: true},
+		{Type: "UserList", Name: "IsEligibleForSearch", Selectors: []string{"IsEligibleForSearch"}, Filter: "IsEligibleForSearch"},
+		{Type: "UserList", Name: "IsEligibleForDisplay", Selectors: []string{"IsEligibleForDisplay"}, Filter: "IsEligibleForDisplay", ReadOnly: true},
+		{Type: "UserList", Name: "ClosingReason", Selectors: []string{"ClosingReason"}, Filter: "ClosingReason"},
+		{Type: "UserList", Name: "UserListType"},
+	},
+	"UserListLogicalRule": {
+		{Type: "UserListLogicalRule", Name: "Operator", Required: true},
+		{Type: "UserListLogicalRule", Name: "RuleOperands", Required: true},
+	},
+	"UserListOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "UserListOperation", Name: "Operand", Required: true},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
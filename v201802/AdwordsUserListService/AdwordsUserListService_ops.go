@@ -0,0 +1,27 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdwordsUserListService
+
+// AddMutateMembersOp returns the ADD operation of operand.
+func AddMutateMembersOp(operand *MutateMembersOperand) *MutateMembersOperation {
+	op := OperatorADD
+	return &MutateMembersOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveMutateMembersOp returns the REMOVE operation of operand.
+func RemoveMutateMembersOp(operand *MutateMembersOperand) *MutateMembersOperation {
+	op := OperatorREMOVE
+	return &MutateMembersOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *UserList) *UserListOperation {
+	op := OperatorADD
+	return &UserListOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *UserList) *UserListOperation {
+	op := OperatorSET
+	return &UserListOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
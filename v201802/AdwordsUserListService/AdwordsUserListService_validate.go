@@ -0,0 +1,337 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package AdwordsUserListService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks MutateMembers against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *MutateMembers) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Query against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Query) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks MutateMembersOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *MutateMembersOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks UserListOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *UserListOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "serviceSelector"), t.ServiceSelector != nil)
+	if t.ServiceSelector != nil {
+		t.ServiceSelector.validate(v, validate.Field(path, "serviceSelector"), op)
+	}
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	if len(t.Operations) > 0 {
+		v.Size(validate.Field(path, "operations"), len(t.Operations), 1, 10000)
+	}
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "ADD", "SET")
+		}
+	}
+}
+
+func (t *MutateMembers) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(MutateMembers)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	if len(t.Operations) > 0 {
+		v.Size(validate.Field(path, "operations"), len(t.Operations), 1, 10000)
+	}
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "ADD", "REMOVE")
+		}
+	}
+}
+
+func (t *Query) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Query)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *AddressInfo) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AddressInfo)
+	}
+	v.Required(validate.Field(path, "hashedFirstName"), t.HashedFirstName != nil)
+	v.Required(validate.Field(path, "hashedLastName"), t.HashedLastName != nil)
+	v.Required(validate.Field(path, "countryCode"), t.CountryCode != nil)
+	if t.CountryCode != nil {
+		v.StringLength(validate.Field(path, "countryCode"), *t.CountryCode, 2, 2, false, false)
+	}
+	v.Required(validate.Field(path, "zipCode"), t.ZipCode != nil)
+	if t.ZipCode != nil {
+		v.StringLength(validate.Field(path, "zipCode"), *t.ZipCode, 1, -1, false, false)
+	}
+}
+
+func (t *Member) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Member)
+	}
+	if t.AddressInfo != nil {
+		t.AddressInfo.validate(v, validate.Field(path, "addressInfo"), op)
+	}
+	if t.UserId != nil {
+		v.StringLength(validate.Field(path, "userId"), *t.UserId, 1, 512, false, false)
+	}
+}
+
+func (t *MutateMembersOperand) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(MutateMembersOperand)
+	}
+	v.Required(validate.Field(path, "userListId"), t.UserListId != nil)
+	if len(t.MembersList) > 0 {
+		v.Size(validate.Field(path, "membersList"), len(t.MembersList), 0, 1000000)
+	}
+	v.ContentsNotNull(validate.Field(path, "membersList"), t.MembersList)
+	for i, e := range t.MembersList {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "membersList"), i), op)
+		}
+	}
+}
+
+func (t *MutateMembersOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(MutateMembersOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *UserList) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(UserList)
+	}
+	if validate.HasOperator(op, "SET") {
+		v.Required(validate.Field(path, "id"), t.Id != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "isReadOnly"), op, t.IsReadOnly != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "accessReason"), op, t.AccessReason != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "size"), op, t.Size != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "sizeRange"), op, t.SizeRange != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "sizeForSearch"), op, t.SizeForSearch != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "sizeRangeForSearch"), op, t.SizeRangeForSearch != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "listType"), op, t.ListType != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "isEligibleForDisplay"), op, t.IsEligibleForDisplay != nil)
+	}
+}
+
+func (t *UserListOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(UserListOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (o *MutateMembersOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *MutateMembersOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	return validate.ID(t.UserListId)
+}
+
+func (o *UserListOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *UserListOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	return validate.ID(t.Id)
+}
@@ -0,0 +1,17 @@
+package AdwordsUserListService
+
+import "testing"
+
+func TestMutateMembersOps(t *testing.T) {
+	operand := &MutateMembersOperand{UserListId: Int64(3), RemoveAll: Bool(true)}
+	add, remove := AddMutateMembersOp(operand), RemoveMutateMembersOp(operand)
+	if *add.Operator != OperatorADD || add.Operand != operand {
+		t.Errorf("got %v of %+v, want ADD", *add.Operator, add.Operand)
+	}
+	if *remove.Operator != OperatorREMOVE || remove.Operand != operand {
+		t.Errorf("got %v of %+v, want REMOVE", *remove.Operator, remove.Operand)
+	}
+	if add.Operator == remove.Operator {
+		t.Error("operations share their operator")
+	}
+}
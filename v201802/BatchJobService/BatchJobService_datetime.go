@@ -0,0 +1,43 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BatchJobService
+
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// NewDateRange returns the DateRange from one date to another, both
+// inclusive.
+func NewDateRange(from, to datetime.Date) *DateRange {
+	return &DateRange{Min: String(from.String()), Max: String(to.String())}
+}
+
+// Dates returns the bounds of r. A bound that is not set is returned as the
+// zero Date.
+func (r *DateRange) Dates() (from, to datetime.Date, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDate(*r.Min); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDate(*r.Max)
+	}
+	return
+}
+
+// GetExpiration returns the Expiration of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *TemporaryUrl) GetExpiration(loc *time.Location) (time.Time, error) {
+	if t.Expiration == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.Expiration, loc)
+}
+
+// SetExpiration sets the Expiration of t to tm formatted in loc.
+func (t *TemporaryUrl) SetExpiration(tm time.Time, loc *time.Location) {
+	t.Expiration = String(datetime.FormatDateTime(tm, loc))
+}
@@ -0,0 +1,165 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BatchJobService
+
+import (
+	"iter"
+	"strconv"
+
+	"github.com/godofdream/go-googleadsinofficial/awql"
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// GetAll returns an iterator over the BatchJob entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func GetAll(api BatchJobServiceAPI, sel *Selector) iter.Seq2[*BatchJob, error] {
+	return GetPager(api, sel).All()
+}
+
+// GetEach calls fn for every BatchJob entry selected by sel and
+// returns the first error. See GetAll.
+func GetEach(api BatchJobServiceAPI, sel *Selector, fn func(*BatchJob) error) error {
+	return GetPager(api, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func GetPager(api BatchJobServiceAPI, sel *Selector) *paging.Pager[*BatchJob] {
+	p := &paging.Pager[*BatchJob]{Key: batchJobKey}
+	if sel == nil {
+		sel = new(Selector)
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*BatchJob, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+		paged.Fields = paging.WithField(paged.Fields, "Id")
+		resp, err := api.Get(&Get{Selector: paged})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.Page != nil && resp.Rval.Page.TotalNumEntries != nil {
+			total = *resp.Rval.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// GetSplitAll is GetAll for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitAll(api BatchJobServiceAPI, sel *Selector, split *paging.Splitter) iter.Seq2[*BatchJob, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel.Neutral(), getSplitPager(api))
+}
+
+// GetSplitEach is GetEach for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitEach(api BatchJobServiceAPI, sel *Selector, split *paging.Splitter, fn func(*BatchJob) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel.Neutral(), getSplitPager(api), fn)
+}
+
+func getSplitPager(api BatchJobServiceAPI) func(*selector.Selector) (*paging.Pager[*BatchJob], error) {
+	return func(s *selector.Selector) (*paging.Pager[*BatchJob], error) {
+		sel, err := ConvertSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return GetPager(api, sel), nil
+	}
+}
+
+// GetIDs returns the ids of the BatchJob entries selected by sel,
+// fetched with the Id field only, e.g. as the values of a
+// paging.Splitter.
+func GetIDs(api BatchJobServiceAPI, sel *Selector) ([]string, error) {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	ids := sel.Copy()
+	ids.Fields = []string{"Id"}
+	var list []string
+	err := GetEach(api, ids, func(e *BatchJob) error {
+		if id := batchJobKey(e); id != "" {
+			list = append(list, id)
+		}
+		return nil
+	})
+	return list, err
+}
+
+// QueryAll returns an iterator over the BatchJob entries of the AWQL
+// query, fetching one page after the other. The LIMIT clause of the query
+// selects the first entry and the page size. See paging.Pager for entries
+// added or removed while iterating.
+func QueryAll(api BatchJobServiceAPI, query string) iter.Seq2[*BatchJob, error] {
+	return QueryPager(api, query).All()
+}
+
+// QueryEach calls fn for every BatchJob entry of the AWQL query and
+// returns the first error. See QueryAll.
+func QueryEach(api BatchJobServiceAPI, query string, fn func(*BatchJob) error) error {
+	return QueryPager(api, query).Each(fn)
+}
+
+// QueryPager returns the paging.Pager of QueryAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func QueryPager(api BatchJobServiceAPI, query string) *paging.Pager[*BatchJob] {
+	p := &paging.Pager[*BatchJob]{Key: batchJobKey}
+	q, err := awql.Parse(query)
+	if err == nil && q.Paging != nil {
+		p.Start, p.PageSize = q.Paging.StartIndex, q.Paging.NumberResults
+	}
+	p.Fetch = func(start, n int32) ([]*BatchJob, int32, error) {
+		if err != nil {
+			return nil, 0, err
+		}
+		window := *q
+		window.Paging = &selector.Paging{StartIndex: start, NumberResults: n}
+		window.Fields = paging.WithField(window.Fields, "Id")
+		paged, err := window.Format()
+		if err != nil {
+			return nil, 0, err
+		}
+		resp, err := api.Query(&Query{Query: String(paged)})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.Page != nil && resp.Rval.Page.TotalNumEntries != nil {
+			total = *resp.Rval.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// batchJobKey identifies a BatchJob by its Id.
+func batchJobKey(e *BatchJob) string {
+	if e != nil && e.Id != nil {
+		return strconv.FormatInt(*e.Id, 10)
+	}
+	return ""
+}
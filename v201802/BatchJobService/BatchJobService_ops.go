@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BatchJobService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *BatchJob) *BatchJobOperation {
+	op := OperatorADD
+	return &BatchJobOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *BatchJob) *BatchJobOperation {
+	op := OperatorSET
+	return &BatchJobOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
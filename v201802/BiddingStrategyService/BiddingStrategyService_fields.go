@@ -0,0 +1,298 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BiddingStrategyService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// SharedBiddingStrategyFields holds the selector field names of SharedBiddingStrategy, including
+// the fields of the types it holds.
+var SharedBiddingStrategyFields = struct {
+	BiddingScheme                                 string
+	Id                                            string
+	MaximizeConversionValueTargetRoas             string
+	Name                                          string
+	PageOnePromotedBidCeiling                     string
+	PageOnePromotedBidChangesForRaisesOnly        string
+	PageOnePromotedBidModifier                    string
+	PageOnePromotedRaiseBidWhenBudgetConstrained  string
+	PageOnePromotedRaiseBidWhenLowQualityScore    string
+	PageOnePromotedStrategyGoal                   string
+	Status                                        string
+	TargetCpa                                     string
+	TargetCpaMaxCpcBidCeiling                     string
+	TargetCpaMaxCpcBidFloor                       string
+	TargetOutrankShare                            string
+	TargetOutrankShareBidChangesForRaisesOnly     string
+	TargetOutrankShareCompetitorDomain            string
+	TargetOutrankShareMaxCpcBidCeiling            string
+	TargetOutrankShareRaiseBidWhenLowQualityScore string
+	TargetRoas                                    string
+	TargetRoasBidCeiling                          string
+	TargetRoasBidFloor                            string
+	TargetSpendBidCeiling                         string
+	TargetSpendSpendTarget                        string
+	Type                                          string
+}{
+	BiddingScheme:                          "BiddingScheme",
+	Id:                                     "Id",
+	MaximizeConversionValueTargetRoas:      "MaximizeConversionValueTargetRoas",
+	Name:                                   "Name",
+	PageOnePromotedBidCeiling:              "PageOnePromotedBidCeiling",
+	PageOnePromotedBidChangesForRaisesOnly: "PageOnePromotedBidChangesForRaisesOnly",
+	PageOnePromotedBidModifier:             "PageOnePromotedBidModifier",
+	PageOnePromotedRaiseBidWhenBudgetConstrained:  "PageOnePromotedRaiseBidWhenBudgetConstrained",
+	PageOnePromotedRaiseBidWhenLowQualityScore:    "PageOnePromotedRaiseBidWhenLowQualityScore",
+	PageOnePromotedStrategyGoal:                   "PageOnePromotedStrategyGoal",
+	Status:                                        "Status",
+	TargetCpa:                                     "TargetCpa",
+	TargetCpaMaxCpcBidCeiling:                     "TargetCpaMaxCpcBidCeiling",
+	TargetCpaMaxCpcBidFloor:                       "TargetCpaMaxCpcBidFloor",
+	TargetOutrankShare:                            "TargetOutrankShare",
+	TargetOutrankShareBidChangesForRaisesOnly:     "TargetOutrankShareBidChangesForRaisesOnly",
+	TargetOutrankShareCompetitorDomain:            "TargetOutrankShareCompetitorDomain",
+	TargetOutrankShareMaxCpcBidCeiling:            "TargetOutrankShareMaxCpcBidCeiling",
+	TargetOutrankShareRaiseBidWhenLowQualityScore: "TargetOutrankShareRaiseBidWhenLowQualityScore",
+	TargetRoas:             "TargetRoas",
+	TargetRoasBidCeiling:   "TargetRoasBidCeiling",
+	TargetRoasBidFloor:     "TargetRoasBidFloor",
+	TargetSpendBidCeiling:  "TargetSpendBidCeiling",
+	TargetSpendSpendTarget: "TargetSpendSpendTarget",
+	Type:                   "Type",
+}
+
+// MaximizeConversionValueBiddingSchemeFields holds the selector field names of MaximizeConversionValueBiddingScheme, including
+// the fields of the types it holds.
+var MaximizeConversionValueBiddingSchemeFields = struct {
+	MaximizeConversionValueTargetRoas string
+}{
+	MaximizeConversionValueTargetRoas: "MaximizeConversionValueTargetRoas",
+}
+
+// PageOnePromotedBiddingSchemeFields holds the selector field names of PageOnePromotedBiddingScheme, including
+// the fields of the types it holds.
+var PageOnePromotedBiddingSchemeFields = struct {
+	PageOnePromotedBidCeiling                    string
+	PageOnePromotedBidChangesForRaisesOnly       string
+	PageOnePromotedBidModifier                   string
+	PageOnePromotedRaiseBidWhenBudgetConstrained string
+	PageOnePromotedRaiseBidWhenLowQualityScore   string
+	PageOnePromotedStrategyGoal                  string
+}{
+	PageOnePromotedBidCeiling:                    "PageOnePromotedBidCeiling",
+	PageOnePromotedBidChangesForRaisesOnly:       "PageOnePromotedBidChangesForRaisesOnly",
+	PageOnePromotedBidModifier:                   "PageOnePromotedBidModifier",
+	PageOnePromotedRaiseBidWhenBudgetConstrained: "PageOnePromotedRaiseBidWhenBudgetConstrained",
+	PageOnePromotedRaiseBidWhenLowQualityScore:   "PageOnePromotedRaiseBidWhenLowQualityScore",
+	PageOnePromotedStrategyGoal:                  "PageOnePromotedStrategyGoal",
+}
+
+// TargetCpaBiddingSchemeFields holds the selector field names of TargetCpaBiddingScheme, including
+// the fields of the types it holds.
+var TargetCpaBiddingSchemeFields = struct {
+	TargetCpa                 string
+	TargetCpaMaxCpcBidCeiling string
+	TargetCpaMaxCpcBidFloor   string
+}{
+	TargetCpa:                 "TargetCpa",
+	TargetCpaMaxCpcBidCeiling: "TargetCpaMaxCpcBidCeiling",
+	TargetCpaMaxCpcBidFloor:   "TargetCpaMaxCpcBidFloor",
+}
+
+// TargetOutrankShareBiddingSchemeFields holds the selector field names of TargetOutrankShareBiddingScheme, including
+// the fields of the types it holds.
+var TargetOutrankShareBiddingSchemeFields = struct {
+	TargetOutrankShare                            string
+	TargetOutrankShareBidChangesForRaisesOnly     string
+	TargetOutrankShareCompetitorDomain            string
+	TargetOutrankShareMaxCpcBidCeiling            string
+	TargetOutrankShareRaiseBidWhenLowQualityScore string
+}{
+	TargetOutrankShare:                            "TargetOutrankShare",
+	TargetOutrankShareBidChangesForRaisesOnly:     "TargetOutrankShareBidChangesForRaisesOnly",
+	TargetOutrankShareCompetitorDomain:            "TargetOutrankShareCompetitorDomain",
+	TargetOutrankShareMaxCpcBidCeiling:            "TargetOutrankShareMaxCpcBidCeiling",
+	TargetOutrankShareRaiseBidWhenLowQualityScore: "TargetOutrankShareRaiseBidWhenLowQualityScore",
+}
+
+// TargetRoasBiddingSchemeFields holds the selector field names of TargetRoasBiddingScheme, including
+// the fields of the types it holds.
+var TargetRoasBiddingSchemeFields = struct {
+	TargetRoas           string
+	TargetRoasBidCeiling string
+	TargetRoasBidFloor   string
+}{
+	TargetRoas:           "TargetRoas",
+	TargetRoasBidCeiling: "TargetRoasBidCeiling",
+	TargetRoasBidFloor:   "TargetRoasBidFloor",
+}
+
+// TargetSpendBiddingSchemeFields holds the selector field names of TargetSpendBiddingScheme, including
+// the fields of the types it holds.
+var TargetSpendBiddingSchemeFields = struct {
+	TargetSpendBidCeiling  string
+	TargetSpendSpendTarget string
+}{
+	TargetSpendBidCeiling:  "TargetSpendBidCeiling",
+	TargetSpendSpendTarget: "TargetSpendSpendTarget",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"SharedBiddingStrategy": {
+		{Type: "SharedBiddingStrategy", Name: "BiddingScheme", Selectors: []string{"BiddingScheme"}},
+		{Type: "SharedBiddingStrategy", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "SharedBiddingStrategy", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "SharedBiddingStrategy", Name: "Status", Selectors: []string{"Status"}, Filter: "Status", ReadOnly: true},
+		{Type: "SharedBiddingStrategy", Name: "Type_", Selectors: []string{"Type"}, Filter: "Type"},
+	},
+	"BiddingStrategyOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "BiddingStrategyOperation", Name: "Operand", Required: true},
+	},
+	"ManualCpmBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "ManualCpmBiddingScheme", Name: "ViewableCpmEnabled", ReadOnly: true},
+	},
+	"MaximizeConversionValueBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "MaximizeConversionValueBiddingScheme", Name: "TargetRoas", Selectors: []string{"MaximizeConversionValueTargetRoas"}, Filter: "MaximizeConversionValueTargetRoas"},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"PageOnePromotedBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "PageOnePromotedBiddingScheme", Name: "StrategyGoal", Selectors: []string{"PageOnePromotedStrategyGoal"}, Required: true},
+		{Type: "PageOnePromotedBiddingScheme", Name: "BidCeiling", Selectors: []string{"PageOnePromotedBidCeiling"}},
+		{Type: "PageOnePromotedBiddingScheme", Name: "BidModifier", Selectors: []string{"PageOnePromotedBidModifier"}},
+		{Type: "PageOnePromotedBiddingScheme", Name: "BidChangesForRaisesOnly", Selectors: []string{"PageOnePromotedBidChangesForRaisesOnly"}},
+		{Type: "PageOnePromotedBiddingScheme", Name: "RaiseBidWhenBudgetConstrained", Selectors: []string{"PageOnePromotedRaiseBidWhenBudgetConstrained"}},
+		{Type: "PageOnePromotedBiddingScheme", Name: "RaiseBidWhenLowQualityScore", Selectors: []string{"PageOnePromotedRaiseBidWhenLowQualityScore"}},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"TargetCpaBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "TargetCpaBiddingScheme", Name: "TargetCpa", Selectors: []string{"TargetCpa"}, Required: true},
+		{Type: "TargetCpaBiddingScheme", Name: "MaxCpcBidCeiling", Selectors: []string{"TargetCpaMaxCpcBidCeiling"}, Filter: "TargetCpaMaxCpcBidCeiling"},
+		{Type: "TargetCpaBiddingScheme", Name: "MaxCpcBidFloor", Selectors: []string{"TargetCpaMaxCpcBidFloor"}, Filter: "TargetCpaMaxCpcBidFloor"},
+	},
+	"TargetOutrankShareBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "TargetOutrankShareBiddingScheme", Name: "TargetOutrankShare", Selectors: []string{"TargetOutrankShare"}, Filter: "TargetOutrankShare", Required: true},
+		{Type: "TargetOutrankShareBiddingScheme", Name: "CompetitorDomain", Selectors: []string{"TargetOutrankShareCompetitorDomain"}, Filter: "TargetOutrankShareCompetitorDomain", Required: true},
+		{Type: "TargetOutrankShareBiddingScheme", Name: "MaxCpcBidCeiling", Selectors: []string{"TargetOutrankShareMaxCpcBidCeiling"}, Filter: "TargetOutrankShareMaxCpcBidCeiling", Required: true},
+		{Type: "TargetOutrankShareBiddingScheme", Name: "BidChangesForRaisesOnly", Selectors: []string{"TargetOutrankShareBidChangesForRaisesOnly"}, Filter: "TargetOutrankShareBidChangesForRaisesOnly", Required: true},
+		{Type: "TargetOutrankShareBiddingScheme", Name: "RaiseBidWhenLowQualityScore", Selectors: []string{"TargetOutrankShareRaiseBidWhenLowQualityScore"}, Filter: "TargetOutrankShareRaiseBidWhenLowQualityScore", Required: true},
+	},
+	"TargetRoasBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "TargetRoasBiddingScheme", Name: "TargetRoas", Selectors: []string{"TargetRoas"}, Required: true},
+		{Type: "TargetRoasBiddingScheme", Name: "BidCeiling", Selectors: []string{"TargetRoasBidCeiling"}},
+		{Type: "TargetRoasBiddingScheme", Name: "BidFloor", Selectors: []string{"TargetRoasBidFloor"}},
+	},
+	"TargetSpendBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "TargetSpendBiddingScheme", Name: "BidCeiling", Selectors: []string{"TargetSpendBidCeiling"}},
+		{Type: "TargetSpendBiddingScheme", Name: "SpendTarget", Selectors: []string{"TargetSpendSpendTarget"}},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BiddingStrategyService
+
+import (
+	"iter"
+	"strconv"
+
+	"github.com/godofdream/go-googleadsinofficial/awql"
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// GetAll returns an iterator over the SharedBiddingStrategy entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func GetAll(api BiddingStrategyServiceAPI, sel *Selector) iter.Seq2[*SharedBiddingStrategy, error] {
+	return GetPager(api, sel).All()
+}
+
+// GetEach calls fn for every SharedBiddingStrategy entry selected by sel and
+// returns the first error. See GetAll.
+func GetEach(api BiddingStrategyServiceAPI, sel *Selector, fn func(*SharedBiddingStrategy) error) error {
+	return GetPager(api, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func GetPager(api BiddingStrategyServiceAPI, sel *Selector) *paging.Pager[*SharedBiddingStrategy] {
+	p := &paging.Pager[*SharedBiddingStrategy]{Key: sharedBiddingStrategyKey}
+	if sel == nil {
+		sel = new(Selector)
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*SharedBiddingStrategy, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+		paged.Fields = paging.WithField(paged.Fields, "Id")
+		resp, err := api.Get(&Get{Selector: paged})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.Page != nil && resp.Rval.Page.TotalNumEntries != nil {
+			total = *resp.Rval.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// GetSplitAll is GetAll for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitAll(api BiddingStrategyServiceAPI, sel *Selector, split *paging.Splitter) iter.Seq2[*SharedBiddingStrategy, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel.Neutral(), getSplitPager(api))
+}
+
+// GetSplitEach is GetEach for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitEach(api BiddingStrategyServiceAPI, sel *Selector, split *paging.Splitter, fn func(*SharedBiddingStrategy) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel.Neutral(), getSplitPager(api), fn)
+}
+
+func getSplitPager(api BiddingStrategyServiceAPI) func(*selector.Selector) (*paging.Pager[*SharedBiddingStrategy], error) {
+	return func(s *selector.Selector) (*paging.Pager[*SharedBiddingStrategy], error) {
+		sel, err := ConvertSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return GetPager(api, sel), nil
+	}
+}
+
+// GetIDs returns the ids of the SharedBiddingStrategy entries selected by sel,
+// fetched with the Id field only, e.g. as the values of a
+// paging.Splitter.
+func GetIDs(api BiddingStrategyServiceAPI, sel *Selector) ([]string, error) {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	ids := sel.Copy()
+	ids.Fields = []string{"Id"}
+	var list []string
+	err := GetEach(api, ids, func(e *SharedBiddingStrategy) error {
+		if id := sharedBiddingStrategyKey(e); id != "" {
+			list = append(list, id)
+		}
+		return nil
+	})
+	return list, err
+}
+
+// QueryAll returns an iterator over the SharedBiddingStrategy entries of the AWQL
+// query, fetching one page after the other. The LIMIT clause of the query
+// selects the first entry and the page size. See paging.Pager for entries
+// added or removed while iterating.
+func QueryAll(api BiddingStrategyServiceAPI, query string) iter.Seq2[*SharedBiddingStrategy, error] {
+	return QueryPager(api, query).All()
+}
+
+// QueryEach calls fn for every SharedBiddingStrategy entry of the AWQL query and
+// returns the first error. See QueryAll.
+func QueryEach(api BiddingStrategyServiceAPI, query string, fn func(*SharedBiddingStrategy) error) error {
+	return QueryPager(api, query).Each(fn)
+}
+
+// QueryPager returns the paging.Pager of QueryAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func QueryPager(api BiddingStrategyServiceAPI, query string) *paging.Pager[*SharedBiddingStrategy] {
+	p := &paging.Pager[*SharedBiddingStrategy]{Key: sharedBiddingStrategyKey}
+	q, err := awql.Parse(query)
+	if err == nil && q.Paging != nil {
+		p.Start, p.PageSize = q.Paging.StartIndex, q.Paging.NumberResults
+	}
+	p.Fetch = func(start, n int32) ([]*SharedBiddingStrategy, int32, error) {
+		if err != nil {
+			return nil, 0, err
+		}
+		window := *q
+		window.Paging = &selector.Paging{StartIndex: start, NumberResults: n}
+		window.Fields = paging.WithField(window.Fields, "Id")
+		paged, err := window.Format()
+		if err != nil {
+			return nil, 0, err
+		}
+		resp, err := api.Query(&Query{Query: String(paged)})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.Page != nil && resp.Rval.Page.TotalNumEntries != nil {
+			total = *resp.Rval.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// sharedBiddingStrategyKey identifies a SharedBiddingStrategy by its Id.
+func sharedBiddingStrategyKey(e *SharedBiddingStrategy) string {
+	if e != nil && e.Id != nil {
+		return strconv.FormatInt(*e.Id, 10)
+	}
+	return ""
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BiddingStrategyService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *SharedBiddingStrategy) *BiddingStrategyOperation {
+	op := OperatorADD
+	return &BiddingStrategyOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *SharedBiddingStrategy) *BiddingStrategyOperation {
+	op := OperatorSET
+	return &BiddingStrategyOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *SharedBiddingStrategy) *BiddingStrategyOperation {
+	op := OperatorREMOVE
+	return &BiddingStrategyOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
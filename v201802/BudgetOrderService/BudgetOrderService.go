@@ -980,392 +980,328 @@ const (
 )
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type NewEntityCreationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NewEntityCreationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NewEntityCreationErrorReason `xml:"reason,omitempty"`
+	Reason *NewEntityCreationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotWhitelistedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotWhitelistedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotWhitelistedErrorReason `xml:"reason,omitempty"`
+	Reason *NotWhitelistedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type PagingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PagingError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *PagingErrorReason `xml:"reason,omitempty"`
+	Reason *PagingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -1373,13 +1309,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -1388,98 +1324,82 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -1490,13 +1410,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -1505,58 +1425,54 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -1564,12 +1480,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -1577,68 +1493,62 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StatsQueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StatsQueryError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StatsQueryErrorReason `xml:"reason,omitempty"`
+	Reason *StatsQueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BudgetOrderErrorReason string
@@ -1840,32 +1750,32 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/billing/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 getResponse" json:"-"`
 
-	Rval *BudgetOrderPage `xml:"rval,omitempty"`
+	Rval *BudgetOrderPage `xml:"https://adwords.google.com/api/adwords/billing/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetBillingAccounts struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 getBillingAccounts"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 getBillingAccounts" json:"-"`
 }
 
 type GetBillingAccountsResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 getBillingAccountsResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 getBillingAccountsResponse" json:"-"`
 
-	Rval []*BillingAccount `xml:"rval,omitempty"`
+	Rval []*BillingAccount `xml:"https://adwords.google.com/api/adwords/billing/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -1873,59 +1783,55 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, SET, REMOVE.</span>
 	//
-	Operations []*BudgetOrderOperation `xml:"operations,omitempty"`
+	Operations []*BudgetOrderOperation `xml:"https://adwords.google.com/api/adwords/billing/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 mutateResponse" json:"-"`
 
-	Rval *BudgetOrderReturnValue `xml:"rval,omitempty"`
+	Rval *BudgetOrderReturnValue `xml:"https://adwords.google.com/api/adwords/billing/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type BillingAccount struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 BillingAccount"`
-
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Id string `xml:"id,omitempty"`
+	Id *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CurrencyCode string `xml:"currencyCode,omitempty"`
+	CurrencyCode *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 currencyCode,omitempty" json:"currencyCode,omitempty"`
 
 	//
 	// A 12 digit billing id assigned to the user by Google.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PrimaryBillingId string `xml:"primaryBillingId,omitempty"`
+	PrimaryBillingId *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 primaryBillingId,omitempty" json:"primaryBillingId,omitempty"`
 
 	//
 	// An optional secondary billing id assigned to the user by Google.
 	//
-	SecondaryBillingId string `xml:"secondaryBillingId,omitempty"`
+	SecondaryBillingId *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 secondaryBillingId,omitempty" json:"secondaryBillingId,omitempty"`
 }
 
 type BudgetOrder struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 BudgetOrder"`
-
 	//
 	// This must be passed as a string with dashes, e.g. "1234-5678-9012-3456".
 	// <span class="constraint Selectable">This field can be selected using the value "BillingAccountId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	BillingAccountId string `xml:"billingAccountId,omitempty"`
+	BillingAccountId *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 billingAccountId,omitempty" json:"billingAccountId,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/billing/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Enables user to specify meaningful name for a billing account
@@ -1935,7 +1841,7 @@ type BudgetOrder struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BillingAccountName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint StringLength">The length of this string should be between 0 and 80, inclusive, (trimmed).</span>
 	//
-	BillingAccountName string `xml:"billingAccountName,omitempty"`
+	BillingAccountName *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 billingAccountName,omitempty" json:"billingAccountName,omitempty"`
 
 	//
 	// Enables user to enter a value that helps them reference this budget order
@@ -1945,7 +1851,7 @@ type BudgetOrder struct {
 	// <span class="constraint Selectable">This field can be selected using the value "PoNumber".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint StringLength">The length of this string should be between 0 and 80, inclusive, (trimmed).</span>
 	//
-	PoNumber string `xml:"poNumber,omitempty"`
+	PoNumber *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 poNumber,omitempty" json:"poNumber,omitempty"`
 
 	//
 	// Enables user to specify meaningful name for referencing this budget order. A default name
@@ -1955,7 +1861,7 @@ type BudgetOrder struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BudgetOrderName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint StringLength">The length of this string should be between 0 and 150, inclusive, (trimmed).</span>
 	//
-	BudgetOrderName string `xml:"budgetOrderName,omitempty"`
+	BudgetOrderName *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 budgetOrderName,omitempty" json:"budgetOrderName,omitempty"`
 
 	//
 	// A 12 digit billing ID assigned to the user by Google. This must be passed in
@@ -1968,7 +1874,7 @@ type BudgetOrder struct {
 	// <span class="constraint Selectable">This field can be selected using the value "PrimaryBillingId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint StringLength">The length of this string should be between 0 and 14, inclusive, (trimmed).</span>
 	//
-	PrimaryBillingId string `xml:"primaryBillingId,omitempty"`
+	PrimaryBillingId *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 primaryBillingId,omitempty" json:"primaryBillingId,omitempty"`
 
 	//
 	// For certain users, a secondary billing ID will be required on mutate.add.
@@ -1977,7 +1883,7 @@ type BudgetOrder struct {
 	// <span class="constraint Selectable">This field can be selected using the value "SecondaryBillingId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint StringLength">The length of this string should be between 0 and 14, inclusive, (trimmed).</span>
 	//
-	SecondaryBillingId string `xml:"secondaryBillingId,omitempty"`
+	SecondaryBillingId *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 secondaryBillingId,omitempty" json:"secondaryBillingId,omitempty"`
 
 	//
 	// The spending limit in micros. To specify an unlimited budget, set spendingLimit to -1,
@@ -1989,7 +1895,7 @@ type BudgetOrder struct {
 	// <span class="constraint Selectable">This field can be selected using the value "SpendingLimit".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	SpendingLimit *Money `xml:"spendingLimit,omitempty"`
+	SpendingLimit *Money `xml:"https://adwords.google.com/api/adwords/billing/v201802 spendingLimit,omitempty" json:"spendingLimit,omitempty"`
 
 	//
 	// The adjustments amount in micros. Adjustments from Google come in the form of credits or
@@ -2000,7 +1906,7 @@ type BudgetOrder struct {
 	// <span class="constraint Selectable">This field can be selected using the value "TotalAdjustments".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	TotalAdjustments *Money `xml:"totalAdjustments,omitempty"`
+	TotalAdjustments *Money `xml:"https://adwords.google.com/api/adwords/billing/v201802 totalAdjustments,omitempty" json:"totalAdjustments,omitempty"`
 
 	//
 	// StartDateTime cannot be in the past, it must be on or before
@@ -2010,7 +1916,7 @@ type BudgetOrder struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	StartDateTime string `xml:"startDateTime,omitempty"`
+	StartDateTime *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 startDateTime,omitempty" json:"startDateTime,omitempty"`
 
 	//
 	// EndDateTime must be on or before "20361231 235959 America/Los_Angeles" or
@@ -2021,7 +1927,7 @@ type BudgetOrder struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	EndDateTime string `xml:"endDateTime,omitempty"`
+	EndDateTime *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 endDateTime,omitempty" json:"endDateTime,omitempty"`
 
 	//
 	// Contains fields that provide information on the last set of values that
@@ -2030,81 +1936,73 @@ type BudgetOrder struct {
 	// <span class="constraint Selectable">This field can be selected using the value "LastRequest".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	LastRequest *BudgetOrderRequest `xml:"lastRequest,omitempty"`
+	LastRequest *BudgetOrderRequest `xml:"https://adwords.google.com/api/adwords/billing/v201802 lastRequest,omitempty" json:"lastRequest,omitempty"`
 }
 
 type BudgetOrderError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 BudgetOrderError"`
-
 	*ApiError
 
-	Reason *BudgetOrderErrorReason `xml:"reason,omitempty"`
+	Reason *BudgetOrderErrorReason `xml:"https://adwords.google.com/api/adwords/billing/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BudgetOrderOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 BudgetOrderOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *BudgetOrder `xml:"operand,omitempty"`
+	Operand *BudgetOrder `xml:"https://adwords.google.com/api/adwords/billing/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type BudgetOrderPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 BudgetOrderPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*BudgetOrder `xml:"entries,omitempty"`
+	Entries []*BudgetOrder `xml:"https://adwords.google.com/api/adwords/billing/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type BudgetOrderRequest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 BudgetOrderRequest"`
-
 	//
 	// Status of the last {@link BudgetOrder} change.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Status *BudgetOrderRequestStatus `xml:"status,omitempty"`
+	Status *BudgetOrderRequestStatus `xml:"https://adwords.google.com/api/adwords/billing/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// {@link DateTime} of when the request was received.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Date string `xml:"date,omitempty"`
+	Date *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 date,omitempty" json:"date,omitempty"`
 
 	//
 	// Enables user to specify meaningful name for a billing account
 	// to aid in reconciling monthly invoices. This name will be printed in the monthly invoices.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BillingAccountName string `xml:"billingAccountName,omitempty"`
+	BillingAccountName *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 billingAccountName,omitempty" json:"billingAccountName,omitempty"`
 
 	//
 	// Enables user to enter a value that helps them reference this budget order
 	// in their monthly invoices. This number will be printed in the monthly invoices.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PoNumber string `xml:"poNumber,omitempty"`
+	PoNumber *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 poNumber,omitempty" json:"poNumber,omitempty"`
 
 	//
 	// Enables user to specify meaningful name for referencing this budget order. A default name
 	// will be provided if none is specified. This name will be printed in the monthly invoices.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BudgetOrderName string `xml:"budgetOrderName,omitempty"`
+	BudgetOrderName *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 budgetOrderName,omitempty" json:"budgetOrderName,omitempty"`
 
 	//
 	// The spending limit in micros. To specify an unlimited budget, set spendingLimit to -1,
 	// otherwise spendingLimit must be greater than 0.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	SpendingLimit *Money `xml:"spendingLimit,omitempty"`
+	SpendingLimit *Money `xml:"https://adwords.google.com/api/adwords/billing/v201802 spendingLimit,omitempty" json:"spendingLimit,omitempty"`
 
 	//
 	// StartDateTime cannot be in the past, it must be on or before
@@ -2112,7 +2010,7 @@ type BudgetOrderRequest struct {
 	// must use the same time zone.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	StartDateTime string `xml:"startDateTime,omitempty"`
+	StartDateTime *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 startDateTime,omitempty" json:"startDateTime,omitempty"`
 
 	//
 	// EndDateTime must be on or before "20361231 235959 America/Los_Angeles" or
@@ -2121,26 +2019,22 @@ type BudgetOrderRequest struct {
 	// must use the same time zone.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	EndDateTime string `xml:"endDateTime,omitempty"`
+	EndDateTime *string `xml:"https://adwords.google.com/api/adwords/billing/v201802 endDateTime,omitempty" json:"endDateTime,omitempty"`
 }
 
 type BudgetOrderReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 BudgetOrderReturnValue"`
-
 	*ListReturnValue
 
 	//
 	// List of {@link BudgetOrder}s affected by the mutate call.
 	//
-	Value []*BudgetOrder `xml:"value,omitempty"`
+	Value []*BudgetOrder `xml:"https://adwords.google.com/api/adwords/billing/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type CustomerOrderLineError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/billing/v201802 CustomerOrderLineError"`
-
 	*ApiError
 
-	Reason *CustomerOrderLineErrorReason `xml:"reason,omitempty"`
+	Reason *CustomerOrderLineErrorReason `xml:"https://adwords.google.com/api/adwords/billing/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BudgetOrderServiceInterface struct {
@@ -0,0 +1,120 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BudgetOrderService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BudgetOrderServiceAPI is the interface of the BudgetOrderService operations. It is
+// implemented by BudgetOrderServiceInterface and, for tests, by FakeBudgetOrderService.
+type BudgetOrderServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	GetBillingAccounts(request *GetBillingAccounts) (*GetBillingAccountsResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+}
+
+var _ BudgetOrderServiceAPI = (*BudgetOrderServiceInterface)(nil)
+var _ BudgetOrderServiceAPI = (*FakeBudgetOrderService)(nil)
+
+// FakeCall is a call recorded by FakeBudgetOrderService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeBudgetOrderService is a programmable BudgetOrderServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeBudgetOrderService struct {
+	GetFunc                func(request *Get) (*GetResponse, error)
+	GetBillingAccountsFunc func(request *GetBillingAccounts) (*GetBillingAccountsResponse, error)
+	MutateFunc             func(request *Mutate) (*MutateResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeBudgetOrderService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeBudgetOrderService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeBudgetOrderService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeBudgetOrderService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeBudgetOrderService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeBudgetOrderService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// GetBillingAccounts records the call and delegates to GetBillingAccountsFunc.
+func (f *FakeBudgetOrderService) GetBillingAccounts(request *GetBillingAccounts) (*GetBillingAccountsResponse, error) {
+	f.record("GetBillingAccounts", request)
+	if f.GetBillingAccountsFunc == nil {
+		return nil, fmt.Errorf("FakeBudgetOrderService.GetBillingAccountsFunc is not set")
+	}
+	return f.GetBillingAccountsFunc(request)
+}
+
+// GetBillingAccountsCalls returns the requests of the recorded GetBillingAccounts calls.
+func (f *FakeBudgetOrderService) GetBillingAccountsCalls() []*GetBillingAccounts {
+	var requests []*GetBillingAccounts
+	for _, c := range f.Calls() {
+		if c.Method == "GetBillingAccounts" {
+			requests = append(requests, c.Request.(*GetBillingAccounts))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeBudgetOrderService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeBudgetOrderService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeBudgetOrderService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
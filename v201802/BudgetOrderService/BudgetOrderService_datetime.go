@@ -0,0 +1,85 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BudgetOrderService
+
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// NewDateRange returns the DateRange from one date to another, both
+// inclusive.
+func NewDateRange(from, to datetime.Date) *DateRange {
+	return &DateRange{Min: String(from.String()), Max: String(to.String())}
+}
+
+// Dates returns the bounds of r. A bound that is not set is returned as the
+// zero Date.
+func (r *DateRange) Dates() (from, to datetime.Date, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDate(*r.Min); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDate(*r.Max)
+	}
+	return
+}
+
+// GetStartDateTime returns the StartDateTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *BudgetOrder) GetStartDateTime(loc *time.Location) (time.Time, error) {
+	if t.StartDateTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.StartDateTime, loc)
+}
+
+// SetStartDateTime sets the StartDateTime of t to tm formatted in loc.
+func (t *BudgetOrder) SetStartDateTime(tm time.Time, loc *time.Location) {
+	t.StartDateTime = String(datetime.FormatDateTime(tm, loc))
+}
+
+// GetEndDateTime returns the EndDateTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *BudgetOrder) GetEndDateTime(loc *time.Location) (time.Time, error) {
+	if t.EndDateTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.EndDateTime, loc)
+}
+
+// SetEndDateTime sets the EndDateTime of t to tm formatted in loc.
+func (t *BudgetOrder) SetEndDateTime(tm time.Time, loc *time.Location) {
+	t.EndDateTime = String(datetime.FormatDateTime(tm, loc))
+}
+
+// GetStartDateTime returns the StartDateTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *BudgetOrderRequest) GetStartDateTime(loc *time.Location) (time.Time, error) {
+	if t.StartDateTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.StartDateTime, loc)
+}
+
+// SetStartDateTime sets the StartDateTime of t to tm formatted in loc.
+func (t *BudgetOrderRequest) SetStartDateTime(tm time.Time, loc *time.Location) {
+	t.StartDateTime = String(datetime.FormatDateTime(tm, loc))
+}
+
+// GetEndDateTime returns the EndDateTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *BudgetOrderRequest) GetEndDateTime(loc *time.Location) (time.Time, error) {
+	if t.EndDateTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.EndDateTime, loc)
+}
+
+// SetEndDateTime sets the EndDateTime of t to tm formatted in loc.
+func (t *BudgetOrderRequest) SetEndDateTime(tm time.Time, loc *time.Location) {
+	t.EndDateTime = String(datetime.FormatDateTime(tm, loc))
+}
@@ -0,0 +1,115 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BudgetOrderService
+
+import (
+	"iter"
+	"strconv"
+
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// GetAll returns an iterator over the BudgetOrder entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func GetAll(api BudgetOrderServiceAPI, sel *Selector) iter.Seq2[*BudgetOrder, error] {
+	return GetPager(api, sel).All()
+}
+
+// GetEach calls fn for every BudgetOrder entry selected by sel and
+// returns the first error. See GetAll.
+func GetEach(api BudgetOrderServiceAPI, sel *Selector, fn func(*BudgetOrder) error) error {
+	return GetPager(api, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func GetPager(api BudgetOrderServiceAPI, sel *Selector) *paging.Pager[*BudgetOrder] {
+	p := &paging.Pager[*BudgetOrder]{Key: budgetOrderKey}
+	if sel == nil {
+		sel = new(Selector)
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*BudgetOrder, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+		paged.Fields = paging.WithField(paged.Fields, "Id")
+		resp, err := api.Get(&Get{ServiceSelector: paged})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.Page != nil && resp.Rval.Page.TotalNumEntries != nil {
+			total = *resp.Rval.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// GetSplitAll is GetAll for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitAll(api BudgetOrderServiceAPI, sel *Selector, split *paging.Splitter) iter.Seq2[*BudgetOrder, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel.Neutral(), getSplitPager(api))
+}
+
+// GetSplitEach is GetEach for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitEach(api BudgetOrderServiceAPI, sel *Selector, split *paging.Splitter, fn func(*BudgetOrder) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel.Neutral(), getSplitPager(api), fn)
+}
+
+func getSplitPager(api BudgetOrderServiceAPI) func(*selector.Selector) (*paging.Pager[*BudgetOrder], error) {
+	return func(s *selector.Selector) (*paging.Pager[*BudgetOrder], error) {
+		sel, err := ConvertSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return GetPager(api, sel), nil
+	}
+}
+
+// GetIDs returns the ids of the BudgetOrder entries selected by sel,
+// fetched with the Id field only, e.g. as the values of a
+// paging.Splitter.
+func GetIDs(api BudgetOrderServiceAPI, sel *Selector) ([]string, error) {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	ids := sel.Copy()
+	ids.Fields = []string{"Id"}
+	var list []string
+	err := GetEach(api, ids, func(e *BudgetOrder) error {
+		if id := budgetOrderKey(e); id != "" {
+			list = append(list, id)
+		}
+		return nil
+	})
+	return list, err
+}
+
+// budgetOrderKey identifies a BudgetOrder by its Id.
+func budgetOrderKey(e *BudgetOrder) string {
+	if e != nil && e.Id != nil {
+		return strconv.FormatInt(*e.Id, 10)
+	}
+	return ""
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BudgetOrderService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *BudgetOrder) *BudgetOrderOperation {
+	op := OperatorADD
+	return &BudgetOrderOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *BudgetOrder) *BudgetOrderOperation {
+	op := OperatorSET
+	return &BudgetOrderOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *BudgetOrder) *BudgetOrderOperation {
+	op := OperatorREMOVE
+	return &BudgetOrderOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package BudgetService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *Budget) *BudgetOperation {
+	op := OperatorADD
+	return &BudgetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *Budget) *BudgetOperation {
+	op := OperatorSET
+	return &BudgetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *Budget) *BudgetOperation {
+	op := OperatorREMOVE
+	return &BudgetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,95 @@
+package BudgetService
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// doubleBudgets is campaign-management code depending only on the
+// interface: it doubles the amounts of the budgets with the given name.
+func doubleBudgets(api BudgetServiceAPI, name string) error {
+	equals := PredicateOperatorEQUALS
+	resp, err := api.Get(&Get{Selector: &Selector{
+		Fields:     []string{"BudgetId", "Amount"},
+		Predicates: []*Predicate{{Field: String("BudgetName"), Operator: &equals, Values: []string{name}}},
+	}})
+	if err != nil {
+		return err
+	}
+	var ops []*BudgetOperation
+	for _, b := range resp.Rval.Entries {
+		ops = append(ops, SetOp(&Budget{BudgetId: b.BudgetId, Amount: &Money{MicroAmount: Int64(2 * Int64Value(b.Amount.MicroAmount))}}))
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+	_, err = api.Mutate(&Mutate{Operations: ops})
+	return err
+}
+
+func TestFakeBudgetService(t *testing.T) {
+	fake := &FakeBudgetService{
+		GetFunc: func(request *Get) (*GetResponse, error) {
+			return &GetResponse{Rval: &BudgetPage{Entries: []*Budget{
+				{BudgetId: Int64(1), Amount: &Money{MicroAmount: Int64(1000000)}},
+				{BudgetId: Int64(2), Amount: &Money{MicroAmount: Int64(2500000)}},
+			}}}, nil
+		},
+		MutateFunc: func(request *Mutate) (*MutateResponse, error) {
+			return &MutateResponse{Rval: &BudgetReturnValue{Value: []*Budget{request.Operations[0].Operand}}}, nil
+		},
+	}
+	if err := doubleBudgets(fake, "Shoes"); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := fake.Calls()
+	if len(calls) != 2 || calls[0].Method != "Get" || calls[1].Method != "Mutate" {
+		t.Fatalf("got calls %+v", calls)
+	}
+	gets := fake.GetCalls()
+	if p := gets[0].Selector.Predicates[0]; len(gets) != 1 || p.Values[0] != "Shoes" {
+		t.Errorf("got Get calls %+v", gets)
+	}
+	mutates := fake.MutateCalls()
+	if len(mutates) != 1 || len(mutates[0].Operations) != 2 {
+		t.Fatalf("got Mutate calls %+v", mutates)
+	}
+	for i, want := range []int64{2000000, 5000000} {
+		op := mutates[0].Operations[i]
+		if *op.Operator != OperatorSET || *op.Operand.BudgetId != int64(i+1) || *op.Operand.Amount.MicroAmount != want {
+			t.Errorf("got operation %d %v %+v, want SET of %d", i, *op.Operator, op.Operand, want)
+		}
+	}
+	if len(fake.QueryCalls()) != 0 {
+		t.Errorf("got Query calls %+v", fake.QueryCalls())
+	}
+
+	fake.Reset()
+	if len(fake.Calls()) != 0 {
+		t.Errorf("got calls %+v after Reset", fake.Calls())
+	}
+}
+
+func TestFakeBudgetServiceErrors(t *testing.T) {
+	// Operations without a function fail, and are recorded.
+	fake := new(FakeBudgetService)
+	if err := doubleBudgets(fake, "Shoes"); err == nil || !strings.Contains(err.Error(), "GetFunc is not set") {
+		t.Errorf("got error %v", err)
+	}
+	if len(fake.GetCalls()) != 1 {
+		t.Errorf("got calls %+v", fake.Calls())
+	}
+
+	// The errors of the functions are returned to the caller.
+	fake.GetFunc = func(request *Get) (*GetResponse, error) {
+		return nil, fmt.Errorf("RateExceededError.RATE_EXCEEDED")
+	}
+	if err := doubleBudgets(fake, "Shoes"); err == nil || err.Error() != "RateExceededError.RATE_EXCEEDED" {
+		t.Errorf("got error %v", err)
+	}
+	if len(fake.MutateCalls()) != 0 {
+		t.Errorf("mutated after a failed Get: %+v", fake.MutateCalls())
+	}
+}
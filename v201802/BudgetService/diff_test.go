@@ -0,0 +1,34 @@
+package BudgetService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/validate"
+)
+
+func TestSetBudgetOperation(t *testing.T) {
+	from := &Budget{BudgetId: Int64(7), Name: String("Budget"), Amount: &Money{MicroAmount: Int64(1000000)}}
+	to := from.Copy()
+	to.Amount.MicroAmount = Int64(2000000)
+
+	op, changes := SetBudgetOperation(from, to)
+	if op == nil || len(changes) != 1 {
+		t.Fatalf("got %v, %v, want an operation with one change", op, changes)
+	}
+	if id := Int64Value(op.Operand.BudgetId); id != 7 {
+		t.Errorf("got budgetId %d, want 7", id)
+	}
+	if op.Operand.Name != nil {
+		t.Errorf("got unchanged name %q", *op.Operand.Name)
+	}
+	if err := op.Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+
+	op.Operand.BudgetId = nil
+	var errs validate.Errors
+	if err := op.Validate(); !errors.As(err, &errs) || len(errs) != 1 || errs[0].Path != "operand.budgetId" {
+		t.Errorf("Validate without budgetId: got %v, want operand.budgetId required", err)
+	}
+}
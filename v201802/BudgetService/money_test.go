@@ -0,0 +1,21 @@
+package BudgetService
+
+import "testing"
+
+func TestMoneyRound(t *testing.T) {
+	m := &Money{ComparableValue: &ComparableValue{ComparableValueType: String("Money")}, MicroAmount: Int64(15000000)}
+	r, err := m.Round("KRW")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Micros() != 20000000 {
+		t.Errorf("got %d micros, want 20000000", r.Micros())
+	}
+	if r.ComparableValue == m.ComparableValue || StringValue(r.ComparableValueType) != "Money" {
+		t.Errorf("got ComparableValue %+v, want a copy of %+v", r.ComparableValue, m.ComparableValue)
+	}
+	sum, err := (*Money)(nil).Add(NewMoney(1))
+	if err != nil || sum.Micros() != 1 {
+		t.Errorf("nil + 1: got %v, %v", sum, err)
+	}
+}
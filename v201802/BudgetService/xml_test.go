@@ -0,0 +1,36 @@
+package BudgetService
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+// A fetched entity can be sent back as the operand of an operation.
+func TestReuseDecoded(t *testing.T) {
+	const response = `<getResponse xmlns="https://adwords.google.com/api/adwords/cm/v201802">
+<rval><totalNumEntries>1</totalNumEntries><entries><budgetId>7</budgetId><name>Budget</name><amount><microAmount>1000000</microAmount></amount></entries></rval>
+</getResponse>`
+	var resp GetResponse
+	if err := xml.Unmarshal([]byte(response), &resp); err != nil {
+		t.Fatal(err)
+	}
+	budget := resp.Rval.Entries[0]
+	budget.Amount.MicroAmount = Int64(2000000)
+
+	b, err := xml.Marshal(&Mutate{Operations: []*BudgetOperation{SetOp(budget)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); !strings.Contains(s, "<operand ") || strings.Contains(s, "<entries") {
+		t.Fatalf("got %s, want the budget as operand", s)
+	}
+	var sent Mutate
+	if err := xml.Unmarshal(b, &sent); err != nil {
+		t.Fatal(err)
+	}
+	operand := sent.Operations[0].Operand
+	if Int64Value(operand.BudgetId) != 7 || operand.Amount.Micros() != 2000000 {
+		t.Errorf("got operand %+v, want the fetched budget with the new amount", operand)
+	}
+}
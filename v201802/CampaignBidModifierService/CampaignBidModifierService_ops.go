@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignBidModifierService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CampaignBidModifier) *CampaignBidModifierOperation {
+	op := OperatorADD
+	return &CampaignBidModifierOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *CampaignBidModifier) *CampaignBidModifierOperation {
+	op := OperatorSET
+	return &CampaignBidModifierOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CampaignBidModifier) *CampaignBidModifierOperation {
+	op := OperatorREMOVE
+	return &CampaignBidModifierOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -2561,22 +2561,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse" json:"-"`
 
-	Rval *CampaignCriterionPage `xml:"rval,omitempty"`
+	Rval *CampaignCriterionPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -2585,33 +2585,31 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, REMOVE, SET.</span>
 	//
-	Operations []*CampaignCriterionOperation `xml:"operations,omitempty"`
+	Operations []*CampaignCriterionOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse" json:"-"`
 
-	Rval *CampaignCriterionReturnValue `xml:"rval,omitempty"`
+	Rval *CampaignCriterionReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Query struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse" json:"-"`
 
-	Rval *CampaignCriterionPage `xml:"rval,omitempty"`
+	Rval *CampaignCriterionPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdSchedule struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdSchedule"`
-
 	*Criterion
 
 	//
@@ -2620,7 +2618,7 @@ type AdSchedule struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	DayOfWeek *DayOfWeek `xml:"dayOfWeek,omitempty"`
+	DayOfWeek *DayOfWeek `xml:"https://adwords.google.com/api/adwords/cm/v201802 dayOfWeek,omitempty" json:"dayOfWeek,omitempty"`
 
 	//
 	// Starting hour in 24 hour time.
@@ -2629,7 +2627,7 @@ type AdSchedule struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	StartHour int32 `xml:"startHour,omitempty"`
+	StartHour *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startHour,omitempty" json:"startHour,omitempty"`
 
 	//
 	// Interval starts these minutes after the starting hour.
@@ -2638,7 +2636,7 @@ type AdSchedule struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	StartMinute *MinuteOfHour `xml:"startMinute,omitempty"`
+	StartMinute *MinuteOfHour `xml:"https://adwords.google.com/api/adwords/cm/v201802 startMinute,omitempty" json:"startMinute,omitempty"`
 
 	//
 	// Ending hour in 24 hour time; <code>24</code> signifies end of the day.
@@ -2647,7 +2645,7 @@ type AdSchedule struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	EndHour int32 `xml:"endHour,omitempty"`
+	EndHour *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 endHour,omitempty" json:"endHour,omitempty"`
 
 	//
 	// Interval ends these minutes after the ending hour.
@@ -2656,180 +2654,162 @@ type AdSchedule struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	EndMinute *MinuteOfHour `xml:"endMinute,omitempty"`
+	EndMinute *MinuteOfHour `xml:"https://adwords.google.com/api/adwords/cm/v201802 endMinute,omitempty" json:"endMinute,omitempty"`
 }
 
 type Address struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Address"`
-
 	//
 	// Street address line 1; <code>null</code> if unknown.
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	StreetAddress string `xml:"streetAddress,omitempty"`
+	StreetAddress *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 streetAddress,omitempty" json:"streetAddress,omitempty"`
 
 	//
 	// Street address line 2; <code>null</code> if unknown.
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	StreetAddress2 string `xml:"streetAddress2,omitempty"`
+	StreetAddress2 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 streetAddress2,omitempty" json:"streetAddress2,omitempty"`
 
 	//
 	// Name of the city; <code>null</code> if unknown.
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	CityName string `xml:"cityName,omitempty"`
+	CityName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 cityName,omitempty" json:"cityName,omitempty"`
 
 	//
 	// Province or state code; <code>null</code> if unknown.
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	ProvinceCode string `xml:"provinceCode,omitempty"`
+	ProvinceCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 provinceCode,omitempty" json:"provinceCode,omitempty"`
 
 	//
 	// Province or state name; <code>null</code> if unknown.
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	ProvinceName string `xml:"provinceName,omitempty"`
+	ProvinceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 provinceName,omitempty" json:"provinceName,omitempty"`
 
 	//
 	// Postal code; <code>null</code> if unknown.
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	PostalCode string `xml:"postalCode,omitempty"`
+	PostalCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 postalCode,omitempty" json:"postalCode,omitempty"`
 
 	//
 	// Country code; <code>null</code> if unknown.
 	//
-	CountryCode string `xml:"countryCode,omitempty"`
+	CountryCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 countryCode,omitempty" json:"countryCode,omitempty"`
 }
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AgeRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AgeRange"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "AgeRangeType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	AgeRangeType *AgeRangeAgeRangeType `xml:"ageRangeType,omitempty"`
+	AgeRangeType *AgeRangeAgeRangeType `xml:"https://adwords.google.com/api/adwords/cm/v201802 ageRangeType,omitempty" json:"ageRangeType,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CampaignCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignCriterion"`
-
 	//
 	// The campaign that the criterion is in.
 	// <span class="constraint Selectable">This field can be selected using the value "CampaignId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "IsNegative".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	IsNegative bool `xml:"isNegative,omitempty"`
+	IsNegative *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isNegative,omitempty" json:"isNegative,omitempty"`
 
 	//
 	// The criterion part of the campaign criterion.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Criterion *Criterion `xml:"criterion,omitempty"`
+	Criterion *Criterion `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterion,omitempty" json:"criterion,omitempty"`
 
 	//
 	// The modifier for bids when the criterion matches.
@@ -2841,13 +2821,13 @@ type CampaignCriterion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BidModifier".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint CampaignType">This field may not be set for campaign channel type SHOPPING with campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	BidModifier float64 `xml:"bidModifier,omitempty"`
+	BidModifier *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidModifier,omitempty" json:"bidModifier,omitempty"`
 
 	//
 	// The status for criteria.
 	// <span class="constraint Selectable">This field can be selected using the value "CampaignCriterionStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CampaignCriterionStatus *CampaignCriterionCampaignCriterionStatus `xml:"campaignCriterionStatus,omitempty"`
+	CampaignCriterionStatus *CampaignCriterionCampaignCriterionStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignCriterionStatus,omitempty" json:"campaignCriterionStatus,omitempty"`
 
 	//
 	// ID of the base campaign from which this draft/trial campaign criterion was created.
@@ -2855,7 +2835,7 @@ type CampaignCriterion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BaseCampaignId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BaseCampaignId int64 `xml:"baseCampaignId,omitempty"`
+	BaseCampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 baseCampaignId,omitempty" json:"baseCampaignId,omitempty"`
 
 	//
 	// This Map provides a place to put new features and settings in older versions
@@ -2864,65 +2844,55 @@ type CampaignCriterion struct {
 	//
 	// It is presently unused.  Do not set a value.
 	//
-	ForwardCompatibilityMap []*String_StringMapEntry `xml:"forwardCompatibilityMap,omitempty"`
+	ForwardCompatibilityMap []*String_StringMapEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 forwardCompatibilityMap,omitempty" json:"forwardCompatibilityMap,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of CampaignCriterion.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CampaignCriterionType string `xml:"CampaignCriterion.Type,omitempty"`
+	CampaignCriterionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignCriterion.Type,omitempty" json:"campaignCriterionType,omitempty"`
 }
 
 type CampaignCriterionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignCriterionError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CampaignCriterionErrorReason `xml:"reason,omitempty"`
+	Reason *CampaignCriterionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CampaignCriterionOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignCriterionOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *CampaignCriterion `xml:"operand,omitempty"`
+	Operand *CampaignCriterion `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type CampaignCriterionPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignCriterionPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*CampaignCriterion `xml:"entries,omitempty"`
+	Entries []*CampaignCriterion `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type CampaignCriterionReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignCriterionReturnValue"`
-
 	*ListReturnValue
 
-	Value []*CampaignCriterion `xml:"value,omitempty"`
+	Value []*CampaignCriterion `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// List of partial failure errors.
 	//
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type Carrier struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Carrier"`
-
 	*Criterion
 
 	//
@@ -2930,7 +2900,7 @@ type Carrier struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CarrierName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Country code of the carrier.
@@ -2938,57 +2908,51 @@ type Carrier struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CarrierCountryCode".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CountryCode string `xml:"countryCode,omitempty"`
+	CountryCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 countryCode,omitempty" json:"countryCode,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ConstantOperand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConstantOperand"`
-
 	*FunctionArgumentOperand
 
 	//
 	// Type of constant in this operand.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Type_ *ConstantOperandConstantType `xml:"type,omitempty"`
+	Type_ *ConstantOperandConstantType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Units of constant in this operand.
 	//
-	Unit *ConstantOperandUnit `xml:"unit,omitempty"`
+	Unit *ConstantOperandUnit `xml:"https://adwords.google.com/api/adwords/cm/v201802 unit,omitempty" json:"unit,omitempty"`
 
 	//
 	// Long value of the operand if it is a long type.
 	//
-	LongValue int64 `xml:"longValue,omitempty"`
+	LongValue *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 longValue,omitempty" json:"longValue,omitempty"`
 
 	//
 	// Boolean value of the operand if it is a boolean type.
 	//
-	BooleanValue bool `xml:"booleanValue,omitempty"`
+	BooleanValue *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 booleanValue,omitempty" json:"booleanValue,omitempty"`
 
 	//
 	// Double value of the operand if it is a double type.
 	//
-	DoubleValue float64 `xml:"doubleValue,omitempty"`
+	DoubleValue *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 doubleValue,omitempty" json:"doubleValue,omitempty"`
 
 	//
 	// String value of the operand if it is a string type.
 	//
-	StringValue string `xml:"stringValue,omitempty"`
+	StringValue *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 stringValue,omitempty" json:"stringValue,omitempty"`
 }
 
 type ContentLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ContentLabel"`
-
 	*Criterion
 
 	//
@@ -2996,164 +2960,144 @@ type ContentLabel struct {
 	// <span class="constraint Selectable">This field can be selected using the value "ContentLabelType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	ContentLabelType *ContentLabelType `xml:"contentLabelType,omitempty"`
+	ContentLabelType *ContentLabelType `xml:"https://adwords.google.com/api/adwords/cm/v201802 contentLabelType,omitempty" json:"contentLabelType,omitempty"`
 }
 
 type Criterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion"`
-
 	//
 	// ID of this criterion.
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "CriteriaType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Type_ *CriterionType `xml:"type,omitempty"`
+	Type_ *CriterionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Criterion.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CriterionType string `xml:"Criterion.Type,omitempty"`
+	CriterionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion.Type,omitempty" json:"criterionType,omitempty"`
 }
 
 type CriterionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionError"`
-
 	*ApiError
 
-	Reason *CriterionErrorReason `xml:"reason,omitempty"`
+	Reason *CriterionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CriterionParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionParameter"`
-
 	//
 	// Indicates that this instance is a subtype of CriterionParameter.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CriterionParameterType string `xml:"CriterionParameter.Type,omitempty"`
+	CriterionParameterType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionParameter.Type,omitempty" json:"criterionParameterType,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type EntityCountLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityCountLimitExceeded"`
-
 	*ApiError
 
 	//
 	// Specifies which level's limit was exceeded.
 	//
-	Reason *EntityCountLimitExceededReason `xml:"reason,omitempty"`
+	Reason *EntityCountLimitExceededReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Id of the entity whose limit was exceeded.
 	//
-	EnclosingId string `xml:"enclosingId,omitempty"`
+	EnclosingId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 enclosingId,omitempty" json:"enclosingId,omitempty"`
 
 	//
 	// The limit which was exceeded.
 	//
-	Limit int32 `xml:"limit,omitempty"`
+	Limit *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 limit,omitempty" json:"limit,omitempty"`
 
 	//
 	// The account limit type which was exceeded.
 	//
-	AccountLimitType string `xml:"accountLimitType,omitempty"`
+	AccountLimitType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 accountLimitType,omitempty" json:"accountLimitType,omitempty"`
 
 	//
 	// The count of existing entities.
 	//
-	ExistingCount int32 `xml:"existingCount,omitempty"`
+	ExistingCount *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 existingCount,omitempty" json:"existingCount,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type Function struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Function"`
-
 	//
 	// Operator for a function.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *FunctionOperator `xml:"operator,omitempty"`
+	Operator *FunctionOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Operand on the LHS in the equation. This is also the operand to be used for
 	// single operand expressions such as NOT.
 	// <span class="constraint CollectionSize">The minimum size of this collection is 1.</span>
 	//
-	LhsOperand []*FunctionArgumentOperand `xml:"lhsOperand,omitempty"`
+	LhsOperand []*FunctionArgumentOperand `xml:"https://adwords.google.com/api/adwords/cm/v201802 lhsOperand,omitempty" json:"lhsOperand,omitempty"`
 
 	//
 	// Operand on the RHS of the equation.
 	//
-	RhsOperand []*FunctionArgumentOperand `xml:"rhsOperand,omitempty"`
+	RhsOperand []*FunctionArgumentOperand `xml:"https://adwords.google.com/api/adwords/cm/v201802 rhsOperand,omitempty" json:"rhsOperand,omitempty"`
 
 	//
 	// String representation of the {@code Function}.
@@ -3167,51 +3111,43 @@ type Function struct {
 	// the same {@code Function} (whitespace and single versus double quotation marks, for example),
 	// the value returned may not be identical to the string sent in the request.
 	//
-	FunctionString string `xml:"functionString,omitempty"`
+	FunctionString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 functionString,omitempty" json:"functionString,omitempty"`
 }
 
 type FunctionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FunctionError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum
 	//
-	Reason *FunctionErrorReason `xml:"reason,omitempty"`
+	Reason *FunctionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Gender struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Gender"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "GenderType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	GenderType *GenderGenderType `xml:"genderType,omitempty"`
+	GenderType *GenderGenderType `xml:"https://adwords.google.com/api/adwords/cm/v201802 genderType,omitempty" json:"genderType,omitempty"`
 }
 
 type GeoPoint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 GeoPoint"`
-
 	//
 	// Micro degrees for the latitude.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	LatitudeInMicroDegrees int32 `xml:"latitudeInMicroDegrees,omitempty"`
+	LatitudeInMicroDegrees *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 latitudeInMicroDegrees,omitempty" json:"latitudeInMicroDegrees,omitempty"`
 
 	//
 	// Micro degrees for the longitude.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	LongitudeInMicroDegrees int32 `xml:"longitudeInMicroDegrees,omitempty"`
+	LongitudeInMicroDegrees *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 longitudeInMicroDegrees,omitempty" json:"longitudeInMicroDegrees,omitempty"`
 }
 
 type GeoTargetOperand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 GeoTargetOperand"`
-
 	*FunctionArgumentOperand
 
 	//
@@ -3219,23 +3155,19 @@ type GeoTargetOperand struct {
 	// <span class="constraint ContentsDistinct">This field must contain distinct elements.</span>
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Locations []int64 `xml:"locations,omitempty"`
+	Locations []int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 locations,omitempty" json:"locations,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type IncomeOperand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IncomeOperand"`
-
 	*FunctionArgumentOperand
 
 	//
@@ -3243,47 +3175,39 @@ type IncomeOperand struct {
 	// highest income bracket.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Tier *IncomeTier `xml:"tier,omitempty"`
+	Tier *IncomeTier `xml:"https://adwords.google.com/api/adwords/cm/v201802 tier,omitempty" json:"tier,omitempty"`
 }
 
 type IncomeRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IncomeRange"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "IncomeRangeType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	IncomeRangeType *IncomeRangeIncomeRangeType `xml:"incomeRangeType,omitempty"`
+	IncomeRangeType *IncomeRangeIncomeRangeType `xml:"https://adwords.google.com/api/adwords/cm/v201802 incomeRangeType,omitempty" json:"incomeRangeType,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type IpBlock struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IpBlock"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "IpAddress".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	IpAddress string `xml:"ipAddress,omitempty"`
+	IpAddress *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ipAddress,omitempty" json:"ipAddress,omitempty"`
 }
 
 type Keyword struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Keyword"`
-
 	*Criterion
 
 	//
@@ -3292,48 +3216,42 @@ type Keyword struct {
 	// <span class="constraint MatchesRegex">Keyword text must not contain NUL (code point 0x0) characters. This is checked by the regular expression '[^\x00]*'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Text string `xml:"text,omitempty"`
+	Text *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 text,omitempty" json:"text,omitempty"`
 
 	//
 	// Match type of this keyword.
 	// <span class="constraint Selectable">This field can be selected using the value "KeywordMatchType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MatchType *KeywordMatchType `xml:"matchType,omitempty"`
+	MatchType *KeywordMatchType `xml:"https://adwords.google.com/api/adwords/cm/v201802 matchType,omitempty" json:"matchType,omitempty"`
 }
 
 type Language struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Language"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "LanguageCode".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Code string `xml:"code,omitempty"`
+	Code *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 code,omitempty" json:"code,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "LanguageName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type Location struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Location"`
-
 	*Criterion
 
 	//
@@ -3343,33 +3261,31 @@ type Location struct {
 	// <span class="constraint Selectable">This field can be selected using the value "LocationName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	LocationName string `xml:"locationName,omitempty"`
+	LocationName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 locationName,omitempty" json:"locationName,omitempty"`
 
 	//
 	// Display type of the location criterion.
 	// <span class="constraint Selectable">This field can be selected using the value "DisplayType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayType string `xml:"displayType,omitempty"`
+	DisplayType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayType,omitempty" json:"displayType,omitempty"`
 
 	//
 	// The targeting status of the location criterion.
 	// <span class="constraint Selectable">This field can be selected using the value "TargetingStatus".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	TargetingStatus *LocationTargetingStatus `xml:"targetingStatus,omitempty"`
+	TargetingStatus *LocationTargetingStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetingStatus,omitempty" json:"targetingStatus,omitempty"`
 
 	//
 	// Ordered list of parents of the location criterion.
 	// <span class="constraint Selectable">This field can be selected using the value "ParentLocations".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ParentLocations []*Location `xml:"parentLocations,omitempty"`
+	ParentLocations []*Location `xml:"https://adwords.google.com/api/adwords/cm/v201802 parentLocations,omitempty" json:"parentLocations,omitempty"`
 }
 
 type LocationExtensionOperand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LocationExtensionOperand"`
-
 	*FunctionArgumentOperand
 
 	//
@@ -3377,17 +3293,15 @@ type LocationExtensionOperand struct {
 	// Only long and double are supported constant types.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Radius *ConstantOperand `xml:"radius,omitempty"`
+	Radius *ConstantOperand `xml:"https://adwords.google.com/api/adwords/cm/v201802 radius,omitempty" json:"radius,omitempty"`
 
 	//
 	// Used to filter locations present in the location feed by location criterion id.
 	//
-	LocationId int64 `xml:"locationId,omitempty"`
+	LocationId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 locationId,omitempty" json:"locationId,omitempty"`
 }
 
 type MobileAppCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileAppCategory"`
-
 	*Criterion
 
 	//
@@ -3396,18 +3310,16 @@ type MobileAppCategory struct {
 	// <span class="constraint Selectable">This field can be selected using the value "MobileAppCategoryId".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MobileAppCategoryId int32 `xml:"mobileAppCategoryId,omitempty"`
+	MobileAppCategoryId *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 mobileAppCategoryId,omitempty" json:"mobileAppCategoryId,omitempty"`
 
 	//
 	// Name of this mobile app category.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type MobileApplication struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileApplication"`
-
 	*Criterion
 
 	//
@@ -3426,99 +3338,85 @@ type MobileApplication struct {
 	// <span class="constraint Selectable">This field can be selected using the value "AppId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// Title of this mobile application.
 	// <span class="constraint Selectable">This field can be selected using the value "DisplayName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type MobileDevice struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileDevice"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "DeviceName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DeviceName string `xml:"deviceName,omitempty"`
+	DeviceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 deviceName,omitempty" json:"deviceName,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "ManufacturerName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ManufacturerName string `xml:"manufacturerName,omitempty"`
+	ManufacturerName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 manufacturerName,omitempty" json:"manufacturerName,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "DeviceType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DeviceType *MobileDeviceDeviceType `xml:"deviceType,omitempty"`
+	DeviceType *MobileDeviceDeviceType `xml:"https://adwords.google.com/api/adwords/cm/v201802 deviceType,omitempty" json:"deviceType,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "OperatingSystemName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	OperatingSystemName string `xml:"operatingSystemName,omitempty"`
+	OperatingSystemName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 operatingSystemName,omitempty" json:"operatingSystemName,omitempty"`
 }
 
 type NegativeCampaignCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NegativeCampaignCriterion"`
-
 	*CampaignCriterion
 }
 
 type NewEntityCreationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NewEntityCreationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NewEntityCreationErrorReason `xml:"reason,omitempty"`
+	Reason *NewEntityCreationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FunctionArgumentOperand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FunctionArgumentOperand"`
-
 	//
 	// Indicates that this instance is a subtype of FunctionArgumentOperand.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	FunctionArgumentOperandType string `xml:"FunctionArgumentOperand.Type,omitempty"`
+	FunctionArgumentOperandType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 FunctionArgumentOperand.Type,omitempty" json:"functionArgumentOperandType,omitempty"`
 }
 
 type OperatingSystemVersion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatingSystemVersion"`
-
 	*Criterion
 
 	//
@@ -3526,140 +3424,122 @@ type OperatingSystemVersion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "OperatingSystemName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// The OS Major Version number.
 	// <span class="constraint Selectable">This field can be selected using the value "OsMajorVersion".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	OsMajorVersion int32 `xml:"osMajorVersion,omitempty"`
+	OsMajorVersion *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 osMajorVersion,omitempty" json:"osMajorVersion,omitempty"`
 
 	//
 	// The OS Minor Version number.
 	// <span class="constraint Selectable">This field can be selected using the value "OsMinorVersion".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	OsMinorVersion int32 `xml:"osMinorVersion,omitempty"`
+	OsMinorVersion *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 osMinorVersion,omitempty" json:"osMinorVersion,omitempty"`
 
 	//
 	// The operator type.
 	// <span class="constraint Selectable">This field can be selected using the value "OperatorType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	OperatorType *OperatingSystemVersionOperatorType `xml:"operatorType,omitempty"`
+	OperatorType *OperatingSystemVersionOperatorType `xml:"https://adwords.google.com/api/adwords/cm/v201802 operatorType,omitempty" json:"operatorType,omitempty"`
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type PagingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PagingError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *PagingErrorReason `xml:"reason,omitempty"`
+	Reason *PagingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Parent struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Parent"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "ParentType".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ParentType *ParentParentType `xml:"parentType,omitempty"`
+	ParentType *ParentParentType `xml:"https://adwords.google.com/api/adwords/cm/v201802 parentType,omitempty" json:"parentType,omitempty"`
 }
 
 type Placement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Placement"`
-
 	*Criterion
 
 	//
@@ -3669,35 +3549,29 @@ type Placement struct {
 	// <span class="constraint Selectable">This field can be selected using the value "PlacementUrl".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 }
 
 type PlacesOfInterestOperand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PlacesOfInterestOperand"`
-
 	*FunctionArgumentOperand
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Category *PlacesOfInterestOperandCategory `xml:"category,omitempty"`
+	Category *PlacesOfInterestOperandCategory `xml:"https://adwords.google.com/api/adwords/cm/v201802 category,omitempty" json:"category,omitempty"`
 }
 
 type Platform struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Platform"`
-
 	*Criterion
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "PlatformName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PlatformName string `xml:"platformName,omitempty"`
+	PlatformName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 platformName,omitempty" json:"platformName,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -3705,13 +3579,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -3720,34 +3594,28 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type ProductAdwordsGrouping struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductAdwordsGrouping"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductAdwordsLabels struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductAdwordsLabels"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductBiddingCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductBiddingCategory"`
-
 	*ProductDimension
 
 	//
@@ -3756,61 +3624,49 @@ type ProductBiddingCategory struct {
 	// <span class="constraint OneOf">The value must be one of {BIDDING_CATEGORY_L1, BIDDING_CATEGORY_L2, BIDDING_CATEGORY_L3, BIDDING_CATEGORY_L4, BIDDING_CATEGORY_L5}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// ID of the product category.
 	// <span class="constraint Filterable">This field can be filtered on using the value "ParentDimensionId".</span>
 	//
-	Value int64 `xml:"value,omitempty"`
+	Value *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductBrand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductBrand"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductCanonicalCondition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductCanonicalCondition"`
-
 	*ProductDimension
 
-	Condition *ProductCanonicalConditionCondition `xml:"condition,omitempty"`
+	Condition *ProductCanonicalConditionCondition `xml:"https://adwords.google.com/api/adwords/cm/v201802 condition,omitempty" json:"condition,omitempty"`
 }
 
 type ProductChannel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductChannel"`
-
 	*ProductDimension
 
-	Channel *ShoppingProductChannel `xml:"channel,omitempty"`
+	Channel *ShoppingProductChannel `xml:"https://adwords.google.com/api/adwords/cm/v201802 channel,omitempty" json:"channel,omitempty"`
 }
 
 type ProductChannelExclusivity struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductChannelExclusivity"`
-
 	*ProductDimension
 
-	ChannelExclusivity *ShoppingProductChannelExclusivity `xml:"channelExclusivity,omitempty"`
+	ChannelExclusivity *ShoppingProductChannelExclusivity `xml:"https://adwords.google.com/api/adwords/cm/v201802 channelExclusivity,omitempty" json:"channelExclusivity,omitempty"`
 }
 
 type ProductLegacyCondition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductLegacyCondition"`
-
 	*ProductDimension
 
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductCustomAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductCustomAttribute"`
-
 	*ProductDimension
 
 	//
@@ -3818,39 +3674,33 @@ type ProductCustomAttribute struct {
 	// <span class="constraint OneOf">The value must be one of {CUSTOM_ATTRIBUTE_0, CUSTOM_ATTRIBUTE_1, CUSTOM_ATTRIBUTE_2, CUSTOM_ATTRIBUTE_3, CUSTOM_ATTRIBUTE_4}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductDimension struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductDimension"`
-
 	//
 	// Indicates that this instance is a subtype of ProductDimension.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ProductDimensionType string `xml:"ProductDimension.Type,omitempty"`
+	ProductDimensionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductDimension.Type,omitempty" json:"productDimensionType,omitempty"`
 }
 
 type ProductOfferId struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductOfferId"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductScope struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductScope"`
-
 	*Criterion
 
 	//
@@ -3858,12 +3708,10 @@ type ProductScope struct {
 	// <span class="constraint NotEmptyForOperators">This field must contain at least one element when it is contained within {@link Operator}s: ADD.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Dimensions []*ProductDimension `xml:"dimensions,omitempty"`
+	Dimensions []*ProductDimension `xml:"https://adwords.google.com/api/adwords/cm/v201802 dimensions,omitempty" json:"dimensions,omitempty"`
 }
 
 type ProductType struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductType"`
-
 	*ProductDimension
 
 	//
@@ -3871,167 +3719,143 @@ type ProductType struct {
 	// <span class="constraint OneOf">The value must be one of {PRODUCT_TYPE_L1, PRODUCT_TYPE_L2, PRODUCT_TYPE_L3, PRODUCT_TYPE_L4, PRODUCT_TYPE_L5}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductTypeFull struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductTypeFull"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type Proximity struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Proximity"`
-
 	*Criterion
 
 	//
 	// Latitude and longitude.
 	// <span class="constraint Selectable">This field can be selected using the value "GeoPoint".</span>
 	//
-	GeoPoint *GeoPoint `xml:"geoPoint,omitempty"`
+	GeoPoint *GeoPoint `xml:"https://adwords.google.com/api/adwords/cm/v201802 geoPoint,omitempty" json:"geoPoint,omitempty"`
 
 	//
 	// Radius distance units.
 	// <span class="constraint Selectable">This field can be selected using the value "RadiusDistanceUnits".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	RadiusDistanceUnits *ProximityDistanceUnits `xml:"radiusDistanceUnits,omitempty"`
+	RadiusDistanceUnits *ProximityDistanceUnits `xml:"https://adwords.google.com/api/adwords/cm/v201802 radiusDistanceUnits,omitempty" json:"radiusDistanceUnits,omitempty"`
 
 	//
 	// Radius expressed in distance units.
 	// <span class="constraint Selectable">This field can be selected using the value "RadiusInUnits".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	RadiusInUnits float64 `xml:"radiusInUnits,omitempty"`
+	RadiusInUnits *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 radiusInUnits,omitempty" json:"radiusInUnits,omitempty"`
 
 	//
 	// Full address; <code>null</code> if unknonwn.
 	// <span class="constraint Selectable">This field can be selected using the value "Address".</span>
 	//
-	Address *Address `xml:"address,omitempty"`
+	Address *Address `xml:"https://adwords.google.com/api/adwords/cm/v201802 address,omitempty" json:"address,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RegionCodeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RegionCodeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RegionCodeErrorReason `xml:"reason,omitempty"`
+	Reason *RegionCodeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -4042,13 +3866,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -4057,42 +3881,38 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type LocationGroups struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LocationGroups"`
-
 	*Criterion
 
 	//
 	// Feed to be used for targeting around locations. This is required for distance targets.
 	// <span class="constraint Selectable">This field can be selected using the value "FeedId".</span>
 	//
-	FeedId int64 `xml:"feedId,omitempty"`
+	FeedId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedId,omitempty" json:"feedId,omitempty"`
 
 	//
 	// Matching function to filter out locations targeted by the criteria.
@@ -4101,35 +3921,33 @@ type LocationGroups struct {
 	// <span class="constraint Selectable">This field can be selected using the value "MatchingFunction".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MatchingFunction *Function `xml:"matchingFunction,omitempty"`
+	MatchingFunction *Function `xml:"https://adwords.google.com/api/adwords/cm/v201802 matchingFunction,omitempty" json:"matchingFunction,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -4137,12 +3955,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -4150,118 +3968,106 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type String_StringMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 String_StringMapEntry"`
-
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type UnknownProductDimension struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UnknownProductDimension"`
-
 	*ProductDimension
 }
 
 type CriterionUserInterest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserInterest"`
-
 	*Criterion
 
 	//
 	// Id of this user interest. This is a required field.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestId".</span>
 	//
-	UserInterestId int64 `xml:"userInterestId,omitempty"`
+	UserInterestId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestId,omitempty" json:"userInterestId,omitempty"`
 
 	//
 	// Parent Id of this user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestParentId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserInterestParentId int64 `xml:"userInterestParentId,omitempty"`
+	UserInterestParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestParentId,omitempty" json:"userInterestParentId,omitempty"`
 
 	//
 	// Name of this user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestName".</span>
 	//
-	UserInterestName string `xml:"userInterestName,omitempty"`
+	UserInterestName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestName,omitempty" json:"userInterestName,omitempty"`
 }
 
 type CriterionUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserList"`
-
 	*Criterion
 
 	//
 	// Id of this user list. This is a required field.
 	// <span class="constraint Selectable">This field can be selected using the value "UserListId".</span>
 	//
-	UserListId int64 `xml:"userListId,omitempty"`
+	UserListId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListId,omitempty" json:"userListId,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "UserListName".</span>
 	//
-	UserListName string `xml:"userListName,omitempty"`
+	UserListName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListName,omitempty" json:"userListName,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "UserListMembershipStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"userListMembershipStatus,omitempty"`
+	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListMembershipStatus,omitempty" json:"userListMembershipStatus,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the google.com
@@ -4269,33 +4075,31 @@ type CriterionUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "UserListEligibleForSearch".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForSearch bool `xml:"userListEligibleForSearch,omitempty"`
+	UserListEligibleForSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForSearch,omitempty" json:"userListEligibleForSearch,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the display network.
 	// <span class="constraint Selectable">This field can be selected using the value "UserListEligibleForDisplay".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForDisplay bool `xml:"userListEligibleForDisplay,omitempty"`
+	UserListEligibleForDisplay *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForDisplay,omitempty" json:"userListEligibleForDisplay,omitempty"`
 }
 
 type Vertical struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Vertical"`
-
 	*Criterion
 
 	//
 	// Id of this vertical.
 	// <span class="constraint Selectable">This field can be selected using the value "VerticalId".</span>
 	//
-	VerticalId int64 `xml:"verticalId,omitempty"`
+	VerticalId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalId,omitempty" json:"verticalId,omitempty"`
 
 	//
 	// Id of the parent of this vertical.
 	// <span class="constraint Selectable">This field can be selected using the value "VerticalParentId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	VerticalParentId int64 `xml:"verticalParentId,omitempty"`
+	VerticalParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalParentId,omitempty" json:"verticalParentId,omitempty"`
 
 	//
 	// The category to target or exclude. Each subsequent element in the array
@@ -4306,12 +4110,10 @@ type Vertical struct {
 	// This field is required and must not be empty.
 	// <span class="constraint Selectable">This field can be selected using the value "Path".</span>
 	//
-	Path []string `xml:"path,omitempty"`
+	Path []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 path,omitempty" json:"path,omitempty"`
 }
 
 type Webpage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Webpage"`
-
 	*Criterion
 
 	//
@@ -4319,30 +4121,28 @@ type Webpage struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Parameter".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Parameter *WebpageParameter `xml:"parameter,omitempty"`
+	Parameter *WebpageParameter `xml:"https://adwords.google.com/api/adwords/cm/v201802 parameter,omitempty" json:"parameter,omitempty"`
 
 	//
 	// Keywordless criteria coverage - Computed percentage of website coverage based on the
 	// website target, negative website targets and negative keywords in the ad group and campaign.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CriteriaCoverage float64 `xml:"criteriaCoverage,omitempty"`
+	CriteriaCoverage *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 criteriaCoverage,omitempty" json:"criteriaCoverage,omitempty"`
 
 	//
 	// Keywordless criteria samples - List of sample urls that matches with the website target.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CriteriaSamples []string `xml:"criteriaSamples,omitempty"`
+	CriteriaSamples []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 criteriaSamples,omitempty" json:"criteriaSamples,omitempty"`
 }
 
 type WebpageCondition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 WebpageCondition"`
-
 	//
 	// Operand of webpage targeting condition.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *WebpageConditionOperand `xml:"operand,omitempty"`
+	Operand *WebpageConditionOperand `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 
 	//
 	// Argument of the webpage targeting condition.
@@ -4350,12 +4150,10 @@ type WebpageCondition struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 2048, inclusive.</span>
 	//
-	Argument string `xml:"argument,omitempty"`
+	Argument *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 argument,omitempty" json:"argument,omitempty"`
 }
 
 type WebpageParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 WebpageParameter"`
-
 	*CriterionParameter
 
 	//
@@ -4368,7 +4166,7 @@ type WebpageParameter struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 2048, inclusive.</span>
 	//
-	CriterionName string `xml:"criterionName,omitempty"`
+	CriterionName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterionName,omitempty" json:"criterionName,omitempty"`
 
 	//
 	// Conditions, or logical expressions, for webpage targeting.
@@ -4379,12 +4177,10 @@ type WebpageParameter struct {
 	// <span class="constraint CollectionSize">The maximum size of this collection is 3.</span>
 	// <span class="constraint ContentsDistinct">This field must contain distinct elements.</span>
 	//
-	Conditions []*WebpageCondition `xml:"conditions,omitempty"`
+	Conditions []*WebpageCondition `xml:"https://adwords.google.com/api/adwords/cm/v201802 conditions,omitempty" json:"conditions,omitempty"`
 }
 
 type YouTubeChannel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 YouTubeChannel"`
-
 	*Criterion
 
 	//
@@ -4395,33 +4191,31 @@ type YouTubeChannel struct {
 	// <p>For more information see: https://support.google.com/youtube/answer/6180214
 	// <span class="constraint Selectable">This field can be selected using the value "ChannelId".</span>
 	//
-	ChannelId string `xml:"channelId,omitempty"`
+	ChannelId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 channelId,omitempty" json:"channelId,omitempty"`
 
 	//
 	// The public name for a YouTube user channel.
 	// <span class="constraint Selectable">This field can be selected using the value "ChannelName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ChannelName string `xml:"channelName,omitempty"`
+	ChannelName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 channelName,omitempty" json:"channelName,omitempty"`
 }
 
 type YouTubeVideo struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 YouTubeVideo"`
-
 	*Criterion
 
 	//
 	// YouTube video id as it appears on the YouTube watch page.
 	// <span class="constraint Selectable">This field can be selected using the value "VideoId".</span>
 	//
-	VideoId string `xml:"videoId,omitempty"`
+	VideoId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 videoId,omitempty" json:"videoId,omitempty"`
 
 	//
 	// Name of the video.
 	// <span class="constraint Selectable">This field can be selected using the value "VideoName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	VideoName string `xml:"videoName,omitempty"`
+	VideoName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 videoName,omitempty" json:"videoName,omitempty"`
 }
 
 type CampaignCriterionServiceInterface struct {
@@ -0,0 +1,1590 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignCriterionService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdSchedule) Copy() *AdSchedule {
+	return deep.Copy(t).(*AdSchedule)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdSchedule) Equal(o *AdSchedule) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Address) Copy() *Address {
+	return deep.Copy(t).(*Address)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Address) Equal(o *Address) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdxError) Copy() *AdxError {
+	return deep.Copy(t).(*AdxError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdxError) Equal(o *AdxError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AgeRange) Copy() *AgeRange {
+	return deep.Copy(t).(*AgeRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AgeRange) Equal(o *AgeRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignCriterion) Copy() *CampaignCriterion {
+	return deep.Copy(t).(*CampaignCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignCriterion) Equal(o *CampaignCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignCriterionError) Copy() *CampaignCriterionError {
+	return deep.Copy(t).(*CampaignCriterionError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignCriterionError) Equal(o *CampaignCriterionError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignCriterionOperation) Copy() *CampaignCriterionOperation {
+	return deep.Copy(t).(*CampaignCriterionOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignCriterionOperation) Equal(o *CampaignCriterionOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignCriterionPage) Copy() *CampaignCriterionPage {
+	return deep.Copy(t).(*CampaignCriterionPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignCriterionPage) Equal(o *CampaignCriterionPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignCriterionReturnValue) Copy() *CampaignCriterionReturnValue {
+	return deep.Copy(t).(*CampaignCriterionReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignCriterionReturnValue) Equal(o *CampaignCriterionReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Carrier) Copy() *Carrier {
+	return deep.Copy(t).(*Carrier)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Carrier) Equal(o *Carrier) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ConstantOperand) Copy() *ConstantOperand {
+	return deep.Copy(t).(*ConstantOperand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ConstantOperand) Equal(o *ConstantOperand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ContentLabel) Copy() *ContentLabel {
+	return deep.Copy(t).(*ContentLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ContentLabel) Equal(o *ContentLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Criterion) Copy() *Criterion {
+	return deep.Copy(t).(*Criterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Criterion) Equal(o *Criterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionError) Copy() *CriterionError {
+	return deep.Copy(t).(*CriterionError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionError) Equal(o *CriterionError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionParameter) Copy() *CriterionParameter {
+	return deep.Copy(t).(*CriterionParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionParameter) Equal(o *CriterionParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityCountLimitExceeded) Copy() *EntityCountLimitExceeded {
+	return deep.Copy(t).(*EntityCountLimitExceeded)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityCountLimitExceeded) Equal(o *EntityCountLimitExceeded) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityNotFound) Copy() *EntityNotFound {
+	return deep.Copy(t).(*EntityNotFound)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityNotFound) Equal(o *EntityNotFound) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Function) Copy() *Function {
+	return deep.Copy(t).(*Function)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Function) Equal(o *Function) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FunctionError) Copy() *FunctionError {
+	return deep.Copy(t).(*FunctionError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FunctionError) Equal(o *FunctionError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Gender) Copy() *Gender {
+	return deep.Copy(t).(*Gender)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Gender) Equal(o *Gender) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GeoPoint) Copy() *GeoPoint {
+	return deep.Copy(t).(*GeoPoint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GeoPoint) Equal(o *GeoPoint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GeoTargetOperand) Copy() *GeoTargetOperand {
+	return deep.Copy(t).(*GeoTargetOperand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GeoTargetOperand) Equal(o *GeoTargetOperand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IncomeOperand) Copy() *IncomeOperand {
+	return deep.Copy(t).(*IncomeOperand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IncomeOperand) Equal(o *IncomeOperand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IncomeRange) Copy() *IncomeRange {
+	return deep.Copy(t).(*IncomeRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IncomeRange) Equal(o *IncomeRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IpBlock) Copy() *IpBlock {
+	return deep.Copy(t).(*IpBlock)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IpBlock) Equal(o *IpBlock) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Keyword) Copy() *Keyword {
+	return deep.Copy(t).(*Keyword)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Keyword) Equal(o *Keyword) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Language) Copy() *Language {
+	return deep.Copy(t).(*Language)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Language) Equal(o *Language) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Location) Copy() *Location {
+	return deep.Copy(t).(*Location)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Location) Equal(o *Location) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LocationExtensionOperand) Copy() *LocationExtensionOperand {
+	return deep.Copy(t).(*LocationExtensionOperand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LocationExtensionOperand) Equal(o *LocationExtensionOperand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileAppCategory) Copy() *MobileAppCategory {
+	return deep.Copy(t).(*MobileAppCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileAppCategory) Equal(o *MobileAppCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileApplication) Copy() *MobileApplication {
+	return deep.Copy(t).(*MobileApplication)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileApplication) Equal(o *MobileApplication) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileDevice) Copy() *MobileDevice {
+	return deep.Copy(t).(*MobileDevice)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileDevice) Equal(o *MobileDevice) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NegativeCampaignCriterion) Copy() *NegativeCampaignCriterion {
+	return deep.Copy(t).(*NegativeCampaignCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NegativeCampaignCriterion) Equal(o *NegativeCampaignCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NewEntityCreationError) Copy() *NewEntityCreationError {
+	return deep.Copy(t).(*NewEntityCreationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NewEntityCreationError) Equal(o *NewEntityCreationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FunctionArgumentOperand) Copy() *FunctionArgumentOperand {
+	return deep.Copy(t).(*FunctionArgumentOperand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FunctionArgumentOperand) Equal(o *FunctionArgumentOperand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatingSystemVersion) Copy() *OperatingSystemVersion {
+	return deep.Copy(t).(*OperatingSystemVersion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatingSystemVersion) Equal(o *OperatingSystemVersion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PagingError) Copy() *PagingError {
+	return deep.Copy(t).(*PagingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PagingError) Equal(o *PagingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Parent) Copy() *Parent {
+	return deep.Copy(t).(*Parent)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Parent) Equal(o *Parent) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Placement) Copy() *Placement {
+	return deep.Copy(t).(*Placement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Placement) Equal(o *Placement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PlacesOfInterestOperand) Copy() *PlacesOfInterestOperand {
+	return deep.Copy(t).(*PlacesOfInterestOperand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PlacesOfInterestOperand) Equal(o *PlacesOfInterestOperand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Platform) Copy() *Platform {
+	return deep.Copy(t).(*Platform)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Platform) Equal(o *Platform) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductAdwordsGrouping) Copy() *ProductAdwordsGrouping {
+	return deep.Copy(t).(*ProductAdwordsGrouping)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductAdwordsGrouping) Equal(o *ProductAdwordsGrouping) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductAdwordsLabels) Copy() *ProductAdwordsLabels {
+	return deep.Copy(t).(*ProductAdwordsLabels)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductAdwordsLabels) Equal(o *ProductAdwordsLabels) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductBiddingCategory) Copy() *ProductBiddingCategory {
+	return deep.Copy(t).(*ProductBiddingCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductBiddingCategory) Equal(o *ProductBiddingCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductBrand) Copy() *ProductBrand {
+	return deep.Copy(t).(*ProductBrand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductBrand) Equal(o *ProductBrand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductCanonicalCondition) Copy() *ProductCanonicalCondition {
+	return deep.Copy(t).(*ProductCanonicalCondition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductCanonicalCondition) Equal(o *ProductCanonicalCondition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductChannel) Copy() *ProductChannel {
+	return deep.Copy(t).(*ProductChannel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductChannel) Equal(o *ProductChannel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductChannelExclusivity) Copy() *ProductChannelExclusivity {
+	return deep.Copy(t).(*ProductChannelExclusivity)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductChannelExclusivity) Equal(o *ProductChannelExclusivity) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductLegacyCondition) Copy() *ProductLegacyCondition {
+	return deep.Copy(t).(*ProductLegacyCondition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductLegacyCondition) Equal(o *ProductLegacyCondition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductCustomAttribute) Copy() *ProductCustomAttribute {
+	return deep.Copy(t).(*ProductCustomAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductCustomAttribute) Equal(o *ProductCustomAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductDimension) Copy() *ProductDimension {
+	return deep.Copy(t).(*ProductDimension)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductDimension) Equal(o *ProductDimension) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductOfferId) Copy() *ProductOfferId {
+	return deep.Copy(t).(*ProductOfferId)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductOfferId) Equal(o *ProductOfferId) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductScope) Copy() *ProductScope {
+	return deep.Copy(t).(*ProductScope)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductScope) Equal(o *ProductScope) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductType) Copy() *ProductType {
+	return deep.Copy(t).(*ProductType)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductType) Equal(o *ProductType) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductTypeFull) Copy() *ProductTypeFull {
+	return deep.Copy(t).(*ProductTypeFull)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductTypeFull) Equal(o *ProductTypeFull) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Proximity) Copy() *Proximity {
+	return deep.Copy(t).(*Proximity)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Proximity) Equal(o *Proximity) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RegionCodeError) Copy() *RegionCodeError {
+	return deep.Copy(t).(*RegionCodeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RegionCodeError) Equal(o *RegionCodeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LocationGroups) Copy() *LocationGroups {
+	return deep.Copy(t).(*LocationGroups)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LocationGroups) Equal(o *LocationGroups) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *String_StringMapEntry) Copy() *String_StringMapEntry {
+	return deep.Copy(t).(*String_StringMapEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *String_StringMapEntry) Equal(o *String_StringMapEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UnknownProductDimension) Copy() *UnknownProductDimension {
+	return deep.Copy(t).(*UnknownProductDimension)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UnknownProductDimension) Equal(o *UnknownProductDimension) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionUserInterest) Copy() *CriterionUserInterest {
+	return deep.Copy(t).(*CriterionUserInterest)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionUserInterest) Equal(o *CriterionUserInterest) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionUserList) Copy() *CriterionUserList {
+	return deep.Copy(t).(*CriterionUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionUserList) Equal(o *CriterionUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Vertical) Copy() *Vertical {
+	return deep.Copy(t).(*Vertical)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Vertical) Equal(o *Vertical) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Webpage) Copy() *Webpage {
+	return deep.Copy(t).(*Webpage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Webpage) Equal(o *Webpage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *WebpageCondition) Copy() *WebpageCondition {
+	return deep.Copy(t).(*WebpageCondition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *WebpageCondition) Equal(o *WebpageCondition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *WebpageParameter) Copy() *WebpageParameter {
+	return deep.Copy(t).(*WebpageParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *WebpageParameter) Equal(o *WebpageParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *YouTubeChannel) Copy() *YouTubeChannel {
+	return deep.Copy(t).(*YouTubeChannel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *YouTubeChannel) Equal(o *YouTubeChannel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *YouTubeVideo) Copy() *YouTubeVideo {
+	return deep.Copy(t).(*YouTubeVideo)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *YouTubeVideo) Equal(o *YouTubeVideo) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffAdSchedule compares from, as fetched from the API, with the desired
+// to. It returns a AdSchedule holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdSchedule(from, to *AdSchedule) (*AdSchedule, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*AdSchedule)
+	return set, changes
+}
+
+// DiffAgeRange compares from, as fetched from the API, with the desired
+// to. It returns a AgeRange holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAgeRange(from, to *AgeRange) (*AgeRange, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*AgeRange)
+	return set, changes
+}
+
+// DiffCampaignCriterion compares from, as fetched from the API, with the desired
+// to. It returns a CampaignCriterion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCampaignCriterion(from, to *CampaignCriterion) (*CampaignCriterion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"BidModifier", "CampaignCriterionStatus", "ForwardCompatibilityMap"},
+		[]string{"CampaignId", "Criterion"},
+	).(*CampaignCriterion)
+	return set, changes
+}
+
+// SetCampaignCriterionOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffCampaignCriterion.
+func SetCampaignCriterionOperation(from, to *CampaignCriterion) (*CampaignCriterionOperation, []deep.Change) {
+	set, changes := DiffCampaignCriterion(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &CampaignCriterionOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+
+// DiffCarrier compares from, as fetched from the API, with the desired
+// to. It returns a Carrier holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCarrier(from, to *Carrier) (*Carrier, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Carrier)
+	return set, changes
+}
+
+// DiffContentLabel compares from, as fetched from the API, with the desired
+// to. It returns a ContentLabel holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffContentLabel(from, to *ContentLabel) (*ContentLabel, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"ContentLabelType"},
+		[]string{"Id"},
+	).(*ContentLabel)
+	return set, changes
+}
+
+// DiffCriterion compares from, as fetched from the API, with the desired
+// to. It returns a Criterion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCriterion(from, to *Criterion) (*Criterion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Criterion)
+	return set, changes
+}
+
+// DiffGender compares from, as fetched from the API, with the desired
+// to. It returns a Gender holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffGender(from, to *Gender) (*Gender, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Gender)
+	return set, changes
+}
+
+// DiffIncomeRange compares from, as fetched from the API, with the desired
+// to. It returns a IncomeRange holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffIncomeRange(from, to *IncomeRange) (*IncomeRange, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*IncomeRange)
+	return set, changes
+}
+
+// DiffIpBlock compares from, as fetched from the API, with the desired
+// to. It returns a IpBlock holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffIpBlock(from, to *IpBlock) (*IpBlock, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"IpAddress"},
+		[]string{"Id"},
+	).(*IpBlock)
+	return set, changes
+}
+
+// DiffKeyword compares from, as fetched from the API, with the desired
+// to. It returns a Keyword holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffKeyword(from, to *Keyword) (*Keyword, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Text", "MatchType"},
+		[]string{"Id"},
+	).(*Keyword)
+	return set, changes
+}
+
+// DiffLanguage compares from, as fetched from the API, with the desired
+// to. It returns a Language holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffLanguage(from, to *Language) (*Language, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Language)
+	return set, changes
+}
+
+// DiffLocation compares from, as fetched from the API, with the desired
+// to. It returns a Location holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffLocation(from, to *Location) (*Location, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Location)
+	return set, changes
+}
+
+// DiffMobileAppCategory compares from, as fetched from the API, with the desired
+// to. It returns a MobileAppCategory holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMobileAppCategory(from, to *MobileAppCategory) (*MobileAppCategory, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"MobileAppCategoryId"},
+		[]string{"Id"},
+	).(*MobileAppCategory)
+	return set, changes
+}
+
+// DiffMobileApplication compares from, as fetched from the API, with the desired
+// to. It returns a MobileApplication holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMobileApplication(from, to *MobileApplication) (*MobileApplication, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"AppId"},
+		[]string{"Id"},
+	).(*MobileApplication)
+	return set, changes
+}
+
+// DiffMobileDevice compares from, as fetched from the API, with the desired
+// to. It returns a MobileDevice holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMobileDevice(from, to *MobileDevice) (*MobileDevice, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*MobileDevice)
+	return set, changes
+}
+
+// DiffNegativeCampaignCriterion compares from, as fetched from the API, with the desired
+// to. It returns a NegativeCampaignCriterion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffNegativeCampaignCriterion(from, to *NegativeCampaignCriterion) (*NegativeCampaignCriterion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"BidModifier", "CampaignCriterionStatus", "ForwardCompatibilityMap"},
+		[]string{"CampaignId", "Criterion"},
+	).(*NegativeCampaignCriterion)
+	return set, changes
+}
+
+// DiffOperatingSystemVersion compares from, as fetched from the API, with the desired
+// to. It returns a OperatingSystemVersion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffOperatingSystemVersion(from, to *OperatingSystemVersion) (*OperatingSystemVersion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*OperatingSystemVersion)
+	return set, changes
+}
+
+// DiffParent compares from, as fetched from the API, with the desired
+// to. It returns a Parent holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffParent(from, to *Parent) (*Parent, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Parent)
+	return set, changes
+}
+
+// DiffPlacement compares from, as fetched from the API, with the desired
+// to. It returns a Placement holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffPlacement(from, to *Placement) (*Placement, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Url"},
+		[]string{"Id"},
+	).(*Placement)
+	return set, changes
+}
+
+// DiffPlatform compares from, as fetched from the API, with the desired
+// to. It returns a Platform holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffPlatform(from, to *Platform) (*Platform, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*Platform)
+	return set, changes
+}
+
+// DiffProductScope compares from, as fetched from the API, with the desired
+// to. It returns a ProductScope holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffProductScope(from, to *ProductScope) (*ProductScope, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Dimensions"},
+		[]string{"Id"},
+	).(*ProductScope)
+	return set, changes
+}
+
+// DiffProximity compares from, as fetched from the API, with the desired
+// to. It returns a Proximity holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffProximity(from, to *Proximity) (*Proximity, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"GeoPoint", "RadiusDistanceUnits", "RadiusInUnits", "Address"},
+		[]string{"Id"},
+	).(*Proximity)
+	return set, changes
+}
+
+// DiffLocationGroups compares from, as fetched from the API, with the desired
+// to. It returns a LocationGroups holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffLocationGroups(from, to *LocationGroups) (*LocationGroups, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"FeedId", "MatchingFunction"},
+		[]string{"Id"},
+	).(*LocationGroups)
+	return set, changes
+}
+
+// DiffCriterionUserInterest compares from, as fetched from the API, with the desired
+// to. It returns a CriterionUserInterest holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCriterionUserInterest(from, to *CriterionUserInterest) (*CriterionUserInterest, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"UserInterestName"},
+		[]string{"Id", "UserInterestId"},
+	).(*CriterionUserInterest)
+	return set, changes
+}
+
+// DiffCriterionUserList compares from, as fetched from the API, with the desired
+// to. It returns a CriterionUserList holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCriterionUserList(from, to *CriterionUserList) (*CriterionUserList, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"UserListName", "UserListMembershipStatus"},
+		[]string{"Id", "UserListId"},
+	).(*CriterionUserList)
+	return set, changes
+}
+
+// DiffVertical compares from, as fetched from the API, with the desired
+// to. It returns a Vertical holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffVertical(from, to *Vertical) (*Vertical, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Path"},
+		[]string{"Id", "VerticalId"},
+	).(*Vertical)
+	return set, changes
+}
+
+// DiffWebpage compares from, as fetched from the API, with the desired
+// to. It returns a Webpage holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffWebpage(from, to *Webpage) (*Webpage, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Parameter"},
+		[]string{"Id"},
+	).(*Webpage)
+	return set, changes
+}
+
+// DiffYouTubeChannel compares from, as fetched from the API, with the desired
+// to. It returns a YouTubeChannel holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffYouTubeChannel(from, to *YouTubeChannel) (*YouTubeChannel, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"ChannelId"},
+		[]string{"Id"},
+	).(*YouTubeChannel)
+	return set, changes
+}
+
+// DiffYouTubeVideo compares from, as fetched from the API, with the desired
+// to. It returns a YouTubeVideo holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffYouTubeVideo(from, to *YouTubeVideo) (*YouTubeVideo, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"VideoId"},
+		[]string{"Id"},
+	).(*YouTubeVideo)
+	return set, changes
+}
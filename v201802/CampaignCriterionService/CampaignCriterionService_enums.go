@@ -0,0 +1,3341 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignCriterionService
+
+import "fmt"
+
+// AdxErrorReasonValues returns all values of AdxErrorReason.
+func AdxErrorReasonValues() []AdxErrorReason {
+	return []AdxErrorReason{
+		AdxErrorReasonUNSUPPORTED_FEATURE,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdxErrorReason.
+func (e AdxErrorReason) IsValid() bool {
+	switch e {
+	case AdxErrorReasonUNSUPPORTED_FEATURE:
+		return true
+	}
+	return false
+}
+
+// ParseAdxErrorReason returns the AdxErrorReason named by s.
+func ParseAdxErrorReason(s string) (AdxErrorReason, error) {
+	e := AdxErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdxErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdxErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdxErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdxErrorReason) UnmarshalText(text []byte) error {
+	v := AdxErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AgeRangeAgeRangeTypeValues returns all values of AgeRangeAgeRangeType.
+func AgeRangeAgeRangeTypeValues() []AgeRangeAgeRangeType {
+	return []AgeRangeAgeRangeType{
+		AgeRangeAgeRangeTypeAGE_RANGE_18_24,
+		AgeRangeAgeRangeTypeAGE_RANGE_25_34,
+		AgeRangeAgeRangeTypeAGE_RANGE_35_44,
+		AgeRangeAgeRangeTypeAGE_RANGE_45_54,
+		AgeRangeAgeRangeTypeAGE_RANGE_55_64,
+		AgeRangeAgeRangeTypeAGE_RANGE_65_UP,
+		AgeRangeAgeRangeTypeAGE_RANGE_UNDETERMINED,
+		AgeRangeAgeRangeTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AgeRangeAgeRangeType.
+func (e AgeRangeAgeRangeType) IsValid() bool {
+	switch e {
+	case AgeRangeAgeRangeTypeAGE_RANGE_18_24,
+		AgeRangeAgeRangeTypeAGE_RANGE_25_34,
+		AgeRangeAgeRangeTypeAGE_RANGE_35_44,
+		AgeRangeAgeRangeTypeAGE_RANGE_45_54,
+		AgeRangeAgeRangeTypeAGE_RANGE_55_64,
+		AgeRangeAgeRangeTypeAGE_RANGE_65_UP,
+		AgeRangeAgeRangeTypeAGE_RANGE_UNDETERMINED,
+		AgeRangeAgeRangeTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAgeRangeAgeRangeType returns the AgeRangeAgeRangeType named by s.
+func ParseAgeRangeAgeRangeType(s string) (AgeRangeAgeRangeType, error) {
+	e := AgeRangeAgeRangeType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AgeRangeAgeRangeType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AgeRangeAgeRangeType.
+func (e AgeRangeAgeRangeType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AgeRangeAgeRangeType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AgeRangeAgeRangeType) UnmarshalText(text []byte) error {
+	v := AgeRangeAgeRangeType(text)
+	if !v.IsValid() {
+		v = AgeRangeAgeRangeTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AuthenticationErrorReasonValues returns all values of AuthenticationErrorReason.
+func AuthenticationErrorReasonValues() []AuthenticationErrorReason {
+	return []AuthenticationErrorReason{
+		AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthenticationErrorReason.
+func (e AuthenticationErrorReason) IsValid() bool {
+	switch e {
+	case AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID:
+		return true
+	}
+	return false
+}
+
+// ParseAuthenticationErrorReason returns the AuthenticationErrorReason named by s.
+func ParseAuthenticationErrorReason(s string) (AuthenticationErrorReason, error) {
+	e := AuthenticationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthenticationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthenticationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthenticationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthenticationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthenticationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthorizationErrorReasonValues returns all values of AuthorizationErrorReason.
+func AuthorizationErrorReasonValues() []AuthorizationErrorReason {
+	return []AuthorizationErrorReason{
+		AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthorizationErrorReason.
+func (e AuthorizationErrorReason) IsValid() bool {
+	switch e {
+	case AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseAuthorizationErrorReason returns the AuthorizationErrorReason named by s.
+func ParseAuthorizationErrorReason(s string) (AuthorizationErrorReason, error) {
+	e := AuthorizationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthorizationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthorizationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthorizationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthorizationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthorizationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CampaignCriterionCampaignCriterionStatusValues returns all values of CampaignCriterionCampaignCriterionStatus.
+func CampaignCriterionCampaignCriterionStatusValues() []CampaignCriterionCampaignCriterionStatus {
+	return []CampaignCriterionCampaignCriterionStatus{
+		CampaignCriterionCampaignCriterionStatusACTIVE,
+		CampaignCriterionCampaignCriterionStatusREMOVED,
+		CampaignCriterionCampaignCriterionStatusPAUSED,
+	}
+}
+
+// IsValid reports whether e is one of the values of CampaignCriterionCampaignCriterionStatus.
+func (e CampaignCriterionCampaignCriterionStatus) IsValid() bool {
+	switch e {
+	case CampaignCriterionCampaignCriterionStatusACTIVE,
+		CampaignCriterionCampaignCriterionStatusREMOVED,
+		CampaignCriterionCampaignCriterionStatusPAUSED:
+		return true
+	}
+	return false
+}
+
+// ParseCampaignCriterionCampaignCriterionStatus returns the CampaignCriterionCampaignCriterionStatus named by s.
+func ParseCampaignCriterionCampaignCriterionStatus(s string) (CampaignCriterionCampaignCriterionStatus, error) {
+	e := CampaignCriterionCampaignCriterionStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CampaignCriterionCampaignCriterionStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// CampaignCriterionCampaignCriterionStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e CampaignCriterionCampaignCriterionStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *CampaignCriterionCampaignCriterionStatus) UnmarshalText(text []byte) error {
+	v := CampaignCriterionCampaignCriterionStatus(text)
+	*e = v
+	return nil
+}
+
+// CampaignCriterionErrorReasonValues returns all values of CampaignCriterionErrorReason.
+func CampaignCriterionErrorReasonValues() []CampaignCriterionErrorReason {
+	return []CampaignCriterionErrorReason{
+		CampaignCriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CampaignCriterionErrorReasonINVALID_PLACEMENT_URL,
+		CampaignCriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CampaignCriterionErrorReasonCANNOT_SET_STATUS_FOR_CRITERIA_TYPE,
+		CampaignCriterionErrorReasonCANNOT_SET_STATUS_FOR_EXCLUDED_CRITERIA,
+		CampaignCriterionErrorReasonCANNOT_TARGET_AND_EXCLUDE,
+		CampaignCriterionErrorReasonTOO_MANY_OPERATIONS,
+		CampaignCriterionErrorReasonOPERATOR_NOT_SUPPORTED_FOR_CRITERION_TYPE,
+		CampaignCriterionErrorReasonSHOPPING_CAMPAIGN_SALES_COUNTRY_NOT_SUPPORTED_FOR_SALES_CHANNEL,
+		CampaignCriterionErrorReasonUNKNOWN,
+		CampaignCriterionErrorReasonCANNOT_ADD_EXISTING_FIELD,
+	}
+}
+
+// IsValid reports whether e is one of the values of CampaignCriterionErrorReason.
+func (e CampaignCriterionErrorReason) IsValid() bool {
+	switch e {
+	case CampaignCriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CampaignCriterionErrorReasonINVALID_PLACEMENT_URL,
+		CampaignCriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CampaignCriterionErrorReasonCANNOT_SET_STATUS_FOR_CRITERIA_TYPE,
+		CampaignCriterionErrorReasonCANNOT_SET_STATUS_FOR_EXCLUDED_CRITERIA,
+		CampaignCriterionErrorReasonCANNOT_TARGET_AND_EXCLUDE,
+		CampaignCriterionErrorReasonTOO_MANY_OPERATIONS,
+		CampaignCriterionErrorReasonOPERATOR_NOT_SUPPORTED_FOR_CRITERION_TYPE,
+		CampaignCriterionErrorReasonSHOPPING_CAMPAIGN_SALES_COUNTRY_NOT_SUPPORTED_FOR_SALES_CHANNEL,
+		CampaignCriterionErrorReasonUNKNOWN,
+		CampaignCriterionErrorReasonCANNOT_ADD_EXISTING_FIELD:
+		return true
+	}
+	return false
+}
+
+// ParseCampaignCriterionErrorReason returns the CampaignCriterionErrorReason named by s.
+func ParseCampaignCriterionErrorReason(s string) (CampaignCriterionErrorReason, error) {
+	e := CampaignCriterionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CampaignCriterionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CampaignCriterionErrorReason.
+func (e CampaignCriterionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CampaignCriterionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CampaignCriterionErrorReason) UnmarshalText(text []byte) error {
+	v := CampaignCriterionErrorReason(text)
+	if !v.IsValid() {
+		v = CampaignCriterionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ClientTermsErrorReasonValues returns all values of ClientTermsErrorReason.
+func ClientTermsErrorReasonValues() []ClientTermsErrorReason {
+	return []ClientTermsErrorReason{
+		ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ClientTermsErrorReason.
+func (e ClientTermsErrorReason) IsValid() bool {
+	switch e {
+	case ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED:
+		return true
+	}
+	return false
+}
+
+// ParseClientTermsErrorReason returns the ClientTermsErrorReason named by s.
+func ParseClientTermsErrorReason(s string) (ClientTermsErrorReason, error) {
+	e := ClientTermsErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ClientTermsErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ClientTermsErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ClientTermsErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ClientTermsErrorReason) UnmarshalText(text []byte) error {
+	v := ClientTermsErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ConstantOperandConstantTypeValues returns all values of ConstantOperandConstantType.
+func ConstantOperandConstantTypeValues() []ConstantOperandConstantType {
+	return []ConstantOperandConstantType{
+		ConstantOperandConstantTypeBOOLEAN,
+		ConstantOperandConstantTypeDOUBLE,
+		ConstantOperandConstantTypeLONG,
+		ConstantOperandConstantTypeSTRING,
+	}
+}
+
+// IsValid reports whether e is one of the values of ConstantOperandConstantType.
+func (e ConstantOperandConstantType) IsValid() bool {
+	switch e {
+	case ConstantOperandConstantTypeBOOLEAN,
+		ConstantOperandConstantTypeDOUBLE,
+		ConstantOperandConstantTypeLONG,
+		ConstantOperandConstantTypeSTRING:
+		return true
+	}
+	return false
+}
+
+// ParseConstantOperandConstantType returns the ConstantOperandConstantType named by s.
+func ParseConstantOperandConstantType(s string) (ConstantOperandConstantType, error) {
+	e := ConstantOperandConstantType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ConstantOperandConstantType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ConstantOperandConstantType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ConstantOperandConstantType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ConstantOperandConstantType) UnmarshalText(text []byte) error {
+	v := ConstantOperandConstantType(text)
+	*e = v
+	return nil
+}
+
+// ConstantOperandUnitValues returns all values of ConstantOperandUnit.
+func ConstantOperandUnitValues() []ConstantOperandUnit {
+	return []ConstantOperandUnit{
+		ConstantOperandUnitMETERS,
+		ConstantOperandUnitMILES,
+		ConstantOperandUnitNONE,
+	}
+}
+
+// IsValid reports whether e is one of the values of ConstantOperandUnit.
+func (e ConstantOperandUnit) IsValid() bool {
+	switch e {
+	case ConstantOperandUnitMETERS,
+		ConstantOperandUnitMILES,
+		ConstantOperandUnitNONE:
+		return true
+	}
+	return false
+}
+
+// ParseConstantOperandUnit returns the ConstantOperandUnit named by s.
+func ParseConstantOperandUnit(s string) (ConstantOperandUnit, error) {
+	e := ConstantOperandUnit(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ConstantOperandUnit %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ConstantOperandUnit are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ConstantOperandUnit) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ConstantOperandUnit) UnmarshalText(text []byte) error {
+	v := ConstantOperandUnit(text)
+	*e = v
+	return nil
+}
+
+// ContentLabelTypeValues returns all values of ContentLabelType.
+func ContentLabelTypeValues() []ContentLabelType {
+	return []ContentLabelType{
+		ContentLabelTypeADULTISH,
+		ContentLabelTypeAFE,
+		ContentLabelTypeBELOW_THE_FOLD,
+		ContentLabelTypeCONFLICT,
+		ContentLabelTypeDP,
+		ContentLabelTypeEMBEDDED_VIDEO,
+		ContentLabelTypeGAMES,
+		ContentLabelTypeJUVENILE,
+		ContentLabelTypePROFANITY,
+		ContentLabelTypeUGC_FORUMS,
+		ContentLabelTypeUGC_IMAGES,
+		ContentLabelTypeUGC_SOCIAL,
+		ContentLabelTypeUGC_VIDEOS,
+		ContentLabelTypeSIRENS,
+		ContentLabelTypeTRAGEDY,
+		ContentLabelTypeVIDEO,
+		ContentLabelTypeVIDEO_RATING_DV_G,
+		ContentLabelTypeVIDEO_RATING_DV_PG,
+		ContentLabelTypeVIDEO_RATING_DV_T,
+		ContentLabelTypeVIDEO_RATING_DV_MA,
+		ContentLabelTypeVIDEO_NOT_YET_RATED,
+		ContentLabelTypeLIVE_STREAMING_VIDEO,
+		ContentLabelTypeALLOWED_GAMBLING_CONTENT,
+		ContentLabelTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of ContentLabelType.
+func (e ContentLabelType) IsValid() bool {
+	switch e {
+	case ContentLabelTypeADULTISH,
+		ContentLabelTypeAFE,
+		ContentLabelTypeBELOW_THE_FOLD,
+		ContentLabelTypeCONFLICT,
+		ContentLabelTypeDP,
+		ContentLabelTypeEMBEDDED_VIDEO,
+		ContentLabelTypeGAMES,
+		ContentLabelTypeJUVENILE,
+		ContentLabelTypePROFANITY,
+		ContentLabelTypeUGC_FORUMS,
+		ContentLabelTypeUGC_IMAGES,
+		ContentLabelTypeUGC_SOCIAL,
+		ContentLabelTypeUGC_VIDEOS,
+		ContentLabelTypeSIRENS,
+		ContentLabelTypeTRAGEDY,
+		ContentLabelTypeVIDEO,
+		ContentLabelTypeVIDEO_RATING_DV_G,
+		ContentLabelTypeVIDEO_RATING_DV_PG,
+		ContentLabelTypeVIDEO_RATING_DV_T,
+		ContentLabelTypeVIDEO_RATING_DV_MA,
+		ContentLabelTypeVIDEO_NOT_YET_RATED,
+		ContentLabelTypeLIVE_STREAMING_VIDEO,
+		ContentLabelTypeALLOWED_GAMBLING_CONTENT,
+		ContentLabelTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseContentLabelType returns the ContentLabelType named by s.
+func ParseContentLabelType(s string) (ContentLabelType, error) {
+	e := ContentLabelType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ContentLabelType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ContentLabelType.
+func (e ContentLabelType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ContentLabelType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ContentLabelType) UnmarshalText(text []byte) error {
+	v := ContentLabelType(text)
+	if !v.IsValid() {
+		v = ContentLabelTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CriterionTypeValues returns all values of CriterionType.
+func CriterionTypeValues() []CriterionType {
+	return []CriterionType{
+		CriterionTypeCONTENT_LABEL,
+		CriterionTypeKEYWORD,
+		CriterionTypePLACEMENT,
+		CriterionTypeVERTICAL,
+		CriterionTypeUSER_LIST,
+		CriterionTypeUSER_INTEREST,
+		CriterionTypeMOBILE_APPLICATION,
+		CriterionTypeMOBILE_APP_CATEGORY,
+		CriterionTypePRODUCT_PARTITION,
+		CriterionTypeIP_BLOCK,
+		CriterionTypeWEBPAGE,
+		CriterionTypeLANGUAGE,
+		CriterionTypeLOCATION,
+		CriterionTypeAGE_RANGE,
+		CriterionTypeCARRIER,
+		CriterionTypeOPERATING_SYSTEM_VERSION,
+		CriterionTypeMOBILE_DEVICE,
+		CriterionTypeGENDER,
+		CriterionTypePARENT,
+		CriterionTypePROXIMITY,
+		CriterionTypePLATFORM,
+		CriterionTypePREFERRED_CONTENT,
+		CriterionTypeAD_SCHEDULE,
+		CriterionTypeLOCATION_GROUPS,
+		CriterionTypePRODUCT_SCOPE,
+		CriterionTypeYOUTUBE_VIDEO,
+		CriterionTypeYOUTUBE_CHANNEL,
+		CriterionTypeAPP_PAYMENT_MODEL,
+		CriterionTypeINCOME_RANGE,
+		CriterionTypeINTERACTION_TYPE,
+		CriterionTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CriterionType.
+func (e CriterionType) IsValid() bool {
+	switch e {
+	case CriterionTypeCONTENT_LABEL,
+		CriterionTypeKEYWORD,
+		CriterionTypePLACEMENT,
+		CriterionTypeVERTICAL,
+		CriterionTypeUSER_LIST,
+		CriterionTypeUSER_INTEREST,
+		CriterionTypeMOBILE_APPLICATION,
+		CriterionTypeMOBILE_APP_CATEGORY,
+		CriterionTypePRODUCT_PARTITION,
+		CriterionTypeIP_BLOCK,
+		CriterionTypeWEBPAGE,
+		CriterionTypeLANGUAGE,
+		CriterionTypeLOCATION,
+		CriterionTypeAGE_RANGE,
+		CriterionTypeCARRIER,
+		CriterionTypeOPERATING_SYSTEM_VERSION,
+		CriterionTypeMOBILE_DEVICE,
+		CriterionTypeGENDER,
+		CriterionTypePARENT,
+		CriterionTypePROXIMITY,
+		CriterionTypePLATFORM,
+		CriterionTypePREFERRED_CONTENT,
+		CriterionTypeAD_SCHEDULE,
+		CriterionTypeLOCATION_GROUPS,
+		CriterionTypePRODUCT_SCOPE,
+		CriterionTypeYOUTUBE_VIDEO,
+		CriterionTypeYOUTUBE_CHANNEL,
+		CriterionTypeAPP_PAYMENT_MODEL,
+		CriterionTypeINCOME_RANGE,
+		CriterionTypeINTERACTION_TYPE,
+		CriterionTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCriterionType returns the CriterionType named by s.
+func ParseCriterionType(s string) (CriterionType, error) {
+	e := CriterionType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CriterionType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CriterionType.
+func (e CriterionType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CriterionType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CriterionType) UnmarshalText(text []byte) error {
+	v := CriterionType(text)
+	if !v.IsValid() {
+		v = CriterionTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CriterionErrorReasonValues returns all values of CriterionErrorReason.
+func CriterionErrorReasonValues() []CriterionErrorReason {
+	return []CriterionErrorReason{
+		CriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CriterionErrorReasonINVALID_EXCLUDED_CATEGORY,
+		CriterionErrorReasonINVALID_KEYWORD_TEXT,
+		CriterionErrorReasonKEYWORD_TEXT_TOO_LONG,
+		CriterionErrorReasonKEYWORD_HAS_TOO_MANY_WORDS,
+		CriterionErrorReasonKEYWORD_HAS_INVALID_CHARS,
+		CriterionErrorReasonINVALID_PLACEMENT_URL,
+		CriterionErrorReasonINVALID_USER_LIST,
+		CriterionErrorReasonINVALID_USER_INTEREST,
+		CriterionErrorReasonINVALID_FORMAT_FOR_PLACEMENT_URL,
+		CriterionErrorReasonPLACEMENT_URL_IS_TOO_LONG,
+		CriterionErrorReasonPLACEMENT_URL_HAS_ILLEGAL_CHAR,
+		CriterionErrorReasonPLACEMENT_URL_HAS_MULTIPLE_SITES_IN_LINE,
+		CriterionErrorReasonPLACEMENT_IS_NOT_AVAILABLE_FOR_TARGETING_OR_EXCLUSION,
+		CriterionErrorReasonINVALID_VERTICAL_PATH,
+		CriterionErrorReasonYOUTUBE_VERTICAL_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_DEMOGRAPHIC_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_URL_UNSUPPORTED,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CriterionErrorReasonCANNOT_ADD_CRITERIA_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_FILTER,
+		CriterionErrorReasonPRODUCT_FILTER_TOO_LONG,
+		CriterionErrorReasonCANNOT_EXCLUDE_SIMILAR_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_CLOSED_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_ONLY_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SHOPPING_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_USER_INTERESTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_SET_BIDS_ON_CRITERION_TYPE_IN_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_URLS_TO_CRITERION_TYPE_FOR_CAMPAIGN_TYPE,
+		CriterionErrorReasonINVALID_IP_ADDRESS,
+		CriterionErrorReasonINVALID_IP_FORMAT,
+		CriterionErrorReasonINVALID_MOBILE_APP,
+		CriterionErrorReasonINVALID_MOBILE_APP_CATEGORY,
+		CriterionErrorReasonINVALID_CRITERION_ID,
+		CriterionErrorReasonCANNOT_TARGET_CRITERION,
+		CriterionErrorReasonCANNOT_TARGET_OBSOLETE_CRITERION,
+		CriterionErrorReasonCRITERION_ID_AND_TYPE_MISMATCH,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS_UNITS,
+		CriterionErrorReasonINVALID_STREETADDRESS_LENGTH,
+		CriterionErrorReasonINVALID_CITYNAME_LENGTH,
+		CriterionErrorReasonINVALID_REGIONCODE_LENGTH,
+		CriterionErrorReasonINVALID_REGIONNAME_LENGTH,
+		CriterionErrorReasonINVALID_POSTALCODE_LENGTH,
+		CriterionErrorReasonINVALID_COUNTRY_CODE,
+		CriterionErrorReasonINVALID_LATITUDE,
+		CriterionErrorReasonINVALID_LONGITUDE,
+		CriterionErrorReasonPROXIMITY_GEOPOINT_AND_ADDRESS_BOTH_CANNOT_BE_NULL,
+		CriterionErrorReasonINVALID_PROXIMITY_ADDRESS,
+		CriterionErrorReasonINVALID_USER_DOMAIN_NAME,
+		CriterionErrorReasonCRITERION_PARAMETER_TOO_LONG,
+		CriterionErrorReasonAD_SCHEDULE_TIME_INTERVALS_OVERLAP,
+		CriterionErrorReasonAD_SCHEDULE_INTERVAL_CANNOT_SPAN_MULTIPLE_DAYS,
+		CriterionErrorReasonAD_SCHEDULE_INVALID_TIME_INTERVAL,
+		CriterionErrorReasonAD_SCHEDULE_EXCEEDED_INTERVALS_PER_DAY_LIMIT,
+		CriterionErrorReasonAD_SCHEDULE_CRITERION_ID_MISMATCHING_FIELDS,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_TYPE,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_CAMPAIGN_OPTED_OUT,
+		CriterionErrorReasonCANNOT_BID_MODIFY_NEGATIVE_CRITERION,
+		CriterionErrorReasonBID_MODIFIER_ALREADY_EXISTS,
+		CriterionErrorReasonFEED_ID_NOT_ALLOWED,
+		CriterionErrorReasonACCOUNT_INELIGIBLE_FOR_CRITERIA_TYPE,
+		CriterionErrorReasonCRITERIA_TYPE_INVALID_FOR_BIDDING_STRATEGY,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERION,
+		CriterionErrorReasonCANNOT_REMOVE_CRITERION,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_LONG,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_LONG,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_BIDDING_CATEGORY,
+		CriterionErrorReasonMISSING_SHOPPING_SETTING,
+		CriterionErrorReasonINVALID_MATCHING_FUNCTION,
+		CriterionErrorReasonLOCATION_FILTER_NOT_ALLOWED,
+		CriterionErrorReasonLOCATION_FILTER_INVALID,
+		CriterionErrorReasonCANNOT_ATTACH_CRITERIA_AT_CAMPAIGN_AND_ADGROUP,
+		CriterionErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CriterionErrorReason.
+func (e CriterionErrorReason) IsValid() bool {
+	switch e {
+	case CriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CriterionErrorReasonINVALID_EXCLUDED_CATEGORY,
+		CriterionErrorReasonINVALID_KEYWORD_TEXT,
+		CriterionErrorReasonKEYWORD_TEXT_TOO_LONG,
+		CriterionErrorReasonKEYWORD_HAS_TOO_MANY_WORDS,
+		CriterionErrorReasonKEYWORD_HAS_INVALID_CHARS,
+		CriterionErrorReasonINVALID_PLACEMENT_URL,
+		CriterionErrorReasonINVALID_USER_LIST,
+		CriterionErrorReasonINVALID_USER_INTEREST,
+		CriterionErrorReasonINVALID_FORMAT_FOR_PLACEMENT_URL,
+		CriterionErrorReasonPLACEMENT_URL_IS_TOO_LONG,
+		CriterionErrorReasonPLACEMENT_URL_HAS_ILLEGAL_CHAR,
+		CriterionErrorReasonPLACEMENT_URL_HAS_MULTIPLE_SITES_IN_LINE,
+		CriterionErrorReasonPLACEMENT_IS_NOT_AVAILABLE_FOR_TARGETING_OR_EXCLUSION,
+		CriterionErrorReasonINVALID_VERTICAL_PATH,
+		CriterionErrorReasonYOUTUBE_VERTICAL_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_DEMOGRAPHIC_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_URL_UNSUPPORTED,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CriterionErrorReasonCANNOT_ADD_CRITERIA_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_FILTER,
+		CriterionErrorReasonPRODUCT_FILTER_TOO_LONG,
+		CriterionErrorReasonCANNOT_EXCLUDE_SIMILAR_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_CLOSED_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_ONLY_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SHOPPING_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_USER_INTERESTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_SET_BIDS_ON_CRITERION_TYPE_IN_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_URLS_TO_CRITERION_TYPE_FOR_CAMPAIGN_TYPE,
+		CriterionErrorReasonINVALID_IP_ADDRESS,
+		CriterionErrorReasonINVALID_IP_FORMAT,
+		CriterionErrorReasonINVALID_MOBILE_APP,
+		CriterionErrorReasonINVALID_MOBILE_APP_CATEGORY,
+		CriterionErrorReasonINVALID_CRITERION_ID,
+		CriterionErrorReasonCANNOT_TARGET_CRITERION,
+		CriterionErrorReasonCANNOT_TARGET_OBSOLETE_CRITERION,
+		CriterionErrorReasonCRITERION_ID_AND_TYPE_MISMATCH,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS_UNITS,
+		CriterionErrorReasonINVALID_STREETADDRESS_LENGTH,
+		CriterionErrorReasonINVALID_CITYNAME_LENGTH,
+		CriterionErrorReasonINVALID_REGIONCODE_LENGTH,
+		CriterionErrorReasonINVALID_REGIONNAME_LENGTH,
+		CriterionErrorReasonINVALID_POSTALCODE_LENGTH,
+		CriterionErrorReasonINVALID_COUNTRY_CODE,
+		CriterionErrorReasonINVALID_LATITUDE,
+		CriterionErrorReasonINVALID_LONGITUDE,
+		CriterionErrorReasonPROXIMITY_GEOPOINT_AND_ADDRESS_BOTH_CANNOT_BE_NULL,
+		CriterionErrorReasonINVALID_PROXIMITY_ADDRESS,
+		CriterionErrorReasonINVALID_USER_DOMAIN_NAME,
+		CriterionErrorReasonCRITERION_PARAMETER_TOO_LONG,
+		CriterionErrorReasonAD_SCHEDULE_TIME_INTERVALS_OVERLAP,
+		CriterionErrorReasonAD_SCHEDULE_INTERVAL_CANNOT_SPAN_MULTIPLE_DAYS,
+		CriterionErrorReasonAD_SCHEDULE_INVALID_TIME_INTERVAL,
+		CriterionErrorReasonAD_SCHEDULE_EXCEEDED_INTERVALS_PER_DAY_LIMIT,
+		CriterionErrorReasonAD_SCHEDULE_CRITERION_ID_MISMATCHING_FIELDS,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_TYPE,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_CAMPAIGN_OPTED_OUT,
+		CriterionErrorReasonCANNOT_BID_MODIFY_NEGATIVE_CRITERION,
+		CriterionErrorReasonBID_MODIFIER_ALREADY_EXISTS,
+		CriterionErrorReasonFEED_ID_NOT_ALLOWED,
+		CriterionErrorReasonACCOUNT_INELIGIBLE_FOR_CRITERIA_TYPE,
+		CriterionErrorReasonCRITERIA_TYPE_INVALID_FOR_BIDDING_STRATEGY,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERION,
+		CriterionErrorReasonCANNOT_REMOVE_CRITERION,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_LONG,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_LONG,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_BIDDING_CATEGORY,
+		CriterionErrorReasonMISSING_SHOPPING_SETTING,
+		CriterionErrorReasonINVALID_MATCHING_FUNCTION,
+		CriterionErrorReasonLOCATION_FILTER_NOT_ALLOWED,
+		CriterionErrorReasonLOCATION_FILTER_INVALID,
+		CriterionErrorReasonCANNOT_ATTACH_CRITERIA_AT_CAMPAIGN_AND_ADGROUP,
+		CriterionErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCriterionErrorReason returns the CriterionErrorReason named by s.
+func ParseCriterionErrorReason(s string) (CriterionErrorReason, error) {
+	e := CriterionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CriterionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CriterionErrorReason.
+func (e CriterionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CriterionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CriterionErrorReason) UnmarshalText(text []byte) error {
+	v := CriterionErrorReason(text)
+	if !v.IsValid() {
+		v = CriterionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DatabaseErrorReasonValues returns all values of DatabaseErrorReason.
+func DatabaseErrorReasonValues() []DatabaseErrorReason {
+	return []DatabaseErrorReason{
+		DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DatabaseErrorReason.
+func (e DatabaseErrorReason) IsValid() bool {
+	switch e {
+	case DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDatabaseErrorReason returns the DatabaseErrorReason named by s.
+func ParseDatabaseErrorReason(s string) (DatabaseErrorReason, error) {
+	e := DatabaseErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DatabaseErrorReason.
+func (e DatabaseErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DatabaseErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DatabaseErrorReason) UnmarshalText(text []byte) error {
+	v := DatabaseErrorReason(text)
+	if !v.IsValid() {
+		v = DatabaseErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DayOfWeekValues returns all values of DayOfWeek.
+func DayOfWeekValues() []DayOfWeek {
+	return []DayOfWeek{
+		DayOfWeekMONDAY,
+		DayOfWeekTUESDAY,
+		DayOfWeekWEDNESDAY,
+		DayOfWeekTHURSDAY,
+		DayOfWeekFRIDAY,
+		DayOfWeekSATURDAY,
+		DayOfWeekSUNDAY,
+	}
+}
+
+// IsValid reports whether e is one of the values of DayOfWeek.
+func (e DayOfWeek) IsValid() bool {
+	switch e {
+	case DayOfWeekMONDAY,
+		DayOfWeekTUESDAY,
+		DayOfWeekWEDNESDAY,
+		DayOfWeekTHURSDAY,
+		DayOfWeekFRIDAY,
+		DayOfWeekSATURDAY,
+		DayOfWeekSUNDAY:
+		return true
+	}
+	return false
+}
+
+// ParseDayOfWeek returns the DayOfWeek named by s.
+func ParseDayOfWeek(s string) (DayOfWeek, error) {
+	e := DayOfWeek(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DayOfWeek %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DayOfWeek are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DayOfWeek) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DayOfWeek) UnmarshalText(text []byte) error {
+	v := DayOfWeek(text)
+	*e = v
+	return nil
+}
+
+// DistinctErrorReasonValues returns all values of DistinctErrorReason.
+func DistinctErrorReasonValues() []DistinctErrorReason {
+	return []DistinctErrorReason{
+		DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DistinctErrorReason.
+func (e DistinctErrorReason) IsValid() bool {
+	switch e {
+	case DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDistinctErrorReason returns the DistinctErrorReason named by s.
+func ParseDistinctErrorReason(s string) (DistinctErrorReason, error) {
+	e := DistinctErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DistinctErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DistinctErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DistinctErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DistinctErrorReason) UnmarshalText(text []byte) error {
+	v := DistinctErrorReason(text)
+	*e = v
+	return nil
+}
+
+// EntityCountLimitExceededReasonValues returns all values of EntityCountLimitExceededReason.
+func EntityCountLimitExceededReasonValues() []EntityCountLimitExceededReason {
+	return []EntityCountLimitExceededReason{
+		EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) IsValid() bool {
+	switch e {
+	case EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseEntityCountLimitExceededReason returns the EntityCountLimitExceededReason named by s.
+func ParseEntityCountLimitExceededReason(s string) (EntityCountLimitExceededReason, error) {
+	e := EntityCountLimitExceededReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityCountLimitExceededReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid EntityCountLimitExceededReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *EntityCountLimitExceededReason) UnmarshalText(text []byte) error {
+	v := EntityCountLimitExceededReason(text)
+	if !v.IsValid() {
+		v = EntityCountLimitExceededReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// EntityNotFoundReasonValues returns all values of EntityNotFoundReason.
+func EntityNotFoundReasonValues() []EntityNotFoundReason {
+	return []EntityNotFoundReason{
+		EntityNotFoundReasonINVALID_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityNotFoundReason.
+func (e EntityNotFoundReason) IsValid() bool {
+	switch e {
+	case EntityNotFoundReasonINVALID_ID:
+		return true
+	}
+	return false
+}
+
+// ParseEntityNotFoundReason returns the EntityNotFoundReason named by s.
+func ParseEntityNotFoundReason(s string) (EntityNotFoundReason, error) {
+	e := EntityNotFoundReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityNotFoundReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityNotFoundReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityNotFoundReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityNotFoundReason) UnmarshalText(text []byte) error {
+	v := EntityNotFoundReason(text)
+	*e = v
+	return nil
+}
+
+// FunctionOperatorValues returns all values of FunctionOperator.
+func FunctionOperatorValues() []FunctionOperator {
+	return []FunctionOperator{
+		FunctionOperatorIN,
+		FunctionOperatorIDENTITY,
+		FunctionOperatorEQUALS,
+		FunctionOperatorAND,
+		FunctionOperatorCONTAINS_ANY,
+		FunctionOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FunctionOperator.
+func (e FunctionOperator) IsValid() bool {
+	switch e {
+	case FunctionOperatorIN,
+		FunctionOperatorIDENTITY,
+		FunctionOperatorEQUALS,
+		FunctionOperatorAND,
+		FunctionOperatorCONTAINS_ANY,
+		FunctionOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFunctionOperator returns the FunctionOperator named by s.
+func ParseFunctionOperator(s string) (FunctionOperator, error) {
+	e := FunctionOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FunctionOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FunctionOperator.
+func (e FunctionOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FunctionOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FunctionOperator) UnmarshalText(text []byte) error {
+	v := FunctionOperator(text)
+	if !v.IsValid() {
+		v = FunctionOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FunctionErrorReasonValues returns all values of FunctionErrorReason.
+func FunctionErrorReasonValues() []FunctionErrorReason {
+	return []FunctionErrorReason{
+		FunctionErrorReasonINVALID_FUNCTION_FORMAT,
+		FunctionErrorReasonDATA_TYPE_MISMATCH,
+		FunctionErrorReasonINVALID_CONJUNCTION_OPERANDS,
+		FunctionErrorReasonINVALID_NUMBER_OF_OPERANDS,
+		FunctionErrorReasonINVALID_OPERAND_TYPE,
+		FunctionErrorReasonINVALID_OPERATOR,
+		FunctionErrorReasonINVALID_REQUEST_CONTEXT_TYPE,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_CALL_PLACEHOLDER,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_PLACEHOLDER,
+		FunctionErrorReasonINVALID_OPERAND,
+		FunctionErrorReasonMISSING_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_NESTING,
+		FunctionErrorReasonMULTIPLE_FEED_IDS_NOT_SUPPORTED,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_FEED_WITH_FIXED_SCHEMA,
+		FunctionErrorReasonINVALID_ATTRIBUTE_NAME,
+		FunctionErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FunctionErrorReason.
+func (e FunctionErrorReason) IsValid() bool {
+	switch e {
+	case FunctionErrorReasonINVALID_FUNCTION_FORMAT,
+		FunctionErrorReasonDATA_TYPE_MISMATCH,
+		FunctionErrorReasonINVALID_CONJUNCTION_OPERANDS,
+		FunctionErrorReasonINVALID_NUMBER_OF_OPERANDS,
+		FunctionErrorReasonINVALID_OPERAND_TYPE,
+		FunctionErrorReasonINVALID_OPERATOR,
+		FunctionErrorReasonINVALID_REQUEST_CONTEXT_TYPE,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_CALL_PLACEHOLDER,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_PLACEHOLDER,
+		FunctionErrorReasonINVALID_OPERAND,
+		FunctionErrorReasonMISSING_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_NESTING,
+		FunctionErrorReasonMULTIPLE_FEED_IDS_NOT_SUPPORTED,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_FEED_WITH_FIXED_SCHEMA,
+		FunctionErrorReasonINVALID_ATTRIBUTE_NAME,
+		FunctionErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFunctionErrorReason returns the FunctionErrorReason named by s.
+func ParseFunctionErrorReason(s string) (FunctionErrorReason, error) {
+	e := FunctionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FunctionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FunctionErrorReason.
+func (e FunctionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FunctionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FunctionErrorReason) UnmarshalText(text []byte) error {
+	v := FunctionErrorReason(text)
+	if !v.IsValid() {
+		v = FunctionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// GenderGenderTypeValues returns all values of GenderGenderType.
+func GenderGenderTypeValues() []GenderGenderType {
+	return []GenderGenderType{
+		GenderGenderTypeGENDER_MALE,
+		GenderGenderTypeGENDER_FEMALE,
+		GenderGenderTypeGENDER_UNDETERMINED,
+	}
+}
+
+// IsValid reports whether e is one of the values of GenderGenderType.
+func (e GenderGenderType) IsValid() bool {
+	switch e {
+	case GenderGenderTypeGENDER_MALE,
+		GenderGenderTypeGENDER_FEMALE,
+		GenderGenderTypeGENDER_UNDETERMINED:
+		return true
+	}
+	return false
+}
+
+// ParseGenderGenderType returns the GenderGenderType named by s.
+func ParseGenderGenderType(s string) (GenderGenderType, error) {
+	e := GenderGenderType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid GenderGenderType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// GenderGenderType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e GenderGenderType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *GenderGenderType) UnmarshalText(text []byte) error {
+	v := GenderGenderType(text)
+	*e = v
+	return nil
+}
+
+// IdErrorReasonValues returns all values of IdErrorReason.
+func IdErrorReasonValues() []IdErrorReason {
+	return []IdErrorReason{
+		IdErrorReasonNOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of IdErrorReason.
+func (e IdErrorReason) IsValid() bool {
+	switch e {
+	case IdErrorReasonNOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseIdErrorReason returns the IdErrorReason named by s.
+func ParseIdErrorReason(s string) (IdErrorReason, error) {
+	e := IdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// IdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e IdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *IdErrorReason) UnmarshalText(text []byte) error {
+	v := IdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// IncomeRangeIncomeRangeTypeValues returns all values of IncomeRangeIncomeRangeType.
+func IncomeRangeIncomeRangeTypeValues() []IncomeRangeIncomeRangeType {
+	return []IncomeRangeIncomeRangeType{
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_UNDETERMINED,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_0_50,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_50_60,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_60_70,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_70_80,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_80_90,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_90_UP,
+		IncomeRangeIncomeRangeTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of IncomeRangeIncomeRangeType.
+func (e IncomeRangeIncomeRangeType) IsValid() bool {
+	switch e {
+	case IncomeRangeIncomeRangeTypeINCOME_RANGE_UNDETERMINED,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_0_50,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_50_60,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_60_70,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_70_80,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_80_90,
+		IncomeRangeIncomeRangeTypeINCOME_RANGE_90_UP,
+		IncomeRangeIncomeRangeTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseIncomeRangeIncomeRangeType returns the IncomeRangeIncomeRangeType named by s.
+func ParseIncomeRangeIncomeRangeType(s string) (IncomeRangeIncomeRangeType, error) {
+	e := IncomeRangeIncomeRangeType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IncomeRangeIncomeRangeType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of IncomeRangeIncomeRangeType.
+func (e IncomeRangeIncomeRangeType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid IncomeRangeIncomeRangeType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *IncomeRangeIncomeRangeType) UnmarshalText(text []byte) error {
+	v := IncomeRangeIncomeRangeType(text)
+	if !v.IsValid() {
+		v = IncomeRangeIncomeRangeTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// IncomeTierValues returns all values of IncomeTier.
+func IncomeTierValues() []IncomeTier {
+	return []IncomeTier{
+		IncomeTierUNKNOWN,
+		IncomeTierTIER_1,
+		IncomeTierTIER_2,
+		IncomeTierTIER_3,
+		IncomeTierTIER_4,
+		IncomeTierTIER_5,
+		IncomeTierTIER_6_TO_10,
+	}
+}
+
+// IsValid reports whether e is one of the values of IncomeTier.
+func (e IncomeTier) IsValid() bool {
+	switch e {
+	case IncomeTierUNKNOWN,
+		IncomeTierTIER_1,
+		IncomeTierTIER_2,
+		IncomeTierTIER_3,
+		IncomeTierTIER_4,
+		IncomeTierTIER_5,
+		IncomeTierTIER_6_TO_10:
+		return true
+	}
+	return false
+}
+
+// ParseIncomeTier returns the IncomeTier named by s.
+func ParseIncomeTier(s string) (IncomeTier, error) {
+	e := IncomeTier(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IncomeTier %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of IncomeTier.
+func (e IncomeTier) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid IncomeTier %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *IncomeTier) UnmarshalText(text []byte) error {
+	v := IncomeTier(text)
+	if !v.IsValid() {
+		v = IncomeTierUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// InternalApiErrorReasonValues returns all values of InternalApiErrorReason.
+func InternalApiErrorReasonValues() []InternalApiErrorReason {
+	return []InternalApiErrorReason{
+		InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE,
+	}
+}
+
+// IsValid reports whether e is one of the values of InternalApiErrorReason.
+func (e InternalApiErrorReason) IsValid() bool {
+	switch e {
+	case InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE:
+		return true
+	}
+	return false
+}
+
+// ParseInternalApiErrorReason returns the InternalApiErrorReason named by s.
+func ParseInternalApiErrorReason(s string) (InternalApiErrorReason, error) {
+	e := InternalApiErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid InternalApiErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of InternalApiErrorReason.
+func (e InternalApiErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid InternalApiErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *InternalApiErrorReason) UnmarshalText(text []byte) error {
+	v := InternalApiErrorReason(text)
+	if !v.IsValid() {
+		v = InternalApiErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// KeywordMatchTypeValues returns all values of KeywordMatchType.
+func KeywordMatchTypeValues() []KeywordMatchType {
+	return []KeywordMatchType{
+		KeywordMatchTypeEXACT,
+		KeywordMatchTypePHRASE,
+		KeywordMatchTypeBROAD,
+	}
+}
+
+// IsValid reports whether e is one of the values of KeywordMatchType.
+func (e KeywordMatchType) IsValid() bool {
+	switch e {
+	case KeywordMatchTypeEXACT,
+		KeywordMatchTypePHRASE,
+		KeywordMatchTypeBROAD:
+		return true
+	}
+	return false
+}
+
+// ParseKeywordMatchType returns the KeywordMatchType named by s.
+func ParseKeywordMatchType(s string) (KeywordMatchType, error) {
+	e := KeywordMatchType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid KeywordMatchType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// KeywordMatchType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e KeywordMatchType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *KeywordMatchType) UnmarshalText(text []byte) error {
+	v := KeywordMatchType(text)
+	*e = v
+	return nil
+}
+
+// LocationTargetingStatusValues returns all values of LocationTargetingStatus.
+func LocationTargetingStatusValues() []LocationTargetingStatus {
+	return []LocationTargetingStatus{
+		LocationTargetingStatusACTIVE,
+		LocationTargetingStatusOBSOLETE,
+		LocationTargetingStatusPHASING_OUT,
+	}
+}
+
+// IsValid reports whether e is one of the values of LocationTargetingStatus.
+func (e LocationTargetingStatus) IsValid() bool {
+	switch e {
+	case LocationTargetingStatusACTIVE,
+		LocationTargetingStatusOBSOLETE,
+		LocationTargetingStatusPHASING_OUT:
+		return true
+	}
+	return false
+}
+
+// ParseLocationTargetingStatus returns the LocationTargetingStatus named by s.
+func ParseLocationTargetingStatus(s string) (LocationTargetingStatus, error) {
+	e := LocationTargetingStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid LocationTargetingStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// LocationTargetingStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e LocationTargetingStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *LocationTargetingStatus) UnmarshalText(text []byte) error {
+	v := LocationTargetingStatus(text)
+	*e = v
+	return nil
+}
+
+// MinuteOfHourValues returns all values of MinuteOfHour.
+func MinuteOfHourValues() []MinuteOfHour {
+	return []MinuteOfHour{
+		MinuteOfHourZERO,
+		MinuteOfHourFIFTEEN,
+		MinuteOfHourTHIRTY,
+		MinuteOfHourFORTY_FIVE,
+	}
+}
+
+// IsValid reports whether e is one of the values of MinuteOfHour.
+func (e MinuteOfHour) IsValid() bool {
+	switch e {
+	case MinuteOfHourZERO,
+		MinuteOfHourFIFTEEN,
+		MinuteOfHourTHIRTY,
+		MinuteOfHourFORTY_FIVE:
+		return true
+	}
+	return false
+}
+
+// ParseMinuteOfHour returns the MinuteOfHour named by s.
+func ParseMinuteOfHour(s string) (MinuteOfHour, error) {
+	e := MinuteOfHour(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MinuteOfHour %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MinuteOfHour are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MinuteOfHour) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MinuteOfHour) UnmarshalText(text []byte) error {
+	v := MinuteOfHour(text)
+	*e = v
+	return nil
+}
+
+// MobileDeviceDeviceTypeValues returns all values of MobileDeviceDeviceType.
+func MobileDeviceDeviceTypeValues() []MobileDeviceDeviceType {
+	return []MobileDeviceDeviceType{
+		MobileDeviceDeviceTypeDEVICE_TYPE_MOBILE,
+		MobileDeviceDeviceTypeDEVICE_TYPE_TABLET,
+	}
+}
+
+// IsValid reports whether e is one of the values of MobileDeviceDeviceType.
+func (e MobileDeviceDeviceType) IsValid() bool {
+	switch e {
+	case MobileDeviceDeviceTypeDEVICE_TYPE_MOBILE,
+		MobileDeviceDeviceTypeDEVICE_TYPE_TABLET:
+		return true
+	}
+	return false
+}
+
+// ParseMobileDeviceDeviceType returns the MobileDeviceDeviceType named by s.
+func ParseMobileDeviceDeviceType(s string) (MobileDeviceDeviceType, error) {
+	e := MobileDeviceDeviceType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MobileDeviceDeviceType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MobileDeviceDeviceType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MobileDeviceDeviceType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MobileDeviceDeviceType) UnmarshalText(text []byte) error {
+	v := MobileDeviceDeviceType(text)
+	*e = v
+	return nil
+}
+
+// NewEntityCreationErrorReasonValues returns all values of NewEntityCreationErrorReason.
+func NewEntityCreationErrorReasonValues() []NewEntityCreationErrorReason {
+	return []NewEntityCreationErrorReason{
+		NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS,
+	}
+}
+
+// IsValid reports whether e is one of the values of NewEntityCreationErrorReason.
+func (e NewEntityCreationErrorReason) IsValid() bool {
+	switch e {
+	case NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS:
+		return true
+	}
+	return false
+}
+
+// ParseNewEntityCreationErrorReason returns the NewEntityCreationErrorReason named by s.
+func ParseNewEntityCreationErrorReason(s string) (NewEntityCreationErrorReason, error) {
+	e := NewEntityCreationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NewEntityCreationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NewEntityCreationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NewEntityCreationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NewEntityCreationErrorReason) UnmarshalText(text []byte) error {
+	v := NewEntityCreationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NotEmptyErrorReasonValues returns all values of NotEmptyErrorReason.
+func NotEmptyErrorReasonValues() []NotEmptyErrorReason {
+	return []NotEmptyErrorReason{
+		NotEmptyErrorReasonEMPTY_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotEmptyErrorReason.
+func (e NotEmptyErrorReason) IsValid() bool {
+	switch e {
+	case NotEmptyErrorReasonEMPTY_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseNotEmptyErrorReason returns the NotEmptyErrorReason named by s.
+func ParseNotEmptyErrorReason(s string) (NotEmptyErrorReason, error) {
+	e := NotEmptyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotEmptyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotEmptyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotEmptyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotEmptyErrorReason) UnmarshalText(text []byte) error {
+	v := NotEmptyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NullErrorReasonValues returns all values of NullErrorReason.
+func NullErrorReasonValues() []NullErrorReason {
+	return []NullErrorReason{
+		NullErrorReasonNULL_CONTENT,
+	}
+}
+
+// IsValid reports whether e is one of the values of NullErrorReason.
+func (e NullErrorReason) IsValid() bool {
+	switch e {
+	case NullErrorReasonNULL_CONTENT:
+		return true
+	}
+	return false
+}
+
+// ParseNullErrorReason returns the NullErrorReason named by s.
+func ParseNullErrorReason(s string) (NullErrorReason, error) {
+	e := NullErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NullErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NullErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NullErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NullErrorReason) UnmarshalText(text []byte) error {
+	v := NullErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OperatingSystemVersionOperatorTypeValues returns all values of OperatingSystemVersionOperatorType.
+func OperatingSystemVersionOperatorTypeValues() []OperatingSystemVersionOperatorType {
+	return []OperatingSystemVersionOperatorType{
+		OperatingSystemVersionOperatorTypeGREATER_THAN_EQUAL_TO,
+		OperatingSystemVersionOperatorTypeEQUAL_TO,
+		OperatingSystemVersionOperatorTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatingSystemVersionOperatorType.
+func (e OperatingSystemVersionOperatorType) IsValid() bool {
+	switch e {
+	case OperatingSystemVersionOperatorTypeGREATER_THAN_EQUAL_TO,
+		OperatingSystemVersionOperatorTypeEQUAL_TO,
+		OperatingSystemVersionOperatorTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperatingSystemVersionOperatorType returns the OperatingSystemVersionOperatorType named by s.
+func ParseOperatingSystemVersionOperatorType(s string) (OperatingSystemVersionOperatorType, error) {
+	e := OperatingSystemVersionOperatorType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatingSystemVersionOperatorType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperatingSystemVersionOperatorType.
+func (e OperatingSystemVersionOperatorType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperatingSystemVersionOperatorType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperatingSystemVersionOperatorType) UnmarshalText(text []byte) error {
+	v := OperatingSystemVersionOperatorType(text)
+	if !v.IsValid() {
+		v = OperatingSystemVersionOperatorTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperationAccessDeniedReasonValues returns all values of OperationAccessDeniedReason.
+func OperationAccessDeniedReasonValues() []OperationAccessDeniedReason {
+	return []OperationAccessDeniedReason{
+		OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) IsValid() bool {
+	switch e {
+	case OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperationAccessDeniedReason returns the OperationAccessDeniedReason named by s.
+func ParseOperationAccessDeniedReason(s string) (OperationAccessDeniedReason, error) {
+	e := OperationAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperationAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperationAccessDeniedReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperationAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := OperationAccessDeniedReason(text)
+	if !v.IsValid() {
+		v = OperationAccessDeniedReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperatorValues returns all values of Operator.
+func OperatorValues() []Operator {
+	return []Operator{
+		OperatorADD,
+		OperatorREMOVE,
+		OperatorSET,
+	}
+}
+
+// IsValid reports whether e is one of the values of Operator.
+func (e Operator) IsValid() bool {
+	switch e {
+	case OperatorADD,
+		OperatorREMOVE,
+		OperatorSET:
+		return true
+	}
+	return false
+}
+
+// ParseOperator returns the Operator named by s.
+func ParseOperator(s string) (Operator, error) {
+	e := Operator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid Operator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// Operator are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e Operator) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *Operator) UnmarshalText(text []byte) error {
+	v := Operator(text)
+	*e = v
+	return nil
+}
+
+// OperatorErrorReasonValues returns all values of OperatorErrorReason.
+func OperatorErrorReasonValues() []OperatorErrorReason {
+	return []OperatorErrorReason{
+		OperatorErrorReasonOPERATOR_NOT_SUPPORTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatorErrorReason.
+func (e OperatorErrorReason) IsValid() bool {
+	switch e {
+	case OperatorErrorReasonOPERATOR_NOT_SUPPORTED:
+		return true
+	}
+	return false
+}
+
+// ParseOperatorErrorReason returns the OperatorErrorReason named by s.
+func ParseOperatorErrorReason(s string) (OperatorErrorReason, error) {
+	e := OperatorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// OperatorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e OperatorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *OperatorErrorReason) UnmarshalText(text []byte) error {
+	v := OperatorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// PagingErrorReasonValues returns all values of PagingErrorReason.
+func PagingErrorReasonValues() []PagingErrorReason {
+	return []PagingErrorReason{
+		PagingErrorReasonSTART_INDEX_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonNUMBER_OF_RESULTS_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PagingErrorReason.
+func (e PagingErrorReason) IsValid() bool {
+	switch e {
+	case PagingErrorReasonSTART_INDEX_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonNUMBER_OF_RESULTS_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePagingErrorReason returns the PagingErrorReason named by s.
+func ParsePagingErrorReason(s string) (PagingErrorReason, error) {
+	e := PagingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PagingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PagingErrorReason.
+func (e PagingErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PagingErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PagingErrorReason) UnmarshalText(text []byte) error {
+	v := PagingErrorReason(text)
+	if !v.IsValid() {
+		v = PagingErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ParentParentTypeValues returns all values of ParentParentType.
+func ParentParentTypeValues() []ParentParentType {
+	return []ParentParentType{
+		ParentParentTypePARENT_PARENT,
+		ParentParentTypePARENT_NOT_A_PARENT,
+		ParentParentTypePARENT_UNDETERMINED,
+		ParentParentTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of ParentParentType.
+func (e ParentParentType) IsValid() bool {
+	switch e {
+	case ParentParentTypePARENT_PARENT,
+		ParentParentTypePARENT_NOT_A_PARENT,
+		ParentParentTypePARENT_UNDETERMINED,
+		ParentParentTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseParentParentType returns the ParentParentType named by s.
+func ParseParentParentType(s string) (ParentParentType, error) {
+	e := ParentParentType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ParentParentType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ParentParentType.
+func (e ParentParentType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ParentParentType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ParentParentType) UnmarshalText(text []byte) error {
+	v := ParentParentType(text)
+	if !v.IsValid() {
+		v = ParentParentTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PlacesOfInterestOperandCategoryValues returns all values of PlacesOfInterestOperandCategory.
+func PlacesOfInterestOperandCategoryValues() []PlacesOfInterestOperandCategory {
+	return []PlacesOfInterestOperandCategory{
+		PlacesOfInterestOperandCategoryAIRPORT,
+		PlacesOfInterestOperandCategoryDOWNTOWN,
+		PlacesOfInterestOperandCategoryUNIVERSITY,
+		PlacesOfInterestOperandCategoryUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PlacesOfInterestOperandCategory.
+func (e PlacesOfInterestOperandCategory) IsValid() bool {
+	switch e {
+	case PlacesOfInterestOperandCategoryAIRPORT,
+		PlacesOfInterestOperandCategoryDOWNTOWN,
+		PlacesOfInterestOperandCategoryUNIVERSITY,
+		PlacesOfInterestOperandCategoryUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePlacesOfInterestOperandCategory returns the PlacesOfInterestOperandCategory named by s.
+func ParsePlacesOfInterestOperandCategory(s string) (PlacesOfInterestOperandCategory, error) {
+	e := PlacesOfInterestOperandCategory(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PlacesOfInterestOperandCategory %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PlacesOfInterestOperandCategory.
+func (e PlacesOfInterestOperandCategory) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PlacesOfInterestOperandCategory %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PlacesOfInterestOperandCategory) UnmarshalText(text []byte) error {
+	v := PlacesOfInterestOperandCategory(text)
+	if !v.IsValid() {
+		v = PlacesOfInterestOperandCategoryUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PredicateOperatorValues returns all values of PredicateOperator.
+func PredicateOperatorValues() []PredicateOperator {
+	return []PredicateOperator{
+		PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PredicateOperator.
+func (e PredicateOperator) IsValid() bool {
+	switch e {
+	case PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePredicateOperator returns the PredicateOperator named by s.
+func ParsePredicateOperator(s string) (PredicateOperator, error) {
+	e := PredicateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PredicateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PredicateOperator.
+func (e PredicateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PredicateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PredicateOperator) UnmarshalText(text []byte) error {
+	v := PredicateOperator(text)
+	if !v.IsValid() {
+		v = PredicateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ProductCanonicalConditionConditionValues returns all values of ProductCanonicalConditionCondition.
+func ProductCanonicalConditionConditionValues() []ProductCanonicalConditionCondition {
+	return []ProductCanonicalConditionCondition{
+		ProductCanonicalConditionConditionNEW,
+		ProductCanonicalConditionConditionUSED,
+		ProductCanonicalConditionConditionREFURBISHED,
+		ProductCanonicalConditionConditionUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProductCanonicalConditionCondition.
+func (e ProductCanonicalConditionCondition) IsValid() bool {
+	switch e {
+	case ProductCanonicalConditionConditionNEW,
+		ProductCanonicalConditionConditionUSED,
+		ProductCanonicalConditionConditionREFURBISHED,
+		ProductCanonicalConditionConditionUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseProductCanonicalConditionCondition returns the ProductCanonicalConditionCondition named by s.
+func ParseProductCanonicalConditionCondition(s string) (ProductCanonicalConditionCondition, error) {
+	e := ProductCanonicalConditionCondition(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ProductCanonicalConditionCondition %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ProductCanonicalConditionCondition.
+func (e ProductCanonicalConditionCondition) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ProductCanonicalConditionCondition %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ProductCanonicalConditionCondition) UnmarshalText(text []byte) error {
+	v := ProductCanonicalConditionCondition(text)
+	if !v.IsValid() {
+		v = ProductCanonicalConditionConditionUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ProductDimensionTypeValues returns all values of ProductDimensionType.
+func ProductDimensionTypeValues() []ProductDimensionType {
+	return []ProductDimensionType{
+		ProductDimensionTypeUNKNOWN,
+		ProductDimensionTypeBIDDING_CATEGORY_L1,
+		ProductDimensionTypeBIDDING_CATEGORY_L2,
+		ProductDimensionTypeBIDDING_CATEGORY_L3,
+		ProductDimensionTypeBIDDING_CATEGORY_L4,
+		ProductDimensionTypeBIDDING_CATEGORY_L5,
+		ProductDimensionTypeBRAND,
+		ProductDimensionTypeCANONICAL_CONDITION,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_0,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_1,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_2,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_3,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_4,
+		ProductDimensionTypeOFFER_ID,
+		ProductDimensionTypePRODUCT_TYPE_L1,
+		ProductDimensionTypePRODUCT_TYPE_L2,
+		ProductDimensionTypePRODUCT_TYPE_L3,
+		ProductDimensionTypePRODUCT_TYPE_L4,
+		ProductDimensionTypePRODUCT_TYPE_L5,
+		ProductDimensionTypeCHANNEL,
+		ProductDimensionTypeCHANNEL_EXCLUSIVITY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProductDimensionType.
+func (e ProductDimensionType) IsValid() bool {
+	switch e {
+	case ProductDimensionTypeUNKNOWN,
+		ProductDimensionTypeBIDDING_CATEGORY_L1,
+		ProductDimensionTypeBIDDING_CATEGORY_L2,
+		ProductDimensionTypeBIDDING_CATEGORY_L3,
+		ProductDimensionTypeBIDDING_CATEGORY_L4,
+		ProductDimensionTypeBIDDING_CATEGORY_L5,
+		ProductDimensionTypeBRAND,
+		ProductDimensionTypeCANONICAL_CONDITION,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_0,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_1,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_2,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_3,
+		ProductDimensionTypeCUSTOM_ATTRIBUTE_4,
+		ProductDimensionTypeOFFER_ID,
+		ProductDimensionTypePRODUCT_TYPE_L1,
+		ProductDimensionTypePRODUCT_TYPE_L2,
+		ProductDimensionTypePRODUCT_TYPE_L3,
+		ProductDimensionTypePRODUCT_TYPE_L4,
+		ProductDimensionTypePRODUCT_TYPE_L5,
+		ProductDimensionTypeCHANNEL,
+		ProductDimensionTypeCHANNEL_EXCLUSIVITY:
+		return true
+	}
+	return false
+}
+
+// ParseProductDimensionType returns the ProductDimensionType named by s.
+func ParseProductDimensionType(s string) (ProductDimensionType, error) {
+	e := ProductDimensionType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ProductDimensionType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ProductDimensionType.
+func (e ProductDimensionType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ProductDimensionType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ProductDimensionType) UnmarshalText(text []byte) error {
+	v := ProductDimensionType(text)
+	if !v.IsValid() {
+		v = ProductDimensionTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ProximityDistanceUnitsValues returns all values of ProximityDistanceUnits.
+func ProximityDistanceUnitsValues() []ProximityDistanceUnits {
+	return []ProximityDistanceUnits{
+		ProximityDistanceUnitsKILOMETERS,
+		ProximityDistanceUnitsMILES,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProximityDistanceUnits.
+func (e ProximityDistanceUnits) IsValid() bool {
+	switch e {
+	case ProximityDistanceUnitsKILOMETERS,
+		ProximityDistanceUnitsMILES:
+		return true
+	}
+	return false
+}
+
+// ParseProximityDistanceUnits returns the ProximityDistanceUnits named by s.
+func ParseProximityDistanceUnits(s string) (ProximityDistanceUnits, error) {
+	e := ProximityDistanceUnits(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ProximityDistanceUnits %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ProximityDistanceUnits are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ProximityDistanceUnits) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ProximityDistanceUnits) UnmarshalText(text []byte) error {
+	v := ProximityDistanceUnits(text)
+	*e = v
+	return nil
+}
+
+// QueryErrorReasonValues returns all values of QueryErrorReason.
+func QueryErrorReasonValues() []QueryErrorReason {
+	return []QueryErrorReason{
+		QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QueryErrorReason.
+func (e QueryErrorReason) IsValid() bool {
+	switch e {
+	case QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED:
+		return true
+	}
+	return false
+}
+
+// ParseQueryErrorReason returns the QueryErrorReason named by s.
+func ParseQueryErrorReason(s string) (QueryErrorReason, error) {
+	e := QueryErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QueryErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QueryErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QueryErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QueryErrorReason) UnmarshalText(text []byte) error {
+	v := QueryErrorReason(text)
+	*e = v
+	return nil
+}
+
+// QuotaCheckErrorReasonValues returns all values of QuotaCheckErrorReason.
+func QuotaCheckErrorReasonValues() []QuotaCheckErrorReason {
+	return []QuotaCheckErrorReason{
+		QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QuotaCheckErrorReason.
+func (e QuotaCheckErrorReason) IsValid() bool {
+	switch e {
+	case QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseQuotaCheckErrorReason returns the QuotaCheckErrorReason named by s.
+func ParseQuotaCheckErrorReason(s string) (QuotaCheckErrorReason, error) {
+	e := QuotaCheckErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QuotaCheckErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QuotaCheckErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QuotaCheckErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QuotaCheckErrorReason) UnmarshalText(text []byte) error {
+	v := QuotaCheckErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RangeErrorReasonValues returns all values of RangeErrorReason.
+func RangeErrorReasonValues() []RangeErrorReason {
+	return []RangeErrorReason{
+		RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH,
+	}
+}
+
+// IsValid reports whether e is one of the values of RangeErrorReason.
+func (e RangeErrorReason) IsValid() bool {
+	switch e {
+	case RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH:
+		return true
+	}
+	return false
+}
+
+// ParseRangeErrorReason returns the RangeErrorReason named by s.
+func ParseRangeErrorReason(s string) (RangeErrorReason, error) {
+	e := RangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RangeErrorReason) UnmarshalText(text []byte) error {
+	v := RangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RateExceededErrorReasonValues returns all values of RateExceededErrorReason.
+func RateExceededErrorReasonValues() []RateExceededErrorReason {
+	return []RateExceededErrorReason{
+		RateExceededErrorReasonRATE_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RateExceededErrorReason.
+func (e RateExceededErrorReason) IsValid() bool {
+	switch e {
+	case RateExceededErrorReasonRATE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseRateExceededErrorReason returns the RateExceededErrorReason named by s.
+func ParseRateExceededErrorReason(s string) (RateExceededErrorReason, error) {
+	e := RateExceededErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RateExceededErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RateExceededErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RateExceededErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RateExceededErrorReason) UnmarshalText(text []byte) error {
+	v := RateExceededErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ReadOnlyErrorReasonValues returns all values of ReadOnlyErrorReason.
+func ReadOnlyErrorReasonValues() []ReadOnlyErrorReason {
+	return []ReadOnlyErrorReason{
+		ReadOnlyErrorReasonREAD_ONLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ReadOnlyErrorReason.
+func (e ReadOnlyErrorReason) IsValid() bool {
+	switch e {
+	case ReadOnlyErrorReasonREAD_ONLY:
+		return true
+	}
+	return false
+}
+
+// ParseReadOnlyErrorReason returns the ReadOnlyErrorReason named by s.
+func ParseReadOnlyErrorReason(s string) (ReadOnlyErrorReason, error) {
+	e := ReadOnlyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ReadOnlyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ReadOnlyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ReadOnlyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ReadOnlyErrorReason) UnmarshalText(text []byte) error {
+	v := ReadOnlyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RegionCodeErrorReasonValues returns all values of RegionCodeErrorReason.
+func RegionCodeErrorReasonValues() []RegionCodeErrorReason {
+	return []RegionCodeErrorReason{
+		RegionCodeErrorReasonINVALID_REGION_CODE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RegionCodeErrorReason.
+func (e RegionCodeErrorReason) IsValid() bool {
+	switch e {
+	case RegionCodeErrorReasonINVALID_REGION_CODE:
+		return true
+	}
+	return false
+}
+
+// ParseRegionCodeErrorReason returns the RegionCodeErrorReason named by s.
+func ParseRegionCodeErrorReason(s string) (RegionCodeErrorReason, error) {
+	e := RegionCodeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RegionCodeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RegionCodeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RegionCodeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RegionCodeErrorReason) UnmarshalText(text []byte) error {
+	v := RegionCodeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RejectedErrorReasonValues returns all values of RejectedErrorReason.
+func RejectedErrorReasonValues() []RejectedErrorReason {
+	return []RejectedErrorReason{
+		RejectedErrorReasonUNKNOWN_VALUE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RejectedErrorReason.
+func (e RejectedErrorReason) IsValid() bool {
+	switch e {
+	case RejectedErrorReasonUNKNOWN_VALUE:
+		return true
+	}
+	return false
+}
+
+// ParseRejectedErrorReason returns the RejectedErrorReason named by s.
+func ParseRejectedErrorReason(s string) (RejectedErrorReason, error) {
+	e := RejectedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RejectedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RejectedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RejectedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RejectedErrorReason) UnmarshalText(text []byte) error {
+	v := RejectedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RequestErrorReasonValues returns all values of RequestErrorReason.
+func RequestErrorReasonValues() []RequestErrorReason {
+	return []RequestErrorReason{
+		RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequestErrorReason.
+func (e RequestErrorReason) IsValid() bool {
+	switch e {
+	case RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION:
+		return true
+	}
+	return false
+}
+
+// ParseRequestErrorReason returns the RequestErrorReason named by s.
+func ParseRequestErrorReason(s string) (RequestErrorReason, error) {
+	e := RequestErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequestErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of RequestErrorReason.
+func (e RequestErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid RequestErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *RequestErrorReason) UnmarshalText(text []byte) error {
+	v := RequestErrorReason(text)
+	if !v.IsValid() {
+		v = RequestErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RequiredErrorReasonValues returns all values of RequiredErrorReason.
+func RequiredErrorReasonValues() []RequiredErrorReason {
+	return []RequiredErrorReason{
+		RequiredErrorReasonREQUIRED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequiredErrorReason.
+func (e RequiredErrorReason) IsValid() bool {
+	switch e {
+	case RequiredErrorReasonREQUIRED:
+		return true
+	}
+	return false
+}
+
+// ParseRequiredErrorReason returns the RequiredErrorReason named by s.
+func ParseRequiredErrorReason(s string) (RequiredErrorReason, error) {
+	e := RequiredErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequiredErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RequiredErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RequiredErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RequiredErrorReason) UnmarshalText(text []byte) error {
+	v := RequiredErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SelectorErrorReasonValues returns all values of SelectorErrorReason.
+func SelectorErrorReasonValues() []SelectorErrorReason {
+	return []SelectorErrorReason{
+		SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of SelectorErrorReason.
+func (e SelectorErrorReason) IsValid() bool {
+	switch e {
+	case SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseSelectorErrorReason returns the SelectorErrorReason named by s.
+func ParseSelectorErrorReason(s string) (SelectorErrorReason, error) {
+	e := SelectorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SelectorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SelectorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SelectorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SelectorErrorReason) UnmarshalText(text []byte) error {
+	v := SelectorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ShoppingProductChannelValues returns all values of ShoppingProductChannel.
+func ShoppingProductChannelValues() []ShoppingProductChannel {
+	return []ShoppingProductChannel{
+		ShoppingProductChannelUNKNOWN,
+		ShoppingProductChannelONLINE,
+		ShoppingProductChannelLOCAL,
+	}
+}
+
+// IsValid reports whether e is one of the values of ShoppingProductChannel.
+func (e ShoppingProductChannel) IsValid() bool {
+	switch e {
+	case ShoppingProductChannelUNKNOWN,
+		ShoppingProductChannelONLINE,
+		ShoppingProductChannelLOCAL:
+		return true
+	}
+	return false
+}
+
+// ParseShoppingProductChannel returns the ShoppingProductChannel named by s.
+func ParseShoppingProductChannel(s string) (ShoppingProductChannel, error) {
+	e := ShoppingProductChannel(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ShoppingProductChannel %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ShoppingProductChannel.
+func (e ShoppingProductChannel) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ShoppingProductChannel %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ShoppingProductChannel) UnmarshalText(text []byte) error {
+	v := ShoppingProductChannel(text)
+	if !v.IsValid() {
+		v = ShoppingProductChannelUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ShoppingProductChannelExclusivityValues returns all values of ShoppingProductChannelExclusivity.
+func ShoppingProductChannelExclusivityValues() []ShoppingProductChannelExclusivity {
+	return []ShoppingProductChannelExclusivity{
+		ShoppingProductChannelExclusivityUNKNOWN,
+		ShoppingProductChannelExclusivitySINGLE_CHANNEL,
+		ShoppingProductChannelExclusivityMULTI_CHANNEL,
+	}
+}
+
+// IsValid reports whether e is one of the values of ShoppingProductChannelExclusivity.
+func (e ShoppingProductChannelExclusivity) IsValid() bool {
+	switch e {
+	case ShoppingProductChannelExclusivityUNKNOWN,
+		ShoppingProductChannelExclusivitySINGLE_CHANNEL,
+		ShoppingProductChannelExclusivityMULTI_CHANNEL:
+		return true
+	}
+	return false
+}
+
+// ParseShoppingProductChannelExclusivity returns the ShoppingProductChannelExclusivity named by s.
+func ParseShoppingProductChannelExclusivity(s string) (ShoppingProductChannelExclusivity, error) {
+	e := ShoppingProductChannelExclusivity(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ShoppingProductChannelExclusivity %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ShoppingProductChannelExclusivity.
+func (e ShoppingProductChannelExclusivity) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ShoppingProductChannelExclusivity %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ShoppingProductChannelExclusivity) UnmarshalText(text []byte) error {
+	v := ShoppingProductChannelExclusivity(text)
+	if !v.IsValid() {
+		v = ShoppingProductChannelExclusivityUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SizeLimitErrorReasonValues returns all values of SizeLimitErrorReason.
+func SizeLimitErrorReasonValues() []SizeLimitErrorReason {
+	return []SizeLimitErrorReason{
+		SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) IsValid() bool {
+	switch e {
+	case SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSizeLimitErrorReason returns the SizeLimitErrorReason named by s.
+func ParseSizeLimitErrorReason(s string) (SizeLimitErrorReason, error) {
+	e := SizeLimitErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeLimitErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SizeLimitErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SizeLimitErrorReason) UnmarshalText(text []byte) error {
+	v := SizeLimitErrorReason(text)
+	if !v.IsValid() {
+		v = SizeLimitErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SortOrderValues returns all values of SortOrder.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASCENDING,
+		SortOrderDESCENDING,
+	}
+}
+
+// IsValid reports whether e is one of the values of SortOrder.
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderASCENDING,
+		SortOrderDESCENDING:
+		return true
+	}
+	return false
+}
+
+// ParseSortOrder returns the SortOrder named by s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	e := SortOrder(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SortOrder %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SortOrder are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	*e = v
+	return nil
+}
+
+// StringFormatErrorReasonValues returns all values of StringFormatErrorReason.
+func StringFormatErrorReasonValues() []StringFormatErrorReason {
+	return []StringFormatErrorReason{
+		StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringFormatErrorReason.
+func (e StringFormatErrorReason) IsValid() bool {
+	switch e {
+	case StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT:
+		return true
+	}
+	return false
+}
+
+// ParseStringFormatErrorReason returns the StringFormatErrorReason named by s.
+func ParseStringFormatErrorReason(s string) (StringFormatErrorReason, error) {
+	e := StringFormatErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringFormatErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringFormatErrorReason.
+func (e StringFormatErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringFormatErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringFormatErrorReason) UnmarshalText(text []byte) error {
+	v := StringFormatErrorReason(text)
+	if !v.IsValid() {
+		v = StringFormatErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// StringLengthErrorReasonValues returns all values of StringLengthErrorReason.
+func StringLengthErrorReasonValues() []StringLengthErrorReason {
+	return []StringLengthErrorReason{
+		StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringLengthErrorReason.
+func (e StringLengthErrorReason) IsValid() bool {
+	switch e {
+	case StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG:
+		return true
+	}
+	return false
+}
+
+// ParseStringLengthErrorReason returns the StringLengthErrorReason named by s.
+func ParseStringLengthErrorReason(s string) (StringLengthErrorReason, error) {
+	e := StringLengthErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringLengthErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StringLengthErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StringLengthErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StringLengthErrorReason) UnmarshalText(text []byte) error {
+	v := StringLengthErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CriterionUserListMembershipStatusValues returns all values of CriterionUserListMembershipStatus.
+func CriterionUserListMembershipStatusValues() []CriterionUserListMembershipStatus {
+	return []CriterionUserListMembershipStatus{
+		CriterionUserListMembershipStatusOPEN,
+		CriterionUserListMembershipStatusCLOSED,
+	}
+}
+
+// IsValid reports whether e is one of the values of CriterionUserListMembershipStatus.
+func (e CriterionUserListMembershipStatus) IsValid() bool {
+	switch e {
+	case CriterionUserListMembershipStatusOPEN,
+		CriterionUserListMembershipStatusCLOSED:
+		return true
+	}
+	return false
+}
+
+// ParseCriterionUserListMembershipStatus returns the CriterionUserListMembershipStatus named by s.
+func ParseCriterionUserListMembershipStatus(s string) (CriterionUserListMembershipStatus, error) {
+	e := CriterionUserListMembershipStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CriterionUserListMembershipStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// CriterionUserListMembershipStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e CriterionUserListMembershipStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *CriterionUserListMembershipStatus) UnmarshalText(text []byte) error {
+	v := CriterionUserListMembershipStatus(text)
+	*e = v
+	return nil
+}
+
+// WebpageConditionOperandValues returns all values of WebpageConditionOperand.
+func WebpageConditionOperandValues() []WebpageConditionOperand {
+	return []WebpageConditionOperand{
+		WebpageConditionOperandURL,
+		WebpageConditionOperandCATEGORY,
+		WebpageConditionOperandPAGE_TITLE,
+		WebpageConditionOperandPAGE_CONTENT,
+		WebpageConditionOperandCUSTOM_LABEL,
+		WebpageConditionOperandUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of WebpageConditionOperand.
+func (e WebpageConditionOperand) IsValid() bool {
+	switch e {
+	case WebpageConditionOperandURL,
+		WebpageConditionOperandCATEGORY,
+		WebpageConditionOperandPAGE_TITLE,
+		WebpageConditionOperandPAGE_CONTENT,
+		WebpageConditionOperandCUSTOM_LABEL,
+		WebpageConditionOperandUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseWebpageConditionOperand returns the WebpageConditionOperand named by s.
+func ParseWebpageConditionOperand(s string) (WebpageConditionOperand, error) {
+	e := WebpageConditionOperand(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid WebpageConditionOperand %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of WebpageConditionOperand.
+func (e WebpageConditionOperand) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid WebpageConditionOperand %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *WebpageConditionOperand) UnmarshalText(text []byte) error {
+	v := WebpageConditionOperand(text)
+	if !v.IsValid() {
+		v = WebpageConditionOperandUNKNOWN
+	}
+	*e = v
+	return nil
+}
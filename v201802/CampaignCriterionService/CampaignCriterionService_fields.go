@@ -0,0 +1,1148 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignCriterionService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AdScheduleFields holds the selector field names of AdSchedule, including
+// the fields of the types it holds.
+var AdScheduleFields = struct {
+	CriteriaType string
+	DayOfWeek    string
+	EndHour      string
+	EndMinute    string
+	Id           string
+	StartHour    string
+	StartMinute  string
+}{
+	CriteriaType: "CriteriaType",
+	DayOfWeek:    "DayOfWeek",
+	EndHour:      "EndHour",
+	EndMinute:    "EndMinute",
+	Id:           "Id",
+	StartHour:    "StartHour",
+	StartMinute:  "StartMinute",
+}
+
+// AgeRangeFields holds the selector field names of AgeRange, including
+// the fields of the types it holds.
+var AgeRangeFields = struct {
+	AgeRangeType string
+	CriteriaType string
+	Id           string
+}{
+	AgeRangeType: "AgeRangeType",
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// CampaignCriterionFields holds the selector field names of CampaignCriterion, including
+// the fields of the types it holds.
+var CampaignCriterionFields = struct {
+	Address                    string
+	AgeRangeType               string
+	AppId                      string
+	BaseCampaignId             string
+	BidModifier                string
+	CampaignCriterionStatus    string
+	CampaignId                 string
+	CarrierCountryCode         string
+	CarrierName                string
+	ChannelId                  string
+	ChannelName                string
+	ContentLabelType           string
+	CriteriaType               string
+	DayOfWeek                  string
+	DeviceName                 string
+	DeviceType                 string
+	Dimensions                 string
+	DisplayName                string
+	DisplayType                string
+	EndHour                    string
+	EndMinute                  string
+	FeedId                     string
+	GenderType                 string
+	GeoPoint                   string
+	Id                         string
+	IncomeRangeType            string
+	IpAddress                  string
+	IsNegative                 string
+	KeywordMatchType           string
+	KeywordText                string
+	LanguageCode               string
+	LanguageName               string
+	LocationName               string
+	ManufacturerName           string
+	MatchingFunction           string
+	MobileAppCategoryId        string
+	OperatingSystemName        string
+	OperatorType               string
+	OsMajorVersion             string
+	OsMinorVersion             string
+	Parameter                  string
+	ParentLocations            string
+	ParentType                 string
+	Path                       string
+	PlacementUrl               string
+	PlatformName               string
+	RadiusDistanceUnits        string
+	RadiusInUnits              string
+	StartHour                  string
+	StartMinute                string
+	TargetingStatus            string
+	UserInterestId             string
+	UserInterestName           string
+	UserInterestParentId       string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+	VerticalId                 string
+	VerticalParentId           string
+	VideoId                    string
+	VideoName                  string
+}{
+	Address:                    "Address",
+	AgeRangeType:               "AgeRangeType",
+	AppId:                      "AppId",
+	BaseCampaignId:             "BaseCampaignId",
+	BidModifier:                "BidModifier",
+	CampaignCriterionStatus:    "CampaignCriterionStatus",
+	CampaignId:                 "CampaignId",
+	CarrierCountryCode:         "CarrierCountryCode",
+	CarrierName:                "CarrierName",
+	ChannelId:                  "ChannelId",
+	ChannelName:                "ChannelName",
+	ContentLabelType:           "ContentLabelType",
+	CriteriaType:               "CriteriaType",
+	DayOfWeek:                  "DayOfWeek",
+	DeviceName:                 "DeviceName",
+	DeviceType:                 "DeviceType",
+	Dimensions:                 "Dimensions",
+	DisplayName:                "DisplayName",
+	DisplayType:                "DisplayType",
+	EndHour:                    "EndHour",
+	EndMinute:                  "EndMinute",
+	FeedId:                     "FeedId",
+	GenderType:                 "GenderType",
+	GeoPoint:                   "GeoPoint",
+	Id:                         "Id",
+	IncomeRangeType:            "IncomeRangeType",
+	IpAddress:                  "IpAddress",
+	IsNegative:                 "IsNegative",
+	KeywordMatchType:           "KeywordMatchType",
+	KeywordText:                "KeywordText",
+	LanguageCode:               "LanguageCode",
+	LanguageName:               "LanguageName",
+	LocationName:               "LocationName",
+	ManufacturerName:           "ManufacturerName",
+	MatchingFunction:           "MatchingFunction",
+	MobileAppCategoryId:        "MobileAppCategoryId",
+	OperatingSystemName:        "OperatingSystemName",
+	OperatorType:               "OperatorType",
+	OsMajorVersion:             "OsMajorVersion",
+	OsMinorVersion:             "OsMinorVersion",
+	Parameter:                  "Parameter",
+	ParentLocations:            "ParentLocations",
+	ParentType:                 "ParentType",
+	Path:                       "Path",
+	PlacementUrl:               "PlacementUrl",
+	PlatformName:               "PlatformName",
+	RadiusDistanceUnits:        "RadiusDistanceUnits",
+	RadiusInUnits:              "RadiusInUnits",
+	StartHour:                  "StartHour",
+	StartMinute:                "StartMinute",
+	TargetingStatus:            "TargetingStatus",
+	UserInterestId:             "UserInterestId",
+	UserInterestName:           "UserInterestName",
+	UserInterestParentId:       "UserInterestParentId",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+	VerticalId:                 "VerticalId",
+	VerticalParentId:           "VerticalParentId",
+	VideoId:                    "VideoId",
+	VideoName:                  "VideoName",
+}
+
+// CarrierFields holds the selector field names of Carrier, including
+// the fields of the types it holds.
+var CarrierFields = struct {
+	CarrierCountryCode string
+	CarrierName        string
+	CriteriaType       string
+	Id                 string
+}{
+	CarrierCountryCode: "CarrierCountryCode",
+	CarrierName:        "CarrierName",
+	CriteriaType:       "CriteriaType",
+	Id:                 "Id",
+}
+
+// ContentLabelFields holds the selector field names of ContentLabel, including
+// the fields of the types it holds.
+var ContentLabelFields = struct {
+	ContentLabelType string
+	CriteriaType     string
+	Id               string
+}{
+	ContentLabelType: "ContentLabelType",
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+}
+
+// CriterionFields holds the selector field names of Criterion, including
+// the fields of the types it holds.
+var CriterionFields = struct {
+	Address                    string
+	AgeRangeType               string
+	AppId                      string
+	CarrierCountryCode         string
+	CarrierName                string
+	ChannelId                  string
+	ChannelName                string
+	ContentLabelType           string
+	CriteriaType               string
+	DayOfWeek                  string
+	DeviceName                 string
+	DeviceType                 string
+	Dimensions                 string
+	DisplayName                string
+	DisplayType                string
+	EndHour                    string
+	EndMinute                  string
+	FeedId                     string
+	GenderType                 string
+	GeoPoint                   string
+	Id                         string
+	IncomeRangeType            string
+	IpAddress                  string
+	KeywordMatchType           string
+	KeywordText                string
+	LanguageCode               string
+	LanguageName               string
+	LocationName               string
+	ManufacturerName           string
+	MatchingFunction           string
+	MobileAppCategoryId        string
+	OperatingSystemName        string
+	OperatorType               string
+	OsMajorVersion             string
+	OsMinorVersion             string
+	Parameter                  string
+	ParentLocations            string
+	ParentType                 string
+	Path                       string
+	PlacementUrl               string
+	PlatformName               string
+	RadiusDistanceUnits        string
+	RadiusInUnits              string
+	StartHour                  string
+	StartMinute                string
+	TargetingStatus            string
+	UserInterestId             string
+	UserInterestName           string
+	UserInterestParentId       string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+	VerticalId                 string
+	VerticalParentId           string
+	VideoId                    string
+	VideoName                  string
+}{
+	Address:                    "Address",
+	AgeRangeType:               "AgeRangeType",
+	AppId:                      "AppId",
+	CarrierCountryCode:         "CarrierCountryCode",
+	CarrierName:                "CarrierName",
+	ChannelId:                  "ChannelId",
+	ChannelName:                "ChannelName",
+	ContentLabelType:           "ContentLabelType",
+	CriteriaType:               "CriteriaType",
+	DayOfWeek:                  "DayOfWeek",
+	DeviceName:                 "DeviceName",
+	DeviceType:                 "DeviceType",
+	Dimensions:                 "Dimensions",
+	DisplayName:                "DisplayName",
+	DisplayType:                "DisplayType",
+	EndHour:                    "EndHour",
+	EndMinute:                  "EndMinute",
+	FeedId:                     "FeedId",
+	GenderType:                 "GenderType",
+	GeoPoint:                   "GeoPoint",
+	Id:                         "Id",
+	IncomeRangeType:            "IncomeRangeType",
+	IpAddress:                  "IpAddress",
+	KeywordMatchType:           "KeywordMatchType",
+	KeywordText:                "KeywordText",
+	LanguageCode:               "LanguageCode",
+	LanguageName:               "LanguageName",
+	LocationName:               "LocationName",
+	ManufacturerName:           "ManufacturerName",
+	MatchingFunction:           "MatchingFunction",
+	MobileAppCategoryId:        "MobileAppCategoryId",
+	OperatingSystemName:        "OperatingSystemName",
+	OperatorType:               "OperatorType",
+	OsMajorVersion:             "OsMajorVersion",
+	OsMinorVersion:             "OsMinorVersion",
+	Parameter:                  "Parameter",
+	ParentLocations:            "ParentLocations",
+	ParentType:                 "ParentType",
+	Path:                       "Path",
+	PlacementUrl:               "PlacementUrl",
+	PlatformName:               "PlatformName",
+	RadiusDistanceUnits:        "RadiusDistanceUnits",
+	RadiusInUnits:              "RadiusInUnits",
+	StartHour:                  "StartHour",
+	StartMinute:                "StartMinute",
+	TargetingStatus:            "TargetingStatus",
+	UserInterestId:             "UserInterestId",
+	UserInterestName:           "UserInterestName",
+	UserInterestParentId:       "UserInterestParentId",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+	VerticalId:                 "VerticalId",
+	VerticalParentId:           "VerticalParentId",
+	VideoId:                    "VideoId",
+	VideoName:                  "VideoName",
+}
+
+// GenderFields holds the selector field names of Gender, including
+// the fields of the types it holds.
+var GenderFields = struct {
+	CriteriaType string
+	GenderType   string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	GenderType:   "GenderType",
+	Id:           "Id",
+}
+
+// IncomeRangeFields holds the selector field names of IncomeRange, including
+// the fields of the types it holds.
+var IncomeRangeFields = struct {
+	CriteriaType    string
+	Id              string
+	IncomeRangeType string
+}{
+	CriteriaType:    "CriteriaType",
+	Id:              "Id",
+	IncomeRangeType: "IncomeRangeType",
+}
+
+// IpBlockFields holds the selector field names of IpBlock, including
+// the fields of the types it holds.
+var IpBlockFields = struct {
+	CriteriaType string
+	Id           string
+	IpAddress    string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	IpAddress:    "IpAddress",
+}
+
+// KeywordFields holds the selector field names of Keyword, including
+// the fields of the types it holds.
+var KeywordFields = struct {
+	CriteriaType     string
+	Id               string
+	KeywordMatchType string
+	KeywordText      string
+}{
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+}
+
+// LanguageFields holds the selector field names of Language, including
+// the fields of the types it holds.
+var LanguageFields = struct {
+	CriteriaType string
+	Id           string
+	LanguageCode string
+	LanguageName string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	LanguageCode: "LanguageCode",
+	LanguageName: "LanguageName",
+}
+
+// LocationFields holds the selector field names of Location, including
+// the fields of the types it holds.
+var LocationFields = struct {
+	CriteriaType    string
+	DisplayType     string
+	Id              string
+	LocationName    string
+	ParentLocations string
+	TargetingStatus string
+}{
+	CriteriaType:    "CriteriaType",
+	DisplayType:     "DisplayType",
+	Id:              "Id",
+	LocationName:    "LocationName",
+	ParentLocations: "ParentLocations",
+	TargetingStatus: "TargetingStatus",
+}
+
+// MobileAppCategoryFields holds the selector field names of MobileAppCategory, including
+// the fields of the types it holds.
+var MobileAppCategoryFields = struct {
+	CriteriaType        string
+	Id                  string
+	MobileAppCategoryId string
+}{
+	CriteriaType:        "CriteriaType",
+	Id:                  "Id",
+	MobileAppCategoryId: "MobileAppCategoryId",
+}
+
+// MobileApplicationFields holds the selector field names of MobileApplication, including
+// the fields of the types it holds.
+var MobileApplicationFields = struct {
+	AppId        string
+	CriteriaType string
+	DisplayName  string
+	Id           string
+}{
+	AppId:        "AppId",
+	CriteriaType: "CriteriaType",
+	DisplayName:  "DisplayName",
+	Id:           "Id",
+}
+
+// MobileDeviceFields holds the selector field names of MobileDevice, including
+// the fields of the types it holds.
+var MobileDeviceFields = struct {
+	CriteriaType        string
+	DeviceName          string
+	DeviceType          string
+	Id                  string
+	ManufacturerName    string
+	OperatingSystemName string
+}{
+	CriteriaType:        "CriteriaType",
+	DeviceName:          "DeviceName",
+	DeviceType:          "DeviceType",
+	Id:                  "Id",
+	ManufacturerName:    "ManufacturerName",
+	OperatingSystemName: "OperatingSystemName",
+}
+
+// NegativeCampaignCriterionFields holds the selector field names of NegativeCampaignCriterion, including
+// the fields of the types it holds.
+var NegativeCampaignCriterionFields = struct {
+	Address                    string
+	AgeRangeType               string
+	AppId                      string
+	BaseCampaignId             string
+	BidModifier                string
+	CampaignCriterionStatus    string
+	CampaignId                 string
+	CarrierCountryCode         string
+	CarrierName                string
+	ChannelId                  string
+	ChannelName                string
+	ContentLabelType           string
+	CriteriaType               string
+	DayOfWeek                  string
+	DeviceName                 string
+	DeviceType                 string
+	Dimensions                 string
+	DisplayName                string
+	DisplayType                string
+	EndHour                    string
+	EndMinute                  string
+	FeedId                     string
+	GenderType                 string
+	GeoPoint                   string
+	Id                         string
+	IncomeRangeType            string
+	IpAddress                  string
+	IsNegative                 string
+	KeywordMatchType           string
+	KeywordText                string
+	LanguageCode               string
+	LanguageName               string
+	LocationName               string
+	ManufacturerName           string
+	MatchingFunction           string
+	MobileAppCategoryId        string
+	OperatingSystemName        string
+	OperatorType               string
+	OsMajorVersion             string
+	OsMinorVersion             string
+	Parameter                  string
+	ParentLocations            string
+	ParentType                 string
+	Path                       string
+	PlacementUrl               string
+	PlatformName               string
+	RadiusDistanceUnits        string
+	RadiusInUnits              string
+	StartHour                  string
+	StartMinute                string
+	TargetingStatus            string
+	UserInterestId             string
+	UserInterestName           string
+	UserInterestParentId       string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+	VerticalId                 string
+	VerticalParentId           string
+	VideoId                    string
+	VideoName                  string
+}{
+	Address:                    "Address",
+	AgeRangeType:               "AgeRangeType",
+	AppId:                      "AppId",
+	BaseCampaignId:             "BaseCampaignId",
+	BidModifier:                "BidModifier",
+	CampaignCriterionStatus:    "CampaignCriterionStatus",
+	CampaignId:                 "CampaignId",
+	CarrierCountryCode:         "CarrierCountryCode",
+	CarrierName:                "CarrierName",
+	ChannelId:                  "ChannelId",
+	ChannelName:                "ChannelName",
+	ContentLabelType:           "ContentLabelType",
+	CriteriaType:               "CriteriaType",
+	DayOfWeek:                  "DayOfWeek",
+	DeviceName:                 "DeviceName",
+	DeviceType:                 "DeviceType",
+	Dimensions:                 "Dimensions",
+	DisplayName:                "DisplayName",
+	DisplayType:                "DisplayType",
+	EndHour:                    "EndHour",
+	EndMinute:                  "EndMinute",
+	FeedId:                     "FeedId",
+	GenderType:                 "GenderType",
+	GeoPoint:                   "GeoPoint",
+	Id:                         "Id",
+	IncomeRangeType:            "IncomeRangeType",
+	IpAddress:                  "IpAddress",
+	IsNegative:                 "IsNegative",
+	KeywordMatchType:           "KeywordMatchType",
+	KeywordText:                "KeywordText",
+	LanguageCode:               "LanguageCode",
+	LanguageName:               "LanguageName",
+	LocationName:               "LocationName",
+	ManufacturerName:           "ManufacturerName",
+	MatchingFunction:           "MatchingFunction",
+	MobileAppCategoryId:        "MobileAppCategoryId",
+	OperatingSystemName:        "OperatingSystemName",
+	OperatorType:               "OperatorType",
+	OsMajorVersion:             "OsMajorVersion",
+	OsMinorVersion:             "OsMinorVersion",
+	Parameter:                  "Parameter",
+	ParentLocations:            "ParentLocations",
+	ParentType:                 "ParentType",
+	Path:                       "Path",
+	PlacementUrl:               "PlacementUrl",
+	PlatformName:               "PlatformName",
+	RadiusDistanceUnits:        "RadiusDistanceUnits",
+	RadiusInUnits:              "RadiusInUnits",
+	StartHour:                  "StartHour",
+	StartMinute:                "StartMinute",
+	TargetingStatus:            "TargetingStatus",
+	UserInterestId:             "UserInterestId",
+	UserInterestName:           "UserInterestName",
+	UserInterestParentId:       "UserInterestParentId",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+	VerticalId:                 "VerticalId",
+	VerticalParentId:           "VerticalParentId",
+	VideoId:                    "VideoId",
+	VideoName:                  "VideoName",
+}
+
+// OperatingSystemVersionFields holds the selector field names of OperatingSystemVersion, including
+// the fields of the types it holds.
+var OperatingSystemVersionFields = struct {
+	CriteriaType        string
+	Id                  string
+	OperatingSystemName string
+	OperatorType        string
+	OsMajorVersion      string
+	OsMinorVersion      string
+}{
+	CriteriaType:        "CriteriaType",
+	Id:                  "Id",
+	OperatingSystemName: "OperatingSystemName",
+	OperatorType:        "OperatorType",
+	OsMajorVersion:      "OsMajorVersion",
+	OsMinorVersion:      "OsMinorVersion",
+}
+
+// ParentFields holds the selector field names of Parent, including
+// the fields of the types it holds.
+var ParentFields = struct {
+	CriteriaType string
+	Id           string
+	ParentType   string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	ParentType:   "ParentType",
+}
+
+// PlacementFields holds the selector field names of Placement, including
+// the fields of the types it holds.
+var PlacementFields = struct {
+	CriteriaType string
+	Id           string
+	PlacementUrl string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	PlacementUrl: "PlacementUrl",
+}
+
+// PlatformFields holds the selector field names of Platform, including
+// the fields of the types it holds.
+var PlatformFields = struct {
+	CriteriaType string
+	Id           string
+	PlatformName string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	PlatformName: "PlatformName",
+}
+
+// ProductScopeFields holds the selector field names of ProductScope, including
+// the fields of the types it holds.
+var ProductScopeFields = struct {
+	CriteriaType string
+	Dimensions   string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Dimensions:   "Dimensions",
+	Id:           "Id",
+}
+
+// ProximityFields holds the selector field names of Proximity, including
+// the fields of the types it holds.
+var ProximityFields = struct {
+	Address             string
+	CriteriaType        string
+	GeoPoint            string
+	Id                  string
+	RadiusDistanceUnits string
+	RadiusInUnits       string
+}{
+	Address:             "Address",
+	CriteriaType:        "CriteriaType",
+	GeoPoint:            "GeoPoint",
+	Id:                  "Id",
+	RadiusDistanceUnits: "RadiusDistanceUnits",
+	RadiusInUnits:       "RadiusInUnits",
+}
+
+// LocationGroupsFields holds the selector field names of LocationGroups, including
+// the fields of the types it holds.
+var LocationGroupsFields = struct {
+	CriteriaType     string
+	FeedId           string
+	Id               string
+	MatchingFunction string
+}{
+	CriteriaType:     "CriteriaType",
+	FeedId:           "FeedId",
+	Id:               "Id",
+	MatchingFunction: "MatchingFunction",
+}
+
+// CriterionUserInterestFields holds the selector field names of CriterionUserInterest, including
+// the fields of the types it holds.
+var CriterionUserInterestFields = struct {
+	CriteriaType         string
+	Id                   string
+	UserInterestId       string
+	UserInterestName     string
+	UserInterestParentId string
+}{
+	CriteriaType:         "CriteriaType",
+	Id:                   "Id",
+	UserInterestId:       "UserInterestId",
+	UserInterestName:     "UserInterestName",
+	UserInterestParentId: "UserInterestParentId",
+}
+
+// CriterionUserListFields holds the selector field names of CriterionUserList, including
+// the fields of the types it holds.
+var CriterionUserListFields = struct {
+	CriteriaType               string
+	Id                         string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+}{
+	CriteriaType:               "CriteriaType",
+	Id:                         "Id",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+}
+
+// VerticalFields holds the selector field names of Vertical, including
+// the fields of the types it holds.
+var VerticalFields = struct {
+	CriteriaType     string
+	Id               string
+	Path             string
+	VerticalId       string
+	VerticalParentId string
+}{
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	Path:             "Path",
+	VerticalId:       "VerticalId",
+	VerticalParentId: "VerticalParentId",
+}
+
+// WebpageFields holds the selector field names of Webpage, including
+// the fields of the types it holds.
+var WebpageFields = struct {
+	CriteriaType string
+	Id           string
+	Parameter    string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	Parameter:    "Parameter",
+}
+
+// YouTubeChannelFields holds the selector field names of YouTubeChannel, including
+// the fields of the types it holds.
+var YouTubeChannelFields = struct {
+	ChannelId    string
+	ChannelName  string
+	CriteriaType string
+	Id           string
+}{
+	ChannelId:    "ChannelId",
+	ChannelName:  "ChannelName",
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// YouTubeVideoFields holds the selector field names of YouTubeVideo, including
+// the fields of the types it holds.
+var YouTubeVideoFields = struct {
+	CriteriaType string
+	Id           string
+	VideoId      string
+	VideoName    string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	VideoId:      "VideoId",
+	VideoName:    "VideoName",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"AdSchedule": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "AdSchedule", Name: "DayOfWeek", Selectors: []string{"DayOfWeek"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdSchedule", Name: "StartHour", Selectors: []string{"StartHour"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdSchedule", Name: "StartMinute", Selectors: []string{"StartMinute"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdSchedule", Name: "EndHour", Selectors: []string{"EndHour"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdSchedule", Name: "EndMinute", Selectors: []string{"EndMinute"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"AgeRange": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "AgeRange", Name: "AgeRangeType", Selectors: []string{"AgeRangeType"}, ReadOnly: true},
+	},
+	"CampaignCriterion": {
+		{Type: "CampaignCriterion", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId", Required: true},
+		{Type: "CampaignCriterion", Name: "IsNegative", Selectors: []string{"IsNegative"}, Filter: "IsNegative", ReadOnly: true},
+		{Type: "CampaignCriterion", Name: "Criterion", Required: true},
+		{Type: "CampaignCriterion", Name: "BidModifier", Selectors: []string{"BidModifier"}, Filter: "BidModifier"},
+		{Type: "CampaignCriterion", Name: "CampaignCriterionStatus", Selectors: []string{"CampaignCriterionStatus"}, Filter: "CampaignCriterionStatus"},
+		{Type: "CampaignCriterion", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, Filter: "BaseCampaignId", ReadOnly: true},
+		{Type: "CampaignCriterion", Name: "ForwardCompatibilityMap"},
+		{Type: "CampaignCriterion", Name: "CampaignCriterionType"},
+	},
+	"CampaignCriterionOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "CampaignCriterionOperation", Name: "Operand", Required: true},
+	},
+	"Carrier": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Carrier", Name: "Name", Selectors: []string{"CarrierName"}, ReadOnly: true},
+		{Type: "Carrier", Name: "CountryCode", Selectors: []string{"CarrierCountryCode"}, ReadOnly: true},
+	},
+	"ConstantOperand": {
+		{Type: "FunctionArgumentOperand", Name: "FunctionArgumentOperandType"},
+		{Type: "ConstantOperand", Name: "Type_", Required: true},
+		{Type: "ConstantOperand", Name: "Unit"},
+		{Type: "ConstantOperand", Name: "LongValue"},
+		{Type: "ConstantOperand", Name: "BooleanValue"},
+		{Type: "ConstantOperand", Name: "DoubleValue"},
+		{Type: "ConstantOperand", Name: "StringValue"},
+	},
+	"ContentLabel": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "ContentLabel", Name: "ContentLabelType", Selectors: []string{"ContentLabelType"}, Filter: "ContentLabelType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Criterion": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+	},
+	"Function": {
+		{Type: "Function", Name: "Operator", Required: true},
+		{Type: "Function", Name: "LhsOperand"},
+		{Type: "Function", Name: "RhsOperand"},
+		{Type: "Function", Name: "FunctionString"},
+	},
+	"Gender": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Gender", Name: "GenderType", Selectors: []string{"GenderType"}, ReadOnly: true},
+	},
+	"GeoPoint": {
+		{Type: "GeoPoint", Name: "LatitudeInMicroDegrees", Required: true},
+		{Type: "GeoPoint", Name: "LongitudeInMicroDegrees", Required: true},
+	},
+	"IncomeOperand": {
+		{Type: "FunctionArgumentOperand", Name: "FunctionArgumentOperandType"},
+		{Type: "IncomeOperand", Name: "Tier", Required: true},
+	},
+	"IncomeRange": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "IncomeRange", Name: "IncomeRangeType", Selectors: []string{"IncomeRangeType"}, ReadOnly: true},
+	},
+	"IpBlock": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "IpBlock", Name: "IpAddress", Selectors: []string{"IpAddress"}, Filter: "IpAddress", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Keyword": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Keyword", Name: "Text", Selectors: []string{"KeywordText"}, Filter: "KeywordText", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Keyword", Name: "MatchType", Selectors: []string{"KeywordMatchType"}, Filter: "KeywordMatchType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Language": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Language", Name: "Code", Selectors: []string{"LanguageCode"}, ReadOnly: true},
+		{Type: "Language", Name: "Name", Selectors: []string{"LanguageName"}, ReadOnly: true},
+	},
+	"Location": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Location", Name: "LocationName", Selectors: []string{"LocationName"}, Filter: "LocationName", ReadOnly: true},
+		{Type: "Location", Name: "DisplayType", Selectors: []string{"DisplayType"}, ReadOnly: true},
+		{Type: "Location", Name: "TargetingStatus", Selectors: []string{"TargetingStatus"}, ReadOnly: true},
+		{Type: "Location", Name: "ParentLocations", Selectors: []string{"ParentLocations"}, ReadOnly: true},
+	},
+	"LocationExtensionOperand": {
+		{Type: "FunctionArgumentOperand", Name: "FunctionArgumentOperandType"},
+		{Type: "LocationExtensionOperand", Name: "Radius", Required: true},
+		{Type: "LocationExtensionOperand", Name: "LocationId"},
+	},
+	"MobileAppCategory": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileAppCategory", Name: "MobileAppCategoryId", Selectors: []string{"MobileAppCategoryId"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileAppCategory", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileApplication": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileApplication", Name: "AppId", Selectors: []string{"AppId"}, Filter: "AppId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileApplication", Name: "DisplayName", Selectors: []string{"DisplayName"}, Filter: "DisplayName", ReadOnly: true},
+	},
+	"MobileDevice": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileDevice", Name: "DeviceName", Selectors: []string{"DeviceName"}, ReadOnly: true},
+		{Type: "MobileDevice", Name: "ManufacturerName", Selectors: []string{"ManufacturerName"}, ReadOnly: true},
+		{Type: "MobileDevice", Name: "DeviceType", Selectors: []string{"DeviceType"}, ReadOnly: true},
+		{Type: "MobileDevice", Name: "OperatingSystemName", Selectors: []string{"OperatingSystemName"}, ReadOnly: true},
+	},
+	"NegativeCampaignCriterion": {
+		{Type: "CampaignCriterion", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId", Required: true},
+		{Type: "CampaignCriterion", Name: "IsNegative", Selectors: []string{"IsNegative"}, Filter: "IsNegative", ReadOnly: true},
+		{Type: "CampaignCriterion", Name: "Criterion", Required: true},
+		{Type: "CampaignCriterion", Name: "BidModifier", Selectors: []string{"BidModifier"}, Filter: "BidModifier"},
+		{Type: "CampaignCriterion", Name: "CampaignCriterionStatus", Selectors: []string{"CampaignCriterionStatus"}, Filter: "CampaignCriterionStatus"},
+		{Type: "CampaignCriterion", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, Filter: "BaseCampaignId", ReadOnly: true},
+		{Type: "CampaignCriterion", Name: "ForwardCompatibilityMap"},
+		{Type: "CampaignCriterion", Name: "CampaignCriterionType"},
+	},
+	"OperatingSystemVersion": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "OperatingSystemVersion", Name: "Name", Selectors: []string{"OperatingSystemName"}, ReadOnly: true},
+		{Type: "OperatingSystemVersion", Name: "OsMajorVersion", Selectors: []string{"OsMajorVersion"}, ReadOnly: true},
+		{Type: "OperatingSystemVersion", Name: "OsMinorVersion", Selectors: []string{"OsMinorVersion"}, ReadOnly: true},
+		{Type: "OperatingSystemVersion", Name: "OperatorType", Selectors: []string{"OperatorType"}, ReadOnly: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Parent": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Parent", Name: "ParentType", Selectors: []string{"ParentType"}, ReadOnly: true},
+	},
+	"Placement": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Placement", Name: "Url", Selectors: []string{"PlacementUrl"}, Filter: "PlacementUrl", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"PlacesOfInterestOperand": {
+		{Type: "FunctionArgumentOperand", Name: "FunctionArgumentOperandType"},
+		{Type: "PlacesOfInterestOperand", Name: "Category", Required: true},
+	},
+	"Platform": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Platform", Name: "PlatformName", Selectors: []string{"PlatformName"}, Filter: "PlatformName", ReadOnly: true},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"ProductBiddingCategory": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductBiddingCategory", Name: "Type_", Filter: "ParentDimensionType", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductBiddingCategory", Name: "Value", Filter: "ParentDimensionId"},
+	},
+	"ProductCustomAttribute": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductCustomAttribute", Name: "Type_", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductCustomAttribute", Name: "Value"},
+	},
+	"ProductScope": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "ProductScope", Name: "Dimensions", Selectors: []string{"Dimensions"}, Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"ProductType": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductType", Name: "Type_", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductType", Name: "Value"},
+	},
+	"Proximity": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Proximity", Name: "GeoPoint", Selectors: []string{"GeoPoint"}},
+		{Type: "Proximity", Name: "RadiusDistanceUnits", Selectors: []string{"RadiusDistanceUnits"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Proximity", Name: "RadiusInUnits", Selectors: []string{"RadiusInUnits"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Proximity", Name: "Address", Selectors: []string{"Address"}},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"LocationGroups": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "LocationGroups", Name: "FeedId", Selectors: []string{"FeedId"}},
+		{Type: "LocationGroups", Name: "MatchingFunction", Selectors: []string{"MatchingFunction"}, Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"CriterionUserInterest": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserInterest", Name: "UserInterestId", Selectors: []string{"UserInterestId"}},
+		{Type: "CriterionUserInterest", Name: "UserInterestParentId", Selectors: []string{"UserInterestParentId"}, ReadOnly: true},
+		{Type: "CriterionUserInterest", Name: "UserInterestName", Selectors: []string{"UserInterestName"}},
+	},
+	"CriterionUserList": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserList", Name: "UserListId", Selectors: []string{"UserListId"}},
+		{Type: "CriterionUserList", Name: "UserListName", Selectors: []string{"UserListName"}},
+		{Type: "CriterionUserList", Name: "UserListMembershipStatus", Selectors: []string{"UserListMembershipStatus"}, Filter: "UserListMembershipStatus"},
+		{Type: "CriterionUserList", Name: "UserListEligibleForSearch", Selectors: []string{"UserListEligibleForSearch"}, Filter: "UserListEligibleForSearch", ReadOnly: true},
+		{Type: "CriterionUserList", Name: "UserListEligibleForDisplay", Selectors: []string{"UserListEligibleForDisplay"}, Filter: "UserListEligibleForDisplay", ReadOnly: true},
+	},
+	"Vertical": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Vertical", Name: "VerticalId", Selectors: []string{"VerticalId"}},
+		{Type: "Vertical", Name: "VerticalParentId", Selectors: []string{"VerticalParentId"}, ReadOnly: true},
+		{Type: "Vertical", Name: "Path", Selectors: []string{"Path"}},
+	},
+	"Webpage": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Webpage", Name: "Parameter", Selectors: []string{"Parameter"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Webpage", Name: "CriteriaCoverage", ReadOnly: true},
+		{Type: "Webpage", Name: "CriteriaSamples", ReadOnly: true},
+	},
+	"WebpageCondition": {
+		{Type: "WebpageCondition", Name: "Operand", Required: true},
+		{Type: "WebpageCondition", Name: "Argument", Required: true},
+	},
+	"WebpageParameter": {
+		{Type: "CriterionParameter", Name: "CriterionParameterType"},
+		{Type: "WebpageParameter", Name: "CriterionName", Required: true},
+		{Type: "WebpageParameter", Name: "Conditions"},
+	},
+	"YouTubeChannel": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "YouTubeChannel", Name: "ChannelId", Selectors: []string{"ChannelId"}},
+		{Type: "YouTubeChannel", Name: "ChannelName", Selectors: []string{"ChannelName"}, ReadOnly: true},
+	},
+	"YouTubeVideo": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "YouTubeVideo", Name: "VideoId", Selectors: []string{"VideoId"}},
+		{Type: "YouTubeVideo", Name: "VideoName", Selectors: []string{"VideoName"}, ReadOnly: true},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1481 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignCriterionService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "AdSchedule" as the
+// concrete type in the discriminator of its base types.
+func (t AdSchedule) MarshalJSON() ([]byte, error) {
+	type plain AdSchedule
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("AdSchedule")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdxError" as the
+// concrete type in the discriminator of its base types.
+func (t AdxError) MarshalJSON() ([]byte, error) {
+	type plain AdxError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdxError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AgeRange" as the
+// concrete type in the discriminator of its base types.
+func (t AgeRange) MarshalJSON() ([]byte, error) {
+	type plain AgeRange
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("AgeRange")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignCriterionError" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignCriterionError) MarshalJSON() ([]byte, error) {
+	type plain CampaignCriterionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CampaignCriterionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignCriterionOperation" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignCriterionOperation) MarshalJSON() ([]byte, error) {
+	type plain CampaignCriterionOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("CampaignCriterionOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignCriterionPage" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignCriterionPage) MarshalJSON() ([]byte, error) {
+	type plain CampaignCriterionPage
+	v := plain(t)
+
+	b0 := new(Page)
+	if v.Page != nil {
+		*b0 = *v.Page
+	}
+	if b0.PageType == nil {
+		b0.PageType = String("CampaignCriterionPage")
+	}
+	v.Page = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignCriterionReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignCriterionReturnValue) MarshalJSON() ([]byte, error) {
+	type plain CampaignCriterionReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("CampaignCriterionReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Carrier" as the
+// concrete type in the discriminator of its base types.
+func (t Carrier) MarshalJSON() ([]byte, error) {
+	type plain Carrier
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Carrier")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ConstantOperand" as the
+// concrete type in the discriminator of its base types.
+func (t ConstantOperand) MarshalJSON() ([]byte, error) {
+	type plain ConstantOperand
+	v := plain(t)
+
+	b0 := new(FunctionArgumentOperand)
+	if v.FunctionArgumentOperand != nil {
+		*b0 = *v.FunctionArgumentOperand
+	}
+	if b0.FunctionArgumentOperandType == nil {
+		b0.FunctionArgumentOperandType = String("ConstantOperand")
+	}
+	v.FunctionArgumentOperand = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ContentLabel" as the
+// concrete type in the discriminator of its base types.
+func (t ContentLabel) MarshalJSON() ([]byte, error) {
+	type plain ContentLabel
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("ContentLabel")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionError" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionError) MarshalJSON() ([]byte, error) {
+	type plain CriterionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CriterionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityCountLimitExceeded" as the
+// concrete type in the discriminator of its base types.
+func (t EntityCountLimitExceeded) MarshalJSON() ([]byte, error) {
+	type plain EntityCountLimitExceeded
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityCountLimitExceeded")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityNotFound" as the
+// concrete type in the discriminator of its base types.
+func (t EntityNotFound) MarshalJSON() ([]byte, error) {
+	type plain EntityNotFound
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityNotFound")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "FunctionError" as the
+// concrete type in the discriminator of its base types.
+func (t FunctionError) MarshalJSON() ([]byte, error) {
+	type plain FunctionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("FunctionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Gender" as the
+// concrete type in the discriminator of its base types.
+func (t Gender) MarshalJSON() ([]byte, error) {
+	type plain Gender
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Gender")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "GeoTargetOperand" as the
+// concrete type in the discriminator of its base types.
+func (t GeoTargetOperand) MarshalJSON() ([]byte, error) {
+	type plain GeoTargetOperand
+	v := plain(t)
+
+	b0 := new(FunctionArgumentOperand)
+	if v.FunctionArgumentOperand != nil {
+		*b0 = *v.FunctionArgumentOperand
+	}
+	if b0.FunctionArgumentOperandType == nil {
+		b0.FunctionArgumentOperandType = String("GeoTargetOperand")
+	}
+	v.FunctionArgumentOperand = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IncomeOperand" as the
+// concrete type in the discriminator of its base types.
+func (t IncomeOperand) MarshalJSON() ([]byte, error) {
+	type plain IncomeOperand
+	v := plain(t)
+
+	b0 := new(FunctionArgumentOperand)
+	if v.FunctionArgumentOperand != nil {
+		*b0 = *v.FunctionArgumentOperand
+	}
+	if b0.FunctionArgumentOperandType == nil {
+		b0.FunctionArgumentOperandType = String("IncomeOperand")
+	}
+	v.FunctionArgumentOperand = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IncomeRange" as the
+// concrete type in the discriminator of its base types.
+func (t IncomeRange) MarshalJSON() ([]byte, error) {
+	type plain IncomeRange
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("IncomeRange")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IpBlock" as the
+// concrete type in the discriminator of its base types.
+func (t IpBlock) MarshalJSON() ([]byte, error) {
+	type plain IpBlock
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("IpBlock")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Keyword" as the
+// concrete type in the discriminator of its base types.
+func (t Keyword) MarshalJSON() ([]byte, error) {
+	type plain Keyword
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Keyword")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Language" as the
+// concrete type in the discriminator of its base types.
+func (t Language) MarshalJSON() ([]byte, error) {
+	type plain Language
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Language")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Location" as the
+// concrete type in the discriminator of its base types.
+func (t Location) MarshalJSON() ([]byte, error) {
+	type plain Location
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Location")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LocationExtensionOperand" as the
+// concrete type in the discriminator of its base types.
+func (t LocationExtensionOperand) MarshalJSON() ([]byte, error) {
+	type plain LocationExtensionOperand
+	v := plain(t)
+
+	b0 := new(FunctionArgumentOperand)
+	if v.FunctionArgumentOperand != nil {
+		*b0 = *v.FunctionArgumentOperand
+	}
+	if b0.FunctionArgumentOperandType == nil {
+		b0.FunctionArgumentOperandType = String("LocationExtensionOperand")
+	}
+	v.FunctionArgumentOperand = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileAppCategory" as the
+// concrete type in the discriminator of its base types.
+func (t MobileAppCategory) MarshalJSON() ([]byte, error) {
+	type plain MobileAppCategory
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileAppCategory")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileApplication" as the
+// concrete type in the discriminator of its base types.
+func (t MobileApplication) MarshalJSON() ([]byte, error) {
+	type plain MobileApplication
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileApplication")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileDevice" as the
+// concrete type in the discriminator of its base types.
+func (t MobileDevice) MarshalJSON() ([]byte, error) {
+	type plain MobileDevice
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileDevice")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NegativeCampaignCriterion" as the
+// concrete type in the discriminator of its base types.
+func (t NegativeCampaignCriterion) MarshalJSON() ([]byte, error) {
+	type plain NegativeCampaignCriterion
+	v := plain(t)
+
+	b0 := new(CampaignCriterion)
+	if v.CampaignCriterion != nil {
+		*b0 = *v.CampaignCriterion
+	}
+	if b0.CampaignCriterionType == nil {
+		b0.CampaignCriterionType = String("NegativeCampaignCriterion")
+	}
+	v.CampaignCriterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NewEntityCreationError" as the
+// concrete type in the discriminator of its base types.
+func (t NewEntityCreationError) MarshalJSON() ([]byte, error) {
+	type plain NewEntityCreationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NewEntityCreationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NullError" as the
+// concrete type in the discriminator of its base types.
+func (t NullError) MarshalJSON() ([]byte, error) {
+	type plain NullError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NullError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatingSystemVersion" as the
+// concrete type in the discriminator of its base types.
+func (t OperatingSystemVersion) MarshalJSON() ([]byte, error) {
+	type plain OperatingSystemVersion
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("OperatingSystemVersion")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PagingError" as the
+// concrete type in the discriminator of its base types.
+func (t PagingError) MarshalJSON() ([]byte, error) {
+	type plain PagingError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("PagingError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Parent" as the
+// concrete type in the discriminator of its base types.
+func (t Parent) MarshalJSON() ([]byte, error) {
+	type plain Parent
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Parent")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Placement" as the
+// concrete type in the discriminator of its base types.
+func (t Placement) MarshalJSON() ([]byte, error) {
+	type plain Placement
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Placement")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PlacesOfInterestOperand" as the
+// concrete type in the discriminator of its base types.
+func (t PlacesOfInterestOperand) MarshalJSON() ([]byte, error) {
+	type plain PlacesOfInterestOperand
+	v := plain(t)
+
+	b0 := new(FunctionArgumentOperand)
+	if v.FunctionArgumentOperand != nil {
+		*b0 = *v.FunctionArgumentOperand
+	}
+	if b0.FunctionArgumentOperandType == nil {
+		b0.FunctionArgumentOperandType = String("PlacesOfInterestOperand")
+	}
+	v.FunctionArgumentOperand = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Platform" as the
+// concrete type in the discriminator of its base types.
+func (t Platform) MarshalJSON() ([]byte, error) {
+	type plain Platform
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Platform")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductAdwordsGrouping" as the
+// concrete type in the discriminator of its base types.
+func (t ProductAdwordsGrouping) MarshalJSON() ([]byte, error) {
+	type plain ProductAdwordsGrouping
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductAdwordsGrouping")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductAdwordsLabels" as the
+// concrete type in the discriminator of its base types.
+func (t ProductAdwordsLabels) MarshalJSON() ([]byte, error) {
+	type plain ProductAdwordsLabels
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductAdwordsLabels")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductBiddingCategory" as the
+// concrete type in the discriminator of its base types.
+func (t ProductBiddingCategory) MarshalJSON() ([]byte, error) {
+	type plain ProductBiddingCategory
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductBiddingCategory")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductBrand" as the
+// concrete type in the discriminator of its base types.
+func (t ProductBrand) MarshalJSON() ([]byte, error) {
+	type plain ProductBrand
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductBrand")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductCanonicalCondition" as the
+// concrete type in the discriminator of its base types.
+func (t ProductCanonicalCondition) MarshalJSON() ([]byte, error) {
+	type plain ProductCanonicalCondition
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductCanonicalCondition")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductChannel" as the
+// concrete type in the discriminator of its base types.
+func (t ProductChannel) MarshalJSON() ([]byte, error) {
+	type plain ProductChannel
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductChannel")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductChannelExclusivity" as the
+// concrete type in the discriminator of its base types.
+func (t ProductChannelExclusivity) MarshalJSON() ([]byte, error) {
+	type plain ProductChannelExclusivity
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductChannelExclusivity")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductLegacyCondition" as the
+// concrete type in the discriminator of its base types.
+func (t ProductLegacyCondition) MarshalJSON() ([]byte, error) {
+	type plain ProductLegacyCondition
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductLegacyCondition")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductCustomAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t ProductCustomAttribute) MarshalJSON() ([]byte, error) {
+	type plain ProductCustomAttribute
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductCustomAttribute")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductOfferId" as the
+// concrete type in the discriminator of its base types.
+func (t ProductOfferId) MarshalJSON() ([]byte, error) {
+	type plain ProductOfferId
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductOfferId")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductScope" as the
+// concrete type in the discriminator of its base types.
+func (t ProductScope) MarshalJSON() ([]byte, error) {
+	type plain ProductScope
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("ProductScope")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductType" as the
+// concrete type in the discriminator of its base types.
+func (t ProductType) MarshalJSON() ([]byte, error) {
+	type plain ProductType
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductType")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductTypeFull" as the
+// concrete type in the discriminator of its base types.
+func (t ProductTypeFull) MarshalJSON() ([]byte, error) {
+	type plain ProductTypeFull
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductTypeFull")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Proximity" as the
+// concrete type in the discriminator of its base types.
+func (t Proximity) MarshalJSON() ([]byte, error) {
+	type plain Proximity
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Proximity")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QueryError" as the
+// concrete type in the discriminator of its base types.
+func (t QueryError) MarshalJSON() ([]byte, error) {
+	type plain QueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RegionCodeError" as the
+// concrete type in the discriminator of its base types.
+func (t RegionCodeError) MarshalJSON() ([]byte, error) {
+	type plain RegionCodeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RegionCodeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SelectorError" as the
+// concrete type in the discriminator of its base types.
+func (t SelectorError) MarshalJSON() ([]byte, error) {
+	type plain SelectorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SelectorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LocationGroups" as the
+// concrete type in the discriminator of its base types.
+func (t LocationGroups) MarshalJSON() ([]byte, error) {
+	type plain LocationGroups
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("LocationGroups")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UnknownProductDimension" as the
+// concrete type in the discriminator of its base types.
+func (t UnknownProductDimension) MarshalJSON() ([]byte, error) {
+	type plain UnknownProductDimension
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("UnknownProductDimension")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserInterest" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserInterest) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserInterest
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserInterest")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserList" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserList) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserList
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserList")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Vertical" as the
+// concrete type in the discriminator of its base types.
+func (t Vertical) MarshalJSON() ([]byte, error) {
+	type plain Vertical
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Vertical")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Webpage" as the
+// concrete type in the discriminator of its base types.
+func (t Webpage) MarshalJSON() ([]byte, error) {
+	type plain Webpage
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Webpage")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "WebpageParameter" as the
+// concrete type in the discriminator of its base types.
+func (t WebpageParameter) MarshalJSON() ([]byte, error) {
+	type plain WebpageParameter
+	v := plain(t)
+
+	b0 := new(CriterionParameter)
+	if v.CriterionParameter != nil {
+		*b0 = *v.CriterionParameter
+	}
+	if b0.CriterionParameterType == nil {
+		b0.CriterionParameterType = String("WebpageParameter")
+	}
+	v.CriterionParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "YouTubeChannel" as the
+// concrete type in the discriminator of its base types.
+func (t YouTubeChannel) MarshalJSON() ([]byte, error) {
+	type plain YouTubeChannel
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("YouTubeChannel")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "YouTubeVideo" as the
+// concrete type in the discriminator of its base types.
+func (t YouTubeVideo) MarshalJSON() ([]byte, error) {
+	type plain YouTubeVideo
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("YouTubeVideo")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignCriterionService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CampaignCriterion) *CampaignCriterionOperation {
+	op := OperatorADD
+	return &CampaignCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *CampaignCriterion) *CampaignCriterionOperation {
+	op := OperatorSET
+	return &CampaignCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CampaignCriterion) *CampaignCriterionOperation {
+	op := OperatorREMOVE
+	return &CampaignCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,57 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignExtensionSettingService
+
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// NewDateRange returns the DateRange from one date to another, both
+// inclusive.
+func NewDateRange(from, to datetime.Date) *DateRange {
+	return &DateRange{Min: String(from.String()), Max: String(to.String())}
+}
+
+// Dates returns the bounds of r. A bound that is not set is returned as the
+// zero Date.
+func (r *DateRange) Dates() (from, to datetime.Date, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDate(*r.Min); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDate(*r.Max)
+	}
+	return
+}
+
+// GetStartTime returns the StartTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *ExtensionFeedItem) GetStartTime(loc *time.Location) (time.Time, error) {
+	if t.StartTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.StartTime, loc)
+}
+
+// SetStartTime sets the StartTime of t to tm formatted in loc.
+func (t *ExtensionFeedItem) SetStartTime(tm time.Time, loc *time.Location) {
+	t.StartTime = String(datetime.FormatDateTime(tm, loc))
+}
+
+// GetEndTime returns the EndTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *ExtensionFeedItem) GetEndTime(loc *time.Location) (time.Time, error) {
+	if t.EndTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.EndTime, loc)
+}
+
+// SetEndTime sets the EndTime of t to tm formatted in loc.
+func (t *ExtensionFeedItem) SetEndTime(tm time.Time, loc *time.Location) {
+	t.EndTime = String(datetime.FormatDateTime(tm, loc))
+}
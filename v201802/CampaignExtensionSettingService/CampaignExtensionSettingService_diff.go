@@ -0,0 +1,1018 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignExtensionSettingService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AppFeedItem) Copy() *AppFeedItem {
+	return deep.Copy(t).(*AppFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AppFeedItem) Equal(o *AppFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CallConversionType) Copy() *CallConversionType {
+	return deep.Copy(t).(*CallConversionType)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CallConversionType) Equal(o *CallConversionType) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CallFeedItem) Copy() *CallFeedItem {
+	return deep.Copy(t).(*CallFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CallFeedItem) Equal(o *CallFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CalloutFeedItem) Copy() *CalloutFeedItem {
+	return deep.Copy(t).(*CalloutFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CalloutFeedItem) Equal(o *CalloutFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignExtensionSetting) Copy() *CampaignExtensionSetting {
+	return deep.Copy(t).(*CampaignExtensionSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignExtensionSetting) Equal(o *CampaignExtensionSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignExtensionSettingOperation) Copy() *CampaignExtensionSettingOperation {
+	return deep.Copy(t).(*CampaignExtensionSettingOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignExtensionSettingOperation) Equal(o *CampaignExtensionSettingOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignExtensionSettingPage) Copy() *CampaignExtensionSettingPage {
+	return deep.Copy(t).(*CampaignExtensionSettingPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignExtensionSettingPage) Equal(o *CampaignExtensionSettingPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignExtensionSettingReturnValue) Copy() *CampaignExtensionSettingReturnValue {
+	return deep.Copy(t).(*CampaignExtensionSettingReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignExtensionSettingReturnValue) Equal(o *CampaignExtensionSettingReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CollectionSizeError) Copy() *CollectionSizeError {
+	return deep.Copy(t).(*CollectionSizeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CollectionSizeError) Equal(o *CollectionSizeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ComparableValue) Copy() *ComparableValue {
+	return deep.Copy(t).(*ComparableValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ComparableValue) Equal(o *ComparableValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Criterion) Copy() *Criterion {
+	return deep.Copy(t).(*Criterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Criterion) Equal(o *Criterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionError) Copy() *CriterionError {
+	return deep.Copy(t).(*CriterionError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionError) Equal(o *CriterionError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameter) Copy() *CustomParameter {
+	return deep.Copy(t).(*CustomParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameter) Equal(o *CustomParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameters) Copy() *CustomParameters {
+	return deep.Copy(t).(*CustomParameters)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameters) Equal(o *CustomParameters) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DisapprovalReason) Copy() *DisapprovalReason {
+	return deep.Copy(t).(*DisapprovalReason)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DisapprovalReason) Equal(o *DisapprovalReason) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DoubleValue) Copy() *DoubleValue {
+	return deep.Copy(t).(*DoubleValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DoubleValue) Equal(o *DoubleValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityAccessDenied) Copy() *EntityAccessDenied {
+	return deep.Copy(t).(*EntityAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityAccessDenied) Equal(o *EntityAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityCountLimitExceeded) Copy() *EntityCountLimitExceeded {
+	return deep.Copy(t).(*EntityCountLimitExceeded)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityCountLimitExceeded) Equal(o *EntityCountLimitExceeded) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityNotFound) Copy() *EntityNotFound {
+	return deep.Copy(t).(*EntityNotFound)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityNotFound) Equal(o *EntityNotFound) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExtensionFeedItem) Copy() *ExtensionFeedItem {
+	return deep.Copy(t).(*ExtensionFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExtensionFeedItem) Equal(o *ExtensionFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExtensionSetting) Copy() *ExtensionSetting {
+	return deep.Copy(t).(*ExtensionSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExtensionSetting) Equal(o *ExtensionSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ExtensionSettingError) Copy() *ExtensionSettingError {
+	return deep.Copy(t).(*ExtensionSettingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ExtensionSettingError) Equal(o *ExtensionSettingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedItemAdGroupTargeting) Copy() *FeedItemAdGroupTargeting {
+	return deep.Copy(t).(*FeedItemAdGroupTargeting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedItemAdGroupTargeting) Equal(o *FeedItemAdGroupTargeting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedItemAttributeError) Copy() *FeedItemAttributeError {
+	return deep.Copy(t).(*FeedItemAttributeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedItemAttributeError) Equal(o *FeedItemAttributeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedItemCampaignTargeting) Copy() *FeedItemCampaignTargeting {
+	return deep.Copy(t).(*FeedItemCampaignTargeting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedItemCampaignTargeting) Equal(o *FeedItemCampaignTargeting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedItemDevicePreference) Copy() *FeedItemDevicePreference {
+	return deep.Copy(t).(*FeedItemDevicePreference)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedItemDevicePreference) Equal(o *FeedItemDevicePreference) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedItemGeoRestriction) Copy() *FeedItemGeoRestriction {
+	return deep.Copy(t).(*FeedItemGeoRestriction)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedItemGeoRestriction) Equal(o *FeedItemGeoRestriction) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedItemPolicyData) Copy() *FeedItemPolicyData {
+	return deep.Copy(t).(*FeedItemPolicyData)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedItemPolicyData) Equal(o *FeedItemPolicyData) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedItemSchedule) Copy() *FeedItemSchedule {
+	return deep.Copy(t).(*FeedItemSchedule)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedItemSchedule) Equal(o *FeedItemSchedule) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FeedItemScheduling) Copy() *FeedItemScheduling {
+	return deep.Copy(t).(*FeedItemScheduling)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FeedItemScheduling) Equal(o *FeedItemScheduling) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Keyword) Copy() *Keyword {
+	return deep.Copy(t).(*Keyword)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Keyword) Equal(o *Keyword) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Location) Copy() *Location {
+	return deep.Copy(t).(*Location)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Location) Equal(o *Location) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LongValue) Copy() *LongValue {
+	return deep.Copy(t).(*LongValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LongValue) Equal(o *LongValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MessageFeedItem) Copy() *MessageFeedItem {
+	return deep.Copy(t).(*MessageFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MessageFeedItem) Equal(o *MessageFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileAppCategory) Copy() *MobileAppCategory {
+	return deep.Copy(t).(*MobileAppCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileAppCategory) Equal(o *MobileAppCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileApplication) Copy() *MobileApplication {
+	return deep.Copy(t).(*MobileApplication)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileApplication) Equal(o *MobileApplication) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Money) Copy() *Money {
+	return deep.Copy(t).(*Money)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Money) Equal(o *Money) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MoneyWithCurrency) Copy() *MoneyWithCurrency {
+	return deep.Copy(t).(*MoneyWithCurrency)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MoneyWithCurrency) Equal(o *MoneyWithCurrency) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NewEntityCreationError) Copy() *NewEntityCreationError {
+	return deep.Copy(t).(*NewEntityCreationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NewEntityCreationError) Equal(o *NewEntityCreationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NumberValue) Copy() *NumberValue {
+	return deep.Copy(t).(*NumberValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NumberValue) Equal(o *NumberValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Placement) Copy() *Placement {
+	return deep.Copy(t).(*Placement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Placement) Equal(o *Placement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyData) Copy() *PolicyData {
+	return deep.Copy(t).(*PolicyData)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyData) Equal(o *PolicyData) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PriceFeedItem) Copy() *PriceFeedItem {
+	return deep.Copy(t).(*PriceFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PriceFeedItem) Equal(o *PriceFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PriceTableRow) Copy() *PriceTableRow {
+	return deep.Copy(t).(*PriceTableRow)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PriceTableRow) Equal(o *PriceTableRow) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PromotionFeedItem) Copy() *PromotionFeedItem {
+	return deep.Copy(t).(*PromotionFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PromotionFeedItem) Equal(o *PromotionFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReviewFeedItem) Copy() *ReviewFeedItem {
+	return deep.Copy(t).(*ReviewFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReviewFeedItem) Equal(o *ReviewFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SitelinkFeedItem) Copy() *SitelinkFeedItem {
+	return deep.Copy(t).(*SitelinkFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SitelinkFeedItem) Equal(o *SitelinkFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StructuredSnippetFeedItem) Copy() *StructuredSnippetFeedItem {
+	return deep.Copy(t).(*StructuredSnippetFeedItem)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StructuredSnippetFeedItem) Equal(o *StructuredSnippetFeedItem) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlError) Copy() *UrlError {
+	return deep.Copy(t).(*UrlError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlError) Equal(o *UrlError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlList) Copy() *UrlList {
+	return deep.Copy(t).(*UrlList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlList) Equal(o *UrlList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionUserInterest) Copy() *CriterionUserInterest {
+	return deep.Copy(t).(*CriterionUserInterest)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionUserInterest) Equal(o *CriterionUserInterest) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionUserList) Copy() *CriterionUserList {
+	return deep.Copy(t).(*CriterionUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionUserList) Equal(o *CriterionUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Vertical) Copy() *Vertical {
+	return deep.Copy(t).(*Vertical)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Vertical) Equal(o *Vertical) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffCampaignExtensionSetting compares from, as fetched from the API, with the desired
+// to. It returns a CampaignExtensionSetting holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCampaignExtensionSetting(from, to *CampaignExtensionSetting) (*CampaignExtensionSetting, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"ExtensionSetting"},
+		[]string{"CampaignId", "ExtensionType"},
+	).(*CampaignExtensionSetting)
+	return set, changes
+}
+
+// SetCampaignExtensionSettingOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffCampaignExtensionSetting.
+func SetCampaignExtensionSettingOperation(from, to *CampaignExtensionSetting) (*CampaignExtensionSettingOperation, []deep.Change) {
+	set, changes := DiffCampaignExtensionSetting(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &CampaignExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+
+// DiffExtensionSetting compares from, as fetched from the API, with the desired
+// to. It returns a ExtensionSetting holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffExtensionSetting(from, to *ExtensionSetting) (*ExtensionSetting, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Extensions", "PlatformRestrictions"},
+		nil,
+	).(*ExtensionSetting)
+	return set, changes
+}
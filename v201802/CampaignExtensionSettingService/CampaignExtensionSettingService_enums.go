@@ -0,0 +1,3253 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignExtensionSettingService
+
+import "fmt"
+
+// AppFeedItemAppStoreValues returns all values of AppFeedItemAppStore.
+func AppFeedItemAppStoreValues() []AppFeedItemAppStore {
+	return []AppFeedItemAppStore{
+		AppFeedItemAppStoreAPPLE_ITUNES,
+		AppFeedItemAppStoreGOOGLE_PLAY,
+		AppFeedItemAppStoreUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AppFeedItemAppStore.
+func (e AppFeedItemAppStore) IsValid() bool {
+	switch e {
+	case AppFeedItemAppStoreAPPLE_ITUNES,
+		AppFeedItemAppStoreGOOGLE_PLAY,
+		AppFeedItemAppStoreUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAppFeedItemAppStore returns the AppFeedItemAppStore named by s.
+func ParseAppFeedItemAppStore(s string) (AppFeedItemAppStore, error) {
+	e := AppFeedItemAppStore(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AppFeedItemAppStore %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AppFeedItemAppStore.
+func (e AppFeedItemAppStore) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AppFeedItemAppStore %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AppFeedItemAppStore) UnmarshalText(text []byte) error {
+	v := AppFeedItemAppStore(text)
+	if !v.IsValid() {
+		v = AppFeedItemAppStoreUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AuthenticationErrorReasonValues returns all values of AuthenticationErrorReason.
+func AuthenticationErrorReasonValues() []AuthenticationErrorReason {
+	return []AuthenticationErrorReason{
+		AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthenticationErrorReason.
+func (e AuthenticationErrorReason) IsValid() bool {
+	switch e {
+	case AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID:
+		return true
+	}
+	return false
+}
+
+// ParseAuthenticationErrorReason returns the AuthenticationErrorReason named by s.
+func ParseAuthenticationErrorReason(s string) (AuthenticationErrorReason, error) {
+	e := AuthenticationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthenticationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthenticationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthenticationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthenticationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthenticationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthorizationErrorReasonValues returns all values of AuthorizationErrorReason.
+func AuthorizationErrorReasonValues() []AuthorizationErrorReason {
+	return []AuthorizationErrorReason{
+		AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthorizationErrorReason.
+func (e AuthorizationErrorReason) IsValid() bool {
+	switch e {
+	case AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseAuthorizationErrorReason returns the AuthorizationErrorReason named by s.
+func ParseAuthorizationErrorReason(s string) (AuthorizationErrorReason, error) {
+	e := AuthorizationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthorizationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthorizationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthorizationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthorizationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthorizationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ClientTermsErrorReasonValues returns all values of ClientTermsErrorReason.
+func ClientTermsErrorReasonValues() []ClientTermsErrorReason {
+	return []ClientTermsErrorReason{
+		ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ClientTermsErrorReason.
+func (e ClientTermsErrorReason) IsValid() bool {
+	switch e {
+	case ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED:
+		return true
+	}
+	return false
+}
+
+// ParseClientTermsErrorReason returns the ClientTermsErrorReason named by s.
+func ParseClientTermsErrorReason(s string) (ClientTermsErrorReason, error) {
+	e := ClientTermsErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ClientTermsErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ClientTermsErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ClientTermsErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ClientTermsErrorReason) UnmarshalText(text []byte) error {
+	v := ClientTermsErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CollectionSizeErrorReasonValues returns all values of CollectionSizeErrorReason.
+func CollectionSizeErrorReasonValues() []CollectionSizeErrorReason {
+	return []CollectionSizeErrorReason{
+		CollectionSizeErrorReasonTOO_FEW,
+		CollectionSizeErrorReasonTOO_MANY,
+	}
+}
+
+// IsValid reports whether e is one of the values of CollectionSizeErrorReason.
+func (e CollectionSizeErrorReason) IsValid() bool {
+	switch e {
+	case CollectionSizeErrorReasonTOO_FEW,
+		CollectionSizeErrorReasonTOO_MANY:
+		return true
+	}
+	return false
+}
+
+// ParseCollectionSizeErrorReason returns the CollectionSizeErrorReason named by s.
+func ParseCollectionSizeErrorReason(s string) (CollectionSizeErrorReason, error) {
+	e := CollectionSizeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CollectionSizeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// CollectionSizeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e CollectionSizeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *CollectionSizeErrorReason) UnmarshalText(text []byte) error {
+	v := CollectionSizeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CriterionTypeValues returns all values of CriterionType.
+func CriterionTypeValues() []CriterionType {
+	return []CriterionType{
+		CriterionTypeCONTENT_LABEL,
+		CriterionTypeKEYWORD,
+		CriterionTypePLACEMENT,
+		CriterionTypeVERTICAL,
+		CriterionTypeUSER_LIST,
+		CriterionTypeUSER_INTEREST,
+		CriterionTypeMOBILE_APPLICATION,
+		CriterionTypeMOBILE_APP_CATEGORY,
+		CriterionTypePRODUCT_PARTITION,
+		CriterionTypeIP_BLOCK,
+		CriterionTypeWEBPAGE,
+		CriterionTypeLANGUAGE,
+		CriterionTypeLOCATION,
+		CriterionTypeAGE_RANGE,
+		CriterionTypeCARRIER,
+		CriterionTypeOPERATING_SYSTEM_VERSION,
+		CriterionTypeMOBILE_DEVICE,
+		CriterionTypeGENDER,
+		CriterionTypePARENT,
+		CriterionTypePROXIMITY,
+		CriterionTypePLATFORM,
+		CriterionTypePREFERRED_CONTENT,
+		CriterionTypeAD_SCHEDULE,
+		CriterionTypeLOCATION_GROUPS,
+		CriterionTypePRODUCT_SCOPE,
+		CriterionTypeYOUTUBE_VIDEO,
+		CriterionTypeYOUTUBE_CHANNEL,
+		CriterionTypeAPP_PAYMENT_MODEL,
+		CriterionTypeINCOME_RANGE,
+		CriterionTypeINTERACTION_TYPE,
+		CriterionTypeUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CriterionType.
+func (e CriterionType) IsValid() bool {
+	switch e {
+	case CriterionTypeCONTENT_LABEL,
+		CriterionTypeKEYWORD,
+		CriterionTypePLACEMENT,
+		CriterionTypeVERTICAL,
+		CriterionTypeUSER_LIST,
+		CriterionTypeUSER_INTEREST,
+		CriterionTypeMOBILE_APPLICATION,
+		CriterionTypeMOBILE_APP_CATEGORY,
+		CriterionTypePRODUCT_PARTITION,
+		CriterionTypeIP_BLOCK,
+		CriterionTypeWEBPAGE,
+		CriterionTypeLANGUAGE,
+		CriterionTypeLOCATION,
+		CriterionTypeAGE_RANGE,
+		CriterionTypeCARRIER,
+		CriterionTypeOPERATING_SYSTEM_VERSION,
+		CriterionTypeMOBILE_DEVICE,
+		CriterionTypeGENDER,
+		CriterionTypePARENT,
+		CriterionTypePROXIMITY,
+		CriterionTypePLATFORM,
+		CriterionTypePREFERRED_CONTENT,
+		CriterionTypeAD_SCHEDULE,
+		CriterionTypeLOCATION_GROUPS,
+		CriterionTypePRODUCT_SCOPE,
+		CriterionTypeYOUTUBE_VIDEO,
+		CriterionTypeYOUTUBE_CHANNEL,
+		CriterionTypeAPP_PAYMENT_MODEL,
+		CriterionTypeINCOME_RANGE,
+		CriterionTypeINTERACTION_TYPE,
+		CriterionTypeUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCriterionType returns the CriterionType named by s.
+func ParseCriterionType(s string) (CriterionType, error) {
+	e := CriterionType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CriterionType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CriterionType.
+func (e CriterionType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CriterionType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CriterionType) UnmarshalText(text []byte) error {
+	v := CriterionType(text)
+	if !v.IsValid() {
+		v = CriterionTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CriterionErrorReasonValues returns all values of CriterionErrorReason.
+func CriterionErrorReasonValues() []CriterionErrorReason {
+	return []CriterionErrorReason{
+		CriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CriterionErrorReasonINVALID_EXCLUDED_CATEGORY,
+		CriterionErrorReasonINVALID_KEYWORD_TEXT,
+		CriterionErrorReasonKEYWORD_TEXT_TOO_LONG,
+		CriterionErrorReasonKEYWORD_HAS_TOO_MANY_WORDS,
+		CriterionErrorReasonKEYWORD_HAS_INVALID_CHARS,
+		CriterionErrorReasonINVALID_PLACEMENT_URL,
+		CriterionErrorReasonINVALID_USER_LIST,
+		CriterionErrorReasonINVALID_USER_INTEREST,
+		CriterionErrorReasonINVALID_FORMAT_FOR_PLACEMENT_URL,
+		CriterionErrorReasonPLACEMENT_URL_IS_TOO_LONG,
+		CriterionErrorReasonPLACEMENT_URL_HAS_ILLEGAL_CHAR,
+		CriterionErrorReasonPLACEMENT_URL_HAS_MULTIPLE_SITES_IN_LINE,
+		CriterionErrorReasonPLACEMENT_IS_NOT_AVAILABLE_FOR_TARGETING_OR_EXCLUSION,
+		CriterionErrorReasonINVALID_VERTICAL_PATH,
+		CriterionErrorReasonYOUTUBE_VERTICAL_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_DEMOGRAPHIC_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_URL_UNSUPPORTED,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CriterionErrorReasonCANNOT_ADD_CRITERIA_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_FILTER,
+		CriterionErrorReasonPRODUCT_FILTER_TOO_LONG,
+		CriterionErrorReasonCANNOT_EXCLUDE_SIMILAR_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_CLOSED_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_ONLY_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SHOPPING_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_USER_INTERESTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_SET_BIDS_ON_CRITERION_TYPE_IN_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_URLS_TO_CRITERION_TYPE_FOR_CAMPAIGN_TYPE,
+		CriterionErrorReasonINVALID_IP_ADDRESS,
+		CriterionErrorReasonINVALID_IP_FORMAT,
+		CriterionErrorReasonINVALID_MOBILE_APP,
+		CriterionErrorReasonINVALID_MOBILE_APP_CATEGORY,
+		CriterionErrorReasonINVALID_CRITERION_ID,
+		CriterionErrorReasonCANNOT_TARGET_CRITERION,
+		CriterionErrorReasonCANNOT_TARGET_OBSOLETE_CRITERION,
+		CriterionErrorReasonCRITERION_ID_AND_TYPE_MISMATCH,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS_UNITS,
+		CriterionErrorReasonINVALID_STREETADDRESS_LENGTH,
+		CriterionErrorReasonINVALID_CITYNAME_LENGTH,
+		CriterionErrorReasonINVALID_REGIONCODE_LENGTH,
+		CriterionErrorReasonINVALID_REGIONNAME_LENGTH,
+		CriterionErrorReasonINVALID_POSTALCODE_LENGTH,
+		CriterionErrorReasonINVALID_COUNTRY_CODE,
+		CriterionErrorReasonINVALID_LATITUDE,
+		CriterionErrorReasonINVALID_LONGITUDE,
+		CriterionErrorReasonPROXIMITY_GEOPOINT_AND_ADDRESS_BOTH_CANNOT_BE_NULL,
+		CriterionErrorReasonINVALID_PROXIMITY_ADDRESS,
+		CriterionErrorReasonINVALID_USER_DOMAIN_NAME,
+		CriterionErrorReasonCRITERION_PARAMETER_TOO_LONG,
+		CriterionErrorReasonAD_SCHEDULE_TIME_INTERVALS_OVERLAP,
+		CriterionErrorReasonAD_SCHEDULE_INTERVAL_CANNOT_SPAN_MULTIPLE_DAYS,
+		CriterionErrorReasonAD_SCHEDULE_INVALID_TIME_INTERVAL,
+		CriterionErrorReasonAD_SCHEDULE_EXCEEDED_INTERVALS_PER_DAY_LIMIT,
+		CriterionErrorReasonAD_SCHEDULE_CRITERION_ID_MISMATCHING_FIELDS,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_TYPE,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_CAMPAIGN_OPTED_OUT,
+		CriterionErrorReasonCANNOT_BID_MODIFY_NEGATIVE_CRITERION,
+		CriterionErrorReasonBID_MODIFIER_ALREADY_EXISTS,
+		CriterionErrorReasonFEED_ID_NOT_ALLOWED,
+		CriterionErrorReasonACCOUNT_INELIGIBLE_FOR_CRITERIA_TYPE,
+		CriterionErrorReasonCRITERIA_TYPE_INVALID_FOR_BIDDING_STRATEGY,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERION,
+		CriterionErrorReasonCANNOT_REMOVE_CRITERION,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_LONG,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_LONG,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_BIDDING_CATEGORY,
+		CriterionErrorReasonMISSING_SHOPPING_SETTING,
+		CriterionErrorReasonINVALID_MATCHING_FUNCTION,
+		CriterionErrorReasonLOCATION_FILTER_NOT_ALLOWED,
+		CriterionErrorReasonLOCATION_FILTER_INVALID,
+		CriterionErrorReasonCANNOT_ATTACH_CRITERIA_AT_CAMPAIGN_AND_ADGROUP,
+		CriterionErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CriterionErrorReason.
+func (e CriterionErrorReason) IsValid() bool {
+	switch e {
+	case CriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CriterionErrorReasonINVALID_EXCLUDED_CATEGORY,
+		CriterionErrorReasonINVALID_KEYWORD_TEXT,
+		CriterionErrorReasonKEYWORD_TEXT_TOO_LONG,
+		CriterionErrorReasonKEYWORD_HAS_TOO_MANY_WORDS,
+		CriterionErrorReasonKEYWORD_HAS_INVALID_CHARS,
+		CriterionErrorReasonINVALID_PLACEMENT_URL,
+		CriterionErrorReasonINVALID_USER_LIST,
+		CriterionErrorReasonINVALID_USER_INTEREST,
+		CriterionErrorReasonINVALID_FORMAT_FOR_PLACEMENT_URL,
+		CriterionErrorReasonPLACEMENT_URL_IS_TOO_LONG,
+		CriterionErrorReasonPLACEMENT_URL_HAS_ILLEGAL_CHAR,
+		CriterionErrorReasonPLACEMENT_URL_HAS_MULTIPLE_SITES_IN_LINE,
+		CriterionErrorReasonPLACEMENT_IS_NOT_AVAILABLE_FOR_TARGETING_OR_EXCLUSION,
+		CriterionErrorReasonINVALID_VERTICAL_PATH,
+		CriterionErrorReasonYOUTUBE_VERTICAL_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_DEMOGRAPHIC_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_URL_UNSUPPORTED,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CriterionErrorReasonCANNOT_ADD_CRITERIA_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_FILTER,
+		CriterionErrorReasonPRODUCT_FILTER_TOO_LONG,
+		CriterionErrorReasonCANNOT_EXCLUDE_SIMILAR_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_CLOSED_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_ONLY_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SHOPPING_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_USER_INTERESTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_SET_BIDS_ON_CRITERION_TYPE_IN_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_URLS_TO_CRITERION_TYPE_FOR_CAMPAIGN_TYPE,
+		CriterionErrorReasonINVALID_IP_ADDRESS,
+		CriterionErrorReasonINVALID_IP_FORMAT,
+		CriterionErrorReasonINVALID_MOBILE_APP,
+		CriterionErrorReasonINVALID_MOBILE_APP_CATEGORY,
+		CriterionErrorReasonINVALID_CRITERION_ID,
+		CriterionErrorReasonCANNOT_TARGET_CRITERION,
+		CriterionErrorReasonCANNOT_TARGET_OBSOLETE_CRITERION,
+		CriterionErrorReasonCRITERION_ID_AND_TYPE_MISMATCH,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS_UNITS,
+		CriterionErrorReasonINVALID_STREETADDRESS_LENGTH,
+		CriterionErrorReasonINVALID_CITYNAME_LENGTH,
+		CriterionErrorReasonINVALID_REGIONCODE_LENGTH,
+		CriterionErrorReasonINVALID_REGIONNAME_LENGTH,
+		CriterionErrorReasonINVALID_POSTALCODE_LENGTH,
+		CriterionErrorReasonINVALID_COUNTRY_CODE,
+		CriterionErrorReasonINVALID_LATITUDE,
+		CriterionErrorReasonINVALID_LONGITUDE,
+		CriterionErrorReasonPROXIMITY_GEOPOINT_AND_ADDRESS_BOTH_CANNOT_BE_NULL,
+		CriterionErrorReasonINVALID_PROXIMITY_ADDRESS,
+		CriterionErrorReasonINVALID_USER_DOMAIN_NAME,
+		CriterionErrorReasonCRITERION_PARAMETER_TOO_LONG,
+		CriterionErrorReasonAD_SCHEDULE_TIME_INTERVALS_OVERLAP,
+		CriterionErrorReasonAD_SCHEDULE_INTERVAL_CANNOT_SPAN_MULTIPLE_DAYS,
+		CriterionErrorReasonAD_SCHEDULE_INVALID_TIME_INTERVAL,
+		CriterionErrorReasonAD_SCHEDULE_EXCEEDED_INTERVALS_PER_DAY_LIMIT,
+		CriterionErrorReasonAD_SCHEDULE_CRITERION_ID_MISMATCHING_FIELDS,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_TYPE,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_CAMPAIGN_OPTED_OUT,
+		CriterionErrorReasonCANNOT_BID_MODIFY_NEGATIVE_CRITERION,
+		CriterionErrorReasonBID_MODIFIER_ALREADY_EXISTS,
+		CriterionErrorReasonFEED_ID_NOT_ALLOWED,
+		CriterionErrorReasonACCOUNT_INELIGIBLE_FOR_CRITERIA_TYPE,
+		CriterionErrorReasonCRITERIA_TYPE_INVALID_FOR_BIDDING_STRATEGY,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERION,
+		CriterionErrorReasonCANNOT_REMOVE_CRITERION,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_LONG,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_LONG,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_BIDDING_CATEGORY,
+		CriterionErrorReasonMISSING_SHOPPING_SETTING,
+		CriterionErrorReasonINVALID_MATCHING_FUNCTION,
+		CriterionErrorReasonLOCATION_FILTER_NOT_ALLOWED,
+		CriterionErrorReasonLOCATION_FILTER_INVALID,
+		CriterionErrorReasonCANNOT_ATTACH_CRITERIA_AT_CAMPAIGN_AND_ADGROUP,
+		CriterionErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCriterionErrorReason returns the CriterionErrorReason named by s.
+func ParseCriterionErrorReason(s string) (CriterionErrorReason, error) {
+	e := CriterionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CriterionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CriterionErrorReason.
+func (e CriterionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CriterionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CriterionErrorReason) UnmarshalText(text []byte) error {
+	v := CriterionErrorReason(text)
+	if !v.IsValid() {
+		v = CriterionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DatabaseErrorReasonValues returns all values of DatabaseErrorReason.
+func DatabaseErrorReasonValues() []DatabaseErrorReason {
+	return []DatabaseErrorReason{
+		DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DatabaseErrorReason.
+func (e DatabaseErrorReason) IsValid() bool {
+	switch e {
+	case DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDatabaseErrorReason returns the DatabaseErrorReason named by s.
+func ParseDatabaseErrorReason(s string) (DatabaseErrorReason, error) {
+	e := DatabaseErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DatabaseErrorReason.
+func (e DatabaseErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DatabaseErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DatabaseErrorReason) UnmarshalText(text []byte) error {
+	v := DatabaseErrorReason(text)
+	if !v.IsValid() {
+		v = DatabaseErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DateErrorReasonValues returns all values of DateErrorReason.
+func DateErrorReasonValues() []DateErrorReason {
+	return []DateErrorReason{
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL,
+	}
+}
+
+// IsValid reports whether e is one of the values of DateErrorReason.
+func (e DateErrorReason) IsValid() bool {
+	switch e {
+	case DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL:
+		return true
+	}
+	return false
+}
+
+// ParseDateErrorReason returns the DateErrorReason named by s.
+func ParseDateErrorReason(s string) (DateErrorReason, error) {
+	e := DateErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DateErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DateErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DateErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DateErrorReason) UnmarshalText(text []byte) error {
+	v := DateErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DayOfWeekValues returns all values of DayOfWeek.
+func DayOfWeekValues() []DayOfWeek {
+	return []DayOfWeek{
+		DayOfWeekMONDAY,
+		DayOfWeekTUESDAY,
+		DayOfWeekWEDNESDAY,
+		DayOfWeekTHURSDAY,
+		DayOfWeekFRIDAY,
+		DayOfWeekSATURDAY,
+		DayOfWeekSUNDAY,
+	}
+}
+
+// IsValid reports whether e is one of the values of DayOfWeek.
+func (e DayOfWeek) IsValid() bool {
+	switch e {
+	case DayOfWeekMONDAY,
+		DayOfWeekTUESDAY,
+		DayOfWeekWEDNESDAY,
+		DayOfWeekTHURSDAY,
+		DayOfWeekFRIDAY,
+		DayOfWeekSATURDAY,
+		DayOfWeekSUNDAY:
+		return true
+	}
+	return false
+}
+
+// ParseDayOfWeek returns the DayOfWeek named by s.
+func ParseDayOfWeek(s string) (DayOfWeek, error) {
+	e := DayOfWeek(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DayOfWeek %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DayOfWeek are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DayOfWeek) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DayOfWeek) UnmarshalText(text []byte) error {
+	v := DayOfWeek(text)
+	*e = v
+	return nil
+}
+
+// DistinctErrorReasonValues returns all values of DistinctErrorReason.
+func DistinctErrorReasonValues() []DistinctErrorReason {
+	return []DistinctErrorReason{
+		DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DistinctErrorReason.
+func (e DistinctErrorReason) IsValid() bool {
+	switch e {
+	case DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDistinctErrorReason returns the DistinctErrorReason named by s.
+func ParseDistinctErrorReason(s string) (DistinctErrorReason, error) {
+	e := DistinctErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DistinctErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DistinctErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DistinctErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DistinctErrorReason) UnmarshalText(text []byte) error {
+	v := DistinctErrorReason(text)
+	*e = v
+	return nil
+}
+
+// EntityAccessDeniedReasonValues returns all values of EntityAccessDeniedReason.
+func EntityAccessDeniedReasonValues() []EntityAccessDeniedReason {
+	return []EntityAccessDeniedReason{
+		EntityAccessDeniedReasonREAD_ACCESS_DENIED,
+		EntityAccessDeniedReasonWRITE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityAccessDeniedReason.
+func (e EntityAccessDeniedReason) IsValid() bool {
+	switch e {
+	case EntityAccessDeniedReasonREAD_ACCESS_DENIED,
+		EntityAccessDeniedReasonWRITE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseEntityAccessDeniedReason returns the EntityAccessDeniedReason named by s.
+func ParseEntityAccessDeniedReason(s string) (EntityAccessDeniedReason, error) {
+	e := EntityAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityAccessDeniedReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityAccessDeniedReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := EntityAccessDeniedReason(text)
+	*e = v
+	return nil
+}
+
+// EntityCountLimitExceededReasonValues returns all values of EntityCountLimitExceededReason.
+func EntityCountLimitExceededReasonValues() []EntityCountLimitExceededReason {
+	return []EntityCountLimitExceededReason{
+		EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) IsValid() bool {
+	switch e {
+	case EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseEntityCountLimitExceededReason returns the EntityCountLimitExceededReason named by s.
+func ParseEntityCountLimitExceededReason(s string) (EntityCountLimitExceededReason, error) {
+	e := EntityCountLimitExceededReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityCountLimitExceededReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid EntityCountLimitExceededReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *EntityCountLimitExceededReason) UnmarshalText(text []byte) error {
+	v := EntityCountLimitExceededReason(text)
+	if !v.IsValid() {
+		v = EntityCountLimitExceededReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// EntityNotFoundReasonValues returns all values of EntityNotFoundReason.
+func EntityNotFoundReasonValues() []EntityNotFoundReason {
+	return []EntityNotFoundReason{
+		EntityNotFoundReasonINVALID_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityNotFoundReason.
+func (e EntityNotFoundReason) IsValid() bool {
+	switch e {
+	case EntityNotFoundReasonINVALID_ID:
+		return true
+	}
+	return false
+}
+
+// ParseEntityNotFoundReason returns the EntityNotFoundReason named by s.
+func ParseEntityNotFoundReason(s string) (EntityNotFoundReason, error) {
+	e := EntityNotFoundReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityNotFoundReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityNotFoundReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityNotFoundReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityNotFoundReason) UnmarshalText(text []byte) error {
+	v := EntityNotFoundReason(text)
+	*e = v
+	return nil
+}
+
+// ExtensionSettingPlatformValues returns all values of ExtensionSettingPlatform.
+func ExtensionSettingPlatformValues() []ExtensionSettingPlatform {
+	return []ExtensionSettingPlatform{
+		ExtensionSettingPlatformDESKTOP,
+		ExtensionSettingPlatformMOBILE,
+		ExtensionSettingPlatformNONE,
+	}
+}
+
+// IsValid reports whether e is one of the values of ExtensionSettingPlatform.
+func (e ExtensionSettingPlatform) IsValid() bool {
+	switch e {
+	case ExtensionSettingPlatformDESKTOP,
+		ExtensionSettingPlatformMOBILE,
+		ExtensionSettingPlatformNONE:
+		return true
+	}
+	return false
+}
+
+// ParseExtensionSettingPlatform returns the ExtensionSettingPlatform named by s.
+func ParseExtensionSettingPlatform(s string) (ExtensionSettingPlatform, error) {
+	e := ExtensionSettingPlatform(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ExtensionSettingPlatform %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ExtensionSettingPlatform are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ExtensionSettingPlatform) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ExtensionSettingPlatform) UnmarshalText(text []byte) error {
+	v := ExtensionSettingPlatform(text)
+	*e = v
+	return nil
+}
+
+// ExtensionSettingErrorReasonValues returns all values of ExtensionSettingErrorReason.
+func ExtensionSettingErrorReasonValues() []ExtensionSettingErrorReason {
+	return []ExtensionSettingErrorReason{
+		ExtensionSettingErrorReasonEXTENSIONS_REQUIRED,
+		ExtensionSettingErrorReasonFEED_TYPE_EXTENSION_TYPE_MISMATCH,
+		ExtensionSettingErrorReasonINVALID_FEED_TYPE,
+		ExtensionSettingErrorReasonINVALID_FEED_TYPE_FOR_CUSTOMER_EXTENSION_SETTING,
+		ExtensionSettingErrorReasonCANNOT_CHANGE_FEED_ITEM_ON_ADD,
+		ExtensionSettingErrorReasonCANNOT_UPDATE_NEWLY_ADDED_EXTENSION,
+		ExtensionSettingErrorReasonNO_EXISTING_AD_GROUP_EXTENSION_SETTING_FOR_TYPE,
+		ExtensionSettingErrorReasonNO_EXISTING_CAMPAIGN_EXTENSION_SETTING_FOR_TYPE,
+		ExtensionSettingErrorReasonNO_EXISTING_CUSTOMER_EXTENSION_SETTING_FOR_TYPE,
+		ExtensionSettingErrorReasonAD_GROUP_EXTENSION_SETTING_ALREADY_EXISTS,
+		ExtensionSettingErrorReasonCAMPAIGN_EXTENSION_SETTING_ALREADY_EXISTS,
+		ExtensionSettingErrorReasonCUSTOMER_EXTENSION_SETTING_ALREADY_EXISTS,
+		ExtensionSettingErrorReasonAD_GROUP_FEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		ExtensionSettingErrorReasonCAMPAIGN_FEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		ExtensionSettingErrorReasonCUSTOMER_FEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		ExtensionSettingErrorReasonVALUE_OUT_OF_RANGE,
+		ExtensionSettingErrorReasonCANNOT_SET_WITH_FINAL_URLS,
+		ExtensionSettingErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		ExtensionSettingErrorReasonCANNOT_SET_BOTH_DESTINATION_URL_AND_TRACKING_URL_TEMPLATE,
+		ExtensionSettingErrorReasonINVALID_PHONE_NUMBER,
+		ExtensionSettingErrorReasonPHONE_NUMBER_NOT_SUPPORTED_FOR_COUNTRY,
+		ExtensionSettingErrorReasonCARRIER_SPECIFIC_SHORT_NUMBER_NOT_ALLOWED,
+		ExtensionSettingErrorReasonPREMIUM_RATE_NUMBER_NOT_ALLOWED,
+		ExtensionSettingErrorReasonDISALLOWED_NUMBER_TYPE,
+		ExtensionSettingErrorReasonINVALID_DOMESTIC_PHONE_NUMBER_FORMAT,
+		ExtensionSettingErrorReasonVANITY_PHONE_NUMBER_NOT_ALLOWED,
+		ExtensionSettingErrorReasonINVALID_COUNTRY_CODE,
+		ExtensionSettingErrorReasonINVALID_CALL_CONVERSION_TYPE_ID,
+		ExtensionSettingErrorReasonCUSTOMER_NOT_WHITELISTED_FOR_CALLTRACKING,
+		ExtensionSettingErrorReasonCALLTRACKING_NOT_SUPPORTED_FOR_COUNTRY,
+		ExtensionSettingErrorReasonINVALID_APP_ID,
+		ExtensionSettingErrorReasonQUOTES_IN_REVIEW_EXTENSION_SNIPPET,
+		ExtensionSettingErrorReasonHYPHENS_IN_REVIEW_EXTENSION_SNIPPET,
+		ExtensionSettingErrorReasonREVIEW_EXTENSION_SOURCE_INELIGIBLE,
+		ExtensionSettingErrorReasonSOURCE_NAME_IN_REVIEW_EXTENSION_TEXT,
+		ExtensionSettingErrorReasonMISSING_FIELD,
+		ExtensionSettingErrorReasonINCONSISTENT_CURRENCY_CODES,
+		ExtensionSettingErrorReasonPRICE_EXTENSION_HAS_DUPLICATED_HEADERS,
+		ExtensionSettingErrorReasonPRICE_ITEM_HAS_DUPLICATED_HEADER_AND_DESCRIPTION,
+		ExtensionSettingErrorReasonPRICE_EXTENSION_HAS_TOO_FEW_ITEMS,
+		ExtensionSettingErrorReasonPRICE_EXTENSION_HAS_TOO_MANY_ITEMS,
+		ExtensionSettingErrorReasonUNSUPPORTED_VALUE,
+		ExtensionSettingErrorReasonUNSUPPORTED_VALUE_IN_SELECTED_LANGUAGE,
+		ExtensionSettingErrorReasonINVALID_DEVICE_PREFERENCE,
+		ExtensionSettingErrorReasonINVALID_SCHEDULE_END,
+		ExtensionSettingErrorReasonDATE_TIME_MUST_BE_IN_ACCOUNT_TIME_ZONE,
+		ExtensionSettingErrorReasonOVERLAPPING_SCHEDULES,
+		ExtensionSettingErrorReasonSCHEDULE_END_NOT_AFTER_START,
+		ExtensionSettingErrorReasonTOO_MANY_SCHEDULES_PER_DAY,
+		ExtensionSettingErrorReasonDUPLICATE_EXTENSION_FEED_ITEM_EDIT,
+		ExtensionSettingErrorReasonINVALID_SNIPPETS_HEADER,
+		ExtensionSettingErrorReasonPHONE_NUMBER_NOT_SUPPORTED_WITH_CALLTRACKING_FOR_COUNTRY,
+		ExtensionSettingErrorReasonCAMPAIGN_TARGETING_MISMATCH,
+		ExtensionSettingErrorReasonCANNOT_OPERATE_ON_DELETED_FEED,
+		ExtensionSettingErrorReasonCONCRETE_EXTENSION_TYPE_REQUIRED,
+		ExtensionSettingErrorReasonINCOMPATIBLE_UNDERLYING_MATCHING_FUNCTION,
+		ExtensionSettingErrorReasonSTART_DATE_AFTER_END_DATE,
+		ExtensionSettingErrorReasonINVALID_PRICE_FORMAT,
+		ExtensionSettingErrorReasonPROMOTION_INVALID_TIME,
+		ExtensionSettingErrorReasonPROMOTION_CANNOT_SET_PERCENT_OFF_AND_MONEY_AMOUNT_OFF,
+		ExtensionSettingErrorReasonPROMOTION_CANNOT_SET_PROMOTION_CODE_AND_ORDERS_OVER_AMOUNT,
+		ExtensionSettingErrorReasonTOO_MANY_DECIMAL_PLACES_SPECIFIED,
+		ExtensionSettingErrorReasonINVALID_LANGUAGE_CODE,
+		ExtensionSettingErrorReasonUNSUPPORTED_LANGUAGE,
+		ExtensionSettingErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of ExtensionSettingErrorReason.
+func (e ExtensionSettingErrorReason) IsValid() bool {
+	switch e {
+	case ExtensionSettingErrorReasonEXTENSIONS_REQUIRED,
+		ExtensionSettingErrorReasonFEED_TYPE_EXTENSION_TYPE_MISMATCH,
+		ExtensionSettingErrorReasonINVALID_FEED_TYPE,
+		ExtensionSettingErrorReasonINVALID_FEED_TYPE_FOR_CUSTOMER_EXTENSION_SETTING,
+		ExtensionSettingErrorReasonCANNOT_CHANGE_FEED_ITEM_ON_ADD,
+		ExtensionSettingErrorReasonCANNOT_UPDATE_NEWLY_ADDED_EXTENSION,
+		ExtensionSettingErrorReasonNO_EXISTING_AD_GROUP_EXTENSION_SETTING_FOR_TYPE,
+		ExtensionSettingErrorReasonNO_EXISTING_CAMPAIGN_EXTENSION_SETTING_FOR_TYPE,
+		ExtensionSettingErrorReasonNO_EXISTING_CUSTOMER_EXTENSION_SETTING_FOR_TYPE,
+		ExtensionSettingErrorReasonAD_GROUP_EXTENSION_SETTING_ALREADY_EXISTS,
+		ExtensionSettingErrorReasonCAMPAIGN_EXTENSION_SETTING_ALREADY_EXISTS,
+		ExtensionSettingErrorReasonCUSTOMER_EXTENSION_SETTING_ALREADY_EXISTS,
+		ExtensionSettingErrorReasonAD_GROUP_FEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		ExtensionSettingErrorReasonCAMPAIGN_FEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		ExtensionSettingErrorReasonCUSTOMER_FEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		ExtensionSettingErrorReasonVALUE_OUT_OF_RANGE,
+		ExtensionSettingErrorReasonCANNOT_SET_WITH_FINAL_URLS,
+		ExtensionSettingErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		ExtensionSettingErrorReasonCANNOT_SET_BOTH_DESTINATION_URL_AND_TRACKING_URL_TEMPLATE,
+		ExtensionSettingErrorReasonINVALID_PHONE_NUMBER,
+		ExtensionSettingErrorReasonPHONE_NUMBER_NOT_SUPPORTED_FOR_COUNTRY,
+		ExtensionSettingErrorReasonCARRIER_SPECIFIC_SHORT_NUMBER_NOT_ALLOWED,
+		ExtensionSettingErrorReasonPREMIUM_RATE_NUMBER_NOT_ALLOWED,
+		ExtensionSettingErrorReasonDISALLOWED_NUMBER_TYPE,
+		ExtensionSettingErrorReasonINVALID_DOMESTIC_PHONE_NUMBER_FORMAT,
+		ExtensionSettingErrorReasonVANITY_PHONE_NUMBER_NOT_ALLOWED,
+		ExtensionSettingErrorReasonINVALID_COUNTRY_CODE,
+		ExtensionSettingErrorReasonINVALID_CALL_CONVERSION_TYPE_ID,
+		ExtensionSettingErrorReasonCUSTOMER_NOT_WHITELISTED_FOR_CALLTRACKING,
+		ExtensionSettingErrorReasonCALLTRACKING_NOT_SUPPORTED_FOR_COUNTRY,
+		ExtensionSettingErrorReasonINVALID_APP_ID,
+		ExtensionSettingErrorReasonQUOTES_IN_REVIEW_EXTENSION_SNIPPET,
+		ExtensionSettingErrorReasonHYPHENS_IN_REVIEW_EXTENSION_SNIPPET,
+		ExtensionSettingErrorReasonREVIEW_EXTENSION_SOURCE_INELIGIBLE,
+		ExtensionSettingErrorReasonSOURCE_NAME_IN_REVIEW_EXTENSION_TEXT,
+		ExtensionSettingErrorReasonMISSING_FIELD,
+		ExtensionSettingErrorReasonINCONSISTENT_CURRENCY_CODES,
+		ExtensionSettingErrorReasonPRICE_EXTENSION_HAS_DUPLICATED_HEADERS,
+		ExtensionSettingErrorReasonPRICE_ITEM_HAS_DUPLICATED_HEADER_AND_DESCRIPTION,
+		ExtensionSettingErrorReasonPRICE_EXTENSION_HAS_TOO_FEW_ITEMS,
+		ExtensionSettingErrorReasonPRICE_EXTENSION_HAS_TOO_MANY_ITEMS,
+		ExtensionSettingErrorReasonUNSUPPORTED_VALUE,
+		ExtensionSettingErrorReasonUNSUPPORTED_VALUE_IN_SELECTED_LANGUAGE,
+		ExtensionSettingErrorReasonINVALID_DEVICE_PREFERENCE,
+		ExtensionSettingErrorReasonINVALID_SCHEDULE_END,
+		ExtensionSettingErrorReasonDATE_TIME_MUST_BE_IN_ACCOUNT_TIME_ZONE,
+		ExtensionSettingErrorReasonOVERLAPPING_SCHEDULES,
+		ExtensionSettingErrorReasonSCHEDULE_END_NOT_AFTER_START,
+		ExtensionSettingErrorReasonTOO_MANY_SCHEDULES_PER_DAY,
+		ExtensionSettingErrorReasonDUPLICATE_EXTENSION_FEED_ITEM_EDIT,
+		ExtensionSettingErrorReasonINVALID_SNIPPETS_HEADER,
+		ExtensionSettingErrorReasonPHONE_NUMBER_NOT_SUPPORTED_WITH_CALLTRACKING_FOR_COUNTRY,
+		ExtensionSettingErrorReasonCAMPAIGN_TARGETING_MISMATCH,
+		ExtensionSettingErrorReasonCANNOT_OPERATE_ON_DELETED_FEED,
+		ExtensionSettingErrorReasonCONCRETE_EXTENSION_TYPE_REQUIRED,
+		ExtensionSettingErrorReasonINCOMPATIBLE_UNDERLYING_MATCHING_FUNCTION,
+		ExtensionSettingErrorReasonSTART_DATE_AFTER_END_DATE,
+		ExtensionSettingErrorReasonINVALID_PRICE_FORMAT,
+		ExtensionSettingErrorReasonPROMOTION_INVALID_TIME,
+		ExtensionSettingErrorReasonPROMOTION_CANNOT_SET_PERCENT_OFF_AND_MONEY_AMOUNT_OFF,
+		ExtensionSettingErrorReasonPROMOTION_CANNOT_SET_PROMOTION_CODE_AND_ORDERS_OVER_AMOUNT,
+		ExtensionSettingErrorReasonTOO_MANY_DECIMAL_PLACES_SPECIFIED,
+		ExtensionSettingErrorReasonINVALID_LANGUAGE_CODE,
+		ExtensionSettingErrorReasonUNSUPPORTED_LANGUAGE,
+		ExtensionSettingErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseExtensionSettingErrorReason returns the ExtensionSettingErrorReason named by s.
+func ParseExtensionSettingErrorReason(s string) (ExtensionSettingErrorReason, error) {
+	e := ExtensionSettingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ExtensionSettingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of ExtensionSettingErrorReason.
+func (e ExtensionSettingErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid ExtensionSettingErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *ExtensionSettingErrorReason) UnmarshalText(text []byte) error {
+	v := ExtensionSettingErrorReason(text)
+	if !v.IsValid() {
+		v = ExtensionSettingErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FeedItemStatusValues returns all values of FeedItemStatus.
+func FeedItemStatusValues() []FeedItemStatus {
+	return []FeedItemStatus{
+		FeedItemStatusENABLED,
+		FeedItemStatusREMOVED,
+		FeedItemStatusUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedItemStatus.
+func (e FeedItemStatus) IsValid() bool {
+	switch e {
+	case FeedItemStatusENABLED,
+		FeedItemStatusREMOVED,
+		FeedItemStatusUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFeedItemStatus returns the FeedItemStatus named by s.
+func ParseFeedItemStatus(s string) (FeedItemStatus, error) {
+	e := FeedItemStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedItemStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FeedItemStatus.
+func (e FeedItemStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FeedItemStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FeedItemStatus) UnmarshalText(text []byte) error {
+	v := FeedItemStatus(text)
+	if !v.IsValid() {
+		v = FeedItemStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FeedItemApprovalStatusValues returns all values of FeedItemApprovalStatus.
+func FeedItemApprovalStatusValues() []FeedItemApprovalStatus {
+	return []FeedItemApprovalStatus{
+		FeedItemApprovalStatusUNCHECKED,
+		FeedItemApprovalStatusAPPROVED,
+		FeedItemApprovalStatusDISAPPROVED,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedItemApprovalStatus.
+func (e FeedItemApprovalStatus) IsValid() bool {
+	switch e {
+	case FeedItemApprovalStatusUNCHECKED,
+		FeedItemApprovalStatusAPPROVED,
+		FeedItemApprovalStatusDISAPPROVED:
+		return true
+	}
+	return false
+}
+
+// ParseFeedItemApprovalStatus returns the FeedItemApprovalStatus named by s.
+func ParseFeedItemApprovalStatus(s string) (FeedItemApprovalStatus, error) {
+	e := FeedItemApprovalStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedItemApprovalStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// FeedItemApprovalStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e FeedItemApprovalStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *FeedItemApprovalStatus) UnmarshalText(text []byte) error {
+	v := FeedItemApprovalStatus(text)
+	*e = v
+	return nil
+}
+
+// FeedItemQualityApprovalStatusValues returns all values of FeedItemQualityApprovalStatus.
+func FeedItemQualityApprovalStatusValues() []FeedItemQualityApprovalStatus {
+	return []FeedItemQualityApprovalStatus{
+		FeedItemQualityApprovalStatusUNKNOWN,
+		FeedItemQualityApprovalStatusAPPROVED,
+		FeedItemQualityApprovalStatusDISAPPROVED,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedItemQualityApprovalStatus.
+func (e FeedItemQualityApprovalStatus) IsValid() bool {
+	switch e {
+	case FeedItemQualityApprovalStatusUNKNOWN,
+		FeedItemQualityApprovalStatusAPPROVED,
+		FeedItemQualityApprovalStatusDISAPPROVED:
+		return true
+	}
+	return false
+}
+
+// ParseFeedItemQualityApprovalStatus returns the FeedItemQualityApprovalStatus named by s.
+func ParseFeedItemQualityApprovalStatus(s string) (FeedItemQualityApprovalStatus, error) {
+	e := FeedItemQualityApprovalStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedItemQualityApprovalStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FeedItemQualityApprovalStatus.
+func (e FeedItemQualityApprovalStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FeedItemQualityApprovalStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FeedItemQualityApprovalStatus) UnmarshalText(text []byte) error {
+	v := FeedItemQualityApprovalStatus(text)
+	if !v.IsValid() {
+		v = FeedItemQualityApprovalStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FeedItemQualityDisapprovalReasonsValues returns all values of FeedItemQualityDisapprovalReasons.
+func FeedItemQualityDisapprovalReasonsValues() []FeedItemQualityDisapprovalReasons {
+	return []FeedItemQualityDisapprovalReasons{
+		FeedItemQualityDisapprovalReasonsUNKNOWN,
+		FeedItemQualityDisapprovalReasonsTABLE_REPETITIVE_HEADERS,
+		FeedItemQualityDisapprovalReasonsTABLE_REPETITIVE_DESCRIPTION,
+		FeedItemQualityDisapprovalReasonsTABLE_INCONSISTENT_ROWS,
+		FeedItemQualityDisapprovalReasonsDESCRIPTION_HAS_PRICE_QUALIFIERS,
+		FeedItemQualityDisapprovalReasonsUNSUPPORTED_LANGUAGE,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_HEADER_TABLE_TYPE_MISMATCH,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_HEADER_HAS_PROMOTIONAL_TEXT,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_DESCRIPTION_NOT_RELEVANT,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_DESCRIPTION_HAS_PROMOTIONAL_TEXT,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_HEADER_DESCRIPTION_REPETITIVE,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_UNRATEABLE,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_PRICE_INVALID,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_URL_INVALID,
+		FeedItemQualityDisapprovalReasonsHEADER_OR_DESCRIPTION_HAS_PRICE,
+		FeedItemQualityDisapprovalReasonsSTRUCTURED_SNIPPETS_HEADER_POLICY_VIOLATED,
+		FeedItemQualityDisapprovalReasonsSTRUCTURED_SNIPPETS_REPEATED_VALUES,
+		FeedItemQualityDisapprovalReasonsSTRUCTURED_SNIPPETS_EDITORIAL_GUIDELINES,
+		FeedItemQualityDisapprovalReasonsSTRUCTURED_SNIPPETS_HAS_PROMOTIONAL_TEXT,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedItemQualityDisapprovalReasons.
+func (e FeedItemQualityDisapprovalReasons) IsValid() bool {
+	switch e {
+	case FeedItemQualityDisapprovalReasonsUNKNOWN,
+		FeedItemQualityDisapprovalReasonsTABLE_REPETITIVE_HEADERS,
+		FeedItemQualityDisapprovalReasonsTABLE_REPETITIVE_DESCRIPTION,
+		FeedItemQualityDisapprovalReasonsTABLE_INCONSISTENT_ROWS,
+		FeedItemQualityDisapprovalReasonsDESCRIPTION_HAS_PRICE_QUALIFIERS,
+		FeedItemQualityDisapprovalReasonsUNSUPPORTED_LANGUAGE,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_HEADER_TABLE_TYPE_MISMATCH,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_HEADER_HAS_PROMOTIONAL_TEXT,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_DESCRIPTION_NOT_RELEVANT,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_DESCRIPTION_HAS_PROMOTIONAL_TEXT,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_HEADER_DESCRIPTION_REPETITIVE,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_UNRATEABLE,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_PRICE_INVALID,
+		FeedItemQualityDisapprovalReasonsTABLE_ROW_URL_INVALID,
+		FeedItemQualityDisapprovalReasonsHEADER_OR_DESCRIPTION_HAS_PRICE,
+		FeedItemQualityDisapprovalReasonsSTRUCTURED_SNIPPETS_HEADER_POLICY_VIOLATED,
+		FeedItemQualityDisapprovalReasonsSTRUCTURED_SNIPPETS_REPEATED_VALUES,
+		FeedItemQualityDisapprovalReasonsSTRUCTURED_SNIPPETS_EDITORIAL_GUIDELINES,
+		FeedItemQualityDisapprovalReasonsSTRUCTURED_SNIPPETS_HAS_PROMOTIONAL_TEXT:
+		return true
+	}
+	return false
+}
+
+// ParseFeedItemQualityDisapprovalReasons returns the FeedItemQualityDisapprovalReasons named by s.
+func ParseFeedItemQualityDisapprovalReasons(s string) (FeedItemQualityDisapprovalReasons, error) {
+	e := FeedItemQualityDisapprovalReasons(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedItemQualityDisapprovalReasons %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FeedItemQualityDisapprovalReasons.
+func (e FeedItemQualityDisapprovalReasons) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FeedItemQualityDisapprovalReasons %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FeedItemQualityDisapprovalReasons) UnmarshalText(text []byte) error {
+	v := FeedItemQualityDisapprovalReasons(text)
+	if !v.IsValid() {
+		v = FeedItemQualityDisapprovalReasonsUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FeedItemValidationStatusValues returns all values of FeedItemValidationStatus.
+func FeedItemValidationStatusValues() []FeedItemValidationStatus {
+	return []FeedItemValidationStatus{
+		FeedItemValidationStatusUNCHECKED,
+		FeedItemValidationStatusERROR,
+		FeedItemValidationStatusVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedItemValidationStatus.
+func (e FeedItemValidationStatus) IsValid() bool {
+	switch e {
+	case FeedItemValidationStatusUNCHECKED,
+		FeedItemValidationStatusERROR,
+		FeedItemValidationStatusVALID:
+		return true
+	}
+	return false
+}
+
+// ParseFeedItemValidationStatus returns the FeedItemValidationStatus named by s.
+func ParseFeedItemValidationStatus(s string) (FeedItemValidationStatus, error) {
+	e := FeedItemValidationStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedItemValidationStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// FeedItemValidationStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e FeedItemValidationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *FeedItemValidationStatus) UnmarshalText(text []byte) error {
+	v := FeedItemValidationStatus(text)
+	*e = v
+	return nil
+}
+
+// FeedTypeValues returns all values of FeedType.
+func FeedTypeValues() []FeedType {
+	return []FeedType{
+		FeedTypeNONE,
+		FeedTypeSITELINK,
+		FeedTypeCALL,
+		FeedTypeAPP,
+		FeedTypeREVIEW,
+		FeedTypeAD_CUSTOMIZER,
+		FeedTypeCALLOUT,
+		FeedTypeSTRUCTURED_SNIPPET,
+		FeedTypeMESSAGE,
+		FeedTypePRICE,
+		FeedTypePROMOTION,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedType.
+func (e FeedType) IsValid() bool {
+	switch e {
+	case FeedTypeNONE,
+		FeedTypeSITELINK,
+		FeedTypeCALL,
+		FeedTypeAPP,
+		FeedTypeREVIEW,
+		FeedTypeAD_CUSTOMIZER,
+		FeedTypeCALLOUT,
+		FeedTypeSTRUCTURED_SNIPPET,
+		FeedTypeMESSAGE,
+		FeedTypePRICE,
+		FeedTypePROMOTION:
+		return true
+	}
+	return false
+}
+
+// ParseFeedType returns the FeedType named by s.
+func ParseFeedType(s string) (FeedType, error) {
+	e := FeedType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// FeedType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e FeedType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *FeedType) UnmarshalText(text []byte) error {
+	v := FeedType(text)
+	*e = v
+	return nil
+}
+
+// GeoRestrictionValues returns all values of GeoRestriction.
+func GeoRestrictionValues() []GeoRestriction {
+	return []GeoRestriction{
+		GeoRestrictionUNKNOWN,
+		GeoRestrictionLOCATION_OF_PRESENCE,
+	}
+}
+
+// IsValid reports whether e is one of the values of GeoRestriction.
+func (e GeoRestriction) IsValid() bool {
+	switch e {
+	case GeoRestrictionUNKNOWN,
+		GeoRestrictionLOCATION_OF_PRESENCE:
+		return true
+	}
+	return false
+}
+
+// ParseGeoRestriction returns the GeoRestriction named by s.
+func ParseGeoRestriction(s string) (GeoRestriction, error) {
+	e := GeoRestriction(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid GeoRestriction %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of GeoRestriction.
+func (e GeoRestriction) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid GeoRestriction %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *GeoRestriction) UnmarshalText(text []byte) error {
+	v := GeoRestriction(text)
+	if !v.IsValid() {
+		v = GeoRestrictionUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// IdErrorReasonValues returns all values of IdErrorReason.
+func IdErrorReasonValues() []IdErrorReason {
+	return []IdErrorReason{
+		IdErrorReasonNOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of IdErrorReason.
+func (e IdErrorReason) IsValid() bool {
+	switch e {
+	case IdErrorReasonNOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseIdErrorReason returns the IdErrorReason named by s.
+func ParseIdErrorReason(s string) (IdErrorReason, error) {
+	e := IdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// IdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e IdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *IdErrorReason) UnmarshalText(text []byte) error {
+	v := IdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// InternalApiErrorReasonValues returns all values of InternalApiErrorReason.
+func InternalApiErrorReasonValues() []InternalApiErrorReason {
+	return []InternalApiErrorReason{
+		InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE,
+	}
+}
+
+// IsValid reports whether e is one of the values of InternalApiErrorReason.
+func (e InternalApiErrorReason) IsValid() bool {
+	switch e {
+	case InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE:
+		return true
+	}
+	return false
+}
+
+// ParseInternalApiErrorReason returns the InternalApiErrorReason named by s.
+func ParseInternalApiErrorReason(s string) (InternalApiErrorReason, error) {
+	e := InternalApiErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid InternalApiErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of InternalApiErrorReason.
+func (e InternalApiErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid InternalApiErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *InternalApiErrorReason) UnmarshalText(text []byte) error {
+	v := InternalApiErrorReason(text)
+	if !v.IsValid() {
+		v = InternalApiErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// KeywordMatchTypeValues returns all values of KeywordMatchType.
+func KeywordMatchTypeValues() []KeywordMatchType {
+	return []KeywordMatchType{
+		KeywordMatchTypeEXACT,
+		KeywordMatchTypePHRASE,
+		KeywordMatchTypeBROAD,
+	}
+}
+
+// IsValid reports whether e is one of the values of KeywordMatchType.
+func (e KeywordMatchType) IsValid() bool {
+	switch e {
+	case KeywordMatchTypeEXACT,
+		KeywordMatchTypePHRASE,
+		KeywordMatchTypeBROAD:
+		return true
+	}
+	return false
+}
+
+// ParseKeywordMatchType returns the KeywordMatchType named by s.
+func ParseKeywordMatchType(s string) (KeywordMatchType, error) {
+	e := KeywordMatchType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid KeywordMatchType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// KeywordMatchType are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e KeywordMatchType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *KeywordMatchType) UnmarshalText(text []byte) error {
+	v := KeywordMatchType(text)
+	*e = v
+	return nil
+}
+
+// LocationTargetingStatusValues returns all values of LocationTargetingStatus.
+func LocationTargetingStatusValues() []LocationTargetingStatus {
+	return []LocationTargetingStatus{
+		LocationTargetingStatusACTIVE,
+		LocationTargetingStatusOBSOLETE,
+		LocationTargetingStatusPHASING_OUT,
+	}
+}
+
+// IsValid reports whether e is one of the values of LocationTargetingStatus.
+func (e LocationTargetingStatus) IsValid() bool {
+	switch e {
+	case LocationTargetingStatusACTIVE,
+		LocationTargetingStatusOBSOLETE,
+		LocationTargetingStatusPHASING_OUT:
+		return true
+	}
+	return false
+}
+
+// ParseLocationTargetingStatus returns the LocationTargetingStatus named by s.
+func ParseLocationTargetingStatus(s string) (LocationTargetingStatus, error) {
+	e := LocationTargetingStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid LocationTargetingStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// LocationTargetingStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e LocationTargetingStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *LocationTargetingStatus) UnmarshalText(text []byte) error {
+	v := LocationTargetingStatus(text)
+	*e = v
+	return nil
+}
+
+// MinuteOfHourValues returns all values of MinuteOfHour.
+func MinuteOfHourValues() []MinuteOfHour {
+	return []MinuteOfHour{
+		MinuteOfHourZERO,
+		MinuteOfHourFIFTEEN,
+		MinuteOfHourTHIRTY,
+		MinuteOfHourFORTY_FIVE,
+	}
+}
+
+// IsValid reports whether e is one of the values of MinuteOfHour.
+func (e MinuteOfHour) IsValid() bool {
+	switch e {
+	case MinuteOfHourZERO,
+		MinuteOfHourFIFTEEN,
+		MinuteOfHourTHIRTY,
+		MinuteOfHourFORTY_FIVE:
+		return true
+	}
+	return false
+}
+
+// ParseMinuteOfHour returns the MinuteOfHour named by s.
+func ParseMinuteOfHour(s string) (MinuteOfHour, error) {
+	e := MinuteOfHour(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MinuteOfHour %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MinuteOfHour are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MinuteOfHour) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MinuteOfHour) UnmarshalText(text []byte) error {
+	v := MinuteOfHour(text)
+	*e = v
+	return nil
+}
+
+// NewEntityCreationErrorReasonValues returns all values of NewEntityCreationErrorReason.
+func NewEntityCreationErrorReasonValues() []NewEntityCreationErrorReason {
+	return []NewEntityCreationErrorReason{
+		NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS,
+	}
+}
+
+// IsValid reports whether e is one of the values of NewEntityCreationErrorReason.
+func (e NewEntityCreationErrorReason) IsValid() bool {
+	switch e {
+	case NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS:
+		return true
+	}
+	return false
+}
+
+// ParseNewEntityCreationErrorReason returns the NewEntityCreationErrorReason named by s.
+func ParseNewEntityCreationErrorReason(s string) (NewEntityCreationErrorReason, error) {
+	e := NewEntityCreationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NewEntityCreationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NewEntityCreationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NewEntityCreationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NewEntityCreationErrorReason) UnmarshalText(text []byte) error {
+	v := NewEntityCreationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NotEmptyErrorReasonValues returns all values of NotEmptyErrorReason.
+func NotEmptyErrorReasonValues() []NotEmptyErrorReason {
+	return []NotEmptyErrorReason{
+		NotEmptyErrorReasonEMPTY_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotEmptyErrorReason.
+func (e NotEmptyErrorReason) IsValid() bool {
+	switch e {
+	case NotEmptyErrorReasonEMPTY_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseNotEmptyErrorReason returns the NotEmptyErrorReason named by s.
+func ParseNotEmptyErrorReason(s string) (NotEmptyErrorReason, error) {
+	e := NotEmptyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotEmptyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotEmptyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotEmptyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotEmptyErrorReason) UnmarshalText(text []byte) error {
+	v := NotEmptyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NullErrorReasonValues returns all values of NullErrorReason.
+func NullErrorReasonValues() []NullErrorReason {
+	return []NullErrorReason{
+		NullErrorReasonNULL_CONTENT,
+	}
+}
+
+// IsValid reports whether e is one of the values of NullErrorReason.
+func (e NullErrorReason) IsValid() bool {
+	switch e {
+	case NullErrorReasonNULL_CONTENT:
+		return true
+	}
+	return false
+}
+
+// ParseNullErrorReason returns the NullErrorReason named by s.
+func ParseNullErrorReason(s string) (NullErrorReason, error) {
+	e := NullErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NullErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NullErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NullErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NullErrorReason) UnmarshalText(text []byte) error {
+	v := NullErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OperationAccessDeniedReasonValues returns all values of OperationAccessDeniedReason.
+func OperationAccessDeniedReasonValues() []OperationAccessDeniedReason {
+	return []OperationAccessDeniedReason{
+		OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) IsValid() bool {
+	switch e {
+	case OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperationAccessDeniedReason returns the OperationAccessDeniedReason named by s.
+func ParseOperationAccessDeniedReason(s string) (OperationAccessDeniedReason, error) {
+	e := OperationAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperationAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperationAccessDeniedReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperationAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := OperationAccessDeniedReason(text)
+	if !v.IsValid() {
+		v = OperationAccessDeniedReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperatorValues returns all values of Operator.
+func OperatorValues() []Operator {
+	return []Operator{
+		OperatorADD,
+		OperatorREMOVE,
+		OperatorSET,
+	}
+}
+
+// IsValid reports whether e is one of the values of Operator.
+func (e Operator) IsValid() bool {
+	switch e {
+	case OperatorADD,
+		OperatorREMOVE,
+		OperatorSET:
+		return true
+	}
+	return false
+}
+
+// ParseOperator returns the Operator named by s.
+func ParseOperator(s string) (Operator, error) {
+	e := Operator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid Operator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// Operator are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e Operator) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *Operator) UnmarshalText(text []byte) error {
+	v := Operator(text)
+	*e = v
+	return nil
+}
+
+// OperatorErrorReasonValues returns all values of OperatorErrorReason.
+func OperatorErrorReasonValues() []OperatorErrorReason {
+	return []OperatorErrorReason{
+		OperatorErrorReasonOPERATOR_NOT_SUPPORTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatorErrorReason.
+func (e OperatorErrorReason) IsValid() bool {
+	switch e {
+	case OperatorErrorReasonOPERATOR_NOT_SUPPORTED:
+		return true
+	}
+	return false
+}
+
+// ParseOperatorErrorReason returns the OperatorErrorReason named by s.
+func ParseOperatorErrorReason(s string) (OperatorErrorReason, error) {
+	e := OperatorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// OperatorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e OperatorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *OperatorErrorReason) UnmarshalText(text []byte) error {
+	v := OperatorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// PredicateOperatorValues returns all values of PredicateOperator.
+func PredicateOperatorValues() []PredicateOperator {
+	return []PredicateOperator{
+		PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PredicateOperator.
+func (e PredicateOperator) IsValid() bool {
+	switch e {
+	case PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePredicateOperator returns the PredicateOperator named by s.
+func ParsePredicateOperator(s string) (PredicateOperator, error) {
+	e := PredicateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PredicateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PredicateOperator.
+func (e PredicateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PredicateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PredicateOperator) UnmarshalText(text []byte) error {
+	v := PredicateOperator(text)
+	if !v.IsValid() {
+		v = PredicateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PriceExtensionPriceQualifierValues returns all values of PriceExtensionPriceQualifier.
+func PriceExtensionPriceQualifierValues() []PriceExtensionPriceQualifier {
+	return []PriceExtensionPriceQualifier{
+		PriceExtensionPriceQualifierUNKNOWN,
+		PriceExtensionPriceQualifierFROM,
+		PriceExtensionPriceQualifierUP_TO,
+		PriceExtensionPriceQualifierAVERAGE,
+		PriceExtensionPriceQualifierNONE,
+	}
+}
+
+// IsValid reports whether e is one of the values of PriceExtensionPriceQualifier.
+func (e PriceExtensionPriceQualifier) IsValid() bool {
+	switch e {
+	case PriceExtensionPriceQualifierUNKNOWN,
+		PriceExtensionPriceQualifierFROM,
+		PriceExtensionPriceQualifierUP_TO,
+		PriceExtensionPriceQualifierAVERAGE,
+		PriceExtensionPriceQualifierNONE:
+		return true
+	}
+	return false
+}
+
+// ParsePriceExtensionPriceQualifier returns the PriceExtensionPriceQualifier named by s.
+func ParsePriceExtensionPriceQualifier(s string) (PriceExtensionPriceQualifier, error) {
+	e := PriceExtensionPriceQualifier(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PriceExtensionPriceQualifier %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PriceExtensionPriceQualifier.
+func (e PriceExtensionPriceQualifier) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PriceExtensionPriceQualifier %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PriceExtensionPriceQualifier) UnmarshalText(text []byte) error {
+	v := PriceExtensionPriceQualifier(text)
+	if !v.IsValid() {
+		v = PriceExtensionPriceQualifierUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PriceExtensionPriceUnitValues returns all values of PriceExtensionPriceUnit.
+func PriceExtensionPriceUnitValues() []PriceExtensionPriceUnit {
+	return []PriceExtensionPriceUnit{
+		PriceExtensionPriceUnitUNKNOWN,
+		PriceExtensionPriceUnitPER_HOUR,
+		PriceExtensionPriceUnitPER_DAY,
+		PriceExtensionPriceUnitPER_WEEK,
+		PriceExtensionPriceUnitPER_MONTH,
+		PriceExtensionPriceUnitPER_YEAR,
+		PriceExtensionPriceUnitPER_NIGHT,
+		PriceExtensionPriceUnitNONE,
+	}
+}
+
+// IsValid reports whether e is one of the values of PriceExtensionPriceUnit.
+func (e PriceExtensionPriceUnit) IsValid() bool {
+	switch e {
+	case PriceExtensionPriceUnitUNKNOWN,
+		PriceExtensionPriceUnitPER_HOUR,
+		PriceExtensionPriceUnitPER_DAY,
+		PriceExtensionPriceUnitPER_WEEK,
+		PriceExtensionPriceUnitPER_MONTH,
+		PriceExtensionPriceUnitPER_YEAR,
+		PriceExtensionPriceUnitPER_NIGHT,
+		PriceExtensionPriceUnitNONE:
+		return true
+	}
+	return false
+}
+
+// ParsePriceExtensionPriceUnit returns the PriceExtensionPriceUnit named by s.
+func ParsePriceExtensionPriceUnit(s string) (PriceExtensionPriceUnit, error) {
+	e := PriceExtensionPriceUnit(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PriceExtensionPriceUnit %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PriceExtensionPriceUnit.
+func (e PriceExtensionPriceUnit) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PriceExtensionPriceUnit %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PriceExtensionPriceUnit) UnmarshalText(text []byte) error {
+	v := PriceExtensionPriceUnit(text)
+	if !v.IsValid() {
+		v = PriceExtensionPriceUnitUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PriceExtensionTypeValues returns all values of PriceExtensionType.
+func PriceExtensionTypeValues() []PriceExtensionType {
+	return []PriceExtensionType{
+		PriceExtensionTypeUNKNOWN,
+		PriceExtensionTypeBRANDS,
+		PriceExtensionTypeEVENTS,
+		PriceExtensionTypeLOCATIONS,
+		PriceExtensionTypeNEIGHBORHOODS,
+		PriceExtensionTypePRODUCT_CATEGORIES,
+		PriceExtensionTypePRODUCT_TIERS,
+		PriceExtensionTypeSERVICES,
+		PriceExtensionTypeSERVICE_CATEGORIES,
+		PriceExtensionTypeSERVICE_TIERS,
+	}
+}
+
+// IsValid reports whether e is one of the values of PriceExtensionType.
+func (e PriceExtensionType) IsValid() bool {
+	switch e {
+	case PriceExtensionTypeUNKNOWN,
+		PriceExtensionTypeBRANDS,
+		PriceExtensionTypeEVENTS,
+		PriceExtensionTypeLOCATIONS,
+		PriceExtensionTypeNEIGHBORHOODS,
+		PriceExtensionTypePRODUCT_CATEGORIES,
+		PriceExtensionTypePRODUCT_TIERS,
+		PriceExtensionTypeSERVICES,
+		PriceExtensionTypeSERVICE_CATEGORIES,
+		PriceExtensionTypeSERVICE_TIERS:
+		return true
+	}
+	return false
+}
+
+// ParsePriceExtensionType returns the PriceExtensionType named by s.
+func ParsePriceExtensionType(s string) (PriceExtensionType, error) {
+	e := PriceExtensionType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PriceExtensionType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PriceExtensionType.
+func (e PriceExtensionType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PriceExtensionType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PriceExtensionType) UnmarshalText(text []byte) error {
+	v := PriceExtensionType(text)
+	if !v.IsValid() {
+		v = PriceExtensionTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PromotionExtensionDiscountModifierValues returns all values of PromotionExtensionDiscountModifier.
+func PromotionExtensionDiscountModifierValues() []PromotionExtensionDiscountModifier {
+	return []PromotionExtensionDiscountModifier{
+		PromotionExtensionDiscountModifierUNKNOWN,
+		PromotionExtensionDiscountModifierUP_TO,
+		PromotionExtensionDiscountModifierNONE,
+	}
+}
+
+// IsValid reports whether e is one of the values of PromotionExtensionDiscountModifier.
+func (e PromotionExtensionDiscountModifier) IsValid() bool {
+	switch e {
+	case PromotionExtensionDiscountModifierUNKNOWN,
+		PromotionExtensionDiscountModifierUP_TO,
+		PromotionExtensionDiscountModifierNONE:
+		return true
+	}
+	return false
+}
+
+// ParsePromotionExtensionDiscountModifier returns the PromotionExtensionDiscountModifier named by s.
+func ParsePromotionExtensionDiscountModifier(s string) (PromotionExtensionDiscountModifier, error) {
+	e := PromotionExtensionDiscountModifier(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PromotionExtensionDiscountModifier %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PromotionExtensionDiscountModifier.
+func (e PromotionExtensionDiscountModifier) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PromotionExtensionDiscountModifier %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PromotionExtensionDiscountModifier) UnmarshalText(text []byte) error {
+	v := PromotionExtensionDiscountModifier(text)
+	if !v.IsValid() {
+		v = PromotionExtensionDiscountModifierUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PromotionExtensionOccasionValues returns all values of PromotionExtensionOccasion.
+func PromotionExtensionOccasionValues() []PromotionExtensionOccasion {
+	return []PromotionExtensionOccasion{
+		PromotionExtensionOccasionUNKNOWN,
+		PromotionExtensionOccasionNEW_YEARS,
+		PromotionExtensionOccasionVALENTINES_DAY,
+		PromotionExtensionOccasionEASTER,
+		PromotionExtensionOccasionMOTHERS_DAY,
+		PromotionExtensionOccasionFATHERS_DAY,
+		PromotionExtensionOccasionLABOR_DAY,
+		PromotionExtensionOccasionBACK_TO_SCHOOL,
+		PromotionExtensionOccasionHALLOWEEN,
+		PromotionExtensionOccasionBLACK_FRIDAY,
+		PromotionExtensionOccasionCYBER_MONDAY,
+		PromotionExtensionOccasionCHRISTMAS,
+		PromotionExtensionOccasionBOXING_DAY,
+		PromotionExtensionOccasionNONE,
+	}
+}
+
+// IsValid reports whether e is one of the values of PromotionExtensionOccasion.
+func (e PromotionExtensionOccasion) IsValid() bool {
+	switch e {
+	case PromotionExtensionOccasionUNKNOWN,
+		PromotionExtensionOccasionNEW_YEARS,
+		PromotionExtensionOccasionVALENTINES_DAY,
+		PromotionExtensionOccasionEASTER,
+		PromotionExtensionOccasionMOTHERS_DAY,
+		PromotionExtensionOccasionFATHERS_DAY,
+		PromotionExtensionOccasionLABOR_DAY,
+		PromotionExtensionOccasionBACK_TO_SCHOOL,
+		PromotionExtensionOccasionHALLOWEEN,
+		PromotionExtensionOccasionBLACK_FRIDAY,
+		PromotionExtensionOccasionCYBER_MONDAY,
+		PromotionExtensionOccasionCHRISTMAS,
+		PromotionExtensionOccasionBOXING_DAY,
+		PromotionExtensionOccasionNONE:
+		return true
+	}
+	return false
+}
+
+// ParsePromotionExtensionOccasion returns the PromotionExtensionOccasion named by s.
+func ParsePromotionExtensionOccasion(s string) (PromotionExtensionOccasion, error) {
+	e := PromotionExtensionOccasion(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PromotionExtensionOccasion %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PromotionExtensionOccasion.
+func (e PromotionExtensionOccasion) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PromotionExtensionOccasion %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PromotionExtensionOccasion) UnmarshalText(text []byte) error {
+	v := PromotionExtensionOccasion(text)
+	if !v.IsValid() {
+		v = PromotionExtensionOccasionUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// QueryErrorReasonValues returns all values of QueryErrorReason.
+func QueryErrorReasonValues() []QueryErrorReason {
+	return []QueryErrorReason{
+		QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QueryErrorReason.
+func (e QueryErrorReason) IsValid() bool {
+	switch e {
+	case QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED:
+		return true
+	}
+	return false
+}
+
+// ParseQueryErrorReason returns the QueryErrorReason named by s.
+func ParseQueryErrorReason(s string) (QueryErrorReason, error) {
+	e := QueryErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QueryErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QueryErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QueryErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QueryErrorReason) UnmarshalText(text []byte) error {
+	v := QueryErrorReason(text)
+	*e = v
+	return nil
+}
+
+// QuotaCheckErrorReasonValues returns all values of QuotaCheckErrorReason.
+func QuotaCheckErrorReasonValues() []QuotaCheckErrorReason {
+	return []QuotaCheckErrorReason{
+		QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QuotaCheckErrorReason.
+func (e QuotaCheckErrorReason) IsValid() bool {
+	switch e {
+	case QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseQuotaCheckErrorReason returns the QuotaCheckErrorReason named by s.
+func ParseQuotaCheckErrorReason(s string) (QuotaCheckErrorReason, error) {
+	e := QuotaCheckErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QuotaCheckErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QuotaCheckErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QuotaCheckErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QuotaCheckErrorReason) UnmarshalText(text []byte) error {
+	v := QuotaCheckErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RangeErrorReasonValues returns all values of RangeErrorReason.
+func RangeErrorReasonValues() []RangeErrorReason {
+	return []RangeErrorReason{
+		RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH,
+	}
+}
+
+// IsValid reports whether e is one of the values of RangeErrorReason.
+func (e RangeErrorReason) IsValid() bool {
+	switch e {
+	case RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH:
+		return true
+	}
+	return false
+}
+
+// ParseRangeErrorReason returns the RangeErrorReason named by s.
+func ParseRangeErrorReason(s string) (RangeErrorReason, error) {
+	e := RangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RangeErrorReason) UnmarshalText(text []byte) error {
+	v := RangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RateExceededErrorReasonValues returns all values of RateExceededErrorReason.
+func RateExceededErrorReasonValues() []RateExceededErrorReason {
+	return []RateExceededErrorReason{
+		RateExceededErrorReasonRATE_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RateExceededErrorReason.
+func (e RateExceededErrorReason) IsValid() bool {
+	switch e {
+	case RateExceededErrorReasonRATE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseRateExceededErrorReason returns the RateExceededErrorReason named by s.
+func ParseRateExceededErrorReason(s string) (RateExceededErrorReason, error) {
+	e := RateExceededErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RateExceededErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RateExceededErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RateExceededErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RateExceededErrorReason) UnmarshalText(text []byte) error {
+	v := RateExceededErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ReadOnlyErrorReasonValues returns all values of ReadOnlyErrorReason.
+func ReadOnlyErrorReasonValues() []ReadOnlyErrorReason {
+	return []ReadOnlyErrorReason{
+		ReadOnlyErrorReasonREAD_ONLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ReadOnlyErrorReason.
+func (e ReadOnlyErrorReason) IsValid() bool {
+	switch e {
+	case ReadOnlyErrorReasonREAD_ONLY:
+		return true
+	}
+	return false
+}
+
+// ParseReadOnlyErrorReason returns the ReadOnlyErrorReason named by s.
+func ParseReadOnlyErrorReason(s string) (ReadOnlyErrorReason, error) {
+	e := ReadOnlyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ReadOnlyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ReadOnlyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ReadOnlyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ReadOnlyErrorReason) UnmarshalText(text []byte) error {
+	v := ReadOnlyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RejectedErrorReasonValues returns all values of RejectedErrorReason.
+func RejectedErrorReasonValues() []RejectedErrorReason {
+	return []RejectedErrorReason{
+		RejectedErrorReasonUNKNOWN_VALUE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RejectedErrorReason.
+func (e RejectedErrorReason) IsValid() bool {
+	switch e {
+	case RejectedErrorReasonUNKNOWN_VALUE:
+		return true
+	}
+	return false
+}
+
+// ParseRejectedErrorReason returns the RejectedErrorReason named by s.
+func ParseRejectedErrorReason(s string) (RejectedErrorReason, error) {
+	e := RejectedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RejectedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RejectedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RejectedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RejectedErrorReason) UnmarshalText(text []byte) error {
+	v := RejectedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RequestErrorReasonValues returns all values of RequestErrorReason.
+func RequestErrorReasonValues() []RequestErrorReason {
+	return []RequestErrorReason{
+		RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequestErrorReason.
+func (e RequestErrorReason) IsValid() bool {
+	switch e {
+	case RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION:
+		return true
+	}
+	return false
+}
+
+// ParseRequestErrorReason returns the RequestErrorReason named by s.
+func ParseRequestErrorReason(s string) (RequestErrorReason, error) {
+	e := RequestErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequestErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of RequestErrorReason.
+func (e RequestErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid RequestErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *RequestErrorReason) UnmarshalText(text []byte) error {
+	v := RequestErrorReason(text)
+	if !v.IsValid() {
+		v = RequestErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RequiredErrorReasonValues returns all values of RequiredErrorReason.
+func RequiredErrorReasonValues() []RequiredErrorReason {
+	return []RequiredErrorReason{
+		RequiredErrorReasonREQUIRED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequiredErrorReason.
+func (e RequiredErrorReason) IsValid() bool {
+	switch e {
+	case RequiredErrorReasonREQUIRED:
+		return true
+	}
+	return false
+}
+
+// ParseRequiredErrorReason returns the RequiredErrorReason named by s.
+func ParseRequiredErrorReason(s string) (RequiredErrorReason, error) {
+	e := RequiredErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequiredErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RequiredErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RequiredErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RequiredErrorReason) UnmarshalText(text []byte) error {
+	v := RequiredErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SelectorErrorReasonValues returns all values of SelectorErrorReason.
+func SelectorErrorReasonValues() []SelectorErrorReason {
+	return []SelectorErrorReason{
+		SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of SelectorErrorReason.
+func (e SelectorErrorReason) IsValid() bool {
+	switch e {
+	case SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseSelectorErrorReason returns the SelectorErrorReason named by s.
+func ParseSelectorErrorReason(s string) (SelectorErrorReason, error) {
+	e := SelectorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SelectorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SelectorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SelectorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SelectorErrorReason) UnmarshalText(text []byte) error {
+	v := SelectorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SizeLimitErrorReasonValues returns all values of SizeLimitErrorReason.
+func SizeLimitErrorReasonValues() []SizeLimitErrorReason {
+	return []SizeLimitErrorReason{
+		SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) IsValid() bool {
+	switch e {
+	case SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSizeLimitErrorReason returns the SizeLimitErrorReason named by s.
+func ParseSizeLimitErrorReason(s string) (SizeLimitErrorReason, error) {
+	e := SizeLimitErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeLimitErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SizeLimitErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SizeLimitErrorReason) UnmarshalText(text []byte) error {
+	v := SizeLimitErrorReason(text)
+	if !v.IsValid() {
+		v = SizeLimitErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SortOrderValues returns all values of SortOrder.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASCENDING,
+		SortOrderDESCENDING,
+	}
+}
+
+// IsValid reports whether e is one of the values of SortOrder.
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderASCENDING,
+		SortOrderDESCENDING:
+		return true
+	}
+	return false
+}
+
+// ParseSortOrder returns the SortOrder named by s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	e := SortOrder(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SortOrder %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SortOrder are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	*e = v
+	return nil
+}
+
+// StringFormatErrorReasonValues returns all values of StringFormatErrorReason.
+func StringFormatErrorReasonValues() []StringFormatErrorReason {
+	return []StringFormatErrorReason{
+		StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringFormatErrorReason.
+func (e StringFormatErrorReason) IsValid() bool {
+	switch e {
+	case StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT:
+		return true
+	}
+	return false
+}
+
+// ParseStringFormatErrorReason returns the StringFormatErrorReason named by s.
+func ParseStringFormatErrorReason(s string) (StringFormatErrorReason, error) {
+	e := StringFormatErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringFormatErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringFormatErrorReason.
+func (e StringFormatErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringFormatErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringFormatErrorReason) UnmarshalText(text []byte) error {
+	v := StringFormatErrorReason(text)
+	if !v.IsValid() {
+		v = StringFormatErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// StringLengthErrorReasonValues returns all values of StringLengthErrorReason.
+func StringLengthErrorReasonValues() []StringLengthErrorReason {
+	return []StringLengthErrorReason{
+		StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringLengthErrorReason.
+func (e StringLengthErrorReason) IsValid() bool {
+	switch e {
+	case StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG:
+		return true
+	}
+	return false
+}
+
+// ParseStringLengthErrorReason returns the StringLengthErrorReason named by s.
+func ParseStringLengthErrorReason(s string) (StringLengthErrorReason, error) {
+	e := StringLengthErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringLengthErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StringLengthErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StringLengthErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StringLengthErrorReason) UnmarshalText(text []byte) error {
+	v := StringLengthErrorReason(text)
+	*e = v
+	return nil
+}
+
+// UrlErrorReasonValues returns all values of UrlErrorReason.
+func UrlErrorReasonValues() []UrlErrorReason {
+	return []UrlErrorReason{
+		UrlErrorReasonINVALID_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TAG_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMALFORMED_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_HOST_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TLD_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonREDUNDANT_NESTED_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonINVALID_FINAL_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonMALFORMED_FINAL_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_URL,
+		UrlErrorReasonINVALID_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_MOBILE_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonMALFORMED_FINAL_MOBILE_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_FINAL_APP_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_APP_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_APP_URL_TAG,
+		UrlErrorReasonMULTIPLE_APP_URLS_FOR_OSTYPE,
+		UrlErrorReasonINVALID_OSTYPE,
+		UrlErrorReasonINVALID_PROTOCOL_FOR_APP_URL,
+		UrlErrorReasonINVALID_PACKAGE_ID_FOR_APP_URL,
+		UrlErrorReasonURL_CUSTOM_PARAMETERS_COUNT_EXCEEDS_LIMIT,
+		UrlErrorReasonURL_CUSTOM_PARAMETER_REMOVAL_WITH_NON_NULL_VALUE,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_IN_ADD_OPERATION,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_DURING_FULL_REPLACEMENT,
+		UrlErrorReasonNULL_CUSTOM_PARAMETER_VALUE_DURING_ADD_OR_FULL_REPLACEMENT,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_KEY,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonINVALID_TAG_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonREDUNDANT_NESTED_URL_CUSTOM_PARAMETER_TAG,
+		UrlErrorReasonMISSING_PROTOCOL,
+		UrlErrorReasonINVALID_URL,
+		UrlErrorReasonDESTINATION_URL_DEPRECATED,
+		UrlErrorReasonINVALID_TAG_IN_URL,
+		UrlErrorReasonMISSING_URL_TAG,
+		UrlErrorReasonDUPLICATE_URL_ID,
+		UrlErrorReasonINVALID_URL_ID,
+		UrlErrorReasonURL_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of UrlErrorReason.
+func (e UrlErrorReason) IsValid() bool {
+	switch e {
+	case UrlErrorReasonINVALID_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TAG_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMALFORMED_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_HOST_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TLD_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonREDUNDANT_NESTED_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonINVALID_FINAL_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonMALFORMED_FINAL_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_URL,
+		UrlErrorReasonINVALID_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_MOBILE_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonMALFORMED_FINAL_MOBILE_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_FINAL_APP_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_APP_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_APP_URL_TAG,
+		UrlErrorReasonMULTIPLE_APP_URLS_FOR_OSTYPE,
+		UrlErrorReasonINVALID_OSTYPE,
+		UrlErrorReasonINVALID_PROTOCOL_FOR_APP_URL,
+		UrlErrorReasonINVALID_PACKAGE_ID_FOR_APP_URL,
+		UrlErrorReasonURL_CUSTOM_PARAMETERS_COUNT_EXCEEDS_LIMIT,
+		UrlErrorReasonURL_CUSTOM_PARAMETER_REMOVAL_WITH_NON_NULL_VALUE,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_IN_ADD_OPERATION,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_DURING_FULL_REPLACEMENT,
+		UrlErrorReasonNULL_CUSTOM_PARAMETER_VALUE_DURING_ADD_OR_FULL_REPLACEMENT,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_KEY,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonINVALID_TAG_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonREDUNDANT_NESTED_URL_CUSTOM_PARAMETER_TAG,
+		UrlErrorReasonMISSING_PROTOCOL,
+		UrlErrorReasonINVALID_URL,
+		UrlErrorReasonDESTINATION_URL_DEPRECATED,
+		UrlErrorReasonINVALID_TAG_IN_URL,
+		UrlErrorReasonMISSING_URL_TAG,
+		UrlErrorReasonDUPLICATE_URL_ID,
+		UrlErrorReasonINVALID_URL_ID,
+		UrlErrorReasonURL_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseUrlErrorReason returns the UrlErrorReason named by s.
+func ParseUrlErrorReason(s string) (UrlErrorReason, error) {
+	e := UrlErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UrlErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// UrlErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e UrlErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *UrlErrorReason) UnmarshalText(text []byte) error {
+	v := UrlErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CriterionUserListMembershipStatusValues returns all values of CriterionUserListMembershipStatus.
+func CriterionUserListMembershipStatusValues() []CriterionUserListMembershipStatus {
+	return []CriterionUserListMembershipStatus{
+		CriterionUserListMembershipStatusOPEN,
+		CriterionUserListMembershipStatusCLOSED,
+	}
+}
+
+// IsValid reports whether e is one of the values of CriterionUserListMembershipStatus.
+func (e CriterionUserListMembershipStatus) IsValid() bool {
+	switch e {
+	case CriterionUserListMembershipStatusOPEN,
+		CriterionUserListMembershipStatusCLOSED:
+		return true
+	}
+	return false
+}
+
+// ParseCriterionUserListMembershipStatus returns the CriterionUserListMembershipStatus named by s.
+func ParseCriterionUserListMembershipStatus(s string) (CriterionUserListMembershipStatus, error) {
+	e := CriterionUserListMembershipStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CriterionUserListMembershipStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// CriterionUserListMembershipStatus are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e CriterionUserListMembershipStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *CriterionUserListMembershipStatus) UnmarshalText(text []byte) error {
+	v := CriterionUserListMembershipStatus(text)
+	*e = v
+	return nil
+}
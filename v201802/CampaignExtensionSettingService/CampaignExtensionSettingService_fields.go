@@ -0,0 +1,462 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignExtensionSettingService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// CampaignExtensionSettingFields holds the selector field names of CampaignExtensionSetting, including
+// the fields of the types it holds.
+var CampaignExtensionSettingFields = struct {
+	CampaignId           string
+	ExtensionType        string
+	Extensions           string
+	PlatformRestrictions string
+}{
+	CampaignId:           "CampaignId",
+	ExtensionType:        "ExtensionType",
+	Extensions:           "Extensions",
+	PlatformRestrictions: "PlatformRestrictions",
+}
+
+// ExtensionSettingFields holds the selector field names of ExtensionSetting, including
+// the fields of the types it holds.
+var ExtensionSettingFields = struct {
+	Extensions           string
+	PlatformRestrictions string
+}{
+	Extensions:           "Extensions",
+	PlatformRestrictions: "PlatformRestrictions",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"AppFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "AppFeedItem", Name: "AppStore"},
+		{Type: "AppFeedItem", Name: "AppId"},
+		{Type: "AppFeedItem", Name: "AppLinkText"},
+		{Type: "AppFeedItem", Name: "AppUrl"},
+		{Type: "AppFeedItem", Name: "AppFinalUrls"},
+		{Type: "AppFeedItem", Name: "AppFinalMobileUrls"},
+		{Type: "AppFeedItem", Name: "AppTrackingUrlTemplate"},
+		{Type: "AppFeedItem", Name: "AppUrlCustomParameters"},
+	},
+	"CallFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "CallFeedItem", Name: "CallPhoneNumber"},
+		{Type: "CallFeedItem", Name: "CallCountryCode"},
+		{Type: "CallFeedItem", Name: "CallTracking"},
+		{Type: "CallFeedItem", Name: "CallConversionType"},
+		{Type: "CallFeedItem", Name: "DisableCallConversionTracking"},
+	},
+	"CalloutFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "CalloutFeedItem", Name: "CalloutText"},
+	},
+	"CampaignExtensionSetting": {
+		{Type: "CampaignExtensionSetting", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId", Required: true},
+		{Type: "CampaignExtensionSetting", Name: "ExtensionType", Selectors: []string{"ExtensionType"}, Filter: "ExtensionType", Required: true},
+		{Type: "CampaignExtensionSetting", Name: "ExtensionSetting"},
+	},
+	"CampaignExtensionSettingOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "CampaignExtensionSettingOperation", Name: "Operand", Required: true},
+	},
+	"Criterion": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+	},
+	"CustomParameter": {
+		{Type: "CustomParameter", Name: "Key", Required: true},
+		{Type: "CustomParameter", Name: "Value"},
+		{Type: "CustomParameter", Name: "IsRemove"},
+	},
+	"ExtensionFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+	},
+	"ExtensionSetting": {
+		{Type: "ExtensionSetting", Name: "Extensions", Selectors: []string{"Extensions"}},
+		{Type: "ExtensionSetting", Name: "PlatformRestrictions", Selectors: []string{"PlatformRestrictions"}},
+	},
+	"FeedItemAdGroupTargeting": {
+		{Type: "FeedItemAdGroupTargeting", Name: "TargetingAdGroupId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+	},
+	"FeedItemCampaignTargeting": {
+		{Type: "FeedItemCampaignTargeting", Name: "TargetingCampaignId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+	},
+	"FeedItemSchedule": {
+		{Type: "FeedItemSchedule", Name: "DayOfWeek", Required: true},
+		{Type: "FeedItemSchedule", Name: "StartHour", Required: true},
+		{Type: "FeedItemSchedule", Name: "StartMinute", Required: true},
+		{Type: "FeedItemSchedule", Name: "EndHour", Required: true},
+		{Type: "FeedItemSchedule", Name: "EndMinute", Required: true},
+	},
+	"Keyword": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Keyword", Name: "Text", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Keyword", Name: "MatchType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Location": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Location", Name: "LocationName", ReadOnly: true},
+		{Type: "Location", Name: "DisplayType", ReadOnly: true},
+		{Type: "Location", Name: "TargetingStatus", ReadOnly: true},
+		{Type: "Location", Name: "ParentLocations", ReadOnly: true},
+	},
+	"MessageFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "MessageFeedItem", Name: "MessageBusinessName"},
+		{Type: "MessageFeedItem", Name: "MessageCountryCode"},
+		{Type: "MessageFeedItem", Name: "MessagePhoneNumber"},
+		{Type: "MessageFeedItem", Name: "MessageExtensionText"},
+		{Type: "MessageFeedItem", Name: "MessageText"},
+	},
+	"MobileAppCategory": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileAppCategory", Name: "MobileAppCategoryId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileAppCategory", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileApplication": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileApplication", Name: "AppId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileApplication", Name: "DisplayName", ReadOnly: true},
+	},
+	"MoneyWithCurrency": {
+		{Type: "ComparableValue", Name: "ComparableValueType"},
+		{Type: "MoneyWithCurrency", Name: "Money", Required: true, RequiredOperators: []string{"ADD", "SET"}},
+		{Type: "MoneyWithCurrency", Name: "CurrencyCode"},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Placement": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Placement", Name: "Url", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"PriceFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "PriceFeedItem", Name: "PriceExtensionType"},
+		{Type: "PriceFeedItem", Name: "PriceQualifier"},
+		{Type: "PriceFeedItem", Name: "TrackingUrlTemplate"},
+		{Type: "PriceFeedItem", Name: "Language"},
+		{Type: "PriceFeedItem", Name: "TableRows"},
+	},
+	"PromotionFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "PromotionFeedItem", Name: "PromotionTarget"},
+		{Type: "PromotionFeedItem", Name: "DiscountModifier"},
+		{Type: "PromotionFeedItem", Name: "PercentOff"},
+		{Type: "PromotionFeedItem", Name: "MoneyAmountOff"},
+		{Type: "PromotionFeedItem", Name: "PromotionCode"},
+		{Type: "PromotionFeedItem", Name: "OrdersOverAmount"},
+		{Type: "PromotionFeedItem", Name: "PromotionStart"},
+		{Type: "PromotionFeedItem", Name: "PromotionEnd"},
+		{Type: "PromotionFeedItem", Name: "Occasion"},
+		{Type: "PromotionFeedItem", Name: "FinalUrls"},
+		{Type: "PromotionFeedItem", Name: "FinalMobileUrls"},
+		{Type: "PromotionFeedItem", Name: "TrackingUrlTemplate"},
+		{Type: "PromotionFeedItem", Name: "PromotionUrlCustomParameters"},
+		{Type: "PromotionFeedItem", Name: "Language"},
+	},
+	"ReviewFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "ReviewFeedItem", Name: "ReviewText"},
+		{Type: "ReviewFeedItem", Name: "ReviewSourceName"},
+		{Type: "ReviewFeedItem", Name: "ReviewSourceUrl"},
+		{Type: "ReviewFeedItem", Name: "ReviewTextExactlyQuoted"},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"SitelinkFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "SitelinkFeedItem", Name: "SitelinkText"},
+		{Type: "SitelinkFeedItem", Name: "SitelinkUrl"},
+		{Type: "SitelinkFeedItem", Name: "SitelinkLine2"},
+		{Type: "SitelinkFeedItem", Name: "SitelinkLine3"},
+		{Type: "SitelinkFeedItem", Name: "SitelinkFinalUrls"},
+		{Type: "SitelinkFeedItem", Name: "SitelinkFinalMobileUrls"},
+		{Type: "SitelinkFeedItem", Name: "SitelinkTrackingUrlTemplate"},
+		{Type: "SitelinkFeedItem", Name: "SitelinkUrlCustomParameters"},
+	},
+	"StructuredSnippetFeedItem": {
+		{Type: "ExtensionFeedItem", Name: "FeedId", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedItemId"},
+		{Type: "ExtensionFeedItem", Name: "Status", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "FeedType", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "ExtensionFeedItem", Name: "DevicePreference"},
+		{Type: "ExtensionFeedItem", Name: "Scheduling"},
+		{Type: "ExtensionFeedItem", Name: "CampaignTargeting"},
+		{Type: "ExtensionFeedItem", Name: "AdGroupTargeting"},
+		{Type: "ExtensionFeedItem", Name: "KeywordTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargeting"},
+		{Type: "ExtensionFeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "ExtensionFeedItem", Name: "PolicyData", ReadOnly: true},
+		{Type: "ExtensionFeedItem", Name: "ExtensionFeedItemType"},
+		{Type: "StructuredSnippetFeedItem", Name: "Header"},
+		{Type: "StructuredSnippetFeedItem", Name: "Values"},
+	},
+	"CriterionUserInterest": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserInterest", Name: "UserInterestId"},
+		{Type: "CriterionUserInterest", Name: "UserInterestParentId", ReadOnly: true},
+		{Type: "CriterionUserInterest", Name: "UserInterestName"},
+	},
+	"CriterionUserList": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserList", Name: "UserListId"},
+		{Type: "CriterionUserList", Name: "UserListName"},
+		{Type: "CriterionUserList", Name: "UserListMembershipStatus"},
+		{Type: "CriterionUserList", Name: "UserListEligibleForSearch", ReadOnly: true},
+		{Type: "CriterionUserList", Name: "UserListEligibleForDisplay", ReadOnly: true},
+	},
+	"Vertical": {
+		{Type: "Criterion", Name: "Id"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Vertical", Name: "VerticalId"},
+		{Type: "Vertical", Name: "VerticalParentId", ReadOnly: true},
+		{Type: "Vertical", Name: "Path"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
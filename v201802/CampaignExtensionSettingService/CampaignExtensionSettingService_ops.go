@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignExtensionSettingService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CampaignExtensionSetting) *CampaignExtensionSettingOperation {
+	op := OperatorADD
+	return &CampaignExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *CampaignExtensionSetting) *CampaignExtensionSettingOperation {
+	op := OperatorSET
+	return &CampaignExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CampaignExtensionSetting) *CampaignExtensionSettingOperation {
+	op := OperatorREMOVE
+	return &CampaignExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignFeedService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CampaignFeed) *CampaignFeedOperation {
+	op := OperatorADD
+	return &CampaignFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *CampaignFeed) *CampaignFeedOperation {
+	op := OperatorSET
+	return &CampaignFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CampaignFeed) *CampaignFeedOperation {
+	op := OperatorREMOVE
+	return &CampaignFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,98 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignGroupPerformanceTargetService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// CampaignGroupPerformanceTargetServiceAPI is the interface of the CampaignGroupPerformanceTargetService operations. It is
+// implemented by CampaignGroupPerformanceTargetServiceInterface and, for tests, by FakeCampaignGroupPerformanceTargetService.
+type CampaignGroupPerformanceTargetServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+}
+
+var _ CampaignGroupPerformanceTargetServiceAPI = (*CampaignGroupPerformanceTargetServiceInterface)(nil)
+var _ CampaignGroupPerformanceTargetServiceAPI = (*FakeCampaignGroupPerformanceTargetService)(nil)
+
+// FakeCall is a call recorded by FakeCampaignGroupPerformanceTargetService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeCampaignGroupPerformanceTargetService is a programmable CampaignGroupPerformanceTargetServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeCampaignGroupPerformanceTargetService struct {
+	GetFunc    func(request *Get) (*GetResponse, error)
+	MutateFunc func(request *Mutate) (*MutateResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeCampaignGroupPerformanceTargetService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeCampaignGroupPerformanceTargetService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeCampaignGroupPerformanceTargetService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeCampaignGroupPerformanceTargetService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeCampaignGroupPerformanceTargetService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeCampaignGroupPerformanceTargetService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeCampaignGroupPerformanceTargetService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeCampaignGroupPerformanceTargetService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeCampaignGroupPerformanceTargetService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
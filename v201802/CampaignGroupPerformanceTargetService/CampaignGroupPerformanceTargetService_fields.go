@@ -0,0 +1,187 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignGroupPerformanceTargetService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// CampaignGroupPerformanceTargetFields holds the selector field names of CampaignGroupPerformanceTarget, including
+// the fields of the types it holds.
+var CampaignGroupPerformanceTargetFields = struct {
+	CampaignGroupId        string
+	EfficiencyTargetType   string
+	EfficiencyTargetValue  string
+	EndDate                string
+	ForecastStatus         string
+	HasPromotedSuggestions string
+	Id                     string
+	SpendTarget            string
+	SpendTargetType        string
+	StartDate              string
+	VolumeGoalType         string
+	VolumeTargetValue      string
+}{
+	CampaignGroupId:        "CampaignGroupId",
+	EfficiencyTargetType:   "EfficiencyTargetType",
+	EfficiencyTargetValue:  "EfficiencyTargetValue",
+	EndDate:                "EndDate",
+	ForecastStatus:         "ForecastStatus",
+	HasPromotedSuggestions: "HasPromotedSuggestions",
+	Id:                     "Id",
+	SpendTarget:            "SpendTarget",
+	SpendTargetType:        "SpendTargetType",
+	StartDate:              "StartDate",
+	VolumeGoalType:         "VolumeGoalType",
+	VolumeTargetValue:      "VolumeTargetValue",
+}
+
+// PerformanceTargetFields holds the selector field names of PerformanceTarget, including
+// the fields of the types it holds.
+var PerformanceTargetFields = struct {
+	EfficiencyTargetType   string
+	EfficiencyTargetValue  string
+	EndDate                string
+	ForecastStatus         string
+	HasPromotedSuggestions string
+	SpendTarget            string
+	SpendTargetType        string
+	StartDate              string
+	VolumeGoalType         string
+	VolumeTargetValue      string
+}{
+	EfficiencyTargetType:   "EfficiencyTargetType",
+	EfficiencyTargetValue:  "EfficiencyTargetValue",
+	EndDate:                "EndDate",
+	ForecastStatus:         "ForecastStatus",
+	HasPromotedSuggestions: "HasPromotedSuggestions",
+	SpendTarget:            "SpendTarget",
+	SpendTargetType:        "SpendTargetType",
+	StartDate:              "StartDate",
+	VolumeGoalType:         "VolumeGoalType",
+	VolumeTargetValue:      "VolumeTargetValue",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"CampaignGroupPerformanceTarget": {
+		{Type: "CampaignGroupPerformanceTarget", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}, Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "CampaignGroupPerformanceTarget", Name: "CampaignGroupId", Selectors: []string{"CampaignGroupId"}, Filter: "CampaignGroupId", Required: true},
+		{Type: "CampaignGroupPerformanceTarget", Name: "PerformanceTarget", Required: true, RequiredOperators: []string{"ADD", "SET"}},
+	},
+	"CampaignGroupPerformanceTargetOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "CampaignGroupPerformanceTargetOperation", Name: "Operand", Required: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"PerformanceTarget": {
+		{Type: "PerformanceTarget", Name: "VolumeGoalType", Selectors: []string{"VolumeGoalType"}, Filter: "VolumeGoalType", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "PerformanceTarget", Name: "VolumeTargetValue", Selectors: []string{"VolumeTargetValue"}, Filter: "VolumeTargetValue"},
+		{Type: "PerformanceTarget", Name: "EfficiencyTargetType", Selectors: []string{"EfficiencyTargetType"}, Filter: "EfficiencyTargetType"},
+		{Type: "PerformanceTarget", Name: "EfficiencyTargetValue", Selectors: []string{"EfficiencyTargetValue"}, Filter: "EfficiencyTargetValue"},
+		{Type: "PerformanceTarget", Name: "SpendTarget", Selectors: []string{"SpendTarget"}, Filter: "SpendTarget"},
+		{Type: "PerformanceTarget", Name: "SpendTargetType", Selectors: []string{"SpendTargetType"}, Filter: "SpendTargetType"},
+		{Type: "PerformanceTarget", Name: "ForecastStatus", Selectors: []string{"ForecastStatus"}, Filter: "ForecastStatus", ReadOnly: true},
+		{Type: "PerformanceTarget", Name: "HasPromotedSuggestions", Selectors: []string{"HasPromotedSuggestions"}, Filter: "HasPromotedSuggestions", ReadOnly: true},
+		{Type: "PerformanceTarget", Name: "StartDate", Selectors: []string{"StartDate"}, Filter: "StartDate"},
+		{Type: "PerformanceTarget", Name: "EndDate", Selectors: []string{"EndDate"}, Filter: "EndDate"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignGroupPerformanceTargetService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CampaignGroupPerformanceTarget) *CampaignGroupPerformanceTargetOperation {
+	op := OperatorADD
+	return &CampaignGroupPerformanceTargetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *CampaignGroupPerformanceTarget) *CampaignGroupPerformanceTargetOperation {
+	op := OperatorSET
+	return &CampaignGroupPerformanceTargetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CampaignGroupPerformanceTarget) *CampaignGroupPerformanceTargetOperation {
+	op := OperatorREMOVE
+	return &CampaignGroupPerformanceTargetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
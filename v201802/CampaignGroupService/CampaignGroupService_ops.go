@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignGroupService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CampaignGroup) *CampaignGroupOperation {
+	op := OperatorADD
+	return &CampaignGroupOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *CampaignGroup) *CampaignGroupOperation {
+	op := OperatorSET
+	return &CampaignGroupOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CampaignGroup) *CampaignGroupOperation {
+	op := OperatorREMOVE
+	return &CampaignGroupOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -3018,22 +3018,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse" json:"-"`
 
-	Rval *CampaignPage `xml:"rval,omitempty"`
+	Rval *CampaignPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -3042,17 +3042,17 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, SET.</span>
 	//
-	Operations []*CampaignOperation `xml:"operations,omitempty"`
+	Operations []*CampaignOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse" json:"-"`
 
-	Rval *CampaignReturnValue `xml:"rval,omitempty"`
+	Rval *CampaignReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabel"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabel" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -3061,159 +3061,139 @@ type MutateLabel struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, REMOVE.</span>
 	//
-	Operations []*CampaignLabelOperation `xml:"operations,omitempty"`
+	Operations []*CampaignLabelOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateLabelResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabelResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateLabelResponse" json:"-"`
 
-	Rval *CampaignLabelReturnValue `xml:"rval,omitempty"`
+	Rval *CampaignLabelReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Query struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse" json:"-"`
 
-	Rval *CampaignPage `xml:"rval,omitempty"`
+	Rval *CampaignPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type LabelAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LabelAttribute"`
-
 	//
 	// Indicates that this instance is a subtype of LabelAttribute.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	LabelAttributeType string `xml:"LabelAttribute.Type,omitempty"`
+	LabelAttributeType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 LabelAttribute.Type,omitempty" json:"labelAttributeType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BiddingErrors struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingErrors"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *BiddingErrorsReason `xml:"reason,omitempty"`
+	Reason *BiddingErrorsReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingScheme"`
-
 	//
 	// Indicates that this instance is a subtype of BiddingScheme.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	BiddingSchemeType string `xml:"BiddingScheme.Type,omitempty"`
+	BiddingSchemeType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingScheme.Type,omitempty" json:"biddingSchemeType,omitempty"`
 }
 
 type BiddingStrategyConfiguration struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BiddingStrategyConfiguration"`
-
 	//
 	// Id of the bidding strategy to be associated with the campaign, ad group or ad group criteria. A
 	// bidding strategy is created using the BiddingStrategyService ADD operation and is assigned a
@@ -3224,14 +3204,14 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BiddingStrategyId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint AdxEnabled">This is disabled for AdX.</span>
 	//
-	BiddingStrategyId int64 `xml:"biddingStrategyId,omitempty"`
+	BiddingStrategyId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyId,omitempty" json:"biddingStrategyId,omitempty"`
 
 	//
 	// Name of the bidding strategy. This is applicable only for flexible bidding strategies.
 	// <span class="constraint Selectable">This field can be selected using the value "BiddingStrategyName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BiddingStrategyName string `xml:"biddingStrategyName,omitempty"`
+	BiddingStrategyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyName,omitempty" json:"biddingStrategyName,omitempty"`
 
 	//
 	// The type of the bidding strategy to be attached.
@@ -3251,14 +3231,14 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint CampaignType">This field may only be set to the values: TARGET_CPA, TARGET_ROAS for campaign channel type MULTI_CHANNEL.</span>
 	// <span class="constraint CampaignType">This field may only be set to the values: MANUAL_CPC, ENHANCED_CPC, TARGET_CPA, TARGET_ROAS, NONE for campaign channel type DISPLAY with campaign channel subtype DISPLAY_GMAIL_AD.</span>
 	//
-	BiddingStrategyType *BiddingStrategyType `xml:"biddingStrategyType,omitempty"`
+	BiddingStrategyType *BiddingStrategyType `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyType,omitempty" json:"biddingStrategyType,omitempty"`
 
 	//
 	// Indicates where the bidding strategy is associated i.e. campaign, ad group or
 	// ad group criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BiddingStrategySource *BiddingStrategySource `xml:"biddingStrategySource,omitempty"`
+	BiddingStrategySource *BiddingStrategySource `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategySource,omitempty" json:"biddingStrategySource,omitempty"`
 
 	//
 	// The bidding strategy metadata. Bidding strategy can be associated using the {@linkplain
@@ -3269,7 +3249,7 @@ type BiddingStrategyConfiguration struct {
 	//
 	// <p>Starting with v201705, this field cannot be set at the ad group or ad group criterion level.
 	//
-	BiddingScheme *BiddingScheme `xml:"biddingScheme,omitempty"`
+	BiddingScheme *BiddingScheme `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingScheme,omitempty" json:"biddingScheme,omitempty"`
 
 	//
 	// Specifies the bids. Bids can be set only on ad groups and ad group criteria.
@@ -3279,7 +3259,7 @@ type BiddingStrategyConfiguration struct {
 	// creation. Default CPC and CPM values are minimal billable amounts in local currencies.
 	// For example, for US Dollars CPC and CPM default values are $0.01 and $0.01, respectively.
 	//
-	Bids []*Bids `xml:"bids,omitempty"`
+	Bids []*Bids `xml:"https://adwords.google.com/api/adwords/cm/v201802 bids,omitempty" json:"bids,omitempty"`
 
 	//
 	// The target return on average spend (ROAS). This target can only be set on ad groups. If this
@@ -3289,23 +3269,19 @@ type BiddingStrategyConfiguration struct {
 	// <span class="constraint CampaignType">This field may not be set.</span>
 	// <span class="constraint InRange">This field must be between 0.01 and 1000.0, inclusive.</span>
 	//
-	TargetRoasOverride float64 `xml:"targetRoasOverride,omitempty"`
+	TargetRoasOverride *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoasOverride,omitempty" json:"targetRoasOverride,omitempty"`
 }
 
 type Bids struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Bids"`
-
 	//
 	// Indicates that this instance is a subtype of Bids.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	BidsType string `xml:"Bids.Type,omitempty"`
+	BidsType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Bids.Type,omitempty" json:"bidsType,omitempty"`
 }
 
 type Budget struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Budget"`
-
 	//
 	// A Budget is created using the BudgetService ADD operation and is
 	// assigned a BudgetId. The BudgetId is used when modifying the
@@ -3315,7 +3291,7 @@ type Budget struct {
 	// among the Campaigns to get the optimum result.
 	// <span class="constraint Selectable">This field can be selected using the value "BudgetId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	BudgetId int64 `xml:"budgetId,omitempty"`
+	BudgetId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 budgetId,omitempty" json:"budgetId,omitempty"`
 
 	//
 	// Name of the Budget. When creating a Budget through BudgetService, every explicitly shared
@@ -3326,7 +3302,7 @@ type Budget struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 255, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Amount of budget in the local currency for the account.
@@ -3338,7 +3314,7 @@ type Budget struct {
 	// <span class="constraint InRange">This field must be greater than or equal to 1.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	Amount *Money `xml:"amount,omitempty"`
+	Amount *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 amount,omitempty" json:"amount,omitempty"`
 
 	//
 	// Delivery method for the Budget which determines the rate at which the
@@ -3347,7 +3323,7 @@ type Budget struct {
 	// <span class="constraint Selectable">This field can be selected using the value "DeliveryMethod".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	DeliveryMethod *BudgetBudgetDeliveryMethod `xml:"deliveryMethod,omitempty"`
+	DeliveryMethod *BudgetBudgetDeliveryMethod `xml:"https://adwords.google.com/api/adwords/cm/v201802 deliveryMethod,omitempty" json:"deliveryMethod,omitempty"`
 
 	//
 	// Number of campaigns actively using this budget. This field is only
@@ -3355,7 +3331,7 @@ type Budget struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BudgetReferenceCount".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ReferenceCount int32 `xml:"referenceCount,omitempty"`
+	ReferenceCount *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 referenceCount,omitempty" json:"referenceCount,omitempty"`
 
 	//
 	// If true, this budget was created with the purpose of sharing
@@ -3368,41 +3344,37 @@ type Budget struct {
 	// <span class="constraint Selectable">This field can be selected using the value "IsBudgetExplicitlyShared".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	IsExplicitlyShared bool `xml:"isExplicitlyShared,omitempty"`
+	IsExplicitlyShared *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isExplicitlyShared,omitempty" json:"isExplicitlyShared,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "BudgetStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Status *BudgetBudgetStatus `xml:"status,omitempty"`
+	Status *BudgetBudgetStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 }
 
 type BudgetError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BudgetError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *BudgetErrorReason `xml:"reason,omitempty"`
+	Reason *BudgetErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Campaign struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Campaign"`
-
 	//
 	// ID of this campaign.
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Id of the campaign group this campaign belongs to.
 	// <span class="constraint Selectable">This field can be selected using the value "CampaignGroupId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CampaignGroupId int64 `xml:"campaignGroupId,omitempty"`
+	CampaignGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignGroupId,omitempty" json:"campaignGroupId,omitempty"`
 
 	//
 	// Name of this campaign.
@@ -3416,27 +3388,27 @@ type Campaign struct {
 	// <span class="constraint MatchesRegex">Campaign names must not contain any null (code point 0x0), NL line feed (code point 0xA) or carriage return (code point 0xD) characters. This is checked by the regular expression '[^\x00\x0A\x0D]*'.</span>
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Status of this campaign. On add, defaults to {@code ENABLED}.
 	// <span class="constraint Selectable">This field can be selected using the value "Status".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Status *CampaignStatus `xml:"status,omitempty"`
+	Status *CampaignStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// Serving status.
 	// <span class="constraint Selectable">This field can be selected using the value "ServingStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ServingStatus *ServingStatus `xml:"servingStatus,omitempty"`
+	ServingStatus *ServingStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 servingStatus,omitempty" json:"servingStatus,omitempty"`
 
 	//
 	// Date the campaign begins. On add, defaults to the current day
 	// in the parent account's local timezone. The date's format should be YYYYMMDD.
 	// <span class="constraint Selectable">This field can be selected using the value "StartDate".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	StartDate string `xml:"startDate,omitempty"`
+	StartDate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 startDate,omitempty" json:"startDate,omitempty"`
 
 	//
 	// Date the campaign ends. On add, defaults to <code>20371230</code>, which means the
@@ -3444,37 +3416,37 @@ type Campaign struct {
 	// field to <code>203712<b>30</b></code>. The date's format should be YYYYMMDD.
 	// <span class="constraint Selectable">This field can be selected using the value "EndDate".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	EndDate string `xml:"endDate,omitempty"`
+	EndDate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 endDate,omitempty" json:"endDate,omitempty"`
 
 	//
 	// Current base budget of campaign; default if no custom budgets are enabled.
 	// This field is readonly for draft campaigns and will be ignored when sent to the API.
 	//
-	Budget *Budget `xml:"budget,omitempty"`
+	Budget *Budget `xml:"https://adwords.google.com/api/adwords/cm/v201802 budget,omitempty" json:"budget,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ConversionOptimizerEligibility *ConversionOptimizerEligibility `xml:"conversionOptimizerEligibility,omitempty"`
+	ConversionOptimizerEligibility *ConversionOptimizerEligibility `xml:"https://adwords.google.com/api/adwords/cm/v201802 conversionOptimizerEligibility,omitempty" json:"conversionOptimizerEligibility,omitempty"`
 
 	//
 	// Ad serving optimization status.
 	// <span class="constraint Selectable">This field can be selected using the value "AdServingOptimizationStatus".</span>
 	// <span class="constraint CampaignType">This field may only be set to CONVERSION_OPTIMIZE for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	//
-	AdServingOptimizationStatus *AdServingOptimizationStatus `xml:"adServingOptimizationStatus,omitempty"`
+	AdServingOptimizationStatus *AdServingOptimizationStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 adServingOptimizationStatus,omitempty" json:"adServingOptimizationStatus,omitempty"`
 
 	//
 	// Frequency cap for this campaign.
 	// <span class="constraint CampaignType">This field may not be set for campaign channel subtypes: UNIVERSAL_APP_CAMPAIGN, SEARCH_MOBILE_APP.</span>
 	//
-	FrequencyCap *FrequencyCap `xml:"frequencyCap,omitempty"`
+	FrequencyCap *FrequencyCap `xml:"https://adwords.google.com/api/adwords/cm/v201802 frequencyCap,omitempty" json:"frequencyCap,omitempty"`
 
 	//
 	// List of settings for the campaign.
 	// <span class="constraint Selectable">This field can be selected using the value "Settings".</span>
 	//
-	Settings []*Setting `xml:"settings,omitempty"`
+	Settings []*Setting `xml:"https://adwords.google.com/api/adwords/cm/v201802 settings,omitempty" json:"settings,omitempty"`
 
 	//
 	// The primary serving target for ads within this campaign. The targeting options can be refined
@@ -3484,7 +3456,7 @@ type Campaign struct {
 	// <span class="constraint Selectable">This field can be selected using the value "AdvertisingChannelType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: SET.</span>
 	//
-	AdvertisingChannelType *AdvertisingChannelType `xml:"advertisingChannelType,omitempty"`
+	AdvertisingChannelType *AdvertisingChannelType `xml:"https://adwords.google.com/api/adwords/cm/v201802 advertisingChannelType,omitempty" json:"advertisingChannelType,omitempty"`
 
 	//
 	// Optional refinement of advertisingChannelType. Must be a valid sub-type of the parent channel
@@ -3492,12 +3464,12 @@ type Campaign struct {
 	// <span class="constraint Selectable">This field can be selected using the value "AdvertisingChannelSubType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: SET.</span>
 	//
-	AdvertisingChannelSubType *AdvertisingChannelSubType `xml:"advertisingChannelSubType,omitempty"`
+	AdvertisingChannelSubType *AdvertisingChannelSubType `xml:"https://adwords.google.com/api/adwords/cm/v201802 advertisingChannelSubType,omitempty" json:"advertisingChannelSubType,omitempty"`
 
 	//
 	// Network settings for the campaign indicating where the campaign will serve.
 	//
-	NetworkSetting *NetworkSetting `xml:"networkSetting,omitempty"`
+	NetworkSetting *NetworkSetting `xml:"https://adwords.google.com/api/adwords/cm/v201802 networkSetting,omitempty" json:"networkSetting,omitempty"`
 
 	//
 	// Labels that are attached to the campaign. To associate an existing {@link Label} to an
@@ -3509,7 +3481,7 @@ type Campaign struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Labels".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	Labels []*Label `xml:"labels,omitempty"`
+	Labels []*Label `xml:"https://adwords.google.com/api/adwords/cm/v201802 labels,omitempty" json:"labels,omitempty"`
 
 	//
 	// Bidding configuration for this campaign. To change an existing campaign's
@@ -3518,7 +3490,7 @@ type Campaign struct {
 	// This field is required and should not be {@code null} when it is contained within
 	// {@link Operator}s : ADD
 	//
-	BiddingStrategyConfiguration *BiddingStrategyConfiguration `xml:"biddingStrategyConfiguration,omitempty"`
+	BiddingStrategyConfiguration *BiddingStrategyConfiguration `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddingStrategyConfiguration,omitempty" json:"biddingStrategyConfiguration,omitempty"`
 
 	//
 	// Indicates if this campaign is a normal campaign, a draft campaign,
@@ -3526,7 +3498,7 @@ type Campaign struct {
 	// <span class="constraint Selectable">This field can be selected using the value "CampaignTrialType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CampaignTrialType *CampaignTrialType `xml:"campaignTrialType,omitempty"`
+	CampaignTrialType *CampaignTrialType `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignTrialType,omitempty" json:"campaignTrialType,omitempty"`
 
 	//
 	// ID of the base campaign of the draft or trial campaign. For base campaigns, this is equal to
@@ -3534,7 +3506,7 @@ type Campaign struct {
 	// <span class="constraint Selectable">This field can be selected using the value "BaseCampaignId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BaseCampaignId int64 `xml:"baseCampaignId,omitempty"`
+	BaseCampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 baseCampaignId,omitempty" json:"baseCampaignId,omitempty"`
 
 	//
 	// This Map provides a place to put new features and settings in older versions
@@ -3543,7 +3515,7 @@ type Campaign struct {
 	//
 	// It is presently unused.  Do not set a value.
 	//
-	ForwardCompatibilityMap []*String_StringMapEntry `xml:"forwardCompatibilityMap,omitempty"`
+	ForwardCompatibilityMap []*String_StringMapEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 forwardCompatibilityMap,omitempty" json:"forwardCompatibilityMap,omitempty"`
 
 	//
 	// URL template for constructing a tracking URL.
@@ -3552,7 +3524,7 @@ type Campaign struct {
 	// <span class="constraint Selectable">This field can be selected using the value "TrackingUrlTemplate".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint CampaignType">This field may not be set for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	//
-	TrackingUrlTemplate string `xml:"trackingUrlTemplate,omitempty"`
+	TrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
 
 	//
 	// URL template for appending params to Final URL.
@@ -3561,7 +3533,7 @@ type Campaign struct {
 	// <p>This field is supported only in test accounts.
 	// <span class="constraint Selectable">This field can be selected using the value "FinalUrlSuffix".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	FinalUrlSuffix string `xml:"finalUrlSuffix,omitempty"`
+	FinalUrlSuffix *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 finalUrlSuffix,omitempty" json:"finalUrlSuffix,omitempty"`
 
 	//
 	// A list of mappings to be used for substituting URL custom parameter tags in the
@@ -3569,204 +3541,170 @@ type Campaign struct {
 	// <span class="constraint Selectable">This field can be selected using the value "UrlCustomParameters".</span>
 	// <span class="constraint CampaignType">This field may not be set for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	//
-	UrlCustomParameters *CustomParameters `xml:"urlCustomParameters,omitempty"`
+	UrlCustomParameters *CustomParameters `xml:"https://adwords.google.com/api/adwords/cm/v201802 urlCustomParameters,omitempty" json:"urlCustomParameters,omitempty"`
 
 	//
 	// Describes how unbranded pharma ads will be displayed.
 	// <span class="constraint CampaignType">This field may not be set for campaign channel subtype UNIVERSAL_APP_CAMPAIGN.</span>
 	//
-	VanityPharma *VanityPharma `xml:"vanityPharma,omitempty"`
+	VanityPharma *VanityPharma `xml:"https://adwords.google.com/api/adwords/cm/v201802 vanityPharma,omitempty" json:"vanityPharma,omitempty"`
 
 	//
 	// Selective optimization setting for this campaign, which includes a set of conversion
 	// types to optimize this campaign towards.
 	// <span class="constraint Selectable">This field can be selected using the value "SelectiveOptimization".</span>
 	//
-	SelectiveOptimization *SelectiveOptimization `xml:"selectiveOptimization,omitempty"`
+	SelectiveOptimization *SelectiveOptimization `xml:"https://adwords.google.com/api/adwords/cm/v201802 selectiveOptimization,omitempty" json:"selectiveOptimization,omitempty"`
 }
 
 type CampaignError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CampaignErrorReason `xml:"reason,omitempty"`
+	Reason *CampaignErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CampaignLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignLabel"`
-
 	//
 	// The id of the campaign that the label is applied to.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 
 	//
 	// The id of an existing label to be applied to the campaign.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD, REMOVE.</span>
 	//
-	LabelId int64 `xml:"labelId,omitempty"`
+	LabelId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 labelId,omitempty" json:"labelId,omitempty"`
 }
 
 type CampaignLabelOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignLabelOperation"`
-
 	*Operation
 
 	//
 	// CampaignLabel to operate on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *CampaignLabel `xml:"operand,omitempty"`
+	Operand *CampaignLabel `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type CampaignLabelReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignLabelReturnValue"`
-
 	*ListReturnValue
 
-	Value []*CampaignLabel `xml:"value,omitempty"`
+	Value []*CampaignLabel `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type TextLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TextLabel"`
-
 	*Label
 }
 
 type DisplayAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DisplayAttribute"`
-
 	*LabelAttribute
 
 	//
 	// Background color of the label in RGB format.
 	// <span class="constraint MatchesRegex">A background color string must begin with a '#' character followed by either 6 or 3 hexadecimal characters (24 vs. 12 bits). This is checked by the regular expression '^\#([a-fA-F0-9]{6}|[a-fA-F0-9]{3})$'.</span>
 	//
-	BackgroundColor string `xml:"backgroundColor,omitempty"`
+	BackgroundColor *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 backgroundColor,omitempty" json:"backgroundColor,omitempty"`
 
 	//
 	// A short description of the label.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive.</span>
 	//
-	Description string `xml:"description,omitempty"`
+	Description *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description,omitempty" json:"description,omitempty"`
 }
 
 type CampaignOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *Campaign `xml:"operand,omitempty"`
+	Operand *Campaign `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type CampaignPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*Campaign `xml:"entries,omitempty"`
+	Entries []*Campaign `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type CampaignReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CampaignReturnValue"`
-
 	*ListReturnValue
 
-	Value []*Campaign `xml:"value,omitempty"`
+	Value []*Campaign `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// List of partial failure errors.
 	//
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type CertificateDomainMismatchInCountryConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CertificateDomainMismatchInCountryConstraint"`
-
 	*CountryConstraint
 }
 
 type CertificateMissingConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CertificateMissingConstraint"`
-
 	*PolicyTopicConstraint
 }
 
 type CertificateMissingInCountryConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CertificateMissingInCountryConstraint"`
-
 	*CountryConstraint
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type ConversionOptimizerEligibility struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConversionOptimizerEligibility"`
-
 	//
 	// If the campaign is eligible to enter conversion optimizer.
 	// <span class="constraint Selectable">This field can be selected using the value "Eligible".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Eligible bool `xml:"eligible,omitempty"`
+	Eligible *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 eligible,omitempty" json:"eligible,omitempty"`
 
 	//
 	// Reason why a campaign would be rejected for conversion optimizer.
 	// <span class="constraint Selectable">This field can be selected using the value "RejectionReasons".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	RejectionReasons []*ConversionOptimizerEligibilityRejectionReason `xml:"rejectionReasons,omitempty"`
+	RejectionReasons []*ConversionOptimizerEligibilityRejectionReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 rejectionReasons,omitempty" json:"rejectionReasons,omitempty"`
 }
 
 type CountryConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CountryConstraint"`
-
 	*PolicyTopicConstraint
 
 	//
 	// The set of targeted country criterion IDs to which a policy topic entry applies.
 	//
-	ConstrainedCountries []int64 `xml:"constrainedCountries,omitempty"`
+	ConstrainedCountries []int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 constrainedCountries,omitempty" json:"constrainedCountries,omitempty"`
 
 	//
 	// The total number of targeted countries.
 	//
-	TotalTargetedCountries int32 `xml:"totalTargetedCountries,omitempty"`
+	TotalTargetedCountries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalTargetedCountries,omitempty" json:"totalTargetedCountries,omitempty"`
 }
 
 type CpaBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpaBid"`
-
 	*Bids
 
 	//
@@ -3777,19 +3715,17 @@ type CpaBid struct {
 	// set the {@linkplain TargetCpaBiddingScheme#targetCpa} on the strategy's
 	// {@linkplain BiddingStrategyConfiguration#biddingScheme}.
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group, ad group strategy, or campaign strategy) at which the bid was set.
 	// This is applicable only at the ad group level.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	BidSource *BidSource `xml:"bidSource,omitempty"`
+	BidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidSource,omitempty" json:"bidSource,omitempty"`
 }
 
 type CpcBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpcBid"`
-
 	*Bids
 
 	//
@@ -3799,285 +3735,249 @@ type CpcBid struct {
 	// <li>keywords & placements for content targeting.</li></ul>
 	// At the ad group criteria level, this is the max cpc bid.
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group or criterion) at which the bid was set. This is applicable
 	// only at the criteria level.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CpcBidSource *BidSource `xml:"cpcBidSource,omitempty"`
+	CpcBidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 cpcBidSource,omitempty" json:"cpcBidSource,omitempty"`
 }
 
 type CpmBid struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CpmBid"`
-
 	*Bids
 
 	//
 	// Max CPM (cost per thousand impressions) bid.
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// The level (ad group or criterion) at which the bid was set. This is applicable
 	// only at the criteria level.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CpmBidSource *BidSource `xml:"cpmBidSource,omitempty"`
+	CpmBidSource *BidSource `xml:"https://adwords.google.com/api/adwords/cm/v201802 cpmBidSource,omitempty" json:"cpmBidSource,omitempty"`
 }
 
 type CustomParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameter"`
-
 	//
 	// The parameter key to be mapped.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 16, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// The value this parameter should be mapped to. It should be null if isRemove is true.
 	// <span class="constraint StringLength">The length of this string should be between 0 and 200, inclusive, in UTF-8 bytes, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// On SET operation, indicates that the parameter should be removed from the existing parameters.
 	// If set to true, the value field must be null.
 	//
-	IsRemove bool `xml:"isRemove,omitempty"`
+	IsRemove *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isRemove,omitempty" json:"isRemove,omitempty"`
 }
 
 type CustomParameters struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CustomParameters"`
-
 	//
 	// The list of custom parameters.
 	//
 	// <p>On update, all parameters can be cleared by providing an empty or null list and setting
 	// doReplace to true.
 	//
-	Parameters []*CustomParameter `xml:"parameters,omitempty"`
+	Parameters []*CustomParameter `xml:"https://adwords.google.com/api/adwords/cm/v201802 parameters,omitempty" json:"parameters,omitempty"`
 
 	//
 	// On SET operation, indicates that the current parameters should be cleared and replaced
 	// with these parameters.
 	//
-	DoReplace bool `xml:"doReplace,omitempty"`
+	DoReplace *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 doReplace,omitempty" json:"doReplace,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DateRangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateRangeErrorReason `xml:"reason,omitempty"`
+	Reason *DateRangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type DynamicSearchAdsSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DynamicSearchAdsSetting"`
-
 	*Setting
 
 	//
 	// The Internet domain name that this setting represents. E.g. "google.com" or "www.google.com".
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	DomainName string `xml:"domainName,omitempty"`
+	DomainName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 domainName,omitempty" json:"domainName,omitempty"`
 
 	//
 	// A language code that indicates what language the contents of the domain is in. E.g. "en"
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	LanguageCode string `xml:"languageCode,omitempty"`
+	LanguageCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 languageCode,omitempty" json:"languageCode,omitempty"`
 
 	//
 	// A toggle for the advertiser to decide if they want this campaign to use the advertiser supplied
 	// URLs only.
 	//
-	UseSuppliedUrlsOnly bool `xml:"useSuppliedUrlsOnly,omitempty"`
+	UseSuppliedUrlsOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 useSuppliedUrlsOnly,omitempty" json:"useSuppliedUrlsOnly,omitempty"`
 
 	//
 	// Page feeds associated with this campaign.
 	//
-	PageFeed *PageFeed `xml:"pageFeed,omitempty"`
+	PageFeed *PageFeed `xml:"https://adwords.google.com/api/adwords/cm/v201802 pageFeed,omitempty" json:"pageFeed,omitempty"`
 }
 
 type EnhancedCpcBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EnhancedCpcBiddingScheme"`
-
 	*BiddingScheme
 }
 
 type EntityAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityAccessDenied"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *EntityAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type EntityCountLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityCountLimitExceeded"`
-
 	*ApiError
 
 	//
 	// Specifies which level's limit was exceeded.
 	//
-	Reason *EntityCountLimitExceededReason `xml:"reason,omitempty"`
+	Reason *EntityCountLimitExceededReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Id of the entity whose limit was exceeded.
 	//
-	EnclosingId string `xml:"enclosingId,omitempty"`
+	EnclosingId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 enclosingId,omitempty" json:"enclosingId,omitempty"`
 
 	//
 	// The limit which was exceeded.
 	//
-	Limit int32 `xml:"limit,omitempty"`
+	Limit *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 limit,omitempty" json:"limit,omitempty"`
 
 	//
 	// The account limit type which was exceeded.
 	//
-	AccountLimitType string `xml:"accountLimitType,omitempty"`
+	AccountLimitType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 accountLimitType,omitempty" json:"accountLimitType,omitempty"`
 
 	//
 	// The count of existing entities.
 	//
-	ExistingCount int32 `xml:"existingCount,omitempty"`
+	ExistingCount *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 existingCount,omitempty" json:"existingCount,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type ForwardCompatibilityError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ForwardCompatibilityError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ForwardCompatibilityErrorReason `xml:"reason,omitempty"`
+	Reason *ForwardCompatibilityErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FrequencyCap struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FrequencyCap"`
-
 	//
 	// Maximum number of impressions allowed during the time range by this cap.
 	// To remove the frequency cap on a campaign, set this field to {@code 0}.
 	// <span class="constraint Selectable">This field can be selected using the value "FrequencyCapMaxImpressions".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Impressions int64 `xml:"impressions,omitempty"`
+	Impressions *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 impressions,omitempty" json:"impressions,omitempty"`
 
 	//
 	// Unit of time the cap is defined at.
 	// Only the Day, Week and Month time units are supported.
 	// <span class="constraint Selectable">This field can be selected using the value "TimeUnit".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TimeUnit *TimeUnit `xml:"timeUnit,omitempty"`
+	TimeUnit *TimeUnit `xml:"https://adwords.google.com/api/adwords/cm/v201802 timeUnit,omitempty" json:"timeUnit,omitempty"`
 
 	//
 	// The level on which the cap is to be applied (creative/adgroup).
 	// Cap is applied to all the entities of this level in the campaign.
 	// <span class="constraint Selectable">This field can be selected using the value "Level".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Level *Level `xml:"level,omitempty"`
+	Level *Level `xml:"https://adwords.google.com/api/adwords/cm/v201802 level,omitempty" json:"level,omitempty"`
 }
 
 type GeoTargetTypeSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 GeoTargetTypeSetting"`
-
 	*Setting
 
 	//
@@ -4089,7 +3989,7 @@ type GeoTargetTypeSetting struct {
 	//
 	// <p>The default value is DONT_CARE.
 	//
-	PositiveGeoTargetType *GeoTargetTypeSettingPositiveGeoTargetType `xml:"positiveGeoTargetType,omitempty"`
+	PositiveGeoTargetType *GeoTargetTypeSettingPositiveGeoTargetType `xml:"https://adwords.google.com/api/adwords/cm/v201802 positiveGeoTargetType,omitempty" json:"positiveGeoTargetType,omitempty"`
 
 	//
 	// The setting used for negative geotargeting in this particular campaign.
@@ -4100,118 +4000,102 @@ type GeoTargetTypeSetting struct {
 	//
 	// <p>The default value is DONT_CARE.
 	//
-	NegativeGeoTargetType *GeoTargetTypeSettingNegativeGeoTargetType `xml:"negativeGeoTargetType,omitempty"`
+	NegativeGeoTargetType *GeoTargetTypeSettingNegativeGeoTargetType `xml:"https://adwords.google.com/api/adwords/cm/v201802 negativeGeoTargetType,omitempty" json:"negativeGeoTargetType,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Label struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Label"`
-
 	//
 	// Id of label.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Name of label.
 	// <span class="constraint StringLength">The length of this string should be between 1 and 80, inclusive.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Status of the label.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Status *LabelStatus `xml:"status,omitempty"`
+	Status *LabelStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// Attributes of the label.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	Attribute *LabelAttribute `xml:"attribute,omitempty"`
+	Attribute *LabelAttribute `xml:"https://adwords.google.com/api/adwords/cm/v201802 attribute,omitempty" json:"attribute,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Label.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	LabelType string `xml:"Label.Type,omitempty"`
+	LabelType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Label.Type,omitempty" json:"labelType,omitempty"`
 }
 
 type ListError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ListErrorReason `xml:"reason,omitempty"`
+	Reason *ListErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ListOperations struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListOperations"`
-
 	//
 	// Indicates that all contents of the list should be deleted. If this is true, the list will be
 	// cleared first, then proceed to the operators.
 	//
-	Clear bool `xml:"clear,omitempty"`
+	Clear *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 clear,omitempty" json:"clear,omitempty"`
 
 	//
 	// The desired behavior of each element in the POJO list that this ListOperation corresponds to.
 	// This will contain the same number of elements as the corresponding List<>.
 	//
-	Operators []*ListOperationsListOperator `xml:"operators,omitempty"`
+	Operators []*ListOperationsListOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operators,omitempty" json:"operators,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type ManualCpcBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ManualCpcBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -4222,12 +4106,10 @@ type ManualCpcBiddingScheme struct {
 	// >AdWords Help Center</a>.
 	// <span class="constraint Selectable">This field can be selected using the value "EnhancedCpcEnabled".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	EnhancedCpcEnabled bool `xml:"enhancedCpcEnabled,omitempty"`
+	EnhancedCpcEnabled *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 enhancedCpcEnabled,omitempty" json:"enhancedCpcEnabled,omitempty"`
 }
 
 type ManualCpmBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ManualCpmBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -4237,12 +4119,10 @@ type ManualCpmBiddingScheme struct {
 	// <span class="constraint Selectable">This field can be selected using the value "ViewableCpmEnabled".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ViewableCpmEnabled bool `xml:"viewableCpmEnabled,omitempty"`
+	ViewableCpmEnabled *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 viewableCpmEnabled,omitempty" json:"viewableCpmEnabled,omitempty"`
 }
 
 type MaximizeConversionValueBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MaximizeConversionValueBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -4253,29 +4133,23 @@ type MaximizeConversionValueBiddingScheme struct {
 	// <span class="constraint Selectable">This field can be selected using the value "MaximizeConversionValueTargetRoas".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint InRange">This field must be between 0.0 and 1.7976931348623157E308, inclusive.</span>
 	//
-	TargetRoas float64 `xml:"targetRoas,omitempty"`
+	TargetRoas *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoas,omitempty" json:"targetRoas,omitempty"`
 }
 
 type MaximizeConversionsBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MaximizeConversionsBiddingScheme"`
-
 	*BiddingScheme
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type UniversalAppCampaignSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UniversalAppCampaignSetting"`
-
 	*Setting
 
 	//
@@ -4287,7 +4161,7 @@ type UniversalAppCampaignSetting struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// The vendor, i.e. application store that distributes this specific app, as identified by the
@@ -4295,7 +4169,7 @@ type UniversalAppCampaignSetting struct {
 	// <p>This field is required and should not be null when it is contained within Operators : ADD.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE.</span>
 	//
-	AppVendor *MobileApplicationVendor `xml:"appVendor,omitempty"`
+	AppVendor *MobileApplicationVendor `xml:"https://adwords.google.com/api/adwords/cm/v201802 appVendor,omitempty" json:"appVendor,omitempty"`
 
 	//
 	// A description line of your mobile application promotion ad(s).
@@ -4303,7 +4177,7 @@ type UniversalAppCampaignSetting struct {
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	Description1 string `xml:"description1,omitempty"`
+	Description1 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description1,omitempty" json:"description1,omitempty"`
 
 	//
 	// A description line of your mobile application promotion ad(s).
@@ -4311,7 +4185,7 @@ type UniversalAppCampaignSetting struct {
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	Description2 string `xml:"description2,omitempty"`
+	Description2 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description2,omitempty" json:"description2,omitempty"`
 
 	//
 	// A description line of your mobile application promotion ad(s).
@@ -4319,7 +4193,7 @@ type UniversalAppCampaignSetting struct {
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	Description3 string `xml:"description3,omitempty"`
+	Description3 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description3,omitempty" json:"description3,omitempty"`
 
 	//
 	// A description line of your mobile application promotion ad(s).
@@ -4327,44 +4201,42 @@ type UniversalAppCampaignSetting struct {
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	// <span class="constraint StringLength">The length of this string should be between 1 and 25, inclusive, (trimmed).</span>
 	//
-	Description4 string `xml:"description4,omitempty"`
+	Description4 *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 description4,omitempty" json:"description4,omitempty"`
 
 	//
 	// MediaIds for YouTube videos to be shown to users when advertising on video networks.
 	//
-	YoutubeVideoMediaIds []int64 `xml:"youtubeVideoMediaIds,omitempty"`
+	YoutubeVideoMediaIds []int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 youtubeVideoMediaIds,omitempty" json:"youtubeVideoMediaIds,omitempty"`
 
 	//
 	// MediaIds for landscape images to be used in creatives to be shown to users when advertising on
 	// display networks.
 	//
-	ImageMediaIds []int64 `xml:"imageMediaIds,omitempty"`
+	ImageMediaIds []int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 imageMediaIds,omitempty" json:"imageMediaIds,omitempty"`
 
 	//
 	// Represents the goal towards which the bidding strategy, of this universal app campaign, should
 	// optimize for.
 	//
-	UniversalAppBiddingStrategyGoalType *UniversalAppBiddingStrategyGoalType `xml:"universalAppBiddingStrategyGoalType,omitempty"`
+	UniversalAppBiddingStrategyGoalType *UniversalAppBiddingStrategyGoalType `xml:"https://adwords.google.com/api/adwords/cm/v201802 universalAppBiddingStrategyGoalType,omitempty" json:"universalAppBiddingStrategyGoalType,omitempty"`
 
 	//
 	// Operations for YouTube Video MediaIds.
 	//
-	YoutubeVideoMediaIdsOps *ListOperations `xml:"youtubeVideoMediaIdsOps,omitempty"`
+	YoutubeVideoMediaIdsOps *ListOperations `xml:"https://adwords.google.com/api/adwords/cm/v201802 youtubeVideoMediaIdsOps,omitempty" json:"youtubeVideoMediaIdsOps,omitempty"`
 
 	//
 	// Operations for Image MediaIds.
 	//
-	ImageMediaIdsOps *ListOperations `xml:"imageMediaIdsOps,omitempty"`
+	ImageMediaIdsOps *ListOperations `xml:"https://adwords.google.com/api/adwords/cm/v201802 imageMediaIdsOps,omitempty" json:"imageMediaIdsOps,omitempty"`
 
 	//
 	// Ads policy decisions associated with asset(s).
 	//
-	AdsPolicyDecisions []*UniversalAppCampaignAdsPolicyDecisions `xml:"adsPolicyDecisions,omitempty"`
+	AdsPolicyDecisions []*UniversalAppCampaignAdsPolicyDecisions `xml:"https://adwords.google.com/api/adwords/cm/v201802 adsPolicyDecisions,omitempty" json:"adsPolicyDecisions,omitempty"`
 }
 
 type NetworkSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NetworkSetting"`
-
 	//
 	// Ads will be served with Google.com search results.
 	// <span class="constraint Selectable">This field can be selected using the value "TargetGoogleSearch".</span><span class="constraint Filterable">This field can be filtered on.</span>
@@ -4374,7 +4246,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel type DISPLAY.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetGoogleSearch bool `xml:"targetGoogleSearch,omitempty"`
+	TargetGoogleSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetGoogleSearch,omitempty" json:"targetGoogleSearch,omitempty"`
 
 	//
 	// Ads will be served on partner sites in the Google Search Network
@@ -4384,7 +4256,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel type MULTI_CHANNEL.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetSearchNetwork bool `xml:"targetSearchNetwork,omitempty"`
+	TargetSearchNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetSearchNetwork,omitempty" json:"targetSearchNetwork,omitempty"`
 
 	//
 	// Ads will be served on specified placements in the Google Display Network.
@@ -4394,7 +4266,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel subtype SEARCH_MOBILE_APP.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetContentNetwork bool `xml:"targetContentNetwork,omitempty"`
+	TargetContentNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetContentNetwork,omitempty" json:"targetContentNetwork,omitempty"`
 
 	//
 	// Ads will be served on the Google Partner Network. This is available to
@@ -4403,148 +4275,126 @@ type NetworkSetting struct {
 	// <span class="constraint AdxEnabled">This is disabled for AdX.</span>
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel type MULTI_CHANNEL.</span>
 	//
-	TargetPartnerSearchNetwork bool `xml:"targetPartnerSearchNetwork,omitempty"`
+	TargetPartnerSearchNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetPartnerSearchNetwork,omitempty" json:"targetPartnerSearchNetwork,omitempty"`
 }
 
 type NewEntityCreationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NewEntityCreationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NewEntityCreationErrorReason `xml:"reason,omitempty"`
+	Reason *NewEntityCreationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type PageFeed struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PageFeed"`
-
 	//
 	// A list of page feeds associated with this campaign's dynamic search ads setting.
 	//
-	FeedIds []int64 `xml:"feedIds,omitempty"`
+	FeedIds []int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 feedIds,omitempty" json:"feedIds,omitempty"`
 }
 
 type PageOnePromotedBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PageOnePromotedBiddingScheme"`
-
 	*BiddingScheme
 
 	//
 	// Specifies the strategy goal: where impressions are desired to be shown on
 	// search result pages.
 	//
-	StrategyGoal *PageOnePromotedBiddingSchemeStrategyGoal `xml:"strategyGoal,omitempty"`
+	StrategyGoal *PageOnePromotedBiddingSchemeStrategyGoal `xml:"https://adwords.google.com/api/adwords/cm/v201802 strategyGoal,omitempty" json:"strategyGoal,omitempty"`
 
 	//
 	// Strategy maximum bid limit in advertiser local currency micro units.
 	// This upper limit applies to all keywords managed by the strategy.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// Bid Multiplier to be applied to the relevant bid estimate (depending on the strategyGoal)
 	// in determining a keyword's new max cpc bid.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidModifier float64 `xml:"bidModifier,omitempty"`
+	BidModifier *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidModifier,omitempty" json:"bidModifier,omitempty"`
 
 	//
 	// Controls whether the strategy always follows bid estimate changes, or only
@@ -4552,107 +4402,97 @@ type PageOnePromotedBiddingScheme struct {
 	// If true, only updates a keyword's bid if the current bid estimate is
 	// greater than the current bid.
 	//
-	BidChangesForRaisesOnly bool `xml:"bidChangesForRaisesOnly,omitempty"`
+	BidChangesForRaisesOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidChangesForRaisesOnly,omitempty" json:"bidChangesForRaisesOnly,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids when the throttling rate
 	// of the budget it is serving out of rises above a threshold.
 	//
-	RaiseBidWhenBudgetConstrained bool `xml:"raiseBidWhenBudgetConstrained,omitempty"`
+	RaiseBidWhenBudgetConstrained *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenBudgetConstrained,omitempty" json:"raiseBidWhenBudgetConstrained,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids on keywords with lower-range
 	// quality scores.
 	//
-	RaiseBidWhenLowQualityScore bool `xml:"raiseBidWhenLowQualityScore,omitempty"`
+	RaiseBidWhenLowQualityScore *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenLowQualityScore,omitempty" json:"raiseBidWhenLowQualityScore,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type PolicyTopicConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyTopicConstraint"`
-
-	ConstraintType *PolicyTopicConstraintPolicyTopicConstraintType `xml:"constraintType,omitempty"`
+	ConstraintType *PolicyTopicConstraintPolicyTopicConstraintType `xml:"https://adwords.google.com/api/adwords/cm/v201802 constraintType,omitempty" json:"constraintType,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of PolicyTopicConstraint.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PolicyTopicConstraintType string `xml:"PolicyTopicConstraint.Type,omitempty"`
+	PolicyTopicConstraintType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyTopicConstraint.Type,omitempty" json:"policyTopicConstraintType,omitempty"`
 }
 
 type PolicyTopicEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyTopicEntry"`
-
 	//
 	// The type of the policy topic entry.
 	//
-	PolicyTopicEntryType *PolicyTopicEntryType `xml:"policyTopicEntryType,omitempty"`
+	PolicyTopicEntryType *PolicyTopicEntryType `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicEntryType,omitempty" json:"policyTopicEntryType,omitempty"`
 
 	//
 	// The policy topic evidences associated with this policy topic entry.
 	//
-	PolicyTopicEvidences []*PolicyTopicEvidence `xml:"policyTopicEvidences,omitempty"`
+	PolicyTopicEvidences []*PolicyTopicEvidence `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicEvidences,omitempty" json:"policyTopicEvidences,omitempty"`
 
 	//
 	// The targeting constraints to which this PolicyTopicEntry is related.
 	//
-	PolicyTopicConstraints []*PolicyTopicConstraint `xml:"policyTopicConstraints,omitempty"`
+	PolicyTopicConstraints []*PolicyTopicConstraint `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicConstraints,omitempty" json:"policyTopicConstraints,omitempty"`
 
 	//
 	// The policy topic id.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PolicyTopicId string `xml:"policyTopicId,omitempty"`
+	PolicyTopicId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicId,omitempty" json:"policyTopicId,omitempty"`
 
 	//
 	// The policy topic name (in English).
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PolicyTopicName string `xml:"policyTopicName,omitempty"`
+	PolicyTopicName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicName,omitempty" json:"policyTopicName,omitempty"`
 
 	//
 	// URL of the help center article describing this policy topic entry.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PolicyTopicHelpCenterUrl string `xml:"policyTopicHelpCenterUrl,omitempty"`
+	PolicyTopicHelpCenterUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicHelpCenterUrl,omitempty" json:"policyTopicHelpCenterUrl,omitempty"`
 }
 
 type PolicyTopicEvidence struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyTopicEvidence"`
-
 	//
 	// The type of evidence for the policy topic.
 	//
-	PolicyTopicEvidenceType *PolicyTopicEvidenceType `xml:"policyTopicEvidenceType,omitempty"`
+	PolicyTopicEvidenceType *PolicyTopicEvidenceType `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicEvidenceType,omitempty" json:"policyTopicEvidenceType,omitempty"`
 
 	//
 	// The actual evidence that triggered this policy topic to be reported. This field is associated
 	// with the policyTopicEvidenceType. So for example, when policyTopicEvidenceType is AD_TEXT,
 	// the evidence is the texts associated with the Ad.
 	//
-	EvidenceTextList []string `xml:"evidenceTextList,omitempty"`
+	EvidenceTextList []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 evidenceTextList,omitempty" json:"evidenceTextList,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -4660,13 +4500,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -4675,150 +4515,124 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RealTimeBiddingSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RealTimeBiddingSetting"`
-
 	*Setting
 
 	//
 	// Whether the campaign is opted in to real-time bidding.
 	//
-	OptIn bool `xml:"optIn,omitempty"`
+	OptIn *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 optIn,omitempty" json:"optIn,omitempty"`
 }
 
 type RegionCodeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RegionCodeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RegionCodeErrorReason `xml:"reason,omitempty"`
+	Reason *RegionCodeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ResellerConstraint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResellerConstraint"`
-
 	*PolicyTopicConstraint
 }
 
 type SelectiveOptimization struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectiveOptimization"`
-
 	//
 	// The selected conversion ids for selective optimization.
 	//
-	ConversionTypeIds []int64 `xml:"conversionTypeIds,omitempty"`
+	ConversionTypeIds []int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 conversionTypeIds,omitempty" json:"conversionTypeIds,omitempty"`
 
 	//
 	// The selected conversion ids ops for selective optimization.
 	//
-	ConversionTypeIdsOps *ListOperations `xml:"conversionTypeIdsOps,omitempty"`
+	ConversionTypeIdsOps *ListOperations `xml:"https://adwords.google.com/api/adwords/cm/v201802 conversionTypeIdsOps,omitempty" json:"conversionTypeIdsOps,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -4829,13 +4643,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -4844,64 +4658,56 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Setting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Setting"`
-
 	//
 	// Indicates that this instance is a subtype of Setting.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	SettingType string `xml:"Setting.Type,omitempty"`
+	SettingType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Setting.Type,omitempty" json:"settingType,omitempty"`
 }
 
 type SettingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SettingError"`
-
 	*ApiError
 
 	//
 	// The setting error reason.
 	//
-	Reason *SettingErrorReason `xml:"reason,omitempty"`
+	Reason *SettingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ShoppingSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ShoppingSetting"`
-
 	*Setting
 
 	//
 	// ID of the Merchant Center account.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MerchantId int64 `xml:"merchantId,omitempty"`
+	MerchantId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 merchantId,omitempty" json:"merchantId,omitempty"`
 
 	//
 	// Sales country of products to include in the campaign.
@@ -4909,7 +4715,7 @@ type ShoppingSetting struct {
 	// {@link AdvertisingChannelType#SHOPPING}
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	SalesCountry string `xml:"salesCountry,omitempty"`
+	SalesCountry *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 salesCountry,omitempty" json:"salesCountry,omitempty"`
 
 	//
 	// Priority of the campaign. Campaigns with numerically higher priorities take precedence over
@@ -4918,13 +4724,13 @@ type ShoppingSetting struct {
 	// <span class="constraint InRange">This field must be between 0 and 2, inclusive.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	CampaignPriority int32 `xml:"campaignPriority,omitempty"`
+	CampaignPriority *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignPriority,omitempty" json:"campaignPriority,omitempty"`
 
 	//
 	// Enable local inventory ads.
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel type SHOPPING with campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	EnableLocal bool `xml:"enableLocal,omitempty"`
+	EnableLocal *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 enableLocal,omitempty" json:"enableLocal,omitempty"`
 
 	//
 	// The platform on which a shopping product can be purchased.
@@ -4933,35 +4739,33 @@ type ShoppingSetting struct {
 	// GOOGLE or MERCHANT_AND_GOOGLE.
 	// <span class="constraint CampaignType">This field may only be set to MERCHANT for campaign channel type SHOPPING with campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	PurchasePlatform *ShoppingPurchasePlatform `xml:"purchasePlatform,omitempty"`
+	PurchasePlatform *ShoppingPurchasePlatform `xml:"https://adwords.google.com/api/adwords/cm/v201802 purchasePlatform,omitempty" json:"purchasePlatform,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -4969,12 +4773,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -4982,81 +4786,71 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StatsQueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StatsQueryError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StatsQueryErrorReason `xml:"reason,omitempty"`
+	Reason *StatsQueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type String_StringMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 String_StringMapEntry"`
-
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type TargetCpaBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetCpaBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -5065,26 +4859,24 @@ type TargetCpaBiddingScheme struct {
 	// <span class="constraint Selectable">This field can be selected using the value "TargetCpa".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	TargetCpa *Money `xml:"targetCpa,omitempty"`
+	TargetCpa *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetCpa,omitempty" json:"targetCpa,omitempty"`
 
 	//
 	// Maximum cpc bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint Selectable">This field can be selected using the value "TargetCpaMaxCpcBidCeiling".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	MaxCpcBidCeiling *Money `xml:"maxCpcBidCeiling,omitempty"`
+	MaxCpcBidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidCeiling,omitempty" json:"maxCpcBidCeiling,omitempty"`
 
 	//
 	// Minimum cpc bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint Selectable">This field can be selected using the value "TargetCpaMaxCpcBidFloor".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	MaxCpcBidFloor *Money `xml:"maxCpcBidFloor,omitempty"`
+	MaxCpcBidFloor *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidFloor,omitempty" json:"maxCpcBidFloor,omitempty"`
 }
 
 type TargetOutrankShareBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetOutrankShareBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -5094,40 +4886,38 @@ type TargetOutrankShareBiddingScheme struct {
 	// competitor does not.
 	// <span class="constraint InRange">This field must be between 1 and 1000000, inclusive.</span>
 	//
-	TargetOutrankShare int32 `xml:"targetOutrankShare,omitempty"`
+	TargetOutrankShare *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetOutrankShare,omitempty" json:"targetOutrankShare,omitempty"`
 
 	//
 	// Competitor's visible domain URL.
 	//
-	CompetitorDomain string `xml:"competitorDomain,omitempty"`
+	CompetitorDomain *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 competitorDomain,omitempty" json:"competitorDomain,omitempty"`
 
 	//
 	// Ceiling on max CPC bids.
 	//
-	MaxCpcBidCeiling *Money `xml:"maxCpcBidCeiling,omitempty"`
+	MaxCpcBidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 maxCpcBidCeiling,omitempty" json:"maxCpcBidCeiling,omitempty"`
 
 	//
 	// Controls whether the strategy always follows bid estimate changes, or only increases. If false,
 	// always sets a keyword's new bid to the estimate that will meet the target. If true, only
 	// updates a keyword's bid if the current bid estimate is greater than the current bid.
 	//
-	BidChangesForRaisesOnly bool `xml:"bidChangesForRaisesOnly,omitempty"`
+	BidChangesForRaisesOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidChangesForRaisesOnly,omitempty" json:"bidChangesForRaisesOnly,omitempty"`
 
 	//
 	// Controls whether the strategy is allowed to raise bids on keywords with lower-range quality
 	// scores.
 	//
-	RaiseBidWhenLowQualityScore bool `xml:"raiseBidWhenLowQualityScore,omitempty"`
+	RaiseBidWhenLowQualityScore *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 raiseBidWhenLowQualityScore,omitempty" json:"raiseBidWhenLowQualityScore,omitempty"`
 }
 
 type TargetingSettingDetail struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetingSettingDetail"`
-
 	//
 	// The criterion type group that these settings apply to.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	CriterionTypeGroup *CriterionTypeGroup `xml:"criterionTypeGroup,omitempty"`
+	CriterionTypeGroup *CriterionTypeGroup `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterionTypeGroup,omitempty" json:"criterionTypeGroup,omitempty"`
 
 	//
 	// If true, criteria of this type can be used to modify bidding but will not restrict targeting
@@ -5137,12 +4927,10 @@ type TargetingSettingDetail struct {
 	// The default setting for a CriterionTypeGroup is false ("Target and Bid").
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	TargetAll bool `xml:"targetAll,omitempty"`
+	TargetAll *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetAll,omitempty" json:"targetAll,omitempty"`
 }
 
 type TargetRoasBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetRoasBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -5151,26 +4939,24 @@ type TargetRoasBiddingScheme struct {
 	// <span class="constraint InRange">This field must be between 0.01 and 1000.0, inclusive.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	TargetRoas float64 `xml:"targetRoas,omitempty"`
+	TargetRoas *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetRoas,omitempty" json:"targetRoas,omitempty"`
 
 	//
 	// Maximum bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint Selectable">This field can be selected using the value "TargetRoasBidCeiling".</span>
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// Minimum bid limit that applies to all keywords managed by the strategy.
 	// <span class="constraint Selectable">This field can be selected using the value "TargetRoasBidFloor".</span>
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidFloor *Money `xml:"bidFloor,omitempty"`
+	BidFloor *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidFloor,omitempty" json:"bidFloor,omitempty"`
 }
 
 type TargetSpendBiddingScheme struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetSpendBiddingScheme"`
-
 	*BiddingScheme
 
 	//
@@ -5178,7 +4964,7 @@ type TargetSpendBiddingScheme struct {
 	// <span class="constraint Selectable">This field can be selected using the value "TargetSpendBidCeiling".</span>
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	BidCeiling *Money `xml:"bidCeiling,omitempty"`
+	BidCeiling *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidCeiling,omitempty" json:"bidCeiling,omitempty"`
 
 	//
 	// A spend target under which to maximize clicks. The TargetSpend bidder will
@@ -5187,23 +4973,19 @@ type TargetSpendBiddingScheme struct {
 	// <span class="constraint Selectable">This field can be selected using the value "TargetSpendSpendTarget".</span>
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	SpendTarget *Money `xml:"spendTarget,omitempty"`
+	SpendTarget *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 spendTarget,omitempty" json:"spendTarget,omitempty"`
 }
 
 type TargetingSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TargetingSetting"`
-
 	*Setting
 
 	//
 	// The list of per-criterion-type-group targeting settings.
 	//
-	Details []*TargetingSettingDetail `xml:"details,omitempty"`
+	Details []*TargetingSettingDetail `xml:"https://adwords.google.com/api/adwords/cm/v201802 details,omitempty" json:"details,omitempty"`
 }
 
 type TrackingSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 TrackingSetting"`
-
 	*Setting
 
 	//
@@ -5213,16 +4995,14 @@ type TrackingSetting struct {
 	// Specify "NONE" to clear existing url.
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	TrackingUrl string `xml:"trackingUrl,omitempty"`
+	TrackingUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingUrl,omitempty" json:"trackingUrl,omitempty"`
 }
 
 type UniversalAppCampaignAdsPolicyDecisions struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UniversalAppCampaignAdsPolicyDecisions"`
-
 	//
 	// Used to identify assets that are associated with the Ads Policy decisions.
 	//
-	UniversalAppCampaignAsset *UniversalAppCampaignAsset `xml:"universalAppCampaignAsset,omitempty"`
+	UniversalAppCampaignAsset *UniversalAppCampaignAsset `xml:"https://adwords.google.com/api/adwords/cm/v201802 universalAppCampaignAsset,omitempty" json:"universalAppCampaignAsset,omitempty"`
 
 	//
 	// Unique identifier, which when combined with the UniversalAppCampaignAsset, can be used to
@@ -5231,40 +5011,36 @@ type UniversalAppCampaignAdsPolicyDecisions struct {
 	// <p>For example, in the case of {@link UniversalAppCampaignAsset}.VIDEO - the id could be used
 	// to identify the individual video.
 	//
-	AssetId string `xml:"assetId,omitempty"`
+	AssetId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 assetId,omitempty" json:"assetId,omitempty"`
 
 	//
 	// List of policy decisions associated with the asset(s).
 	//
-	PolicyTopicEntries []*PolicyTopicEntry `xml:"policyTopicEntries,omitempty"`
+	PolicyTopicEntries []*PolicyTopicEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyTopicEntries,omitempty" json:"policyTopicEntries,omitempty"`
 }
 
 type UrlError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *UrlErrorReason `xml:"reason,omitempty"`
+	Reason *UrlErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type VanityPharma struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 VanityPharma"`
-
 	//
 	// The display mode for vanity pharma URLs.
 	// <span class="constraint Selectable">This field can be selected using the value "VanityPharmaDisplayUrlMode".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	VanityPharmaDisplayUrlMode *VanityPharmaDisplayUrlMode `xml:"vanityPharmaDisplayUrlMode,omitempty"`
+	VanityPharmaDisplayUrlMode *VanityPharmaDisplayUrlMode `xml:"https://adwords.google.com/api/adwords/cm/v201802 vanityPharmaDisplayUrlMode,omitempty" json:"vanityPharmaDisplayUrlMode,omitempty"`
 
 	//
 	// The text that will be displayed in display URL of the text ad when website description
 	// is the selected display mode for vanity pharma URLs.
 	// <span class="constraint Selectable">This field can be selected using the value "VanityPharmaText".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	VanityPharmaText *VanityPharmaText `xml:"vanityPharmaText,omitempty"`
+	VanityPharmaText *VanityPharmaText `xml:"https://adwords.google.com/api/adwords/cm/v201802 vanityPharmaText,omitempty" json:"vanityPharmaText,omitempty"`
 }
 
 type CampaignServiceInterface struct {
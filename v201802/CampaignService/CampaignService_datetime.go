@@ -0,0 +1,53 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignService
+
+import "github.com/godofdream/go-googleadsinofficial/datetime"
+
+// NewDateRange returns the DateRange from one date to another, both
+// inclusive.
+func NewDateRange(from, to datetime.Date) *DateRange {
+	return &DateRange{Min: String(from.String()), Max: String(to.String())}
+}
+
+// Dates returns the bounds of r. A bound that is not set is returned as the
+// zero Date.
+func (r *DateRange) Dates() (from, to datetime.Date, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDate(*r.Min); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDate(*r.Max)
+	}
+	return
+}
+
+// GetStartDate returns the StartDate of t, or the zero Date if it is not
+// set.
+func (t *Campaign) GetStartDate() (datetime.Date, error) {
+	if t.StartDate == nil {
+		return datetime.Date{}, nil
+	}
+	return datetime.ParseDate(*t.StartDate)
+}
+
+// SetStartDate sets the StartDate of t to d.
+func (t *Campaign) SetStartDate(d datetime.Date) {
+	t.StartDate = String(d.String())
+}
+
+// GetEndDate returns the EndDate of t, or the zero Date if it is not
+// set.
+func (t *Campaign) GetEndDate() (datetime.Date, error) {
+	if t.EndDate == nil {
+		return datetime.Date{}, nil
+	}
+	return datetime.ParseDate(*t.EndDate)
+}
+
+// SetEndDate sets the EndDate of t to d.
+func (t *Campaign) SetEndDate(d datetime.Date) {
+	t.EndDate = String(d.String())
+}
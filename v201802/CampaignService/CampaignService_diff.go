@@ -0,0 +1,1444 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateLabel) Copy() *MutateLabel {
+	return deep.Copy(t).(*MutateLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateLabel) Equal(o *MutateLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateLabelResponse) Copy() *MutateLabelResponse {
+	return deep.Copy(t).(*MutateLabelResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateLabelResponse) Equal(o *MutateLabelResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdxError) Copy() *AdxError {
+	return deep.Copy(t).(*AdxError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdxError) Equal(o *AdxError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LabelAttribute) Copy() *LabelAttribute {
+	return deep.Copy(t).(*LabelAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LabelAttribute) Equal(o *LabelAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingErrors) Copy() *BiddingErrors {
+	return deep.Copy(t).(*BiddingErrors)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingErrors) Equal(o *BiddingErrors) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingScheme) Copy() *BiddingScheme {
+	return deep.Copy(t).(*BiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingScheme) Equal(o *BiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BiddingStrategyConfiguration) Copy() *BiddingStrategyConfiguration {
+	return deep.Copy(t).(*BiddingStrategyConfiguration)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BiddingStrategyConfiguration) Equal(o *BiddingStrategyConfiguration) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Bids) Copy() *Bids {
+	return deep.Copy(t).(*Bids)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Bids) Equal(o *Bids) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Budget) Copy() *Budget {
+	return deep.Copy(t).(*Budget)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Budget) Equal(o *Budget) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BudgetError) Copy() *BudgetError {
+	return deep.Copy(t).(*BudgetError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BudgetError) Equal(o *BudgetError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Campaign) Copy() *Campaign {
+	return deep.Copy(t).(*Campaign)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Campaign) Equal(o *Campaign) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignError) Copy() *CampaignError {
+	return deep.Copy(t).(*CampaignError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignError) Equal(o *CampaignError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignLabel) Copy() *CampaignLabel {
+	return deep.Copy(t).(*CampaignLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignLabel) Equal(o *CampaignLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignLabelOperation) Copy() *CampaignLabelOperation {
+	return deep.Copy(t).(*CampaignLabelOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignLabelOperation) Equal(o *CampaignLabelOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignLabelReturnValue) Copy() *CampaignLabelReturnValue {
+	return deep.Copy(t).(*CampaignLabelReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignLabelReturnValue) Equal(o *CampaignLabelReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TextLabel) Copy() *TextLabel {
+	return deep.Copy(t).(*TextLabel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TextLabel) Equal(o *TextLabel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DisplayAttribute) Copy() *DisplayAttribute {
+	return deep.Copy(t).(*DisplayAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DisplayAttribute) Equal(o *DisplayAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignOperation) Copy() *CampaignOperation {
+	return deep.Copy(t).(*CampaignOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignOperation) Equal(o *CampaignOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignPage) Copy() *CampaignPage {
+	return deep.Copy(t).(*CampaignPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignPage) Equal(o *CampaignPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CampaignReturnValue) Copy() *CampaignReturnValue {
+	return deep.Copy(t).(*CampaignReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CampaignReturnValue) Equal(o *CampaignReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CertificateDomainMismatchInCountryConstraint) Copy() *CertificateDomainMismatchInCountryConstraint {
+	return deep.Copy(t).(*CertificateDomainMismatchInCountryConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CertificateDomainMismatchInCountryConstraint) Equal(o *CertificateDomainMismatchInCountryConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CertificateMissingConstraint) Copy() *CertificateMissingConstraint {
+	return deep.Copy(t).(*CertificateMissingConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CertificateMissingConstraint) Equal(o *CertificateMissingConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CertificateMissingInCountryConstraint) Copy() *CertificateMissingInCountryConstraint {
+	return deep.Copy(t).(*CertificateMissingInCountryConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CertificateMissingInCountryConstraint) Equal(o *CertificateMissingInCountryConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ComparableValue) Copy() *ComparableValue {
+	return deep.Copy(t).(*ComparableValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ComparableValue) Equal(o *ComparableValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ConversionOptimizerEligibility) Copy() *ConversionOptimizerEligibility {
+	return deep.Copy(t).(*ConversionOptimizerEligibility)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ConversionOptimizerEligibility) Equal(o *ConversionOptimizerEligibility) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CountryConstraint) Copy() *CountryConstraint {
+	return deep.Copy(t).(*CountryConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CountryConstraint) Equal(o *CountryConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpaBid) Copy() *CpaBid {
+	return deep.Copy(t).(*CpaBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpaBid) Equal(o *CpaBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpcBid) Copy() *CpcBid {
+	return deep.Copy(t).(*CpcBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpcBid) Equal(o *CpcBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CpmBid) Copy() *CpmBid {
+	return deep.Copy(t).(*CpmBid)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CpmBid) Equal(o *CpmBid) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameter) Copy() *CustomParameter {
+	return deep.Copy(t).(*CustomParameter)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameter) Equal(o *CustomParameter) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CustomParameters) Copy() *CustomParameters {
+	return deep.Copy(t).(*CustomParameters)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CustomParameters) Equal(o *CustomParameters) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRangeError) Copy() *DateRangeError {
+	return deep.Copy(t).(*DateRangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRangeError) Equal(o *DateRangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DoubleValue) Copy() *DoubleValue {
+	return deep.Copy(t).(*DoubleValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DoubleValue) Equal(o *DoubleValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DynamicSearchAdsSetting) Copy() *DynamicSearchAdsSetting {
+	return deep.Copy(t).(*DynamicSearchAdsSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DynamicSearchAdsSetting) Equal(o *DynamicSearchAdsSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EnhancedCpcBiddingScheme) Copy() *EnhancedCpcBiddingScheme {
+	return deep.Copy(t).(*EnhancedCpcBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EnhancedCpcBiddingScheme) Equal(o *EnhancedCpcBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityAccessDenied) Copy() *EntityAccessDenied {
+	return deep.Copy(t).(*EntityAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityAccessDenied) Equal(o *EntityAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityCountLimitExceeded) Copy() *EntityCountLimitExceeded {
+	return deep.Copy(t).(*EntityCountLimitExceeded)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityCountLimitExceeded) Equal(o *EntityCountLimitExceeded) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *EntityNotFound) Copy() *EntityNotFound {
+	return deep.Copy(t).(*EntityNotFound)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *EntityNotFound) Equal(o *EntityNotFound) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ForwardCompatibilityError) Copy() *ForwardCompatibilityError {
+	return deep.Copy(t).(*ForwardCompatibilityError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ForwardCompatibilityError) Equal(o *ForwardCompatibilityError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FrequencyCap) Copy() *FrequencyCap {
+	return deep.Copy(t).(*FrequencyCap)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FrequencyCap) Equal(o *FrequencyCap) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GeoTargetTypeSetting) Copy() *GeoTargetTypeSetting {
+	return deep.Copy(t).(*GeoTargetTypeSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GeoTargetTypeSetting) Equal(o *GeoTargetTypeSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Label) Copy() *Label {
+	return deep.Copy(t).(*Label)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Label) Equal(o *Label) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListError) Copy() *ListError {
+	return deep.Copy(t).(*ListError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListError) Equal(o *ListError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListOperations) Copy() *ListOperations {
+	return deep.Copy(t).(*ListOperations)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListOperations) Equal(o *ListOperations) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LongValue) Copy() *LongValue {
+	return deep.Copy(t).(*LongValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LongValue) Equal(o *LongValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ManualCpcBiddingScheme) Copy() *ManualCpcBiddingScheme {
+	return deep.Copy(t).(*ManualCpcBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ManualCpcBiddingScheme) Equal(o *ManualCpcBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ManualCpmBiddingScheme) Copy() *ManualCpmBiddingScheme {
+	return deep.Copy(t).(*ManualCpmBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ManualCpmBiddingScheme) Equal(o *ManualCpmBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MaximizeConversionValueBiddingScheme) Copy() *MaximizeConversionValueBiddingScheme {
+	return deep.Copy(t).(*MaximizeConversionValueBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MaximizeConversionValueBiddingScheme) Equal(o *MaximizeConversionValueBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MaximizeConversionsBiddingScheme) Copy() *MaximizeConversionsBiddingScheme {
+	return deep.Copy(t).(*MaximizeConversionsBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MaximizeConversionsBiddingScheme) Equal(o *MaximizeConversionsBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Money) Copy() *Money {
+	return deep.Copy(t).(*Money)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Money) Equal(o *Money) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UniversalAppCampaignSetting) Copy() *UniversalAppCampaignSetting {
+	return deep.Copy(t).(*UniversalAppCampaignSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UniversalAppCampaignSetting) Equal(o *UniversalAppCampaignSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NetworkSetting) Copy() *NetworkSetting {
+	return deep.Copy(t).(*NetworkSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NetworkSetting) Equal(o *NetworkSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NewEntityCreationError) Copy() *NewEntityCreationError {
+	return deep.Copy(t).(*NewEntityCreationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NewEntityCreationError) Equal(o *NewEntityCreationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NumberValue) Copy() *NumberValue {
+	return deep.Copy(t).(*NumberValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NumberValue) Equal(o *NumberValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PageFeed) Copy() *PageFeed {
+	return deep.Copy(t).(*PageFeed)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PageFeed) Equal(o *PageFeed) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PageOnePromotedBiddingScheme) Copy() *PageOnePromotedBiddingScheme {
+	return deep.Copy(t).(*PageOnePromotedBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PageOnePromotedBiddingScheme) Equal(o *PageOnePromotedBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyTopicConstraint) Copy() *PolicyTopicConstraint {
+	return deep.Copy(t).(*PolicyTopicConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyTopicConstraint) Equal(o *PolicyTopicConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyTopicEntry) Copy() *PolicyTopicEntry {
+	return deep.Copy(t).(*PolicyTopicEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyTopicEntry) Equal(o *PolicyTopicEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *PolicyTopicEvidence) Copy() *PolicyTopicEvidence {
+	return deep.Copy(t).(*PolicyTopicEvidence)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *PolicyTopicEvidence) Equal(o *PolicyTopicEvidence) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RealTimeBiddingSetting) Copy() *RealTimeBiddingSetting {
+	return deep.Copy(t).(*RealTimeBiddingSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RealTimeBiddingSetting) Equal(o *RealTimeBiddingSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RegionCodeError) Copy() *RegionCodeError {
+	return deep.Copy(t).(*RegionCodeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RegionCodeError) Equal(o *RegionCodeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ResellerConstraint) Copy() *ResellerConstraint {
+	return deep.Copy(t).(*ResellerConstraint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ResellerConstraint) Equal(o *ResellerConstraint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectiveOptimization) Copy() *SelectiveOptimization {
+	return deep.Copy(t).(*SelectiveOptimization)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectiveOptimization) Equal(o *SelectiveOptimization) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Setting) Copy() *Setting {
+	return deep.Copy(t).(*Setting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Setting) Equal(o *Setting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SettingError) Copy() *SettingError {
+	return deep.Copy(t).(*SettingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SettingError) Equal(o *SettingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ShoppingSetting) Copy() *ShoppingSetting {
+	return deep.Copy(t).(*ShoppingSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ShoppingSetting) Equal(o *ShoppingSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StatsQueryError) Copy() *StatsQueryError {
+	return deep.Copy(t).(*StatsQueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StatsQueryError) Equal(o *StatsQueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *String_StringMapEntry) Copy() *String_StringMapEntry {
+	return deep.Copy(t).(*String_StringMapEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *String_StringMapEntry) Equal(o *String_StringMapEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetCpaBiddingScheme) Copy() *TargetCpaBiddingScheme {
+	return deep.Copy(t).(*TargetCpaBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetCpaBiddingScheme) Equal(o *TargetCpaBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetOutrankShareBiddingScheme) Copy() *TargetOutrankShareBiddingScheme {
+	return deep.Copy(t).(*TargetOutrankShareBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetOutrankShareBiddingScheme) Equal(o *TargetOutrankShareBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetingSettingDetail) Copy() *TargetingSettingDetail {
+	return deep.Copy(t).(*TargetingSettingDetail)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetingSettingDetail) Equal(o *TargetingSettingDetail) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetRoasBiddingScheme) Copy() *TargetRoasBiddingScheme {
+	return deep.Copy(t).(*TargetRoasBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetRoasBiddingScheme) Equal(o *TargetRoasBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetSpendBiddingScheme) Copy() *TargetSpendBiddingScheme {
+	return deep.Copy(t).(*TargetSpendBiddingScheme)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetSpendBiddingScheme) Equal(o *TargetSpendBiddingScheme) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TargetingSetting) Copy() *TargetingSetting {
+	return deep.Copy(t).(*TargetingSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TargetingSetting) Equal(o *TargetingSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *TrackingSetting) Copy() *TrackingSetting {
+	return deep.Copy(t).(*TrackingSetting)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *TrackingSetting) Equal(o *TrackingSetting) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UniversalAppCampaignAdsPolicyDecisions) Copy() *UniversalAppCampaignAdsPolicyDecisions {
+	return deep.Copy(t).(*UniversalAppCampaignAdsPolicyDecisions)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UniversalAppCampaignAdsPolicyDecisions) Equal(o *UniversalAppCampaignAdsPolicyDecisions) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UrlError) Copy() *UrlError {
+	return deep.Copy(t).(*UrlError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UrlError) Equal(o *UrlError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *VanityPharma) Copy() *VanityPharma {
+	return deep.Copy(t).(*VanityPharma)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *VanityPharma) Equal(o *VanityPharma) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffBiddingStrategyConfiguration compares from, as fetched from the API, with the desired
+// to. It returns a BiddingStrategyConfiguration holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffBiddingStrategyConfiguration(from, to *BiddingStrategyConfiguration) (*BiddingStrategyConfiguration, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"BiddingStrategyType", "BiddingScheme", "Bids", "TargetRoasOverride"},
+		[]string{"BiddingStrategyId"},
+	).(*BiddingStrategyConfiguration)
+	return set, changes
+}
+
+// DiffBudget compares from, as fetched from the API, with the desired
+// to. It returns a Budget holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffBudget(from, to *Budget) (*Budget, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Amount", "DeliveryMethod", "IsExplicitlyShared"},
+		[]string{"BudgetId"},
+	).(*Budget)
+	return set, changes
+}
+
+// DiffCampaign compares from, as fetched from the API, with the desired
+// to. It returns a Campaign holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCampaign(from, to *Campaign) (*Campaign, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"CampaignGroupId", "Name", "Status", "StartDate", "EndDate", "Budget", "AdServingOptimizationStatus", "FrequencyCap", "Settings", "NetworkSetting", "BiddingStrategyConfiguration", "ForwardCompatibilityMap", "TrackingUrlTemplate", "FinalUrlSuffix", "UrlCustomParameters", "VanityPharma", "SelectiveOptimization"},
+		[]string{"Id"},
+	).(*Campaign)
+	return set, changes
+}
+
+// SetCampaignOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffCampaign.
+func SetCampaignOperation(from, to *Campaign) (*CampaignOperation, []deep.Change) {
+	set, changes := DiffCampaign(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &CampaignOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+
+// DiffConversionOptimizerEligibility compares from, as fetched from the API, with the desired
+// to. It returns a ConversionOptimizerEligibility holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffConversionOptimizerEligibility(from, to *ConversionOptimizerEligibility) (*ConversionOptimizerEligibility, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		nil,
+	).(*ConversionOptimizerEligibility)
+	return set, changes
+}
+
+// DiffFrequencyCap compares from, as fetched from the API, with the desired
+// to. It returns a FrequencyCap holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffFrequencyCap(from, to *FrequencyCap) (*FrequencyCap, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Impressions", "TimeUnit", "Level"},
+		nil,
+	).(*FrequencyCap)
+	return set, changes
+}
+
+// DiffManualCpcBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a ManualCpcBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffManualCpcBiddingScheme(from, to *ManualCpcBiddingScheme) (*ManualCpcBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"EnhancedCpcEnabled"},
+		nil,
+	).(*ManualCpcBiddingScheme)
+	return set, changes
+}
+
+// DiffManualCpmBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a ManualCpmBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffManualCpmBiddingScheme(from, to *ManualCpmBiddingScheme) (*ManualCpmBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		nil,
+	).(*ManualCpmBiddingScheme)
+	return set, changes
+}
+
+// DiffMaximizeConversionValueBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a MaximizeConversionValueBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMaximizeConversionValueBiddingScheme(from, to *MaximizeConversionValueBiddingScheme) (*MaximizeConversionValueBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"TargetRoas"},
+		nil,
+	).(*MaximizeConversionValueBiddingScheme)
+	return set, changes
+}
+
+// DiffNetworkSetting compares from, as fetched from the API, with the desired
+// to. It returns a NetworkSetting holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffNetworkSetting(from, to *NetworkSetting) (*NetworkSetting, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"TargetGoogleSearch", "TargetSearchNetwork", "TargetContentNetwork", "TargetPartnerSearchNetwork"},
+		nil,
+	).(*NetworkSetting)
+	return set, changes
+}
+
+// DiffTargetCpaBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a TargetCpaBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffTargetCpaBiddingScheme(from, to *TargetCpaBiddingScheme) (*TargetCpaBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"MaxCpcBidCeiling", "MaxCpcBidFloor"},
+		[]string{"TargetCpa"},
+	).(*TargetCpaBiddingScheme)
+	return set, changes
+}
+
+// DiffTargetRoasBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a TargetRoasBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffTargetRoasBiddingScheme(from, to *TargetRoasBiddingScheme) (*TargetRoasBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"BidCeiling", "BidFloor"},
+		[]string{"TargetRoas"},
+	).(*TargetRoasBiddingScheme)
+	return set, changes
+}
+
+// DiffTargetSpendBiddingScheme compares from, as fetched from the API, with the desired
+// to. It returns a TargetSpendBiddingScheme holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffTargetSpendBiddingScheme(from, to *TargetSpendBiddingScheme) (*TargetSpendBiddingScheme, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"BidCeiling", "SpendTarget"},
+		nil,
+	).(*TargetSpendBiddingScheme)
+	return set, changes
+}
+
+// DiffVanityPharma compares from, as fetched from the API, with the desired
+// to. It returns a VanityPharma holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffVanityPharma(from, to *VanityPharma) (*VanityPharma, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"VanityPharmaDisplayUrlMode", "VanityPharmaText"},
+		nil,
+	).(*VanityPharma)
+	return set, changes
+}
@@ -0,0 +1,562 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// BiddingStrategyConfigurationFields holds the selector field names of BiddingStrategyConfiguration, including
+// the fields of the types it holds.
+var BiddingStrategyConfigurationFields = struct {
+	BiddingStrategyId                 string
+	BiddingStrategyName               string
+	BiddingStrategyType               string
+	EnhancedCpcEnabled                string
+	MaximizeConversionValueTargetRoas string
+	TargetCpa                         string
+	TargetCpaMaxCpcBidCeiling         string
+	TargetCpaMaxCpcBidFloor           string
+	TargetRoas                        string
+	TargetRoasBidCeiling              string
+	TargetRoasBidFloor                string
+	TargetSpendBidCeiling             string
+	TargetSpendSpendTarget            string
+	ViewableCpmEnabled                string
+}{
+	BiddingStrategyId:                 "BiddingStrategyId",
+	BiddingStrategyName:               "BiddingStrategyName",
+	BiddingStrategyType:               "BiddingStrategyType",
+	EnhancedCpcEnabled:                "EnhancedCpcEnabled",
+	MaximizeConversionValueTargetRoas: "MaximizeConversionValueTargetRoas",
+	TargetCpa:                         "TargetCpa",
+	TargetCpaMaxCpcBidCeiling:         "TargetCpaMaxCpcBidCeiling",
+	TargetCpaMaxCpcBidFloor:           "TargetCpaMaxCpcBidFloor",
+	TargetRoas:                        "TargetRoas",
+	TargetRoasBidCeiling:              "TargetRoasBidCeiling",
+	TargetRoasBidFloor:                "TargetRoasBidFloor",
+	TargetSpendBidCeiling:             "TargetSpendBidCeiling",
+	TargetSpendSpendTarget:            "TargetSpendSpendTarget",
+	ViewableCpmEnabled:                "ViewableCpmEnabled",
+}
+
+// BudgetFields holds the selector field names of Budget, including
+// the fields of the types it holds.
+var BudgetFields = struct {
+	Amount                   string
+	BudgetId                 string
+	BudgetName               string
+	BudgetReferenceCount     string
+	BudgetStatus             string
+	DeliveryMethod           string
+	IsBudgetExplicitlyShared string
+}{
+	Amount:                   "Amount",
+	BudgetId:                 "BudgetId",
+	BudgetName:               "BudgetName",
+	BudgetReferenceCount:     "BudgetReferenceCount",
+	BudgetStatus:             "BudgetStatus",
+	DeliveryMethod:           "DeliveryMethod",
+	IsBudgetExplicitlyShared: "IsBudgetExplicitlyShared",
+}
+
+// CampaignFields holds the selector field names of Campaign, including
+// the fields of the types it holds.
+var CampaignFields = struct {
+	AdServingOptimizationStatus       string
+	AdvertisingChannelSubType         string
+	AdvertisingChannelType            string
+	Amount                            string
+	BaseCampaignId                    string
+	BiddingStrategyId                 string
+	BiddingStrategyName               string
+	BiddingStrategyType               string
+	BudgetId                          string
+	BudgetName                        string
+	BudgetReferenceCount              string
+	BudgetStatus                      string
+	CampaignGroupId                   string
+	CampaignTrialType                 string
+	DeliveryMethod                    string
+	Eligible                          string
+	EndDate                           string
+	EnhancedCpcEnabled                string
+	FinalUrlSuffix                    string
+	FrequencyCapMaxImpressions        string
+	Id                                string
+	IsBudgetExplicitlyShared          string
+	Labels                            string
+	Level                             string
+	MaximizeConversionValueTargetRoas string
+	Name                              string
+	RejectionReasons                  string
+	SelectiveOptimization             string
+	ServingStatus                     string
+	Settings                          string
+	StartDate                         string
+	Status                            string
+	TargetContentNetwork              string
+	TargetCpa                         string
+	TargetCpaMaxCpcBidCeiling         string
+	TargetCpaMaxCpcBidFloor           string
+	TargetGoogleSearch                string
+	TargetPartnerSearchNetwork        string
+	TargetRoas                        string
+	TargetRoasBidCeiling              string
+	TargetRoasBidFloor                string
+	TargetSearchNetwork               string
+	TargetSpendBidCeiling             string
+	TargetSpendSpendTarget            string
+	TimeUnit                          string
+	TrackingUrlTemplate               string
+	UrlCustomParameters               string
+	VanityPharmaDisplayUrlMode        string
+	VanityPharmaText                  string
+	ViewableCpmEnabled                string
+}{
+	AdServingOptimizationStatus:       "AdServingOptimizationStatus",
+	AdvertisingChannelSubType:         "AdvertisingChannelSubType",
+	AdvertisingChannelType:            "AdvertisingChannelType",
+	Amount:                            "Amount",
+	BaseCampaignId:                    "BaseCampaignId",
+	BiddingStrategyId:                 "BiddingStrategyId",
+	BiddingStrategyName:               "BiddingStrategyName",
+	BiddingStrategyType:               "BiddingStrategyType",
+	BudgetId:                          "BudgetId",
+	BudgetName:                        "BudgetName",
+	BudgetReferenceCount:              "BudgetReferenceCount",
+	BudgetStatus:                      "BudgetStatus",
+	CampaignGroupId:                   "CampaignGroupId",
+	CampaignTrialType:                 "CampaignTrialType",
+	DeliveryMethod:                    "DeliveryMethod",
+	Eligible:                          "Eligible",
+	EndDate:                           "EndDate",
+	EnhancedCpcEnabled:                "EnhancedCpcEnabled",
+	FinalUrlSuffix:                    "FinalUrlSuffix",
+	FrequencyCapMaxImpressions:        "FrequencyCapMaxImpressions",
+	Id:                                "Id",
+	IsBudgetExplicitlyShared:          "IsBudgetExplicitlyShared",
+	Labels:                            "Labels",
+	Level:                             "Level",
+	MaximizeConversionValueTargetRoas: "MaximizeConversionValueTargetRoas",
+	Name:                              "Name",
+	RejectionReasons:                  "RejectionReasons",
+	SelectiveOptimization:             "SelectiveOptimization",
+	ServingStatus:                     "ServingStatus",
+	Settings:                          "Settings",
+	StartDate:                         "StartDate",
+	Status:                            "Status",
+	TargetContentNetwork:              "TargetContentNetwork",
+	TargetCpa:                         "TargetCpa",
+	TargetCpaMaxCpcBidCeiling:         "TargetCpaMaxCpcBidCeiling",
+	TargetCpaMaxCpcBidFloor:           "TargetCpaMaxCpcBidFloor",
+	TargetGoogleSearch:                "TargetGoogleSearch",
+	TargetPartnerSearchNetwork:        "TargetPartnerSearchNetwork",
+	TargetRoas:                        "TargetRoas",
+	TargetRoasBidCeiling:              "TargetRoasBidCeiling",
+	TargetRoasBidFloor:                "TargetRoasBidFloor",
+	TargetSearchNetwork:               "TargetSearchNetwork",
+	TargetSpendBidCeiling:             "TargetSpendBidCeiling",
+	TargetSpendSpendTarget:            "TargetSpendSpendTarget",
+	TimeUnit:                          "TimeUnit",
+	TrackingUrlTemplate:               "TrackingUrlTemplate",
+	UrlCustomParameters:               "UrlCustomParameters",
+	VanityPharmaDisplayUrlMode:        "VanityPharmaDisplayUrlMode",
+	VanityPharmaText:                  "VanityPharmaText",
+	ViewableCpmEnabled:                "ViewableCpmEnabled",
+}
+
+// ConversionOptimizerEligibilityFields holds the selector field names of ConversionOptimizerEligibility, including
+// the fields of the types it holds.
+var ConversionOptimizerEligibilityFields = struct {
+	Eligible         string
+	RejectionReasons string
+}{
+	Eligible:         "Eligible",
+	RejectionReasons: "RejectionReasons",
+}
+
+// FrequencyCapFields holds the selector field names of FrequencyCap, including
+// the fields of the types it holds.
+var FrequencyCapFields = struct {
+	FrequencyCapMaxImpressions string
+	Level                      string
+	TimeUnit                   string
+}{
+	FrequencyCapMaxImpressions: "FrequencyCapMaxImpressions",
+	Level:                      "Level",
+	TimeUnit:                   "TimeUnit",
+}
+
+// ManualCpcBiddingSchemeFields holds the selector field names of ManualCpcBiddingScheme, including
+// the fields of the types it holds.
+var ManualCpcBiddingSchemeFields = struct {
+	EnhancedCpcEnabled string
+}{
+	EnhancedCpcEnabled: "EnhancedCpcEnabled",
+}
+
+// ManualCpmBiddingSchemeFields holds the selector field names of ManualCpmBiddingScheme, including
+// the fields of the types it holds.
+var ManualCpmBiddingSchemeFields = struct {
+	ViewableCpmEnabled string
+}{
+	ViewableCpmEnabled: "ViewableCpmEnabled",
+}
+
+// MaximizeConversionValueBiddingSchemeFields holds the selector field names of MaximizeConversionValueBiddingScheme, including
+// the fields of the types it holds.
+var MaximizeConversionValueBiddingSchemeFields = struct {
+	MaximizeConversionValueTargetRoas string
+}{
+	MaximizeConversionValueTargetRoas: "MaximizeConversionValueTargetRoas",
+}
+
+// NetworkSettingFields holds the selector field names of NetworkSetting, including
+// the fields of the types it holds.
+var NetworkSettingFields = struct {
+	TargetContentNetwork       string
+	TargetGoogleSearch         string
+	TargetPartnerSearchNetwork string
+	TargetSearchNetwork        string
+}{
+	TargetContentNetwork:       "TargetContentNetwork",
+	TargetGoogleSearch:         "TargetGoogleSearch",
+	TargetPartnerSearchNetwork: "TargetPartnerSearchNetwork",
+	TargetSearchNetwork:        "TargetSearchNetwork",
+}
+
+// TargetCpaBiddingSchemeFields holds the selector field names of TargetCpaBiddingScheme, including
+// the fields of the types it holds.
+var TargetCpaBiddingSchemeFields = struct {
+	TargetCpa                 string
+	TargetCpaMaxCpcBidCeiling string
+	TargetCpaMaxCpcBidFloor   string
+}{
+	TargetCpa:                 "TargetCpa",
+	TargetCpaMaxCpcBidCeiling: "TargetCpaMaxCpcBidCeiling",
+	TargetCpaMaxCpcBidFloor:   "TargetCpaMaxCpcBidFloor",
+}
+
+// TargetRoasBiddingSchemeFields holds the selector field names of TargetRoasBiddingScheme, including
+// the fields of the types it holds.
+var TargetRoasBiddingSchemeFields = struct {
+	TargetRoas           string
+	TargetRoasBidCeiling string
+	TargetRoasBidFloor   string
+}{
+	TargetRoas:           "TargetRoas",
+	TargetRoasBidCeiling: "TargetRoasBidCeiling",
+	TargetRoasBidFloor:   "TargetRoasBidFloor",
+}
+
+// TargetSpendBiddingSchemeFields holds the selector field names of TargetSpendBiddingScheme, including
+// the fields of the types it holds.
+var TargetSpendBiddingSchemeFields = struct {
+	TargetSpendBidCeiling  string
+	TargetSpendSpendTarget string
+}{
+	TargetSpendBidCeiling:  "TargetSpendBidCeiling",
+	TargetSpendSpendTarget: "TargetSpendSpendTarget",
+}
+
+// VanityPharmaFields holds the selector field names of VanityPharma, including
+// the fields of the types it holds.
+var VanityPharmaFields = struct {
+	VanityPharmaDisplayUrlMode string
+	VanityPharmaText           string
+}{
+	VanityPharmaDisplayUrlMode: "VanityPharmaDisplayUrlMode",
+	VanityPharmaText:           "VanityPharmaText",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"MutateLabel": {
+		{Type: "MutateLabel", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"BiddingStrategyConfiguration": {
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyId", Selectors: []string{"BiddingStrategyId"}, Filter: "BiddingStrategyId"},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyName", Selectors: []string{"BiddingStrategyName"}, Filter: "BiddingStrategyName", ReadOnly: true},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategyType", Selectors: []string{"BiddingStrategyType"}, Filter: "BiddingStrategyType"},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingStrategySource", ReadOnly: true},
+		{Type: "BiddingStrategyConfiguration", Name: "BiddingScheme"},
+		{Type: "BiddingStrategyConfiguration", Name: "Bids"},
+		{Type: "BiddingStrategyConfiguration", Name: "TargetRoasOverride"},
+	},
+	"Budget": {
+		{Type: "Budget", Name: "BudgetId", Selectors: []string{"BudgetId"}, Filter: "BudgetId"},
+		{Type: "Budget", Name: "Name", Selectors: []string{"BudgetName"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Budget", Name: "Amount", Selectors: []string{"Amount"}, Filter: "Amount", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Budget", Name: "DeliveryMethod", Selectors: []string{"DeliveryMethod"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Budget", Name: "ReferenceCount", Selectors: []string{"BudgetReferenceCount"}, Filter: "BudgetReferenceCount", ReadOnly: true},
+		{Type: "Budget", Name: "IsExplicitlyShared", Selectors: []string{"IsBudgetExplicitlyShared"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Budget", Name: "Status", Selectors: []string{"BudgetStatus"}, Filter: "BudgetStatus", ReadOnly: true},
+	},
+	"Campaign": {
+		{Type: "Campaign", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "Campaign", Name: "CampaignGroupId", Selectors: []string{"CampaignGroupId"}, Filter: "CampaignGroupId"},
+		{Type: "Campaign", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "Campaign", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "Campaign", Name: "ServingStatus", Selectors: []string{"ServingStatus"}, Filter: "ServingStatus", ReadOnly: true},
+		{Type: "Campaign", Name: "StartDate", Selectors: []string{"StartDate"}, Filter: "StartDate"},
+		{Type: "Campaign", Name: "EndDate", Selectors: []string{"EndDate"}, Filter: "EndDate"},
+		{Type: "Campaign", Name: "Budget"},
+		{Type: "Campaign", Name: "ConversionOptimizerEligibility", ReadOnly: true},
+		{Type: "Campaign", Name: "AdServingOptimizationStatus", Selectors: []string{"AdServingOptimizationStatus"}},
+		{Type: "Campaign", Name: "FrequencyCap"},
+		{Type: "Campaign", Name: "Settings", Selectors: []string{"Settings"}},
+		{Type: "Campaign", Name: "AdvertisingChannelType", Selectors: []string{"AdvertisingChannelType"}, Filter: "AdvertisingChannelType", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "Campaign", Name: "AdvertisingChannelSubType", Selectors: []string{"AdvertisingChannelSubType"}, Filter: "AdvertisingChannelSubType", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "Campaign", Name: "NetworkSetting"},
+		{Type: "Campaign", Name: "Labels", Selectors: []string{"Labels"}, Filter: "Labels", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Campaign", Name: "BiddingStrategyConfiguration"},
+		{Type: "Campaign", Name: "CampaignTrialType", Selectors: []string{"CampaignTrialType"}, Filter: "CampaignTrialType", ReadOnly: true},
+		{Type: "Campaign", Name: "BaseCampaignId", Selectors: []string{"BaseCampaignId"}, Filter: "BaseCampaignId", ReadOnly: true},
+		{Type: "Campaign", Name: "ForwardCompatibilityMap"},
+		{Type: "Campaign", Name: "TrackingUrlTemplate", Selectors: []string{"TrackingUrlTemplate"}, Filter: "TrackingUrlTemplate"},
+		{Type: "Campaign", Name: "FinalUrlSuffix", Selectors: []string{"FinalUrlSuffix"}, Filter: "FinalUrlSuffix"},
+		{Type: "Campaign", Name: "UrlCustomParameters", Selectors: []string{"UrlCustomParameters"}},
+		{Type: "Campaign", Name: "VanityPharma"},
+		{Type: "Campaign", Name: "SelectiveOptimization", Selectors: []string{"SelectiveOptimization"}},
+	},
+	"CampaignLabel": {
+		{Type: "CampaignLabel", Name: "CampaignId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+		{Type: "CampaignLabel", Name: "LabelId", Required: true, RequiredOperators: []string{"ADD", "REMOVE"}},
+	},
+	"CampaignLabelOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "CampaignLabelOperation", Name: "Operand", Required: true},
+	},
+	"TextLabel": {
+		{Type: "Label", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Label", Name: "Name"},
+		{Type: "Label", Name: "Status", ReadOnly: true},
+		{Type: "Label", Name: "Attribute", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Label", Name: "LabelType"},
+	},
+	"CampaignOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "CampaignOperation", Name: "Operand", Required: true},
+	},
+	"ConversionOptimizerEligibility": {
+		{Type: "ConversionOptimizerEligibility", Name: "Eligible", Selectors: []string{"Eligible"}, ReadOnly: true},
+		{Type: "ConversionOptimizerEligibility", Name: "RejectionReasons", Selectors: []string{"RejectionReasons"}, ReadOnly: true},
+	},
+	"CpaBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpaBid", Name: "Bid"},
+		{Type: "CpaBid", Name: "BidSource", ReadOnly: true},
+	},
+	"CpcBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpcBid", Name: "Bid"},
+		{Type: "CpcBid", Name: "CpcBidSource", ReadOnly: true},
+	},
+	"CpmBid": {
+		{Type: "Bids", Name: "BidsType"},
+		{Type: "CpmBid", Name: "Bid"},
+		{Type: "CpmBid", Name: "CpmBidSource", ReadOnly: true},
+	},
+	"CustomParameter": {
+		{Type: "CustomParameter", Name: "Key", Required: true},
+		{Type: "CustomParameter", Name: "Value"},
+		{Type: "CustomParameter", Name: "IsRemove"},
+	},
+	"DynamicSearchAdsSetting": {
+		{Type: "Setting", Name: "SettingType"},
+		{Type: "DynamicSearchAdsSetting", Name: "DomainName", Required: true},
+		{Type: "DynamicSearchAdsSetting", Name: "LanguageCode", Required: true},
+		{Type: "DynamicSearchAdsSetting", Name: "UseSuppliedUrlsOnly"},
+		{Type: "DynamicSearchAdsSetting", Name: "PageFeed"},
+	},
+	"FrequencyCap": {
+		{Type: "FrequencyCap", Name: "Impressions", Selectors: []string{"FrequencyCapMaxImpressions"}, Filter: "FrequencyCapMaxImpressions"},
+		{Type: "FrequencyCap", Name: "TimeUnit", Selectors: []string{"TimeUnit"}, Filter: "TimeUnit"},
+		{Type: "FrequencyCap", Name: "Level", Selectors: []string{"Level"}, Filter: "Level"},
+	},
+	"Label": {
+		{Type: "Label", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Label", Name: "Name"},
+		{Type: "Label", Name: "Status", ReadOnly: true},
+		{Type: "Label", Name: "Attribute", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "Label", Name: "LabelType"},
+	},
+	"ManualCpcBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "ManualCpcBiddingScheme", Name: "EnhancedCpcEnabled", Selectors: []string{"EnhancedCpcEnabled"}, Filter: "EnhancedCpcEnabled"},
+	},
+	"ManualCpmBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "ManualCpmBiddingScheme", Name: "ViewableCpmEnabled", Selectors: []string{"ViewableCpmEnabled"}, Filter: "ViewableCpmEnabled", ReadOnly: true},
+	},
+	"MaximizeConversionValueBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "MaximizeConversionValueBiddingScheme", Name: "TargetRoas", Selectors: []string{"MaximizeConversionValueTargetRoas"}, Filter: "MaximizeConversionValueTargetRoas"},
+	},
+	"UniversalAppCampaignSetting": {
+		{Type: "Setting", Name: "SettingType"},
+		{Type: "UniversalAppCampaignSetting", Name: "AppId", Filter: "UniversalAppCampaignSettingAppId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "UniversalAppCampaignSetting", Name: "AppVendor", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "UniversalAppCampaignSetting", Name: "Description1", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "UniversalAppCampaignSetting", Name: "Description2", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "UniversalAppCampaignSetting", Name: "Description3", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "UniversalAppCampaignSetting", Name: "Description4", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "UniversalAppCampaignSetting", Name: "YoutubeVideoMediaIds"},
+		{Type: "UniversalAppCampaignSetting", Name: "ImageMediaIds"},
+		{Type: "UniversalAppCampaignSetting", Name: "UniversalAppBiddingStrategyGoalType"},
+		{Type: "UniversalAppCampaignSetting", Name: "YoutubeVideoMediaIdsOps"},
+		{Type: "UniversalAppCampaignSetting", Name: "ImageMediaIdsOps"},
+		{Type: "UniversalAppCampaignSetting", Name: "AdsPolicyDecisions"},
+	},
+	"NetworkSetting": {
+		{Type: "NetworkSetting", Name: "TargetGoogleSearch", Selectors: []string{"TargetGoogleSearch"}, Filter: "TargetGoogleSearch"},
+		{Type: "NetworkSetting", Name: "TargetSearchNetwork", Selectors: []string{"TargetSearchNetwork"}, Filter: "TargetSearchNetwork"},
+		{Type: "NetworkSetting", Name: "TargetContentNetwork", Selectors: []string{"TargetContentNetwork"}, Filter: "TargetContentNetwork"},
+		{Type: "NetworkSetting", Name: "TargetPartnerSearchNetwork", Selectors: []string{"TargetPartnerSearchNetwork"}, Filter: "TargetPartnerSearchNetwork"},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"PolicyTopicEntry": {
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicEntryType"},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicEvidences"},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicConstraints"},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicId", ReadOnly: true},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicName", ReadOnly: true},
+		{Type: "PolicyTopicEntry", Name: "PolicyTopicHelpCenterUrl", ReadOnly: true},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"ShoppingSetting": {
+		{Type: "Setting", Name: "SettingType"},
+		{Type: "ShoppingSetting", Name: "MerchantId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ShoppingSetting", Name: "SalesCountry", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ShoppingSetting", Name: "CampaignPriority", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ShoppingSetting", Name: "EnableLocal"},
+		{Type: "ShoppingSetting", Name: "PurchasePlatform"},
+	},
+	"TargetCpaBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "TargetCpaBiddingScheme", Name: "TargetCpa", Selectors: []string{"TargetCpa"}, Required: true},
+		{Type: "TargetCpaBiddingScheme", Name: "MaxCpcBidCeiling", Selectors: []string{"TargetCpaMaxCpcBidCeiling"}, Filter: "TargetCpaMaxCpcBidCeiling"},
+		{Type: "TargetCpaBiddingScheme", Name: "MaxCpcBidFloor", Selectors: []string{"TargetCpaMaxCpcBidFloor"}, Filter: "TargetCpaMaxCpcBidFloor"},
+	},
+	"TargetingSettingDetail": {
+		{Type: "TargetingSettingDetail", Name: "CriterionTypeGroup", Required: true},
+		{Type: "TargetingSettingDetail", Name: "TargetAll", Required: true},
+	},
+	"TargetRoasBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "TargetRoasBiddingScheme", Name: "TargetRoas", Selectors: []string{"TargetRoas"}, Required: true},
+		{Type: "TargetRoasBiddingScheme", Name: "BidCeiling", Selectors: []string{"TargetRoasBidCeiling"}},
+		{Type: "TargetRoasBiddingScheme", Name: "BidFloor", Selectors: []string{"TargetRoasBidFloor"}},
+	},
+	"TargetSpendBiddingScheme": {
+		{Type: "BiddingScheme", Name: "BiddingSchemeType"},
+		{Type: "TargetSpendBiddingScheme", Name: "BidCeiling", Selectors: []string{"TargetSpendBidCeiling"}},
+		{Type: "TargetSpendBiddingScheme", Name: "SpendTarget", Selectors: []string{"TargetSpendSpendTarget"}},
+	},
+	"VanityPharma": {
+		{Type: "VanityPharma", Name: "VanityPharmaDisplayUrlMode", Selectors: []string{"VanityPharmaDisplayUrlMode"}, Filter: "VanityPharmaDisplayUrlMode"},
+		{Type: "VanityPharma", Name: "VanityPharmaText", Selectors: []string{"VanityPharmaText"}, Filter: "VanityPharmaText"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1361 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "AdxError" as the
+// concrete type in the discriminator of its base types.
+func (t AdxError) MarshalJSON() ([]byte, error) {
+	type plain AdxError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdxError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "BiddingErrors" as the
+// concrete type in the discriminator of its base types.
+func (t BiddingErrors) MarshalJSON() ([]byte, error) {
+	type plain BiddingErrors
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("BiddingErrors")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "BudgetError" as the
+// concrete type in the discriminator of its base types.
+func (t BudgetError) MarshalJSON() ([]byte, error) {
+	type plain BudgetError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("BudgetError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignError" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignError) MarshalJSON() ([]byte, error) {
+	type plain CampaignError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CampaignError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignLabelOperation" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignLabelOperation) MarshalJSON() ([]byte, error) {
+	type plain CampaignLabelOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("CampaignLabelOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignLabelReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignLabelReturnValue) MarshalJSON() ([]byte, error) {
+	type plain CampaignLabelReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("CampaignLabelReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TextLabel" as the
+// concrete type in the discriminator of its base types.
+func (t TextLabel) MarshalJSON() ([]byte, error) {
+	type plain TextLabel
+	v := plain(t)
+
+	b0 := new(Label)
+	if v.Label != nil {
+		*b0 = *v.Label
+	}
+	if b0.LabelType == nil {
+		b0.LabelType = String("TextLabel")
+	}
+	v.Label = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DisplayAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t DisplayAttribute) MarshalJSON() ([]byte, error) {
+	type plain DisplayAttribute
+	v := plain(t)
+
+	b0 := new(LabelAttribute)
+	if v.LabelAttribute != nil {
+		*b0 = *v.LabelAttribute
+	}
+	if b0.LabelAttributeType == nil {
+		b0.LabelAttributeType = String("DisplayAttribute")
+	}
+	v.LabelAttribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignOperation" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignOperation) MarshalJSON() ([]byte, error) {
+	type plain CampaignOperation
+	v := plain(t)
+
+	b0 := new(Operation)
+	if v.Operation != nil {
+		*b0 = *v.Operation
+	}
+	if b0.OperationType == nil {
+		b0.OperationType = String("CampaignOperation")
+	}
+	v.Operation = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignPage" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignPage) MarshalJSON() ([]byte, error) {
+	type plain CampaignPage
+	v := plain(t)
+
+	b0 := new(Page)
+	if v.Page != nil {
+		*b0 = *v.Page
+	}
+	if b0.PageType == nil {
+		b0.PageType = String("CampaignPage")
+	}
+	v.Page = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CampaignReturnValue" as the
+// concrete type in the discriminator of its base types.
+func (t CampaignReturnValue) MarshalJSON() ([]byte, error) {
+	type plain CampaignReturnValue
+	v := plain(t)
+
+	b0 := new(ListReturnValue)
+	if v.ListReturnValue != nil {
+		*b0 = *v.ListReturnValue
+	}
+	if b0.ListReturnValueType == nil {
+		b0.ListReturnValueType = String("CampaignReturnValue")
+	}
+	v.ListReturnValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CertificateDomainMismatchInCountryConstraint" as the
+// concrete type in the discriminator of its base types.
+func (t CertificateDomainMismatchInCountryConstraint) MarshalJSON() ([]byte, error) {
+	type plain CertificateDomainMismatchInCountryConstraint
+	v := plain(t)
+
+	b0 := new(CountryConstraint)
+	if v.CountryConstraint != nil {
+		*b0 = *v.CountryConstraint
+	}
+	v.CountryConstraint = b0
+
+	b1 := new(PolicyTopicConstraint)
+	if b0.PolicyTopicConstraint != nil {
+		*b1 = *b0.PolicyTopicConstraint
+	}
+	if b1.PolicyTopicConstraintType == nil {
+		b1.PolicyTopicConstraintType = String("CertificateDomainMismatchInCountryConstraint")
+	}
+	b0.PolicyTopicConstraint = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CertificateMissingConstraint" as the
+// concrete type in the discriminator of its base types.
+func (t CertificateMissingConstraint) MarshalJSON() ([]byte, error) {
+	type plain CertificateMissingConstraint
+	v := plain(t)
+
+	b0 := new(PolicyTopicConstraint)
+	if v.PolicyTopicConstraint != nil {
+		*b0 = *v.PolicyTopicConstraint
+	}
+	if b0.PolicyTopicConstraintType == nil {
+		b0.PolicyTopicConstraintType = String("CertificateMissingConstraint")
+	}
+	v.PolicyTopicConstraint = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CertificateMissingInCountryConstraint" as the
+// concrete type in the discriminator of its base types.
+func (t CertificateMissingInCountryConstraint) MarshalJSON() ([]byte, error) {
+	type plain CertificateMissingInCountryConstraint
+	v := plain(t)
+
+	b0 := new(CountryConstraint)
+	if v.CountryConstraint != nil {
+		*b0 = *v.CountryConstraint
+	}
+	v.CountryConstraint = b0
+
+	b1 := new(PolicyTopicConstraint)
+	if b0.PolicyTopicConstraint != nil {
+		*b1 = *b0.PolicyTopicConstraint
+	}
+	if b1.PolicyTopicConstraintType == nil {
+		b1.PolicyTopicConstraintType = String("CertificateMissingInCountryConstraint")
+	}
+	b0.PolicyTopicConstraint = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CpaBid" as the
+// concrete type in the discriminator of its base types.
+func (t CpaBid) MarshalJSON() ([]byte, error) {
+	type plain CpaBid
+	v := plain(t)
+
+	b0 := new(Bids)
+	if v.Bids != nil {
+		*b0 = *v.Bids
+	}
+	if b0.BidsType == nil {
+		b0.BidsType = String("CpaBid")
+	}
+	v.Bids = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CpcBid" as the
+// concrete type in the discriminator of its base types.
+func (t CpcBid) MarshalJSON() ([]byte, error) {
+	type plain CpcBid
+	v := plain(t)
+
+	b0 := new(Bids)
+	if v.Bids != nil {
+		*b0 = *v.Bids
+	}
+	if b0.BidsType == nil {
+		b0.BidsType = String("CpcBid")
+	}
+	v.Bids = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CpmBid" as the
+// concrete type in the discriminator of its base types.
+func (t CpmBid) MarshalJSON() ([]byte, error) {
+	type plain CpmBid
+	v := plain(t)
+
+	b0 := new(Bids)
+	if v.Bids != nil {
+		*b0 = *v.Bids
+	}
+	if b0.BidsType == nil {
+		b0.BidsType = String("CpmBid")
+	}
+	v.Bids = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DateError" as the
+// concrete type in the discriminator of its base types.
+func (t DateError) MarshalJSON() ([]byte, error) {
+	type plain DateError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DateError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DateRangeError" as the
+// concrete type in the discriminator of its base types.
+func (t DateRangeError) MarshalJSON() ([]byte, error) {
+	type plain DateRangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DateRangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DoubleValue" as the
+// concrete type in the discriminator of its base types.
+func (t DoubleValue) MarshalJSON() ([]byte, error) {
+	type plain DoubleValue
+	v := plain(t)
+
+	b0 := new(NumberValue)
+	if v.NumberValue != nil {
+		*b0 = *v.NumberValue
+	}
+	v.NumberValue = b0
+
+	b1 := new(ComparableValue)
+	if b0.ComparableValue != nil {
+		*b1 = *b0.ComparableValue
+	}
+	if b1.ComparableValueType == nil {
+		b1.ComparableValueType = String("DoubleValue")
+	}
+	b0.ComparableValue = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DynamicSearchAdsSetting" as the
+// concrete type in the discriminator of its base types.
+func (t DynamicSearchAdsSetting) MarshalJSON() ([]byte, error) {
+	type plain DynamicSearchAdsSetting
+	v := plain(t)
+
+	b0 := new(Setting)
+	if v.Setting != nil {
+		*b0 = *v.Setting
+	}
+	if b0.SettingType == nil {
+		b0.SettingType = String("DynamicSearchAdsSetting")
+	}
+	v.Setting = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EnhancedCpcBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t EnhancedCpcBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain EnhancedCpcBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("EnhancedCpcBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t EntityAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain EntityAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityCountLimitExceeded" as the
+// concrete type in the discriminator of its base types.
+func (t EntityCountLimitExceeded) MarshalJSON() ([]byte, error) {
+	type plain EntityCountLimitExceeded
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityCountLimitExceeded")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityNotFound" as the
+// concrete type in the discriminator of its base types.
+func (t EntityNotFound) MarshalJSON() ([]byte, error) {
+	type plain EntityNotFound
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityNotFound")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ForwardCompatibilityError" as the
+// concrete type in the discriminator of its base types.
+func (t ForwardCompatibilityError) MarshalJSON() ([]byte, error) {
+	type plain ForwardCompatibilityError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ForwardCompatibilityError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "GeoTargetTypeSetting" as the
+// concrete type in the discriminator of its base types.
+func (t GeoTargetTypeSetting) MarshalJSON() ([]byte, error) {
+	type plain GeoTargetTypeSetting
+	v := plain(t)
+
+	b0 := new(Setting)
+	if v.Setting != nil {
+		*b0 = *v.Setting
+	}
+	if b0.SettingType == nil {
+		b0.SettingType = String("GeoTargetTypeSetting")
+	}
+	v.Setting = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ListError" as the
+// concrete type in the discriminator of its base types.
+func (t ListError) MarshalJSON() ([]byte, error) {
+	type plain ListError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ListError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LongValue" as the
+// concrete type in the discriminator of its base types.
+func (t LongValue) MarshalJSON() ([]byte, error) {
+	type plain LongValue
+	v := plain(t)
+
+	b0 := new(NumberValue)
+	if v.NumberValue != nil {
+		*b0 = *v.NumberValue
+	}
+	v.NumberValue = b0
+
+	b1 := new(ComparableValue)
+	if b0.ComparableValue != nil {
+		*b1 = *b0.ComparableValue
+	}
+	if b1.ComparableValueType == nil {
+		b1.ComparableValueType = String("LongValue")
+	}
+	b0.ComparableValue = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ManualCpcBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t ManualCpcBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain ManualCpcBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("ManualCpcBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ManualCpmBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t ManualCpmBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain ManualCpmBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("ManualCpmBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MaximizeConversionValueBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t MaximizeConversionValueBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain MaximizeConversionValueBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("MaximizeConversionValueBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MaximizeConversionsBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t MaximizeConversionsBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain MaximizeConversionsBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("MaximizeConversionsBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Money" as the
+// concrete type in the discriminator of its base types.
+func (t Money) MarshalJSON() ([]byte, error) {
+	type plain Money
+	v := plain(t)
+
+	b0 := new(ComparableValue)
+	if v.ComparableValue != nil {
+		*b0 = *v.ComparableValue
+	}
+	if b0.ComparableValueType == nil {
+		b0.ComparableValueType = String("Money")
+	}
+	v.ComparableValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UniversalAppCampaignSetting" as the
+// concrete type in the discriminator of its base types.
+func (t UniversalAppCampaignSetting) MarshalJSON() ([]byte, error) {
+	type plain UniversalAppCampaignSetting
+	v := plain(t)
+
+	b0 := new(Setting)
+	if v.Setting != nil {
+		*b0 = *v.Setting
+	}
+	if b0.SettingType == nil {
+		b0.SettingType = String("UniversalAppCampaignSetting")
+	}
+	v.Setting = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NewEntityCreationError" as the
+// concrete type in the discriminator of its base types.
+func (t NewEntityCreationError) MarshalJSON() ([]byte, error) {
+	type plain NewEntityCreationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NewEntityCreationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NullError" as the
+// concrete type in the discriminator of its base types.
+func (t NullError) MarshalJSON() ([]byte, error) {
+	type plain NullError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NullError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "PageOnePromotedBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t PageOnePromotedBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain PageOnePromotedBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("PageOnePromotedBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QueryError" as the
+// concrete type in the discriminator of its base types.
+func (t QueryError) MarshalJSON() ([]byte, error) {
+	type plain QueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RealTimeBiddingSetting" as the
+// concrete type in the discriminator of its base types.
+func (t RealTimeBiddingSetting) MarshalJSON() ([]byte, error) {
+	type plain RealTimeBiddingSetting
+	v := plain(t)
+
+	b0 := new(Setting)
+	if v.Setting != nil {
+		*b0 = *v.Setting
+	}
+	if b0.SettingType == nil {
+		b0.SettingType = String("RealTimeBiddingSetting")
+	}
+	v.Setting = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RegionCodeError" as the
+// concrete type in the discriminator of its base types.
+func (t RegionCodeError) MarshalJSON() ([]byte, error) {
+	type plain RegionCodeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RegionCodeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ResellerConstraint" as the
+// concrete type in the discriminator of its base types.
+func (t ResellerConstraint) MarshalJSON() ([]byte, error) {
+	type plain ResellerConstraint
+	v := plain(t)
+
+	b0 := new(PolicyTopicConstraint)
+	if v.PolicyTopicConstraint != nil {
+		*b0 = *v.PolicyTopicConstraint
+	}
+	if b0.PolicyTopicConstraintType == nil {
+		b0.PolicyTopicConstraintType = String("ResellerConstraint")
+	}
+	v.PolicyTopicConstraint = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SelectorError" as the
+// concrete type in the discriminator of its base types.
+func (t SelectorError) MarshalJSON() ([]byte, error) {
+	type plain SelectorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SelectorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SettingError" as the
+// concrete type in the discriminator of its base types.
+func (t SettingError) MarshalJSON() ([]byte, error) {
+	type plain SettingError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SettingError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ShoppingSetting" as the
+// concrete type in the discriminator of its base types.
+func (t ShoppingSetting) MarshalJSON() ([]byte, error) {
+	type plain ShoppingSetting
+	v := plain(t)
+
+	b0 := new(Setting)
+	if v.Setting != nil {
+		*b0 = *v.Setting
+	}
+	if b0.SettingType == nil {
+		b0.SettingType = String("ShoppingSetting")
+	}
+	v.Setting = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StatsQueryError" as the
+// concrete type in the discriminator of its base types.
+func (t StatsQueryError) MarshalJSON() ([]byte, error) {
+	type plain StatsQueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StatsQueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetCpaBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t TargetCpaBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain TargetCpaBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("TargetCpaBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetOutrankShareBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t TargetOutrankShareBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain TargetOutrankShareBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("TargetOutrankShareBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetRoasBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t TargetRoasBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain TargetRoasBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("TargetRoasBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetSpendBiddingScheme" as the
+// concrete type in the discriminator of its base types.
+func (t TargetSpendBiddingScheme) MarshalJSON() ([]byte, error) {
+	type plain TargetSpendBiddingScheme
+	v := plain(t)
+
+	b0 := new(BiddingScheme)
+	if v.BiddingScheme != nil {
+		*b0 = *v.BiddingScheme
+	}
+	if b0.BiddingSchemeType == nil {
+		b0.BiddingSchemeType = String("TargetSpendBiddingScheme")
+	}
+	v.BiddingScheme = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetingSetting" as the
+// concrete type in the discriminator of its base types.
+func (t TargetingSetting) MarshalJSON() ([]byte, error) {
+	type plain TargetingSetting
+	v := plain(t)
+
+	b0 := new(Setting)
+	if v.Setting != nil {
+		*b0 = *v.Setting
+	}
+	if b0.SettingType == nil {
+		b0.SettingType = String("TargetingSetting")
+	}
+	v.Setting = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TrackingSetting" as the
+// concrete type in the discriminator of its base types.
+func (t TrackingSetting) MarshalJSON() ([]byte, error) {
+	type plain TrackingSetting
+	v := plain(t)
+
+	b0 := new(Setting)
+	if v.Setting != nil {
+		*b0 = *v.Setting
+	}
+	if b0.SettingType == nil {
+		b0.SettingType = String("TrackingSetting")
+	}
+	v.Setting = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UrlError" as the
+// concrete type in the discriminator of its base types.
+func (t UrlError) MarshalJSON() ([]byte, error) {
+	type plain UrlError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("UrlError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,27 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignService
+
+// AddLabelOp returns the ADD operation of operand.
+func AddLabelOp(operand *CampaignLabel) *CampaignLabelOperation {
+	op := OperatorADD
+	return &CampaignLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveLabelOp returns the REMOVE operation of operand.
+func RemoveLabelOp(operand *CampaignLabel) *CampaignLabelOperation {
+	op := OperatorREMOVE
+	return &CampaignLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *Campaign) *CampaignOperation {
+	op := OperatorADD
+	return &CampaignOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *Campaign) *CampaignOperation {
+	op := OperatorSET
+	return &CampaignOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
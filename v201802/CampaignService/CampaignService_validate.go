@@ -0,0 +1,410 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks MutateLabel against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *MutateLabel) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Query against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Query) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks CampaignLabelOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *CampaignLabelOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks CampaignOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *CampaignOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "serviceSelector"), t.ServiceSelector != nil)
+	if t.ServiceSelector != nil {
+		t.ServiceSelector.validate(v, validate.Field(path, "serviceSelector"), op)
+	}
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "ADD", "SET")
+		}
+		v.DistinctIds(validate.Field(path, "operations"), operators, ids, "REMOVE", "SET")
+	}
+}
+
+func (t *MutateLabel) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(MutateLabel)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "ADD", "REMOVE")
+		}
+		v.DistinctIds(validate.Field(path, "operations"), operators, ids, "ADD", "REMOVE")
+	}
+}
+
+func (t *Query) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Query)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *BiddingStrategyConfiguration) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(BiddingStrategyConfiguration)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "biddingStrategyName"), op, t.BiddingStrategyName != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "biddingStrategySource"), op, t.BiddingStrategySource != nil)
+	}
+	if t.TargetRoasOverride != nil {
+		v.Range(validate.Field(path, "targetRoasOverride"), float64(*t.TargetRoasOverride), 0.01, 1000.0)
+	}
+}
+
+func (t *Budget) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Budget)
+	}
+	if t.Name != nil {
+		v.StringLength(validate.Field(path, "name"), *t.Name, 1, 255, true, true)
+	}
+	if t.Amount != nil && t.Amount.MicroAmount != nil {
+		v.AtLeast(validate.Field(validate.Field(path, "amount"), "microAmount"), float64(*t.Amount.MicroAmount), 1.)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "referenceCount"), op, t.ReferenceCount != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "status"), op, t.Status != nil)
+	}
+}
+
+func (t *Campaign) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Campaign)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "id"), op, t.Id != nil)
+	}
+	if t.Name != nil {
+		v.StringLength(validate.Field(path, "name"), *t.Name, 1, -1, false, false)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "servingStatus"), op, t.ServingStatus != nil)
+	}
+	if t.StartDate != nil {
+		v.Date(validate.Field(path, "startDate"), *t.StartDate)
+	}
+	if t.EndDate != nil {
+		v.Date(validate.Field(path, "endDate"), *t.EndDate)
+	}
+	if t.Budget != nil {
+		t.Budget.validate(v, validate.Field(path, "budget"), op)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "conversionOptimizerEligibility"), op, t.ConversionOptimizerEligibility != nil)
+	}
+	if t.ConversionOptimizerEligibility != nil {
+		t.ConversionOptimizerEligibility.validate(v, validate.Field(path, "conversionOptimizerEligibility"), op)
+	}
+	for i, e := range t.Labels {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "labels"), i), op)
+		}
+	}
+	if t.BiddingStrategyConfiguration != nil {
+		t.BiddingStrategyConfiguration.validate(v, validate.Field(path, "biddingStrategyConfiguration"), op)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "campaignTrialType"), op, t.CampaignTrialType != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "baseCampaignId"), op, t.BaseCampaignId != nil)
+	}
+	if t.UrlCustomParameters != nil {
+		t.UrlCustomParameters.validate(v, validate.Field(path, "urlCustomParameters"), op)
+	}
+}
+
+func (t *CampaignLabel) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CampaignLabel)
+	}
+	if validate.HasOperator(op, "ADD", "REMOVE") {
+		v.Required(validate.Field(path, "campaignId"), t.CampaignId != nil)
+	}
+	if validate.HasOperator(op, "ADD", "REMOVE") {
+		v.Required(validate.Field(path, "labelId"), t.LabelId != nil)
+	}
+}
+
+func (t *CampaignLabelOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CampaignLabelOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	if validate.HasOperator(op, "SET") {
+		v.Required(validate.Field(validate.Field(path, "operand"), "campaignId"), t.Operand != nil && t.Operand.CampaignId != nil)
+	}
+	if validate.HasOperator(op, "SET") {
+		v.Required(validate.Field(validate.Field(path, "operand"), "labelId"), t.Operand != nil && t.Operand.LabelId != nil)
+	}
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *CampaignOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CampaignOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	if validate.HasOperator(op, "SET") {
+		v.Required(validate.Field(validate.Field(path, "operand"), "id"), t.Operand != nil && t.Operand.Id != nil)
+	}
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *ConversionOptimizerEligibility) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(ConversionOptimizerEligibility)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "eligible"), op, t.Eligible != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "rejectionReasons"), op, len(t.RejectionReasons) > 0)
+	}
+}
+
+func (t *CustomParameter) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CustomParameter)
+	}
+	v.Required(validate.Field(path, "key"), t.Key != nil)
+	if t.Key != nil {
+		v.StringLength(validate.Field(path, "key"), *t.Key, 1, 16, true, true)
+	}
+	if t.Value != nil {
+		v.StringLength(validate.Field(path, "value"), *t.Value, 0, 200, true, true)
+	}
+}
+
+func (t *CustomParameters) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CustomParameters)
+	}
+	for i, e := range t.Parameters {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "parameters"), i), op)
+		}
+	}
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *Label) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Label)
+	}
+	if validate.HasOperator(op, "REMOVE", "SET") {
+		v.Required(validate.Field(path, "id"), t.Id != nil)
+	}
+	if t.Name != nil {
+		v.StringLength(validate.Field(path, "name"), *t.Name, 1, 80, false, false)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "status"), op, t.Status != nil)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
+
+func (o *CampaignLabelOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *CampaignLabelOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	return validate.ID(t.CampaignId, t.LabelId)
+}
+
+func (o *CampaignOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *CampaignOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	return validate.ID(t.Id)
+}
@@ -0,0 +1,53 @@
+package CampaignService
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+	"github.com/godofdream/go-googleadsinofficial/validate"
+)
+
+func TestCampaignDates(t *testing.T) {
+	c := new(Campaign)
+	if d, err := c.GetStartDate(); err != nil || !d.IsZero() {
+		t.Errorf("unset start date: got %v, %v", d, err)
+	}
+	c.SetStartDate(datetime.Date{Year: 2018, Month: time.March, Day: 1})
+	if StringValue(c.StartDate) != "20180301" {
+		t.Errorf("got start date %q, want 20180301", StringValue(c.StartDate))
+	}
+	if d, err := c.GetStartDate(); err != nil || d.String() != "20180301" {
+		t.Errorf("got %v, %v", d, err)
+	}
+}
+
+func TestValidateDates(t *testing.T) {
+	tests := []struct {
+		start string
+		valid bool
+	}{
+		{"20180301", true},
+		{"2018-03-01", false},
+		{"20180230", false},
+	}
+	for _, tt := range tests {
+		op := AddOp(&Campaign{Name: String("Campaign"), StartDate: String(tt.start)})
+		err := op.Validate()
+		var errs validate.Errors
+		invalid := errors.As(err, &errs) && containsPath(errs, "operand.startDate")
+		if invalid == tt.valid {
+			t.Errorf("start date %q: got %v", tt.start, err)
+		}
+	}
+}
+
+func containsPath(errs validate.Errors, path string) bool {
+	for _, e := range errs {
+		if e.Path == path {
+			return true
+		}
+	}
+	return false
+}
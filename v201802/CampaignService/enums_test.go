@@ -0,0 +1,56 @@
+package CampaignService
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestUnmarshalUnknownEnum(t *testing.T) {
+	var c Campaign
+	data := `<Campaign xmlns="https://adwords.google.com/api/adwords/cm/v201802">` +
+		`<status>PAUSED</status><advertisingChannelType>LOCAL_SERVICES</advertisingChannelType>` +
+		`</Campaign>`
+	if err := xml.Unmarshal([]byte(data), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Status == nil || *c.Status != CampaignStatusPAUSED {
+		t.Errorf("got status %v, want PAUSED", c.Status)
+	}
+	if c.AdvertisingChannelType == nil || *c.AdvertisingChannelType != AdvertisingChannelTypeUNKNOWN {
+		t.Errorf("got advertisingChannelType %v, want UNKNOWN", c.AdvertisingChannelType)
+	}
+	if _, err := ParseAdvertisingChannelType("LOCAL_SERVICES"); err == nil {
+		t.Error("ParseAdvertisingChannelType accepted LOCAL_SERVICES")
+	}
+}
+
+func TestEnumRoundTrip(t *testing.T) {
+	var c Campaign
+	data := `<Campaign xmlns="https://adwords.google.com/api/adwords/cm/v201802">` +
+		`<servingStatus>PAUSED_BY_SYSTEM</servingStatus><advertisingChannelType>LOCAL_SERVICES</advertisingChannelType>` +
+		`</Campaign>`
+	if err := xml.Unmarshal([]byte(data), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.ServingStatus == nil || *c.ServingStatus != "PAUSED_BY_SYSTEM" || c.ServingStatus.IsValid() {
+		t.Fatalf("got servingStatus %v, want the unknown PAUSED_BY_SYSTEM", c.ServingStatus)
+	}
+	// Values kept by UnmarshalText are sent back as they are, values mapped
+	// to UNKNOWN as UNKNOWN.
+	out, err := xml.Marshal(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again Campaign
+	if err = xml.Unmarshal(out, &again); err != nil {
+		t.Fatal(err)
+	}
+	if *again.ServingStatus != *c.ServingStatus || *again.AdvertisingChannelType != AdvertisingChannelTypeUNKNOWN {
+		t.Errorf("got %v, %v after a round trip of %s", *again.ServingStatus, *again.AdvertisingChannelType, out)
+	}
+
+	// Enums with UNKNOWN reject values they don't know.
+	if _, err := AdvertisingChannelType("LOCAL_SERVICES").MarshalText(); err == nil {
+		t.Error("AdvertisingChannelType marshaled LOCAL_SERVICES")
+	}
+}
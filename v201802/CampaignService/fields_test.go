@@ -0,0 +1,87 @@
+package CampaignService
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestTypeFields checks the metadata of fields against their annotations in
+// CampaignService.go.
+func TestTypeFields(t *testing.T) {
+	want := map[FieldKey]FieldInfo{
+		// Selectable and filterable, read only for ADD.
+		{"Campaign", "Id"}: {Type: "Campaign", Name: "Id", Selectors: []string{"Id"}, Filter: "Id",
+			ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		// Selectable and filterable, without further constraints.
+		{"Campaign", "Name"}: {Type: "Campaign", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		// Read only for any operator.
+		{"Campaign", "ServingStatus"}: {Type: "Campaign", Name: "ServingStatus", Selectors: []string{"ServingStatus"},
+			Filter: "ServingStatus", ReadOnly: true},
+		// Selectable, but not filterable.
+		{"Campaign", "AdServingOptimizationStatus"}: {Type: "Campaign", Name: "AdServingOptimizationStatus",
+			Selectors: []string{"AdServingOptimizationStatus"}},
+		// Read only for several operators.
+		{"Campaign", "Labels"}: {Type: "Campaign", Name: "Labels", Selectors: []string{"Labels"}, Filter: "Labels",
+			ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		// Neither selectable nor constrained.
+		{"Campaign", "Budget"}: {Type: "Campaign", Name: "Budget"},
+		// Required for some operators.
+		{"CampaignLabel", "CampaignId"}: {Type: "CampaignLabel", Name: "CampaignId", Required: true,
+			RequiredOperators: []string{"ADD", "REMOVE"}},
+		// Required for any operator.
+		{"Get", "ServiceSelector"}: {Type: "Get", Name: "ServiceSelector", Required: true},
+	}
+	got := make(map[FieldKey]FieldInfo)
+	for _, typeName := range []string{"Campaign", "CampaignLabel", "Get"} {
+		for _, f := range TypeFields(typeName) {
+			got[FieldKey{f.Type, f.Name}] = f
+		}
+	}
+	for key, w := range want {
+		if f, ok := got[key]; !ok || !reflect.DeepEqual(f, w) {
+			t.Errorf("got %+v for %v, want %+v", f, key, w)
+		}
+	}
+	if f := got[FieldKey{"Campaign", "Name"}]; !f.Selectable() || !f.Filterable() {
+		t.Errorf("Campaign.Name is not selectable and filterable")
+	}
+	if f := got[FieldKey{"Campaign", "AdServingOptimizationStatus"}]; !f.Selectable() || f.Filterable() {
+		t.Errorf("Campaign.AdServingOptimizationStatus is filterable or not selectable")
+	}
+	if TypeFields("NoSuchType") != nil {
+		t.Errorf("got fields of an unknown type")
+	}
+}
+
+func TestInheritedFields(t *testing.T) {
+	fields := TypeFields("ManualCpcBiddingScheme")
+	if len(fields) != 2 || fields[0].Type != "BiddingScheme" || fields[0].Name != "BiddingSchemeType" ||
+		fields[1].Type != "ManualCpcBiddingScheme" || fields[1].Filter != "EnhancedCpcEnabled" {
+		t.Errorf("got fields %+v, want the inherited field first", fields)
+	}
+}
+
+func TestLookupField(t *testing.T) {
+	fields := LookupField(CampaignFields.AdvertisingChannelType)
+	if len(fields) != 1 || fields[0].Type != "Campaign" || fields[0].Name != "AdvertisingChannelType" ||
+		!reflect.DeepEqual(fields[0].ReadOnlyOperators, []string{"SET"}) {
+		t.Errorf("got fields %+v", fields)
+	}
+	// The constants include the selectable fields of the types a campaign
+	// holds, by their selector names.
+	for name, want := range map[string]string{
+		CampaignFields.Id:                 "Id",
+		CampaignFields.BudgetId:           "BudgetId",
+		CampaignFields.EnhancedCpcEnabled: "EnhancedCpcEnabled",
+	} {
+		if name != want {
+			t.Errorf("got constant %q, want %q", name, want)
+		}
+		if len(LookupField(name)) == 0 {
+			t.Errorf("no field is selectable by %q", name)
+		}
+	}
+	if fields := LookupField("CampaignNam"); fields != nil {
+		t.Errorf("got fields %+v for a misspelled name", fields)
+	}
+}
@@ -0,0 +1,58 @@
+package CampaignService
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestOps(t *testing.T) {
+	c := &Campaign{Id: Int64(1), Name: String("Shoes")}
+	for _, tt := range []struct {
+		op   *CampaignOperation
+		want Operator
+	}{
+		{AddOp(c), OperatorADD},
+		{SetOp(c), OperatorSET},
+	} {
+		if tt.op.Operation == nil || tt.op.Operator == nil || *tt.op.Operator != tt.want {
+			t.Errorf("got operation %+v, want %s", tt.op.Operation, tt.want)
+		}
+		if tt.op.Operand != c {
+			t.Errorf("%s: got operand %+v, want the campaign passed", tt.want, tt.op.Operand)
+		}
+	}
+
+	// Each operation has an operator of its own.
+	add, set := AddOp(c), SetOp(c)
+	*add.Operator = OperatorREMOVE
+	if *set.Operator != OperatorSET || *AddOp(c).Operator != OperatorADD {
+		t.Error("operations share their operator")
+	}
+}
+
+func TestLabelOps(t *testing.T) {
+	label := &CampaignLabel{CampaignId: Int64(1), LabelId: Int64(2)}
+	add, remove := AddLabelOp(label), RemoveLabelOp(label)
+	if *add.Operator != OperatorADD || add.Operand != label {
+		t.Errorf("got %v of %+v, want ADD", *add.Operator, add.Operand)
+	}
+	if *remove.Operator != OperatorREMOVE || remove.Operand != label {
+		t.Errorf("got %v of %+v, want REMOVE", *remove.Operator, remove.Operand)
+	}
+}
+
+func TestOpsXML(t *testing.T) {
+	b, err := xml.Marshal(&Mutate{Operations: []*CampaignOperation{SetOp(&Campaign{Id: Int64(1), Name: String("Shoes")})}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`>SET</operator>`, `>1</id>`, `>Shoes</name>`} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("operation lacks %s: %s", want, b)
+		}
+	}
+	if strings.Index(string(b), "operator>") > strings.Index(string(b), "operand") {
+		t.Errorf("the operand precedes the operator: %s", b)
+	}
+}
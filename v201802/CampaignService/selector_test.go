@@ -0,0 +1,32 @@
+package CampaignService
+
+import (
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+func TestConvertSelector(t *testing.T) {
+	sel, err := ConvertSelector(&selector.Selector{
+		Fields:     []string{"Id"},
+		Predicates: []*selector.Predicate{{Field: "Status", Operator: selector.In, Values: []string{"ENABLED"}}},
+		DateRange:  &selector.DateRange{Max: "20180131"},
+		Paging:     &selector.Paging{StartIndex: 10, NumberResults: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sel.DateRange.Min != nil || StringValue(sel.DateRange.Max) != "20180131" {
+		t.Errorf("got date range %v-%v, want an open start", sel.DateRange.Min, sel.DateRange.Max)
+	}
+	if *sel.Predicates[0].Operator != PredicateOperatorIN || *sel.Paging.StartIndex != 10 || *sel.Paging.NumberResults != 5 {
+		t.Errorf("got predicate %+v, paging %+v", sel.Predicates[0], sel.Paging)
+	}
+	if back := sel.Neutral(); back.DateRange.Min != "" || back.DateRange.Max != "20180131" {
+		t.Errorf("got neutral date range %+v", back.DateRange)
+	}
+
+	if _, err := ConvertSelector(&selector.Selector{Fields: []string{"Id"}, Ordering: []*selector.OrderBy{{Field: "Id", SortOrder: "RANDOM"}}}); err == nil {
+		t.Error("converted the unknown sort order RANDOM")
+	}
+}
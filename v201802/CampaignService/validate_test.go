@@ -0,0 +1,24 @@
+package CampaignService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/godofdream/go-googleadsinofficial/validate"
+)
+
+func TestValidateReadOnly(t *testing.T) {
+	// A campaign as returned by Get.
+	fetched := func() *Campaign {
+		serving := ServingStatusSERVING
+		return &Campaign{Id: Int64(1), Name: String("Campaign"), ServingStatus: &serving}
+	}
+	if err := SetOp(fetched()).Validate(); err != nil {
+		t.Errorf("SET of a fetched campaign: %v", err)
+	}
+	err := AddOp(fetched()).Validate()
+	var errs validate.Errors
+	if !errors.As(err, &errs) || !containsPath(errs, "operand.servingStatus") {
+		t.Errorf("ADD with a serving status: got %v", err)
+	}
+}
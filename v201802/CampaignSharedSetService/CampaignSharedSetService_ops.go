@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CampaignSharedSetService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CampaignSharedSet) *CampaignSharedSetOperation {
+	op := OperatorADD
+	return &CampaignSharedSetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CampaignSharedSet) *CampaignSharedSetOperation {
+	op := OperatorREMOVE
+	return &CampaignSharedSetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -1328,373 +1328,333 @@ const (
 )
 
 type GetAgeRangeCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getAgeRangeCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getAgeRangeCriterion" json:"-"`
 }
 
 type GetAgeRangeCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getAgeRangeCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getAgeRangeCriterionResponse" json:"-"`
 
-	Rval []*AgeRange `xml:"rval,omitempty"`
+	Rval []*AgeRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetCarrierCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCarrierCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCarrierCriterion" json:"-"`
 }
 
 type GetCarrierCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCarrierCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCarrierCriterionResponse" json:"-"`
 
-	Rval []*Carrier `xml:"rval,omitempty"`
+	Rval []*Carrier `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetGenderCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getGenderCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getGenderCriterion" json:"-"`
 }
 
 type GetGenderCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getGenderCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getGenderCriterionResponse" json:"-"`
 
-	Rval []*Gender `xml:"rval,omitempty"`
+	Rval []*Gender `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetLanguageCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getLanguageCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getLanguageCriterion" json:"-"`
 }
 
 type GetLanguageCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getLanguageCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getLanguageCriterionResponse" json:"-"`
 
-	Rval []*Language `xml:"rval,omitempty"`
+	Rval []*Language `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetMobileAppCategoryCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getMobileAppCategoryCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getMobileAppCategoryCriterion" json:"-"`
 }
 
 type GetMobileAppCategoryCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getMobileAppCategoryCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getMobileAppCategoryCriterionResponse" json:"-"`
 
-	Rval []*MobileAppCategory `xml:"rval,omitempty"`
+	Rval []*MobileAppCategory `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetMobileDeviceCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getMobileDeviceCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getMobileDeviceCriterion" json:"-"`
 }
 
 type GetMobileDeviceCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getMobileDeviceCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getMobileDeviceCriterionResponse" json:"-"`
 
-	Rval []*MobileDevice `xml:"rval,omitempty"`
+	Rval []*MobileDevice `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetOperatingSystemVersionCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getOperatingSystemVersionCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getOperatingSystemVersionCriterion" json:"-"`
 }
 
 type GetOperatingSystemVersionCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getOperatingSystemVersionCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getOperatingSystemVersionCriterionResponse" json:"-"`
 
-	Rval []*OperatingSystemVersion `xml:"rval,omitempty"`
+	Rval []*OperatingSystemVersion `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetProductBiddingCategoryData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getProductBiddingCategoryData"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getProductBiddingCategoryData" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Selector *Selector `xml:"selector,omitempty"`
+	Selector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 selector,omitempty" json:"selector,omitempty"`
 }
 
 type GetProductBiddingCategoryDataResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getProductBiddingCategoryDataResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getProductBiddingCategoryDataResponse" json:"-"`
 
-	Rval []*ProductBiddingCategoryData `xml:"rval,omitempty"`
+	Rval []*ProductBiddingCategoryData `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetUserInterestCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getUserInterestCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getUserInterestCriterion" json:"-"`
 
-	UserInterestTaxonomyType *ConstantDataServiceUserInterestTaxonomyType `xml:"userInterestTaxonomyType,omitempty"`
+	UserInterestTaxonomyType *ConstantDataServiceUserInterestTaxonomyType `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestTaxonomyType,omitempty" json:"userInterestTaxonomyType,omitempty"`
 }
 
 type GetUserInterestCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getUserInterestCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getUserInterestCriterionResponse" json:"-"`
 
-	Rval []*CriterionUserInterest `xml:"rval,omitempty"`
+	Rval []*CriterionUserInterest `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetVerticalCriterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getVerticalCriterion"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getVerticalCriterion" json:"-"`
 }
 
 type GetVerticalCriterionResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getVerticalCriterionResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getVerticalCriterionResponse" json:"-"`
 
-	Rval []*Vertical `xml:"rval,omitempty"`
+	Rval []*Vertical `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AgeRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AgeRange"`
-
 	*Criterion
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	AgeRangeType *AgeRangeAgeRangeType `xml:"ageRangeType,omitempty"`
+	AgeRangeType *AgeRangeAgeRangeType `xml:"https://adwords.google.com/api/adwords/cm/v201802 ageRangeType,omitempty" json:"ageRangeType,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Carrier struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Carrier"`
-
 	*Criterion
 
 	//
 	// Name of the carrier.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Country code of the carrier.
 	// Can be {@code null} if not applicable, e.g., for Carrier "Wifi".
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CountryCode string `xml:"countryCode,omitempty"`
+	CountryCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 countryCode,omitempty" json:"countryCode,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ConstantData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConstantData"`
-
 	//
 	// Indicates that this instance is a subtype of ConstantData.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ConstantDataType string `xml:"ConstantData.Type,omitempty"`
+	ConstantDataType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConstantData.Type,omitempty" json:"constantDataType,omitempty"`
 }
 
 type Criterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion"`
-
 	//
 	// ID of this criterion.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Type_ *CriterionType `xml:"type,omitempty"`
+	Type_ *CriterionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Criterion.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CriterionType string `xml:"Criterion.Type,omitempty"`
+	CriterionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion.Type,omitempty" json:"criterionType,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type Gender struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Gender"`
-
 	*Criterion
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	GenderType *GenderGenderType `xml:"genderType,omitempty"`
+	GenderType *GenderGenderType `xml:"https://adwords.google.com/api/adwords/cm/v201802 genderType,omitempty" json:"genderType,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Keyword struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Keyword"`
-
 	*Criterion
 
 	//
@@ -1702,34 +1662,30 @@ type Keyword struct {
 	// <span class="constraint MatchesRegex">Keyword text must not contain NUL (code point 0x0) characters. This is checked by the regular expression '[^\x00]*'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Text string `xml:"text,omitempty"`
+	Text *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 text,omitempty" json:"text,omitempty"`
 
 	//
 	// Match type of this keyword.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MatchType *KeywordMatchType `xml:"matchType,omitempty"`
+	MatchType *KeywordMatchType `xml:"https://adwords.google.com/api/adwords/cm/v201802 matchType,omitempty" json:"matchType,omitempty"`
 }
 
 type Language struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Language"`
-
 	*Criterion
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Code string `xml:"code,omitempty"`
+	Code *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 code,omitempty" json:"code,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type MobileAppCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileAppCategory"`
-
 	*Criterion
 
 	//
@@ -1737,18 +1693,16 @@ type MobileAppCategory struct {
 	// available <a href="/adwords/api/docs/appendix/mobileappcategories">here</a>.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MobileAppCategoryId int32 `xml:"mobileAppCategoryId,omitempty"`
+	MobileAppCategoryId *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 mobileAppCategoryId,omitempty" json:"mobileAppCategoryId,omitempty"`
 
 	//
 	// Name of this mobile app category.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type MobileApplication struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileApplication"`
-
 	*Criterion
 
 	//
@@ -1766,148 +1720,130 @@ type MobileApplication struct {
 	// "2-com.labpixies.colordrips" for Android.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// Title of this mobile application.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type MobileDevice struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileDevice"`
-
 	*Criterion
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DeviceName string `xml:"deviceName,omitempty"`
+	DeviceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 deviceName,omitempty" json:"deviceName,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ManufacturerName string `xml:"manufacturerName,omitempty"`
+	ManufacturerName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 manufacturerName,omitempty" json:"manufacturerName,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DeviceType *MobileDeviceDeviceType `xml:"deviceType,omitempty"`
+	DeviceType *MobileDeviceDeviceType `xml:"https://adwords.google.com/api/adwords/cm/v201802 deviceType,omitempty" json:"deviceType,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "OperatingSystemName".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	OperatingSystemName string `xml:"operatingSystemName,omitempty"`
+	OperatingSystemName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 operatingSystemName,omitempty" json:"operatingSystemName,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatingSystemVersion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatingSystemVersion"`
-
 	*Criterion
 
 	//
 	// The name of the operating system.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// The OS Major Version number.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	OsMajorVersion int32 `xml:"osMajorVersion,omitempty"`
+	OsMajorVersion *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 osMajorVersion,omitempty" json:"osMajorVersion,omitempty"`
 
 	//
 	// The OS Minor Version number.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	OsMinorVersion int32 `xml:"osMinorVersion,omitempty"`
+	OsMinorVersion *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 osMinorVersion,omitempty" json:"osMinorVersion,omitempty"`
 
 	//
 	// The operator type.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	OperatorType *OperatingSystemVersionOperatorType `xml:"operatorType,omitempty"`
+	OperatorType *OperatingSystemVersionOperatorType `xml:"https://adwords.google.com/api/adwords/cm/v201802 operatorType,omitempty" json:"operatorType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Placement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Placement"`
-
 	*Criterion
 
 	//
@@ -1916,12 +1852,10 @@ type Placement struct {
 	// <p>For example, "http://www.domain.com".
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -1929,13 +1863,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -1944,34 +1878,28 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type ProductAdwordsGrouping struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductAdwordsGrouping"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductAdwordsLabels struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductAdwordsLabels"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductBiddingCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductBiddingCategory"`
-
 	*ProductDimension
 
 	//
@@ -1980,95 +1908,81 @@ type ProductBiddingCategory struct {
 	// <span class="constraint OneOf">The value must be one of {BIDDING_CATEGORY_L1, BIDDING_CATEGORY_L2, BIDDING_CATEGORY_L3, BIDDING_CATEGORY_L4, BIDDING_CATEGORY_L5}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// ID of the product category.
 	// <span class="constraint Filterable">This field can be filtered on using the value "ParentDimensionId".</span>
 	//
-	Value int64 `xml:"value,omitempty"`
+	Value *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductBiddingCategoryData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductBiddingCategoryData"`
-
 	*ConstantData
 
 	//
 	// The dimension value that corresponds to this category.
 	//
-	DimensionValue *ProductBiddingCategory `xml:"dimensionValue,omitempty"`
+	DimensionValue *ProductBiddingCategory `xml:"https://adwords.google.com/api/adwords/cm/v201802 dimensionValue,omitempty" json:"dimensionValue,omitempty"`
 
 	//
 	// The dimension value that corresponds to parent category.
 	//
-	ParentDimensionValue *ProductBiddingCategory `xml:"parentDimensionValue,omitempty"`
+	ParentDimensionValue *ProductBiddingCategory `xml:"https://adwords.google.com/api/adwords/cm/v201802 parentDimensionValue,omitempty" json:"parentDimensionValue,omitempty"`
 
 	//
 	// The country of the taxonomy. It applies to all categories from the taxonomy.
 	// <span class="constraint Selectable">This field can be selected using the value "Country".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Country string `xml:"country,omitempty"`
+	Country *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 country,omitempty" json:"country,omitempty"`
 
 	//
 	// The status of the taxonomy. It applies to all categories from the taxonomy.
 	// <span class="constraint Selectable">This field can be selected using the value "BiddingCategoryStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Status *ShoppingBiddingDimensionStatus `xml:"status,omitempty"`
+	Status *ShoppingBiddingDimensionStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// A map of displayValues by their language code. The language code is a 2-letter string
 	// conforming with ISO 639-1 standard.
 	//
-	DisplayValue []*String_StringMapEntry `xml:"displayValue,omitempty"`
+	DisplayValue []*String_StringMapEntry `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayValue,omitempty" json:"displayValue,omitempty"`
 }
 
 type ProductBrand struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductBrand"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductCanonicalCondition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductCanonicalCondition"`
-
 	*ProductDimension
 
-	Condition *ProductCanonicalConditionCondition `xml:"condition,omitempty"`
+	Condition *ProductCanonicalConditionCondition `xml:"https://adwords.google.com/api/adwords/cm/v201802 condition,omitempty" json:"condition,omitempty"`
 }
 
 type ProductChannel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductChannel"`
-
 	*ProductDimension
 
-	Channel *ShoppingProductChannel `xml:"channel,omitempty"`
+	Channel *ShoppingProductChannel `xml:"https://adwords.google.com/api/adwords/cm/v201802 channel,omitempty" json:"channel,omitempty"`
 }
 
 type ProductChannelExclusivity struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductChannelExclusivity"`
-
 	*ProductDimension
 
-	ChannelExclusivity *ShoppingProductChannelExclusivity `xml:"channelExclusivity,omitempty"`
+	ChannelExclusivity *ShoppingProductChannelExclusivity `xml:"https://adwords.google.com/api/adwords/cm/v201802 channelExclusivity,omitempty" json:"channelExclusivity,omitempty"`
 }
 
 type ProductLegacyCondition struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductLegacyCondition"`
-
 	*ProductDimension
 
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductCustomAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductCustomAttribute"`
-
 	*ProductDimension
 
 	//
@@ -2076,39 +1990,33 @@ type ProductCustomAttribute struct {
 	// <span class="constraint OneOf">The value must be one of {CUSTOM_ATTRIBUTE_0, CUSTOM_ATTRIBUTE_1, CUSTOM_ATTRIBUTE_2, CUSTOM_ATTRIBUTE_3, CUSTOM_ATTRIBUTE_4}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductDimension struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductDimension"`
-
 	//
 	// Indicates that this instance is a subtype of ProductDimension.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ProductDimensionType string `xml:"ProductDimension.Type,omitempty"`
+	ProductDimensionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductDimension.Type,omitempty" json:"productDimensionType,omitempty"`
 }
 
 type ProductOfferId struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductOfferId"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductType struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductType"`
-
 	*ProductDimension
 
 	//
@@ -2116,114 +2024,96 @@ type ProductType struct {
 	// <span class="constraint OneOf">The value must be one of {PRODUCT_TYPE_L1, PRODUCT_TYPE_L2, PRODUCT_TYPE_L3, PRODUCT_TYPE_L4, PRODUCT_TYPE_L5}.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Type_ *ProductDimensionType `xml:"type,omitempty"`
+	Type_ *ProductDimensionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// <span class="constraint StringLength">This string must not be empty, (trimmed).</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ProductTypeFull struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ProductTypeFull"`
-
 	*ProductDimension
 
 	//
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -2234,13 +2124,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -2249,58 +2139,54 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -2308,12 +2194,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -2321,138 +2207,124 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type String_StringMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 String_StringMapEntry"`
-
-	Key string `xml:"key,omitempty"`
+	Key *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type UnknownProductDimension struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UnknownProductDimension"`
-
 	*ProductDimension
 }
 
 type CriterionUserInterest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserInterest"`
-
 	*Criterion
 
 	//
 	// Id of this user interest. This is a required field.
 	//
-	UserInterestId int64 `xml:"userInterestId,omitempty"`
+	UserInterestId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestId,omitempty" json:"userInterestId,omitempty"`
 
 	//
 	// Parent Id of this user interest.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserInterestParentId int64 `xml:"userInterestParentId,omitempty"`
+	UserInterestParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestParentId,omitempty" json:"userInterestParentId,omitempty"`
 
 	//
 	// Name of this user interest.
 	//
-	UserInterestName string `xml:"userInterestName,omitempty"`
+	UserInterestName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestName,omitempty" json:"userInterestName,omitempty"`
 }
 
 type CriterionUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserList"`
-
 	*Criterion
 
 	//
 	// Id of this user list. This is a required field.
 	//
-	UserListId int64 `xml:"userListId,omitempty"`
+	UserListId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListId,omitempty" json:"userListId,omitempty"`
 
-	UserListName string `xml:"userListName,omitempty"`
+	UserListName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListName,omitempty" json:"userListName,omitempty"`
 
-	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"userListMembershipStatus,omitempty"`
+	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListMembershipStatus,omitempty" json:"userListMembershipStatus,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the google.com
 	// (search) network.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForSearch bool `xml:"userListEligibleForSearch,omitempty"`
+	UserListEligibleForSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForSearch,omitempty" json:"userListEligibleForSearch,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the display network.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForDisplay bool `xml:"userListEligibleForDisplay,omitempty"`
+	UserListEligibleForDisplay *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForDisplay,omitempty" json:"userListEligibleForDisplay,omitempty"`
 }
 
 type Vertical struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Vertical"`
-
 	*Criterion
 
 	//
 	// Id of this vertical.
 	//
-	VerticalId int64 `xml:"verticalId,omitempty"`
+	VerticalId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalId,omitempty" json:"verticalId,omitempty"`
 
 	//
 	// Id of the parent of this vertical.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	VerticalParentId int64 `xml:"verticalParentId,omitempty"`
+	VerticalParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalParentId,omitempty" json:"verticalParentId,omitempty"`
 
 	//
 	// The category to target or exclude. Each subsequent element in the array
@@ -2462,7 +2334,7 @@ type Vertical struct {
 	// is available <a href="/adwords/api/docs/appendix/verticals">here</a>
 	// This field is required and must not be empty.
 	//
-	Path []string `xml:"path,omitempty"`
+	Path []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 path,omitempty" json:"path,omitempty"`
 }
 
 type ConstantDataServiceInterface struct {
@@ -0,0 +1,274 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConstantDataService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ConstantDataServiceAPI is the interface of the ConstantDataService operations. It is
+// implemented by ConstantDataServiceInterface and, for tests, by FakeConstantDataService.
+type ConstantDataServiceAPI interface {
+	GetAgeRangeCriterion(request *GetAgeRangeCriterion) (*GetAgeRangeCriterionResponse, error)
+	GetCarrierCriterion(request *GetCarrierCriterion) (*GetCarrierCriterionResponse, error)
+	GetGenderCriterion(request *GetGenderCriterion) (*GetGenderCriterionResponse, error)
+	GetLanguageCriterion(request *GetLanguageCriterion) (*GetLanguageCriterionResponse, error)
+	GetMobileAppCategoryCriterion(request *GetMobileAppCategoryCriterion) (*GetMobileAppCategoryCriterionResponse, error)
+	GetMobileDeviceCriterion(request *GetMobileDeviceCriterion) (*GetMobileDeviceCriterionResponse, error)
+	GetOperatingSystemVersionCriterion(request *GetOperatingSystemVersionCriterion) (*GetOperatingSystemVersionCriterionResponse, error)
+	GetProductBiddingCategoryData(request *GetProductBiddingCategoryData) (*GetProductBiddingCategoryDataResponse, error)
+	GetUserInterestCriterion(request *GetUserInterestCriterion) (*GetUserInterestCriterionResponse, error)
+	GetVerticalCriterion(request *GetVerticalCriterion) (*GetVerticalCriterionResponse, error)
+}
+
+var _ ConstantDataServiceAPI = (*ConstantDataServiceInterface)(nil)
+var _ ConstantDataServiceAPI = (*FakeConstantDataService)(nil)
+
+// FakeCall is a call recorded by FakeConstantDataService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "GetAgeRangeCriterion".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeConstantDataService is a programmable ConstantDataServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeConstantDataService struct {
+	GetAgeRangeCriterionFunc               func(request *GetAgeRangeCriterion) (*GetAgeRangeCriterionResponse, error)
+	GetCarrierCriterionFunc                func(request *GetCarrierCriterion) (*GetCarrierCriterionResponse, error)
+	GetGenderCriterionFunc                 func(request *GetGenderCriterion) (*GetGenderCriterionResponse, error)
+	GetLanguageCriterionFunc               func(request *GetLanguageCriterion) (*GetLanguageCriterionResponse, error)
+	GetMobileAppCategoryCriterionFunc      func(request *GetMobileAppCategoryCriterion) (*GetMobileAppCategoryCriterionResponse, error)
+	GetMobileDeviceCriterionFunc           func(request *GetMobileDeviceCriterion) (*GetMobileDeviceCriterionResponse, error)
+	GetOperatingSystemVersionCriterionFunc func(request *GetOperatingSystemVersionCriterion) (*GetOperatingSystemVersionCriterionResponse, error)
+	GetProductBiddingCategoryDataFunc      func(request *GetProductBiddingCategoryData) (*GetProductBiddingCategoryDataResponse, error)
+	GetUserInterestCriterionFunc           func(request *GetUserInterestCriterion) (*GetUserInterestCriterionResponse, error)
+	GetVerticalCriterionFunc               func(request *GetVerticalCriterion) (*GetVerticalCriterionResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeConstantDataService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeConstantDataService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeConstantDataService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// GetAgeRangeCriterion records the call and delegates to GetAgeRangeCriterionFunc.
+func (f *FakeConstantDataService) GetAgeRangeCriterion(request *GetAgeRangeCriterion) (*GetAgeRangeCriterionResponse, error) {
+	f.record("GetAgeRangeCriterion", request)
+	if f.GetAgeRangeCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetAgeRangeCriterionFunc is not set")
+	}
+	return f.GetAgeRangeCriterionFunc(request)
+}
+
+// GetAgeRangeCriterionCalls returns the requests of the recorded GetAgeRangeCriterion calls.
+func (f *FakeConstantDataService) GetAgeRangeCriterionCalls() []*GetAgeRangeCriterion {
+	var requests []*GetAgeRangeCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetAgeRangeCriterion" {
+			requests = append(requests, c.Request.(*GetAgeRangeCriterion))
+		}
+	}
+	return requests
+}
+
+// GetCarrierCriterion records the call and delegates to GetCarrierCriterionFunc.
+func (f *FakeConstantDataService) GetCarrierCriterion(request *GetCarrierCriterion) (*GetCarrierCriterionResponse, error) {
+	f.record("GetCarrierCriterion", request)
+	if f.GetCarrierCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetCarrierCriterionFunc is not set")
+	}
+	return f.GetCarrierCriterionFunc(request)
+}
+
+// GetCarrierCriterionCalls returns the requests of the recorded GetCarrierCriterion calls.
+func (f *FakeConstantDataService) GetCarrierCriterionCalls() []*GetCarrierCriterion {
+	var requests []*GetCarrierCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetCarrierCriterion" {
+			requests = append(requests, c.Request.(*GetCarrierCriterion))
+		}
+	}
+	return requests
+}
+
+// GetGenderCriterion records the call and delegates to GetGenderCriterionFunc.
+func (f *FakeConstantDataService) GetGenderCriterion(request *GetGenderCriterion) (*GetGenderCriterionResponse, error) {
+	f.record("GetGenderCriterion", request)
+	if f.GetGenderCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetGenderCriterionFunc is not set")
+	}
+	return f.GetGenderCriterionFunc(request)
+}
+
+// GetGenderCriterionCalls returns the requests of the recorded GetGenderCriterion calls.
+func (f *FakeConstantDataService) GetGenderCriterionCalls() []*GetGenderCriterion {
+	var requests []*GetGenderCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetGenderCriterion" {
+			requests = append(requests, c.Request.(*GetGenderCriterion))
+		}
+	}
+	return requests
+}
+
+// GetLanguageCriterion records the call and delegates to GetLanguageCriterionFunc.
+func (f *FakeConstantDataService) GetLanguageCriterion(request *GetLanguageCriterion) (*GetLanguageCriterionResponse, error) {
+	f.record("GetLanguageCriterion", request)
+	if f.GetLanguageCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetLanguageCriterionFunc is not set")
+	}
+	return f.GetLanguageCriterionFunc(request)
+}
+
+// GetLanguageCriterionCalls returns the requests of the recorded GetLanguageCriterion calls.
+func (f *FakeConstantDataService) GetLanguageCriterionCalls() []*GetLanguageCriterion {
+	var requests []*GetLanguageCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetLanguageCriterion" {
+			requests = append(requests, c.Request.(*GetLanguageCriterion))
+		}
+	}
+	return requests
+}
+
+// GetMobileAppCategoryCriterion records the call and delegates to GetMobileAppCategoryCriterionFunc.
+func (f *FakeConstantDataService) GetMobileAppCategoryCriterion(request *GetMobileAppCategoryCriterion) (*GetMobileAppCategoryCriterionResponse, error) {
+	f.record("GetMobileAppCategoryCriterion", request)
+	if f.GetMobileAppCategoryCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetMobileAppCategoryCriterionFunc is not set")
+	}
+	return f.GetMobileAppCategoryCriterionFunc(request)
+}
+
+// GetMobileAppCategoryCriterionCalls returns the requests of the recorded GetMobileAppCategoryCriterion calls.
+func (f *FakeConstantDataService) GetMobileAppCategoryCriterionCalls() []*GetMobileAppCategoryCriterion {
+	var requests []*GetMobileAppCategoryCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetMobileAppCategoryCriterion" {
+			requests = append(requests, c.Request.(*GetMobileAppCategoryCriterion))
+		}
+	}
+	return requests
+}
+
+// GetMobileDeviceCriterion records the call and delegates to GetMobileDeviceCriterionFunc.
+func (f *FakeConstantDataService) GetMobileDeviceCriterion(request *GetMobileDeviceCriterion) (*GetMobileDeviceCriterionResponse, error) {
+	f.record("GetMobileDeviceCriterion", request)
+	if f.GetMobileDeviceCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetMobileDeviceCriterionFunc is not set")
+	}
+	return f.GetMobileDeviceCriterionFunc(request)
+}
+
+// GetMobileDeviceCriterionCalls returns the requests of the recorded GetMobileDeviceCriterion calls.
+func (f *FakeConstantDataService) GetMobileDeviceCriterionCalls() []*GetMobileDeviceCriterion {
+	var requests []*GetMobileDeviceCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetMobileDeviceCriterion" {
+			requests = append(requests, c.Request.(*GetMobileDeviceCriterion))
+		}
+	}
+	return requests
+}
+
+// GetOperatingSystemVersionCriterion records the call and delegates to GetOperatingSystemVersionCriterionFunc.
+func (f *FakeConstantDataService) GetOperatingSystemVersionCriterion(request *GetOperatingSystemVersionCriterion) (*GetOperatingSystemVersionCriterionResponse, error) {
+	f.record("GetOperatingSystemVersionCriterion", request)
+	if f.GetOperatingSystemVersionCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetOperatingSystemVersionCriterionFunc is not set")
+	}
+	return f.GetOperatingSystemVersionCriterionFunc(request)
+}
+
+// GetOperatingSystemVersionCriterionCalls returns the requests of the recorded GetOperatingSystemVersionCriterion calls.
+func (f *FakeConstantDataService) GetOperatingSystemVersionCriterionCalls() []*GetOperatingSystemVersionCriterion {
+	var requests []*GetOperatingSystemVersionCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetOperatingSystemVersionCriterion" {
+			requests = append(requests, c.Request.(*GetOperatingSystemVersionCriterion))
+		}
+	}
+	return requests
+}
+
+// GetProductBiddingCategoryData records the call and delegates to GetProductBiddingCategoryDataFunc.
+func (f *FakeConstantDataService) GetProductBiddingCategoryData(request *GetProductBiddingCategoryData) (*GetProductBiddingCategoryDataResponse, error) {
+	f.record("GetProductBiddingCategoryData", request)
+	if f.GetProductBiddingCategoryDataFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetProductBiddingCategoryDataFunc is not set")
+	}
+	return f.GetProductBiddingCategoryDataFunc(request)
+}
+
+// GetProductBiddingCategoryDataCalls returns the requests of the recorded GetProductBiddingCategoryData calls.
+func (f *FakeConstantDataService) GetProductBiddingCategoryDataCalls() []*GetProductBiddingCategoryData {
+	var requests []*GetProductBiddingCategoryData
+	for _, c := range f.Calls() {
+		if c.Method == "GetProductBiddingCategoryData" {
+			requests = append(requests, c.Request.(*GetProductBiddingCategoryData))
+		}
+	}
+	return requests
+}
+
+// GetUserInterestCriterion records the call and delegates to GetUserInterestCriterionFunc.
+func (f *FakeConstantDataService) GetUserInterestCriterion(request *GetUserInterestCriterion) (*GetUserInterestCriterionResponse, error) {
+	f.record("GetUserInterestCriterion", request)
+	if f.GetUserInterestCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetUserInterestCriterionFunc is not set")
+	}
+	return f.GetUserInterestCriterionFunc(request)
+}
+
+// GetUserInterestCriterionCalls returns the requests of the recorded GetUserInterestCriterion calls.
+func (f *FakeConstantDataService) GetUserInterestCriterionCalls() []*GetUserInterestCriterion {
+	var requests []*GetUserInterestCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetUserInterestCriterion" {
+			requests = append(requests, c.Request.(*GetUserInterestCriterion))
+		}
+	}
+	return requests
+}
+
+// GetVerticalCriterion records the call and delegates to GetVerticalCriterionFunc.
+func (f *FakeConstantDataService) GetVerticalCriterion(request *GetVerticalCriterion) (*GetVerticalCriterionResponse, error) {
+	f.record("GetVerticalCriterion", request)
+	if f.GetVerticalCriterionFunc == nil {
+		return nil, fmt.Errorf("FakeConstantDataService.GetVerticalCriterionFunc is not set")
+	}
+	return f.GetVerticalCriterionFunc(request)
+}
+
+// GetVerticalCriterionCalls returns the requests of the recorded GetVerticalCriterion calls.
+func (f *FakeConstantDataService) GetVerticalCriterionCalls() []*GetVerticalCriterion {
+	var requests []*GetVerticalCriterion
+	for _, c := range f.Calls() {
+		if c.Method == "GetVerticalCriterion" {
+			requests = append(requests, c.Request.(*GetVerticalCriterion))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,897 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConstantDataService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *GetAgeRangeCriterion) Copy() *GetAgeRangeCriterion {
+	return deep.Copy(t).(*GetAgeRangeCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetAgeRangeCriterion) Equal(o *GetAgeRangeCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetAgeRangeCriterionResponse) Copy() *GetAgeRangeCriterionResponse {
+	return deep.Copy(t).(*GetAgeRangeCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetAgeRangeCriterionResponse) Equal(o *GetAgeRangeCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetCarrierCriterion) Copy() *GetCarrierCriterion {
+	return deep.Copy(t).(*GetCarrierCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetCarrierCriterion) Equal(o *GetCarrierCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetCarrierCriterionResponse) Copy() *GetCarrierCriterionResponse {
+	return deep.Copy(t).(*GetCarrierCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetCarrierCriterionResponse) Equal(o *GetCarrierCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetGenderCriterion) Copy() *GetGenderCriterion {
+	return deep.Copy(t).(*GetGenderCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetGenderCriterion) Equal(o *GetGenderCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetGenderCriterionResponse) Copy() *GetGenderCriterionResponse {
+	return deep.Copy(t).(*GetGenderCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetGenderCriterionResponse) Equal(o *GetGenderCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetLanguageCriterion) Copy() *GetLanguageCriterion {
+	return deep.Copy(t).(*GetLanguageCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetLanguageCriterion) Equal(o *GetLanguageCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetLanguageCriterionResponse) Copy() *GetLanguageCriterionResponse {
+	return deep.Copy(t).(*GetLanguageCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetLanguageCriterionResponse) Equal(o *GetLanguageCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetMobileAppCategoryCriterion) Copy() *GetMobileAppCategoryCriterion {
+	return deep.Copy(t).(*GetMobileAppCategoryCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetMobileAppCategoryCriterion) Equal(o *GetMobileAppCategoryCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetMobileAppCategoryCriterionResponse) Copy() *GetMobileAppCategoryCriterionResponse {
+	return deep.Copy(t).(*GetMobileAppCategoryCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetMobileAppCategoryCriterionResponse) Equal(o *GetMobileAppCategoryCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetMobileDeviceCriterion) Copy() *GetMobileDeviceCriterion {
+	return deep.Copy(t).(*GetMobileDeviceCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetMobileDeviceCriterion) Equal(o *GetMobileDeviceCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetMobileDeviceCriterionResponse) Copy() *GetMobileDeviceCriterionResponse {
+	return deep.Copy(t).(*GetMobileDeviceCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetMobileDeviceCriterionResponse) Equal(o *GetMobileDeviceCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetOperatingSystemVersionCriterion) Copy() *GetOperatingSystemVersionCriterion {
+	return deep.Copy(t).(*GetOperatingSystemVersionCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetOperatingSystemVersionCriterion) Equal(o *GetOperatingSystemVersionCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetOperatingSystemVersionCriterionResponse) Copy() *GetOperatingSystemVersionCriterionResponse {
+	return deep.Copy(t).(*GetOperatingSystemVersionCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetOperatingSystemVersionCriterionResponse) Equal(o *GetOperatingSystemVersionCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetProductBiddingCategoryData) Copy() *GetProductBiddingCategoryData {
+	return deep.Copy(t).(*GetProductBiddingCategoryData)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetProductBiddingCategoryData) Equal(o *GetProductBiddingCategoryData) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetProductBiddingCategoryDataResponse) Copy() *GetProductBiddingCategoryDataResponse {
+	return deep.Copy(t).(*GetProductBiddingCategoryDataResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetProductBiddingCategoryDataResponse) Equal(o *GetProductBiddingCategoryDataResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetUserInterestCriterion) Copy() *GetUserInterestCriterion {
+	return deep.Copy(t).(*GetUserInterestCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetUserInterestCriterion) Equal(o *GetUserInterestCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetUserInterestCriterionResponse) Copy() *GetUserInterestCriterionResponse {
+	return deep.Copy(t).(*GetUserInterestCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetUserInterestCriterionResponse) Equal(o *GetUserInterestCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetVerticalCriterion) Copy() *GetVerticalCriterion {
+	return deep.Copy(t).(*GetVerticalCriterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetVerticalCriterion) Equal(o *GetVerticalCriterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetVerticalCriterionResponse) Copy() *GetVerticalCriterionResponse {
+	return deep.Copy(t).(*GetVerticalCriterionResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetVerticalCriterionResponse) Equal(o *GetVerticalCriterionResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdxError) Copy() *AdxError {
+	return deep.Copy(t).(*AdxError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdxError) Equal(o *AdxError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AgeRange) Copy() *AgeRange {
+	return deep.Copy(t).(*AgeRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AgeRange) Equal(o *AgeRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Carrier) Copy() *Carrier {
+	return deep.Copy(t).(*Carrier)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Carrier) Equal(o *Carrier) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ConstantData) Copy() *ConstantData {
+	return deep.Copy(t).(*ConstantData)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ConstantData) Equal(o *ConstantData) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Criterion) Copy() *Criterion {
+	return deep.Copy(t).(*Criterion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Criterion) Equal(o *Criterion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Gender) Copy() *Gender {
+	return deep.Copy(t).(*Gender)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Gender) Equal(o *Gender) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Keyword) Copy() *Keyword {
+	return deep.Copy(t).(*Keyword)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Keyword) Equal(o *Keyword) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Language) Copy() *Language {
+	return deep.Copy(t).(*Language)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Language) Equal(o *Language) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileAppCategory) Copy() *MobileAppCategory {
+	return deep.Copy(t).(*MobileAppCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileAppCategory) Equal(o *MobileAppCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileApplication) Copy() *MobileApplication {
+	return deep.Copy(t).(*MobileApplication)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileApplication) Equal(o *MobileApplication) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MobileDevice) Copy() *MobileDevice {
+	return deep.Copy(t).(*MobileDevice)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MobileDevice) Equal(o *MobileDevice) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatingSystemVersion) Copy() *OperatingSystemVersion {
+	return deep.Copy(t).(*OperatingSystemVersion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatingSystemVersion) Equal(o *OperatingSystemVersion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Placement) Copy() *Placement {
+	return deep.Copy(t).(*Placement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Placement) Equal(o *Placement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductAdwordsGrouping) Copy() *ProductAdwordsGrouping {
+	return deep.Copy(t).(*ProductAdwordsGrouping)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductAdwordsGrouping) Equal(o *ProductAdwordsGrouping) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductAdwordsLabels) Copy() *ProductAdwordsLabels {
+	return deep.Copy(t).(*ProductAdwordsLabels)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductAdwordsLabels) Equal(o *ProductAdwordsLabels) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductBiddingCategory) Copy() *ProductBiddingCategory {
+	return deep.Copy(t).(*ProductBiddingCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductBiddingCategory) Equal(o *ProductBiddingCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductBiddingCategoryData) Copy() *ProductBiddingCategoryData {
+	return deep.Copy(t).(*ProductBiddingCategoryData)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductBiddingCategoryData) Equal(o *ProductBiddingCategoryData) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductBrand) Copy() *ProductBrand {
+	return deep.Copy(t).(*ProductBrand)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductBrand) Equal(o *ProductBrand) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductCanonicalCondition) Copy() *ProductCanonicalCondition {
+	return deep.Copy(t).(*ProductCanonicalCondition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductCanonicalCondition) Equal(o *ProductCanonicalCondition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductChannel) Copy() *ProductChannel {
+	return deep.Copy(t).(*ProductChannel)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductChannel) Equal(o *ProductChannel) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductChannelExclusivity) Copy() *ProductChannelExclusivity {
+	return deep.Copy(t).(*ProductChannelExclusivity)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductChannelExclusivity) Equal(o *ProductChannelExclusivity) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductLegacyCondition) Copy() *ProductLegacyCondition {
+	return deep.Copy(t).(*ProductLegacyCondition)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductLegacyCondition) Equal(o *ProductLegacyCondition) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductCustomAttribute) Copy() *ProductCustomAttribute {
+	return deep.Copy(t).(*ProductCustomAttribute)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductCustomAttribute) Equal(o *ProductCustomAttribute) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductDimension) Copy() *ProductDimension {
+	return deep.Copy(t).(*ProductDimension)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductDimension) Equal(o *ProductDimension) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductOfferId) Copy() *ProductOfferId {
+	return deep.Copy(t).(*ProductOfferId)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductOfferId) Equal(o *ProductOfferId) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductType) Copy() *ProductType {
+	return deep.Copy(t).(*ProductType)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductType) Equal(o *ProductType) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ProductTypeFull) Copy() *ProductTypeFull {
+	return deep.Copy(t).(*ProductTypeFull)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ProductTypeFull) Equal(o *ProductTypeFull) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *String_StringMapEntry) Copy() *String_StringMapEntry {
+	return deep.Copy(t).(*String_StringMapEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *String_StringMapEntry) Equal(o *String_StringMapEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UnknownProductDimension) Copy() *UnknownProductDimension {
+	return deep.Copy(t).(*UnknownProductDimension)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UnknownProductDimension) Equal(o *UnknownProductDimension) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionUserInterest) Copy() *CriterionUserInterest {
+	return deep.Copy(t).(*CriterionUserInterest)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionUserInterest) Equal(o *CriterionUserInterest) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionUserList) Copy() *CriterionUserList {
+	return deep.Copy(t).(*CriterionUserList)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionUserList) Equal(o *CriterionUserList) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Vertical) Copy() *Vertical {
+	return deep.Copy(t).(*Vertical)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Vertical) Equal(o *Vertical) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffMobileDevice compares from, as fetched from the API, with the desired
+// to. It returns a MobileDevice holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffMobileDevice(from, to *MobileDevice) (*MobileDevice, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		[]string{"Id"},
+	).(*MobileDevice)
+	return set, changes
+}
+
+// DiffProductBiddingCategoryData compares from, as fetched from the API, with the desired
+// to. It returns a ProductBiddingCategoryData holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffProductBiddingCategoryData(from, to *ProductBiddingCategoryData) (*ProductBiddingCategoryData, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"DimensionValue", "ParentDimensionValue", "Country", "Status", "DisplayValue"},
+		nil,
+	).(*ProductBiddingCategoryData)
+	return set, changes
+}
@@ -0,0 +1,245 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConstantDataService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// MobileDeviceFields holds the selector field names of MobileDevice, including
+// the fields of the types it holds.
+var MobileDeviceFields = struct {
+	OperatingSystemName string
+}{
+	OperatingSystemName: "OperatingSystemName",
+}
+
+// ProductBiddingCategoryDataFields holds the selector field names of ProductBiddingCategoryData, including
+// the fields of the types it holds.
+var ProductBiddingCategoryDataFields = struct {
+	BiddingCategoryStatus string
+	Country               string
+}{
+	BiddingCategoryStatus: "BiddingCategoryStatus",
+	Country:               "Country",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"GetProductBiddingCategoryData": {
+		{Type: "GetProductBiddingCategoryData", Name: "Selector", Required: true},
+	},
+	"AgeRange": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "AgeRange", Name: "AgeRangeType", ReadOnly: true},
+	},
+	"Carrier": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Carrier", Name: "Name", ReadOnly: true},
+		{Type: "Carrier", Name: "CountryCode", ReadOnly: true},
+	},
+	"Criterion": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+	},
+	"Gender": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Gender", Name: "GenderType", ReadOnly: true},
+	},
+	"Keyword": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Keyword", Name: "Text", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Keyword", Name: "MatchType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Language": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Language", Name: "Code", ReadOnly: true},
+		{Type: "Language", Name: "Name", ReadOnly: true},
+	},
+	"MobileAppCategory": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileAppCategory", Name: "MobileAppCategoryId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileAppCategory", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileApplication": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileApplication", Name: "AppId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileApplication", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileDevice": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileDevice", Name: "DeviceName", ReadOnly: true},
+		{Type: "MobileDevice", Name: "ManufacturerName", ReadOnly: true},
+		{Type: "MobileDevice", Name: "DeviceType", ReadOnly: true},
+		{Type: "MobileDevice", Name: "OperatingSystemName", Selectors: []string{"OperatingSystemName"}, ReadOnly: true},
+	},
+	"OperatingSystemVersion": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "OperatingSystemVersion", Name: "Name", ReadOnly: true},
+		{Type: "OperatingSystemVersion", Name: "OsMajorVersion", ReadOnly: true},
+		{Type: "OperatingSystemVersion", Name: "OsMinorVersion", ReadOnly: true},
+		{Type: "OperatingSystemVersion", Name: "OperatorType", ReadOnly: true},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Placement": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Placement", Name: "Url", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"ProductBiddingCategory": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductBiddingCategory", Name: "Type_", Filter: "ParentDimensionType", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductBiddingCategory", Name: "Value", Filter: "ParentDimensionId"},
+	},
+	"ProductBiddingCategoryData": {
+		{Type: "ConstantData", Name: "ConstantDataType"},
+		{Type: "ProductBiddingCategoryData", Name: "DimensionValue"},
+		{Type: "ProductBiddingCategoryData", Name: "ParentDimensionValue"},
+		{Type: "ProductBiddingCategoryData", Name: "Country", Selectors: []string{"Country"}, Filter: "Country"},
+		{Type: "ProductBiddingCategoryData", Name: "Status", Selectors: []string{"BiddingCategoryStatus"}, Filter: "BiddingCategoryStatus"},
+		{Type: "ProductBiddingCategoryData", Name: "DisplayValue"},
+	},
+	"ProductCustomAttribute": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductCustomAttribute", Name: "Type_", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductCustomAttribute", Name: "Value"},
+	},
+	"ProductType": {
+		{Type: "ProductDimension", Name: "ProductDimensionType"},
+		{Type: "ProductType", Name: "Type_", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ProductType", Name: "Value"},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"CriterionUserInterest": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserInterest", Name: "UserInterestId"},
+		{Type: "CriterionUserInterest", Name: "UserInterestParentId", ReadOnly: true},
+		{Type: "CriterionUserInterest", Name: "UserInterestName"},
+	},
+	"CriterionUserList": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserList", Name: "UserListId"},
+		{Type: "CriterionUserList", Name: "UserListName"},
+		{Type: "CriterionUserList", Name: "UserListMembershipStatus"},
+		{Type: "CriterionUserList", Name: "UserListEligibleForSearch", ReadOnly: true},
+		{Type: "CriterionUserList", Name: "UserListEligibleForDisplay", ReadOnly: true},
+	},
+	"Vertical": {
+		{Type: "Criterion", Name: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Vertical", Name: "VerticalId"},
+		{Type: "Vertical", Name: "VerticalParentId", ReadOnly: true},
+		{Type: "Vertical", Name: "Path"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,941 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConstantDataService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "AdxError" as the
+// concrete type in the discriminator of its base types.
+func (t AdxError) MarshalJSON() ([]byte, error) {
+	type plain AdxError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdxError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AgeRange" as the
+// concrete type in the discriminator of its base types.
+func (t AgeRange) MarshalJSON() ([]byte, error) {
+	type plain AgeRange
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("AgeRange")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Carrier" as the
+// concrete type in the discriminator of its base types.
+func (t Carrier) MarshalJSON() ([]byte, error) {
+	type plain Carrier
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Carrier")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DateError" as the
+// concrete type in the discriminator of its base types.
+func (t DateError) MarshalJSON() ([]byte, error) {
+	type plain DateError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DateError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Gender" as the
+// concrete type in the discriminator of its base types.
+func (t Gender) MarshalJSON() ([]byte, error) {
+	type plain Gender
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Gender")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Keyword" as the
+// concrete type in the discriminator of its base types.
+func (t Keyword) MarshalJSON() ([]byte, error) {
+	type plain Keyword
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Keyword")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Language" as the
+// concrete type in the discriminator of its base types.
+func (t Language) MarshalJSON() ([]byte, error) {
+	type plain Language
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Language")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileAppCategory" as the
+// concrete type in the discriminator of its base types.
+func (t MobileAppCategory) MarshalJSON() ([]byte, error) {
+	type plain MobileAppCategory
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileAppCategory")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileApplication" as the
+// concrete type in the discriminator of its base types.
+func (t MobileApplication) MarshalJSON() ([]byte, error) {
+	type plain MobileApplication
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileApplication")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileDevice" as the
+// concrete type in the discriminator of its base types.
+func (t MobileDevice) MarshalJSON() ([]byte, error) {
+	type plain MobileDevice
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileDevice")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NullError" as the
+// concrete type in the discriminator of its base types.
+func (t NullError) MarshalJSON() ([]byte, error) {
+	type plain NullError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NullError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatingSystemVersion" as the
+// concrete type in the discriminator of its base types.
+func (t OperatingSystemVersion) MarshalJSON() ([]byte, error) {
+	type plain OperatingSystemVersion
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("OperatingSystemVersion")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Placement" as the
+// concrete type in the discriminator of its base types.
+func (t Placement) MarshalJSON() ([]byte, error) {
+	type plain Placement
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Placement")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductAdwordsGrouping" as the
+// concrete type in the discriminator of its base types.
+func (t ProductAdwordsGrouping) MarshalJSON() ([]byte, error) {
+	type plain ProductAdwordsGrouping
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductAdwordsGrouping")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductAdwordsLabels" as the
+// concrete type in the discriminator of its base types.
+func (t ProductAdwordsLabels) MarshalJSON() ([]byte, error) {
+	type plain ProductAdwordsLabels
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductAdwordsLabels")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductBiddingCategory" as the
+// concrete type in the discriminator of its base types.
+func (t ProductBiddingCategory) MarshalJSON() ([]byte, error) {
+	type plain ProductBiddingCategory
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductBiddingCategory")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductBiddingCategoryData" as the
+// concrete type in the discriminator of its base types.
+func (t ProductBiddingCategoryData) MarshalJSON() ([]byte, error) {
+	type plain ProductBiddingCategoryData
+	v := plain(t)
+
+	b0 := new(ConstantData)
+	if v.ConstantData != nil {
+		*b0 = *v.ConstantData
+	}
+	if b0.ConstantDataType == nil {
+		b0.ConstantDataType = String("ProductBiddingCategoryData")
+	}
+	v.ConstantData = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductBrand" as the
+// concrete type in the discriminator of its base types.
+func (t ProductBrand) MarshalJSON() ([]byte, error) {
+	type plain ProductBrand
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductBrand")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductCanonicalCondition" as the
+// concrete type in the discriminator of its base types.
+func (t ProductCanonicalCondition) MarshalJSON() ([]byte, error) {
+	type plain ProductCanonicalCondition
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductCanonicalCondition")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductChannel" as the
+// concrete type in the discriminator of its base types.
+func (t ProductChannel) MarshalJSON() ([]byte, error) {
+	type plain ProductChannel
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductChannel")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductChannelExclusivity" as the
+// concrete type in the discriminator of its base types.
+func (t ProductChannelExclusivity) MarshalJSON() ([]byte, error) {
+	type plain ProductChannelExclusivity
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductChannelExclusivity")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductLegacyCondition" as the
+// concrete type in the discriminator of its base types.
+func (t ProductLegacyCondition) MarshalJSON() ([]byte, error) {
+	type plain ProductLegacyCondition
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductLegacyCondition")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductCustomAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t ProductCustomAttribute) MarshalJSON() ([]byte, error) {
+	type plain ProductCustomAttribute
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductCustomAttribute")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductOfferId" as the
+// concrete type in the discriminator of its base types.
+func (t ProductOfferId) MarshalJSON() ([]byte, error) {
+	type plain ProductOfferId
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductOfferId")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductType" as the
+// concrete type in the discriminator of its base types.
+func (t ProductType) MarshalJSON() ([]byte, error) {
+	type plain ProductType
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductType")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ProductTypeFull" as the
+// concrete type in the discriminator of its base types.
+func (t ProductTypeFull) MarshalJSON() ([]byte, error) {
+	type plain ProductTypeFull
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("ProductTypeFull")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SelectorError" as the
+// concrete type in the discriminator of its base types.
+func (t SelectorError) MarshalJSON() ([]byte, error) {
+	type plain SelectorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SelectorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "UnknownProductDimension" as the
+// concrete type in the discriminator of its base types.
+func (t UnknownProductDimension) MarshalJSON() ([]byte, error) {
+	type plain UnknownProductDimension
+	v := plain(t)
+
+	b0 := new(ProductDimension)
+	if v.ProductDimension != nil {
+		*b0 = *v.ProductDimension
+	}
+	if b0.ProductDimensionType == nil {
+		b0.ProductDimensionType = String("UnknownProductDimension")
+	}
+	v.ProductDimension = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserInterest" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserInterest) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserInterest
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserInterest")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserList" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserList) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserList
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserList")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Vertical" as the
+// concrete type in the discriminator of its base types.
+func (t Vertical) MarshalJSON() ([]byte, error) {
+	type plain Vertical
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Vertical")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,190 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConstantDataService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks GetAgeRangeCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetAgeRangeCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetCarrierCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetCarrierCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetGenderCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetGenderCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetLanguageCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetLanguageCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetMobileAppCategoryCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetMobileAppCategoryCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetMobileDeviceCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetMobileDeviceCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetOperatingSystemVersionCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetOperatingSystemVersionCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetProductBiddingCategoryData against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetProductBiddingCategoryData) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetUserInterestCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetUserInterestCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetVerticalCriterion against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetVerticalCriterion) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *GetAgeRangeCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetCarrierCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetGenderCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetLanguageCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetMobileAppCategoryCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetMobileDeviceCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetOperatingSystemVersionCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetProductBiddingCategoryData) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(GetProductBiddingCategoryData)
+	}
+	v.Required(validate.Field(path, "selector"), t.Selector != nil)
+	if t.Selector != nil {
+		t.Selector.validate(v, validate.Field(path, "selector"), op)
+	}
+}
+
+func (t *GetUserInterestCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetVerticalCriterion) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
@@ -1437,22 +1437,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getResponse" json:"-"`
 
-	Rval *ConversionTrackerPage `xml:"rval,omitempty"`
+	Rval *ConversionTrackerPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -1461,33 +1461,31 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, SET.</span>
 	//
-	Operations []*ConversionTrackerOperation `xml:"operations,omitempty"`
+	Operations []*ConversionTrackerOperation `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 mutateResponse" json:"-"`
 
-	Rval *ConversionTrackerReturnValue `xml:"rval,omitempty"`
+	Rval *ConversionTrackerReturnValue `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Query struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 query" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryResponse" json:"-"`
 
-	Rval *ConversionTrackerPage `xml:"rval,omitempty"`
+	Rval *ConversionTrackerPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdCallMetricsConversion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdCallMetricsConversion"`
-
 	*ConversionTracker
 
 	//
@@ -1496,68 +1494,60 @@ type AdCallMetricsConversion struct {
 	// <span class="constraint Selectable">This field can be selected using the value "PhoneCallDuration".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint InRange">This field must be between 0 and 10000, inclusive.</span>
 	//
-	PhoneCallDuration int64 `xml:"phoneCallDuration,omitempty"`
+	PhoneCallDuration *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 phoneCallDuration,omitempty" json:"phoneCallDuration,omitempty"`
 }
 
 type AdWordsConversionTracker struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdWordsConversionTracker"`
-
 	*ConversionTracker
 
 	//
 	// Tracking code to use for the conversion type.
 	// <span class="constraint Selectable">This field can be selected using the value "TrackingCodeType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TrackingCodeType *AdWordsConversionTrackerTrackingCodeType `xml:"trackingCodeType,omitempty"`
+	TrackingCodeType *AdWordsConversionTrackerTrackingCodeType `xml:"https://adwords.google.com/api/adwords/cm/v201802 trackingCodeType,omitempty" json:"trackingCodeType,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type AppConversion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AppConversion"`
-
 	*ConversionTracker
 
 	//
@@ -1566,7 +1556,7 @@ type AppConversion struct {
 	// Android app install (from Google Play).
 	// <span class="constraint Selectable">This field can be selected using the value "AppId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// App platform of the app conversion tracker. This field defaults to NONE.
@@ -1574,7 +1564,7 @@ type AppConversion struct {
 	// set at the same time as AppConversionType.
 	// <span class="constraint Selectable">This field can be selected using the value "AppPlatform".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AppPlatform *AppConversionAppPlatform `xml:"appPlatform,omitempty"`
+	AppPlatform *AppConversionAppPlatform `xml:"https://adwords.google.com/api/adwords/cm/v201802 appPlatform,omitempty" json:"appPlatform,omitempty"`
 
 	//
 	// The generated snippet for this conversion tracker. This snippet is
@@ -1582,7 +1572,7 @@ type AppConversion struct {
 	// field will always be returned for conversion trackers using snippets.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Snippet string `xml:"snippet,omitempty"`
+	Snippet *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 snippet,omitempty" json:"snippet,omitempty"`
 
 	//
 	// The type of AppConversion, which identifies a conversion as being either download or
@@ -1590,7 +1580,7 @@ type AppConversion struct {
 	// appropriately. It is an error to change the value once it is set. This field must be set
 	// at the same time as AppPlatform.
 	//
-	AppConversionType *AppConversionAppConversionType `xml:"appConversionType,omitempty"`
+	AppConversionType *AppConversionAppConversionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 appConversionType,omitempty" json:"appConversionType,omitempty"`
 
 	//
 	// The postback URL. When the conversion type specifies a postback url,
@@ -1599,77 +1589,63 @@ type AppConversion struct {
 	// https://developers.google.com/app-conversion-tracking/docs/app-install-feedback
 	// <span class="constraint Selectable">This field can be selected using the value "AppPostbackUrl".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AppPostbackUrl string `xml:"appPostbackUrl,omitempty"`
+	AppPostbackUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appPostbackUrl,omitempty" json:"appPostbackUrl,omitempty"`
 }
 
 type AppPostbackUrlError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AppPostbackUrlError"`
-
 	*ApiError
 
-	Reason *AppPostbackUrlErrorReason `xml:"reason,omitempty"`
+	Reason *AppPostbackUrlErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ConversionTrackerPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConversionTrackerPage"`
-
 	*NoStatsPage
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*ConversionTracker `xml:"entries,omitempty"`
+	Entries []*ConversionTracker `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type ConversionTracker struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConversionTracker"`
-
 	//
 	// ID of this conversion tracker, {@code null} when creating a new one.
 	//
@@ -1684,7 +1660,7 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// The ID of the original conversion type on which this ConversionType is based.
@@ -1694,26 +1670,26 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "OriginalConversionTypeId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: SET.</span>
 	//
-	OriginalConversionTypeId int64 `xml:"originalConversionTypeId,omitempty"`
+	OriginalConversionTypeId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 originalConversionTypeId,omitempty" json:"originalConversionTypeId,omitempty"`
 
 	//
 	// Name of this conversion tracker.
 	// <span class="constraint Selectable">This field can be selected using the value "Name".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// Status of this conversion tracker.
 	// <span class="constraint Selectable">This field can be selected using the value "Status".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Status *ConversionTrackerStatus `xml:"status,omitempty"`
+	Status *ConversionTrackerStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 status,omitempty" json:"status,omitempty"`
 
 	//
 	// The category of conversion that is being tracked.
 	// <span class="constraint Selectable">This field can be selected using the value "Category".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Category *ConversionTrackerCategory `xml:"category,omitempty"`
+	Category *ConversionTrackerCategory `xml:"https://adwords.google.com/api/adwords/cm/v201802 category,omitempty" json:"category,omitempty"`
 
 	//
 	// The event snippet that works with the global site tag to track actions that should be counted
@@ -1721,7 +1697,7 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "GoogleEventSnippet".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	GoogleEventSnippet string `xml:"googleEventSnippet,omitempty"`
+	GoogleEventSnippet *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 googleEventSnippet,omitempty" json:"googleEventSnippet,omitempty"`
 
 	//
 	// The global site tag that adds visitors to your basic remarketing lists and sets new cookies on
@@ -1730,14 +1706,14 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "GoogleGlobalSiteTag".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	GoogleGlobalSiteTag string `xml:"googleGlobalSiteTag,omitempty"`
+	GoogleGlobalSiteTag *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 googleGlobalSiteTag,omitempty" json:"googleGlobalSiteTag,omitempty"`
 
 	//
 	// The status of the data-driven attribution model for the conversion type.
 	// <span class="constraint Selectable">This field can be selected using the value "DataDrivenModelStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DataDrivenModelStatus *DataDrivenModelStatus `xml:"dataDrivenModelStatus,omitempty"`
+	DataDrivenModelStatus *DataDrivenModelStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 dataDrivenModelStatus,omitempty" json:"dataDrivenModelStatus,omitempty"`
 
 	//
 	// The external customer ID of the conversion type owner, or 0 if this is a system-defined
@@ -1745,7 +1721,7 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "ConversionTypeOwnerCustomerId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ConversionTypeOwnerCustomerId int64 `xml:"conversionTypeOwnerCustomerId,omitempty"`
+	ConversionTypeOwnerCustomerId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 conversionTypeOwnerCustomerId,omitempty" json:"conversionTypeOwnerCustomerId,omitempty"`
 
 	//
 	// Lookback window for view-through conversions in days. This is the length of
@@ -1754,7 +1730,7 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "ViewthroughLookbackWindow".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint InRange">This field must be between 1 and 30, inclusive.</span>
 	//
-	ViewthroughLookbackWindow int32 `xml:"viewthroughLookbackWindow,omitempty"`
+	ViewthroughLookbackWindow *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 viewthroughLookbackWindow,omitempty" json:"viewthroughLookbackWindow,omitempty"`
 
 	//
 	// The click-through conversion (ctc) lookback window is the maximum number of days between
@@ -1768,7 +1744,7 @@ type ConversionTracker struct {
 	// range.
 	// <span class="constraint Selectable">This field can be selected using the value "CtcLookbackWindow".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CtcLookbackWindow int32 `xml:"ctcLookbackWindow,omitempty"`
+	CtcLookbackWindow *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 ctcLookbackWindow,omitempty" json:"ctcLookbackWindow,omitempty"`
 
 	//
 	// How to count events for this conversion tracker.
@@ -1778,7 +1754,7 @@ type ConversionTracker struct {
 	// More information is available at https://support.google.com/adwords/answer/3438531
 	// <span class="constraint Selectable">This field can be selected using the value "CountingType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CountingType *ConversionDeduplicationMode `xml:"countingType,omitempty"`
+	CountingType *ConversionDeduplicationMode `xml:"https://adwords.google.com/api/adwords/cm/v201802 countingType,omitempty" json:"countingType,omitempty"`
 
 	//
 	// The value to use when the tag for this conversion tracker sends conversion events without
@@ -1791,7 +1767,7 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "DefaultRevenueValue".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint InRange">This field must be between 0 and 1000000000000, inclusive.</span>
 	//
-	DefaultRevenueValue float64 `xml:"defaultRevenueValue,omitempty"`
+	DefaultRevenueValue *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 defaultRevenueValue,omitempty" json:"defaultRevenueValue,omitempty"`
 
 	//
 	// The currency code to use when the tag for this conversion tracker sends conversion events
@@ -1807,7 +1783,7 @@ type ConversionTracker struct {
 	// a currency code is set in the tag.
 	// <span class="constraint Selectable">This field can be selected using the value "DefaultRevenueCurrencyCode".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	DefaultRevenueCurrencyCode string `xml:"defaultRevenueCurrencyCode,omitempty"`
+	DefaultRevenueCurrencyCode *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 defaultRevenueCurrencyCode,omitempty" json:"defaultRevenueCurrencyCode,omitempty"`
 
 	//
 	// Controls whether conversion event values and currency codes are taken from the tag snippet or
@@ -1819,7 +1795,7 @@ type ConversionTracker struct {
 	// are only used if the tag does not supply a value, or the tag's value is unparseable.
 	// <span class="constraint Selectable">This field can be selected using the value "AlwaysUseDefaultRevenueValue".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AlwaysUseDefaultRevenueValue bool `xml:"alwaysUseDefaultRevenueValue,omitempty"`
+	AlwaysUseDefaultRevenueValue *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 alwaysUseDefaultRevenueValue,omitempty" json:"alwaysUseDefaultRevenueValue,omitempty"`
 
 	//
 	// Whether this conversion tracker should be excluded from the "Conversions" columns in reports.
@@ -1830,7 +1806,7 @@ type ConversionTracker struct {
 	// Either way, conversions will still be counted in the "AllConversions" columns in reports.
 	// <span class="constraint Selectable">This field can be selected using the value "ExcludeFromBidding".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	ExcludeFromBidding bool `xml:"excludeFromBidding,omitempty"`
+	ExcludeFromBidding *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 excludeFromBidding,omitempty" json:"excludeFromBidding,omitempty"`
 
 	//
 	// Attribution models describing how to distribute credit for a particular conversion across
@@ -1838,7 +1814,7 @@ type ConversionTracker struct {
 	// more information about attribution modeling in AdWords.
 	// <span class="constraint Selectable">This field can be selected using the value "AttributionModelType".</span>
 	//
-	AttributionModelType *AttributionModelType `xml:"attributionModelType,omitempty"`
+	AttributionModelType *AttributionModelType `xml:"https://adwords.google.com/api/adwords/cm/v201802 attributionModelType,omitempty" json:"attributionModelType,omitempty"`
 
 	//
 	// The date of the most recent ad click that led to a conversion of this conversion type.
@@ -1847,7 +1823,7 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "MostRecentConversionDate".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	MostRecentConversionDate string `xml:"mostRecentConversionDate,omitempty"`
+	MostRecentConversionDate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 mostRecentConversionDate,omitempty" json:"mostRecentConversionDate,omitempty"`
 
 	//
 	// The last time a conversion tag for this conversion type successfully fired and was seen by
@@ -1859,241 +1835,201 @@ type ConversionTracker struct {
 	// <span class="constraint Selectable">This field can be selected using the value "LastReceivedRequestTime".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	LastReceivedRequestTime string `xml:"lastReceivedRequestTime,omitempty"`
+	LastReceivedRequestTime *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 lastReceivedRequestTime,omitempty" json:"lastReceivedRequestTime,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ConversionTracker.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ConversionTrackerType string `xml:"ConversionTracker.Type,omitempty"`
+	ConversionTrackerType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConversionTracker.Type,omitempty" json:"conversionTrackerType,omitempty"`
 }
 
 type ConversionTrackerOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConversionTrackerOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *ConversionTracker `xml:"operand,omitempty"`
+	Operand *ConversionTracker `xml:"https://adwords.google.com/api/adwords/cm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type ConversionTrackerReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConversionTrackerReturnValue"`
-
 	*ListReturnValue
 
-	Value []*ConversionTracker `xml:"value,omitempty"`
+	Value []*ConversionTracker `xml:"https://adwords.google.com/api/adwords/cm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ConversionTrackingError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ConversionTrackingError"`
-
 	*ApiError
 
-	Reason *ConversionTrackingErrorReason `xml:"reason,omitempty"`
+	Reason *ConversionTrackingErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type NoStatsPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NoStatsPage"`
-
 	*Page
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -2101,13 +2037,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -2116,108 +2052,90 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -2228,13 +2146,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -2243,58 +2161,54 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -2302,12 +2216,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -2315,87 +2229,77 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type UploadCallConversion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UploadCallConversion"`
-
 	*ConversionTracker
 }
 
 type UploadConversion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UploadConversion"`
-
 	*ConversionTracker
 
 	//
 	// Whether this conversion is using an external attribution model.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: SET.</span>
 	//
-	IsExternallyAttributed bool `xml:"isExternallyAttributed,omitempty"`
+	IsExternallyAttributed *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isExternallyAttributed,omitempty" json:"isExternallyAttributed,omitempty"`
 }
 
 type WebsiteCallMetricsConversion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 WebsiteCallMetricsConversion"`
-
 	*ConversionTracker
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "WebsitePhoneCallDuration".</span>
 	// <span class="constraint InRange">This field must be between 0 and 10000, inclusive.</span>
 	//
-	PhoneCallDuration int64 `xml:"phoneCallDuration,omitempty"`
+	PhoneCallDuration *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 phoneCallDuration,omitempty" json:"phoneCallDuration,omitempty"`
 }
 
 type ConversionTrackerServiceInterface struct {
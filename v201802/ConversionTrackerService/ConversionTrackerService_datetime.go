@@ -0,0 +1,57 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConversionTrackerService
+
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// NewDateRange returns the DateRange from one date to another, both
+// inclusive.
+func NewDateRange(from, to datetime.Date) *DateRange {
+	return &DateRange{Min: String(from.String()), Max: String(to.String())}
+}
+
+// Dates returns the bounds of r. A bound that is not set is returned as the
+// zero Date.
+func (r *DateRange) Dates() (from, to datetime.Date, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDate(*r.Min); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDate(*r.Max)
+	}
+	return
+}
+
+// GetMostRecentConversionDate returns the MostRecentConversionDate of t, or the zero Date if it is not
+// set.
+func (t *ConversionTracker) GetMostRecentConversionDate() (datetime.Date, error) {
+	if t.MostRecentConversionDate == nil {
+		return datetime.Date{}, nil
+	}
+	return datetime.ParseDate(*t.MostRecentConversionDate)
+}
+
+// SetMostRecentConversionDate sets the MostRecentConversionDate of t to d.
+func (t *ConversionTracker) SetMostRecentConversionDate(d datetime.Date) {
+	t.MostRecentConversionDate = String(d.String())
+}
+
+// GetLastReceivedRequestTime returns the LastReceivedRequestTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *ConversionTracker) GetLastReceivedRequestTime(loc *time.Location) (time.Time, error) {
+	if t.LastReceivedRequestTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.LastReceivedRequestTime, loc)
+}
+
+// SetLastReceivedRequestTime sets the LastReceivedRequestTime of t to tm formatted in loc.
+func (t *ConversionTracker) SetLastReceivedRequestTime(tm time.Time, loc *time.Location) {
+	t.LastReceivedRequestTime = String(datetime.FormatDateTime(tm, loc))
+}
@@ -0,0 +1,688 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConversionTrackerService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *Get) Copy() *Get {
+	return deep.Copy(t).(*Get)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Get) Equal(o *Get) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetResponse) Copy() *GetResponse {
+	return deep.Copy(t).(*GetResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetResponse) Equal(o *GetResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Mutate) Copy() *Mutate {
+	return deep.Copy(t).(*Mutate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Mutate) Equal(o *Mutate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *MutateResponse) Copy() *MutateResponse {
+	return deep.Copy(t).(*MutateResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *MutateResponse) Equal(o *MutateResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Query) Copy() *Query {
+	return deep.Copy(t).(*Query)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Query) Equal(o *Query) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryResponse) Copy() *QueryResponse {
+	return deep.Copy(t).(*QueryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryResponse) Equal(o *QueryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdCallMetricsConversion) Copy() *AdCallMetricsConversion {
+	return deep.Copy(t).(*AdCallMetricsConversion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdCallMetricsConversion) Equal(o *AdCallMetricsConversion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdWordsConversionTracker) Copy() *AdWordsConversionTracker {
+	return deep.Copy(t).(*AdWordsConversionTracker)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdWordsConversionTracker) Equal(o *AdWordsConversionTracker) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AppConversion) Copy() *AppConversion {
+	return deep.Copy(t).(*AppConversion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AppConversion) Equal(o *AppConversion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AppPostbackUrlError) Copy() *AppPostbackUrlError {
+	return deep.Copy(t).(*AppPostbackUrlError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AppPostbackUrlError) Equal(o *AppPostbackUrlError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ConversionTrackerPage) Copy() *ConversionTrackerPage {
+	return deep.Copy(t).(*ConversionTrackerPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ConversionTrackerPage) Equal(o *ConversionTrackerPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ConversionTracker) Copy() *ConversionTracker {
+	return deep.Copy(t).(*ConversionTracker)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ConversionTracker) Equal(o *ConversionTracker) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ConversionTrackerOperation) Copy() *ConversionTrackerOperation {
+	return deep.Copy(t).(*ConversionTrackerOperation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ConversionTrackerOperation) Equal(o *ConversionTrackerOperation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ConversionTrackerReturnValue) Copy() *ConversionTrackerReturnValue {
+	return deep.Copy(t).(*ConversionTrackerReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ConversionTrackerReturnValue) Equal(o *ConversionTrackerReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ConversionTrackingError) Copy() *ConversionTrackingError {
+	return deep.Copy(t).(*ConversionTrackingError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ConversionTrackingError) Equal(o *ConversionTrackingError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ListReturnValue) Copy() *ListReturnValue {
+	return deep.Copy(t).(*ListReturnValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ListReturnValue) Equal(o *ListReturnValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NoStatsPage) Copy() *NoStatsPage {
+	return deep.Copy(t).(*NoStatsPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NoStatsPage) Equal(o *NoStatsPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Operation) Copy() *Operation {
+	return deep.Copy(t).(*Operation)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Operation) Equal(o *Operation) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UploadCallConversion) Copy() *UploadCallConversion {
+	return deep.Copy(t).(*UploadCallConversion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UploadCallConversion) Equal(o *UploadCallConversion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *UploadConversion) Copy() *UploadConversion {
+	return deep.Copy(t).(*UploadConversion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *UploadConversion) Equal(o *UploadConversion) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *WebsiteCallMetricsConversion) Copy() *WebsiteCallMetricsConversion {
+	return deep.Copy(t).(*WebsiteCallMetricsConversion)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *WebsiteCallMetricsConversion) Equal(o *WebsiteCallMetricsConversion) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffAdCallMetricsConversion compares from, as fetched from the API, with the desired
+// to. It returns a AdCallMetricsConversion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdCallMetricsConversion(from, to *AdCallMetricsConversion) (*AdCallMetricsConversion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Status", "Category", "ViewthroughLookbackWindow", "CtcLookbackWindow", "CountingType", "DefaultRevenueValue", "DefaultRevenueCurrencyCode", "AlwaysUseDefaultRevenueValue", "ExcludeFromBidding", "AttributionModelType", "PhoneCallDuration"},
+		[]string{"Id"},
+	).(*AdCallMetricsConversion)
+	return set, changes
+}
+
+// DiffAdWordsConversionTracker compares from, as fetched from the API, with the desired
+// to. It returns a AdWordsConversionTracker holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdWordsConversionTracker(from, to *AdWordsConversionTracker) (*AdWordsConversionTracker, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Status", "Category", "ViewthroughLookbackWindow", "CtcLookbackWindow", "CountingType", "DefaultRevenueValue", "DefaultRevenueCurrencyCode", "AlwaysUseDefaultRevenueValue", "ExcludeFromBidding", "AttributionModelType", "TrackingCodeType"},
+		[]string{"Id"},
+	).(*AdWordsConversionTracker)
+	return set, changes
+}
+
+// DiffAppConversion compares from, as fetched from the API, with the desired
+// to. It returns a AppConversion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAppConversion(from, to *AppConversion) (*AppConversion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Status", "Category", "ViewthroughLookbackWindow", "CtcLookbackWindow", "CountingType", "DefaultRevenueValue", "DefaultRevenueCurrencyCode", "AlwaysUseDefaultRevenueValue", "ExcludeFromBidding", "AttributionModelType", "AppId", "AppPlatform", "AppConversionType", "AppPostbackUrl"},
+		[]string{"Id"},
+	).(*AppConversion)
+	return set, changes
+}
+
+// DiffConversionTracker compares from, as fetched from the API, with the desired
+// to. It returns a ConversionTracker holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffConversionTracker(from, to *ConversionTracker) (*ConversionTracker, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Status", "Category", "ViewthroughLookbackWindow", "CtcLookbackWindow", "CountingType", "DefaultRevenueValue", "DefaultRevenueCurrencyCode", "AlwaysUseDefaultRevenueValue", "ExcludeFromBidding", "AttributionModelType"},
+		[]string{"Id"},
+	).(*ConversionTracker)
+	return set, changes
+}
+
+// SetConversionTrackerOperation returns the SET operation for the changes from from
+// to to, or nil if no mutable field changed. See DiffConversionTracker.
+func SetConversionTrackerOperation(from, to *ConversionTracker) (*ConversionTrackerOperation, []deep.Change) {
+	set, changes := DiffConversionTracker(from, to)
+	if set == nil {
+		return nil, changes
+	}
+	op := OperatorSET
+	return &ConversionTrackerOperation{Operation: &Operation{Operator: &op}, Operand: set}, changes
+}
+
+// DiffUploadCallConversion compares from, as fetched from the API, with the desired
+// to. It returns a UploadCallConversion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffUploadCallConversion(from, to *UploadCallConversion) (*UploadCallConversion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Status", "Category", "ViewthroughLookbackWindow", "CtcLookbackWindow", "CountingType", "DefaultRevenueValue", "DefaultRevenueCurrencyCode", "AlwaysUseDefaultRevenueValue", "ExcludeFromBidding", "AttributionModelType"},
+		[]string{"Id"},
+	).(*UploadCallConversion)
+	return set, changes
+}
+
+// DiffUploadConversion compares from, as fetched from the API, with the desired
+// to. It returns a UploadConversion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffUploadConversion(from, to *UploadConversion) (*UploadConversion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Status", "Category", "ViewthroughLookbackWindow", "CtcLookbackWindow", "CountingType", "DefaultRevenueValue", "DefaultRevenueCurrencyCode", "AlwaysUseDefaultRevenueValue", "ExcludeFromBidding", "AttributionModelType"},
+		[]string{"Id"},
+	).(*UploadConversion)
+	return set, changes
+}
+
+// DiffWebsiteCallMetricsConversion compares from, as fetched from the API, with the desired
+// to. It returns a WebsiteCallMetricsConversion holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffWebsiteCallMetricsConversion(from, to *WebsiteCallMetricsConversion) (*WebsiteCallMetricsConversion, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Name", "Status", "Category", "ViewthroughLookbackWindow", "CtcLookbackWindow", "CountingType", "DefaultRevenueValue", "DefaultRevenueCurrencyCode", "AlwaysUseDefaultRevenueValue", "ExcludeFromBidding", "AttributionModelType", "PhoneCallDuration"},
+		[]string{"Id"},
+	).(*WebsiteCallMetricsConversion)
+	return set, changes
+}
@@ -0,0 +1,612 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConversionTrackerService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AdCallMetricsConversionFields holds the selector field names of AdCallMetricsConversion, including
+// the fields of the types it holds.
+var AdCallMetricsConversionFields = struct {
+	AlwaysUseDefaultRevenueValue  string
+	AttributionModelType          string
+	Category                      string
+	ConversionTypeOwnerCustomerId string
+	CountingType                  string
+	CtcLookbackWindow             string
+	DataDrivenModelStatus         string
+	DefaultRevenueCurrencyCode    string
+	DefaultRevenueValue           string
+	ExcludeFromBidding            string
+	GoogleEventSnippet            string
+	GoogleGlobalSiteTag           string
+	Id                            string
+	LastReceivedRequestTime       string
+	MostRecentConversionDate      string
+	Name                          string
+	OriginalConversionTypeId      string
+	PhoneCallDuration             string
+	Status                        string
+	ViewthroughLookbackWindow     string
+}{
+	AlwaysUseDefaultRevenueValue:  "AlwaysUseDefaultRevenueValue",
+	AttributionModelType:          "AttributionModelType",
+	Category:                      "Category",
+	ConversionTypeOwnerCustomerId: "ConversionTypeOwnerCustomerId",
+	CountingType:                  "CountingType",
+	CtcLookbackWindow:             "CtcLookbackWindow",
+	DataDrivenModelStatus:         "DataDrivenModelStatus",
+	DefaultRevenueCurrencyCode:    "DefaultRevenueCurrencyCode",
+	DefaultRevenueValue:           "DefaultRevenueValue",
+	ExcludeFromBidding:            "ExcludeFromBidding",
+	GoogleEventSnippet:            "GoogleEventSnippet",
+	GoogleGlobalSiteTag:           "GoogleGlobalSiteTag",
+	Id:                            "Id",
+	LastReceivedRequestTime:       "LastReceivedRequestTime",
+	MostRecentConversionDate:      "MostRecentConversionDate",
+	Name:                          "Name",
+	OriginalConversionTypeId:      "OriginalConversionTypeId",
+	PhoneCallDuration:             "PhoneCallDuration",
+	Status:                        "Status",
+	ViewthroughLookbackWindow:     "ViewthroughLookbackWindow",
+}
+
+// AdWordsConversionTrackerFields holds the selector field names of AdWordsConversionTracker, including
+// the fields of the types it holds.
+var AdWordsConversionTrackerFields = struct {
+	AlwaysUseDefaultRevenueValue  string
+	AttributionModelType          string
+	Category                      string
+	ConversionTypeOwnerCustomerId string
+	CountingType                  string
+	CtcLookbackWindow             string
+	DataDrivenModelStatus         string
+	DefaultRevenueCurrencyCode    string
+	DefaultRevenueValue           string
+	ExcludeFromBidding            string
+	GoogleEventSnippet            string
+	GoogleGlobalSiteTag           string
+	Id                            string
+	LastReceivedRequestTime       string
+	MostRecentConversionDate      string
+	Name                          string
+	OriginalConversionTypeId      string
+	Status                        string
+	TrackingCodeType              string
+	ViewthroughLookbackWindow     string
+}{
+	AlwaysUseDefaultRevenueValue:  "AlwaysUseDefaultRevenueValue",
+	AttributionModelType:          "AttributionModelType",
+	Category:                      "Category",
+	ConversionTypeOwnerCustomerId: "ConversionTypeOwnerCustomerId",
+	CountingType:                  "CountingType",
+	CtcLookbackWindow:             "CtcLookbackWindow",
+	DataDrivenModelStatus:         "DataDrivenModelStatus",
+	DefaultRevenueCurrencyCode:    "DefaultRevenueCurrencyCode",
+	DefaultRevenueValue:           "DefaultRevenueValue",
+	ExcludeFromBidding:            "ExcludeFromBidding",
+	GoogleEventSnippet:            "GoogleEventSnippet",
+	GoogleGlobalSiteTag:           "GoogleGlobalSiteTag",
+	Id:                            "Id",
+	LastReceivedRequestTime:       "LastReceivedRequestTime",
+	MostRecentConversionDate:      "MostRecentConversionDate",
+	Name:                          "Name",
+	OriginalConversionTypeId:      "OriginalConversionTypeId",
+	Status:                        "Status",
+	TrackingCodeType:              "TrackingCodeType",
+	ViewthroughLookbackWindow:     "ViewthroughLookbackWindow",
+}
+
+// AppConversionFields holds the selector field names of AppConversion, including
+// the fields of the types it holds.
+var AppConversionFields = struct {
+	AlwaysUseDefaultRevenueValue  string
+	AppId                         string
+	AppPlatform                   string
+	AppPostbackUrl                string
+	AttributionModelType          string
+	Category                      string
+	ConversionTypeOwnerCustomerId string
+	CountingType                  string
+	CtcLookbackWindow             string
+	DataDrivenModelStatus         string
+	DefaultRevenueCurrencyCode    string
+	DefaultRevenueValue           string
+	ExcludeFromBidding            string
+	GoogleEventSnippet            string
+	GoogleGlobalSiteTag           string
+	Id                            string
+	LastReceivedRequestTime       string
+	MostRecentConversionDate      string
+	Name                          string
+	OriginalConversionTypeId      string
+	Status                        string
+	ViewthroughLookbackWindow     string
+}{
+	AlwaysUseDefaultRevenueValue:  "AlwaysUseDefaultRevenueValue",
+	AppId:                         "AppId",
+	AppPlatform:                   "AppPlatform",
+	AppPostbackUrl:                "AppPostbackUrl",
+	AttributionModelType:          "AttributionModelType",
+	Category:                      "Category",
+	ConversionTypeOwnerCustomerId: "ConversionTypeOwnerCustomerId",
+	CountingType:                  "CountingType",
+	CtcLookbackWindow:             "CtcLookbackWindow",
+	DataDrivenModelStatus:         "DataDrivenModelStatus",
+	DefaultRevenueCurrencyCode:    "DefaultRevenueCurrencyCode",
+	DefaultRevenueValue:           "DefaultRevenueValue",
+	ExcludeFromBidding:            "ExcludeFromBidding",
+	GoogleEventSnippet:            "GoogleEventSnippet",
+	GoogleGlobalSiteTag:           "GoogleGlobalSiteTag",
+	Id:                            "Id",
+	LastReceivedRequestTime:       "LastReceivedRequestTime",
+	MostRecentConversionDate:      "MostRecentConversionDate",
+	Name:                          "Name",
+	OriginalConversionTypeId:      "OriginalConversionTypeId",
+	Status:                        "Status",
+	ViewthroughLookbackWindow:     "ViewthroughLookbackWindow",
+}
+
+// ConversionTrackerFields holds the selector field names of ConversionTracker, including
+// the fields of the types it holds.
+var ConversionTrackerFields = struct {
+	AlwaysUseDefaultRevenueValue  string
+	AppId                         string
+	AppPlatform                   string
+	AppPostbackUrl                string
+	AttributionModelType          string
+	Category                      string
+	ConversionTypeOwnerCustomerId string
+	CountingType                  string
+	CtcLookbackWindow             string
+	DataDrivenModelStatus         string
+	DefaultRevenueCurrencyCode    string
+	DefaultRevenueValue           string
+	ExcludeFromBidding            string
+	GoogleEventSnippet            string
+	GoogleGlobalSiteTag           string
+	Id                            string
+	LastReceivedRequestTime       string
+	MostRecentConversionDate      string
+	Name                          string
+	OriginalConversionTypeId      string
+	PhoneCallDuration             string
+	Status                        string
+	TrackingCodeType              string
+	ViewthroughLookbackWindow     string
+	WebsitePhoneCallDuration      string
+}{
+	AlwaysUseDefaultRevenueValue:  "AlwaysUseDefaultRevenueValue",
+	AppId:                         "AppId",
+	AppPlatform:                   "AppPlatform",
+	AppPostbackUrl:                "AppPostbackUrl",
+	AttributionModelType:          "AttributionModelType",
+	Category:                      "Category",
+	ConversionTypeOwnerCustomerId: "ConversionTypeOwnerCustomerId",
+	CountingType:                  "CountingType",
+	CtcLookbackWindow:             "CtcLookbackWindow",
+	DataDrivenModelStatus:         "DataDrivenModelStatus",
+	DefaultRevenueCurrencyCode:    "DefaultRevenueCurrencyCode",
+	DefaultRevenueValue:           "DefaultRevenueValue",
+	ExcludeFromBidding:            "ExcludeFromBidding",
+	GoogleEventSnippet:            "GoogleEventSnippet",
+	GoogleGlobalSiteTag:           "GoogleGlobalSiteTag",
+	Id:                            "Id",
+	LastReceivedRequestTime:       "LastReceivedRequestTime",
+	MostRecentConversionDate:      "MostRecentConversionDate",
+	Name:                          "Name",
+	OriginalConversionTypeId:      "OriginalConversionTypeId",
+	PhoneCallDuration:             "PhoneCallDuration",
+	Status:                        "Status",
+	TrackingCodeType:              "TrackingCodeType",
+	ViewthroughLookbackWindow:     "ViewthroughLookbackWindow",
+	WebsitePhoneCallDuration:      "WebsitePhoneCallDuration",
+}
+
+// UploadCallConversionFields holds the selector field names of UploadCallConversion, including
+// the fields of the types it holds.
+var UploadCallConversionFields = struct {
+	AlwaysUseDefaultRevenueValue  string
+	AttributionModelType          string
+	Category                      string
+	ConversionTypeOwnerCustomerId string
+	CountingType                  string
+	CtcLookbackWindow             string
+	DataDrivenModelStatus         string
+	DefaultRevenueCurrencyCode    string
+	DefaultRevenueValue           string
+	ExcludeFromBidding            string
+	GoogleEventSnippet            string
+	GoogleGlobalSiteTag           string
+	Id                            string
+	LastReceivedRequestTime       string
+	MostRecentConversionDate      string
+	Name                          string
+	OriginalConversionTypeId      string
+	Status                        string
+	ViewthroughLookbackWindow     string
+}{
+	AlwaysUseDefaultRevenueValue:  "AlwaysUseDefaultRevenueValue",
+	AttributionModelType:          "AttributionModelType",
+	Category:                      "Category",
+	ConversionTypeOwnerCustomerId: "ConversionTypeOwnerCustomerId",
+	CountingType:                  "CountingType",
+	CtcLookbackWindow:             "CtcLookbackWindow",
+	DataDrivenModelStatus:         "DataDrivenModelStatus",
+	DefaultRevenueCurrencyCode:    "DefaultRevenueCurrencyCode",
+	DefaultRevenueValue:           "DefaultRevenueValue",
+	ExcludeFromBidding:            "ExcludeFromBidding",
+	GoogleEventSnippet:            "GoogleEventSnippet",
+	GoogleGlobalSiteTag:           "GoogleGlobalSiteTag",
+	Id:                            "Id",
+	LastReceivedRequestTime:       "LastReceivedRequestTime",
+	MostRecentConversionDate:      "MostRecentConversionDate",
+	Name:                          "Name",
+	OriginalConversionTypeId:      "OriginalConversionTypeId",
+	Status:                        "Status",
+	ViewthroughLookbackWindow:     "ViewthroughLookbackWindow",
+}
+
+// UploadConversionFields holds the selector field names of UploadConversion, including
+// the fields of the types it holds.
+var UploadConversionFields = struct {
+	AlwaysUseDefaultRevenueValue  string
+	AttributionModelType          string
+	Category                      string
+	ConversionTypeOwnerCustomerId string
+	CountingType                  string
+	CtcLookbackWindow             string
+	DataDrivenModelStatus         string
+	DefaultRevenueCurrencyCode    string
+	DefaultRevenueValue           string
+	ExcludeFromBidding            string
+	GoogleEventSnippet            string
+	GoogleGlobalSiteTag           string
+	Id                            string
+	LastReceivedRequestTime       string
+	MostRecentConversionDate      string
+	Name                          string
+	OriginalConversionTypeId      string
+	Status                        string
+	ViewthroughLookbackWindow     string
+}{
+	AlwaysUseDefaultRevenueValue:  "AlwaysUseDefaultRevenueValue",
+	AttributionModelType:          "AttributionModelType",
+	Category:                      "Category",
+	ConversionTypeOwnerCustomerId: "ConversionTypeOwnerCustomerId",
+	CountingType:                  "CountingType",
+	CtcLookbackWindow:             "CtcLookbackWindow",
+	DataDrivenModelStatus:         "DataDrivenModelStatus",
+	DefaultRevenueCurrencyCode:    "DefaultRevenueCurrencyCode",
+	DefaultRevenueValue:           "DefaultRevenueValue",
+	ExcludeFromBidding:            "ExcludeFromBidding",
+	GoogleEventSnippet:            "GoogleEventSnippet",
+	GoogleGlobalSiteTag:           "GoogleGlobalSiteTag",
+	Id:                            "Id",
+	LastReceivedRequestTime:       "LastReceivedRequestTime",
+	MostRecentConversionDate:      "MostRecentConversionDate",
+	Name:                          "Name",
+	OriginalConversionTypeId:      "OriginalConversionTypeId",
+	Status:                        "Status",
+	ViewthroughLookbackWindow:     "ViewthroughLookbackWindow",
+}
+
+// WebsiteCallMetricsConversionFields holds the selector field names of WebsiteCallMetricsConversion, including
+// the fields of the types it holds.
+var WebsiteCallMetricsConversionFields = struct {
+	AlwaysUseDefaultRevenueValue  string
+	AttributionModelType          string
+	Category                      string
+	ConversionTypeOwnerCustomerId string
+	CountingType                  string
+	CtcLookbackWindow             string
+	DataDrivenModelStatus         string
+	DefaultRevenueCurrencyCode    string
+	DefaultRevenueValue           string
+	ExcludeFromBidding            string
+	GoogleEventSnippet            string
+	GoogleGlobalSiteTag           string
+	Id                            string
+	LastReceivedRequestTime       string
+	MostRecentConversionDate      string
+	Name                          string
+	OriginalConversionTypeId      string
+	Status                        string
+	ViewthroughLookbackWindow     string
+	WebsitePhoneCallDuration      string
+}{
+	AlwaysUseDefaultRevenueValue:  "AlwaysUseDefaultRevenueValue",
+	AttributionModelType:          "AttributionModelType",
+	Category:                      "Category",
+	ConversionTypeOwnerCustomerId: "ConversionTypeOwnerCustomerId",
+	CountingType:                  "CountingType",
+	CtcLookbackWindow:             "CtcLookbackWindow",
+	DataDrivenModelStatus:         "DataDrivenModelStatus",
+	DefaultRevenueCurrencyCode:    "DefaultRevenueCurrencyCode",
+	DefaultRevenueValue:           "DefaultRevenueValue",
+	ExcludeFromBidding:            "ExcludeFromBidding",
+	GoogleEventSnippet:            "GoogleEventSnippet",
+	GoogleGlobalSiteTag:           "GoogleGlobalSiteTag",
+	Id:                            "Id",
+	LastReceivedRequestTime:       "LastReceivedRequestTime",
+	MostRecentConversionDate:      "MostRecentConversionDate",
+	Name:                          "Name",
+	OriginalConversionTypeId:      "OriginalConversionTypeId",
+	Status:                        "Status",
+	ViewthroughLookbackWindow:     "ViewthroughLookbackWindow",
+	WebsitePhoneCallDuration:      "WebsitePhoneCallDuration",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"AdCallMetricsConversion": {
+		{Type: "ConversionTracker", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "OriginalConversionTypeId", Selectors: []string{"OriginalConversionTypeId"}, Filter: "OriginalConversionTypeId", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "ConversionTracker", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "ConversionTracker", Name: "Category", Selectors: []string{"Category"}, Filter: "Category"},
+		{Type: "ConversionTracker", Name: "GoogleEventSnippet", Selectors: []string{"GoogleEventSnippet"}, Filter: "GoogleEventSnippet", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "GoogleGlobalSiteTag", Selectors: []string{"GoogleGlobalSiteTag"}, Filter: "GoogleGlobalSiteTag", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "DataDrivenModelStatus", Selectors: []string{"DataDrivenModelStatus"}, Filter: "DataDrivenModelStatus", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTypeOwnerCustomerId", Selectors: []string{"ConversionTypeOwnerCustomerId"}, Filter: "ConversionTypeOwnerCustomerId", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ViewthroughLookbackWindow", Selectors: []string{"ViewthroughLookbackWindow"}, Filter: "ViewthroughLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CtcLookbackWindow", Selectors: []string{"CtcLookbackWindow"}, Filter: "CtcLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CountingType", Selectors: []string{"CountingType"}, Filter: "CountingType"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueValue", Selectors: []string{"DefaultRevenueValue"}, Filter: "DefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueCurrencyCode", Selectors: []string{"DefaultRevenueCurrencyCode"}, Filter: "DefaultRevenueCurrencyCode"},
+		{Type: "ConversionTracker", Name: "AlwaysUseDefaultRevenueValue", Selectors: []string{"AlwaysUseDefaultRevenueValue"}, Filter: "AlwaysUseDefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "ExcludeFromBidding", Selectors: []string{"ExcludeFromBidding"}, Filter: "ExcludeFromBidding"},
+		{Type: "ConversionTracker", Name: "AttributionModelType", Selectors: []string{"AttributionModelType"}},
+		{Type: "ConversionTracker", Name: "MostRecentConversionDate", Selectors: []string{"MostRecentConversionDate"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "LastReceivedRequestTime", Selectors: []string{"LastReceivedRequestTime"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTrackerType"},
+		{Type: "AdCallMetricsConversion", Name: "PhoneCallDuration", Selectors: []string{"PhoneCallDuration"}, Filter: "PhoneCallDuration"},
+	},
+	"AdWordsConversionTracker": {
+		{Type: "ConversionTracker", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "OriginalConversionTypeId", Selectors: []string{"OriginalConversionTypeId"}, Filter: "OriginalConversionTypeId", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "ConversionTracker", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "ConversionTracker", Name: "Category", Selectors: []string{"Category"}, Filter: "Category"},
+		{Type: "ConversionTracker", Name: "GoogleEventSnippet", Selectors: []string{"GoogleEventSnippet"}, Filter: "GoogleEventSnippet", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "GoogleGlobalSiteTag", Selectors: []string{"GoogleGlobalSiteTag"}, Filter: "GoogleGlobalSiteTag", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "DataDrivenModelStatus", Selectors: []string{"DataDrivenModelStatus"}, Filter: "DataDrivenModelStatus", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTypeOwnerCustomerId", Selectors: []string{"ConversionTypeOwnerCustomerId"}, Filter: "ConversionTypeOwnerCustomerId", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ViewthroughLookbackWindow", Selectors: []string{"ViewthroughLookbackWindow"}, Filter: "ViewthroughLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CtcLookbackWindow", Selectors: []string{"CtcLookbackWindow"}, Filter: "CtcLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CountingType", Selectors: []string{"CountingType"}, Filter: "CountingType"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueValue", Selectors: []string{"DefaultRevenueValue"}, Filter: "DefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueCurrencyCode", Selectors: []string{"DefaultRevenueCurrencyCode"}, Filter: "DefaultRevenueCurrencyCode"},
+		{Type: "ConversionTracker", Name: "AlwaysUseDefaultRevenueValue", Selectors: []string{"AlwaysUseDefaultRevenueValue"}, Filter: "AlwaysUseDefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "ExcludeFromBidding", Selectors: []string{"ExcludeFromBidding"}, Filter: "ExcludeFromBidding"},
+		{Type: "ConversionTracker", Name: "AttributionModelType", Selectors: []string{"AttributionModelType"}},
+		{Type: "ConversionTracker", Name: "MostRecentConversionDate", Selectors: []string{"MostRecentConversionDate"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "LastReceivedRequestTime", Selectors: []string{"LastReceivedRequestTime"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTrackerType"},
+		{Type: "AdWordsConversionTracker", Name: "TrackingCodeType", Selectors: []string{"TrackingCodeType"}, Filter: "TrackingCodeType"},
+	},
+	"AppConversion": {
+		{Type: "ConversionTracker", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "OriginalConversionTypeId", Selectors: []string{"OriginalConversionTypeId"}, Filter: "OriginalConversionTypeId", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "ConversionTracker", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "ConversionTracker", Name: "Category", Selectors: []string{"Category"}, Filter: "Category"},
+		{Type: "ConversionTracker", Name: "GoogleEventSnippet", Selectors: []string{"GoogleEventSnippet"}, Filter: "GoogleEventSnippet", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "GoogleGlobalSiteTag", Selectors: []string{"GoogleGlobalSiteTag"}, Filter: "GoogleGlobalSiteTag", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "DataDrivenModelStatus", Selectors: []string{"DataDrivenModelStatus"}, Filter: "DataDrivenModelStatus", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTypeOwnerCustomerId", Selectors: []string{"ConversionTypeOwnerCustomerId"}, Filter: "ConversionTypeOwnerCustomerId", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ViewthroughLookbackWindow", Selectors: []string{"ViewthroughLookbackWindow"}, Filter: "ViewthroughLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CtcLookbackWindow", Selectors: []string{"CtcLookbackWindow"}, Filter: "CtcLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CountingType", Selectors: []string{"CountingType"}, Filter: "CountingType"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueValue", Selectors: []string{"DefaultRevenueValue"}, Filter: "DefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueCurrencyCode", Selectors: []string{"DefaultRevenueCurrencyCode"}, Filter: "DefaultRevenueCurrencyCode"},
+		{Type: "ConversionTracker", Name: "AlwaysUseDefaultRevenueValue", Selectors: []string{"AlwaysUseDefaultRevenueValue"}, Filter: "AlwaysUseDefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "ExcludeFromBidding", Selectors: []string{"ExcludeFromBidding"}, Filter: "ExcludeFromBidding"},
+		{Type: "ConversionTracker", Name: "AttributionModelType", Selectors: []string{"AttributionModelType"}},
+		{Type: "ConversionTracker", Name: "MostRecentConversionDate", Selectors: []string{"MostRecentConversionDate"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "LastReceivedRequestTime", Selectors: []string{"LastReceivedRequestTime"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTrackerType"},
+		{Type: "AppConversion", Name: "AppId", Selectors: []string{"AppId"}, Filter: "AppId"},
+		{Type: "AppConversion", Name: "AppPlatform", Selectors: []string{"AppPlatform"}, Filter: "AppPlatform"},
+		{Type: "AppConversion", Name: "Snippet", ReadOnly: true},
+		{Type: "AppConversion", Name: "AppConversionType"},
+		{Type: "AppConversion", Name: "AppPostbackUrl", Selectors: []string{"AppPostbackUrl"}, Filter: "AppPostbackUrl"},
+	},
+	"ConversionTracker": {
+		{Type: "ConversionTracker", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "OriginalConversionTypeId", Selectors: []string{"OriginalConversionTypeId"}, Filter: "OriginalConversionTypeId", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "ConversionTracker", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "ConversionTracker", Name: "Category", Selectors: []string{"Category"}, Filter: "Category"},
+		{Type: "ConversionTracker", Name: "GoogleEventSnippet", Selectors: []string{"GoogleEventSnippet"}, Filter: "GoogleEventSnippet", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "GoogleGlobalSiteTag", Selectors: []string{"GoogleGlobalSiteTag"}, Filter: "GoogleGlobalSiteTag", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "DataDrivenModelStatus", Selectors: []string{"DataDrivenModelStatus"}, Filter: "DataDrivenModelStatus", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTypeOwnerCustomerId", Selectors: []string{"ConversionTypeOwnerCustomerId"}, Filter: "ConversionTypeOwnerCustomerId", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ViewthroughLookbackWindow", Selectors: []string{"ViewthroughLookbackWindow"}, Filter: "ViewthroughLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CtcLookbackWindow", Selectors: []string{"CtcLookbackWindow"}, Filter: "CtcLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CountingType", Selectors: []string{"CountingType"}, Filter: "CountingType"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueValue", Selectors: []string{"DefaultRevenueValue"}, Filter: "DefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueCurrencyCode", Selectors: []string{"DefaultRevenueCurrencyCode"}, Filter: "DefaultRevenueCurrencyCode"},
+		{Type: "ConversionTracker", Name: "AlwaysUseDefaultRevenueValue", Selectors: []string{"AlwaysUseDefaultRevenueValue"}, Filter: "AlwaysUseDefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "ExcludeFromBidding", Selectors: []string{"ExcludeFromBidding"}, Filter: "ExcludeFromBidding"},
+		{Type: "ConversionTracker", Name: "AttributionModelType", Selectors: []string{"AttributionModelType"}},
+		{Type: "ConversionTracker", Name: "MostRecentConversionDate", Selectors: []string{"MostRecentConversionDate"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "LastReceivedRequestTime", Selectors: []string{"LastReceivedRequestTime"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTrackerType"},
+	},
+	"ConversionTrackerOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "ConversionTrackerOperation", Name: "Operand", Required: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"UploadCallConversion": {
+		{Type: "ConversionTracker", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "OriginalConversionTypeId", Selectors: []string{"OriginalConversionTypeId"}, Filter: "OriginalConversionTypeId", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "ConversionTracker", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "ConversionTracker", Name: "Category", Selectors: []string{"Category"}, Filter: "Category"},
+		{Type: "ConversionTracker", Name: "GoogleEventSnippet", Selectors: []string{"GoogleEventSnippet"}, Filter: "GoogleEventSnippet", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "GoogleGlobalSiteTag", Selectors: []string{"GoogleGlobalSiteTag"}, Filter: "GoogleGlobalSiteTag", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "DataDrivenModelStatus", Selectors: []string{"DataDrivenModelStatus"}, Filter: "DataDrivenModelStatus", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTypeOwnerCustomerId", Selectors: []string{"ConversionTypeOwnerCustomerId"}, Filter: "ConversionTypeOwnerCustomerId", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ViewthroughLookbackWindow", Selectors: []string{"ViewthroughLookbackWindow"}, Filter: "ViewthroughLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CtcLookbackWindow", Selectors: []string{"CtcLookbackWindow"}, Filter: "CtcLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CountingType", Selectors: []string{"CountingType"}, Filter: "CountingType"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueValue", Selectors: []string{"DefaultRevenueValue"}, Filter: "DefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueCurrencyCode", Selectors: []string{"DefaultRevenueCurrencyCode"}, Filter: "DefaultRevenueCurrencyCode"},
+		{Type: "ConversionTracker", Name: "AlwaysUseDefaultRevenueValue", Selectors: []string{"AlwaysUseDefaultRevenueValue"}, Filter: "AlwaysUseDefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "ExcludeFromBidding", Selectors: []string{"ExcludeFromBidding"}, Filter: "ExcludeFromBidding"},
+		{Type: "ConversionTracker", Name: "AttributionModelType", Selectors: []string{"AttributionModelType"}},
+		{Type: "ConversionTracker", Name: "MostRecentConversionDate", Selectors: []string{"MostRecentConversionDate"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "LastReceivedRequestTime", Selectors: []string{"LastReceivedRequestTime"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTrackerType"},
+	},
+	"UploadConversion": {
+		{Type: "ConversionTracker", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "OriginalConversionTypeId", Selectors: []string{"OriginalConversionTypeId"}, Filter: "OriginalConversionTypeId", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "ConversionTracker", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "ConversionTracker", Name: "Category", Selectors: []string{"Category"}, Filter: "Category"},
+		{Type: "ConversionTracker", Name: "GoogleEventSnippet", Selectors: []string{"GoogleEventSnippet"}, Filter: "GoogleEventSnippet", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "GoogleGlobalSiteTag", Selectors: []string{"GoogleGlobalSiteTag"}, Filter: "GoogleGlobalSiteTag", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "DataDrivenModelStatus", Selectors: []string{"DataDrivenModelStatus"}, Filter: "DataDrivenModelStatus", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTypeOwnerCustomerId", Selectors: []string{"ConversionTypeOwnerCustomerId"}, Filter: "ConversionTypeOwnerCustomerId", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ViewthroughLookbackWindow", Selectors: []string{"ViewthroughLookbackWindow"}, Filter: "ViewthroughLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CtcLookbackWindow", Selectors: []string{"CtcLookbackWindow"}, Filter: "CtcLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CountingType", Selectors: []string{"CountingType"}, Filter: "CountingType"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueValue", Selectors: []string{"DefaultRevenueValue"}, Filter: "DefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueCurrencyCode", Selectors: []string{"DefaultRevenueCurrencyCode"}, Filter: "DefaultRevenueCurrencyCode"},
+		{Type: "ConversionTracker", Name: "AlwaysUseDefaultRevenueValue", Selectors: []string{"AlwaysUseDefaultRevenueValue"}, Filter: "AlwaysUseDefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "ExcludeFromBidding", Selectors: []string{"ExcludeFromBidding"}, Filter: "ExcludeFromBidding"},
+		{Type: "ConversionTracker", Name: "AttributionModelType", Selectors: []string{"AttributionModelType"}},
+		{Type: "ConversionTracker", Name: "MostRecentConversionDate", Selectors: []string{"MostRecentConversionDate"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "LastReceivedRequestTime", Selectors: []string{"LastReceivedRequestTime"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTrackerType"},
+		{Type: "UploadConversion", Name: "IsExternallyAttributed", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+	},
+	"WebsiteCallMetricsConversion": {
+		{Type: "ConversionTracker", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "OriginalConversionTypeId", Selectors: []string{"OriginalConversionTypeId"}, Filter: "OriginalConversionTypeId", ReadOnly: true, ReadOnlyOperators: []string{"SET"}},
+		{Type: "ConversionTracker", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ConversionTracker", Name: "Status", Selectors: []string{"Status"}, Filter: "Status"},
+		{Type: "ConversionTracker", Name: "Category", Selectors: []string{"Category"}, Filter: "Category"},
+		{Type: "ConversionTracker", Name: "GoogleEventSnippet", Selectors: []string{"GoogleEventSnippet"}, Filter: "GoogleEventSnippet", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "GoogleGlobalSiteTag", Selectors: []string{"GoogleGlobalSiteTag"}, Filter: "GoogleGlobalSiteTag", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "DataDrivenModelStatus", Selectors: []string{"DataDrivenModelStatus"}, Filter: "DataDrivenModelStatus", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTypeOwnerCustomerId", Selectors: []string{"ConversionTypeOwnerCustomerId"}, Filter: "ConversionTypeOwnerCustomerId", ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ViewthroughLookbackWindow", Selectors: []string{"ViewthroughLookbackWindow"}, Filter: "ViewthroughLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CtcLookbackWindow", Selectors: []string{"CtcLookbackWindow"}, Filter: "CtcLookbackWindow"},
+		{Type: "ConversionTracker", Name: "CountingType", Selectors: []string{"CountingType"}, Filter: "CountingType"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueValue", Selectors: []string{"DefaultRevenueValue"}, Filter: "DefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "DefaultRevenueCurrencyCode", Selectors: []string{"DefaultRevenueCurrencyCode"}, Filter: "DefaultRevenueCurrencyCode"},
+		{Type: "ConversionTracker", Name: "AlwaysUseDefaultRevenueValue", Selectors: []string{"AlwaysUseDefaultRevenueValue"}, Filter: "AlwaysUseDefaultRevenueValue"},
+		{Type: "ConversionTracker", Name: "ExcludeFromBidding", Selectors: []string{"ExcludeFromBidding"}, Filter: "ExcludeFromBidding"},
+		{Type: "ConversionTracker", Name: "AttributionModelType", Selectors: []string{"AttributionModelType"}},
+		{Type: "ConversionTracker", Name: "MostRecentConversionDate", Selectors: []string{"MostRecentConversionDate"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "LastReceivedRequestTime", Selectors: []string{"LastReceivedRequestTime"}, ReadOnly: true},
+		{Type: "ConversionTracker", Name: "ConversionTrackerType"},
+		{Type: "WebsiteCallMetricsConversion", Name: "PhoneCallDuration", Selectors: []string{"WebsitePhoneCallDuration"}},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ConversionTrackerService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *ConversionTracker) *ConversionTrackerOperation {
+	op := OperatorADD
+	return &ConversionTrackerOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *ConversionTracker) *ConversionTrackerOperation {
+	op := OperatorSET
+	return &ConversionTrackerOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
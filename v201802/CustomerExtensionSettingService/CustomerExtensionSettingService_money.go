@@ -0,0 +1,109 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerExtensionSettingService
+
+import "github.com/godofdream/go-googleadsinofficial/money"
+
+// NewMoney returns a Money of micros.
+func NewMoney(micros int64) *Money {
+	return &Money{MicroAmount: &micros}
+}
+
+// ParseMoney converts the decimal amount s in the currency with the given
+// ISO 4217 code (e.g. ManagedCustomer.CurrencyCode) to Money, rounded to
+// the billable unit of the currency.
+func ParseMoney(s, currencyCode string) (*Money, error) {
+	c, err := money.Lookup(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	micros, err := c.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return NewMoney(micros), nil
+}
+
+// Micros returns the amount of m in micros, or 0 if m or its amount is nil.
+func (m *Money) Micros() int64 {
+	if m == nil || m.MicroAmount == nil {
+		return 0
+	}
+	return *m.MicroAmount
+}
+
+// Round returns a copy of m rounded to the billable unit of the currency
+// with the given ISO 4217 code.
+func (m *Money) Round(currencyCode string) (*Money, error) {
+	c, err := money.Lookup(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	micros, err := c.Round(m.Micros())
+	if err != nil {
+		return nil, err
+	}
+	return m.with(micros), nil
+}
+
+// Format formats m as a decimal amount in the currency with the given
+// ISO 4217 code, e.g. "12.34" for USD or "1235" for JPY.
+func (m *Money) Format(currencyCode string) (string, error) {
+	c, err := money.Lookup(currencyCode)
+	if err != nil {
+		return "", err
+	}
+	return c.Format(m.Micros()), nil
+}
+
+// Add returns m+o, failing with money.ErrOverflow instead of wrapping.
+func (m *Money) Add(o *Money) (*Money, error) {
+	micros, err := money.Add(m.Micros(), o.Micros())
+	if err != nil {
+		return nil, err
+	}
+	return m.with(micros), nil
+}
+
+// Sub returns m-o, failing with money.ErrOverflow instead of wrapping.
+func (m *Money) Sub(o *Money) (*Money, error) {
+	micros, err := money.Sub(m.Micros(), o.Micros())
+	if err != nil {
+		return nil, err
+	}
+	return m.with(micros), nil
+}
+
+// Scale returns m*num/den rounded half away from zero, e.g. m.Scale(110,
+// 100) for a 10% increase.
+func (m *Money) Scale(num, den int64) (*Money, error) {
+	micros, err := money.Scale(m.Micros(), num, den)
+	if err != nil {
+		return nil, err
+	}
+	return m.with(micros), nil
+}
+
+// with returns a Money of micros that keeps the ComparableValue of m,
+// which records its concrete type where a ComparableValue is expected.
+func (m *Money) with(micros int64) *Money {
+	r := NewMoney(micros)
+	if m != nil && m.ComparableValue != nil {
+		base := *m.ComparableValue
+		r.ComparableValue = &base
+	}
+	return r
+}
+
+// NewLongValue returns a LongValue of n.
+func NewLongValue(n int64) *LongValue {
+	return &LongValue{Number: &n}
+}
+
+// Int64 returns the number of v, or 0 if v or its number is nil.
+func (v *LongValue) Int64() int64 {
+	if v == nil || v.Number == nil {
+		return 0
+	}
+	return *v.Number
+}
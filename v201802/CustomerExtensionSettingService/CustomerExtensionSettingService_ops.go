@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerExtensionSettingService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CustomerExtensionSetting) *CustomerExtensionSettingOperation {
+	op := OperatorADD
+	return &CustomerExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *CustomerExtensionSetting) *CustomerExtensionSettingOperation {
+	op := OperatorSET
+	return &CustomerExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CustomerExtensionSetting) *CustomerExtensionSettingOperation {
+	op := OperatorREMOVE
+	return &CustomerExtensionSettingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
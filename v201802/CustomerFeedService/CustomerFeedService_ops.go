@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerFeedService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CustomerFeed) *CustomerFeedOperation {
+	op := OperatorADD
+	return &CustomerFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *CustomerFeed) *CustomerFeedOperation {
+	op := OperatorSET
+	return &CustomerFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CustomerFeed) *CustomerFeedOperation {
+	op := OperatorREMOVE
+	return &CustomerFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
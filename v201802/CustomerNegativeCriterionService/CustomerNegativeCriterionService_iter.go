@@ -0,0 +1,135 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerNegativeCriterionService
+
+import (
+	"iter"
+
+	"github.com/godofdream/go-googleadsinofficial/awql"
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// GetAll returns an iterator over the CustomerNegativeCriterion entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func GetAll(api CustomerNegativeCriterionServiceAPI, sel *Selector) iter.Seq2[*CustomerNegativeCriterion, error] {
+	return GetPager(api, sel).All()
+}
+
+// GetEach calls fn for every CustomerNegativeCriterion entry selected by sel and
+// returns the first error. See GetAll.
+func GetEach(api CustomerNegativeCriterionServiceAPI, sel *Selector, fn func(*CustomerNegativeCriterion) error) error {
+	return GetPager(api, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func GetPager(api CustomerNegativeCriterionServiceAPI, sel *Selector) *paging.Pager[*CustomerNegativeCriterion] {
+	p := &paging.Pager[*CustomerNegativeCriterion]{}
+	if sel == nil {
+		sel = new(Selector)
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*CustomerNegativeCriterion, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+		resp, err := api.Get(&Get{Selector: paged})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.Page != nil && resp.Rval.Page.TotalNumEntries != nil {
+			total = *resp.Rval.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// GetSplitAll is GetAll for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitAll(api CustomerNegativeCriterionServiceAPI, sel *Selector, split *paging.Splitter) iter.Seq2[*CustomerNegativeCriterion, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel.Neutral(), getSplitPager(api))
+}
+
+// GetSplitEach is GetEach for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitEach(api CustomerNegativeCriterionServiceAPI, sel *Selector, split *paging.Splitter, fn func(*CustomerNegativeCriterion) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel.Neutral(), getSplitPager(api), fn)
+}
+
+func getSplitPager(api CustomerNegativeCriterionServiceAPI) func(*selector.Selector) (*paging.Pager[*CustomerNegativeCriterion], error) {
+	return func(s *selector.Selector) (*paging.Pager[*CustomerNegativeCriterion], error) {
+		sel, err := ConvertSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return GetPager(api, sel), nil
+	}
+}
+
+// QueryAll returns an iterator over the CustomerNegativeCriterion entries of the AWQL
+// query, fetching one page after the other. The LIMIT clause of the query
+// selects the first entry and the page size. See paging.Pager for entries
+// added or removed while iterating.
+func QueryAll(api CustomerNegativeCriterionServiceAPI, query string) iter.Seq2[*CustomerNegativeCriterion, error] {
+	return QueryPager(api, query).All()
+}
+
+// QueryEach calls fn for every CustomerNegativeCriterion entry of the AWQL query and
+// returns the first error. See QueryAll.
+func QueryEach(api CustomerNegativeCriterionServiceAPI, query string, fn func(*CustomerNegativeCriterion) error) error {
+	return QueryPager(api, query).Each(fn)
+}
+
+// QueryPager returns the paging.Pager of QueryAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func QueryPager(api CustomerNegativeCriterionServiceAPI, query string) *paging.Pager[*CustomerNegativeCriterion] {
+	p := &paging.Pager[*CustomerNegativeCriterion]{}
+	q, err := awql.Parse(query)
+	if err == nil && q.Paging != nil {
+		p.Start, p.PageSize = q.Paging.StartIndex, q.Paging.NumberResults
+	}
+	p.Fetch = func(start, n int32) ([]*CustomerNegativeCriterion, int32, error) {
+		if err != nil {
+			return nil, 0, err
+		}
+		window := *q
+		window.Paging = &selector.Paging{StartIndex: start, NumberResults: n}
+		paged, err := window.Format()
+		if err != nil {
+			return nil, 0, err
+		}
+		resp, err := api.Query(&Query{Query: String(paged)})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.Page != nil && resp.Rval.Page.TotalNumEntries != nil {
+			total = *resp.Rval.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
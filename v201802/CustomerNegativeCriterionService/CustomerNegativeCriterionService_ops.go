@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerNegativeCriterionService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *CustomerNegativeCriterion) *CustomerNegativeCriterionOperation {
+	op := OperatorADD
+	return &CustomerNegativeCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *CustomerNegativeCriterion) *CustomerNegativeCriterionOperation {
+	op := OperatorREMOVE
+	return &CustomerNegativeCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -1004,260 +1004,220 @@ const (
 )
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -1265,13 +1225,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -1280,98 +1240,82 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -1382,13 +1326,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -1397,47 +1341,45 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -1445,12 +1387,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -1458,68 +1400,62 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type UrlError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 UrlError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *UrlErrorReason `xml:"reason,omitempty"`
+	Reason *UrlErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 //
@@ -1590,47 +1526,47 @@ const (
 )
 
 type GetCustomers struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getCustomers"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getCustomers" json:"-"`
 }
 
 type GetCustomersResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getCustomersResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getCustomersResponse" json:"-"`
 
-	Rval []*Customer `xml:"rval,omitempty"`
+	Rval []*Customer `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetServiceLinks struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getServiceLinks"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getServiceLinks" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Selector *Selector `xml:"selector,omitempty"`
+	Selector *Selector `xml:"https://adwords.google.com/api/adwords/mcm/v201802 selector,omitempty" json:"selector,omitempty"`
 }
 
 type GetServiceLinksResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getServiceLinksResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getServiceLinksResponse" json:"-"`
 
-	Rval []*ServiceLink `xml:"rval,omitempty"`
+	Rval []*ServiceLink `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Customer *Customer `xml:"customer,omitempty"`
+	Customer *Customer `xml:"https://adwords.google.com/api/adwords/mcm/v201802 customer,omitempty" json:"customer,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateResponse" json:"-"`
 
-	Rval *Customer `xml:"rval,omitempty"`
+	Rval *Customer `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateServiceLinks struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateServiceLinks"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateServiceLinks" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -1638,18 +1574,16 @@ type MutateServiceLinks struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: SET, REMOVE.</span>
 	//
-	Operations []*ServiceLinkOperation `xml:"operations,omitempty"`
+	Operations []*ServiceLinkOperation `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateServiceLinksResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateServiceLinksResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateServiceLinksResponse" json:"-"`
 
-	Rval []*ServiceLink `xml:"rval,omitempty"`
+	Rval []*ServiceLink `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type ConversionTrackingSettings struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ConversionTrackingSettings"`
-
 	//
 	// With Cross-Account Conversion Tracking, a manager can share its conversion tracking ID among
 	// the clients it manages. If a customer is using a manager's conversion tracking ID we store
@@ -1662,7 +1596,7 @@ type ConversionTrackingSettings struct {
 	// conversion tracking ID.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	EffectiveConversionTrackingId int64 `xml:"effectiveConversionTrackingId,omitempty"`
+	EffectiveConversionTrackingId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 effectiveConversionTrackingId,omitempty" json:"effectiveConversionTrackingId,omitempty"`
 
 	//
 	// True if a customer is using cross-account conversion tracking.
@@ -1670,24 +1604,22 @@ type ConversionTrackingSettings struct {
 	// his own conversion tracking ID.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UsesCrossAccountConversionTracking bool `xml:"usesCrossAccountConversionTracking,omitempty"`
+	UsesCrossAccountConversionTracking *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 usesCrossAccountConversionTracking,omitempty" json:"usesCrossAccountConversionTracking,omitempty"`
 
 	//
 	// True if customer has selected to include cross-device conversions
 	// in the "Conversions" column, which is used by any conversion-based bid
 	// strategies; false otherwise.
 	//
-	OptimizeOnEstimatedConversions bool `xml:"optimizeOnEstimatedConversions,omitempty"`
+	OptimizeOnEstimatedConversions *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 optimizeOnEstimatedConversions,omitempty" json:"optimizeOnEstimatedConversions,omitempty"`
 }
 
 type Customer struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 Customer"`
-
 	//
 	// The 10-digit AdWords Customer ID.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CustomerId int64 `xml:"customerId,omitempty"`
+	CustomerId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 customerId,omitempty" json:"customerId,omitempty"`
 
 	//
 	// The currency in which this account operates.
@@ -1697,7 +1629,7 @@ type Customer struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	// <span class="constraint StringLength">The length of this string should be between 3 and 3, inclusive.</span>
 	//
-	CurrencyCode string `xml:"currencyCode,omitempty"`
+	CurrencyCode *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 currencyCode,omitempty" json:"currencyCode,omitempty"`
 
 	//
 	// The local timezone ID for this customer.
@@ -1706,37 +1638,37 @@ type Customer struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	// <span class="constraint StringLength">This string must not be empty.</span>
 	//
-	DateTimeZone string `xml:"dateTimeZone,omitempty"`
+	DateTimeZone *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 dateTimeZone,omitempty" json:"dateTimeZone,omitempty"`
 
 	//
 	// An optional, non-unique descriptive name for this customer.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DescriptiveName string `xml:"descriptiveName,omitempty"`
+	DescriptiveName *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 descriptiveName,omitempty" json:"descriptiveName,omitempty"`
 
 	//
 	// Whether this customer can manage other AdWords customers
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CanManageClients bool `xml:"canManageClients,omitempty"`
+	CanManageClients *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 canManageClients,omitempty" json:"canManageClients,omitempty"`
 
 	//
 	// Whether this customer's account is a test account.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	TestAccount bool `xml:"testAccount,omitempty"`
+	TestAccount *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 testAccount,omitempty" json:"testAccount,omitempty"`
 
 	//
 	// Whether auto-tagging is enabled for this customer.
 	//
-	AutoTaggingEnabled bool `xml:"autoTaggingEnabled,omitempty"`
+	AutoTaggingEnabled *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 autoTaggingEnabled,omitempty" json:"autoTaggingEnabled,omitempty"`
 
 	//
 	// URL template for constructing a tracking URL.
 	//
 	// <p>On update, empty string ("") indicates to clear the field.
 	//
-	TrackingUrlTemplate string `xml:"trackingUrlTemplate,omitempty"`
+	TrackingUrlTemplate *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 trackingUrlTemplate,omitempty" json:"trackingUrlTemplate,omitempty"`
 
 	//
 	// URL template for appending params to Final URL.
@@ -1744,89 +1676,81 @@ type Customer struct {
 	// <p>On update, empty string ("") indicates to clear the field.
 	// <p>This field is supported only in test accounts.
 	//
-	FinalUrlSuffix string `xml:"finalUrlSuffix,omitempty"`
+	FinalUrlSuffix *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 finalUrlSuffix,omitempty" json:"finalUrlSuffix,omitempty"`
 
 	//
 	// Whether parallel tracking is enabled for this customer.
 	//
 	// <p>This field is supported only in test accounts.
 	//
-	ParallelTrackingEnabled bool `xml:"parallelTrackingEnabled,omitempty"`
+	ParallelTrackingEnabled *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 parallelTrackingEnabled,omitempty" json:"parallelTrackingEnabled,omitempty"`
 
 	//
 	// Customer-level AdWords Conversion Tracking settings
 	//
-	ConversionTrackingSettings *ConversionTrackingSettings `xml:"conversionTrackingSettings,omitempty"`
+	ConversionTrackingSettings *ConversionTrackingSettings `xml:"https://adwords.google.com/api/adwords/mcm/v201802 conversionTrackingSettings,omitempty" json:"conversionTrackingSettings,omitempty"`
 
 	//
 	// Customer-level AdWords Remarketing settings
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	RemarketingSettings *RemarketingSettings `xml:"remarketingSettings,omitempty"`
+	RemarketingSettings *RemarketingSettings `xml:"https://adwords.google.com/api/adwords/mcm/v201802 remarketingSettings,omitempty" json:"remarketingSettings,omitempty"`
 }
 
 type CustomerError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 CustomerError"`
-
 	*ApiError
 
-	Reason *CustomerErrorReason `xml:"reason,omitempty"`
+	Reason *CustomerErrorReason `xml:"https://adwords.google.com/api/adwords/mcm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RemarketingSettings struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 RemarketingSettings"`
-
 	//
 	// The Adwords remarketing tag snippet for the customer.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Snippet string `xml:"snippet,omitempty"`
+	Snippet *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 snippet,omitempty" json:"snippet,omitempty"`
 
 	//
 	// The google one global site tag for the customer.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	GoogleGlobalSiteTag string `xml:"googleGlobalSiteTag,omitempty"`
+	GoogleGlobalSiteTag *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 googleGlobalSiteTag,omitempty" json:"googleGlobalSiteTag,omitempty"`
 }
 
 type ServiceLink struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ServiceLink"`
-
 	//
 	// The service being linked.
 	// <span class="constraint Filterable">This field can be filtered on using the value "ServiceType".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceType *ServiceType `xml:"serviceType,omitempty"`
+	ServiceType *ServiceType `xml:"https://adwords.google.com/api/adwords/mcm/v201802 serviceType,omitempty" json:"serviceType,omitempty"`
 
 	//
 	// An ID uniquely identifying this link within a given {@link serviceType}.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	ServiceLinkId int64 `xml:"serviceLinkId,omitempty"`
+	ServiceLinkId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 serviceLinkId,omitempty" json:"serviceLinkId,omitempty"`
 
 	//
 	// Status of the link.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET.</span>
 	//
-	LinkStatus *ServiceLinkLinkStatus `xml:"linkStatus,omitempty"`
+	LinkStatus *ServiceLinkLinkStatus `xml:"https://adwords.google.com/api/adwords/mcm/v201802 linkStatus,omitempty" json:"linkStatus,omitempty"`
 
 	//
 	// An identifier for the service account to which the AdWords account is linked.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type ServiceLinkOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ServiceLinkOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *ServiceLink `xml:"operand,omitempty"`
+	Operand *ServiceLink `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type CustomerServiceInterface struct {
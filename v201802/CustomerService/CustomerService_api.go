@@ -0,0 +1,142 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// CustomerServiceAPI is the interface of the CustomerService operations. It is
+// implemented by CustomerServiceInterface and, for tests, by FakeCustomerService.
+type CustomerServiceAPI interface {
+	GetCustomers(request *GetCustomers) (*GetCustomersResponse, error)
+	GetServiceLinks(request *GetServiceLinks) (*GetServiceLinksResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+	MutateServiceLinks(request *MutateServiceLinks) (*MutateServiceLinksResponse, error)
+}
+
+var _ CustomerServiceAPI = (*CustomerServiceInterface)(nil)
+var _ CustomerServiceAPI = (*FakeCustomerService)(nil)
+
+// FakeCall is a call recorded by FakeCustomerService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "GetCustomers".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeCustomerService is a programmable CustomerServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeCustomerService struct {
+	GetCustomersFunc       func(request *GetCustomers) (*GetCustomersResponse, error)
+	GetServiceLinksFunc    func(request *GetServiceLinks) (*GetServiceLinksResponse, error)
+	MutateFunc             func(request *Mutate) (*MutateResponse, error)
+	MutateServiceLinksFunc func(request *MutateServiceLinks) (*MutateServiceLinksResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeCustomerService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeCustomerService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeCustomerService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// GetCustomers records the call and delegates to GetCustomersFunc.
+func (f *FakeCustomerService) GetCustomers(request *GetCustomers) (*GetCustomersResponse, error) {
+	f.record("GetCustomers", request)
+	if f.GetCustomersFunc == nil {
+		return nil, fmt.Errorf("FakeCustomerService.GetCustomersFunc is not set")
+	}
+	return f.GetCustomersFunc(request)
+}
+
+// GetCustomersCalls returns the requests of the recorded GetCustomers calls.
+func (f *FakeCustomerService) GetCustomersCalls() []*GetCustomers {
+	var requests []*GetCustomers
+	for _, c := range f.Calls() {
+		if c.Method == "GetCustomers" {
+			requests = append(requests, c.Request.(*GetCustomers))
+		}
+	}
+	return requests
+}
+
+// GetServiceLinks records the call and delegates to GetServiceLinksFunc.
+func (f *FakeCustomerService) GetServiceLinks(request *GetServiceLinks) (*GetServiceLinksResponse, error) {
+	f.record("GetServiceLinks", request)
+	if f.GetServiceLinksFunc == nil {
+		return nil, fmt.Errorf("FakeCustomerService.GetServiceLinksFunc is not set")
+	}
+	return f.GetServiceLinksFunc(request)
+}
+
+// GetServiceLinksCalls returns the requests of the recorded GetServiceLinks calls.
+func (f *FakeCustomerService) GetServiceLinksCalls() []*GetServiceLinks {
+	var requests []*GetServiceLinks
+	for _, c := range f.Calls() {
+		if c.Method == "GetServiceLinks" {
+			requests = append(requests, c.Request.(*GetServiceLinks))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeCustomerService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeCustomerService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeCustomerService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
+
+// MutateServiceLinks records the call and delegates to MutateServiceLinksFunc.
+func (f *FakeCustomerService) MutateServiceLinks(request *MutateServiceLinks) (*MutateServiceLinksResponse, error) {
+	f.record("MutateServiceLinks", request)
+	if f.MutateServiceLinksFunc == nil {
+		return nil, fmt.Errorf("FakeCustomerService.MutateServiceLinksFunc is not set")
+	}
+	return f.MutateServiceLinksFunc(request)
+}
+
+// MutateServiceLinksCalls returns the requests of the recorded MutateServiceLinks calls.
+func (f *FakeCustomerService) MutateServiceLinksCalls() []*MutateServiceLinks {
+	var requests []*MutateServiceLinks
+	for _, c := range f.Calls() {
+		if c.Method == "MutateServiceLinks" {
+			requests = append(requests, c.Request.(*MutateServiceLinks))
+		}
+	}
+	return requests
+}
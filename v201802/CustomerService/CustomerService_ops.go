@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerService
+
+// SetServiceLinkOp returns the SET operation of operand.
+func SetServiceLinkOp(operand *ServiceLink) *ServiceLinkOperation {
+	op := OperatorSET
+	return &ServiceLinkOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveServiceLinkOp returns the REMOVE operation of operand.
+func RemoveServiceLinkOp(operand *ServiceLink) *ServiceLinkOperation {
+	op := OperatorREMOVE
+	return &ServiceLinkOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,283 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks GetCustomers against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetCustomers) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetServiceLinks against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetServiceLinks) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks MutateServiceLinks against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *MutateServiceLinks) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks ServiceLinkOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *ServiceLinkOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
+
+func (t *GetCustomers) validate(v *validate.Validator, path, op string) {
+}
+
+func (t *GetServiceLinks) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(GetServiceLinks)
+	}
+	v.Required(validate.Field(path, "selector"), t.Selector != nil)
+	if t.Selector != nil {
+		t.Selector.validate(v, validate.Field(path, "selector"), op)
+	}
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "customer"), t.Customer != nil)
+	if t.Customer != nil {
+		t.Customer.validate(v, validate.Field(path, "customer"), op)
+	}
+}
+
+func (t *MutateServiceLinks) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(MutateServiceLinks)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "SET", "REMOVE")
+		}
+	}
+}
+
+func (t *ConversionTrackingSettings) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(ConversionTrackingSettings)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "effectiveConversionTrackingId"), op, t.EffectiveConversionTrackingId != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "usesCrossAccountConversionTracking"), op, t.UsesCrossAccountConversionTracking != nil)
+	}
+}
+
+func (t *Customer) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Customer)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "customerId"), op, t.CustomerId != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "currencyCode"), op, t.CurrencyCode != nil)
+	}
+	if t.CurrencyCode != nil {
+		v.StringLength(validate.Field(path, "currencyCode"), *t.CurrencyCode, 3, 3, false, false)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "dateTimeZone"), op, t.DateTimeZone != nil)
+	}
+	if t.DateTimeZone != nil {
+		v.StringLength(validate.Field(path, "dateTimeZone"), *t.DateTimeZone, 1, -1, false, false)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "descriptiveName"), op, t.DescriptiveName != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "canManageClients"), op, t.CanManageClients != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "testAccount"), op, t.TestAccount != nil)
+	}
+	if t.ConversionTrackingSettings != nil {
+		t.ConversionTrackingSettings.validate(v, validate.Field(path, "conversionTrackingSettings"), op)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "remarketingSettings"), op, t.RemarketingSettings != nil)
+	}
+	if t.RemarketingSettings != nil {
+		t.RemarketingSettings.validate(v, validate.Field(path, "remarketingSettings"), op)
+	}
+}
+
+func (t *RemarketingSettings) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(RemarketingSettings)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "snippet"), op, t.Snippet != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "googleGlobalSiteTag"), op, t.GoogleGlobalSiteTag != nil)
+	}
+}
+
+func (t *ServiceLink) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(ServiceLink)
+	}
+	v.Required(validate.Field(path, "serviceType"), t.ServiceType != nil)
+	if validate.HasOperator(op, "REMOVE", "SET") {
+		v.Required(validate.Field(path, "serviceLinkId"), t.ServiceLinkId != nil)
+	}
+	if validate.HasOperator(op, "SET") {
+		v.Required(validate.Field(path, "linkStatus"), t.LinkStatus != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "name"), op, t.Name != nil)
+	}
+}
+
+func (t *ServiceLinkOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(ServiceLinkOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (o *ServiceLinkOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *ServiceLinkOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	return validate.ID(t.ServiceLinkId)
+}
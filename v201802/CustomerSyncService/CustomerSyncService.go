@@ -290,261 +290,209 @@ const (
 )
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
-	Trigger string `xml:"trigger,omitempty"`
-
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateTimeRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateTimeRange"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
-	Min string `xml:"min,omitempty"`
-
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
-	Field string `xml:"field,omitempty"`
-
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 //
@@ -606,153 +554,143 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Selector *CustomerSyncSelector `xml:"selector,omitempty"`
+	Selector *CustomerSyncSelector `xml:"https://adwords.google.com/api/adwords/ch/v201802 selector,omitempty" json:"selector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 getResponse" json:"-"`
 
-	Rval *CustomerChangeData `xml:"rval,omitempty"`
+	Rval *CustomerChangeData `xml:"https://adwords.google.com/api/adwords/ch/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdGroupChangeData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 AdGroupChangeData"`
-
 	//
 	// The ad group ID.
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// Whether or not the fields of this adgroup have changed, for example the AdGroup name. Changes
 	// to the Ads and Criteria are enumerated in their respective lists and will not be reflected in
 	// this status.
 	//
-	AdGroupChangeStatus *ChangeStatus `xml:"adGroupChangeStatus,omitempty"`
+	AdGroupChangeStatus *ChangeStatus `xml:"https://adwords.google.com/api/adwords/ch/v201802 adGroupChangeStatus,omitempty" json:"adGroupChangeStatus,omitempty"`
 
 	//
 	// The IDs of any changed ads of this ad group. This includes ads that have been deleted.
 	//
-	ChangedAds []int64 `xml:"changedAds,omitempty"`
+	ChangedAds []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedAds,omitempty" json:"changedAds,omitempty"`
 
 	//
 	// The IDs of any changed criterion of this ad group.
 	//
-	ChangedCriteria []int64 `xml:"changedCriteria,omitempty"`
+	ChangedCriteria []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedCriteria,omitempty" json:"changedCriteria,omitempty"`
 
 	//
 	// The IDs of any deleted criterion of this ad group.
 	//
-	RemovedCriteria []int64 `xml:"removedCriteria,omitempty"`
+	RemovedCriteria []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 removedCriteria,omitempty" json:"removedCriteria,omitempty"`
 
 	//
 	// A list of feed IDs for AdGroupFeeds that have been changed in this ad group. If an AdGroupFeed
 	// is deleted after a modification, it will not be included in this list.
 	//
-	ChangedFeeds []int64 `xml:"changedFeeds,omitempty"`
+	ChangedFeeds []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedFeeds,omitempty" json:"changedFeeds,omitempty"`
 
 	//
 	// A list of feed IDs for AdGroupFeeds that have been removed from the ad group.
 	//
-	RemovedFeeds []int64 `xml:"removedFeeds,omitempty"`
+	RemovedFeeds []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 removedFeeds,omitempty" json:"removedFeeds,omitempty"`
 
 	//
 	// Set of campaign criterion that have a bid modifier override at ad group level. If the
 	// associated bid modifier override is deleted after a modification, it will not be included in
 	// this list.
 	//
-	ChangedAdGroupBidModifierCriteria []int64 `xml:"changedAdGroupBidModifierCriteria,omitempty"`
+	ChangedAdGroupBidModifierCriteria []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedAdGroupBidModifierCriteria,omitempty" json:"changedAdGroupBidModifierCriteria,omitempty"`
 
 	//
 	// Set of campaign criterion whose bid modifier override at ad group level has been removed.
 	//
-	RemovedAdGroupBidModifierCriteria []int64 `xml:"removedAdGroupBidModifierCriteria,omitempty"`
+	RemovedAdGroupBidModifierCriteria []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 removedAdGroupBidModifierCriteria,omitempty" json:"removedAdGroupBidModifierCriteria,omitempty"`
 }
 
 type CampaignChangeData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 CampaignChangeData"`
-
 	//
 	// The campaign ID.
 	//
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 
 	//
 	// Whether or not the fields of this campaign have changed. Changes to campaign level criteria and
 	// ad extensions are enumerated in their respective lists and will not be reflected in this
 	// status.
 	//
-	CampaignChangeStatus *ChangeStatus `xml:"campaignChangeStatus,omitempty"`
+	CampaignChangeStatus *ChangeStatus `xml:"https://adwords.google.com/api/adwords/ch/v201802 campaignChangeStatus,omitempty" json:"campaignChangeStatus,omitempty"`
 
 	//
 	// A list of change information for all changed adgroups belonging to the campaign.
 	//
-	ChangedAdGroups []*AdGroupChangeData `xml:"changedAdGroups,omitempty"`
+	ChangedAdGroups []*AdGroupChangeData `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedAdGroups,omitempty" json:"changedAdGroups,omitempty"`
 
 	//
 	// A list of criteria IDs that have been added as campaign criteria. This list includes any
 	// criteria that can be downloaded using CampaignCriterionService.
 	//
-	AddedCampaignCriteria []int64 `xml:"addedCampaignCriteria,omitempty"`
+	AddedCampaignCriteria []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 addedCampaignCriteria,omitempty" json:"addedCampaignCriteria,omitempty"`
 
 	//
 	// A list of criteria IDs that have been deleted as campaign criteria. This list includes any
 	// criteria that can be downloaded using CampaignCriterionService.
 	//
-	RemovedCampaignCriteria []int64 `xml:"removedCampaignCriteria,omitempty"`
+	RemovedCampaignCriteria []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 removedCampaignCriteria,omitempty" json:"removedCampaignCriteria,omitempty"`
 
 	//
 	// A list of feed IDs for CampaignFeeds that have been changed in this campaign. If a CampaignFeed
 	// is deleted after a modification, it will not be included in this list.
 	//
-	ChangedFeeds []int64 `xml:"changedFeeds,omitempty"`
+	ChangedFeeds []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedFeeds,omitempty" json:"changedFeeds,omitempty"`
 
 	//
 	// A list of feed IDs for CampaignFeeds that have been removed from the campaign.
 	//
-	RemovedFeeds []int64 `xml:"removedFeeds,omitempty"`
+	RemovedFeeds []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 removedFeeds,omitempty" json:"removedFeeds,omitempty"`
 }
 
 type CustomerChangeData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 CustomerChangeData"`
-
 	//
 	// A list of campaign changes for the customer, as specified by the selector. If a campaign is
 	// included in the selector it will be included in this list, even if the campaign did not change.
 	//
-	ChangedCampaigns []*CampaignChangeData `xml:"changedCampaigns,omitempty"`
+	ChangedCampaigns []*CampaignChangeData `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedCampaigns,omitempty" json:"changedCampaigns,omitempty"`
 
 	//
 	// A list of feed changes for the customer as specified in the selector. If a feed is included in
 	// the selector then it will be included in this list, even if the feed did not change.
 	//
-	ChangedFeeds []*FeedChangeData `xml:"changedFeeds,omitempty"`
+	ChangedFeeds []*FeedChangeData `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedFeeds,omitempty" json:"changedFeeds,omitempty"`
 
 	//
 	// The timestamp for the last changed processed for this customer. It is important that this
 	// timestamp be used for subsequent requests to avoid missing any changes.
 	//
-	LastChangeTimestamp string `xml:"lastChangeTimestamp,omitempty"`
+	LastChangeTimestamp *string `xml:"https://adwords.google.com/api/adwords/ch/v201802 lastChangeTimestamp,omitempty" json:"lastChangeTimestamp,omitempty"`
 }
 
 type CustomerSyncError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 CustomerSyncError"`
-
 	*ApiError
 
-	Reason *CustomerSyncErrorReason `xml:"reason,omitempty"`
+	Reason *CustomerSyncErrorReason `xml:"https://adwords.google.com/api/adwords/ch/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 }
 
 type CustomerSyncSelector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 CustomerSyncSelector"`
-
 	//
 	// Only return entities that have changed during the specified time range. String Format: yyyyMMdd
 	// HHmmss <Timezone ID> (for example, 20100609 150223 America/New_York). See the <a
@@ -760,44 +698,42 @@ type CustomerSyncSelector struct {
 	// the complete list of Timezone IDs.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	DateTimeRange *DateTimeRange `xml:"dateTimeRange,omitempty"`
+	DateTimeRange *DateTimeRange `xml:"https://adwords.google.com/api/adwords/ch/v201802 dateTimeRange,omitempty" json:"dateTimeRange,omitempty"`
 
 	//
 	// Return entities belonging to these campaigns.
 	// <span class="constraint ContentsDistinct">This field must contain distinct elements.</span>
 	//
-	CampaignIds []int64 `xml:"campaignIds,omitempty"`
+	CampaignIds []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 campaignIds,omitempty" json:"campaignIds,omitempty"`
 
 	//
 	// Return entities belonging to these feeds.
 	// <span class="constraint ContentsDistinct">This field must contain distinct elements.</span>
 	//
-	FeedIds []int64 `xml:"feedIds,omitempty"`
+	FeedIds []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 feedIds,omitempty" json:"feedIds,omitempty"`
 }
 
 type FeedChangeData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/ch/v201802 FeedChangeData"`
-
 	//
 	// The feed ID.
 	//
-	FeedId int64 `xml:"feedId,omitempty"`
+	FeedId *int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 feedId,omitempty" json:"feedId,omitempty"`
 
 	//
 	// Whether or not the fields of this feed have changed.
 	//
-	FeedChangeStatus *ChangeStatus `xml:"feedChangeStatus,omitempty"`
+	FeedChangeStatus *ChangeStatus `xml:"https://adwords.google.com/api/adwords/ch/v201802 feedChangeStatus,omitempty" json:"feedChangeStatus,omitempty"`
 
 	//
 	// A list of feed item IDs that have been added or modified within the the feed. If a feed item is
 	// deleted after a modification, it will not be included in this list.
 	//
-	ChangedFeedItems []int64 `xml:"changedFeedItems,omitempty"`
+	ChangedFeedItems []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 changedFeedItems,omitempty" json:"changedFeedItems,omitempty"`
 
 	//
 	// A list feed item IDs that have been removed from the feed.
 	//
-	RemovedFeedItems []int64 `xml:"removedFeedItems,omitempty"`
+	RemovedFeedItems []int64 `xml:"https://adwords.google.com/api/adwords/ch/v201802 removedFeedItems,omitempty" json:"removedFeedItems,omitempty"`
 }
 
 type CustomerSyncServiceInterface struct {
@@ -0,0 +1,43 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerSyncService
+
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// NewDateTimeRange returns the DateTimeRange between two times formatted in
+// loc, the time zone of the account.
+func NewDateTimeRange(from, to time.Time, loc *time.Location) *DateTimeRange {
+	return &DateTimeRange{Min: String(datetime.FormatDateTime(from, loc)), Max: String(datetime.FormatDateTime(to, loc))}
+}
+
+// Times returns the bounds of r. loc is used for bounds without a time zone
+// ID. A bound that is not set is returned as the zero time.Time.
+func (r *DateTimeRange) Times(loc *time.Location) (from, to time.Time, err error) {
+	if r.Min != nil {
+		if from, err = datetime.ParseDateTime(*r.Min, loc); err != nil {
+			return
+		}
+	}
+	if r.Max != nil {
+		to, err = datetime.ParseDateTime(*r.Max, loc)
+	}
+	return
+}
+
+// GetLastChangeTimestamp returns the LastChangeTimestamp of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *CustomerChangeData) GetLastChangeTimestamp(loc *time.Location) (time.Time, error) {
+	if t.LastChangeTimestamp == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.LastChangeTimestamp, loc)
+}
+
+// SetLastChangeTimestamp sets the LastChangeTimestamp of t to tm formatted in loc.
+func (t *CustomerChangeData) SetLastChangeTimestamp(tm time.Time, loc *time.Location) {
+	t.LastChangeTimestamp = String(datetime.FormatDateTime(tm, loc))
+}
@@ -0,0 +1,47 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package CustomerSyncService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *DateTimeRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateTimeRange)
+	}
+	if t.Min != nil {
+		v.DateTime(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.DateTime(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "selector"), t.Selector != nil)
+	if t.Selector != nil {
+		t.Selector.validate(v, validate.Field(path, "selector"), op)
+	}
+}
+
+func (t *CustomerSyncSelector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CustomerSyncSelector)
+	}
+	v.Required(validate.Field(path, "dateTimeRange"), t.DateTimeRange != nil)
+	if t.DateTimeRange != nil {
+		t.DateTimeRange.validate(v, validate.Field(path, "dateTimeRange"), op)
+	}
+	v.ContentsDistinct(validate.Field(path, "campaignIds"), t.CampaignIds)
+	v.ContentsDistinct(validate.Field(path, "feedIds"), t.FeedIds)
+}
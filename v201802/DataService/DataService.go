@@ -1018,134 +1018,132 @@ const (
 )
 
 type GetAdGroupBidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getAdGroupBidLandscape"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getAdGroupBidLandscape" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetAdGroupBidLandscapeResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getAdGroupBidLandscapeResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getAdGroupBidLandscapeResponse" json:"-"`
 
-	Rval *AdGroupBidLandscapePage `xml:"rval,omitempty"`
+	Rval *AdGroupBidLandscapePage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetCampaignCriterionBidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCampaignCriterionBidLandscape"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCampaignCriterionBidLandscape" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetCampaignCriterionBidLandscapeResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCampaignCriterionBidLandscapeResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCampaignCriterionBidLandscapeResponse" json:"-"`
 
-	Rval *CriterionBidLandscapePage `xml:"rval,omitempty"`
+	Rval *CriterionBidLandscapePage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetCriterionBidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCriterionBidLandscape"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCriterionBidLandscape" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetCriterionBidLandscapeResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCriterionBidLandscapeResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getCriterionBidLandscapeResponse" json:"-"`
 
-	Rval *CriterionBidLandscapePage `xml:"rval,omitempty"`
+	Rval *CriterionBidLandscapePage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetDomainCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getDomainCategory"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getDomainCategory" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetDomainCategoryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getDomainCategoryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 getDomainCategoryResponse" json:"-"`
 
-	Rval *DomainCategoryPage `xml:"rval,omitempty"`
+	Rval *DomainCategoryPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type QueryAdGroupBidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryAdGroupBidLandscape"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryAdGroupBidLandscape" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryAdGroupBidLandscapeResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryAdGroupBidLandscapeResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryAdGroupBidLandscapeResponse" json:"-"`
 
-	Rval *AdGroupBidLandscapePage `xml:"rval,omitempty"`
+	Rval *AdGroupBidLandscapePage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type QueryCampaignCriterionBidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryCampaignCriterionBidLandscape"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryCampaignCriterionBidLandscape" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryCampaignCriterionBidLandscapeResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryCampaignCriterionBidLandscapeResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryCampaignCriterionBidLandscapeResponse" json:"-"`
 
-	Rval *CriterionBidLandscapePage `xml:"rval,omitempty"`
+	Rval *CriterionBidLandscapePage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type QueryCriterionBidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryCriterionBidLandscape"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryCriterionBidLandscape" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryCriterionBidLandscapeResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryCriterionBidLandscapeResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryCriterionBidLandscapeResponse" json:"-"`
 
-	Rval *CriterionBidLandscapePage `xml:"rval,omitempty"`
+	Rval *CriterionBidLandscapePage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type QueryDomainCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryDomainCategory"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryDomainCategory" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Query string `xml:"query,omitempty"`
+	Query *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 query,omitempty" json:"query,omitempty"`
 }
 
 type QueryDomainCategoryResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryDomainCategoryResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 queryDomainCategoryResponse" json:"-"`
 
-	Rval *DomainCategoryPage `xml:"rval,omitempty"`
+	Rval *DomainCategoryPage `xml:"https://adwords.google.com/api/adwords/cm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdGroupBidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupBidLandscape"`
-
 	*BidLandscape
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "LandscapeType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Type_ *AdGroupBidLandscapeType `xml:"type,omitempty"`
+	Type_ *AdGroupBidLandscapeType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Only applies to landscapes with {@code landscapeType == DEFAULT}. If true, then this bid
@@ -1156,116 +1154,100 @@ type AdGroupBidLandscape struct {
 	// {@code landscapeType}s null is returned.
 	// <span class="constraint Selectable">This field can be selected using the value "LandscapeCurrent".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	LandscapeCurrent bool `xml:"landscapeCurrent,omitempty"`
+	LandscapeCurrent *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 landscapeCurrent,omitempty" json:"landscapeCurrent,omitempty"`
 }
 
 type AdGroupBidLandscapePage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupBidLandscapePage"`
-
 	*NoStatsPage
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*AdGroupBidLandscape `xml:"entries,omitempty"`
+	Entries []*AdGroupBidLandscape `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BidLandscape"`
-
 	*DataEntry
 
 	//
@@ -1273,7 +1255,7 @@ type BidLandscape struct {
 	// landscape is associated.
 	// <span class="constraint Selectable">This field can be selected using the value "CampaignId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 
 	//
 	// ID of the ad group that contains the criterion with which this bid
@@ -1281,7 +1263,7 @@ type BidLandscape struct {
 	// Only available for ad group bid landscapes and ad group criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "AdGroupId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// First day on which this landscape is based. Typically, it could be
@@ -1289,216 +1271,194 @@ type BidLandscape struct {
 	// <span class="constraint Selectable">This field can be selected using the value "StartDate".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	StartDate string `xml:"startDate,omitempty"`
+	StartDate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 startDate,omitempty" json:"startDate,omitempty"`
 
 	//
 	// Last day on which this landscape is based.
 	// <span class="constraint Selectable">This field can be selected using the value "EndDate".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	EndDate string `xml:"endDate,omitempty"`
+	EndDate *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 endDate,omitempty" json:"endDate,omitempty"`
 
 	//
 	// List of landscape points, each corresponding to a specifid bid amount.
 	//
-	LandscapePoints []*BidLandscapeLandscapePoint `xml:"landscapePoints,omitempty"`
+	LandscapePoints []*BidLandscapeLandscapePoint `xml:"https://adwords.google.com/api/adwords/cm/v201802 landscapePoints,omitempty" json:"landscapePoints,omitempty"`
 }
 
 type BidLandscapeLandscapePoint struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BidLandscape.LandscapePoint"`
-
 	//
 	// The bid amount used to estimate this landscape point's data.
 	// Only available for ad group bid landscapes and ad group criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "Bid".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Bid *Money `xml:"bid,omitempty"`
+	Bid *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 bid,omitempty" json:"bid,omitempty"`
 
 	//
 	// Estimated number of clicks at this bid. For mobile bid modifier landscapes, this is the
 	// estimated number of clicks for mobile only.
 	// <span class="constraint Selectable">This field can be selected using the value "LocalClicks".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Clicks int64 `xml:"clicks,omitempty"`
+	Clicks *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 clicks,omitempty" json:"clicks,omitempty"`
 
 	//
 	// Estimated cost at this bid. For mobile bid modifier landscapes, this is the estimated cost
 	// for mobile only.
 	// <span class="constraint Selectable">This field can be selected using the value "LocalCost".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Cost *Money `xml:"cost,omitempty"`
+	Cost *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 cost,omitempty" json:"cost,omitempty"`
 
 	//
 	// Estimated number of impressions at this bid. For mobile bid modifier landscapes, this is the
 	// estimated number of impressions for mobile only.
 	// <span class="constraint Selectable">This field can be selected using the value "LocalImpressions".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	Impressions int64 `xml:"impressions,omitempty"`
+	Impressions *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 impressions,omitempty" json:"impressions,omitempty"`
 
 	//
 	// Estimated number of promoted impressions.
 	// <span class="constraint Selectable">This field can be selected using the value "PromotedImpressions".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	PromotedImpressions int64 `xml:"promotedImpressions,omitempty"`
+	PromotedImpressions *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 promotedImpressions,omitempty" json:"promotedImpressions,omitempty"`
 
 	//
 	// Required daily budget to achieve the predicted stats at this bid.
 	// Only available for campaign criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "RequiredBudget".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	RequiredBudget *Money `xml:"requiredBudget,omitempty"`
+	RequiredBudget *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 requiredBudget,omitempty" json:"requiredBudget,omitempty"`
 
 	//
 	// Estimated number of biddable conversions. Only available for ad group bid landscapes and ad
 	// group criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "BiddableConversions".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	BiddableConversions float64 `xml:"biddableConversions,omitempty"`
+	BiddableConversions *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddableConversions,omitempty" json:"biddableConversions,omitempty"`
 
 	//
 	// Estimated biddable conversions value. Only available for ad group bid landscapes and ad group
 	// criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "BiddableConversionsValue".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	BiddableConversionsValue float64 `xml:"biddableConversionsValue,omitempty"`
+	BiddableConversionsValue *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 biddableConversionsValue,omitempty" json:"biddableConversionsValue,omitempty"`
 
 	//
 	// The bid modifier value of this point.
 	// Only available for campaign criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "BidModifier".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	BidModifier float64 `xml:"bidModifier,omitempty"`
+	BidModifier *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 bidModifier,omitempty" json:"bidModifier,omitempty"`
 
 	//
 	// Estimated total impressions for all devices in mobile bid modifier landscape.
 	// Only available for campaign criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "TotalLocalImpressions".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TotalLocalImpressions int64 `xml:"totalLocalImpressions,omitempty"`
+	TotalLocalImpressions *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalLocalImpressions,omitempty" json:"totalLocalImpressions,omitempty"`
 
 	//
 	// Estimated total clicks for all devices in mobile bid modifier landscape.
 	// Only available for campaign criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "TotalLocalClicks".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TotalLocalClicks int64 `xml:"totalLocalClicks,omitempty"`
+	TotalLocalClicks *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalLocalClicks,omitempty" json:"totalLocalClicks,omitempty"`
 
 	//
 	// Estimated total cost for all devices in mobile bid modifier landscape.
 	// Only available for campaign criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "TotalLocalCost".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TotalLocalCost *Money `xml:"totalLocalCost,omitempty"`
+	TotalLocalCost *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalLocalCost,omitempty" json:"totalLocalCost,omitempty"`
 
 	//
 	// Estimated total promoted impressions for all devices in mobile bid modifier landscape.
 	// Only available for campaign criterion bid landscapes.
 	// <span class="constraint Selectable">This field can be selected using the value "TotalLocalPromotedImpressions".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	TotalLocalPromotedImpressions int64 `xml:"totalLocalPromotedImpressions,omitempty"`
+	TotalLocalPromotedImpressions *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalLocalPromotedImpressions,omitempty" json:"totalLocalPromotedImpressions,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type CriterionBidLandscape struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionBidLandscape"`
-
 	*BidLandscape
 
 	//
 	// ID of the criterion associated with this landscape.
 	// <span class="constraint Selectable">This field can be selected using the value "CriterionId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CriterionId int64 `xml:"criterionId,omitempty"`
+	CriterionId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 criterionId,omitempty" json:"criterionId,omitempty"`
 }
 
 type CriterionBidLandscapePage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionBidLandscapePage"`
-
 	*NoStatsPage
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*CriterionBidLandscape `xml:"entries,omitempty"`
+	Entries []*CriterionBidLandscape `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DimensionProperties struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DimensionProperties"`
-
 	*DataEntry
 
-	LevelOfDetail *LevelOfDetail `xml:"levelOfDetail,omitempty"`
+	LevelOfDetail *LevelOfDetail `xml:"https://adwords.google.com/api/adwords/cm/v201802 levelOfDetail,omitempty" json:"levelOfDetail,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DomainCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DomainCategory"`
-
 	*DimensionProperties
 
 	//
@@ -1506,7 +1466,7 @@ type DomainCategory struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Category".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Category string `xml:"category,omitempty"`
+	Category *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 category,omitempty" json:"category,omitempty"`
 
 	//
 	// Coverage is the number of docs that match a label / number of docs that match the
@@ -1517,7 +1477,7 @@ type DomainCategory struct {
 	// <span class="constraint Selectable">This field can be selected using the value "Coverage".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Coverage float64 `xml:"coverage,omitempty"`
+	Coverage *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 coverage,omitempty" json:"coverage,omitempty"`
 
 	//
 	// The domain for the website. Since many different domains can have the same categories, the
@@ -1527,7 +1487,7 @@ type DomainCategory struct {
 	// <span class="constraint Selectable">This field can be selected using the value "DomainName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DomainName string `xml:"domainName,omitempty"`
+	DomainName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 domainName,omitempty" json:"domainName,omitempty"`
 
 	//
 	// The language for the website. Since many different domains can have the same categories, the
@@ -1537,224 +1497,188 @@ type DomainCategory struct {
 	// <span class="constraint Selectable">This field can be selected using the value "IsoLanguage".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	IsoLanguage string `xml:"isoLanguage,omitempty"`
+	IsoLanguage *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 isoLanguage,omitempty" json:"isoLanguage,omitempty"`
 
 	//
 	// The recommended cost per click for the category.
 	// <span class="constraint Selectable">This field can be selected using the value "RecommendedCpc".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	RecommendedCpc *Money `xml:"recommendedCpc,omitempty"`
+	RecommendedCpc *Money `xml:"https://adwords.google.com/api/adwords/cm/v201802 recommendedCpc,omitempty" json:"recommendedCpc,omitempty"`
 
 	//
 	// Used to determine whether a category has sub-categories associated with it.
 	// <span class="constraint Selectable">This field can be selected using the value "HasChild".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	HasChild bool `xml:"hasChild,omitempty"`
+	HasChild *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 hasChild,omitempty" json:"hasChild,omitempty"`
 
 	//
 	// The position of this category in the recommended set of categories.
 	// <span class="constraint Selectable">This field can be selected using the value "CategoryRank".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CategoryRank int32 `xml:"categoryRank,omitempty"`
+	CategoryRank *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 categoryRank,omitempty" json:"categoryRank,omitempty"`
 }
 
 type DomainCategoryPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DomainCategoryPage"`
-
 	*Page
 
 	//
 	// The result entries in this page.
 	//
-	Entries []*DomainCategory `xml:"entries,omitempty"`
+	Entries []*DomainCategory `xml:"https://adwords.google.com/api/adwords/cm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type LevelOfDetail struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LevelOfDetail"`
-
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "CampaignId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type NoStatsPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NoStatsPage"`
-
 	*Page
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -1762,13 +1686,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -1777,108 +1701,90 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QueryError"`
-
 	*ApiError
 
-	Reason *QueryErrorReason `xml:"reason,omitempty"`
+	Reason *QueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -1889,13 +1795,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -1904,58 +1810,54 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -1963,12 +1865,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -1976,76 +1878,68 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DataEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DataEntry"`
-
 	//
 	// Indicates that this instance is a subtype of DataEntry.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	DataEntryType string `xml:"DataEntry.Type,omitempty"`
+	DataEntryType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 DataEntry.Type,omitempty" json:"dataEntryType,omitempty"`
 }
 
 type DataError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DataError"`
-
 	*ApiError
 
-	Reason *DataErrorReason `xml:"reason,omitempty"`
+	Reason *DataErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DataServiceInterface struct {
@@ -0,0 +1,230 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package DataService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DataServiceAPI is the interface of the DataService operations. It is
+// implemented by DataServiceInterface and, for tests, by FakeDataService.
+type DataServiceAPI interface {
+	GetAdGroupBidLandscape(request *GetAdGroupBidLandscape) (*GetAdGroupBidLandscapeResponse, error)
+	GetCampaignCriterionBidLandscape(request *GetCampaignCriterionBidLandscape) (*GetCampaignCriterionBidLandscapeResponse, error)
+	GetCriterionBidLandscape(request *GetCriterionBidLandscape) (*GetCriterionBidLandscapeResponse, error)
+	GetDomainCategory(request *GetDomainCategory) (*GetDomainCategoryResponse, error)
+	QueryAdGroupBidLandscape(request *QueryAdGroupBidLandscape) (*QueryAdGroupBidLandscapeResponse, error)
+	QueryCampaignCriterionBidLandscape(request *QueryCampaignCriterionBidLandscape) (*QueryCampaignCriterionBidLandscapeResponse, error)
+	QueryCriterionBidLandscape(request *QueryCriterionBidLandscape) (*QueryCriterionBidLandscapeResponse, error)
+	QueryDomainCategory(request *QueryDomainCategory) (*QueryDomainCategoryResponse, error)
+}
+
+var _ DataServiceAPI = (*DataServiceInterface)(nil)
+var _ DataServiceAPI = (*FakeDataService)(nil)
+
+// FakeCall is a call recorded by FakeDataService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "GetAdGroupBidLandscape".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeDataService is a programmable DataServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeDataService struct {
+	GetAdGroupBidLandscapeFunc             func(request *GetAdGroupBidLandscape) (*GetAdGroupBidLandscapeResponse, error)
+	GetCampaignCriterionBidLandscapeFunc   func(request *GetCampaignCriterionBidLandscape) (*GetCampaignCriterionBidLandscapeResponse, error)
+	GetCriterionBidLandscapeFunc           func(request *GetCriterionBidLandscape) (*GetCriterionBidLandscapeResponse, error)
+	GetDomainCategoryFunc                  func(request *GetDomainCategory) (*GetDomainCategoryResponse, error)
+	QueryAdGroupBidLandscapeFunc           func(request *QueryAdGroupBidLandscape) (*QueryAdGroupBidLandscapeResponse, error)
+	QueryCampaignCriterionBidLandscapeFunc func(request *QueryCampaignCriterionBidLandscape) (*QueryCampaignCriterionBidLandscapeResponse, error)
+	QueryCriterionBidLandscapeFunc         func(request *QueryCriterionBidLandscape) (*QueryCriterionBidLandscapeResponse, error)
+	QueryDomainCategoryFunc                func(request *QueryDomainCategory) (*QueryDomainCategoryResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeDataService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeDataService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeDataService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// GetAdGroupBidLandscape records the call and delegates to GetAdGroupBidLandscapeFunc.
+func (f *FakeDataService) GetAdGroupBidLandscape(request *GetAdGroupBidLandscape) (*GetAdGroupBidLandscapeResponse, error) {
+	f.record("GetAdGroupBidLandscape", request)
+	if f.GetAdGroupBidLandscapeFunc == nil {
+		return nil, fmt.Errorf("FakeDataService.GetAdGroupBidLandscapeFunc is not set")
+	}
+	return f.GetAdGroupBidLandscapeFunc(request)
+}
+
+// GetAdGroupBidLandscapeCalls returns the requests of the recorded GetAdGroupBidLandscape calls.
+func (f *FakeDataService) GetAdGroupBidLandscapeCalls() []*GetAdGroupBidLandscape {
+	var requests []*GetAdGroupBidLandscape
+	for _, c := range f.Calls() {
+		if c.Method == "GetAdGroupBidLandscape" {
+			requests = append(requests, c.Request.(*GetAdGroupBidLandscape))
+		}
+	}
+	return requests
+}
+
+// GetCampaignCriterionBidLandscape records the call and delegates to GetCampaignCriterionBidLandscapeFunc.
+func (f *FakeDataService) GetCampaignCriterionBidLandscape(request *GetCampaignCriterionBidLandscape) (*GetCampaignCriterionBidLandscapeResponse, error) {
+	f.record("GetCampaignCriterionBidLandscape", request)
+	if f.GetCampaignCriterionBidLandscapeFunc == nil {
+		return nil, fmt.Errorf("FakeDataService.GetCampaignCriterionBidLandscapeFunc is not set")
+	}
+	return f.GetCampaignCriterionBidLandscapeFunc(request)
+}
+
+// GetCampaignCriterionBidLandscapeCalls returns the requests of the recorded GetCampaignCriterionBidLandscape calls.
+func (f *FakeDataService) GetCampaignCriterionBidLandscapeCalls() []*GetCampaignCriterionBidLandscape {
+	var requests []*GetCampaignCriterionBidLandscape
+	for _, c := range f.Calls() {
+		if c.Method == "GetCampaignCriterionBidLandscape" {
+			requests = append(requests, c.Request.(*GetCampaignCriterionBidLandscape))
+		}
+	}
+	return requests
+}
+
+// GetCriterionBidLandscape records the call and delegates to GetCriterionBidLandscapeFunc.
+func (f *FakeDataService) GetCriterionBidLandscape(request *GetCriterionBidLandscape) (*GetCriterionBidLandscapeResponse, error) {
+	f.record("GetCriterionBidLandscape", request)
+	if f.GetCriterionBidLandscapeFunc == nil {
+		return nil, fmt.Errorf("FakeDataService.GetCriterionBidLandscapeFunc is not set")
+	}
+	return f.GetCriterionBidLandscapeFunc(request)
+}
+
+// GetCriterionBidLandscapeCalls returns the requests of the recorded GetCriterionBidLandscape calls.
+func (f *FakeDataService) GetCriterionBidLandscapeCalls() []*GetCriterionBidLandscape {
+	var requests []*GetCriterionBidLandscape
+	for _, c := range f.Calls() {
+		if c.Method == "GetCriterionBidLandscape" {
+			requests = append(requests, c.Request.(*GetCriterionBidLandscape))
+		}
+	}
+	return requests
+}
+
+// GetDomainCategory records the call and delegates to GetDomainCategoryFunc.
+func (f *FakeDataService) GetDomainCategory(request *GetDomainCategory) (*GetDomainCategoryResponse, error) {
+	f.record("GetDomainCategory", request)
+	if f.GetDomainCategoryFunc == nil {
+		return nil, fmt.Errorf("FakeDataService.GetDomainCategoryFunc is not set")
+	}
+	return f.GetDomainCategoryFunc(request)
+}
+
+// GetDomainCategoryCalls returns the requests of the recorded GetDomainCategory calls.
+func (f *FakeDataService) GetDomainCategoryCalls() []*GetDomainCategory {
+	var requests []*GetDomainCategory
+	for _, c := range f.Calls() {
+		if c.Method == "GetDomainCategory" {
+			requests = append(requests, c.Request.(*GetDomainCategory))
+		}
+	}
+	return requests
+}
+
+// QueryAdGroupBidLandscape records the call and delegates to QueryAdGroupBidLandscapeFunc.
+func (f *FakeDataService) QueryAdGroupBidLandscape(request *QueryAdGroupBidLandscape) (*QueryAdGroupBidLandscapeResponse, error) {
+	f.record("QueryAdGroupBidLandscape", request)
+	if f.QueryAdGroupBidLandscapeFunc == nil {
+		return nil, fmt.Errorf("FakeDataService.QueryAdGroupBidLandscapeFunc is not set")
+	}
+	return f.QueryAdGroupBidLandscapeFunc(request)
+}
+
+// QueryAdGroupBidLandscapeCalls returns the requests of the recorded QueryAdGroupBidLandscape calls.
+func (f *FakeDataService) QueryAdGroupBidLandscapeCalls() []*QueryAdGroupBidLandscape {
+	var requests []*QueryAdGroupBidLandscape
+	for _, c := range f.Calls() {
+		if c.Method == "QueryAdGroupBidLandscape" {
+			requests = append(requests, c.Request.(*QueryAdGroupBidLandscape))
+		}
+	}
+	return requests
+}
+
+// QueryCampaignCriterionBidLandscape records the call and delegates to QueryCampaignCriterionBidLandscapeFunc.
+func (f *FakeDataService) QueryCampaignCriterionBidLandscape(request *QueryCampaignCriterionBidLandscape) (*QueryCampaignCriterionBidLandscapeResponse, error) {
+	f.record("QueryCampaignCriterionBidLandscape", request)
+	if f.QueryCampaignCriterionBidLandscapeFunc == nil {
+		return nil, fmt.Errorf("FakeDataService.QueryCampaignCriterionBidLandscapeFunc is not set")
+	}
+	return f.QueryCampaignCriterionBidLandscapeFunc(request)
+}
+
+// QueryCampaignCriterionBidLandscapeCalls returns the requests of the recorded QueryCampaignCriterionBidLandscape calls.
+func (f *FakeDataService) QueryCampaignCriterionBidLandscapeCalls() []*QueryCampaignCriterionBidLandscape {
+	var requests []*QueryCampaignCriterionBidLandscape
+	for _, c := range f.Calls() {
+		if c.Method == "QueryCampaignCriterionBidLandscape" {
+			requests = append(requests, c.Request.(*QueryCampaignCriterionBidLandscape))
+		}
+	}
+	return requests
+}
+
+// QueryCriterionBidLandscape records the call and delegates to QueryCriterionBidLandscapeFunc.
+func (f *FakeDataService) QueryCriterionBidLandscape(request *QueryCriterionBidLandscape) (*QueryCriterionBidLandscapeResponse, error) {
+	f.record("QueryCriterionBidLandscape", request)
+	if f.QueryCriterionBidLandscapeFunc == nil {
+		return nil, fmt.Errorf("FakeDataService.QueryCriterionBidLandscapeFunc is not set")
+	}
+	return f.QueryCriterionBidLandscapeFunc(request)
+}
+
+// QueryCriterionBidLandscapeCalls returns the requests of the recorded QueryCriterionBidLandscape calls.
+func (f *FakeDataService) QueryCriterionBidLandscapeCalls() []*QueryCriterionBidLandscape {
+	var requests []*QueryCriterionBidLandscape
+	for _, c := range f.Calls() {
+		if c.Method == "QueryCriterionBidLandscape" {
+			requests = append(requests, c.Request.(*QueryCriterionBidLandscape))
+		}
+	}
+	return requests
+}
+
+// QueryDomainCategory records the call and delegates to QueryDomainCategoryFunc.
+func (f *FakeDataService) QueryDomainCategory(request *QueryDomainCategory) (*QueryDomainCategoryResponse, error) {
+	f.record("QueryDomainCategory", request)
+	if f.QueryDomainCategoryFunc == nil {
+		return nil, fmt.Errorf("FakeDataService.QueryDomainCategoryFunc is not set")
+	}
+	return f.QueryDomainCategoryFunc(request)
+}
+
+// QueryDomainCategoryCalls returns the requests of the recorded QueryDomainCategory calls.
+func (f *FakeDataService) QueryDomainCategoryCalls() []*QueryDomainCategory {
+	var requests []*QueryDomainCategory
+	for _, c := range f.Calls() {
+		if c.Method == "QueryDomainCategory" {
+			requests = append(requests, c.Request.(*QueryDomainCategory))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,811 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package DataService
+
+import "github.com/godofdream/go-googleadsinofficial/deep"
+
+// Copy returns a deep copy of t.
+func (t *GetAdGroupBidLandscape) Copy() *GetAdGroupBidLandscape {
+	return deep.Copy(t).(*GetAdGroupBidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetAdGroupBidLandscape) Equal(o *GetAdGroupBidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetAdGroupBidLandscapeResponse) Copy() *GetAdGroupBidLandscapeResponse {
+	return deep.Copy(t).(*GetAdGroupBidLandscapeResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetAdGroupBidLandscapeResponse) Equal(o *GetAdGroupBidLandscapeResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetCampaignCriterionBidLandscape) Copy() *GetCampaignCriterionBidLandscape {
+	return deep.Copy(t).(*GetCampaignCriterionBidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetCampaignCriterionBidLandscape) Equal(o *GetCampaignCriterionBidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetCampaignCriterionBidLandscapeResponse) Copy() *GetCampaignCriterionBidLandscapeResponse {
+	return deep.Copy(t).(*GetCampaignCriterionBidLandscapeResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetCampaignCriterionBidLandscapeResponse) Equal(o *GetCampaignCriterionBidLandscapeResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetCriterionBidLandscape) Copy() *GetCriterionBidLandscape {
+	return deep.Copy(t).(*GetCriterionBidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetCriterionBidLandscape) Equal(o *GetCriterionBidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetCriterionBidLandscapeResponse) Copy() *GetCriterionBidLandscapeResponse {
+	return deep.Copy(t).(*GetCriterionBidLandscapeResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetCriterionBidLandscapeResponse) Equal(o *GetCriterionBidLandscapeResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetDomainCategory) Copy() *GetDomainCategory {
+	return deep.Copy(t).(*GetDomainCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetDomainCategory) Equal(o *GetDomainCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *GetDomainCategoryResponse) Copy() *GetDomainCategoryResponse {
+	return deep.Copy(t).(*GetDomainCategoryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *GetDomainCategoryResponse) Equal(o *GetDomainCategoryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryAdGroupBidLandscape) Copy() *QueryAdGroupBidLandscape {
+	return deep.Copy(t).(*QueryAdGroupBidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryAdGroupBidLandscape) Equal(o *QueryAdGroupBidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryAdGroupBidLandscapeResponse) Copy() *QueryAdGroupBidLandscapeResponse {
+	return deep.Copy(t).(*QueryAdGroupBidLandscapeResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryAdGroupBidLandscapeResponse) Equal(o *QueryAdGroupBidLandscapeResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryCampaignCriterionBidLandscape) Copy() *QueryCampaignCriterionBidLandscape {
+	return deep.Copy(t).(*QueryCampaignCriterionBidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryCampaignCriterionBidLandscape) Equal(o *QueryCampaignCriterionBidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryCampaignCriterionBidLandscapeResponse) Copy() *QueryCampaignCriterionBidLandscapeResponse {
+	return deep.Copy(t).(*QueryCampaignCriterionBidLandscapeResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryCampaignCriterionBidLandscapeResponse) Equal(o *QueryCampaignCriterionBidLandscapeResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryCriterionBidLandscape) Copy() *QueryCriterionBidLandscape {
+	return deep.Copy(t).(*QueryCriterionBidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryCriterionBidLandscape) Equal(o *QueryCriterionBidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryCriterionBidLandscapeResponse) Copy() *QueryCriterionBidLandscapeResponse {
+	return deep.Copy(t).(*QueryCriterionBidLandscapeResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryCriterionBidLandscapeResponse) Equal(o *QueryCriterionBidLandscapeResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryDomainCategory) Copy() *QueryDomainCategory {
+	return deep.Copy(t).(*QueryDomainCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryDomainCategory) Equal(o *QueryDomainCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryDomainCategoryResponse) Copy() *QueryDomainCategoryResponse {
+	return deep.Copy(t).(*QueryDomainCategoryResponse)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryDomainCategoryResponse) Equal(o *QueryDomainCategoryResponse) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupBidLandscape) Copy() *AdGroupBidLandscape {
+	return deep.Copy(t).(*AdGroupBidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupBidLandscape) Equal(o *AdGroupBidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdGroupBidLandscapePage) Copy() *AdGroupBidLandscapePage {
+	return deep.Copy(t).(*AdGroupBidLandscapePage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdGroupBidLandscapePage) Equal(o *AdGroupBidLandscapePage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AdxError) Copy() *AdxError {
+	return deep.Copy(t).(*AdxError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AdxError) Equal(o *AdxError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiError) Copy() *ApiError {
+	return deep.Copy(t).(*ApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiError) Equal(o *ApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApiException) Copy() *ApiException {
+	return deep.Copy(t).(*ApiException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApiException) Equal(o *ApiException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ApplicationException) Copy() *ApplicationException {
+	return deep.Copy(t).(*ApplicationException)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ApplicationException) Equal(o *ApplicationException) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthenticationError) Copy() *AuthenticationError {
+	return deep.Copy(t).(*AuthenticationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthenticationError) Equal(o *AuthenticationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *AuthorizationError) Copy() *AuthorizationError {
+	return deep.Copy(t).(*AuthorizationError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *AuthorizationError) Equal(o *AuthorizationError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BidLandscape) Copy() *BidLandscape {
+	return deep.Copy(t).(*BidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BidLandscape) Equal(o *BidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *BidLandscapeLandscapePoint) Copy() *BidLandscapeLandscapePoint {
+	return deep.Copy(t).(*BidLandscapeLandscapePoint)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *BidLandscapeLandscapePoint) Equal(o *BidLandscapeLandscapePoint) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ClientTermsError) Copy() *ClientTermsError {
+	return deep.Copy(t).(*ClientTermsError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ClientTermsError) Equal(o *ClientTermsError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ComparableValue) Copy() *ComparableValue {
+	return deep.Copy(t).(*ComparableValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ComparableValue) Equal(o *ComparableValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionBidLandscape) Copy() *CriterionBidLandscape {
+	return deep.Copy(t).(*CriterionBidLandscape)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionBidLandscape) Equal(o *CriterionBidLandscape) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *CriterionBidLandscapePage) Copy() *CriterionBidLandscapePage {
+	return deep.Copy(t).(*CriterionBidLandscapePage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *CriterionBidLandscapePage) Equal(o *CriterionBidLandscapePage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DatabaseError) Copy() *DatabaseError {
+	return deep.Copy(t).(*DatabaseError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DatabaseError) Equal(o *DatabaseError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateError) Copy() *DateError {
+	return deep.Copy(t).(*DateError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateError) Equal(o *DateError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DateRange) Copy() *DateRange {
+	return deep.Copy(t).(*DateRange)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DateRange) Equal(o *DateRange) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DimensionProperties) Copy() *DimensionProperties {
+	return deep.Copy(t).(*DimensionProperties)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DimensionProperties) Equal(o *DimensionProperties) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DistinctError) Copy() *DistinctError {
+	return deep.Copy(t).(*DistinctError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DistinctError) Equal(o *DistinctError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DomainCategory) Copy() *DomainCategory {
+	return deep.Copy(t).(*DomainCategory)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DomainCategory) Equal(o *DomainCategory) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DomainCategoryPage) Copy() *DomainCategoryPage {
+	return deep.Copy(t).(*DomainCategoryPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DomainCategoryPage) Equal(o *DomainCategoryPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DoubleValue) Copy() *DoubleValue {
+	return deep.Copy(t).(*DoubleValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DoubleValue) Equal(o *DoubleValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *FieldPathElement) Copy() *FieldPathElement {
+	return deep.Copy(t).(*FieldPathElement)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *FieldPathElement) Equal(o *FieldPathElement) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *IdError) Copy() *IdError {
+	return deep.Copy(t).(*IdError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *IdError) Equal(o *IdError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *InternalApiError) Copy() *InternalApiError {
+	return deep.Copy(t).(*InternalApiError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *InternalApiError) Equal(o *InternalApiError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LevelOfDetail) Copy() *LevelOfDetail {
+	return deep.Copy(t).(*LevelOfDetail)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LevelOfDetail) Equal(o *LevelOfDetail) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *LongValue) Copy() *LongValue {
+	return deep.Copy(t).(*LongValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *LongValue) Equal(o *LongValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Money) Copy() *Money {
+	return deep.Copy(t).(*Money)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Money) Equal(o *Money) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NoStatsPage) Copy() *NoStatsPage {
+	return deep.Copy(t).(*NoStatsPage)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NoStatsPage) Equal(o *NoStatsPage) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NotEmptyError) Copy() *NotEmptyError {
+	return deep.Copy(t).(*NotEmptyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NotEmptyError) Equal(o *NotEmptyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NullError) Copy() *NullError {
+	return deep.Copy(t).(*NullError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NullError) Equal(o *NullError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *NumberValue) Copy() *NumberValue {
+	return deep.Copy(t).(*NumberValue)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *NumberValue) Equal(o *NumberValue) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperationAccessDenied) Copy() *OperationAccessDenied {
+	return deep.Copy(t).(*OperationAccessDenied)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperationAccessDenied) Equal(o *OperationAccessDenied) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OperatorError) Copy() *OperatorError {
+	return deep.Copy(t).(*OperatorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OperatorError) Equal(o *OperatorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *OrderBy) Copy() *OrderBy {
+	return deep.Copy(t).(*OrderBy)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *OrderBy) Equal(o *OrderBy) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Page) Copy() *Page {
+	return deep.Copy(t).(*Page)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Page) Equal(o *Page) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Paging) Copy() *Paging {
+	return deep.Copy(t).(*Paging)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Paging) Equal(o *Paging) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Predicate) Copy() *Predicate {
+	return deep.Copy(t).(*Predicate)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Predicate) Equal(o *Predicate) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QueryError) Copy() *QueryError {
+	return deep.Copy(t).(*QueryError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QueryError) Equal(o *QueryError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *QuotaCheckError) Copy() *QuotaCheckError {
+	return deep.Copy(t).(*QuotaCheckError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *QuotaCheckError) Equal(o *QuotaCheckError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RangeError) Copy() *RangeError {
+	return deep.Copy(t).(*RangeError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RangeError) Equal(o *RangeError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RateExceededError) Copy() *RateExceededError {
+	return deep.Copy(t).(*RateExceededError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RateExceededError) Equal(o *RateExceededError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *ReadOnlyError) Copy() *ReadOnlyError {
+	return deep.Copy(t).(*ReadOnlyError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *ReadOnlyError) Equal(o *ReadOnlyError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RejectedError) Copy() *RejectedError {
+	return deep.Copy(t).(*RejectedError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RejectedError) Equal(o *RejectedError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequestError) Copy() *RequestError {
+	return deep.Copy(t).(*RequestError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequestError) Equal(o *RequestError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *RequiredError) Copy() *RequiredError {
+	return deep.Copy(t).(*RequiredError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *RequiredError) Equal(o *RequiredError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *Selector) Copy() *Selector {
+	return deep.Copy(t).(*Selector)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *Selector) Equal(o *Selector) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SelectorError) Copy() *SelectorError {
+	return deep.Copy(t).(*SelectorError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SelectorError) Equal(o *SelectorError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SizeLimitError) Copy() *SizeLimitError {
+	return deep.Copy(t).(*SizeLimitError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SizeLimitError) Equal(o *SizeLimitError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapHeader) Copy() *SoapHeader {
+	return deep.Copy(t).(*SoapHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapHeader) Equal(o *SoapHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *SoapResponseHeader) Copy() *SoapResponseHeader {
+	return deep.Copy(t).(*SoapResponseHeader)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *SoapResponseHeader) Equal(o *SoapResponseHeader) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringFormatError) Copy() *StringFormatError {
+	return deep.Copy(t).(*StringFormatError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringFormatError) Equal(o *StringFormatError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *StringLengthError) Copy() *StringLengthError {
+	return deep.Copy(t).(*StringLengthError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *StringLengthError) Equal(o *StringLengthError) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DataEntry) Copy() *DataEntry {
+	return deep.Copy(t).(*DataEntry)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DataEntry) Equal(o *DataEntry) bool {
+	return deep.Equal(t, o)
+}
+
+// Copy returns a deep copy of t.
+func (t *DataError) Copy() *DataError {
+	return deep.Copy(t).(*DataError)
+}
+
+// Equal reports whether t and o hold the same values. XMLName is ignored.
+func (t *DataError) Equal(o *DataError) bool {
+	return deep.Equal(t, o)
+}
+
+// DiffAdGroupBidLandscape compares from, as fetched from the API, with the desired
+// to. It returns a AdGroupBidLandscape holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffAdGroupBidLandscape(from, to *AdGroupBidLandscape) (*AdGroupBidLandscape, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"CampaignId", "LandscapePoints", "Type_", "LandscapeCurrent"},
+		[]string{"AdGroupId", "StartDate", "EndDate"},
+	).(*AdGroupBidLandscape)
+	return set, changes
+}
+
+// DiffBidLandscape compares from, as fetched from the API, with the desired
+// to. It returns a BidLandscape holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffBidLandscape(from, to *BidLandscape) (*BidLandscape, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"CampaignId", "AdGroupId", "LandscapePoints"},
+		[]string{"StartDate", "EndDate"},
+	).(*BidLandscape)
+	return set, changes
+}
+
+// DiffBidLandscapeLandscapePoint compares from, as fetched from the API, with the desired
+// to. It returns a BidLandscapeLandscapePoint holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffBidLandscapeLandscapePoint(from, to *BidLandscapeLandscapePoint) (*BidLandscapeLandscapePoint, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"Clicks", "Cost", "Impressions", "PromotedImpressions", "RequiredBudget", "BiddableConversions", "BiddableConversionsValue", "BidModifier", "TotalLocalImpressions", "TotalLocalClicks", "TotalLocalCost", "TotalLocalPromotedImpressions"},
+		[]string{"Bid"},
+	).(*BidLandscapeLandscapePoint)
+	return set, changes
+}
+
+// DiffCriterionBidLandscape compares from, as fetched from the API, with the desired
+// to. It returns a CriterionBidLandscape holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffCriterionBidLandscape(from, to *CriterionBidLandscape) (*CriterionBidLandscape, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"CampaignId", "AdGroupId", "LandscapePoints"},
+		[]string{"StartDate", "EndDate", "CriterionId"},
+	).(*CriterionBidLandscape)
+	return set, changes
+}
+
+// DiffDomainCategory compares from, as fetched from the API, with the desired
+// to. It returns a DomainCategory holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffDomainCategory(from, to *DomainCategory) (*DomainCategory, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{"LevelOfDetail"},
+		nil,
+	).(*DomainCategory)
+	return set, changes
+}
+
+// DiffLevelOfDetail compares from, as fetched from the API, with the desired
+// to. It returns a LevelOfDetail holding the changed mutable fields of to and
+// the fields identifying it, or nil if no mutable field changed, and all
+// changed values.
+func DiffLevelOfDetail(from, to *LevelOfDetail) (*LevelOfDetail, []deep.Change) {
+	changes := deep.Diff(from, to)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	set, _ := deep.Set(from, to,
+		[]string{},
+		nil,
+	).(*LevelOfDetail)
+	return set, changes
+}
@@ -0,0 +1,373 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package DataService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AdGroupBidLandscapeFields holds the selector field names of AdGroupBidLandscape, including
+// the fields of the types it holds.
+var AdGroupBidLandscapeFields = struct {
+	AdGroupId                     string
+	Bid                           string
+	BidModifier                   string
+	BiddableConversions           string
+	BiddableConversionsValue      string
+	CampaignId                    string
+	EndDate                       string
+	LandscapeCurrent              string
+	LandscapeType                 string
+	LocalClicks                   string
+	LocalCost                     string
+	LocalImpressions              string
+	PromotedImpressions           string
+	RequiredBudget                string
+	StartDate                     string
+	TotalLocalClicks              string
+	TotalLocalCost                string
+	TotalLocalImpressions         string
+	TotalLocalPromotedImpressions string
+}{
+	AdGroupId:                     "AdGroupId",
+	Bid:                           "Bid",
+	BidModifier:                   "BidModifier",
+	BiddableConversions:           "BiddableConversions",
+	BiddableConversionsValue:      "BiddableConversionsValue",
+	CampaignId:                    "CampaignId",
+	EndDate:                       "EndDate",
+	LandscapeCurrent:              "LandscapeCurrent",
+	LandscapeType:                 "LandscapeType",
+	LocalClicks:                   "LocalClicks",
+	LocalCost:                     "LocalCost",
+	LocalImpressions:              "LocalImpressions",
+	PromotedImpressions:           "PromotedImpressions",
+	RequiredBudget:                "RequiredBudget",
+	StartDate:                     "StartDate",
+	TotalLocalClicks:              "TotalLocalClicks",
+	TotalLocalCost:                "TotalLocalCost",
+	TotalLocalImpressions:         "TotalLocalImpressions",
+	TotalLocalPromotedImpressions: "TotalLocalPromotedImpressions",
+}
+
+// BidLandscapeFields holds the selector field names of BidLandscape, including
+// the fields of the types it holds.
+var BidLandscapeFields = struct {
+	AdGroupId                     string
+	Bid                           string
+	BidModifier                   string
+	BiddableConversions           string
+	BiddableConversionsValue      string
+	CampaignId                    string
+	CriterionId                   string
+	EndDate                       string
+	LandscapeCurrent              string
+	LandscapeType                 string
+	LocalClicks                   string
+	LocalCost                     string
+	LocalImpressions              string
+	PromotedImpressions           string
+	RequiredBudget                string
+	StartDate                     string
+	TotalLocalClicks              string
+	TotalLocalCost                string
+	TotalLocalImpressions         string
+	TotalLocalPromotedImpressions string
+}{
+	AdGroupId:                     "AdGroupId",
+	Bid:                           "Bid",
+	BidModifier:                   "BidModifier",
+	BiddableConversions:           "BiddableConversions",
+	BiddableConversionsValue:      "BiddableConversionsValue",
+	CampaignId:                    "CampaignId",
+	CriterionId:                   "CriterionId",
+	EndDate:                       "EndDate",
+	LandscapeCurrent:              "LandscapeCurrent",
+	LandscapeType:                 "LandscapeType",
+	LocalClicks:                   "LocalClicks",
+	LocalCost:                     "LocalCost",
+	LocalImpressions:              "LocalImpressions",
+	PromotedImpressions:           "PromotedImpressions",
+	RequiredBudget:                "RequiredBudget",
+	StartDate:                     "StartDate",
+	TotalLocalClicks:              "TotalLocalClicks",
+	TotalLocalCost:                "TotalLocalCost",
+	TotalLocalImpressions:         "TotalLocalImpressions",
+	TotalLocalPromotedImpressions: "TotalLocalPromotedImpressions",
+}
+
+// BidLandscapeLandscapePointFields holds the selector field names of BidLandscapeLandscapePoint, including
+// the fields of the types it holds.
+var BidLandscapeLandscapePointFields = struct {
+	Bid                           string
+	BidModifier                   string
+	BiddableConversions           string
+	BiddableConversionsValue      string
+	LocalClicks                   string
+	LocalCost                     string
+	LocalImpressions              string
+	PromotedImpressions           string
+	RequiredBudget                string
+	TotalLocalClicks              string
+	TotalLocalCost                string
+	TotalLocalImpressions         string
+	TotalLocalPromotedImpressions string
+}{
+	Bid:                           "Bid",
+	BidModifier:                   "BidModifier",
+	BiddableConversions:           "BiddableConversions",
+	BiddableConversionsValue:      "BiddableConversionsValue",
+	LocalClicks:                   "LocalClicks",
+	LocalCost:                     "LocalCost",
+	LocalImpressions:              "LocalImpressions",
+	PromotedImpressions:           "PromotedImpressions",
+	RequiredBudget:                "RequiredBudget",
+	TotalLocalClicks:              "TotalLocalClicks",
+	TotalLocalCost:                "TotalLocalCost",
+	TotalLocalImpressions:         "TotalLocalImpressions",
+	TotalLocalPromotedImpressions: "TotalLocalPromotedImpressions",
+}
+
+// CriterionBidLandscapeFields holds the selector field names of CriterionBidLandscape, including
+// the fields of the types it holds.
+var CriterionBidLandscapeFields = struct {
+	AdGroupId                     string
+	Bid                           string
+	BidModifier                   string
+	BiddableConversions           string
+	BiddableConversionsValue      string
+	CampaignId                    string
+	CriterionId                   string
+	EndDate                       string
+	LocalClicks                   string
+	LocalCost                     string
+	LocalImpressions              string
+	PromotedImpressions           string
+	RequiredBudget                string
+	StartDate                     string
+	TotalLocalClicks              string
+	TotalLocalCost                string
+	TotalLocalImpressions         string
+	TotalLocalPromotedImpressions string
+}{
+	AdGroupId:                     "AdGroupId",
+	Bid:                           "Bid",
+	BidModifier:                   "BidModifier",
+	BiddableConversions:           "BiddableConversions",
+	BiddableConversionsValue:      "BiddableConversionsValue",
+	CampaignId:                    "CampaignId",
+	CriterionId:                   "CriterionId",
+	EndDate:                       "EndDate",
+	LocalClicks:                   "LocalClicks",
+	LocalCost:                     "LocalCost",
+	LocalImpressions:              "LocalImpressions",
+	PromotedImpressions:           "PromotedImpressions",
+	RequiredBudget:                "RequiredBudget",
+	StartDate:                     "StartDate",
+	TotalLocalClicks:              "TotalLocalClicks",
+	TotalLocalCost:                "TotalLocalCost",
+	TotalLocalImpressions:         "TotalLocalImpressions",
+	TotalLocalPromotedImpressions: "TotalLocalPromotedImpressions",
+}
+
+// DomainCategoryFields holds the selector field names of DomainCategory, including
+// the fields of the types it holds.
+var DomainCategoryFields = struct {
+	CampaignId     string
+	Category       string
+	CategoryRank   string
+	Coverage       string
+	DomainName     string
+	HasChild       string
+	IsoLanguage    string
+	RecommendedCpc string
+}{
+	CampaignId:     "CampaignId",
+	Category:       "Category",
+	CategoryRank:   "CategoryRank",
+	Coverage:       "Coverage",
+	DomainName:     "DomainName",
+	HasChild:       "HasChild",
+	IsoLanguage:    "IsoLanguage",
+	RecommendedCpc: "RecommendedCpc",
+}
+
+// LevelOfDetailFields holds the selector field names of LevelOfDetail, including
+// the fields of the types it holds.
+var LevelOfDetailFields = struct {
+	CampaignId string
+}{
+	CampaignId: "CampaignId",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"GetAdGroupBidLandscape": {
+		{Type: "GetAdGroupBidLandscape", Name: "ServiceSelector", Required: true},
+	},
+	"GetCampaignCriterionBidLandscape": {
+		{Type: "GetCampaignCriterionBidLandscape", Name: "ServiceSelector", Required: true},
+	},
+	"GetCriterionBidLandscape": {
+		{Type: "GetCriterionBidLandscape", Name: "ServiceSelector", Required: true},
+	},
+	"GetDomainCategory": {
+		{Type: "GetDomainCategory", Name: "ServiceSelector", Required: true},
+	},
+	"QueryAdGroupBidLandscape": {
+		{Type: "QueryAdGroupBidLandscape", Name: "Query", Required: true},
+	},
+	"QueryCampaignCriterionBidLandscape": {
+		{Type: "QueryCampaignCriterionBidLandscape", Name: "Query", Required: true},
+	},
+	"QueryCriterionBidLandscape": {
+		{Type: "QueryCriterionBidLandscape", Name: "Query", Required: true},
+	},
+	"QueryDomainCategory": {
+		{Type: "QueryDomainCategory", Name: "Query", Required: true},
+	},
+	"AdGroupBidLandscape": {
+		{Type: "DataEntry", Name: "DataEntryType"},
+		{Type: "BidLandscape", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId"},
+		{Type: "BidLandscape", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId"},
+		{Type: "BidLandscape", Name: "StartDate", Selectors: []string{"StartDate"}, Required: true},
+		{Type: "BidLandscape", Name: "EndDate", Selectors: []string{"EndDate"}, Required: true},
+		{Type: "BidLandscape", Name: "LandscapePoints"},
+		{Type: "AdGroupBidLandscape", Name: "Type_", Selectors: []string{"LandscapeType"}, Filter: "LandscapeType"},
+		{Type: "AdGroupBidLandscape", Name: "LandscapeCurrent", Selectors: []string{"LandscapeCurrent"}, Filter: "LandscapeCurrent"},
+	},
+	"BidLandscape": {
+		{Type: "DataEntry", Name: "DataEntryType"},
+		{Type: "BidLandscape", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId"},
+		{Type: "BidLandscape", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId"},
+		{Type: "BidLandscape", Name: "StartDate", Selectors: []string{"StartDate"}, Required: true},
+		{Type: "BidLandscape", Name: "EndDate", Selectors: []string{"EndDate"}, Required: true},
+		{Type: "BidLandscape", Name: "LandscapePoints"},
+	},
+	"BidLandscapeLandscapePoint": {
+		{Type: "BidLandscapeLandscapePoint", Name: "Bid", Selectors: []string{"Bid"}, Filter: "Bid", Required: true},
+		{Type: "BidLandscapeLandscapePoint", Name: "Clicks", Selectors: []string{"LocalClicks"}, Filter: "LocalClicks"},
+		{Type: "BidLandscapeLandscapePoint", Name: "Cost", Selectors: []string{"LocalCost"}, Filter: "LocalCost"},
+		{Type: "BidLandscapeLandscapePoint", Name: "Impressions", Selectors: []string{"LocalImpressions"}, Filter: "LocalImpressions"},
+		{Type: "BidLandscapeLandscapePoint", Name: "PromotedImpressions", Selectors: []string{"PromotedImpressions"}, Filter: "PromotedImpressions"},
+		{Type: "BidLandscapeLandscapePoint", Name: "RequiredBudget", Selectors: []string{"RequiredBudget"}, Filter: "RequiredBudget"},
+		{Type: "BidLandscapeLandscapePoint", Name: "BiddableConversions", Selectors: []string{"BiddableConversions"}, Filter: "BiddableConversions"},
+		{Type: "BidLandscapeLandscapePoint", Name: "BiddableConversionsValue", Selectors: []string{"BiddableConversionsValue"}, Filter: "BiddableConversionsValue"},
+		{Type: "BidLandscapeLandscapePoint", Name: "BidModifier", Selectors: []string{"BidModifier"}, Filter: "BidModifier"},
+		{Type: "BidLandscapeLandscapePoint", Name: "TotalLocalImpressions", Selectors: []string{"TotalLocalImpressions"}, Filter: "TotalLocalImpressions"},
+		{Type: "BidLandscapeLandscapePoint", Name: "TotalLocalClicks", Selectors: []string{"TotalLocalClicks"}, Filter: "TotalLocalClicks"},
+		{Type: "BidLandscapeLandscapePoint", Name: "TotalLocalCost", Selectors: []string{"TotalLocalCost"}, Filter: "TotalLocalCost"},
+		{Type: "BidLandscapeLandscapePoint", Name: "TotalLocalPromotedImpressions", Selectors: []string{"TotalLocalPromotedImpressions"}, Filter: "TotalLocalPromotedImpressions"},
+	},
+	"CriterionBidLandscape": {
+		{Type: "DataEntry", Name: "DataEntryType"},
+		{Type: "BidLandscape", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId"},
+		{Type: "BidLandscape", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId"},
+		{Type: "BidLandscape", Name: "StartDate", Selectors: []string{"StartDate"}, Required: true},
+		{Type: "BidLandscape", Name: "EndDate", Selectors: []string{"EndDate"}, Required: true},
+		{Type: "BidLandscape", Name: "LandscapePoints"},
+		{Type: "CriterionBidLandscape", Name: "CriterionId", Selectors: []string{"CriterionId"}, Filter: "CriterionId"},
+	},
+	"DomainCategory": {
+		{Type: "DataEntry", Name: "DataEntryType"},
+		{Type: "DimensionProperties", Name: "LevelOfDetail"},
+		{Type: "DomainCategory", Name: "Category", Selectors: []string{"Category"}, Filter: "Category", ReadOnly: true},
+		{Type: "DomainCategory", Name: "Coverage", Selectors: []string{"Coverage"}, ReadOnly: true},
+		{Type: "DomainCategory", Name: "DomainName", Selectors: []string{"DomainName"}, Filter: "DomainName", ReadOnly: true},
+		{Type: "DomainCategory", Name: "IsoLanguage", Selectors: []string{"IsoLanguage"}, ReadOnly: true},
+		{Type: "DomainCategory", Name: "RecommendedCpc", Selectors: []string{"RecommendedCpc"}, ReadOnly: true},
+		{Type: "DomainCategory", Name: "HasChild", Selectors: []string{"HasChild"}, ReadOnly: true},
+		{Type: "DomainCategory", Name: "CategoryRank", Selectors: []string{"CategoryRank"}, ReadOnly: true},
+	},
+	"LevelOfDetail": {
+		{Type: "LevelOfDetail", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId", ReadOnly: true},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package DataService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks GetAdGroupBidLandscape against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetAdGroupBidLandscape) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetCampaignCriterionBidLandscape against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetCampaignCriterionBidLandscape) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetCriterionBidLandscape against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetCriterionBidLandscape) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks GetDomainCategory against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetDomainCategory) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks QueryAdGroupBidLandscape against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *QueryAdGroupBidLandscape) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks QueryCampaignCriterionBidLandscape against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *QueryCampaignCriterionBidLandscape) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks QueryCriterionBidLandscape against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *QueryCriterionBidLandscape) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks QueryDomainCategory against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *QueryDomainCategory) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *GetAdGroupBidLandscape) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(GetAdGroupBidLandscape)
+	}
+	v.Required(validate.Field(path, "serviceSelector"), t.ServiceSelector != nil)
+	if t.ServiceSelector != nil {
+		t.ServiceSelector.validate(v, validate.Field(path, "serviceSelector"), op)
+	}
+}
+
+func (t *GetCampaignCriterionBidLandscape) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(GetCampaignCriterionBidLandscape)
+	}
+	v.Required(validate.Field(path, "serviceSelector"), t.ServiceSelector != nil)
+	if t.ServiceSelector != nil {
+		t.ServiceSelector.validate(v, validate.Field(path, "serviceSelector"), op)
+	}
+}
+
+func (t *GetCriterionBidLandscape) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(GetCriterionBidLandscape)
+	}
+	v.Required(validate.Field(path, "serviceSelector"), t.ServiceSelector != nil)
+	if t.ServiceSelector != nil {
+		t.ServiceSelector.validate(v, validate.Field(path, "serviceSelector"), op)
+	}
+}
+
+func (t *GetDomainCategory) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(GetDomainCategory)
+	}
+	v.Required(validate.Field(path, "serviceSelector"), t.ServiceSelector != nil)
+	if t.ServiceSelector != nil {
+		t.ServiceSelector.validate(v, validate.Field(path, "serviceSelector"), op)
+	}
+}
+
+func (t *QueryAdGroupBidLandscape) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(QueryAdGroupBidLandscape)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *QueryCampaignCriterionBidLandscape) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(QueryCampaignCriterionBidLandscape)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *QueryCriterionBidLandscape) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(QueryCriterionBidLandscape)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *QueryDomainCategory) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(QueryDomainCategory)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
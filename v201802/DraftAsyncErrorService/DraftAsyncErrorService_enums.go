@@ -0,0 +1,3754 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package DraftAsyncErrorService
+
+import "fmt"
+
+// AdErrorReasonValues returns all values of AdErrorReason.
+func AdErrorReasonValues() []AdErrorReason {
+	return []AdErrorReason{
+		AdErrorReasonAD_CUSTOMIZERS_NOT_SUPPORTED_FOR_AD_TYPE,
+		AdErrorReasonAPPROXIMATELY_TOO_LONG,
+		AdErrorReasonAPPROXIMATELY_TOO_SHORT,
+		AdErrorReasonBAD_SNIPPET,
+		AdErrorReasonCANNOT_MODIFY_AD,
+		AdErrorReasonCANNOT_SET_BUSINESS_NAME_IF_URL_SET,
+		AdErrorReasonCANNOT_SET_FIELD,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_ORIGIN_AD_ID_SET,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_AD_ID_SET_FOR_SHARING,
+		AdErrorReasonCANNOT_SET_ALLOW_FLEXIBLE_COLOR_FALSE,
+		AdErrorReasonCANNOT_SET_COLOR_CONTROL_WHEN_NATIVE_FORMAT_SETTING,
+		AdErrorReasonCANNOT_SET_URL,
+		AdErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_TRACKING_URL_TEMPLATE,
+		AdErrorReasonCANNOT_SET_WITH_URL_DATA,
+		AdErrorReasonCANNOT_USE_AD_SUBCLASS_FOR_OPERATOR,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_MOBILEADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_ADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_REDIRECT_ADS,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE_FOR_UPDATING_BEACON_URL,
+		AdErrorReasonDIMENSION_ALREADY_IN_UNION,
+		AdErrorReasonDIMENSION_MUST_BE_SET,
+		AdErrorReasonDIMENSION_NOT_IN_UNION,
+		AdErrorReasonDISPLAY_URL_CANNOT_BE_SPECIFIED,
+		AdErrorReasonDOMESTIC_PHONE_NUMBER_FORMAT,
+		AdErrorReasonEMERGENCY_PHONE_NUMBER,
+		AdErrorReasonEMPTY_FIELD,
+		AdErrorReasonFEED_ATTRIBUTE_MUST_HAVE_MAPPING_FOR_TYPE_ID,
+		AdErrorReasonFEED_ATTRIBUTE_MAPPING_TYPE_MISMATCH,
+		AdErrorReasonILLEGAL_AD_CUSTOMIZER_TAG_USE,
+		AdErrorReasonILLEGAL_TAG_USE,
+		AdErrorReasonINCONSISTENT_DIMENSIONS,
+		AdErrorReasonINCONSISTENT_STATUS_IN_TEMPLATE_UNION,
+		AdErrorReasonINCORRECT_LENGTH,
+		AdErrorReasonINELIGIBLE_FOR_UPGRADE,
+		AdErrorReasonINVALID_AD_ADDRESS_CAMPAIGN_TARGET,
+		AdErrorReasonINVALID_AD_TYPE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_IMAGE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_TEXT,
+		AdErrorReasonINVALID_CALL_TO_ACTION_TEXT,
+		AdErrorReasonINVALID_CHARACTER_FOR_URL,
+		AdErrorReasonINVALID_COUNTRY_CODE,
+		AdErrorReasonINVALID_DSA_URL_TAG,
+		AdErrorReasonINVALID_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonINVALID_INPUT,
+		AdErrorReasonINVALID_MARKUP_LANGUAGE,
+		AdErrorReasonINVALID_MOBILE_CARRIER,
+		AdErrorReasonINVALID_MOBILE_CARRIER_TARGET,
+		AdErrorReasonINVALID_NUMBER_OF_ELEMENTS,
+		AdErrorReasonINVALID_PHONE_NUMBER_FORMAT,
+		AdErrorReasonINVALID_RICH_MEDIA_CERTIFIED_VENDOR_FORMAT_ID,
+		AdErrorReasonINVALID_TEMPLATE_DATA,
+		AdErrorReasonINVALID_TEMPLATE_ELEMENT_FIELD_TYPE,
+		AdErrorReasonINVALID_TEMPLATE_ID,
+		AdErrorReasonLINE_TOO_WIDE,
+		AdErrorReasonMISSING_AD_CUSTOMIZER_MAPPING,
+		AdErrorReasonMISSING_ADDRESS_COMPONENT,
+		AdErrorReasonMISSING_ADVERTISEMENT_NAME,
+		AdErrorReasonMISSING_BUSINESS_NAME,
+		AdErrorReasonMISSING_DESCRIPTION1,
+		AdErrorReasonMISSING_DESCRIPTION2,
+		AdErrorReasonMISSING_DESTINATION_URL_TAG,
+		AdErrorReasonMISSING_LANDING_PAGE_URL_TAG,
+		AdErrorReasonMISSING_DIMENSION,
+		AdErrorReasonMISSING_DISPLAY_URL,
+		AdErrorReasonMISSING_HEADLINE,
+		AdErrorReasonMISSING_HEIGHT,
+		AdErrorReasonMISSING_IMAGE,
+		AdErrorReasonMISSING_MARKETING_IMAGE_OR_PRODUCT_VIDEOS,
+		AdErrorReasonMISSING_MARKUP_LANGUAGES,
+		AdErrorReasonMISSING_MOBILE_CARRIER,
+		AdErrorReasonMISSING_PHONE,
+		AdErrorReasonMISSING_REQUIRED_TEMPLATE_FIELDS,
+		AdErrorReasonMISSING_TEMPLATE_FIELD_VALUE,
+		AdErrorReasonMISSING_TEXT,
+		AdErrorReasonMISSING_VISIBLE_URL,
+		AdErrorReasonMISSING_WIDTH,
+		AdErrorReasonMULTIPLE_DISTINCT_FEEDS_UNSUPPORTED,
+		AdErrorReasonMUST_USE_TEMP_AD_UNION_ID_ON_ADD,
+		AdErrorReasonTOO_LONG,
+		AdErrorReasonTOO_SHORT,
+		AdErrorReasonUNION_DIMENSIONS_CANNOT_CHANGE,
+		AdErrorReasonUNKNOWN_ADDRESS_COMPONENT,
+		AdErrorReasonUNKNOWN_FIELD_NAME,
+		AdErrorReasonUNKNOWN_UNIQUE_NAME,
+		AdErrorReasonUNSUPPORTED_DIMENSIONS,
+		AdErrorReasonURL_INVALID_SCHEME,
+		AdErrorReasonURL_INVALID_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_MALFORMED,
+		AdErrorReasonURL_NO_HOST,
+		AdErrorReasonURL_NOT_EQUIVALENT,
+		AdErrorReasonURL_HOST_NAME_TOO_LONG,
+		AdErrorReasonURL_NO_SCHEME,
+		AdErrorReasonURL_NO_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_PATH_NOT_ALLOWED,
+		AdErrorReasonURL_PORT_NOT_ALLOWED,
+		AdErrorReasonURL_QUERY_NOT_ALLOWED,
+		AdErrorReasonURL_SCHEME_BEFORE_DSA_TAG,
+		AdErrorReasonURL_SCHEME_BEFORE_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonUSER_DOES_NOT_HAVE_ACCESS_TO_TEMPLATE,
+		AdErrorReasonINCONSISTENT_EXPANDABLE_SETTINGS,
+		AdErrorReasonINVALID_FORMAT,
+		AdErrorReasonINVALID_FIELD_TEXT,
+		AdErrorReasonELEMENT_NOT_PRESENT,
+		AdErrorReasonIMAGE_ERROR,
+		AdErrorReasonVALUE_NOT_IN_RANGE,
+		AdErrorReasonFIELD_NOT_PRESENT,
+		AdErrorReasonADDRESS_NOT_COMPLETE,
+		AdErrorReasonADDRESS_INVALID,
+		AdErrorReasonVIDEO_RETRIEVAL_ERROR,
+		AdErrorReasonAUDIO_ERROR,
+		AdErrorReasonINVALID_YOUTUBE_DISPLAY_URL,
+		AdErrorReasonTOO_MANY_PRODUCT_IMAGES,
+		AdErrorReasonTOO_MANY_PRODUCT_VIDEOS,
+		AdErrorReasonINCOMPATIBLE_AD_TYPE_AND_DEVICE_PREFERENCE,
+		AdErrorReasonCALLTRACKING_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonCARRIER_SPECIFIC_SHORT_NUMBER_NOT_ALLOWED,
+		AdErrorReasonDISALLOWED_NUMBER_TYPE,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_WITH_CALLTRACKING_FOR_COUNTRY,
+		AdErrorReasonPREMIUM_RATE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonVANITY_PHONE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonINVALID_CALL_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_DISABLE_CALL_CONVERSION_AND_SET_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_SET_PATH2_WITHOUT_PATH1,
+		AdErrorReasonMISSING_DYNAMIC_SEARCH_ADS_SETTING_DOMAIN_NAME,
+		AdErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdErrorReason.
+func (e AdErrorReason) IsValid() bool {
+	switch e {
+	case AdErrorReasonAD_CUSTOMIZERS_NOT_SUPPORTED_FOR_AD_TYPE,
+		AdErrorReasonAPPROXIMATELY_TOO_LONG,
+		AdErrorReasonAPPROXIMATELY_TOO_SHORT,
+		AdErrorReasonBAD_SNIPPET,
+		AdErrorReasonCANNOT_MODIFY_AD,
+		AdErrorReasonCANNOT_SET_BUSINESS_NAME_IF_URL_SET,
+		AdErrorReasonCANNOT_SET_FIELD,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_ORIGIN_AD_ID_SET,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_AD_ID_SET_FOR_SHARING,
+		AdErrorReasonCANNOT_SET_ALLOW_FLEXIBLE_COLOR_FALSE,
+		AdErrorReasonCANNOT_SET_COLOR_CONTROL_WHEN_NATIVE_FORMAT_SETTING,
+		AdErrorReasonCANNOT_SET_URL,
+		AdErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_TRACKING_URL_TEMPLATE,
+		AdErrorReasonCANNOT_SET_WITH_URL_DATA,
+		AdErrorReasonCANNOT_USE_AD_SUBCLASS_FOR_OPERATOR,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_MOBILEADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_ADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_REDIRECT_ADS,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE_FOR_UPDATING_BEACON_URL,
+		AdErrorReasonDIMENSION_ALREADY_IN_UNION,
+		AdErrorReasonDIMENSION_MUST_BE_SET,
+		AdErrorReasonDIMENSION_NOT_IN_UNION,
+		AdErrorReasonDISPLAY_URL_CANNOT_BE_SPECIFIED,
+		AdErrorReasonDOMESTIC_PHONE_NUMBER_FORMAT,
+		AdErrorReasonEMERGENCY_PHONE_NUMBER,
+		AdErrorReasonEMPTY_FIELD,
+		AdErrorReasonFEED_ATTRIBUTE_MUST_HAVE_MAPPING_FOR_TYPE_ID,
+		AdErrorReasonFEED_ATTRIBUTE_MAPPING_TYPE_MISMATCH,
+		AdErrorReasonILLEGAL_AD_CUSTOMIZER_TAG_USE,
+		AdErrorReasonILLEGAL_TAG_USE,
+		AdErrorReasonINCONSISTENT_DIMENSIONS,
+		AdErrorReasonINCONSISTENT_STATUS_IN_TEMPLATE_UNION,
+		AdErrorReasonINCORRECT_LENGTH,
+		AdErrorReasonINELIGIBLE_FOR_UPGRADE,
+		AdErrorReasonINVALID_AD_ADDRESS_CAMPAIGN_TARGET,
+		AdErrorReasonINVALID_AD_TYPE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_IMAGE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_TEXT,
+		AdErrorReasonINVALID_CALL_TO_ACTION_TEXT,
+		AdErrorReasonINVALID_CHARACTER_FOR_URL,
+		AdErrorReasonINVALID_COUNTRY_CODE,
+		AdErrorReasonINVALID_DSA_URL_TAG,
+		AdErrorReasonINVALID_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonINVALID_INPUT,
+		AdErrorReasonINVALID_MARKUP_LANGUAGE,
+		AdErrorReasonINVALID_MOBILE_CARRIER,
+		AdErrorReasonINVALID_MOBILE_CARRIER_TARGET,
+		AdErrorReasonINVALID_NUMBER_OF_ELEMENTS,
+		AdErrorReasonINVALID_PHONE_NUMBER_FORMAT,
+		AdErrorReasonINVALID_RICH_MEDIA_CERTIFIED_VENDOR_FORMAT_ID,
+		AdErrorReasonINVALID_TEMPLATE_DATA,
+		AdErrorReasonINVALID_TEMPLATE_ELEMENT_FIELD_TYPE,
+		AdErrorReasonINVALID_TEMPLATE_ID,
+		AdErrorReasonLINE_TOO_WIDE,
+		AdErrorReasonMISSING_AD_CUSTOMIZER_MAPPING,
+		AdErrorReasonMISSING_ADDRESS_COMPONENT,
+		AdErrorReasonMISSING_ADVERTISEMENT_NAME,
+		AdErrorReasonMISSING_BUSINESS_NAME,
+		AdErrorReasonMISSING_DESCRIPTION1,
+		AdErrorReasonMISSING_DESCRIPTION2,
+		AdErrorReasonMISSING_DESTINATION_URL_TAG,
+		AdErrorReasonMISSING_LANDING_PAGE_URL_TAG,
+		AdErrorReasonMISSING_DIMENSION,
+		AdErrorReasonMISSING_DISPLAY_URL,
+		AdErrorReasonMISSING_HEADLINE,
+		AdErrorReasonMISSING_HEIGHT,
+		AdErrorReasonMISSING_IMAGE,
+		AdErrorReasonMISSING_MARKETING_IMAGE_OR_PRODUCT_VIDEOS,
+		AdErrorReasonMISSING_MARKUP_LANGUAGES,
+		AdErrorReasonMISSING_MOBILE_CARRIER,
+		AdErrorReasonMISSING_PHONE,
+		AdErrorReasonMISSING_REQUIRED_TEMPLATE_FIELDS,
+		AdErrorReasonMISSING_TEMPLATE_FIELD_VALUE,
+		AdErrorReasonMISSING_TEXT,
+		AdErrorReasonMISSING_VISIBLE_URL,
+		AdErrorReasonMISSING_WIDTH,
+		AdErrorReasonMULTIPLE_DISTINCT_FEEDS_UNSUPPORTED,
+		AdErrorReasonMUST_USE_TEMP_AD_UNION_ID_ON_ADD,
+		AdErrorReasonTOO_LONG,
+		AdErrorReasonTOO_SHORT,
+		AdErrorReasonUNION_DIMENSIONS_CANNOT_CHANGE,
+		AdErrorReasonUNKNOWN_ADDRESS_COMPONENT,
+		AdErrorReasonUNKNOWN_FIELD_NAME,
+		AdErrorReasonUNKNOWN_UNIQUE_NAME,
+		AdErrorReasonUNSUPPORTED_DIMENSIONS,
+		AdErrorReasonURL_INVALID_SCHEME,
+		AdErrorReasonURL_INVALID_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_MALFORMED,
+		AdErrorReasonURL_NO_HOST,
+		AdErrorReasonURL_NOT_EQUIVALENT,
+		AdErrorReasonURL_HOST_NAME_TOO_LONG,
+		AdErrorReasonURL_NO_SCHEME,
+		AdErrorReasonURL_NO_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_PATH_NOT_ALLOWED,
+		AdErrorReasonURL_PORT_NOT_ALLOWED,
+		AdErrorReasonURL_QUERY_NOT_ALLOWED,
+		AdErrorReasonURL_SCHEME_BEFORE_DSA_TAG,
+		AdErrorReasonURL_SCHEME_BEFORE_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonUSER_DOES_NOT_HAVE_ACCESS_TO_TEMPLATE,
+		AdErrorReasonINCONSISTENT_EXPANDABLE_SETTINGS,
+		AdErrorReasonINVALID_FORMAT,
+		AdErrorReasonINVALID_FIELD_TEXT,
+		AdErrorReasonELEMENT_NOT_PRESENT,
+		AdErrorReasonIMAGE_ERROR,
+		AdErrorReasonVALUE_NOT_IN_RANGE,
+		AdErrorReasonFIELD_NOT_PRESENT,
+		AdErrorReasonADDRESS_NOT_COMPLETE,
+		AdErrorReasonADDRESS_INVALID,
+		AdErrorReasonVIDEO_RETRIEVAL_ERROR,
+		AdErrorReasonAUDIO_ERROR,
+		AdErrorReasonINVALID_YOUTUBE_DISPLAY_URL,
+		AdErrorReasonTOO_MANY_PRODUCT_IMAGES,
+		AdErrorReasonTOO_MANY_PRODUCT_VIDEOS,
+		AdErrorReasonINCOMPATIBLE_AD_TYPE_AND_DEVICE_PREFERENCE,
+		AdErrorReasonCALLTRACKING_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonCARRIER_SPECIFIC_SHORT_NUMBER_NOT_ALLOWED,
+		AdErrorReasonDISALLOWED_NUMBER_TYPE,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_WITH_CALLTRACKING_FOR_COUNTRY,
+		AdErrorReasonPREMIUM_RATE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonVANITY_PHONE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonINVALID_CALL_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_DISABLE_CALL_CONVERSION_AND_SET_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_SET_PATH2_WITHOUT_PATH1,
+		AdErrorReasonMISSING_DYNAMIC_SEARCH_ADS_SETTING_DOMAIN_NAME,
+		AdErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAdErrorReason returns the AdErrorReason named by s.
+func ParseAdErrorReason(s string) (AdErrorReason, error) {
+	e := AdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdErrorReason.
+func (e AdErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdErrorReason) UnmarshalText(text []byte) error {
+	v := AdErrorReason(text)
+	if !v.IsValid() {
+		v = AdErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdGroupAdErrorReasonValues returns all values of AdGroupAdErrorReason.
+func AdGroupAdErrorReasonValues() []AdGroupAdErrorReason {
+	return []AdGroupAdErrorReason{
+		AdGroupAdErrorReasonAD_GROUP_AD_LABEL_DOES_NOT_EXIST,
+		AdGroupAdErrorReasonAD_GROUP_AD_LABEL_ALREADY_EXISTS,
+		AdGroupAdErrorReasonAD_NOT_UNDER_ADGROUP,
+		AdGroupAdErrorReasonCANNOT_OPERATE_ON_REMOVED_ADGROUPAD,
+		AdGroupAdErrorReasonCANNOT_CREATE_DEPRECATED_ADS,
+		AdGroupAdErrorReasonCANNOT_CREATE_TEXT_ADS,
+		AdGroupAdErrorReasonEMPTY_FIELD,
+		AdGroupAdErrorReasonENTITY_REFERENCED_IN_MULTIPLE_OPS,
+		AdGroupAdErrorReasonUNSUPPORTED_OPERATION,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupAdErrorReason.
+func (e AdGroupAdErrorReason) IsValid() bool {
+	switch e {
+	case AdGroupAdErrorReasonAD_GROUP_AD_LABEL_DOES_NOT_EXIST,
+		AdGroupAdErrorReasonAD_GROUP_AD_LABEL_ALREADY_EXISTS,
+		AdGroupAdErrorReasonAD_NOT_UNDER_ADGROUP,
+		AdGroupAdErrorReasonCANNOT_OPERATE_ON_REMOVED_ADGROUPAD,
+		AdGroupAdErrorReasonCANNOT_CREATE_DEPRECATED_ADS,
+		AdGroupAdErrorReasonCANNOT_CREATE_TEXT_ADS,
+		AdGroupAdErrorReasonEMPTY_FIELD,
+		AdGroupAdErrorReasonENTITY_REFERENCED_IN_MULTIPLE_OPS,
+		AdGroupAdErrorReasonUNSUPPORTED_OPERATION:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupAdErrorReason returns the AdGroupAdErrorReason named by s.
+func ParseAdGroupAdErrorReason(s string) (AdGroupAdErrorReason, error) {
+	e := AdGroupAdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupAdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdGroupAdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdGroupAdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdGroupAdErrorReason) UnmarshalText(text []byte) error {
+	v := AdGroupAdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AdGroupCriterionErrorReasonValues returns all values of AdGroupCriterionErrorReason.
+func AdGroupCriterionErrorReasonValues() []AdGroupCriterionErrorReason {
+	return []AdGroupCriterionErrorReason{
+		AdGroupCriterionErrorReasonAD_GROUP_CRITERION_LABEL_DOES_NOT_EXIST,
+		AdGroupCriterionErrorReasonAD_GROUP_CRITERION_LABEL_ALREADY_EXISTS,
+		AdGroupCriterionErrorReasonCANNOT_ADD_LABEL_TO_NEGATIVE_CRITERION,
+		AdGroupCriterionErrorReasonTOO_MANY_OPERATIONS,
+		AdGroupCriterionErrorReasonCANT_UPDATE_NEGATIVE,
+		AdGroupCriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		AdGroupCriterionErrorReasonBID_INCOMPATIBLE_WITH_ADGROUP,
+		AdGroupCriterionErrorReasonCANNOT_TARGET_AND_EXCLUDE,
+		AdGroupCriterionErrorReasonILLEGAL_URL,
+		AdGroupCriterionErrorReasonINVALID_KEYWORD_TEXT,
+		AdGroupCriterionErrorReasonINVALID_DESTINATION_URL,
+		AdGroupCriterionErrorReasonMISSING_DESTINATION_URL_TAG,
+		AdGroupCriterionErrorReasonKEYWORD_LEVEL_BID_NOT_SUPPORTED_FOR_MANUALCPM,
+		AdGroupCriterionErrorReasonINVALID_USER_STATUS,
+		AdGroupCriterionErrorReasonCANNOT_ADD_CRITERIA_TYPE,
+		AdGroupCriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		AdGroupCriterionErrorReasonINVALID_PRODUCT_PARTITION_HIERARCHY,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_UNIT_CANNOT_HAVE_CHILDREN,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_SUBDIVISION_REQUIRES_OTHERS_CASE,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_REQUIRES_SAME_DIMENSION_TYPE_AS_SIBLINGS,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_ALREADY_EXISTS,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_DOES_NOT_EXIST,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_CANNOT_BE_REMOVED,
+		AdGroupCriterionErrorReasonINVALID_PRODUCT_PARTITION_TYPE,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_ADD_MAY_ONLY_USE_TEMP_ID,
+		AdGroupCriterionErrorReasonCAMPAIGN_TYPE_NOT_COMPATIBLE_WITH_PARTIAL_FAILURE,
+		AdGroupCriterionErrorReasonOPERATIONS_FOR_TOO_MANY_SHOPPING_ADGROUPS,
+		AdGroupCriterionErrorReasonCANNOT_MODIFY_URL_FIELDS_WITH_DUPLICATE_ELEMENTS,
+		AdGroupCriterionErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		AdGroupCriterionErrorReasonCANNOT_CLEAR_FINAL_URLS_IF_FINAL_MOBILE_URLS_EXIST,
+		AdGroupCriterionErrorReasonCANNOT_CLEAR_FINAL_URLS_IF_FINAL_APP_URLS_EXIST,
+		AdGroupCriterionErrorReasonCANNOT_CLEAR_FINAL_URLS_IF_TRACKING_URL_TEMPLATE_EXISTS,
+		AdGroupCriterionErrorReasonCANNOT_CLEAR_FINAL_URLS_IF_URL_CUSTOM_PARAMETERS_EXIST,
+		AdGroupCriterionErrorReasonCANNOT_SET_BOTH_DESTINATION_URL_AND_FINAL_URLS,
+		AdGroupCriterionErrorReasonCANNOT_SET_BOTH_DESTINATION_URL_AND_TRACKING_URL_TEMPLATE,
+		AdGroupCriterionErrorReasonFINAL_URLS_NOT_SUPPORTED_FOR_CRITERION_TYPE,
+		AdGroupCriterionErrorReasonFINAL_MOBILE_URLS_NOT_SUPPORTED_FOR_CRITERION_TYPE,
+		AdGroupCriterionErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupCriterionErrorReason.
+func (e AdGroupCriterionErrorReason) IsValid() bool {
+	switch e {
+	case AdGroupCriterionErrorReasonAD_GROUP_CRITERION_LABEL_DOES_NOT_EXIST,
+		AdGroupCriterionErrorReasonAD_GROUP_CRITERION_LABEL_ALREADY_EXISTS,
+		AdGroupCriterionErrorReasonCANNOT_ADD_LABEL_TO_NEGATIVE_CRITERION,
+		AdGroupCriterionErrorReasonTOO_MANY_OPERATIONS,
+		AdGroupCriterionErrorReasonCANT_UPDATE_NEGATIVE,
+		AdGroupCriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		AdGroupCriterionErrorReasonBID_INCOMPATIBLE_WITH_ADGROUP,
+		AdGroupCriterionErrorReasonCANNOT_TARGET_AND_EXCLUDE,
+		AdGroupCriterionErrorReasonILLEGAL_URL,
+		AdGroupCriterionErrorReasonINVALID_KEYWORD_TEXT,
+		AdGroupCriterionErrorReasonINVALID_DESTINATION_URL,
+		AdGroupCriterionErrorReasonMISSING_DESTINATION_URL_TAG,
+		AdGroupCriterionErrorReasonKEYWORD_LEVEL_BID_NOT_SUPPORTED_FOR_MANUALCPM,
+		AdGroupCriterionErrorReasonINVALID_USER_STATUS,
+		AdGroupCriterionErrorReasonCANNOT_ADD_CRITERIA_TYPE,
+		AdGroupCriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		AdGroupCriterionErrorReasonINVALID_PRODUCT_PARTITION_HIERARCHY,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_UNIT_CANNOT_HAVE_CHILDREN,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_SUBDIVISION_REQUIRES_OTHERS_CASE,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_REQUIRES_SAME_DIMENSION_TYPE_AS_SIBLINGS,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_ALREADY_EXISTS,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_DOES_NOT_EXIST,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_CANNOT_BE_REMOVED,
+		AdGroupCriterionErrorReasonINVALID_PRODUCT_PARTITION_TYPE,
+		AdGroupCriterionErrorReasonPRODUCT_PARTITION_ADD_MAY_ONLY_USE_TEMP_ID,
+		AdGroupCriterionErrorReasonCAMPAIGN_TYPE_NOT_COMPATIBLE_WITH_PARTIAL_FAILURE,
+		AdGroupCriterionErrorReasonOPERATIONS_FOR_TOO_MANY_SHOPPING_ADGROUPS,
+		AdGroupCriterionErrorReasonCANNOT_MODIFY_URL_FIELDS_WITH_DUPLICATE_ELEMENTS,
+		AdGroupCriterionErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		AdGroupCriterionErrorReasonCANNOT_CLEAR_FINAL_URLS_IF_FINAL_MOBILE_URLS_EXIST,
+		AdGroupCriterionErrorReasonCANNOT_CLEAR_FINAL_URLS_IF_FINAL_APP_URLS_EXIST,
+		AdGroupCriterionErrorReasonCANNOT_CLEAR_FINAL_URLS_IF_TRACKING_URL_TEMPLATE_EXISTS,
+		AdGroupCriterionErrorReasonCANNOT_CLEAR_FINAL_URLS_IF_URL_CUSTOM_PARAMETERS_EXIST,
+		AdGroupCriterionErrorReasonCANNOT_SET_BOTH_DESTINATION_URL_AND_FINAL_URLS,
+		AdGroupCriterionErrorReasonCANNOT_SET_BOTH_DESTINATION_URL_AND_TRACKING_URL_TEMPLATE,
+		AdGroupCriterionErrorReasonFINAL_URLS_NOT_SUPPORTED_FOR_CRITERION_TYPE,
+		AdGroupCriterionErrorReasonFINAL_MOBILE_URLS_NOT_SUPPORTED_FOR_CRITERION_TYPE,
+		AdGroupCriterionErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupCriterionErrorReason returns the AdGroupCriterionErrorReason named by s.
+func ParseAdGroupCriterionErrorReason(s string) (AdGroupCriterionErrorReason, error) {
+	e := AdGroupCriterionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupCriterionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdGroupCriterionErrorReason.
+func (e AdGroupCriterionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdGroupCriterionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdGroupCriterionErrorReason) UnmarshalText(text []byte) error {
+	v := AdGroupCriterionErrorReason(text)
+	if !v.IsValid() {
+		v = AdGroupCriterionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdGroupFeedErrorReasonValues returns all values of AdGroupFeedErrorReason.
+func AdGroupFeedErrorReasonValues() []AdGroupFeedErrorReason {
+	return []AdGroupFeedErrorReason{
+		AdGroupFeedErrorReasonFEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		AdGroupFeedErrorReasonINVALID_ID,
+		AdGroupFeedErrorReasonCANNOT_ADD_FOR_DELETED_FEED,
+		AdGroupFeedErrorReasonCANNOT_ADD_ALREADY_EXISTING_ADGROUP_FEED,
+		AdGroupFeedErrorReasonCANNOT_OPERATE_ON_REMOVED_ADGROUP_FEED,
+		AdGroupFeedErrorReasonINVALID_PLACEHOLDER_TYPES,
+		AdGroupFeedErrorReasonMISSING_FEEDMAPPING_FOR_PLACEHOLDER_TYPE,
+		AdGroupFeedErrorReasonNO_EXISTING_LOCATION_CUSTOMER_FEED,
+		AdGroupFeedErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupFeedErrorReason.
+func (e AdGroupFeedErrorReason) IsValid() bool {
+	switch e {
+	case AdGroupFeedErrorReasonFEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		AdGroupFeedErrorReasonINVALID_ID,
+		AdGroupFeedErrorReasonCANNOT_ADD_FOR_DELETED_FEED,
+		AdGroupFeedErrorReasonCANNOT_ADD_ALREADY_EXISTING_ADGROUP_FEED,
+		AdGroupFeedErrorReasonCANNOT_OPERATE_ON_REMOVED_ADGROUP_FEED,
+		AdGroupFeedErrorReasonINVALID_PLACEHOLDER_TYPES,
+		AdGroupFeedErrorReasonMISSING_FEEDMAPPING_FOR_PLACEHOLDER_TYPE,
+		AdGroupFeedErrorReasonNO_EXISTING_LOCATION_CUSTOMER_FEED,
+		AdGroupFeedErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupFeedErrorReason returns the AdGroupFeedErrorReason named by s.
+func ParseAdGroupFeedErrorReason(s string) (AdGroupFeedErrorReason, error) {
+	e := AdGroupFeedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupFeedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdGroupFeedErrorReason.
+func (e AdGroupFeedErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdGroupFeedErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdGroupFeedErrorReason) UnmarshalText(text []byte) error {
+	v := AdGroupFeedErrorReason(text)
+	if !v.IsValid() {
+		v = AdGroupFeedErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AdGroupServiceErrorReasonValues returns all values of AdGroupServiceErrorReason.
+func AdGroupServiceErrorReasonValues() []AdGroupServiceErrorReason {
+	return []AdGroupServiceErrorReason{
+		AdGroupServiceErrorReasonDUPLICATE_ADGROUP_NAME,
+		AdGroupServiceErrorReasonINVALID_ADGROUP_NAME,
+		AdGroupServiceErrorReasonUSE_SET_OPERATOR_AND_MARK_STATUS_TO_REMOVED,
+		AdGroupServiceErrorReasonADVERTISER_NOT_ON_CONTENT_NETWORK,
+		AdGroupServiceErrorReasonBID_TOO_BIG,
+		AdGroupServiceErrorReasonBID_TYPE_AND_BIDDING_STRATEGY_MISMATCH,
+		AdGroupServiceErrorReasonMISSING_ADGROUP_NAME,
+		AdGroupServiceErrorReasonADGROUP_LABEL_DOES_NOT_EXIST,
+		AdGroupServiceErrorReasonADGROUP_LABEL_ALREADY_EXISTS,
+		AdGroupServiceErrorReasonINVALID_CONTENT_BID_CRITERION_TYPE_GROUP,
+		AdGroupServiceErrorReasonAD_GROUP_TYPE_NOT_VALID_FOR_ADVERTISING_CHANNEL_TYPE,
+		AdGroupServiceErrorReasonADGROUP_TYPE_NOT_SUPPORTED_FOR_CAMPAIGN_SALES_COUNTRY,
+		AdGroupServiceErrorReasonCANNOT_ADD_ADGROUP_OF_TYPE_DSA_TO_CAMPAIGN_WITHOUT_DSA_SETTING,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdGroupServiceErrorReason.
+func (e AdGroupServiceErrorReason) IsValid() bool {
+	switch e {
+	case AdGroupServiceErrorReasonDUPLICATE_ADGROUP_NAME,
+		AdGroupServiceErrorReasonINVALID_ADGROUP_NAME,
+		AdGroupServiceErrorReasonUSE_SET_OPERATOR_AND_MARK_STATUS_TO_REMOVED,
+		AdGroupServiceErrorReasonADVERTISER_NOT_ON_CONTENT_NETWORK,
+		AdGroupServiceErrorReasonBID_TOO_BIG,
+		AdGroupServiceErrorReasonBID_TYPE_AND_BIDDING_STRATEGY_MISMATCH,
+		AdGroupServiceErrorReasonMISSING_ADGROUP_NAME,
+		AdGroupServiceErrorReasonADGROUP_LABEL_DOES_NOT_EXIST,
+		AdGroupServiceErrorReasonADGROUP_LABEL_ALREADY_EXISTS,
+		AdGroupServiceErrorReasonINVALID_CONTENT_BID_CRITERION_TYPE_GROUP,
+		AdGroupServiceErrorReasonAD_GROUP_TYPE_NOT_VALID_FOR_ADVERTISING_CHANNEL_TYPE,
+		AdGroupServiceErrorReasonADGROUP_TYPE_NOT_SUPPORTED_FOR_CAMPAIGN_SALES_COUNTRY,
+		AdGroupServiceErrorReasonCANNOT_ADD_ADGROUP_OF_TYPE_DSA_TO_CAMPAIGN_WITHOUT_DSA_SETTING:
+		return true
+	}
+	return false
+}
+
+// ParseAdGroupServiceErrorReason returns the AdGroupServiceErrorReason named by s.
+func ParseAdGroupServiceErrorReason(s string) (AdGroupServiceErrorReason, error) {
+	e := AdGroupServiceErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdGroupServiceErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdGroupServiceErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdGroupServiceErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdGroupServiceErrorReason) UnmarshalText(text []byte) error {
+	v := AdGroupServiceErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AdSharingErrorReasonValues returns all values of AdSharingErrorReason.
+func AdSharingErrorReasonValues() []AdSharingErrorReason {
+	return []AdSharingErrorReason{
+		AdSharingErrorReasonAD_GROUP_ALREADY_CONTAINS_AD,
+		AdSharingErrorReasonINCOMPATIBLE_AD_UNDER_AD_GROUP,
+		AdSharingErrorReasonCANNOT_SHARE_INACTIVE_AD,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdSharingErrorReason.
+func (e AdSharingErrorReason) IsValid() bool {
+	switch e {
+	case AdSharingErrorReasonAD_GROUP_ALREADY_CONTAINS_AD,
+		AdSharingErrorReasonINCOMPATIBLE_AD_UNDER_AD_GROUP,
+		AdSharingErrorReasonCANNOT_SHARE_INACTIVE_AD:
+		return true
+	}
+	return false
+}
+
+// ParseAdSharingErrorReason returns the AdSharingErrorReason named by s.
+func ParseAdSharingErrorReason(s string) (AdSharingErrorReason, error) {
+	e := AdSharingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdSharingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AdSharingErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AdSharingErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AdSharingErrorReason) UnmarshalText(text []byte) error {
+	v := AdSharingErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthenticationErrorReasonValues returns all values of AuthenticationErrorReason.
+func AuthenticationErrorReasonValues() []AuthenticationErrorReason {
+	return []AuthenticationErrorReason{
+		AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthenticationErrorReason.
+func (e AuthenticationErrorReason) IsValid() bool {
+	switch e {
+	case AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID:
+		return true
+	}
+	return false
+}
+
+// ParseAuthenticationErrorReason returns the AuthenticationErrorReason named by s.
+func ParseAuthenticationErrorReason(s string) (AuthenticationErrorReason, error) {
+	e := AuthenticationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthenticationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthenticationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthenticationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthenticationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthenticationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthorizationErrorReasonValues returns all values of AuthorizationErrorReason.
+func AuthorizationErrorReasonValues() []AuthorizationErrorReason {
+	return []AuthorizationErrorReason{
+		AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthorizationErrorReason.
+func (e AuthorizationErrorReason) IsValid() bool {
+	switch e {
+	case AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseAuthorizationErrorReason returns the AuthorizationErrorReason named by s.
+func ParseAuthorizationErrorReason(s string) (AuthorizationErrorReason, error) {
+	e := AuthorizationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthorizationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthorizationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthorizationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthorizationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthorizationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// BiddingErrorsReasonValues returns all values of BiddingErrorsReason.
+func BiddingErrorsReasonValues() []BiddingErrorsReason {
+	return []BiddingErrorsReason{
+		BiddingErrorsReasonBIDDING_STRATEGY_TRANSITION_NOT_ALLOWED,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_COMPATIBLE_WITH_ADGROUP_OVERRIDES,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_COMPATIBLE_WITH_ADGROUP_CRITERIA_OVERRIDES,
+		BiddingErrorsReasonCAMPAIGN_BIDDING_STRATEGY_CANNOT_BE_OVERRIDDEN,
+		BiddingErrorsReasonADGROUP_BIDDING_STRATEGY_CANNOT_BE_OVERRIDDEN,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_CAMPAIGN,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_ADGROUP,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_ADGROUP_CRITERIA,
+		BiddingErrorsReasonINVALID_ANONYMOUS_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonBIDS_NOT_ALLLOWED,
+		BiddingErrorsReasonDUPLICATE_BIDS,
+		BiddingErrorsReasonINVALID_BIDDING_SCHEME,
+		BiddingErrorsReasonINVALID_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonMISSING_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonNULL_BID,
+		BiddingErrorsReasonINVALID_BID,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_AVAILABLE_FOR_ACCOUNT_TYPE,
+		BiddingErrorsReasonCONVERSION_TRACKING_NOT_ENABLED,
+		BiddingErrorsReasonNOT_ENOUGH_CONVERSIONS,
+		BiddingErrorsReasonCANNOT_CREATE_CAMPAIGN_WITH_BIDDING_STRATEGY,
+		BiddingErrorsReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_AD_GROUP_LEVEL_POP_BIDDING_STRATEGY,
+		BiddingErrorsReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_CAMPAIGN_LEVEL_POP_BIDDING_STRATEGY,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_SUPPORTED_WITH_AD_SCHEDULE,
+		BiddingErrorsReasonPAY_PER_CONVERSION_NOT_AVAILABLE_FOR_CUSTOMER,
+		BiddingErrorsReasonPAY_PER_CONVERSION_NOT_ALLOWED_WITH_TARGET_CPA,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_ALLOWED_FOR_SEARCH_ONLY_CAMPAIGNS,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_SUPPORTED_IN_DRAFTS_OR_EXPERIMENTS,
+		BiddingErrorsReasonBIDDING_STRATEGY_TYPE_DOES_NOT_SUPPORT_PRODUCT_TYPE_ADGROUP_CRITERION,
+		BiddingErrorsReasonBID_TOO_SMALL,
+		BiddingErrorsReasonBID_TOO_BIG,
+		BiddingErrorsReasonBID_TOO_MANY_FRACTIONAL_DIGITS,
+		BiddingErrorsReasonENHANCED_CPC_ENABLED_NOT_SUPPORTED_ON_PORTFOLIO_TARGET_SPEND_STRATEGY,
+		BiddingErrorsReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of BiddingErrorsReason.
+func (e BiddingErrorsReason) IsValid() bool {
+	switch e {
+	case BiddingErrorsReasonBIDDING_STRATEGY_TRANSITION_NOT_ALLOWED,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_COMPATIBLE_WITH_ADGROUP_OVERRIDES,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_COMPATIBLE_WITH_ADGROUP_CRITERIA_OVERRIDES,
+		BiddingErrorsReasonCAMPAIGN_BIDDING_STRATEGY_CANNOT_BE_OVERRIDDEN,
+		BiddingErrorsReasonADGROUP_BIDDING_STRATEGY_CANNOT_BE_OVERRIDDEN,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_CAMPAIGN,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_ADGROUP,
+		BiddingErrorsReasonCANNOT_ATTACH_BIDDING_STRATEGY_TO_ADGROUP_CRITERIA,
+		BiddingErrorsReasonINVALID_ANONYMOUS_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonBIDS_NOT_ALLLOWED,
+		BiddingErrorsReasonDUPLICATE_BIDS,
+		BiddingErrorsReasonINVALID_BIDDING_SCHEME,
+		BiddingErrorsReasonINVALID_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonMISSING_BIDDING_STRATEGY_TYPE,
+		BiddingErrorsReasonNULL_BID,
+		BiddingErrorsReasonINVALID_BID,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_AVAILABLE_FOR_ACCOUNT_TYPE,
+		BiddingErrorsReasonCONVERSION_TRACKING_NOT_ENABLED,
+		BiddingErrorsReasonNOT_ENOUGH_CONVERSIONS,
+		BiddingErrorsReasonCANNOT_CREATE_CAMPAIGN_WITH_BIDDING_STRATEGY,
+		BiddingErrorsReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_AD_GROUP_LEVEL_POP_BIDDING_STRATEGY,
+		BiddingErrorsReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_CAMPAIGN_LEVEL_POP_BIDDING_STRATEGY,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_SUPPORTED_WITH_AD_SCHEDULE,
+		BiddingErrorsReasonPAY_PER_CONVERSION_NOT_AVAILABLE_FOR_CUSTOMER,
+		BiddingErrorsReasonPAY_PER_CONVERSION_NOT_ALLOWED_WITH_TARGET_CPA,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_ALLOWED_FOR_SEARCH_ONLY_CAMPAIGNS,
+		BiddingErrorsReasonBIDDING_STRATEGY_NOT_SUPPORTED_IN_DRAFTS_OR_EXPERIMENTS,
+		BiddingErrorsReasonBIDDING_STRATEGY_TYPE_DOES_NOT_SUPPORT_PRODUCT_TYPE_ADGROUP_CRITERION,
+		BiddingErrorsReasonBID_TOO_SMALL,
+		BiddingErrorsReasonBID_TOO_BIG,
+		BiddingErrorsReasonBID_TOO_MANY_FRACTIONAL_DIGITS,
+		BiddingErrorsReasonENHANCED_CPC_ENABLED_NOT_SUPPORTED_ON_PORTFOLIO_TARGET_SPEND_STRATEGY,
+		BiddingErrorsReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseBiddingErrorsReason returns the BiddingErrorsReason named by s.
+func ParseBiddingErrorsReason(s string) (BiddingErrorsReason, error) {
+	e := BiddingErrorsReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid BiddingErrorsReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of BiddingErrorsReason.
+func (e BiddingErrorsReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid BiddingErrorsReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *BiddingErrorsReason) UnmarshalText(text []byte) error {
+	v := BiddingErrorsReason(text)
+	if !v.IsValid() {
+		v = BiddingErrorsReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CampaignBidModifierErrorReasonValues returns all values of CampaignBidModifierErrorReason.
+func CampaignBidModifierErrorReasonValues() []CampaignBidModifierErrorReason {
+	return []CampaignBidModifierErrorReason{
+		CampaignBidModifierErrorReasonCAMPAIGN_BID_MODIFIER_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of CampaignBidModifierErrorReason.
+func (e CampaignBidModifierErrorReason) IsValid() bool {
+	switch e {
+	case CampaignBidModifierErrorReasonCAMPAIGN_BID_MODIFIER_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseCampaignBidModifierErrorReason returns the CampaignBidModifierErrorReason named by s.
+func ParseCampaignBidModifierErrorReason(s string) (CampaignBidModifierErrorReason, error) {
+	e := CampaignBidModifierErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CampaignBidModifierErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// CampaignBidModifierErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e CampaignBidModifierErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *CampaignBidModifierErrorReason) UnmarshalText(text []byte) error {
+	v := CampaignBidModifierErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CampaignCriterionErrorReasonValues returns all values of CampaignCriterionErrorReason.
+func CampaignCriterionErrorReasonValues() []CampaignCriterionErrorReason {
+	return []CampaignCriterionErrorReason{
+		CampaignCriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CampaignCriterionErrorReasonINVALID_PLACEMENT_URL,
+		CampaignCriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CampaignCriterionErrorReasonCANNOT_SET_STATUS_FOR_CRITERIA_TYPE,
+		CampaignCriterionErrorReasonCANNOT_SET_STATUS_FOR_EXCLUDED_CRITERIA,
+		CampaignCriterionErrorReasonCANNOT_TARGET_AND_EXCLUDE,
+		CampaignCriterionErrorReasonTOO_MANY_OPERATIONS,
+		CampaignCriterionErrorReasonOPERATOR_NOT_SUPPORTED_FOR_CRITERION_TYPE,
+		CampaignCriterionErrorReasonSHOPPING_CAMPAIGN_SALES_COUNTRY_NOT_SUPPORTED_FOR_SALES_CHANNEL,
+		CampaignCriterionErrorReasonUNKNOWN,
+		CampaignCriterionErrorReasonCANNOT_ADD_EXISTING_FIELD,
+	}
+}
+
+// IsValid reports whether e is one of the values of CampaignCriterionErrorReason.
+func (e CampaignCriterionErrorReason) IsValid() bool {
+	switch e {
+	case CampaignCriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CampaignCriterionErrorReasonINVALID_PLACEMENT_URL,
+		CampaignCriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CampaignCriterionErrorReasonCANNOT_SET_STATUS_FOR_CRITERIA_TYPE,
+		CampaignCriterionErrorReasonCANNOT_SET_STATUS_FOR_EXCLUDED_CRITERIA,
+		CampaignCriterionErrorReasonCANNOT_TARGET_AND_EXCLUDE,
+		CampaignCriterionErrorReasonTOO_MANY_OPERATIONS,
+		CampaignCriterionErrorReasonOPERATOR_NOT_SUPPORTED_FOR_CRITERION_TYPE,
+		CampaignCriterionErrorReasonSHOPPING_CAMPAIGN_SALES_COUNTRY_NOT_SUPPORTED_FOR_SALES_CHANNEL,
+		CampaignCriterionErrorReasonUNKNOWN,
+		CampaignCriterionErrorReasonCANNOT_ADD_EXISTING_FIELD:
+		return true
+	}
+	return false
+}
+
+// ParseCampaignCriterionErrorReason returns the CampaignCriterionErrorReason named by s.
+func ParseCampaignCriterionErrorReason(s string) (CampaignCriterionErrorReason, error) {
+	e := CampaignCriterionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CampaignCriterionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CampaignCriterionErrorReason.
+func (e CampaignCriterionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CampaignCriterionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CampaignCriterionErrorReason) UnmarshalText(text []byte) error {
+	v := CampaignCriterionErrorReason(text)
+	if !v.IsValid() {
+		v = CampaignCriterionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CampaignErrorReasonValues returns all values of CampaignErrorReason.
+func CampaignErrorReasonValues() []CampaignErrorReason {
+	return []CampaignErrorReason{
+		CampaignErrorReasonCANNOT_GO_BACK_TO_INCOMPLETE,
+		CampaignErrorReasonCANNOT_TARGET_CONTENT_NETWORK,
+		CampaignErrorReasonCANNOT_TARGET_SEARCH_NETWORK,
+		CampaignErrorReasonCANNOT_TARGET_SEARCH_NETWORK_WITHOUT_GOOGLE_SEARCH,
+		CampaignErrorReasonCANNOT_TARGET_GOOGLE_SEARCH_FOR_CPM_CAMPAIGN,
+		CampaignErrorReasonCAMPAIGN_MUST_TARGET_AT_LEAST_ONE_NETWORK,
+		CampaignErrorReasonCANNOT_TARGET_PARTNER_SEARCH_NETWORK,
+		CampaignErrorReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_CRITERIA_LEVEL_BIDDING_STRATEGY,
+		CampaignErrorReasonCAMPAIGN_DURATION_MUST_CONTAIN_ALL_RUNNABLE_TRIALS,
+		CampaignErrorReasonCANNOT_MODIFY_FOR_TRIAL_CAMPAIGN,
+		CampaignErrorReasonDUPLICATE_CAMPAIGN_NAME,
+		CampaignErrorReasonINCOMPATIBLE_CAMPAIGN_FIELD,
+		CampaignErrorReasonINVALID_CAMPAIGN_NAME,
+		CampaignErrorReasonINVALID_AD_SERVING_OPTIMIZATION_STATUS,
+		CampaignErrorReasonINVALID_TRACKING_URL,
+		CampaignErrorReasonCANNOT_SET_BOTH_TRACKING_URL_TEMPLATE_AND_TRACKING_SETTING,
+		CampaignErrorReasonMAX_IMPRESSIONS_NOT_IN_RANGE,
+		CampaignErrorReasonTIME_UNIT_NOT_SUPPORTED,
+		CampaignErrorReasonINVALID_OPERATION_IF_SERVING_STATUS_HAS_ENDED,
+		CampaignErrorReasonBUDGET_CANNOT_BE_SHARED,
+		CampaignErrorReasonCAMPAIGN_CANNOT_USE_SHARED_BUDGET,
+		CampaignErrorReasonCANNOT_CHANGE_BUDGET_ON_CAMPAIGN_WITH_TRIALS,
+		CampaignErrorReasonCAMPAIGN_LABEL_DOES_NOT_EXIST,
+		CampaignErrorReasonCAMPAIGN_LABEL_ALREADY_EXISTS,
+		CampaignErrorReasonMISSING_SHOPPING_SETTING,
+		CampaignErrorReasonINVALID_SHOPPING_SALES_COUNTRY,
+		CampaignErrorReasonSHOPPING_MERCHANT_NOT_ALLOWED_FOR_PURCHASES_ON_GOOGLE,
+		CampaignErrorReasonPURCHASES_ON_GOOGLE_NOT_SUPPORTED_FOR_SHOPPING_SALES_COUNTRY,
+		CampaignErrorReasonMISSING_UNIVERSAL_APP_CAMPAIGN_SETTING,
+		CampaignErrorReasonADVERTISING_CHANNEL_TYPE_NOT_AVAILABLE_FOR_ACCOUNT_TYPE,
+		CampaignErrorReasonINVALID_ADVERTISING_CHANNEL_SUB_TYPE,
+		CampaignErrorReasonAT_LEAST_ONE_CONVERSION_MUST_BE_SELECTED,
+		CampaignErrorReasonCANNOT_SET_AD_ROTATION_MODE,
+		CampaignErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CampaignErrorReason.
+func (e CampaignErrorReason) IsValid() bool {
+	switch e {
+	case CampaignErrorReasonCANNOT_GO_BACK_TO_INCOMPLETE,
+		CampaignErrorReasonCANNOT_TARGET_CONTENT_NETWORK,
+		CampaignErrorReasonCANNOT_TARGET_SEARCH_NETWORK,
+		CampaignErrorReasonCANNOT_TARGET_SEARCH_NETWORK_WITHOUT_GOOGLE_SEARCH,
+		CampaignErrorReasonCANNOT_TARGET_GOOGLE_SEARCH_FOR_CPM_CAMPAIGN,
+		CampaignErrorReasonCAMPAIGN_MUST_TARGET_AT_LEAST_ONE_NETWORK,
+		CampaignErrorReasonCANNOT_TARGET_PARTNER_SEARCH_NETWORK,
+		CampaignErrorReasonCANNOT_TARGET_CONTENT_NETWORK_ONLY_WITH_CRITERIA_LEVEL_BIDDING_STRATEGY,
+		CampaignErrorReasonCAMPAIGN_DURATION_MUST_CONTAIN_ALL_RUNNABLE_TRIALS,
+		CampaignErrorReasonCANNOT_MODIFY_FOR_TRIAL_CAMPAIGN,
+		CampaignErrorReasonDUPLICATE_CAMPAIGN_NAME,
+		CampaignErrorReasonINCOMPATIBLE_CAMPAIGN_FIELD,
+		CampaignErrorReasonINVALID_CAMPAIGN_NAME,
+		CampaignErrorReasonINVALID_AD_SERVING_OPTIMIZATION_STATUS,
+		CampaignErrorReasonINVALID_TRACKING_URL,
+		CampaignErrorReasonCANNOT_SET_BOTH_TRACKING_URL_TEMPLATE_AND_TRACKING_SETTING,
+		CampaignErrorReasonMAX_IMPRESSIONS_NOT_IN_RANGE,
+		CampaignErrorReasonTIME_UNIT_NOT_SUPPORTED,
+		CampaignErrorReasonINVALID_OPERATION_IF_SERVING_STATUS_HAS_ENDED,
+		CampaignErrorReasonBUDGET_CANNOT_BE_SHARED,
+		CampaignErrorReasonCAMPAIGN_CANNOT_USE_SHARED_BUDGET,
+		CampaignErrorReasonCANNOT_CHANGE_BUDGET_ON_CAMPAIGN_WITH_TRIALS,
+		CampaignErrorReasonCAMPAIGN_LABEL_DOES_NOT_EXIST,
+		CampaignErrorReasonCAMPAIGN_LABEL_ALREADY_EXISTS,
+		CampaignErrorReasonMISSING_SHOPPING_SETTING,
+		CampaignErrorReasonINVALID_SHOPPING_SALES_COUNTRY,
+		CampaignErrorReasonSHOPPING_MERCHANT_NOT_ALLOWED_FOR_PURCHASES_ON_GOOGLE,
+		CampaignErrorReasonPURCHASES_ON_GOOGLE_NOT_SUPPORTED_FOR_SHOPPING_SALES_COUNTRY,
+		CampaignErrorReasonMISSING_UNIVERSAL_APP_CAMPAIGN_SETTING,
+		CampaignErrorReasonADVERTISING_CHANNEL_TYPE_NOT_AVAILABLE_FOR_ACCOUNT_TYPE,
+		CampaignErrorReasonINVALID_ADVERTISING_CHANNEL_SUB_TYPE,
+		CampaignErrorReasonAT_LEAST_ONE_CONVERSION_MUST_BE_SELECTED,
+		CampaignErrorReasonCANNOT_SET_AD_ROTATION_MODE,
+		CampaignErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCampaignErrorReason returns the CampaignErrorReason named by s.
+func ParseCampaignErrorReason(s string) (CampaignErrorReason, error) {
+	e := CampaignErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CampaignErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CampaignErrorReason.
+func (e CampaignErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CampaignErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CampaignErrorReason) UnmarshalText(text []byte) error {
+	v := CampaignErrorReason(text)
+	if !v.IsValid() {
+		v = CampaignErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CampaignFeedErrorReasonValues returns all values of CampaignFeedErrorReason.
+func CampaignFeedErrorReasonValues() []CampaignFeedErrorReason {
+	return []CampaignFeedErrorReason{
+		CampaignFeedErrorReasonFEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		CampaignFeedErrorReasonINVALID_ID,
+		CampaignFeedErrorReasonCANNOT_ADD_FOR_DELETED_FEED,
+		CampaignFeedErrorReasonCANNOT_ADD_ALREADY_EXISTING_CAMPAIGN_FEED,
+		CampaignFeedErrorReasonCANNOT_OPERATE_ON_REMOVED_CAMPAIGN_FEED,
+		CampaignFeedErrorReasonINVALID_PLACEHOLDER_TYPES,
+		CampaignFeedErrorReasonMISSING_FEEDMAPPING_FOR_PLACEHOLDER_TYPE,
+		CampaignFeedErrorReasonNO_EXISTING_LOCATION_CUSTOMER_FEED,
+		CampaignFeedErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CampaignFeedErrorReason.
+func (e CampaignFeedErrorReason) IsValid() bool {
+	switch e {
+	case CampaignFeedErrorReasonFEED_ALREADY_EXISTS_FOR_PLACEHOLDER_TYPE,
+		CampaignFeedErrorReasonINVALID_ID,
+		CampaignFeedErrorReasonCANNOT_ADD_FOR_DELETED_FEED,
+		CampaignFeedErrorReasonCANNOT_ADD_ALREADY_EXISTING_CAMPAIGN_FEED,
+		CampaignFeedErrorReasonCANNOT_OPERATE_ON_REMOVED_CAMPAIGN_FEED,
+		CampaignFeedErrorReasonINVALID_PLACEHOLDER_TYPES,
+		CampaignFeedErrorReasonMISSING_FEEDMAPPING_FOR_PLACEHOLDER_TYPE,
+		CampaignFeedErrorReasonNO_EXISTING_LOCATION_CUSTOMER_FEED,
+		CampaignFeedErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCampaignFeedErrorReason returns the CampaignFeedErrorReason named by s.
+func ParseCampaignFeedErrorReason(s string) (CampaignFeedErrorReason, error) {
+	e := CampaignFeedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CampaignFeedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CampaignFeedErrorReason.
+func (e CampaignFeedErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CampaignFeedErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CampaignFeedErrorReason) UnmarshalText(text []byte) error {
+	v := CampaignFeedErrorReason(text)
+	if !v.IsValid() {
+		v = CampaignFeedErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CampaignPreferenceErrorReasonValues returns all values of CampaignPreferenceErrorReason.
+func CampaignPreferenceErrorReasonValues() []CampaignPreferenceErrorReason {
+	return []CampaignPreferenceErrorReason{
+		CampaignPreferenceErrorReasonPREFERENCE_ALREADY_EXISTS,
+		CampaignPreferenceErrorReasonPREFERENCE_NOT_FOUND,
+		CampaignPreferenceErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CampaignPreferenceErrorReason.
+func (e CampaignPreferenceErrorReason) IsValid() bool {
+	switch e {
+	case CampaignPreferenceErrorReasonPREFERENCE_ALREADY_EXISTS,
+		CampaignPreferenceErrorReasonPREFERENCE_NOT_FOUND,
+		CampaignPreferenceErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCampaignPreferenceErrorReason returns the CampaignPreferenceErrorReason named by s.
+func ParseCampaignPreferenceErrorReason(s string) (CampaignPreferenceErrorReason, error) {
+	e := CampaignPreferenceErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CampaignPreferenceErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CampaignPreferenceErrorReason.
+func (e CampaignPreferenceErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CampaignPreferenceErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CampaignPreferenceErrorReason) UnmarshalText(text []byte) error {
+	v := CampaignPreferenceErrorReason(text)
+	if !v.IsValid() {
+		v = CampaignPreferenceErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// CampaignSharedSetErrorReasonValues returns all values of CampaignSharedSetErrorReason.
+func CampaignSharedSetErrorReasonValues() []CampaignSharedSetErrorReason {
+	return []CampaignSharedSetErrorReason{
+		CampaignSharedSetErrorReasonCAMPAIGN_SHARED_SET_DOES_NOT_EXIST,
+		CampaignSharedSetErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CampaignSharedSetErrorReason.
+func (e CampaignSharedSetErrorReason) IsValid() bool {
+	switch e {
+	case CampaignSharedSetErrorReasonCAMPAIGN_SHARED_SET_DOES_NOT_EXIST,
+		CampaignSharedSetErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCampaignSharedSetErrorReason returns the CampaignSharedSetErrorReason named by s.
+func ParseCampaignSharedSetErrorReason(s string) (CampaignSharedSetErrorReason, error) {
+	e := CampaignSharedSetErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CampaignSharedSetErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CampaignSharedSetErrorReason.
+func (e CampaignSharedSetErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CampaignSharedSetErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CampaignSharedSetErrorReason) UnmarshalText(text []byte) error {
+	v := CampaignSharedSetErrorReason(text)
+	if !v.IsValid() {
+		v = CampaignSharedSetErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// ClientTermsErrorReasonValues returns all values of ClientTermsErrorReason.
+func ClientTermsErrorReasonValues() []ClientTermsErrorReason {
+	return []ClientTermsErrorReason{
+		ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ClientTermsErrorReason.
+func (e ClientTermsErrorReason) IsValid() bool {
+	switch e {
+	case ClientTermsErrorReasonINCOMPLETE_SIGNUP_CURRENT_ADWORDS_TNC_NOT_AGREED:
+		return true
+	}
+	return false
+}
+
+// ParseClientTermsErrorReason returns the ClientTermsErrorReason named by s.
+func ParseClientTermsErrorReason(s string) (ClientTermsErrorReason, error) {
+	e := ClientTermsErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ClientTermsErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ClientTermsErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ClientTermsErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ClientTermsErrorReason) UnmarshalText(text []byte) error {
+	v := ClientTermsErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CriterionErrorReasonValues returns all values of CriterionErrorReason.
+func CriterionErrorReasonValues() []CriterionErrorReason {
+	return []CriterionErrorReason{
+		CriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CriterionErrorReasonINVALID_EXCLUDED_CATEGORY,
+		CriterionErrorReasonINVALID_KEYWORD_TEXT,
+		CriterionErrorReasonKEYWORD_TEXT_TOO_LONG,
+		CriterionErrorReasonKEYWORD_HAS_TOO_MANY_WORDS,
+		CriterionErrorReasonKEYWORD_HAS_INVALID_CHARS,
+		CriterionErrorReasonINVALID_PLACEMENT_URL,
+		CriterionErrorReasonINVALID_USER_LIST,
+		CriterionErrorReasonINVALID_USER_INTEREST,
+		CriterionErrorReasonINVALID_FORMAT_FOR_PLACEMENT_URL,
+		CriterionErrorReasonPLACEMENT_URL_IS_TOO_LONG,
+		CriterionErrorReasonPLACEMENT_URL_HAS_ILLEGAL_CHAR,
+		CriterionErrorReasonPLACEMENT_URL_HAS_MULTIPLE_SITES_IN_LINE,
+		CriterionErrorReasonPLACEMENT_IS_NOT_AVAILABLE_FOR_TARGETING_OR_EXCLUSION,
+		CriterionErrorReasonINVALID_VERTICAL_PATH,
+		CriterionErrorReasonYOUTUBE_VERTICAL_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_DEMOGRAPHIC_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_URL_UNSUPPORTED,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CriterionErrorReasonCANNOT_ADD_CRITERIA_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_FILTER,
+		CriterionErrorReasonPRODUCT_FILTER_TOO_LONG,
+		CriterionErrorReasonCANNOT_EXCLUDE_SIMILAR_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_CLOSED_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_ONLY_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SHOPPING_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_USER_INTERESTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_SET_BIDS_ON_CRITERION_TYPE_IN_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_URLS_TO_CRITERION_TYPE_FOR_CAMPAIGN_TYPE,
+		CriterionErrorReasonINVALID_IP_ADDRESS,
+		CriterionErrorReasonINVALID_IP_FORMAT,
+		CriterionErrorReasonINVALID_MOBILE_APP,
+		CriterionErrorReasonINVALID_MOBILE_APP_CATEGORY,
+		CriterionErrorReasonINVALID_CRITERION_ID,
+		CriterionErrorReasonCANNOT_TARGET_CRITERION,
+		CriterionErrorReasonCANNOT_TARGET_OBSOLETE_CRITERION,
+		CriterionErrorReasonCRITERION_ID_AND_TYPE_MISMATCH,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS_UNITS,
+		CriterionErrorReasonINVALID_STREETADDRESS_LENGTH,
+		CriterionErrorReasonINVALID_CITYNAME_LENGTH,
+		CriterionErrorReasonINVALID_REGIONCODE_LENGTH,
+		CriterionErrorReasonINVALID_REGIONNAME_LENGTH,
+		CriterionErrorReasonINVALID_POSTALCODE_LENGTH,
+		CriterionErrorReasonINVALID_COUNTRY_CODE,
+		CriterionErrorReasonINVALID_LATITUDE,
+		CriterionErrorReasonINVALID_LONGITUDE,
+		CriterionErrorReasonPROXIMITY_GEOPOINT_AND_ADDRESS_BOTH_CANNOT_BE_NULL,
+		CriterionErrorReasonINVALID_PROXIMITY_ADDRESS,
+		CriterionErrorReasonINVALID_USER_DOMAIN_NAME,
+		CriterionErrorReasonCRITERION_PARAMETER_TOO_LONG,
+		CriterionErrorReasonAD_SCHEDULE_TIME_INTERVALS_OVERLAP,
+		CriterionErrorReasonAD_SCHEDULE_INTERVAL_CANNOT_SPAN_MULTIPLE_DAYS,
+		CriterionErrorReasonAD_SCHEDULE_INVALID_TIME_INTERVAL,
+		CriterionErrorReasonAD_SCHEDULE_EXCEEDED_INTERVALS_PER_DAY_LIMIT,
+		CriterionErrorReasonAD_SCHEDULE_CRITERION_ID_MISMATCHING_FIELDS,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_TYPE,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_CAMPAIGN_OPTED_OUT,
+		CriterionErrorReasonCANNOT_BID_MODIFY_NEGATIVE_CRITERION,
+		CriterionErrorReasonBID_MODIFIER_ALREADY_EXISTS,
+		CriterionErrorReasonFEED_ID_NOT_ALLOWED,
+		CriterionErrorReasonACCOUNT_INELIGIBLE_FOR_CRITERIA_TYPE,
+		CriterionErrorReasonCRITERIA_TYPE_INVALID_FOR_BIDDING_STRATEGY,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERION,
+		CriterionErrorReasonCANNOT_REMOVE_CRITERION,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_LONG,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_LONG,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_BIDDING_CATEGORY,
+		CriterionErrorReasonMISSING_SHOPPING_SETTING,
+		CriterionErrorReasonINVALID_MATCHING_FUNCTION,
+		CriterionErrorReasonLOCATION_FILTER_NOT_ALLOWED,
+		CriterionErrorReasonLOCATION_FILTER_INVALID,
+		CriterionErrorReasonCANNOT_ATTACH_CRITERIA_AT_CAMPAIGN_AND_ADGROUP,
+		CriterionErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of CriterionErrorReason.
+func (e CriterionErrorReason) IsValid() bool {
+	switch e {
+	case CriterionErrorReasonCONCRETE_TYPE_REQUIRED,
+		CriterionErrorReasonINVALID_EXCLUDED_CATEGORY,
+		CriterionErrorReasonINVALID_KEYWORD_TEXT,
+		CriterionErrorReasonKEYWORD_TEXT_TOO_LONG,
+		CriterionErrorReasonKEYWORD_HAS_TOO_MANY_WORDS,
+		CriterionErrorReasonKEYWORD_HAS_INVALID_CHARS,
+		CriterionErrorReasonINVALID_PLACEMENT_URL,
+		CriterionErrorReasonINVALID_USER_LIST,
+		CriterionErrorReasonINVALID_USER_INTEREST,
+		CriterionErrorReasonINVALID_FORMAT_FOR_PLACEMENT_URL,
+		CriterionErrorReasonPLACEMENT_URL_IS_TOO_LONG,
+		CriterionErrorReasonPLACEMENT_URL_HAS_ILLEGAL_CHAR,
+		CriterionErrorReasonPLACEMENT_URL_HAS_MULTIPLE_SITES_IN_LINE,
+		CriterionErrorReasonPLACEMENT_IS_NOT_AVAILABLE_FOR_TARGETING_OR_EXCLUSION,
+		CriterionErrorReasonINVALID_VERTICAL_PATH,
+		CriterionErrorReasonYOUTUBE_VERTICAL_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_DEMOGRAPHIC_CHANNEL_DEPRECATED,
+		CriterionErrorReasonYOUTUBE_URL_UNSUPPORTED,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERIA_TYPE,
+		CriterionErrorReasonCANNOT_ADD_CRITERIA_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_FILTER,
+		CriterionErrorReasonPRODUCT_FILTER_TOO_LONG,
+		CriterionErrorReasonCANNOT_EXCLUDE_SIMILAR_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_CLOSED_USER_LIST,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_ONLY_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_DISPLAY_ONLY_LISTS_TO_SHOPPING_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_USER_INTERESTS_TO_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_SET_BIDS_ON_CRITERION_TYPE_IN_SEARCH_CAMPAIGNS,
+		CriterionErrorReasonCANNOT_ADD_URLS_TO_CRITERION_TYPE_FOR_CAMPAIGN_TYPE,
+		CriterionErrorReasonINVALID_IP_ADDRESS,
+		CriterionErrorReasonINVALID_IP_FORMAT,
+		CriterionErrorReasonINVALID_MOBILE_APP,
+		CriterionErrorReasonINVALID_MOBILE_APP_CATEGORY,
+		CriterionErrorReasonINVALID_CRITERION_ID,
+		CriterionErrorReasonCANNOT_TARGET_CRITERION,
+		CriterionErrorReasonCANNOT_TARGET_OBSOLETE_CRITERION,
+		CriterionErrorReasonCRITERION_ID_AND_TYPE_MISMATCH,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS,
+		CriterionErrorReasonINVALID_PROXIMITY_RADIUS_UNITS,
+		CriterionErrorReasonINVALID_STREETADDRESS_LENGTH,
+		CriterionErrorReasonINVALID_CITYNAME_LENGTH,
+		CriterionErrorReasonINVALID_REGIONCODE_LENGTH,
+		CriterionErrorReasonINVALID_REGIONNAME_LENGTH,
+		CriterionErrorReasonINVALID_POSTALCODE_LENGTH,
+		CriterionErrorReasonINVALID_COUNTRY_CODE,
+		CriterionErrorReasonINVALID_LATITUDE,
+		CriterionErrorReasonINVALID_LONGITUDE,
+		CriterionErrorReasonPROXIMITY_GEOPOINT_AND_ADDRESS_BOTH_CANNOT_BE_NULL,
+		CriterionErrorReasonINVALID_PROXIMITY_ADDRESS,
+		CriterionErrorReasonINVALID_USER_DOMAIN_NAME,
+		CriterionErrorReasonCRITERION_PARAMETER_TOO_LONG,
+		CriterionErrorReasonAD_SCHEDULE_TIME_INTERVALS_OVERLAP,
+		CriterionErrorReasonAD_SCHEDULE_INTERVAL_CANNOT_SPAN_MULTIPLE_DAYS,
+		CriterionErrorReasonAD_SCHEDULE_INVALID_TIME_INTERVAL,
+		CriterionErrorReasonAD_SCHEDULE_EXCEEDED_INTERVALS_PER_DAY_LIMIT,
+		CriterionErrorReasonAD_SCHEDULE_CRITERION_ID_MISMATCHING_FIELDS,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_TYPE,
+		CriterionErrorReasonCANNOT_BID_MODIFY_CRITERION_CAMPAIGN_OPTED_OUT,
+		CriterionErrorReasonCANNOT_BID_MODIFY_NEGATIVE_CRITERION,
+		CriterionErrorReasonBID_MODIFIER_ALREADY_EXISTS,
+		CriterionErrorReasonFEED_ID_NOT_ALLOWED,
+		CriterionErrorReasonACCOUNT_INELIGIBLE_FOR_CRITERIA_TYPE,
+		CriterionErrorReasonCRITERIA_TYPE_INVALID_FOR_BIDDING_STRATEGY,
+		CriterionErrorReasonCANNOT_EXCLUDE_CRITERION,
+		CriterionErrorReasonCANNOT_REMOVE_CRITERION,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_LONG,
+		CriterionErrorReasonPRODUCT_SCOPE_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_LONG,
+		CriterionErrorReasonPRODUCT_PARTITION_TOO_MANY_DIMENSIONS,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION,
+		CriterionErrorReasonINVALID_PRODUCT_DIMENSION_TYPE,
+		CriterionErrorReasonINVALID_PRODUCT_BIDDING_CATEGORY,
+		CriterionErrorReasonMISSING_SHOPPING_SETTING,
+		CriterionErrorReasonINVALID_MATCHING_FUNCTION,
+		CriterionErrorReasonLOCATION_FILTER_NOT_ALLOWED,
+		CriterionErrorReasonLOCATION_FILTER_INVALID,
+		CriterionErrorReasonCANNOT_ATTACH_CRITERIA_AT_CAMPAIGN_AND_ADGROUP,
+		CriterionErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseCriterionErrorReason returns the CriterionErrorReason named by s.
+func ParseCriterionErrorReason(s string) (CriterionErrorReason, error) {
+	e := CriterionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CriterionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of CriterionErrorReason.
+func (e CriterionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid CriterionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *CriterionErrorReason) UnmarshalText(text []byte) error {
+	v := CriterionErrorReason(text)
+	if !v.IsValid() {
+		v = CriterionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DatabaseErrorReasonValues returns all values of DatabaseErrorReason.
+func DatabaseErrorReasonValues() []DatabaseErrorReason {
+	return []DatabaseErrorReason{
+		DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DatabaseErrorReason.
+func (e DatabaseErrorReason) IsValid() bool {
+	switch e {
+	case DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDatabaseErrorReason returns the DatabaseErrorReason named by s.
+func ParseDatabaseErrorReason(s string) (DatabaseErrorReason, error) {
+	e := DatabaseErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DatabaseErrorReason.
+func (e DatabaseErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DatabaseErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DatabaseErrorReason) UnmarshalText(text []byte) error {
+	v := DatabaseErrorReason(text)
+	if !v.IsValid() {
+		v = DatabaseErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DateErrorReasonValues returns all values of DateErrorReason.
+func DateErrorReasonValues() []DateErrorReason {
+	return []DateErrorReason{
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL,
+	}
+}
+
+// IsValid reports whether e is one of the values of DateErrorReason.
+func (e DateErrorReason) IsValid() bool {
+	switch e {
+	case DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL:
+		return true
+	}
+	return false
+}
+
+// ParseDateErrorReason returns the DateErrorReason named by s.
+func ParseDateErrorReason(s string) (DateErrorReason, error) {
+	e := DateErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DateErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DateErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DateErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DateErrorReason) UnmarshalText(text []byte) error {
+	v := DateErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DateRangeErrorReasonValues returns all values of DateRangeErrorReason.
+func DateRangeErrorReasonValues() []DateRangeErrorReason {
+	return []DateRangeErrorReason{
+		DateRangeErrorReasonDATE_RANGE_ERROR,
+		DateRangeErrorReasonINVALID_DATE,
+		DateRangeErrorReasonSTART_DATE_AFTER_END_DATE,
+		DateRangeErrorReasonCANNOT_SET_DATE_TO_PAST,
+		DateRangeErrorReasonAFTER_MAXIMUM_ALLOWABLE_DATE,
+		DateRangeErrorReasonCANNOT_MODIFY_START_DATE_IF_ALREADY_STARTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of DateRangeErrorReason.
+func (e DateRangeErrorReason) IsValid() bool {
+	switch e {
+	case DateRangeErrorReasonDATE_RANGE_ERROR,
+		DateRangeErrorReasonINVALID_DATE,
+		DateRangeErrorReasonSTART_DATE_AFTER_END_DATE,
+		DateRangeErrorReasonCANNOT_SET_DATE_TO_PAST,
+		DateRangeErrorReasonAFTER_MAXIMUM_ALLOWABLE_DATE,
+		DateRangeErrorReasonCANNOT_MODIFY_START_DATE_IF_ALREADY_STARTED:
+		return true
+	}
+	return false
+}
+
+// ParseDateRangeErrorReason returns the DateRangeErrorReason named by s.
+func ParseDateRangeErrorReason(s string) (DateRangeErrorReason, error) {
+	e := DateRangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DateRangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DateRangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DateRangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DateRangeErrorReason) UnmarshalText(text []byte) error {
+	v := DateRangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DistinctErrorReasonValues returns all values of DistinctErrorReason.
+func DistinctErrorReasonValues() []DistinctErrorReason {
+	return []DistinctErrorReason{
+		DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DistinctErrorReason.
+func (e DistinctErrorReason) IsValid() bool {
+	switch e {
+	case DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDistinctErrorReason returns the DistinctErrorReason named by s.
+func ParseDistinctErrorReason(s string) (DistinctErrorReason, error) {
+	e := DistinctErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DistinctErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DistinctErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DistinctErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DistinctErrorReason) UnmarshalText(text []byte) error {
+	v := DistinctErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DraftErrorReasonValues returns all values of DraftErrorReason.
+func DraftErrorReasonValues() []DraftErrorReason {
+	return []DraftErrorReason{
+		DraftErrorReasonCANNOT_CHANGE_ARCHIVED_DRAFT,
+		DraftErrorReasonCANNOT_CHANGE_PROMOTED_DRAFT,
+		DraftErrorReasonCANNOT_CHANGE_PROMOTE_FAILED_DRAFT,
+		DraftErrorReasonCUSTOMER_CANNOT_CREATE_DRAFT,
+		DraftErrorReasonCAMPAIGN_CANNOT_CREATE_DRAFT,
+		DraftErrorReasonDUPLICATE_DRAFT_NAME,
+		DraftErrorReasonINVALID_DRAFT_CHANGE,
+		DraftErrorReasonINVALID_STATUS_TRANSITION,
+		DraftErrorReasonMAX_NUMBER_OF_DRAFTS_PER_CAMPAIGN_REACHED,
+		DraftErrorReasonDRAFT_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of DraftErrorReason.
+func (e DraftErrorReason) IsValid() bool {
+	switch e {
+	case DraftErrorReasonCANNOT_CHANGE_ARCHIVED_DRAFT,
+		DraftErrorReasonCANNOT_CHANGE_PROMOTED_DRAFT,
+		DraftErrorReasonCANNOT_CHANGE_PROMOTE_FAILED_DRAFT,
+		DraftErrorReasonCUSTOMER_CANNOT_CREATE_DRAFT,
+		DraftErrorReasonCAMPAIGN_CANNOT_CREATE_DRAFT,
+		DraftErrorReasonDUPLICATE_DRAFT_NAME,
+		DraftErrorReasonINVALID_DRAFT_CHANGE,
+		DraftErrorReasonINVALID_STATUS_TRANSITION,
+		DraftErrorReasonMAX_NUMBER_OF_DRAFTS_PER_CAMPAIGN_REACHED,
+		DraftErrorReasonDRAFT_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseDraftErrorReason returns the DraftErrorReason named by s.
+func ParseDraftErrorReason(s string) (DraftErrorReason, error) {
+	e := DraftErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DraftErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DraftErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DraftErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DraftErrorReason) UnmarshalText(text []byte) error {
+	v := DraftErrorReason(text)
+	*e = v
+	return nil
+}
+
+// EntityAccessDeniedReasonValues returns all values of EntityAccessDeniedReason.
+func EntityAccessDeniedReasonValues() []EntityAccessDeniedReason {
+	return []EntityAccessDeniedReason{
+		EntityAccessDeniedReasonREAD_ACCESS_DENIED,
+		EntityAccessDeniedReasonWRITE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityAccessDeniedReason.
+func (e EntityAccessDeniedReason) IsValid() bool {
+	switch e {
+	case EntityAccessDeniedReasonREAD_ACCESS_DENIED,
+		EntityAccessDeniedReasonWRITE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseEntityAccessDeniedReason returns the EntityAccessDeniedReason named by s.
+func ParseEntityAccessDeniedReason(s string) (EntityAccessDeniedReason, error) {
+	e := EntityAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityAccessDeniedReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityAccessDeniedReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := EntityAccessDeniedReason(text)
+	*e = v
+	return nil
+}
+
+// EntityCountLimitExceededReasonValues returns all values of EntityCountLimitExceededReason.
+func EntityCountLimitExceededReasonValues() []EntityCountLimitExceededReason {
+	return []EntityCountLimitExceededReason{
+		EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) IsValid() bool {
+	switch e {
+	case EntityCountLimitExceededReasonACCOUNT_LIMIT,
+		EntityCountLimitExceededReasonCAMPAIGN_LIMIT,
+		EntityCountLimitExceededReasonADGROUP_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_AD_LIMIT,
+		EntityCountLimitExceededReasonAD_GROUP_CRITERION_LIMIT,
+		EntityCountLimitExceededReasonSHARED_SET_LIMIT,
+		EntityCountLimitExceededReasonMATCHING_FUNCTION_LIMIT,
+		EntityCountLimitExceededReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseEntityCountLimitExceededReason returns the EntityCountLimitExceededReason named by s.
+func ParseEntityCountLimitExceededReason(s string) (EntityCountLimitExceededReason, error) {
+	e := EntityCountLimitExceededReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityCountLimitExceededReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of EntityCountLimitExceededReason.
+func (e EntityCountLimitExceededReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid EntityCountLimitExceededReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *EntityCountLimitExceededReason) UnmarshalText(text []byte) error {
+	v := EntityCountLimitExceededReason(text)
+	if !v.IsValid() {
+		v = EntityCountLimitExceededReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// EntityNotFoundReasonValues returns all values of EntityNotFoundReason.
+func EntityNotFoundReasonValues() []EntityNotFoundReason {
+	return []EntityNotFoundReason{
+		EntityNotFoundReasonINVALID_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityNotFoundReason.
+func (e EntityNotFoundReason) IsValid() bool {
+	switch e {
+	case EntityNotFoundReasonINVALID_ID:
+		return true
+	}
+	return false
+}
+
+// ParseEntityNotFoundReason returns the EntityNotFoundReason named by s.
+func ParseEntityNotFoundReason(s string) (EntityNotFoundReason, error) {
+	e := EntityNotFoundReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityNotFoundReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityNotFoundReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityNotFoundReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityNotFoundReason) UnmarshalText(text []byte) error {
+	v := EntityNotFoundReason(text)
+	*e = v
+	return nil
+}
+
+// FeedErrorReasonValues returns all values of FeedErrorReason.
+func FeedErrorReasonValues() []FeedErrorReason {
+	return []FeedErrorReason{
+		FeedErrorReasonATTRIBUTE_NAMES_NOT_UNIQUE,
+		FeedErrorReasonATTRIBUTES_DO_NOT_MATCH_EXISTING_ATTRIBUTES,
+		FeedErrorReasonCANNOT_CHANGE_ORIGIN,
+		FeedErrorReasonCANNOT_SPECIFY_USER_ORIGIN_FOR_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_SPECIFY_ADWORDS_ORIGIN_FOR_NON_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_SPECIFY_FEED_ATTRIBUTES_FOR_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_UPDATE_FEED_ATTRIBUTES_WITH_ORIGIN_ADWORDS,
+		FeedErrorReasonFEED_REMOVED,
+		FeedErrorReasonINVALID_ORIGIN_VALUE,
+		FeedErrorReasonFEED_ORIGIN_IS_NOT_USER,
+		FeedErrorReasonINVALID_AUTH_TOKEN_FOR_EMAIL,
+		FeedErrorReasonINVALID_EMAIL,
+		FeedErrorReasonDUPLICATE_FEED_NAME,
+		FeedErrorReasonINVALID_FEED_NAME,
+		FeedErrorReasonMISSING_OAUTH_INFO,
+		FeedErrorReasonNEW_ATTRIBUTE_CANNOT_BE_PART_OF_UNIQUE_KEY,
+		FeedErrorReasonTOO_MANY_FEED_ATTRIBUTES_FOR_FEED,
+		FeedErrorReasonINVALID_BUSINESS_ACCOUNT,
+		FeedErrorReasonBUSINESS_ACCOUNT_CANNOT_ACCESS_LOCATION_ACCOUNT,
+		FeedErrorReasonINVALID_AFFILIATE_CHAIN_ID,
+		FeedErrorReasonCANNOT_CHANGE_SYSTEM_FEED_GENERATION_DATA_TYPE,
+		FeedErrorReasonUNSUPPORTED_AFFILIATE_LOCATION_RELATIONSHIP_TYPE,
+		FeedErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FeedErrorReason.
+func (e FeedErrorReason) IsValid() bool {
+	switch e {
+	case FeedErrorReasonATTRIBUTE_NAMES_NOT_UNIQUE,
+		FeedErrorReasonATTRIBUTES_DO_NOT_MATCH_EXISTING_ATTRIBUTES,
+		FeedErrorReasonCANNOT_CHANGE_ORIGIN,
+		FeedErrorReasonCANNOT_SPECIFY_USER_ORIGIN_FOR_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_SPECIFY_ADWORDS_ORIGIN_FOR_NON_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_SPECIFY_FEED_ATTRIBUTES_FOR_SYSTEM_FEED,
+		FeedErrorReasonCANNOT_UPDATE_FEED_ATTRIBUTES_WITH_ORIGIN_ADWORDS,
+		FeedErrorReasonFEED_REMOVED,
+		FeedErrorReasonINVALID_ORIGIN_VALUE,
+		FeedErrorReasonFEED_ORIGIN_IS_NOT_USER,
+		FeedErrorReasonINVALID_AUTH_TOKEN_FOR_EMAIL,
+		FeedErrorReasonINVALID_EMAIL,
+		FeedErrorReasonDUPLICATE_FEED_NAME,
+		FeedErrorReasonINVALID_FEED_NAME,
+		FeedErrorReasonMISSING_OAUTH_INFO,
+		FeedErrorReasonNEW_ATTRIBUTE_CANNOT_BE_PART_OF_UNIQUE_KEY,
+		FeedErrorReasonTOO_MANY_FEED_ATTRIBUTES_FOR_FEED,
+		FeedErrorReasonINVALID_BUSINESS_ACCOUNT,
+		FeedErrorReasonBUSINESS_ACCOUNT_CANNOT_ACCESS_LOCATION_ACCOUNT,
+		FeedErrorReasonINVALID_AFFILIATE_CHAIN_ID,
+		FeedErrorReasonCANNOT_CHANGE_SYSTEM_FEED_GENERATION_DATA_TYPE,
+		FeedErrorReasonUNSUPPORTED_AFFILIATE_LOCATION_RELATIONSHIP_TYPE,
+		FeedErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFeedErrorReason returns the FeedErrorReason named by s.
+func ParseFeedErrorReason(s string) (FeedErrorReason, error) {
+	e := FeedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FeedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FeedErrorReason.
+func (e FeedErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FeedErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FeedErrorReason) UnmarshalText(text []byte) error {
+	v := FeedErrorReason(text)
+	if !v.IsValid() {
+		v = FeedErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// FunctionErrorReasonValues returns all values of FunctionErrorReason.
+func FunctionErrorReasonValues() []FunctionErrorReason {
+	return []FunctionErrorReason{
+		FunctionErrorReasonINVALID_FUNCTION_FORMAT,
+		FunctionErrorReasonDATA_TYPE_MISMATCH,
+		FunctionErrorReasonINVALID_CONJUNCTION_OPERANDS,
+		FunctionErrorReasonINVALID_NUMBER_OF_OPERANDS,
+		FunctionErrorReasonINVALID_OPERAND_TYPE,
+		FunctionErrorReasonINVALID_OPERATOR,
+		FunctionErrorReasonINVALID_REQUEST_CONTEXT_TYPE,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_CALL_PLACEHOLDER,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_PLACEHOLDER,
+		FunctionErrorReasonINVALID_OPERAND,
+		FunctionErrorReasonMISSING_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_NESTING,
+		FunctionErrorReasonMULTIPLE_FEED_IDS_NOT_SUPPORTED,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_FEED_WITH_FIXED_SCHEMA,
+		FunctionErrorReasonINVALID_ATTRIBUTE_NAME,
+		FunctionErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of FunctionErrorReason.
+func (e FunctionErrorReason) IsValid() bool {
+	switch e {
+	case FunctionErrorReasonINVALID_FUNCTION_FORMAT,
+		FunctionErrorReasonDATA_TYPE_MISMATCH,
+		FunctionErrorReasonINVALID_CONJUNCTION_OPERANDS,
+		FunctionErrorReasonINVALID_NUMBER_OF_OPERANDS,
+		FunctionErrorReasonINVALID_OPERAND_TYPE,
+		FunctionErrorReasonINVALID_OPERATOR,
+		FunctionErrorReasonINVALID_REQUEST_CONTEXT_TYPE,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_CALL_PLACEHOLDER,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_PLACEHOLDER,
+		FunctionErrorReasonINVALID_OPERAND,
+		FunctionErrorReasonMISSING_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_CONSTANT_OPERAND_VALUE,
+		FunctionErrorReasonINVALID_NESTING,
+		FunctionErrorReasonMULTIPLE_FEED_IDS_NOT_SUPPORTED,
+		FunctionErrorReasonINVALID_FUNCTION_FOR_FEED_WITH_FIXED_SCHEMA,
+		FunctionErrorReasonINVALID_ATTRIBUTE_NAME,
+		FunctionErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseFunctionErrorReason returns the FunctionErrorReason named by s.
+func ParseFunctionErrorReason(s string) (FunctionErrorReason, error) {
+	e := FunctionErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid FunctionErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of FunctionErrorReason.
+func (e FunctionErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid FunctionErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *FunctionErrorReason) UnmarshalText(text []byte) error {
+	v := FunctionErrorReason(text)
+	if !v.IsValid() {
+		v = FunctionErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// IdErrorReasonValues returns all values of IdErrorReason.
+func IdErrorReasonValues() []IdErrorReason {
+	return []IdErrorReason{
+		IdErrorReasonNOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of IdErrorReason.
+func (e IdErrorReason) IsValid() bool {
+	switch e {
+	case IdErrorReasonNOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseIdErrorReason returns the IdErrorReason named by s.
+func ParseIdErrorReason(s string) (IdErrorReason, error) {
+	e := IdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// IdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e IdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *IdErrorReason) UnmarshalText(text []byte) error {
+	v := IdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ImageErrorReasonValues returns all values of ImageErrorReason.
+func ImageErrorReasonValues() []ImageErrorReason {
+	return []ImageErrorReason{
+		ImageErrorReasonINVALID_IMAGE,
+		ImageErrorReasonSTORAGE_ERROR,
+		ImageErrorReasonBAD_REQUEST,
+		ImageErrorReasonUNEXPECTED_SIZE,
+		ImageErrorReasonANIMATED_NOT_ALLOWED,
+		ImageErrorReasonANIMATION_TOO_LONG,
+		ImageErrorReasonSERVER_ERROR,
+		ImageErrorReasonCMYK_JPEG_NOT_ALLOWED,
+		ImageErrorReasonFLASH_NOT_ALLOWED,
+		ImageErrorReasonFLASH_WITHOUT_CLICKTAG,
+		ImageErrorReasonFLASH_ERROR_AFTER_FIXING_CLICK_TAG,
+		ImageErrorReasonANIMATED_VISUAL_EFFECT,
+		ImageErrorReasonFLASH_ERROR,
+		ImageErrorReasonLAYOUT_PROBLEM,
+		ImageErrorReasonPROBLEM_READING_IMAGE_FILE,
+		ImageErrorReasonERROR_STORING_IMAGE,
+		ImageErrorReasonASPECT_RATIO_NOT_ALLOWED,
+		ImageErrorReasonFLASH_HAS_NETWORK_OBJECTS,
+		ImageErrorReasonFLASH_HAS_NETWORK_METHODS,
+		ImageErrorReasonFLASH_HAS_URL,
+		ImageErrorReasonFLASH_HAS_MOUSE_TRACKING,
+		ImageErrorReasonFLASH_HAS_RANDOM_NUM,
+		ImageErrorReasonFLASH_SELF_TARGETS,
+		ImageErrorReasonFLASH_BAD_GETURL_TARGET,
+		ImageErrorReasonFLASH_VERSION_NOT_SUPPORTED,
+		ImageErrorReasonFLASH_WITHOUT_HARD_CODED_CLICK_URL,
+		ImageErrorReasonINVALID_FLASH_FILE,
+		ImageErrorReasonFAILED_TO_FIX_CLICK_TAG_IN_FLASH,
+		ImageErrorReasonFLASH_ACCESSES_NETWORK_RESOURCES,
+		ImageErrorReasonFLASH_EXTERNAL_JS_CALL,
+		ImageErrorReasonFLASH_EXTERNAL_FS_CALL,
+		ImageErrorReasonFILE_TOO_LARGE,
+		ImageErrorReasonIMAGE_DATA_TOO_LARGE,
+		ImageErrorReasonIMAGE_PROCESSING_ERROR,
+		ImageErrorReasonIMAGE_TOO_SMALL,
+		ImageErrorReasonINVALID_INPUT,
+		ImageErrorReasonPROBLEM_READING_FILE,
+	}
+}
+
+// IsValid reports whether e is one of the values of ImageErrorReason.
+func (e ImageErrorReason) IsValid() bool {
+	switch e {
+	case ImageErrorReasonINVALID_IMAGE,
+		ImageErrorReasonSTORAGE_ERROR,
+		ImageErrorReasonBAD_REQUEST,
+		ImageErrorReasonUNEXPECTED_SIZE,
+		ImageErrorReasonANIMATED_NOT_ALLOWED,
+		ImageErrorReasonANIMATION_TOO_LONG,
+		ImageErrorReasonSERVER_ERROR,
+		ImageErrorReasonCMYK_JPEG_NOT_ALLOWED,
+		ImageErrorReasonFLASH_NOT_ALLOWED,
+		ImageErrorReasonFLASH_WITHOUT_CLICKTAG,
+		ImageErrorReasonFLASH_ERROR_AFTER_FIXING_CLICK_TAG,
+		ImageErrorReasonANIMATED_VISUAL_EFFECT,
+		ImageErrorReasonFLASH_ERROR,
+		ImageErrorReasonLAYOUT_PROBLEM,
+		ImageErrorReasonPROBLEM_READING_IMAGE_FILE,
+		ImageErrorReasonERROR_STORING_IMAGE,
+		ImageErrorReasonASPECT_RATIO_NOT_ALLOWED,
+		ImageErrorReasonFLASH_HAS_NETWORK_OBJECTS,
+		ImageErrorReasonFLASH_HAS_NETWORK_METHODS,
+		ImageErrorReasonFLASH_HAS_URL,
+		ImageErrorReasonFLASH_HAS_MOUSE_TRACKING,
+		ImageErrorReasonFLASH_HAS_RANDOM_NUM,
+		ImageErrorReasonFLASH_SELF_TARGETS,
+		ImageErrorReasonFLASH_BAD_GETURL_TARGET,
+		ImageErrorReasonFLASH_VERSION_NOT_SUPPORTED,
+		ImageErrorReasonFLASH_WITHOUT_HARD_CODED_CLICK_URL,
+		ImageErrorReasonINVALID_FLASH_FILE,
+		ImageErrorReasonFAILED_TO_FIX_CLICK_TAG_IN_FLASH,
+		ImageErrorReasonFLASH_ACCESSES_NETWORK_RESOURCES,
+		ImageErrorReasonFLASH_EXTERNAL_JS_CALL,
+		ImageErrorReasonFLASH_EXTERNAL_FS_CALL,
+		ImageErrorReasonFILE_TOO_LARGE,
+		ImageErrorReasonIMAGE_DATA_TOO_LARGE,
+		ImageErrorReasonIMAGE_PROCESSING_ERROR,
+		ImageErrorReasonIMAGE_TOO_SMALL,
+		ImageErrorReasonINVALID_INPUT,
+		ImageErrorReasonPROBLEM_READING_FILE:
+		return true
+	}
+	return false
+}
+
+// ParseImageErrorReason returns the ImageErrorReason named by s.
+func ParseImageErrorReason(s string) (ImageErrorReason, error) {
+	e := ImageErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ImageErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ImageErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ImageErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ImageErrorReason) UnmarshalText(text []byte) error {
+	v := ImageErrorReason(text)
+	*e = v
+	return nil
+}
+
+// InternalApiErrorReasonValues returns all values of InternalApiErrorReason.
+func InternalApiErrorReasonValues() []InternalApiErrorReason {
+	return []InternalApiErrorReason{
+		InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE,
+	}
+}
+
+// IsValid reports whether e is one of the values of InternalApiErrorReason.
+func (e InternalApiErrorReason) IsValid() bool {
+	switch e {
+	case InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE:
+		return true
+	}
+	return false
+}
+
+// ParseInternalApiErrorReason returns the InternalApiErrorReason named by s.
+func ParseInternalApiErrorReason(s string) (InternalApiErrorReason, error) {
+	e := InternalApiErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid InternalApiErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of InternalApiErrorReason.
+func (e InternalApiErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid InternalApiErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *InternalApiErrorReason) UnmarshalText(text []byte) error {
+	v := InternalApiErrorReason(text)
+	if !v.IsValid() {
+		v = InternalApiErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// MediaErrorReasonValues returns all values of MediaErrorReason.
+func MediaErrorReasonValues() []MediaErrorReason {
+	return []MediaErrorReason{
+		MediaErrorReasonCANNOT_ADD_STANDARD_ICON,
+		MediaErrorReasonCANNOT_SELECT_STANDARD_ICON_WITH_OTHER_TYPES,
+		MediaErrorReasonCANNOT_SPECIFY_MEDIA_ID_AND_DATA,
+		MediaErrorReasonDUPLICATE_MEDIA,
+		MediaErrorReasonEMPTY_FIELD,
+		MediaErrorReasonENTITY_REFERENCED_IN_MULTIPLE_OPS,
+		MediaErrorReasonFIELD_NOT_SUPPORTED_FOR_MEDIA_SUB_TYPE,
+		MediaErrorReasonINVALID_MEDIA_ID,
+		MediaErrorReasonINVALID_MEDIA_SUB_TYPE,
+		MediaErrorReasonINVALID_MEDIA_TYPE,
+		MediaErrorReasonINVALID_MIME_TYPE,
+		MediaErrorReasonINVALID_REFERENCE_ID,
+		MediaErrorReasonINVALID_YOU_TUBE_ID,
+		MediaErrorReasonMEDIA_FAILED_TRANSCODING,
+		MediaErrorReasonMEDIA_NOT_TRANSCODED,
+		MediaErrorReasonMEDIA_TYPE_DOES_NOT_MATCH_OBJECT_TYPE,
+		MediaErrorReasonNO_FIELDS_SPECIFIED,
+		MediaErrorReasonNULL_REFERENCE_ID_AND_MEDIA_ID,
+		MediaErrorReasonTOO_LONG,
+		MediaErrorReasonUNSUPPORTED_OPERATION,
+		MediaErrorReasonUNSUPPORTED_TYPE,
+		MediaErrorReasonYOU_TUBE_SERVICE_UNAVAILABLE,
+		MediaErrorReasonYOU_TUBE_VIDEO_HAS_NON_POSITIVE_DURATION,
+		MediaErrorReasonYOU_TUBE_VIDEO_NOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of MediaErrorReason.
+func (e MediaErrorReason) IsValid() bool {
+	switch e {
+	case MediaErrorReasonCANNOT_ADD_STANDARD_ICON,
+		MediaErrorReasonCANNOT_SELECT_STANDARD_ICON_WITH_OTHER_TYPES,
+		MediaErrorReasonCANNOT_SPECIFY_MEDIA_ID_AND_DATA,
+		MediaErrorReasonDUPLICATE_MEDIA,
+		MediaErrorReasonEMPTY_FIELD,
+		MediaErrorReasonENTITY_REFERENCED_IN_MULTIPLE_OPS,
+		MediaErrorReasonFIELD_NOT_SUPPORTED_FOR_MEDIA_SUB_TYPE,
+		MediaErrorReasonINVALID_MEDIA_ID,
+		MediaErrorReasonINVALID_MEDIA_SUB_TYPE,
+		MediaErrorReasonINVALID_MEDIA_TYPE,
+		MediaErrorReasonINVALID_MIME_TYPE,
+		MediaErrorReasonINVALID_REFERENCE_ID,
+		MediaErrorReasonINVALID_YOU_TUBE_ID,
+		MediaErrorReasonMEDIA_FAILED_TRANSCODING,
+		MediaErrorReasonMEDIA_NOT_TRANSCODED,
+		MediaErrorReasonMEDIA_TYPE_DOES_NOT_MATCH_OBJECT_TYPE,
+		MediaErrorReasonNO_FIELDS_SPECIFIED,
+		MediaErrorReasonNULL_REFERENCE_ID_AND_MEDIA_ID,
+		MediaErrorReasonTOO_LONG,
+		MediaErrorReasonUNSUPPORTED_OPERATION,
+		MediaErrorReasonUNSUPPORTED_TYPE,
+		MediaErrorReasonYOU_TUBE_SERVICE_UNAVAILABLE,
+		MediaErrorReasonYOU_TUBE_VIDEO_HAS_NON_POSITIVE_DURATION,
+		MediaErrorReasonYOU_TUBE_VIDEO_NOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseMediaErrorReason returns the MediaErrorReason named by s.
+func ParseMediaErrorReason(s string) (MediaErrorReason, error) {
+	e := MediaErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MediaErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MediaErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MediaErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MediaErrorReason) UnmarshalText(text []byte) error {
+	v := MediaErrorReason(text)
+	*e = v
+	return nil
+}
+
+// MultiplierErrorReasonValues returns all values of MultiplierErrorReason.
+func MultiplierErrorReasonValues() []MultiplierErrorReason {
+	return []MultiplierErrorReason{
+		MultiplierErrorReasonMULTIPLIER_TOO_HIGH,
+		MultiplierErrorReasonMULTIPLIER_TOO_LOW,
+		MultiplierErrorReasonTOO_MANY_FRACTIONAL_DIGITS,
+		MultiplierErrorReasonMULTIPLIER_NOT_ALLOWED_FOR_BIDDING_STRATEGY,
+		MultiplierErrorReasonMULTIPLIER_NOT_ALLOWED_WHEN_BASE_BID_IS_MISSING,
+		MultiplierErrorReasonNO_MULTIPLIER_SPECIFIED,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_DAILY_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_MONTHLY_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_CUSTOM_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_MAX_ALLOWED_BID,
+		MultiplierErrorReasonBID_LESS_THAN_MIN_ALLOWED_BID_WITH_MULTIPLIER,
+		MultiplierErrorReasonMULTIPLIER_AND_BIDDING_STRATEGY_TYPE_MISMATCH,
+		MultiplierErrorReasonMULTIPLIER_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of MultiplierErrorReason.
+func (e MultiplierErrorReason) IsValid() bool {
+	switch e {
+	case MultiplierErrorReasonMULTIPLIER_TOO_HIGH,
+		MultiplierErrorReasonMULTIPLIER_TOO_LOW,
+		MultiplierErrorReasonTOO_MANY_FRACTIONAL_DIGITS,
+		MultiplierErrorReasonMULTIPLIER_NOT_ALLOWED_FOR_BIDDING_STRATEGY,
+		MultiplierErrorReasonMULTIPLIER_NOT_ALLOWED_WHEN_BASE_BID_IS_MISSING,
+		MultiplierErrorReasonNO_MULTIPLIER_SPECIFIED,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_DAILY_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_MONTHLY_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_CUSTOM_BUDGET,
+		MultiplierErrorReasonMULTIPLIER_CAUSES_BID_TO_EXCEED_MAX_ALLOWED_BID,
+		MultiplierErrorReasonBID_LESS_THAN_MIN_ALLOWED_BID_WITH_MULTIPLIER,
+		MultiplierErrorReasonMULTIPLIER_AND_BIDDING_STRATEGY_TYPE_MISMATCH,
+		MultiplierErrorReasonMULTIPLIER_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseMultiplierErrorReason returns the MultiplierErrorReason named by s.
+func ParseMultiplierErrorReason(s string) (MultiplierErrorReason, error) {
+	e := MultiplierErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid MultiplierErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// MultiplierErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e MultiplierErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *MultiplierErrorReason) UnmarshalText(text []byte) error {
+	v := MultiplierErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NewEntityCreationErrorReasonValues returns all values of NewEntityCreationErrorReason.
+func NewEntityCreationErrorReasonValues() []NewEntityCreationErrorReason {
+	return []NewEntityCreationErrorReason{
+		NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS,
+	}
+}
+
+// IsValid reports whether e is one of the values of NewEntityCreationErrorReason.
+func (e NewEntityCreationErrorReason) IsValid() bool {
+	switch e {
+	case NewEntityCreationErrorReasonCANNOT_SET_ID_FOR_ADD,
+		NewEntityCreationErrorReasonDUPLICATE_TEMP_IDS,
+		NewEntityCreationErrorReasonTEMP_ID_ENTITY_HAD_ERRORS:
+		return true
+	}
+	return false
+}
+
+// ParseNewEntityCreationErrorReason returns the NewEntityCreationErrorReason named by s.
+func ParseNewEntityCreationErrorReason(s string) (NewEntityCreationErrorReason, error) {
+	e := NewEntityCreationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NewEntityCreationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NewEntityCreationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NewEntityCreationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NewEntityCreationErrorReason) UnmarshalText(text []byte) error {
+	v := NewEntityCreationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NotEmptyErrorReasonValues returns all values of NotEmptyErrorReason.
+func NotEmptyErrorReasonValues() []NotEmptyErrorReason {
+	return []NotEmptyErrorReason{
+		NotEmptyErrorReasonEMPTY_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotEmptyErrorReason.
+func (e NotEmptyErrorReason) IsValid() bool {
+	switch e {
+	case NotEmptyErrorReasonEMPTY_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseNotEmptyErrorReason returns the NotEmptyErrorReason named by s.
+func ParseNotEmptyErrorReason(s string) (NotEmptyErrorReason, error) {
+	e := NotEmptyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotEmptyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotEmptyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotEmptyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotEmptyErrorReason) UnmarshalText(text []byte) error {
+	v := NotEmptyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NullErrorReasonValues returns all values of NullErrorReason.
+func NullErrorReasonValues() []NullErrorReason {
+	return []NullErrorReason{
+		NullErrorReasonNULL_CONTENT,
+	}
+}
+
+// IsValid reports whether e is one of the values of NullErrorReason.
+func (e NullErrorReason) IsValid() bool {
+	switch e {
+	case NullErrorReasonNULL_CONTENT:
+		return true
+	}
+	return false
+}
+
+// ParseNullErrorReason returns the NullErrorReason named by s.
+func ParseNullErrorReason(s string) (NullErrorReason, error) {
+	e := NullErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NullErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NullErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NullErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NullErrorReason) UnmarshalText(text []byte) error {
+	v := NullErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OperationAccessDeniedReasonValues returns all values of OperationAccessDeniedReason.
+func OperationAccessDeniedReasonValues() []OperationAccessDeniedReason {
+	return []OperationAccessDeniedReason{
+		OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) IsValid() bool {
+	switch e {
+	case OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperationAccessDeniedReason returns the OperationAccessDeniedReason named by s.
+func ParseOperationAccessDeniedReason(s string) (OperationAccessDeniedReason, error) {
+	e := OperationAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperationAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperationAccessDeniedReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperationAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := OperationAccessDeniedReason(text)
+	if !v.IsValid() {
+		v = OperationAccessDeniedReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperatorErrorReasonValues returns all values of OperatorErrorReason.
+func OperatorErrorReasonValues() []OperatorErrorReason {
+	return []OperatorErrorReason{
+		OperatorErrorReasonOPERATOR_NOT_SUPPORTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatorErrorReason.
+func (e OperatorErrorReason) IsValid() bool {
+	switch e {
+	case OperatorErrorReasonOPERATOR_NOT_SUPPORTED:
+		return true
+	}
+	return false
+}
+
+// ParseOperatorErrorReason returns the OperatorErrorReason named by s.
+func ParseOperatorErrorReason(s string) (OperatorErrorReason, error) {
+	e := OperatorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// OperatorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e OperatorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *OperatorErrorReason) UnmarshalText(text []byte) error {
+	v := OperatorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// PagingErrorReasonValues returns all values of PagingErrorReason.
+func PagingErrorReasonValues() []PagingErrorReason {
+	return []PagingErrorReason{
+		PagingErrorReasonSTART_INDEX_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonNUMBER_OF_RESULTS_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PagingErrorReason.
+func (e PagingErrorReason) IsValid() bool {
+	switch e {
+	case PagingErrorReasonSTART_INDEX_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonNUMBER_OF_RESULTS_CANNOT_BE_NEGATIVE,
+		PagingErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePagingErrorReason returns the PagingErrorReason named by s.
+func ParsePagingErrorReason(s string) (PagingErrorReason, error) {
+	e := PagingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PagingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PagingErrorReason.
+func (e PagingErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PagingErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PagingErrorReason) UnmarshalText(text []byte) error {
+	v := PagingErrorReason(text)
+	if !v.IsValid() {
+		v = PagingErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// PredicateOperatorValues returns all values of PredicateOperator.
+func PredicateOperatorValues() []PredicateOperator {
+	return []PredicateOperator{
+		PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PredicateOperator.
+func (e PredicateOperator) IsValid() bool {
+	switch e {
+	case PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePredicateOperator returns the PredicateOperator named by s.
+func ParsePredicateOperator(s string) (PredicateOperator, error) {
+	e := PredicateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PredicateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PredicateOperator.
+func (e PredicateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PredicateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PredicateOperator) UnmarshalText(text []byte) error {
+	v := PredicateOperator(text)
+	if !v.IsValid() {
+		v = PredicateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// QueryErrorReasonValues returns all values of QueryErrorReason.
+func QueryErrorReasonValues() []QueryErrorReason {
+	return []QueryErrorReason{
+		QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QueryErrorReason.
+func (e QueryErrorReason) IsValid() bool {
+	switch e {
+	case QueryErrorReasonPARSING_FAILED,
+		QueryErrorReasonMISSING_QUERY,
+		QueryErrorReasonMISSING_SELECT_CLAUSE,
+		QueryErrorReasonMISSING_FROM_CLAUSE,
+		QueryErrorReasonINVALID_SELECT_CLAUSE,
+		QueryErrorReasonINVALID_FROM_CLAUSE,
+		QueryErrorReasonINVALID_WHERE_CLAUSE,
+		QueryErrorReasonINVALID_ORDER_BY_CLAUSE,
+		QueryErrorReasonINVALID_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_START_INDEX_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_PAGE_SIZE_IN_LIMIT_CLAUSE,
+		QueryErrorReasonINVALID_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MIN_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonINVALID_MAX_DATE_IN_DURING_CLAUSE,
+		QueryErrorReasonMAX_LESS_THAN_MIN_IN_DURING_CLAUSE,
+		QueryErrorReasonVALIDATION_FAILED:
+		return true
+	}
+	return false
+}
+
+// ParseQueryErrorReason returns the QueryErrorReason named by s.
+func ParseQueryErrorReason(s string) (QueryErrorReason, error) {
+	e := QueryErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QueryErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QueryErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QueryErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QueryErrorReason) UnmarshalText(text []byte) error {
+	v := QueryErrorReason(text)
+	*e = v
+	return nil
+}
+
+// QuotaCheckErrorReasonValues returns all values of QuotaCheckErrorReason.
+func QuotaCheckErrorReasonValues() []QuotaCheckErrorReason {
+	return []QuotaCheckErrorReason{
+		QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QuotaCheckErrorReason.
+func (e QuotaCheckErrorReason) IsValid() bool {
+	switch e {
+	case QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseQuotaCheckErrorReason returns the QuotaCheckErrorReason named by s.
+func ParseQuotaCheckErrorReason(s string) (QuotaCheckErrorReason, error) {
+	e := QuotaCheckErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QuotaCheckErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QuotaCheckErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QuotaCheckErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QuotaCheckErrorReason) UnmarshalText(text []byte) error {
+	v := QuotaCheckErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RangeErrorReasonValues returns all values of RangeErrorReason.
+func RangeErrorReasonValues() []RangeErrorReason {
+	return []RangeErrorReason{
+		RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH,
+	}
+}
+
+// IsValid reports whether e is one of the values of RangeErrorReason.
+func (e RangeErrorReason) IsValid() bool {
+	switch e {
+	case RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH:
+		return true
+	}
+	return false
+}
+
+// ParseRangeErrorReason returns the RangeErrorReason named by s.
+func ParseRangeErrorReason(s string) (RangeErrorReason, error) {
+	e := RangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RangeErrorReason) UnmarshalText(text []byte) error {
+	v := RangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RateExceededErrorReasonValues returns all values of RateExceededErrorReason.
+func RateExceededErrorReasonValues() []RateExceededErrorReason {
+	return []RateExceededErrorReason{
+		RateExceededErrorReasonRATE_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RateExceededErrorReason.
+func (e RateExceededErrorReason) IsValid() bool {
+	switch e {
+	case RateExceededErrorReasonRATE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseRateExceededErrorReason returns the RateExceededErrorReason named by s.
+func ParseRateExceededErrorReason(s string) (RateExceededErrorReason, error) {
+	e := RateExceededErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RateExceededErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RateExceededErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RateExceededErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RateExceededErrorReason) UnmarshalText(text []byte) error {
+	v := RateExceededErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ReadOnlyErrorReasonValues returns all values of ReadOnlyErrorReason.
+func ReadOnlyErrorReasonValues() []ReadOnlyErrorReason {
+	return []ReadOnlyErrorReason{
+		ReadOnlyErrorReasonREAD_ONLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ReadOnlyErrorReason.
+func (e ReadOnlyErrorReason) IsValid() bool {
+	switch e {
+	case ReadOnlyErrorReasonREAD_ONLY:
+		return true
+	}
+	return false
+}
+
+// ParseReadOnlyErrorReason returns the ReadOnlyErrorReason named by s.
+func ParseReadOnlyErrorReason(s string) (ReadOnlyErrorReason, error) {
+	e := ReadOnlyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ReadOnlyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ReadOnlyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ReadOnlyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ReadOnlyErrorReason) UnmarshalText(text []byte) error {
+	v := ReadOnlyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RejectedErrorReasonValues returns all values of RejectedErrorReason.
+func RejectedErrorReasonValues() []RejectedErrorReason {
+	return []RejectedErrorReason{
+		RejectedErrorReasonUNKNOWN_VALUE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RejectedErrorReason.
+func (e RejectedErrorReason) IsValid() bool {
+	switch e {
+	case RejectedErrorReasonUNKNOWN_VALUE:
+		return true
+	}
+	return false
+}
+
+// ParseRejectedErrorReason returns the RejectedErrorReason named by s.
+func ParseRejectedErrorReason(s string) (RejectedErrorReason, error) {
+	e := RejectedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RejectedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RejectedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RejectedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RejectedErrorReason) UnmarshalText(text []byte) error {
+	v := RejectedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RequestErrorReasonValues returns all values of RequestErrorReason.
+func RequestErrorReasonValues() []RequestErrorReason {
+	return []RequestErrorReason{
+		RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequestErrorReason.
+func (e RequestErrorReason) IsValid() bool {
+	switch e {
+	case RequestErrorReasonUNKNOWN,
+		RequestErrorReasonINVALID_INPUT,
+		RequestErrorReasonUNSUPPORTED_VERSION:
+		return true
+	}
+	return false
+}
+
+// ParseRequestErrorReason returns the RequestErrorReason named by s.
+func ParseRequestErrorReason(s string) (RequestErrorReason, error) {
+	e := RequestErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequestErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of RequestErrorReason.
+func (e RequestErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid RequestErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *RequestErrorReason) UnmarshalText(text []byte) error {
+	v := RequestErrorReason(text)
+	if !v.IsValid() {
+		v = RequestErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// RequiredErrorReasonValues returns all values of RequiredErrorReason.
+func RequiredErrorReasonValues() []RequiredErrorReason {
+	return []RequiredErrorReason{
+		RequiredErrorReasonREQUIRED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequiredErrorReason.
+func (e RequiredErrorReason) IsValid() bool {
+	switch e {
+	case RequiredErrorReasonREQUIRED:
+		return true
+	}
+	return false
+}
+
+// ParseRequiredErrorReason returns the RequiredErrorReason named by s.
+func ParseRequiredErrorReason(s string) (RequiredErrorReason, error) {
+	e := RequiredErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequiredErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RequiredErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RequiredErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RequiredErrorReason) UnmarshalText(text []byte) error {
+	v := RequiredErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SelectorErrorReasonValues returns all values of SelectorErrorReason.
+func SelectorErrorReasonValues() []SelectorErrorReason {
+	return []SelectorErrorReason{
+		SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of SelectorErrorReason.
+func (e SelectorErrorReason) IsValid() bool {
+	switch e {
+	case SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseSelectorErrorReason returns the SelectorErrorReason named by s.
+func ParseSelectorErrorReason(s string) (SelectorErrorReason, error) {
+	e := SelectorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SelectorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SelectorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SelectorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SelectorErrorReason) UnmarshalText(text []byte) error {
+	v := SelectorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SettingErrorReasonValues returns all values of SettingErrorReason.
+func SettingErrorReasonValues() []SettingErrorReason {
+	return []SettingErrorReason{
+		SettingErrorReasonDUPLICATE_SETTING_TYPE,
+		SettingErrorReasonSETTING_TYPE_IS_NOT_AVAILABLE,
+		SettingErrorReasonSETTING_TYPE_IS_NOT_COMPATIBLE_WITH_CAMPAIGN,
+		SettingErrorReasonTARGETING_SETTING_CONTAINS_INVALID_CRITERION_TYPE_GROUP,
+		SettingErrorReasonTARGETING_SETTING_DEMOGRAPHIC_CRITERION_TYPE_GROUPS_MUST_BE_SET_TO_TARGET_ALL,
+		SettingErrorReasonTARGETING_SETTING_CANNOT_CHANGE_TARGET_ALL_TO_FALSE_FOR_DEMOGRAPHIC_CRITERION_TYPE_GROUP,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_AT_LEAST_ONE_FEED_ID_MUST_BE_PRESENT,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_INVALID_DOMAIN_NAME,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_SUBDOMAIN_NAME,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_INVALID_LANGUAGE_CODE,
+		SettingErrorReasonTARGET_ALL_IS_NOT_ALLOWED_FOR_PLACEMENT_IN_SEARCH_CAMPAIGN,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_DUPLICATE_DESCRIPTION,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_DESCRIPTION_LINE_WIDTH_TOO_LONG,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_APP_ID_CANNOT_BE_MODIFIED,
+		SettingErrorReasonTOO_MANY_YOUTUBE_MEDIA_IDS_IN_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonTOO_MANY_IMAGE_MEDIA_IDS_IN_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonMEDIA_INCOMPATIBLE_FOR_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonTOO_MANY_EXCLAMATION_MARKS,
+		SettingErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SettingErrorReason.
+func (e SettingErrorReason) IsValid() bool {
+	switch e {
+	case SettingErrorReasonDUPLICATE_SETTING_TYPE,
+		SettingErrorReasonSETTING_TYPE_IS_NOT_AVAILABLE,
+		SettingErrorReasonSETTING_TYPE_IS_NOT_COMPATIBLE_WITH_CAMPAIGN,
+		SettingErrorReasonTARGETING_SETTING_CONTAINS_INVALID_CRITERION_TYPE_GROUP,
+		SettingErrorReasonTARGETING_SETTING_DEMOGRAPHIC_CRITERION_TYPE_GROUPS_MUST_BE_SET_TO_TARGET_ALL,
+		SettingErrorReasonTARGETING_SETTING_CANNOT_CHANGE_TARGET_ALL_TO_FALSE_FOR_DEMOGRAPHIC_CRITERION_TYPE_GROUP,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_AT_LEAST_ONE_FEED_ID_MUST_BE_PRESENT,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_INVALID_DOMAIN_NAME,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_SUBDOMAIN_NAME,
+		SettingErrorReasonDYNAMIC_SEARCH_ADS_SETTING_CONTAINS_INVALID_LANGUAGE_CODE,
+		SettingErrorReasonTARGET_ALL_IS_NOT_ALLOWED_FOR_PLACEMENT_IN_SEARCH_CAMPAIGN,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_DUPLICATE_DESCRIPTION,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_DESCRIPTION_LINE_WIDTH_TOO_LONG,
+		SettingErrorReasonUNIVERSAL_APP_CAMPAIGN_SETTING_APP_ID_CANNOT_BE_MODIFIED,
+		SettingErrorReasonTOO_MANY_YOUTUBE_MEDIA_IDS_IN_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonTOO_MANY_IMAGE_MEDIA_IDS_IN_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonMEDIA_INCOMPATIBLE_FOR_UNIVERSAL_APP_CAMPAIGN,
+		SettingErrorReasonTOO_MANY_EXCLAMATION_MARKS,
+		SettingErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSettingErrorReason returns the SettingErrorReason named by s.
+func ParseSettingErrorReason(s string) (SettingErrorReason, error) {
+	e := SettingErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SettingErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SettingErrorReason.
+func (e SettingErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SettingErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SettingErrorReason) UnmarshalText(text []byte) error {
+	v := SettingErrorReason(text)
+	if !v.IsValid() {
+		v = SettingErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SizeLimitErrorReasonValues returns all values of SizeLimitErrorReason.
+func SizeLimitErrorReasonValues() []SizeLimitErrorReason {
+	return []SizeLimitErrorReason{
+		SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) IsValid() bool {
+	switch e {
+	case SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSizeLimitErrorReason returns the SizeLimitErrorReason named by s.
+func ParseSizeLimitErrorReason(s string) (SizeLimitErrorReason, error) {
+	e := SizeLimitErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeLimitErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SizeLimitErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SizeLimitErrorReason) UnmarshalText(text []byte) error {
+	v := SizeLimitErrorReason(text)
+	if !v.IsValid() {
+		v = SizeLimitErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SortOrderValues returns all values of SortOrder.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASCENDING,
+		SortOrderDESCENDING,
+	}
+}
+
+// IsValid reports whether e is one of the values of SortOrder.
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderASCENDING,
+		SortOrderDESCENDING:
+		return true
+	}
+	return false
+}
+
+// ParseSortOrder returns the SortOrder named by s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	e := SortOrder(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SortOrder %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SortOrder are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	*e = v
+	return nil
+}
+
+// StringFormatErrorReasonValues returns all values of StringFormatErrorReason.
+func StringFormatErrorReasonValues() []StringFormatErrorReason {
+	return []StringFormatErrorReason{
+		StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringFormatErrorReason.
+func (e StringFormatErrorReason) IsValid() bool {
+	switch e {
+	case StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT:
+		return true
+	}
+	return false
+}
+
+// ParseStringFormatErrorReason returns the StringFormatErrorReason named by s.
+func ParseStringFormatErrorReason(s string) (StringFormatErrorReason, error) {
+	e := StringFormatErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringFormatErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringFormatErrorReason.
+func (e StringFormatErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringFormatErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringFormatErrorReason) UnmarshalText(text []byte) error {
+	v := StringFormatErrorReason(text)
+	if !v.IsValid() {
+		v = StringFormatErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// StringLengthErrorReasonValues returns all values of StringLengthErrorReason.
+func StringLengthErrorReasonValues() []StringLengthErrorReason {
+	return []StringLengthErrorReason{
+		StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringLengthErrorReason.
+func (e StringLengthErrorReason) IsValid() bool {
+	switch e {
+	case StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG:
+		return true
+	}
+	return false
+}
+
+// ParseStringLengthErrorReason returns the StringLengthErrorReason named by s.
+func ParseStringLengthErrorReason(s string) (StringLengthErrorReason, error) {
+	e := StringLengthErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringLengthErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StringLengthErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StringLengthErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StringLengthErrorReason) UnmarshalText(text []byte) error {
+	v := StringLengthErrorReason(text)
+	*e = v
+	return nil
+}
+
+// UrlErrorReasonValues returns all values of UrlErrorReason.
+func UrlErrorReasonValues() []UrlErrorReason {
+	return []UrlErrorReason{
+		UrlErrorReasonINVALID_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TAG_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMALFORMED_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_HOST_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TLD_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonREDUNDANT_NESTED_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonINVALID_FINAL_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonMALFORMED_FINAL_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_URL,
+		UrlErrorReasonINVALID_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_MOBILE_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonMALFORMED_FINAL_MOBILE_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_FINAL_APP_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_APP_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_APP_URL_TAG,
+		UrlErrorReasonMULTIPLE_APP_URLS_FOR_OSTYPE,
+		UrlErrorReasonINVALID_OSTYPE,
+		UrlErrorReasonINVALID_PROTOCOL_FOR_APP_URL,
+		UrlErrorReasonINVALID_PACKAGE_ID_FOR_APP_URL,
+		UrlErrorReasonURL_CUSTOM_PARAMETERS_COUNT_EXCEEDS_LIMIT,
+		UrlErrorReasonURL_CUSTOM_PARAMETER_REMOVAL_WITH_NON_NULL_VALUE,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_IN_ADD_OPERATION,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_DURING_FULL_REPLACEMENT,
+		UrlErrorReasonNULL_CUSTOM_PARAMETER_VALUE_DURING_ADD_OR_FULL_REPLACEMENT,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_KEY,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonINVALID_TAG_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonREDUNDANT_NESTED_URL_CUSTOM_PARAMETER_TAG,
+		UrlErrorReasonMISSING_PROTOCOL,
+		UrlErrorReasonINVALID_URL,
+		UrlErrorReasonDESTINATION_URL_DEPRECATED,
+		UrlErrorReasonINVALID_TAG_IN_URL,
+		UrlErrorReasonMISSING_URL_TAG,
+		UrlErrorReasonDUPLICATE_URL_ID,
+		UrlErrorReasonINVALID_URL_ID,
+		UrlErrorReasonURL_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of UrlErrorReason.
+func (e UrlErrorReason) IsValid() bool {
+	switch e {
+	case UrlErrorReasonINVALID_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TAG_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_PROTOCOL_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMALFORMED_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonMISSING_HOST_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonINVALID_TLD_IN_TRACKING_URL_TEMPLATE,
+		UrlErrorReasonREDUNDANT_NESTED_TRACKING_URL_TEMPLATE_TAG,
+		UrlErrorReasonINVALID_FINAL_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_URL,
+		UrlErrorReasonMALFORMED_FINAL_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_URL,
+		UrlErrorReasonINVALID_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_MOBILE_URL_TAG,
+		UrlErrorReasonMISSING_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_PROTOCOL_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonMALFORMED_FINAL_MOBILE_URL,
+		UrlErrorReasonMISSING_HOST_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_TLD_IN_FINAL_MOBILE_URL,
+		UrlErrorReasonINVALID_FINAL_APP_URL,
+		UrlErrorReasonINVALID_TAG_IN_FINAL_APP_URL,
+		UrlErrorReasonREDUNDANT_NESTED_FINAL_APP_URL_TAG,
+		UrlErrorReasonMULTIPLE_APP_URLS_FOR_OSTYPE,
+		UrlErrorReasonINVALID_OSTYPE,
+		UrlErrorReasonINVALID_PROTOCOL_FOR_APP_URL,
+		UrlErrorReasonINVALID_PACKAGE_ID_FOR_APP_URL,
+		UrlErrorReasonURL_CUSTOM_PARAMETERS_COUNT_EXCEEDS_LIMIT,
+		UrlErrorReasonURL_CUSTOM_PARAMETER_REMOVAL_WITH_NON_NULL_VALUE,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_IN_ADD_OPERATION,
+		UrlErrorReasonCANNOT_REMOVE_URL_CUSTOM_PARAMETER_DURING_FULL_REPLACEMENT,
+		UrlErrorReasonNULL_CUSTOM_PARAMETER_VALUE_DURING_ADD_OR_FULL_REPLACEMENT,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_KEY,
+		UrlErrorReasonINVALID_CHARACTERS_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonINVALID_TAG_IN_URL_CUSTOM_PARAMETER_VALUE,
+		UrlErrorReasonREDUNDANT_NESTED_URL_CUSTOM_PARAMETER_TAG,
+		UrlErrorReasonMISSING_PROTOCOL,
+		UrlErrorReasonINVALID_URL,
+		UrlErrorReasonDESTINATION_URL_DEPRECATED,
+		UrlErrorReasonINVALID_TAG_IN_URL,
+		UrlErrorReasonMISSING_URL_TAG,
+		UrlErrorReasonDUPLICATE_URL_ID,
+		UrlErrorReasonINVALID_URL_ID,
+		UrlErrorReasonURL_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseUrlErrorReason returns the UrlErrorReason named by s.
+func ParseUrlErrorReason(s string) (UrlErrorReason, error) {
+	e := UrlErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid UrlErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// UrlErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e UrlErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *UrlErrorReason) UnmarshalText(text []byte) error {
+	v := UrlErrorReason(text)
+	*e = v
+	return nil
+}
+
+// VideoErrorReasonValues returns all values of VideoErrorReason.
+func VideoErrorReasonValues() []VideoErrorReason {
+	return []VideoErrorReason{
+		VideoErrorReasonINVALID_VIDEO,
+		VideoErrorReasonSTORAGE_ERROR,
+		VideoErrorReasonBAD_REQUEST,
+		VideoErrorReasonERROR_GENERATING_STREAMING_URL,
+		VideoErrorReasonUNEXPECTED_SIZE,
+		VideoErrorReasonSERVER_ERROR,
+		VideoErrorReasonFILE_TOO_LARGE,
+		VideoErrorReasonVIDEO_PROCESSING_ERROR,
+		VideoErrorReasonINVALID_INPUT,
+		VideoErrorReasonPROBLEM_READING_FILE,
+		VideoErrorReasonINVALID_ISCI,
+		VideoErrorReasonINVALID_AD_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of VideoErrorReason.
+func (e VideoErrorReason) IsValid() bool {
+	switch e {
+	case VideoErrorReasonINVALID_VIDEO,
+		VideoErrorReasonSTORAGE_ERROR,
+		VideoErrorReasonBAD_REQUEST,
+		VideoErrorReasonERROR_GENERATING_STREAMING_URL,
+		VideoErrorReasonUNEXPECTED_SIZE,
+		VideoErrorReasonSERVER_ERROR,
+		VideoErrorReasonFILE_TOO_LARGE,
+		VideoErrorReasonVIDEO_PROCESSING_ERROR,
+		VideoErrorReasonINVALID_INPUT,
+		VideoErrorReasonPROBLEM_READING_FILE,
+		VideoErrorReasonINVALID_ISCI,
+		VideoErrorReasonINVALID_AD_ID:
+		return true
+	}
+	return false
+}
+
+// ParseVideoErrorReason returns the VideoErrorReason named by s.
+func ParseVideoErrorReason(s string) (VideoErrorReason, error) {
+	e := VideoErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid VideoErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// VideoErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e VideoErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *VideoErrorReason) UnmarshalText(text []byte) error {
+	v := VideoErrorReason(text)
+	*e = v
+	return nil
+}
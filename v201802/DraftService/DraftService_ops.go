@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package DraftService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *Draft) *DraftOperation {
+	op := OperatorADD
+	return &DraftOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *Draft) *DraftOperation {
+	op := OperatorSET
+	return &DraftOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,413 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package FeedItemService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// CriterionFields holds the selector field names of Criterion, including
+// the fields of the types it holds.
+var CriterionFields = struct {
+	GeoTargetingCriterionId     string
+	GeoTargetingDisplayType     string
+	GeoTargetingLocationName    string
+	GeoTargetingParentLocations string
+	GeoTargetingStatus          string
+	KeywordMatchType            string
+	KeywordTargetingCriterionId string
+	KeywordText                 string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	GeoTargetingDisplayType:     "GeoTargetingDisplayType",
+	GeoTargetingLocationName:    "GeoTargetingLocationName",
+	GeoTargetingParentLocations: "GeoTargetingParentLocations",
+	GeoTargetingStatus:          "GeoTargetingStatus",
+	KeywordMatchType:            "KeywordMatchType",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+	KeywordText:                 "KeywordText",
+}
+
+// FeedItemFields holds the selector field names of FeedItem, including
+// the fields of the types it holds.
+var FeedItemFields = struct {
+	AttributeValues             string
+	DevicePreference            string
+	EndTime                     string
+	FeedId                      string
+	FeedItemId                  string
+	GeoTargetingCriterionId     string
+	GeoTargetingDisplayType     string
+	GeoTargetingLocationName    string
+	GeoTargetingParentLocations string
+	GeoTargetingRestriction     string
+	GeoTargetingStatus          string
+	KeywordMatchType            string
+	KeywordTargetingCriterionId string
+	KeywordText                 string
+	PolicyData                  string
+	Scheduling                  string
+	StartTime                   string
+	Status                      string
+	TargetingAdGroupId          string
+	TargetingCampaignId         string
+	UrlCustomParameters         string
+}{
+	AttributeValues:             "AttributeValues",
+	DevicePreference:            "DevicePreference",
+	EndTime:                     "EndTime",
+	FeedId:                      "FeedId",
+	FeedItemId:                  "FeedItemId",
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	GeoTargetingDisplayType:     "GeoTargetingDisplayType",
+	GeoTargetingLocationName:    "GeoTargetingLocationName",
+	GeoTargetingParentLocations: "GeoTargetingParentLocations",
+	GeoTargetingRestriction:     "GeoTargetingRestriction",
+	GeoTargetingStatus:          "GeoTargetingStatus",
+	KeywordMatchType:            "KeywordMatchType",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+	KeywordText:                 "KeywordText",
+	PolicyData:                  "PolicyData",
+	Scheduling:                  "Scheduling",
+	StartTime:                   "StartTime",
+	Status:                      "Status",
+	TargetingAdGroupId:          "TargetingAdGroupId",
+	TargetingCampaignId:         "TargetingCampaignId",
+	UrlCustomParameters:         "UrlCustomParameters",
+}
+
+// FeedItemAdGroupTargetingFields holds the selector field names of FeedItemAdGroupTargeting, including
+// the fields of the types it holds.
+var FeedItemAdGroupTargetingFields = struct {
+	TargetingAdGroupId string
+}{
+	TargetingAdGroupId: "TargetingAdGroupId",
+}
+
+// FeedItemCampaignTargetingFields holds the selector field names of FeedItemCampaignTargeting, including
+// the fields of the types it holds.
+var FeedItemCampaignTargetingFields = struct {
+	TargetingCampaignId string
+}{
+	TargetingCampaignId: "TargetingCampaignId",
+}
+
+// FeedItemGeoRestrictionFields holds the selector field names of FeedItemGeoRestriction, including
+// the fields of the types it holds.
+var FeedItemGeoRestrictionFields = struct {
+	GeoTargetingRestriction string
+}{
+	GeoTargetingRestriction: "GeoTargetingRestriction",
+}
+
+// KeywordFields holds the selector field names of Keyword, including
+// the fields of the types it holds.
+var KeywordFields = struct {
+	GeoTargetingCriterionId     string
+	KeywordMatchType            string
+	KeywordTargetingCriterionId string
+	KeywordText                 string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	KeywordMatchType:            "KeywordMatchType",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+	KeywordText:                 "KeywordText",
+}
+
+// LocationFields holds the selector field names of Location, including
+// the fields of the types it holds.
+var LocationFields = struct {
+	GeoTargetingCriterionId     string
+	GeoTargetingDisplayType     string
+	GeoTargetingLocationName    string
+	GeoTargetingParentLocations string
+	GeoTargetingStatus          string
+	KeywordTargetingCriterionId string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	GeoTargetingDisplayType:     "GeoTargetingDisplayType",
+	GeoTargetingLocationName:    "GeoTargetingLocationName",
+	GeoTargetingParentLocations: "GeoTargetingParentLocations",
+	GeoTargetingStatus:          "GeoTargetingStatus",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+}
+
+// MobileAppCategoryFields holds the selector field names of MobileAppCategory, including
+// the fields of the types it holds.
+var MobileAppCategoryFields = struct {
+	GeoTargetingCriterionId     string
+	KeywordTargetingCriterionId string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+}
+
+// MobileApplicationFields holds the selector field names of MobileApplication, including
+// the fields of the types it holds.
+var MobileApplicationFields = struct {
+	GeoTargetingCriterionId     string
+	KeywordTargetingCriterionId string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+}
+
+// PlacementFields holds the selector field names of Placement, including
+// the fields of the types it holds.
+var PlacementFields = struct {
+	GeoTargetingCriterionId     string
+	KeywordTargetingCriterionId string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+}
+
+// CriterionUserInterestFields holds the selector field names of CriterionUserInterest, including
+// the fields of the types it holds.
+var CriterionUserInterestFields = struct {
+	GeoTargetingCriterionId     string
+	KeywordTargetingCriterionId string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+}
+
+// CriterionUserListFields holds the selector field names of CriterionUserList, including
+// the fields of the types it holds.
+var CriterionUserListFields = struct {
+	GeoTargetingCriterionId     string
+	KeywordTargetingCriterionId string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+}
+
+// VerticalFields holds the selector field names of Vertical, including
+// the fields of the types it holds.
+var VerticalFields = struct {
+	GeoTargetingCriterionId     string
+	KeywordTargetingCriterionId string
+}{
+	GeoTargetingCriterionId:     "GeoTargetingCriterionId",
+	KeywordTargetingCriterionId: "KeywordTargetingCriterionId",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"Criterion": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+	},
+	"CustomParameter": {
+		{Type: "CustomParameter", Name: "Key", Required: true},
+		{Type: "CustomParameter", Name: "Value"},
+		{Type: "CustomParameter", Name: "IsRemove"},
+	},
+	"FeedItem": {
+		{Type: "FeedItem", Name: "FeedId", Selectors: []string{"FeedId"}, Filter: "FeedId", Required: true},
+		{Type: "FeedItem", Name: "FeedItemId", Selectors: []string{"FeedItemId"}, Filter: "FeedItemId"},
+		{Type: "FeedItem", Name: "Status", Selectors: []string{"Status"}, Filter: "Status", ReadOnly: true},
+		{Type: "FeedItem", Name: "StartTime", Selectors: []string{"StartTime"}, Filter: "StartTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "FeedItem", Name: "EndTime", Selectors: []string{"EndTime"}, Filter: "EndTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+		{Type: "FeedItem", Name: "AttributeValues", Selectors: []string{"AttributeValues"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "FeedItem", Name: "PolicyData", Selectors: []string{"PolicyData"}, ReadOnly: true},
+		{Type: "FeedItem", Name: "DevicePreference", Selectors: []string{"DevicePreference"}, Filter: "DevicePreference"},
+		{Type: "FeedItem", Name: "Scheduling", Selectors: []string{"Scheduling"}},
+		{Type: "FeedItem", Name: "CampaignTargeting"},
+		{Type: "FeedItem", Name: "AdGroupTargeting"},
+		{Type: "FeedItem", Name: "KeywordTargeting"},
+		{Type: "FeedItem", Name: "GeoTargeting"},
+		{Type: "FeedItem", Name: "GeoTargetingRestriction"},
+		{Type: "FeedItem", Name: "UrlCustomParameters", Selectors: []string{"UrlCustomParameters"}},
+	},
+	"FeedItemAdGroupTargeting": {
+		{Type: "FeedItemAdGroupTargeting", Name: "TargetingAdGroupId", Selectors: []string{"TargetingAdGroupId"}, Filter: "TargetingAdGroupId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+	},
+	"FeedItemCampaignTargeting": {
+		{Type: "FeedItemCampaignTargeting", Name: "TargetingCampaignId", Selectors: []string{"TargetingCampaignId"}, Filter: "TargetingCampaignId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE"}},
+	},
+	"FeedItemGeoRestriction": {
+		{Type: "FeedItemGeoRestriction", Name: "GeoRestriction", Selectors: []string{"GeoTargetingRestriction"}, Filter: "GeoTargetingRestriction"},
+	},
+	"FeedItemOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "FeedItemOperation", Name: "Operand", Required: true},
+	},
+	"FeedItemSchedule": {
+		{Type: "FeedItemSchedule", Name: "DayOfWeek", Required: true},
+		{Type: "FeedItemSchedule", Name: "StartHour", Required: true},
+		{Type: "FeedItemSchedule", Name: "StartMinute", Required: true},
+		{Type: "FeedItemSchedule", Name: "EndHour", Required: true},
+		{Type: "FeedItemSchedule", Name: "EndMinute", Required: true},
+	},
+	"Keyword": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Keyword", Name: "Text", Selectors: []string{"KeywordText"}, Filter: "KeywordText", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Keyword", Name: "MatchType", Selectors: []string{"KeywordMatchType"}, Filter: "KeywordMatchType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Location": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Location", Name: "LocationName", Selectors: []string{"GeoTargetingLocationName"}, ReadOnly: true},
+		{Type: "Location", Name: "DisplayType", Selectors: []string{"GeoTargetingDisplayType"}, ReadOnly: true},
+		{Type: "Location", Name: "TargetingStatus", Selectors: []string{"GeoTargetingStatus"}, ReadOnly: true},
+		{Type: "Location", Name: "ParentLocations", Selectors: []string{"GeoTargetingParentLocations"}, ReadOnly: true},
+	},
+	"MobileAppCategory": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileAppCategory", Name: "MobileAppCategoryId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileAppCategory", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileApplication": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileApplication", Name: "AppId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileApplication", Name: "DisplayName", ReadOnly: true},
+	},
+	"MoneyWithCurrency": {
+		{Type: "ComparableValue", Name: "ComparableValueType"},
+		{Type: "MoneyWithCurrency", Name: "Money", Required: true, RequiredOperators: []string{"ADD", "SET"}},
+		{Type: "MoneyWithCurrency", Name: "CurrencyCode"},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Placement": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Placement", Name: "Url", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"CriterionUserInterest": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserInterest", Name: "UserInterestId"},
+		{Type: "CriterionUserInterest", Name: "UserInterestParentId", ReadOnly: true},
+		{Type: "CriterionUserInterest", Name: "UserInterestName"},
+	},
+	"CriterionUserList": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserList", Name: "UserListId"},
+		{Type: "CriterionUserList", Name: "UserListName"},
+		{Type: "CriterionUserList", Name: "UserListMembershipStatus"},
+		{Type: "CriterionUserList", Name: "UserListEligibleForSearch", ReadOnly: true},
+		{Type: "CriterionUserList", Name: "UserListEligibleForDisplay", ReadOnly: true},
+	},
+	"Vertical": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"KeywordTargetingCriterionId", "GeoTargetingCriterionId"}, Filter: "KeywordTargetingCriterionId"},
+		{Type: "Criterion", Name: "Type_", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Vertical", Name: "VerticalId"},
+		{Type: "Vertical", Name: "VerticalParentId", ReadOnly: true},
+		{Type: "Vertical", Name: "Path"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
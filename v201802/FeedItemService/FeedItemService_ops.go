@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package FeedItemService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *FeedItem) *FeedItemOperation {
+	op := OperatorADD
+	return &FeedItemOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *FeedItem) *FeedItemOperation {
+	op := OperatorSET
+	return &FeedItemOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *FeedItem) *FeedItemOperation {
+	op := OperatorREMOVE
+	return &FeedItemOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
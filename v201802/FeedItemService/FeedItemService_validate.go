@@ -0,0 +1,362 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package FeedItemService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Query against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Query) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks FeedItemOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *FeedItemOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "selector"), t.Selector != nil)
+	if t.Selector != nil {
+		t.Selector.validate(v, validate.Field(path, "selector"), op)
+	}
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+		}
+		v.DistinctIds(validate.Field(path, "operations"), operators, ids, "REMOVE", "SET")
+	}
+}
+
+func (t *Query) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Query)
+	}
+	v.Required(validate.Field(path, "query"), t.Query != nil)
+}
+
+func (t *Criterion) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Criterion)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "type"), op, t.Type_ != nil)
+	}
+}
+
+func (t *CustomParameter) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CustomParameter)
+	}
+	v.Required(validate.Field(path, "key"), t.Key != nil)
+	if t.Key != nil {
+		v.StringLength(validate.Field(path, "key"), *t.Key, 1, 16, true, true)
+	}
+	if t.Value != nil {
+		v.StringLength(validate.Field(path, "value"), *t.Value, 0, 200, true, true)
+	}
+}
+
+func (t *CustomParameters) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CustomParameters)
+	}
+	for i, e := range t.Parameters {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "parameters"), i), op)
+		}
+	}
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *FeedItem) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(FeedItem)
+	}
+	v.Required(validate.Field(path, "feedId"), t.FeedId != nil)
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "status"), op, t.Status != nil)
+	}
+	if t.StartTime != nil {
+		v.DateTime(validate.Field(path, "startTime"), *t.StartTime)
+	}
+	if t.EndTime != nil {
+		v.DateTime(validate.Field(path, "endTime"), *t.EndTime)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "attributeValues"), len(t.AttributeValues) > 0)
+	}
+	for i, e := range t.AttributeValues {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "attributeValues"), i), op)
+		}
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "policyData"), op, len(t.PolicyData) > 0)
+	}
+	if t.Scheduling != nil {
+		t.Scheduling.validate(v, validate.Field(path, "scheduling"), op)
+	}
+	if t.KeywordTargeting != nil {
+		t.KeywordTargeting.validate(v, validate.Field(path, "keywordTargeting"), op)
+	}
+	if t.GeoTargeting != nil {
+		t.GeoTargeting.validate(v, validate.Field(path, "geoTargeting"), op)
+	}
+	if t.UrlCustomParameters != nil {
+		t.UrlCustomParameters.validate(v, validate.Field(path, "urlCustomParameters"), op)
+	}
+}
+
+func (t *FeedItemAttributeValue) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(FeedItemAttributeValue)
+	}
+	if t.MoneyWithCurrencyValue != nil {
+		t.MoneyWithCurrencyValue.validate(v, validate.Field(path, "moneyWithCurrencyValue"), op)
+	}
+}
+
+func (t *FeedItemOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(FeedItemOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	if validate.HasOperator(op, "SET") {
+		v.Required(validate.Field(validate.Field(path, "operand"), "feedItemId"), t.Operand != nil && t.Operand.FeedItemId != nil)
+	}
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *FeedItemSchedule) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(FeedItemSchedule)
+	}
+	v.Required(validate.Field(path, "dayOfWeek"), t.DayOfWeek != nil)
+	v.Required(validate.Field(path, "startHour"), t.StartHour != nil)
+	if t.StartHour != nil {
+		v.Range(validate.Field(path, "startHour"), float64(*t.StartHour), 0, 23)
+	}
+	v.Required(validate.Field(path, "startMinute"), t.StartMinute != nil)
+	v.Required(validate.Field(path, "endHour"), t.EndHour != nil)
+	if t.EndHour != nil {
+		v.Range(validate.Field(path, "endHour"), float64(*t.EndHour), 0, 24)
+	}
+	v.Required(validate.Field(path, "endMinute"), t.EndMinute != nil)
+}
+
+func (t *FeedItemScheduling) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(FeedItemScheduling)
+	}
+	for i, e := range t.FeedItemSchedules {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "feedItemSchedules"), i), op)
+		}
+	}
+}
+
+func (t *Keyword) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Keyword)
+	}
+	t.Criterion.validate(v, path, op)
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "text"), t.Text != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "matchType"), t.MatchType != nil)
+	}
+}
+
+func (t *Location) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Location)
+	}
+	t.Criterion.validate(v, path, op)
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "locationName"), op, t.LocationName != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "displayType"), op, t.DisplayType != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "targetingStatus"), op, t.TargetingStatus != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "parentLocations"), op, len(t.ParentLocations) > 0)
+	}
+	for i, e := range t.ParentLocations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "parentLocations"), i), op)
+		}
+	}
+}
+
+func (t *MoneyWithCurrency) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(MoneyWithCurrency)
+	}
+	if validate.HasOperator(op, "ADD", "SET") {
+		v.Required(validate.Field(path, "money"), t.Money != nil)
+	}
+	if t.Money != nil && t.Money.MicroAmount != nil {
+		v.AtLeast(validate.Field(validate.Field(path, "money"), "microAmount"), float64(*t.Money.MicroAmount), 0.)
+	}
+	if t.CurrencyCode != nil {
+		v.StringLength(validate.Field(path, "currencyCode"), *t.CurrencyCode, 3, 3, true, false)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
+
+func (o *FeedItemOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *FeedItemOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	var keywordTargetingID *int64
+	if t.KeywordTargeting != nil && t.KeywordTargeting.Criterion != nil {
+		keywordTargetingID = t.KeywordTargeting.Criterion.Id
+	}
+	var geoTargetingID *int64
+	if t.GeoTargeting != nil && t.GeoTargeting.Criterion != nil {
+		geoTargetingID = t.GeoTargeting.Criterion.Id
+	}
+	return validate.ID(t.FeedId, t.FeedItemId, keywordTargetingID, geoTargetingID)
+}
@@ -0,0 +1,582 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package FeedItemTargetService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AdScheduleFields holds the selector field names of AdSchedule, including
+// the fields of the types it holds.
+var AdScheduleFields = struct {
+	CriteriaType string
+	DayOfWeek    string
+	EndHour      string
+	EndMinute    string
+	Id           string
+	StartHour    string
+	StartMinute  string
+}{
+	CriteriaType: "CriteriaType",
+	DayOfWeek:    "DayOfWeek",
+	EndHour:      "EndHour",
+	EndMinute:    "EndMinute",
+	Id:           "Id",
+	StartHour:    "StartHour",
+	StartMinute:  "StartMinute",
+}
+
+// CriterionFields holds the selector field names of Criterion, including
+// the fields of the types it holds.
+var CriterionFields = struct {
+	CriteriaType     string
+	DayOfWeek        string
+	DisplayType      string
+	EndHour          string
+	EndMinute        string
+	Id               string
+	KeywordMatchType string
+	KeywordText      string
+	LocationName     string
+	ParentLocations  string
+	PlatformName     string
+	StartHour        string
+	StartMinute      string
+	TargetingStatus  string
+}{
+	CriteriaType:     "CriteriaType",
+	DayOfWeek:        "DayOfWeek",
+	DisplayType:      "DisplayType",
+	EndHour:          "EndHour",
+	EndMinute:        "EndMinute",
+	Id:               "Id",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+	LocationName:     "LocationName",
+	ParentLocations:  "ParentLocations",
+	PlatformName:     "PlatformName",
+	StartHour:        "StartHour",
+	StartMinute:      "StartMinute",
+	TargetingStatus:  "TargetingStatus",
+}
+
+// FeedItemAdGroupTargetFields holds the selector field names of FeedItemAdGroupTarget, including
+// the fields of the types it holds.
+var FeedItemAdGroupTargetFields = struct {
+	AdGroupId          string
+	AdGroupName        string
+	FeedId             string
+	FeedItemId         string
+	ParentCampaignName string
+	Status             string
+	TargetType         string
+}{
+	AdGroupId:          "AdGroupId",
+	AdGroupName:        "AdGroupName",
+	FeedId:             "FeedId",
+	FeedItemId:         "FeedItemId",
+	ParentCampaignName: "ParentCampaignName",
+	Status:             "Status",
+	TargetType:         "TargetType",
+}
+
+// FeedItemCampaignTargetFields holds the selector field names of FeedItemCampaignTarget, including
+// the fields of the types it holds.
+var FeedItemCampaignTargetFields = struct {
+	CampaignId   string
+	CampaignName string
+	FeedId       string
+	FeedItemId   string
+	Status       string
+	TargetType   string
+}{
+	CampaignId:   "CampaignId",
+	CampaignName: "CampaignName",
+	FeedId:       "FeedId",
+	FeedItemId:   "FeedItemId",
+	Status:       "Status",
+	TargetType:   "TargetType",
+}
+
+// FeedItemCriterionTargetFields holds the selector field names of FeedItemCriterionTarget, including
+// the fields of the types it holds.
+var FeedItemCriterionTargetFields = struct {
+	CriteriaType     string
+	DayOfWeek        string
+	DisplayType      string
+	EndHour          string
+	EndMinute        string
+	FeedId           string
+	FeedItemId       string
+	Id               string
+	IsNegative       string
+	KeywordMatchType string
+	KeywordText      string
+	LocationName     string
+	ParentLocations  string
+	PlatformName     string
+	StartHour        string
+	StartMinute      string
+	Status           string
+	TargetType       string
+	TargetingStatus  string
+}{
+	CriteriaType:     "CriteriaType",
+	DayOfWeek:        "DayOfWeek",
+	DisplayType:      "DisplayType",
+	EndHour:          "EndHour",
+	EndMinute:        "EndMinute",
+	FeedId:           "FeedId",
+	FeedItemId:       "FeedItemId",
+	Id:               "Id",
+	IsNegative:       "IsNegative",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+	LocationName:     "LocationName",
+	ParentLocations:  "ParentLocations",
+	PlatformName:     "PlatformName",
+	StartHour:        "StartHour",
+	StartMinute:      "StartMinute",
+	Status:           "Status",
+	TargetType:       "TargetType",
+	TargetingStatus:  "TargetingStatus",
+}
+
+// FeedItemTargetFields holds the selector field names of FeedItemTarget, including
+// the fields of the types it holds.
+var FeedItemTargetFields = struct {
+	AdGroupId          string
+	AdGroupName        string
+	CampaignId         string
+	CampaignName       string
+	CriteriaType       string
+	DayOfWeek          string
+	DisplayType        string
+	EndHour            string
+	EndMinute          string
+	FeedId             string
+	FeedItemId         string
+	Id                 string
+	IsNegative         string
+	KeywordMatchType   string
+	KeywordText        string
+	LocationName       string
+	ParentCampaignName string
+	ParentLocations    string
+	PlatformName       string
+	StartHour          string
+	StartMinute        string
+	Status             string
+	TargetType         string
+	TargetingStatus    string
+}{
+	AdGroupId:          "AdGroupId",
+	AdGroupName:        "AdGroupName",
+	CampaignId:         "CampaignId",
+	CampaignName:       "CampaignName",
+	CriteriaType:       "CriteriaType",
+	DayOfWeek:          "DayOfWeek",
+	DisplayType:        "DisplayType",
+	EndHour:            "EndHour",
+	EndMinute:          "EndMinute",
+	FeedId:             "FeedId",
+	FeedItemId:         "FeedItemId",
+	Id:                 "Id",
+	IsNegative:         "IsNegative",
+	KeywordMatchType:   "KeywordMatchType",
+	KeywordText:        "KeywordText",
+	LocationName:       "LocationName",
+	ParentCampaignName: "ParentCampaignName",
+	ParentLocations:    "ParentLocations",
+	PlatformName:       "PlatformName",
+	StartHour:          "StartHour",
+	StartMinute:        "StartMinute",
+	Status:             "Status",
+	TargetType:         "TargetType",
+	TargetingStatus:    "TargetingStatus",
+}
+
+// KeywordFields holds the selector field names of Keyword, including
+// the fields of the types it holds.
+var KeywordFields = struct {
+	CriteriaType     string
+	Id               string
+	KeywordMatchType string
+	KeywordText      string
+}{
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+}
+
+// LocationFields holds the selector field names of Location, including
+// the fields of the types it holds.
+var LocationFields = struct {
+	CriteriaType    string
+	DisplayType     string
+	Id              string
+	LocationName    string
+	ParentLocations string
+	TargetingStatus string
+}{
+	CriteriaType:    "CriteriaType",
+	DisplayType:     "DisplayType",
+	Id:              "Id",
+	LocationName:    "LocationName",
+	ParentLocations: "ParentLocations",
+	TargetingStatus: "TargetingStatus",
+}
+
+// MobileAppCategoryFields holds the selector field names of MobileAppCategory, including
+// the fields of the types it holds.
+var MobileAppCategoryFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// MobileApplicationFields holds the selector field names of MobileApplication, including
+// the fields of the types it holds.
+var MobileApplicationFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// NegativeFeedItemCriterionTargetFields holds the selector field names of NegativeFeedItemCriterionTarget, including
+// the fields of the types it holds.
+var NegativeFeedItemCriterionTargetFields = struct {
+	CriteriaType     string
+	DayOfWeek        string
+	DisplayType      string
+	EndHour          string
+	EndMinute        string
+	FeedId           string
+	FeedItemId       string
+	Id               string
+	IsNegative       string
+	KeywordMatchType string
+	KeywordText      string
+	LocationName     string
+	ParentLocations  string
+	PlatformName     string
+	StartHour        string
+	StartMinute      string
+	Status           string
+	TargetType       string
+	TargetingStatus  string
+}{
+	CriteriaType:     "CriteriaType",
+	DayOfWeek:        "DayOfWeek",
+	DisplayType:      "DisplayType",
+	EndHour:          "EndHour",
+	EndMinute:        "EndMinute",
+	FeedId:           "FeedId",
+	FeedItemId:       "FeedItemId",
+	Id:               "Id",
+	IsNegative:       "IsNegative",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+	LocationName:     "LocationName",
+	ParentLocations:  "ParentLocations",
+	PlatformName:     "PlatformName",
+	StartHour:        "StartHour",
+	StartMinute:      "StartMinute",
+	Status:           "Status",
+	TargetType:       "TargetType",
+	TargetingStatus:  "TargetingStatus",
+}
+
+// PlacementFields holds the selector field names of Placement, including
+// the fields of the types it holds.
+var PlacementFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// PlatformFields holds the selector field names of Platform, including
+// the fields of the types it holds.
+var PlatformFields = struct {
+	CriteriaType string
+	Id           string
+	PlatformName string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	PlatformName: "PlatformName",
+}
+
+// CriterionUserInterestFields holds the selector field names of CriterionUserInterest, including
+// the fields of the types it holds.
+var CriterionUserInterestFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// CriterionUserListFields holds the selector field names of CriterionUserList, including
+// the fields of the types it holds.
+var CriterionUserListFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// VerticalFields holds the selector field names of Vertical, including
+// the fields of the types it holds.
+var VerticalFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"AdSchedule": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "AdSchedule", Name: "DayOfWeek", Selectors: []string{"DayOfWeek"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdSchedule", Name: "StartHour", Selectors: []string{"StartHour"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdSchedule", Name: "StartMinute", Selectors: []string{"StartMinute"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdSchedule", Name: "EndHour", Selectors: []string{"EndHour"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "AdSchedule", Name: "EndMinute", Selectors: []string{"EndMinute"}, ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}, Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Criterion": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+	},
+	"FeedItemAdGroupTarget": {
+		{Type: "FeedItemTarget", Name: "FeedId", Selectors: []string{"FeedId"}, Filter: "FeedId", Required: true},
+		{Type: "FeedItemTarget", Name: "FeedItemId", Selectors: []string{"FeedItemId"}, Filter: "FeedItemId", Required: true},
+		{Type: "FeedItemTarget", Name: "TargetType", Selectors: []string{"TargetType"}, Filter: "TargetType", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "Status", Selectors: []string{"Status"}, Filter: "Status", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "FeedItemTargetType"},
+		{Type: "FeedItemAdGroupTarget", Name: "AdGroupId", Selectors: []string{"AdGroupId"}, Filter: "AdGroupId", Required: true},
+		{Type: "FeedItemAdGroupTarget", Name: "AdGroupName", Selectors: []string{"AdGroupName"}, Filter: "AdGroupName", ReadOnly: true},
+		{Type: "FeedItemAdGroupTarget", Name: "ParentCampaignName", Selectors: []string{"ParentCampaignName"}, Filter: "ParentCampaignName", ReadOnly: true},
+	},
+	"FeedItemCampaignTarget": {
+		{Type: "FeedItemTarget", Name: "FeedId", Selectors: []string{"FeedId"}, Filter: "FeedId", Required: true},
+		{Type: "FeedItemTarget", Name: "FeedItemId", Selectors: []string{"FeedItemId"}, Filter: "FeedItemId", Required: true},
+		{Type: "FeedItemTarget", Name: "TargetType", Selectors: []string{"TargetType"}, Filter: "TargetType", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "Status", Selectors: []string{"Status"}, Filter: "Status", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "FeedItemTargetType"},
+		{Type: "FeedItemCampaignTarget", Name: "CampaignId", Selectors: []string{"CampaignId"}, Filter: "CampaignId", Required: true},
+		{Type: "FeedItemCampaignTarget", Name: "CampaignName", Selectors: []string{"CampaignName"}, Filter: "CampaignName", ReadOnly: true},
+	},
+	"FeedItemCriterionTarget": {
+		{Type: "FeedItemTarget", Name: "FeedId", Selectors: []string{"FeedId"}, Filter: "FeedId", Required: true},
+		{Type: "FeedItemTarget", Name: "FeedItemId", Selectors: []string{"FeedItemId"}, Filter: "FeedItemId", Required: true},
+		{Type: "FeedItemTarget", Name: "TargetType", Selectors: []string{"TargetType"}, Filter: "TargetType", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "Status", Selectors: []string{"Status"}, Filter: "Status", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "FeedItemTargetType"},
+		{Type: "FeedItemCriterionTarget", Name: "Criterion", Required: true},
+		{Type: "FeedItemCriterionTarget", Name: "IsNegative", Selectors: []string{"IsNegative"}, Filter: "IsNegative", ReadOnly: true},
+	},
+	"FeedItemTarget": {
+		{Type: "FeedItemTarget", Name: "FeedId", Selectors: []string{"FeedId"}, Filter: "FeedId", Required: true},
+		{Type: "FeedItemTarget", Name: "FeedItemId", Selectors: []string{"FeedItemId"}, Filter: "FeedItemId", Required: true},
+		{Type: "FeedItemTarget", Name: "TargetType", Selectors: []string{"TargetType"}, Filter: "TargetType", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "Status", Selectors: []string{"Status"}, Filter: "Status", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "FeedItemTargetType"},
+	},
+	"FeedItemTargetOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "FeedItemTargetOperation", Name: "Operand", Required: true},
+	},
+	"Keyword": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Keyword", Name: "Text", Selectors: []string{"KeywordText"}, Filter: "KeywordText", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Keyword", Name: "MatchType", Selectors: []string{"KeywordMatchType"}, Filter: "KeywordMatchType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Location": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Location", Name: "LocationName", Selectors: []string{"LocationName"}, ReadOnly: true},
+		{Type: "Location", Name: "DisplayType", Selectors: []string{"DisplayType"}, ReadOnly: true},
+		{Type: "Location", Name: "TargetingStatus", Selectors: []string{"TargetingStatus"}, ReadOnly: true},
+		{Type: "Location", Name: "ParentLocations", Selectors: []string{"ParentLocations"}, ReadOnly: true},
+	},
+	"MobileAppCategory": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileAppCategory", Name: "MobileAppCategoryId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileAppCategory", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileApplication": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileApplication", Name: "AppId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileApplication", Name: "DisplayName", ReadOnly: true},
+	},
+	"NegativeFeedItemCriterionTarget": {
+		{Type: "FeedItemTarget", Name: "FeedId", Selectors: []string{"FeedId"}, Filter: "FeedId", Required: true},
+		{Type: "FeedItemTarget", Name: "FeedItemId", Selectors: []string{"FeedItemId"}, Filter: "FeedItemId", Required: true},
+		{Type: "FeedItemTarget", Name: "TargetType", Selectors: []string{"TargetType"}, Filter: "TargetType", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "Status", Selectors: []string{"Status"}, Filter: "Status", ReadOnly: true},
+		{Type: "FeedItemTarget", Name: "FeedItemTargetType"},
+		{Type: "FeedItemCriterionTarget", Name: "Criterion", Required: true},
+		{Type: "FeedItemCriterionTarget", Name: "IsNegative", Selectors: []string{"IsNegative"}, Filter: "IsNegative", ReadOnly: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Placement": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Placement", Name: "Url", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Platform": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Platform", Name: "PlatformName", Selectors: []string{"PlatformName"}, Filter: "PlatformName", ReadOnly: true},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"CriterionUserInterest": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserInterest", Name: "UserInterestId"},
+		{Type: "CriterionUserInterest", Name: "UserInterestParentId", ReadOnly: true},
+		{Type: "CriterionUserInterest", Name: "UserInterestName"},
+	},
+	"CriterionUserList": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserList", Name: "UserListId"},
+		{Type: "CriterionUserList", Name: "UserListName"},
+		{Type: "CriterionUserList", Name: "UserListMembershipStatus"},
+		{Type: "CriterionUserList", Name: "UserListEligibleForSearch", ReadOnly: true},
+		{Type: "CriterionUserList", Name: "UserListEligibleForDisplay", ReadOnly: true},
+	},
+	"Vertical": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Vertical", Name: "VerticalId"},
+		{Type: "Vertical", Name: "VerticalParentId", ReadOnly: true},
+		{Type: "Vertical", Name: "Path"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package FeedItemTargetService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *FeedItemTarget) *FeedItemTargetOperation {
+	op := OperatorADD
+	return &FeedItemTargetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *FeedItemTarget) *FeedItemTargetOperation {
+	op := OperatorREMOVE
+	return &FeedItemTargetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package FeedMappingService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *FeedMapping) *FeedMappingOperation {
+	op := OperatorADD
+	return &FeedMappingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *FeedMapping) *FeedMappingOperation {
+	op := OperatorSET
+	return &FeedMappingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *FeedMapping) *FeedMappingOperation {
+	op := OperatorREMOVE
+	return &FeedMappingOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
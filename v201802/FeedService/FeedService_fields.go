@@ -0,0 +1,158 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package FeedService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// FeedFields holds the selector field names of Feed, including
+// the fields of the types it holds.
+var FeedFields = struct {
+	Attributes               string
+	FeedStatus               string
+	Id                       string
+	Name                     string
+	Origin                   string
+	SystemFeedGenerationData string
+}{
+	Attributes:               "Attributes",
+	FeedStatus:               "FeedStatus",
+	Id:                       "Id",
+	Name:                     "Name",
+	Origin:                   "Origin",
+	SystemFeedGenerationData: "SystemFeedGenerationData",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"PlacesLocationFeedData": {
+		{Type: "SystemFeedGenerationData", Name: "SystemFeedGenerationDataType"},
+		{Type: "PlacesLocationFeedData", Name: "OAuthInfo"},
+		{Type: "PlacesLocationFeedData", Name: "EmailAddress", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "PlacesLocationFeedData", Name: "BusinessAccountIdentifier"},
+		{Type: "PlacesLocationFeedData", Name: "BusinessNameFilter"},
+		{Type: "PlacesLocationFeedData", Name: "CategoryFilters"},
+		{Type: "PlacesLocationFeedData", Name: "LabelFilters"},
+	},
+	"Feed": {
+		{Type: "Feed", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Feed", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Feed", Name: "Attributes", Selectors: []string{"Attributes"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Feed", Name: "Status", Selectors: []string{"FeedStatus"}, Filter: "FeedStatus", ReadOnly: true},
+		{Type: "Feed", Name: "Origin", Selectors: []string{"Origin"}, Filter: "Origin"},
+		{Type: "Feed", Name: "SystemFeedGenerationData", Selectors: []string{"SystemFeedGenerationData"}},
+	},
+	"FeedAttribute": {
+		{Type: "FeedAttribute", Name: "Id"},
+		{Type: "FeedAttribute", Name: "Name", Required: true, RequiredOperators: []string{"ADD", "SET"}},
+		{Type: "FeedAttribute", Name: "Type_", Required: true, RequiredOperators: []string{"ADD", "SET"}},
+		{Type: "FeedAttribute", Name: "IsPartOfKey"},
+	},
+	"FeedOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "FeedOperation", Name: "Operand", Required: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package FeedService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *Feed) *FeedOperation {
+	op := OperatorADD
+	return &FeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *Feed) *FeedOperation {
+	op := OperatorSET
+	return &FeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *Feed) *FeedOperation {
+	op := OperatorREMOVE
+	return &FeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,144 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package LabelService
+
+import (
+	"iter"
+	"strconv"
+
+	"github.com/godofdream/go-googleadsinofficial/awql"
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// GetAll returns an iterator over the Label entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func GetAll(api LabelServiceAPI, sel *Selector) iter.Seq2[*Label, error] {
+	return GetPager(api, sel).All()
+}
+
+// GetEach calls fn for every Label entry selected by sel and
+// returns the first error. See GetAll.
+func GetEach(api LabelServiceAPI, sel *Selector, fn func(*Label) error) error {
+	return GetPager(api, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func GetPager(api LabelServiceAPI, sel *Selector) *paging.Pager[*Label] {
+	p := &paging.Pager[*Label]{Key: labelKey}
+	if sel == nil {
+		sel = new(Selector)
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*Label, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+		resp, err := api.Get(&Get{ServiceSelector: paged})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.NoStatsPage != nil && resp.Rval.NoStatsPage.Page != nil && resp.Rval.NoStatsPage.Page.TotalNumEntries != nil {
+			total = *resp.Rval.NoStatsPage.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// GetSplitAll is GetAll for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitAll(api LabelServiceAPI, sel *Selector, split *paging.Splitter) iter.Seq2[*Label, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel.Neutral(), getSplitPager(api))
+}
+
+// GetSplitEach is GetEach for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitEach(api LabelServiceAPI, sel *Selector, split *paging.Splitter, fn func(*Label) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel.Neutral(), getSplitPager(api), fn)
+}
+
+func getSplitPager(api LabelServiceAPI) func(*selector.Selector) (*paging.Pager[*Label], error) {
+	return func(s *selector.Selector) (*paging.Pager[*Label], error) {
+		sel, err := ConvertSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return GetPager(api, sel), nil
+	}
+}
+
+// QueryAll returns an iterator over the Label entries of the AWQL
+// query, fetching one page after the other. The LIMIT clause of the query
+// selects the first entry and the page size. See paging.Pager for entries
+// added or removed while iterating.
+func QueryAll(api LabelServiceAPI, query string) iter.Seq2[*Label, error] {
+	return QueryPager(api, query).All()
+}
+
+// QueryEach calls fn for every Label entry of the AWQL query and
+// returns the first error. See QueryAll.
+func QueryEach(api LabelServiceAPI, query string, fn func(*Label) error) error {
+	return QueryPager(api, query).Each(fn)
+}
+
+// QueryPager returns the paging.Pager of QueryAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func QueryPager(api LabelServiceAPI, query string) *paging.Pager[*Label] {
+	p := &paging.Pager[*Label]{Key: labelKey}
+	q, err := awql.Parse(query)
+	if err == nil && q.Paging != nil {
+		p.Start, p.PageSize = q.Paging.StartIndex, q.Paging.NumberResults
+	}
+	p.Fetch = func(start, n int32) ([]*Label, int32, error) {
+		if err != nil {
+			return nil, 0, err
+		}
+		window := *q
+		window.Paging = &selector.Paging{StartIndex: start, NumberResults: n}
+		paged, err := window.Format()
+		if err != nil {
+			return nil, 0, err
+		}
+		resp, err := api.Query(&Query{Query: String(paged)})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.NoStatsPage != nil && resp.Rval.NoStatsPage.Page != nil && resp.Rval.NoStatsPage.Page.TotalNumEntries != nil {
+			total = *resp.Rval.NoStatsPage.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// labelKey identifies a Label by its Id.
+func labelKey(e *Label) string {
+	if e != nil && e.Id != nil {
+		return strconv.FormatInt(*e.Id, 10)
+	}
+	return ""
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package LabelService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *Label) *LabelOperation {
+	op := OperatorADD
+	return &LabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *Label) *LabelOperation {
+	op := OperatorSET
+	return &LabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *Label) *LabelOperation {
+	op := OperatorREMOVE
+	return &LabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -899,287 +899,243 @@ const (
 )
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -1187,13 +1143,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -1202,98 +1158,82 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -1304,13 +1244,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -1319,58 +1259,54 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -1378,12 +1314,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -1391,57 +1327,53 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 //
@@ -1604,37 +1536,37 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/mcm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getResponse" json:"-"`
 
-	Rval *ManagedCustomerPage `xml:"rval,omitempty"`
+	Rval *ManagedCustomerPage `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type GetPendingInvitations struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getPendingInvitations"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getPendingInvitations" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Selector *PendingInvitationSelector `xml:"selector,omitempty"`
+	Selector *PendingInvitationSelector `xml:"https://adwords.google.com/api/adwords/mcm/v201802 selector,omitempty" json:"selector,omitempty"`
 }
 
 type GetPendingInvitationsResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getPendingInvitationsResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 getPendingInvitationsResponse" json:"-"`
 
-	Rval []*PendingInvitation `xml:"rval,omitempty"`
+	Rval []*PendingInvitation `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -1642,49 +1574,49 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: SET, ADD.</span>
 	//
-	Operations []*ManagedCustomerOperation `xml:"operations,omitempty"`
+	Operations []*ManagedCustomerOperation `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateResponse" json:"-"`
 
-	Rval *ManagedCustomerReturnValue `xml:"rval,omitempty"`
+	Rval *ManagedCustomerReturnValue `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateLabel"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateLabel" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, REMOVE.</span>
 	//
-	Operations []*ManagedCustomerLabelOperation `xml:"operations,omitempty"`
+	Operations []*ManagedCustomerLabelOperation `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateLabelResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateLabelResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateLabelResponse" json:"-"`
 
-	Rval *ManagedCustomerLabelReturnValue `xml:"rval,omitempty"`
+	Rval *ManagedCustomerLabelReturnValue `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateLink struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateLink"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateLink" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: SET, ADD.</span>
 	//
-	Operations []*LinkOperation `xml:"operations,omitempty"`
+	Operations []*LinkOperation `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateLinkResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateLinkResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateLinkResponse" json:"-"`
 
-	Rval *MutateLinkResults `xml:"rval,omitempty"`
+	Rval *MutateLinkResults `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type MutateManager struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateManager"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateManager" json:"-"`
 
 	//
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
@@ -1692,18 +1624,16 @@ type MutateManager struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: SET.</span>
 	//
-	Operations []*MoveOperation `xml:"operations,omitempty"`
+	Operations []*MoveOperation `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateManagerResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateManagerResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 mutateManagerResponse" json:"-"`
 
-	Rval *MutateManagerResults `xml:"rval,omitempty"`
+	Rval *MutateManagerResults `xml:"https://adwords.google.com/api/adwords/mcm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AccountLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 AccountLabel"`
-
 	//
 	// ID of the label.
 	// <p>This field is selectable/filterable in AccountLabelService.  To select labels or filter by
@@ -1712,7 +1642,7 @@ type AccountLabel struct {
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// Name of the label.
@@ -1721,31 +1651,29 @@ type AccountLabel struct {
 	// <span class="constraint Selectable">This field can be selected using the value "LabelName".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type ManagedCustomerLink struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomerLink"`
-
 	//
 	// The manager customer ID in this link.
 	//
-	ManagerCustomerId int64 `xml:"managerCustomerId,omitempty"`
+	ManagerCustomerId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 managerCustomerId,omitempty" json:"managerCustomerId,omitempty"`
 
 	//
 	// The client customer ID in this link.
 	//
-	ClientCustomerId int64 `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// The status of the link.
 	//
-	LinkStatus *LinkStatus `xml:"linkStatus,omitempty"`
+	LinkStatus *LinkStatus `xml:"https://adwords.google.com/api/adwords/mcm/v201802 linkStatus,omitempty" json:"linkStatus,omitempty"`
 
 	//
 	// The pending descriptive name of the client for link invitations.
 	//
-	PendingDescriptiveName string `xml:"pendingDescriptiveName,omitempty"`
+	PendingDescriptiveName *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 pendingDescriptiveName,omitempty" json:"pendingDescriptiveName,omitempty"`
 
 	//
 	// Whether the link is hidden.
@@ -1753,36 +1681,32 @@ type ManagedCustomerLink struct {
 	// <p>Hiding accounts removes them from your manager account views without unlinking them
 	// in the AdWords UI. Ads in those accounts will continue running normally.
 	//
-	IsHidden bool `xml:"isHidden,omitempty"`
+	IsHidden *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 isHidden,omitempty" json:"isHidden,omitempty"`
 }
 
 type LinkOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 LinkOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *ManagedCustomerLink `xml:"operand,omitempty"`
+	Operand *ManagedCustomerLink `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type ManagedCustomer struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomer"`
-
 	//
 	// The name used by the manager to refer to the client.
 	// <span class="constraint Selectable">This field can be selected using the value "Name".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 name,omitempty" json:"name,omitempty"`
 
 	//
 	// The 10-digit ID that uniquely identifies the AdWords account.
 	// <span class="constraint Selectable">This field can be selected using the value "CustomerId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
 	//
-	CustomerId int64 `xml:"customerId,omitempty"`
+	CustomerId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 customerId,omitempty" json:"customerId,omitempty"`
 
 	//
 	// Whether this account can manage clients.
@@ -1790,7 +1714,7 @@ type ManagedCustomer struct {
 	// and will be ignored when sent to the API.</span>
 	// <span class="constraint Selectable">This field can be selected using the value "CanManageClients".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	CanManageClients bool `xml:"canManageClients,omitempty"`
+	CanManageClients *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 canManageClients,omitempty" json:"canManageClients,omitempty"`
 
 	//
 	// The currency in which this account operates.
@@ -1801,7 +1725,7 @@ type ManagedCustomer struct {
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	// <span class="constraint StringLength">The length of this string should be between 3 and 3, inclusive.</span>
 	//
-	CurrencyCode string `xml:"currencyCode,omitempty"`
+	CurrencyCode *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 currencyCode,omitempty" json:"currencyCode,omitempty"`
 
 	//
 	// The local timezone ID for this customer.
@@ -1810,14 +1734,14 @@ type ManagedCustomer struct {
 	// <span class="constraint Selectable">This field can be selected using the value "DateTimeZone".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	DateTimeZone string `xml:"dateTimeZone,omitempty"`
+	DateTimeZone *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 dateTimeZone,omitempty" json:"dateTimeZone,omitempty"`
 
 	//
 	// Whether this managed customer's account is a test account.
 	// <span class="constraint Selectable">This field can be selected using the value "TestAccount".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	TestAccount bool `xml:"testAccount,omitempty"`
+	TestAccount *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 testAccount,omitempty" json:"testAccount,omitempty"`
 
 	//
 	// The list of account labels associated with this customer. Only labels owned by the requesting
@@ -1826,62 +1750,54 @@ type ManagedCustomer struct {
 	// <span class="constraint Selectable">This field can be selected using the value "AccountLabels".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	AccountLabels []*AccountLabel `xml:"accountLabels,omitempty"`
+	AccountLabels []*AccountLabel `xml:"https://adwords.google.com/api/adwords/mcm/v201802 accountLabels,omitempty" json:"accountLabels,omitempty"`
 
 	//
 	// Specify ExcludeHiddenAccounts=true to exclude hidden accounts during traversal.
 	// <span class="constraint Filterable">This field can be filtered on using the value "ExcludeHiddenAccounts".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ExcludeHiddenAccounts bool `xml:"excludeHiddenAccounts,omitempty"`
+	ExcludeHiddenAccounts *bool `xml:"https://adwords.google.com/api/adwords/mcm/v201802 excludeHiddenAccounts,omitempty" json:"excludeHiddenAccounts,omitempty"`
 }
 
 type ManagedCustomerLabel struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomerLabel"`
-
 	//
 	// The ID of an existing label to be applied to the account.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	LabelId int64 `xml:"labelId,omitempty"`
+	LabelId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 labelId,omitempty" json:"labelId,omitempty"`
 
 	//
 	// The 10-digit customer ID that identifies this account. Note that this is a {@code long} (do not
 	// include hyphens in the middle), just like {@link ManagedCustomer#customerId}.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	CustomerId int64 `xml:"customerId,omitempty"`
+	CustomerId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 customerId,omitempty" json:"customerId,omitempty"`
 }
 
 type ManagedCustomerLabelOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomerLabelOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *ManagedCustomerLabel `xml:"operand,omitempty"`
+	Operand *ManagedCustomerLabel `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type ManagedCustomerLabelReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomerLabelReturnValue"`
-
 	//
 	// List of managed customer labels.
 	//
-	Value []*ManagedCustomerLabel `xml:"value,omitempty"`
+	Value []*ManagedCustomerLabel `xml:"https://adwords.google.com/api/adwords/mcm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ManagedCustomerOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomerOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *ManagedCustomer `xml:"operand,omitempty"`
+	Operand *ManagedCustomer `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operand,omitempty" json:"operand,omitempty"`
 
 	//
 	// Email invitee.
@@ -1892,7 +1808,7 @@ type ManagedCustomerOperation struct {
 	// <p class="note"><b>Note:</b> This is only supported for {@code ADD} operator.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	InviteeEmail string `xml:"inviteeEmail,omitempty"`
+	InviteeEmail *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 inviteeEmail,omitempty" json:"inviteeEmail,omitempty"`
 
 	//
 	// Email invitee role.
@@ -1903,102 +1819,86 @@ type ManagedCustomerOperation struct {
 	// <p class="note"><b>Note:</b> This is only supported for {@code ADD} operator.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: REMOVE and SET.</span>
 	//
-	InviteeRole *AccessRole `xml:"inviteeRole,omitempty"`
+	InviteeRole *AccessRole `xml:"https://adwords.google.com/api/adwords/mcm/v201802 inviteeRole,omitempty" json:"inviteeRole,omitempty"`
 }
 
 type ManagedCustomerPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomerPage"`
-
 	*Page
 
 	//
 	// Subset of the managed customers' information that are being retrieved.
 	//
-	Entries []*ManagedCustomer `xml:"entries,omitempty"`
+	Entries []*ManagedCustomer `xml:"https://adwords.google.com/api/adwords/mcm/v201802 entries,omitempty" json:"entries,omitempty"`
 
 	//
 	// Links between manager and client customers.
 	//
-	Links []*ManagedCustomerLink `xml:"links,omitempty"`
+	Links []*ManagedCustomerLink `xml:"https://adwords.google.com/api/adwords/mcm/v201802 links,omitempty" json:"links,omitempty"`
 }
 
 type ManagedCustomerReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomerReturnValue"`
-
 	//
 	// List of managed customers.
 	//
-	Value []*ManagedCustomer `xml:"value,omitempty"`
+	Value []*ManagedCustomer `xml:"https://adwords.google.com/api/adwords/mcm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type ManagedCustomerServiceError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 ManagedCustomerServiceError"`
-
 	*ApiError
 
-	Reason *ManagedCustomerServiceErrorReason `xml:"reason,omitempty"`
+	Reason *ManagedCustomerServiceErrorReason `xml:"https://adwords.google.com/api/adwords/mcm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// The list of customer ids associated with the error.
 	//
-	CustomerIds []int64 `xml:"customerIds,omitempty"`
+	CustomerIds []int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 customerIds,omitempty" json:"customerIds,omitempty"`
 }
 
 type MoveOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 MoveOperation"`
-
 	*Operation
 
-	Operand *ManagedCustomerLink `xml:"operand,omitempty"`
+	Operand *ManagedCustomerLink `xml:"https://adwords.google.com/api/adwords/mcm/v201802 operand,omitempty" json:"operand,omitempty"`
 
 	//
 	// The ID of the old Manager.
 	//
-	OldManagerCustomerId int64 `xml:"oldManagerCustomerId,omitempty"`
+	OldManagerCustomerId *int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 oldManagerCustomerId,omitempty" json:"oldManagerCustomerId,omitempty"`
 }
 
 type MutateLinkResults struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 MutateLinkResults"`
-
 	//
 	// Links that were changed as a result of this call.
 	//
-	Links []*ManagedCustomerLink `xml:"links,omitempty"`
+	Links []*ManagedCustomerLink `xml:"https://adwords.google.com/api/adwords/mcm/v201802 links,omitempty" json:"links,omitempty"`
 }
 
 type MutateManagerResults struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 MutateManagerResults"`
-
 	//
 	// The links that were changed as a result of this call.
 	//
-	Links []*ManagedCustomerLink `xml:"links,omitempty"`
+	Links []*ManagedCustomerLink `xml:"https://adwords.google.com/api/adwords/mcm/v201802 links,omitempty" json:"links,omitempty"`
 }
 
 type PendingInvitation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 PendingInvitation"`
-
-	Manager *ManagedCustomer `xml:"manager,omitempty"`
+	Manager *ManagedCustomer `xml:"https://adwords.google.com/api/adwords/mcm/v201802 manager,omitempty" json:"manager,omitempty"`
 
-	Client *ManagedCustomer `xml:"client,omitempty"`
+	Client *ManagedCustomer `xml:"https://adwords.google.com/api/adwords/mcm/v201802 client,omitempty" json:"client,omitempty"`
 
-	CreationDate string `xml:"creationDate,omitempty"`
+	CreationDate *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 creationDate,omitempty" json:"creationDate,omitempty"`
 
-	ExpirationDate string `xml:"expirationDate,omitempty"`
+	ExpirationDate *string `xml:"https://adwords.google.com/api/adwords/mcm/v201802 expirationDate,omitempty" json:"expirationDate,omitempty"`
 }
 
 type PendingInvitationSelector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/mcm/v201802 PendingInvitationSelector"`
-
 	//
 	// Manager customer IDs to check for sent invitations.
 	//
-	ManagerCustomerIds []int64 `xml:"managerCustomerIds,omitempty"`
+	ManagerCustomerIds []int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 managerCustomerIds,omitempty" json:"managerCustomerIds,omitempty"`
 
 	//
 	// Client customer IDs to check for received invitations.
 	//
-	ClientCustomerIds []int64 `xml:"clientCustomerIds,omitempty"`
+	ClientCustomerIds []int64 `xml:"https://adwords.google.com/api/adwords/mcm/v201802 clientCustomerIds,omitempty" json:"clientCustomerIds,omitempty"`
 }
 
 type ManagedCustomerServiceInterface struct {
@@ -0,0 +1,186 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ManagedCustomerService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ManagedCustomerServiceAPI is the interface of the ManagedCustomerService operations. It is
+// implemented by ManagedCustomerServiceInterface and, for tests, by FakeManagedCustomerService.
+type ManagedCustomerServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	GetPendingInvitations(request *GetPendingInvitations) (*GetPendingInvitationsResponse, error)
+	Mutate(request *Mutate) (*MutateResponse, error)
+	MutateLabel(request *MutateLabel) (*MutateLabelResponse, error)
+	MutateLink(request *MutateLink) (*MutateLinkResponse, error)
+	MutateManager(request *MutateManager) (*MutateManagerResponse, error)
+}
+
+var _ ManagedCustomerServiceAPI = (*ManagedCustomerServiceInterface)(nil)
+var _ ManagedCustomerServiceAPI = (*FakeManagedCustomerService)(nil)
+
+// FakeCall is a call recorded by FakeManagedCustomerService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeManagedCustomerService is a programmable ManagedCustomerServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeManagedCustomerService struct {
+	GetFunc                   func(request *Get) (*GetResponse, error)
+	GetPendingInvitationsFunc func(request *GetPendingInvitations) (*GetPendingInvitationsResponse, error)
+	MutateFunc                func(request *Mutate) (*MutateResponse, error)
+	MutateLabelFunc           func(request *MutateLabel) (*MutateLabelResponse, error)
+	MutateLinkFunc            func(request *MutateLink) (*MutateLinkResponse, error)
+	MutateManagerFunc         func(request *MutateManager) (*MutateManagerResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeManagedCustomerService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeManagedCustomerService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeManagedCustomerService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeManagedCustomerService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeManagedCustomerService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeManagedCustomerService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// GetPendingInvitations records the call and delegates to GetPendingInvitationsFunc.
+func (f *FakeManagedCustomerService) GetPendingInvitations(request *GetPendingInvitations) (*GetPendingInvitationsResponse, error) {
+	f.record("GetPendingInvitations", request)
+	if f.GetPendingInvitationsFunc == nil {
+		return nil, fmt.Errorf("FakeManagedCustomerService.GetPendingInvitationsFunc is not set")
+	}
+	return f.GetPendingInvitationsFunc(request)
+}
+
+// GetPendingInvitationsCalls returns the requests of the recorded GetPendingInvitations calls.
+func (f *FakeManagedCustomerService) GetPendingInvitationsCalls() []*GetPendingInvitations {
+	var requests []*GetPendingInvitations
+	for _, c := range f.Calls() {
+		if c.Method == "GetPendingInvitations" {
+			requests = append(requests, c.Request.(*GetPendingInvitations))
+		}
+	}
+	return requests
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeManagedCustomerService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeManagedCustomerService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeManagedCustomerService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
+
+// MutateLabel records the call and delegates to MutateLabelFunc.
+func (f *FakeManagedCustomerService) MutateLabel(request *MutateLabel) (*MutateLabelResponse, error) {
+	f.record("MutateLabel", request)
+	if f.MutateLabelFunc == nil {
+		return nil, fmt.Errorf("FakeManagedCustomerService.MutateLabelFunc is not set")
+	}
+	return f.MutateLabelFunc(request)
+}
+
+// MutateLabelCalls returns the requests of the recorded MutateLabel calls.
+func (f *FakeManagedCustomerService) MutateLabelCalls() []*MutateLabel {
+	var requests []*MutateLabel
+	for _, c := range f.Calls() {
+		if c.Method == "MutateLabel" {
+			requests = append(requests, c.Request.(*MutateLabel))
+		}
+	}
+	return requests
+}
+
+// MutateLink records the call and delegates to MutateLinkFunc.
+func (f *FakeManagedCustomerService) MutateLink(request *MutateLink) (*MutateLinkResponse, error) {
+	f.record("MutateLink", request)
+	if f.MutateLinkFunc == nil {
+		return nil, fmt.Errorf("FakeManagedCustomerService.MutateLinkFunc is not set")
+	}
+	return f.MutateLinkFunc(request)
+}
+
+// MutateLinkCalls returns the requests of the recorded MutateLink calls.
+func (f *FakeManagedCustomerService) MutateLinkCalls() []*MutateLink {
+	var requests []*MutateLink
+	for _, c := range f.Calls() {
+		if c.Method == "MutateLink" {
+			requests = append(requests, c.Request.(*MutateLink))
+		}
+	}
+	return requests
+}
+
+// MutateManager records the call and delegates to MutateManagerFunc.
+func (f *FakeManagedCustomerService) MutateManager(request *MutateManager) (*MutateManagerResponse, error) {
+	f.record("MutateManager", request)
+	if f.MutateManagerFunc == nil {
+		return nil, fmt.Errorf("FakeManagedCustomerService.MutateManagerFunc is not set")
+	}
+	return f.MutateManagerFunc(request)
+}
+
+// MutateManagerCalls returns the requests of the recorded MutateManager calls.
+func (f *FakeManagedCustomerService) MutateManagerCalls() []*MutateManager {
+	var requests []*MutateManager
+	for _, c := range f.Calls() {
+		if c.Method == "MutateManager" {
+			requests = append(requests, c.Request.(*MutateManager))
+		}
+	}
+	return requests
+}
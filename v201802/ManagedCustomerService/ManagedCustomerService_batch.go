@@ -0,0 +1,121 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ManagedCustomerService
+
+import "github.com/godofdream/go-googleadsinofficial/batch"
+
+// MutateBatch is Mutate for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateBatch(api ManagedCustomerServiceAPI, operations []*ManagedCustomerOperation, opts *batch.Options) (*ManagedCustomerReturnValue, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*ManagedCustomerOperation) (*MutateResponse, error) {
+		return api.Mutate(&Mutate{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Value)
+	})
+	rval := new(ManagedCustomerReturnValue)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Value = append(rval.Value, make([]*ManagedCustomer, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		rval.Value = append(rval.Value, batch.Pad(r.Value, c.End-c.Start)...)
+	}
+	return rval, err
+}
+
+// MutateLabelBatch is MutateLabel for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateLabelBatch(api ManagedCustomerServiceAPI, operations []*ManagedCustomerLabelOperation, opts *batch.Options) (*ManagedCustomerLabelReturnValue, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*ManagedCustomerLabelOperation) (*MutateLabelResponse, error) {
+		return api.MutateLabel(&MutateLabel{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateLabelResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Value)
+	})
+	rval := new(ManagedCustomerLabelReturnValue)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Value = append(rval.Value, make([]*ManagedCustomerLabel, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		rval.Value = append(rval.Value, batch.Pad(r.Value, c.End-c.Start)...)
+	}
+	return rval, err
+}
+
+// MutateLinkBatch is MutateLink for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateLinkBatch(api ManagedCustomerServiceAPI, operations []*LinkOperation, opts *batch.Options) (*MutateLinkResults, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*LinkOperation) (*MutateLinkResponse, error) {
+		return api.MutateLink(&MutateLink{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateLinkResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Links)
+	})
+	rval := new(MutateLinkResults)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Links = append(rval.Links, make([]*ManagedCustomerLink, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		rval.Links = append(rval.Links, batch.Pad(r.Links, c.End-c.Start)...)
+	}
+	return rval, err
+}
+
+// MutateManagerBatch is MutateManager for any number of operations. They are sent in
+// chunks limited by opts and the results are merged in the order of the
+// operations.
+// A chunk failing as a whole or returning a number of values other than
+// its number of operations leaves nil values at its positions and returns
+// a batch.ChunkError, joined with those of the other failed chunks. A
+// chunk without values, e.g. with ValidateOnly, leaves nil values too.
+func MutateManagerBatch(api ManagedCustomerServiceAPI, operations []*MoveOperation, opts *batch.Options) (*MutateManagerResults, error) {
+	chunks, err := batch.Run(operations, opts, func(ops []*MoveOperation) (*MutateManagerResponse, error) {
+		return api.MutateManager(&MutateManager{Operations: ops})
+	})
+	err = batch.Check(chunks, err, func(r *MutateManagerResponse) int {
+		if r == nil || r.Rval == nil {
+			return 0
+		}
+		return len(r.Rval.Links)
+	})
+	rval := new(MutateManagerResults)
+	for _, c := range chunks {
+		if c.Err != nil || c.Result == nil || c.Result.Rval == nil {
+			rval.Links = append(rval.Links, make([]*ManagedCustomerLink, c.End-c.Start)...)
+			continue
+		}
+		r := c.Result.Rval
+		rval.Links = append(rval.Links, batch.Pad(r.Links, c.End-c.Start)...)
+	}
+	return rval, err
+}
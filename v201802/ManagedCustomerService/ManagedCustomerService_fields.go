@@ -0,0 +1,196 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ManagedCustomerService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AccountLabelFields holds the selector field names of AccountLabel, including
+// the fields of the types it holds.
+var AccountLabelFields = struct {
+	LabelId   string
+	LabelName string
+}{
+	LabelId:   "LabelId",
+	LabelName: "LabelName",
+}
+
+// ManagedCustomerFields holds the selector field names of ManagedCustomer, including
+// the fields of the types it holds.
+var ManagedCustomerFields = struct {
+	AccountLabels    string
+	CanManageClients string
+	CurrencyCode     string
+	CustomerId       string
+	DateTimeZone     string
+	LabelId          string
+	LabelName        string
+	Name             string
+	TestAccount      string
+}{
+	AccountLabels:    "AccountLabels",
+	CanManageClients: "CanManageClients",
+	CurrencyCode:     "CurrencyCode",
+	CustomerId:       "CustomerId",
+	DateTimeZone:     "DateTimeZone",
+	LabelId:          "LabelId",
+	LabelName:        "LabelName",
+	Name:             "Name",
+	TestAccount:      "TestAccount",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"GetPendingInvitations": {
+		{Type: "GetPendingInvitations", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"MutateLabel": {
+		{Type: "MutateLabel", Name: "Operations", Required: true},
+	},
+	"MutateLink": {
+		{Type: "MutateLink", Name: "Operations", Required: true},
+	},
+	"MutateManager": {
+		{Type: "MutateManager", Name: "Operations", Required: true},
+	},
+	"AccountLabel": {
+		{Type: "AccountLabel", Name: "Id", Selectors: []string{"LabelId"}, Filter: "LabelId", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}, Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "AccountLabel", Name: "Name", Selectors: []string{"LabelName"}, Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"LinkOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "LinkOperation", Name: "Operand", Required: true},
+	},
+	"ManagedCustomer": {
+		{Type: "ManagedCustomer", Name: "Name", Selectors: []string{"Name"}, Filter: "Name", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ManagedCustomer", Name: "CustomerId", Selectors: []string{"CustomerId"}, Filter: "CustomerId", ReadOnly: true, ReadOnlyOperators: []string{"ADD"}},
+		{Type: "ManagedCustomer", Name: "CanManageClients", Selectors: []string{"CanManageClients"}, Filter: "CanManageClients", ReadOnly: true},
+		{Type: "ManagedCustomer", Name: "CurrencyCode", Selectors: []string{"CurrencyCode"}, Filter: "CurrencyCode", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ManagedCustomer", Name: "DateTimeZone", Selectors: []string{"DateTimeZone"}, Filter: "DateTimeZone", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "ManagedCustomer", Name: "TestAccount", Selectors: []string{"TestAccount"}, ReadOnly: true},
+		{Type: "ManagedCustomer", Name: "AccountLabels", Selectors: []string{"AccountLabels"}, Filter: "AccountLabels", ReadOnly: true},
+		{Type: "ManagedCustomer", Name: "ExcludeHiddenAccounts", Filter: "ExcludeHiddenAccounts", ReadOnly: true},
+	},
+	"ManagedCustomerLabel": {
+		{Type: "ManagedCustomerLabel", Name: "LabelId", Required: true},
+		{Type: "ManagedCustomerLabel", Name: "CustomerId", Required: true},
+	},
+	"ManagedCustomerLabelOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "ManagedCustomerLabelOperation", Name: "Operand", Required: true},
+	},
+	"ManagedCustomerOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "ManagedCustomerOperation", Name: "Operand", Required: true},
+		{Type: "ManagedCustomerOperation", Name: "InviteeEmail", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "ManagedCustomerOperation", Name: "InviteeRole", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+	},
+	"MoveOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "MoveOperation", Name: "Operand"},
+		{Type: "MoveOperation", Name: "OldManagerCustomerId"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
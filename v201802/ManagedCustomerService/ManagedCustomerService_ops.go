@@ -0,0 +1,45 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ManagedCustomerService
+
+// AddLinkOp returns the ADD operation of operand.
+func AddLinkOp(operand *ManagedCustomerLink) *LinkOperation {
+	op := OperatorADD
+	return &LinkOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetLinkOp returns the SET operation of operand.
+func SetLinkOp(operand *ManagedCustomerLink) *LinkOperation {
+	op := OperatorSET
+	return &LinkOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// AddLabelOp returns the ADD operation of operand.
+func AddLabelOp(operand *ManagedCustomerLabel) *ManagedCustomerLabelOperation {
+	op := OperatorADD
+	return &ManagedCustomerLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveLabelOp returns the REMOVE operation of operand.
+func RemoveLabelOp(operand *ManagedCustomerLabel) *ManagedCustomerLabelOperation {
+	op := OperatorREMOVE
+	return &ManagedCustomerLabelOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *ManagedCustomer) *ManagedCustomerOperation {
+	op := OperatorADD
+	return &ManagedCustomerOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *ManagedCustomer) *ManagedCustomerOperation {
+	op := OperatorSET
+	return &ManagedCustomerOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetMoveOp returns the SET operation of operand.
+func SetMoveOp(operand *ManagedCustomerLink) *MoveOperation {
+	op := OperatorSET
+	return &MoveOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
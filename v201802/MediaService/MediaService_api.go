@@ -0,0 +1,120 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package MediaService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MediaServiceAPI is the interface of the MediaService operations. It is
+// implemented by MediaServiceInterface and, for tests, by FakeMediaService.
+type MediaServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Query(request *Query) (*QueryResponse, error)
+	Upload(request *Upload) (*UploadResponse, error)
+}
+
+var _ MediaServiceAPI = (*MediaServiceInterface)(nil)
+var _ MediaServiceAPI = (*FakeMediaService)(nil)
+
+// FakeCall is a call recorded by FakeMediaService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeMediaService is a programmable MediaServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeMediaService struct {
+	GetFunc    func(request *Get) (*GetResponse, error)
+	QueryFunc  func(request *Query) (*QueryResponse, error)
+	UploadFunc func(request *Upload) (*UploadResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeMediaService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeMediaService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeMediaService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeMediaService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeMediaService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeMediaService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Query records the call and delegates to QueryFunc.
+func (f *FakeMediaService) Query(request *Query) (*QueryResponse, error) {
+	f.record("Query", request)
+	if f.QueryFunc == nil {
+		return nil, fmt.Errorf("FakeMediaService.QueryFunc is not set")
+	}
+	return f.QueryFunc(request)
+}
+
+// QueryCalls returns the requests of the recorded Query calls.
+func (f *FakeMediaService) QueryCalls() []*Query {
+	var requests []*Query
+	for _, c := range f.Calls() {
+		if c.Method == "Query" {
+			requests = append(requests, c.Request.(*Query))
+		}
+	}
+	return requests
+}
+
+// Upload records the call and delegates to UploadFunc.
+func (f *FakeMediaService) Upload(request *Upload) (*UploadResponse, error) {
+	f.record("Upload", request)
+	if f.UploadFunc == nil {
+		return nil, fmt.Errorf("FakeMediaService.UploadFunc is not set")
+	}
+	return f.UploadFunc(request)
+}
+
+// UploadCalls returns the requests of the recorded Upload calls.
+func (f *FakeMediaService) UploadCalls() []*Upload {
+	var requests []*Upload
+	for _, c := range f.Calls() {
+		if c.Method == "Upload" {
+			requests = append(requests, c.Request.(*Upload))
+		}
+	}
+	return requests
+}
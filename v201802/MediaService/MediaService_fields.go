@@ -0,0 +1,377 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package MediaService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// AudioFields holds the selector field names of Audio, including
+// the fields of the types it holds.
+var AudioFields = struct {
+	CreationTime        string
+	Dimensions          string
+	DurationMillis      string
+	FileSize            string
+	Height              string
+	MediaId             string
+	MimeType            string
+	Name                string
+	ReadyToPlayOnTheWeb string
+	ReferenceId         string
+	SourceUrl           string
+	StreamingUrl        string
+	Type                string
+	Urls                string
+	Width               string
+}{
+	CreationTime:        "CreationTime",
+	Dimensions:          "Dimensions",
+	DurationMillis:      "DurationMillis",
+	FileSize:            "FileSize",
+	Height:              "Height",
+	MediaId:             "MediaId",
+	MimeType:            "MimeType",
+	Name:                "Name",
+	ReadyToPlayOnTheWeb: "ReadyToPlayOnTheWeb",
+	ReferenceId:         "ReferenceId",
+	SourceUrl:           "SourceUrl",
+	StreamingUrl:        "StreamingUrl",
+	Type:                "Type",
+	Urls:                "Urls",
+	Width:               "Width",
+}
+
+// DimensionsFields holds the selector field names of Dimensions, including
+// the fields of the types it holds.
+var DimensionsFields = struct {
+	Height string
+	Width  string
+}{
+	Height: "Height",
+	Width:  "Width",
+}
+
+// ImageFields holds the selector field names of Image, including
+// the fields of the types it holds.
+var ImageFields = struct {
+	CreationTime string
+	Dimensions   string
+	FileSize     string
+	Height       string
+	MediaId      string
+	MimeType     string
+	Name         string
+	ReferenceId  string
+	SourceUrl    string
+	Type         string
+	Urls         string
+	Width        string
+}{
+	CreationTime: "CreationTime",
+	Dimensions:   "Dimensions",
+	FileSize:     "FileSize",
+	Height:       "Height",
+	MediaId:      "MediaId",
+	MimeType:     "MimeType",
+	Name:         "Name",
+	ReferenceId:  "ReferenceId",
+	SourceUrl:    "SourceUrl",
+	Type:         "Type",
+	Urls:         "Urls",
+	Width:        "Width",
+}
+
+// MediaFields holds the selector field names of Media, including
+// the fields of the types it holds.
+var MediaFields = struct {
+	AdvertisingId                        string
+	CreationTime                         string
+	Dimensions                           string
+	DurationMillis                       string
+	FileSize                             string
+	Height                               string
+	IndustryStandardCommercialIdentifier string
+	MediaId                              string
+	MimeType                             string
+	Name                                 string
+	ReadyToPlayOnTheWeb                  string
+	ReferenceId                          string
+	SourceUrl                            string
+	StreamingUrl                         string
+	Type                                 string
+	Urls                                 string
+	Width                                string
+	YouTubeVideoIdString                 string
+}{
+	AdvertisingId:                        "AdvertisingId",
+	CreationTime:                         "CreationTime",
+	Dimensions:                           "Dimensions",
+	DurationMillis:                       "DurationMillis",
+	FileSize:                             "FileSize",
+	Height:                               "Height",
+	IndustryStandardCommercialIdentifier: "IndustryStandardCommercialIdentifier",
+	MediaId:                              "MediaId",
+	MimeType:                             "MimeType",
+	Name:                                 "Name",
+	ReadyToPlayOnTheWeb:                  "ReadyToPlayOnTheWeb",
+	ReferenceId:                          "ReferenceId",
+	SourceUrl:                            "SourceUrl",
+	StreamingUrl:                         "StreamingUrl",
+	Type:                                 "Type",
+	Urls:                                 "Urls",
+	Width:                                "Width",
+	YouTubeVideoIdString:                 "YouTubeVideoIdString",
+}
+
+// MediaBundleFields holds the selector field names of MediaBundle, including
+// the fields of the types it holds.
+var MediaBundleFields = struct {
+	CreationTime string
+	Dimensions   string
+	FileSize     string
+	Height       string
+	MediaId      string
+	MimeType     string
+	Name         string
+	ReferenceId  string
+	SourceUrl    string
+	Type         string
+	Urls         string
+	Width        string
+}{
+	CreationTime: "CreationTime",
+	Dimensions:   "Dimensions",
+	FileSize:     "FileSize",
+	Height:       "Height",
+	MediaId:      "MediaId",
+	MimeType:     "MimeType",
+	Name:         "Name",
+	ReferenceId:  "ReferenceId",
+	SourceUrl:    "SourceUrl",
+	Type:         "Type",
+	Urls:         "Urls",
+	Width:        "Width",
+}
+
+// VideoFields holds the selector field names of Video, including
+// the fields of the types it holds.
+var VideoFields = struct {
+	AdvertisingId                        string
+	CreationTime                         string
+	Dimensions                           string
+	DurationMillis                       string
+	FileSize                             string
+	Height                               string
+	IndustryStandardCommercialIdentifier string
+	MediaId                              string
+	MimeType                             string
+	Name                                 string
+	ReadyToPlayOnTheWeb                  string
+	ReferenceId                          string
+	SourceUrl                            string
+	StreamingUrl                         string
+	Type                                 string
+	Urls                                 string
+	Width                                string
+	YouTubeVideoIdString                 string
+}{
+	AdvertisingId:                        "AdvertisingId",
+	CreationTime:                         "CreationTime",
+	Dimensions:                           "Dimensions",
+	DurationMillis:                       "DurationMillis",
+	FileSize:                             "FileSize",
+	Height:                               "Height",
+	IndustryStandardCommercialIdentifier: "IndustryStandardCommercialIdentifier",
+	MediaId:                              "MediaId",
+	MimeType:                             "MimeType",
+	Name:                                 "Name",
+	ReadyToPlayOnTheWeb:                  "ReadyToPlayOnTheWeb",
+	ReferenceId:                          "ReferenceId",
+	SourceUrl:                            "SourceUrl",
+	StreamingUrl:                         "StreamingUrl",
+	Type:                                 "Type",
+	Urls:                                 "Urls",
+	Width:                                "Width",
+	YouTubeVideoIdString:                 "YouTubeVideoIdString",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "ServiceSelector", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"Audio": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Filter: "MediaId", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, Filter: "Type", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, Filter: "ReferenceId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, Filter: "MimeType", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, Filter: "SourceUrl", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, Filter: "FileSize", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, Filter: "CreationTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+		{Type: "Audio", Name: "DurationMillis", Selectors: []string{"DurationMillis"}, Filter: "DurationMillis"},
+		{Type: "Audio", Name: "StreamingUrl", Selectors: []string{"StreamingUrl"}},
+		{Type: "Audio", Name: "ReadyToPlayOnTheWeb", Selectors: []string{"ReadyToPlayOnTheWeb"}},
+	},
+	"Dimensions": {
+		{Type: "Dimensions", Name: "Width", Selectors: []string{"Width"}, Filter: "Width", Required: true},
+		{Type: "Dimensions", Name: "Height", Selectors: []string{"Height"}, Filter: "Height", Required: true},
+	},
+	"Image": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Filter: "MediaId", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, Filter: "Type", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, Filter: "ReferenceId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, Filter: "MimeType", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, Filter: "SourceUrl", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, Filter: "FileSize", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, Filter: "CreationTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+		{Type: "Image", Name: "Data"},
+	},
+	"Media": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Filter: "MediaId", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, Filter: "Type", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, Filter: "ReferenceId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, Filter: "MimeType", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, Filter: "SourceUrl", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, Filter: "FileSize", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, Filter: "CreationTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+	},
+	"MediaBundle": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Filter: "MediaId", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, Filter: "Type", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, Filter: "ReferenceId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, Filter: "MimeType", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, Filter: "SourceUrl", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, Filter: "FileSize", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, Filter: "CreationTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+		{Type: "MediaBundle", Name: "Data"},
+		{Type: "MediaBundle", Name: "MediaBundleUrl", ReadOnly: true},
+		{Type: "MediaBundle", Name: "EntryPoint"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"Video": {
+		{Type: "Media", Name: "MediaId", Selectors: []string{"MediaId"}, Filter: "MediaId", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Type_", Selectors: []string{"Type"}, Filter: "Type", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "ReferenceId", Selectors: []string{"ReferenceId"}, Filter: "ReferenceId", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Dimensions", Selectors: []string{"Dimensions"}},
+		{Type: "Media", Name: "Urls", Selectors: []string{"Urls"}, ReadOnly: true},
+		{Type: "Media", Name: "MimeType", Selectors: []string{"MimeType"}, Filter: "MimeType", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "SourceUrl", Selectors: []string{"SourceUrl"}, Filter: "SourceUrl", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "Name", Selectors: []string{"Name"}, Filter: "Name"},
+		{Type: "Media", Name: "FileSize", Selectors: []string{"FileSize"}, Filter: "FileSize", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "CreationTime", Selectors: []string{"CreationTime"}, Filter: "CreationTime", ReadOnly: true, ReadOnlyOperators: []string{"REMOVE", "SET"}},
+		{Type: "Media", Name: "MediaType"},
+		{Type: "Video", Name: "DurationMillis", Selectors: []string{"DurationMillis"}, Filter: "DurationMillis"},
+		{Type: "Video", Name: "StreamingUrl", Selectors: []string{"StreamingUrl"}},
+		{Type: "Video", Name: "ReadyToPlayOnTheWeb", Selectors: []string{"ReadyToPlayOnTheWeb"}},
+		{Type: "Video", Name: "IndustryStandardCommercialIdentifier", Selectors: []string{"IndustryStandardCommercialIdentifier"}, Filter: "IndustryStandardCommercialIdentifier"},
+		{Type: "Video", Name: "AdvertisingId", Selectors: []string{"AdvertisingId"}, Filter: "AdvertisingId"},
+		{Type: "Video", Name: "YouTubeVideoIdString", Selectors: []string{"YouTubeVideoIdString"}, Filter: "YouTubeVideoIdString"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
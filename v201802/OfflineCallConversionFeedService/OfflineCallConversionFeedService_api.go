@@ -0,0 +1,76 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineCallConversionFeedService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// OfflineCallConversionFeedServiceAPI is the interface of the OfflineCallConversionFeedService operations. It is
+// implemented by OfflineCallConversionFeedServiceInterface and, for tests, by FakeOfflineCallConversionFeedService.
+type OfflineCallConversionFeedServiceAPI interface {
+	Mutate(request *Mutate) (*MutateResponse, error)
+}
+
+var _ OfflineCallConversionFeedServiceAPI = (*OfflineCallConversionFeedServiceInterface)(nil)
+var _ OfflineCallConversionFeedServiceAPI = (*FakeOfflineCallConversionFeedService)(nil)
+
+// FakeCall is a call recorded by FakeOfflineCallConversionFeedService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Mutate".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeOfflineCallConversionFeedService is a programmable OfflineCallConversionFeedServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeOfflineCallConversionFeedService struct {
+	MutateFunc func(request *Mutate) (*MutateResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeOfflineCallConversionFeedService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeOfflineCallConversionFeedService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeOfflineCallConversionFeedService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeOfflineCallConversionFeedService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeOfflineCallConversionFeedService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeOfflineCallConversionFeedService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
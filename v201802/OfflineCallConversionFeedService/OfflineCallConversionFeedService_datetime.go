@@ -0,0 +1,37 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineCallConversionFeedService
+
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// GetCallStartTime returns the CallStartTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *OfflineCallConversionFeed) GetCallStartTime(loc *time.Location) (time.Time, error) {
+	if t.CallStartTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.CallStartTime, loc)
+}
+
+// SetCallStartTime sets the CallStartTime of t to tm formatted in loc.
+func (t *OfflineCallConversionFeed) SetCallStartTime(tm time.Time, loc *time.Location) {
+	t.CallStartTime = String(datetime.FormatDateTime(tm, loc))
+}
+
+// GetConversionTime returns the ConversionTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *OfflineCallConversionFeed) GetConversionTime(loc *time.Location) (time.Time, error) {
+	if t.ConversionTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.ConversionTime, loc)
+}
+
+// SetConversionTime sets the ConversionTime of t to tm formatted in loc.
+func (t *OfflineCallConversionFeed) SetConversionTime(tm time.Time, loc *time.Location) {
+	t.ConversionTime = String(datetime.FormatDateTime(tm, loc))
+}
@@ -0,0 +1,9 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineCallConversionFeedService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *OfflineCallConversionFeed) *OfflineCallConversionFeedOperation {
+	op := OperatorADD
+	return &OfflineCallConversionFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
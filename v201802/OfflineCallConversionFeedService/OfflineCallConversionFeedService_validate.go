@@ -0,0 +1,112 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineCallConversionFeedService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks OfflineCallConversionFeedOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *OfflineCallConversionFeedOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	v.NotEmpty(validate.Field(path, "operations"), t.Operations)
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "ADD")
+		}
+	}
+}
+
+func (t *OfflineCallConversionFeed) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OfflineCallConversionFeed)
+	}
+	v.Required(validate.Field(path, "callerId"), t.CallerId != nil)
+	if t.CallerId != nil {
+		v.StringLength(validate.Field(path, "callerId"), *t.CallerId, 1, 30, true, false)
+	}
+	v.Required(validate.Field(path, "callStartTime"), t.CallStartTime != nil)
+	if t.CallStartTime != nil {
+		v.DateTime(validate.Field(path, "callStartTime"), *t.CallStartTime)
+	}
+	v.Required(validate.Field(path, "conversionName"), t.ConversionName != nil)
+	if t.ConversionName != nil {
+		v.StringLength(validate.Field(path, "conversionName"), *t.ConversionName, 1, 100, true, false)
+	}
+	if t.ConversionTime != nil {
+		v.DateTime(validate.Field(path, "conversionTime"), *t.ConversionTime)
+	}
+	if t.ConversionValue != nil {
+		v.AtLeast(validate.Field(path, "conversionValue"), float64(*t.ConversionValue), 0.)
+	}
+	if t.ConversionCurrencyCode != nil {
+		v.StringLength(validate.Field(path, "conversionCurrencyCode"), *t.ConversionCurrencyCode, 3, 3, true, false)
+	}
+}
+
+func (t *OfflineCallConversionFeedOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OfflineCallConversionFeedOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (o *OfflineCallConversionFeedOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *OfflineCallConversionFeedOperation) operandID() string {
+	return ""
+}
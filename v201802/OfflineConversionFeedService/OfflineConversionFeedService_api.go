@@ -0,0 +1,76 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineConversionFeedService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// OfflineConversionFeedServiceAPI is the interface of the OfflineConversionFeedService operations. It is
+// implemented by OfflineConversionFeedServiceInterface and, for tests, by FakeOfflineConversionFeedService.
+type OfflineConversionFeedServiceAPI interface {
+	Mutate(request *Mutate) (*MutateResponse, error)
+}
+
+var _ OfflineConversionFeedServiceAPI = (*OfflineConversionFeedServiceInterface)(nil)
+var _ OfflineConversionFeedServiceAPI = (*FakeOfflineConversionFeedService)(nil)
+
+// FakeCall is a call recorded by FakeOfflineConversionFeedService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Mutate".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeOfflineConversionFeedService is a programmable OfflineConversionFeedServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeOfflineConversionFeedService struct {
+	MutateFunc func(request *Mutate) (*MutateResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeOfflineConversionFeedService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeOfflineConversionFeedService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeOfflineConversionFeedService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Mutate records the call and delegates to MutateFunc.
+func (f *FakeOfflineConversionFeedService) Mutate(request *Mutate) (*MutateResponse, error) {
+	f.record("Mutate", request)
+	if f.MutateFunc == nil {
+		return nil, fmt.Errorf("FakeOfflineConversionFeedService.MutateFunc is not set")
+	}
+	return f.MutateFunc(request)
+}
+
+// MutateCalls returns the requests of the recorded Mutate calls.
+func (f *FakeOfflineConversionFeedService) MutateCalls() []*Mutate {
+	var requests []*Mutate
+	for _, c := range f.Calls() {
+		if c.Method == "Mutate" {
+			requests = append(requests, c.Request.(*Mutate))
+		}
+	}
+	return requests
+}
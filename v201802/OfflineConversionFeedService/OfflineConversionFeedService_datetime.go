@@ -0,0 +1,23 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineConversionFeedService
+
+import (
+	"time"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// GetConversionTime returns the ConversionTime of t, or the zero time.Time if it is
+// not set. loc is used if it has no time zone ID.
+func (t *OfflineConversionFeed) GetConversionTime(loc *time.Location) (time.Time, error) {
+	if t.ConversionTime == nil {
+		return time.Time{}, nil
+	}
+	return datetime.ParseDateTime(*t.ConversionTime, loc)
+}
+
+// SetConversionTime sets the ConversionTime of t to tm formatted in loc.
+func (t *OfflineConversionFeed) SetConversionTime(tm time.Time, loc *time.Location) {
+	t.ConversionTime = String(datetime.FormatDateTime(tm, loc))
+}
@@ -0,0 +1,120 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineConversionFeedService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// OfflineConversionFeedFields holds the selector field names of OfflineConversionFeed, including
+// the fields of the types it holds.
+var OfflineConversionFeedFields = struct {
+	ConversionCurrencyCode string
+	ConversionName         string
+	ConversionTime         string
+	ConversionValue        string
+	GoogleClickId          string
+}{
+	ConversionCurrencyCode: "ConversionCurrencyCode",
+	ConversionName:         "ConversionName",
+	ConversionTime:         "ConversionTime",
+	ConversionValue:        "ConversionValue",
+	GoogleClickId:          "GoogleClickId",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"OfflineConversionFeed": {
+		{Type: "OfflineConversionFeed", Name: "GoogleClickId", Selectors: []string{"GoogleClickId"}, Filter: "GoogleClickId", Required: true},
+		{Type: "OfflineConversionFeed", Name: "ConversionName", Selectors: []string{"ConversionName"}, Filter: "ConversionName", Required: true},
+		{Type: "OfflineConversionFeed", Name: "ConversionTime", Selectors: []string{"ConversionTime"}, Filter: "ConversionTime", Required: true},
+		{Type: "OfflineConversionFeed", Name: "ConversionValue", Selectors: []string{"ConversionValue"}, Filter: "ConversionValue"},
+		{Type: "OfflineConversionFeed", Name: "ConversionCurrencyCode", Selectors: []string{"ConversionCurrencyCode"}, Filter: "ConversionCurrencyCode"},
+		{Type: "OfflineConversionFeed", Name: "ExternalAttributionCredit"},
+		{Type: "OfflineConversionFeed", Name: "ExternalAttributionModel"},
+	},
+	"OfflineConversionFeedOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "OfflineConversionFeedOperation", Name: "Operand", Required: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineConversionFeedService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *OfflineConversionFeed) *OfflineConversionFeedOperation {
+	op := OperatorADD
+	return &OfflineConversionFeedOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -1569,384 +1569,322 @@ const (
 )
 
 type AdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdErrorReason `xml:"reason,omitempty"`
+	Reason *AdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CollectionSizeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CollectionSizeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CollectionSizeErrorReason `xml:"reason,omitempty"`
+	Reason *CollectionSizeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateRange struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateRange"`
-
 	//
 	// the lower bound of this date range, inclusive.
 	//
-	Min string `xml:"min,omitempty"`
+	Min *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// the upper bound of this date range, inclusive.
 	//
-	Max string `xml:"max,omitempty"`
+	Max *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ListReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue"`
-
 	//
 	// Indicates that this instance is a subtype of ListReturnValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ListReturnValueType string `xml:"ListReturnValue.Type,omitempty"`
+	ListReturnValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ListReturnValue.Type,omitempty" json:"listReturnValueType,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NotWhitelistedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotWhitelistedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotWhitelistedErrorReason `xml:"reason,omitempty"`
+	Reason *NotWhitelistedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type Operation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation"`
-
 	//
 	// Operator.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *Operator `xml:"operator,omitempty"`
+	Operator *Operator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Operation.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	OperationType string `xml:"Operation.Type,omitempty"`
+	OperationType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Operation.Type,omitempty" json:"operationType,omitempty"`
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OrderBy struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OrderBy"`
-
 	//
 	// The field to sort the results on.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The order to sort the results on. The default sort order is {@link SortOrder#ASCENDING}.
 	//
-	SortOrder *SortOrder `xml:"sortOrder,omitempty"`
+	SortOrder *SortOrder `xml:"https://adwords.google.com/api/adwords/cm/v201802 sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
 type Page struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page"`
-
 	//
 	// Total number of entries in the result that this page is a part of.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Page.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	PageType string `xml:"Page.Type,omitempty"`
+	PageType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Page.Type,omitempty" json:"pageType,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Predicate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Predicate"`
-
 	//
 	// The field by which to filter the returned data. Possible values are marked Filterable on
 	// the entity's reference page. For example, for predicates for the
@@ -1954,13 +1892,13 @@ type Predicate struct {
 	// {@link Campaign} reference page.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// The operator to use for filtering the data returned.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operator *PredicateOperator `xml:"operator,omitempty"`
+	Operator *PredicateOperator `xml:"https://adwords.google.com/api/adwords/cm/v201802 operator,omitempty" json:"operator,omitempty"`
 
 	//
 	// The values by which to filter the field. The {@link Operator#CONTAINS_ALL},
@@ -1969,101 +1907,85 @@ type Predicate struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Values []string `xml:"values,omitempty"`
+	Values []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 values,omitempty" json:"values,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RegionCodeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RegionCodeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RegionCodeErrorReason `xml:"reason,omitempty"`
+	Reason *RegionCodeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Selector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Selector"`
-
 	//
 	// List of fields to select.
 	// <a href="/adwords/api/docs/appendix/selectorfields">Possible values</a>
@@ -2074,13 +1996,13 @@ type Selector struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Fields []string `xml:"fields,omitempty"`
+	Fields []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fields,omitempty" json:"fields,omitempty"`
 
 	//
 	// Specifies how an entity (eg. adgroup, campaign, criterion, ad) should be filtered.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Predicates []*Predicate `xml:"predicates,omitempty"`
+	Predicates []*Predicate `xml:"https://adwords.google.com/api/adwords/cm/v201802 predicates,omitempty" json:"predicates,omitempty"`
 
 	//
 	// Range of dates for which you want to include data. If this value is omitted,
@@ -2089,58 +2011,54 @@ type Selector struct {
 	// service. For all other services, it is ignored.</p>
 	// <span class="constraint DateRangeWithinRange">This range must be contained within the range [19700101, 20380101].</span>
 	//
-	DateRange *DateRange `xml:"dateRange,omitempty"`
+	DateRange *DateRange `xml:"https://adwords.google.com/api/adwords/cm/v201802 dateRange,omitempty" json:"dateRange,omitempty"`
 
 	//
 	// The fields on which you want to sort, and the sort order. The order in the list is
 	// significant: The first element in the list indicates the primary sort order, the next
 	// specifies the secondary sort order and so on.
 	//
-	Ordering []*OrderBy `xml:"ordering,omitempty"`
+	Ordering []*OrderBy `xml:"https://adwords.google.com/api/adwords/cm/v201802 ordering,omitempty" json:"ordering,omitempty"`
 
 	//
 	// Pagination information.
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/cm/v201802 paging,omitempty" json:"paging,omitempty"`
 }
 
 type SelectorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SelectorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by enum.
 	//
-	Reason *SelectorErrorReason `xml:"reason,omitempty"`
+	Reason *SelectorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -2148,12 +2066,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -2161,57 +2079,53 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 //
@@ -2449,22 +2363,22 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ServiceSelector *Selector `xml:"serviceSelector,omitempty"`
+	ServiceSelector *Selector `xml:"https://adwords.google.com/api/adwords/rm/v201802 serviceSelector,omitempty" json:"serviceSelector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 getResponse" json:"-"`
 
-	Rval *OfflineDataUploadPage `xml:"rval,omitempty"`
+	Rval *OfflineDataUploadPage `xml:"https://adwords.google.com/api/adwords/rm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Mutate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutate"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutate" json:"-"`
 
 	//
 	// <span class="constraint CollectionSize">The minimum size of this collection is 1.</span>
@@ -2472,132 +2386,114 @@ type Mutate struct {
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	// <span class="constraint SupportedOperators">The following {@link Operator}s are supported: ADD, SET.</span>
 	//
-	Operations []*OfflineDataUploadOperation `xml:"operations,omitempty"`
+	Operations []*OfflineDataUploadOperation `xml:"https://adwords.google.com/api/adwords/rm/v201802 operations,omitempty" json:"operations,omitempty"`
 }
 
 type MutateResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutateResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 mutateResponse" json:"-"`
 
-	Rval *OfflineDataUploadReturnValue `xml:"rval,omitempty"`
+	Rval *OfflineDataUploadReturnValue `xml:"https://adwords.google.com/api/adwords/rm/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type CurrencyCodeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 CurrencyCodeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CurrencyCodeErrorReason `xml:"reason,omitempty"`
+	Reason *CurrencyCodeErrorReason `xml:"https://adwords.google.com/api/adwords/rm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FirstPartyUploadMetadata struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 FirstPartyUploadMetadata"`
-
 	*StoreSalesUploadCommonMetadata
 }
 
 type MoneyWithCurrency struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 MoneyWithCurrency"`
-
 	*ComparableValue
 
 	//
 	// The amount of money.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	Money *Money `xml:"money,omitempty"`
+	Money *Money `xml:"https://adwords.google.com/api/adwords/rm/v201802 money,omitempty" json:"money,omitempty"`
 
 	//
 	// Currency code.
 	// <span class="constraint StringLength">The length of this string should be between 3 and 3, inclusive, (trimmed).</span>
 	//
-	CurrencyCode string `xml:"currencyCode,omitempty"`
+	CurrencyCode *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 currencyCode,omitempty" json:"currencyCode,omitempty"`
 }
 
 type OfflineData struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 OfflineData"`
-
-	StoreSalesTransaction *StoreSalesTransaction `xml:"StoreSalesTransaction,omitempty"`
+	StoreSalesTransaction *StoreSalesTransaction `xml:"https://adwords.google.com/api/adwords/rm/v201802 StoreSalesTransaction,omitempty" json:"storeSalesTransaction,omitempty"`
 }
 
 type OfflineDataUpload struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 OfflineDataUpload"`
-
 	//
 	// User specified upload id.
 	// <span class="constraint Selectable">This field can be selected using the value "ExternalUploadId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	ExternalUploadId int64 `xml:"externalUploadId,omitempty"`
+	ExternalUploadId *int64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 externalUploadId,omitempty" json:"externalUploadId,omitempty"`
 
 	//
 	// Type of this upload.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	UploadType *OfflineDataUploadType `xml:"uploadType,omitempty"`
+	UploadType *OfflineDataUploadType `xml:"https://adwords.google.com/api/adwords/rm/v201802 uploadType,omitempty" json:"uploadType,omitempty"`
 
 	//
 	// Status of this upload.
 	// <span class="constraint Selectable">This field can be selected using the value "UploadStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
 	//
-	UploadStatus *OfflineDataUploadStatus `xml:"uploadStatus,omitempty"`
+	UploadStatus *OfflineDataUploadStatus `xml:"https://adwords.google.com/api/adwords/rm/v201802 uploadStatus,omitempty" json:"uploadStatus,omitempty"`
 
 	//
 	// Metadata for this upload.
 	//
-	UploadMetadata *UploadMetadata `xml:"uploadMetadata,omitempty"`
+	UploadMetadata *UploadMetadata `xml:"https://adwords.google.com/api/adwords/rm/v201802 uploadMetadata,omitempty" json:"uploadMetadata,omitempty"`
 
 	//
 	// List of offline data in this upload. For AdWords API, each offlineDataList can have at most 50
 	// OfflineData.
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	OfflineDataList []*OfflineData `xml:"offlineDataList,omitempty"`
+	OfflineDataList []*OfflineData `xml:"https://adwords.google.com/api/adwords/rm/v201802 offlineDataList,omitempty" json:"offlineDataList,omitempty"`
 
 	//
 	// Processing failure reason for get, if status is FAILURE. Used for upload level failures.
 	// <span class="constraint Selectable">This field can be selected using the value "FailureReason".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API for the following {@link Operator}s: ADD.</span>
 	//
-	FailureReason *OfflineDataUploadFailureReason `xml:"failureReason,omitempty"`
+	FailureReason *OfflineDataUploadFailureReason `xml:"https://adwords.google.com/api/adwords/rm/v201802 failureReason,omitempty" json:"failureReason,omitempty"`
 }
 
 type OfflineDataUploadError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 OfflineDataUploadError"`
-
 	*ApiError
 
-	Reason *OfflineDataUploadErrorReason `xml:"reason,omitempty"`
+	Reason *OfflineDataUploadErrorReason `xml:"https://adwords.google.com/api/adwords/rm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OfflineDataUploadOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 OfflineDataUploadOperation"`
-
 	*Operation
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operand *OfflineDataUpload `xml:"operand,omitempty"`
+	Operand *OfflineDataUpload `xml:"https://adwords.google.com/api/adwords/rm/v201802 operand,omitempty" json:"operand,omitempty"`
 }
 
 type OfflineDataUploadPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 OfflineDataUploadPage"`
-
 	*Page
 
-	Entries []*OfflineDataUpload `xml:"entries,omitempty"`
+	Entries []*OfflineDataUpload `xml:"https://adwords.google.com/api/adwords/rm/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type OfflineDataUploadReturnValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 OfflineDataUploadReturnValue"`
-
 	*ListReturnValue
 
-	Value []*OfflineDataUpload `xml:"value,omitempty"`
+	Value []*OfflineDataUpload `xml:"https://adwords.google.com/api/adwords/rm/v201802 value,omitempty" json:"value,omitempty"`
 
 	//
 	// In v201710 and previous, this field stores a list of operation level errors. Starting in
@@ -2607,46 +2503,42 @@ type OfflineDataUploadReturnValue struct {
 	// information about partial failure, see:
 	// https://developers.google.com/adwords/api/docs/guides/partial-failure
 	//
-	PartialFailureErrors []*ApiError `xml:"partialFailureErrors,omitempty"`
+	PartialFailureErrors []*ApiError `xml:"https://adwords.google.com/api/adwords/rm/v201802 partialFailureErrors,omitempty" json:"partialFailureErrors,omitempty"`
 }
 
 type StoreSalesTransaction struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 StoreSalesTransaction"`
-
 	//
 	// List of UserIdentifiers.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	UserIdentifiers []*UserIdentifier `xml:"userIdentifiers,omitempty"`
+	UserIdentifiers []*UserIdentifier `xml:"https://adwords.google.com/api/adwords/rm/v201802 userIdentifiers,omitempty" json:"userIdentifiers,omitempty"`
 
 	//
 	// Transaction time.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	TransactionTime string `xml:"transactionTime,omitempty"`
+	TransactionTime *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 transactionTime,omitempty" json:"transactionTime,omitempty"`
 
 	//
 	// Transaction amount. We support the ISO 4217 3-character currency code. For example: USD, EUR.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	TransactionAmount *MoneyWithCurrency `xml:"transactionAmount,omitempty"`
+	TransactionAmount *MoneyWithCurrency `xml:"https://adwords.google.com/api/adwords/rm/v201802 transactionAmount,omitempty" json:"transactionAmount,omitempty"`
 
 	//
 	// Conversion name configured while creating ConversionType in AdWords.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ConversionName string `xml:"conversionName,omitempty"`
+	ConversionName *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 conversionName,omitempty" json:"conversionName,omitempty"`
 }
 
 type StoreSalesUploadCommonMetadata struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 StoreSalesUploadCommonMetadata"`
-
 	//
 	// This is the fraction of overall sales which you can associate with a customer loyalty program.
 	// The fraction needs to be between 0 and 1 (excluding 0).
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	LoyaltyRate float64 `xml:"loyaltyRate,omitempty"`
+	LoyaltyRate *float64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 loyaltyRate,omitempty" json:"loyaltyRate,omitempty"`
 
 	//
 	// This is the ratio of sales you?re uploading compared to the overall sales that you can
@@ -2655,81 +2547,75 @@ type StoreSalesUploadCommonMetadata struct {
 	// would be 0.5 (excluding 0).
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	TransactionUploadRate float64 `xml:"transactionUploadRate,omitempty"`
+	TransactionUploadRate *float64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 transactionUploadRate,omitempty" json:"transactionUploadRate,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of StoreSalesUploadCommonMetadata.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	StoreSalesUploadCommonMetadataType string `xml:"StoreSalesUploadCommonMetadata.Type,omitempty"`
+	StoreSalesUploadCommonMetadataType *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 StoreSalesUploadCommonMetadata.Type,omitempty" json:"storeSalesUploadCommonMetadataType,omitempty"`
 }
 
 type ThirdPartyUploadMetadata struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 ThirdPartyUploadMetadata"`
-
 	*StoreSalesUploadCommonMetadata
 
 	//
 	// Advertiser upload time to partner.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	AdvertiserUploadTime string `xml:"advertiserUploadTime,omitempty"`
+	AdvertiserUploadTime *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 advertiserUploadTime,omitempty" json:"advertiserUploadTime,omitempty"`
 
 	//
 	// The fraction of transactions that are valid. Invalid transactions may include invalid format,
 	// values. Range (0.0 to 1.0]
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	ValidTransactionRate float64 `xml:"validTransactionRate,omitempty"`
+	ValidTransactionRate *float64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 validTransactionRate,omitempty" json:"validTransactionRate,omitempty"`
 
 	//
 	// The fraction of valid transactions that are matched to an external user id on the partner side.
 	// Range (0.0 to 1.0]
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	PartnerMatchRate float64 `xml:"partnerMatchRate,omitempty"`
+	PartnerMatchRate *float64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 partnerMatchRate,omitempty" json:"partnerMatchRate,omitempty"`
 
 	//
 	// The fraction of valid transactions that are uploaded by the partner to Google. Range (0.0 to
 	// 1.0]
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	PartnerUploadRate float64 `xml:"partnerUploadRate,omitempty"`
+	PartnerUploadRate *float64 `xml:"https://adwords.google.com/api/adwords/rm/v201802 partnerUploadRate,omitempty" json:"partnerUploadRate,omitempty"`
 
 	//
 	// Indicates the version of partnerIds to be used for uploads.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	BridgeMapVersionId string `xml:"bridgeMapVersionId,omitempty"`
+	BridgeMapVersionId *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 bridgeMapVersionId,omitempty" json:"bridgeMapVersionId,omitempty"`
 
 	//
 	// The ID of the third party partner uploading the transaction feed.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	PartnerId int32 `xml:"partnerId,omitempty"`
+	PartnerId *int32 `xml:"https://adwords.google.com/api/adwords/rm/v201802 partnerId,omitempty" json:"partnerId,omitempty"`
 }
 
 type UploadMetadata struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UploadMetadata"`
-
-	StoreSalesUploadCommonMetadata *StoreSalesUploadCommonMetadata `xml:"StoreSalesUploadCommonMetadata,omitempty"`
+	StoreSalesUploadCommonMetadata *StoreSalesUploadCommonMetadata `xml:"https://adwords.google.com/api/adwords/rm/v201802 StoreSalesUploadCommonMetadata,omitempty" json:"storeSalesUploadCommonMetadata,omitempty"`
 }
 
 type UserIdentifier struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/rm/v201802 UserIdentifier"`
-
 	//
 	// Type of user identifier.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	UserIdentifierType *OfflineDataUploadUserIdentifierType `xml:"userIdentifierType,omitempty"`
+	UserIdentifierType *OfflineDataUploadUserIdentifierType `xml:"https://adwords.google.com/api/adwords/rm/v201802 userIdentifierType,omitempty" json:"userIdentifierType,omitempty"`
 
 	//
 	// Value of identifier. Hashed using SHA-256 if needed.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/rm/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type OfflineDataUploadServiceInterface struct {
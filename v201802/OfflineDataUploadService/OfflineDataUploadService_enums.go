@@ -0,0 +1,2032 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineDataUploadService
+
+import "fmt"
+
+// AdErrorReasonValues returns all values of AdErrorReason.
+func AdErrorReasonValues() []AdErrorReason {
+	return []AdErrorReason{
+		AdErrorReasonAD_CUSTOMIZERS_NOT_SUPPORTED_FOR_AD_TYPE,
+		AdErrorReasonAPPROXIMATELY_TOO_LONG,
+		AdErrorReasonAPPROXIMATELY_TOO_SHORT,
+		AdErrorReasonBAD_SNIPPET,
+		AdErrorReasonCANNOT_MODIFY_AD,
+		AdErrorReasonCANNOT_SET_BUSINESS_NAME_IF_URL_SET,
+		AdErrorReasonCANNOT_SET_FIELD,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_ORIGIN_AD_ID_SET,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_AD_ID_SET_FOR_SHARING,
+		AdErrorReasonCANNOT_SET_ALLOW_FLEXIBLE_COLOR_FALSE,
+		AdErrorReasonCANNOT_SET_COLOR_CONTROL_WHEN_NATIVE_FORMAT_SETTING,
+		AdErrorReasonCANNOT_SET_URL,
+		AdErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_TRACKING_URL_TEMPLATE,
+		AdErrorReasonCANNOT_SET_WITH_URL_DATA,
+		AdErrorReasonCANNOT_USE_AD_SUBCLASS_FOR_OPERATOR,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_MOBILEADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_ADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_REDIRECT_ADS,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE_FOR_UPDATING_BEACON_URL,
+		AdErrorReasonDIMENSION_ALREADY_IN_UNION,
+		AdErrorReasonDIMENSION_MUST_BE_SET,
+		AdErrorReasonDIMENSION_NOT_IN_UNION,
+		AdErrorReasonDISPLAY_URL_CANNOT_BE_SPECIFIED,
+		AdErrorReasonDOMESTIC_PHONE_NUMBER_FORMAT,
+		AdErrorReasonEMERGENCY_PHONE_NUMBER,
+		AdErrorReasonEMPTY_FIELD,
+		AdErrorReasonFEED_ATTRIBUTE_MUST_HAVE_MAPPING_FOR_TYPE_ID,
+		AdErrorReasonFEED_ATTRIBUTE_MAPPING_TYPE_MISMATCH,
+		AdErrorReasonILLEGAL_AD_CUSTOMIZER_TAG_USE,
+		AdErrorReasonILLEGAL_TAG_USE,
+		AdErrorReasonINCONSISTENT_DIMENSIONS,
+		AdErrorReasonINCONSISTENT_STATUS_IN_TEMPLATE_UNION,
+		AdErrorReasonINCORRECT_LENGTH,
+		AdErrorReasonINELIGIBLE_FOR_UPGRADE,
+		AdErrorReasonINVALID_AD_ADDRESS_CAMPAIGN_TARGET,
+		AdErrorReasonINVALID_AD_TYPE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_IMAGE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_TEXT,
+		AdErrorReasonINVALID_CALL_TO_ACTION_TEXT,
+		AdErrorReasonINVALID_CHARACTER_FOR_URL,
+		AdErrorReasonINVALID_COUNTRY_CODE,
+		AdErrorReasonINVALID_DSA_URL_TAG,
+		AdErrorReasonINVALID_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonINVALID_INPUT,
+		AdErrorReasonINVALID_MARKUP_LANGUAGE,
+		AdErrorReasonINVALID_MOBILE_CARRIER,
+		AdErrorReasonINVALID_MOBILE_CARRIER_TARGET,
+		AdErrorReasonINVALID_NUMBER_OF_ELEMENTS,
+		AdErrorReasonINVALID_PHONE_NUMBER_FORMAT,
+		AdErrorReasonINVALID_RICH_MEDIA_CERTIFIED_VENDOR_FORMAT_ID,
+		AdErrorReasonINVALID_TEMPLATE_DATA,
+		AdErrorReasonINVALID_TEMPLATE_ELEMENT_FIELD_TYPE,
+		AdErrorReasonINVALID_TEMPLATE_ID,
+		AdErrorReasonLINE_TOO_WIDE,
+		AdErrorReasonMISSING_AD_CUSTOMIZER_MAPPING,
+		AdErrorReasonMISSING_ADDRESS_COMPONENT,
+		AdErrorReasonMISSING_ADVERTISEMENT_NAME,
+		AdErrorReasonMISSING_BUSINESS_NAME,
+		AdErrorReasonMISSING_DESCRIPTION1,
+		AdErrorReasonMISSING_DESCRIPTION2,
+		AdErrorReasonMISSING_DESTINATION_URL_TAG,
+		AdErrorReasonMISSING_LANDING_PAGE_URL_TAG,
+		AdErrorReasonMISSING_DIMENSION,
+		AdErrorReasonMISSING_DISPLAY_URL,
+		AdErrorReasonMISSING_HEADLINE,
+		AdErrorReasonMISSING_HEIGHT,
+		AdErrorReasonMISSING_IMAGE,
+		AdErrorReasonMISSING_MARKETING_IMAGE_OR_PRODUCT_VIDEOS,
+		AdErrorReasonMISSING_MARKUP_LANGUAGES,
+		AdErrorReasonMISSING_MOBILE_CARRIER,
+		AdErrorReasonMISSING_PHONE,
+		AdErrorReasonMISSING_REQUIRED_TEMPLATE_FIELDS,
+		AdErrorReasonMISSING_TEMPLATE_FIELD_VALUE,
+		AdErrorReasonMISSING_TEXT,
+		AdErrorReasonMISSING_VISIBLE_URL,
+		AdErrorReasonMISSING_WIDTH,
+		AdErrorReasonMULTIPLE_DISTINCT_FEEDS_UNSUPPORTED,
+		AdErrorReasonMUST_USE_TEMP_AD_UNION_ID_ON_ADD,
+		AdErrorReasonTOO_LONG,
+		AdErrorReasonTOO_SHORT,
+		AdErrorReasonUNION_DIMENSIONS_CANNOT_CHANGE,
+		AdErrorReasonUNKNOWN_ADDRESS_COMPONENT,
+		AdErrorReasonUNKNOWN_FIELD_NAME,
+		AdErrorReasonUNKNOWN_UNIQUE_NAME,
+		AdErrorReasonUNSUPPORTED_DIMENSIONS,
+		AdErrorReasonURL_INVALID_SCHEME,
+		AdErrorReasonURL_INVALID_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_MALFORMED,
+		AdErrorReasonURL_NO_HOST,
+		AdErrorReasonURL_NOT_EQUIVALENT,
+		AdErrorReasonURL_HOST_NAME_TOO_LONG,
+		AdErrorReasonURL_NO_SCHEME,
+		AdErrorReasonURL_NO_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_PATH_NOT_ALLOWED,
+		AdErrorReasonURL_PORT_NOT_ALLOWED,
+		AdErrorReasonURL_QUERY_NOT_ALLOWED,
+		AdErrorReasonURL_SCHEME_BEFORE_DSA_TAG,
+		AdErrorReasonURL_SCHEME_BEFORE_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonUSER_DOES_NOT_HAVE_ACCESS_TO_TEMPLATE,
+		AdErrorReasonINCONSISTENT_EXPANDABLE_SETTINGS,
+		AdErrorReasonINVALID_FORMAT,
+		AdErrorReasonINVALID_FIELD_TEXT,
+		AdErrorReasonELEMENT_NOT_PRESENT,
+		AdErrorReasonIMAGE_ERROR,
+		AdErrorReasonVALUE_NOT_IN_RANGE,
+		AdErrorReasonFIELD_NOT_PRESENT,
+		AdErrorReasonADDRESS_NOT_COMPLETE,
+		AdErrorReasonADDRESS_INVALID,
+		AdErrorReasonVIDEO_RETRIEVAL_ERROR,
+		AdErrorReasonAUDIO_ERROR,
+		AdErrorReasonINVALID_YOUTUBE_DISPLAY_URL,
+		AdErrorReasonTOO_MANY_PRODUCT_IMAGES,
+		AdErrorReasonTOO_MANY_PRODUCT_VIDEOS,
+		AdErrorReasonINCOMPATIBLE_AD_TYPE_AND_DEVICE_PREFERENCE,
+		AdErrorReasonCALLTRACKING_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonCARRIER_SPECIFIC_SHORT_NUMBER_NOT_ALLOWED,
+		AdErrorReasonDISALLOWED_NUMBER_TYPE,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_WITH_CALLTRACKING_FOR_COUNTRY,
+		AdErrorReasonPREMIUM_RATE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonVANITY_PHONE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonINVALID_CALL_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_DISABLE_CALL_CONVERSION_AND_SET_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_SET_PATH2_WITHOUT_PATH1,
+		AdErrorReasonMISSING_DYNAMIC_SEARCH_ADS_SETTING_DOMAIN_NAME,
+		AdErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of AdErrorReason.
+func (e AdErrorReason) IsValid() bool {
+	switch e {
+	case AdErrorReasonAD_CUSTOMIZERS_NOT_SUPPORTED_FOR_AD_TYPE,
+		AdErrorReasonAPPROXIMATELY_TOO_LONG,
+		AdErrorReasonAPPROXIMATELY_TOO_SHORT,
+		AdErrorReasonBAD_SNIPPET,
+		AdErrorReasonCANNOT_MODIFY_AD,
+		AdErrorReasonCANNOT_SET_BUSINESS_NAME_IF_URL_SET,
+		AdErrorReasonCANNOT_SET_FIELD,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_ORIGIN_AD_ID_SET,
+		AdErrorReasonCANNOT_SET_FIELD_WITH_AD_ID_SET_FOR_SHARING,
+		AdErrorReasonCANNOT_SET_ALLOW_FLEXIBLE_COLOR_FALSE,
+		AdErrorReasonCANNOT_SET_COLOR_CONTROL_WHEN_NATIVE_FORMAT_SETTING,
+		AdErrorReasonCANNOT_SET_URL,
+		AdErrorReasonCANNOT_SET_WITHOUT_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_FINAL_URLS,
+		AdErrorReasonCANNOT_SET_WITH_TRACKING_URL_TEMPLATE,
+		AdErrorReasonCANNOT_SET_WITH_URL_DATA,
+		AdErrorReasonCANNOT_USE_AD_SUBCLASS_FOR_OPERATOR,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_MOBILEADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_ADS,
+		AdErrorReasonCUSTOMER_NOT_APPROVED_THIRDPARTY_REDIRECT_ADS,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE,
+		AdErrorReasonCUSTOMER_NOT_ELIGIBLE_FOR_UPDATING_BEACON_URL,
+		AdErrorReasonDIMENSION_ALREADY_IN_UNION,
+		AdErrorReasonDIMENSION_MUST_BE_SET,
+		AdErrorReasonDIMENSION_NOT_IN_UNION,
+		AdErrorReasonDISPLAY_URL_CANNOT_BE_SPECIFIED,
+		AdErrorReasonDOMESTIC_PHONE_NUMBER_FORMAT,
+		AdErrorReasonEMERGENCY_PHONE_NUMBER,
+		AdErrorReasonEMPTY_FIELD,
+		AdErrorReasonFEED_ATTRIBUTE_MUST_HAVE_MAPPING_FOR_TYPE_ID,
+		AdErrorReasonFEED_ATTRIBUTE_MAPPING_TYPE_MISMATCH,
+		AdErrorReasonILLEGAL_AD_CUSTOMIZER_TAG_USE,
+		AdErrorReasonILLEGAL_TAG_USE,
+		AdErrorReasonINCONSISTENT_DIMENSIONS,
+		AdErrorReasonINCONSISTENT_STATUS_IN_TEMPLATE_UNION,
+		AdErrorReasonINCORRECT_LENGTH,
+		AdErrorReasonINELIGIBLE_FOR_UPGRADE,
+		AdErrorReasonINVALID_AD_ADDRESS_CAMPAIGN_TARGET,
+		AdErrorReasonINVALID_AD_TYPE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_IMAGE,
+		AdErrorReasonINVALID_ATTRIBUTES_FOR_MOBILE_TEXT,
+		AdErrorReasonINVALID_CALL_TO_ACTION_TEXT,
+		AdErrorReasonINVALID_CHARACTER_FOR_URL,
+		AdErrorReasonINVALID_COUNTRY_CODE,
+		AdErrorReasonINVALID_DSA_URL_TAG,
+		AdErrorReasonINVALID_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonINVALID_INPUT,
+		AdErrorReasonINVALID_MARKUP_LANGUAGE,
+		AdErrorReasonINVALID_MOBILE_CARRIER,
+		AdErrorReasonINVALID_MOBILE_CARRIER_TARGET,
+		AdErrorReasonINVALID_NUMBER_OF_ELEMENTS,
+		AdErrorReasonINVALID_PHONE_NUMBER_FORMAT,
+		AdErrorReasonINVALID_RICH_MEDIA_CERTIFIED_VENDOR_FORMAT_ID,
+		AdErrorReasonINVALID_TEMPLATE_DATA,
+		AdErrorReasonINVALID_TEMPLATE_ELEMENT_FIELD_TYPE,
+		AdErrorReasonINVALID_TEMPLATE_ID,
+		AdErrorReasonLINE_TOO_WIDE,
+		AdErrorReasonMISSING_AD_CUSTOMIZER_MAPPING,
+		AdErrorReasonMISSING_ADDRESS_COMPONENT,
+		AdErrorReasonMISSING_ADVERTISEMENT_NAME,
+		AdErrorReasonMISSING_BUSINESS_NAME,
+		AdErrorReasonMISSING_DESCRIPTION1,
+		AdErrorReasonMISSING_DESCRIPTION2,
+		AdErrorReasonMISSING_DESTINATION_URL_TAG,
+		AdErrorReasonMISSING_LANDING_PAGE_URL_TAG,
+		AdErrorReasonMISSING_DIMENSION,
+		AdErrorReasonMISSING_DISPLAY_URL,
+		AdErrorReasonMISSING_HEADLINE,
+		AdErrorReasonMISSING_HEIGHT,
+		AdErrorReasonMISSING_IMAGE,
+		AdErrorReasonMISSING_MARKETING_IMAGE_OR_PRODUCT_VIDEOS,
+		AdErrorReasonMISSING_MARKUP_LANGUAGES,
+		AdErrorReasonMISSING_MOBILE_CARRIER,
+		AdErrorReasonMISSING_PHONE,
+		AdErrorReasonMISSING_REQUIRED_TEMPLATE_FIELDS,
+		AdErrorReasonMISSING_TEMPLATE_FIELD_VALUE,
+		AdErrorReasonMISSING_TEXT,
+		AdErrorReasonMISSING_VISIBLE_URL,
+		AdErrorReasonMISSING_WIDTH,
+		AdErrorReasonMULTIPLE_DISTINCT_FEEDS_UNSUPPORTED,
+		AdErrorReasonMUST_USE_TEMP_AD_UNION_ID_ON_ADD,
+		AdErrorReasonTOO_LONG,
+		AdErrorReasonTOO_SHORT,
+		AdErrorReasonUNION_DIMENSIONS_CANNOT_CHANGE,
+		AdErrorReasonUNKNOWN_ADDRESS_COMPONENT,
+		AdErrorReasonUNKNOWN_FIELD_NAME,
+		AdErrorReasonUNKNOWN_UNIQUE_NAME,
+		AdErrorReasonUNSUPPORTED_DIMENSIONS,
+		AdErrorReasonURL_INVALID_SCHEME,
+		AdErrorReasonURL_INVALID_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_MALFORMED,
+		AdErrorReasonURL_NO_HOST,
+		AdErrorReasonURL_NOT_EQUIVALENT,
+		AdErrorReasonURL_HOST_NAME_TOO_LONG,
+		AdErrorReasonURL_NO_SCHEME,
+		AdErrorReasonURL_NO_TOP_LEVEL_DOMAIN,
+		AdErrorReasonURL_PATH_NOT_ALLOWED,
+		AdErrorReasonURL_PORT_NOT_ALLOWED,
+		AdErrorReasonURL_QUERY_NOT_ALLOWED,
+		AdErrorReasonURL_SCHEME_BEFORE_DSA_TAG,
+		AdErrorReasonURL_SCHEME_BEFORE_EXPANDED_DYNAMIC_SEARCH_AD_TAG,
+		AdErrorReasonUSER_DOES_NOT_HAVE_ACCESS_TO_TEMPLATE,
+		AdErrorReasonINCONSISTENT_EXPANDABLE_SETTINGS,
+		AdErrorReasonINVALID_FORMAT,
+		AdErrorReasonINVALID_FIELD_TEXT,
+		AdErrorReasonELEMENT_NOT_PRESENT,
+		AdErrorReasonIMAGE_ERROR,
+		AdErrorReasonVALUE_NOT_IN_RANGE,
+		AdErrorReasonFIELD_NOT_PRESENT,
+		AdErrorReasonADDRESS_NOT_COMPLETE,
+		AdErrorReasonADDRESS_INVALID,
+		AdErrorReasonVIDEO_RETRIEVAL_ERROR,
+		AdErrorReasonAUDIO_ERROR,
+		AdErrorReasonINVALID_YOUTUBE_DISPLAY_URL,
+		AdErrorReasonTOO_MANY_PRODUCT_IMAGES,
+		AdErrorReasonTOO_MANY_PRODUCT_VIDEOS,
+		AdErrorReasonINCOMPATIBLE_AD_TYPE_AND_DEVICE_PREFERENCE,
+		AdErrorReasonCALLTRACKING_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonCARRIER_SPECIFIC_SHORT_NUMBER_NOT_ALLOWED,
+		AdErrorReasonDISALLOWED_NUMBER_TYPE,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_FOR_COUNTRY,
+		AdErrorReasonPHONE_NUMBER_NOT_SUPPORTED_WITH_CALLTRACKING_FOR_COUNTRY,
+		AdErrorReasonPREMIUM_RATE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonVANITY_PHONE_NUMBER_NOT_ALLOWED,
+		AdErrorReasonINVALID_CALL_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_DISABLE_CALL_CONVERSION_AND_SET_CONVERSION_TYPE_ID,
+		AdErrorReasonCANNOT_SET_PATH2_WITHOUT_PATH1,
+		AdErrorReasonMISSING_DYNAMIC_SEARCH_ADS_SETTING_DOMAIN_NAME,
+		AdErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseAdErrorReason returns the AdErrorReason named by s.
+func ParseAdErrorReason(s string) (AdErrorReason, error) {
+	e := AdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of AdErrorReason.
+func (e AdErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AdErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *AdErrorReason) UnmarshalText(text []byte) error {
+	v := AdErrorReason(text)
+	if !v.IsValid() {
+		v = AdErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// AuthenticationErrorReasonValues returns all values of AuthenticationErrorReason.
+func AuthenticationErrorReasonValues() []AuthenticationErrorReason {
+	return []AuthenticationErrorReason{
+		AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthenticationErrorReason.
+func (e AuthenticationErrorReason) IsValid() bool {
+	switch e {
+	case AuthenticationErrorReasonAUTHENTICATION_FAILED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_IS_REQUIRED,
+		AuthenticationErrorReasonCLIENT_EMAIL_REQUIRED,
+		AuthenticationErrorReasonCLIENT_CUSTOMER_ID_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_INVALID,
+		AuthenticationErrorReasonCLIENT_EMAIL_FAILED_TO_AUTHENTICATE,
+		AuthenticationErrorReasonCUSTOMER_NOT_FOUND,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_DELETED,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_AUTHENTICATE_GOOGLE_ACCOUNT,
+		AuthenticationErrorReasonGOOGLE_ACCOUNT_USER_AND_ADS_USER_MISMATCH,
+		AuthenticationErrorReasonLOGIN_COOKIE_REQUIRED,
+		AuthenticationErrorReasonNOT_ADS_USER,
+		AuthenticationErrorReasonOAUTH_TOKEN_INVALID,
+		AuthenticationErrorReasonOAUTH_TOKEN_EXPIRED,
+		AuthenticationErrorReasonOAUTH_TOKEN_DISABLED,
+		AuthenticationErrorReasonOAUTH_TOKEN_REVOKED,
+		AuthenticationErrorReasonOAUTH_TOKEN_HEADER_INVALID,
+		AuthenticationErrorReasonLOGIN_COOKIE_INVALID,
+		AuthenticationErrorReasonFAILED_TO_RETRIEVE_LOGIN_COOKIE,
+		AuthenticationErrorReasonUSER_ID_INVALID:
+		return true
+	}
+	return false
+}
+
+// ParseAuthenticationErrorReason returns the AuthenticationErrorReason named by s.
+func ParseAuthenticationErrorReason(s string) (AuthenticationErrorReason, error) {
+	e := AuthenticationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthenticationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthenticationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthenticationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthenticationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthenticationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// AuthorizationErrorReasonValues returns all values of AuthorizationErrorReason.
+func AuthorizationErrorReasonValues() []AuthorizationErrorReason {
+	return []AuthorizationErrorReason{
+		AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of AuthorizationErrorReason.
+func (e AuthorizationErrorReason) IsValid() bool {
+	switch e {
+	case AuthorizationErrorReasonUNABLE_TO_AUTHORIZE,
+		AuthorizationErrorReasonNO_ADWORDS_ACCOUNT_FOR_CUSTOMER,
+		AuthorizationErrorReasonUSER_PERMISSION_DENIED,
+		AuthorizationErrorReasonEFFECTIVE_USER_PERMISSION_DENIED,
+		AuthorizationErrorReasonCUSTOMER_NOT_ACTIVE,
+		AuthorizationErrorReasonUSER_HAS_READONLY_PERMISSION,
+		AuthorizationErrorReasonNO_CUSTOMER_FOUND,
+		AuthorizationErrorReasonSERVICE_ACCESS_DENIED:
+		return true
+	}
+	return false
+}
+
+// ParseAuthorizationErrorReason returns the AuthorizationErrorReason named by s.
+func ParseAuthorizationErrorReason(s string) (AuthorizationErrorReason, error) {
+	e := AuthorizationErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid AuthorizationErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// AuthorizationErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e AuthorizationErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *AuthorizationErrorReason) UnmarshalText(text []byte) error {
+	v := AuthorizationErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CollectionSizeErrorReasonValues returns all values of CollectionSizeErrorReason.
+func CollectionSizeErrorReasonValues() []CollectionSizeErrorReason {
+	return []CollectionSizeErrorReason{
+		CollectionSizeErrorReasonTOO_FEW,
+		CollectionSizeErrorReasonTOO_MANY,
+	}
+}
+
+// IsValid reports whether e is one of the values of CollectionSizeErrorReason.
+func (e CollectionSizeErrorReason) IsValid() bool {
+	switch e {
+	case CollectionSizeErrorReasonTOO_FEW,
+		CollectionSizeErrorReasonTOO_MANY:
+		return true
+	}
+	return false
+}
+
+// ParseCollectionSizeErrorReason returns the CollectionSizeErrorReason named by s.
+func ParseCollectionSizeErrorReason(s string) (CollectionSizeErrorReason, error) {
+	e := CollectionSizeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CollectionSizeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// CollectionSizeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e CollectionSizeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *CollectionSizeErrorReason) UnmarshalText(text []byte) error {
+	v := CollectionSizeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DatabaseErrorReasonValues returns all values of DatabaseErrorReason.
+func DatabaseErrorReasonValues() []DatabaseErrorReason {
+	return []DatabaseErrorReason{
+		DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of DatabaseErrorReason.
+func (e DatabaseErrorReason) IsValid() bool {
+	switch e {
+	case DatabaseErrorReasonCONCURRENT_MODIFICATION,
+		DatabaseErrorReasonPERMISSION_DENIED,
+		DatabaseErrorReasonACCESS_PROHIBITED,
+		DatabaseErrorReasonCAMPAIGN_PRODUCT_NOT_SUPPORTED,
+		DatabaseErrorReasonDUPLICATE_KEY,
+		DatabaseErrorReasonDATABASE_ERROR,
+		DatabaseErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDatabaseErrorReason returns the DatabaseErrorReason named by s.
+func ParseDatabaseErrorReason(s string) (DatabaseErrorReason, error) {
+	e := DatabaseErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DatabaseErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of DatabaseErrorReason.
+func (e DatabaseErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid DatabaseErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *DatabaseErrorReason) UnmarshalText(text []byte) error {
+	v := DatabaseErrorReason(text)
+	if !v.IsValid() {
+		v = DatabaseErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// DateErrorReasonValues returns all values of DateErrorReason.
+func DateErrorReasonValues() []DateErrorReason {
+	return []DateErrorReason{
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL,
+	}
+}
+
+// IsValid reports whether e is one of the values of DateErrorReason.
+func (e DateErrorReason) IsValid() bool {
+	switch e {
+	case DateErrorReasonINVALID_FIELD_VALUES_IN_DATE,
+		DateErrorReasonINVALID_FIELD_VALUES_IN_DATE_TIME,
+		DateErrorReasonINVALID_STRING_DATE,
+		DateErrorReasonINVALID_STRING_DATE_RANGE,
+		DateErrorReasonINVALID_STRING_DATE_TIME,
+		DateErrorReasonEARLIER_THAN_MINIMUM_DATE,
+		DateErrorReasonLATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_DATE_LATER_THAN_MAXIMUM_DATE,
+		DateErrorReasonDATE_RANGE_MINIMUM_AND_MAXIMUM_DATES_BOTH_NULL:
+		return true
+	}
+	return false
+}
+
+// ParseDateErrorReason returns the DateErrorReason named by s.
+func ParseDateErrorReason(s string) (DateErrorReason, error) {
+	e := DateErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DateErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DateErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DateErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DateErrorReason) UnmarshalText(text []byte) error {
+	v := DateErrorReason(text)
+	*e = v
+	return nil
+}
+
+// DistinctErrorReasonValues returns all values of DistinctErrorReason.
+func DistinctErrorReasonValues() []DistinctErrorReason {
+	return []DistinctErrorReason{
+		DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE,
+	}
+}
+
+// IsValid reports whether e is one of the values of DistinctErrorReason.
+func (e DistinctErrorReason) IsValid() bool {
+	switch e {
+	case DistinctErrorReasonDUPLICATE_ELEMENT,
+		DistinctErrorReasonDUPLICATE_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDistinctErrorReason returns the DistinctErrorReason named by s.
+func ParseDistinctErrorReason(s string) (DistinctErrorReason, error) {
+	e := DistinctErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid DistinctErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// DistinctErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e DistinctErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *DistinctErrorReason) UnmarshalText(text []byte) error {
+	v := DistinctErrorReason(text)
+	*e = v
+	return nil
+}
+
+// EntityNotFoundReasonValues returns all values of EntityNotFoundReason.
+func EntityNotFoundReasonValues() []EntityNotFoundReason {
+	return []EntityNotFoundReason{
+		EntityNotFoundReasonINVALID_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of EntityNotFoundReason.
+func (e EntityNotFoundReason) IsValid() bool {
+	switch e {
+	case EntityNotFoundReasonINVALID_ID:
+		return true
+	}
+	return false
+}
+
+// ParseEntityNotFoundReason returns the EntityNotFoundReason named by s.
+func ParseEntityNotFoundReason(s string) (EntityNotFoundReason, error) {
+	e := EntityNotFoundReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid EntityNotFoundReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// EntityNotFoundReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e EntityNotFoundReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *EntityNotFoundReason) UnmarshalText(text []byte) error {
+	v := EntityNotFoundReason(text)
+	*e = v
+	return nil
+}
+
+// IdErrorReasonValues returns all values of IdErrorReason.
+func IdErrorReasonValues() []IdErrorReason {
+	return []IdErrorReason{
+		IdErrorReasonNOT_FOUND,
+	}
+}
+
+// IsValid reports whether e is one of the values of IdErrorReason.
+func (e IdErrorReason) IsValid() bool {
+	switch e {
+	case IdErrorReasonNOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseIdErrorReason returns the IdErrorReason named by s.
+func ParseIdErrorReason(s string) (IdErrorReason, error) {
+	e := IdErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid IdErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// IdErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e IdErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *IdErrorReason) UnmarshalText(text []byte) error {
+	v := IdErrorReason(text)
+	*e = v
+	return nil
+}
+
+// InternalApiErrorReasonValues returns all values of InternalApiErrorReason.
+func InternalApiErrorReasonValues() []InternalApiErrorReason {
+	return []InternalApiErrorReason{
+		InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE,
+	}
+}
+
+// IsValid reports whether e is one of the values of InternalApiErrorReason.
+func (e InternalApiErrorReason) IsValid() bool {
+	switch e {
+	case InternalApiErrorReasonUNEXPECTED_INTERNAL_API_ERROR,
+		InternalApiErrorReasonTRANSIENT_ERROR,
+		InternalApiErrorReasonUNKNOWN,
+		InternalApiErrorReasonDOWNTIME,
+		InternalApiErrorReasonERROR_GENERATING_RESPONSE:
+		return true
+	}
+	return false
+}
+
+// ParseInternalApiErrorReason returns the InternalApiErrorReason named by s.
+func ParseInternalApiErrorReason(s string) (InternalApiErrorReason, error) {
+	e := InternalApiErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid InternalApiErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of InternalApiErrorReason.
+func (e InternalApiErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid InternalApiErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *InternalApiErrorReason) UnmarshalText(text []byte) error {
+	v := InternalApiErrorReason(text)
+	if !v.IsValid() {
+		v = InternalApiErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// NotEmptyErrorReasonValues returns all values of NotEmptyErrorReason.
+func NotEmptyErrorReasonValues() []NotEmptyErrorReason {
+	return []NotEmptyErrorReason{
+		NotEmptyErrorReasonEMPTY_LIST,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotEmptyErrorReason.
+func (e NotEmptyErrorReason) IsValid() bool {
+	switch e {
+	case NotEmptyErrorReasonEMPTY_LIST:
+		return true
+	}
+	return false
+}
+
+// ParseNotEmptyErrorReason returns the NotEmptyErrorReason named by s.
+func ParseNotEmptyErrorReason(s string) (NotEmptyErrorReason, error) {
+	e := NotEmptyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotEmptyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotEmptyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotEmptyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotEmptyErrorReason) UnmarshalText(text []byte) error {
+	v := NotEmptyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NotWhitelistedErrorReasonValues returns all values of NotWhitelistedErrorReason.
+func NotWhitelistedErrorReasonValues() []NotWhitelistedErrorReason {
+	return []NotWhitelistedErrorReason{
+		NotWhitelistedErrorReasonCUSTOMER_NOT_WHITELISTED_FOR_API,
+	}
+}
+
+// IsValid reports whether e is one of the values of NotWhitelistedErrorReason.
+func (e NotWhitelistedErrorReason) IsValid() bool {
+	switch e {
+	case NotWhitelistedErrorReasonCUSTOMER_NOT_WHITELISTED_FOR_API:
+		return true
+	}
+	return false
+}
+
+// ParseNotWhitelistedErrorReason returns the NotWhitelistedErrorReason named by s.
+func ParseNotWhitelistedErrorReason(s string) (NotWhitelistedErrorReason, error) {
+	e := NotWhitelistedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NotWhitelistedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NotWhitelistedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NotWhitelistedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NotWhitelistedErrorReason) UnmarshalText(text []byte) error {
+	v := NotWhitelistedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// NullErrorReasonValues returns all values of NullErrorReason.
+func NullErrorReasonValues() []NullErrorReason {
+	return []NullErrorReason{
+		NullErrorReasonNULL_CONTENT,
+	}
+}
+
+// IsValid reports whether e is one of the values of NullErrorReason.
+func (e NullErrorReason) IsValid() bool {
+	switch e {
+	case NullErrorReasonNULL_CONTENT:
+		return true
+	}
+	return false
+}
+
+// ParseNullErrorReason returns the NullErrorReason named by s.
+func ParseNullErrorReason(s string) (NullErrorReason, error) {
+	e := NullErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid NullErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// NullErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e NullErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *NullErrorReason) UnmarshalText(text []byte) error {
+	v := NullErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OperationAccessDeniedReasonValues returns all values of OperationAccessDeniedReason.
+func OperationAccessDeniedReasonValues() []OperationAccessDeniedReason {
+	return []OperationAccessDeniedReason{
+		OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) IsValid() bool {
+	switch e {
+	case OperationAccessDeniedReasonACTION_NOT_PERMITTED,
+		OperationAccessDeniedReasonADD_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonREMOVE_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonSET_OPERATION_NOT_PERMITTED,
+		OperationAccessDeniedReasonMUTATE_ACTION_NOT_PERMITTED_FOR_CLIENT,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_CAMPAIGN_TYPE,
+		OperationAccessDeniedReasonADD_AS_REMOVED_NOT_PERMITTED,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_REMOVED_ENTITY,
+		OperationAccessDeniedReasonOPERATION_NOT_PERMITTED_FOR_AD_GROUP_TYPE,
+		OperationAccessDeniedReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseOperationAccessDeniedReason returns the OperationAccessDeniedReason named by s.
+func ParseOperationAccessDeniedReason(s string) (OperationAccessDeniedReason, error) {
+	e := OperationAccessDeniedReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperationAccessDeniedReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OperationAccessDeniedReason.
+func (e OperationAccessDeniedReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OperationAccessDeniedReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OperationAccessDeniedReason) UnmarshalText(text []byte) error {
+	v := OperationAccessDeniedReason(text)
+	if !v.IsValid() {
+		v = OperationAccessDeniedReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OperatorValues returns all values of Operator.
+func OperatorValues() []Operator {
+	return []Operator{
+		OperatorADD,
+		OperatorREMOVE,
+		OperatorSET,
+	}
+}
+
+// IsValid reports whether e is one of the values of Operator.
+func (e Operator) IsValid() bool {
+	switch e {
+	case OperatorADD,
+		OperatorREMOVE,
+		OperatorSET:
+		return true
+	}
+	return false
+}
+
+// ParseOperator returns the Operator named by s.
+func ParseOperator(s string) (Operator, error) {
+	e := Operator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid Operator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// Operator are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e Operator) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *Operator) UnmarshalText(text []byte) error {
+	v := Operator(text)
+	*e = v
+	return nil
+}
+
+// OperatorErrorReasonValues returns all values of OperatorErrorReason.
+func OperatorErrorReasonValues() []OperatorErrorReason {
+	return []OperatorErrorReason{
+		OperatorErrorReasonOPERATOR_NOT_SUPPORTED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OperatorErrorReason.
+func (e OperatorErrorReason) IsValid() bool {
+	switch e {
+	case OperatorErrorReasonOPERATOR_NOT_SUPPORTED:
+		return true
+	}
+	return false
+}
+
+// ParseOperatorErrorReason returns the OperatorErrorReason named by s.
+func ParseOperatorErrorReason(s string) (OperatorErrorReason, error) {
+	e := OperatorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OperatorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// OperatorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e OperatorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *OperatorErrorReason) UnmarshalText(text []byte) error {
+	v := OperatorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// PredicateOperatorValues returns all values of PredicateOperator.
+func PredicateOperatorValues() []PredicateOperator {
+	return []PredicateOperator{
+		PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of PredicateOperator.
+func (e PredicateOperator) IsValid() bool {
+	switch e {
+	case PredicateOperatorEQUALS,
+		PredicateOperatorNOT_EQUALS,
+		PredicateOperatorIN,
+		PredicateOperatorNOT_IN,
+		PredicateOperatorGREATER_THAN,
+		PredicateOperatorGREATER_THAN_EQUALS,
+		PredicateOperatorLESS_THAN,
+		PredicateOperatorLESS_THAN_EQUALS,
+		PredicateOperatorSTARTS_WITH,
+		PredicateOperatorSTARTS_WITH_IGNORE_CASE,
+		PredicateOperatorCONTAINS,
+		PredicateOperatorCONTAINS_IGNORE_CASE,
+		PredicateOperatorDOES_NOT_CONTAIN,
+		PredicateOperatorDOES_NOT_CONTAIN_IGNORE_CASE,
+		PredicateOperatorCONTAINS_ANY,
+		PredicateOperatorCONTAINS_ALL,
+		PredicateOperatorCONTAINS_NONE,
+		PredicateOperatorUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParsePredicateOperator returns the PredicateOperator named by s.
+func ParsePredicateOperator(s string) (PredicateOperator, error) {
+	e := PredicateOperator(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid PredicateOperator %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of PredicateOperator.
+func (e PredicateOperator) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid PredicateOperator %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *PredicateOperator) UnmarshalText(text []byte) error {
+	v := PredicateOperator(text)
+	if !v.IsValid() {
+		v = PredicateOperatorUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// QuotaCheckErrorReasonValues returns all values of QuotaCheckErrorReason.
+func QuotaCheckErrorReasonValues() []QuotaCheckErrorReason {
+	return []QuotaCheckErrorReason{
+		QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of QuotaCheckErrorReason.
+func (e QuotaCheckErrorReason) IsValid() bool {
+	switch e {
+	case QuotaCheckErrorReasonINVALID_TOKEN_HEADER,
+		QuotaCheckErrorReasonACCOUNT_DELINQUENT,
+		QuotaCheckErrorReasonACCOUNT_INACCESSIBLE,
+		QuotaCheckErrorReasonACCOUNT_INACTIVE,
+		QuotaCheckErrorReasonINCOMPLETE_SIGNUP,
+		QuotaCheckErrorReasonDEVELOPER_TOKEN_NOT_APPROVED,
+		QuotaCheckErrorReasonTERMS_AND_CONDITIONS_NOT_SIGNED,
+		QuotaCheckErrorReasonMONTHLY_BUDGET_REACHED,
+		QuotaCheckErrorReasonQUOTA_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseQuotaCheckErrorReason returns the QuotaCheckErrorReason named by s.
+func ParseQuotaCheckErrorReason(s string) (QuotaCheckErrorReason, error) {
+	e := QuotaCheckErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid QuotaCheckErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// QuotaCheckErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e QuotaCheckErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *QuotaCheckErrorReason) UnmarshalText(text []byte) error {
+	v := QuotaCheckErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RangeErrorReasonValues returns all values of RangeErrorReason.
+func RangeErrorReasonValues() []RangeErrorReason {
+	return []RangeErrorReason{
+		RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH,
+	}
+}
+
+// IsValid reports whether e is one of the values of RangeErrorReason.
+func (e RangeErrorReason) IsValid() bool {
+	switch e {
+	case RangeErrorReasonTOO_LOW,
+		RangeErrorReasonTOO_HIGH:
+		return true
+	}
+	return false
+}
+
+// ParseRangeErrorReason returns the RangeErrorReason named by s.
+func ParseRangeErrorReason(s string) (RangeErrorReason, error) {
+	e := RangeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RangeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RangeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RangeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RangeErrorReason) UnmarshalText(text []byte) error {
+	v := RangeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RateExceededErrorReasonValues returns all values of RateExceededErrorReason.
+func RateExceededErrorReasonValues() []RateExceededErrorReason {
+	return []RateExceededErrorReason{
+		RateExceededErrorReasonRATE_EXCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RateExceededErrorReason.
+func (e RateExceededErrorReason) IsValid() bool {
+	switch e {
+	case RateExceededErrorReasonRATE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// ParseRateExceededErrorReason returns the RateExceededErrorReason named by s.
+func ParseRateExceededErrorReason(s string) (RateExceededErrorReason, error) {
+	e := RateExceededErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RateExceededErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RateExceededErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RateExceededErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RateExceededErrorReason) UnmarshalText(text []byte) error {
+	v := RateExceededErrorReason(text)
+	*e = v
+	return nil
+}
+
+// ReadOnlyErrorReasonValues returns all values of ReadOnlyErrorReason.
+func ReadOnlyErrorReasonValues() []ReadOnlyErrorReason {
+	return []ReadOnlyErrorReason{
+		ReadOnlyErrorReasonREAD_ONLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of ReadOnlyErrorReason.
+func (e ReadOnlyErrorReason) IsValid() bool {
+	switch e {
+	case ReadOnlyErrorReasonREAD_ONLY:
+		return true
+	}
+	return false
+}
+
+// ParseReadOnlyErrorReason returns the ReadOnlyErrorReason named by s.
+func ParseReadOnlyErrorReason(s string) (ReadOnlyErrorReason, error) {
+	e := ReadOnlyErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid ReadOnlyErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// ReadOnlyErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e ReadOnlyErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *ReadOnlyErrorReason) UnmarshalText(text []byte) error {
+	v := ReadOnlyErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RegionCodeErrorReasonValues returns all values of RegionCodeErrorReason.
+func RegionCodeErrorReasonValues() []RegionCodeErrorReason {
+	return []RegionCodeErrorReason{
+		RegionCodeErrorReasonINVALID_REGION_CODE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RegionCodeErrorReason.
+func (e RegionCodeErrorReason) IsValid() bool {
+	switch e {
+	case RegionCodeErrorReasonINVALID_REGION_CODE:
+		return true
+	}
+	return false
+}
+
+// ParseRegionCodeErrorReason returns the RegionCodeErrorReason named by s.
+func ParseRegionCodeErrorReason(s string) (RegionCodeErrorReason, error) {
+	e := RegionCodeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RegionCodeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RegionCodeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RegionCodeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RegionCodeErrorReason) UnmarshalText(text []byte) error {
+	v := RegionCodeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RejectedErrorReasonValues returns all values of RejectedErrorReason.
+func RejectedErrorReasonValues() []RejectedErrorReason {
+	return []RejectedErrorReason{
+		RejectedErrorReasonUNKNOWN_VALUE,
+	}
+}
+
+// IsValid reports whether e is one of the values of RejectedErrorReason.
+func (e RejectedErrorReason) IsValid() bool {
+	switch e {
+	case RejectedErrorReasonUNKNOWN_VALUE:
+		return true
+	}
+	return false
+}
+
+// ParseRejectedErrorReason returns the RejectedErrorReason named by s.
+func ParseRejectedErrorReason(s string) (RejectedErrorReason, error) {
+	e := RejectedErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RejectedErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RejectedErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RejectedErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RejectedErrorReason) UnmarshalText(text []byte) error {
+	v := RejectedErrorReason(text)
+	*e = v
+	return nil
+}
+
+// RequiredErrorReasonValues returns all values of RequiredErrorReason.
+func RequiredErrorReasonValues() []RequiredErrorReason {
+	return []RequiredErrorReason{
+		RequiredErrorReasonREQUIRED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RequiredErrorReason.
+func (e RequiredErrorReason) IsValid() bool {
+	switch e {
+	case RequiredErrorReasonREQUIRED:
+		return true
+	}
+	return false
+}
+
+// ParseRequiredErrorReason returns the RequiredErrorReason named by s.
+func ParseRequiredErrorReason(s string) (RequiredErrorReason, error) {
+	e := RequiredErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid RequiredErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// RequiredErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e RequiredErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *RequiredErrorReason) UnmarshalText(text []byte) error {
+	v := RequiredErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SelectorErrorReasonValues returns all values of SelectorErrorReason.
+func SelectorErrorReasonValues() []SelectorErrorReason {
+	return []SelectorErrorReason{
+		SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR,
+	}
+}
+
+// IsValid reports whether e is one of the values of SelectorErrorReason.
+func (e SelectorErrorReason) IsValid() bool {
+	switch e {
+	case SelectorErrorReasonINVALID_FIELD_NAME,
+		SelectorErrorReasonMISSING_FIELDS,
+		SelectorErrorReasonMISSING_PREDICATES,
+		SelectorErrorReasonOPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_ENUM_VALUE,
+		SelectorErrorReasonMISSING_PREDICATE_OPERATOR,
+		SelectorErrorReasonMISSING_PREDICATE_VALUES,
+		SelectorErrorReasonINVALID_PREDICATE_FIELD_NAME,
+		SelectorErrorReasonINVALID_PREDICATE_OPERATOR,
+		SelectorErrorReasonINVALID_FIELD_SELECTION,
+		SelectorErrorReasonINVALID_PREDICATE_VALUE,
+		SelectorErrorReasonINVALID_SORT_FIELD_NAME,
+		SelectorErrorReasonSELECTOR_ERROR,
+		SelectorErrorReasonFILTER_BY_DATE_RANGE_NOT_SUPPORTED,
+		SelectorErrorReasonSTART_INDEX_IS_TOO_HIGH,
+		SelectorErrorReasonTOO_MANY_PREDICATE_VALUES,
+		SelectorErrorReasonUNKNOWN_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseSelectorErrorReason returns the SelectorErrorReason named by s.
+func ParseSelectorErrorReason(s string) (SelectorErrorReason, error) {
+	e := SelectorErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SelectorErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SelectorErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SelectorErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SelectorErrorReason) UnmarshalText(text []byte) error {
+	v := SelectorErrorReason(text)
+	*e = v
+	return nil
+}
+
+// SizeLimitErrorReasonValues returns all values of SizeLimitErrorReason.
+func SizeLimitErrorReasonValues() []SizeLimitErrorReason {
+	return []SizeLimitErrorReason{
+		SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN,
+	}
+}
+
+// IsValid reports whether e is one of the values of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) IsValid() bool {
+	switch e {
+	case SizeLimitErrorReasonREQUEST_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonRESPONSE_SIZE_LIMIT_EXCEEDED,
+		SizeLimitErrorReasonINTERNAL_STORAGE_ERROR,
+		SizeLimitErrorReasonUNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseSizeLimitErrorReason returns the SizeLimitErrorReason named by s.
+func ParseSizeLimitErrorReason(s string) (SizeLimitErrorReason, error) {
+	e := SizeLimitErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SizeLimitErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of SizeLimitErrorReason.
+func (e SizeLimitErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid SizeLimitErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *SizeLimitErrorReason) UnmarshalText(text []byte) error {
+	v := SizeLimitErrorReason(text)
+	if !v.IsValid() {
+		v = SizeLimitErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// SortOrderValues returns all values of SortOrder.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASCENDING,
+		SortOrderDESCENDING,
+	}
+}
+
+// IsValid reports whether e is one of the values of SortOrder.
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderASCENDING,
+		SortOrderDESCENDING:
+		return true
+	}
+	return false
+}
+
+// ParseSortOrder returns the SortOrder named by s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	e := SortOrder(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid SortOrder %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// SortOrder are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e SortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *SortOrder) UnmarshalText(text []byte) error {
+	v := SortOrder(text)
+	*e = v
+	return nil
+}
+
+// StringFormatErrorReasonValues returns all values of StringFormatErrorReason.
+func StringFormatErrorReasonValues() []StringFormatErrorReason {
+	return []StringFormatErrorReason{
+		StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringFormatErrorReason.
+func (e StringFormatErrorReason) IsValid() bool {
+	switch e {
+	case StringFormatErrorReasonUNKNOWN,
+		StringFormatErrorReasonILLEGAL_CHARS,
+		StringFormatErrorReasonINVALID_FORMAT:
+		return true
+	}
+	return false
+}
+
+// ParseStringFormatErrorReason returns the StringFormatErrorReason named by s.
+func ParseStringFormatErrorReason(s string) (StringFormatErrorReason, error) {
+	e := StringFormatErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringFormatErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of StringFormatErrorReason.
+func (e StringFormatErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid StringFormatErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *StringFormatErrorReason) UnmarshalText(text []byte) error {
+	v := StringFormatErrorReason(text)
+	if !v.IsValid() {
+		v = StringFormatErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// StringLengthErrorReasonValues returns all values of StringLengthErrorReason.
+func StringLengthErrorReasonValues() []StringLengthErrorReason {
+	return []StringLengthErrorReason{
+		StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG,
+	}
+}
+
+// IsValid reports whether e is one of the values of StringLengthErrorReason.
+func (e StringLengthErrorReason) IsValid() bool {
+	switch e {
+	case StringLengthErrorReasonTOO_SHORT,
+		StringLengthErrorReasonTOO_LONG:
+		return true
+	}
+	return false
+}
+
+// ParseStringLengthErrorReason returns the StringLengthErrorReason named by s.
+func ParseStringLengthErrorReason(s string) (StringLengthErrorReason, error) {
+	e := StringLengthErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid StringLengthErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// StringLengthErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e StringLengthErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *StringLengthErrorReason) UnmarshalText(text []byte) error {
+	v := StringLengthErrorReason(text)
+	*e = v
+	return nil
+}
+
+// CurrencyCodeErrorReasonValues returns all values of CurrencyCodeErrorReason.
+func CurrencyCodeErrorReasonValues() []CurrencyCodeErrorReason {
+	return []CurrencyCodeErrorReason{
+		CurrencyCodeErrorReasonUNSUPPORTED_CURRENCY_CODE,
+	}
+}
+
+// IsValid reports whether e is one of the values of CurrencyCodeErrorReason.
+func (e CurrencyCodeErrorReason) IsValid() bool {
+	switch e {
+	case CurrencyCodeErrorReasonUNSUPPORTED_CURRENCY_CODE:
+		return true
+	}
+	return false
+}
+
+// ParseCurrencyCodeErrorReason returns the CurrencyCodeErrorReason named by s.
+func ParseCurrencyCodeErrorReason(s string) (CurrencyCodeErrorReason, error) {
+	e := CurrencyCodeErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid CurrencyCodeErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Values that are not part of
+// CurrencyCodeErrorReason are sent as they are, as UnmarshalText keeps them, so a decoded
+// value can be sent back.
+func (e CurrencyCodeErrorReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. IsValid reports such values.
+func (e *CurrencyCodeErrorReason) UnmarshalText(text []byte) error {
+	v := CurrencyCodeErrorReason(text)
+	*e = v
+	return nil
+}
+
+// OfflineDataUploadErrorReasonValues returns all values of OfflineDataUploadErrorReason.
+func OfflineDataUploadErrorReasonValues() []OfflineDataUploadErrorReason {
+	return []OfflineDataUploadErrorReason{
+		OfflineDataUploadErrorReasonUNKNOWN,
+		OfflineDataUploadErrorReasonINCOMPATIBLE_USERIDENTIFIER_TYPE,
+		OfflineDataUploadErrorReasonINVALID_UPLOAD_TYPE,
+		OfflineDataUploadErrorReasonMISSING_UPLOAD_METADATA,
+		OfflineDataUploadErrorReasonINVALID_UPLOAD_METADATA,
+		OfflineDataUploadErrorReasonINVALID_PARTNER_ID,
+		OfflineDataUploadErrorReasonMISSING_TRANSACTION_INFO,
+		OfflineDataUploadErrorReasonINVALID_CONVERSION_TYPE,
+		OfflineDataUploadErrorReasonFUTURE_TRANSACTION_TIME,
+		OfflineDataUploadErrorReasonNEGATIVE_TRANSACTION_AMOUNT,
+		OfflineDataUploadErrorReasonCOUNTRY_CODE_HASHED,
+		OfflineDataUploadErrorReasonZIPCODE_HASHED,
+		OfflineDataUploadErrorReasonEMAIL_NOT_HASHED,
+		OfflineDataUploadErrorReasonFIRST_NAME_NOT_HASHED,
+		OfflineDataUploadErrorReasonLAST_NAME_NOT_HASHED,
+		OfflineDataUploadErrorReasonPHONE_NOT_HASHED,
+	}
+}
+
+// IsValid reports whether e is one of the values of OfflineDataUploadErrorReason.
+func (e OfflineDataUploadErrorReason) IsValid() bool {
+	switch e {
+	case OfflineDataUploadErrorReasonUNKNOWN,
+		OfflineDataUploadErrorReasonINCOMPATIBLE_USERIDENTIFIER_TYPE,
+		OfflineDataUploadErrorReasonINVALID_UPLOAD_TYPE,
+		OfflineDataUploadErrorReasonMISSING_UPLOAD_METADATA,
+		OfflineDataUploadErrorReasonINVALID_UPLOAD_METADATA,
+		OfflineDataUploadErrorReasonINVALID_PARTNER_ID,
+		OfflineDataUploadErrorReasonMISSING_TRANSACTION_INFO,
+		OfflineDataUploadErrorReasonINVALID_CONVERSION_TYPE,
+		OfflineDataUploadErrorReasonFUTURE_TRANSACTION_TIME,
+		OfflineDataUploadErrorReasonNEGATIVE_TRANSACTION_AMOUNT,
+		OfflineDataUploadErrorReasonCOUNTRY_CODE_HASHED,
+		OfflineDataUploadErrorReasonZIPCODE_HASHED,
+		OfflineDataUploadErrorReasonEMAIL_NOT_HASHED,
+		OfflineDataUploadErrorReasonFIRST_NAME_NOT_HASHED,
+		OfflineDataUploadErrorReasonLAST_NAME_NOT_HASHED,
+		OfflineDataUploadErrorReasonPHONE_NOT_HASHED:
+		return true
+	}
+	return false
+}
+
+// ParseOfflineDataUploadErrorReason returns the OfflineDataUploadErrorReason named by s.
+func ParseOfflineDataUploadErrorReason(s string) (OfflineDataUploadErrorReason, error) {
+	e := OfflineDataUploadErrorReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OfflineDataUploadErrorReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OfflineDataUploadErrorReason.
+func (e OfflineDataUploadErrorReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OfflineDataUploadErrorReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OfflineDataUploadErrorReason) UnmarshalText(text []byte) error {
+	v := OfflineDataUploadErrorReason(text)
+	if !v.IsValid() {
+		v = OfflineDataUploadErrorReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OfflineDataUploadFailureReasonValues returns all values of OfflineDataUploadFailureReason.
+func OfflineDataUploadFailureReasonValues() []OfflineDataUploadFailureReason {
+	return []OfflineDataUploadFailureReason{
+		OfflineDataUploadFailureReasonUNKNOWN,
+		OfflineDataUploadFailureReasonINSUFFICIENT_MATCHED_TRANSACTIONS,
+		OfflineDataUploadFailureReasonINSUFFICIENT_TRANSACTIONS,
+	}
+}
+
+// IsValid reports whether e is one of the values of OfflineDataUploadFailureReason.
+func (e OfflineDataUploadFailureReason) IsValid() bool {
+	switch e {
+	case OfflineDataUploadFailureReasonUNKNOWN,
+		OfflineDataUploadFailureReasonINSUFFICIENT_MATCHED_TRANSACTIONS,
+		OfflineDataUploadFailureReasonINSUFFICIENT_TRANSACTIONS:
+		return true
+	}
+	return false
+}
+
+// ParseOfflineDataUploadFailureReason returns the OfflineDataUploadFailureReason named by s.
+func ParseOfflineDataUploadFailureReason(s string) (OfflineDataUploadFailureReason, error) {
+	e := OfflineDataUploadFailureReason(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OfflineDataUploadFailureReason %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OfflineDataUploadFailureReason.
+func (e OfflineDataUploadFailureReason) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OfflineDataUploadFailureReason %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OfflineDataUploadFailureReason) UnmarshalText(text []byte) error {
+	v := OfflineDataUploadFailureReason(text)
+	if !v.IsValid() {
+		v = OfflineDataUploadFailureReasonUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OfflineDataUploadStatusValues returns all values of OfflineDataUploadStatus.
+func OfflineDataUploadStatusValues() []OfflineDataUploadStatus {
+	return []OfflineDataUploadStatus{
+		OfflineDataUploadStatusUNKNOWN,
+		OfflineDataUploadStatusFAILURE,
+		OfflineDataUploadStatusIN_PROCESS,
+		OfflineDataUploadStatusSUCCESS,
+	}
+}
+
+// IsValid reports whether e is one of the values of OfflineDataUploadStatus.
+func (e OfflineDataUploadStatus) IsValid() bool {
+	switch e {
+	case OfflineDataUploadStatusUNKNOWN,
+		OfflineDataUploadStatusFAILURE,
+		OfflineDataUploadStatusIN_PROCESS,
+		OfflineDataUploadStatusSUCCESS:
+		return true
+	}
+	return false
+}
+
+// ParseOfflineDataUploadStatus returns the OfflineDataUploadStatus named by s.
+func ParseOfflineDataUploadStatus(s string) (OfflineDataUploadStatus, error) {
+	e := OfflineDataUploadStatus(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OfflineDataUploadStatus %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OfflineDataUploadStatus.
+func (e OfflineDataUploadStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OfflineDataUploadStatus %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OfflineDataUploadStatus) UnmarshalText(text []byte) error {
+	v := OfflineDataUploadStatus(text)
+	if !v.IsValid() {
+		v = OfflineDataUploadStatusUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OfflineDataUploadTypeValues returns all values of OfflineDataUploadType.
+func OfflineDataUploadTypeValues() []OfflineDataUploadType {
+	return []OfflineDataUploadType{
+		OfflineDataUploadTypeUNKNOWN,
+		OfflineDataUploadTypeSTORE_SALES_UPLOAD_FIRST_PARTY,
+		OfflineDataUploadTypeSTORE_SALES_UPLOAD_THIRD_PARTY,
+	}
+}
+
+// IsValid reports whether e is one of the values of OfflineDataUploadType.
+func (e OfflineDataUploadType) IsValid() bool {
+	switch e {
+	case OfflineDataUploadTypeUNKNOWN,
+		OfflineDataUploadTypeSTORE_SALES_UPLOAD_FIRST_PARTY,
+		OfflineDataUploadTypeSTORE_SALES_UPLOAD_THIRD_PARTY:
+		return true
+	}
+	return false
+}
+
+// ParseOfflineDataUploadType returns the OfflineDataUploadType named by s.
+func ParseOfflineDataUploadType(s string) (OfflineDataUploadType, error) {
+	e := OfflineDataUploadType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OfflineDataUploadType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OfflineDataUploadType.
+func (e OfflineDataUploadType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OfflineDataUploadType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OfflineDataUploadType) UnmarshalText(text []byte) error {
+	v := OfflineDataUploadType(text)
+	if !v.IsValid() {
+		v = OfflineDataUploadTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
+
+// OfflineDataUploadUserIdentifierTypeValues returns all values of OfflineDataUploadUserIdentifierType.
+func OfflineDataUploadUserIdentifierTypeValues() []OfflineDataUploadUserIdentifierType {
+	return []OfflineDataUploadUserIdentifierType{
+		OfflineDataUploadUserIdentifierTypeUNKNOWN,
+		OfflineDataUploadUserIdentifierTypeHASHED_EMAIL,
+		OfflineDataUploadUserIdentifierTypeHASHED_PHONE,
+		OfflineDataUploadUserIdentifierTypeHASHED_LAST_NAME,
+		OfflineDataUploadUserIdentifierTypeHASHED_FIRST_NAME,
+		OfflineDataUploadUserIdentifierTypeCITY,
+		OfflineDataUploadUserIdentifierTypeSTATE,
+		OfflineDataUploadUserIdentifierTypeZIPCODE,
+		OfflineDataUploadUserIdentifierTypeCOUNTRY_CODE,
+		OfflineDataUploadUserIdentifierTypeEXTERNAL_USER_ID,
+	}
+}
+
+// IsValid reports whether e is one of the values of OfflineDataUploadUserIdentifierType.
+func (e OfflineDataUploadUserIdentifierType) IsValid() bool {
+	switch e {
+	case OfflineDataUploadUserIdentifierTypeUNKNOWN,
+		OfflineDataUploadUserIdentifierTypeHASHED_EMAIL,
+		OfflineDataUploadUserIdentifierTypeHASHED_PHONE,
+		OfflineDataUploadUserIdentifierTypeHASHED_LAST_NAME,
+		OfflineDataUploadUserIdentifierTypeHASHED_FIRST_NAME,
+		OfflineDataUploadUserIdentifierTypeCITY,
+		OfflineDataUploadUserIdentifierTypeSTATE,
+		OfflineDataUploadUserIdentifierTypeZIPCODE,
+		OfflineDataUploadUserIdentifierTypeCOUNTRY_CODE,
+		OfflineDataUploadUserIdentifierTypeEXTERNAL_USER_ID:
+		return true
+	}
+	return false
+}
+
+// ParseOfflineDataUploadUserIdentifierType returns the OfflineDataUploadUserIdentifierType named by s.
+func ParseOfflineDataUploadUserIdentifierType(s string) (OfflineDataUploadUserIdentifierType, error) {
+	e := OfflineDataUploadUserIdentifierType(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("invalid OfflineDataUploadUserIdentifierType %q", s)
+	}
+	return e, nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values that
+// are not part of OfflineDataUploadUserIdentifierType.
+func (e OfflineDataUploadUserIdentifierType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid OfflineDataUploadUserIdentifierType %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike Parse, it
+// accepts values added to the API after this version, so responses holding
+// them still decode. Such values become UNKNOWN.
+func (e *OfflineDataUploadUserIdentifierType) UnmarshalText(text []byte) error {
+	v := OfflineDataUploadUserIdentifierType(text)
+	if !v.IsValid() {
+		v = OfflineDataUploadUserIdentifierTypeUNKNOWN
+	}
+	*e = v
+	return nil
+}
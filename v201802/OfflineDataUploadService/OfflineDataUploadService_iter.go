@@ -0,0 +1,86 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineDataUploadService
+
+import (
+	"iter"
+
+	"github.com/godofdream/go-googleadsinofficial/paging"
+	"github.com/godofdream/go-googleadsinofficial/selector"
+)
+
+// GetAll returns an iterator over the OfflineDataUpload entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func GetAll(api OfflineDataUploadServiceAPI, sel *Selector) iter.Seq2[*OfflineDataUpload, error] {
+	return GetPager(api, sel).All()
+}
+
+// GetEach calls fn for every OfflineDataUpload entry selected by sel and
+// returns the first error. See GetAll.
+func GetEach(api OfflineDataUploadServiceAPI, sel *Selector, fn func(*OfflineDataUpload) error) error {
+	return GetPager(api, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func GetPager(api OfflineDataUploadServiceAPI, sel *Selector) *paging.Pager[*OfflineDataUpload] {
+	p := &paging.Pager[*OfflineDataUpload]{}
+	if sel == nil {
+		sel = new(Selector)
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*OfflineDataUpload, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+		resp, err := api.Get(&Get{ServiceSelector: paged})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.Page != nil && resp.Rval.Page.TotalNumEntries != nil {
+			total = *resp.Rval.Page.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
+
+// GetSplitAll is GetAll for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitAll(api OfflineDataUploadServiceAPI, sel *Selector, split *paging.Splitter) iter.Seq2[*OfflineDataUpload, error] {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitAll(split, sel.Neutral(), getSplitPager(api))
+}
+
+// GetSplitEach is GetEach for selections larger than the service
+// returns. See paging.Splitter.
+func GetSplitEach(api OfflineDataUploadServiceAPI, sel *Selector, split *paging.Splitter, fn func(*OfflineDataUpload) error) error {
+	if sel == nil {
+		sel = new(Selector)
+	}
+	return paging.SplitEach(split, sel.Neutral(), getSplitPager(api), fn)
+}
+
+func getSplitPager(api OfflineDataUploadServiceAPI) func(*selector.Selector) (*paging.Pager[*OfflineDataUpload], error) {
+	return func(s *selector.Selector) (*paging.Pager[*OfflineDataUpload], error) {
+		sel, err := ConvertSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return GetPager(api, sel), nil
+	}
+}
@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineDataUploadService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *OfflineDataUpload) *OfflineDataUploadOperation {
+	op := OperatorADD
+	return &OfflineDataUploadOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *OfflineDataUpload) *OfflineDataUploadOperation {
+	op := OperatorSET
+	return &OfflineDataUploadOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
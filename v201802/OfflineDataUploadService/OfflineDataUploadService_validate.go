@@ -0,0 +1,273 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package OfflineDataUploadService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Mutate against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Mutate) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks Operation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Operation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+// Validate checks OfflineDataUploadOperation against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *OfflineDataUploadOperation) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *DateRange) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(DateRange)
+	}
+	if t.Min != nil {
+		v.Date(validate.Field(path, "min"), *t.Min)
+	}
+	if t.Max != nil {
+		v.Date(validate.Field(path, "max"), *t.Max)
+	}
+}
+
+func (t *Operation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Operation)
+	}
+	if t.Operator != nil {
+		op = string(*t.Operator)
+	}
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+}
+
+func (t *OrderBy) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OrderBy)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Predicate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Predicate)
+	}
+	v.Required(validate.Field(path, "field"), t.Field != nil)
+	v.Required(validate.Field(path, "operator"), t.Operator != nil)
+	v.Required(validate.Field(path, "values"), len(t.Values) > 0)
+}
+
+func (t *Selector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Selector)
+	}
+	v.Required(validate.Field(path, "fields"), len(t.Fields) > 0)
+	v.ContentsDistinct(validate.Field(path, "fields"), t.Fields)
+	v.ContentsNotNull(validate.Field(path, "predicates"), t.Predicates)
+	for i, e := range t.Predicates {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "predicates"), i), op)
+		}
+	}
+	if t.DateRange != nil {
+		v.DateRange(validate.Field(path, "dateRange"), StringValue(t.DateRange.Min), StringValue(t.DateRange.Max), "19700101", "20380101")
+	}
+	if t.DateRange != nil {
+		t.DateRange.validate(v, validate.Field(path, "dateRange"), op)
+	}
+	for i, e := range t.Ordering {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "ordering"), i), op)
+		}
+	}
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "serviceSelector"), t.ServiceSelector != nil)
+	if t.ServiceSelector != nil {
+		t.ServiceSelector.validate(v, validate.Field(path, "serviceSelector"), op)
+	}
+}
+
+func (t *Mutate) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Mutate)
+	}
+	v.Required(validate.Field(path, "operations"), len(t.Operations) > 0)
+	if len(t.Operations) > 0 {
+		v.Size(validate.Field(path, "operations"), len(t.Operations), 1, -1)
+	}
+	v.ContentsNotNull(validate.Field(path, "operations"), t.Operations)
+	for i, e := range t.Operations {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "operations"), i), op)
+		}
+	}
+	if ops := t.Operations; len(ops) > 0 {
+		operators, ids := make([]string, len(ops)), make([]string, len(ops))
+		for i, o := range ops {
+			operators[i], ids[i] = o.operator(), o.operandID()
+			v.SupportedOperator(validate.Index(validate.Field(path, "operations"), i), operators[i], "ADD", "SET")
+		}
+	}
+}
+
+func (t *MoneyWithCurrency) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(MoneyWithCurrency)
+	}
+	if t.Money != nil && t.Money.MicroAmount != nil {
+		v.AtLeast(validate.Field(validate.Field(path, "money"), "microAmount"), float64(*t.Money.MicroAmount), 0.)
+	}
+	if t.CurrencyCode != nil {
+		v.StringLength(validate.Field(path, "currencyCode"), *t.CurrencyCode, 3, 3, true, false)
+	}
+}
+
+func (t *OfflineData) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OfflineData)
+	}
+	if t.StoreSalesTransaction != nil {
+		t.StoreSalesTransaction.validate(v, validate.Field(path, "storeSalesTransaction"), op)
+	}
+}
+
+func (t *OfflineDataUpload) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OfflineDataUpload)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "externalUploadId"), t.ExternalUploadId != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "uploadType"), t.UploadType != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "uploadStatus"), op, t.UploadStatus != nil)
+	}
+	if t.UploadMetadata != nil {
+		t.UploadMetadata.validate(v, validate.Field(path, "uploadMetadata"), op)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "offlineDataList"), len(t.OfflineDataList) > 0)
+	}
+	for i, e := range t.OfflineDataList {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "offlineDataList"), i), op)
+		}
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "failureReason"), op, t.FailureReason != nil)
+	}
+}
+
+func (t *OfflineDataUploadOperation) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(OfflineDataUploadOperation)
+	}
+	if t.Operation != nil && t.Operation.Operator != nil {
+		op = string(*t.Operation.Operator)
+	}
+	t.Operation.validate(v, path, op)
+	v.Required(validate.Field(path, "operand"), t.Operand != nil)
+	if t.Operand != nil {
+		t.Operand.validate(v, validate.Field(path, "operand"), op)
+	}
+}
+
+func (t *StoreSalesTransaction) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(StoreSalesTransaction)
+	}
+	v.Required(validate.Field(path, "userIdentifiers"), len(t.UserIdentifiers) > 0)
+	for i, e := range t.UserIdentifiers {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "userIdentifiers"), i), op)
+		}
+	}
+	v.Required(validate.Field(path, "transactionTime"), t.TransactionTime != nil)
+	if t.TransactionTime != nil {
+		v.DateTime(validate.Field(path, "transactionTime"), *t.TransactionTime)
+	}
+	v.Required(validate.Field(path, "transactionAmount"), t.TransactionAmount != nil)
+	if t.TransactionAmount != nil {
+		t.TransactionAmount.validate(v, validate.Field(path, "transactionAmount"), op)
+	}
+	v.Required(validate.Field(path, "conversionName"), t.ConversionName != nil)
+}
+
+func (t *StoreSalesUploadCommonMetadata) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(StoreSalesUploadCommonMetadata)
+	}
+	v.Required(validate.Field(path, "loyaltyRate"), t.LoyaltyRate != nil)
+	v.Required(validate.Field(path, "transactionUploadRate"), t.TransactionUploadRate != nil)
+}
+
+func (t *UploadMetadata) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(UploadMetadata)
+	}
+	if t.StoreSalesUploadCommonMetadata != nil {
+		t.StoreSalesUploadCommonMetadata.validate(v, validate.Field(path, "storeSalesUploadCommonMetadata"), op)
+	}
+}
+
+func (t *UserIdentifier) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(UserIdentifier)
+	}
+	v.Required(validate.Field(path, "userIdentifierType"), t.UserIdentifierType != nil)
+	v.Required(validate.Field(path, "value"), t.Value != nil)
+}
+
+func (o *OfflineDataUploadOperation) operator() string {
+	if o == nil || !(o.Operation != nil && o.Operation.Operator != nil) {
+		return ""
+	}
+	return string(*o.Operation.Operator)
+}
+
+func (o *OfflineDataUploadOperation) operandID() string {
+	if o == nil || o.Operand == nil {
+		return ""
+	}
+	t := o.Operand
+	return validate.ID(t.ExternalUploadId)
+}
@@ -0,0 +1,76 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ReportDefinitionService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ReportDefinitionServiceAPI is the interface of the ReportDefinitionService operations. It is
+// implemented by ReportDefinitionServiceInterface and, for tests, by FakeReportDefinitionService.
+type ReportDefinitionServiceAPI interface {
+	GetReportFields(request *GetReportFields) (*GetReportFieldsResponse, error)
+}
+
+var _ ReportDefinitionServiceAPI = (*ReportDefinitionServiceInterface)(nil)
+var _ ReportDefinitionServiceAPI = (*FakeReportDefinitionService)(nil)
+
+// FakeCall is a call recorded by FakeReportDefinitionService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "GetReportFields".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeReportDefinitionService is a programmable ReportDefinitionServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeReportDefinitionService struct {
+	GetReportFieldsFunc func(request *GetReportFields) (*GetReportFieldsResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeReportDefinitionService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeReportDefinitionService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeReportDefinitionService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// GetReportFields records the call and delegates to GetReportFieldsFunc.
+func (f *FakeReportDefinitionService) GetReportFields(request *GetReportFields) (*GetReportFieldsResponse, error) {
+	f.record("GetReportFields", request)
+	if f.GetReportFieldsFunc == nil {
+		return nil, fmt.Errorf("FakeReportDefinitionService.GetReportFieldsFunc is not set")
+	}
+	return f.GetReportFieldsFunc(request)
+}
+
+// GetReportFieldsCalls returns the requests of the recorded GetReportFields calls.
+func (f *FakeReportDefinitionService) GetReportFieldsCalls() []*GetReportFields {
+	var requests []*GetReportFields
+	for _, c := range f.Calls() {
+		if c.Method == "GetReportFields" {
+			requests = append(requests, c.Request.(*GetReportFields))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,105 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ReportDefinitionService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+var typeFields = map[string][]FieldInfo{
+	"GetReportFields": {
+		{Type: "GetReportFields", Name: "ReportType", Required: true},
+	},
+	"EnumValuePair": {
+		{Type: "EnumValuePair", Name: "EnumValue", ReadOnly: true},
+		{Type: "EnumValuePair", Name: "EnumDisplayValue", ReadOnly: true},
+	},
+	"ReportDefinitionField": {
+		{Type: "ReportDefinitionField", Name: "FieldName", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "DisplayFieldName", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "XmlAttributeName", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "FieldType", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "FieldBehavior", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "EnumValues", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "CanSelect", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "CanFilter", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "IsEnumType", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "IsBeta", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "IsZeroRowCompatible", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "EnumValuePairs", ReadOnly: true},
+		{Type: "ReportDefinitionField", Name: "ExclusiveFields", ReadOnly: true},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package ReportDefinitionService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks GetReportFields against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *GetReportFields) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *GetReportFields) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(GetReportFields)
+	}
+	v.Required(validate.Field(path, "reportType"), t.ReportType != nil)
+}
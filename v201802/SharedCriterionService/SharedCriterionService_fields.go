@@ -0,0 +1,362 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package SharedCriterionService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// CriterionFields holds the selector field names of Criterion, including
+// the fields of the types it holds.
+var CriterionFields = struct {
+	AppId            string
+	ChannelId        string
+	ChannelName      string
+	CriteriaType     string
+	DisplayName      string
+	Id               string
+	KeywordMatchType string
+	KeywordText      string
+	PlacementUrl     string
+	VideoId          string
+	VideoName        string
+}{
+	AppId:            "AppId",
+	ChannelId:        "ChannelId",
+	ChannelName:      "ChannelName",
+	CriteriaType:     "CriteriaType",
+	DisplayName:      "DisplayName",
+	Id:               "Id",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+	PlacementUrl:     "PlacementUrl",
+	VideoId:          "VideoId",
+	VideoName:        "VideoName",
+}
+
+// KeywordFields holds the selector field names of Keyword, including
+// the fields of the types it holds.
+var KeywordFields = struct {
+	CriteriaType     string
+	Id               string
+	KeywordMatchType string
+	KeywordText      string
+}{
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+}
+
+// MobileAppCategoryFields holds the selector field names of MobileAppCategory, including
+// the fields of the types it holds.
+var MobileAppCategoryFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// MobileApplicationFields holds the selector field names of MobileApplication, including
+// the fields of the types it holds.
+var MobileApplicationFields = struct {
+	AppId        string
+	CriteriaType string
+	DisplayName  string
+	Id           string
+}{
+	AppId:        "AppId",
+	CriteriaType: "CriteriaType",
+	DisplayName:  "DisplayName",
+	Id:           "Id",
+}
+
+// PlacementFields holds the selector field names of Placement, including
+// the fields of the types it holds.
+var PlacementFields = struct {
+	CriteriaType string
+	Id           string
+	PlacementUrl string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	PlacementUrl: "PlacementUrl",
+}
+
+// SharedCriterionFields holds the selector field names of SharedCriterion, including
+// the fields of the types it holds.
+var SharedCriterionFields = struct {
+	AppId            string
+	ChannelId        string
+	ChannelName      string
+	CriteriaType     string
+	DisplayName      string
+	Id               string
+	KeywordMatchType string
+	KeywordText      string
+	Negative         string
+	PlacementUrl     string
+	SharedSetId      string
+	VideoId          string
+	VideoName        string
+}{
+	AppId:            "AppId",
+	ChannelId:        "ChannelId",
+	ChannelName:      "ChannelName",
+	CriteriaType:     "CriteriaType",
+	DisplayName:      "DisplayName",
+	Id:               "Id",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+	Negative:         "Negative",
+	PlacementUrl:     "PlacementUrl",
+	SharedSetId:      "SharedSetId",
+	VideoId:          "VideoId",
+	VideoName:        "VideoName",
+}
+
+// CriterionUserInterestFields holds the selector field names of CriterionUserInterest, including
+// the fields of the types it holds.
+var CriterionUserInterestFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// CriterionUserListFields holds the selector field names of CriterionUserList, including
+// the fields of the types it holds.
+var CriterionUserListFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// VerticalFields holds the selector field names of Vertical, including
+// the fields of the types it holds.
+var VerticalFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// YouTubeChannelFields holds the selector field names of YouTubeChannel, including
+// the fields of the types it holds.
+var YouTubeChannelFields = struct {
+	ChannelId    string
+	ChannelName  string
+	CriteriaType string
+	Id           string
+}{
+	ChannelId:    "ChannelId",
+	ChannelName:  "ChannelName",
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// YouTubeVideoFields holds the selector field names of YouTubeVideo, including
+// the fields of the types it holds.
+var YouTubeVideoFields = struct {
+	CriteriaType string
+	Id           string
+	VideoId      string
+	VideoName    string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	VideoId:      "VideoId",
+	VideoName:    "VideoName",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"Mutate": {
+		{Type: "Mutate", Name: "Operations", Required: true},
+	},
+	"Query": {
+		{Type: "Query", Name: "Query", Required: true},
+	},
+	"Criterion": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+	},
+	"Keyword": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Keyword", Name: "Text", Selectors: []string{"KeywordText"}, Filter: "KeywordText", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Keyword", Name: "MatchType", Selectors: []string{"KeywordMatchType"}, Filter: "KeywordMatchType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"MobileAppCategory": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileAppCategory", Name: "MobileAppCategoryId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileAppCategory", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileApplication": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileApplication", Name: "AppId", Selectors: []string{"AppId"}, Filter: "AppId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileApplication", Name: "DisplayName", Selectors: []string{"DisplayName"}, Filter: "DisplayName", ReadOnly: true},
+	},
+	"Operation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+	},
+	"OrderBy": {
+		{Type: "OrderBy", Name: "Field", Required: true},
+		{Type: "OrderBy", Name: "SortOrder"},
+	},
+	"Placement": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Placement", Name: "Url", Selectors: []string{"PlacementUrl"}, Filter: "PlacementUrl", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Predicate": {
+		{Type: "Predicate", Name: "Field", Required: true},
+		{Type: "Predicate", Name: "Operator", Required: true},
+		{Type: "Predicate", Name: "Values", Required: true},
+	},
+	"Selector": {
+		{Type: "Selector", Name: "Fields", Required: true},
+		{Type: "Selector", Name: "Predicates"},
+		{Type: "Selector", Name: "DateRange"},
+		{Type: "Selector", Name: "Ordering"},
+		{Type: "Selector", Name: "Paging"},
+	},
+	"SharedCriterion": {
+		{Type: "SharedCriterion", Name: "SharedSetId", Selectors: []string{"SharedSetId"}, Filter: "SharedSetId", Required: true},
+		{Type: "SharedCriterion", Name: "Criterion", Required: true},
+		{Type: "SharedCriterion", Name: "Negative", Selectors: []string{"Negative"}, Filter: "Negative"},
+	},
+	"SharedCriterionOperation": {
+		{Type: "Operation", Name: "Operator", Required: true},
+		{Type: "Operation", Name: "OperationType"},
+		{Type: "SharedCriterionOperation", Name: "Operand", Required: true},
+	},
+	"CriterionUserInterest": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserInterest", Name: "UserInterestId"},
+		{Type: "CriterionUserInterest", Name: "UserInterestParentId", ReadOnly: true},
+		{Type: "CriterionUserInterest", Name: "UserInterestName"},
+	},
+	"CriterionUserList": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserList", Name: "UserListId"},
+		{Type: "CriterionUserList", Name: "UserListName"},
+		{Type: "CriterionUserList", Name: "UserListMembershipStatus"},
+		{Type: "CriterionUserList", Name: "UserListEligibleForSearch", ReadOnly: true},
+		{Type: "CriterionUserList", Name: "UserListEligibleForDisplay", ReadOnly: true},
+	},
+	"Vertical": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Vertical", Name: "VerticalId"},
+		{Type: "Vertical", Name: "VerticalParentId", ReadOnly: true},
+		{Type: "Vertical", Name: "Path"},
+	},
+	"YouTubeChannel": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "YouTubeChannel", Name: "ChannelId", Selectors: []string{"ChannelId"}},
+		{Type: "YouTubeChannel", Name: "ChannelName", Selectors: []string{"ChannelName"}, ReadOnly: true},
+	},
+	"YouTubeVideo": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "YouTubeVideo", Name: "VideoId", Selectors: []string{"VideoId"}},
+		{Type: "YouTubeVideo", Name: "VideoName", Selectors: []string{"VideoName"}, ReadOnly: true},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
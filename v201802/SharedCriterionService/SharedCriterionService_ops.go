@@ -0,0 +1,15 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package SharedCriterionService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *SharedCriterion) *SharedCriterionOperation {
+	op := OperatorADD
+	return &SharedCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *SharedCriterion) *SharedCriterionOperation {
+	op := OperatorREMOVE
+	return &SharedCriterionOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
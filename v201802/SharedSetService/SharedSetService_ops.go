@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package SharedSetService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *SharedSet) *SharedSetOperation {
+	op := OperatorADD
+	return &SharedSetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *SharedSet) *SharedSetOperation {
+	op := OperatorSET
+	return &SharedSetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *SharedSet) *SharedSetOperation {
+	op := OperatorREMOVE
+	return &SharedSetOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
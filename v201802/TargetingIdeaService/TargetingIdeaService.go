@@ -1704,321 +1704,271 @@ const (
 )
 
 type AdGroupCriterionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdGroupCriterionErrorReason `xml:"reason,omitempty"`
+	Reason *AdGroupCriterionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AdGroupCriterionLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdGroupCriterionLimitExceeded"`
-
 	*EntityCountLimitExceeded
 
-	LimitType *AdGroupCriterionLimitExceededCriteriaLimitType `xml:"limitType,omitempty"`
+	LimitType *AdGroupCriterionLimitExceededCriteriaLimitType `xml:"https://adwords.google.com/api/adwords/cm/v201802 limitType,omitempty" json:"limitType,omitempty"`
 }
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type BudgetError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 BudgetError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *BudgetErrorReason `xml:"reason,omitempty"`
+	Reason *BudgetErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CollectionSizeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CollectionSizeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CollectionSizeErrorReason `xml:"reason,omitempty"`
+	Reason *CollectionSizeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type Criterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion"`
-
 	//
 	// ID of this criterion.
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "CriteriaType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Type_ *CriterionType `xml:"type,omitempty"`
+	Type_ *CriterionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Criterion.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CriterionType string `xml:"Criterion.Type,omitempty"`
+	CriterionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion.Type,omitempty" json:"criterionType,omitempty"`
 }
 
 type CriterionError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionError"`
-
 	*ApiError
 
-	Reason *CriterionErrorReason `xml:"reason,omitempty"`
+	Reason *CriterionErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CriterionPolicyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionPolicyError"`
-
 	*PolicyViolationError
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type EntityCountLimitExceeded struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityCountLimitExceeded"`
-
 	*ApiError
 
 	//
 	// Specifies which level's limit was exceeded.
 	//
-	Reason *EntityCountLimitExceededReason `xml:"reason,omitempty"`
+	Reason *EntityCountLimitExceededReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Id of the entity whose limit was exceeded.
 	//
-	EnclosingId string `xml:"enclosingId,omitempty"`
+	EnclosingId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 enclosingId,omitempty" json:"enclosingId,omitempty"`
 
 	//
 	// The limit which was exceeded.
 	//
-	Limit int32 `xml:"limit,omitempty"`
+	Limit *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 limit,omitempty" json:"limit,omitempty"`
 
 	//
 	// The account limit type which was exceeded.
 	//
-	AccountLimitType string `xml:"accountLimitType,omitempty"`
+	AccountLimitType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 accountLimitType,omitempty" json:"accountLimitType,omitempty"`
 
 	//
 	// The count of existing entities.
 	//
-	ExistingCount int32 `xml:"existingCount,omitempty"`
+	ExistingCount *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 existingCount,omitempty" json:"existingCount,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Keyword struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Keyword"`
-
 	*Criterion
 
 	//
@@ -2027,35 +1977,31 @@ type Keyword struct {
 	// <span class="constraint MatchesRegex">Keyword text must not contain NUL (code point 0x0) characters. This is checked by the regular expression '[^\x00]*'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Text string `xml:"text,omitempty"`
+	Text *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 text,omitempty" json:"text,omitempty"`
 
 	//
 	// Match type of this keyword.
 	// <span class="constraint Selectable">This field can be selected using the value "KeywordMatchType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MatchType *KeywordMatchType `xml:"matchType,omitempty"`
+	MatchType *KeywordMatchType `xml:"https://adwords.google.com/api/adwords/cm/v201802 matchType,omitempty" json:"matchType,omitempty"`
 }
 
 type Language struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Language"`
-
 	*Criterion
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Code string `xml:"code,omitempty"`
+	Code *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 code,omitempty" json:"code,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type Location struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Location"`
-
 	*Criterion
 
 	//
@@ -2064,41 +2010,37 @@ type Location struct {
 	// characters.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	LocationName string `xml:"locationName,omitempty"`
+	LocationName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 locationName,omitempty" json:"locationName,omitempty"`
 
 	//
 	// Display type of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayType string `xml:"displayType,omitempty"`
+	DisplayType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayType,omitempty" json:"displayType,omitempty"`
 
 	//
 	// The targeting status of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	TargetingStatus *LocationTargetingStatus `xml:"targetingStatus,omitempty"`
+	TargetingStatus *LocationTargetingStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetingStatus,omitempty" json:"targetingStatus,omitempty"`
 
 	//
 	// Ordered list of parents of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ParentLocations []*Location `xml:"parentLocations,omitempty"`
+	ParentLocations []*Location `xml:"https://adwords.google.com/api/adwords/cm/v201802 parentLocations,omitempty" json:"parentLocations,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type MobileAppCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileAppCategory"`
-
 	*Criterion
 
 	//
@@ -2107,18 +2049,16 @@ type MobileAppCategory struct {
 	// <span class="constraint Selectable">This field can be selected using the value "MobileAppCategoryId".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MobileAppCategoryId int32 `xml:"mobileAppCategoryId,omitempty"`
+	MobileAppCategoryId *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 mobileAppCategoryId,omitempty" json:"mobileAppCategoryId,omitempty"`
 
 	//
 	// Name of this mobile app category.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type MobileApplication struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileApplication"`
-
 	*Criterion
 
 	//
@@ -2137,30 +2077,26 @@ type MobileApplication struct {
 	// <span class="constraint Selectable">This field can be selected using the value "AppId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// Title of this mobile application.
 	// <span class="constraint Selectable">This field can be selected using the value "DisplayName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type NetworkSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NetworkSetting"`
-
 	//
 	// Ads will be served with Google.com search results.
 	// <span class="constraint AdxEnabled">This is disabled for AdX.</span>
@@ -2169,7 +2105,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel type DISPLAY.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetGoogleSearch bool `xml:"targetGoogleSearch,omitempty"`
+	TargetGoogleSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetGoogleSearch,omitempty" json:"targetGoogleSearch,omitempty"`
 
 	//
 	// Ads will be served on partner sites in the Google Search Network
@@ -2178,7 +2114,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel type MULTI_CHANNEL.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetSearchNetwork bool `xml:"targetSearchNetwork,omitempty"`
+	TargetSearchNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetSearchNetwork,omitempty" json:"targetSearchNetwork,omitempty"`
 
 	//
 	// Ads will be served on specified placements in the Google Display Network.
@@ -2187,7 +2123,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel subtype SEARCH_MOBILE_APP.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetContentNetwork bool `xml:"targetContentNetwork,omitempty"`
+	TargetContentNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetContentNetwork,omitempty" json:"targetContentNetwork,omitempty"`
 
 	//
 	// Ads will be served on the Google Partner Network. This is available to
@@ -2195,76 +2131,62 @@ type NetworkSetting struct {
 	// <span class="constraint AdxEnabled">This is disabled for AdX.</span>
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel type MULTI_CHANNEL.</span>
 	//
-	TargetPartnerSearchNetwork bool `xml:"targetPartnerSearchNetwork,omitempty"`
+	TargetPartnerSearchNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetPartnerSearchNetwork,omitempty" json:"targetPartnerSearchNetwork,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Paging struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Paging"`
-
 	//
 	// Index of the first result to return in this page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	StartIndex int32 `xml:"startIndex,omitempty"`
+	StartIndex *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 startIndex,omitempty" json:"startIndex,omitempty"`
 
 	//
 	// Maximum number of results to return in this page. Set this to a reasonable value to limit
 	// the number of results returned per page.
 	// <span class="constraint InRange">This field must be greater than or equal to 0.</span>
 	//
-	NumberResults int32 `xml:"numberResults,omitempty"`
+	NumberResults *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 numberResults,omitempty" json:"numberResults,omitempty"`
 }
 
 type Placement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Placement"`
-
 	*Criterion
 
 	//
@@ -2274,79 +2196,71 @@ type Placement struct {
 	// <span class="constraint Selectable">This field can be selected using the value "PlacementUrl".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 }
 
 type Platform struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Platform"`
-
 	*Criterion
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PlatformName string `xml:"platformName,omitempty"`
+	PlatformName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 platformName,omitempty" json:"platformName,omitempty"`
 }
 
 type PolicyViolationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationError"`
-
 	*ApiError
 
 	//
 	// Unique identifier for the violation.
 	//
-	Key *PolicyViolationKey `xml:"key,omitempty"`
+	Key *PolicyViolationKey `xml:"https://adwords.google.com/api/adwords/cm/v201802 key,omitempty" json:"key,omitempty"`
 
 	//
 	// Name of policy suitable for display to users. In the user's preferred
 	// language.
 	//
-	ExternalPolicyName string `xml:"externalPolicyName,omitempty"`
+	ExternalPolicyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyName,omitempty" json:"externalPolicyName,omitempty"`
 
 	//
 	// Url with writeup about the policy.
 	//
-	ExternalPolicyUrl string `xml:"externalPolicyUrl,omitempty"`
+	ExternalPolicyUrl *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyUrl,omitempty" json:"externalPolicyUrl,omitempty"`
 
 	//
 	// Localized description of the violation.
 	//
-	ExternalPolicyDescription string `xml:"externalPolicyDescription,omitempty"`
+	ExternalPolicyDescription *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 externalPolicyDescription,omitempty" json:"externalPolicyDescription,omitempty"`
 
 	//
 	// Whether user can file an exemption request for this violation.
 	//
-	IsExemptable bool `xml:"isExemptable,omitempty"`
+	IsExemptable *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 isExemptable,omitempty" json:"isExemptable,omitempty"`
 
 	//
 	// Lists the parts that violate the policy.
 	//
-	ViolatingParts []*PolicyViolationErrorPart `xml:"violatingParts,omitempty"`
+	ViolatingParts []*PolicyViolationErrorPart `xml:"https://adwords.google.com/api/adwords/cm/v201802 violatingParts,omitempty" json:"violatingParts,omitempty"`
 }
 
 type PolicyViolationErrorPart struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationError.Part"`
-
 	//
 	// Index of the starting position of the violating text within the line.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 
 	//
 	// The length of the violating text.
 	//
-	Length int32 `xml:"length,omitempty"`
+	Length *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 length,omitempty" json:"length,omitempty"`
 }
 
 type PolicyViolationKey struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 PolicyViolationKey"`
-
 	//
 	// Unique id of the violated policy.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	PolicyName string `xml:"policyName,omitempty"`
+	PolicyName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 policyName,omitempty" json:"policyName,omitempty"`
 
 	//
 	// The text that violates the policy if specified. Otherwise, refers to the
@@ -2356,132 +2270,114 @@ type PolicyViolationKey struct {
 	// May be null for criterion exemptions, in which case this refers to the
 	// whole policy. Must be specified for ad exemptions.
 	//
-	ViolatingText string `xml:"violatingText,omitempty"`
+	ViolatingText *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 violatingText,omitempty" json:"violatingText,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RegionCodeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RegionCodeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RegionCodeErrorReason `xml:"reason,omitempty"`
+	Reason *RegionCodeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -2489,12 +2385,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -2502,115 +2398,105 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StatsQueryError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StatsQueryError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StatsQueryErrorReason `xml:"reason,omitempty"`
+	Reason *StatsQueryErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CriterionUserInterest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserInterest"`
-
 	*Criterion
 
 	//
 	// Id of this user interest. This is a required field.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestId".</span>
 	//
-	UserInterestId int64 `xml:"userInterestId,omitempty"`
+	UserInterestId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestId,omitempty" json:"userInterestId,omitempty"`
 
 	//
 	// Parent Id of this user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestParentId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserInterestParentId int64 `xml:"userInterestParentId,omitempty"`
+	UserInterestParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestParentId,omitempty" json:"userInterestParentId,omitempty"`
 
 	//
 	// Name of this user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestName".</span>
 	//
-	UserInterestName string `xml:"userInterestName,omitempty"`
+	UserInterestName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestName,omitempty" json:"userInterestName,omitempty"`
 }
 
 type CriterionUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserList"`
-
 	*Criterion
 
 	//
 	// Id of this user list. This is a required field.
 	// <span class="constraint Selectable">This field can be selected using the value "UserListId".</span>
 	//
-	UserListId int64 `xml:"userListId,omitempty"`
+	UserListId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListId,omitempty" json:"userListId,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "UserListName".</span>
 	//
-	UserListName string `xml:"userListName,omitempty"`
+	UserListName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListName,omitempty" json:"userListName,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "UserListMembershipStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"userListMembershipStatus,omitempty"`
+	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListMembershipStatus,omitempty" json:"userListMembershipStatus,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the google.com
@@ -2618,33 +2504,31 @@ type CriterionUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "UserListEligibleForSearch".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForSearch bool `xml:"userListEligibleForSearch,omitempty"`
+	UserListEligibleForSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForSearch,omitempty" json:"userListEligibleForSearch,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the display network.
 	// <span class="constraint Selectable">This field can be selected using the value "UserListEligibleForDisplay".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForDisplay bool `xml:"userListEligibleForDisplay,omitempty"`
+	UserListEligibleForDisplay *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForDisplay,omitempty" json:"userListEligibleForDisplay,omitempty"`
 }
 
 type Vertical struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Vertical"`
-
 	*Criterion
 
 	//
 	// Id of this vertical.
 	// <span class="constraint Selectable">This field can be selected using the value "VerticalId".</span>
 	//
-	VerticalId int64 `xml:"verticalId,omitempty"`
+	VerticalId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalId,omitempty" json:"verticalId,omitempty"`
 
 	//
 	// Id of the parent of this vertical.
 	// <span class="constraint Selectable">This field can be selected using the value "VerticalParentId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	VerticalParentId int64 `xml:"verticalParentId,omitempty"`
+	VerticalParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalParentId,omitempty" json:"verticalParentId,omitempty"`
 
 	//
 	// The category to target or exclude. Each subsequent element in the array
@@ -2655,7 +2539,7 @@ type Vertical struct {
 	// This field is required and must not be empty.
 	// <span class="constraint Selectable">This field can be selected using the value "Path".</span>
 	//
-	Path []string `xml:"path,omitempty"`
+	Path []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 path,omitempty" json:"path,omitempty"`
 }
 
 //
@@ -2964,45 +2848,39 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Selector *TargetingIdeaSelector `xml:"selector,omitempty"`
+	Selector *TargetingIdeaSelector `xml:"https://adwords.google.com/api/adwords/o/v201802 selector,omitempty" json:"selector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 getResponse" json:"-"`
 
-	Rval *TargetingIdeaPage `xml:"rval,omitempty"`
+	Rval *TargetingIdeaPage `xml:"https://adwords.google.com/api/adwords/o/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type Attribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 Attribute"`
-
 	//
 	// Indicates that this instance is a subtype of Attribute.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	AttributeType string `xml:"Attribute.Type,omitempty"`
+	AttributeType *string `xml:"https://adwords.google.com/api/adwords/o/v201802 Attribute.Type,omitempty" json:"attributeType,omitempty"`
 }
 
 type BooleanAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 BooleanAttribute"`
-
 	*Attribute
 
 	//
 	// Boolean value contained by this {@link Attribute}.
 	//
-	Value bool `xml:"value,omitempty"`
+	Value *bool `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type CategoryProductsAndServicesSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 CategoryProductsAndServicesSearchParameter"`
-
 	*SearchParameter
 
 	//
@@ -3010,12 +2888,10 @@ type CategoryProductsAndServicesSearchParameter struct {
 	// search results should belong to.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	CategoryId int32 `xml:"categoryId,omitempty"`
+	CategoryId *int32 `xml:"https://adwords.google.com/api/adwords/o/v201802 categoryId,omitempty" json:"categoryId,omitempty"`
 }
 
 type CompetitionSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 CompetitionSearchParameter"`
-
 	*SearchParameter
 
 	//
@@ -3026,45 +2902,37 @@ type CompetitionSearchParameter struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Levels []*CompetitionSearchParameterLevel `xml:"levels,omitempty"`
+	Levels []*CompetitionSearchParameterLevel `xml:"https://adwords.google.com/api/adwords/o/v201802 levels,omitempty" json:"levels,omitempty"`
 }
 
 type CriterionAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 CriterionAttribute"`
-
 	*Attribute
 
 	//
 	// Criterion value contained by this {@link Attribute}.
 	//
-	Value *Criterion `xml:"value,omitempty"`
+	Value *Criterion `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type CurrencyCodeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 CurrencyCodeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CurrencyCodeErrorReason `xml:"reason,omitempty"`
+	Reason *CurrencyCodeErrorReason `xml:"https://adwords.google.com/api/adwords/o/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 DoubleAttribute"`
-
 	*Attribute
 
 	//
 	// Double value contained by this {@link Attribute}.
 	//
-	Value float64 `xml:"value,omitempty"`
+	Value *float64 `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type IdeaTextFilterSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 IdeaTextFilterSearchParameter"`
-
 	*SearchParameter
 
 	//
@@ -3073,7 +2941,7 @@ type IdeaTextFilterSearchParameter struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint ContentsStringLength">Strings in this field must be non-empty (trimmed).</span>
 	//
-	Included []string `xml:"included,omitempty"`
+	Included []string `xml:"https://adwords.google.com/api/adwords/o/v201802 included,omitempty" json:"included,omitempty"`
 
 	//
 	// A set of strings specifying which ideas should be excluded from the results.
@@ -3081,63 +2949,51 @@ type IdeaTextFilterSearchParameter struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint ContentsStringLength">Strings in this field must be non-empty (trimmed).</span>
 	//
-	Excluded []string `xml:"excluded,omitempty"`
+	Excluded []string `xml:"https://adwords.google.com/api/adwords/o/v201802 excluded,omitempty" json:"excluded,omitempty"`
 }
 
 type IdeaTypeAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 IdeaTypeAttribute"`
-
 	*Attribute
 
 	//
 	// {@link IdeaType} value contained by this {@link Attribute}.
 	//
-	Value *IdeaType `xml:"value,omitempty"`
+	Value *IdeaType `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type IncludeAdultContentSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 IncludeAdultContentSearchParameter"`
-
 	*SearchParameter
 }
 
 type IntegerAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 IntegerAttribute"`
-
 	*Attribute
 
 	//
 	// Integer value contained by this {@link Attribute}.
 	//
-	Value int32 `xml:"value,omitempty"`
+	Value *int32 `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type IntegerSetAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 IntegerSetAttribute"`
-
 	*Attribute
 
 	//
 	// Set of integer values contained by this {@link Attribute}.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Value []int32 `xml:"value,omitempty"`
+	Value []int32 `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type KeywordAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 KeywordAttribute"`
-
 	*Attribute
 
 	//
 	// {@link Keyword} value contained by this {@link Attribute}.
 	//
-	Value *Keyword `xml:"value,omitempty"`
+	Value *Keyword `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type LanguageSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 LanguageSearchParameter"`
-
 	*SearchParameter
 
 	//
@@ -3147,12 +3003,10 @@ type LanguageSearchParameter struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Languages []*Language `xml:"languages,omitempty"`
+	Languages []*Language `xml:"https://adwords.google.com/api/adwords/o/v201802 languages,omitempty" json:"languages,omitempty"`
 }
 
 type LocationSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 LocationSearchParameter"`
-
 	*SearchParameter
 
 	//
@@ -3163,81 +3017,69 @@ type LocationSearchParameter struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Locations []*Location `xml:"locations,omitempty"`
+	Locations []*Location `xml:"https://adwords.google.com/api/adwords/o/v201802 locations,omitempty" json:"locations,omitempty"`
 }
 
 type LongAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 LongAttribute"`
-
 	*Attribute
 
 	//
 	// Long value contained by this {@link Attribute}.
 	//
-	Value int64 `xml:"value,omitempty"`
+	Value *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type LongComparisonOperation struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 LongComparisonOperation"`
-
 	//
 	// The minimum value of elements returned by this operation (inclusive).
 	//
-	Minimum int64 `xml:"minimum,omitempty"`
+	Minimum *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 minimum,omitempty" json:"minimum,omitempty"`
 
 	//
 	// The maximum value of elements returned by this operation (inclusive).
 	//
-	Maximum int64 `xml:"maximum,omitempty"`
+	Maximum *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 maximum,omitempty" json:"maximum,omitempty"`
 }
 
 type LongRangeAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 LongRangeAttribute"`
-
 	*Attribute
 
 	//
 	// {@link Range} of {@link LongValue} values contained by this
 	// {@link Attribute}.
 	//
-	Value *Range `xml:"value,omitempty"`
+	Value *Range `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type MoneyAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 MoneyAttribute"`
-
 	*Attribute
 
 	//
 	// {@link Money} value contained by this {@link Attribute}.
 	//
-	Value *Money `xml:"value,omitempty"`
+	Value *Money `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type MonthlySearchVolume struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 MonthlySearchVolume"`
-
 	//
 	// The year this search volume occurred in. (i.e. 2009)
 	//
-	Year int32 `xml:"year,omitempty"`
+	Year *int32 `xml:"https://adwords.google.com/api/adwords/o/v201802 year,omitempty" json:"year,omitempty"`
 
 	//
 	// The month this search volume occurred in. Month is 1 indexed,
 	// such that 1=January and 12=December.
 	//
-	Month int32 `xml:"month,omitempty"`
+	Month *int32 `xml:"https://adwords.google.com/api/adwords/o/v201802 month,omitempty" json:"month,omitempty"`
 
 	//
 	// The approximate number of searches in this year/month. A {@code null} count
 	// means that data is unavailable or unknown.
 	//
-	Count int64 `xml:"count,omitempty"`
+	Count *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 count,omitempty" json:"count,omitempty"`
 }
 
 type MonthlySearchVolumeAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 MonthlySearchVolumeAttribute"`
-
 	*Attribute
 
 	//
@@ -3247,12 +3089,10 @@ type MonthlySearchVolumeAttribute struct {
 	// month.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Value []*MonthlySearchVolume `xml:"value,omitempty"`
+	Value []*MonthlySearchVolume `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type NetworkSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 NetworkSearchParameter"`
-
 	*SearchParameter
 
 	//
@@ -3265,20 +3105,16 @@ type NetworkSearchParameter struct {
 	// </ul>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	NetworkSetting *NetworkSetting `xml:"networkSetting,omitempty"`
+	NetworkSetting *NetworkSetting `xml:"https://adwords.google.com/api/adwords/o/v201802 networkSetting,omitempty" json:"networkSetting,omitempty"`
 }
 
 type Range struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 Range"`
-
-	Min *ComparableValue `xml:"min,omitempty"`
+	Min *ComparableValue `xml:"https://adwords.google.com/api/adwords/o/v201802 min,omitempty" json:"min,omitempty"`
 
-	Max *ComparableValue `xml:"max,omitempty"`
+	Max *ComparableValue `xml:"https://adwords.google.com/api/adwords/o/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type RelatedToQuerySearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 RelatedToQuerySearchParameter"`
-
 	*SearchParameter
 
 	//
@@ -3289,12 +3125,10 @@ type RelatedToQuerySearchParameter struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Queries []string `xml:"queries,omitempty"`
+	Queries []string `xml:"https://adwords.google.com/api/adwords/o/v201802 queries,omitempty" json:"queries,omitempty"`
 }
 
 type RelatedToUrlSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 RelatedToUrlSearchParameter"`
-
 	*SearchParameter
 
 	//
@@ -3305,100 +3139,84 @@ type RelatedToUrlSearchParameter struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Urls []string `xml:"urls,omitempty"`
+	Urls []string `xml:"https://adwords.google.com/api/adwords/o/v201802 urls,omitempty" json:"urls,omitempty"`
 
 	//
 	// Whether to crawl links off of the {@code urls} for the same domain.
 	// Default is {@code false}.
 	//
-	IncludeSubUrls bool `xml:"includeSubUrls,omitempty"`
+	IncludeSubUrls *bool `xml:"https://adwords.google.com/api/adwords/o/v201802 includeSubUrls,omitempty" json:"includeSubUrls,omitempty"`
 }
 
 type SearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 SearchParameter"`
-
 	//
 	// Indicates that this instance is a subtype of SearchParameter.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	SearchParameterType string `xml:"SearchParameter.Type,omitempty"`
+	SearchParameterType *string `xml:"https://adwords.google.com/api/adwords/o/v201802 SearchParameter.Type,omitempty" json:"searchParameterType,omitempty"`
 }
 
 type SearchVolumeSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 SearchVolumeSearchParameter"`
-
 	*SearchParameter
 
 	//
 	// Used to specify the range of search volume.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Operation *LongComparisonOperation `xml:"operation,omitempty"`
+	Operation *LongComparisonOperation `xml:"https://adwords.google.com/api/adwords/o/v201802 operation,omitempty" json:"operation,omitempty"`
 }
 
 type SeedAdGroupIdSearchParameter struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 SeedAdGroupIdSearchParameter"`
-
 	*SearchParameter
 
 	//
 	// The id for the ad group that should be used as a seed for generating new ideas.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 }
 
 type StringAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 StringAttribute"`
-
 	*Attribute
 
 	//
 	// String value contained by this {@link Attribute}.
 	//
-	Value string `xml:"value,omitempty"`
+	Value *string `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type TargetingIdea struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 TargetingIdea"`
-
 	//
 	// Map of {@link AttributeType} to {@link Attribute}. Stores all data retrieved for each key
 	// {@code AttributeType}.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Data []*Type_AttributeMapEntry `xml:"data,omitempty"`
+	Data []*Type_AttributeMapEntry `xml:"https://adwords.google.com/api/adwords/o/v201802 data,omitempty" json:"data,omitempty"`
 }
 
 type TargetingIdeaError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 TargetingIdeaError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *TargetingIdeaErrorReason `xml:"reason,omitempty"`
+	Reason *TargetingIdeaErrorReason `xml:"https://adwords.google.com/api/adwords/o/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type TargetingIdeaPage struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 TargetingIdeaPage"`
-
 	//
 	// Total number of entries that can be retrieved using the get method.
 	//
-	TotalNumEntries int32 `xml:"totalNumEntries,omitempty"`
+	TotalNumEntries *int32 `xml:"https://adwords.google.com/api/adwords/o/v201802 totalNumEntries,omitempty" json:"totalNumEntries,omitempty"`
 
 	//
 	// The result entries in this page, as list of {@link TargetingIdea}s.
 	//
-	Entries []*TargetingIdea `xml:"entries,omitempty"`
+	Entries []*TargetingIdea `xml:"https://adwords.google.com/api/adwords/o/v201802 entries,omitempty" json:"entries,omitempty"`
 }
 
 type TargetingIdeaSelector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 TargetingIdeaSelector"`
-
 	//
 	// Search for targeting ideas based on these search rules.
 	//
@@ -3408,19 +3226,19 @@ type TargetingIdeaSelector struct {
 	// <span class="constraint DistinctTypes">Elements in this field must have distinct types.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	SearchParameters []*SearchParameter `xml:"searchParameters,omitempty"`
+	SearchParameters []*SearchParameter `xml:"https://adwords.google.com/api/adwords/o/v201802 searchParameters,omitempty" json:"searchParameters,omitempty"`
 
 	//
 	// Limits the request to responses of this {@link IdeaType}, e.g. {@code KEYWORDS}.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	IdeaType *IdeaType `xml:"ideaType,omitempty"`
+	IdeaType *IdeaType `xml:"https://adwords.google.com/api/adwords/o/v201802 ideaType,omitempty" json:"ideaType,omitempty"`
 
 	//
 	// Specifies the {@link RequestType}, e.g. {@code IDEAS} or {@code STATS}.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	RequestType *RequestType `xml:"requestType,omitempty"`
+	RequestType *RequestType `xml:"https://adwords.google.com/api/adwords/o/v201802 requestType,omitempty" json:"requestType,omitempty"`
 
 	//
 	// Request {@link Attribute}s and associated data for this set of {@link Type}s.
@@ -3430,21 +3248,21 @@ type TargetingIdeaSelector struct {
 	// <span class="constraint ContentsDistinct">This field must contain distinct elements.</span>
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	RequestedAttributeTypes []*AttributeType `xml:"requestedAttributeTypes,omitempty"`
+	RequestedAttributeTypes []*AttributeType `xml:"https://adwords.google.com/api/adwords/o/v201802 requestedAttributeTypes,omitempty" json:"requestedAttributeTypes,omitempty"`
 
 	//
 	// A {@link Paging} object that specifies the desired starting index and
 	// number of results to return.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Paging *Paging `xml:"paging,omitempty"`
+	Paging *Paging `xml:"https://adwords.google.com/api/adwords/o/v201802 paging,omitempty" json:"paging,omitempty"`
 
 	//
 	// The locale code (for example "en_US") used for localizing strings,
 	// controlling numeric formatting, and the like.  See RFC 3066 for
 	// information on the format used.
 	//
-	LocaleCode string `xml:"localeCode,omitempty"`
+	LocaleCode *string `xml:"https://adwords.google.com/api/adwords/o/v201802 localeCode,omitempty" json:"localeCode,omitempty"`
 
 	//
 	// The currency code to be used for all monetary values returned in results in
@@ -3452,48 +3270,40 @@ type TargetingIdeaSelector struct {
 	// https://developers.google.com/adwords/api/docs/appendix/currencycodes
 	// for supported currencies). The default is "USD" (US dollars).
 	//
-	CurrencyCode string `xml:"currencyCode,omitempty"`
+	CurrencyCode *string `xml:"https://adwords.google.com/api/adwords/o/v201802 currencyCode,omitempty" json:"currencyCode,omitempty"`
 }
 
 type TrafficEstimatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 TrafficEstimatorError"`
-
 	*ApiError
 
-	Reason *TrafficEstimatorErrorReason `xml:"reason,omitempty"`
+	Reason *TrafficEstimatorErrorReason `xml:"https://adwords.google.com/api/adwords/o/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Type_AttributeMapEntry struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 Type_AttributeMapEntry"`
-
-	Key *AttributeType `xml:"key,omitempty"`
+	Key *AttributeType `xml:"https://adwords.google.com/api/adwords/o/v201802 key,omitempty" json:"key,omitempty"`
 
-	Value *Attribute `xml:"value,omitempty"`
+	Value *Attribute `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type WebpageDescriptor struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 WebpageDescriptor"`
-
 	//
 	// The URL of the webpage.
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/o/v201802 url,omitempty" json:"url,omitempty"`
 
 	//
 	// The title of the webpage.
 	//
-	Title string `xml:"title,omitempty"`
+	Title *string `xml:"https://adwords.google.com/api/adwords/o/v201802 title,omitempty" json:"title,omitempty"`
 }
 
 type WebpageDescriptorAttribute struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 WebpageDescriptorAttribute"`
-
 	*Attribute
 
 	//
 	// {@link WebpageDescriptor} value contained by this {@link Attribute}.
 	//
-	Value *WebpageDescriptor `xml:"value,omitempty"`
+	Value *WebpageDescriptor `xml:"https://adwords.google.com/api/adwords/o/v201802 value,omitempty" json:"value,omitempty"`
 }
 
 type TargetingIdeaServiceInterface struct {
@@ -0,0 +1,76 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TargetingIdeaService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// TargetingIdeaServiceAPI is the interface of the TargetingIdeaService operations. It is
+// implemented by TargetingIdeaServiceInterface and, for tests, by FakeTargetingIdeaService.
+type TargetingIdeaServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+}
+
+var _ TargetingIdeaServiceAPI = (*TargetingIdeaServiceInterface)(nil)
+var _ TargetingIdeaServiceAPI = (*FakeTargetingIdeaService)(nil)
+
+// FakeCall is a call recorded by FakeTargetingIdeaService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeTargetingIdeaService is a programmable TargetingIdeaServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeTargetingIdeaService struct {
+	GetFunc func(request *Get) (*GetResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeTargetingIdeaService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeTargetingIdeaService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeTargetingIdeaService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeTargetingIdeaService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeTargetingIdeaService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeTargetingIdeaService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
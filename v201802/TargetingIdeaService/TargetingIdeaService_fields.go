@@ -0,0 +1,405 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TargetingIdeaService
+
+// FieldInfo describes a field of a type of this package as annotated in the
+// API reference.
+type FieldInfo struct {
+	// Type is the type declaring the field, which is a base type for
+	// inherited fields.
+	Type string
+
+	// Name is the Go name of the field.
+	Name string
+
+	// Selectors are the names to use in Selector.Fields and OrderBy.Field,
+	// empty if the field cannot be selected.
+	Selectors []string
+
+	// Filter is the name to use in Predicate.Field, or "" if the field
+	// cannot be filtered on.
+	Filter string
+
+	// ReadOnly fields are ignored when sent to the API with one of
+	// ReadOnlyOperators, or with any operator if ReadOnlyOperators is nil.
+	ReadOnly          bool
+	ReadOnlyOperators []string
+
+	// Required fields must be set with one of RequiredOperators, or with any
+	// operator if RequiredOperators is nil.
+	Required          bool
+	RequiredOperators []string
+}
+
+// Selectable reports whether the field can be selected.
+func (f FieldInfo) Selectable() bool {
+	return len(f.Selectors) > 0
+}
+
+// Filterable reports whether the field can be filtered on.
+func (f FieldInfo) Filterable() bool {
+	return f.Filter != ""
+}
+
+// CriterionFields holds the selector field names of Criterion, including
+// the fields of the types it holds.
+var CriterionFields = struct {
+	AppId                      string
+	CriteriaType               string
+	DisplayName                string
+	Id                         string
+	KeywordMatchType           string
+	KeywordText                string
+	MobileAppCategoryId        string
+	Path                       string
+	PlacementUrl               string
+	UserInterestId             string
+	UserInterestName           string
+	UserInterestParentId       string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+	VerticalId                 string
+	VerticalParentId           string
+}{
+	AppId:                      "AppId",
+	CriteriaType:               "CriteriaType",
+	DisplayName:                "DisplayName",
+	Id:                         "Id",
+	KeywordMatchType:           "KeywordMatchType",
+	KeywordText:                "KeywordText",
+	MobileAppCategoryId:        "MobileAppCategoryId",
+	Path:                       "Path",
+	PlacementUrl:               "PlacementUrl",
+	UserInterestId:             "UserInterestId",
+	UserInterestName:           "UserInterestName",
+	UserInterestParentId:       "UserInterestParentId",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+	VerticalId:                 "VerticalId",
+	VerticalParentId:           "VerticalParentId",
+}
+
+// KeywordFields holds the selector field names of Keyword, including
+// the fields of the types it holds.
+var KeywordFields = struct {
+	CriteriaType     string
+	Id               string
+	KeywordMatchType string
+	KeywordText      string
+}{
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	KeywordMatchType: "KeywordMatchType",
+	KeywordText:      "KeywordText",
+}
+
+// LanguageFields holds the selector field names of Language, including
+// the fields of the types it holds.
+var LanguageFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// LocationFields holds the selector field names of Location, including
+// the fields of the types it holds.
+var LocationFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// MobileAppCategoryFields holds the selector field names of MobileAppCategory, including
+// the fields of the types it holds.
+var MobileAppCategoryFields = struct {
+	CriteriaType        string
+	Id                  string
+	MobileAppCategoryId string
+}{
+	CriteriaType:        "CriteriaType",
+	Id:                  "Id",
+	MobileAppCategoryId: "MobileAppCategoryId",
+}
+
+// MobileApplicationFields holds the selector field names of MobileApplication, including
+// the fields of the types it holds.
+var MobileApplicationFields = struct {
+	AppId        string
+	CriteriaType string
+	DisplayName  string
+	Id           string
+}{
+	AppId:        "AppId",
+	CriteriaType: "CriteriaType",
+	DisplayName:  "DisplayName",
+	Id:           "Id",
+}
+
+// PlacementFields holds the selector field names of Placement, including
+// the fields of the types it holds.
+var PlacementFields = struct {
+	CriteriaType string
+	Id           string
+	PlacementUrl string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+	PlacementUrl: "PlacementUrl",
+}
+
+// PlatformFields holds the selector field names of Platform, including
+// the fields of the types it holds.
+var PlatformFields = struct {
+	CriteriaType string
+	Id           string
+}{
+	CriteriaType: "CriteriaType",
+	Id:           "Id",
+}
+
+// CriterionUserInterestFields holds the selector field names of CriterionUserInterest, including
+// the fields of the types it holds.
+var CriterionUserInterestFields = struct {
+	CriteriaType         string
+	Id                   string
+	UserInterestId       string
+	UserInterestName     string
+	UserInterestParentId string
+}{
+	CriteriaType:         "CriteriaType",
+	Id:                   "Id",
+	UserInterestId:       "UserInterestId",
+	UserInterestName:     "UserInterestName",
+	UserInterestParentId: "UserInterestParentId",
+}
+
+// CriterionUserListFields holds the selector field names of CriterionUserList, including
+// the fields of the types it holds.
+var CriterionUserListFields = struct {
+	CriteriaType               string
+	Id                         string
+	UserListEligibleForDisplay string
+	UserListEligibleForSearch  string
+	UserListId                 string
+	UserListMembershipStatus   string
+	UserListName               string
+}{
+	CriteriaType:               "CriteriaType",
+	Id:                         "Id",
+	UserListEligibleForDisplay: "UserListEligibleForDisplay",
+	UserListEligibleForSearch:  "UserListEligibleForSearch",
+	UserListId:                 "UserListId",
+	UserListMembershipStatus:   "UserListMembershipStatus",
+	UserListName:               "UserListName",
+}
+
+// VerticalFields holds the selector field names of Vertical, including
+// the fields of the types it holds.
+var VerticalFields = struct {
+	CriteriaType     string
+	Id               string
+	Path             string
+	VerticalId       string
+	VerticalParentId string
+}{
+	CriteriaType:     "CriteriaType",
+	Id:               "Id",
+	Path:             "Path",
+	VerticalId:       "VerticalId",
+	VerticalParentId: "VerticalParentId",
+}
+
+var typeFields = map[string][]FieldInfo{
+	"Criterion": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+	},
+	"Keyword": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Keyword", Name: "Text", Selectors: []string{"KeywordText"}, Filter: "KeywordText", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "Keyword", Name: "MatchType", Selectors: []string{"KeywordMatchType"}, Filter: "KeywordMatchType", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Language": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Language", Name: "Code", ReadOnly: true},
+		{Type: "Language", Name: "Name", ReadOnly: true},
+	},
+	"Location": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Location", Name: "LocationName", ReadOnly: true},
+		{Type: "Location", Name: "DisplayType", ReadOnly: true},
+		{Type: "Location", Name: "TargetingStatus", ReadOnly: true},
+		{Type: "Location", Name: "ParentLocations", ReadOnly: true},
+	},
+	"MobileAppCategory": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileAppCategory", Name: "MobileAppCategoryId", Selectors: []string{"MobileAppCategoryId"}, Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileAppCategory", Name: "DisplayName", ReadOnly: true},
+	},
+	"MobileApplication": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "MobileApplication", Name: "AppId", Selectors: []string{"AppId"}, Filter: "AppId", Required: true, RequiredOperators: []string{"ADD"}},
+		{Type: "MobileApplication", Name: "DisplayName", Selectors: []string{"DisplayName"}, Filter: "DisplayName", ReadOnly: true},
+	},
+	"Placement": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Placement", Name: "Url", Selectors: []string{"PlacementUrl"}, Filter: "PlacementUrl", Required: true, RequiredOperators: []string{"ADD"}},
+	},
+	"Platform": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Platform", Name: "PlatformName", ReadOnly: true},
+	},
+	"PolicyViolationKey": {
+		{Type: "PolicyViolationKey", Name: "PolicyName", Required: true},
+		{Type: "PolicyViolationKey", Name: "ViolatingText"},
+	},
+	"CriterionUserInterest": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserInterest", Name: "UserInterestId", Selectors: []string{"UserInterestId"}},
+		{Type: "CriterionUserInterest", Name: "UserInterestParentId", Selectors: []string{"UserInterestParentId"}, ReadOnly: true},
+		{Type: "CriterionUserInterest", Name: "UserInterestName", Selectors: []string{"UserInterestName"}},
+	},
+	"CriterionUserList": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "CriterionUserList", Name: "UserListId", Selectors: []string{"UserListId"}},
+		{Type: "CriterionUserList", Name: "UserListName", Selectors: []string{"UserListName"}},
+		{Type: "CriterionUserList", Name: "UserListMembershipStatus", Selectors: []string{"UserListMembershipStatus"}, Filter: "UserListMembershipStatus"},
+		{Type: "CriterionUserList", Name: "UserListEligibleForSearch", Selectors: []string{"UserListEligibleForSearch"}, Filter: "UserListEligibleForSearch", ReadOnly: true},
+		{Type: "CriterionUserList", Name: "UserListEligibleForDisplay", Selectors: []string{"UserListEligibleForDisplay"}, Filter: "UserListEligibleForDisplay", ReadOnly: true},
+	},
+	"Vertical": {
+		{Type: "Criterion", Name: "Id", Selectors: []string{"Id"}, Filter: "Id", Required: true, RequiredOperators: []string{"REMOVE", "SET"}},
+		{Type: "Criterion", Name: "Type_", Selectors: []string{"CriteriaType"}, Filter: "CriteriaType", ReadOnly: true},
+		{Type: "Criterion", Name: "CriterionType"},
+		{Type: "Vertical", Name: "VerticalId", Selectors: []string{"VerticalId"}},
+		{Type: "Vertical", Name: "VerticalParentId", Selectors: []string{"VerticalParentId"}, ReadOnly: true},
+		{Type: "Vertical", Name: "Path", Selectors: []string{"Path"}},
+	},
+	"Get": {
+		{Type: "Get", Name: "Selector", Required: true},
+	},
+	"CategoryProductsAndServicesSearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "CategoryProductsAndServicesSearchParameter", Name: "CategoryId", Required: true},
+	},
+	"CompetitionSearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "CompetitionSearchParameter", Name: "Levels", Required: true},
+	},
+	"IntegerSetAttribute": {
+		{Type: "Attribute", Name: "AttributeType"},
+		{Type: "IntegerSetAttribute", Name: "Value", Required: true},
+	},
+	"LanguageSearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "LanguageSearchParameter", Name: "Languages", Required: true},
+	},
+	"LocationSearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "LocationSearchParameter", Name: "Locations", Required: true},
+	},
+	"MonthlySearchVolumeAttribute": {
+		{Type: "Attribute", Name: "AttributeType"},
+		{Type: "MonthlySearchVolumeAttribute", Name: "Value", Required: true},
+	},
+	"NetworkSearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "NetworkSearchParameter", Name: "NetworkSetting", Required: true},
+	},
+	"RelatedToQuerySearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "RelatedToQuerySearchParameter", Name: "Queries", Required: true},
+	},
+	"RelatedToUrlSearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "RelatedToUrlSearchParameter", Name: "Urls", Required: true},
+		{Type: "RelatedToUrlSearchParameter", Name: "IncludeSubUrls"},
+	},
+	"SearchVolumeSearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "SearchVolumeSearchParameter", Name: "Operation", Required: true},
+	},
+	"SeedAdGroupIdSearchParameter": {
+		{Type: "SearchParameter", Name: "SearchParameterType"},
+		{Type: "SeedAdGroupIdSearchParameter", Name: "AdGroupId", Required: true},
+	},
+	"TargetingIdea": {
+		{Type: "TargetingIdea", Name: "Data", Required: true},
+	},
+	"TargetingIdeaSelector": {
+		{Type: "TargetingIdeaSelector", Name: "SearchParameters", Required: true},
+		{Type: "TargetingIdeaSelector", Name: "IdeaType", Required: true},
+		{Type: "TargetingIdeaSelector", Name: "RequestType", Required: true},
+		{Type: "TargetingIdeaSelector", Name: "RequestedAttributeTypes"},
+		{Type: "TargetingIdeaSelector", Name: "Paging", Required: true},
+		{Type: "TargetingIdeaSelector", Name: "LocaleCode"},
+		{Type: "TargetingIdeaSelector", Name: "CurrencyCode"},
+	},
+}
+
+// TypeFields returns the fields of the named type, inherited fields first,
+// or nil if the type has no annotated fields.
+func TypeFields(typeName string) []FieldInfo {
+	return typeFields[typeName]
+}
+
+// LookupField returns the fields selectable or filterable by name. Fields of
+// different types may share a name, e.g. "Id".
+func LookupField(name string) []FieldInfo {
+	var fields []FieldInfo
+	seen := make(map[FieldKey]bool)
+	for _, list := range typeFields {
+		for _, f := range list {
+			key := FieldKey{f.Type, f.Name}
+			if seen[key] || f.Filter != name && !containsString(f.Selectors, name) {
+				continue
+			}
+			seen[key] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FieldKey identifies a field by its declaring type and Go name.
+type FieldKey struct {
+	Type, Name string
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TargetingIdeaService
+
+import (
+	"iter"
+
+	"github.com/godofdream/go-googleadsinofficial/paging"
+)
+
+// GetAll returns an iterator over the TargetingIdea entries selected by
+// sel, fetching one page after the other. The Paging of sel selects the
+// first entry and the page size. See paging.Pager for entries added or
+// removed while iterating.
+func GetAll(api TargetingIdeaServiceAPI, sel *TargetingIdeaSelector) iter.Seq2[*TargetingIdea, error] {
+	return GetPager(api, sel).All()
+}
+
+// GetEach calls fn for every TargetingIdea entry selected by sel and
+// returns the first error. See GetAll.
+func GetEach(api TargetingIdeaServiceAPI, sel *TargetingIdeaSelector, fn func(*TargetingIdea) error) error {
+	return GetPager(api, sel).Each(fn)
+}
+
+// GetPager returns the paging.Pager of GetAll, e.g. to fetch pages
+// concurrently or retry failed requests.
+func GetPager(api TargetingIdeaServiceAPI, sel *TargetingIdeaSelector) *paging.Pager[*TargetingIdea] {
+	p := &paging.Pager[*TargetingIdea]{}
+	if sel == nil {
+		sel = new(TargetingIdeaSelector)
+	}
+	if sel.Paging != nil {
+		if sel.Paging.StartIndex != nil {
+			p.Start = *sel.Paging.StartIndex
+		}
+		if sel.Paging.NumberResults != nil {
+			p.PageSize = *sel.Paging.NumberResults
+		}
+	}
+	p.Fetch = func(start, n int32) ([]*TargetingIdea, int32, error) {
+		paged := sel.Copy()
+		paged.Paging = &Paging{StartIndex: &start, NumberResults: &n}
+		resp, err := api.Get(&Get{Selector: paged})
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Rval == nil {
+			return nil, 0, nil
+		}
+		var total int32
+		if resp.Rval.TotalNumEntries != nil {
+			total = *resp.Rval.TotalNumEntries
+		}
+		return resp.Rval.Entries, total, nil
+	}
+	return p
+}
@@ -0,0 +1,1343 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TargetingIdeaService
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionError" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionError) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdGroupCriterionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdGroupCriterionLimitExceeded" as the
+// concrete type in the discriminator of its base types.
+func (t AdGroupCriterionLimitExceeded) MarshalJSON() ([]byte, error) {
+	type plain AdGroupCriterionLimitExceeded
+	v := plain(t)
+
+	b0 := new(EntityCountLimitExceeded)
+	if v.EntityCountLimitExceeded != nil {
+		*b0 = *v.EntityCountLimitExceeded
+	}
+	v.EntityCountLimitExceeded = b0
+
+	b1 := new(ApiError)
+	if b0.ApiError != nil {
+		*b1 = *b0.ApiError
+	}
+	if b1.ApiErrorType == nil {
+		b1.ApiErrorType = String("AdGroupCriterionLimitExceeded")
+	}
+	b0.ApiError = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AdxError" as the
+// concrete type in the discriminator of its base types.
+func (t AdxError) MarshalJSON() ([]byte, error) {
+	type plain AdxError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AdxError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ApiException" as the
+// concrete type in the discriminator of its base types.
+func (t ApiException) MarshalJSON() ([]byte, error) {
+	type plain ApiException
+	v := plain(t)
+
+	b0 := new(ApplicationException)
+	if v.ApplicationException != nil {
+		*b0 = *v.ApplicationException
+	}
+	if b0.ApplicationExceptionType == nil {
+		b0.ApplicationExceptionType = String("ApiException")
+	}
+	v.ApplicationException = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthenticationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthenticationError) MarshalJSON() ([]byte, error) {
+	type plain AuthenticationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthenticationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "AuthorizationError" as the
+// concrete type in the discriminator of its base types.
+func (t AuthorizationError) MarshalJSON() ([]byte, error) {
+	type plain AuthorizationError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("AuthorizationError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "BudgetError" as the
+// concrete type in the discriminator of its base types.
+func (t BudgetError) MarshalJSON() ([]byte, error) {
+	type plain BudgetError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("BudgetError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ClientTermsError" as the
+// concrete type in the discriminator of its base types.
+func (t ClientTermsError) MarshalJSON() ([]byte, error) {
+	type plain ClientTermsError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ClientTermsError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CollectionSizeError" as the
+// concrete type in the discriminator of its base types.
+func (t CollectionSizeError) MarshalJSON() ([]byte, error) {
+	type plain CollectionSizeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CollectionSizeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionError" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionError) MarshalJSON() ([]byte, error) {
+	type plain CriterionError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CriterionError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionPolicyError" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionPolicyError) MarshalJSON() ([]byte, error) {
+	type plain CriterionPolicyError
+	v := plain(t)
+
+	b0 := new(PolicyViolationError)
+	if v.PolicyViolationError != nil {
+		*b0 = *v.PolicyViolationError
+	}
+	v.PolicyViolationError = b0
+
+	b1 := new(ApiError)
+	if b0.ApiError != nil {
+		*b1 = *b0.ApiError
+	}
+	if b1.ApiErrorType == nil {
+		b1.ApiErrorType = String("CriterionPolicyError")
+	}
+	b0.ApiError = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DatabaseError" as the
+// concrete type in the discriminator of its base types.
+func (t DatabaseError) MarshalJSON() ([]byte, error) {
+	type plain DatabaseError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DatabaseError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DateError" as the
+// concrete type in the discriminator of its base types.
+func (t DateError) MarshalJSON() ([]byte, error) {
+	type plain DateError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DateError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DistinctError" as the
+// concrete type in the discriminator of its base types.
+func (t DistinctError) MarshalJSON() ([]byte, error) {
+	type plain DistinctError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("DistinctError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DoubleValue" as the
+// concrete type in the discriminator of its base types.
+func (t DoubleValue) MarshalJSON() ([]byte, error) {
+	type plain DoubleValue
+	v := plain(t)
+
+	b0 := new(NumberValue)
+	if v.NumberValue != nil {
+		*b0 = *v.NumberValue
+	}
+	v.NumberValue = b0
+
+	b1 := new(ComparableValue)
+	if b0.ComparableValue != nil {
+		*b1 = *b0.ComparableValue
+	}
+	if b1.ComparableValueType == nil {
+		b1.ComparableValueType = String("DoubleValue")
+	}
+	b0.ComparableValue = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "EntityNotFound" as the
+// concrete type in the discriminator of its base types.
+func (t EntityNotFound) MarshalJSON() ([]byte, error) {
+	type plain EntityNotFound
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("EntityNotFound")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdError" as the
+// concrete type in the discriminator of its base types.
+func (t IdError) MarshalJSON() ([]byte, error) {
+	type plain IdError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("IdError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "InternalApiError" as the
+// concrete type in the discriminator of its base types.
+func (t InternalApiError) MarshalJSON() ([]byte, error) {
+	type plain InternalApiError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("InternalApiError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Keyword" as the
+// concrete type in the discriminator of its base types.
+func (t Keyword) MarshalJSON() ([]byte, error) {
+	type plain Keyword
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Keyword")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Language" as the
+// concrete type in the discriminator of its base types.
+func (t Language) MarshalJSON() ([]byte, error) {
+	type plain Language
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Language")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Location" as the
+// concrete type in the discriminator of its base types.
+func (t Location) MarshalJSON() ([]byte, error) {
+	type plain Location
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Location")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LongValue" as the
+// concrete type in the discriminator of its base types.
+func (t LongValue) MarshalJSON() ([]byte, error) {
+	type plain LongValue
+	v := plain(t)
+
+	b0 := new(NumberValue)
+	if v.NumberValue != nil {
+		*b0 = *v.NumberValue
+	}
+	v.NumberValue = b0
+
+	b1 := new(ComparableValue)
+	if b0.ComparableValue != nil {
+		*b1 = *b0.ComparableValue
+	}
+	if b1.ComparableValueType == nil {
+		b1.ComparableValueType = String("LongValue")
+	}
+	b0.ComparableValue = b1
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileAppCategory" as the
+// concrete type in the discriminator of its base types.
+func (t MobileAppCategory) MarshalJSON() ([]byte, error) {
+	type plain MobileAppCategory
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileAppCategory")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MobileApplication" as the
+// concrete type in the discriminator of its base types.
+func (t MobileApplication) MarshalJSON() ([]byte, error) {
+	type plain MobileApplication
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("MobileApplication")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Money" as the
+// concrete type in the discriminator of its base types.
+func (t Money) MarshalJSON() ([]byte, error) {
+	type plain Money
+	v := plain(t)
+
+	b0 := new(ComparableValue)
+	if v.ComparableValue != nil {
+		*b0 = *v.ComparableValue
+	}
+	if b0.ComparableValueType == nil {
+		b0.ComparableValueType = String("Money")
+	}
+	v.ComparableValue = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NotEmptyError" as the
+// concrete type in the discriminator of its base types.
+func (t NotEmptyError) MarshalJSON() ([]byte, error) {
+	type plain NotEmptyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NotEmptyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NullError" as the
+// concrete type in the discriminator of its base types.
+func (t NullError) MarshalJSON() ([]byte, error) {
+	type plain NullError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("NullError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperationAccessDenied" as the
+// concrete type in the discriminator of its base types.
+func (t OperationAccessDenied) MarshalJSON() ([]byte, error) {
+	type plain OperationAccessDenied
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperationAccessDenied")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "OperatorError" as the
+// concrete type in the discriminator of its base types.
+func (t OperatorError) MarshalJSON() ([]byte, error) {
+	type plain OperatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("OperatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Placement" as the
+// concrete type in the discriminator of its base types.
+func (t Placement) MarshalJSON() ([]byte, error) {
+	type plain Placement
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Placement")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Platform" as the
+// concrete type in the discriminator of its base types.
+func (t Platform) MarshalJSON() ([]byte, error) {
+	type plain Platform
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Platform")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "QuotaCheckError" as the
+// concrete type in the discriminator of its base types.
+func (t QuotaCheckError) MarshalJSON() ([]byte, error) {
+	type plain QuotaCheckError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("QuotaCheckError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RangeError" as the
+// concrete type in the discriminator of its base types.
+func (t RangeError) MarshalJSON() ([]byte, error) {
+	type plain RangeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RangeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RateExceededError" as the
+// concrete type in the discriminator of its base types.
+func (t RateExceededError) MarshalJSON() ([]byte, error) {
+	type plain RateExceededError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RateExceededError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "ReadOnlyError" as the
+// concrete type in the discriminator of its base types.
+func (t ReadOnlyError) MarshalJSON() ([]byte, error) {
+	type plain ReadOnlyError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("ReadOnlyError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RegionCodeError" as the
+// concrete type in the discriminator of its base types.
+func (t RegionCodeError) MarshalJSON() ([]byte, error) {
+	type plain RegionCodeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RegionCodeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RejectedError" as the
+// concrete type in the discriminator of its base types.
+func (t RejectedError) MarshalJSON() ([]byte, error) {
+	type plain RejectedError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RejectedError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequestError" as the
+// concrete type in the discriminator of its base types.
+func (t RequestError) MarshalJSON() ([]byte, error) {
+	type plain RequestError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequestError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RequiredError" as the
+// concrete type in the discriminator of its base types.
+func (t RequiredError) MarshalJSON() ([]byte, error) {
+	type plain RequiredError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("RequiredError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SizeLimitError" as the
+// concrete type in the discriminator of its base types.
+func (t SizeLimitError) MarshalJSON() ([]byte, error) {
+	type plain SizeLimitError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("SizeLimitError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StatsQueryError" as the
+// concrete type in the discriminator of its base types.
+func (t StatsQueryError) MarshalJSON() ([]byte, error) {
+	type plain StatsQueryError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StatsQueryError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringFormatError" as the
+// concrete type in the discriminator of its base types.
+func (t StringFormatError) MarshalJSON() ([]byte, error) {
+	type plain StringFormatError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringFormatError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringLengthError" as the
+// concrete type in the discriminator of its base types.
+func (t StringLengthError) MarshalJSON() ([]byte, error) {
+	type plain StringLengthError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("StringLengthError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserInterest" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserInterest) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserInterest
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserInterest")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionUserList" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionUserList) MarshalJSON() ([]byte, error) {
+	type plain CriterionUserList
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("CriterionUserList")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "Vertical" as the
+// concrete type in the discriminator of its base types.
+func (t Vertical) MarshalJSON() ([]byte, error) {
+	type plain Vertical
+	v := plain(t)
+
+	b0 := new(Criterion)
+	if v.Criterion != nil {
+		*b0 = *v.Criterion
+	}
+	if b0.CriterionType == nil {
+		b0.CriterionType = String("Vertical")
+	}
+	v.Criterion = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "BooleanAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t BooleanAttribute) MarshalJSON() ([]byte, error) {
+	type plain BooleanAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("BooleanAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CategoryProductsAndServicesSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t CategoryProductsAndServicesSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain CategoryProductsAndServicesSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("CategoryProductsAndServicesSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CompetitionSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t CompetitionSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain CompetitionSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("CompetitionSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CriterionAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t CriterionAttribute) MarshalJSON() ([]byte, error) {
+	type plain CriterionAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("CriterionAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "CurrencyCodeError" as the
+// concrete type in the discriminator of its base types.
+func (t CurrencyCodeError) MarshalJSON() ([]byte, error) {
+	type plain CurrencyCodeError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("CurrencyCodeError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "DoubleAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t DoubleAttribute) MarshalJSON() ([]byte, error) {
+	type plain DoubleAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("DoubleAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdeaTextFilterSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t IdeaTextFilterSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain IdeaTextFilterSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("IdeaTextFilterSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IdeaTypeAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t IdeaTypeAttribute) MarshalJSON() ([]byte, error) {
+	type plain IdeaTypeAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("IdeaTypeAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IncludeAdultContentSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t IncludeAdultContentSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain IncludeAdultContentSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("IncludeAdultContentSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IntegerAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t IntegerAttribute) MarshalJSON() ([]byte, error) {
+	type plain IntegerAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("IntegerAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "IntegerSetAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t IntegerSetAttribute) MarshalJSON() ([]byte, error) {
+	type plain IntegerSetAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("IntegerSetAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "KeywordAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t KeywordAttribute) MarshalJSON() ([]byte, error) {
+	type plain KeywordAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("KeywordAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LanguageSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t LanguageSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain LanguageSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("LanguageSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LocationSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t LocationSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain LocationSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("LocationSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LongAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t LongAttribute) MarshalJSON() ([]byte, error) {
+	type plain LongAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("LongAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "LongRangeAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t LongRangeAttribute) MarshalJSON() ([]byte, error) {
+	type plain LongRangeAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("LongRangeAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MoneyAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t MoneyAttribute) MarshalJSON() ([]byte, error) {
+	type plain MoneyAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("MoneyAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "MonthlySearchVolumeAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t MonthlySearchVolumeAttribute) MarshalJSON() ([]byte, error) {
+	type plain MonthlySearchVolumeAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("MonthlySearchVolumeAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "NetworkSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t NetworkSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain NetworkSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("NetworkSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RelatedToQuerySearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t RelatedToQuerySearchParameter) MarshalJSON() ([]byte, error) {
+	type plain RelatedToQuerySearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("RelatedToQuerySearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "RelatedToUrlSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t RelatedToUrlSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain RelatedToUrlSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("RelatedToUrlSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SearchVolumeSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t SearchVolumeSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain SearchVolumeSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("SearchVolumeSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "SeedAdGroupIdSearchParameter" as the
+// concrete type in the discriminator of its base types.
+func (t SeedAdGroupIdSearchParameter) MarshalJSON() ([]byte, error) {
+	type plain SeedAdGroupIdSearchParameter
+	v := plain(t)
+
+	b0 := new(SearchParameter)
+	if v.SearchParameter != nil {
+		*b0 = *v.SearchParameter
+	}
+	if b0.SearchParameterType == nil {
+		b0.SearchParameterType = String("SeedAdGroupIdSearchParameter")
+	}
+	v.SearchParameter = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "StringAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t StringAttribute) MarshalJSON() ([]byte, error) {
+	type plain StringAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("StringAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TargetingIdeaError" as the
+// concrete type in the discriminator of its base types.
+func (t TargetingIdeaError) MarshalJSON() ([]byte, error) {
+	type plain TargetingIdeaError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("TargetingIdeaError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "TrafficEstimatorError" as the
+// concrete type in the discriminator of its base types.
+func (t TrafficEstimatorError) MarshalJSON() ([]byte, error) {
+	type plain TrafficEstimatorError
+	v := plain(t)
+
+	b0 := new(ApiError)
+	if v.ApiError != nil {
+		*b0 = *v.ApiError
+	}
+	if b0.ApiErrorType == nil {
+		b0.ApiErrorType = String("TrafficEstimatorError")
+	}
+	v.ApiError = b0
+
+	return json.Marshal(v)
+}
+
+// MarshalJSON implements json.Marshaler. It records "WebpageDescriptorAttribute" as the
+// concrete type in the discriminator of its base types.
+func (t WebpageDescriptorAttribute) MarshalJSON() ([]byte, error) {
+	type plain WebpageDescriptorAttribute
+	v := plain(t)
+
+	b0 := new(Attribute)
+	if v.Attribute != nil {
+		*b0 = *v.Attribute
+	}
+	if b0.AttributeType == nil {
+		b0.AttributeType = String("WebpageDescriptorAttribute")
+	}
+	v.Attribute = b0
+
+	return json.Marshal(v)
+}
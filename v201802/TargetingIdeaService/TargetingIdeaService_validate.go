@@ -0,0 +1,51 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TargetingIdeaService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *Paging) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Paging)
+	}
+	if t.StartIndex != nil {
+		v.AtLeast(validate.Field(path, "startIndex"), float64(*t.StartIndex), 0.)
+	}
+	if t.NumberResults != nil {
+		v.AtLeast(validate.Field(path, "numberResults"), float64(*t.NumberResults), 0.)
+	}
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "selector"), t.Selector != nil)
+	if t.Selector != nil {
+		t.Selector.validate(v, validate.Field(path, "selector"), op)
+	}
+}
+
+func (t *TargetingIdeaSelector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(TargetingIdeaSelector)
+	}
+	v.Required(validate.Field(path, "searchParameters"), len(t.SearchParameters) > 0)
+	v.ContentsNotNull(validate.Field(path, "searchParameters"), t.SearchParameters)
+	v.Required(validate.Field(path, "ideaType"), t.IdeaType != nil)
+	v.Required(validate.Field(path, "requestType"), t.RequestType != nil)
+	v.ContentsNotNull(validate.Field(path, "requestedAttributeTypes"), t.RequestedAttributeTypes)
+	v.ContentsDistinct(validate.Field(path, "requestedAttributeTypes"), t.RequestedAttributeTypes)
+	v.Required(validate.Field(path, "paging"), t.Paging != nil)
+	if t.Paging != nil {
+		t.Paging.validate(v, validate.Field(path, "paging"), op)
+	}
+}
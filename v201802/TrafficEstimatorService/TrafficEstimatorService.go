@@ -920,257 +920,217 @@ const (
 )
 
 type AdxError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AdxError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AdxErrorReason `xml:"reason,omitempty"`
+	Reason *AdxErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError"`
-
 	//
 	// The OGNL field path to identify cause of error.
 	//
-	FieldPath string `xml:"fieldPath,omitempty"`
+	FieldPath *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPath,omitempty" json:"fieldPath,omitempty"`
 
 	//
 	// A parsed copy of the field path. For example, the field path "operations[1].operand"
 	// corresponds to this list: {FieldPathElement(field = "operations", index = 1),
 	// FieldPathElement(field = "operand", index = null)}.
 	//
-	FieldPathElements []*FieldPathElement `xml:"fieldPathElements,omitempty"`
+	FieldPathElements []*FieldPathElement `xml:"https://adwords.google.com/api/adwords/cm/v201802 fieldPathElements,omitempty" json:"fieldPathElements,omitempty"`
 
 	//
 	// The data that caused the error.
 	//
-	Trigger string `xml:"trigger,omitempty"`
+	Trigger *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 trigger,omitempty" json:"trigger,omitempty"`
 
 	//
 	// A simple string representation of the error and reason.
 	//
-	ErrorString string `xml:"errorString,omitempty"`
+	ErrorString *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 errorString,omitempty" json:"errorString,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApiError.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApiErrorType string `xml:"ApiError.Type,omitempty"`
+	ApiErrorType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiError.Type,omitempty" json:"apiErrorType,omitempty"`
 }
 
 type ApiException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApiException"`
-
 	*ApplicationException
 
 	//
 	// List of errors.
 	//
-	Errors []*ApiError `xml:"errors,omitempty"`
+	Errors []*ApiError `xml:"https://adwords.google.com/api/adwords/cm/v201802 errors,omitempty" json:"errors,omitempty"`
 }
 
 type ApplicationException struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException"`
-
 	//
 	// Error message.
 	//
-	Message string `xml:"message,omitempty"`
+	Message *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 message,omitempty" json:"message,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of ApplicationException.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ApplicationExceptionType string `xml:"ApplicationException.Type,omitempty"`
+	ApplicationExceptionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ApplicationException.Type,omitempty" json:"applicationExceptionType,omitempty"`
 }
 
 type AuthenticationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthenticationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthenticationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthenticationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type AuthorizationError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 AuthorizationError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *AuthorizationErrorReason `xml:"reason,omitempty"`
+	Reason *AuthorizationErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ClientTermsError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ClientTermsError"`
-
 	*ApiError
 
-	Reason *ClientTermsErrorReason `xml:"reason,omitempty"`
+	Reason *ClientTermsErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CollectionSizeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CollectionSizeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CollectionSizeErrorReason `xml:"reason,omitempty"`
+	Reason *CollectionSizeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type ComparableValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue"`
-
 	//
 	// Indicates that this instance is a subtype of ComparableValue.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	ComparableValueType string `xml:"ComparableValue.Type,omitempty"`
+	ComparableValueType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 ComparableValue.Type,omitempty" json:"comparableValueType,omitempty"`
 }
 
 type Criterion struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion"`
-
 	//
 	// ID of this criterion.
 	// <span class="constraint Selectable">This field can be selected using the value "Id".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : SET, REMOVE.</span>
 	//
-	Id int64 `xml:"id,omitempty"`
+	Id *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 id,omitempty" json:"id,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "CriteriaType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Type_ *CriterionType `xml:"type,omitempty"`
+	Type_ *CriterionType `xml:"https://adwords.google.com/api/adwords/cm/v201802 type,omitempty" json:"type,omitempty"`
 
 	//
 	// Indicates that this instance is a subtype of Criterion.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	CriterionType string `xml:"Criterion.Type,omitempty"`
+	CriterionType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 Criterion.Type,omitempty" json:"criterionType,omitempty"`
 }
 
 type DatabaseError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DatabaseError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DatabaseErrorReason `xml:"reason,omitempty"`
+	Reason *DatabaseErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DateError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DateError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DateErrorReason `xml:"reason,omitempty"`
+	Reason *DateErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DistinctError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DistinctError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *DistinctErrorReason `xml:"reason,omitempty"`
+	Reason *DistinctErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type DoubleValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 DoubleValue"`
-
 	*NumberValue
 
 	//
 	// the underlying double value.
 	//
-	Number float64 `xml:"number,omitempty"`
+	Number *float64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type EntityAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityAccessDenied"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *EntityAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type EntityNotFound struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 EntityNotFound"`
-
 	*ApiError
 
 	//
 	// Reason for this error.
 	//
-	Reason *EntityNotFoundReason `xml:"reason,omitempty"`
+	Reason *EntityNotFoundReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type FieldPathElement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 FieldPathElement"`
-
 	//
 	// The name of a field in lower camelcase. (e.g. "biddingStrategy")
 	//
-	Field string `xml:"field,omitempty"`
+	Field *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 field,omitempty" json:"field,omitempty"`
 
 	//
 	// For list fields, this is a 0-indexed position in the list. Null for non-list fields.
 	//
-	Index int32 `xml:"index,omitempty"`
+	Index *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 index,omitempty" json:"index,omitempty"`
 }
 
 type IdError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 IdError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *IdErrorReason `xml:"reason,omitempty"`
+	Reason *IdErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type InternalApiError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 InternalApiError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *InternalApiErrorReason `xml:"reason,omitempty"`
+	Reason *InternalApiErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Keyword struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Keyword"`
-
 	*Criterion
 
 	//
@@ -1179,35 +1139,31 @@ type Keyword struct {
 	// <span class="constraint MatchesRegex">Keyword text must not contain NUL (code point 0x0) characters. This is checked by the regular expression '[^\x00]*'.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Text string `xml:"text,omitempty"`
+	Text *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 text,omitempty" json:"text,omitempty"`
 
 	//
 	// Match type of this keyword.
 	// <span class="constraint Selectable">This field can be selected using the value "KeywordMatchType".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MatchType *KeywordMatchType `xml:"matchType,omitempty"`
+	MatchType *KeywordMatchType `xml:"https://adwords.google.com/api/adwords/cm/v201802 matchType,omitempty" json:"matchType,omitempty"`
 }
 
 type Language struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Language"`
-
 	*Criterion
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Code string `xml:"code,omitempty"`
+	Code *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 code,omitempty" json:"code,omitempty"`
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	Name string `xml:"name,omitempty"`
+	Name *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 name,omitempty" json:"name,omitempty"`
 }
 
 type Location struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Location"`
-
 	*Criterion
 
 	//
@@ -1216,41 +1172,37 @@ type Location struct {
 	// characters.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	LocationName string `xml:"locationName,omitempty"`
+	LocationName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 locationName,omitempty" json:"locationName,omitempty"`
 
 	//
 	// Display type of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayType string `xml:"displayType,omitempty"`
+	DisplayType *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayType,omitempty" json:"displayType,omitempty"`
 
 	//
 	// The targeting status of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	TargetingStatus *LocationTargetingStatus `xml:"targetingStatus,omitempty"`
+	TargetingStatus *LocationTargetingStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetingStatus,omitempty" json:"targetingStatus,omitempty"`
 
 	//
 	// Ordered list of parents of the location criterion.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	ParentLocations []*Location `xml:"parentLocations,omitempty"`
+	ParentLocations []*Location `xml:"https://adwords.google.com/api/adwords/cm/v201802 parentLocations,omitempty" json:"parentLocations,omitempty"`
 }
 
 type LongValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 LongValue"`
-
 	*NumberValue
 
 	//
 	// the underlying long value.
 	//
-	Number int64 `xml:"number,omitempty"`
+	Number *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 number,omitempty" json:"number,omitempty"`
 }
 
 type MobileAppCategory struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileAppCategory"`
-
 	*Criterion
 
 	//
@@ -1259,18 +1211,16 @@ type MobileAppCategory struct {
 	// <span class="constraint Selectable">This field can be selected using the value "MobileAppCategoryId".</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	MobileAppCategoryId int32 `xml:"mobileAppCategoryId,omitempty"`
+	MobileAppCategoryId *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 mobileAppCategoryId,omitempty" json:"mobileAppCategoryId,omitempty"`
 
 	//
 	// Name of this mobile app category.
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type MobileApplication struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 MobileApplication"`
-
 	*Criterion
 
 	//
@@ -1289,30 +1239,26 @@ type MobileApplication struct {
 	// <span class="constraint Selectable">This field can be selected using the value "AppId".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	AppId string `xml:"appId,omitempty"`
+	AppId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 appId,omitempty" json:"appId,omitempty"`
 
 	//
 	// Title of this mobile application.
 	// <span class="constraint Selectable">This field can be selected using the value "DisplayName".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	DisplayName string `xml:"displayName,omitempty"`
+	DisplayName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 displayName,omitempty" json:"displayName,omitempty"`
 }
 
 type Money struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Money"`
-
 	*ComparableValue
 
 	//
 	// Amount in micros. One million is equivalent to one unit.
 	//
-	MicroAmount int64 `xml:"microAmount,omitempty"`
+	MicroAmount *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 microAmount,omitempty" json:"microAmount,omitempty"`
 }
 
 type NetworkSetting struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NetworkSetting"`
-
 	//
 	// Ads will be served with Google.com search results.
 	// <span class="constraint AdxEnabled">This is disabled for AdX.</span>
@@ -1321,7 +1267,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel type DISPLAY.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetGoogleSearch bool `xml:"targetGoogleSearch,omitempty"`
+	TargetGoogleSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetGoogleSearch,omitempty" json:"targetGoogleSearch,omitempty"`
 
 	//
 	// Ads will be served on partner sites in the Google Search Network
@@ -1330,7 +1276,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel type MULTI_CHANNEL.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetSearchNetwork bool `xml:"targetSearchNetwork,omitempty"`
+	TargetSearchNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetSearchNetwork,omitempty" json:"targetSearchNetwork,omitempty"`
 
 	//
 	// Ads will be served on specified placements in the Google Display Network.
@@ -1339,7 +1285,7 @@ type NetworkSetting struct {
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel subtype SEARCH_MOBILE_APP.</span>
 	// <span class="constraint CampaignType">This field may only be set to true for campaign channel subtype SHOPPING_UNIVERSAL_ADS.</span>
 	//
-	TargetContentNetwork bool `xml:"targetContentNetwork,omitempty"`
+	TargetContentNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetContentNetwork,omitempty" json:"targetContentNetwork,omitempty"`
 
 	//
 	// Ads will be served on the Google Partner Network. This is available to
@@ -1347,59 +1293,47 @@ type NetworkSetting struct {
 	// <span class="constraint AdxEnabled">This is disabled for AdX.</span>
 	// <span class="constraint CampaignType">This field may only be set to false for campaign channel type MULTI_CHANNEL.</span>
 	//
-	TargetPartnerSearchNetwork bool `xml:"targetPartnerSearchNetwork,omitempty"`
+	TargetPartnerSearchNetwork *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 targetPartnerSearchNetwork,omitempty" json:"targetPartnerSearchNetwork,omitempty"`
 }
 
 type NotEmptyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NotEmptyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NotEmptyErrorReason `xml:"reason,omitempty"`
+	Reason *NotEmptyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NullError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NullError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *NullErrorReason `xml:"reason,omitempty"`
+	Reason *NullErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type NumberValue struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 NumberValue"`
-
 	*ComparableValue
 }
 
 type OperationAccessDenied struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperationAccessDenied"`
-
 	*ApiError
 
-	Reason *OperationAccessDeniedReason `xml:"reason,omitempty"`
+	Reason *OperationAccessDeniedReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type OperatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 OperatorError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *OperatorErrorReason `xml:"reason,omitempty"`
+	Reason *OperatorErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Placement struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Placement"`
-
 	*Criterion
 
 	//
@@ -1409,143 +1343,123 @@ type Placement struct {
 	// <span class="constraint Selectable">This field can be selected using the value "PlacementUrl".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null} when it is contained within {@link Operator}s : ADD.</span>
 	//
-	Url string `xml:"url,omitempty"`
+	Url *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 url,omitempty" json:"url,omitempty"`
 }
 
 type Platform struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Platform"`
-
 	*Criterion
 
 	//
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	PlatformName string `xml:"platformName,omitempty"`
+	PlatformName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 platformName,omitempty" json:"platformName,omitempty"`
 }
 
 type QuotaCheckError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 QuotaCheckError"`
-
 	*ApiError
 
-	Reason *QuotaCheckErrorReason `xml:"reason,omitempty"`
+	Reason *QuotaCheckErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RangeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RangeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RangeErrorReason `xml:"reason,omitempty"`
+	Reason *RangeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RateExceededError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RateExceededError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RateExceededErrorReason `xml:"reason,omitempty"`
+	Reason *RateExceededErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 
 	//
 	// Cause of the rate exceeded error.
 	//
-	RateName string `xml:"rateName,omitempty"`
+	RateName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateName,omitempty" json:"rateName,omitempty"`
 
 	//
 	// The scope of the rate (ACCOUNT/DEVELOPER).
 	//
-	RateScope string `xml:"rateScope,omitempty"`
+	RateScope *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 rateScope,omitempty" json:"rateScope,omitempty"`
 
 	//
 	// The amount of time (in seconds) the client should wait before retrying the request.
 	//
-	RetryAfterSeconds int32 `xml:"retryAfterSeconds,omitempty"`
+	RetryAfterSeconds *int32 `xml:"https://adwords.google.com/api/adwords/cm/v201802 retryAfterSeconds,omitempty" json:"retryAfterSeconds,omitempty"`
 }
 
 type ReadOnlyError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ReadOnlyError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *ReadOnlyErrorReason `xml:"reason,omitempty"`
+	Reason *ReadOnlyErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RegionCodeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RegionCodeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RegionCodeErrorReason `xml:"reason,omitempty"`
+	Reason *RegionCodeErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RejectedError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RejectedError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RejectedErrorReason `xml:"reason,omitempty"`
+	Reason *RejectedErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequestError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestError"`
-
 	*ApiError
 
-	Reason *RequestErrorReason `xml:"reason,omitempty"`
+	Reason *RequestErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type RequiredError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequiredError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *RequiredErrorReason `xml:"reason,omitempty"`
+	Reason *RequiredErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SizeLimitError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SizeLimitError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *SizeLimitErrorReason `xml:"reason,omitempty"`
+	Reason *SizeLimitErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type SoapHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 RequestHeader" json:"-"`
 
 	//
 	// The header identifies the customer id of the client of the AdWords manager, if an AdWords
 	// manager is acting on behalf of their client or the customer id of the advertiser managing their
 	// own account.
 	//
-	ClientCustomerId string `xml:"clientCustomerId,omitempty"`
+	ClientCustomerId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 clientCustomerId,omitempty" json:"clientCustomerId,omitempty"`
 
 	//
 	// Developer token to identify that the person making the call has enough
 	// quota.
 	//
-	DeveloperToken string `xml:"developerToken,omitempty"`
+	DeveloperToken *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 developerToken,omitempty" json:"developerToken,omitempty"`
 
 	//
 	// UserAgent is used to track distribution of API client programs and
@@ -1553,12 +1467,12 @@ type SoapHeader struct {
 	// value for tracking purposes. To be clear this is not the same as an HTTP
 	// user agent.
 	//
-	UserAgent string `xml:"userAgent,omitempty"`
+	UserAgent *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userAgent,omitempty" json:"userAgent,omitempty"`
 
 	//
 	// Used to validate the request without executing it.
 	//
-	ValidateOnly bool `xml:"validateOnly,omitempty"`
+	ValidateOnly *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 validateOnly,omitempty" json:"validateOnly,omitempty"`
 
 	//
 	// If true, API will try to commit as many error free operations as possible and
@@ -1566,104 +1480,96 @@ type SoapHeader struct {
 	//
 	// <p>Ignored for non-mutate calls.
 	//
-	PartialFailure bool `xml:"partialFailure,omitempty"`
+	PartialFailure *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 partialFailure,omitempty" json:"partialFailure,omitempty"`
 }
 
 type SoapResponseHeader struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 SoapResponseHeader"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 ResponseHeader" json:"-"`
 
 	//
 	// Unique id that identifies this request. If developers have any support issues, sending us
 	// this id will enable us to find their request more easily.
 	//
-	RequestId string `xml:"requestId,omitempty"`
+	RequestId *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 requestId,omitempty" json:"requestId,omitempty"`
 
 	//
 	// The name of the service being invoked.
 	//
-	ServiceName string `xml:"serviceName,omitempty"`
+	ServiceName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 serviceName,omitempty" json:"serviceName,omitempty"`
 
 	//
 	// The name of the method being invoked.
 	//
-	MethodName string `xml:"methodName,omitempty"`
+	MethodName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 methodName,omitempty" json:"methodName,omitempty"`
 
 	//
 	// Number of operations performed for this SOAP request.
 	//
-	Operations int64 `xml:"operations,omitempty"`
+	Operations *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 operations,omitempty" json:"operations,omitempty"`
 
 	//
 	// Elapsed time in milliseconds between the AdWords API receiving the request and sending the
 	// response.
 	//
-	ResponseTime int64 `xml:"responseTime,omitempty"`
+	ResponseTime *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 responseTime,omitempty" json:"responseTime,omitempty"`
 }
 
 type StringFormatError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringFormatError"`
-
 	*ApiError
 
-	Reason *StringFormatErrorReason `xml:"reason,omitempty"`
+	Reason *StringFormatErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type StringLengthError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 StringLengthError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *StringLengthErrorReason `xml:"reason,omitempty"`
+	Reason *StringLengthErrorReason `xml:"https://adwords.google.com/api/adwords/cm/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type CriterionUserInterest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserInterest"`
-
 	*Criterion
 
 	//
 	// Id of this user interest. This is a required field.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestId".</span>
 	//
-	UserInterestId int64 `xml:"userInterestId,omitempty"`
+	UserInterestId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestId,omitempty" json:"userInterestId,omitempty"`
 
 	//
 	// Parent Id of this user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestParentId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserInterestParentId int64 `xml:"userInterestParentId,omitempty"`
+	UserInterestParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestParentId,omitempty" json:"userInterestParentId,omitempty"`
 
 	//
 	// Name of this user interest.
 	// <span class="constraint Selectable">This field can be selected using the value "UserInterestName".</span>
 	//
-	UserInterestName string `xml:"userInterestName,omitempty"`
+	UserInterestName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userInterestName,omitempty" json:"userInterestName,omitempty"`
 }
 
 type CriterionUserList struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 CriterionUserList"`
-
 	*Criterion
 
 	//
 	// Id of this user list. This is a required field.
 	// <span class="constraint Selectable">This field can be selected using the value "UserListId".</span>
 	//
-	UserListId int64 `xml:"userListId,omitempty"`
+	UserListId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListId,omitempty" json:"userListId,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "UserListName".</span>
 	//
-	UserListName string `xml:"userListName,omitempty"`
+	UserListName *string `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListName,omitempty" json:"userListName,omitempty"`
 
 	//
 	// <span class="constraint Selectable">This field can be selected using the value "UserListMembershipStatus".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	//
-	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"userListMembershipStatus,omitempty"`
+	UserListMembershipStatus *CriterionUserListMembershipStatus `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListMembershipStatus,omitempty" json:"userListMembershipStatus,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the google.com
@@ -1671,33 +1577,31 @@ type CriterionUserList struct {
 	// <span class="constraint Selectable">This field can be selected using the value "UserListEligibleForSearch".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForSearch bool `xml:"userListEligibleForSearch,omitempty"`
+	UserListEligibleForSearch *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForSearch,omitempty" json:"userListEligibleForSearch,omitempty"`
 
 	//
 	// Determines whether a user list is eligible for targeting in the display network.
 	// <span class="constraint Selectable">This field can be selected using the value "UserListEligibleForDisplay".</span><span class="constraint Filterable">This field can be filtered on.</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	UserListEligibleForDisplay bool `xml:"userListEligibleForDisplay,omitempty"`
+	UserListEligibleForDisplay *bool `xml:"https://adwords.google.com/api/adwords/cm/v201802 userListEligibleForDisplay,omitempty" json:"userListEligibleForDisplay,omitempty"`
 }
 
 type Vertical struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201802 Vertical"`
-
 	*Criterion
 
 	//
 	// Id of this vertical.
 	// <span class="constraint Selectable">This field can be selected using the value "VerticalId".</span>
 	//
-	VerticalId int64 `xml:"verticalId,omitempty"`
+	VerticalId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalId,omitempty" json:"verticalId,omitempty"`
 
 	//
 	// Id of the parent of this vertical.
 	// <span class="constraint Selectable">This field can be selected using the value "VerticalParentId".</span>
 	// <span class="constraint ReadOnly">This field is read only and will be ignored when sent to the API.</span>
 	//
-	VerticalParentId int64 `xml:"verticalParentId,omitempty"`
+	VerticalParentId *int64 `xml:"https://adwords.google.com/api/adwords/cm/v201802 verticalParentId,omitempty" json:"verticalParentId,omitempty"`
 
 	//
 	// The category to target or exclude. Each subsequent element in the array
@@ -1708,7 +1612,7 @@ type Vertical struct {
 	// This field is required and must not be empty.
 	// <span class="constraint Selectable">This field can be selected using the value "Path".</span>
 	//
-	Path []string `xml:"path,omitempty"`
+	Path []string `xml:"https://adwords.google.com/api/adwords/cm/v201802 path,omitempty" json:"path,omitempty"`
 }
 
 //
@@ -1787,23 +1691,21 @@ const (
 )
 
 type Get struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 get"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 get" json:"-"`
 
 	//
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Selector *TrafficEstimatorSelector `xml:"selector,omitempty"`
+	Selector *TrafficEstimatorSelector `xml:"https://adwords.google.com/api/adwords/o/v201802 selector,omitempty" json:"selector,omitempty"`
 }
 
 type GetResponse struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 getResponse"`
+	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 getResponse" json:"-"`
 
-	Rval *TrafficEstimatorResult `xml:"rval,omitempty"`
+	Rval *TrafficEstimatorResult `xml:"https://adwords.google.com/api/adwords/o/v201802 rval,omitempty" json:"rval,omitempty"`
 }
 
 type AdGroupEstimate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 AdGroupEstimate"`
-
 	*Estimate
 
 	//
@@ -1811,7 +1713,7 @@ type AdGroupEstimate struct {
 	//
 	// This will be <code>null</code> for new ad groups.
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// The estimates for the keywords specified in the request.
@@ -1819,12 +1721,10 @@ type AdGroupEstimate struct {
 	// The list of estimates are returned in the same order as the keywords that
 	// were sent in the request.
 	//
-	KeywordEstimates []*KeywordEstimate `xml:"keywordEstimates,omitempty"`
+	KeywordEstimates []*KeywordEstimate `xml:"https://adwords.google.com/api/adwords/o/v201802 keywordEstimates,omitempty" json:"keywordEstimates,omitempty"`
 }
 
 type AdGroupEstimateRequest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 AdGroupEstimateRequest"`
-
 	*EstimateRequest
 
 	//
@@ -1833,7 +1733,7 @@ type AdGroupEstimateRequest struct {
 	//
 	// <p>For usage, refer to document from {@link CampaignEstimateRequest}.
 	//
-	AdGroupId int64 `xml:"adGroupId,omitempty"`
+	AdGroupId *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 adGroupId,omitempty" json:"adGroupId,omitempty"`
 
 	//
 	// The keywords to estimate.
@@ -1841,7 +1741,7 @@ type AdGroupEstimateRequest struct {
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	KeywordEstimateRequests []*KeywordEstimateRequest `xml:"keywordEstimateRequests,omitempty"`
+	KeywordEstimateRequests []*KeywordEstimateRequest `xml:"https://adwords.google.com/api/adwords/o/v201802 keywordEstimateRequests,omitempty" json:"keywordEstimateRequests,omitempty"`
 
 	//
 	// The max CPC bid to use for estimates for this ad group.
@@ -1849,12 +1749,10 @@ type AdGroupEstimateRequest struct {
 	// <p>This value overrides the max CPC of AdGroup specified by
 	// {@link #adGroupId}.
 	//
-	MaxCpc *Money `xml:"maxCpc,omitempty"`
+	MaxCpc *Money `xml:"https://adwords.google.com/api/adwords/o/v201802 maxCpc,omitempty" json:"maxCpc,omitempty"`
 }
 
 type CampaignEstimate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 CampaignEstimate"`
-
 	*Estimate
 
 	//
@@ -1862,24 +1760,22 @@ type CampaignEstimate struct {
 	//
 	// This will be <code>null</code> for new campaigns.
 	//
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 
 	//
 	// The estimates for the ad groups belonging to this campaign in the request.
 	//
 	// They will be returned in the same order that they were sent in the request.
 	//
-	AdGroupEstimates []*AdGroupEstimate `xml:"adGroupEstimates,omitempty"`
+	AdGroupEstimates []*AdGroupEstimate `xml:"https://adwords.google.com/api/adwords/o/v201802 adGroupEstimates,omitempty" json:"adGroupEstimates,omitempty"`
 
 	//
 	// Traffic estimates segmented by platform for this campaign.
 	//
-	PlatformEstimates []*PlatformCampaignEstimate `xml:"platformEstimates,omitempty"`
+	PlatformEstimates []*PlatformCampaignEstimate `xml:"https://adwords.google.com/api/adwords/o/v201802 platformEstimates,omitempty" json:"platformEstimates,omitempty"`
 }
 
 type CampaignEstimateRequest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 CampaignEstimateRequest"`
-
 	*EstimateRequest
 
 	//
@@ -1888,7 +1784,7 @@ type CampaignEstimateRequest struct {
 	// Refer to the {@link CampaignEstimateRequest} documentation for
 	// detailed usage.
 	//
-	CampaignId int64 `xml:"campaignId,omitempty"`
+	CampaignId *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 campaignId,omitempty" json:"campaignId,omitempty"`
 
 	//
 	// The list of ad groups to estimate. This field is required and should not be {@code null}. At
@@ -1899,7 +1795,7 @@ type CampaignEstimateRequest struct {
 	// the API will return an error.
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	AdGroupEstimateRequests []*AdGroupEstimateRequest `xml:"adGroupEstimateRequests,omitempty"`
+	AdGroupEstimateRequests []*AdGroupEstimateRequest `xml:"https://adwords.google.com/api/adwords/o/v201802 adGroupEstimateRequests,omitempty" json:"adGroupEstimateRequests,omitempty"`
 
 	//
 	// A list of {@link Criterion}s to be used for this Campaign. Criteria
@@ -1937,7 +1833,7 @@ type CampaignEstimateRequest struct {
 	// <span class="constraint ContentsDistinct">This field must contain distinct elements.</span>
 	// <span class="constraint ContentsNotNull">This field must not contain {@code null} elements.</span>
 	//
-	Criteria []*Criterion `xml:"criteria,omitempty"`
+	Criteria []*Criterion `xml:"https://adwords.google.com/api/adwords/o/v201802 criteria,omitempty" json:"criteria,omitempty"`
 
 	//
 	// A {@link NetworkSetting} to be used for this Campaign. The value of this
@@ -1955,51 +1851,43 @@ type CampaignEstimateRequest struct {
 	// <p>If all request network settings and Campaign's network settings are
 	// empty, the default is {@link NetworkSetting#targetGoogleSearch}.
 	//
-	NetworkSetting *NetworkSetting `xml:"networkSetting,omitempty"`
+	NetworkSetting *NetworkSetting `xml:"https://adwords.google.com/api/adwords/o/v201802 networkSetting,omitempty" json:"networkSetting,omitempty"`
 
 	//
 	// Daily campaign budget to use in traffic estimation.  If not specified,
 	// the daily budget is unlimited.
 	//
-	DailyBudget *Money `xml:"dailyBudget,omitempty"`
+	DailyBudget *Money `xml:"https://adwords.google.com/api/adwords/o/v201802 dailyBudget,omitempty" json:"dailyBudget,omitempty"`
 }
 
 type CurrencyCodeError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 CurrencyCodeError"`
-
 	*ApiError
 
 	//
 	// The error reason represented by an enum.
 	//
-	Reason *CurrencyCodeErrorReason `xml:"reason,omitempty"`
+	Reason *CurrencyCodeErrorReason `xml:"https://adwords.google.com/api/adwords/o/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type Estimate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 Estimate"`
-
 	//
 	// Indicates that this instance is a subtype of Estimate.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	EstimateType string `xml:"Estimate.Type,omitempty"`
+	EstimateType *string `xml:"https://adwords.google.com/api/adwords/o/v201802 Estimate.Type,omitempty" json:"estimateType,omitempty"`
 }
 
 type EstimateRequest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 EstimateRequest"`
-
 	//
 	// Indicates that this instance is a subtype of EstimateRequest.
 	// Although this field is returned in the response, it is ignored on input
 	// and cannot be selected. Specify xsi:type instead.
 	//
-	EstimateRequestType string `xml:"EstimateRequest.Type,omitempty"`
+	EstimateRequestType *string `xml:"https://adwords.google.com/api/adwords/o/v201802 EstimateRequest.Type,omitempty" json:"estimateRequestType,omitempty"`
 }
 
 type KeywordEstimate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 KeywordEstimate"`
-
 	*Estimate
 
 	//
@@ -2007,7 +1895,7 @@ type KeywordEstimate struct {
 	//
 	// This will not be returned if this is a new keyword.
 	//
-	CriterionId int64 `xml:"criterionId,omitempty"`
+	CriterionId *int64 `xml:"https://adwords.google.com/api/adwords/o/v201802 criterionId,omitempty" json:"criterionId,omitempty"`
 
 	//
 	// The lower bound on the estimated stats.
@@ -2015,7 +1903,7 @@ type KeywordEstimate struct {
 	// <p>This is not a guarantee that actual performance will never be lower than
 	// these stats.
 	//
-	Min *StatsEstimate `xml:"min,omitempty"`
+	Min *StatsEstimate `xml:"https://adwords.google.com/api/adwords/o/v201802 min,omitempty" json:"min,omitempty"`
 
 	//
 	// The upper bound on the estimated stats.
@@ -2023,12 +1911,10 @@ type KeywordEstimate struct {
 	// <p>This is not a guarantee that actual performance will never be higher than
 	// these stats.
 	//
-	Max *StatsEstimate `xml:"max,omitempty"`
+	Max *StatsEstimate `xml:"https://adwords.google.com/api/adwords/o/v201802 max,omitempty" json:"max,omitempty"`
 }
 
 type KeywordEstimateRequest struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 KeywordEstimateRequest"`
-
 	*EstimateRequest
 
 	//
@@ -2045,7 +1931,7 @@ type KeywordEstimateRequest struct {
 	// </ul>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Keyword *Keyword `xml:"keyword,omitempty"`
+	Keyword *Keyword `xml:"https://adwords.google.com/api/adwords/o/v201802 keyword,omitempty" json:"keyword,omitempty"`
 
 	//
 	// The max CPC bid for this keyword.
@@ -2059,7 +1945,7 @@ type KeywordEstimateRequest struct {
 	// {@link KeywordEstimateRequest}. This would happen, for example, if the
 	// {@link KeywordEstimateRequest} is for a new keyword.
 	//
-	MaxCpc *Money `xml:"maxCpc,omitempty"`
+	MaxCpc *Money `xml:"https://adwords.google.com/api/adwords/o/v201802 maxCpc,omitempty" json:"maxCpc,omitempty"`
 
 	//
 	// Whether the keyword is negative or not. The default value is false.
@@ -2070,86 +1956,76 @@ type KeywordEstimateRequest struct {
 	// but including a negative keyword will affect the other estimates in the
 	// request.
 	//
-	IsNegative bool `xml:"isNegative,omitempty"`
+	IsNegative *bool `xml:"https://adwords.google.com/api/adwords/o/v201802 isNegative,omitempty" json:"isNegative,omitempty"`
 }
 
 type PlatformCampaignEstimate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 PlatformCampaignEstimate"`
-
 	//
 	// The {@link Platform} associated with this estimate.
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	Platform *Platform `xml:"platform,omitempty"`
+	Platform *Platform `xml:"https://adwords.google.com/api/adwords/o/v201802 platform,omitempty" json:"platform,omitempty"`
 
 	//
 	// Minimum estimate for the specified {@link Platform}.
 	//
-	MinEstimate *StatsEstimate `xml:"minEstimate,omitempty"`
+	MinEstimate *StatsEstimate `xml:"https://adwords.google.com/api/adwords/o/v201802 minEstimate,omitempty" json:"minEstimate,omitempty"`
 
 	//
 	// Maximum estimate for the specified {@link Platform}.
 	//
-	MaxEstimate *StatsEstimate `xml:"maxEstimate,omitempty"`
+	MaxEstimate *StatsEstimate `xml:"https://adwords.google.com/api/adwords/o/v201802 maxEstimate,omitempty" json:"maxEstimate,omitempty"`
 }
 
 type StatsEstimate struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 StatsEstimate"`
-
 	//
 	// The estimated average CPC.
 	//
-	AverageCpc *Money `xml:"averageCpc,omitempty"`
+	AverageCpc *Money `xml:"https://adwords.google.com/api/adwords/o/v201802 averageCpc,omitempty" json:"averageCpc,omitempty"`
 
 	//
 	// The estimated average position.
 	//
-	AveragePosition float64 `xml:"averagePosition,omitempty"`
+	AveragePosition *float64 `xml:"https://adwords.google.com/api/adwords/o/v201802 averagePosition,omitempty" json:"averagePosition,omitempty"`
 
 	//
 	// The estimated click through rate.
 	//
-	ClickThroughRate float64 `xml:"clickThroughRate,omitempty"`
+	ClickThroughRate *float64 `xml:"https://adwords.google.com/api/adwords/o/v201802 clickThroughRate,omitempty" json:"clickThroughRate,omitempty"`
 
 	//
 	// The estimated number of clicks, in floating point representation.
 	//
-	ClicksPerDay float32 `xml:"clicksPerDay,omitempty"`
+	ClicksPerDay float32 `xml:"https://adwords.google.com/api/adwords/o/v201802 clicksPerDay,omitempty" json:"clicksPerDay,omitempty"`
 
 	//
 	// The estimated number of impressions, in floating point representation.
 	//
-	ImpressionsPerDay float32 `xml:"impressionsPerDay,omitempty"`
+	ImpressionsPerDay float32 `xml:"https://adwords.google.com/api/adwords/o/v201802 impressionsPerDay,omitempty" json:"impressionsPerDay,omitempty"`
 
 	//
 	// The estimated total cost.
 	//
-	TotalCost *Money `xml:"totalCost,omitempty"`
+	TotalCost *Money `xml:"https://adwords.google.com/api/adwords/o/v201802 totalCost,omitempty" json:"totalCost,omitempty"`
 }
 
 type TrafficEstimatorError struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 TrafficEstimatorError"`
-
 	*ApiError
 
-	Reason *TrafficEstimatorErrorReason `xml:"reason,omitempty"`
+	Reason *TrafficEstimatorErrorReason `xml:"https://adwords.google.com/api/adwords/o/v201802 reason,omitempty" json:"reason,omitempty"`
 }
 
 type TrafficEstimatorResult struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 TrafficEstimatorResult"`
-
 	//
 	// The estimates for the campaigns specified in the request.
 	//
 	// They are listed in the same order as the campaigns that were sent in the
 	// request.
 	//
-	CampaignEstimates []*CampaignEstimate `xml:"campaignEstimates,omitempty"`
+	CampaignEstimates []*CampaignEstimate `xml:"https://adwords.google.com/api/adwords/o/v201802 campaignEstimates,omitempty" json:"campaignEstimates,omitempty"`
 }
 
 type TrafficEstimatorSelector struct {
-	XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/o/v201802 TrafficEstimatorSelector"`
-
 	//
 	// A list of all campaigns to estimate.<p>
 	//
@@ -2160,12 +2036,12 @@ type TrafficEstimatorSelector struct {
 	// <span class="constraint NotEmpty">This field must contain at least one element.</span>
 	// <span class="constraint Required">This field is required and should not be {@code null}.</span>
 	//
-	CampaignEstimateRequests []*CampaignEstimateRequest `xml:"campaignEstimateRequests,omitempty"`
+	CampaignEstimateRequests []*CampaignEstimateRequest `xml:"https://adwords.google.com/api/adwords/o/v201802 campaignEstimateRequests,omitempty" json:"campaignEstimateRequests,omitempty"`
 
 	//
 	// To request a list of campaign level estimates segmented by platform.
 	//
-	PlatformEstimateRequested bool `xml:"platformEstimateRequested,omitempty"`
+	PlatformEstimateRequested *bool `xml:"https://adwords.google.com/api/adwords/o/v201802 platformEstimateRequested,omitempty" json:"platformEstimateRequested,omitempty"`
 }
 
 type TrafficEstimatorServiceInterface struct {
@@ -0,0 +1,76 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TrafficEstimatorService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// TrafficEstimatorServiceAPI is the interface of the TrafficEstimatorService operations. It is
+// implemented by TrafficEstimatorServiceInterface and, for tests, by FakeTrafficEstimatorService.
+type TrafficEstimatorServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+}
+
+var _ TrafficEstimatorServiceAPI = (*TrafficEstimatorServiceInterface)(nil)
+var _ TrafficEstimatorServiceAPI = (*FakeTrafficEstimatorService)(nil)
+
+// FakeCall is a call recorded by FakeTrafficEstimatorService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeTrafficEstimatorService is a programmable TrafficEstimatorServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeTrafficEstimatorService struct {
+	GetFunc func(request *Get) (*GetResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeTrafficEstimatorService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeTrafficEstimatorService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeTrafficEstimatorService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeTrafficEstimatorService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeTrafficEstimatorService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeTrafficEstimatorService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
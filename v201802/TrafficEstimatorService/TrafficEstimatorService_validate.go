@@ -0,0 +1,109 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TrafficEstimatorService
+
+import "github.com/godofdream/go-googleadsinofficial/validate"
+
+// Validate checks Get against the constraints of the API reference. It
+// returns validate.Errors listing every violation, or nil.
+func (t *Get) Validate() error {
+	v := new(validate.Validator)
+	t.validate(v, "", "")
+	return v.Err()
+}
+
+func (t *Criterion) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Criterion)
+	}
+	if validate.HasOperator(op, "REMOVE", "SET") {
+		v.Required(validate.Field(path, "id"), t.Id != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.ReadOnly(validate.Field(path, "type"), op, t.Type_ != nil)
+	}
+}
+
+func (t *Keyword) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Keyword)
+	}
+	t.Criterion.validate(v, path, op)
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "text"), t.Text != nil)
+	}
+	if validate.HasOperator(op, "ADD") {
+		v.Required(validate.Field(path, "matchType"), t.MatchType != nil)
+	}
+}
+
+func (t *Get) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(Get)
+	}
+	v.Required(validate.Field(path, "selector"), t.Selector != nil)
+	if t.Selector != nil {
+		t.Selector.validate(v, validate.Field(path, "selector"), op)
+	}
+}
+
+func (t *AdGroupEstimateRequest) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(AdGroupEstimateRequest)
+	}
+	v.Required(validate.Field(path, "keywordEstimateRequests"), len(t.KeywordEstimateRequests) > 0)
+	v.ContentsNotNull(validate.Field(path, "keywordEstimateRequests"), t.KeywordEstimateRequests)
+	v.ContentsDistinct(validate.Field(path, "keywordEstimateRequests"), t.KeywordEstimateRequests)
+	for i, e := range t.KeywordEstimateRequests {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "keywordEstimateRequests"), i), op)
+		}
+	}
+}
+
+func (t *CampaignEstimateRequest) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(CampaignEstimateRequest)
+	}
+	v.ContentsNotNull(validate.Field(path, "adGroupEstimateRequests"), t.AdGroupEstimateRequests)
+	for i, e := range t.AdGroupEstimateRequests {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "adGroupEstimateRequests"), i), op)
+		}
+	}
+	v.ContentsNotNull(validate.Field(path, "criteria"), t.Criteria)
+	v.ContentsDistinct(validate.Field(path, "criteria"), t.Criteria)
+	for i, e := range t.Criteria {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "criteria"), i), op)
+		}
+	}
+}
+
+func (t *KeywordEstimateRequest) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(KeywordEstimateRequest)
+	}
+	v.Required(validate.Field(path, "keyword"), t.Keyword != nil)
+	if t.Keyword != nil {
+		t.Keyword.validate(v, validate.Field(path, "keyword"), op)
+	}
+}
+
+func (t *TrafficEstimatorSelector) validate(v *validate.Validator, path, op string) {
+	if t == nil {
+		t = new(TrafficEstimatorSelector)
+	}
+	v.Required(validate.Field(path, "campaignEstimateRequests"), len(t.CampaignEstimateRequests) > 0)
+	v.NotEmpty(validate.Field(path, "campaignEstimateRequests"), t.CampaignEstimateRequests)
+	if len(t.CampaignEstimateRequests) > 0 {
+		v.Size(validate.Field(path, "campaignEstimateRequests"), len(t.CampaignEstimateRequests), 0, 5)
+	}
+	v.ContentsNotNull(validate.Field(path, "campaignEstimateRequests"), t.CampaignEstimateRequests)
+	v.ContentsDistinct(validate.Field(path, "campaignEstimateRequests"), t.CampaignEstimateRequests)
+	for i, e := range t.CampaignEstimateRequests {
+		if e != nil {
+			e.validate(v, validate.Index(validate.Field(path, "campaignEstimateRequests"), i), op)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TrialAsyncErrorService
+
+import (
+	"fmt"
+	"sync"
+)
+
+// TrialAsyncErrorServiceAPI is the interface of the TrialAsyncErrorService operations. It is
+// implemented by TrialAsyncErrorServiceInterface and, for tests, by FakeTrialAsyncErrorService.
+type TrialAsyncErrorServiceAPI interface {
+	Get(request *Get) (*GetResponse, error)
+	Query(request *Query) (*QueryResponse, error)
+}
+
+var _ TrialAsyncErrorServiceAPI = (*TrialAsyncErrorServiceInterface)(nil)
+var _ TrialAsyncErrorServiceAPI = (*FakeTrialAsyncErrorService)(nil)
+
+// FakeCall is a call recorded by FakeTrialAsyncErrorService.
+type FakeCall struct {
+	// Method is the name of the called operation, e.g. "Get".
+	Method string
+
+	// Request is the request passed to the operation.
+	Request interface{}
+}
+
+// FakeTrialAsyncErrorService is a programmable TrialAsyncErrorServiceAPI for tests. Each operation records
+// the call and delegates to the function of the same name with a Func
+// suffix. Operations without a function fail.
+type FakeTrialAsyncErrorService struct {
+	GetFunc   func(request *Get) (*GetResponse, error)
+	QueryFunc func(request *Query) (*QueryResponse, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+func (f *FakeTrialAsyncErrorService) record(method string, request interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Request: request})
+}
+
+// Calls returns the recorded calls in call order.
+func (f *FakeTrialAsyncErrorService) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeTrialAsyncErrorService) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *FakeTrialAsyncErrorService) Get(request *Get) (*GetResponse, error) {
+	f.record("Get", request)
+	if f.GetFunc == nil {
+		return nil, fmt.Errorf("FakeTrialAsyncErrorService.GetFunc is not set")
+	}
+	return f.GetFunc(request)
+}
+
+// GetCalls returns the requests of the recorded Get calls.
+func (f *FakeTrialAsyncErrorService) GetCalls() []*Get {
+	var requests []*Get
+	for _, c := range f.Calls() {
+		if c.Method == "Get" {
+			requests = append(requests, c.Request.(*Get))
+		}
+	}
+	return requests
+}
+
+// Query records the call and delegates to QueryFunc.
+func (f *FakeTrialAsyncErrorService) Query(request *Query) (*QueryResponse, error) {
+	f.record("Query", request)
+	if f.QueryFunc == nil {
+		return nil, fmt.Errorf("FakeTrialAsyncErrorService.QueryFunc is not set")
+	}
+	return f.QueryFunc(request)
+}
+
+// QueryCalls returns the requests of the recorded Query calls.
+func (f *FakeTrialAsyncErrorService) QueryCalls() []*Query {
+	var requests []*Query
+	for _, c := range f.Calls() {
+		if c.Method == "Query" {
+			requests = append(requests, c.Request.(*Query))
+		}
+	}
+	return requests
+}
@@ -0,0 +1,21 @@
+// Code generated by adwordsgen. DO NOT EDIT.
+
+package TrialService
+
+// AddOp returns the ADD operation of operand.
+func AddOp(operand *Trial) *TrialOperation {
+	op := OperatorADD
+	return &TrialOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// SetOp returns the SET operation of operand.
+func SetOp(operand *Trial) *TrialOperation {
+	op := OperatorSET
+	return &TrialOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
+
+// RemoveOp returns the REMOVE operation of operand.
+func RemoveOp(operand *Trial) *TrialOperation {
+	op := OperatorREMOVE
+	return &TrialOperation{Operation: &Operation{Operator: &op}, Operand: operand}
+}
@@ -0,0 +1,263 @@
+// Package validate checks requests against the constraints documented in the
+// AdWords API reference before they are sent.
+//
+// The generated Validate methods of the service packages use a Validator to
+// collect every violation of a request together with the path of the
+// offending field, e.g. "operations[2].operand.finalUrls". Paths use the
+// element names of the API, like the fieldPath of an ApiError.
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/godofdream/go-googleadsinofficial/datetime"
+)
+
+// An Error is a single violated constraint.
+type Error struct {
+	// Path is the path of the field, e.g. "operations[0].operand.name".
+	Path string
+
+	// Constraint is the name of the constraint in the API reference, e.g.
+	// "Required" or "StringLength".
+	Constraint string
+
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// Errors lists all violations of a request in field order.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	switch len(e) {
+	case 0:
+		return "no errors"
+	case 1:
+		return e[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", e[0].Error(), len(e)-1)
+}
+
+// A Validator collects the errors of a request.
+type Validator struct {
+	errs Errors
+}
+
+// Err returns the collected errors as Errors, or nil if there are none.
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// Report adds an error for the field at path.
+func (v *Validator) Report(path, constraint, format string, args ...interface{}) {
+	v.errs = append(v.errs, &Error{Path: path, Constraint: constraint, Message: fmt.Sprintf(format, args...)})
+}
+
+// Field returns the path of the field name below path.
+func Field(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// Index returns the path of element i of the list at path.
+func Index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// HasOperator reports whether op is one of ops. An empty ops list holds for
+// every operator.
+func HasOperator(op string, ops ...string) bool {
+	if len(ops) == 0 {
+		return true
+	}
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+// Required reports a missing required field.
+func (v *Validator) Required(path string, set bool) {
+	if !set {
+		v.Report(path, "Required", "field is required")
+	}
+}
+
+// ReadOnly reports a read only field that is set. The API ignores such
+// fields, which usually means the request does not do what was intended.
+// The generated Validate methods only check it for ADD, as an entity
+// fetched with Get and sent with SET still holds its read only fields.
+func (v *Validator) ReadOnly(path, op string, set bool) {
+	if set {
+		v.Report(path, "ReadOnly", "field is read only for operator %s", op)
+	}
+}
+
+// Size checks the size n of a collection. max < 0 means no maximum.
+func (v *Validator) Size(path string, n, min, max int) {
+	switch {
+	case n < min:
+		v.Report(path, "CollectionSize", "collection has %d elements, the minimum is %d", n, min)
+	case max >= 0 && n > max:
+		v.Report(path, "CollectionSize", "collection has %d elements, the maximum is %d", n, max)
+	}
+}
+
+// NotEmpty reports an empty collection. A nil collection is not sent and
+// therefore not empty.
+func (v *Validator) NotEmpty(path string, list interface{}) {
+	rv := reflect.ValueOf(list)
+	if !rv.IsNil() && rv.Len() == 0 {
+		v.Report(path, "NotEmpty", "collection must contain at least one element")
+	}
+}
+
+// ContentsNotNull reports nil elements of a slice of pointers.
+func (v *Validator) ContentsNotNull(path string, list interface{}) {
+	rv := reflect.ValueOf(list)
+	for i := 0; i < rv.Len(); i++ {
+		if rv.Index(i).IsNil() {
+			v.Report(Index(path, i), "ContentsNotNull", "element must not be null")
+		}
+	}
+}
+
+// ContentsDistinct reports elements of a slice equal to an earlier element.
+func (v *Validator) ContentsDistinct(path string, list interface{}) {
+	rv := reflect.ValueOf(list)
+	for i := 1; i < rv.Len(); i++ {
+		for j := 0; j < i; j++ {
+			if reflect.DeepEqual(rv.Index(i).Interface(), rv.Index(j).Interface()) {
+				v.Report(Index(path, i), "ContentsDistinct", "element is a duplicate of element %d", j)
+				break
+			}
+		}
+	}
+}
+
+// ContentsStringLength reports blank strings of a list.
+func (v *Validator) ContentsStringLength(path string, list []string) {
+	for i, s := range list {
+		if strings.TrimSpace(s) == "" {
+			v.Report(Index(path, i), "ContentsStringLength", "string must not be empty")
+		}
+	}
+}
+
+// Range checks min <= x <= max.
+func (v *Validator) Range(path string, x, min, max float64) {
+	if x < min || x > max {
+		v.Report(path, "InRange", "%v is not between %v and %v", x, min, max)
+	}
+}
+
+// AtLeast checks x >= min.
+func (v *Validator) AtLeast(path string, x, min float64) {
+	if x < min {
+		v.Report(path, "InRange", "%v is less than %v", x, min)
+	}
+}
+
+// StringLength checks the length of a string that is set. The length is
+// counted in characters, or in UTF-8 bytes if bytes is set, after trimming
+// surrounding white space if trim is set. max < 0 means no maximum.
+func (v *Validator) StringLength(path, s string, min, max int, trim, bytes bool) {
+	if trim {
+		s = strings.TrimSpace(s)
+	}
+	n := utf8.RuneCountInString(s)
+	unit := "characters"
+	if bytes {
+		n, unit = len(s), "bytes"
+	}
+	switch {
+	case n < min && min == 1:
+		v.Report(path, "StringLength", "string must not be empty")
+	case n < min:
+		v.Report(path, "StringLength", "string has %d %s, the minimum is %d", n, unit, min)
+	case max >= 0 && n > max:
+		v.Report(path, "StringLength", "string has %d %s, the maximum is %d", n, unit, max)
+	}
+}
+
+// DateRange checks that the yyyyMMdd dates from and to lie within
+// [min, max]. Unset bounds are not checked.
+func (v *Validator) DateRange(path, from, to, min, max string) {
+	for _, d := range []string{from, to} {
+		if d != "" && (d < min || d > max) {
+			v.Report(path, "DateRangeWithinRange", "date %s is not within [%s, %s]", d, min, max)
+		}
+	}
+}
+
+// Date checks that s is a "yyyyMMdd" date.
+func (v *Validator) Date(path, s string) {
+	if _, err := datetime.ParseDate(s); err != nil {
+		v.Report(path, "Date", "invalid date %q, want yyyyMMdd", s)
+	}
+}
+
+// DateTime checks that s is a "yyyyMMdd HHmmss <time zone ID>" timestamp.
+// The time zone ID may be omitted for timestamps in account time.
+func (v *Validator) DateTime(path, s string) {
+	if _, err := datetime.ParseDateTime(s, time.UTC); err != nil {
+		v.Report(path, "DateTime", "invalid timestamp %q, want yyyyMMdd HHmmss <time zone ID>", s)
+	}
+}
+
+// SupportedOperator reports an operator that is not one of ops.
+func (v *Validator) SupportedOperator(path, op string, ops ...string) {
+	if op != "" && !HasOperator(op, ops...) {
+		v.Report(path, "SupportedOperators", "operator %s is not supported, use one of %s", op, strings.Join(ops, ", "))
+	}
+}
+
+// DistinctIds reports operations of a list that address the same entity as
+// an earlier one. ids holds the operators and identifying keys of the
+// operations, "" for operations that cannot be identified.
+func (v *Validator) DistinctIds(path string, operators, ids []string, ops ...string) {
+	first := make(map[string]int)
+	for i, id := range ids {
+		if id == "" || !HasOperator(operators[i], ops...) {
+			continue
+		}
+		if j, ok := first[id]; ok {
+			v.Report(Index(path, i), "DistinctIds", "operation addresses the same entity as operation %d", j)
+			continue
+		}
+		first[id] = i
+	}
+}
+
+// ID returns the key of an entity identified by ids, or "" if none of the
+// ids is set.
+func ID(ids ...*int64) string {
+	parts := make([]string, len(ids))
+	set := false
+	for i, id := range ids {
+		parts[i] = "-"
+		if id != nil {
+			parts[i], set = strconv.FormatInt(*id, 10), true
+		}
+	}
+	if !set {
+		return ""
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,154 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// check runs fn on a new Validator and returns the collected errors as
+// "path constraint: message".
+func check(fn func(v *Validator)) []string {
+	v := new(Validator)
+	fn(v)
+	var list []string
+	var errs Errors
+	if errors.As(v.Err(), &errs) {
+		for _, e := range errs {
+			list = append(list, e.Path+" "+e.Constraint+": "+e.Message)
+		}
+	}
+	return list
+}
+
+func expect(t *testing.T, name string, got []string, want ...string) {
+	t.Helper()
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("%s: got errors\n%s\nwant\n%s", name, strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	v := new(Validator)
+	if err := v.Err(); err != nil {
+		t.Errorf("got %v without errors", err)
+	}
+	v.Required(Field(Field(Index("operations", 2), "operand"), "name"), false)
+	if err := v.Err(); err == nil || err.Error() != "operations[2].operand.name: field is required" {
+		t.Errorf("got %v", err)
+	}
+	v.Required("selector", false)
+	if err := v.Err(); err.Error() != "operations[2].operand.name: field is required (and 1 more errors)" {
+		t.Errorf("got %v", err)
+	}
+	if Field("", "selector") != "selector" {
+		t.Errorf("got path %q", Field("", "selector"))
+	}
+}
+
+func TestStringLength(t *testing.T) {
+	expect(t, "StringLength", check(func(v *Validator) {
+		v.StringLength("a", "", 1, 10, false, false)
+		v.StringLength("b", "  ", 1, 10, true, false)
+		v.StringLength("c", "  ", 1, 10, false, false)
+		v.StringLength("d", "größe", 1, 5, false, false)
+		v.StringLength("e", "größe", 1, 5, false, true)
+		v.StringLength("f", "ab", 3, -1, false, false)
+		v.StringLength("g", strings.Repeat("x", 1000), 0, -1, false, false)
+	}),
+		"a StringLength: string must not be empty",
+		"b StringLength: string must not be empty",
+		"e StringLength: string has 7 bytes, the maximum is 5",
+		"f StringLength: string has 2 characters, the minimum is 3",
+	)
+}
+
+func TestCollections(t *testing.T) {
+	a, b := int64(1), int64(2)
+	expect(t, "collections", check(func(v *Validator) {
+		v.Size("size", 1, 2, 5)
+		v.Size("size", 6, 2, 5)
+		v.Size("size", 100, 2, -1)
+		v.NotEmpty("nil", []string(nil))
+		v.NotEmpty("empty", []string{})
+		v.ContentsNotNull("ptrs", []*int64{&a, nil, &b, nil})
+		v.ContentsDistinct("values", []string{"x", "y", "x", "y", "z"})
+		v.ContentsDistinct("ptrs", []*int64{&a, &b, &b})
+		v.ContentsStringLength("urls", []string{"https://example.com", " ", ""})
+	}),
+		"size CollectionSize: collection has 1 elements, the minimum is 2",
+		"size CollectionSize: collection has 6 elements, the maximum is 5",
+		"empty NotEmpty: collection must contain at least one element",
+		"ptrs[1] ContentsNotNull: element must not be null",
+		"ptrs[3] ContentsNotNull: element must not be null",
+		"values[2] ContentsDistinct: element is a duplicate of element 0",
+		"values[3] ContentsDistinct: element is a duplicate of element 1",
+		"ptrs[2] ContentsDistinct: element is a duplicate of element 1",
+		"urls[1] ContentsStringLength: string must not be empty",
+		"urls[2] ContentsStringLength: string must not be empty",
+	)
+}
+
+func TestRanges(t *testing.T) {
+	expect(t, "ranges", check(func(v *Validator) {
+		v.Range("bid", 0.5, 0, 1)
+		v.Range("bid", 1.5, 0, 1)
+		v.AtLeast("amount", 0, 0)
+		v.AtLeast("amount", -1, 0)
+		v.DateRange("range", "20180101", "", "20170101", "20371230")
+		v.DateRange("range", "20160101", "20380101", "20170101", "20371230")
+	}),
+		"bid InRange: 1.5 is not between 0 and 1",
+		"amount InRange: -1 is less than 0",
+		"range DateRangeWithinRange: date 20160101 is not within [20170101, 20371230]",
+		"range DateRangeWithinRange: date 20380101 is not within [20170101, 20371230]",
+	)
+}
+
+func TestDates(t *testing.T) {
+	expect(t, "dates", check(func(v *Validator) {
+		v.Date("startDate", "20180228")
+		v.Date("startDate", "20180229")
+		v.DateTime("conversionTime", "20180228 235959 America/New_York")
+		v.DateTime("expiration", "20180228 235959")
+		v.DateTime("conversionTime", "2018-02-28T23:59:59Z")
+	}),
+		`startDate Date: invalid date "20180229", want yyyyMMdd`,
+		`conversionTime DateTime: invalid timestamp "2018-02-28T23:59:59Z", want yyyyMMdd HHmmss <time zone ID>`,
+	)
+}
+
+func TestOperators(t *testing.T) {
+	if !HasOperator("SET") || !HasOperator("SET", "ADD", "SET") || HasOperator("REMOVE", "ADD", "SET") {
+		t.Error("wrong HasOperator")
+	}
+	expect(t, "operators", check(func(v *Validator) {
+		v.ReadOnly("id", "ADD", false)
+		v.ReadOnly("id", "ADD", true)
+		v.SupportedOperator("operator", "", "ADD", "SET")
+		v.SupportedOperator("operator", "SET", "ADD", "SET")
+		v.SupportedOperator("operator", "REMOVE", "ADD", "SET")
+	}),
+		"id ReadOnly: field is read only for operator ADD",
+		"operator SupportedOperators: operator REMOVE is not supported, use one of ADD, SET",
+	)
+}
+
+func TestDistinctIds(t *testing.T) {
+	one, two := int64(1), int64(2)
+	if id := ID(nil, nil); id != "" {
+		t.Errorf("got %q without ids", id)
+	}
+	if id := ID(&one, nil, &two); id != "1/-/2" {
+		t.Errorf("got %q", id)
+	}
+	expect(t, "DistinctIds", check(func(v *Validator) {
+		v.DistinctIds("operations",
+			[]string{"SET", "ADD", "SET", "REMOVE", "SET", "ADD", "ADD"},
+			[]string{ID(&one), ID(&one), ID(&one), ID(&two), ID(&two), "", ""},
+			"SET", "REMOVE")
+	}),
+		"operations[2] DistinctIds: operation addresses the same entity as operation 0",
+		"operations[4] DistinctIds: operation addresses the same entity as operation 3",
+	)
+}
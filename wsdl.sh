@@ -1,7 +1,7 @@
 #!/bin/bash
 
 export PATH="$PATH:$1/bin"
-export version="v201802"
+export version="${2:-v201802}"
 mkdir -p $version
 cd $version
 for url in \
@@ -64,3 +64,5 @@ https://adwords.google.com/api/adwords/cm/$version/TrialService?wsdl \
   #wsdl2go -p googleadsinofficial -i ${service}.wsdl -o ${service}/${service}.go
   gowsdl -o ${service}.go -p ${service} ${service}.wsdl
 done
+
+go run ../cmd/adwordsgen */